@@ -0,0 +1,29 @@
+// Typed client for the Ethos HTTP API. Paths, parameters and bodies come
+// from schema.gen.ts, which `make generate-client-ts` writes from the
+// gateway's OpenAPI spec; do not edit it by hand.
+import createClient, { type ClientOptions } from "openapi-fetch";
+
+import type { components, paths } from "./schema.gen";
+
+export type { components, paths };
+
+export interface EthosClientOptions extends ClientOptions {
+  // Returns the access token to send, if any, for each request
+  accessToken?: () => string | undefined;
+}
+
+export function createEthosClient({ accessToken, ...options }: EthosClientOptions = {}) {
+  const client = createClient<paths>(options);
+  if (accessToken) {
+    client.use({
+      onRequest({ request }) {
+        const token = accessToken();
+        if (token) {
+          request.headers.set("Authorization", `Bearer ${token}`);
+        }
+        return request;
+      },
+    });
+  }
+  return client;
+}
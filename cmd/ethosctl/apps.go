@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+
+	"github.com/semmidev/ethos-go/internal/auth/adapters"
+	authtask "github.com/semmidev/ethos-go/internal/auth/adapters/task"
+	authapp "github.com/semmidev/ethos-go/internal/auth/app"
+	"github.com/semmidev/ethos-go/internal/auth/domain/gateway"
+	authsvc "github.com/semmidev/ethos-go/internal/auth/service"
+	"github.com/semmidev/ethos-go/internal/common/database"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/events"
+	"github.com/semmidev/ethos-go/internal/common/metrics"
+	"github.com/semmidev/ethos-go/internal/common/outbox"
+	commontask "github.com/semmidev/ethos-go/internal/common/task"
+	habittask "github.com/semmidev/ethos-go/internal/habits/adapters/task"
+	habitsapp "github.com/semmidev/ethos-go/internal/habits/app"
+	habitsvc "github.com/semmidev/ethos-go/internal/habits/service"
+	notificationsapp "github.com/semmidev/ethos-go/internal/notifications/app"
+	notificationsvc "github.com/semmidev/ethos-go/internal/notifications/service"
+)
+
+// metrics are never scraped from a CLI invocation, but the handlers require
+// a client, so share a single one to avoid duplicate registrations.
+func (e *env) metrics() decorator.MetricsClient {
+	if e.metricsClient == nil {
+		e.metricsClient = metrics.NewPrometheusMetricsClient()
+	}
+	return e.metricsClient
+}
+
+// authApp wires the auth module the same way the API does, publishing
+// events through the outbox so the worker delivers them as usual.
+func (e *env) authApp(ctx context.Context) (authapp.Application, error) {
+	return e.authAppWithDispatcher(ctx, nil)
+}
+
+// authAppWithDispatcher is authApp with an overridable task dispatcher.
+// A nil dispatcher means the regular Asynq dispatcher.
+func (e *env) authAppWithDispatcher(ctx context.Context, dispatcher gateway.TaskDispatcher) (authapp.Application, error) {
+	db, err := e.database()
+	if err != nil {
+		return authapp.Application{}, err
+	}
+
+	if dispatcher == nil {
+		client, err := e.queue()
+		if err != nil {
+			return authapp.Application{}, err
+		}
+		dispatcher = authtask.NewTaskDispatcher(e.cfg, commontask.NewAsynqDispatcher(client))
+	}
+
+	publisher := e.eventPublisher(db)
+
+	return authsvc.NewApplication(ctx, e.cfg, db, dispatcher, publisher, e.log, e.metrics()), nil
+}
+
+// userRepository gives direct access to users for lookups the auth
+// commands don't expose (e.g. resolving an email to an ID).
+func (e *env) userRepository() (*adapters.UserPostgresRepository, error) {
+	db, err := e.database()
+	if err != nil {
+		return nil, err
+	}
+	return adapters.NewUserPostgresRepository(db), nil
+}
+
+func (e *env) habitsAndNotificationsApps(ctx context.Context) (habitsapp.Application, notificationsapp.Application, error) {
+	db, err := e.database()
+	if err != nil {
+		return habitsapp.Application{}, notificationsapp.Application{}, err
+	}
+
+	client, err := e.queue()
+	if err != nil {
+		return habitsapp.Application{}, notificationsapp.Application{}, err
+	}
+
+	publisher := e.eventPublisher(db)
+	habitsApp := habitsvc.NewApplication(ctx, e.cfg, db, habittask.NewTaskDispatcher(commontask.NewAsynqDispatcher(client), e.log), publisher, e.log, e.metrics())
+	notificationsApp := notificationsvc.NewApplication(db, e.log, e.metrics(), e.cfg)
+
+	return habitsApp, notificationsApp, nil
+}
+
+// eventPublisher writes events to the outbox, sealed in envelopes, for the
+// worker to deliver
+func (e *env) eventPublisher(db database.DBTX) events.Publisher {
+	return events.NewEnvelopePublisher(outbox.NewPublisher(outbox.NewRepository(db)), events.NewModuleRegistry(), "ethosctl", e.log)
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	habitsv1 "github.com/semmidev/ethos-go/contracts/grpc/ethos/habits/v1"
+	"github.com/semmidev/ethos-go/internal/common/grpcutil"
+)
+
+// habitsClient dials the API's gRPC server at --grpc-addr on first use.
+// Calls carry an ethosctl service token, which the API trusts for admin
+// methods, so no operator account is needed here either.
+func (e *env) habitsClient() (habitsv1.HabitsServiceClient, error) {
+	if e.habits != nil {
+		return e.habits, nil
+	}
+
+	conn, err := e.grpcConnection()
+	if err != nil {
+		return nil, err
+	}
+
+	e.habits = habitsv1.NewHabitsServiceClient(conn)
+	return e.habits, nil
+}
+
+func (e *env) grpcConnection() (*grpc.ClientConn, error) {
+	if e.grpcConn != nil {
+		return e.grpcConn, nil
+	}
+
+	cfg, err := e.config()
+	if err != nil {
+		return nil, err
+	}
+	// A random per-process secret would sign tokens the API rejects
+	if cfg.GRPCServiceSecret == "" {
+		return nil, errors.New("GRPC_SERVICE_SECRET is not configured")
+	}
+
+	serviceAuth, err := grpcutil.NewServiceAuth(cfg.GRPCServiceSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := grpc.NewClient(e.grpcAddr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		serviceAuth.DialOption(grpcutil.ServiceCLI),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", e.grpcAddr, err)
+	}
+
+	e.grpcConn = conn
+	return conn, nil
+}
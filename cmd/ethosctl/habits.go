@@ -0,0 +1,262 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	habitsv1 "github.com/semmidev/ethos-go/contracts/grpc/ethos/habits/v1"
+	"github.com/semmidev/ethos-go/internal/common/dateutil"
+	"github.com/semmidev/ethos-go/internal/common/random"
+	"github.com/semmidev/ethos-go/internal/habits/app/command"
+)
+
+func newHabitsCmd(e *env) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "habits",
+		Short: "Habit operations",
+	}
+
+	cmd.AddCommand(
+		newHabitsLogCmd(e),
+		newHabitsRecomputeStatsCmd(e),
+		newHabitsRebuildDashboardsCmd(e),
+	)
+
+	return cmd
+}
+
+func newHabitsLogCmd(e *env) *cobra.Command {
+	var (
+		userID   string
+		email    string
+		habitID  string
+		date     string
+		count    int
+		note     string
+		override bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "log",
+		Short: "Log a habit completion on behalf of a user",
+		Long: "Log a habit completion through the regular LogHabit command.\n" +
+			"With --override the backdating window and the user's log lock are skipped,\n" +
+			"e.g. to restore a streak lost to an outage.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := cmd.Context()
+
+			if (userID == "") == (email == "") {
+				return errors.New("exactly one of --user-id or --email is required")
+			}
+
+			logDate, err := dateutil.ParseDate("date", date)
+			if err != nil {
+				return fmt.Errorf("invalid --date %q, expected YYYY-MM-DD", date)
+			}
+
+			if _, err := e.config(); err != nil {
+				return err
+			}
+
+			if email != "" {
+				repo, err := e.userRepository()
+				if err != nil {
+					return err
+				}
+				u, err := repo.FindByEmail(ctx, email)
+				if err != nil {
+					return fmt.Errorf("failed to load user %s: %w", email, err)
+				}
+				userID = u.UserID().String()
+			}
+
+			habitsApp, _, err := e.habitsAndNotificationsApps(ctx)
+			if err != nil {
+				return err
+			}
+
+			logCmd := command.LogHabit{
+				LogID:    random.NewUUID().String(),
+				HabitID:  habitID,
+				UserID:   userID,
+				LogDate:  logDate,
+				Count:    count,
+				Override: override,
+			}
+			if note != "" {
+				logCmd.Note = &note
+			}
+
+			result, err := habitsApp.Commands.LogHabit.Handle(ctx, logCmd)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "logged habit %s for user %s on %s as %s (%d today)\n",
+				habitID, userID, date, result.LogID, result.TotalToday)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&userID, "user-id", "", "user ID")
+	cmd.Flags().StringVar(&email, "email", "", "user email address")
+	cmd.Flags().StringVar(&habitID, "habit-id", "", "habit ID (required)")
+	cmd.Flags().StringVar(&date, "date", dateutil.FormatDate(time.Now()), "log date in YYYY-MM-DD format")
+	cmd.Flags().IntVar(&count, "count", 1, "completion count")
+	cmd.Flags().StringVar(&note, "note", "", "optional note")
+	cmd.Flags().BoolVar(&override, "override", false, "skip the backdating window and log lock")
+	_ = cmd.MarkFlagRequired("habit-id")
+
+	return cmd
+}
+
+func newHabitsRecomputeStatsCmd(e *env) *cobra.Command {
+	var (
+		userID  string
+		email   string
+		habitID string
+		since   time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:   "recompute-stats",
+		Short: "Rebuild habit stats from logs and repair any that drifted",
+		Long: "Run the same consistency check as the worker's nightly job.\n" +
+			"Without a user every habit logged within --since is checked; with --user-id or\n" +
+			"--email all of that user's habits are, or only --habit-id.\n" +
+			"With --grpc-addr the API runs the check through its RecomputeHabitStats RPC.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := cmd.Context()
+
+			if userID != "" && email != "" {
+				return errors.New("only one of --user-id or --email may be set")
+			}
+
+			if e.grpcAddr != "" {
+				// Resolving an email needs the database
+				if email != "" {
+					return errors.New("--email is not supported with --grpc-addr, use --user-id")
+				}
+
+				client, err := e.habitsClient()
+				if err != nil {
+					return err
+				}
+
+				req := &habitsv1.RecomputeHabitStatsRequest{
+					ActiveSince: timestamppb.New(time.Now().Add(-since)),
+				}
+				if userID != "" {
+					req.UserId = &userID
+				}
+				if habitID != "" {
+					req.HabitId = &habitID
+				}
+
+				resp, err := client.RecomputeHabitStats(ctx, req)
+				if err != nil {
+					return err
+				}
+
+				fmt.Fprintf(cmd.OutOrStdout(), "checked %d habits, repaired %d\n", resp.Checked, resp.Repaired)
+				return nil
+			}
+
+			if _, err := e.config(); err != nil {
+				return err
+			}
+
+			if email != "" {
+				repo, err := e.userRepository()
+				if err != nil {
+					return err
+				}
+				u, err := repo.FindByEmail(ctx, email)
+				if err != nil {
+					return fmt.Errorf("failed to load user %s: %w", email, err)
+				}
+				userID = u.UserID().String()
+			}
+
+			habitsApp, _, err := e.habitsAndNotificationsApps(ctx)
+			if err != nil {
+				return err
+			}
+
+			result, err := habitsApp.Commands.RecomputeStats.Handle(ctx, command.RecomputeStats{
+				UserID:      userID,
+				HabitID:     habitID,
+				ActiveSince: time.Now().Add(-since),
+			})
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "checked %d habits, repaired %d\n", result.Checked, result.Repaired)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&userID, "user-id", "", "only this user's habits")
+	cmd.Flags().StringVar(&email, "email", "", "only the habits of the user with this email")
+	cmd.Flags().StringVar(&habitID, "habit-id", "", "only this habit (requires --user-id or --email)")
+	cmd.Flags().DurationVar(&since, "since", 48*time.Hour, "check habits logged within this window")
+
+	return cmd
+}
+
+func newHabitsRebuildDashboardsCmd(e *env) *cobra.Command {
+	var (
+		userID string
+		email  string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "rebuild-dashboards",
+		Short: "Recompute the stored dashboard of every user, or of one user",
+		Long: "Rebuild the user_dashboards projection from habits and logs, e.g. after\n" +
+			"the worker missed events. Without --user-id or --email every user with\n" +
+			"habits is rebuilt.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := cmd.Context()
+
+			if userID != "" && email != "" {
+				return errors.New("only one of --user-id or --email may be set")
+			}
+
+			if _, err := e.config(); err != nil {
+				return err
+			}
+
+			if email != "" {
+				repo, err := e.userRepository()
+				if err != nil {
+					return err
+				}
+				u, err := repo.FindByEmail(ctx, email)
+				if err != nil {
+					return fmt.Errorf("failed to load user %s: %w", email, err)
+				}
+				userID = u.UserID().String()
+			}
+
+			habitsApp, _, err := e.habitsAndNotificationsApps(ctx)
+			if err != nil {
+				return err
+			}
+
+			result, err := habitsApp.Commands.RefreshDashboards.Handle(ctx, command.RefreshDashboards{UserID: userID})
+			fmt.Fprintf(cmd.OutOrStdout(), "rebuilt %d dashboards\n", result.Refreshed)
+			return err
+		},
+	}
+
+	cmd.Flags().StringVar(&userID, "user-id", "", "only this user's dashboard")
+	cmd.Flags().StringVar(&email, "email", "", "only the dashboard of the user with this email")
+
+	return cmd
+}
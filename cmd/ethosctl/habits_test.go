@@ -0,0 +1,179 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/semmidev/ethos-go/config"
+	habitsv1 "github.com/semmidev/ethos-go/contracts/grpc/ethos/habits/v1"
+	"github.com/semmidev/ethos-go/internal/common/grpcutil"
+)
+
+// fakeHabitsClient records RecomputeHabitStats calls. Other methods are
+// not used by ethosctl and panic.
+type fakeHabitsClient struct {
+	habitsv1.HabitsServiceClient
+
+	requests []*habitsv1.RecomputeHabitStatsRequest
+	resp     *habitsv1.RecomputeHabitStatsResponse
+	err      error
+}
+
+func (f *fakeHabitsClient) RecomputeHabitStats(_ context.Context, in *habitsv1.RecomputeHabitStatsRequest, _ ...grpc.CallOption) (*habitsv1.RecomputeHabitStatsResponse, error) {
+	f.requests = append(f.requests, in)
+	return f.resp, f.err
+}
+
+// execute runs ethosctl with args and returns what it printed
+func execute(e *env, args ...string) (string, error) {
+	var out bytes.Buffer
+	root := newRootCmd(e)
+	root.SetArgs(args)
+	root.SetOut(&out)
+	root.SetErr(&out)
+
+	err := root.ExecuteContext(context.Background())
+	return out.String(), err
+}
+
+func TestHabitsRecomputeStatsOverGRPC(t *testing.T) {
+	Convey("Given ethosctl pointed at the API's gRPC server", t, func() {
+		client := &fakeHabitsClient{
+			resp: &habitsv1.RecomputeHabitStatsResponse{Success: true, Checked: 4, Repaired: 1},
+		}
+		e := &env{habits: client}
+
+		Convey("When stats are recomputed for one habit", func() {
+			out, err := execute(e, "--grpc-addr", "api:9090", "habits", "recompute-stats",
+				"--user-id", "user-1", "--habit-id", "habit-1", "--since", "24h")
+
+			Convey("Then the RPC is called with the filters and its result printed", func() {
+				So(err, ShouldBeNil)
+				So(out, ShouldEqual, "checked 4 habits, repaired 1\n")
+
+				So(client.requests, ShouldHaveLength, 1)
+				req := client.requests[0]
+				So(req.GetUserId(), ShouldEqual, "user-1")
+				So(req.GetHabitId(), ShouldEqual, "habit-1")
+				So(req.GetActiveSince().AsTime(), ShouldHappenWithin, time.Minute, time.Now().Add(-24*time.Hour))
+			})
+		})
+
+		Convey("When no user is given", func() {
+			_, err := execute(e, "--grpc-addr", "api:9090", "habits", "recompute-stats")
+
+			Convey("Then every recently logged habit is checked", func() {
+				So(err, ShouldBeNil)
+				So(client.requests, ShouldHaveLength, 1)
+				So(client.requests[0].UserId, ShouldBeNil)
+				So(client.requests[0].HabitId, ShouldBeNil)
+			})
+		})
+
+		Convey("When the API refuses the call", func() {
+			client.resp = nil
+			client.err = status.Error(codes.PermissionDenied, "admin role required")
+
+			out, err := execute(e, "--grpc-addr", "api:9090", "habits", "recompute-stats", "--user-id", "user-1")
+
+			Convey("Then the gRPC error is returned and nothing is printed", func() {
+				So(status.Code(err), ShouldEqual, codes.PermissionDenied)
+				So(out, ShouldBeEmpty)
+			})
+		})
+
+		Convey("When the user is given by email", func() {
+			_, err := execute(e, "--grpc-addr", "api:9090", "habits", "recompute-stats", "--email", "ann@example.com")
+
+			Convey("Then it is refused, as resolving it needs the database", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "--email is not supported with --grpc-addr")
+				So(client.requests, ShouldBeEmpty)
+			})
+		})
+
+		Convey("When both a user ID and an email are given", func() {
+			_, err := execute(e, "--grpc-addr", "api:9090", "habits", "recompute-stats",
+				"--user-id", "user-1", "--email", "ann@example.com")
+
+			Convey("Then it is refused before calling the API", func() {
+				So(err, ShouldNotBeNil)
+				So(client.requests, ShouldBeEmpty)
+			})
+		})
+	})
+}
+
+// habitsServer reports which service the API saw calling RecomputeHabitStats
+type habitsServer struct {
+	habitsv1.UnimplementedHabitsServiceServer
+	caller chan string
+}
+
+func (s *habitsServer) RecomputeHabitStats(ctx context.Context, _ *habitsv1.RecomputeHabitStatsRequest) (*habitsv1.RecomputeHabitStatsResponse, error) {
+	service, _ := grpcutil.ServiceFromContext(ctx)
+	s.caller <- service
+	return &habitsv1.RecomputeHabitStatsResponse{Success: true}, nil
+}
+
+func TestHabitsClient(t *testing.T) {
+	const secret = "ethosctl-test-service-secret-of-32-chars"
+
+	Convey("Given an API that requires service tokens", t, func() {
+		serviceAuth, err := grpcutil.NewServiceAuth(secret)
+		So(err, ShouldBeNil)
+
+		server := &habitsServer{caller: make(chan string, 1)}
+		grpcServer := grpc.NewServer(grpc.UnaryInterceptor(serviceAuth.UnaryServerInterceptor(nil)))
+		habitsv1.RegisterHabitsServiceServer(grpcServer, server)
+
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		So(err, ShouldBeNil)
+		go func() { _ = grpcServer.Serve(ln) }()
+		defer grpcServer.Stop()
+
+		Convey("When ethosctl has the shared secret", func() {
+			e := &env{cfg: &config.Config{GRPCServiceSecret: secret}}
+			defer e.close()
+
+			out, err := execute(e, "--grpc-addr", ln.Addr().String(), "habits", "recompute-stats")
+
+			Convey("Then its calls are signed as ethosctl", func() {
+				So(err, ShouldBeNil)
+				So(out, ShouldEqual, "checked 0 habits, repaired 0\n")
+				So(<-server.caller, ShouldEqual, grpcutil.ServiceCLI)
+			})
+		})
+
+		Convey("When ethosctl signs with another secret", func() {
+			e := &env{cfg: &config.Config{GRPCServiceSecret: "a-different-secret-that-is-32-chars-long"}}
+			defer e.close()
+
+			_, err := execute(e, "--grpc-addr", ln.Addr().String(), "habits", "recompute-stats")
+
+			Convey("Then the API rejects the call", func() {
+				So(status.Code(err), ShouldEqual, codes.Unauthenticated)
+			})
+		})
+
+		Convey("When no service secret is configured", func() {
+			e := &env{cfg: &config.Config{}}
+
+			_, err := execute(e, "--grpc-addr", ln.Addr().String(), "habits", "recompute-stats")
+
+			Convey("Then ethosctl does not dial at all", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "GRPC_SERVICE_SECRET is not configured")
+				So(e.grpcConn, ShouldBeNil)
+			})
+		})
+	})
+}
@@ -0,0 +1,207 @@
+// Command ethosctl is the operator CLI for ethos-go.
+//
+// It talks to the database and Redis directly using the service's own
+// configuration (.env / environment variables), so it needs no user account
+// or access token. With --grpc-addr, commands that support it call the API's
+// gRPC server instead, signed with the shared service secret. Run
+// `ethosctl --help` for the available commands.
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/jmoiron/sqlx"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/semmidev/ethos-go/config"
+	habitsv1 "github.com/semmidev/ethos-go/contracts/grpc/ethos/habits/v1"
+	"github.com/semmidev/ethos-go/internal/common/database"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/events"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+)
+
+// Build-time variables injected via ldflags
+var (
+	version = "dev"
+	commit  = "unknown"
+)
+
+func main() {
+	ctx := context.Background()
+	if err := run(ctx, os.Args[1:], os.Stdout, os.Stderr); err != nil {
+		fmt.Fprintf(os.Stderr, "error: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, args []string, stdout, stderr io.Writer) error {
+	ctx, cancel := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	e := &env{}
+	defer e.close()
+
+	root := newRootCmd(e)
+	root.SetArgs(args)
+	root.SetOut(stdout)
+	root.SetErr(stderr)
+
+	return root.ExecuteContext(ctx)
+}
+
+func newRootCmd(e *env) *cobra.Command {
+	root := &cobra.Command{
+		Use:           "ethosctl",
+		Short:         "Operator tooling for ethos-go",
+		Version:       fmt.Sprintf("%s (%s)", version, commit),
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+	root.PersistentFlags().BoolVarP(&e.verbose, "verbose", "v", false, "log at info level instead of warn")
+	root.PersistentFlags().StringVar(&e.grpcAddr, "grpc-addr", "", "call the API's gRPC server at host:port instead of the database, for commands that support it")
+
+	root.AddCommand(
+		newUserCmd(e),
+		newSessionsCmd(e),
+		newOutboxCmd(e),
+		newMigrateCmd(e),
+		newRemindersCmd(e),
+		newHabitsCmd(e),
+	)
+
+	return root
+}
+
+// env holds the lazily initialised dependencies shared by all commands.
+type env struct {
+	verbose  bool
+	grpcAddr string
+
+	cfg           *config.Config
+	log           logger.Logger
+	db            *sqlx.DB
+	asynqClient   *asynq.Client
+	nats          *events.NATSPublisher
+	metricsClient decorator.MetricsClient
+	grpcConn      *grpc.ClientConn
+	habits        habitsv1.HabitsServiceClient
+}
+
+// config loads configuration and the logger on first use.
+func (e *env) config() (*config.Config, error) {
+	if e.cfg != nil {
+		return e.cfg, nil
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	// Keep command output readable; structured logs only matter when
+	// something goes wrong.
+	if !e.verbose {
+		cfg.LoggerLevel = "warn"
+	}
+	cfg.LoggerOutput = "stdout"
+
+	log, err := logger.New(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize logger: %w", err)
+	}
+
+	e.cfg = cfg
+	e.log = log
+	return cfg, nil
+}
+
+func (e *env) database() (*sqlx.DB, error) {
+	if e.db != nil {
+		return e.db, nil
+	}
+
+	cfg, err := e.config()
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := database.NewSQLXConnection(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize database: %w", err)
+	}
+
+	e.db = db
+	return db, nil
+}
+
+func (e *env) queue() (*asynq.Client, error) {
+	if e.asynqClient != nil {
+		return e.asynqClient, nil
+	}
+
+	cfg, err := e.config()
+	if err != nil {
+		return nil, err
+	}
+
+	e.asynqClient = asynq.NewClient(asynq.RedisClientOpt{
+		Addr:     cfg.RedisDSN(),
+		Password: cfg.RedisPassword,
+		DB:       cfg.RedisDB,
+	})
+	return e.asynqClient, nil
+}
+
+// natsPublisher publishes straight to the event stream, bypassing the
+// outbox
+func (e *env) natsPublisher(ctx context.Context) (*events.NATSPublisher, error) {
+	if e.nats != nil {
+		return e.nats, nil
+	}
+
+	cfg, err := e.config()
+	if err != nil {
+		return nil, err
+	}
+	if cfg.NATSUrl == "" {
+		return nil, errors.New("NATS_URL is not configured")
+	}
+
+	publisher, err := events.NewNATSPublisher(ctx, events.NATSConfig{
+		URL:           cfg.NATSUrl,
+		StreamName:    cfg.NATSStreamName,
+		MaxReconnects: cfg.NATSMaxReconnects,
+		ReconnectWait: 2 * time.Second,
+	}, e.log)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize NATS: %w", err)
+	}
+
+	e.nats = publisher
+	return publisher, nil
+}
+
+func (e *env) close() {
+	if e.grpcConn != nil {
+		e.grpcConn.Close()
+	}
+	if e.nats != nil {
+		e.nats.Close()
+	}
+	if e.asynqClient != nil {
+		e.asynqClient.Close()
+	}
+	if e.db != nil {
+		e.db.Close()
+	}
+}
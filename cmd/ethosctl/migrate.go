@@ -0,0 +1,129 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/spf13/cobra"
+
+	"github.com/semmidev/ethos-go/internal/common/database"
+	"github.com/semmidev/ethos-go/migrations"
+)
+
+func newMigrateCmd(e *env) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Apply, roll back and inspect database migrations",
+	}
+
+	cmd.AddCommand(
+		newMigrateUpCmd(e),
+		newMigrateDownCmd(e),
+		newMigrateStatusCmd(e),
+		newMigrateForceCmd(e),
+	)
+
+	return cmd
+}
+
+func newMigrateUpCmd(e *env) *cobra.Command {
+	return &cobra.Command{
+		Use:   "up",
+		Short: "Apply all pending migrations",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg, err := e.config()
+			if err != nil {
+				return err
+			}
+
+			if err := database.RunMigrations(cfg.DSN(), migrations.FS, "."); err != nil {
+				return err
+			}
+
+			return printMigrationVersion(cmd, cfg.DSN())
+		},
+	}
+}
+
+func newMigrateDownCmd(e *env) *cobra.Command {
+	var steps int
+
+	cmd := &cobra.Command{
+		Use:   "down",
+		Short: "Roll back the most recent migrations",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg, err := e.config()
+			if err != nil {
+				return err
+			}
+
+			if err := database.RollbackMigrations(cfg.DSN(), migrations.FS, ".", steps); err != nil {
+				return err
+			}
+
+			return printMigrationVersion(cmd, cfg.DSN())
+		},
+	}
+
+	cmd.Flags().IntVar(&steps, "steps", 1, "number of migrations to roll back")
+
+	return cmd
+}
+
+func newMigrateStatusCmd(e *env) *cobra.Command {
+	return &cobra.Command{
+		Use:   "status",
+		Short: "Show the current schema version and whether it is dirty",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg, err := e.config()
+			if err != nil {
+				return err
+			}
+
+			return printMigrationVersion(cmd, cfg.DSN())
+		},
+	}
+}
+
+func newMigrateForceCmd(e *env) *cobra.Command {
+	return &cobra.Command{
+		Use:   "force VERSION",
+		Short: "Mark the schema as being at VERSION and clear the dirty flag",
+		Long: "Record VERSION as applied without running any migration.\n" +
+			"Only use this after repairing a failed migration by hand; use `force -- -1` to record no version.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			version, err := strconv.Atoi(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid version %q: %w", args[0], err)
+			}
+
+			cfg, err := e.config()
+			if err != nil {
+				return err
+			}
+
+			if err := database.ForceMigration(cfg.DSN(), migrations.FS, ".", version); err != nil {
+				return err
+			}
+
+			return printMigrationVersion(cmd, cfg.DSN())
+		},
+	}
+}
+
+func printMigrationVersion(cmd *cobra.Command, dsn string) error {
+	version, dirty, err := database.MigrationInfo(dsn, migrations.FS, ".")
+	if errors.Is(err, migrate.ErrNilVersion) {
+		fmt.Fprintln(cmd.OutOrStdout(), "no migrations applied")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "schema at version %d (dirty: %t)\n", version, dirty)
+	return nil
+}
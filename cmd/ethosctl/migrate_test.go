@@ -0,0 +1,42 @@
+package main
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/config"
+)
+
+func TestMigrateCommands(t *testing.T) {
+	Convey("Given ethosctl with a configuration loaded", t, func() {
+		e := &env{cfg: &config.Config{}}
+
+		Convey("When a migration version is forced with a non-numeric version", func() {
+			_, err := execute(e, "migrate", "force", "latest")
+
+			Convey("Then it is refused", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, `invalid version "latest"`)
+			})
+		})
+
+		Convey("When no version is given to force", func() {
+			_, err := execute(e, "migrate", "force")
+
+			Convey("Then the usage error is returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When zero migrations are rolled back", func() {
+			out, err := execute(e, "migrate", "down", "--steps", "0")
+
+			Convey("Then it is refused before connecting, so a typo can't wipe the schema", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "rollback steps must be positive")
+				So(out, ShouldBeEmpty)
+			})
+		})
+	})
+}
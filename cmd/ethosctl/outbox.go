@@ -0,0 +1,142 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/semmidev/ethos-go/internal/common/dateutil"
+	"github.com/semmidev/ethos-go/internal/common/events"
+	"github.com/semmidev/ethos-go/internal/common/outbox"
+)
+
+func newOutboxCmd(e *env) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "outbox",
+		Short: "Inspect and repair the event outbox",
+	}
+
+	cmd.AddCommand(
+		newOutboxRequeueCmd(e),
+		newOutboxReplayCmd(e),
+	)
+
+	return cmd
+}
+
+func newOutboxRequeueCmd(e *env) *cobra.Command {
+	return &cobra.Command{
+		Use:   "requeue",
+		Short: "Reset the retry counter on failed outbox entries so the worker publishes them again",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			db, err := e.database()
+			if err != nil {
+				return err
+			}
+
+			n, err := outbox.NewRepository(db).RequeueFailed(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "requeued %d outbox entries\n", n)
+			return nil
+		},
+	}
+}
+
+func newOutboxReplayCmd(e *env) *cobra.Command {
+	var (
+		filter   outbox.ReplayFilter
+		consumer string
+		since    string
+		until    string
+		dryRun   bool
+		rate     int
+	)
+
+	cmd := &cobra.Command{
+		Use:   "replay",
+		Short: "Republish published outbox events to one consumer, e.g. to rebuild a projection",
+		Long: `Republish published outbox events, in the order they were written, to a
+single durable consumer. Other consumers acknowledge and skip the replayed
+messages. Select events by aggregate, by time range, or both.`,
+		Example: `  ethosctl outbox replay --consumer ethos-worker --aggregate-type habit --since 2026-09-01 --dry-run
+  ethosctl outbox replay --consumer ethos-worker --aggregate-id 0b6c... --rate 20`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := cmd.Context()
+			out := cmd.OutOrStdout()
+
+			var err error
+			if filter.Since, err = parseReplayTime(since); err != nil {
+				return fmt.Errorf("--since: %w", err)
+			}
+			if filter.Until, err = parseReplayTime(until); err != nil {
+				return fmt.Errorf("--until: %w", err)
+			}
+			if consumer == "" {
+				return errors.New("--consumer is required")
+			}
+
+			db, err := e.database()
+			if err != nil {
+				return err
+			}
+
+			var publisher events.Publisher
+			if !dryRun {
+				if publisher, err = e.natsPublisher(ctx); err != nil {
+					return err
+				}
+			}
+
+			replayer := outbox.NewReplayer(outbox.NewRepository(db), publisher, e.log)
+			result, err := replayer.Replay(ctx, outbox.ReplayOptions{
+				Filter:   filter,
+				Consumer: consumer,
+				DryRun:   dryRun,
+				Rate:     rate,
+				OnEntry: func(entry outbox.OutboxEntry) {
+					fmt.Fprintf(out, "%s  %s  %s %s  %s\n",
+						entry.CreatedAt.Format(time.RFC3339), entry.EventType,
+						entry.AggregateType, entry.AggregateID, entry.ID)
+				},
+			})
+			if err != nil {
+				return fmt.Errorf("replay stopped after %d of %d events: %w", result.Published, result.Matched, err)
+			}
+
+			if dryRun {
+				fmt.Fprintf(out, "dry run: %d events would be replayed to %s\n", result.Matched, consumer)
+				return nil
+			}
+			fmt.Fprintf(out, "replayed %d events to %s (run %s)\n", result.Published, consumer, result.RunID)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&consumer, "consumer", "", "durable consumer that handles the replayed events, e.g. the worker's NATS_CONSUMER_NAME (required)")
+	cmd.Flags().StringVar(&filter.AggregateType, "aggregate-type", "", "only events of this aggregate type, e.g. habit")
+	cmd.Flags().StringVar(&filter.AggregateID, "aggregate-id", "", "only events of this aggregate")
+	cmd.Flags().StringVar(&filter.EventType, "event-type", "", "only events of this type, e.g. habits.habit.completed")
+	cmd.Flags().StringVar(&since, "since", "", "only events written at or after this time (YYYY-MM-DD or RFC 3339)")
+	cmd.Flags().StringVar(&until, "until", "", "only events written before this time (YYYY-MM-DD or RFC 3339)")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "list the matching events without publishing them")
+	cmd.Flags().IntVar(&rate, "rate", 50, "maximum events published per second, 0 for unlimited")
+
+	return cmd
+}
+
+// parseReplayTime accepts a date (midnight UTC) or an RFC 3339 timestamp
+func parseReplayTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	t, err := dateutil.ParseTimestamp("time", value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %q, expected YYYY-MM-DD or RFC 3339", value)
+	}
+	return t, nil
+}
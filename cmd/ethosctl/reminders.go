@@ -0,0 +1,77 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	notifadapters "github.com/semmidev/ethos-go/internal/notifications/adapters"
+	notiftask "github.com/semmidev/ethos-go/internal/notifications/adapters/task"
+)
+
+func newRemindersCmd(e *env) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reminders",
+		Short: "Habit reminder operations",
+	}
+
+	cmd.AddCommand(newRemindersSweepCmd(e))
+
+	return cmd
+}
+
+func newRemindersSweepCmd(e *env) *cobra.Command {
+	var inline bool
+
+	cmd := &cobra.Command{
+		Use:   "sweep",
+		Short: "Run the reminder sweep now instead of waiting for the scheduler",
+		Long: "Enqueue the same task the worker's scheduler runs every minute.\n" +
+			"With --inline the sweep runs in this process, which is useful when the worker is down.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := cmd.Context()
+
+			if _, err := e.config(); err != nil {
+				return err
+			}
+
+			if inline {
+				habitsApp, notificationsApp, err := e.habitsAndNotificationsApps(ctx)
+				if err != nil {
+					return err
+				}
+
+				db, err := e.database()
+				if err != nil {
+					return err
+				}
+
+				deliveries := notifadapters.NewPreferencesPostgresRepository(db)
+				processor := notiftask.NewTaskProcessor(notificationsApp, habitsApp, deliveries, e.log)
+				if err := processor.ProcessTask(ctx, notiftask.NewProcessRemindersTask()); err != nil {
+					return err
+				}
+
+				fmt.Fprintln(cmd.OutOrStdout(), "reminder sweep completed")
+				return nil
+			}
+
+			client, err := e.queue()
+			if err != nil {
+				return err
+			}
+
+			info, err := client.EnqueueContext(ctx, notiftask.NewProcessRemindersTask())
+			if err != nil {
+				return fmt.Errorf("failed to enqueue reminder sweep: %w", err)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "reminder sweep enqueued as task %s\n", info.ID)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&inline, "inline", false, "run the sweep in this process instead of enqueueing it")
+
+	return cmd
+}
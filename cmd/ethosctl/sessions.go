@@ -0,0 +1,73 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/semmidev/ethos-go/internal/auth/app/command"
+)
+
+func newSessionsCmd(e *env) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sessions",
+		Short: "Manage login sessions",
+	}
+
+	cmd.AddCommand(newSessionsRevokeCmd(e))
+
+	return cmd
+}
+
+func newSessionsRevokeCmd(e *env) *cobra.Command {
+	var (
+		userID string
+		email  string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "revoke",
+		Short: "Revoke every session for a user, forcing them to log in again",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := cmd.Context()
+
+			if (userID == "") == (email == "") {
+				return errors.New("exactly one of --user-id or --email is required")
+			}
+
+			if _, err := e.config(); err != nil {
+				return err
+			}
+
+			if email != "" {
+				repo, err := e.userRepository()
+				if err != nil {
+					return err
+				}
+				u, err := repo.FindByEmail(ctx, email)
+				if err != nil {
+					return fmt.Errorf("failed to load user %s: %w", email, err)
+				}
+				userID = u.UserID().String()
+			}
+
+			app, err := e.authApp(ctx)
+			if err != nil {
+				return err
+			}
+
+			if err := app.Commands.LogoutAll.Handle(ctx, command.LogoutAllCommand{UserID: userID}); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "revoked all sessions for user %s\n", userID)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&userID, "user-id", "", "user ID")
+	cmd.Flags().StringVar(&email, "email", "", "user email address")
+
+	return cmd
+}
@@ -0,0 +1,220 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/user"
+
+	"github.com/spf13/cobra"
+
+	"github.com/semmidev/ethos-go/internal/auth/app/command"
+	"github.com/semmidev/ethos-go/internal/auth/domain/gateway"
+)
+
+func newUserCmd(e *env) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "user",
+		Short: "Manage user accounts",
+	}
+
+	cmd.AddCommand(
+		newUserCreateCmd(e),
+		newUserResendVerificationCmd(e),
+		newUserAnonymizeCmd(e),
+	)
+
+	return cmd
+}
+
+func newUserCreateCmd(e *env) *cobra.Command {
+	var (
+		email    string
+		name     string
+		password string
+		verified bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a user account",
+		Long: "Create a user account through the regular registration flow.\n" +
+			"With --verified the account is marked verified immediately and no verification email is sent.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := cmd.Context()
+
+			if _, err := e.config(); err != nil {
+				return err
+			}
+
+			var dispatcher gateway.TaskDispatcher
+			if verified {
+				dispatcher = skipVerifyEmailDispatcher{}
+			}
+
+			app, err := e.authAppWithDispatcher(ctx, dispatcher)
+			if err != nil {
+				return err
+			}
+
+			result, err := app.Commands.Register.Handle(ctx, command.RegisterCommand{
+				Name:     name,
+				Email:    email,
+				Password: password,
+			})
+			if err != nil {
+				return err
+			}
+
+			if verified {
+				if err := markVerified(ctx, e, result.Email); err != nil {
+					return err
+				}
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "created user %s (%s)\n", result.UserID, result.Email)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&email, "email", "", "email address (required)")
+	cmd.Flags().StringVar(&name, "name", "", "display name (required)")
+	cmd.Flags().StringVar(&password, "password", "", "initial password, at least 8 characters (required)")
+	cmd.Flags().BoolVar(&verified, "verified", false, "mark the account verified and skip the verification email")
+	_ = cmd.MarkFlagRequired("email")
+	_ = cmd.MarkFlagRequired("name")
+	_ = cmd.MarkFlagRequired("password")
+
+	return cmd
+}
+
+func newUserResendVerificationCmd(e *env) *cobra.Command {
+	var email string
+
+	cmd := &cobra.Command{
+		Use:   "resend-verification",
+		Short: "Send a fresh verification code to an unverified user",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := cmd.Context()
+
+			if _, err := e.config(); err != nil {
+				return err
+			}
+
+			app, err := e.authApp(ctx)
+			if err != nil {
+				return err
+			}
+
+			if err := app.Commands.ResendVerification.Handle(ctx, command.ResendVerificationCommand{Email: email}); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "verification email queued for %s\n", email)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&email, "email", "", "email address (required)")
+	_ = cmd.MarkFlagRequired("email")
+
+	return cmd
+}
+
+func newUserAnonymizeCmd(e *env) *cobra.Command {
+	var (
+		actor  string
+		reason string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "anonymize USER_ID...",
+		Short: "Forget the personal data of one or more users",
+		Long: "Scrub each user's name, email, avatar and credentials, the client details of\n" +
+			"their sessions, their notifications and their habit notes, signing them out.\n" +
+			"Habits and logs are kept for analytics. Every user gets an audit log entry and\n" +
+			"a user.anonymized event. Users that fail are reported and the rest continue.",
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			if actor == "" {
+				current, err := user.Current()
+				if err != nil {
+					return errors.New("--actor is required when the OS user is unknown")
+				}
+				actor = current.Username
+			}
+
+			if _, err := e.config(); err != nil {
+				return err
+			}
+
+			app, err := e.authApp(ctx)
+			if err != nil {
+				return err
+			}
+
+			failed := 0
+			for _, userID := range args {
+				err := app.Commands.AnonymizeUser.Handle(ctx, command.AnonymizeUserCommand{
+					UserID: userID,
+					Actor:  actor,
+					Reason: reason,
+				})
+				if err != nil {
+					failed++
+					fmt.Fprintf(cmd.ErrOrStderr(), "failed to anonymize user %s: %s\n", userID, err)
+					continue
+				}
+				fmt.Fprintf(cmd.OutOrStdout(), "anonymized user %s\n", userID)
+			}
+
+			if failed > 0 {
+				return fmt.Errorf("%d of %d users were not anonymized", failed, len(args))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&actor, "actor", "", "operator recorded in the audit log (default: the OS user)")
+	cmd.Flags().StringVar(&reason, "reason", "", "why the users are anonymized, e.g. a ticket reference (required)")
+	_ = cmd.MarkFlagRequired("reason")
+
+	return cmd
+}
+
+func markVerified(ctx context.Context, e *env, email string) error {
+	repo, err := e.userRepository()
+	if err != nil {
+		return err
+	}
+
+	u, err := repo.FindByEmail(ctx, email)
+	if err != nil {
+		return fmt.Errorf("failed to load user %s: %w", email, err)
+	}
+
+	u.MarkVerified()
+	if err := repo.Update(ctx, u); err != nil {
+		return fmt.Errorf("failed to mark user %s verified: %w", email, err)
+	}
+
+	return nil
+}
+
+// skipVerifyEmailDispatcher drops verification emails for accounts that
+// are created already verified.
+type skipVerifyEmailDispatcher struct{}
+
+func (skipVerifyEmailDispatcher) DispatchSendVerifyEmail(context.Context, *gateway.PayloadSendVerifyEmail) error {
+	return nil
+}
+
+func (skipVerifyEmailDispatcher) DispatchSendForgotPasswordEmail(context.Context, *gateway.PayloadSendForgotPasswordEmail) error {
+	return nil
+}
+
+func (skipVerifyEmailDispatcher) DispatchSendMagicLinkEmail(context.Context, *gateway.PayloadSendMagicLinkEmail) error {
+	return nil
+}
@@ -0,0 +1,292 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/semmidev/ethos-go/internal/auth/domain/user"
+	"github.com/semmidev/ethos-go/internal/habits/domain/habit"
+	"github.com/semmidev/ethos-go/internal/habits/domain/habitlog"
+	"github.com/semmidev/ethos-go/internal/notifications/domain"
+)
+
+var demoNames = []string{
+	"Alya Pratama",
+	"Budi Santoso",
+	"Citra Lestari",
+	"Dimas Nugroho",
+	"Eka Putri",
+}
+
+var demoTimezones = []string{
+	"Asia/Jakarta",
+	"Asia/Makassar",
+	"Asia/Singapore",
+	"Europe/Berlin",
+	"America/New_York",
+}
+
+// habitTemplate describes a demo habit and how reliably its owner keeps it.
+type habitTemplate struct {
+	name         string
+	description  string
+	frequency    string
+	targetCount  int
+	reminderTime string
+
+	// adherence is the base probability of completing a scheduled
+	// occurrence; weekendDip lowers it on Saturdays and Sundays.
+	adherence  float64
+	weekendDip float64
+}
+
+var habitTemplates = []habitTemplate{
+	{name: "Morning run", description: "5k around the block", frequency: habit.FrequencyDaily, targetCount: 1, reminderTime: "06:30", adherence: 0.7, weekendDip: 0.2},
+	{name: "Read 20 pages", frequency: habit.FrequencyDaily, targetCount: 1, reminderTime: "21:00", adherence: 0.8},
+	{name: "Drink water", description: "Glasses of water", frequency: habit.FrequencyDaily, targetCount: 8, adherence: 0.9},
+	{name: "Meditate", description: "10 minutes of breathing", frequency: habit.FrequencyDaily, targetCount: 1, reminderTime: "07:00", adherence: 0.6},
+	{name: "Practice guitar", frequency: habit.FrequencyDaily, targetCount: 1, adherence: 0.5, weekendDip: -0.2},
+	{name: "No sugar", frequency: habit.FrequencyDaily, targetCount: 1, adherence: 0.65, weekendDip: 0.25},
+	{name: "Gym session", frequency: habit.FrequencyWeekly, targetCount: 3, reminderTime: "17:30", adherence: 0.75},
+	{name: "Call family", frequency: habit.FrequencyWeekly, targetCount: 1, adherence: 0.85},
+	{name: "Meal prep", description: "Cook lunches for the week", frequency: habit.FrequencyWeekly, targetCount: 1, adherence: 0.7},
+	{name: "Budget review", frequency: habit.FrequencyMonthly, targetCount: 1, adherence: 0.9},
+	{name: "Donate blood", frequency: habit.FrequencyMonthly, targetCount: 1, adherence: 0.4},
+}
+
+var logNotes = []string{
+	"Felt great today",
+	"Harder than usual",
+	"Did it with a friend",
+	"Short session, still counts",
+	"Back on track",
+}
+
+// pickTemplates selects a varied set of habits: mostly daily, at least one
+// weekly and one monthly.
+func pickTemplates(rng *rand.Rand) []habitTemplate {
+	var daily, weekly, monthly []habitTemplate
+	for _, t := range habitTemplates {
+		switch t.frequency {
+		case habit.FrequencyDaily:
+			daily = append(daily, t)
+		case habit.FrequencyWeekly:
+			weekly = append(weekly, t)
+		case habit.FrequencyMonthly:
+			monthly = append(monthly, t)
+		}
+	}
+
+	picked := sample(rng, daily, 3+rng.Intn(2))
+	picked = append(picked, sample(rng, weekly, 1+rng.Intn(2))...)
+	picked = append(picked, sample(rng, monthly, 1)...)
+	return picked
+}
+
+func sample(rng *rand.Rand, from []habitTemplate, n int) []habitTemplate {
+	shuffled := append([]habitTemplate(nil), from...)
+	rng.Shuffle(len(shuffled), func(i, j int) { shuffled[i], shuffled[j] = shuffled[j], shuffled[i] })
+	if n > len(shuffled) {
+		n = len(shuffled)
+	}
+	return shuffled[:n]
+}
+
+func (t habitTemplate) build(rng *rand.Rand, userID string, createdAt time.Time) (*habit.Habit, error) {
+	var description, reminder *string
+	if t.description != "" {
+		description = &t.description
+	}
+	if t.reminderTime != "" {
+		reminder = &t.reminderTime
+	}
+
+	return habit.UnmarshalHabitFromDatabase(
+		randomUUID(rng).String(), userID, t.name, description,
+		t.frequency, habit.AllDays, 1,
+		t.targetCount, reminder, true, nil,
+		createdAt, createdAt, 1,
+	)
+}
+
+// milestone is a streak length reached while simulating a daily habit.
+type milestone struct {
+	habitID   string
+	habitName string
+	days      int
+	reachedAt time.Time
+}
+
+var milestoneDays = map[int]bool{7: true, 30: true, 100: true}
+
+type history struct {
+	logs       []*habitlog.HabitLog
+	stats      *habit.HabitStats
+	milestones []milestone
+}
+
+// simulateHistory generates logs from the habit's creation up to today.
+// Completion probability rises after a completed day and falls after a
+// missed one, so the data has streaks and lapses rather than uniform noise.
+func simulateHistory(rng *rand.Rand, h *habit.Habit, t habitTemplate, now time.Time) history {
+	var out history
+
+	start := truncateToDay(h.CreatedAt())
+	today := truncateToDay(now)
+
+	switch t.frequency {
+	case habit.FrequencyDaily:
+		p := t.adherence
+		streak := 0
+		for day := start; !day.After(today); day = day.AddDate(0, 0, 1) {
+			chance := p
+			if isWeekend(day) {
+				chance -= t.weekendDip
+			}
+			// Leave today open half the time so the dashboard has work to do.
+			if day.Equal(today) {
+				chance /= 2
+			}
+
+			if rng.Float64() < chance {
+				out.logs = append(out.logs, newLog(rng, h, t, day))
+				p = clamp(p+0.05, 0.2, 0.97)
+				streak++
+				if milestoneDays[streak] {
+					out.milestones = append(out.milestones, milestone{h.HabitID(), h.Name(), streak, day})
+				}
+			} else {
+				p = clamp(t.adherence-0.1, 0.1, 0.9)
+				streak = 0
+			}
+		}
+
+	case habit.FrequencyWeekly:
+		for week := start; !week.After(today); week = week.AddDate(0, 0, 7) {
+			for _, offset := range rng.Perm(7)[:t.targetCount] {
+				day := week.AddDate(0, 0, offset)
+				if day.After(today) || rng.Float64() >= t.adherence {
+					continue
+				}
+				out.logs = append(out.logs, newLog(rng, h, t, day))
+			}
+		}
+
+	case habit.FrequencyMonthly:
+		for month := start; !month.After(today); month = month.AddDate(0, 1, 0) {
+			day := month.AddDate(0, 0, rng.Intn(28))
+			if day.After(today) || rng.Float64() >= t.adherence {
+				continue
+			}
+			out.logs = append(out.logs, newLog(rng, h, t, day))
+		}
+	}
+
+	out.stats = habit.NewStreakService().CalculateStreak(h, out.logs, nil, now)
+	return out
+}
+
+func newLog(rng *rand.Rand, h *habit.Habit, t habitTemplate, day time.Time) *habitlog.HabitLog {
+	count := t.targetCount
+	if count > 1 && rng.Float64() < 0.3 {
+		count = 1 + rng.Intn(count)
+	}
+
+	var note *string
+	if rng.Float64() < 0.1 {
+		n := logNotes[rng.Intn(len(logNotes))]
+		note = &n
+	}
+
+	// Logs are always reconstructed from valid input, so an error here is
+	// a programming mistake.
+	l, err := habitlog.UnmarshalHabitLogFromDatabase(
+		randomUUID(rng).String(), h.HabitID(), h.UserID(),
+		day, count, note,
+		day.Add(20*time.Hour), day.Add(20*time.Hour),
+	)
+	if err != nil {
+		panic(err)
+	}
+	return l
+}
+
+func (s *seeder) seedNotifications(ctx context.Context, u *user.User, joinedAt time.Time, milestones []milestone) error {
+	userID := u.UserID().String()
+
+	var all []*domain.Notification
+	add := func(typ domain.NotificationType, title, message string, data map[string]interface{}, at time.Time) error {
+		n, err := domain.NewNotification(userID, typ, title, message, data)
+		if err != nil {
+			return err
+		}
+		n.ID = randomUUID(s.rng).String()
+		n.CreatedAt = at
+		all = append(all, n)
+		return nil
+	}
+
+	if err := add(domain.TypeWelcome, "Welcome to Ethos",
+		fmt.Sprintf("Hi %s, start by creating your first habit.", u.Name()), nil, joinedAt); err != nil {
+		return err
+	}
+
+	for _, m := range milestones {
+		if err := add(domain.TypeStreakMilestone, fmt.Sprintf("%d-day streak!", m.days),
+			fmt.Sprintf("You've completed '%s' %d days in a row.", m.habitName, m.days),
+			map[string]interface{}{"habit_id": m.habitID, "streak": m.days}, m.reachedAt.Add(21*time.Hour)); err != nil {
+			return err
+		}
+	}
+
+	for i := 1; i <= 5; i++ {
+		at := s.now.AddDate(0, 0, -i).Add(-time.Duration(s.rng.Intn(12)) * time.Hour)
+		if err := add(domain.TypeHabitReminder, "Habit Reminder",
+			"Don't forget to complete your habits today!", nil, at); err != nil {
+			return err
+		}
+	}
+
+	if err := add(domain.TypeSystem, "Scheduled maintenance",
+		"Ethos will be briefly unavailable this Sunday at 02:00 UTC.", nil, s.now.AddDate(0, 0, -2)); err != nil {
+		return err
+	}
+
+	// Everything older than three days has been seen.
+	readBefore := s.now.AddDate(0, 0, -3)
+	for _, n := range all {
+		if n.CreatedAt.Before(readBefore) {
+			n.MarkAsRead()
+			readAt := n.CreatedAt.Add(time.Hour)
+			n.ReadAt = &readAt
+		}
+		if err := s.notifications.Create(ctx, n); err != nil {
+			return fmt.Errorf("create notification: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// randomUUID derives IDs from the seeded generator so a -reset run with the
+// same -seed reproduces the same data.
+func randomUUID(rng *rand.Rand) uuid.UUID {
+	return uuid.Must(uuid.NewRandomFromReader(rng))
+}
+
+func truncateToDay(t time.Time) time.Time {
+	y, m, d := t.UTC().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func isWeekend(t time.Time) bool {
+	return t.Weekday() == time.Saturday || t.Weekday() == time.Sunday
+}
+
+func clamp(v, lo, hi float64) float64 {
+	return max(lo, min(hi, v))
+}
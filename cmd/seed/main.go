@@ -0,0 +1,205 @@
+// Command seed fills a development database with demo users, habits,
+// months of habit logs and notifications so dashboards and analytics have
+// realistic data to render.
+//
+// It reads the same configuration as the API (.env / environment variables)
+// and refuses to run when APP_ENV is production. Every demo account shares
+// the password printed at the end of the run.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/semmidev/ethos-go/config"
+	authadapters "github.com/semmidev/ethos-go/internal/auth/adapters"
+	"github.com/semmidev/ethos-go/internal/auth/domain/user"
+	"github.com/semmidev/ethos-go/internal/common/database"
+	"github.com/semmidev/ethos-go/internal/common/i18n"
+	habitadapters "github.com/semmidev/ethos-go/internal/habits/adapters"
+	notifadapters "github.com/semmidev/ethos-go/internal/notifications/adapters"
+)
+
+const demoPassword = "password123"
+
+type options struct {
+	users  int
+	months int
+	seed   int64
+	reset  bool
+}
+
+func main() {
+	ctx := context.Background()
+	if err := run(ctx, os.Args[1:], os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "%s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, args []string, stdout io.Writer) error {
+	ctx, cancel := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	var opts options
+	fs := flag.NewFlagSet("seed", flag.ContinueOnError)
+	fs.IntVar(&opts.users, "users", 3, "number of demo users to create")
+	fs.IntVar(&opts.months, "months", 6, "months of habit history to generate")
+	fs.Int64Var(&opts.seed, "seed", 1, "random seed; with -reset the same seed reproduces the same data")
+	fs.BoolVar(&opts.reset, "reset", false, "delete existing demo users (and everything they own) first")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if opts.users < 1 || opts.months < 1 {
+		return errors.New("-users and -months must be at least 1")
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+	if cfg.AppEnv == "production" {
+		return errors.New("refusing to seed a production database")
+	}
+
+	db, err := database.NewSQLXConnection(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to initialize database: %w", err)
+	}
+	defer db.Close()
+
+	s := newSeeder(db, opts)
+	for i := 1; i <= opts.users; i++ {
+		email := fmt.Sprintf("demo%d@ethos.local", i)
+		created, err := s.seedUser(ctx, email, demoNames[(i-1)%len(demoNames)])
+		if err != nil {
+			return fmt.Errorf("seed %s: %w", email, err)
+		}
+		if created {
+			fmt.Fprintf(stdout, "seeded %s\n", email)
+		} else {
+			fmt.Fprintf(stdout, "skipped %s (already exists, use -reset to recreate)\n", email)
+		}
+	}
+
+	fmt.Fprintf(stdout, "demo password: %s\n", demoPassword)
+	return nil
+}
+
+type seeder struct {
+	db            *sqlx.DB
+	opts          options
+	rng           *rand.Rand
+	now           time.Time
+	users         *authadapters.UserPostgresRepository
+	hasher        *authadapters.BcryptPasswordHasher
+	notifications *notifadapters.NotificationPostgresRepository
+}
+
+func newSeeder(db *sqlx.DB, opts options) *seeder {
+	return &seeder{
+		db:            db,
+		opts:          opts,
+		rng:           rand.New(rand.NewSource(opts.seed)),
+		now:           time.Now(),
+		users:         authadapters.NewUserPostgresRepository(db),
+		hasher:        authadapters.NewBcryptPasswordHasher(),
+		notifications: notifadapters.NewNotificationPostgresRepository(db),
+	}
+}
+
+// seedUser creates one demo account with its history. It reports false
+// when the account already exists and -reset was not given.
+func (s *seeder) seedUser(ctx context.Context, email, name string) (bool, error) {
+	existing, err := s.users.FindByEmail(ctx, email)
+	switch {
+	case err == nil && !s.opts.reset:
+		return false, nil
+	case err == nil:
+		if err := s.users.Delete(ctx, existing.UserID()); err != nil {
+			return false, fmt.Errorf("delete existing user: %w", err)
+		}
+	case !errors.Is(err, user.ErrNotFound):
+		return false, fmt.Errorf("look up user: %w", err)
+	}
+
+	hashed, err := s.hasher.Hash(ctx, demoPassword)
+	if err != nil {
+		return false, err
+	}
+
+	joinedAt := s.now.AddDate(0, -s.opts.months, -s.rng.Intn(7))
+	u := user.UnmarshalUserFromDatabase(
+		randomUUID(s.rng), email, name, &hashed,
+		"email", nil, user.RoleMember,
+		demoTimezones[s.rng.Intn(len(demoTimezones))],
+		i18n.DefaultLocale,
+		user.DefaultWeekStart,
+		nil, nil, user.Preferences{},
+		true, "", true,
+		nil, nil,
+		joinedAt, joinedAt,
+	)
+	if err := s.users.Create(ctx, u); err != nil {
+		return false, fmt.Errorf("create user: %w", err)
+	}
+
+	milestones, err := s.seedHabits(ctx, u, joinedAt)
+	if err != nil {
+		return false, err
+	}
+
+	if err := s.seedNotifications(ctx, u, joinedAt, milestones); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (s *seeder) seedHabits(ctx context.Context, u *user.User, joinedAt time.Time) ([]milestone, error) {
+	var milestones []milestone
+
+	uow := habitadapters.NewHabitsUnitOfWork(s.db)
+	err := uow.WithTransaction(ctx, func(tx habitadapters.HabitsUnitOfWork) error {
+		milestones = nil
+		for _, tmpl := range pickTemplates(s.rng) {
+			// Stagger habit creation over the first weeks so the
+			// dashboard shows habits of different ages.
+			createdAt := joinedAt.Add(time.Duration(s.rng.Intn(21*24)) * time.Hour)
+
+			h, err := tmpl.build(s.rng, u.UserID().String(), createdAt)
+			if err != nil {
+				return err
+			}
+			if err := tx.Habits().AddHabit(ctx, h); err != nil {
+				return fmt.Errorf("add habit %q: %w", tmpl.name, err)
+			}
+
+			history := simulateHistory(s.rng, h, tmpl, s.now)
+			for _, l := range history.logs {
+				if err := tx.HabitLogs().AddHabitLog(ctx, l); err != nil {
+					return fmt.Errorf("add log for %q: %w", tmpl.name, err)
+				}
+			}
+
+			if err := tx.Habits().UpsertStats(ctx, history.stats); err != nil {
+				return fmt.Errorf("upsert stats for %q: %w", tmpl.name, err)
+			}
+
+			milestones = append(milestones, history.milestones...)
+		}
+		return nil
+	})
+
+	return milestones, err
+}
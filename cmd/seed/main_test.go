@@ -0,0 +1,222 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/lib/pq"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/common/database"
+	"github.com/semmidev/ethos-go/internal/habits/domain/habit"
+	"github.com/semmidev/ethos-go/migrations"
+)
+
+func TestPickTemplates(t *testing.T) {
+	Convey("Given any seed", t, func() {
+		for seed := int64(1); seed <= 20; seed++ {
+			picked := pickTemplates(rand.New(rand.NewSource(seed)))
+
+			counts := map[string]int{}
+			names := map[string]bool{}
+			for _, tmpl := range picked {
+				counts[tmpl.frequency]++
+				names[tmpl.name] = true
+			}
+
+			So(counts[habit.FrequencyDaily], ShouldBeBetweenOrEqual, 3, 4)
+			So(counts[habit.FrequencyWeekly], ShouldBeBetweenOrEqual, 1, 2)
+			So(counts[habit.FrequencyMonthly], ShouldEqual, 1)
+			So(names, ShouldHaveLength, len(picked))
+		}
+	})
+}
+
+func TestSimulateHistory(t *testing.T) {
+	now := time.Date(2026, time.October, 17, 12, 0, 0, 0, time.UTC)
+	createdAt := now.AddDate(0, -6, 0)
+
+	simulate := func(tmpl habitTemplate, seed int64) (*habit.Habit, history) {
+		rng := rand.New(rand.NewSource(seed))
+		h, err := tmpl.build(rng, uuid.NewString(), createdAt)
+		So(err, ShouldBeNil)
+		return h, simulateHistory(rng, h, tmpl, now)
+	}
+
+	Convey("Given six months of a daily habit", t, func() {
+		tmpl := habitTemplate{name: "Read 20 pages", frequency: habit.FrequencyDaily, targetCount: 1, adherence: 0.8}
+		h, got := simulate(tmpl, 7)
+
+		Convey("Then it is logged at most once a day, between its creation and today", func() {
+			So(got.logs, ShouldNotBeEmpty)
+			seen := map[time.Time]bool{}
+			for _, l := range got.logs {
+				So(l.HabitID(), ShouldEqual, h.HabitID())
+				So(l.LogDate(), ShouldHappenOnOrAfter, truncateToDay(createdAt))
+				So(l.LogDate(), ShouldHappenOnOrBefore, truncateToDay(now))
+				So(seen[l.LogDate()], ShouldBeFalse)
+				seen[l.LogDate()] = true
+			}
+		})
+
+		Convey("Then each streak milestone is backed by that many days in a row", func() {
+			logged := map[time.Time]bool{}
+			for _, l := range got.logs {
+				logged[l.LogDate()] = true
+			}
+
+			So(got.milestones, ShouldNotBeEmpty)
+			for _, m := range got.milestones {
+				So(milestoneDays[m.days], ShouldBeTrue)
+				So(m.habitID, ShouldEqual, h.HabitID())
+				for i := 0; i < m.days; i++ {
+					So(logged[m.reachedAt.AddDate(0, 0, -i)], ShouldBeTrue)
+				}
+			}
+		})
+
+		Convey("Then the stats count every logged day", func() {
+			So(got.stats.HabitID(), ShouldEqual, h.HabitID())
+			So(got.stats.TotalCompletions(), ShouldEqual, len(got.logs))
+		})
+
+		Convey("Then the same seed reproduces the same history", func() {
+			_, again := simulate(tmpl, 7)
+			So(again.logs, ShouldHaveLength, len(got.logs))
+			for i := range got.logs {
+				So(again.logs[i].LogDate(), ShouldEqual, got.logs[i].LogDate())
+				So(again.logs[i].Count(), ShouldEqual, got.logs[i].Count())
+			}
+		})
+	})
+
+	Convey("Given a weekly habit with a target of three", t, func() {
+		tmpl := habitTemplate{name: "Gym session", frequency: habit.FrequencyWeekly, targetCount: 3, adherence: 1}
+		_, got := simulate(tmpl, 3)
+
+		Convey("Then no week gets more than three logs", func() {
+			perWeek := map[int]int{}
+			for _, l := range got.logs {
+				perWeek[int(l.LogDate().Sub(truncateToDay(createdAt)).Hours()/24)/7]++
+			}
+			So(perWeek, ShouldNotBeEmpty)
+			for _, n := range perWeek {
+				So(n, ShouldBeLessThanOrEqualTo, 3)
+			}
+		})
+	})
+}
+
+func TestRunOptions(t *testing.T) {
+	Convey("Given the seed command", t, func() {
+		Convey("When asked for no users", func() {
+			err := run(context.Background(), []string{"-users", "0"}, io.Discard)
+
+			Convey("Then it is refused before loading the configuration", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "-users and -months must be at least 1")
+			})
+		})
+
+		Convey("When given an unknown flag", func() {
+			err := run(context.Background(), []string{"-nope"}, io.Discard)
+
+			Convey("Then the flag error is returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When pointed at production", func() {
+			for k, v := range map[string]string{
+				"APP_ENV":                   "production",
+				"SERVER_PORT":               "8080",
+				"DB_HOST":                   "db.invalid",
+				"DB_PORT":                   "5432",
+				"DB_USER":                   "ethos",
+				"DB_DB":                     "ethos",
+				"REDIS_HOST":                "redis.invalid",
+				"REDIS_PORT":                "6379",
+				"AUTH_JWT_SECRET":           "seed-test-jwt-secret-of-32-characters",
+				"AUTH_CODE_SECRET":          "seed-test-code-secret-of-32-characters",
+				"AUTH_ACCESS_TOKEN_EXPIRY":  "15m",
+				"AUTH_REFRESH_TOKEN_EXPIRY": "24h",
+			} {
+				t.Setenv(k, v)
+			}
+
+			err := run(context.Background(), nil, io.Discard)
+
+			Convey("Then it refuses without connecting", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "refusing to seed a production database")
+			})
+		})
+	})
+}
+
+// TestSeedUser seeds a demo account into Postgres. Like the habit
+// benchmarks, it is skipped unless ETHOS_BENCH_DSN is set.
+func TestSeedUser(t *testing.T) {
+	dsn := os.Getenv("ETHOS_BENCH_DSN")
+	if dsn == "" {
+		t.Skip("ETHOS_BENCH_DSN not set")
+	}
+	if err := database.RunMigrations(dsn, migrations.FS, "."); err != nil {
+		t.Fatalf("run migrations: %v", err)
+	}
+	db, err := sqlx.Connect("postgres", dsn)
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	email := fmt.Sprintf("seed-%s@ethos.local", uuid.NewString())
+	t.Cleanup(func() {
+		// Habits, logs and notifications cascade from the user
+		_, _ = db.Exec(`DELETE FROM users WHERE email = $1`, email)
+	})
+
+	ctx := context.Background()
+	created, err := newSeeder(db, options{users: 1, months: 2, seed: 1}).seedUser(ctx, email, "Seed Test")
+	if err != nil || !created {
+		t.Fatalf("seed user: created=%t err=%v", created, err)
+	}
+
+	Convey("Given a seeded demo account", t, func() {
+		var habits, logs, notifications int
+		So(db.Get(&habits, `SELECT COUNT(*) FROM habits h JOIN users u USING (user_id) WHERE u.email = $1`, email), ShouldBeNil)
+		So(db.Get(&logs, `SELECT COUNT(*) FROM habit_logs l JOIN users u USING (user_id) WHERE u.email = $1`, email), ShouldBeNil)
+		So(db.Get(&notifications, `SELECT COUNT(*) FROM notifications n JOIN users u USING (user_id) WHERE u.email = $1`, email), ShouldBeNil)
+
+		Convey("Then it has habits, months of logs and notifications", func() {
+			So(habits, ShouldBeGreaterThanOrEqualTo, 5)
+			So(logs, ShouldBeGreaterThan, habits)
+			So(notifications, ShouldBeGreaterThan, 0)
+		})
+
+		Convey("When it is seeded again", func() {
+			created, err := newSeeder(db, options{users: 1, months: 2, seed: 1}).seedUser(ctx, email, "Seed Test")
+
+			Convey("Then it is left alone", func() {
+				So(err, ShouldBeNil)
+				So(created, ShouldBeFalse)
+			})
+		})
+
+		Convey("When it is seeded again with -reset", func() {
+			created, err := newSeeder(db, options{users: 1, months: 2, seed: 2, reset: true}).seedUser(ctx, email, "Seed Test")
+
+			Convey("Then it is recreated", func() {
+				So(err, ShouldBeNil)
+				So(created, ShouldBeTrue)
+			})
+		})
+	})
+}
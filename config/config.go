@@ -1,16 +1,26 @@
 package config
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
 	"net"
+	"net/url"
+	"os"
 	"reflect"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/spf13/viper"
 )
 
+// gRPC serving modes
+const (
+	GRPCModeShared   = "shared"
+	GRPCModeSeparate = "separate"
+)
+
 type Config struct {
 	AppName      string `mapstructure:"APP_NAME" env:"APP_NAME"`
 	AppEnv       string `mapstructure:"APP_ENV" env:"APP_ENV"`
@@ -19,6 +29,44 @@ type Config struct {
 
 	ServerHost string `mapstructure:"SERVER_HOST" env:"SERVER_HOST"`
 	ServerPort string `mapstructure:"SERVER_PORT" env:"SERVER_PORT"`
+	// ServerListen overrides SERVER_HOST and SERVER_PORT with host:port,
+	// unix:/path/to.sock, or systemd[:NAME] for a socket passed by systemd
+	// socket activation
+	ServerListen string `mapstructure:"SERVER_LISTEN" env:"SERVER_LISTEN"`
+	// ServerSocketMode is the octal permission mode of a unix socket
+	ServerSocketMode string `mapstructure:"SERVER_SOCKET_MODE" env:"SERVER_SOCKET_MODE"`
+
+	// GRPCMode is "separate", the default, to serve gRPC on GRPCPort, or
+	// "shared" to serve it on the HTTP server's port over HTTP/2 cleartext.
+	// Shared gRPC calls are passed on ahead of the HTTP middleware, so they
+	// skip its rate limiting, CORS, request logging and body size limit.
+	GRPCMode string `mapstructure:"GRPC_MODE" env:"GRPC_MODE"`
+	GRPCPort string `mapstructure:"GRPC_PORT" env:"GRPC_PORT"`
+
+	// RequestTimeout bounds how long an API request may run. Its context,
+	// and the database calls made with it, are cancelled after it and the
+	// request fails with 504. RequestExportTimeout replaces it for the
+	// data export, which gathers everything a user has.
+	RequestTimeout       time.Duration `mapstructure:"REQUEST_TIMEOUT" env:"REQUEST_TIMEOUT"`
+	RequestExportTimeout time.Duration `mapstructure:"REQUEST_EXPORT_TIMEOUT" env:"REQUEST_EXPORT_TIMEOUT"`
+
+	// CircuitBreakerFailureThreshold is how many calls in a row to an
+	// external service (the mail server, Google sign-in) must fail before
+	// further calls fail at once, for CircuitBreakerOpenTimeout. A single
+	// probe call then decides whether the service is back.
+	CircuitBreakerFailureThreshold int           `mapstructure:"CIRCUIT_BREAKER_FAILURE_THRESHOLD" env:"CIRCUIT_BREAKER_FAILURE_THRESHOLD"`
+	CircuitBreakerOpenTimeout      time.Duration `mapstructure:"CIRCUIT_BREAKER_OPEN_TIMEOUT" env:"CIRCUIT_BREAKER_OPEN_TIMEOUT"`
+
+	// WorkerMetricsPort is where the worker serves /metrics
+	WorkerMetricsPort string `mapstructure:"WORKER_METRICS_PORT" env:"WORKER_METRICS_PORT"`
+
+	// StartupTimeout bounds how long the API and worker wait at boot for
+	// Postgres, Redis, NATS and the OTLP exporters to come up. Failed
+	// attempts are retried with exponential backoff and jitter, starting at
+	// StartupRetryInitialInterval and growing to StartupRetryMaxInterval.
+	StartupTimeout              time.Duration `mapstructure:"STARTUP_TIMEOUT" env:"STARTUP_TIMEOUT"`
+	StartupRetryInitialInterval time.Duration `mapstructure:"STARTUP_RETRY_INITIAL_INTERVAL" env:"STARTUP_RETRY_INITIAL_INTERVAL"`
+	StartupRetryMaxInterval     time.Duration `mapstructure:"STARTUP_RETRY_MAX_INTERVAL" env:"STARTUP_RETRY_MAX_INTERVAL"`
 
 	DBHost     string `mapstructure:"DB_HOST" env:"DB_HOST"`
 	DBPort     int    `mapstructure:"DB_PORT" env:"DB_PORT"`
@@ -27,6 +75,17 @@ type Config struct {
 	DBName     string `mapstructure:"DB_DB" env:"DB_DB"`
 	DBSSLMode  string `mapstructure:"DB_SSL_MODE" env:"DB_SSL_MODE"`
 
+	// DBDisableAutoMigrate stops the API from applying migrations on startup.
+	// Production deployments set it and run `ethosctl migrate up` instead.
+	DBDisableAutoMigrate bool `mapstructure:"DB_DISABLE_AUTO_MIGRATE" env:"DB_DISABLE_AUTO_MIGRATE"`
+
+	// DBStatementTimeout makes Postgres cancel any statement on a pooled
+	// connection that runs longer, so a runaway query cannot hold it forever
+	DBStatementTimeout time.Duration `mapstructure:"DB_STATEMENT_TIMEOUT" env:"DB_STATEMENT_TIMEOUT"`
+	// DBSlowQueryThreshold is how long a statement may take before it is
+	// logged as a slow query
+	DBSlowQueryThreshold time.Duration `mapstructure:"DB_SLOW_QUERY_THRESHOLD" env:"DB_SLOW_QUERY_THRESHOLD"`
+
 	RedisHost     string `mapstructure:"REDIS_HOST" env:"REDIS_HOST"`
 	RedisPort     int    `mapstructure:"REDIS_PORT" env:"REDIS_PORT"`
 	RedisPassword string `mapstructure:"REDIS_PASSWORD" env:"REDIS_PASSWORD"`
@@ -49,8 +108,53 @@ type Config struct {
 	LoggerRate       float64       `mapstructure:"LOGGER_RATE" env:"LOGGER_RATE"`
 
 	AuthJWTSecret          string        `mapstructure:"AUTH_JWT_SECRET" env:"AUTH_JWT_SECRET"`
+	AuthJWTAlgorithm       string        `mapstructure:"AUTH_JWT_ALGORITHM" env:"AUTH_JWT_ALGORITHM"`         // HS256 (default), RS256 or EdDSA
+	AuthJWTKeyID           string        `mapstructure:"AUTH_JWT_KEY_ID" env:"AUTH_JWT_KEY_ID"`               // kid stamped on newly issued tokens
+	AuthJWTKeysDir         string        `mapstructure:"AUTH_JWT_KEYS_DIR" env:"AUTH_JWT_KEYS_DIR"`           // <kid>.pem files for RS256/EdDSA
+	AuthJWTPreviousKeys    string        `mapstructure:"AUTH_JWT_PREVIOUS_KEYS" env:"AUTH_JWT_PREVIOUS_KEYS"` // "kid=secret,..." HMAC keys that still verify
+	AuthCodeSecret         string        `mapstructure:"AUTH_CODE_SECRET" env:"AUTH_CODE_SECRET"`             // keys the stored hash of emailed codes
 	AuthAccessTokenExpiry  time.Duration `mapstructure:"AUTH_ACCESS_TOKEN_EXPIRY" env:"AUTH_ACCESS_TOKEN_EXPIRY"`
 	AuthRefreshTokenExpiry time.Duration `mapstructure:"AUTH_REFRESH_TOKEN_EXPIRY" env:"AUTH_REFRESH_TOKEN_EXPIRY"`
+	AuthUserCacheTTL       time.Duration `mapstructure:"AUTH_USER_CACHE_TTL" env:"AUTH_USER_CACHE_TTL"` // cache the per-request user lookup; 0 disables
+
+	// Passwordless login through an emailed single-use link. Links expire
+	// after AuthMagicLinkTTL, and each user is sent at most
+	// AuthMagicLinkHourlyLimit of them per hour.
+	AuthMagicLinkEnabled     bool          `mapstructure:"AUTH_MAGIC_LINK_ENABLED" env:"AUTH_MAGIC_LINK_ENABLED"`
+	AuthMagicLinkTTL         time.Duration `mapstructure:"AUTH_MAGIC_LINK_TTL" env:"AUTH_MAGIC_LINK_TTL"`
+	AuthMagicLinkHourlyLimit int           `mapstructure:"AUTH_MAGIC_LINK_HOURLY_LIMIT" env:"AUTH_MAGIC_LINK_HOURLY_LIMIT"`
+
+	// Date (YYYY-MM-DD) the deprecated unversioned /api/* and bare /v1/*
+	// paths stop working, announced in their Sunset header; empty while
+	// not yet scheduled
+	APILegacySunset string `mapstructure:"API_LEGACY_SUNSET" env:"API_LEGACY_SUNSET"`
+
+	// Largest per_page a list request may ask for; larger pages are capped
+	PaginationMaxPerPage int `mapstructure:"PAGINATION_MAX_PER_PAGE" env:"PAGINATION_MAX_PER_PAGE"`
+
+	// Shared secret for service-to-service gRPC tokens. When empty a random
+	// per-process secret is used, so only the in-process gateway can call.
+	GRPCServiceSecret string `mapstructure:"GRPC_SERVICE_SECRET" env:"GRPC_SERVICE_SECRET"`
+
+	// How many days back users may log a habit. Older dates are rejected
+	// unless an admin overrides the check.
+	HabitLogBackdateDays int `mapstructure:"HABIT_LOG_BACKDATE_DAYS" env:"HABIT_LOG_BACKDATE_DAYS"`
+
+	// How many completions past its target a habit may be logged in a day;
+	// 0 caps a day's log at the target
+	HabitLogMaxOvershoot int `mapstructure:"HABIT_LOG_MAX_OVERSHOOT" env:"HABIT_LOG_MAX_OVERSHOOT"`
+
+	// How many active and paused habits one user can have on any plan;
+	// creating more is refused
+	HabitMaxActive int `mapstructure:"HABIT_MAX_ACTIVE" env:"HABIT_MAX_ACTIVE"`
+
+	// How many months of habit logs each plan keeps, as "plan=months,...".
+	// Older logs are compacted into monthly summaries; plans not listed
+	// keep their logs indefinitely.
+	HabitLogRetention string `mapstructure:"HABIT_LOG_RETENTION" env:"HABIT_LOG_RETENTION"`
+
+	// Users without a log for this many days get a re-engagement nudge
+	ReengagementInactiveDays int `mapstructure:"REENGAGEMENT_INACTIVE_DAYS" env:"REENGAGEMENT_INACTIVE_DAYS"`
 
 	// OpenTelemetry configuration
 	OTLPEndpoint      string  `mapstructure:"OTEL_EXPORTER_OTLP_ENDPOINT" env:"OTEL_EXPORTER_OTLP_ENDPOINT"`
@@ -58,26 +162,144 @@ type Config struct {
 	OTLPEnableMetrics bool    `mapstructure:"OTEL_ENABLE_METRICS" env:"OTEL_ENABLE_METRICS"`
 	OTLPSampleRate    float64 `mapstructure:"OTEL_SAMPLE_RATE" env:"OTEL_SAMPLE_RATE"`
 
+	// Optional basic auth for the Prometheus /metrics endpoint
+	MetricsUsername string `mapstructure:"METRICS_USERNAME" env:"METRICS_USERNAME"`
+	MetricsPassword string `mapstructure:"METRICS_PASSWORD" env:"METRICS_PASSWORD"`
+
+	// Basic auth for operator endpoints under /admin; unset disables them
+	AdminUsername string `mapstructure:"ADMIN_USERNAME" env:"ADMIN_USERNAME"`
+	AdminPassword string `mapstructure:"ADMIN_PASSWORD" env:"ADMIN_PASSWORD"`
+
+	// Tasks that exhaust their retries are emailed here; unset only logs them
+	OpsAlertEmail string `mapstructure:"OPS_ALERT_EMAIL" env:"OPS_ALERT_EMAIL"`
+
+	// Internal errors and panics are sent to this error tracker, tagged with
+	// the build version and commit; empty disables reporting
+	ErrorReporter string `mapstructure:"ERROR_REPORTER" env:"ERROR_REPORTER"`
+	SentryDSN     string `mapstructure:"SENTRY_DSN" env:"SENTRY_DSN"`
+	RollbarToken  string `mapstructure:"ROLLBAR_TOKEN" env:"ROLLBAR_TOKEN"`
+
 	// Google OAuth configuration
 	GoogleClientID     string `mapstructure:"GOOGLE_CLIENT_ID" env:"GOOGLE_CLIENT_ID"`
 	GoogleClientSecret string `mapstructure:"GOOGLE_CLIENT_SECRET" env:"GOOGLE_CLIENT_SECRET"`
 	GoogleCallbackURL  string `mapstructure:"GOOGLE_CALLBACK_URL" env:"GOOGLE_CALLBACK_URL"`
 
+	// Enterprise single sign-on through an OpenID Connect provider, enabled
+	// when OIDCIssuerURL is set. Users are created on their first login.
+	// OIDCGroupRoles maps groups from the OIDCGroupsClaim claim to roles,
+	// e.g. "ethos-admins=admin". With OIDCRequired every other way of
+	// signing in or registering is refused.
+	OIDCIssuerURL    string `mapstructure:"OIDC_ISSUER_URL" env:"OIDC_ISSUER_URL"`
+	OIDCClientID     string `mapstructure:"OIDC_CLIENT_ID" env:"OIDC_CLIENT_ID"`
+	OIDCClientSecret string `mapstructure:"OIDC_CLIENT_SECRET" env:"OIDC_CLIENT_SECRET"`
+	OIDCCallbackURL  string `mapstructure:"OIDC_CALLBACK_URL" env:"OIDC_CALLBACK_URL"`
+	OIDCGroupsClaim  string `mapstructure:"OIDC_GROUPS_CLAIM" env:"OIDC_GROUPS_CLAIM"`
+	OIDCGroupRoles   string `mapstructure:"OIDC_GROUP_ROLES" env:"OIDC_GROUP_ROLES"` // "group=role,..."
+	OIDCRequired     bool   `mapstructure:"OIDC_REQUIRED" env:"OIDC_REQUIRED"`
+
+	// SCIMToken is the bearer token identity providers present to the SCIM
+	// user provisioning endpoints under /scim/v2; empty disables them
+	SCIMToken string `mapstructure:"SCIM_TOKEN" env:"SCIM_TOKEN"`
+
+	// Stripe billing. The webhook at /webhooks/stripe is enabled when
+	// StripeWebhookSecret, the endpoint's signing secret, is set.
+	// StripePricePlans maps Stripe price IDs to the plan they grant, e.g.
+	// "price_monthly=pro,price_yearly=pro".
+	StripeWebhookSecret string `mapstructure:"STRIPE_WEBHOOK_SECRET" env:"STRIPE_WEBHOOK_SECRET"`
+	StripePricePlans    string `mapstructure:"STRIPE_PRICE_PLANS" env:"STRIPE_PRICE_PLANS"`
+
+	// Current versions of the terms of service and privacy policy. Signed in
+	// users must accept the current version of each before using the API,
+	// so changing one publishes a new version everyone has to accept.
+	// Empty requires no consent to that document.
+	LegalTermsVersion   string `mapstructure:"LEGAL_TERMS_VERSION" env:"LEGAL_TERMS_VERSION"`
+	LegalPrivacyVersion string `mapstructure:"LEGAL_PRIVACY_VERSION" env:"LEGAL_PRIVACY_VERSION"`
+
+	// Optional MaxMind GeoIP2/GeoLite2 City database (.mmdb) used to show
+	// the approximate location of each session
+	GeoIPDatabasePath string `mapstructure:"GEOIP_DATABASE_PATH" env:"GEOIP_DATABASE_PATH"`
+
 	// Event (Canonical Log Lines) configuration
 	EventSampleRate     float64 `mapstructure:"EVENT_SAMPLE_RATE" env:"EVENT_SAMPLE_RATE"`
 	EventP99ThresholdMs int64   `mapstructure:"EVENT_P99_THRESHOLD_MS" env:"EVENT_P99_THRESHOLD_MS"`
 
+	// Debug payload capture: request and response bodies of matching
+	// requests are logged and attached to traces, with credentials, tokens
+	// and emails redacted. Comma-separated path prefixes and user IDs; both
+	// empty disables capture. Re-read on reload like the logger settings.
+	DebugCaptureRoutes   string `mapstructure:"DEBUG_CAPTURE_ROUTES" env:"DEBUG_CAPTURE_ROUTES"`
+	DebugCaptureUsers    string `mapstructure:"DEBUG_CAPTURE_USERS" env:"DEBUG_CAPTURE_USERS"`
+	DebugCaptureMaxBytes int    `mapstructure:"DEBUG_CAPTURE_MAX_BYTES" env:"DEBUG_CAPTURE_MAX_BYTES"`
+
+	// Availability SLOs per route group, as the percentage of requests that
+	// must not fail with a 5xx. Burn rates against them are exported as
+	// slo_burn_rate. Re-read on reload.
+	SLOAuthTarget          float64 `mapstructure:"SLO_AUTH_TARGET" env:"SLO_AUTH_TARGET"`
+	SLOHabitsTarget        float64 `mapstructure:"SLO_HABITS_TARGET" env:"SLO_HABITS_TARGET"`
+	SLONotificationsTarget float64 `mapstructure:"SLO_NOTIFICATIONS_TARGET" env:"SLO_NOTIFICATIONS_TARGET"`
+
 	// NATS configuration
 	NATSUrl           string `mapstructure:"NATS_URL" env:"NATS_URL"`
 	NATSStreamName    string `mapstructure:"NATS_STREAM_NAME" env:"NATS_STREAM_NAME"`
 	NATSConsumerName  string `mapstructure:"NATS_CONSUMER_NAME" env:"NATS_CONSUMER_NAME"`
 	NATSMaxReconnects int    `mapstructure:"NATS_MAX_RECONNECTS" env:"NATS_MAX_RECONNECTS"`
+
+	// Outbox configuration. The worker is woken by LISTEN/NOTIFY on every
+	// outbox insert and polls every OutboxFallbackInterval to catch anything
+	// missed; OutboxPollInterval is used instead when listening is disabled
+	// and while the listen connection is down.
+	OutboxPollInterval     time.Duration `mapstructure:"OUTBOX_POLL_INTERVAL" env:"OUTBOX_POLL_INTERVAL"`
+	OutboxFallbackInterval time.Duration `mapstructure:"OUTBOX_FALLBACK_INTERVAL" env:"OUTBOX_FALLBACK_INTERVAL"`
+	OutboxDisableListen    bool          `mapstructure:"OUTBOX_DISABLE_LISTEN" env:"OUTBOX_DISABLE_LISTEN"`
+
+	// Object storage for uploads such as avatars. The local driver writes
+	// under StorageLocalDir and the API serves the files at /uploads unless
+	// StoragePublicURL points elsewhere. The s3 driver hands out presigned
+	// URLs valid for StorageURLExpiry, or StoragePublicURL links for a public
+	// bucket or CDN. StorageS3Endpoint selects an S3-compatible service.
+	StorageDriver      string        `mapstructure:"STORAGE_DRIVER" env:"STORAGE_DRIVER"` // "local" (default) or "s3"
+	StorageLocalDir    string        `mapstructure:"STORAGE_LOCAL_DIR" env:"STORAGE_LOCAL_DIR"`
+	StoragePublicURL   string        `mapstructure:"STORAGE_PUBLIC_URL" env:"STORAGE_PUBLIC_URL"`
+	StorageURLExpiry   time.Duration `mapstructure:"STORAGE_URL_EXPIRY" env:"STORAGE_URL_EXPIRY"`
+	StorageS3Bucket    string        `mapstructure:"STORAGE_S3_BUCKET" env:"STORAGE_S3_BUCKET"`
+	StorageS3Region    string        `mapstructure:"STORAGE_S3_REGION" env:"STORAGE_S3_REGION"`
+	StorageS3Endpoint  string        `mapstructure:"STORAGE_S3_ENDPOINT" env:"STORAGE_S3_ENDPOINT"`
+	StorageS3AccessKey string        `mapstructure:"STORAGE_S3_ACCESS_KEY" env:"STORAGE_S3_ACCESS_KEY"`
+	StorageS3SecretKey string        `mapstructure:"STORAGE_S3_SECRET_KEY" env:"STORAGE_S3_SECRET_KEY"`
+
+	// Secrets provider configuration. When set, DB/Redis/SMTP/JWT/OAuth
+	// secrets are read from the provider and override .env values.
+	SecretsProvider string `mapstructure:"SECRETS_PROVIDER" env:"SECRETS_PROVIDER"` // "", "file" or "vault"
+	SecretsDir      string `mapstructure:"SECRETS_DIR" env:"SECRETS_DIR"`
+	VaultAddr       string `mapstructure:"VAULT_ADDR" env:"VAULT_ADDR"`
+	VaultToken      string `mapstructure:"VAULT_TOKEN" env:"VAULT_TOKEN"`
+	VaultSecretPath string `mapstructure:"VAULT_SECRET_PATH" env:"VAULT_SECRET_PATH"` // e.g. "secret/data/ethos-go"
 }
 
 func (c *Config) DSN() string {
 	return fmt.Sprintf("postgres://%s:%s@%s:%d/%s?sslmode=%s", c.DBUser, c.DBPassword, c.DBHost, c.DBPort, c.DBName, c.DBSSLMode)
 }
 
+// ServerListenAddress is where the HTTP server listens
+func (c *Config) ServerListenAddress() string {
+	if c.ServerListen != "" {
+		return c.ServerListen
+	}
+	return net.JoinHostPort(c.ServerHost, c.ServerPort)
+}
+
+// ServerWriteTimeout is how long the HTTP server gives a response to be
+// written: enough for the slowest request to time out and report it
+func (c *Config) ServerWriteTimeout() time.Duration {
+	return max(c.RequestTimeout, c.RequestExportTimeout) + 5*time.Second
+}
+
+// ServerSocketFileMode is the permission mode of a unix socket
+func (c *Config) ServerSocketFileMode() os.FileMode {
+	mode, _ := strconv.ParseUint(c.ServerSocketMode, 8, 32)
+	return os.FileMode(mode) & os.ModePerm
+}
+
 func (c *Config) RedisDSN() string {
 	return net.JoinHostPort(c.RedisHost, fmt.Sprintf("%d", c.RedisPort))
 }
@@ -109,12 +331,27 @@ func (c *Config) Validate() error {
 	}
 
 	// Required auth fields
-	if c.AuthJWTSecret == "" {
-		errors = append(errors, "AUTH_JWT_SECRET is required")
+	switch c.AuthJWTAlgorithm {
+	case "", "HS256":
+		if c.AuthJWTSecret == "" {
+			errors = append(errors, "AUTH_JWT_SECRET is required")
+		}
+	case "RS256", "EdDSA":
+		if c.AuthJWTKeysDir == "" {
+			errors = append(errors, "AUTH_JWT_KEYS_DIR is required for "+c.AuthJWTAlgorithm)
+		}
+	default:
+		errors = append(errors, "AUTH_JWT_ALGORITHM must be one of HS256, RS256, EdDSA")
 	}
-	if len(c.AuthJWTSecret) < 32 {
+	if c.AuthJWTSecret != "" && len(c.AuthJWTSecret) < 32 {
 		errors = append(errors, "AUTH_JWT_SECRET must be at least 32 characters")
 	}
+	if len(c.AuthCodeSecret) < 32 {
+		errors = append(errors, "AUTH_CODE_SECRET is required and must be at least 32 characters")
+	}
+	if c.GRPCServiceSecret != "" && len(c.GRPCServiceSecret) < 32 {
+		errors = append(errors, "GRPC_SERVICE_SECRET must be at least 32 characters")
+	}
 	if c.AuthAccessTokenExpiry == 0 {
 		errors = append(errors, "AUTH_ACCESS_TOKEN_EXPIRY is required")
 	}
@@ -122,10 +359,154 @@ func (c *Config) Validate() error {
 		errors = append(errors, "AUTH_REFRESH_TOKEN_EXPIRY is required")
 	}
 
+	if c.AuthMagicLinkTTL < 0 {
+		errors = append(errors, "AUTH_MAGIC_LINK_TTL must not be negative")
+	}
+	if c.AuthMagicLinkHourlyLimit < 0 {
+		errors = append(errors, "AUTH_MAGIC_LINK_HOURLY_LIMIT must not be negative")
+	}
+
+	if c.OIDCIssuerURL != "" {
+		if u, err := url.Parse(c.OIDCIssuerURL); err != nil || !u.IsAbs() {
+			errors = append(errors, "OIDC_ISSUER_URL must be an absolute URL")
+		}
+		if c.OIDCClientID == "" || c.OIDCClientSecret == "" || c.OIDCCallbackURL == "" {
+			errors = append(errors, "OIDC_CLIENT_ID, OIDC_CLIENT_SECRET and OIDC_CALLBACK_URL are required with OIDC_ISSUER_URL")
+		}
+	} else if c.OIDCRequired {
+		errors = append(errors, "OIDC_REQUIRED needs OIDC_ISSUER_URL")
+	}
+	if c.SCIMToken != "" && len(c.SCIMToken) < 32 {
+		errors = append(errors, "SCIM_TOKEN must be at least 32 characters")
+	}
+	if c.StripeWebhookSecret != "" && c.StripePricePlans == "" {
+		errors = append(errors, "STRIPE_PRICE_PLANS is required with STRIPE_WEBHOOK_SECRET")
+	}
+	if len(c.LegalTermsVersion) > 64 || len(c.LegalPrivacyVersion) > 64 {
+		errors = append(errors, "LEGAL_TERMS_VERSION and LEGAL_PRIVACY_VERSION must be at most 64 characters")
+	}
+
+	if c.APILegacySunset != "" {
+		if _, err := time.Parse(time.DateOnly, c.APILegacySunset); err != nil {
+			errors = append(errors, "API_LEGACY_SUNSET must be a date in YYYY-MM-DD format")
+		}
+	}
+
+	if c.PaginationMaxPerPage < 0 {
+		errors = append(errors, "PAGINATION_MAX_PER_PAGE must not be negative")
+	}
+
+	if c.HabitLogBackdateDays < 0 {
+		errors = append(errors, "HABIT_LOG_BACKDATE_DAYS must not be negative")
+	}
+	if c.HabitLogMaxOvershoot < 0 {
+		errors = append(errors, "HABIT_LOG_MAX_OVERSHOOT must not be negative")
+	}
+	if c.HabitMaxActive < 0 {
+		errors = append(errors, "HABIT_MAX_ACTIVE must not be negative")
+	}
+
+	if c.ReengagementInactiveDays < 0 {
+		errors = append(errors, "REENGAGEMENT_INACTIVE_DAYS must not be negative")
+	}
+
+	if c.RequestTimeout < 0 || c.RequestExportTimeout < 0 {
+		errors = append(errors, "REQUEST_TIMEOUT and REQUEST_EXPORT_TIMEOUT must not be negative")
+	}
+
+	if c.CircuitBreakerFailureThreshold < 0 || c.CircuitBreakerOpenTimeout < 0 {
+		errors = append(errors, "CIRCUIT_BREAKER_FAILURE_THRESHOLD and CIRCUIT_BREAKER_OPEN_TIMEOUT must not be negative")
+	}
+
+	if c.StartupTimeout < 0 || c.StartupRetryInitialInterval < 0 || c.StartupRetryMaxInterval < 0 {
+		errors = append(errors, "STARTUP_TIMEOUT and STARTUP_RETRY_* intervals must not be negative")
+	}
+	if c.StartupRetryMaxInterval < c.StartupRetryInitialInterval {
+		errors = append(errors, "STARTUP_RETRY_MAX_INTERVAL must not be less than STARTUP_RETRY_INITIAL_INTERVAL")
+	}
+
+	if c.DBStatementTimeout < 0 {
+		errors = append(errors, "DB_STATEMENT_TIMEOUT must not be negative")
+	}
+	if c.DBSlowQueryThreshold < 0 {
+		errors = append(errors, "DB_SLOW_QUERY_THRESHOLD must not be negative")
+	}
+
+	if c.DebugCaptureMaxBytes < 0 {
+		errors = append(errors, "DEBUG_CAPTURE_MAX_BYTES must not be negative")
+	}
+
+	for _, slo := range []struct {
+		key    string
+		target float64
+	}{
+		{"SLO_AUTH_TARGET", c.SLOAuthTarget},
+		{"SLO_HABITS_TARGET", c.SLOHabitsTarget},
+		{"SLO_NOTIFICATIONS_TARGET", c.SLONotificationsTarget},
+	} {
+		if slo.target <= 0 || slo.target >= 100 {
+			errors = append(errors, slo.key+" must be a percentage between 0 and 100")
+		}
+	}
+
+	if c.OutboxPollInterval < 0 {
+		errors = append(errors, "OUTBOX_POLL_INTERVAL must not be negative")
+	}
+	if c.OutboxFallbackInterval < 0 {
+		errors = append(errors, "OUTBOX_FALLBACK_INTERVAL must not be negative")
+	}
+
+	switch c.StorageDriver {
+	case "local":
+	case "s3":
+		if c.StorageS3Bucket == "" || c.StorageS3Region == "" {
+			errors = append(errors, "STORAGE_S3_BUCKET and STORAGE_S3_REGION are required for the s3 storage driver")
+		}
+		if c.StorageS3AccessKey == "" || c.StorageS3SecretKey == "" {
+			errors = append(errors, "STORAGE_S3_ACCESS_KEY and STORAGE_S3_SECRET_KEY are required for the s3 storage driver")
+		}
+		if c.StoragePublicURL == "" && c.StorageURLExpiry > 7*24*time.Hour {
+			errors = append(errors, "STORAGE_URL_EXPIRY must not exceed 168h")
+		}
+	default:
+		errors = append(errors, "STORAGE_DRIVER must be one of local, s3")
+	}
+	if c.StorageURLExpiry < 0 {
+		errors = append(errors, "STORAGE_URL_EXPIRY must not be negative")
+	}
+
+	switch c.ErrorReporter {
+	case "":
+	case "sentry":
+		if c.SentryDSN == "" {
+			errors = append(errors, "SENTRY_DSN is required for the sentry error reporter")
+		}
+	case "rollbar":
+		if c.RollbarToken == "" {
+			errors = append(errors, "ROLLBAR_TOKEN is required for the rollbar error reporter")
+		}
+	default:
+		errors = append(errors, "ERROR_REPORTER must be one of sentry, rollbar")
+	}
+
+	// Metrics basic auth needs both halves
+	if (c.MetricsUsername == "") != (c.MetricsPassword == "") {
+		errors = append(errors, "METRICS_USERNAME and METRICS_PASSWORD must be set together")
+	}
+	if (c.AdminUsername == "") != (c.AdminPassword == "") {
+		errors = append(errors, "ADMIN_USERNAME and ADMIN_PASSWORD must be set together")
+	}
+
 	// Validate server config
-	if c.ServerPort == "" {
+	if c.ServerPort == "" && c.ServerListen == "" {
 		errors = append(errors, "SERVER_PORT is required")
 	}
+	if c.GRPCMode != GRPCModeShared && c.GRPCMode != GRPCModeSeparate {
+		errors = append(errors, "GRPC_MODE must be shared or separate")
+	}
+	if mode, err := strconv.ParseUint(c.ServerSocketMode, 8, 32); err != nil || mode > 0o777 {
+		errors = append(errors, "SERVER_SOCKET_MODE must be an octal permission mode such as 0660")
+	}
 
 	if len(errors) > 0 {
 		return fmt.Errorf("configuration validation failed:\n  - %s", strings.Join(errors, "\n  - "))
@@ -148,11 +529,72 @@ func (c *Config) setDefaults() {
 	if c.ServerHost == "" {
 		c.ServerHost = "0.0.0.0"
 	}
+	if c.ServerSocketMode == "" {
+		c.ServerSocketMode = "0660"
+	}
+	if c.GRPCMode == "" {
+		c.GRPCMode = GRPCModeSeparate
+	}
+	if c.GRPCPort == "" {
+		c.GRPCPort = "50051"
+	}
+
+	if c.RequestTimeout == 0 {
+		c.RequestTimeout = 15 * time.Second
+	}
+	if c.RequestExportTimeout == 0 {
+		c.RequestExportTimeout = 2 * time.Minute
+	}
+
+	if c.CircuitBreakerFailureThreshold == 0 {
+		c.CircuitBreakerFailureThreshold = 5
+	}
+	if c.CircuitBreakerOpenTimeout == 0 {
+		c.CircuitBreakerOpenTimeout = 30 * time.Second
+	}
+
+	if c.WorkerMetricsPort == "" {
+		c.WorkerMetricsPort = "8081"
+	}
+
+	// Auth defaults
+	if c.AuthJWTAlgorithm == "" {
+		c.AuthJWTAlgorithm = "HS256"
+	}
+	if c.AuthJWTKeyID == "" {
+		c.AuthJWTKeyID = "primary"
+	}
+	if c.AuthMagicLinkTTL == 0 {
+		c.AuthMagicLinkTTL = 15 * time.Minute
+	}
+	if c.AuthMagicLinkHourlyLimit == 0 {
+		c.AuthMagicLinkHourlyLimit = 5
+	}
+	if c.OIDCGroupsClaim == "" {
+		c.OIDCGroupsClaim = "groups"
+	}
+
+	// Startup defaults
+	if c.StartupTimeout == 0 {
+		c.StartupTimeout = time.Minute
+	}
+	if c.StartupRetryInitialInterval == 0 {
+		c.StartupRetryInitialInterval = 500 * time.Millisecond
+	}
+	if c.StartupRetryMaxInterval == 0 {
+		c.StartupRetryMaxInterval = 10 * time.Second
+	}
 
 	// Database defaults
 	if c.DBSSLMode == "" {
 		c.DBSSLMode = "disable"
 	}
+	if c.DBStatementTimeout == 0 {
+		c.DBStatementTimeout = 30 * time.Second
+	}
+	if c.DBSlowQueryThreshold == 0 {
+		c.DBSlowQueryThreshold = 500 * time.Millisecond
+	}
 
 	// Logger defaults
 	if c.LoggerLevel == "" {
@@ -171,6 +613,61 @@ func (c *Config) setDefaults() {
 		c.LoggerMaxAge = 28 // 28 days
 	}
 
+	if c.PaginationMaxPerPage == 0 {
+		c.PaginationMaxPerPage = 100
+	}
+
+	// Habit defaults
+	if c.HabitLogBackdateDays == 0 {
+		c.HabitLogBackdateDays = 7
+	}
+	if c.HabitMaxActive == 0 {
+		c.HabitMaxActive = 100
+	}
+	if c.HabitLogRetention == "" {
+		c.HabitLogRetention = "free=12"
+	}
+
+	// Notification defaults
+	if c.ReengagementInactiveDays == 0 {
+		c.ReengagementInactiveDays = 3
+	}
+
+	// Outbox defaults
+	if c.OutboxPollInterval == 0 {
+		c.OutboxPollInterval = time.Second
+	}
+	if c.OutboxFallbackInterval == 0 {
+		c.OutboxFallbackInterval = 30 * time.Second
+	}
+
+	// Storage defaults
+	if c.StorageDriver == "" {
+		c.StorageDriver = "local"
+	}
+	if c.StorageLocalDir == "" {
+		c.StorageLocalDir = "./data/uploads"
+	}
+	if c.StorageURLExpiry == 0 {
+		c.StorageURLExpiry = time.Hour
+	}
+
+	// Debug capture defaults
+	if c.DebugCaptureMaxBytes == 0 {
+		c.DebugCaptureMaxBytes = 8192
+	}
+
+	// SLO defaults
+	if c.SLOAuthTarget == 0 {
+		c.SLOAuthTarget = 99.9
+	}
+	if c.SLOHabitsTarget == 0 {
+		c.SLOHabitsTarget = 99.5
+	}
+	if c.SLONotificationsTarget == 0 {
+		c.SLONotificationsTarget = 99
+	}
+
 	// Event defaults
 	if c.EventSampleRate == 0 {
 		c.EventSampleRate = 0.05 // 5% sampling for normal requests
@@ -205,7 +702,8 @@ func (c *Config) setDefaults() {
         +------------------+
 
 Priority Resolution Rule:
-ENV > .env > default
+secrets provider > ENV > .env > default
+(the secrets provider only covers the keys listed in secretKeys)
 
 Explanation:
 - v.ReadInConfig() loads .env
@@ -252,6 +750,13 @@ func Load() (*Config, error) {
 		return nil, fmt.Errorf("failed to unmarshal config: %w", err)
 	}
 
+	// Resolve secrets from the external store, if configured
+	secretsCtx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+	if err := cfg.applySecrets(secretsCtx); err != nil {
+		return nil, fmt.Errorf("failed to load secrets: %w", err)
+	}
+
 	// Set defaults for optional fields
 	cfg.setDefaults()
 
@@ -0,0 +1,195 @@
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+// secretKeys are the configuration keys a secrets provider may supply.
+// Anything else stays in .env / environment variables.
+var secretKeys = []string{
+	"DB_PASSWORD",
+	"REDIS_PASSWORD",
+	"SMTP_PASSWORD",
+	"AUTH_JWT_SECRET",
+	"AUTH_JWT_PREVIOUS_KEYS",
+	"AUTH_CODE_SECRET",
+	"GRPC_SERVICE_SECRET",
+	"GOOGLE_CLIENT_SECRET",
+	"OIDC_CLIENT_SECRET",
+	"SCIM_TOKEN",
+	"STRIPE_WEBHOOK_SECRET",
+	"METRICS_PASSWORD",
+	"ADMIN_PASSWORD",
+	"STORAGE_S3_SECRET_KEY",
+	"SENTRY_DSN",
+	"ROLLBAR_TOKEN",
+}
+
+// SecretsProvider resolves secret values from an external store.
+// Secret returns ok=false when the store has no value for key, in which
+// case the value from .env / environment variables is kept.
+type SecretsProvider interface {
+	Secret(ctx context.Context, key string) (value string, ok bool, err error)
+}
+
+// SecretsProviderFactory builds a provider from the non-secret settings.
+type SecretsProviderFactory func(cfg *Config) (SecretsProvider, error)
+
+var (
+	providersMu sync.RWMutex
+	providers   = map[string]SecretsProviderFactory{
+		"file":  newFileSecretsProvider,
+		"vault": newVaultSecretsProvider,
+	}
+)
+
+// RegisterSecretsProvider makes a provider selectable with
+// SECRETS_PROVIDER=name. Call it from an init function before Load, e.g.
+// to add a cloud secrets manager without pulling its SDK into every build.
+func RegisterSecretsProvider(name string, factory SecretsProviderFactory) {
+	providersMu.Lock()
+	defer providersMu.Unlock()
+	providers[name] = factory
+}
+
+// applySecrets overwrites secret fields with values from the configured
+// provider. It is a no-op when SECRETS_PROVIDER is empty.
+func (c *Config) applySecrets(ctx context.Context) error {
+	if c.SecretsProvider == "" {
+		return nil
+	}
+
+	providersMu.RLock()
+	factory, ok := providers[c.SecretsProvider]
+	providersMu.RUnlock()
+	if !ok {
+		return fmt.Errorf("unknown SECRETS_PROVIDER %q", c.SecretsProvider)
+	}
+
+	provider, err := factory(c)
+	if err != nil {
+		return fmt.Errorf("init %s secrets provider: %w", c.SecretsProvider, err)
+	}
+
+	v := reflect.ValueOf(c).Elem()
+	t := v.Type()
+	for _, key := range secretKeys {
+		value, ok, err := provider.Secret(ctx, key)
+		if err != nil {
+			return fmt.Errorf("read secret %s: %w", key, err)
+		}
+		if !ok {
+			continue
+		}
+
+		for i := 0; i < t.NumField(); i++ {
+			if t.Field(i).Tag.Get("mapstructure") == key {
+				v.Field(i).SetString(value)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// fileSecretsProvider reads one file per key from a directory, the layout
+// used by Docker and Kubernetes secret mounts (e.g. /run/secrets/DB_PASSWORD).
+type fileSecretsProvider struct {
+	dir string
+}
+
+func newFileSecretsProvider(cfg *Config) (SecretsProvider, error) {
+	dir := cfg.SecretsDir
+	if dir == "" {
+		dir = "/run/secrets"
+	}
+	return fileSecretsProvider{dir: dir}, nil
+}
+
+func (p fileSecretsProvider) Secret(_ context.Context, key string) (string, bool, error) {
+	data, err := os.ReadFile(filepath.Join(p.dir, key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+	return strings.TrimRight(string(data), "\r\n"), true, nil
+}
+
+// vaultSecretsProvider reads a single HashiCorp Vault KV v2 secret whose
+// fields are named after the configuration keys. The secret is fetched once
+// on first use.
+type vaultSecretsProvider struct {
+	url    string
+	token  string
+	client *http.Client
+
+	once   sync.Once
+	values map[string]string
+	err    error
+}
+
+func newVaultSecretsProvider(cfg *Config) (SecretsProvider, error) {
+	if cfg.VaultAddr == "" || cfg.VaultToken == "" || cfg.VaultSecretPath == "" {
+		return nil, errors.New("VAULT_ADDR, VAULT_TOKEN and VAULT_SECRET_PATH are required")
+	}
+
+	return &vaultSecretsProvider{
+		url:    strings.TrimRight(cfg.VaultAddr, "/") + "/v1/" + strings.TrimLeft(cfg.VaultSecretPath, "/"),
+		token:  cfg.VaultToken,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+func (p *vaultSecretsProvider) Secret(ctx context.Context, key string) (string, bool, error) {
+	p.once.Do(func() { p.values, p.err = p.fetch(ctx) })
+	if p.err != nil {
+		return "", false, p.err
+	}
+
+	value, ok := p.values[key]
+	return value, ok, nil
+}
+
+func (p *vaultSecretsProvider) fetch(ctx context.Context) (map[string]string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Vault-Token", p.token)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("vault request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("vault returned %s", resp.Status)
+	}
+
+	// KV v2 nests the secret under data.data
+	var body struct {
+		Data struct {
+			Data map[string]string `json:"data"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("decode vault response: %w", err)
+	}
+
+	return body.Data.Data, nil
+}
@@ -0,0 +1,138 @@
+package config_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/config"
+)
+
+// baseEnv is the smallest .env that passes validation
+const baseEnv = `DB_HOST=localhost
+DB_PORT=5432
+DB_USER=ethosgo
+DB_DB=ethosgo
+DB_PASSWORD=from-env-file
+REDIS_HOST=localhost
+REDIS_PORT=6379
+AUTH_JWT_SECRET=jwt-secret-that-is-at-least-32-characters
+AUTH_CODE_SECRET=code-secret-that-is-at-least-32-characters
+AUTH_ACCESS_TOKEN_EXPIRY=15m
+AUTH_REFRESH_TOKEN_EXPIRY=24h
+SERVER_PORT=8080
+`
+
+// inConfigDir runs the test from a fresh directory holding env as .env,
+// where Load and Watch look for it
+func inConfigDir(t *testing.T, env string) string {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, ".env"), []byte(env), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Chdir(dir)
+	return dir
+}
+
+func TestLoadSecrets(t *testing.T) {
+	secretsDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(secretsDir, "DB_PASSWORD"), []byte("from-file\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	vault := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "vault-token" || r.URL.Path != "/v1/secret/data/ethos-go" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"data":{"DB_PASSWORD":"from-vault","SMTP_PASSWORD":"smtp-from-vault"}}}`))
+	}))
+	defer vault.Close()
+
+	testCases := []struct {
+		name     string
+		env      map[string]string
+		wantErr  string
+		wantDB   string
+		wantSMTP string
+		wantJWT  string
+	}{
+		{
+			name:    "no provider keeps the .env values",
+			env:     map[string]string{},
+			wantDB:  "from-env-file",
+			wantJWT: "jwt-secret-that-is-at-least-32-characters",
+		},
+		{
+			name:    "file provider reads one file per key",
+			env:     map[string]string{"SECRETS_PROVIDER": "file", "SECRETS_DIR": secretsDir},
+			wantDB:  "from-file",
+			wantJWT: "jwt-secret-that-is-at-least-32-characters",
+		},
+		{
+			name: "vault provider reads the KV secret's fields",
+			env: map[string]string{
+				"SECRETS_PROVIDER":  "vault",
+				"VAULT_ADDR":        vault.URL + "/",
+				"VAULT_TOKEN":       "vault-token",
+				"VAULT_SECRET_PATH": "/secret/data/ethos-go",
+			},
+			wantDB:   "from-vault",
+			wantSMTP: "smtp-from-vault",
+			wantJWT:  "jwt-secret-that-is-at-least-32-characters",
+		},
+		{
+			name: "vault provider fails when vault refuses the token",
+			env: map[string]string{
+				"SECRETS_PROVIDER":  "vault",
+				"VAULT_ADDR":        vault.URL,
+				"VAULT_TOKEN":       "revoked-token",
+				"VAULT_SECRET_PATH": "secret/data/ethos-go",
+			},
+			wantErr: "vault returned 403 Forbidden",
+		},
+		{
+			name:    "vault provider needs its settings",
+			env:     map[string]string{"SECRETS_PROVIDER": "vault", "VAULT_ADDR": vault.URL},
+			wantErr: "VAULT_ADDR, VAULT_TOKEN and VAULT_SECRET_PATH are required",
+		},
+		{
+			name:    "unknown provider is refused",
+			env:     map[string]string{"SECRETS_PROVIDER": "keychain"},
+			wantErr: `unknown SECRETS_PROVIDER "keychain"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			inConfigDir(t, baseEnv)
+			for key, value := range tc.env {
+				t.Setenv(key, value)
+			}
+
+			Convey("When loading with "+tc.name, t, func() {
+				cfg, err := config.Load()
+
+				if tc.wantErr != "" {
+					Convey("Then it fails", func() {
+						So(err, ShouldNotBeNil)
+						So(err.Error(), ShouldContainSubstring, tc.wantErr)
+					})
+					return
+				}
+
+				Convey("Then the provider's values win and the rest are kept", func() {
+					So(err, ShouldBeNil)
+					So(cfg.DBPassword, ShouldEqual, tc.wantDB)
+					So(cfg.SMTPPassword, ShouldEqual, tc.wantSMTP)
+					So(cfg.AuthJWTSecret, ShouldEqual, tc.wantJWT)
+				})
+			})
+		})
+	}
+}
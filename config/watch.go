@@ -0,0 +1,72 @@
+package config
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// Watch reloads configuration on SIGHUP or when the .env file changes and
+// passes the new value to onReload. It blocks until ctx is cancelled.
+//
+// Only settings that are safe to change at runtime (log level, event
+// sampling, debug capture, SLO targets, page size cap) should be read from
+// the reloaded Config; connection settings and secrets take effect on the
+// next restart.
+// A reload that fails to load or validate is logged and ignored.
+func Watch(ctx context.Context, onReload func(*Config)) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+
+	// Watch the directory rather than the file so editors that replace
+	// .env via rename are still noticed.
+	var fileEvents <-chan fsnotify.Event
+	if watcher, err := fsnotify.NewWatcher(); err != nil {
+		slog.Warn("config file watching disabled", "error", err)
+	} else {
+		defer watcher.Close()
+		if err := watcher.Add("."); err != nil {
+			slog.Warn("config file watching disabled", "error", err)
+		} else {
+			fileEvents = watcher.Events
+		}
+	}
+
+	// Editors often write a file in several steps; wait for them to settle.
+	const debounce = 500 * time.Millisecond
+	timer := time.NewTimer(debounce)
+	timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hup:
+			reload(onReload, "SIGHUP")
+		case ev := <-fileEvents:
+			if filepath.Base(ev.Name) == ".env" && ev.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename) != 0 {
+				timer.Reset(debounce)
+			}
+		case <-timer.C:
+			reload(onReload, "file change")
+		}
+	}
+}
+
+func reload(onReload func(*Config), trigger string) {
+	cfg, err := Load()
+	if err != nil {
+		slog.Error("config reload failed, keeping current settings", "trigger", trigger, "error", err)
+		return
+	}
+
+	slog.Info("config reloaded", "trigger", trigger)
+	onReload(cfg)
+}
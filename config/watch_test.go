@@ -0,0 +1,133 @@
+package config_test
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/config"
+)
+
+// watchReloads runs Watch from the current directory until the test ends
+// and returns the configs it reloads
+func watchReloads(t *testing.T) <-chan *config.Config {
+	ctx, cancel := context.WithCancel(context.Background())
+	reloads := make(chan *config.Config, 10)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		config.Watch(ctx, func(cfg *config.Config) { reloads <- cfg })
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+
+	// Give the watcher time to start following the directory
+	time.Sleep(100 * time.Millisecond)
+	return reloads
+}
+
+// collect returns the configs reloaded until quiet passes without one
+func collect(reloads <-chan *config.Config, quiet time.Duration) []*config.Config {
+	var got []*config.Config
+	for {
+		select {
+		case cfg := <-reloads:
+			got = append(got, cfg)
+		case <-time.After(quiet):
+			return got
+		}
+	}
+}
+
+func TestWatchFileChanges(t *testing.T) {
+	testCases := []struct {
+		name       string
+		writes     []string
+		wantDBPass []string
+	}{
+		{
+			name: "a burst of writes reloads once, with the last write",
+			writes: []string{
+				baseEnv + "DB_PASSWORD=first\n",
+				baseEnv + "DB_PASSWORD=second\n",
+				baseEnv + "DB_PASSWORD=third\n",
+			},
+			wantDBPass: []string{"third"},
+		},
+		{
+			name:   "a file that fails validation keeps the current settings",
+			writes: []string{strings.Replace(baseEnv, "AUTH_CODE_SECRET=", "AUTH_CODE_SECRET_OLD=", 1)},
+		},
+		{
+			name: "a failed reload does not stop watching",
+			writes: []string{
+				strings.Replace(baseEnv, "AUTH_CODE_SECRET=", "AUTH_CODE_SECRET_OLD=", 1),
+				"",
+				baseEnv + "DB_PASSWORD=fixed\n",
+			},
+			wantDBPass: []string{"fixed"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			inConfigDir(t, baseEnv)
+			reloads := watchReloads(t)
+
+			Convey("When .env is written: "+tc.name, t, func() {
+				for _, env := range tc.writes {
+					// An empty write stands for a pause long enough for the
+					// previous write to be reloaded on its own
+					if env == "" {
+						time.Sleep(time.Second)
+						continue
+					}
+					So(os.WriteFile(".env", []byte(env), 0o600), ShouldBeNil)
+					time.Sleep(50 * time.Millisecond)
+				}
+
+				got := collect(reloads, 1500*time.Millisecond)
+
+				Convey("Then only the expected reloads are passed on", func() {
+					So(got, ShouldHaveLength, len(tc.wantDBPass))
+					for i, cfg := range got {
+						So(cfg.DBPassword, ShouldEqual, tc.wantDBPass[i])
+					}
+				})
+			})
+		})
+	}
+}
+
+func TestWatchSIGHUP(t *testing.T) {
+	inConfigDir(t, baseEnv)
+
+	// While a handler is registered, SIGHUP no longer terminates the test
+	// binary, even before Watch has registered its own
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+
+	reloads := watchReloads(t)
+
+	Convey("When the process receives SIGHUP", t, func() {
+		So(syscall.Kill(os.Getpid(), syscall.SIGHUP), ShouldBeNil)
+
+		Convey("Then the configuration is reloaded", func() {
+			select {
+			case cfg := <-reloads:
+				So(cfg.DBPassword, ShouldEqual, "from-env-file")
+			case <-time.After(2 * time.Second):
+				So("no reload after SIGHUP", ShouldBeEmpty)
+			}
+		})
+	})
+}
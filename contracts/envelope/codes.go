@@ -0,0 +1,34 @@
+package envelope
+
+// Error codes
+const (
+	CodeInvalidCredentials     = "AUTH_INVALID_CREDENTIALS"
+	CodeEmailNotVerified       = "AUTH_EMAIL_NOT_VERIFIED"
+	CodeAccountDeactivated     = "AUTH_ACCOUNT_DEACTIVATED"
+	CodeSessionExpired         = "AUTH_SESSION_EXPIRED"
+	CodeSessionBlocked         = "AUTH_SESSION_BLOCKED"
+	CodeInvalidToken           = "AUTH_INVALID_TOKEN"
+	CodeTokenExpired           = "AUTH_TOKEN_EXPIRED"
+	CodeUnauthorized           = "AUTH_UNAUTHORIZED"
+	CodeInsufficientPermission = "AUTH_INSUFFICIENT_PERMISSION"
+	CodeConsentRequired        = "AUTH_CONSENT_REQUIRED"
+
+	CodeNotFound      = "RESOURCE_NOT_FOUND"
+	CodeAlreadyExists = "RESOURCE_ALREADY_EXISTS"
+	CodeConflict      = "RESOURCE_CONFLICT"
+
+	CodeValidationFailed = "VALIDATION_FAILED"
+	CodeInvalidInput     = "VALIDATION_INVALID_INPUT"
+	CodeMissingField     = "VALIDATION_MISSING_FIELD"
+
+	CodeInternalError        = "INTERNAL_ERROR"
+	CodeDatabaseError        = "INTERNAL_DATABASE_ERROR"
+	CodeExternalServiceError = "INTERNAL_EXTERNAL_SERVICE_ERROR"
+
+	CodeBusinessRuleViolation = "BUSINESS_RULE_VIOLATION"
+	CodeOperationNotAllowed   = "BUSINESS_OPERATION_NOT_ALLOWED"
+	CodePlanLimitExceeded     = "PLAN_LIMIT_EXCEEDED"
+
+	CodeRateLimited    = "RATE_LIMITED"
+	CodeRequestTimeout = "REQUEST_TIMEOUT"
+)
@@ -0,0 +1,54 @@
+// Package envelope defines the JSON envelope every Ethos HTTP response is
+// wrapped in, successful or not, so clients need a single parser.
+package envelope
+
+// Response is the unified response structure. Successful responses carry
+// Data, and Meta for lists; failed ones carry an Error.
+type Response struct {
+	Success bool        `json:"success"`
+	Message string      `json:"message,omitempty"`
+	Data    interface{} `json:"data,omitempty"`
+	Meta    interface{} `json:"meta,omitempty"`
+	Error   interface{} `json:"error,omitempty"`
+	// RequestID identifies the request in logs and error reports
+	RequestID string `json:"request_id,omitempty"`
+}
+
+// Meta contains metadata for responses (e.g., pagination)
+type Meta struct {
+	Pagination *Paging `json:"pagination,omitempty"`
+}
+
+// Paging describes the page of a list response
+type Paging struct {
+	HasPreviousPage        bool `json:"has_previous_page"`
+	HasNextPage            bool `json:"has_next_page"`
+	CurrentPage            int  `json:"current_page"`
+	PerPage                int  `json:"per_page"`
+	TotalData              int  `json:"total_data"`
+	TotalDataInCurrentPage int  `json:"total_data_in_current_page"`
+	LastPage               int  `json:"last_page"`
+	From                   int  `json:"from"`
+	To                     int  `json:"to"`
+}
+
+// Error is the "error" object of every failed HTTP response:
+//
+//	{
+//	  "success": false,
+//	  "message": "Habit not found",
+//	  "error": {
+//	    "code": "RESOURCE_NOT_FOUND",
+//	    "message": "Habit not found",
+//	    "details": {"resource": "Habit", "identifier": "..."},
+//	    "request_id": "host/abc123-000042"
+//	  }
+//	}
+//
+// Code is one of the Code constants.
+type Error struct {
+	Code      string                 `json:"code"`
+	Message   string                 `json:"message"`
+	Details   map[string]interface{} `json:"details,omitempty"`
+	RequestID string                 `json:"request_id,omitempty"`
+}
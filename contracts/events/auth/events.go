@@ -1,9 +1,14 @@
-package events
+// Package auth holds the events the auth module publishes. Producers build
+// them with the New<Event> constructors; consumers decode them with the
+// generated Parse<Event> and On<Event> helpers.
+package auth
+
+//go:generate go run ../gen
 
 import (
 	"time"
 
-	commonevents "github.com/semmidev/ethos-go/internal/common/events"
+	commonevents "github.com/semmidev/ethos-go/contracts/events"
 )
 
 // Event subjects
@@ -13,13 +18,25 @@ const (
 	PasswordChangedType    = "auth.user.password_changed"
 	UserLoggedInType       = "auth.user.logged_in"
 	PasswordResetRequested = "auth.user.password_reset_requested"
+	UserAnonymizedType     = "auth.user.anonymized"
 )
 
+// RegisterSchemas registers the schema of every auth event. Bump the
+// version and register the new struct alongside the old one when an
+// event's shape changes.
+func RegisterSchemas(r commonevents.SchemaRegistry) {
+	r.Register(UserRegisteredType, 1, UserRegistered{})
+	r.Register(UserVerifiedType, 1, UserVerified{})
+	r.Register(PasswordChangedType, 1, PasswordChanged{})
+	r.Register(UserLoggedInType, 1, UserLoggedIn{})
+	r.Register(UserAnonymizedType, 1, UserAnonymized{})
+}
+
 // UserRegistered is emitted when a new user registers
 type UserRegistered struct {
 	commonevents.BaseEvent
-	UserID       string `json:"user_id"`
-	Email        string `json:"email"`
+	UserID       string `json:"user_id" validate:"required"`
+	Email        string `json:"email" validate:"required"`
 	Name         string `json:"name"`
 	AuthProvider string `json:"auth_provider"`
 }
@@ -38,8 +55,8 @@ func NewUserRegistered(userID, email, name, authProvider string) UserRegistered
 // UserVerified is emitted when a user verifies their email
 type UserVerified struct {
 	commonevents.BaseEvent
-	UserID     string    `json:"user_id"`
-	Email      string    `json:"email"`
+	UserID     string    `json:"user_id" validate:"required"`
+	Email      string    `json:"email" validate:"required"`
 	VerifiedAt time.Time `json:"verified_at"`
 }
 
@@ -56,8 +73,8 @@ func NewUserVerified(userID, email string) UserVerified {
 // PasswordChanged is emitted when a user changes their password
 type PasswordChanged struct {
 	commonevents.BaseEvent
-	UserID    string    `json:"user_id"`
-	Email     string    `json:"email"`
+	UserID    string    `json:"user_id" validate:"required"`
+	Email     string    `json:"email" validate:"required"`
 	ChangedAt time.Time `json:"changed_at"`
 }
 
@@ -74,8 +91,8 @@ func NewPasswordChanged(userID, email string) PasswordChanged {
 // UserLoggedIn is emitted when a user logs in
 type UserLoggedIn struct {
 	commonevents.BaseEvent
-	UserID    string `json:"user_id"`
-	Email     string `json:"email"`
+	UserID    string `json:"user_id" validate:"required"`
+	Email     string `json:"email" validate:"required"`
 	UserAgent string `json:"user_agent"`
 	ClientIP  string `json:"client_ip"`
 }
@@ -90,3 +107,21 @@ func NewUserLoggedIn(userID, email, userAgent, clientIP string) UserLoggedIn {
 		ClientIP:  clientIP,
 	}
 }
+
+// UserAnonymized is emitted when a user's personal data has been scrubbed.
+// Consumers holding copies of it, such as an email or IP address taken
+// from earlier events, should forget them.
+type UserAnonymized struct {
+	commonevents.BaseEvent
+	UserID       string    `json:"user_id" validate:"required"`
+	AnonymizedAt time.Time `json:"anonymized_at"`
+}
+
+// NewUserAnonymized creates a new UserAnonymized event
+func NewUserAnonymized(userID string, anonymizedAt time.Time) UserAnonymized {
+	return UserAnonymized{
+		BaseEvent:    commonevents.NewBaseEvent(UserAnonymizedType, "user", userID),
+		UserID:       userID,
+		AnonymizedAt: anonymizedAt.UTC(),
+	}
+}
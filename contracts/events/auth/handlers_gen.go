@@ -0,0 +1,59 @@
+// Code generated by contracts/events/gen. DO NOT EDIT.
+
+package auth
+
+import (
+	"context"
+
+	commonevents "github.com/semmidev/ethos-go/contracts/events"
+)
+
+// ParseUserRegistered decodes the data of a UserRegisteredType event
+func ParseUserRegistered(data []byte) (*UserRegistered, error) {
+	return commonevents.ParseEvent[UserRegistered](data)
+}
+
+// OnUserRegistered returns a consumer handler for UserRegisteredType events
+func OnUserRegistered(handle func(ctx context.Context, event *UserRegistered) error) commonevents.Handler {
+	return commonevents.NewTypedHandler(UserRegisteredType, handle)
+}
+
+// ParseUserVerified decodes the data of a UserVerifiedType event
+func ParseUserVerified(data []byte) (*UserVerified, error) {
+	return commonevents.ParseEvent[UserVerified](data)
+}
+
+// OnUserVerified returns a consumer handler for UserVerifiedType events
+func OnUserVerified(handle func(ctx context.Context, event *UserVerified) error) commonevents.Handler {
+	return commonevents.NewTypedHandler(UserVerifiedType, handle)
+}
+
+// ParsePasswordChanged decodes the data of a PasswordChangedType event
+func ParsePasswordChanged(data []byte) (*PasswordChanged, error) {
+	return commonevents.ParseEvent[PasswordChanged](data)
+}
+
+// OnPasswordChanged returns a consumer handler for PasswordChangedType events
+func OnPasswordChanged(handle func(ctx context.Context, event *PasswordChanged) error) commonevents.Handler {
+	return commonevents.NewTypedHandler(PasswordChangedType, handle)
+}
+
+// ParseUserLoggedIn decodes the data of a UserLoggedInType event
+func ParseUserLoggedIn(data []byte) (*UserLoggedIn, error) {
+	return commonevents.ParseEvent[UserLoggedIn](data)
+}
+
+// OnUserLoggedIn returns a consumer handler for UserLoggedInType events
+func OnUserLoggedIn(handle func(ctx context.Context, event *UserLoggedIn) error) commonevents.Handler {
+	return commonevents.NewTypedHandler(UserLoggedInType, handle)
+}
+
+// ParseUserAnonymized decodes the data of a UserAnonymizedType event
+func ParseUserAnonymized(data []byte) (*UserAnonymized, error) {
+	return commonevents.ParseEvent[UserAnonymized](data)
+}
+
+// OnUserAnonymized returns a consumer handler for UserAnonymizedType events
+func OnUserAnonymized(handle func(ctx context.Context, event *UserAnonymized) error) commonevents.Handler {
+	return commonevents.NewTypedHandler(UserAnonymizedType, handle)
+}
@@ -0,0 +1,47 @@
+package events
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidPayload is returned for events whose data does not match their
+// schema
+var ErrInvalidPayload = errors.New("invalid event payload")
+
+// Envelope is the wire format of every published event. The event itself
+// travels in Data, validated against the schema registered for its type
+// and SchemaVersion, so consumers can tell which shape they received.
+type Envelope struct {
+	BaseEvent
+	SchemaVersion int               `json:"schema_version"`
+	Producer      string            `json:"producer"`
+	TraceContext  map[string]string `json:"trace_context,omitempty"`
+	Data          json.RawMessage   `json:"data"`
+}
+
+// OpenEnvelope decodes a published event without validating its data.
+// Events published before envelopes existed are the bare event JSON; they
+// are read as schema version 1 with the whole message as data.
+func OpenEnvelope(raw []byte) (*Envelope, error) {
+	var env Envelope
+	if err := json.Unmarshal(raw, &env); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPayload, err)
+	}
+
+	if env.SchemaVersion == 0 {
+		env.SchemaVersion = 1
+		env.Data = raw
+	}
+
+	return &env, nil
+}
+
+// SchemaRegistry records the struct each version of an event type decodes
+// into. Each module's RegisterSchemas fills one.
+type SchemaRegistry interface {
+	// Register adds version of eventType's schema. schema is a value or
+	// pointer of the struct type.
+	Register(eventType string, version int, schema any)
+}
@@ -0,0 +1,178 @@
+// Command gen writes the typed parsing helpers of an event contracts package.
+//
+// It reads the package's RegisterSchemas function and, for the latest
+// version of every registered event type, emits a Parse<Event> function and
+// an On<Event> consumer handler constructor. Handlers built from these decode
+// into the same struct the producer publishes, so the two cannot drift.
+//
+// Run it through go generate from the event contracts package directory.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const outputFile = "handlers_gen.go"
+
+// schema is one r.Register(eventType, version, Struct{}) call
+type schema struct {
+	eventType string
+	version   int
+	structure string
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("contracts/events/gen: ")
+
+	pkg, schemas, err := readSchemas(".")
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(schemas) == 0 {
+		log.Fatal("no schemas registered in RegisterSchemas")
+	}
+
+	src, err := render(pkg, latest(schemas))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(outputFile, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// readSchemas parses the package in dir and returns its name and the
+// schemas registered by its RegisterSchemas function, in order
+func readSchemas(dir string) (string, []schema, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		name := fi.Name()
+		return name != outputFile && !strings.HasSuffix(name, "_test.go")
+	}, 0)
+	if err != nil {
+		return "", nil, err
+	}
+	if len(pkgs) != 1 {
+		return "", nil, fmt.Errorf("expected one package in %s, found %d", dir, len(pkgs))
+	}
+
+	for name, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				fn, ok := decl.(*ast.FuncDecl)
+				if !ok || fn.Name.Name != "RegisterSchemas" || fn.Body == nil {
+					continue
+				}
+				schemas, err := registerCalls(fn)
+				return name, schemas, err
+			}
+		}
+		return name, nil, fmt.Errorf("package %s has no RegisterSchemas function", name)
+	}
+	return "", nil, nil
+}
+
+// registerCalls extracts the Register calls of fn
+func registerCalls(fn *ast.FuncDecl) ([]schema, error) {
+	var schemas []schema
+	var err error
+
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || err != nil {
+			return err == nil
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || sel.Sel.Name != "Register" {
+			return true
+		}
+		if len(call.Args) != 3 {
+			err = fmt.Errorf("Register call with %d arguments", len(call.Args))
+			return false
+		}
+
+		eventType, ok := call.Args[0].(*ast.Ident)
+		if !ok {
+			err = fmt.Errorf("Register event type must be a constant name")
+			return false
+		}
+		lit, ok := call.Args[1].(*ast.BasicLit)
+		if !ok || lit.Kind != token.INT {
+			err = fmt.Errorf("Register version for %s must be an integer literal", eventType.Name)
+			return false
+		}
+		version, convErr := strconv.Atoi(lit.Value)
+		if convErr != nil {
+			err = convErr
+			return false
+		}
+		composite, ok := call.Args[2].(*ast.CompositeLit)
+		if !ok {
+			err = fmt.Errorf("Register schema for %s must be a struct literal", eventType.Name)
+			return false
+		}
+		structure, ok := composite.Type.(*ast.Ident)
+		if !ok {
+			err = fmt.Errorf("Register schema for %s must be a struct of the package", eventType.Name)
+			return false
+		}
+
+		schemas = append(schemas, schema{eventType: eventType.Name, version: version, structure: structure.Name})
+		return true
+	})
+
+	return schemas, err
+}
+
+// latest keeps the newest version of each event type, in order of first
+// registration
+func latest(schemas []schema) []schema {
+	index := make(map[string]int)
+	var result []schema
+	for _, s := range schemas {
+		i, ok := index[s.eventType]
+		if !ok {
+			index[s.eventType] = len(result)
+			result = append(result, s)
+			continue
+		}
+		if s.version > result[i].version {
+			result[i] = s
+		}
+	}
+	return result
+}
+
+func render(pkg string, schemas []schema) ([]byte, error) {
+	var b bytes.Buffer
+
+	fmt.Fprintf(&b, "// Code generated by contracts/events/gen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package %s\n\n", pkg)
+	fmt.Fprintf(&b, "import (\n\t\"context\"\n\n\tcommonevents \"github.com/semmidev/ethos-go/contracts/events\"\n)\n")
+
+	for _, s := range schemas {
+		fmt.Fprintf(&b, `
+// Parse%[1]s decodes the data of a %[2]s event
+func Parse%[1]s(data []byte) (*%[1]s, error) {
+	return commonevents.ParseEvent[%[1]s](data)
+}
+
+// On%[1]s returns a consumer handler for %[2]s events
+func On%[1]s(handle func(ctx context.Context, event *%[1]s) error) commonevents.Handler {
+	return commonevents.NewTypedHandler(%[2]s, handle)
+}
+`, s.structure, s.eventType)
+	}
+
+	return format.Source(b.Bytes())
+}
@@ -0,0 +1,218 @@
+// Package habits holds the events the habits module publishes. Producers
+// build them with the New<Event> constructors; consumers decode them with
+// the generated Parse<Event> and On<Event> helpers.
+package habits
+
+//go:generate go run ../gen
+
+import (
+	"time"
+
+	commonevents "github.com/semmidev/ethos-go/contracts/events"
+)
+
+// Event subjects
+const (
+	HabitCreatedType     = "habits.habit.created"
+	HabitCompletedType   = "habits.habit.completed"
+	HabitDeactivatedType = "habits.habit.deactivated"
+	HabitActivatedType   = "habits.habit.activated"
+	HabitPausedType      = "habits.habit.paused"
+	HabitDeletedType     = "habits.habit.deleted"
+	HabitLogDeletedType  = "habits.log.deleted"
+	StreakMilestoneType  = "habits.streak.milestone"
+)
+
+// RegisterSchemas registers the schema of every habits event. Bump the
+// version and register the new struct alongside the old one when an
+// event's shape changes.
+func RegisterSchemas(r commonevents.SchemaRegistry) {
+	r.Register(HabitCreatedType, 1, HabitCreated{})
+	r.Register(HabitCompletedType, 1, HabitCompleted{})
+	r.Register(HabitDeactivatedType, 1, HabitDeactivated{})
+	r.Register(HabitActivatedType, 1, HabitActivated{})
+	r.Register(HabitPausedType, 1, HabitPaused{})
+	r.Register(HabitDeletedType, 1, HabitDeleted{})
+	r.Register(HabitLogDeletedType, 1, HabitLogDeleted{})
+	r.Register(StreakMilestoneType, 1, StreakMilestone{})
+}
+
+// HabitEvent holds the fields every habits event carries, for consumers
+// that handle several event types alike
+type HabitEvent struct {
+	commonevents.BaseEvent
+	HabitID string `json:"habit_id"`
+	UserID  string `json:"user_id"`
+}
+
+// ParseHabitEvent decodes the common fields of any habits event
+func ParseHabitEvent(data []byte) (*HabitEvent, error) {
+	return commonevents.ParseEvent[HabitEvent](data)
+}
+
+// HabitCreated is emitted when a new habit is created
+type HabitCreated struct {
+	commonevents.BaseEvent
+	HabitID     string `json:"habit_id" validate:"required"`
+	UserID      string `json:"user_id" validate:"required"`
+	Name        string `json:"name"`
+	Frequency   string `json:"frequency"`
+	TargetCount int    `json:"target_count"`
+}
+
+// NewHabitCreated creates a new HabitCreated event
+func NewHabitCreated(habitID, userID, name, frequency string, targetCount int) HabitCreated {
+	return HabitCreated{
+		BaseEvent:   commonevents.NewBaseEvent(HabitCreatedType, "habit", habitID),
+		HabitID:     habitID,
+		UserID:      userID,
+		Name:        name,
+		Frequency:   frequency,
+		TargetCount: targetCount,
+	}
+}
+
+// HabitCompleted is emitted when a habit is logged/completed
+type HabitCompleted struct {
+	commonevents.BaseEvent
+	HabitID    string    `json:"habit_id" validate:"required"`
+	UserID     string    `json:"user_id" validate:"required"`
+	LogID      string    `json:"log_id"`
+	LogDate    time.Time `json:"log_date"`
+	Count      int       `json:"count"`
+	TotalToday int       `json:"total_today"`
+	// Correction marks an adjustment to an earlier completion; Count is then
+	// the (negative) change and TotalToday the corrected total
+	Correction bool `json:"correction,omitempty"`
+}
+
+// NewHabitCompleted creates a new HabitCompleted event
+func NewHabitCompleted(habitID, userID, logID string, logDate time.Time, count, totalToday int) HabitCompleted {
+	return HabitCompleted{
+		BaseEvent:  commonevents.NewBaseEvent(HabitCompletedType, "habit", habitID),
+		HabitID:    habitID,
+		UserID:     userID,
+		LogID:      logID,
+		LogDate:    logDate,
+		Count:      count,
+		TotalToday: totalToday,
+	}
+}
+
+// NewHabitCompletionCorrected creates a HabitCompleted event adjusting the
+// day's total by delta, e.g. -1 when a completion is undone
+func NewHabitCompletionCorrected(habitID, userID, logID string, logDate time.Time, delta, totalToday int) HabitCompleted {
+	event := NewHabitCompleted(habitID, userID, logID, logDate, delta, totalToday)
+	event.Correction = true
+	return event
+}
+
+// StreakMilestone is emitted when a user reaches a streak milestone
+type StreakMilestone struct {
+	commonevents.BaseEvent
+	HabitID       string `json:"habit_id" validate:"required"`
+	UserID        string `json:"user_id" validate:"required"`
+	HabitName     string `json:"habit_name"`
+	CurrentStreak int    `json:"current_streak"`
+	Milestone     int    `json:"milestone"` // 7, 30, 100, etc.
+}
+
+// NewStreakMilestone creates a new StreakMilestone event
+func NewStreakMilestone(habitID, userID, habitName string, currentStreak, milestone int) StreakMilestone {
+	return StreakMilestone{
+		BaseEvent:     commonevents.NewBaseEvent(StreakMilestoneType, "habit", habitID),
+		HabitID:       habitID,
+		UserID:        userID,
+		HabitName:     habitName,
+		CurrentStreak: currentStreak,
+		Milestone:     milestone,
+	}
+}
+
+// HabitDeactivated is emitted when a habit is deactivated
+type HabitDeactivated struct {
+	commonevents.BaseEvent
+	HabitID string `json:"habit_id" validate:"required"`
+	UserID  string `json:"user_id" validate:"required"`
+}
+
+// NewHabitDeactivated creates a new HabitDeactivated event
+func NewHabitDeactivated(habitID, userID string) HabitDeactivated {
+	return HabitDeactivated{
+		BaseEvent: commonevents.NewBaseEvent(HabitDeactivatedType, "habit", habitID),
+		HabitID:   habitID,
+		UserID:    userID,
+	}
+}
+
+// HabitActivated is emitted when a habit is activated
+type HabitActivated struct {
+	commonevents.BaseEvent
+	HabitID string `json:"habit_id" validate:"required"`
+	UserID  string `json:"user_id" validate:"required"`
+}
+
+// NewHabitActivated creates a new HabitActivated event
+func NewHabitActivated(habitID, userID string) HabitActivated {
+	return HabitActivated{
+		BaseEvent: commonevents.NewBaseEvent(HabitActivatedType, "habit", habitID),
+		HabitID:   habitID,
+		UserID:    userID,
+	}
+}
+
+// HabitPaused is emitted when a habit is paused until a date
+type HabitPaused struct {
+	commonevents.BaseEvent
+	HabitID     string `json:"habit_id" validate:"required"`
+	UserID      string `json:"user_id" validate:"required"`
+	PausedUntil string `json:"paused_until"` // YYYY-MM-DD
+}
+
+// NewHabitPaused creates a new HabitPaused event
+func NewHabitPaused(habitID, userID string, pausedUntil time.Time) HabitPaused {
+	return HabitPaused{
+		BaseEvent:   commonevents.NewBaseEvent(HabitPausedType, "habit", habitID),
+		HabitID:     habitID,
+		UserID:      userID,
+		PausedUntil: pausedUntil.Format("2006-01-02"),
+	}
+}
+
+// HabitDeleted is emitted when a habit and its logs are deleted
+type HabitDeleted struct {
+	commonevents.BaseEvent
+	HabitID string `json:"habit_id" validate:"required"`
+	UserID  string `json:"user_id" validate:"required"`
+}
+
+// NewHabitDeleted creates a new HabitDeleted event
+func NewHabitDeleted(habitID, userID string) HabitDeleted {
+	return HabitDeleted{
+		BaseEvent: commonevents.NewBaseEvent(HabitDeletedType, "habit", habitID),
+		HabitID:   habitID,
+		UserID:    userID,
+	}
+}
+
+// HabitLogDeleted is emitted when a habit log is deleted
+type HabitLogDeleted struct {
+	commonevents.BaseEvent
+	HabitID string    `json:"habit_id" validate:"required"`
+	UserID  string    `json:"user_id" validate:"required"`
+	LogID   string    `json:"log_id" validate:"required"`
+	LogDate time.Time `json:"log_date"`
+	Count   int       `json:"count"`
+}
+
+// NewHabitLogDeleted creates a new HabitLogDeleted event
+func NewHabitLogDeleted(habitID, userID, logID string, logDate time.Time, count int) HabitLogDeleted {
+	return HabitLogDeleted{
+		BaseEvent: commonevents.NewBaseEvent(HabitLogDeletedType, "habit", habitID),
+		HabitID:   habitID,
+		UserID:    userID,
+		LogID:     logID,
+		LogDate:   logDate,
+		Count:     count,
+	}
+}
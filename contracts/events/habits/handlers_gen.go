@@ -0,0 +1,89 @@
+// Code generated by contracts/events/gen. DO NOT EDIT.
+
+package habits
+
+import (
+	"context"
+
+	commonevents "github.com/semmidev/ethos-go/contracts/events"
+)
+
+// ParseHabitCreated decodes the data of a HabitCreatedType event
+func ParseHabitCreated(data []byte) (*HabitCreated, error) {
+	return commonevents.ParseEvent[HabitCreated](data)
+}
+
+// OnHabitCreated returns a consumer handler for HabitCreatedType events
+func OnHabitCreated(handle func(ctx context.Context, event *HabitCreated) error) commonevents.Handler {
+	return commonevents.NewTypedHandler(HabitCreatedType, handle)
+}
+
+// ParseHabitCompleted decodes the data of a HabitCompletedType event
+func ParseHabitCompleted(data []byte) (*HabitCompleted, error) {
+	return commonevents.ParseEvent[HabitCompleted](data)
+}
+
+// OnHabitCompleted returns a consumer handler for HabitCompletedType events
+func OnHabitCompleted(handle func(ctx context.Context, event *HabitCompleted) error) commonevents.Handler {
+	return commonevents.NewTypedHandler(HabitCompletedType, handle)
+}
+
+// ParseHabitDeactivated decodes the data of a HabitDeactivatedType event
+func ParseHabitDeactivated(data []byte) (*HabitDeactivated, error) {
+	return commonevents.ParseEvent[HabitDeactivated](data)
+}
+
+// OnHabitDeactivated returns a consumer handler for HabitDeactivatedType events
+func OnHabitDeactivated(handle func(ctx context.Context, event *HabitDeactivated) error) commonevents.Handler {
+	return commonevents.NewTypedHandler(HabitDeactivatedType, handle)
+}
+
+// ParseHabitActivated decodes the data of a HabitActivatedType event
+func ParseHabitActivated(data []byte) (*HabitActivated, error) {
+	return commonevents.ParseEvent[HabitActivated](data)
+}
+
+// OnHabitActivated returns a consumer handler for HabitActivatedType events
+func OnHabitActivated(handle func(ctx context.Context, event *HabitActivated) error) commonevents.Handler {
+	return commonevents.NewTypedHandler(HabitActivatedType, handle)
+}
+
+// ParseHabitPaused decodes the data of a HabitPausedType event
+func ParseHabitPaused(data []byte) (*HabitPaused, error) {
+	return commonevents.ParseEvent[HabitPaused](data)
+}
+
+// OnHabitPaused returns a consumer handler for HabitPausedType events
+func OnHabitPaused(handle func(ctx context.Context, event *HabitPaused) error) commonevents.Handler {
+	return commonevents.NewTypedHandler(HabitPausedType, handle)
+}
+
+// ParseHabitDeleted decodes the data of a HabitDeletedType event
+func ParseHabitDeleted(data []byte) (*HabitDeleted, error) {
+	return commonevents.ParseEvent[HabitDeleted](data)
+}
+
+// OnHabitDeleted returns a consumer handler for HabitDeletedType events
+func OnHabitDeleted(handle func(ctx context.Context, event *HabitDeleted) error) commonevents.Handler {
+	return commonevents.NewTypedHandler(HabitDeletedType, handle)
+}
+
+// ParseHabitLogDeleted decodes the data of a HabitLogDeletedType event
+func ParseHabitLogDeleted(data []byte) (*HabitLogDeleted, error) {
+	return commonevents.ParseEvent[HabitLogDeleted](data)
+}
+
+// OnHabitLogDeleted returns a consumer handler for HabitLogDeletedType events
+func OnHabitLogDeleted(handle func(ctx context.Context, event *HabitLogDeleted) error) commonevents.Handler {
+	return commonevents.NewTypedHandler(HabitLogDeletedType, handle)
+}
+
+// ParseStreakMilestone decodes the data of a StreakMilestoneType event
+func ParseStreakMilestone(data []byte) (*StreakMilestone, error) {
+	return commonevents.ParseEvent[StreakMilestone](data)
+}
+
+// OnStreakMilestone returns a consumer handler for StreakMilestoneType events
+func OnStreakMilestone(handle func(ctx context.Context, event *StreakMilestone) error) commonevents.Handler {
+	return commonevents.NewTypedHandler(StreakMilestoneType, handle)
+}
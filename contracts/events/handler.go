@@ -0,0 +1,52 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Handler processes a specific type of event
+type Handler interface {
+	// Handle processes the event
+	Handle(ctx context.Context, data []byte) error
+	// EventType returns the event type this handler processes
+	EventType() string
+}
+
+// ParseEvent is a helper to unmarshal event data
+func ParseEvent[T any](data []byte) (*T, error) {
+	var event T
+	if err := json.Unmarshal(data, &event); err != nil {
+		return nil, fmt.Errorf("unmarshal event: %w", err)
+	}
+	return &event, nil
+}
+
+// TypedHandler is a Handler that decodes each event into T, the struct the
+// producer published, before handing it on
+type TypedHandler[T any] struct {
+	eventType string
+	handle    func(ctx context.Context, event *T) error
+}
+
+var _ Handler = (*TypedHandler[BaseEvent])(nil)
+
+// NewTypedHandler creates a handler for eventType. Prefer the generated
+// On<Event> constructors in the module subpackages, which pair each event
+// type with its struct.
+func NewTypedHandler[T any](eventType string, handle func(ctx context.Context, event *T) error) *TypedHandler[T] {
+	return &TypedHandler[T]{eventType: eventType, handle: handle}
+}
+
+func (h *TypedHandler[T]) EventType() string {
+	return h.eventType
+}
+
+func (h *TypedHandler[T]) Handle(ctx context.Context, data []byte) error {
+	event, err := ParseEvent[T](data)
+	if err != nil {
+		return err
+	}
+	return h.handle(ctx, event)
+}
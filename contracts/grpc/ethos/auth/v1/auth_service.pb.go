@@ -0,0 +1,268 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: ethos/auth/v1/auth_service.proto
+
+package authv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SuccessResponse for simple success/failure responses.
+type SuccessResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the operation was successful.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Human-readable message describing the result.
+	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuccessResponse) Reset() {
+	*x = SuccessResponse{}
+	mi := &file_ethos_auth_v1_auth_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuccessResponse) ProtoMessage() {}
+
+func (x *SuccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_auth_v1_auth_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuccessResponse.ProtoReflect.Descriptor instead.
+func (*SuccessResponse) Descriptor() ([]byte, []int) {
+	return file_ethos_auth_v1_auth_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SuccessResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SuccessResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_ethos_auth_v1_auth_service_proto protoreflect.FileDescriptor
+
+const file_ethos_auth_v1_auth_service_proto_rawDesc = "" +
+	"\n" +
+	" ethos/auth/v1/auth_service.proto\x12\rethos.auth.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1cethos/auth/v1/messages.proto\"E\n" +
+	"\x0fSuccessResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage2\xe6\x1a\n" +
+	"\vAuthService\x12i\n" +
+	"\bRegister\x12\x1e.ethos.auth.v1.RegisterRequest\x1a\x1f.ethos.auth.v1.RegisterResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/auth/register\x12]\n" +
+	"\x05Login\x12\x1b.ethos.auth.v1.LoginRequest\x1a\x1c.ethos.auth.v1.LoginResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/auth/login\x12s\n" +
+	"\vGoogleLogin\x12!.ethos.auth.v1.GoogleLoginRequest\x1a\".ethos.auth.v1.GoogleLoginResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/auth/google/login\x12y\n" +
+	"\x0eGoogleCallback\x12$.ethos.auth.v1.GoogleCallbackRequest\x1a\x1c.ethos.auth.v1.LoginResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/auth/google/callback\x12g\n" +
+	"\bSSOLogin\x12\x1e.ethos.auth.v1.SSOLoginRequest\x1a\x1f.ethos.auth.v1.SSOLoginResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/auth/sso/login\x12p\n" +
+	"\vSSOCallback\x12!.ethos.auth.v1.SSOCallbackRequest\x1a\x1c.ethos.auth.v1.LoginResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/auth/sso/callback\x12z\n" +
+	"\x10RequestMagicLink\x12&.ethos.auth.v1.RequestMagicLinkRequest\x1a\x1e.ethos.auth.v1.SuccessResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/auth/magic-link\x12z\n" +
+	"\x0fVerifyMagicLink\x12%.ethos.auth.v1.VerifyMagicLinkRequest\x1a\x1c.ethos.auth.v1.LoginResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/auth/magic-link/verify\x12m\n" +
+	"\fRefreshToken\x12\".ethos.auth.v1.RefreshTokenRequest\x1a\x1c.ethos.auth.v1.LoginResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/auth/refresh\x12a\n" +
+	"\x06Logout\x12\x1c.ethos.auth.v1.LogoutRequest\x1a\x1d.ethos.auth.v1.LogoutResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/auth/logout\x12k\n" +
+	"\tLogoutAll\x12\x1f.ethos.auth.v1.LogoutAllRequest\x1a\x1d.ethos.auth.v1.LogoutResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/auth/logout-all\x12r\n" +
+	"\fListSessions\x12\".ethos.auth.v1.ListSessionsRequest\x1a#.ethos.auth.v1.ListSessionsResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/auth/sessions\x12\x82\x01\n" +
+	"\rRevokeSession\x12#.ethos.auth.v1.RevokeSessionRequest\x1a$.ethos.auth.v1.RevokeSessionResponse\"&\x82\xd3\xe4\x93\x02 *\x1e/v1/auth/sessions/{session_id}\x12\x8d\x01\n" +
+	"\x13RevokeOtherSessions\x12).ethos.auth.v1.RevokeOtherSessionsRequest\x1a*.ethos.auth.v1.RevokeOtherSessionsResponse\"\x1f\x82\xd3\xe4\x93\x02\x19*\x17/v1/auth/sessions/other\x12h\n" +
+	"\n" +
+	"GetProfile\x12 .ethos.auth.v1.GetProfileRequest\x1a\x1e.ethos.auth.v1.ProfileResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/auth/profile\x12q\n" +
+	"\rUpdateProfile\x12#.ethos.auth.v1.UpdateProfileRequest\x1a\x1e.ethos.auth.v1.ProfileResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\x1a\x10/v1/auth/profile\x12x\n" +
+	"\x0eGetPreferences\x12$.ethos.auth.v1.GetPreferencesRequest\x1a\".ethos.auth.v1.PreferencesResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/auth/preferences\x12\x81\x01\n" +
+	"\x11UpdatePreferences\x12'.ethos.auth.v1.UpdatePreferencesRequest\x1a\".ethos.auth.v1.PreferencesResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\x1a\x14/v1/auth/preferences\x12n\n" +
+	"\fListConsents\x12\".ethos.auth.v1.ListConsentsRequest\x1a\x1f.ethos.auth.v1.ConsentsResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/auth/consents\x12s\n" +
+	"\rAcceptConsent\x12#.ethos.auth.v1.AcceptConsentRequest\x1a\x1f.ethos.auth.v1.ConsentsResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/auth/consents\x12{\n" +
+	"\x0eChangePassword\x12$.ethos.auth.v1.ChangePasswordRequest\x1a\x1e.ethos.auth.v1.SuccessResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/auth/change-password\x12r\n" +
+	"\vVerifyEmail\x12!.ethos.auth.v1.VerifyEmailRequest\x1a\x1e.ethos.auth.v1.SuccessResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/auth/verify-email\x12\x87\x01\n" +
+	"\x12ResendVerification\x12(.ethos.auth.v1.ResendVerificationRequest\x1a\x1e.ethos.auth.v1.SuccessResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/auth/resend-verification\x12{\n" +
+	"\x0eForgotPassword\x12$.ethos.auth.v1.ForgotPasswordRequest\x1a\x1e.ethos.auth.v1.SuccessResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/auth/forgot-password\x12x\n" +
+	"\rResetPassword\x12#.ethos.auth.v1.ResetPasswordRequest\x1a\x1e.ethos.auth.v1.SuccessResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/auth/reset-password\x12v\n" +
+	"\x0eExportUserData\x12$.ethos.auth.v1.ExportUserDataRequest\x1a%.ethos.auth.v1.ExportUserDataResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/auth/export\x12x\n" +
+	"\rDeleteAccount\x12#.ethos.auth.v1.DeleteAccountRequest\x1a\x1e.ethos.auth.v1.SuccessResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/auth/account/delete\x12|\n" +
+	"\x11DeactivateAccount\x12'.ethos.auth.v1.DeactivateAccountRequest\x1a\x1e.ethos.auth.v1.SuccessResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/auth/deactivate\x12`\n" +
+	"\x0fIntrospectToken\x12%.ethos.auth.v1.IntrospectTokenRequest\x1a&.ethos.auth.v1.IntrospectTokenResponseB\xbd\x01\n" +
+	"\x11com.ethos.auth.v1B\x10AuthServiceProtoP\x01Z@github.com/semmidev/ethos-go/contracts/grpc/ethos/auth/v1;authv1\xa2\x02\x03EAX\xaa\x02\rEthos.Auth.V1\xca\x02\rEthos\\Auth\\V1\xe2\x02\x19Ethos\\Auth\\V1\\GPBMetadata\xea\x02\x0fEthos::Auth::V1b\x06proto3"
+
+var (
+	file_ethos_auth_v1_auth_service_proto_rawDescOnce sync.Once
+	file_ethos_auth_v1_auth_service_proto_rawDescData []byte
+)
+
+func file_ethos_auth_v1_auth_service_proto_rawDescGZIP() []byte {
+	file_ethos_auth_v1_auth_service_proto_rawDescOnce.Do(func() {
+		file_ethos_auth_v1_auth_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ethos_auth_v1_auth_service_proto_rawDesc), len(file_ethos_auth_v1_auth_service_proto_rawDesc)))
+	})
+	return file_ethos_auth_v1_auth_service_proto_rawDescData
+}
+
+var file_ethos_auth_v1_auth_service_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_ethos_auth_v1_auth_service_proto_goTypes = []any{
+	(*SuccessResponse)(nil),             // 0: ethos.auth.v1.SuccessResponse
+	(*RegisterRequest)(nil),             // 1: ethos.auth.v1.RegisterRequest
+	(*LoginRequest)(nil),                // 2: ethos.auth.v1.LoginRequest
+	(*GoogleLoginRequest)(nil),          // 3: ethos.auth.v1.GoogleLoginRequest
+	(*GoogleCallbackRequest)(nil),       // 4: ethos.auth.v1.GoogleCallbackRequest
+	(*SSOLoginRequest)(nil),             // 5: ethos.auth.v1.SSOLoginRequest
+	(*SSOCallbackRequest)(nil),          // 6: ethos.auth.v1.SSOCallbackRequest
+	(*RequestMagicLinkRequest)(nil),     // 7: ethos.auth.v1.RequestMagicLinkRequest
+	(*VerifyMagicLinkRequest)(nil),      // 8: ethos.auth.v1.VerifyMagicLinkRequest
+	(*RefreshTokenRequest)(nil),         // 9: ethos.auth.v1.RefreshTokenRequest
+	(*LogoutRequest)(nil),               // 10: ethos.auth.v1.LogoutRequest
+	(*LogoutAllRequest)(nil),            // 11: ethos.auth.v1.LogoutAllRequest
+	(*ListSessionsRequest)(nil),         // 12: ethos.auth.v1.ListSessionsRequest
+	(*RevokeSessionRequest)(nil),        // 13: ethos.auth.v1.RevokeSessionRequest
+	(*RevokeOtherSessionsRequest)(nil),  // 14: ethos.auth.v1.RevokeOtherSessionsRequest
+	(*GetProfileRequest)(nil),           // 15: ethos.auth.v1.GetProfileRequest
+	(*UpdateProfileRequest)(nil),        // 16: ethos.auth.v1.UpdateProfileRequest
+	(*GetPreferencesRequest)(nil),       // 17: ethos.auth.v1.GetPreferencesRequest
+	(*UpdatePreferencesRequest)(nil),    // 18: ethos.auth.v1.UpdatePreferencesRequest
+	(*ListConsentsRequest)(nil),         // 19: ethos.auth.v1.ListConsentsRequest
+	(*AcceptConsentRequest)(nil),        // 20: ethos.auth.v1.AcceptConsentRequest
+	(*ChangePasswordRequest)(nil),       // 21: ethos.auth.v1.ChangePasswordRequest
+	(*VerifyEmailRequest)(nil),          // 22: ethos.auth.v1.VerifyEmailRequest
+	(*ResendVerificationRequest)(nil),   // 23: ethos.auth.v1.ResendVerificationRequest
+	(*ForgotPasswordRequest)(nil),       // 24: ethos.auth.v1.ForgotPasswordRequest
+	(*ResetPasswordRequest)(nil),        // 25: ethos.auth.v1.ResetPasswordRequest
+	(*ExportUserDataRequest)(nil),       // 26: ethos.auth.v1.ExportUserDataRequest
+	(*DeleteAccountRequest)(nil),        // 27: ethos.auth.v1.DeleteAccountRequest
+	(*DeactivateAccountRequest)(nil),    // 28: ethos.auth.v1.DeactivateAccountRequest
+	(*IntrospectTokenRequest)(nil),      // 29: ethos.auth.v1.IntrospectTokenRequest
+	(*RegisterResponse)(nil),            // 30: ethos.auth.v1.RegisterResponse
+	(*LoginResponse)(nil),               // 31: ethos.auth.v1.LoginResponse
+	(*GoogleLoginResponse)(nil),         // 32: ethos.auth.v1.GoogleLoginResponse
+	(*SSOLoginResponse)(nil),            // 33: ethos.auth.v1.SSOLoginResponse
+	(*LogoutResponse)(nil),              // 34: ethos.auth.v1.LogoutResponse
+	(*ListSessionsResponse)(nil),        // 35: ethos.auth.v1.ListSessionsResponse
+	(*RevokeSessionResponse)(nil),       // 36: ethos.auth.v1.RevokeSessionResponse
+	(*RevokeOtherSessionsResponse)(nil), // 37: ethos.auth.v1.RevokeOtherSessionsResponse
+	(*ProfileResponse)(nil),             // 38: ethos.auth.v1.ProfileResponse
+	(*PreferencesResponse)(nil),         // 39: ethos.auth.v1.PreferencesResponse
+	(*ConsentsResponse)(nil),            // 40: ethos.auth.v1.ConsentsResponse
+	(*ExportUserDataResponse)(nil),      // 41: ethos.auth.v1.ExportUserDataResponse
+	(*IntrospectTokenResponse)(nil),     // 42: ethos.auth.v1.IntrospectTokenResponse
+}
+var file_ethos_auth_v1_auth_service_proto_depIdxs = []int32{
+	1,  // 0: ethos.auth.v1.AuthService.Register:input_type -> ethos.auth.v1.RegisterRequest
+	2,  // 1: ethos.auth.v1.AuthService.Login:input_type -> ethos.auth.v1.LoginRequest
+	3,  // 2: ethos.auth.v1.AuthService.GoogleLogin:input_type -> ethos.auth.v1.GoogleLoginRequest
+	4,  // 3: ethos.auth.v1.AuthService.GoogleCallback:input_type -> ethos.auth.v1.GoogleCallbackRequest
+	5,  // 4: ethos.auth.v1.AuthService.SSOLogin:input_type -> ethos.auth.v1.SSOLoginRequest
+	6,  // 5: ethos.auth.v1.AuthService.SSOCallback:input_type -> ethos.auth.v1.SSOCallbackRequest
+	7,  // 6: ethos.auth.v1.AuthService.RequestMagicLink:input_type -> ethos.auth.v1.RequestMagicLinkRequest
+	8,  // 7: ethos.auth.v1.AuthService.VerifyMagicLink:input_type -> ethos.auth.v1.VerifyMagicLinkRequest
+	9,  // 8: ethos.auth.v1.AuthService.RefreshToken:input_type -> ethos.auth.v1.RefreshTokenRequest
+	10, // 9: ethos.auth.v1.AuthService.Logout:input_type -> ethos.auth.v1.LogoutRequest
+	11, // 10: ethos.auth.v1.AuthService.LogoutAll:input_type -> ethos.auth.v1.LogoutAllRequest
+	12, // 11: ethos.auth.v1.AuthService.ListSessions:input_type -> ethos.auth.v1.ListSessionsRequest
+	13, // 12: ethos.auth.v1.AuthService.RevokeSession:input_type -> ethos.auth.v1.RevokeSessionRequest
+	14, // 13: ethos.auth.v1.AuthService.RevokeOtherSessions:input_type -> ethos.auth.v1.RevokeOtherSessionsRequest
+	15, // 14: ethos.auth.v1.AuthService.GetProfile:input_type -> ethos.auth.v1.GetProfileRequest
+	16, // 15: ethos.auth.v1.AuthService.UpdateProfile:input_type -> ethos.auth.v1.UpdateProfileRequest
+	17, // 16: ethos.auth.v1.AuthService.GetPreferences:input_type -> ethos.auth.v1.GetPreferencesRequest
+	18, // 17: ethos.auth.v1.AuthService.UpdatePreferences:input_type -> ethos.auth.v1.UpdatePreferencesRequest
+	19, // 18: ethos.auth.v1.AuthService.ListConsents:input_type -> ethos.auth.v1.ListConsentsRequest
+	20, // 19: ethos.auth.v1.AuthService.AcceptConsent:input_type -> ethos.auth.v1.AcceptConsentRequest
+	21, // 20: ethos.auth.v1.AuthService.ChangePassword:input_type -> ethos.auth.v1.ChangePasswordRequest
+	22, // 21: ethos.auth.v1.AuthService.VerifyEmail:input_type -> ethos.auth.v1.VerifyEmailRequest
+	23, // 22: ethos.auth.v1.AuthService.ResendVerification:input_type -> ethos.auth.v1.ResendVerificationRequest
+	24, // 23: ethos.auth.v1.AuthService.ForgotPassword:input_type -> ethos.auth.v1.ForgotPasswordRequest
+	25, // 24: ethos.auth.v1.AuthService.ResetPassword:input_type -> ethos.auth.v1.ResetPasswordRequest
+	26, // 25: ethos.auth.v1.AuthService.ExportUserData:input_type -> ethos.auth.v1.ExportUserDataRequest
+	27, // 26: ethos.auth.v1.AuthService.DeleteAccount:input_type -> ethos.auth.v1.DeleteAccountRequest
+	28, // 27: ethos.auth.v1.AuthService.DeactivateAccount:input_type -> ethos.auth.v1.DeactivateAccountRequest
+	29, // 28: ethos.auth.v1.AuthService.IntrospectToken:input_type -> ethos.auth.v1.IntrospectTokenRequest
+	30, // 29: ethos.auth.v1.AuthService.Register:output_type -> ethos.auth.v1.RegisterResponse
+	31, // 30: ethos.auth.v1.AuthService.Login:output_type -> ethos.auth.v1.LoginResponse
+	32, // 31: ethos.auth.v1.AuthService.GoogleLogin:output_type -> ethos.auth.v1.GoogleLoginResponse
+	31, // 32: ethos.auth.v1.AuthService.GoogleCallback:output_type -> ethos.auth.v1.LoginResponse
+	33, // 33: ethos.auth.v1.AuthService.SSOLogin:output_type -> ethos.auth.v1.SSOLoginResponse
+	31, // 34: ethos.auth.v1.AuthService.SSOCallback:output_type -> ethos.auth.v1.LoginResponse
+	0,  // 35: ethos.auth.v1.AuthService.RequestMagicLink:output_type -> ethos.auth.v1.SuccessResponse
+	31, // 36: ethos.auth.v1.AuthService.VerifyMagicLink:output_type -> ethos.auth.v1.LoginResponse
+	31, // 37: ethos.auth.v1.AuthService.RefreshToken:output_type -> ethos.auth.v1.LoginResponse
+	34, // 38: ethos.auth.v1.AuthService.Logout:output_type -> ethos.auth.v1.LogoutResponse
+	34, // 39: ethos.auth.v1.AuthService.LogoutAll:output_type -> ethos.auth.v1.LogoutResponse
+	35, // 40: ethos.auth.v1.AuthService.ListSessions:output_type -> ethos.auth.v1.ListSessionsResponse
+	36, // 41: ethos.auth.v1.AuthService.RevokeSession:output_type -> ethos.auth.v1.RevokeSessionResponse
+	37, // 42: ethos.auth.v1.AuthService.RevokeOtherSessions:output_type -> ethos.auth.v1.RevokeOtherSessionsResponse
+	38, // 43: ethos.auth.v1.AuthService.GetProfile:output_type -> ethos.auth.v1.ProfileResponse
+	38, // 44: ethos.auth.v1.AuthService.UpdateProfile:output_type -> ethos.auth.v1.ProfileResponse
+	39, // 45: ethos.auth.v1.AuthService.GetPreferences:output_type -> ethos.auth.v1.PreferencesResponse
+	39, // 46: ethos.auth.v1.AuthService.UpdatePreferences:output_type -> ethos.auth.v1.PreferencesResponse
+	40, // 47: ethos.auth.v1.AuthService.ListConsents:output_type -> ethos.auth.v1.ConsentsResponse
+	40, // 48: ethos.auth.v1.AuthService.AcceptConsent:output_type -> ethos.auth.v1.ConsentsResponse
+	0,  // 49: ethos.auth.v1.AuthService.ChangePassword:output_type -> ethos.auth.v1.SuccessResponse
+	0,  // 50: ethos.auth.v1.AuthService.VerifyEmail:output_type -> ethos.auth.v1.SuccessResponse
+	0,  // 51: ethos.auth.v1.AuthService.ResendVerification:output_type -> ethos.auth.v1.SuccessResponse
+	0,  // 52: ethos.auth.v1.AuthService.ForgotPassword:output_type -> ethos.auth.v1.SuccessResponse
+	0,  // 53: ethos.auth.v1.AuthService.ResetPassword:output_type -> ethos.auth.v1.SuccessResponse
+	41, // 54: ethos.auth.v1.AuthService.ExportUserData:output_type -> ethos.auth.v1.ExportUserDataResponse
+	0,  // 55: ethos.auth.v1.AuthService.DeleteAccount:output_type -> ethos.auth.v1.SuccessResponse
+	0,  // 56: ethos.auth.v1.AuthService.DeactivateAccount:output_type -> ethos.auth.v1.SuccessResponse
+	42, // 57: ethos.auth.v1.AuthService.IntrospectToken:output_type -> ethos.auth.v1.IntrospectTokenResponse
+	29, // [29:58] is the sub-list for method output_type
+	0,  // [0:29] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_ethos_auth_v1_auth_service_proto_init() }
+func file_ethos_auth_v1_auth_service_proto_init() {
+	if File_ethos_auth_v1_auth_service_proto != nil {
+		return
+	}
+	file_ethos_auth_v1_messages_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ethos_auth_v1_auth_service_proto_rawDesc), len(file_ethos_auth_v1_auth_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ethos_auth_v1_auth_service_proto_goTypes,
+		DependencyIndexes: file_ethos_auth_v1_auth_service_proto_depIdxs,
+		MessageInfos:      file_ethos_auth_v1_auth_service_proto_msgTypes,
+	}.Build()
+	File_ethos_auth_v1_auth_service_proto = out.File
+	file_ethos_auth_v1_auth_service_proto_goTypes = nil
+	file_ethos_auth_v1_auth_service_proto_depIdxs = nil
+}
@@ -23,12 +23,22 @@ const (
 	AuthService_Login_FullMethodName               = "/ethos.auth.v1.AuthService/Login"
 	AuthService_GoogleLogin_FullMethodName         = "/ethos.auth.v1.AuthService/GoogleLogin"
 	AuthService_GoogleCallback_FullMethodName      = "/ethos.auth.v1.AuthService/GoogleCallback"
+	AuthService_SSOLogin_FullMethodName            = "/ethos.auth.v1.AuthService/SSOLogin"
+	AuthService_SSOCallback_FullMethodName         = "/ethos.auth.v1.AuthService/SSOCallback"
+	AuthService_RequestMagicLink_FullMethodName    = "/ethos.auth.v1.AuthService/RequestMagicLink"
+	AuthService_VerifyMagicLink_FullMethodName     = "/ethos.auth.v1.AuthService/VerifyMagicLink"
+	AuthService_RefreshToken_FullMethodName        = "/ethos.auth.v1.AuthService/RefreshToken"
 	AuthService_Logout_FullMethodName              = "/ethos.auth.v1.AuthService/Logout"
 	AuthService_LogoutAll_FullMethodName           = "/ethos.auth.v1.AuthService/LogoutAll"
 	AuthService_ListSessions_FullMethodName        = "/ethos.auth.v1.AuthService/ListSessions"
+	AuthService_RevokeSession_FullMethodName       = "/ethos.auth.v1.AuthService/RevokeSession"
 	AuthService_RevokeOtherSessions_FullMethodName = "/ethos.auth.v1.AuthService/RevokeOtherSessions"
 	AuthService_GetProfile_FullMethodName          = "/ethos.auth.v1.AuthService/GetProfile"
 	AuthService_UpdateProfile_FullMethodName       = "/ethos.auth.v1.AuthService/UpdateProfile"
+	AuthService_GetPreferences_FullMethodName      = "/ethos.auth.v1.AuthService/GetPreferences"
+	AuthService_UpdatePreferences_FullMethodName   = "/ethos.auth.v1.AuthService/UpdatePreferences"
+	AuthService_ListConsents_FullMethodName        = "/ethos.auth.v1.AuthService/ListConsents"
+	AuthService_AcceptConsent_FullMethodName       = "/ethos.auth.v1.AuthService/AcceptConsent"
 	AuthService_ChangePassword_FullMethodName      = "/ethos.auth.v1.AuthService/ChangePassword"
 	AuthService_VerifyEmail_FullMethodName         = "/ethos.auth.v1.AuthService/VerifyEmail"
 	AuthService_ResendVerification_FullMethodName  = "/ethos.auth.v1.AuthService/ResendVerification"
@@ -36,6 +46,8 @@ const (
 	AuthService_ResetPassword_FullMethodName       = "/ethos.auth.v1.AuthService/ResetPassword"
 	AuthService_ExportUserData_FullMethodName      = "/ethos.auth.v1.AuthService/ExportUserData"
 	AuthService_DeleteAccount_FullMethodName       = "/ethos.auth.v1.AuthService/DeleteAccount"
+	AuthService_DeactivateAccount_FullMethodName   = "/ethos.auth.v1.AuthService/DeactivateAccount"
+	AuthService_IntrospectToken_FullMethodName     = "/ethos.auth.v1.AuthService/IntrospectToken"
 )
 
 // AuthServiceClient is the client API for AuthService service.
@@ -52,18 +64,52 @@ type AuthServiceClient interface {
 	GoogleLogin(ctx context.Context, in *GoogleLoginRequest, opts ...grpc.CallOption) (*GoogleLoginResponse, error)
 	// GoogleCallback handles the Google OAuth callback.
 	GoogleCallback(ctx context.Context, in *GoogleCallbackRequest, opts ...grpc.CallOption) (*LoginResponse, error)
-	// Logout terminates the specified session.
+	// SSOLogin returns the identity provider's login URL and the state the
+	// client must match on the callback and send back with it.
+	SSOLogin(ctx context.Context, in *SSOLoginRequest, opts ...grpc.CallOption) (*SSOLoginResponse, error)
+	// SSOCallback exchanges the identity provider's code for a session,
+	// provisioning the account on first sign-in. The state must be one handed
+	// out by SSOLogin in the last ten minutes and not used before.
+	SSOCallback(ctx context.Context, in *SSOCallbackRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	// RequestMagicLink emails a single-use login link. It succeeds for unknown
+	// addresses too, so it cannot be used to find accounts.
+	RequestMagicLink(ctx context.Context, in *RequestMagicLinkRequest, opts ...grpc.CallOption) (*SuccessResponse, error)
+	// VerifyMagicLink signs in with the token from a login link, passed as
+	// the token query parameter.
+	VerifyMagicLink(ctx context.Context, in *VerifyMagicLinkRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	// RefreshToken trades a refresh token for a new access token. The refresh
+	// token is rotated: the one sent stops working and a new one is returned.
+	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	// Logout terminates the session of the access token used to call it.
 	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error)
-	// LogoutAll terminates all sessions for a user.
+	// LogoutAll terminates all sessions of the authenticated user.
 	LogoutAll(ctx context.Context, in *LogoutAllRequest, opts ...grpc.CallOption) (*LogoutResponse, error)
 	// ListSessions returns all sessions for the authenticated user.
 	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error)
+	// RevokeSession blocks one of the authenticated user's sessions, e.g. a
+	// lost device. Its refresh token stops working immediately.
+	// Declared before RevokeOtherSessions: the gateway tries routes registered
+	// later first, so the literal "/sessions/other" must be registered after
+	// "/sessions/{session_id}".
+	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error)
 	// RevokeOtherSessions revokes all sessions except the current one.
 	RevokeOtherSessions(ctx context.Context, in *RevokeOtherSessionsRequest, opts ...grpc.CallOption) (*RevokeOtherSessionsResponse, error)
 	// GetProfile retrieves the current user's profile.
 	GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*ProfileResponse, error)
 	// UpdateProfile updates the current user's profile.
 	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*ProfileResponse, error)
+	// GetPreferences retrieves the current user's preferences.
+	GetPreferences(ctx context.Context, in *GetPreferencesRequest, opts ...grpc.CallOption) (*PreferencesResponse, error)
+	// UpdatePreferences changes the given preferences and keeps the others.
+	UpdatePreferences(ctx context.Context, in *UpdatePreferencesRequest, opts ...grpc.CallOption) (*PreferencesResponse, error)
+	// ListConsents lists the current version of the terms of service and
+	// privacy policy, whether the user accepted them, and every version the
+	// user accepted.
+	ListConsents(ctx context.Context, in *ListConsentsRequest, opts ...grpc.CallOption) (*ConsentsResponse, error)
+	// AcceptConsent records that the user accepted the current version of the
+	// terms of service or privacy policy. Until every current version is
+	// accepted, other calls fail with AUTH_CONSENT_REQUIRED.
+	AcceptConsent(ctx context.Context, in *AcceptConsentRequest, opts ...grpc.CallOption) (*ConsentsResponse, error)
 	// ChangePassword changes the user's password.
 	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*SuccessResponse, error)
 	// VerifyEmail verifies the user's email address.
@@ -79,6 +125,15 @@ type AuthServiceClient interface {
 	// DeleteAccount permanently deletes the user account.
 	// Uses POST instead of DELETE to support request body with password confirmation.
 	DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*SuccessResponse, error)
+	// DeactivateAccount switches the account off and signs out every session.
+	// Data is kept and reminders stop; logging in again with reactivate set
+	// switches it back on, unless the identity provider has since deactivated
+	// it through provisioning.
+	DeactivateAccount(ctx context.Context, in *DeactivateAccountRequest, opts ...grpc.CallOption) (*SuccessResponse, error)
+	// IntrospectToken reports whether an access token is currently valid.
+	// Intended for sibling services over gRPC; it is not exposed through the
+	// HTTP gateway.
+	IntrospectToken(ctx context.Context, in *IntrospectTokenRequest, opts ...grpc.CallOption) (*IntrospectTokenResponse, error)
 }
 
 type authServiceClient struct {
@@ -129,6 +184,56 @@ func (c *authServiceClient) GoogleCallback(ctx context.Context, in *GoogleCallba
 	return out, nil
 }
 
+func (c *authServiceClient) SSOLogin(ctx context.Context, in *SSOLoginRequest, opts ...grpc.CallOption) (*SSOLoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SSOLoginResponse)
+	err := c.cc.Invoke(ctx, AuthService_SSOLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) SSOCallback(ctx context.Context, in *SSOCallbackRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, AuthService_SSOCallback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RequestMagicLink(ctx context.Context, in *RequestMagicLinkRequest, opts ...grpc.CallOption) (*SuccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuccessResponse)
+	err := c.cc.Invoke(ctx, AuthService_RequestMagicLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) VerifyMagicLink(ctx context.Context, in *VerifyMagicLinkRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, AuthService_VerifyMagicLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, AuthService_RefreshToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *authServiceClient) Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(LogoutResponse)
@@ -159,6 +264,16 @@ func (c *authServiceClient) ListSessions(ctx context.Context, in *ListSessionsRe
 	return out, nil
 }
 
+func (c *authServiceClient) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeSessionResponse)
+	err := c.cc.Invoke(ctx, AuthService_RevokeSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *authServiceClient) RevokeOtherSessions(ctx context.Context, in *RevokeOtherSessionsRequest, opts ...grpc.CallOption) (*RevokeOtherSessionsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RevokeOtherSessionsResponse)
@@ -189,6 +304,46 @@ func (c *authServiceClient) UpdateProfile(ctx context.Context, in *UpdateProfile
 	return out, nil
 }
 
+func (c *authServiceClient) GetPreferences(ctx context.Context, in *GetPreferencesRequest, opts ...grpc.CallOption) (*PreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreferencesResponse)
+	err := c.cc.Invoke(ctx, AuthService_GetPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) UpdatePreferences(ctx context.Context, in *UpdatePreferencesRequest, opts ...grpc.CallOption) (*PreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreferencesResponse)
+	err := c.cc.Invoke(ctx, AuthService_UpdatePreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ListConsents(ctx context.Context, in *ListConsentsRequest, opts ...grpc.CallOption) (*ConsentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConsentsResponse)
+	err := c.cc.Invoke(ctx, AuthService_ListConsents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) AcceptConsent(ctx context.Context, in *AcceptConsentRequest, opts ...grpc.CallOption) (*ConsentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConsentsResponse)
+	err := c.cc.Invoke(ctx, AuthService_AcceptConsent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *authServiceClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*SuccessResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SuccessResponse)
@@ -259,6 +414,26 @@ func (c *authServiceClient) DeleteAccount(ctx context.Context, in *DeleteAccount
 	return out, nil
 }
 
+func (c *authServiceClient) DeactivateAccount(ctx context.Context, in *DeactivateAccountRequest, opts ...grpc.CallOption) (*SuccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuccessResponse)
+	err := c.cc.Invoke(ctx, AuthService_DeactivateAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) IntrospectToken(ctx context.Context, in *IntrospectTokenRequest, opts ...grpc.CallOption) (*IntrospectTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IntrospectTokenResponse)
+	err := c.cc.Invoke(ctx, AuthService_IntrospectToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthServiceServer is the server API for AuthService service.
 // All implementations must embed UnimplementedAuthServiceServer
 // for forward compatibility.
@@ -273,18 +448,52 @@ type AuthServiceServer interface {
 	GoogleLogin(context.Context, *GoogleLoginRequest) (*GoogleLoginResponse, error)
 	// GoogleCallback handles the Google OAuth callback.
 	GoogleCallback(context.Context, *GoogleCallbackRequest) (*LoginResponse, error)
-	// Logout terminates the specified session.
+	// SSOLogin returns the identity provider's login URL and the state the
+	// client must match on the callback and send back with it.
+	SSOLogin(context.Context, *SSOLoginRequest) (*SSOLoginResponse, error)
+	// SSOCallback exchanges the identity provider's code for a session,
+	// provisioning the account on first sign-in. The state must be one handed
+	// out by SSOLogin in the last ten minutes and not used before.
+	SSOCallback(context.Context, *SSOCallbackRequest) (*LoginResponse, error)
+	// RequestMagicLink emails a single-use login link. It succeeds for unknown
+	// addresses too, so it cannot be used to find accounts.
+	RequestMagicLink(context.Context, *RequestMagicLinkRequest) (*SuccessResponse, error)
+	// VerifyMagicLink signs in with the token from a login link, passed as
+	// the token query parameter.
+	VerifyMagicLink(context.Context, *VerifyMagicLinkRequest) (*LoginResponse, error)
+	// RefreshToken trades a refresh token for a new access token. The refresh
+	// token is rotated: the one sent stops working and a new one is returned.
+	RefreshToken(context.Context, *RefreshTokenRequest) (*LoginResponse, error)
+	// Logout terminates the session of the access token used to call it.
 	Logout(context.Context, *LogoutRequest) (*LogoutResponse, error)
-	// LogoutAll terminates all sessions for a user.
+	// LogoutAll terminates all sessions of the authenticated user.
 	LogoutAll(context.Context, *LogoutAllRequest) (*LogoutResponse, error)
 	// ListSessions returns all sessions for the authenticated user.
 	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
+	// RevokeSession blocks one of the authenticated user's sessions, e.g. a
+	// lost device. Its refresh token stops working immediately.
+	// Declared before RevokeOtherSessions: the gateway tries routes registered
+	// later first, so the literal "/sessions/other" must be registered after
+	// "/sessions/{session_id}".
+	RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error)
 	// RevokeOtherSessions revokes all sessions except the current one.
 	RevokeOtherSessions(context.Context, *RevokeOtherSessionsRequest) (*RevokeOtherSessionsResponse, error)
 	// GetProfile retrieves the current user's profile.
 	GetProfile(context.Context, *GetProfileRequest) (*ProfileResponse, error)
 	// UpdateProfile updates the current user's profile.
 	UpdateProfile(context.Context, *UpdateProfileRequest) (*ProfileResponse, error)
+	// GetPreferences retrieves the current user's preferences.
+	GetPreferences(context.Context, *GetPreferencesRequest) (*PreferencesResponse, error)
+	// UpdatePreferences changes the given preferences and keeps the others.
+	UpdatePreferences(context.Context, *UpdatePreferencesRequest) (*PreferencesResponse, error)
+	// ListConsents lists the current version of the terms of service and
+	// privacy policy, whether the user accepted them, and every version the
+	// user accepted.
+	ListConsents(context.Context, *ListConsentsRequest) (*ConsentsResponse, error)
+	// AcceptConsent records that the user accepted the current version of the
+	// terms of service or privacy policy. Until every current version is
+	// accepted, other calls fail with AUTH_CONSENT_REQUIRED.
+	AcceptConsent(context.Context, *AcceptConsentRequest) (*ConsentsResponse, error)
 	// ChangePassword changes the user's password.
 	ChangePassword(context.Context, *ChangePasswordRequest) (*SuccessResponse, error)
 	// VerifyEmail verifies the user's email address.
@@ -300,6 +509,15 @@ type AuthServiceServer interface {
 	// DeleteAccount permanently deletes the user account.
 	// Uses POST instead of DELETE to support request body with password confirmation.
 	DeleteAccount(context.Context, *DeleteAccountRequest) (*SuccessResponse, error)
+	// DeactivateAccount switches the account off and signs out every session.
+	// Data is kept and reminders stop; logging in again with reactivate set
+	// switches it back on, unless the identity provider has since deactivated
+	// it through provisioning.
+	DeactivateAccount(context.Context, *DeactivateAccountRequest) (*SuccessResponse, error)
+	// IntrospectToken reports whether an access token is currently valid.
+	// Intended for sibling services over gRPC; it is not exposed through the
+	// HTTP gateway.
+	IntrospectToken(context.Context, *IntrospectTokenRequest) (*IntrospectTokenResponse, error)
 	mustEmbedUnimplementedAuthServiceServer()
 }
 
@@ -322,6 +540,21 @@ func (UnimplementedAuthServiceServer) GoogleLogin(context.Context, *GoogleLoginR
 func (UnimplementedAuthServiceServer) GoogleCallback(context.Context, *GoogleCallbackRequest) (*LoginResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GoogleCallback not implemented")
 }
+func (UnimplementedAuthServiceServer) SSOLogin(context.Context, *SSOLoginRequest) (*SSOLoginResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SSOLogin not implemented")
+}
+func (UnimplementedAuthServiceServer) SSOCallback(context.Context, *SSOCallbackRequest) (*LoginResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SSOCallback not implemented")
+}
+func (UnimplementedAuthServiceServer) RequestMagicLink(context.Context, *RequestMagicLinkRequest) (*SuccessResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RequestMagicLink not implemented")
+}
+func (UnimplementedAuthServiceServer) VerifyMagicLink(context.Context, *VerifyMagicLinkRequest) (*LoginResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method VerifyMagicLink not implemented")
+}
+func (UnimplementedAuthServiceServer) RefreshToken(context.Context, *RefreshTokenRequest) (*LoginResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RefreshToken not implemented")
+}
 func (UnimplementedAuthServiceServer) Logout(context.Context, *LogoutRequest) (*LogoutResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method Logout not implemented")
 }
@@ -331,6 +564,9 @@ func (UnimplementedAuthServiceServer) LogoutAll(context.Context, *LogoutAllReque
 func (UnimplementedAuthServiceServer) ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListSessions not implemented")
 }
+func (UnimplementedAuthServiceServer) RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeSession not implemented")
+}
 func (UnimplementedAuthServiceServer) RevokeOtherSessions(context.Context, *RevokeOtherSessionsRequest) (*RevokeOtherSessionsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method RevokeOtherSessions not implemented")
 }
@@ -340,6 +576,18 @@ func (UnimplementedAuthServiceServer) GetProfile(context.Context, *GetProfileReq
 func (UnimplementedAuthServiceServer) UpdateProfile(context.Context, *UpdateProfileRequest) (*ProfileResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateProfile not implemented")
 }
+func (UnimplementedAuthServiceServer) GetPreferences(context.Context, *GetPreferencesRequest) (*PreferencesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPreferences not implemented")
+}
+func (UnimplementedAuthServiceServer) UpdatePreferences(context.Context, *UpdatePreferencesRequest) (*PreferencesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdatePreferences not implemented")
+}
+func (UnimplementedAuthServiceServer) ListConsents(context.Context, *ListConsentsRequest) (*ConsentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListConsents not implemented")
+}
+func (UnimplementedAuthServiceServer) AcceptConsent(context.Context, *AcceptConsentRequest) (*ConsentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AcceptConsent not implemented")
+}
 func (UnimplementedAuthServiceServer) ChangePassword(context.Context, *ChangePasswordRequest) (*SuccessResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ChangePassword not implemented")
 }
@@ -361,6 +609,12 @@ func (UnimplementedAuthServiceServer) ExportUserData(context.Context, *ExportUse
 func (UnimplementedAuthServiceServer) DeleteAccount(context.Context, *DeleteAccountRequest) (*SuccessResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteAccount not implemented")
 }
+func (UnimplementedAuthServiceServer) DeactivateAccount(context.Context, *DeactivateAccountRequest) (*SuccessResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeactivateAccount not implemented")
+}
+func (UnimplementedAuthServiceServer) IntrospectToken(context.Context, *IntrospectTokenRequest) (*IntrospectTokenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method IntrospectToken not implemented")
+}
 func (UnimplementedAuthServiceServer) mustEmbedUnimplementedAuthServiceServer() {}
 func (UnimplementedAuthServiceServer) testEmbeddedByValue()                     {}
 
@@ -454,6 +708,96 @@ func _AuthService_GoogleCallback_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_SSOLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSOLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).SSOLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_SSOLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).SSOLogin(ctx, req.(*SSOLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_SSOCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSOCallbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).SSOCallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_SSOCallback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).SSOCallback(ctx, req.(*SSOCallbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RequestMagicLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestMagicLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RequestMagicLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RequestMagicLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RequestMagicLink(ctx, req.(*RequestMagicLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_VerifyMagicLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyMagicLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).VerifyMagicLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_VerifyMagicLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).VerifyMagicLink(ctx, req.(*VerifyMagicLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RefreshToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AuthService_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LogoutRequest)
 	if err := dec(in); err != nil {
@@ -508,6 +852,24 @@ func _AuthService_ListSessions_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RevokeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RevokeSession(ctx, req.(*RevokeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AuthService_RevokeOtherSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RevokeOtherSessionsRequest)
 	if err := dec(in); err != nil {
@@ -562,6 +924,78 @@ func _AuthService_UpdateProfile_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_GetPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetPreferences(ctx, req.(*GetPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_UpdatePreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).UpdatePreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_UpdatePreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).UpdatePreferences(ctx, req.(*UpdatePreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ListConsents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConsentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ListConsents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ListConsents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ListConsents(ctx, req.(*ListConsentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_AcceptConsent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptConsentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).AcceptConsent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_AcceptConsent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).AcceptConsent(ctx, req.(*AcceptConsentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AuthService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ChangePasswordRequest)
 	if err := dec(in); err != nil {
@@ -688,6 +1122,42 @@ func _AuthService_DeleteAccount_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_DeactivateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeactivateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).DeactivateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_DeactivateAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).DeactivateAccount(ctx, req.(*DeactivateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_IntrospectToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntrospectTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).IntrospectToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_IntrospectToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).IntrospectToken(ctx, req.(*IntrospectTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuthService_ServiceDesc is the grpc.ServiceDesc for AuthService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -711,6 +1181,26 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GoogleCallback",
 			Handler:    _AuthService_GoogleCallback_Handler,
 		},
+		{
+			MethodName: "SSOLogin",
+			Handler:    _AuthService_SSOLogin_Handler,
+		},
+		{
+			MethodName: "SSOCallback",
+			Handler:    _AuthService_SSOCallback_Handler,
+		},
+		{
+			MethodName: "RequestMagicLink",
+			Handler:    _AuthService_RequestMagicLink_Handler,
+		},
+		{
+			MethodName: "VerifyMagicLink",
+			Handler:    _AuthService_VerifyMagicLink_Handler,
+		},
+		{
+			MethodName: "RefreshToken",
+			Handler:    _AuthService_RefreshToken_Handler,
+		},
 		{
 			MethodName: "Logout",
 			Handler:    _AuthService_Logout_Handler,
@@ -723,6 +1213,10 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListSessions",
 			Handler:    _AuthService_ListSessions_Handler,
 		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _AuthService_RevokeSession_Handler,
+		},
 		{
 			MethodName: "RevokeOtherSessions",
 			Handler:    _AuthService_RevokeOtherSessions_Handler,
@@ -735,6 +1229,22 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateProfile",
 			Handler:    _AuthService_UpdateProfile_Handler,
 		},
+		{
+			MethodName: "GetPreferences",
+			Handler:    _AuthService_GetPreferences_Handler,
+		},
+		{
+			MethodName: "UpdatePreferences",
+			Handler:    _AuthService_UpdatePreferences_Handler,
+		},
+		{
+			MethodName: "ListConsents",
+			Handler:    _AuthService_ListConsents_Handler,
+		},
+		{
+			MethodName: "AcceptConsent",
+			Handler:    _AuthService_AcceptConsent_Handler,
+		},
 		{
 			MethodName: "ChangePassword",
 			Handler:    _AuthService_ChangePassword_Handler,
@@ -763,6 +1273,14 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteAccount",
 			Handler:    _AuthService_DeleteAccount_Handler,
 		},
+		{
+			MethodName: "DeactivateAccount",
+			Handler:    _AuthService_DeactivateAccount_Handler,
+		},
+		{
+			MethodName: "IntrospectToken",
+			Handler:    _AuthService_IntrospectToken_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethos/auth/v1/auth_service.proto",
@@ -7,7 +7,7 @@
 package authv1
 
 import (
-	v1 "github.com/semmidev/ethos-go/internal/generated/grpc/ethos/common/v1"
+	v1 "github.com/semmidev/ethos-go/contracts/grpc/ethos/common/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	structpb "google.golang.org/protobuf/types/known/structpb"
@@ -222,7 +222,12 @@ type LoginRequest struct {
 	// User's email address.
 	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
 	// User's password.
-	Password      string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	// Confirms reactivating a deactivated account. Without it, logging in to a
+	// deactivated account fails with AUTH_ACCOUNT_DEACTIVATED. An account the
+	// identity provider deactivated through provisioning cannot be reactivated
+	// this way; logging in to it fails with BUSINESS_OPERATION_NOT_ALLOWED.
+	Reactivate    bool `protobuf:"varint,3,opt,name=reactivate,proto3" json:"reactivate,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -271,6 +276,13 @@ func (x *LoginRequest) GetPassword() string {
 	return ""
 }
 
+func (x *LoginRequest) GetReactivate() bool {
+	if x != nil {
+		return x.Reactivate
+	}
+	return false
+}
+
 // LoginResponse contains authentication tokens.
 type LoginResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -550,7 +562,9 @@ func (x *GoogleLoginData) GetUrl() string {
 type GoogleCallbackRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The authorization code from Google OAuth callback.
-	Code          string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// Confirms reactivating a deactivated account, as in LoginRequest.
+	Reactivate    bool `protobuf:"varint,2,opt,name=reactivate,proto3" json:"reactivate,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -592,29 +606,34 @@ func (x *GoogleCallbackRequest) GetCode() string {
 	return ""
 }
 
-// LogoutRequest contains the session to terminate.
-type LogoutRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Session ID to terminate.
-	SessionId     string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+func (x *GoogleCallbackRequest) GetReactivate() bool {
+	if x != nil {
+		return x.Reactivate
+	}
+	return false
+}
+
+// SSOLoginRequest is empty - no parameters needed.
+type SSOLoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LogoutRequest) Reset() {
-	*x = LogoutRequest{}
+func (x *SSOLoginRequest) Reset() {
+	*x = SSOLoginRequest{}
 	mi := &file_ethos_auth_v1_messages_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LogoutRequest) String() string {
+func (x *SSOLoginRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogoutRequest) ProtoMessage() {}
+func (*SSOLoginRequest) ProtoMessage() {}
 
-func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
+func (x *SSOLoginRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ethos_auth_v1_messages_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -626,41 +645,36 @@ func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
-func (*LogoutRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SSOLoginRequest.ProtoReflect.Descriptor instead.
+func (*SSOLoginRequest) Descriptor() ([]byte, []int) {
 	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *LogoutRequest) GetSessionId() string {
-	if x != nil {
-		return x.SessionId
-	}
-	return ""
-}
-
-// LogoutAllRequest contains the user ID for bulk logout.
-type LogoutAllRequest struct {
+// SSOLoginResponse contains the identity provider login URL.
+type SSOLoginResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// User ID whose sessions should be terminated.
-	UserId        string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Whether the request was successful.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Login URL data.
+	Data          *SSOLoginData `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LogoutAllRequest) Reset() {
-	*x = LogoutAllRequest{}
+func (x *SSOLoginResponse) Reset() {
+	*x = SSOLoginResponse{}
 	mi := &file_ethos_auth_v1_messages_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LogoutAllRequest) String() string {
+func (x *SSOLoginResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogoutAllRequest) ProtoMessage() {}
+func (*SSOLoginResponse) ProtoMessage() {}
 
-func (x *LogoutAllRequest) ProtoReflect() protoreflect.Message {
+func (x *SSOLoginResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_ethos_auth_v1_messages_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -672,43 +686,51 @@ func (x *LogoutAllRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogoutAllRequest.ProtoReflect.Descriptor instead.
-func (*LogoutAllRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SSOLoginResponse.ProtoReflect.Descriptor instead.
+func (*SSOLoginResponse) Descriptor() ([]byte, []int) {
 	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *LogoutAllRequest) GetUserId() string {
+func (x *SSOLoginResponse) GetSuccess() bool {
 	if x != nil {
-		return x.UserId
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-// LogoutResponse contains the logout result.
-type LogoutResponse struct {
+func (x *SSOLoginResponse) GetData() *SSOLoginData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// SSOLoginData contains the identity provider login URL.
+type SSOLoginData struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Whether logout was successful.
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	// Human-readable message.
-	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// The identity provider authorization URL.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Opaque state embedded in the URL. Send it back with the callback within
+	// ten minutes; each state completes one sign-in.
+	State         string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LogoutResponse) Reset() {
-	*x = LogoutResponse{}
+func (x *SSOLoginData) Reset() {
+	*x = SSOLoginData{}
 	mi := &file_ethos_auth_v1_messages_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LogoutResponse) String() string {
+func (x *SSOLoginData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogoutResponse) ProtoMessage() {}
+func (*SSOLoginData) ProtoMessage() {}
 
-func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
+func (x *SSOLoginData) ProtoReflect() protoreflect.Message {
 	mi := &file_ethos_auth_v1_messages_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -720,54 +742,53 @@ func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogoutResponse.ProtoReflect.Descriptor instead.
-func (*LogoutResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SSOLoginData.ProtoReflect.Descriptor instead.
+func (*SSOLoginData) Descriptor() ([]byte, []int) {
 	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *LogoutResponse) GetSuccess() bool {
+func (x *SSOLoginData) GetUrl() string {
 	if x != nil {
-		return x.Success
+		return x.Url
 	}
-	return false
+	return ""
 }
 
-func (x *LogoutResponse) GetMessage() string {
+func (x *SSOLoginData) GetState() string {
 	if x != nil {
-		return x.Message
+		return x.State
 	}
 	return ""
 }
 
-// ListSessionsRequest contains session list filters.
-type ListSessionsRequest struct {
+// SSOCallbackRequest contains the identity provider callback code.
+type SSOCallbackRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Page number (1-indexed).
-	Page int32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	// Number of items per page.
-	PerPage int32 `protobuf:"varint,2,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
-	// Include blocked sessions in results.
-	IncludeBlocked bool `protobuf:"varint,3,opt,name=include_blocked,json=includeBlocked,proto3" json:"include_blocked,omitempty"`
-	// Include expired sessions in results.
-	IncludeExpired bool `protobuf:"varint,4,opt,name=include_expired,json=includeExpired,proto3" json:"include_expired,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// The authorization code from the identity provider callback.
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// Confirms reactivating a deactivated account, as in LoginRequest.
+	Reactivate bool `protobuf:"varint,2,opt,name=reactivate,proto3" json:"reactivate,omitempty"`
+	// The state from the identity provider callback, which must be one
+	// SSOLogin handed out.
+	State         string `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListSessionsRequest) Reset() {
-	*x = ListSessionsRequest{}
+func (x *SSOCallbackRequest) Reset() {
+	*x = SSOCallbackRequest{}
 	mi := &file_ethos_auth_v1_messages_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSessionsRequest) String() string {
+func (x *SSOCallbackRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSessionsRequest) ProtoMessage() {}
+func (*SSOCallbackRequest) ProtoMessage() {}
 
-func (x *ListSessionsRequest) ProtoReflect() protoreflect.Message {
+func (x *SSOCallbackRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ethos_auth_v1_messages_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -779,68 +800,55 @@ func (x *ListSessionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSessionsRequest.ProtoReflect.Descriptor instead.
-func (*ListSessionsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SSOCallbackRequest.ProtoReflect.Descriptor instead.
+func (*SSOCallbackRequest) Descriptor() ([]byte, []int) {
 	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ListSessionsRequest) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *ListSessionsRequest) GetPerPage() int32 {
+func (x *SSOCallbackRequest) GetCode() string {
 	if x != nil {
-		return x.PerPage
+		return x.Code
 	}
-	return 0
+	return ""
 }
 
-func (x *ListSessionsRequest) GetIncludeBlocked() bool {
+func (x *SSOCallbackRequest) GetReactivate() bool {
 	if x != nil {
-		return x.IncludeBlocked
+		return x.Reactivate
 	}
 	return false
 }
 
-func (x *ListSessionsRequest) GetIncludeExpired() bool {
+func (x *SSOCallbackRequest) GetState() string {
 	if x != nil {
-		return x.IncludeExpired
+		return x.State
 	}
-	return false
+	return ""
 }
 
-// ListSessionsResponse contains paginated sessions.
-type ListSessionsResponse struct {
+// RequestMagicLinkRequest asks for a login link to be emailed.
+type RequestMagicLinkRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Whether the request was successful.
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	// Human-readable message.
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	// List of sessions.
-	Data []*Session `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
-	// Pagination metadata.
-	Meta          *v1.Meta `protobuf:"bytes,4,opt,name=meta,proto3" json:"meta,omitempty"`
+	// Email address of the account.
+	Email         string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListSessionsResponse) Reset() {
-	*x = ListSessionsResponse{}
+func (x *RequestMagicLinkRequest) Reset() {
+	*x = RequestMagicLinkRequest{}
 	mi := &file_ethos_auth_v1_messages_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSessionsResponse) String() string {
+func (x *RequestMagicLinkRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSessionsResponse) ProtoMessage() {}
+func (*RequestMagicLinkRequest) ProtoMessage() {}
 
-func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
+func (x *RequestMagicLinkRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ethos_auth_v1_messages_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -852,76 +860,43 @@ func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSessionsResponse.ProtoReflect.Descriptor instead.
-func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestMagicLinkRequest.ProtoReflect.Descriptor instead.
+func (*RequestMagicLinkRequest) Descriptor() ([]byte, []int) {
 	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ListSessionsResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-func (x *ListSessionsResponse) GetMessage() string {
+func (x *RequestMagicLinkRequest) GetEmail() string {
 	if x != nil {
-		return x.Message
+		return x.Email
 	}
 	return ""
 }
 
-func (x *ListSessionsResponse) GetData() []*Session {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-func (x *ListSessionsResponse) GetMeta() *v1.Meta {
-	if x != nil {
-		return x.Meta
-	}
-	return nil
-}
-
-// Session represents a user session.
-type Session struct {
+// VerifyMagicLinkRequest signs in with a login link.
+type VerifyMagicLinkRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Unique session identifier.
-	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	// Browser/client user agent string.
-	UserAgent string `protobuf:"bytes,2,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
-	// Client IP address.
-	ClientIp string `protobuf:"bytes,3,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
-	// Whether the session is blocked.
-	IsBlocked bool `protobuf:"varint,4,opt,name=is_blocked,json=isBlocked,proto3" json:"is_blocked,omitempty"`
-	// Session expiration time.
-	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
-	// Session creation time.
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	// Whether the session is currently active.
-	IsActive bool `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	// Whether this is the current session.
-	IsCurrent     bool `protobuf:"varint,8,opt,name=is_current,json=isCurrent,proto3" json:"is_current,omitempty"`
+	// The token from the login link.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Confirms reactivating a deactivated account, as in LoginRequest.
+	Reactivate    bool `protobuf:"varint,2,opt,name=reactivate,proto3" json:"reactivate,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Session) Reset() {
-	*x = Session{}
+func (x *VerifyMagicLinkRequest) Reset() {
+	*x = VerifyMagicLinkRequest{}
 	mi := &file_ethos_auth_v1_messages_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Session) String() string {
+func (x *VerifyMagicLinkRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Session) ProtoMessage() {}
+func (*VerifyMagicLinkRequest) ProtoMessage() {}
 
-func (x *Session) ProtoReflect() protoreflect.Message {
+func (x *VerifyMagicLinkRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ethos_auth_v1_messages_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -933,88 +908,48 @@ func (x *Session) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Session.ProtoReflect.Descriptor instead.
-func (*Session) Descriptor() ([]byte, []int) {
+// Deprecated: Use VerifyMagicLinkRequest.ProtoReflect.Descriptor instead.
+func (*VerifyMagicLinkRequest) Descriptor() ([]byte, []int) {
 	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *Session) GetSessionId() string {
-	if x != nil {
-		return x.SessionId
-	}
-	return ""
-}
-
-func (x *Session) GetUserAgent() string {
-	if x != nil {
-		return x.UserAgent
-	}
-	return ""
-}
-
-func (x *Session) GetClientIp() string {
+func (x *VerifyMagicLinkRequest) GetToken() string {
 	if x != nil {
-		return x.ClientIp
+		return x.Token
 	}
 	return ""
 }
 
-func (x *Session) GetIsBlocked() bool {
-	if x != nil {
-		return x.IsBlocked
-	}
-	return false
-}
-
-func (x *Session) GetExpiresAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.ExpiresAt
-	}
-	return nil
-}
-
-func (x *Session) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
-}
-
-func (x *Session) GetIsActive() bool {
-	if x != nil {
-		return x.IsActive
-	}
-	return false
-}
-
-func (x *Session) GetIsCurrent() bool {
+func (x *VerifyMagicLinkRequest) GetReactivate() bool {
 	if x != nil {
-		return x.IsCurrent
+		return x.Reactivate
 	}
 	return false
 }
 
-// RevokeOtherSessionsRequest is empty - uses auth context.
-type RevokeOtherSessionsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+// RefreshTokenRequest rotates a session's refresh token.
+type RefreshTokenRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The refresh token from the last login or refresh.
+	RefreshToken  string `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RevokeOtherSessionsRequest) Reset() {
-	*x = RevokeOtherSessionsRequest{}
+func (x *RefreshTokenRequest) Reset() {
+	*x = RefreshTokenRequest{}
 	mi := &file_ethos_auth_v1_messages_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RevokeOtherSessionsRequest) String() string {
+func (x *RefreshTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RevokeOtherSessionsRequest) ProtoMessage() {}
+func (*RefreshTokenRequest) ProtoMessage() {}
 
-func (x *RevokeOtherSessionsRequest) ProtoReflect() protoreflect.Message {
+func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ethos_auth_v1_messages_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1026,39 +961,907 @@ func (x *RevokeOtherSessionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RevokeOtherSessionsRequest.ProtoReflect.Descriptor instead.
-func (*RevokeOtherSessionsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RefreshTokenRequest.ProtoReflect.Descriptor instead.
+func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
 	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{16}
 }
 
-// RevokeOtherSessionsResponse contains the count of revoked sessions.
-type RevokeOtherSessionsResponse struct {
+func (x *RefreshTokenRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+// LogoutRequest ends the caller's current session, taken from the access token.
+type LogoutRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Whether the operation was successful.
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	// Human-readable message.
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	// Number of sessions that were revoked.
-	RevokedCount  int32 `protobuf:"varint,3,opt,name=revoked_count,json=revokedCount,proto3" json:"revoked_count,omitempty"`
+	// Optional. When set it must be the access token's session ID, otherwise
+	// the request is rejected.
+	SessionId     string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RevokeOtherSessionsResponse) Reset() {
-	*x = RevokeOtherSessionsResponse{}
+func (x *LogoutRequest) Reset() {
+	*x = LogoutRequest{}
 	mi := &file_ethos_auth_v1_messages_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RevokeOtherSessionsResponse) String() string {
+func (x *LogoutRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RevokeOtherSessionsResponse) ProtoMessage() {}
+func (*LogoutRequest) ProtoMessage() {}
+
+func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
+func (*LogoutRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *LogoutRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+// LogoutAllRequest ends every session of the caller, taken from the access token.
+type LogoutAllRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. When set it must be the access token's user ID, otherwise the
+	// request is rejected.
+	UserId        string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutAllRequest) Reset() {
+	*x = LogoutAllRequest{}
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutAllRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutAllRequest) ProtoMessage() {}
+
+func (x *LogoutAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutAllRequest.ProtoReflect.Descriptor instead.
+func (*LogoutAllRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *LogoutAllRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// LogoutResponse contains the logout result.
+type LogoutResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether logout was successful.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Human-readable message.
+	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutResponse) Reset() {
+	*x = LogoutResponse{}
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutResponse) ProtoMessage() {}
+
+func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutResponse.ProtoReflect.Descriptor instead.
+func (*LogoutResponse) Descriptor() ([]byte, []int) {
+	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *LogoutResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *LogoutResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// ListSessionsRequest contains session list filters.
+type ListSessionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Page number (1-indexed).
+	Page int32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	// Number of items per page.
+	PerPage int32 `protobuf:"varint,2,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	// Include blocked sessions in results.
+	IncludeBlocked bool `protobuf:"varint,3,opt,name=include_blocked,json=includeBlocked,proto3" json:"include_blocked,omitempty"`
+	// Include expired sessions in results.
+	IncludeExpired bool `protobuf:"varint,4,opt,name=include_expired,json=includeExpired,proto3" json:"include_expired,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListSessionsRequest) Reset() {
+	*x = ListSessionsRequest{}
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsRequest) ProtoMessage() {}
+
+func (x *ListSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListSessionsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListSessionsRequest) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+func (x *ListSessionsRequest) GetIncludeBlocked() bool {
+	if x != nil {
+		return x.IncludeBlocked
+	}
+	return false
+}
+
+func (x *ListSessionsRequest) GetIncludeExpired() bool {
+	if x != nil {
+		return x.IncludeExpired
+	}
+	return false
+}
+
+// ListSessionsResponse contains paginated sessions.
+type ListSessionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the request was successful.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Human-readable message.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// List of sessions.
+	Data []*Session `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	// Pagination metadata.
+	Meta          *v1.Meta `protobuf:"bytes,4,opt,name=meta,proto3" json:"meta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsResponse) Reset() {
+	*x = ListSessionsResponse{}
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsResponse) ProtoMessage() {}
+
+func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListSessionsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ListSessionsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListSessionsResponse) GetData() []*Session {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListSessionsResponse) GetMeta() *v1.Meta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+// Session represents a user session.
+type Session struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique session identifier.
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// Browser/client user agent string.
+	UserAgent string `protobuf:"bytes,2,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	// Client IP address.
+	ClientIp string `protobuf:"bytes,3,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+	// Whether the session is blocked.
+	IsBlocked bool `protobuf:"varint,4,opt,name=is_blocked,json=isBlocked,proto3" json:"is_blocked,omitempty"`
+	// Session expiration time.
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Session creation time.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Whether the session is currently active.
+	IsActive bool `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// Whether this is the current session.
+	IsCurrent bool `protobuf:"varint,8,opt,name=is_current,json=isCurrent,proto3" json:"is_current,omitempty"`
+	// Approximate city of the client IP at login, empty when unknown.
+	City string `protobuf:"bytes,9,opt,name=city,proto3" json:"city,omitempty"`
+	// Approximate country of the client IP at login, empty when unknown.
+	Country       string `protobuf:"bytes,10,opt,name=country,proto3" json:"country,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Session) Reset() {
+	*x = Session{}
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Session) ProtoMessage() {}
+
+func (x *Session) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Session.ProtoReflect.Descriptor instead.
+func (*Session) Descriptor() ([]byte, []int) {
+	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Session) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *Session) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *Session) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+func (x *Session) GetIsBlocked() bool {
+	if x != nil {
+		return x.IsBlocked
+	}
+	return false
+}
+
+func (x *Session) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Session) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Session) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *Session) GetIsCurrent() bool {
+	if x != nil {
+		return x.IsCurrent
+	}
+	return false
+}
+
+func (x *Session) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Session) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+// RevokeSessionRequest names one of the caller's sessions to revoke.
+type RevokeSessionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Session ID to revoke. Sessions of other users are reported as not found.
+	SessionId     string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionRequest) Reset() {
+	*x = RevokeSessionRequest{}
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionRequest) ProtoMessage() {}
+
+func (x *RevokeSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeSessionRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RevokeSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+// RevokeSessionResponse confirms the session was revoked.
+type RevokeSessionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the operation was successful.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Human-readable message.
+	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionResponse) Reset() {
+	*x = RevokeSessionResponse{}
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionResponse) ProtoMessage() {}
+
+func (x *RevokeSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionResponse.ProtoReflect.Descriptor instead.
+func (*RevokeSessionResponse) Descriptor() ([]byte, []int) {
+	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RevokeSessionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RevokeSessionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// RevokeOtherSessionsRequest is empty - uses auth context.
+type RevokeOtherSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeOtherSessionsRequest) Reset() {
+	*x = RevokeOtherSessionsRequest{}
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeOtherSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeOtherSessionsRequest) ProtoMessage() {}
+
+func (x *RevokeOtherSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeOtherSessionsRequest.ProtoReflect.Descriptor instead.
+func (*RevokeOtherSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{25}
+}
+
+// RevokeOtherSessionsResponse contains the count of revoked sessions.
+type RevokeOtherSessionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the operation was successful.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Human-readable message.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Number of sessions that were revoked.
+	RevokedCount  int32 `protobuf:"varint,3,opt,name=revoked_count,json=revokedCount,proto3" json:"revoked_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeOtherSessionsResponse) Reset() {
+	*x = RevokeOtherSessionsResponse{}
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeOtherSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeOtherSessionsResponse) ProtoMessage() {}
 
 func (x *RevokeOtherSessionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_auth_v1_messages_proto_msgTypes[17]
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeOtherSessionsResponse.ProtoReflect.Descriptor instead.
+func (*RevokeOtherSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RevokeOtherSessionsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RevokeOtherSessionsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RevokeOtherSessionsResponse) GetRevokedCount() int32 {
+	if x != nil {
+		return x.RevokedCount
+	}
+	return 0
+}
+
+// GetProfileRequest is empty - uses auth context.
+type GetProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfileRequest) Reset() {
+	*x = GetProfileRequest{}
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileRequest) ProtoMessage() {}
+
+func (x *GetProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetProfileRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{27}
+}
+
+// ProfileResponse contains user profile data.
+type ProfileResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the request was successful.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Human-readable message.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// User profile data.
+	Data          *ProfileData `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProfileResponse) Reset() {
+	*x = ProfileResponse{}
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileResponse) ProtoMessage() {}
+
+func (x *ProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileResponse.ProtoReflect.Descriptor instead.
+func (*ProfileResponse) Descriptor() ([]byte, []int) {
+	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ProfileResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ProfileResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ProfileResponse) GetData() *ProfileData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// ProfileData contains user profile information.
+type ProfileData struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// User identifier.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// User's display name.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// User's email address.
+	Email string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	// User's timezone in IANA format (e.g., Asia/Jakarta).
+	Timezone string `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Account creation time.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Habit logs older than this many days are locked (unset when disabled).
+	LogLockDays *int32 `protobuf:"varint,6,opt,name=log_lock_days,json=logLockDays,proto3,oneof" json:"log_lock_days,omitempty"`
+	// Language for messages, notifications and emails (e.g., en, id).
+	Locale string `protobuf:"bytes,7,opt,name=locale,proto3" json:"locale,omitempty"`
+	// Day weeks begin on for weekly stats and the weekly report: sunday, monday or saturday.
+	WeekStart string `protobuf:"bytes,8,opt,name=week_start,json=weekStart,proto3" json:"week_start,omitempty"`
+	// URL of the user's avatar image, empty when none is set. It may be signed
+	// and expire, so fetch the profile again rather than storing it.
+	// Upload a new avatar with a multipart POST to /v1/auth/profile/avatar.
+	AvatarUrl string `protobuf:"bytes,9,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	// Access role: member or admin. Accounts signed in through single sign-on
+	// take it from their identity provider groups.
+	Role          string `protobuf:"bytes,10,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProfileData) Reset() {
+	*x = ProfileData{}
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProfileData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileData) ProtoMessage() {}
+
+func (x *ProfileData) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileData.ProtoReflect.Descriptor instead.
+func (*ProfileData) Descriptor() ([]byte, []int) {
+	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ProfileData) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ProfileData) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProfileData) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ProfileData) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *ProfileData) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ProfileData) GetLogLockDays() int32 {
+	if x != nil && x.LogLockDays != nil {
+		return *x.LogLockDays
+	}
+	return 0
+}
+
+func (x *ProfileData) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *ProfileData) GetWeekStart() string {
+	if x != nil {
+		return x.WeekStart
+	}
+	return ""
+}
+
+func (x *ProfileData) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *ProfileData) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+// UpdateProfileRequest contains profile update data.
+type UpdateProfileRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// New display name (optional).
+	Name *string `protobuf:"bytes,1,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	// New email address (optional).
+	Email *string `protobuf:"bytes,2,opt,name=email,proto3,oneof" json:"email,omitempty"`
+	// New timezone in IANA format (optional).
+	Timezone *string `protobuf:"bytes,3,opt,name=timezone,proto3,oneof" json:"timezone,omitempty"`
+	// Lock habit logs older than this many days; 0 removes the lock (optional).
+	LogLockDays *int32 `protobuf:"varint,4,opt,name=log_lock_days,json=logLockDays,proto3,oneof" json:"log_lock_days,omitempty"`
+	// New language for messages, notifications and emails: en or id (optional).
+	Locale *string `protobuf:"bytes,5,opt,name=locale,proto3,oneof" json:"locale,omitempty"`
+	// New week start day: sunday, monday or saturday (optional).
+	WeekStart     *string `protobuf:"bytes,6,opt,name=week_start,json=weekStart,proto3,oneof" json:"week_start,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfileRequest) Reset() {
+	*x = UpdateProfileRequest{}
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileRequest) ProtoMessage() {}
+
+func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1069,54 +1872,331 @@ func (x *RevokeOtherSessionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RevokeOtherSessionsResponse.ProtoReflect.Descriptor instead.
-func (*RevokeOtherSessionsResponse) Descriptor() ([]byte, []int) {
-	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *RevokeOtherSessionsResponse) GetSuccess() bool {
+func (x *UpdateProfileRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetEmail() string {
+	if x != nil && x.Email != nil {
+		return *x.Email
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetTimezone() string {
+	if x != nil && x.Timezone != nil {
+		return *x.Timezone
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetLogLockDays() int32 {
+	if x != nil && x.LogLockDays != nil {
+		return *x.LogLockDays
+	}
+	return 0
+}
+
+func (x *UpdateProfileRequest) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetWeekStart() string {
+	if x != nil && x.WeekStart != nil {
+		return *x.WeekStart
+	}
+	return ""
+}
+
+// GetPreferencesRequest is empty - uses auth context.
+type GetPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPreferencesRequest) Reset() {
+	*x = GetPreferencesRequest{}
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPreferencesRequest) ProtoMessage() {}
+
+func (x *GetPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*GetPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{31}
+}
+
+// PreferencesResponse contains user preferences.
+type PreferencesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the request was successful.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Human-readable message.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// User preferences.
+	Data          *UserPreferences `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreferencesResponse) Reset() {
+	*x = PreferencesResponse{}
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreferencesResponse) ProtoMessage() {}
+
+func (x *PreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreferencesResponse.ProtoReflect.Descriptor instead.
+func (*PreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PreferencesResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *RevokeOtherSessionsResponse) GetMessage() string {
+func (x *PreferencesResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *RevokeOtherSessionsResponse) GetRevokedCount() int32 {
+func (x *PreferencesResponse) GetData() *UserPreferences {
 	if x != nil {
-		return x.RevokedCount
+		return x.Data
+	}
+	return nil
+}
+
+// UserPreferences are per-user settings, with defaults filled in.
+type UserPreferences struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Day weeks begin on: sunday, monday or saturday.
+	WeekStart string `protobuf:"bytes,1,opt,name=week_start,json=weekStart,proto3" json:"week_start,omitempty"`
+	// Language for messages, notifications and emails (e.g., en, id).
+	Locale string `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	// Color scheme: system, light or dark.
+	Theme string `protobuf:"bytes,3,opt,name=theme,proto3" json:"theme,omitempty"`
+	// Hour of day (0-23) suggested as a new habit's reminder time (unset when not chosen).
+	DefaultReminderHour *int32 `protobuf:"varint,4,opt,name=default_reminder_hour,json=defaultReminderHour,proto3,oneof" json:"default_reminder_hour,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *UserPreferences) Reset() {
+	*x = UserPreferences{}
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserPreferences) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserPreferences) ProtoMessage() {}
+
+func (x *UserPreferences) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserPreferences.ProtoReflect.Descriptor instead.
+func (*UserPreferences) Descriptor() ([]byte, []int) {
+	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UserPreferences) GetWeekStart() string {
+	if x != nil {
+		return x.WeekStart
+	}
+	return ""
+}
+
+func (x *UserPreferences) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *UserPreferences) GetTheme() string {
+	if x != nil {
+		return x.Theme
+	}
+	return ""
+}
+
+func (x *UserPreferences) GetDefaultReminderHour() int32 {
+	if x != nil && x.DefaultReminderHour != nil {
+		return *x.DefaultReminderHour
 	}
 	return 0
 }
 
-// GetProfileRequest is empty - uses auth context.
-type GetProfileRequest struct {
+// UpdatePreferencesRequest contains the preferences to change.
+type UpdatePreferencesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// New week start day: sunday, monday or saturday (optional).
+	WeekStart *string `protobuf:"bytes,1,opt,name=week_start,json=weekStart,proto3,oneof" json:"week_start,omitempty"`
+	// New language: en or id (optional).
+	Locale *string `protobuf:"bytes,2,opt,name=locale,proto3,oneof" json:"locale,omitempty"`
+	// New color scheme: system, light or dark (optional).
+	Theme *string `protobuf:"bytes,3,opt,name=theme,proto3,oneof" json:"theme,omitempty"`
+	// New suggested reminder hour, 0-23 (optional).
+	DefaultReminderHour *int32 `protobuf:"varint,4,opt,name=default_reminder_hour,json=defaultReminderHour,proto3,oneof" json:"default_reminder_hour,omitempty"`
+	// Remove the suggested reminder hour.
+	ClearDefaultReminderHour bool `protobuf:"varint,5,opt,name=clear_default_reminder_hour,json=clearDefaultReminderHour,proto3" json:"clear_default_reminder_hour,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *UpdatePreferencesRequest) Reset() {
+	*x = UpdatePreferencesRequest{}
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePreferencesRequest) ProtoMessage() {}
+
+func (x *UpdatePreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePreferencesRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *UpdatePreferencesRequest) GetWeekStart() string {
+	if x != nil && x.WeekStart != nil {
+		return *x.WeekStart
+	}
+	return ""
+}
+
+func (x *UpdatePreferencesRequest) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
+func (x *UpdatePreferencesRequest) GetTheme() string {
+	if x != nil && x.Theme != nil {
+		return *x.Theme
+	}
+	return ""
+}
+
+func (x *UpdatePreferencesRequest) GetDefaultReminderHour() int32 {
+	if x != nil && x.DefaultReminderHour != nil {
+		return *x.DefaultReminderHour
+	}
+	return 0
+}
+
+func (x *UpdatePreferencesRequest) GetClearDefaultReminderHour() bool {
+	if x != nil {
+		return x.ClearDefaultReminderHour
+	}
+	return false
+}
+
+// ListConsentsRequest is empty as it uses the authenticated user.
+type ListConsentsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProfileRequest) Reset() {
-	*x = GetProfileRequest{}
-	mi := &file_ethos_auth_v1_messages_proto_msgTypes[18]
+func (x *ListConsentsRequest) Reset() {
+	*x = ListConsentsRequest{}
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProfileRequest) String() string {
+func (x *ListConsentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProfileRequest) ProtoMessage() {}
+func (*ListConsentsRequest) ProtoMessage() {}
 
-func (x *GetProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_auth_v1_messages_proto_msgTypes[18]
+func (x *ListConsentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1127,39 +2207,94 @@ func (x *GetProfileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProfileRequest.ProtoReflect.Descriptor instead.
-func (*GetProfileRequest) Descriptor() ([]byte, []int) {
-	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ListConsentsRequest.ProtoReflect.Descriptor instead.
+func (*ListConsentsRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{35}
 }
 
-// ProfileResponse contains user profile data.
-type ProfileResponse struct {
+// AcceptConsentRequest names the document version being accepted.
+type AcceptConsentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Document accepted: terms or privacy.
+	Document string `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	// Version accepted; must be the document's current version.
+	Version       string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptConsentRequest) Reset() {
+	*x = AcceptConsentRequest{}
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptConsentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptConsentRequest) ProtoMessage() {}
+
+func (x *AcceptConsentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptConsentRequest.ProtoReflect.Descriptor instead.
+func (*AcceptConsentRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *AcceptConsentRequest) GetDocument() string {
+	if x != nil {
+		return x.Document
+	}
+	return ""
+}
+
+func (x *AcceptConsentRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+// ConsentsResponse contains the user's consents.
+type ConsentsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Whether the request was successful.
 	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	// Human-readable message.
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	// User profile data.
-	Data          *ProfileData `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Current documents and consent history.
+	Data          *Consents `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProfileResponse) Reset() {
-	*x = ProfileResponse{}
-	mi := &file_ethos_auth_v1_messages_proto_msgTypes[19]
+func (x *ConsentsResponse) Reset() {
+	*x = ConsentsResponse{}
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProfileResponse) String() string {
+func (x *ConsentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProfileResponse) ProtoMessage() {}
+func (*ConsentsResponse) ProtoMessage() {}
 
-func (x *ProfileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_auth_v1_messages_proto_msgTypes[19]
+func (x *ConsentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1170,64 +2305,58 @@ func (x *ProfileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProfileResponse.ProtoReflect.Descriptor instead.
-func (*ProfileResponse) Descriptor() ([]byte, []int) {
-	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ConsentsResponse.ProtoReflect.Descriptor instead.
+func (*ConsentsResponse) Descriptor() ([]byte, []int) {
+	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *ProfileResponse) GetSuccess() bool {
+func (x *ConsentsResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *ProfileResponse) GetMessage() string {
+func (x *ConsentsResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *ProfileResponse) GetData() *ProfileData {
+func (x *ConsentsResponse) GetData() *Consents {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-// ProfileData contains user profile information.
-type ProfileData struct {
+// Consents lists the published legal documents and the user's consents.
+type Consents struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// User identifier.
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	// User's display name.
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// User's email address.
-	Email string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	// User's timezone in IANA format (e.g., Asia/Jakarta).
-	Timezone string `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
-	// Account creation time.
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Current version of every published document.
+	Documents []*LegalDocument `protobuf:"bytes,1,rep,name=documents,proto3" json:"documents,omitempty"`
+	// Every version the user accepted, newest first.
+	History       []*Consent `protobuf:"bytes,2,rep,name=history,proto3" json:"history,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProfileData) Reset() {
-	*x = ProfileData{}
-	mi := &file_ethos_auth_v1_messages_proto_msgTypes[20]
+func (x *Consents) Reset() {
+	*x = Consents{}
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProfileData) String() string {
+func (x *Consents) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProfileData) ProtoMessage() {}
+func (*Consents) ProtoMessage() {}
 
-func (x *ProfileData) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_auth_v1_messages_proto_msgTypes[20]
+func (x *Consents) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1238,74 +2367,119 @@ func (x *ProfileData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProfileData.ProtoReflect.Descriptor instead.
-func (*ProfileData) Descriptor() ([]byte, []int) {
-	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use Consents.ProtoReflect.Descriptor instead.
+func (*Consents) Descriptor() ([]byte, []int) {
+	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *ProfileData) GetUserId() string {
+func (x *Consents) GetDocuments() []*LegalDocument {
 	if x != nil {
-		return x.UserId
+		return x.Documents
 	}
-	return ""
+	return nil
 }
 
-func (x *ProfileData) GetName() string {
+func (x *Consents) GetHistory() []*Consent {
 	if x != nil {
-		return x.Name
+		return x.History
 	}
-	return ""
+	return nil
 }
 
-func (x *ProfileData) GetEmail() string {
+// LegalDocument is the current version of a legal document.
+type LegalDocument struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Document: terms or privacy.
+	Document string `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	// Version users must accept.
+	CurrentVersion string `protobuf:"bytes,2,opt,name=current_version,json=currentVersion,proto3" json:"current_version,omitempty"`
+	// Whether the user accepted this version.
+	Accepted      bool `protobuf:"varint,3,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LegalDocument) Reset() {
+	*x = LegalDocument{}
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LegalDocument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LegalDocument) ProtoMessage() {}
+
+func (x *LegalDocument) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[39]
 	if x != nil {
-		return x.Email
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LegalDocument.ProtoReflect.Descriptor instead.
+func (*LegalDocument) Descriptor() ([]byte, []int) {
+	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *LegalDocument) GetDocument() string {
+	if x != nil {
+		return x.Document
 	}
 	return ""
 }
 
-func (x *ProfileData) GetTimezone() string {
+func (x *LegalDocument) GetCurrentVersion() string {
 	if x != nil {
-		return x.Timezone
+		return x.CurrentVersion
 	}
 	return ""
 }
 
-func (x *ProfileData) GetCreatedAt() *timestamppb.Timestamp {
+func (x *LegalDocument) GetAccepted() bool {
 	if x != nil {
-		return x.CreatedAt
+		return x.Accepted
 	}
-	return nil
+	return false
 }
 
-// UpdateProfileRequest contains profile update data.
-type UpdateProfileRequest struct {
+// Consent is one version of a document the user accepted.
+type Consent struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// New display name (optional).
-	Name *string `protobuf:"bytes,1,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	// New email address (optional).
-	Email *string `protobuf:"bytes,2,opt,name=email,proto3,oneof" json:"email,omitempty"`
-	// New timezone in IANA format (optional).
-	Timezone      *string `protobuf:"bytes,3,opt,name=timezone,proto3,oneof" json:"timezone,omitempty"`
+	// Document: terms or privacy.
+	Document string `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	// Version accepted.
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// When it was accepted.
+	AcceptedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=accepted_at,json=acceptedAt,proto3" json:"accepted_at,omitempty"`
+	// Client IP address it was accepted from.
+	ClientIp      string `protobuf:"bytes,4,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateProfileRequest) Reset() {
-	*x = UpdateProfileRequest{}
-	mi := &file_ethos_auth_v1_messages_proto_msgTypes[21]
+func (x *Consent) Reset() {
+	*x = Consent{}
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProfileRequest) String() string {
+func (x *Consent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProfileRequest) ProtoMessage() {}
+func (*Consent) ProtoMessage() {}
 
-func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_auth_v1_messages_proto_msgTypes[21]
+func (x *Consent) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1316,28 +2490,35 @@ func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
-func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
-	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use Consent.ProtoReflect.Descriptor instead.
+func (*Consent) Descriptor() ([]byte, []int) {
+	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *UpdateProfileRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+func (x *Consent) GetDocument() string {
+	if x != nil {
+		return x.Document
 	}
 	return ""
 }
 
-func (x *UpdateProfileRequest) GetEmail() string {
-	if x != nil && x.Email != nil {
-		return *x.Email
+func (x *Consent) GetVersion() string {
+	if x != nil {
+		return x.Version
 	}
 	return ""
 }
 
-func (x *UpdateProfileRequest) GetTimezone() string {
-	if x != nil && x.Timezone != nil {
-		return *x.Timezone
+func (x *Consent) GetAcceptedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AcceptedAt
+	}
+	return nil
+}
+
+func (x *Consent) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
 	}
 	return ""
 }
@@ -1355,7 +2536,7 @@ type ChangePasswordRequest struct {
 
 func (x *ChangePasswordRequest) Reset() {
 	*x = ChangePasswordRequest{}
-	mi := &file_ethos_auth_v1_messages_proto_msgTypes[22]
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1367,7 +2548,7 @@ func (x *ChangePasswordRequest) String() string {
 func (*ChangePasswordRequest) ProtoMessage() {}
 
 func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_auth_v1_messages_proto_msgTypes[22]
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1380,7 +2561,7 @@ func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
 func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
-	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{22}
+	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *ChangePasswordRequest) GetCurrentPassword() string {
@@ -1410,7 +2591,7 @@ type VerifyEmailRequest struct {
 
 func (x *VerifyEmailRequest) Reset() {
 	*x = VerifyEmailRequest{}
-	mi := &file_ethos_auth_v1_messages_proto_msgTypes[23]
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1422,7 +2603,7 @@ func (x *VerifyEmailRequest) String() string {
 func (*VerifyEmailRequest) ProtoMessage() {}
 
 func (x *VerifyEmailRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_auth_v1_messages_proto_msgTypes[23]
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1435,7 +2616,7 @@ func (x *VerifyEmailRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerifyEmailRequest.ProtoReflect.Descriptor instead.
 func (*VerifyEmailRequest) Descriptor() ([]byte, []int) {
-	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{23}
+	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *VerifyEmailRequest) GetEmail() string {
@@ -1463,7 +2644,7 @@ type ResendVerificationRequest struct {
 
 func (x *ResendVerificationRequest) Reset() {
 	*x = ResendVerificationRequest{}
-	mi := &file_ethos_auth_v1_messages_proto_msgTypes[24]
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1475,7 +2656,7 @@ func (x *ResendVerificationRequest) String() string {
 func (*ResendVerificationRequest) ProtoMessage() {}
 
 func (x *ResendVerificationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_auth_v1_messages_proto_msgTypes[24]
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1488,7 +2669,7 @@ func (x *ResendVerificationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResendVerificationRequest.ProtoReflect.Descriptor instead.
 func (*ResendVerificationRequest) Descriptor() ([]byte, []int) {
-	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{24}
+	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *ResendVerificationRequest) GetEmail() string {
@@ -1509,7 +2690,7 @@ type ForgotPasswordRequest struct {
 
 func (x *ForgotPasswordRequest) Reset() {
 	*x = ForgotPasswordRequest{}
-	mi := &file_ethos_auth_v1_messages_proto_msgTypes[25]
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1521,7 +2702,7 @@ func (x *ForgotPasswordRequest) String() string {
 func (*ForgotPasswordRequest) ProtoMessage() {}
 
 func (x *ForgotPasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_auth_v1_messages_proto_msgTypes[25]
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1534,7 +2715,7 @@ func (x *ForgotPasswordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ForgotPasswordRequest.ProtoReflect.Descriptor instead.
 func (*ForgotPasswordRequest) Descriptor() ([]byte, []int) {
-	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{25}
+	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *ForgotPasswordRequest) GetEmail() string {
@@ -1559,7 +2740,7 @@ type ResetPasswordRequest struct {
 
 func (x *ResetPasswordRequest) Reset() {
 	*x = ResetPasswordRequest{}
-	mi := &file_ethos_auth_v1_messages_proto_msgTypes[26]
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1571,7 +2752,7 @@ func (x *ResetPasswordRequest) String() string {
 func (*ResetPasswordRequest) ProtoMessage() {}
 
 func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_auth_v1_messages_proto_msgTypes[26]
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1584,7 +2765,7 @@ func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResetPasswordRequest.ProtoReflect.Descriptor instead.
 func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
-	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{26}
+	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *ResetPasswordRequest) GetEmail() string {
@@ -1617,7 +2798,7 @@ type ExportUserDataRequest struct {
 
 func (x *ExportUserDataRequest) Reset() {
 	*x = ExportUserDataRequest{}
-	mi := &file_ethos_auth_v1_messages_proto_msgTypes[27]
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1629,7 +2810,7 @@ func (x *ExportUserDataRequest) String() string {
 func (*ExportUserDataRequest) ProtoMessage() {}
 
 func (x *ExportUserDataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_auth_v1_messages_proto_msgTypes[27]
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1642,7 +2823,7 @@ func (x *ExportUserDataRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExportUserDataRequest.ProtoReflect.Descriptor instead.
 func (*ExportUserDataRequest) Descriptor() ([]byte, []int) {
-	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{27}
+	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{46}
 }
 
 // ExportUserDataResponse contains exported user data.
@@ -1658,7 +2839,7 @@ type ExportUserDataResponse struct {
 
 func (x *ExportUserDataResponse) Reset() {
 	*x = ExportUserDataResponse{}
-	mi := &file_ethos_auth_v1_messages_proto_msgTypes[28]
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1670,7 +2851,7 @@ func (x *ExportUserDataResponse) String() string {
 func (*ExportUserDataResponse) ProtoMessage() {}
 
 func (x *ExportUserDataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_auth_v1_messages_proto_msgTypes[28]
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1683,7 +2864,7 @@ func (x *ExportUserDataResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExportUserDataResponse.ProtoReflect.Descriptor instead.
 func (*ExportUserDataResponse) Descriptor() ([]byte, []int) {
-	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{28}
+	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *ExportUserDataResponse) GetSuccess() bool {
@@ -1711,7 +2892,7 @@ type DeleteAccountRequest struct {
 
 func (x *DeleteAccountRequest) Reset() {
 	*x = DeleteAccountRequest{}
-	mi := &file_ethos_auth_v1_messages_proto_msgTypes[29]
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1723,7 +2904,7 @@ func (x *DeleteAccountRequest) String() string {
 func (*DeleteAccountRequest) ProtoMessage() {}
 
 func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_auth_v1_messages_proto_msgTypes[29]
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1736,7 +2917,7 @@ func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteAccountRequest.ProtoReflect.Descriptor instead.
 func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
-	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{29}
+	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *DeleteAccountRequest) GetPassword() string {
@@ -1746,6 +2927,173 @@ func (x *DeleteAccountRequest) GetPassword() string {
 	return ""
 }
 
+// DeactivateAccountRequest deactivates the caller's account, taken from the
+// access token.
+type DeactivateAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateAccountRequest) Reset() {
+	*x = DeactivateAccountRequest{}
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateAccountRequest) ProtoMessage() {}
+
+func (x *DeactivateAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeactivateAccountRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{49}
+}
+
+// IntrospectTokenRequest contains the access token to check.
+type IntrospectTokenRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Access token (without the "Bearer " prefix).
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IntrospectTokenRequest) Reset() {
+	*x = IntrospectTokenRequest{}
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntrospectTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntrospectTokenRequest) ProtoMessage() {}
+
+func (x *IntrospectTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntrospectTokenRequest.ProtoReflect.Descriptor instead.
+func (*IntrospectTokenRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *IntrospectTokenRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// IntrospectTokenResponse describes an access token.
+// Only active is set when the token is not active.
+type IntrospectTokenResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the token is valid, unexpired and its session is not revoked.
+	Active bool `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
+	// User ID the token was issued to.
+	Sub string `protobuf:"bytes,2,opt,name=sub,proto3" json:"sub,omitempty"`
+	// Session the token belongs to.
+	SessionId string `protobuf:"bytes,3,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// Expiration time (Unix timestamp).
+	Exp int64 `protobuf:"varint,4,opt,name=exp,proto3" json:"exp,omitempty"`
+	// Issue time (Unix timestamp).
+	Iat           int64 `protobuf:"varint,5,opt,name=iat,proto3" json:"iat,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IntrospectTokenResponse) Reset() {
+	*x = IntrospectTokenResponse{}
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntrospectTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntrospectTokenResponse) ProtoMessage() {}
+
+func (x *IntrospectTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_auth_v1_messages_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntrospectTokenResponse.ProtoReflect.Descriptor instead.
+func (*IntrospectTokenResponse) Descriptor() ([]byte, []int) {
+	return file_ethos_auth_v1_messages_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *IntrospectTokenResponse) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *IntrospectTokenResponse) GetSub() string {
+	if x != nil {
+		return x.Sub
+	}
+	return ""
+}
+
+func (x *IntrospectTokenResponse) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *IntrospectTokenResponse) GetExp() int64 {
+	if x != nil {
+		return x.Exp
+	}
+	return 0
+}
+
+func (x *IntrospectTokenResponse) GetIat() int64 {
+	if x != nil {
+		return x.Iat
+	}
+	return 0
+}
+
 var File_ethos_auth_v1_messages_proto protoreflect.FileDescriptor
 
 const file_ethos_auth_v1_messages_proto_rawDesc = "" +
@@ -1762,10 +3110,13 @@ const file_ethos_auth_v1_messages_proto_rawDesc = "" +
 	"\fRegisterData\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12\x12\n" +
-	"\x04name\x18\x03 \x01(\tR\x04name\"@\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"`\n" +
 	"\fLoginRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"W\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x1e\n" +
+	"\n" +
+	"reactivate\x18\x03 \x01(\bR\n" +
+	"reactivate\"W\n" +
 	"\rLoginResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12,\n" +
 	"\x04data\x18\x02 \x01(\v2\x18.ethos.auth.v1.LoginDataR\x04data\"\xaa\x01\n" +
@@ -1782,9 +3133,34 @@ const file_ethos_auth_v1_messages_proto_rawDesc = "" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x122\n" +
 	"\x04data\x18\x02 \x01(\v2\x1e.ethos.auth.v1.GoogleLoginDataR\x04data\"#\n" +
 	"\x0fGoogleLoginData\x12\x10\n" +
-	"\x03url\x18\x01 \x01(\tR\x03url\"+\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\"K\n" +
 	"\x15GoogleCallbackRequest\x12\x12\n" +
-	"\x04code\x18\x01 \x01(\tR\x04code\".\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x1e\n" +
+	"\n" +
+	"reactivate\x18\x02 \x01(\bR\n" +
+	"reactivate\"\x11\n" +
+	"\x0fSSOLoginRequest\"]\n" +
+	"\x10SSOLoginResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12/\n" +
+	"\x04data\x18\x02 \x01(\v2\x1b.ethos.auth.v1.SSOLoginDataR\x04data\"6\n" +
+	"\fSSOLoginData\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\"^\n" +
+	"\x12SSOCallbackRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x1e\n" +
+	"\n" +
+	"reactivate\x18\x02 \x01(\bR\n" +
+	"reactivate\x12\x14\n" +
+	"\x05state\x18\x03 \x01(\tR\x05state\"/\n" +
+	"\x17RequestMagicLinkRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\"N\n" +
+	"\x16VerifyMagicLinkRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1e\n" +
+	"\n" +
+	"reactivate\x18\x02 \x01(\bR\n" +
+	"reactivate\":\n" +
+	"\x13RefreshTokenRequest\x12#\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\".\n" +
 	"\rLogoutRequest\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\"+\n" +
@@ -1802,7 +3178,7 @@ const file_ethos_auth_v1_messages_proto_rawDesc = "" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12*\n" +
 	"\x04data\x18\x03 \x03(\v2\x16.ethos.auth.v1.SessionR\x04data\x12)\n" +
-	"\x04meta\x18\x04 \x01(\v2\x15.ethos.common.v1.MetaR\x04meta\"\xb5\x02\n" +
+	"\x04meta\x18\x04 \x01(\v2\x15.ethos.common.v1.MetaR\x04meta\"\xe3\x02\n" +
 	"\aSession\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1d\n" +
@@ -1817,7 +3193,16 @@ const file_ethos_auth_v1_messages_proto_rawDesc = "" +
 	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1b\n" +
 	"\tis_active\x18\a \x01(\bR\bisActive\x12\x1d\n" +
 	"\n" +
-	"is_current\x18\b \x01(\bR\tisCurrent\"\x1c\n" +
+	"is_current\x18\b \x01(\bR\tisCurrent\x12\x12\n" +
+	"\x04city\x18\t \x01(\tR\x04city\x12\x18\n" +
+	"\acountry\x18\n" +
+	" \x01(\tR\acountry\"5\n" +
+	"\x14RevokeSessionRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"K\n" +
+	"\x15RevokeSessionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x1c\n" +
 	"\x1aRevokeOtherSessionsRequest\"v\n" +
 	"\x1bRevokeOtherSessionsResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
@@ -1827,21 +3212,81 @@ const file_ethos_auth_v1_messages_proto_rawDesc = "" +
 	"\x0fProfileResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12.\n" +
-	"\x04data\x18\x03 \x01(\v2\x1a.ethos.auth.v1.ProfileDataR\x04data\"\xa7\x01\n" +
+	"\x04data\x18\x03 \x01(\v2\x1a.ethos.auth.v1.ProfileDataR\x04data\"\xcc\x02\n" +
 	"\vProfileData\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
 	"\x05email\x18\x03 \x01(\tR\x05email\x12\x1a\n" +
 	"\btimezone\x18\x04 \x01(\tR\btimezone\x129\n" +
 	"\n" +
-	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x8b\x01\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12'\n" +
+	"\rlog_lock_days\x18\x06 \x01(\x05H\x00R\vlogLockDays\x88\x01\x01\x12\x16\n" +
+	"\x06locale\x18\a \x01(\tR\x06locale\x12\x1d\n" +
+	"\n" +
+	"week_start\x18\b \x01(\tR\tweekStart\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\t \x01(\tR\tavatarUrl\x12\x12\n" +
+	"\x04role\x18\n" +
+	" \x01(\tR\x04roleB\x10\n" +
+	"\x0e_log_lock_days\"\xa1\x02\n" +
 	"\x14UpdateProfileRequest\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x19\n" +
 	"\x05email\x18\x02 \x01(\tH\x01R\x05email\x88\x01\x01\x12\x1f\n" +
-	"\btimezone\x18\x03 \x01(\tH\x02R\btimezone\x88\x01\x01B\a\n" +
+	"\btimezone\x18\x03 \x01(\tH\x02R\btimezone\x88\x01\x01\x12'\n" +
+	"\rlog_lock_days\x18\x04 \x01(\x05H\x03R\vlogLockDays\x88\x01\x01\x12\x1b\n" +
+	"\x06locale\x18\x05 \x01(\tH\x04R\x06locale\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"week_start\x18\x06 \x01(\tH\x05R\tweekStart\x88\x01\x01B\a\n" +
 	"\x05_nameB\b\n" +
 	"\x06_emailB\v\n" +
-	"\t_timezone\"e\n" +
+	"\t_timezoneB\x10\n" +
+	"\x0e_log_lock_daysB\t\n" +
+	"\a_localeB\r\n" +
+	"\v_week_start\"\x17\n" +
+	"\x15GetPreferencesRequest\"}\n" +
+	"\x13PreferencesResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x122\n" +
+	"\x04data\x18\x03 \x01(\v2\x1e.ethos.auth.v1.UserPreferencesR\x04data\"\xb1\x01\n" +
+	"\x0fUserPreferences\x12\x1d\n" +
+	"\n" +
+	"week_start\x18\x01 \x01(\tR\tweekStart\x12\x16\n" +
+	"\x06locale\x18\x02 \x01(\tR\x06locale\x12\x14\n" +
+	"\x05theme\x18\x03 \x01(\tR\x05theme\x127\n" +
+	"\x15default_reminder_hour\x18\x04 \x01(\x05H\x00R\x13defaultReminderHour\x88\x01\x01B\x18\n" +
+	"\x16_default_reminder_hour\"\xac\x02\n" +
+	"\x18UpdatePreferencesRequest\x12\"\n" +
+	"\n" +
+	"week_start\x18\x01 \x01(\tH\x00R\tweekStart\x88\x01\x01\x12\x1b\n" +
+	"\x06locale\x18\x02 \x01(\tH\x01R\x06locale\x88\x01\x01\x12\x19\n" +
+	"\x05theme\x18\x03 \x01(\tH\x02R\x05theme\x88\x01\x01\x127\n" +
+	"\x15default_reminder_hour\x18\x04 \x01(\x05H\x03R\x13defaultReminderHour\x88\x01\x01\x12=\n" +
+	"\x1bclear_default_reminder_hour\x18\x05 \x01(\bR\x18clearDefaultReminderHourB\r\n" +
+	"\v_week_startB\t\n" +
+	"\a_localeB\b\n" +
+	"\x06_themeB\x18\n" +
+	"\x16_default_reminder_hour\"\x15\n" +
+	"\x13ListConsentsRequest\"L\n" +
+	"\x14AcceptConsentRequest\x12\x1a\n" +
+	"\bdocument\x18\x01 \x01(\tR\bdocument\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\"s\n" +
+	"\x10ConsentsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12+\n" +
+	"\x04data\x18\x03 \x01(\v2\x17.ethos.auth.v1.ConsentsR\x04data\"x\n" +
+	"\bConsents\x12:\n" +
+	"\tdocuments\x18\x01 \x03(\v2\x1c.ethos.auth.v1.LegalDocumentR\tdocuments\x120\n" +
+	"\ahistory\x18\x02 \x03(\v2\x16.ethos.auth.v1.ConsentR\ahistory\"p\n" +
+	"\rLegalDocument\x12\x1a\n" +
+	"\bdocument\x18\x01 \x01(\tR\bdocument\x12'\n" +
+	"\x0fcurrent_version\x18\x02 \x01(\tR\x0ecurrentVersion\x12\x1a\n" +
+	"\baccepted\x18\x03 \x01(\bR\baccepted\"\x99\x01\n" +
+	"\aConsent\x12\x1a\n" +
+	"\bdocument\x18\x01 \x01(\tR\bdocument\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12;\n" +
+	"\vaccepted_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"acceptedAt\x12\x1b\n" +
+	"\tclient_ip\x18\x04 \x01(\tR\bclientIp\"e\n" +
 	"\x15ChangePasswordRequest\x12)\n" +
 	"\x10current_password\x18\x01 \x01(\tR\x0fcurrentPassword\x12!\n" +
 	"\fnew_password\x18\x02 \x01(\tR\vnewPassword\">\n" +
@@ -1861,8 +3306,18 @@ const file_ethos_auth_v1_messages_proto_rawDesc = "" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12+\n" +
 	"\x04data\x18\x02 \x01(\v2\x17.google.protobuf.StructR\x04data\"2\n" +
 	"\x14DeleteAccountRequest\x12\x1a\n" +
-	"\bpassword\x18\x01 \x01(\tR\bpasswordB\xc3\x01\n" +
-	"\x11com.ethos.auth.v1B\rMessagesProtoP\x01ZIgithub.com/semmidev/ethos-go/internal/generated/grpc/ethos/auth/v1;authv1\xa2\x02\x03EAX\xaa\x02\rEthos.Auth.V1\xca\x02\rEthos\\Auth\\V1\xe2\x02\x19Ethos\\Auth\\V1\\GPBMetadata\xea\x02\x0fEthos::Auth::V1b\x06proto3"
+	"\bpassword\x18\x01 \x01(\tR\bpassword\"\x1a\n" +
+	"\x18DeactivateAccountRequest\".\n" +
+	"\x16IntrospectTokenRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\x86\x01\n" +
+	"\x17IntrospectTokenResponse\x12\x16\n" +
+	"\x06active\x18\x01 \x01(\bR\x06active\x12\x10\n" +
+	"\x03sub\x18\x02 \x01(\tR\x03sub\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x03 \x01(\tR\tsessionId\x12\x10\n" +
+	"\x03exp\x18\x04 \x01(\x03R\x03exp\x12\x10\n" +
+	"\x03iat\x18\x05 \x01(\x03R\x03iatB\xba\x01\n" +
+	"\x11com.ethos.auth.v1B\rMessagesProtoP\x01Z@github.com/semmidev/ethos-go/contracts/grpc/ethos/auth/v1;authv1\xa2\x02\x03EAX\xaa\x02\rEthos.Auth.V1\xca\x02\rEthos\\Auth\\V1\xe2\x02\x19Ethos\\Auth\\V1\\GPBMetadata\xea\x02\x0fEthos::Auth::V1b\x06proto3"
 
 var (
 	file_ethos_auth_v1_messages_proto_rawDescOnce sync.Once
@@ -1876,7 +3331,7 @@ func file_ethos_auth_v1_messages_proto_rawDescGZIP() []byte {
 	return file_ethos_auth_v1_messages_proto_rawDescData
 }
 
-var file_ethos_auth_v1_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
+var file_ethos_auth_v1_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 52)
 var file_ethos_auth_v1_messages_proto_goTypes = []any{
 	(*RegisterRequest)(nil),             // 0: ethos.auth.v1.RegisterRequest
 	(*RegisterResponse)(nil),            // 1: ethos.auth.v1.RegisterResponse
@@ -1888,46 +3343,74 @@ var file_ethos_auth_v1_messages_proto_goTypes = []any{
 	(*GoogleLoginResponse)(nil),         // 7: ethos.auth.v1.GoogleLoginResponse
 	(*GoogleLoginData)(nil),             // 8: ethos.auth.v1.GoogleLoginData
 	(*GoogleCallbackRequest)(nil),       // 9: ethos.auth.v1.GoogleCallbackRequest
-	(*LogoutRequest)(nil),               // 10: ethos.auth.v1.LogoutRequest
-	(*LogoutAllRequest)(nil),            // 11: ethos.auth.v1.LogoutAllRequest
-	(*LogoutResponse)(nil),              // 12: ethos.auth.v1.LogoutResponse
-	(*ListSessionsRequest)(nil),         // 13: ethos.auth.v1.ListSessionsRequest
-	(*ListSessionsResponse)(nil),        // 14: ethos.auth.v1.ListSessionsResponse
-	(*Session)(nil),                     // 15: ethos.auth.v1.Session
-	(*RevokeOtherSessionsRequest)(nil),  // 16: ethos.auth.v1.RevokeOtherSessionsRequest
-	(*RevokeOtherSessionsResponse)(nil), // 17: ethos.auth.v1.RevokeOtherSessionsResponse
-	(*GetProfileRequest)(nil),           // 18: ethos.auth.v1.GetProfileRequest
-	(*ProfileResponse)(nil),             // 19: ethos.auth.v1.ProfileResponse
-	(*ProfileData)(nil),                 // 20: ethos.auth.v1.ProfileData
-	(*UpdateProfileRequest)(nil),        // 21: ethos.auth.v1.UpdateProfileRequest
-	(*ChangePasswordRequest)(nil),       // 22: ethos.auth.v1.ChangePasswordRequest
-	(*VerifyEmailRequest)(nil),          // 23: ethos.auth.v1.VerifyEmailRequest
-	(*ResendVerificationRequest)(nil),   // 24: ethos.auth.v1.ResendVerificationRequest
-	(*ForgotPasswordRequest)(nil),       // 25: ethos.auth.v1.ForgotPasswordRequest
-	(*ResetPasswordRequest)(nil),        // 26: ethos.auth.v1.ResetPasswordRequest
-	(*ExportUserDataRequest)(nil),       // 27: ethos.auth.v1.ExportUserDataRequest
-	(*ExportUserDataResponse)(nil),      // 28: ethos.auth.v1.ExportUserDataResponse
-	(*DeleteAccountRequest)(nil),        // 29: ethos.auth.v1.DeleteAccountRequest
-	(*v1.Meta)(nil),                     // 30: ethos.common.v1.Meta
-	(*timestamppb.Timestamp)(nil),       // 31: google.protobuf.Timestamp
-	(*structpb.Struct)(nil),             // 32: google.protobuf.Struct
+	(*SSOLoginRequest)(nil),             // 10: ethos.auth.v1.SSOLoginRequest
+	(*SSOLoginResponse)(nil),            // 11: ethos.auth.v1.SSOLoginResponse
+	(*SSOLoginData)(nil),                // 12: ethos.auth.v1.SSOLoginData
+	(*SSOCallbackRequest)(nil),          // 13: ethos.auth.v1.SSOCallbackRequest
+	(*RequestMagicLinkRequest)(nil),     // 14: ethos.auth.v1.RequestMagicLinkRequest
+	(*VerifyMagicLinkRequest)(nil),      // 15: ethos.auth.v1.VerifyMagicLinkRequest
+	(*RefreshTokenRequest)(nil),         // 16: ethos.auth.v1.RefreshTokenRequest
+	(*LogoutRequest)(nil),               // 17: ethos.auth.v1.LogoutRequest
+	(*LogoutAllRequest)(nil),            // 18: ethos.auth.v1.LogoutAllRequest
+	(*LogoutResponse)(nil),              // 19: ethos.auth.v1.LogoutResponse
+	(*ListSessionsRequest)(nil),         // 20: ethos.auth.v1.ListSessionsRequest
+	(*ListSessionsResponse)(nil),        // 21: ethos.auth.v1.ListSessionsResponse
+	(*Session)(nil),                     // 22: ethos.auth.v1.Session
+	(*RevokeSessionRequest)(nil),        // 23: ethos.auth.v1.RevokeSessionRequest
+	(*RevokeSessionResponse)(nil),       // 24: ethos.auth.v1.RevokeSessionResponse
+	(*RevokeOtherSessionsRequest)(nil),  // 25: ethos.auth.v1.RevokeOtherSessionsRequest
+	(*RevokeOtherSessionsResponse)(nil), // 26: ethos.auth.v1.RevokeOtherSessionsResponse
+	(*GetProfileRequest)(nil),           // 27: ethos.auth.v1.GetProfileRequest
+	(*ProfileResponse)(nil),             // 28: ethos.auth.v1.ProfileResponse
+	(*ProfileData)(nil),                 // 29: ethos.auth.v1.ProfileData
+	(*UpdateProfileRequest)(nil),        // 30: ethos.auth.v1.UpdateProfileRequest
+	(*GetPreferencesRequest)(nil),       // 31: ethos.auth.v1.GetPreferencesRequest
+	(*PreferencesResponse)(nil),         // 32: ethos.auth.v1.PreferencesResponse
+	(*UserPreferences)(nil),             // 33: ethos.auth.v1.UserPreferences
+	(*UpdatePreferencesRequest)(nil),    // 34: ethos.auth.v1.UpdatePreferencesRequest
+	(*ListConsentsRequest)(nil),         // 35: ethos.auth.v1.ListConsentsRequest
+	(*AcceptConsentRequest)(nil),        // 36: ethos.auth.v1.AcceptConsentRequest
+	(*ConsentsResponse)(nil),            // 37: ethos.auth.v1.ConsentsResponse
+	(*Consents)(nil),                    // 38: ethos.auth.v1.Consents
+	(*LegalDocument)(nil),               // 39: ethos.auth.v1.LegalDocument
+	(*Consent)(nil),                     // 40: ethos.auth.v1.Consent
+	(*ChangePasswordRequest)(nil),       // 41: ethos.auth.v1.ChangePasswordRequest
+	(*VerifyEmailRequest)(nil),          // 42: ethos.auth.v1.VerifyEmailRequest
+	(*ResendVerificationRequest)(nil),   // 43: ethos.auth.v1.ResendVerificationRequest
+	(*ForgotPasswordRequest)(nil),       // 44: ethos.auth.v1.ForgotPasswordRequest
+	(*ResetPasswordRequest)(nil),        // 45: ethos.auth.v1.ResetPasswordRequest
+	(*ExportUserDataRequest)(nil),       // 46: ethos.auth.v1.ExportUserDataRequest
+	(*ExportUserDataResponse)(nil),      // 47: ethos.auth.v1.ExportUserDataResponse
+	(*DeleteAccountRequest)(nil),        // 48: ethos.auth.v1.DeleteAccountRequest
+	(*DeactivateAccountRequest)(nil),    // 49: ethos.auth.v1.DeactivateAccountRequest
+	(*IntrospectTokenRequest)(nil),      // 50: ethos.auth.v1.IntrospectTokenRequest
+	(*IntrospectTokenResponse)(nil),     // 51: ethos.auth.v1.IntrospectTokenResponse
+	(*v1.Meta)(nil),                     // 52: ethos.common.v1.Meta
+	(*timestamppb.Timestamp)(nil),       // 53: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),             // 54: google.protobuf.Struct
 }
 var file_ethos_auth_v1_messages_proto_depIdxs = []int32{
 	2,  // 0: ethos.auth.v1.RegisterResponse.data:type_name -> ethos.auth.v1.RegisterData
 	5,  // 1: ethos.auth.v1.LoginResponse.data:type_name -> ethos.auth.v1.LoginData
 	8,  // 2: ethos.auth.v1.GoogleLoginResponse.data:type_name -> ethos.auth.v1.GoogleLoginData
-	15, // 3: ethos.auth.v1.ListSessionsResponse.data:type_name -> ethos.auth.v1.Session
-	30, // 4: ethos.auth.v1.ListSessionsResponse.meta:type_name -> ethos.common.v1.Meta
-	31, // 5: ethos.auth.v1.Session.expires_at:type_name -> google.protobuf.Timestamp
-	31, // 6: ethos.auth.v1.Session.created_at:type_name -> google.protobuf.Timestamp
-	20, // 7: ethos.auth.v1.ProfileResponse.data:type_name -> ethos.auth.v1.ProfileData
-	31, // 8: ethos.auth.v1.ProfileData.created_at:type_name -> google.protobuf.Timestamp
-	32, // 9: ethos.auth.v1.ExportUserDataResponse.data:type_name -> google.protobuf.Struct
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	12, // 3: ethos.auth.v1.SSOLoginResponse.data:type_name -> ethos.auth.v1.SSOLoginData
+	22, // 4: ethos.auth.v1.ListSessionsResponse.data:type_name -> ethos.auth.v1.Session
+	52, // 5: ethos.auth.v1.ListSessionsResponse.meta:type_name -> ethos.common.v1.Meta
+	53, // 6: ethos.auth.v1.Session.expires_at:type_name -> google.protobuf.Timestamp
+	53, // 7: ethos.auth.v1.Session.created_at:type_name -> google.protobuf.Timestamp
+	29, // 8: ethos.auth.v1.ProfileResponse.data:type_name -> ethos.auth.v1.ProfileData
+	53, // 9: ethos.auth.v1.ProfileData.created_at:type_name -> google.protobuf.Timestamp
+	33, // 10: ethos.auth.v1.PreferencesResponse.data:type_name -> ethos.auth.v1.UserPreferences
+	38, // 11: ethos.auth.v1.ConsentsResponse.data:type_name -> ethos.auth.v1.Consents
+	39, // 12: ethos.auth.v1.Consents.documents:type_name -> ethos.auth.v1.LegalDocument
+	40, // 13: ethos.auth.v1.Consents.history:type_name -> ethos.auth.v1.Consent
+	53, // 14: ethos.auth.v1.Consent.accepted_at:type_name -> google.protobuf.Timestamp
+	54, // 15: ethos.auth.v1.ExportUserDataResponse.data:type_name -> google.protobuf.Struct
+	16, // [16:16] is the sub-list for method output_type
+	16, // [16:16] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_ethos_auth_v1_messages_proto_init() }
@@ -1935,14 +3418,17 @@ func file_ethos_auth_v1_messages_proto_init() {
 	if File_ethos_auth_v1_messages_proto != nil {
 		return
 	}
-	file_ethos_auth_v1_messages_proto_msgTypes[21].OneofWrappers = []any{}
+	file_ethos_auth_v1_messages_proto_msgTypes[29].OneofWrappers = []any{}
+	file_ethos_auth_v1_messages_proto_msgTypes[30].OneofWrappers = []any{}
+	file_ethos_auth_v1_messages_proto_msgTypes[33].OneofWrappers = []any{}
+	file_ethos_auth_v1_messages_proto_msgTypes[34].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ethos_auth_v1_messages_proto_rawDesc), len(file_ethos_auth_v1_messages_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   30,
+			NumMessages:   52,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
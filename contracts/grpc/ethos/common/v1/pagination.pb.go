@@ -262,8 +262,8 @@ const file_ethos_common_v1_pagination_proto_rawDesc = "" +
 	"\x1atotal_data_in_current_page\x18\x06 \x01(\x05R\x16totalDataInCurrentPage\x12\x1b\n" +
 	"\tlast_page\x18\a \x01(\x05R\blastPage\x12\x12\n" +
 	"\x04from\x18\b \x01(\x05R\x04from\x12\x0e\n" +
-	"\x02to\x18\t \x01(\x05R\x02toB\xd3\x01\n" +
-	"\x13com.ethos.common.v1B\x0fPaginationProtoP\x01ZMgithub.com/semmidev/ethos-go/internal/generated/grpc/ethos/common/v1;commonv1\xa2\x02\x03ECX\xaa\x02\x0fEthos.Common.V1\xca\x02\x0fEthos\\Common\\V1\xe2\x02\x1bEthos\\Common\\V1\\GPBMetadata\xea\x02\x11Ethos::Common::V1b\x06proto3"
+	"\x02to\x18\t \x01(\x05R\x02toB\xca\x01\n" +
+	"\x13com.ethos.common.v1B\x0fPaginationProtoP\x01ZDgithub.com/semmidev/ethos-go/contracts/grpc/ethos/common/v1;commonv1\xa2\x02\x03ECX\xaa\x02\x0fEthos.Common.V1\xca\x02\x0fEthos\\Common\\V1\xe2\x02\x1bEthos\\Common\\V1\\GPBMetadata\xea\x02\x11Ethos::Common::V1b\x06proto3"
 
 var (
 	file_ethos_common_v1_pagination_proto_rawDescOnce sync.Once
@@ -121,9 +121,9 @@ const file_ethos_common_v1_types_proto_rawDesc = "" +
 	"\x05Empty\"E\n" +
 	"\x0fSuccessResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessageB\xce\x01\n" +
+	"\amessage\x18\x02 \x01(\tR\amessageB\xc5\x01\n" +
 	"\x13com.ethos.common.v1B\n" +
-	"TypesProtoP\x01ZMgithub.com/semmidev/ethos-go/internal/generated/grpc/ethos/common/v1;commonv1\xa2\x02\x03ECX\xaa\x02\x0fEthos.Common.V1\xca\x02\x0fEthos\\Common\\V1\xe2\x02\x1bEthos\\Common\\V1\\GPBMetadata\xea\x02\x11Ethos::Common::V1b\x06proto3"
+	"TypesProtoP\x01ZDgithub.com/semmidev/ethos-go/contracts/grpc/ethos/common/v1;commonv1\xa2\x02\x03ECX\xaa\x02\x0fEthos.Common.V1\xca\x02\x0fEthos\\Common\\V1\xe2\x02\x1bEthos\\Common\\V1\\GPBMetadata\xea\x02\x11Ethos::Common::V1b\x06proto3"
 
 var (
 	file_ethos_common_v1_types_proto_rawDescOnce sync.Once
@@ -0,0 +1,269 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: ethos/habits/v1/habits_service.proto
+
+package habitsv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SuccessResponse for simple success/failure responses.
+type SuccessResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the operation was successful.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Human-readable message describing the result.
+	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuccessResponse) Reset() {
+	*x = SuccessResponse{}
+	mi := &file_ethos_habits_v1_habits_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuccessResponse) ProtoMessage() {}
+
+func (x *SuccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_habits_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuccessResponse.ProtoReflect.Descriptor instead.
+func (*SuccessResponse) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_habits_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SuccessResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SuccessResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_ethos_habits_v1_habits_service_proto protoreflect.FileDescriptor
+
+const file_ethos_habits_v1_habits_service_proto_rawDesc = "" +
+	"\n" +
+	"$ethos/habits/v1/habits_service.proto\x12\x0fethos.habits.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1eethos/habits/v1/messages.proto\"E\n" +
+	"\x0fSuccessResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage2\xe8\x1b\n" +
+	"\rHabitsService\x12i\n" +
+	"\n" +
+	"ListHabits\x12\".ethos.habits.v1.ListHabitsRequest\x1a#.ethos.habits.v1.ListHabitsResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/v1/habits\x12i\n" +
+	"\vCreateHabit\x12#.ethos.habits.v1.CreateHabitRequest\x1a\x1e.ethos.habits.v1.HabitResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
+	"/v1/habits\x12k\n" +
+	"\bGetHabit\x12 .ethos.habits.v1.GetHabitRequest\x1a\x1e.ethos.habits.v1.HabitResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/habits/{habit_id}\x12t\n" +
+	"\vUpdateHabit\x12#.ethos.habits.v1.UpdateHabitRequest\x1a\x1e.ethos.habits.v1.HabitResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\x1a\x15/v1/habits/{habit_id}\x12s\n" +
+	"\vDeleteHabit\x12#.ethos.habits.v1.DeleteHabitRequest\x1a .ethos.habits.v1.SuccessResponse\"\x1d\x82\xd3\xe4\x93\x02\x17*\x15/v1/habits/{habit_id}\x12\x80\x01\n" +
+	"\rActivateHabit\x12%.ethos.habits.v1.ActivateHabitRequest\x1a .ethos.habits.v1.SuccessResponse\"&\x82\xd3\xe4\x93\x02 \"\x1e/v1/habits/{habit_id}/activate\x12\x86\x01\n" +
+	"\x0fDeactivateHabit\x12'.ethos.habits.v1.DeactivateHabitRequest\x1a .ethos.habits.v1.SuccessResponse\"(\x82\xd3\xe4\x93\x02\"\" /v1/habits/{habit_id}/deactivate\x12\x80\x01\n" +
+	"\rGetHabitStats\x12%.ethos.habits.v1.GetHabitStatsRequest\x1a#.ethos.habits.v1.HabitStatsResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/habits/{habit_id}/stats\x12\x8c\x01\n" +
+	"\x10GetHabitInsights\x12(.ethos.habits.v1.GetHabitInsightsRequest\x1a&.ethos.habits.v1.HabitInsightsResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v1/habits/{habit_id}/insights\x12v\n" +
+	"\bLogHabit\x12 .ethos.habits.v1.LogHabitRequest\x1a!.ethos.habits.v1.LogHabitResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/habits/{habit_id}/logs\x12\x7f\n" +
+	"\fGetHabitLogs\x12$.ethos.habits.v1.GetHabitLogsRequest\x1a%.ethos.habits.v1.GetHabitLogsResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/habits/{habit_id}/logs\x12~\n" +
+	"\x0eUpdateHabitLog\x12&.ethos.habits.v1.UpdateHabitLogRequest\x1a .ethos.habits.v1.SuccessResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\x1a\x17/v1/habit-logs/{log_id}\x12{\n" +
+	"\x0eDeleteHabitLog\x12&.ethos.habits.v1.DeleteHabitLogRequest\x1a .ethos.habits.v1.SuccessResponse\"\x1f\x82\xd3\xe4\x93\x02\x19*\x17/v1/habit-logs/{log_id}\x12\x82\x01\n" +
+	"\fUndoHabitLog\x12$.ethos.habits.v1.UndoHabitLogRequest\x1a .ethos.habits.v1.SuccessResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/habits/{habit_id}/logs/undo\x12\x8c\x01\n" +
+	"\x10SetHabitLogCount\x12(.ethos.habits.v1.SetHabitLogCountRequest\x1a!.ethos.habits.v1.LogHabitResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\x1a /v1/habits/{habit_id}/logs/count\x12o\n" +
+	"\fGetDashboard\x12$.ethos.habits.v1.GetDashboardRequest\x1a\".ethos.habits.v1.DashboardResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/dashboard\x12\x88\x01\n" +
+	"\x12GetWeeklyAnalytics\x12*.ethos.habits.v1.GetWeeklyAnalyticsRequest\x1a(.ethos.habits.v1.WeeklyAnalyticsResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/analytics/weekly\x12\x82\x01\n" +
+	"\x0eComparePeriods\x12&.ethos.habits.v1.ComparePeriodsRequest\x1a).ethos.habits.v1.PeriodComparisonResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/analytics/compare\x12\x94\x01\n" +
+	"\x14GetHabitCorrelations\x12,.ethos.habits.v1.GetHabitCorrelationsRequest\x1a*.ethos.habits.v1.HabitCorrelationsResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/analytics/correlations\x12u\n" +
+	"\rReorderHabits\x12%.ethos.habits.v1.ReorderHabitsRequest\x1a .ethos.habits.v1.SuccessResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\x1a\x10/v1/habits/order\x12z\n" +
+	"\n" +
+	"PauseHabit\x12\".ethos.habits.v1.PauseHabitRequest\x1a .ethos.habits.v1.SuccessResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/habits/{habit_id}/pause\x12\x85\x01\n" +
+	"\rStartVacation\x12%.ethos.habits.v1.StartVacationRequest\x1a!.ethos.habits.v1.VacationResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/habits/{habit_id}/vacations\x12\x92\x01\n" +
+	"\vEndVacation\x12#.ethos.habits.v1.EndVacationRequest\x1a .ethos.habits.v1.SuccessResponse\"<\x82\xd3\xe4\x93\x026:\x01*\"1/v1/habits/{habit_id}/vacations/{vacation_id}/end\x12\x87\x01\n" +
+	"\rListVacations\x12%.ethos.habits.v1.ListVacationsRequest\x1a&.ethos.habits.v1.ListVacationsResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/habits/{habit_id}/vacations\x12~\n" +
+	"\rPreviewImport\x12%.ethos.habits.v1.PreviewImportRequest\x1a&.ethos.habits.v1.ImportPreviewResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/imports/preview\x12k\n" +
+	"\vStartImport\x12#.ethos.habits.v1.StartImportRequest\x1a\x1f.ethos.habits.v1.ImportResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/v1/imports\x12p\n" +
+	"\tGetImport\x12!.ethos.habits.v1.GetImportRequest\x1a\x1f.ethos.habits.v1.ImportResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/imports/{import_id}\x12p\n" +
+	"\x13RecomputeHabitStats\x12+.ethos.habits.v1.RecomputeHabitStatsRequest\x1a,.ethos.habits.v1.RecomputeHabitStatsResponseB\xcd\x01\n" +
+	"\x13com.ethos.habits.v1B\x12HabitsServiceProtoP\x01ZDgithub.com/semmidev/ethos-go/contracts/grpc/ethos/habits/v1;habitsv1\xa2\x02\x03EHX\xaa\x02\x0fEthos.Habits.V1\xca\x02\x0fEthos\\Habits\\V1\xe2\x02\x1bEthos\\Habits\\V1\\GPBMetadata\xea\x02\x11Ethos::Habits::V1b\x06proto3"
+
+var (
+	file_ethos_habits_v1_habits_service_proto_rawDescOnce sync.Once
+	file_ethos_habits_v1_habits_service_proto_rawDescData []byte
+)
+
+func file_ethos_habits_v1_habits_service_proto_rawDescGZIP() []byte {
+	file_ethos_habits_v1_habits_service_proto_rawDescOnce.Do(func() {
+		file_ethos_habits_v1_habits_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ethos_habits_v1_habits_service_proto_rawDesc), len(file_ethos_habits_v1_habits_service_proto_rawDesc)))
+	})
+	return file_ethos_habits_v1_habits_service_proto_rawDescData
+}
+
+var file_ethos_habits_v1_habits_service_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_ethos_habits_v1_habits_service_proto_goTypes = []any{
+	(*SuccessResponse)(nil),             // 0: ethos.habits.v1.SuccessResponse
+	(*ListHabitsRequest)(nil),           // 1: ethos.habits.v1.ListHabitsRequest
+	(*CreateHabitRequest)(nil),          // 2: ethos.habits.v1.CreateHabitRequest
+	(*GetHabitRequest)(nil),             // 3: ethos.habits.v1.GetHabitRequest
+	(*UpdateHabitRequest)(nil),          // 4: ethos.habits.v1.UpdateHabitRequest
+	(*DeleteHabitRequest)(nil),          // 5: ethos.habits.v1.DeleteHabitRequest
+	(*ActivateHabitRequest)(nil),        // 6: ethos.habits.v1.ActivateHabitRequest
+	(*DeactivateHabitRequest)(nil),      // 7: ethos.habits.v1.DeactivateHabitRequest
+	(*GetHabitStatsRequest)(nil),        // 8: ethos.habits.v1.GetHabitStatsRequest
+	(*GetHabitInsightsRequest)(nil),     // 9: ethos.habits.v1.GetHabitInsightsRequest
+	(*LogHabitRequest)(nil),             // 10: ethos.habits.v1.LogHabitRequest
+	(*GetHabitLogsRequest)(nil),         // 11: ethos.habits.v1.GetHabitLogsRequest
+	(*UpdateHabitLogRequest)(nil),       // 12: ethos.habits.v1.UpdateHabitLogRequest
+	(*DeleteHabitLogRequest)(nil),       // 13: ethos.habits.v1.DeleteHabitLogRequest
+	(*UndoHabitLogRequest)(nil),         // 14: ethos.habits.v1.UndoHabitLogRequest
+	(*SetHabitLogCountRequest)(nil),     // 15: ethos.habits.v1.SetHabitLogCountRequest
+	(*GetDashboardRequest)(nil),         // 16: ethos.habits.v1.GetDashboardRequest
+	(*GetWeeklyAnalyticsRequest)(nil),   // 17: ethos.habits.v1.GetWeeklyAnalyticsRequest
+	(*ComparePeriodsRequest)(nil),       // 18: ethos.habits.v1.ComparePeriodsRequest
+	(*GetHabitCorrelationsRequest)(nil), // 19: ethos.habits.v1.GetHabitCorrelationsRequest
+	(*ReorderHabitsRequest)(nil),        // 20: ethos.habits.v1.ReorderHabitsRequest
+	(*PauseHabitRequest)(nil),           // 21: ethos.habits.v1.PauseHabitRequest
+	(*StartVacationRequest)(nil),        // 22: ethos.habits.v1.StartVacationRequest
+	(*EndVacationRequest)(nil),          // 23: ethos.habits.v1.EndVacationRequest
+	(*ListVacationsRequest)(nil),        // 24: ethos.habits.v1.ListVacationsRequest
+	(*PreviewImportRequest)(nil),        // 25: ethos.habits.v1.PreviewImportRequest
+	(*StartImportRequest)(nil),          // 26: ethos.habits.v1.StartImportRequest
+	(*GetImportRequest)(nil),            // 27: ethos.habits.v1.GetImportRequest
+	(*RecomputeHabitStatsRequest)(nil),  // 28: ethos.habits.v1.RecomputeHabitStatsRequest
+	(*ListHabitsResponse)(nil),          // 29: ethos.habits.v1.ListHabitsResponse
+	(*HabitResponse)(nil),               // 30: ethos.habits.v1.HabitResponse
+	(*HabitStatsResponse)(nil),          // 31: ethos.habits.v1.HabitStatsResponse
+	(*HabitInsightsResponse)(nil),       // 32: ethos.habits.v1.HabitInsightsResponse
+	(*LogHabitResponse)(nil),            // 33: ethos.habits.v1.LogHabitResponse
+	(*GetHabitLogsResponse)(nil),        // 34: ethos.habits.v1.GetHabitLogsResponse
+	(*DashboardResponse)(nil),           // 35: ethos.habits.v1.DashboardResponse
+	(*WeeklyAnalyticsResponse)(nil),     // 36: ethos.habits.v1.WeeklyAnalyticsResponse
+	(*PeriodComparisonResponse)(nil),    // 37: ethos.habits.v1.PeriodComparisonResponse
+	(*HabitCorrelationsResponse)(nil),   // 38: ethos.habits.v1.HabitCorrelationsResponse
+	(*VacationResponse)(nil),            // 39: ethos.habits.v1.VacationResponse
+	(*ListVacationsResponse)(nil),       // 40: ethos.habits.v1.ListVacationsResponse
+	(*ImportPreviewResponse)(nil),       // 41: ethos.habits.v1.ImportPreviewResponse
+	(*ImportResponse)(nil),              // 42: ethos.habits.v1.ImportResponse
+	(*RecomputeHabitStatsResponse)(nil), // 43: ethos.habits.v1.RecomputeHabitStatsResponse
+}
+var file_ethos_habits_v1_habits_service_proto_depIdxs = []int32{
+	1,  // 0: ethos.habits.v1.HabitsService.ListHabits:input_type -> ethos.habits.v1.ListHabitsRequest
+	2,  // 1: ethos.habits.v1.HabitsService.CreateHabit:input_type -> ethos.habits.v1.CreateHabitRequest
+	3,  // 2: ethos.habits.v1.HabitsService.GetHabit:input_type -> ethos.habits.v1.GetHabitRequest
+	4,  // 3: ethos.habits.v1.HabitsService.UpdateHabit:input_type -> ethos.habits.v1.UpdateHabitRequest
+	5,  // 4: ethos.habits.v1.HabitsService.DeleteHabit:input_type -> ethos.habits.v1.DeleteHabitRequest
+	6,  // 5: ethos.habits.v1.HabitsService.ActivateHabit:input_type -> ethos.habits.v1.ActivateHabitRequest
+	7,  // 6: ethos.habits.v1.HabitsService.DeactivateHabit:input_type -> ethos.habits.v1.DeactivateHabitRequest
+	8,  // 7: ethos.habits.v1.HabitsService.GetHabitStats:input_type -> ethos.habits.v1.GetHabitStatsRequest
+	9,  // 8: ethos.habits.v1.HabitsService.GetHabitInsights:input_type -> ethos.habits.v1.GetHabitInsightsRequest
+	10, // 9: ethos.habits.v1.HabitsService.LogHabit:input_type -> ethos.habits.v1.LogHabitRequest
+	11, // 10: ethos.habits.v1.HabitsService.GetHabitLogs:input_type -> ethos.habits.v1.GetHabitLogsRequest
+	12, // 11: ethos.habits.v1.HabitsService.UpdateHabitLog:input_type -> ethos.habits.v1.UpdateHabitLogRequest
+	13, // 12: ethos.habits.v1.HabitsService.DeleteHabitLog:input_type -> ethos.habits.v1.DeleteHabitLogRequest
+	14, // 13: ethos.habits.v1.HabitsService.UndoHabitLog:input_type -> ethos.habits.v1.UndoHabitLogRequest
+	15, // 14: ethos.habits.v1.HabitsService.SetHabitLogCount:input_type -> ethos.habits.v1.SetHabitLogCountRequest
+	16, // 15: ethos.habits.v1.HabitsService.GetDashboard:input_type -> ethos.habits.v1.GetDashboardRequest
+	17, // 16: ethos.habits.v1.HabitsService.GetWeeklyAnalytics:input_type -> ethos.habits.v1.GetWeeklyAnalyticsRequest
+	18, // 17: ethos.habits.v1.HabitsService.ComparePeriods:input_type -> ethos.habits.v1.ComparePeriodsRequest
+	19, // 18: ethos.habits.v1.HabitsService.GetHabitCorrelations:input_type -> ethos.habits.v1.GetHabitCorrelationsRequest
+	20, // 19: ethos.habits.v1.HabitsService.ReorderHabits:input_type -> ethos.habits.v1.ReorderHabitsRequest
+	21, // 20: ethos.habits.v1.HabitsService.PauseHabit:input_type -> ethos.habits.v1.PauseHabitRequest
+	22, // 21: ethos.habits.v1.HabitsService.StartVacation:input_type -> ethos.habits.v1.StartVacationRequest
+	23, // 22: ethos.habits.v1.HabitsService.EndVacation:input_type -> ethos.habits.v1.EndVacationRequest
+	24, // 23: ethos.habits.v1.HabitsService.ListVacations:input_type -> ethos.habits.v1.ListVacationsRequest
+	25, // 24: ethos.habits.v1.HabitsService.PreviewImport:input_type -> ethos.habits.v1.PreviewImportRequest
+	26, // 25: ethos.habits.v1.HabitsService.StartImport:input_type -> ethos.habits.v1.StartImportRequest
+	27, // 26: ethos.habits.v1.HabitsService.GetImport:input_type -> ethos.habits.v1.GetImportRequest
+	28, // 27: ethos.habits.v1.HabitsService.RecomputeHabitStats:input_type -> ethos.habits.v1.RecomputeHabitStatsRequest
+	29, // 28: ethos.habits.v1.HabitsService.ListHabits:output_type -> ethos.habits.v1.ListHabitsResponse
+	30, // 29: ethos.habits.v1.HabitsService.CreateHabit:output_type -> ethos.habits.v1.HabitResponse
+	30, // 30: ethos.habits.v1.HabitsService.GetHabit:output_type -> ethos.habits.v1.HabitResponse
+	30, // 31: ethos.habits.v1.HabitsService.UpdateHabit:output_type -> ethos.habits.v1.HabitResponse
+	0,  // 32: ethos.habits.v1.HabitsService.DeleteHabit:output_type -> ethos.habits.v1.SuccessResponse
+	0,  // 33: ethos.habits.v1.HabitsService.ActivateHabit:output_type -> ethos.habits.v1.SuccessResponse
+	0,  // 34: ethos.habits.v1.HabitsService.DeactivateHabit:output_type -> ethos.habits.v1.SuccessResponse
+	31, // 35: ethos.habits.v1.HabitsService.GetHabitStats:output_type -> ethos.habits.v1.HabitStatsResponse
+	32, // 36: ethos.habits.v1.HabitsService.GetHabitInsights:output_type -> ethos.habits.v1.HabitInsightsResponse
+	33, // 37: ethos.habits.v1.HabitsService.LogHabit:output_type -> ethos.habits.v1.LogHabitResponse
+	34, // 38: ethos.habits.v1.HabitsService.GetHabitLogs:output_type -> ethos.habits.v1.GetHabitLogsResponse
+	0,  // 39: ethos.habits.v1.HabitsService.UpdateHabitLog:output_type -> ethos.habits.v1.SuccessResponse
+	0,  // 40: ethos.habits.v1.HabitsService.DeleteHabitLog:output_type -> ethos.habits.v1.SuccessResponse
+	0,  // 41: ethos.habits.v1.HabitsService.UndoHabitLog:output_type -> ethos.habits.v1.SuccessResponse
+	33, // 42: ethos.habits.v1.HabitsService.SetHabitLogCount:output_type -> ethos.habits.v1.LogHabitResponse
+	35, // 43: ethos.habits.v1.HabitsService.GetDashboard:output_type -> ethos.habits.v1.DashboardResponse
+	36, // 44: ethos.habits.v1.HabitsService.GetWeeklyAnalytics:output_type -> ethos.habits.v1.WeeklyAnalyticsResponse
+	37, // 45: ethos.habits.v1.HabitsService.ComparePeriods:output_type -> ethos.habits.v1.PeriodComparisonResponse
+	38, // 46: ethos.habits.v1.HabitsService.GetHabitCorrelations:output_type -> ethos.habits.v1.HabitCorrelationsResponse
+	0,  // 47: ethos.habits.v1.HabitsService.ReorderHabits:output_type -> ethos.habits.v1.SuccessResponse
+	0,  // 48: ethos.habits.v1.HabitsService.PauseHabit:output_type -> ethos.habits.v1.SuccessResponse
+	39, // 49: ethos.habits.v1.HabitsService.StartVacation:output_type -> ethos.habits.v1.VacationResponse
+	0,  // 50: ethos.habits.v1.HabitsService.EndVacation:output_type -> ethos.habits.v1.SuccessResponse
+	40, // 51: ethos.habits.v1.HabitsService.ListVacations:output_type -> ethos.habits.v1.ListVacationsResponse
+	41, // 52: ethos.habits.v1.HabitsService.PreviewImport:output_type -> ethos.habits.v1.ImportPreviewResponse
+	42, // 53: ethos.habits.v1.HabitsService.StartImport:output_type -> ethos.habits.v1.ImportResponse
+	42, // 54: ethos.habits.v1.HabitsService.GetImport:output_type -> ethos.habits.v1.ImportResponse
+	43, // 55: ethos.habits.v1.HabitsService.RecomputeHabitStats:output_type -> ethos.habits.v1.RecomputeHabitStatsResponse
+	28, // [28:56] is the sub-list for method output_type
+	0,  // [0:28] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_ethos_habits_v1_habits_service_proto_init() }
+func file_ethos_habits_v1_habits_service_proto_init() {
+	if File_ethos_habits_v1_habits_service_proto != nil {
+		return
+	}
+	file_ethos_habits_v1_messages_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ethos_habits_v1_habits_service_proto_rawDesc), len(file_ethos_habits_v1_habits_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ethos_habits_v1_habits_service_proto_goTypes,
+		DependencyIndexes: file_ethos_habits_v1_habits_service_proto_depIdxs,
+		MessageInfos:      file_ethos_habits_v1_habits_service_proto_msgTypes,
+	}.Build()
+	File_ethos_habits_v1_habits_service_proto = out.File
+	file_ethos_habits_v1_habits_service_proto_goTypes = nil
+	file_ethos_habits_v1_habits_service_proto_depIdxs = nil
+}
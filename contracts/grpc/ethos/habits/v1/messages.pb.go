@@ -0,0 +1,5030 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: ethos/habits/v1/messages.proto
+
+package habitsv1
+
+import (
+	v1 "github.com/semmidev/ethos-go/contracts/grpc/ethos/common/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Frequency represents habit recurrence patterns.
+type Frequency int32
+
+const (
+	// Unspecified frequency.
+	Frequency_FREQUENCY_UNSPECIFIED Frequency = 0
+	// Daily habit.
+	Frequency_FREQUENCY_DAILY Frequency = 1
+	// Weekly habit.
+	Frequency_FREQUENCY_WEEKLY Frequency = 2
+	// Monthly habit.
+	Frequency_FREQUENCY_MONTHLY Frequency = 3
+)
+
+// Enum value maps for Frequency.
+var (
+	Frequency_name = map[int32]string{
+		0: "FREQUENCY_UNSPECIFIED",
+		1: "FREQUENCY_DAILY",
+		2: "FREQUENCY_WEEKLY",
+		3: "FREQUENCY_MONTHLY",
+	}
+	Frequency_value = map[string]int32{
+		"FREQUENCY_UNSPECIFIED": 0,
+		"FREQUENCY_DAILY":       1,
+		"FREQUENCY_WEEKLY":      2,
+		"FREQUENCY_MONTHLY":     3,
+	}
+)
+
+func (x Frequency) Enum() *Frequency {
+	p := new(Frequency)
+	*p = x
+	return p
+}
+
+func (x Frequency) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Frequency) Descriptor() protoreflect.EnumDescriptor {
+	return file_ethos_habits_v1_messages_proto_enumTypes[0].Descriptor()
+}
+
+func (Frequency) Type() protoreflect.EnumType {
+	return &file_ethos_habits_v1_messages_proto_enumTypes[0]
+}
+
+func (x Frequency) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Frequency.Descriptor instead.
+func (Frequency) EnumDescriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{0}
+}
+
+// Habit represents a user's habit.
+type Habit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique habit identifier.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Habit name.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Habit description.
+	Description *string `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// Habit frequency (daily, weekly, monthly).
+	Frequency string `protobuf:"bytes,4,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	// Target count per frequency period.
+	TargetCount int32 `protobuf:"varint,5,opt,name=target_count,json=targetCount,proto3" json:"target_count,omitempty"`
+	// Daily reminder time in HH:MM format.
+	ReminderTime *string `protobuf:"bytes,6,opt,name=reminder_time,json=reminderTime,proto3,oneof" json:"reminder_time,omitempty"`
+	// Whether the habit is active.
+	IsActive bool `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// Creation time.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Last update time.
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Position in the user's custom order, starting at 0.
+	Position int32 `protobuf:"varint,10,opt,name=position,proto3" json:"position,omitempty"`
+	// Date (YYYY-MM-DD) a paused habit resumes on.
+	PausedUntil *string `protobuf:"bytes,11,opt,name=paused_until,json=pausedUntil,proto3,oneof" json:"paused_until,omitempty"`
+	// Weekdays and interval the habit repeats on.
+	Recurrence *Recurrence `protobuf:"bytes,12,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	// Incremented on every update; send it back as expected_version (or in an
+	// If-Match header) to detect concurrent edits.
+	Version       int32 `protobuf:"varint,13,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Habit) Reset() {
+	*x = Habit{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Habit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Habit) ProtoMessage() {}
+
+func (x *Habit) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Habit.ProtoReflect.Descriptor instead.
+func (*Habit) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Habit) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Habit) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Habit) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *Habit) GetFrequency() string {
+	if x != nil {
+		return x.Frequency
+	}
+	return ""
+}
+
+func (x *Habit) GetTargetCount() int32 {
+	if x != nil {
+		return x.TargetCount
+	}
+	return 0
+}
+
+func (x *Habit) GetReminderTime() string {
+	if x != nil && x.ReminderTime != nil {
+		return *x.ReminderTime
+	}
+	return ""
+}
+
+func (x *Habit) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *Habit) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Habit) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Habit) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *Habit) GetPausedUntil() string {
+	if x != nil && x.PausedUntil != nil {
+		return *x.PausedUntil
+	}
+	return ""
+}
+
+func (x *Habit) GetRecurrence() *Recurrence {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+func (x *Habit) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// Recurrence describes on which weekdays and how often a habit repeats,
+// e.g. days ["mon", "wed", "fri"] or interval 3 for every third day.
+type Recurrence struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Weekday names (sun, mon, tue, wed, thu, fri, sat); empty means every day.
+	Days []string `protobuf:"bytes,1,rep,name=days,proto3" json:"days,omitempty"`
+	// Repeat every N periods of the habit's frequency (default: 1).
+	Interval      int32 `protobuf:"varint,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Recurrence) Reset() {
+	*x = Recurrence{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Recurrence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Recurrence) ProtoMessage() {}
+
+func (x *Recurrence) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Recurrence.ProtoReflect.Descriptor instead.
+func (*Recurrence) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Recurrence) GetDays() []string {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *Recurrence) GetInterval() int32 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+// HabitLog represents a habit completion log entry.
+type HabitLog struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique log identifier.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Parent habit identifier.
+	HabitId string `protobuf:"bytes,2,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	// Date of the log entry.
+	LogDate string `protobuf:"bytes,3,opt,name=log_date,json=logDate,proto3" json:"log_date,omitempty"`
+	// Completion count.
+	Count int32 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	// Optional note.
+	Note *string `protobuf:"bytes,5,opt,name=note,proto3,oneof" json:"note,omitempty"`
+	// Creation time.
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HabitLog) Reset() {
+	*x = HabitLog{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HabitLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HabitLog) ProtoMessage() {}
+
+func (x *HabitLog) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HabitLog.ProtoReflect.Descriptor instead.
+func (*HabitLog) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *HabitLog) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *HabitLog) GetHabitId() string {
+	if x != nil {
+		return x.HabitId
+	}
+	return ""
+}
+
+func (x *HabitLog) GetLogDate() string {
+	if x != nil {
+		return x.LogDate
+	}
+	return ""
+}
+
+func (x *HabitLog) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *HabitLog) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+func (x *HabitLog) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Vacation is a period during which a habit is not expected to be completed.
+type Vacation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique vacation identifier.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Parent habit identifier.
+	HabitId string `protobuf:"bytes,2,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	// First vacation day in YYYY-MM-DD format.
+	StartDate string `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// Last vacation day in YYYY-MM-DD format; unset while ongoing.
+	EndDate *string `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`
+	// Optional reason.
+	Reason *string `protobuf:"bytes,5,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	// Creation time.
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Vacation) Reset() {
+	*x = Vacation{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Vacation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Vacation) ProtoMessage() {}
+
+func (x *Vacation) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Vacation.ProtoReflect.Descriptor instead.
+func (*Vacation) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Vacation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Vacation) GetHabitId() string {
+	if x != nil {
+		return x.HabitId
+	}
+	return ""
+}
+
+func (x *Vacation) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *Vacation) GetEndDate() string {
+	if x != nil && x.EndDate != nil {
+		return *x.EndDate
+	}
+	return ""
+}
+
+func (x *Vacation) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *Vacation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// HabitStats contains habit statistics.
+type HabitStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Total number of logs.
+	TotalLogs int32 `protobuf:"varint,1,opt,name=total_logs,json=totalLogs,proto3" json:"total_logs,omitempty"`
+	// Current streak in days.
+	CurrentStreak int32 `protobuf:"varint,2,opt,name=current_streak,json=currentStreak,proto3" json:"current_streak,omitempty"`
+	// Longest streak achieved.
+	LongestStreak int32 `protobuf:"varint,3,opt,name=longest_streak,json=longestStreak,proto3" json:"longest_streak,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HabitStats) Reset() {
+	*x = HabitStats{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HabitStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HabitStats) ProtoMessage() {}
+
+func (x *HabitStats) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HabitStats.ProtoReflect.Descriptor instead.
+func (*HabitStats) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *HabitStats) GetTotalLogs() int32 {
+	if x != nil {
+		return x.TotalLogs
+	}
+	return 0
+}
+
+func (x *HabitStats) GetCurrentStreak() int32 {
+	if x != nil {
+		return x.CurrentStreak
+	}
+	return 0
+}
+
+func (x *HabitStats) GetLongestStreak() int32 {
+	if x != nil {
+		return x.LongestStreak
+	}
+	return 0
+}
+
+// Dashboard contains user dashboard data.
+type Dashboard struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of active habits.
+	ActiveHabitsCount int32 `protobuf:"varint,1,opt,name=active_habits_count,json=activeHabitsCount,proto3" json:"active_habits_count,omitempty"`
+	// Total logs created today.
+	TotalLogsToday int32 `protobuf:"varint,2,opt,name=total_logs_today,json=totalLogsToday,proto3" json:"total_logs_today,omitempty"`
+	// Current streak in days.
+	CurrentStreak int32 `protobuf:"varint,3,opt,name=current_streak,json=currentStreak,proto3" json:"current_streak,omitempty"`
+	// Longest streak achieved.
+	LongestStreak int32 `protobuf:"varint,4,opt,name=longest_streak,json=longestStreak,proto3" json:"longest_streak,omitempty"`
+	// Weekly completion percentage (0-100).
+	WeeklyCompletion int32 `protobuf:"varint,5,opt,name=weekly_completion,json=weeklyCompletion,proto3" json:"weekly_completion,omitempty"`
+	// Total habit logs all time.
+	TotalLogs     int32 `protobuf:"varint,6,opt,name=total_logs,json=totalLogs,proto3" json:"total_logs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Dashboard) Reset() {
+	*x = Dashboard{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Dashboard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Dashboard) ProtoMessage() {}
+
+func (x *Dashboard) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Dashboard.ProtoReflect.Descriptor instead.
+func (*Dashboard) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Dashboard) GetActiveHabitsCount() int32 {
+	if x != nil {
+		return x.ActiveHabitsCount
+	}
+	return 0
+}
+
+func (x *Dashboard) GetTotalLogsToday() int32 {
+	if x != nil {
+		return x.TotalLogsToday
+	}
+	return 0
+}
+
+func (x *Dashboard) GetCurrentStreak() int32 {
+	if x != nil {
+		return x.CurrentStreak
+	}
+	return 0
+}
+
+func (x *Dashboard) GetLongestStreak() int32 {
+	if x != nil {
+		return x.LongestStreak
+	}
+	return 0
+}
+
+func (x *Dashboard) GetWeeklyCompletion() int32 {
+	if x != nil {
+		return x.WeeklyCompletion
+	}
+	return 0
+}
+
+func (x *Dashboard) GetTotalLogs() int32 {
+	if x != nil {
+		return x.TotalLogs
+	}
+	return 0
+}
+
+// DailyAnalytics contains analytics for a single day.
+type DailyAnalytics struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Day of week name (Mon, Tue, etc.).
+	DayName string `protobuf:"bytes,1,opt,name=day_name,json=dayName,proto3" json:"day_name,omitempty"`
+	// Date in YYYY-MM-DD format.
+	Date string `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	// Number of habit logs on this day.
+	LogsCount int32 `protobuf:"varint,3,opt,name=logs_count,json=logsCount,proto3" json:"logs_count,omitempty"`
+	// Completion percentage for this day (0-100).
+	CompletionPercentage int32 `protobuf:"varint,4,opt,name=completion_percentage,json=completionPercentage,proto3" json:"completion_percentage,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *DailyAnalytics) Reset() {
+	*x = DailyAnalytics{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyAnalytics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyAnalytics) ProtoMessage() {}
+
+func (x *DailyAnalytics) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyAnalytics.ProtoReflect.Descriptor instead.
+func (*DailyAnalytics) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DailyAnalytics) GetDayName() string {
+	if x != nil {
+		return x.DayName
+	}
+	return ""
+}
+
+func (x *DailyAnalytics) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *DailyAnalytics) GetLogsCount() int32 {
+	if x != nil {
+		return x.LogsCount
+	}
+	return 0
+}
+
+func (x *DailyAnalytics) GetCompletionPercentage() int32 {
+	if x != nil {
+		return x.CompletionPercentage
+	}
+	return 0
+}
+
+// WeeklyAnalytics contains weekly analytics data.
+type WeeklyAnalytics struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Daily analytics for each day of the week.
+	Days []*DailyAnalytics `protobuf:"bytes,1,rep,name=days,proto3" json:"days,omitempty"`
+	// Average completion percentage for the week.
+	AverageCompletion int32 `protobuf:"varint,2,opt,name=average_completion,json=averageCompletion,proto3" json:"average_completion,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *WeeklyAnalytics) Reset() {
+	*x = WeeklyAnalytics{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WeeklyAnalytics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeeklyAnalytics) ProtoMessage() {}
+
+func (x *WeeklyAnalytics) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeeklyAnalytics.ProtoReflect.Descriptor instead.
+func (*WeeklyAnalytics) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *WeeklyAnalytics) GetDays() []*DailyAnalytics {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *WeeklyAnalytics) GetAverageCompletion() int32 {
+	if x != nil {
+		return x.AverageCompletion
+	}
+	return 0
+}
+
+// ListHabitsRequest contains filters for listing habits.
+type ListHabitsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Page number (1-indexed).
+	Page int32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	// Number of items per page.
+	PerPage int32 `protobuf:"varint,2,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	// Filter for active habits only.
+	Active *bool `protobuf:"varint,3,opt,name=active,proto3,oneof" json:"active,omitempty"`
+	// Filter for inactive habits only.
+	Inactive *bool `protobuf:"varint,4,opt,name=inactive,proto3,oneof" json:"inactive,omitempty"`
+	// Search keyword.
+	Keyword *string `protobuf:"bytes,5,opt,name=keyword,proto3,oneof" json:"keyword,omitempty"`
+	// Filter by start date.
+	StartDate *string `protobuf:"bytes,6,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"`
+	// Filter by end date.
+	EndDate *string `protobuf:"bytes,7,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`
+	// Sort field.
+	SortBy *string `protobuf:"bytes,8,opt,name=sort_by,json=sortBy,proto3,oneof" json:"sort_by,omitempty"`
+	// Sort direction (asc/desc).
+	SortDirection *string `protobuf:"bytes,9,opt,name=sort_direction,json=sortDirection,proto3,oneof" json:"sort_direction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHabitsRequest) Reset() {
+	*x = ListHabitsRequest{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHabitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHabitsRequest) ProtoMessage() {}
+
+func (x *ListHabitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHabitsRequest.ProtoReflect.Descriptor instead.
+func (*ListHabitsRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListHabitsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListHabitsRequest) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+func (x *ListHabitsRequest) GetActive() bool {
+	if x != nil && x.Active != nil {
+		return *x.Active
+	}
+	return false
+}
+
+func (x *ListHabitsRequest) GetInactive() bool {
+	if x != nil && x.Inactive != nil {
+		return *x.Inactive
+	}
+	return false
+}
+
+func (x *ListHabitsRequest) GetKeyword() string {
+	if x != nil && x.Keyword != nil {
+		return *x.Keyword
+	}
+	return ""
+}
+
+func (x *ListHabitsRequest) GetStartDate() string {
+	if x != nil && x.StartDate != nil {
+		return *x.StartDate
+	}
+	return ""
+}
+
+func (x *ListHabitsRequest) GetEndDate() string {
+	if x != nil && x.EndDate != nil {
+		return *x.EndDate
+	}
+	return ""
+}
+
+func (x *ListHabitsRequest) GetSortBy() string {
+	if x != nil && x.SortBy != nil {
+		return *x.SortBy
+	}
+	return ""
+}
+
+func (x *ListHabitsRequest) GetSortDirection() string {
+	if x != nil && x.SortDirection != nil {
+		return *x.SortDirection
+	}
+	return ""
+}
+
+// ListHabitsResponse contains paginated habits.
+type ListHabitsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the request was successful.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Human-readable message.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// List of habits.
+	Data []*Habit `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	// Pagination metadata.
+	Meta          *v1.Meta `protobuf:"bytes,4,opt,name=meta,proto3" json:"meta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHabitsResponse) Reset() {
+	*x = ListHabitsResponse{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHabitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHabitsResponse) ProtoMessage() {}
+
+func (x *ListHabitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHabitsResponse.ProtoReflect.Descriptor instead.
+func (*ListHabitsResponse) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListHabitsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ListHabitsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListHabitsResponse) GetData() []*Habit {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListHabitsResponse) GetMeta() *v1.Meta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+// CreateHabitRequest contains data for creating a habit.
+type CreateHabitRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Habit name (required).
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Habit description.
+	Description *string `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// Habit frequency (default: daily).
+	Frequency *string `protobuf:"bytes,3,opt,name=frequency,proto3,oneof" json:"frequency,omitempty"`
+	// Target count (default: 1).
+	TargetCount *int32 `protobuf:"varint,4,opt,name=target_count,json=targetCount,proto3,oneof" json:"target_count,omitempty"`
+	// Reminder time in HH:MM format.
+	ReminderTime *string `protobuf:"bytes,5,opt,name=reminder_time,json=reminderTime,proto3,oneof" json:"reminder_time,omitempty"`
+	// Recurrence (default: every day).
+	Recurrence    *Recurrence `protobuf:"bytes,6,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateHabitRequest) Reset() {
+	*x = CreateHabitRequest{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateHabitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateHabitRequest) ProtoMessage() {}
+
+func (x *CreateHabitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateHabitRequest.ProtoReflect.Descriptor instead.
+func (*CreateHabitRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreateHabitRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateHabitRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *CreateHabitRequest) GetFrequency() string {
+	if x != nil && x.Frequency != nil {
+		return *x.Frequency
+	}
+	return ""
+}
+
+func (x *CreateHabitRequest) GetTargetCount() int32 {
+	if x != nil && x.TargetCount != nil {
+		return *x.TargetCount
+	}
+	return 0
+}
+
+func (x *CreateHabitRequest) GetReminderTime() string {
+	if x != nil && x.ReminderTime != nil {
+		return *x.ReminderTime
+	}
+	return ""
+}
+
+func (x *CreateHabitRequest) GetRecurrence() *Recurrence {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+// HabitResponse contains a single habit.
+type HabitResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the request was successful.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Human-readable message.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// The habit data.
+	Data          *Habit `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HabitResponse) Reset() {
+	*x = HabitResponse{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HabitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HabitResponse) ProtoMessage() {}
+
+func (x *HabitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HabitResponse.ProtoReflect.Descriptor instead.
+func (*HabitResponse) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *HabitResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *HabitResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *HabitResponse) GetData() *Habit {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// GetHabitRequest identifies a habit to retrieve.
+type GetHabitRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Habit identifier.
+	HabitId       string `protobuf:"bytes,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHabitRequest) Reset() {
+	*x = GetHabitRequest{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHabitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHabitRequest) ProtoMessage() {}
+
+func (x *GetHabitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHabitRequest.ProtoReflect.Descriptor instead.
+func (*GetHabitRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetHabitRequest) GetHabitId() string {
+	if x != nil {
+		return x.HabitId
+	}
+	return ""
+}
+
+// UpdateHabitRequest contains data for updating a habit.
+type UpdateHabitRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Habit identifier.
+	HabitId string `protobuf:"bytes,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	// New name.
+	Name *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	// New description.
+	Description *string `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// New frequency.
+	Frequency *string `protobuf:"bytes,4,opt,name=frequency,proto3,oneof" json:"frequency,omitempty"`
+	// New target count.
+	TargetCount *int32 `protobuf:"varint,5,opt,name=target_count,json=targetCount,proto3,oneof" json:"target_count,omitempty"`
+	// New reminder time.
+	ReminderTime *string `protobuf:"bytes,6,opt,name=reminder_time,json=reminderTime,proto3,oneof" json:"reminder_time,omitempty"`
+	// New recurrence; replaces both days and interval.
+	Recurrence *Recurrence `protobuf:"bytes,7,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	// Version the client last read; the update fails with 409 Conflict if the
+	// habit has changed since. An If-Match header with the habit's ETag works
+	// too.
+	ExpectedVersion *int32 `protobuf:"varint,8,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateHabitRequest) Reset() {
+	*x = UpdateHabitRequest{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateHabitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateHabitRequest) ProtoMessage() {}
+
+func (x *UpdateHabitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateHabitRequest.ProtoReflect.Descriptor instead.
+func (*UpdateHabitRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateHabitRequest) GetHabitId() string {
+	if x != nil {
+		return x.HabitId
+	}
+	return ""
+}
+
+func (x *UpdateHabitRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateHabitRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateHabitRequest) GetFrequency() string {
+	if x != nil && x.Frequency != nil {
+		return *x.Frequency
+	}
+	return ""
+}
+
+func (x *UpdateHabitRequest) GetTargetCount() int32 {
+	if x != nil && x.TargetCount != nil {
+		return *x.TargetCount
+	}
+	return 0
+}
+
+func (x *UpdateHabitRequest) GetReminderTime() string {
+	if x != nil && x.ReminderTime != nil {
+		return *x.ReminderTime
+	}
+	return ""
+}
+
+func (x *UpdateHabitRequest) GetRecurrence() *Recurrence {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+func (x *UpdateHabitRequest) GetExpectedVersion() int32 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+// DeleteHabitRequest identifies a habit to delete.
+type DeleteHabitRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Habit identifier.
+	HabitId       string `protobuf:"bytes,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteHabitRequest) Reset() {
+	*x = DeleteHabitRequest{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteHabitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteHabitRequest) ProtoMessage() {}
+
+func (x *DeleteHabitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteHabitRequest.ProtoReflect.Descriptor instead.
+func (*DeleteHabitRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeleteHabitRequest) GetHabitId() string {
+	if x != nil {
+		return x.HabitId
+	}
+	return ""
+}
+
+// PauseHabitRequest pauses a habit until a date.
+type PauseHabitRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Habit identifier.
+	HabitId string `protobuf:"bytes,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	// Date (YYYY-MM-DD) the habit resumes on; must be after today.
+	Until         string `protobuf:"bytes,2,opt,name=until,proto3" json:"until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseHabitRequest) Reset() {
+	*x = PauseHabitRequest{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseHabitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseHabitRequest) ProtoMessage() {}
+
+func (x *PauseHabitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseHabitRequest.ProtoReflect.Descriptor instead.
+func (*PauseHabitRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PauseHabitRequest) GetHabitId() string {
+	if x != nil {
+		return x.HabitId
+	}
+	return ""
+}
+
+func (x *PauseHabitRequest) GetUntil() string {
+	if x != nil {
+		return x.Until
+	}
+	return ""
+}
+
+// ReorderHabitsRequest lists habit IDs in their new display order.
+// Habits not listed keep their relative order after the listed ones.
+type ReorderHabitsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Habit identifiers, first to last.
+	HabitIds      []string `protobuf:"bytes,1,rep,name=habit_ids,json=habitIds,proto3" json:"habit_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderHabitsRequest) Reset() {
+	*x = ReorderHabitsRequest{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderHabitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderHabitsRequest) ProtoMessage() {}
+
+func (x *ReorderHabitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderHabitsRequest.ProtoReflect.Descriptor instead.
+func (*ReorderHabitsRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ReorderHabitsRequest) GetHabitIds() []string {
+	if x != nil {
+		return x.HabitIds
+	}
+	return nil
+}
+
+// ActivateHabitRequest identifies a habit to activate.
+type ActivateHabitRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Habit identifier.
+	HabitId       string `protobuf:"bytes,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivateHabitRequest) Reset() {
+	*x = ActivateHabitRequest{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivateHabitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateHabitRequest) ProtoMessage() {}
+
+func (x *ActivateHabitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateHabitRequest.ProtoReflect.Descriptor instead.
+func (*ActivateHabitRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ActivateHabitRequest) GetHabitId() string {
+	if x != nil {
+		return x.HabitId
+	}
+	return ""
+}
+
+// DeactivateHabitRequest identifies a habit to deactivate.
+type DeactivateHabitRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Habit identifier.
+	HabitId       string `protobuf:"bytes,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateHabitRequest) Reset() {
+	*x = DeactivateHabitRequest{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateHabitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateHabitRequest) ProtoMessage() {}
+
+func (x *DeactivateHabitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateHabitRequest.ProtoReflect.Descriptor instead.
+func (*DeactivateHabitRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DeactivateHabitRequest) GetHabitId() string {
+	if x != nil {
+		return x.HabitId
+	}
+	return ""
+}
+
+// GetHabitStatsRequest identifies a habit for statistics.
+type GetHabitStatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Habit identifier.
+	HabitId       string `protobuf:"bytes,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHabitStatsRequest) Reset() {
+	*x = GetHabitStatsRequest{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHabitStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHabitStatsRequest) ProtoMessage() {}
+
+func (x *GetHabitStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHabitStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetHabitStatsRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetHabitStatsRequest) GetHabitId() string {
+	if x != nil {
+		return x.HabitId
+	}
+	return ""
+}
+
+// HabitStatsResponse contains habit statistics.
+type HabitStatsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the request was successful.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Human-readable message.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Habit statistics.
+	Data          *HabitStats `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HabitStatsResponse) Reset() {
+	*x = HabitStatsResponse{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HabitStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HabitStatsResponse) ProtoMessage() {}
+
+func (x *HabitStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HabitStatsResponse.ProtoReflect.Descriptor instead.
+func (*HabitStatsResponse) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *HabitStatsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *HabitStatsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *HabitStatsResponse) GetData() *HabitStats {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// GetHabitInsightsRequest identifies the habit to analyze.
+type GetHabitInsightsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Habit identifier.
+	HabitId       string `protobuf:"bytes,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHabitInsightsRequest) Reset() {
+	*x = GetHabitInsightsRequest{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHabitInsightsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHabitInsightsRequest) ProtoMessage() {}
+
+func (x *GetHabitInsightsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHabitInsightsRequest.ProtoReflect.Descriptor instead.
+func (*GetHabitInsightsRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetHabitInsightsRequest) GetHabitId() string {
+	if x != nil {
+		return x.HabitId
+	}
+	return ""
+}
+
+// InsightBucket counts the logs made on one weekday or time of day.
+type InsightBucket struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Weekday (Monday...Sunday) or time of day (morning, afternoon, evening, night).
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Number of logs.
+	Logs int32 `protobuf:"varint,2,opt,name=logs,proto3" json:"logs,omitempty"`
+	// Percent of all the habit's logs.
+	Share         float64 `protobuf:"fixed64,3,opt,name=share,proto3" json:"share,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InsightBucket) Reset() {
+	*x = InsightBucket{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InsightBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsightBucket) ProtoMessage() {}
+
+func (x *InsightBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsightBucket.ProtoReflect.Descriptor instead.
+func (*InsightBucket) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *InsightBucket) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InsightBucket) GetLogs() int32 {
+	if x != nil {
+		return x.Logs
+	}
+	return 0
+}
+
+func (x *InsightBucket) GetShare() float64 {
+	if x != nil {
+		return x.Share
+	}
+	return 0
+}
+
+// HabitInsights shows on which weekdays and at which times a habit is completed.
+type HabitInsights struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Habit identifier.
+	HabitId string `protobuf:"bytes,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	// Habit name.
+	HabitName string `protobuf:"bytes,2,opt,name=habit_name,json=habitName,proto3" json:"habit_name,omitempty"`
+	// Number of logs analyzed.
+	TotalLogs int32 `protobuf:"varint,3,opt,name=total_logs,json=totalLogs,proto3" json:"total_logs,omitempty"`
+	// Logs per weekday of the log date, Monday first.
+	Weekdays []*InsightBucket `protobuf:"bytes,4,rep,name=weekdays,proto3" json:"weekdays,omitempty"`
+	// Logs per time of day they were made in the user's timezone, morning first.
+	TimesOfDay []*InsightBucket `protobuf:"bytes,5,rep,name=times_of_day,json=timesOfDay,proto3" json:"times_of_day,omitempty"`
+	// Weekday with the most logs; empty until the habit has 5 logs.
+	BestWeekday string `protobuf:"bytes,6,opt,name=best_weekday,json=bestWeekday,proto3" json:"best_weekday,omitempty"`
+	// Weekday with the fewest logs; empty until the habit has 5 logs.
+	WorstWeekday string `protobuf:"bytes,7,opt,name=worst_weekday,json=worstWeekday,proto3" json:"worst_weekday,omitempty"`
+	// Time of day with the most logs; empty until the habit has 5 logs.
+	BestTimeOfDay string `protobuf:"bytes,8,opt,name=best_time_of_day,json=bestTimeOfDay,proto3" json:"best_time_of_day,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HabitInsights) Reset() {
+	*x = HabitInsights{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HabitInsights) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HabitInsights) ProtoMessage() {}
+
+func (x *HabitInsights) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HabitInsights.ProtoReflect.Descriptor instead.
+func (*HabitInsights) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *HabitInsights) GetHabitId() string {
+	if x != nil {
+		return x.HabitId
+	}
+	return ""
+}
+
+func (x *HabitInsights) GetHabitName() string {
+	if x != nil {
+		return x.HabitName
+	}
+	return ""
+}
+
+func (x *HabitInsights) GetTotalLogs() int32 {
+	if x != nil {
+		return x.TotalLogs
+	}
+	return 0
+}
+
+func (x *HabitInsights) GetWeekdays() []*InsightBucket {
+	if x != nil {
+		return x.Weekdays
+	}
+	return nil
+}
+
+func (x *HabitInsights) GetTimesOfDay() []*InsightBucket {
+	if x != nil {
+		return x.TimesOfDay
+	}
+	return nil
+}
+
+func (x *HabitInsights) GetBestWeekday() string {
+	if x != nil {
+		return x.BestWeekday
+	}
+	return ""
+}
+
+func (x *HabitInsights) GetWorstWeekday() string {
+	if x != nil {
+		return x.WorstWeekday
+	}
+	return ""
+}
+
+func (x *HabitInsights) GetBestTimeOfDay() string {
+	if x != nil {
+		return x.BestTimeOfDay
+	}
+	return ""
+}
+
+// HabitInsightsResponse contains habit insights.
+type HabitInsightsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the request was successful.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Human-readable message.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Habit insights.
+	Data          *HabitInsights `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HabitInsightsResponse) Reset() {
+	*x = HabitInsightsResponse{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HabitInsightsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HabitInsightsResponse) ProtoMessage() {}
+
+func (x *HabitInsightsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HabitInsightsResponse.ProtoReflect.Descriptor instead.
+func (*HabitInsightsResponse) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *HabitInsightsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *HabitInsightsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *HabitInsightsResponse) GetData() *HabitInsights {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// LogHabitRequest contains data for logging habit completion.
+type LogHabitRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Habit identifier.
+	HabitId string `protobuf:"bytes,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	// Log date in YYYY-MM-DD format.
+	LogDate string `protobuf:"bytes,2,opt,name=log_date,json=logDate,proto3" json:"log_date,omitempty"`
+	// Completion count.
+	Count int32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	// Optional note.
+	Note          *string `protobuf:"bytes,4,opt,name=note,proto3,oneof" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogHabitRequest) Reset() {
+	*x = LogHabitRequest{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogHabitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogHabitRequest) ProtoMessage() {}
+
+func (x *LogHabitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogHabitRequest.ProtoReflect.Descriptor instead.
+func (*LogHabitRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *LogHabitRequest) GetHabitId() string {
+	if x != nil {
+		return x.HabitId
+	}
+	return ""
+}
+
+func (x *LogHabitRequest) GetLogDate() string {
+	if x != nil {
+		return x.LogDate
+	}
+	return ""
+}
+
+func (x *LogHabitRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *LogHabitRequest) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+// LogHabitResponse contains the created log ID.
+type LogHabitResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the operation was successful.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Human-readable message.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Created log data.
+	Data          *LogHabitData `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogHabitResponse) Reset() {
+	*x = LogHabitResponse{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogHabitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogHabitResponse) ProtoMessage() {}
+
+func (x *LogHabitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogHabitResponse.ProtoReflect.Descriptor instead.
+func (*LogHabitResponse) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *LogHabitResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *LogHabitResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *LogHabitResponse) GetData() *LogHabitData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// LogHabitData describes the day's log.
+type LogHabitData struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The day's log ID, which is an earlier log's when the habit was already
+	// logged that day.
+	LogId string `protobuf:"bytes,1,opt,name=log_id,json=logId,proto3" json:"log_id,omitempty"`
+	// The day's completion count after this change.
+	TotalToday    int32 `protobuf:"varint,2,opt,name=total_today,json=totalToday,proto3" json:"total_today,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogHabitData) Reset() {
+	*x = LogHabitData{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogHabitData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogHabitData) ProtoMessage() {}
+
+func (x *LogHabitData) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogHabitData.ProtoReflect.Descriptor instead.
+func (*LogHabitData) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *LogHabitData) GetLogId() string {
+	if x != nil {
+		return x.LogId
+	}
+	return ""
+}
+
+func (x *LogHabitData) GetTotalToday() int32 {
+	if x != nil {
+		return x.TotalToday
+	}
+	return 0
+}
+
+// GetHabitLogsRequest contains filters for habit logs.
+type GetHabitLogsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Habit identifier.
+	HabitId string `protobuf:"bytes,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	// Page number.
+	Page int32 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	// Items per page.
+	PerPage int32 `protobuf:"varint,3,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	// Filter by start date.
+	StartDate *string `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"`
+	// Filter by end date.
+	EndDate *string `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`
+	// Search keyword.
+	Keyword *string `protobuf:"bytes,6,opt,name=keyword,proto3,oneof" json:"keyword,omitempty"`
+	// Sort field.
+	SortBy *string `protobuf:"bytes,7,opt,name=sort_by,json=sortBy,proto3,oneof" json:"sort_by,omitempty"`
+	// Sort direction (asc/desc).
+	SortDirection *string `protobuf:"bytes,8,opt,name=sort_direction,json=sortDirection,proto3,oneof" json:"sort_direction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHabitLogsRequest) Reset() {
+	*x = GetHabitLogsRequest{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHabitLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHabitLogsRequest) ProtoMessage() {}
+
+func (x *GetHabitLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHabitLogsRequest.ProtoReflect.Descriptor instead.
+func (*GetHabitLogsRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetHabitLogsRequest) GetHabitId() string {
+	if x != nil {
+		return x.HabitId
+	}
+	return ""
+}
+
+func (x *GetHabitLogsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetHabitLogsRequest) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+func (x *GetHabitLogsRequest) GetStartDate() string {
+	if x != nil && x.StartDate != nil {
+		return *x.StartDate
+	}
+	return ""
+}
+
+func (x *GetHabitLogsRequest) GetEndDate() string {
+	if x != nil && x.EndDate != nil {
+		return *x.EndDate
+	}
+	return ""
+}
+
+func (x *GetHabitLogsRequest) GetKeyword() string {
+	if x != nil && x.Keyword != nil {
+		return *x.Keyword
+	}
+	return ""
+}
+
+func (x *GetHabitLogsRequest) GetSortBy() string {
+	if x != nil && x.SortBy != nil {
+		return *x.SortBy
+	}
+	return ""
+}
+
+func (x *GetHabitLogsRequest) GetSortDirection() string {
+	if x != nil && x.SortDirection != nil {
+		return *x.SortDirection
+	}
+	return ""
+}
+
+// GetHabitLogsResponse contains paginated habit logs.
+type GetHabitLogsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the request was successful.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Human-readable message.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// List of habit logs.
+	Data []*HabitLog `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	// Pagination metadata.
+	Meta          *v1.Meta `protobuf:"bytes,4,opt,name=meta,proto3" json:"meta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHabitLogsResponse) Reset() {
+	*x = GetHabitLogsResponse{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHabitLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHabitLogsResponse) ProtoMessage() {}
+
+func (x *GetHabitLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHabitLogsResponse.ProtoReflect.Descriptor instead.
+func (*GetHabitLogsResponse) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetHabitLogsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetHabitLogsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetHabitLogsResponse) GetData() []*HabitLog {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetHabitLogsResponse) GetMeta() *v1.Meta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+// UpdateHabitLogRequest contains data for updating a habit log.
+type UpdateHabitLogRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Log identifier.
+	LogId string `protobuf:"bytes,1,opt,name=log_id,json=logId,proto3" json:"log_id,omitempty"`
+	// New count.
+	Count *int32 `protobuf:"varint,2,opt,name=count,proto3,oneof" json:"count,omitempty"`
+	// New note.
+	Note *string `protobuf:"bytes,3,opt,name=note,proto3,oneof" json:"note,omitempty"`
+	// New log date.
+	LogDate       *string `protobuf:"bytes,4,opt,name=log_date,json=logDate,proto3,oneof" json:"log_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateHabitLogRequest) Reset() {
+	*x = UpdateHabitLogRequest{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateHabitLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateHabitLogRequest) ProtoMessage() {}
+
+func (x *UpdateHabitLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateHabitLogRequest.ProtoReflect.Descriptor instead.
+func (*UpdateHabitLogRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UpdateHabitLogRequest) GetLogId() string {
+	if x != nil {
+		return x.LogId
+	}
+	return ""
+}
+
+func (x *UpdateHabitLogRequest) GetCount() int32 {
+	if x != nil && x.Count != nil {
+		return *x.Count
+	}
+	return 0
+}
+
+func (x *UpdateHabitLogRequest) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+func (x *UpdateHabitLogRequest) GetLogDate() string {
+	if x != nil && x.LogDate != nil {
+		return *x.LogDate
+	}
+	return ""
+}
+
+// DeleteHabitLogRequest identifies a log to delete.
+type DeleteHabitLogRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Log identifier.
+	LogId         string `protobuf:"bytes,1,opt,name=log_id,json=logId,proto3" json:"log_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteHabitLogRequest) Reset() {
+	*x = DeleteHabitLogRequest{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteHabitLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteHabitLogRequest) ProtoMessage() {}
+
+func (x *DeleteHabitLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteHabitLogRequest.ProtoReflect.Descriptor instead.
+func (*DeleteHabitLogRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *DeleteHabitLogRequest) GetLogId() string {
+	if x != nil {
+		return x.LogId
+	}
+	return ""
+}
+
+// UndoHabitLogRequest identifies the day to undo a completion for.
+type UndoHabitLogRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Habit identifier.
+	HabitId string `protobuf:"bytes,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	// Log date in YYYY-MM-DD format (default: today).
+	LogDate       *string `protobuf:"bytes,2,opt,name=log_date,json=logDate,proto3,oneof" json:"log_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UndoHabitLogRequest) Reset() {
+	*x = UndoHabitLogRequest{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UndoHabitLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndoHabitLogRequest) ProtoMessage() {}
+
+func (x *UndoHabitLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndoHabitLogRequest.ProtoReflect.Descriptor instead.
+func (*UndoHabitLogRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UndoHabitLogRequest) GetHabitId() string {
+	if x != nil {
+		return x.HabitId
+	}
+	return ""
+}
+
+func (x *UndoHabitLogRequest) GetLogDate() string {
+	if x != nil && x.LogDate != nil {
+		return *x.LogDate
+	}
+	return ""
+}
+
+// SetHabitLogCountRequest sets a day's completion count.
+type SetHabitLogCountRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Habit identifier.
+	HabitId string `protobuf:"bytes,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	// Log date in YYYY-MM-DD format (default: today).
+	LogDate *string `protobuf:"bytes,2,opt,name=log_date,json=logDate,proto3,oneof" json:"log_date,omitempty"`
+	// The day's completion count.
+	Count         int32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetHabitLogCountRequest) Reset() {
+	*x = SetHabitLogCountRequest{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetHabitLogCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetHabitLogCountRequest) ProtoMessage() {}
+
+func (x *SetHabitLogCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetHabitLogCountRequest.ProtoReflect.Descriptor instead.
+func (*SetHabitLogCountRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SetHabitLogCountRequest) GetHabitId() string {
+	if x != nil {
+		return x.HabitId
+	}
+	return ""
+}
+
+func (x *SetHabitLogCountRequest) GetLogDate() string {
+	if x != nil && x.LogDate != nil {
+		return *x.LogDate
+	}
+	return ""
+}
+
+func (x *SetHabitLogCountRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// GetDashboardRequest is empty - uses auth context.
+type GetDashboardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDashboardRequest) Reset() {
+	*x = GetDashboardRequest{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDashboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDashboardRequest) ProtoMessage() {}
+
+func (x *GetDashboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDashboardRequest.ProtoReflect.Descriptor instead.
+func (*GetDashboardRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{34}
+}
+
+// DashboardResponse contains dashboard data.
+type DashboardResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the request was successful.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Human-readable message.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Dashboard data.
+	Data          *Dashboard `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DashboardResponse) Reset() {
+	*x = DashboardResponse{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DashboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DashboardResponse) ProtoMessage() {}
+
+func (x *DashboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DashboardResponse.ProtoReflect.Descriptor instead.
+func (*DashboardResponse) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DashboardResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DashboardResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DashboardResponse) GetData() *Dashboard {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// GetWeeklyAnalyticsRequest is empty - uses auth context.
+type GetWeeklyAnalyticsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWeeklyAnalyticsRequest) Reset() {
+	*x = GetWeeklyAnalyticsRequest{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWeeklyAnalyticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWeeklyAnalyticsRequest) ProtoMessage() {}
+
+func (x *GetWeeklyAnalyticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWeeklyAnalyticsRequest.ProtoReflect.Descriptor instead.
+func (*GetWeeklyAnalyticsRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{36}
+}
+
+// WeeklyAnalyticsResponse contains weekly analytics.
+type WeeklyAnalyticsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the request was successful.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Human-readable message.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Weekly analytics data.
+	Data          *WeeklyAnalytics `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WeeklyAnalyticsResponse) Reset() {
+	*x = WeeklyAnalyticsResponse{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WeeklyAnalyticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeeklyAnalyticsResponse) ProtoMessage() {}
+
+func (x *WeeklyAnalyticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeeklyAnalyticsResponse.ProtoReflect.Descriptor instead.
+func (*WeeklyAnalyticsResponse) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *WeeklyAnalyticsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *WeeklyAnalyticsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *WeeklyAnalyticsResponse) GetData() *WeeklyAnalytics {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// ComparePeriodsRequest selects the period to compare.
+type ComparePeriodsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "week" or "month"; defaults to month.
+	Period        string `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ComparePeriodsRequest) Reset() {
+	*x = ComparePeriodsRequest{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ComparePeriodsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComparePeriodsRequest) ProtoMessage() {}
+
+func (x *ComparePeriodsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComparePeriodsRequest.ProtoReflect.Descriptor instead.
+func (*ComparePeriodsRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ComparePeriodsRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+// PeriodSummary totals one period across the user's habits.
+type PeriodSummary struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// First day in YYYY-MM-DD format.
+	StartDate string `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// Last day (inclusive) in YYYY-MM-DD format.
+	EndDate string `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// Total completions.
+	Completions int32 `protobuf:"varint,3,opt,name=completions,proto3" json:"completions,omitempty"`
+	// Share of habit-days that were logged (0-100).
+	CompletionRate float64 `protobuf:"fixed64,4,opt,name=completion_rate,json=completionRate,proto3" json:"completion_rate,omitempty"`
+	// Longest run of consecutive logged days of any habit.
+	LongestStreak int32 `protobuf:"varint,5,opt,name=longest_streak,json=longestStreak,proto3" json:"longest_streak,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PeriodSummary) Reset() {
+	*x = PeriodSummary{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeriodSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeriodSummary) ProtoMessage() {}
+
+func (x *PeriodSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeriodSummary.ProtoReflect.Descriptor instead.
+func (*PeriodSummary) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *PeriodSummary) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *PeriodSummary) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *PeriodSummary) GetCompletions() int32 {
+	if x != nil {
+		return x.Completions
+	}
+	return 0
+}
+
+func (x *PeriodSummary) GetCompletionRate() float64 {
+	if x != nil {
+		return x.CompletionRate
+	}
+	return 0
+}
+
+func (x *PeriodSummary) GetLongestStreak() int32 {
+	if x != nil {
+		return x.LongestStreak
+	}
+	return 0
+}
+
+// HabitPeriodDelta compares one habit between the two periods.
+type HabitPeriodDelta struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Habit identifier.
+	HabitId string `protobuf:"bytes,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	// Habit name.
+	HabitName string `protobuf:"bytes,2,opt,name=habit_name,json=habitName,proto3" json:"habit_name,omitempty"`
+	// Completions in the current period.
+	CurrentCompletions int32 `protobuf:"varint,3,opt,name=current_completions,json=currentCompletions,proto3" json:"current_completions,omitempty"`
+	// Completions in the previous period.
+	PreviousCompletions int32 `protobuf:"varint,4,opt,name=previous_completions,json=previousCompletions,proto3" json:"previous_completions,omitempty"`
+	// Percent change in completions; unset when the previous period had none.
+	CompletionsChange *float64 `protobuf:"fixed64,5,opt,name=completions_change,json=completionsChange,proto3,oneof" json:"completions_change,omitempty"`
+	// Completion rate in the current period (0-100).
+	CurrentCompletionRate float64 `protobuf:"fixed64,6,opt,name=current_completion_rate,json=currentCompletionRate,proto3" json:"current_completion_rate,omitempty"`
+	// Completion rate in the previous period (0-100).
+	PreviousCompletionRate float64 `protobuf:"fixed64,7,opt,name=previous_completion_rate,json=previousCompletionRate,proto3" json:"previous_completion_rate,omitempty"`
+	// Change in completion rate, in percentage points.
+	CompletionRateChange float64 `protobuf:"fixed64,8,opt,name=completion_rate_change,json=completionRateChange,proto3" json:"completion_rate_change,omitempty"`
+	// Longest streak within the current period.
+	CurrentLongestStreak int32 `protobuf:"varint,9,opt,name=current_longest_streak,json=currentLongestStreak,proto3" json:"current_longest_streak,omitempty"`
+	// Longest streak within the previous period.
+	PreviousLongestStreak int32 `protobuf:"varint,10,opt,name=previous_longest_streak,json=previousLongestStreak,proto3" json:"previous_longest_streak,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *HabitPeriodDelta) Reset() {
+	*x = HabitPeriodDelta{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HabitPeriodDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HabitPeriodDelta) ProtoMessage() {}
+
+func (x *HabitPeriodDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HabitPeriodDelta.ProtoReflect.Descriptor instead.
+func (*HabitPeriodDelta) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *HabitPeriodDelta) GetHabitId() string {
+	if x != nil {
+		return x.HabitId
+	}
+	return ""
+}
+
+func (x *HabitPeriodDelta) GetHabitName() string {
+	if x != nil {
+		return x.HabitName
+	}
+	return ""
+}
+
+func (x *HabitPeriodDelta) GetCurrentCompletions() int32 {
+	if x != nil {
+		return x.CurrentCompletions
+	}
+	return 0
+}
+
+func (x *HabitPeriodDelta) GetPreviousCompletions() int32 {
+	if x != nil {
+		return x.PreviousCompletions
+	}
+	return 0
+}
+
+func (x *HabitPeriodDelta) GetCompletionsChange() float64 {
+	if x != nil && x.CompletionsChange != nil {
+		return *x.CompletionsChange
+	}
+	return 0
+}
+
+func (x *HabitPeriodDelta) GetCurrentCompletionRate() float64 {
+	if x != nil {
+		return x.CurrentCompletionRate
+	}
+	return 0
+}
+
+func (x *HabitPeriodDelta) GetPreviousCompletionRate() float64 {
+	if x != nil {
+		return x.PreviousCompletionRate
+	}
+	return 0
+}
+
+func (x *HabitPeriodDelta) GetCompletionRateChange() float64 {
+	if x != nil {
+		return x.CompletionRateChange
+	}
+	return 0
+}
+
+func (x *HabitPeriodDelta) GetCurrentLongestStreak() int32 {
+	if x != nil {
+		return x.CurrentLongestStreak
+	}
+	return 0
+}
+
+func (x *HabitPeriodDelta) GetPreviousLongestStreak() int32 {
+	if x != nil {
+		return x.PreviousLongestStreak
+	}
+	return 0
+}
+
+// PeriodComparison compares the current period so far with the same number
+// of days from the start of the previous period.
+type PeriodComparison struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "week" or "month".
+	Period string `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+	// Current period totals.
+	Current *PeriodSummary `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	// Previous period totals.
+	Previous *PeriodSummary `protobuf:"bytes,3,opt,name=previous,proto3" json:"previous,omitempty"`
+	// Percent change in completions; unset when the previous period had none.
+	CompletionsChange *float64 `protobuf:"fixed64,4,opt,name=completions_change,json=completionsChange,proto3,oneof" json:"completions_change,omitempty"`
+	// Change in completion rate, in percentage points.
+	CompletionRateChange float64 `protobuf:"fixed64,5,opt,name=completion_rate_change,json=completionRateChange,proto3" json:"completion_rate_change,omitempty"`
+	// Change in the longest streak, in days.
+	LongestStreakChange int32 `protobuf:"varint,6,opt,name=longest_streak_change,json=longestStreakChange,proto3" json:"longest_streak_change,omitempty"`
+	// Per-habit changes.
+	Habits        []*HabitPeriodDelta `protobuf:"bytes,7,rep,name=habits,proto3" json:"habits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PeriodComparison) Reset() {
+	*x = PeriodComparison{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeriodComparison) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeriodComparison) ProtoMessage() {}
+
+func (x *PeriodComparison) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeriodComparison.ProtoReflect.Descriptor instead.
+func (*PeriodComparison) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *PeriodComparison) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *PeriodComparison) GetCurrent() *PeriodSummary {
+	if x != nil {
+		return x.Current
+	}
+	return nil
+}
+
+func (x *PeriodComparison) GetPrevious() *PeriodSummary {
+	if x != nil {
+		return x.Previous
+	}
+	return nil
+}
+
+func (x *PeriodComparison) GetCompletionsChange() float64 {
+	if x != nil && x.CompletionsChange != nil {
+		return *x.CompletionsChange
+	}
+	return 0
+}
+
+func (x *PeriodComparison) GetCompletionRateChange() float64 {
+	if x != nil {
+		return x.CompletionRateChange
+	}
+	return 0
+}
+
+func (x *PeriodComparison) GetLongestStreakChange() int32 {
+	if x != nil {
+		return x.LongestStreakChange
+	}
+	return 0
+}
+
+func (x *PeriodComparison) GetHabits() []*HabitPeriodDelta {
+	if x != nil {
+		return x.Habits
+	}
+	return nil
+}
+
+// PeriodComparisonResponse contains a period comparison.
+type PeriodComparisonResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the request was successful.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Human-readable message.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Comparison data.
+	Data          *PeriodComparison `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PeriodComparisonResponse) Reset() {
+	*x = PeriodComparisonResponse{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeriodComparisonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeriodComparisonResponse) ProtoMessage() {}
+
+func (x *PeriodComparisonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeriodComparisonResponse.ProtoReflect.Descriptor instead.
+func (*PeriodComparisonResponse) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *PeriodComparisonResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *PeriodComparisonResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PeriodComparisonResponse) GetData() *PeriodComparison {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// GetHabitCorrelationsRequest is empty - uses auth context.
+type GetHabitCorrelationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHabitCorrelationsRequest) Reset() {
+	*x = GetHabitCorrelationsRequest{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHabitCorrelationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHabitCorrelationsRequest) ProtoMessage() {}
+
+func (x *GetHabitCorrelationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHabitCorrelationsRequest.ProtoReflect.Descriptor instead.
+func (*GetHabitCorrelationsRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{43}
+}
+
+// HabitCorrelation reads as: on the habit_days days habit_name was logged,
+// related_habit_name was also logged rate percent of the time.
+type HabitCorrelation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Habit identifier.
+	HabitId string `protobuf:"bytes,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	// Habit name.
+	HabitName string `protobuf:"bytes,2,opt,name=habit_name,json=habitName,proto3" json:"habit_name,omitempty"`
+	// Related habit identifier.
+	RelatedHabitId string `protobuf:"bytes,3,opt,name=related_habit_id,json=relatedHabitId,proto3" json:"related_habit_id,omitempty"`
+	// Related habit name.
+	RelatedHabitName string `protobuf:"bytes,4,opt,name=related_habit_name,json=relatedHabitName,proto3" json:"related_habit_name,omitempty"`
+	// Days the habit was logged in the window.
+	HabitDays int32 `protobuf:"varint,5,opt,name=habit_days,json=habitDays,proto3" json:"habit_days,omitempty"`
+	// Of those, days the related habit was also logged.
+	SharedDays int32 `protobuf:"varint,6,opt,name=shared_days,json=sharedDays,proto3" json:"shared_days,omitempty"`
+	// shared_days as a percent of habit_days.
+	Rate float64 `protobuf:"fixed64,7,opt,name=rate,proto3" json:"rate,omitempty"`
+	// Percent of all days in the window the related habit was logged.
+	BaselineRate float64 `protobuf:"fixed64,8,opt,name=baseline_rate,json=baselineRate,proto3" json:"baseline_rate,omitempty"`
+	// rate minus baseline_rate, in percentage points.
+	Lift          float64 `protobuf:"fixed64,9,opt,name=lift,proto3" json:"lift,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HabitCorrelation) Reset() {
+	*x = HabitCorrelation{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HabitCorrelation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HabitCorrelation) ProtoMessage() {}
+
+func (x *HabitCorrelation) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HabitCorrelation.ProtoReflect.Descriptor instead.
+func (*HabitCorrelation) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *HabitCorrelation) GetHabitId() string {
+	if x != nil {
+		return x.HabitId
+	}
+	return ""
+}
+
+func (x *HabitCorrelation) GetHabitName() string {
+	if x != nil {
+		return x.HabitName
+	}
+	return ""
+}
+
+func (x *HabitCorrelation) GetRelatedHabitId() string {
+	if x != nil {
+		return x.RelatedHabitId
+	}
+	return ""
+}
+
+func (x *HabitCorrelation) GetRelatedHabitName() string {
+	if x != nil {
+		return x.RelatedHabitName
+	}
+	return ""
+}
+
+func (x *HabitCorrelation) GetHabitDays() int32 {
+	if x != nil {
+		return x.HabitDays
+	}
+	return 0
+}
+
+func (x *HabitCorrelation) GetSharedDays() int32 {
+	if x != nil {
+		return x.SharedDays
+	}
+	return 0
+}
+
+func (x *HabitCorrelation) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *HabitCorrelation) GetBaselineRate() float64 {
+	if x != nil {
+		return x.BaselineRate
+	}
+	return 0
+}
+
+func (x *HabitCorrelation) GetLift() float64 {
+	if x != nil {
+		return x.Lift
+	}
+	return 0
+}
+
+// HabitCorrelations lists habit pairs over the last 90 days, strongest first.
+type HabitCorrelations struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// First day of the window in YYYY-MM-DD format.
+	StartDate string `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// Last day of the window in YYYY-MM-DD format.
+	EndDate string `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// Pairs of habits each logged on at least 10 days, at most 20.
+	Correlations  []*HabitCorrelation `protobuf:"bytes,3,rep,name=correlations,proto3" json:"correlations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HabitCorrelations) Reset() {
+	*x = HabitCorrelations{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HabitCorrelations) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HabitCorrelations) ProtoMessage() {}
+
+func (x *HabitCorrelations) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HabitCorrelations.ProtoReflect.Descriptor instead.
+func (*HabitCorrelations) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *HabitCorrelations) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *HabitCorrelations) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *HabitCorrelations) GetCorrelations() []*HabitCorrelation {
+	if x != nil {
+		return x.Correlations
+	}
+	return nil
+}
+
+// HabitCorrelationsResponse contains habit correlations.
+type HabitCorrelationsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the request was successful.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Human-readable message.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Correlation data.
+	Data          *HabitCorrelations `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HabitCorrelationsResponse) Reset() {
+	*x = HabitCorrelationsResponse{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HabitCorrelationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HabitCorrelationsResponse) ProtoMessage() {}
+
+func (x *HabitCorrelationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HabitCorrelationsResponse.ProtoReflect.Descriptor instead.
+func (*HabitCorrelationsResponse) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *HabitCorrelationsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *HabitCorrelationsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *HabitCorrelationsResponse) GetData() *HabitCorrelations {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// StartVacationRequest contains data for starting a vacation.
+type StartVacationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Habit identifier.
+	HabitId string `protobuf:"bytes,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	// First vacation day in YYYY-MM-DD format.
+	StartDate string `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// Last vacation day in YYYY-MM-DD format; omit for an open-ended vacation.
+	EndDate *string `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`
+	// Optional reason.
+	Reason        *string `protobuf:"bytes,4,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartVacationRequest) Reset() {
+	*x = StartVacationRequest{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartVacationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartVacationRequest) ProtoMessage() {}
+
+func (x *StartVacationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartVacationRequest.ProtoReflect.Descriptor instead.
+func (*StartVacationRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *StartVacationRequest) GetHabitId() string {
+	if x != nil {
+		return x.HabitId
+	}
+	return ""
+}
+
+func (x *StartVacationRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *StartVacationRequest) GetEndDate() string {
+	if x != nil && x.EndDate != nil {
+		return *x.EndDate
+	}
+	return ""
+}
+
+func (x *StartVacationRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+// VacationResponse wraps a single vacation.
+type VacationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the request was successful.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Human-readable message.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// The vacation data.
+	Data          *Vacation `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VacationResponse) Reset() {
+	*x = VacationResponse{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VacationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VacationResponse) ProtoMessage() {}
+
+func (x *VacationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VacationResponse.ProtoReflect.Descriptor instead.
+func (*VacationResponse) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *VacationResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *VacationResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *VacationResponse) GetData() *Vacation {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// EndVacationRequest identifies a vacation to end.
+type EndVacationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Habit identifier.
+	HabitId string `protobuf:"bytes,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	// Vacation identifier.
+	VacationId string `protobuf:"bytes,2,opt,name=vacation_id,json=vacationId,proto3" json:"vacation_id,omitempty"`
+	// Last vacation day in YYYY-MM-DD format; defaults to today.
+	EndDate       *string `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EndVacationRequest) Reset() {
+	*x = EndVacationRequest{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndVacationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndVacationRequest) ProtoMessage() {}
+
+func (x *EndVacationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndVacationRequest.ProtoReflect.Descriptor instead.
+func (*EndVacationRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *EndVacationRequest) GetHabitId() string {
+	if x != nil {
+		return x.HabitId
+	}
+	return ""
+}
+
+func (x *EndVacationRequest) GetVacationId() string {
+	if x != nil {
+		return x.VacationId
+	}
+	return ""
+}
+
+func (x *EndVacationRequest) GetEndDate() string {
+	if x != nil && x.EndDate != nil {
+		return *x.EndDate
+	}
+	return ""
+}
+
+// ListVacationsRequest identifies the habit whose vacations to list.
+type ListVacationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Habit identifier.
+	HabitId       string `protobuf:"bytes,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVacationsRequest) Reset() {
+	*x = ListVacationsRequest{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVacationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVacationsRequest) ProtoMessage() {}
+
+func (x *ListVacationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVacationsRequest.ProtoReflect.Descriptor instead.
+func (*ListVacationsRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListVacationsRequest) GetHabitId() string {
+	if x != nil {
+		return x.HabitId
+	}
+	return ""
+}
+
+// ListVacationsResponse contains a habit's vacations.
+type ListVacationsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the request was successful.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Human-readable message.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// List of vacations.
+	Data          []*Vacation `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVacationsResponse) Reset() {
+	*x = ListVacationsResponse{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVacationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVacationsResponse) ProtoMessage() {}
+
+func (x *ListVacationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVacationsResponse.ProtoReflect.Descriptor instead.
+func (*ListVacationsResponse) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListVacationsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ListVacationsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListVacationsResponse) GetData() []*Vacation {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// HabitImport is the progress of an import from another habit tracker.
+type HabitImport struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique import identifier.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// App the file was exported from: habitica, loop or streaks.
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	// One of pending, running, completed or failed.
+	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	// Number of habits in the file.
+	TotalHabits int32 `protobuf:"varint,4,opt,name=total_habits,json=totalHabits,proto3" json:"total_habits,omitempty"`
+	// Number of habits written so far.
+	ImportedHabits int32 `protobuf:"varint,5,opt,name=imported_habits,json=importedHabits,proto3" json:"imported_habits,omitempty"`
+	// Number of logs in the file.
+	TotalLogs int32 `protobuf:"varint,6,opt,name=total_logs,json=totalLogs,proto3" json:"total_logs,omitempty"`
+	// Number of logs written so far.
+	ImportedLogs int32 `protobuf:"varint,7,opt,name=imported_logs,json=importedLogs,proto3" json:"imported_logs,omitempty"`
+	// Share of habits written, from 0 to 100.
+	Progress int32 `protobuf:"varint,8,opt,name=progress,proto3" json:"progress,omitempty"`
+	// Why the import stopped; set when failed.
+	Failure *string `protobuf:"bytes,9,opt,name=failure,proto3,oneof" json:"failure,omitempty"`
+	// Creation time.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Last update time.
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Completion time; set when completed.
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=completed_at,json=completedAt,proto3,oneof" json:"completed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HabitImport) Reset() {
+	*x = HabitImport{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HabitImport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HabitImport) ProtoMessage() {}
+
+func (x *HabitImport) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HabitImport.ProtoReflect.Descriptor instead.
+func (*HabitImport) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *HabitImport) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *HabitImport) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *HabitImport) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *HabitImport) GetTotalHabits() int32 {
+	if x != nil {
+		return x.TotalHabits
+	}
+	return 0
+}
+
+func (x *HabitImport) GetImportedHabits() int32 {
+	if x != nil {
+		return x.ImportedHabits
+	}
+	return 0
+}
+
+func (x *HabitImport) GetTotalLogs() int32 {
+	if x != nil {
+		return x.TotalLogs
+	}
+	return 0
+}
+
+func (x *HabitImport) GetImportedLogs() int32 {
+	if x != nil {
+		return x.ImportedLogs
+	}
+	return 0
+}
+
+func (x *HabitImport) GetProgress() int32 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *HabitImport) GetFailure() string {
+	if x != nil && x.Failure != nil {
+		return *x.Failure
+	}
+	return ""
+}
+
+func (x *HabitImport) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *HabitImport) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *HabitImport) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+// ImportPreviewHabit is one habit an import would create.
+type ImportPreviewHabit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Habit name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Habit frequency: daily, weekly or monthly.
+	Frequency string `protobuf:"bytes,2,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	// Weekdays and interval the habit repeats on.
+	Recurrence *Recurrence `protobuf:"bytes,3,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	// Number of days with completions.
+	Logs int32 `protobuf:"varint,4,opt,name=logs,proto3" json:"logs,omitempty"`
+	// Earliest completion in YYYY-MM-DD format.
+	FirstLogDate *string `protobuf:"bytes,5,opt,name=first_log_date,json=firstLogDate,proto3,oneof" json:"first_log_date,omitempty"`
+	// Latest completion in YYYY-MM-DD format.
+	LastLogDate   *string `protobuf:"bytes,6,opt,name=last_log_date,json=lastLogDate,proto3,oneof" json:"last_log_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportPreviewHabit) Reset() {
+	*x = ImportPreviewHabit{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportPreviewHabit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportPreviewHabit) ProtoMessage() {}
+
+func (x *ImportPreviewHabit) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportPreviewHabit.ProtoReflect.Descriptor instead.
+func (*ImportPreviewHabit) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ImportPreviewHabit) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImportPreviewHabit) GetFrequency() string {
+	if x != nil {
+		return x.Frequency
+	}
+	return ""
+}
+
+func (x *ImportPreviewHabit) GetRecurrence() *Recurrence {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+func (x *ImportPreviewHabit) GetLogs() int32 {
+	if x != nil {
+		return x.Logs
+	}
+	return 0
+}
+
+func (x *ImportPreviewHabit) GetFirstLogDate() string {
+	if x != nil && x.FirstLogDate != nil {
+		return *x.FirstLogDate
+	}
+	return ""
+}
+
+func (x *ImportPreviewHabit) GetLastLogDate() string {
+	if x != nil && x.LastLogDate != nil {
+		return *x.LastLogDate
+	}
+	return ""
+}
+
+// ImportPreview describes what an import would create.
+type ImportPreview struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// App the file was exported from.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// Habits that would be created.
+	Habits []*ImportPreviewHabit `protobuf:"bytes,2,rep,name=habits,proto3" json:"habits,omitempty"`
+	// Number of habits that would be created.
+	TotalHabits int32 `protobuf:"varint,3,opt,name=total_habits,json=totalHabits,proto3" json:"total_habits,omitempty"`
+	// Number of logs that would be created.
+	TotalLogs int32 `protobuf:"varint,4,opt,name=total_logs,json=totalLogs,proto3" json:"total_logs,omitempty"`
+	// Data in the file that would be skipped or approximated.
+	Warnings      []string `protobuf:"bytes,5,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportPreview) Reset() {
+	*x = ImportPreview{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportPreview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportPreview) ProtoMessage() {}
+
+func (x *ImportPreview) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportPreview.ProtoReflect.Descriptor instead.
+func (*ImportPreview) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ImportPreview) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ImportPreview) GetHabits() []*ImportPreviewHabit {
+	if x != nil {
+		return x.Habits
+	}
+	return nil
+}
+
+func (x *ImportPreview) GetTotalHabits() int32 {
+	if x != nil {
+		return x.TotalHabits
+	}
+	return 0
+}
+
+func (x *ImportPreview) GetTotalLogs() int32 {
+	if x != nil {
+		return x.TotalLogs
+	}
+	return 0
+}
+
+func (x *ImportPreview) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+// PreviewImportRequest contains an export file to preview.
+type PreviewImportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// App the file was exported from: habitica (JSON user data export), loop
+	// (Checkmarks.csv) or streaks (CSV export).
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// File contents.
+	Data          string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewImportRequest) Reset() {
+	*x = PreviewImportRequest{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewImportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewImportRequest) ProtoMessage() {}
+
+func (x *PreviewImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewImportRequest.ProtoReflect.Descriptor instead.
+func (*PreviewImportRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *PreviewImportRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *PreviewImportRequest) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+// ImportPreviewResponse wraps an import preview.
+type ImportPreviewResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the request was successful.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Human-readable message.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// The preview.
+	Data          *ImportPreview `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportPreviewResponse) Reset() {
+	*x = ImportPreviewResponse{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportPreviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportPreviewResponse) ProtoMessage() {}
+
+func (x *ImportPreviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportPreviewResponse.ProtoReflect.Descriptor instead.
+func (*ImportPreviewResponse) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ImportPreviewResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ImportPreviewResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ImportPreviewResponse) GetData() *ImportPreview {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// StartImportRequest contains an export file to import.
+type StartImportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// App the file was exported from: habitica, loop or streaks.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// File contents.
+	Data          string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartImportRequest) Reset() {
+	*x = StartImportRequest{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartImportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartImportRequest) ProtoMessage() {}
+
+func (x *StartImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartImportRequest.ProtoReflect.Descriptor instead.
+func (*StartImportRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *StartImportRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *StartImportRequest) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+// GetImportRequest identifies an import.
+type GetImportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Import identifier.
+	ImportId      string `protobuf:"bytes,1,opt,name=import_id,json=importId,proto3" json:"import_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetImportRequest) Reset() {
+	*x = GetImportRequest{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetImportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetImportRequest) ProtoMessage() {}
+
+func (x *GetImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetImportRequest.ProtoReflect.Descriptor instead.
+func (*GetImportRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetImportRequest) GetImportId() string {
+	if x != nil {
+		return x.ImportId
+	}
+	return ""
+}
+
+// ImportResponse wraps a single import.
+type ImportResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the request was successful.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Human-readable message.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// The import.
+	Data          *HabitImport `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportResponse) Reset() {
+	*x = ImportResponse{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportResponse) ProtoMessage() {}
+
+func (x *ImportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportResponse.ProtoReflect.Descriptor instead.
+func (*ImportResponse) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ImportResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ImportResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ImportResponse) GetData() *HabitImport {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// RecomputeHabitStatsRequest selects the habits to recompute. Without a user
+// every habit logged since active_since is checked.
+type RecomputeHabitStatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only recompute this user's habits.
+	UserId *string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	// Only recompute this habit (requires user_id).
+	HabitId *string `protobuf:"bytes,2,opt,name=habit_id,json=habitId,proto3,oneof" json:"habit_id,omitempty"`
+	// Habits logged since this time are checked (default: 48 hours ago).
+	ActiveSince   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=active_since,json=activeSince,proto3,oneof" json:"active_since,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecomputeHabitStatsRequest) Reset() {
+	*x = RecomputeHabitStatsRequest{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecomputeHabitStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecomputeHabitStatsRequest) ProtoMessage() {}
+
+func (x *RecomputeHabitStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecomputeHabitStatsRequest.ProtoReflect.Descriptor instead.
+func (*RecomputeHabitStatsRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *RecomputeHabitStatsRequest) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+func (x *RecomputeHabitStatsRequest) GetHabitId() string {
+	if x != nil && x.HabitId != nil {
+		return *x.HabitId
+	}
+	return ""
+}
+
+func (x *RecomputeHabitStatsRequest) GetActiveSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActiveSince
+	}
+	return nil
+}
+
+// RecomputeHabitStatsResponse reports the recomputation outcome.
+type RecomputeHabitStatsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the request was successful.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Human-readable message.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Number of habits checked.
+	Checked int32 `protobuf:"varint,3,opt,name=checked,proto3" json:"checked,omitempty"`
+	// Number of habits whose stats were repaired.
+	Repaired      int32 `protobuf:"varint,4,opt,name=repaired,proto3" json:"repaired,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecomputeHabitStatsResponse) Reset() {
+	*x = RecomputeHabitStatsResponse{}
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecomputeHabitStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecomputeHabitStatsResponse) ProtoMessage() {}
+
+func (x *RecomputeHabitStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_habits_v1_messages_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecomputeHabitStatsResponse.ProtoReflect.Descriptor instead.
+func (*RecomputeHabitStatsResponse) Descriptor() ([]byte, []int) {
+	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *RecomputeHabitStatsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RecomputeHabitStatsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RecomputeHabitStatsResponse) GetChecked() int32 {
+	if x != nil {
+		return x.Checked
+	}
+	return 0
+}
+
+func (x *RecomputeHabitStatsResponse) GetRepaired() int32 {
+	if x != nil {
+		return x.Repaired
+	}
+	return 0
+}
+
+var File_ethos_habits_v1_messages_proto protoreflect.FileDescriptor
+
+const file_ethos_habits_v1_messages_proto_rawDesc = "" +
+	"\n" +
+	"\x1eethos/habits/v1/messages.proto\x12\x0fethos.habits.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a ethos/common/v1/pagination.proto\"\x9e\x04\n" +
+	"\x05Habit\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x1c\n" +
+	"\tfrequency\x18\x04 \x01(\tR\tfrequency\x12!\n" +
+	"\ftarget_count\x18\x05 \x01(\x05R\vtargetCount\x12(\n" +
+	"\rreminder_time\x18\x06 \x01(\tH\x01R\freminderTime\x88\x01\x01\x12\x1b\n" +
+	"\tis_active\x18\a \x01(\bR\bisActive\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1a\n" +
+	"\bposition\x18\n" +
+	" \x01(\x05R\bposition\x12&\n" +
+	"\fpaused_until\x18\v \x01(\tH\x02R\vpausedUntil\x88\x01\x01\x12;\n" +
+	"\n" +
+	"recurrence\x18\f \x01(\v2\x1b.ethos.habits.v1.RecurrenceR\n" +
+	"recurrence\x12\x18\n" +
+	"\aversion\x18\r \x01(\x05R\aversionB\x0e\n" +
+	"\f_descriptionB\x10\n" +
+	"\x0e_reminder_timeB\x0f\n" +
+	"\r_paused_until\"<\n" +
+	"\n" +
+	"Recurrence\x12\x12\n" +
+	"\x04days\x18\x01 \x03(\tR\x04days\x12\x1a\n" +
+	"\binterval\x18\x02 \x01(\x05R\binterval\"\xc3\x01\n" +
+	"\bHabitLog\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bhabit_id\x18\x02 \x01(\tR\ahabitId\x12\x19\n" +
+	"\blog_date\x18\x03 \x01(\tR\alogDate\x12\x14\n" +
+	"\x05count\x18\x04 \x01(\x05R\x05count\x12\x17\n" +
+	"\x04note\x18\x05 \x01(\tH\x00R\x04note\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\a\n" +
+	"\x05_note\"\xe4\x01\n" +
+	"\bVacation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bhabit_id\x18\x02 \x01(\tR\ahabitId\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\tR\tstartDate\x12\x1e\n" +
+	"\bend_date\x18\x04 \x01(\tH\x00R\aendDate\x88\x01\x01\x12\x1b\n" +
+	"\x06reason\x18\x05 \x01(\tH\x01R\x06reason\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\v\n" +
+	"\t_end_dateB\t\n" +
+	"\a_reason\"y\n" +
+	"\n" +
+	"HabitStats\x12\x1d\n" +
+	"\n" +
+	"total_logs\x18\x01 \x01(\x05R\ttotalLogs\x12%\n" +
+	"\x0ecurrent_streak\x18\x02 \x01(\x05R\rcurrentStreak\x12%\n" +
+	"\x0elongest_streak\x18\x03 \x01(\x05R\rlongestStreak\"\xff\x01\n" +
+	"\tDashboard\x12.\n" +
+	"\x13active_habits_count\x18\x01 \x01(\x05R\x11activeHabitsCount\x12(\n" +
+	"\x10total_logs_today\x18\x02 \x01(\x05R\x0etotalLogsToday\x12%\n" +
+	"\x0ecurrent_streak\x18\x03 \x01(\x05R\rcurrentStreak\x12%\n" +
+	"\x0elongest_streak\x18\x04 \x01(\x05R\rlongestStreak\x12+\n" +
+	"\x11weekly_completion\x18\x05 \x01(\x05R\x10weeklyCompletion\x12\x1d\n" +
+	"\n" +
+	"total_logs\x18\x06 \x01(\x05R\ttotalLogs\"\x93\x01\n" +
+	"\x0eDailyAnalytics\x12\x19\n" +
+	"\bday_name\x18\x01 \x01(\tR\adayName\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12\x1d\n" +
+	"\n" +
+	"logs_count\x18\x03 \x01(\x05R\tlogsCount\x123\n" +
+	"\x15completion_percentage\x18\x04 \x01(\x05R\x14completionPercentage\"u\n" +
+	"\x0fWeeklyAnalytics\x123\n" +
+	"\x04days\x18\x01 \x03(\v2\x1f.ethos.habits.v1.DailyAnalyticsR\x04days\x12-\n" +
+	"\x12average_completion\x18\x02 \x01(\x05R\x11averageCompletion\"\x8c\x03\n" +
+	"\x11ListHabitsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x19\n" +
+	"\bper_page\x18\x02 \x01(\x05R\aperPage\x12\x1b\n" +
+	"\x06active\x18\x03 \x01(\bH\x00R\x06active\x88\x01\x01\x12\x1f\n" +
+	"\binactive\x18\x04 \x01(\bH\x01R\binactive\x88\x01\x01\x12\x1d\n" +
+	"\akeyword\x18\x05 \x01(\tH\x02R\akeyword\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"start_date\x18\x06 \x01(\tH\x03R\tstartDate\x88\x01\x01\x12\x1e\n" +
+	"\bend_date\x18\a \x01(\tH\x04R\aendDate\x88\x01\x01\x12\x1c\n" +
+	"\asort_by\x18\b \x01(\tH\x05R\x06sortBy\x88\x01\x01\x12*\n" +
+	"\x0esort_direction\x18\t \x01(\tH\x06R\rsortDirection\x88\x01\x01B\t\n" +
+	"\a_activeB\v\n" +
+	"\t_inactiveB\n" +
+	"\n" +
+	"\b_keywordB\r\n" +
+	"\v_start_dateB\v\n" +
+	"\t_end_dateB\n" +
+	"\n" +
+	"\b_sort_byB\x11\n" +
+	"\x0f_sort_direction\"\x9f\x01\n" +
+	"\x12ListHabitsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12*\n" +
+	"\x04data\x18\x03 \x03(\v2\x16.ethos.habits.v1.HabitR\x04data\x12)\n" +
+	"\x04meta\x18\x04 \x01(\v2\x15.ethos.common.v1.MetaR\x04meta\"\xc2\x02\n" +
+	"\x12CreateHabitRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12%\n" +
+	"\vdescription\x18\x02 \x01(\tH\x00R\vdescription\x88\x01\x01\x12!\n" +
+	"\tfrequency\x18\x03 \x01(\tH\x01R\tfrequency\x88\x01\x01\x12&\n" +
+	"\ftarget_count\x18\x04 \x01(\x05H\x02R\vtargetCount\x88\x01\x01\x12(\n" +
+	"\rreminder_time\x18\x05 \x01(\tH\x03R\freminderTime\x88\x01\x01\x12;\n" +
+	"\n" +
+	"recurrence\x18\x06 \x01(\v2\x1b.ethos.habits.v1.RecurrenceR\n" +
+	"recurrenceB\x0e\n" +
+	"\f_descriptionB\f\n" +
+	"\n" +
+	"_frequencyB\x0f\n" +
+	"\r_target_countB\x10\n" +
+	"\x0e_reminder_time\"o\n" +
+	"\rHabitResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12*\n" +
+	"\x04data\x18\x03 \x01(\v2\x16.ethos.habits.v1.HabitR\x04data\",\n" +
+	"\x0fGetHabitRequest\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\tR\ahabitId\"\xb0\x03\n" +
+	"\x12UpdateHabitRequest\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\tR\ahabitId\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12!\n" +
+	"\tfrequency\x18\x04 \x01(\tH\x02R\tfrequency\x88\x01\x01\x12&\n" +
+	"\ftarget_count\x18\x05 \x01(\x05H\x03R\vtargetCount\x88\x01\x01\x12(\n" +
+	"\rreminder_time\x18\x06 \x01(\tH\x04R\freminderTime\x88\x01\x01\x12;\n" +
+	"\n" +
+	"recurrence\x18\a \x01(\v2\x1b.ethos.habits.v1.RecurrenceR\n" +
+	"recurrence\x12.\n" +
+	"\x10expected_version\x18\b \x01(\x05H\x05R\x0fexpectedVersion\x88\x01\x01B\a\n" +
+	"\x05_nameB\x0e\n" +
+	"\f_descriptionB\f\n" +
+	"\n" +
+	"_frequencyB\x0f\n" +
+	"\r_target_countB\x10\n" +
+	"\x0e_reminder_timeB\x13\n" +
+	"\x11_expected_version\"/\n" +
+	"\x12DeleteHabitRequest\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\tR\ahabitId\"D\n" +
+	"\x11PauseHabitRequest\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\tR\ahabitId\x12\x14\n" +
+	"\x05until\x18\x02 \x01(\tR\x05until\"3\n" +
+	"\x14ReorderHabitsRequest\x12\x1b\n" +
+	"\thabit_ids\x18\x01 \x03(\tR\bhabitIds\"1\n" +
+	"\x14ActivateHabitRequest\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\tR\ahabitId\"3\n" +
+	"\x16DeactivateHabitRequest\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\tR\ahabitId\"1\n" +
+	"\x14GetHabitStatsRequest\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\tR\ahabitId\"y\n" +
+	"\x12HabitStatsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12/\n" +
+	"\x04data\x18\x03 \x01(\v2\x1b.ethos.habits.v1.HabitStatsR\x04data\"4\n" +
+	"\x17GetHabitInsightsRequest\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\tR\ahabitId\"M\n" +
+	"\rInsightBucket\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04logs\x18\x02 \x01(\x05R\x04logs\x12\x14\n" +
+	"\x05share\x18\x03 \x01(\x01R\x05share\"\xd7\x02\n" +
+	"\rHabitInsights\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\tR\ahabitId\x12\x1d\n" +
+	"\n" +
+	"habit_name\x18\x02 \x01(\tR\thabitName\x12\x1d\n" +
+	"\n" +
+	"total_logs\x18\x03 \x01(\x05R\ttotalLogs\x12:\n" +
+	"\bweekdays\x18\x04 \x03(\v2\x1e.ethos.habits.v1.InsightBucketR\bweekdays\x12@\n" +
+	"\ftimes_of_day\x18\x05 \x03(\v2\x1e.ethos.habits.v1.InsightBucketR\n" +
+	"timesOfDay\x12!\n" +
+	"\fbest_weekday\x18\x06 \x01(\tR\vbestWeekday\x12#\n" +
+	"\rworst_weekday\x18\a \x01(\tR\fworstWeekday\x12'\n" +
+	"\x10best_time_of_day\x18\b \x01(\tR\rbestTimeOfDay\"\x7f\n" +
+	"\x15HabitInsightsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x122\n" +
+	"\x04data\x18\x03 \x01(\v2\x1e.ethos.habits.v1.HabitInsightsR\x04data\"\x7f\n" +
+	"\x0fLogHabitRequest\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\tR\ahabitId\x12\x19\n" +
+	"\blog_date\x18\x02 \x01(\tR\alogDate\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\x12\x17\n" +
+	"\x04note\x18\x04 \x01(\tH\x00R\x04note\x88\x01\x01B\a\n" +
+	"\x05_note\"y\n" +
+	"\x10LogHabitResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x121\n" +
+	"\x04data\x18\x03 \x01(\v2\x1d.ethos.habits.v1.LogHabitDataR\x04data\"F\n" +
+	"\fLogHabitData\x12\x15\n" +
+	"\x06log_id\x18\x01 \x01(\tR\x05logId\x12\x1f\n" +
+	"\vtotal_today\x18\x02 \x01(\x05R\n" +
+	"totalToday\"\xd3\x02\n" +
+	"\x13GetHabitLogsRequest\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\tR\ahabitId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x19\n" +
+	"\bper_page\x18\x03 \x01(\x05R\aperPage\x12\"\n" +
+	"\n" +
+	"start_date\x18\x04 \x01(\tH\x00R\tstartDate\x88\x01\x01\x12\x1e\n" +
+	"\bend_date\x18\x05 \x01(\tH\x01R\aendDate\x88\x01\x01\x12\x1d\n" +
+	"\akeyword\x18\x06 \x01(\tH\x02R\akeyword\x88\x01\x01\x12\x1c\n" +
+	"\asort_by\x18\a \x01(\tH\x03R\x06sortBy\x88\x01\x01\x12*\n" +
+	"\x0esort_direction\x18\b \x01(\tH\x04R\rsortDirection\x88\x01\x01B\r\n" +
+	"\v_start_dateB\v\n" +
+	"\t_end_dateB\n" +
+	"\n" +
+	"\b_keywordB\n" +
+	"\n" +
+	"\b_sort_byB\x11\n" +
+	"\x0f_sort_direction\"\xa4\x01\n" +
+	"\x14GetHabitLogsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12-\n" +
+	"\x04data\x18\x03 \x03(\v2\x19.ethos.habits.v1.HabitLogR\x04data\x12)\n" +
+	"\x04meta\x18\x04 \x01(\v2\x15.ethos.common.v1.MetaR\x04meta\"\xa2\x01\n" +
+	"\x15UpdateHabitLogRequest\x12\x15\n" +
+	"\x06log_id\x18\x01 \x01(\tR\x05logId\x12\x19\n" +
+	"\x05count\x18\x02 \x01(\x05H\x00R\x05count\x88\x01\x01\x12\x17\n" +
+	"\x04note\x18\x03 \x01(\tH\x01R\x04note\x88\x01\x01\x12\x1e\n" +
+	"\blog_date\x18\x04 \x01(\tH\x02R\alogDate\x88\x01\x01B\b\n" +
+	"\x06_countB\a\n" +
+	"\x05_noteB\v\n" +
+	"\t_log_date\".\n" +
+	"\x15DeleteHabitLogRequest\x12\x15\n" +
+	"\x06log_id\x18\x01 \x01(\tR\x05logId\"]\n" +
+	"\x13UndoHabitLogRequest\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\tR\ahabitId\x12\x1e\n" +
+	"\blog_date\x18\x02 \x01(\tH\x00R\alogDate\x88\x01\x01B\v\n" +
+	"\t_log_date\"w\n" +
+	"\x17SetHabitLogCountRequest\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\tR\ahabitId\x12\x1e\n" +
+	"\blog_date\x18\x02 \x01(\tH\x00R\alogDate\x88\x01\x01\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05countB\v\n" +
+	"\t_log_date\"\x15\n" +
+	"\x13GetDashboardRequest\"w\n" +
+	"\x11DashboardResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12.\n" +
+	"\x04data\x18\x03 \x01(\v2\x1a.ethos.habits.v1.DashboardR\x04data\"\x1b\n" +
+	"\x19GetWeeklyAnalyticsRequest\"\x83\x01\n" +
+	"\x17WeeklyAnalyticsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x124\n" +
+	"\x04data\x18\x03 \x01(\v2 .ethos.habits.v1.WeeklyAnalyticsR\x04data\"/\n" +
+	"\x15ComparePeriodsRequest\x12\x16\n" +
+	"\x06period\x18\x01 \x01(\tR\x06period\"\xbb\x01\n" +
+	"\rPeriodSummary\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x02 \x01(\tR\aendDate\x12 \n" +
+	"\vcompletions\x18\x03 \x01(\x05R\vcompletions\x12'\n" +
+	"\x0fcompletion_rate\x18\x04 \x01(\x01R\x0ecompletionRate\x12%\n" +
+	"\x0elongest_streak\x18\x05 \x01(\x05R\rlongestStreak\"\x91\x04\n" +
+	"\x10HabitPeriodDelta\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\tR\ahabitId\x12\x1d\n" +
+	"\n" +
+	"habit_name\x18\x02 \x01(\tR\thabitName\x12/\n" +
+	"\x13current_completions\x18\x03 \x01(\x05R\x12currentCompletions\x121\n" +
+	"\x14previous_completions\x18\x04 \x01(\x05R\x13previousCompletions\x122\n" +
+	"\x12completions_change\x18\x05 \x01(\x01H\x00R\x11completionsChange\x88\x01\x01\x126\n" +
+	"\x17current_completion_rate\x18\x06 \x01(\x01R\x15currentCompletionRate\x128\n" +
+	"\x18previous_completion_rate\x18\a \x01(\x01R\x16previousCompletionRate\x124\n" +
+	"\x16completion_rate_change\x18\b \x01(\x01R\x14completionRateChange\x124\n" +
+	"\x16current_longest_streak\x18\t \x01(\x05R\x14currentLongestStreak\x126\n" +
+	"\x17previous_longest_streak\x18\n" +
+	" \x01(\x05R\x15previousLongestStreakB\x15\n" +
+	"\x13_completions_change\"\x90\x03\n" +
+	"\x10PeriodComparison\x12\x16\n" +
+	"\x06period\x18\x01 \x01(\tR\x06period\x128\n" +
+	"\acurrent\x18\x02 \x01(\v2\x1e.ethos.habits.v1.PeriodSummaryR\acurrent\x12:\n" +
+	"\bprevious\x18\x03 \x01(\v2\x1e.ethos.habits.v1.PeriodSummaryR\bprevious\x122\n" +
+	"\x12completions_change\x18\x04 \x01(\x01H\x00R\x11completionsChange\x88\x01\x01\x124\n" +
+	"\x16completion_rate_change\x18\x05 \x01(\x01R\x14completionRateChange\x122\n" +
+	"\x15longest_streak_change\x18\x06 \x01(\x05R\x13longestStreakChange\x129\n" +
+	"\x06habits\x18\a \x03(\v2!.ethos.habits.v1.HabitPeriodDeltaR\x06habitsB\x15\n" +
+	"\x13_completions_change\"\x85\x01\n" +
+	"\x18PeriodComparisonResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x125\n" +
+	"\x04data\x18\x03 \x01(\v2!.ethos.habits.v1.PeriodComparisonR\x04data\"\x1d\n" +
+	"\x1bGetHabitCorrelationsRequest\"\xb1\x02\n" +
+	"\x10HabitCorrelation\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\tR\ahabitId\x12\x1d\n" +
+	"\n" +
+	"habit_name\x18\x02 \x01(\tR\thabitName\x12(\n" +
+	"\x10related_habit_id\x18\x03 \x01(\tR\x0erelatedHabitId\x12,\n" +
+	"\x12related_habit_name\x18\x04 \x01(\tR\x10relatedHabitName\x12\x1d\n" +
+	"\n" +
+	"habit_days\x18\x05 \x01(\x05R\thabitDays\x12\x1f\n" +
+	"\vshared_days\x18\x06 \x01(\x05R\n" +
+	"sharedDays\x12\x12\n" +
+	"\x04rate\x18\a \x01(\x01R\x04rate\x12#\n" +
+	"\rbaseline_rate\x18\b \x01(\x01R\fbaselineRate\x12\x12\n" +
+	"\x04lift\x18\t \x01(\x01R\x04lift\"\x94\x01\n" +
+	"\x11HabitCorrelations\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x02 \x01(\tR\aendDate\x12E\n" +
+	"\fcorrelations\x18\x03 \x03(\v2!.ethos.habits.v1.HabitCorrelationR\fcorrelations\"\x87\x01\n" +
+	"\x19HabitCorrelationsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x126\n" +
+	"\x04data\x18\x03 \x01(\v2\".ethos.habits.v1.HabitCorrelationsR\x04data\"\xa5\x01\n" +
+	"\x14StartVacationRequest\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\tR\ahabitId\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\tR\tstartDate\x12\x1e\n" +
+	"\bend_date\x18\x03 \x01(\tH\x00R\aendDate\x88\x01\x01\x12\x1b\n" +
+	"\x06reason\x18\x04 \x01(\tH\x01R\x06reason\x88\x01\x01B\v\n" +
+	"\t_end_dateB\t\n" +
+	"\a_reason\"u\n" +
+	"\x10VacationResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12-\n" +
+	"\x04data\x18\x03 \x01(\v2\x19.ethos.habits.v1.VacationR\x04data\"}\n" +
+	"\x12EndVacationRequest\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\tR\ahabitId\x12\x1f\n" +
+	"\vvacation_id\x18\x02 \x01(\tR\n" +
+	"vacationId\x12\x1e\n" +
+	"\bend_date\x18\x03 \x01(\tH\x00R\aendDate\x88\x01\x01B\v\n" +
+	"\t_end_date\"1\n" +
+	"\x14ListVacationsRequest\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\tR\ahabitId\"z\n" +
+	"\x15ListVacationsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12-\n" +
+	"\x04data\x18\x03 \x03(\v2\x19.ethos.habits.v1.VacationR\x04data\"\xef\x03\n" +
+	"\vHabitImport\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12!\n" +
+	"\ftotal_habits\x18\x04 \x01(\x05R\vtotalHabits\x12'\n" +
+	"\x0fimported_habits\x18\x05 \x01(\x05R\x0eimportedHabits\x12\x1d\n" +
+	"\n" +
+	"total_logs\x18\x06 \x01(\x05R\ttotalLogs\x12#\n" +
+	"\rimported_logs\x18\a \x01(\x05R\fimportedLogs\x12\x1a\n" +
+	"\bprogress\x18\b \x01(\x05R\bprogress\x12\x1d\n" +
+	"\afailure\x18\t \x01(\tH\x00R\afailure\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12B\n" +
+	"\fcompleted_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampH\x01R\vcompletedAt\x88\x01\x01B\n" +
+	"\n" +
+	"\b_failureB\x0f\n" +
+	"\r_completed_at\"\x90\x02\n" +
+	"\x12ImportPreviewHabit\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\tfrequency\x18\x02 \x01(\tR\tfrequency\x12;\n" +
+	"\n" +
+	"recurrence\x18\x03 \x01(\v2\x1b.ethos.habits.v1.RecurrenceR\n" +
+	"recurrence\x12\x12\n" +
+	"\x04logs\x18\x04 \x01(\x05R\x04logs\x12)\n" +
+	"\x0efirst_log_date\x18\x05 \x01(\tH\x00R\ffirstLogDate\x88\x01\x01\x12'\n" +
+	"\rlast_log_date\x18\x06 \x01(\tH\x01R\vlastLogDate\x88\x01\x01B\x11\n" +
+	"\x0f_first_log_dateB\x10\n" +
+	"\x0e_last_log_date\"\xc2\x01\n" +
+	"\rImportPreview\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12;\n" +
+	"\x06habits\x18\x02 \x03(\v2#.ethos.habits.v1.ImportPreviewHabitR\x06habits\x12!\n" +
+	"\ftotal_habits\x18\x03 \x01(\x05R\vtotalHabits\x12\x1d\n" +
+	"\n" +
+	"total_logs\x18\x04 \x01(\x05R\ttotalLogs\x12\x1a\n" +
+	"\bwarnings\x18\x05 \x03(\tR\bwarnings\"B\n" +
+	"\x14PreviewImportRequest\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\tR\x04data\"\x7f\n" +
+	"\x15ImportPreviewResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x122\n" +
+	"\x04data\x18\x03 \x01(\v2\x1e.ethos.habits.v1.ImportPreviewR\x04data\"@\n" +
+	"\x12StartImportRequest\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\tR\x04data\"/\n" +
+	"\x10GetImportRequest\x12\x1b\n" +
+	"\timport_id\x18\x01 \x01(\tR\bimportId\"v\n" +
+	"\x0eImportResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x120\n" +
+	"\x04data\x18\x03 \x01(\v2\x1c.ethos.habits.v1.HabitImportR\x04data\"\xc8\x01\n" +
+	"\x1aRecomputeHabitStatsRequest\x12\x1c\n" +
+	"\auser_id\x18\x01 \x01(\tH\x00R\x06userId\x88\x01\x01\x12\x1e\n" +
+	"\bhabit_id\x18\x02 \x01(\tH\x01R\ahabitId\x88\x01\x01\x12B\n" +
+	"\factive_since\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\vactiveSince\x88\x01\x01B\n" +
+	"\n" +
+	"\b_user_idB\v\n" +
+	"\t_habit_idB\x0f\n" +
+	"\r_active_since\"\x87\x01\n" +
+	"\x1bRecomputeHabitStatsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\achecked\x18\x03 \x01(\x05R\achecked\x12\x1a\n" +
+	"\brepaired\x18\x04 \x01(\x05R\brepaired*h\n" +
+	"\tFrequency\x12\x19\n" +
+	"\x15FREQUENCY_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fFREQUENCY_DAILY\x10\x01\x12\x14\n" +
+	"\x10FREQUENCY_WEEKLY\x10\x02\x12\x15\n" +
+	"\x11FREQUENCY_MONTHLY\x10\x03B\xc8\x01\n" +
+	"\x13com.ethos.habits.v1B\rMessagesProtoP\x01ZDgithub.com/semmidev/ethos-go/contracts/grpc/ethos/habits/v1;habitsv1\xa2\x02\x03EHX\xaa\x02\x0fEthos.Habits.V1\xca\x02\x0fEthos\\Habits\\V1\xe2\x02\x1bEthos\\Habits\\V1\\GPBMetadata\xea\x02\x11Ethos::Habits::V1b\x06proto3"
+
+var (
+	file_ethos_habits_v1_messages_proto_rawDescOnce sync.Once
+	file_ethos_habits_v1_messages_proto_rawDescData []byte
+)
+
+func file_ethos_habits_v1_messages_proto_rawDescGZIP() []byte {
+	file_ethos_habits_v1_messages_proto_rawDescOnce.Do(func() {
+		file_ethos_habits_v1_messages_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ethos_habits_v1_messages_proto_rawDesc), len(file_ethos_habits_v1_messages_proto_rawDesc)))
+	})
+	return file_ethos_habits_v1_messages_proto_rawDescData
+}
+
+var file_ethos_habits_v1_messages_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_ethos_habits_v1_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 62)
+var file_ethos_habits_v1_messages_proto_goTypes = []any{
+	(Frequency)(0),                      // 0: ethos.habits.v1.Frequency
+	(*Habit)(nil),                       // 1: ethos.habits.v1.Habit
+	(*Recurrence)(nil),                  // 2: ethos.habits.v1.Recurrence
+	(*HabitLog)(nil),                    // 3: ethos.habits.v1.HabitLog
+	(*Vacation)(nil),                    // 4: ethos.habits.v1.Vacation
+	(*HabitStats)(nil),                  // 5: ethos.habits.v1.HabitStats
+	(*Dashboard)(nil),                   // 6: ethos.habits.v1.Dashboard
+	(*DailyAnalytics)(nil),              // 7: ethos.habits.v1.DailyAnalytics
+	(*WeeklyAnalytics)(nil),             // 8: ethos.habits.v1.WeeklyAnalytics
+	(*ListHabitsRequest)(nil),           // 9: ethos.habits.v1.ListHabitsRequest
+	(*ListHabitsResponse)(nil),          // 10: ethos.habits.v1.ListHabitsResponse
+	(*CreateHabitRequest)(nil),          // 11: ethos.habits.v1.CreateHabitRequest
+	(*HabitResponse)(nil),               // 12: ethos.habits.v1.HabitResponse
+	(*GetHabitRequest)(nil),             // 13: ethos.habits.v1.GetHabitRequest
+	(*UpdateHabitRequest)(nil),          // 14: ethos.habits.v1.UpdateHabitRequest
+	(*DeleteHabitRequest)(nil),          // 15: ethos.habits.v1.DeleteHabitRequest
+	(*PauseHabitRequest)(nil),           // 16: ethos.habits.v1.PauseHabitRequest
+	(*ReorderHabitsRequest)(nil),        // 17: ethos.habits.v1.ReorderHabitsRequest
+	(*ActivateHabitRequest)(nil),        // 18: ethos.habits.v1.ActivateHabitRequest
+	(*DeactivateHabitRequest)(nil),      // 19: ethos.habits.v1.DeactivateHabitRequest
+	(*GetHabitStatsRequest)(nil),        // 20: ethos.habits.v1.GetHabitStatsRequest
+	(*HabitStatsResponse)(nil),          // 21: ethos.habits.v1.HabitStatsResponse
+	(*GetHabitInsightsRequest)(nil),     // 22: ethos.habits.v1.GetHabitInsightsRequest
+	(*InsightBucket)(nil),               // 23: ethos.habits.v1.InsightBucket
+	(*HabitInsights)(nil),               // 24: ethos.habits.v1.HabitInsights
+	(*HabitInsightsResponse)(nil),       // 25: ethos.habits.v1.HabitInsightsResponse
+	(*LogHabitRequest)(nil),             // 26: ethos.habits.v1.LogHabitRequest
+	(*LogHabitResponse)(nil),            // 27: ethos.habits.v1.LogHabitResponse
+	(*LogHabitData)(nil),                // 28: ethos.habits.v1.LogHabitData
+	(*GetHabitLogsRequest)(nil),         // 29: ethos.habits.v1.GetHabitLogsRequest
+	(*GetHabitLogsResponse)(nil),        // 30: ethos.habits.v1.GetHabitLogsResponse
+	(*UpdateHabitLogRequest)(nil),       // 31: ethos.habits.v1.UpdateHabitLogRequest
+	(*DeleteHabitLogRequest)(nil),       // 32: ethos.habits.v1.DeleteHabitLogRequest
+	(*UndoHabitLogRequest)(nil),         // 33: ethos.habits.v1.UndoHabitLogRequest
+	(*SetHabitLogCountRequest)(nil),     // 34: ethos.habits.v1.SetHabitLogCountRequest
+	(*GetDashboardRequest)(nil),         // 35: ethos.habits.v1.GetDashboardRequest
+	(*DashboardResponse)(nil),           // 36: ethos.habits.v1.DashboardResponse
+	(*GetWeeklyAnalyticsRequest)(nil),   // 37: ethos.habits.v1.GetWeeklyAnalyticsRequest
+	(*WeeklyAnalyticsResponse)(nil),     // 38: ethos.habits.v1.WeeklyAnalyticsResponse
+	(*ComparePeriodsRequest)(nil),       // 39: ethos.habits.v1.ComparePeriodsRequest
+	(*PeriodSummary)(nil),               // 40: ethos.habits.v1.PeriodSummary
+	(*HabitPeriodDelta)(nil),            // 41: ethos.habits.v1.HabitPeriodDelta
+	(*PeriodComparison)(nil),            // 42: ethos.habits.v1.PeriodComparison
+	(*PeriodComparisonResponse)(nil),    // 43: ethos.habits.v1.PeriodComparisonResponse
+	(*GetHabitCorrelationsRequest)(nil), // 44: ethos.habits.v1.GetHabitCorrelationsRequest
+	(*HabitCorrelation)(nil),            // 45: ethos.habits.v1.HabitCorrelation
+	(*HabitCorrelations)(nil),           // 46: ethos.habits.v1.HabitCorrelations
+	(*HabitCorrelationsResponse)(nil),   // 47: ethos.habits.v1.HabitCorrelationsResponse
+	(*StartVacationRequest)(nil),        // 48: ethos.habits.v1.StartVacationRequest
+	(*VacationResponse)(nil),            // 49: ethos.habits.v1.VacationResponse
+	(*EndVacationRequest)(nil),          // 50: ethos.habits.v1.EndVacationRequest
+	(*ListVacationsRequest)(nil),        // 51: ethos.habits.v1.ListVacationsRequest
+	(*ListVacationsResponse)(nil),       // 52: ethos.habits.v1.ListVacationsResponse
+	(*HabitImport)(nil),                 // 53: ethos.habits.v1.HabitImport
+	(*ImportPreviewHabit)(nil),          // 54: ethos.habits.v1.ImportPreviewHabit
+	(*ImportPreview)(nil),               // 55: ethos.habits.v1.ImportPreview
+	(*PreviewImportRequest)(nil),        // 56: ethos.habits.v1.PreviewImportRequest
+	(*ImportPreviewResponse)(nil),       // 57: ethos.habits.v1.ImportPreviewResponse
+	(*StartImportRequest)(nil),          // 58: ethos.habits.v1.StartImportRequest
+	(*GetImportRequest)(nil),            // 59: ethos.habits.v1.GetImportRequest
+	(*ImportResponse)(nil),              // 60: ethos.habits.v1.ImportResponse
+	(*RecomputeHabitStatsRequest)(nil),  // 61: ethos.habits.v1.RecomputeHabitStatsRequest
+	(*RecomputeHabitStatsResponse)(nil), // 62: ethos.habits.v1.RecomputeHabitStatsResponse
+	(*timestamppb.Timestamp)(nil),       // 63: google.protobuf.Timestamp
+	(*v1.Meta)(nil),                     // 64: ethos.common.v1.Meta
+}
+var file_ethos_habits_v1_messages_proto_depIdxs = []int32{
+	63, // 0: ethos.habits.v1.Habit.created_at:type_name -> google.protobuf.Timestamp
+	63, // 1: ethos.habits.v1.Habit.updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 2: ethos.habits.v1.Habit.recurrence:type_name -> ethos.habits.v1.Recurrence
+	63, // 3: ethos.habits.v1.HabitLog.created_at:type_name -> google.protobuf.Timestamp
+	63, // 4: ethos.habits.v1.Vacation.created_at:type_name -> google.protobuf.Timestamp
+	7,  // 5: ethos.habits.v1.WeeklyAnalytics.days:type_name -> ethos.habits.v1.DailyAnalytics
+	1,  // 6: ethos.habits.v1.ListHabitsResponse.data:type_name -> ethos.habits.v1.Habit
+	64, // 7: ethos.habits.v1.ListHabitsResponse.meta:type_name -> ethos.common.v1.Meta
+	2,  // 8: ethos.habits.v1.CreateHabitRequest.recurrence:type_name -> ethos.habits.v1.Recurrence
+	1,  // 9: ethos.habits.v1.HabitResponse.data:type_name -> ethos.habits.v1.Habit
+	2,  // 10: ethos.habits.v1.UpdateHabitRequest.recurrence:type_name -> ethos.habits.v1.Recurrence
+	5,  // 11: ethos.habits.v1.HabitStatsResponse.data:type_name -> ethos.habits.v1.HabitStats
+	23, // 12: ethos.habits.v1.HabitInsights.weekdays:type_name -> ethos.habits.v1.InsightBucket
+	23, // 13: ethos.habits.v1.HabitInsights.times_of_day:type_name -> ethos.habits.v1.InsightBucket
+	24, // 14: ethos.habits.v1.HabitInsightsResponse.data:type_name -> ethos.habits.v1.HabitInsights
+	28, // 15: ethos.habits.v1.LogHabitResponse.data:type_name -> ethos.habits.v1.LogHabitData
+	3,  // 16: ethos.habits.v1.GetHabitLogsResponse.data:type_name -> ethos.habits.v1.HabitLog
+	64, // 17: ethos.habits.v1.GetHabitLogsResponse.meta:type_name -> ethos.common.v1.Meta
+	6,  // 18: ethos.habits.v1.DashboardResponse.data:type_name -> ethos.habits.v1.Dashboard
+	8,  // 19: ethos.habits.v1.WeeklyAnalyticsResponse.data:type_name -> ethos.habits.v1.WeeklyAnalytics
+	40, // 20: ethos.habits.v1.PeriodComparison.current:type_name -> ethos.habits.v1.PeriodSummary
+	40, // 21: ethos.habits.v1.PeriodComparison.previous:type_name -> ethos.habits.v1.PeriodSummary
+	41, // 22: ethos.habits.v1.PeriodComparison.habits:type_name -> ethos.habits.v1.HabitPeriodDelta
+	42, // 23: ethos.habits.v1.PeriodComparisonResponse.data:type_name -> ethos.habits.v1.PeriodComparison
+	45, // 24: ethos.habits.v1.HabitCorrelations.correlations:type_name -> ethos.habits.v1.HabitCorrelation
+	46, // 25: ethos.habits.v1.HabitCorrelationsResponse.data:type_name -> ethos.habits.v1.HabitCorrelations
+	4,  // 26: ethos.habits.v1.VacationResponse.data:type_name -> ethos.habits.v1.Vacation
+	4,  // 27: ethos.habits.v1.ListVacationsResponse.data:type_name -> ethos.habits.v1.Vacation
+	63, // 28: ethos.habits.v1.HabitImport.created_at:type_name -> google.protobuf.Timestamp
+	63, // 29: ethos.habits.v1.HabitImport.updated_at:type_name -> google.protobuf.Timestamp
+	63, // 30: ethos.habits.v1.HabitImport.completed_at:type_name -> google.protobuf.Timestamp
+	2,  // 31: ethos.habits.v1.ImportPreviewHabit.recurrence:type_name -> ethos.habits.v1.Recurrence
+	54, // 32: ethos.habits.v1.ImportPreview.habits:type_name -> ethos.habits.v1.ImportPreviewHabit
+	55, // 33: ethos.habits.v1.ImportPreviewResponse.data:type_name -> ethos.habits.v1.ImportPreview
+	53, // 34: ethos.habits.v1.ImportResponse.data:type_name -> ethos.habits.v1.HabitImport
+	63, // 35: ethos.habits.v1.RecomputeHabitStatsRequest.active_since:type_name -> google.protobuf.Timestamp
+	36, // [36:36] is the sub-list for method output_type
+	36, // [36:36] is the sub-list for method input_type
+	36, // [36:36] is the sub-list for extension type_name
+	36, // [36:36] is the sub-list for extension extendee
+	0,  // [0:36] is the sub-list for field type_name
+}
+
+func init() { file_ethos_habits_v1_messages_proto_init() }
+func file_ethos_habits_v1_messages_proto_init() {
+	if File_ethos_habits_v1_messages_proto != nil {
+		return
+	}
+	file_ethos_habits_v1_messages_proto_msgTypes[0].OneofWrappers = []any{}
+	file_ethos_habits_v1_messages_proto_msgTypes[2].OneofWrappers = []any{}
+	file_ethos_habits_v1_messages_proto_msgTypes[3].OneofWrappers = []any{}
+	file_ethos_habits_v1_messages_proto_msgTypes[8].OneofWrappers = []any{}
+	file_ethos_habits_v1_messages_proto_msgTypes[10].OneofWrappers = []any{}
+	file_ethos_habits_v1_messages_proto_msgTypes[13].OneofWrappers = []any{}
+	file_ethos_habits_v1_messages_proto_msgTypes[25].OneofWrappers = []any{}
+	file_ethos_habits_v1_messages_proto_msgTypes[28].OneofWrappers = []any{}
+	file_ethos_habits_v1_messages_proto_msgTypes[30].OneofWrappers = []any{}
+	file_ethos_habits_v1_messages_proto_msgTypes[32].OneofWrappers = []any{}
+	file_ethos_habits_v1_messages_proto_msgTypes[33].OneofWrappers = []any{}
+	file_ethos_habits_v1_messages_proto_msgTypes[40].OneofWrappers = []any{}
+	file_ethos_habits_v1_messages_proto_msgTypes[41].OneofWrappers = []any{}
+	file_ethos_habits_v1_messages_proto_msgTypes[47].OneofWrappers = []any{}
+	file_ethos_habits_v1_messages_proto_msgTypes[49].OneofWrappers = []any{}
+	file_ethos_habits_v1_messages_proto_msgTypes[52].OneofWrappers = []any{}
+	file_ethos_habits_v1_messages_proto_msgTypes[53].OneofWrappers = []any{}
+	file_ethos_habits_v1_messages_proto_msgTypes[60].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ethos_habits_v1_messages_proto_rawDesc), len(file_ethos_habits_v1_messages_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   62,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ethos_habits_v1_messages_proto_goTypes,
+		DependencyIndexes: file_ethos_habits_v1_messages_proto_depIdxs,
+		EnumInfos:         file_ethos_habits_v1_messages_proto_enumTypes,
+		MessageInfos:      file_ethos_habits_v1_messages_proto_msgTypes,
+	}.Build()
+	File_ethos_habits_v1_messages_proto = out.File
+	file_ethos_habits_v1_messages_proto_goTypes = nil
+	file_ethos_habits_v1_messages_proto_depIdxs = nil
+}
@@ -0,0 +1,1521 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: ethos/notifications/v1/messages.proto
+
+package notificationsv1
+
+import (
+	v1 "github.com/semmidev/ethos-go/contracts/grpc/ethos/common/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// NotificationType represents the type of notification.
+type NotificationType int32
+
+const (
+	// Unspecified notification type.
+	NotificationType_NOTIFICATION_TYPE_UNSPECIFIED NotificationType = 0
+	// Streak milestone notification.
+	NotificationType_NOTIFICATION_TYPE_STREAK_MILESTONE NotificationType = 1
+	// Habit reminder notification.
+	NotificationType_NOTIFICATION_TYPE_HABIT_REMINDER NotificationType = 2
+	// Achievement notification.
+	NotificationType_NOTIFICATION_TYPE_ACHIEVEMENT NotificationType = 3
+	// System notification.
+	NotificationType_NOTIFICATION_TYPE_SYSTEM NotificationType = 4
+	// Welcome notification.
+	NotificationType_NOTIFICATION_TYPE_WELCOME NotificationType = 5
+	// End-of-day completion summary.
+	NotificationType_NOTIFICATION_TYPE_DAILY_SUMMARY NotificationType = 6
+	// Nudge after a stretch without logs.
+	NotificationType_NOTIFICATION_TYPE_REENGAGEMENT NotificationType = 7
+	// Reminder for a habit missed several days in a row.
+	NotificationType_NOTIFICATION_TYPE_REMINDER_ESCALATION NotificationType = 8
+)
+
+// Enum value maps for NotificationType.
+var (
+	NotificationType_name = map[int32]string{
+		0: "NOTIFICATION_TYPE_UNSPECIFIED",
+		1: "NOTIFICATION_TYPE_STREAK_MILESTONE",
+		2: "NOTIFICATION_TYPE_HABIT_REMINDER",
+		3: "NOTIFICATION_TYPE_ACHIEVEMENT",
+		4: "NOTIFICATION_TYPE_SYSTEM",
+		5: "NOTIFICATION_TYPE_WELCOME",
+		6: "NOTIFICATION_TYPE_DAILY_SUMMARY",
+		7: "NOTIFICATION_TYPE_REENGAGEMENT",
+		8: "NOTIFICATION_TYPE_REMINDER_ESCALATION",
+	}
+	NotificationType_value = map[string]int32{
+		"NOTIFICATION_TYPE_UNSPECIFIED":         0,
+		"NOTIFICATION_TYPE_STREAK_MILESTONE":    1,
+		"NOTIFICATION_TYPE_HABIT_REMINDER":      2,
+		"NOTIFICATION_TYPE_ACHIEVEMENT":         3,
+		"NOTIFICATION_TYPE_SYSTEM":              4,
+		"NOTIFICATION_TYPE_WELCOME":             5,
+		"NOTIFICATION_TYPE_DAILY_SUMMARY":       6,
+		"NOTIFICATION_TYPE_REENGAGEMENT":        7,
+		"NOTIFICATION_TYPE_REMINDER_ESCALATION": 8,
+	}
+)
+
+func (x NotificationType) Enum() *NotificationType {
+	p := new(NotificationType)
+	*p = x
+	return p
+}
+
+func (x NotificationType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NotificationType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ethos_notifications_v1_messages_proto_enumTypes[0].Descriptor()
+}
+
+func (NotificationType) Type() protoreflect.EnumType {
+	return &file_ethos_notifications_v1_messages_proto_enumTypes[0]
+}
+
+func (x NotificationType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotificationType.Descriptor instead.
+func (NotificationType) EnumDescriptor() ([]byte, []int) {
+	return file_ethos_notifications_v1_messages_proto_rawDescGZIP(), []int{0}
+}
+
+// Notification represents a user notification.
+type Notification struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique notification identifier.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Notification type.
+	Type NotificationType `protobuf:"varint,2,opt,name=type,proto3,enum=ethos.notifications.v1.NotificationType" json:"type,omitempty"`
+	// Notification title.
+	Title string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	// Notification message.
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	// Additional notification data.
+	Data *structpb.Struct `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	// Whether the notification has been read.
+	IsRead bool `protobuf:"varint,6,opt,name=is_read,json=isRead,proto3" json:"is_read,omitempty"`
+	// Creation time.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Time when notification was read.
+	ReadAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=read_at,json=readAt,proto3,oneof" json:"read_at,omitempty"`
+	// Thread the notification belongs to, e.g. "habit:<habit_id>" for the
+	// reminders of one habit.
+	GroupKey *string `protobuf:"bytes,9,opt,name=group_key,json=groupKey,proto3,oneof" json:"group_key,omitempty"`
+	// In grouped listings, the number of notifications in the thread this
+	// one, its latest, stands for.
+	GroupCount int32 `protobuf:"varint,10,opt,name=group_count,json=groupCount,proto3" json:"group_count,omitempty"`
+	// In grouped listings, how many of the thread's notifications are unread.
+	GroupUnreadCount int32 `protobuf:"varint,11,opt,name=group_unread_count,json=groupUnreadCount,proto3" json:"group_unread_count,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Notification) Reset() {
+	*x = Notification{}
+	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification) ProtoMessage() {}
+
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_ethos_notifications_v1_messages_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Notification) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Notification) GetType() NotificationType {
+	if x != nil {
+		return x.Type
+	}
+	return NotificationType_NOTIFICATION_TYPE_UNSPECIFIED
+}
+
+func (x *Notification) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Notification) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Notification) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Notification) GetIsRead() bool {
+	if x != nil {
+		return x.IsRead
+	}
+	return false
+}
+
+func (x *Notification) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Notification) GetReadAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReadAt
+	}
+	return nil
+}
+
+func (x *Notification) GetGroupKey() string {
+	if x != nil && x.GroupKey != nil {
+		return *x.GroupKey
+	}
+	return ""
+}
+
+func (x *Notification) GetGroupCount() int32 {
+	if x != nil {
+		return x.GroupCount
+	}
+	return 0
+}
+
+func (x *Notification) GetGroupUnreadCount() int32 {
+	if x != nil {
+		return x.GroupUnreadCount
+	}
+	return 0
+}
+
+// CreateNotificationRequest contains data for creating a notification.
+type CreateNotificationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Notification title.
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// Notification message.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Notification type as string (streak_milestone, habit_reminder, etc.).
+	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	// Additional data.
+	Data *structpb.Struct `protobuf:"bytes,4,opt,name=data,proto3,oneof" json:"data,omitempty"`
+	// Deliver the notification at this time instead of now, at most 30 days
+	// ahead. Until then it is pending and not listed.
+	DeliverAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=deliver_at,json=deliverAt,proto3,oneof" json:"deliver_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateNotificationRequest) Reset() {
+	*x = CreateNotificationRequest{}
+	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNotificationRequest) ProtoMessage() {}
+
+func (x *CreateNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNotificationRequest.ProtoReflect.Descriptor instead.
+func (*CreateNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_notifications_v1_messages_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateNotificationRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateNotificationRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateNotificationRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreateNotificationRequest) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *CreateNotificationRequest) GetDeliverAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeliverAt
+	}
+	return nil
+}
+
+// ListNotificationsRequest contains filters for listing notifications.
+type ListNotificationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Page number (1-indexed).
+	Page int32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	// Number of items per page.
+	PerPage int32 `protobuf:"varint,2,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	// Only return unread notifications. Deprecated: use read=false.
+	//
+	// Deprecated: Marked as deprecated in ethos/notifications/v1/messages.proto.
+	UnreadOnly bool `protobuf:"varint,3,opt,name=unread_only,json=unreadOnly,proto3" json:"unread_only,omitempty"`
+	// Collapse each thread into its latest notification, with counts.
+	Grouped bool `protobuf:"varint,4,opt,name=grouped,proto3" json:"grouped,omitempty"`
+	// Only return notifications of these types (streak_milestone,
+	// habit_reminder, etc.). Repeat the parameter to select several.
+	Type []string `protobuf:"bytes,5,rep,name=type,proto3" json:"type,omitempty"`
+	// Only return read notifications when true, unread ones when false.
+	Read *bool `protobuf:"varint,6,opt,name=read,proto3,oneof" json:"read,omitempty"`
+	// Only return notifications created on or after this day, in
+	// YYYY-MM-DD format (UTC).
+	StartDate *string `protobuf:"bytes,7,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"`
+	// Only return notifications created on or before this day, in
+	// YYYY-MM-DD format (UTC).
+	EndDate *string `protobuf:"bytes,8,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`
+	// Only return notifications about this habit.
+	HabitId *string `protobuf:"bytes,9,opt,name=habit_id,json=habitId,proto3,oneof" json:"habit_id,omitempty"`
+	// Only return notifications whose title or message contains this text,
+	// ignoring case.
+	Keyword       *string `protobuf:"bytes,10,opt,name=keyword,proto3,oneof" json:"keyword,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationsRequest) Reset() {
+	*x = ListNotificationsRequest{}
+	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationsRequest) ProtoMessage() {}
+
+func (x *ListNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*ListNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_notifications_v1_messages_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListNotificationsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListNotificationsRequest) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in ethos/notifications/v1/messages.proto.
+func (x *ListNotificationsRequest) GetUnreadOnly() bool {
+	if x != nil {
+		return x.UnreadOnly
+	}
+	return false
+}
+
+func (x *ListNotificationsRequest) GetGrouped() bool {
+	if x != nil {
+		return x.Grouped
+	}
+	return false
+}
+
+func (x *ListNotificationsRequest) GetType() []string {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *ListNotificationsRequest) GetRead() bool {
+	if x != nil && x.Read != nil {
+		return *x.Read
+	}
+	return false
+}
+
+func (x *ListNotificationsRequest) GetStartDate() string {
+	if x != nil && x.StartDate != nil {
+		return *x.StartDate
+	}
+	return ""
+}
+
+func (x *ListNotificationsRequest) GetEndDate() string {
+	if x != nil && x.EndDate != nil {
+		return *x.EndDate
+	}
+	return ""
+}
+
+func (x *ListNotificationsRequest) GetHabitId() string {
+	if x != nil && x.HabitId != nil {
+		return *x.HabitId
+	}
+	return ""
+}
+
+func (x *ListNotificationsRequest) GetKeyword() string {
+	if x != nil && x.Keyword != nil {
+		return *x.Keyword
+	}
+	return ""
+}
+
+// ListNotificationsResponse contains paginated notifications.
+type ListNotificationsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the request was successful.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Human-readable message.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// List of notifications.
+	Data []*Notification `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	// Pagination metadata.
+	Meta          *v1.Meta `protobuf:"bytes,4,opt,name=meta,proto3" json:"meta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationsResponse) Reset() {
+	*x = ListNotificationsResponse{}
+	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationsResponse) ProtoMessage() {}
+
+func (x *ListNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*ListNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_ethos_notifications_v1_messages_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListNotificationsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ListNotificationsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListNotificationsResponse) GetData() []*Notification {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListNotificationsResponse) GetMeta() *v1.Meta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+// GetUnreadCountRequest is empty - uses auth context.
+type GetUnreadCountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUnreadCountRequest) Reset() {
+	*x = GetUnreadCountRequest{}
+	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUnreadCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnreadCountRequest) ProtoMessage() {}
+
+func (x *GetUnreadCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnreadCountRequest.ProtoReflect.Descriptor instead.
+func (*GetUnreadCountRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_notifications_v1_messages_proto_rawDescGZIP(), []int{4}
+}
+
+// UnreadCountResponse contains the unread notification count.
+type UnreadCountResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the request was successful.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Human-readable message.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Unread count data.
+	Data          *UnreadCountData `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnreadCountResponse) Reset() {
+	*x = UnreadCountResponse{}
+	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnreadCountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnreadCountResponse) ProtoMessage() {}
+
+func (x *UnreadCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnreadCountResponse.ProtoReflect.Descriptor instead.
+func (*UnreadCountResponse) Descriptor() ([]byte, []int) {
+	return file_ethos_notifications_v1_messages_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UnreadCountResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UnreadCountResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UnreadCountResponse) GetData() *UnreadCountData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// UnreadCountData contains the unread count.
+type UnreadCountData struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of unread notifications.
+	Count         int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnreadCountData) Reset() {
+	*x = UnreadCountData{}
+	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnreadCountData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnreadCountData) ProtoMessage() {}
+
+func (x *UnreadCountData) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnreadCountData.ProtoReflect.Descriptor instead.
+func (*UnreadCountData) Descriptor() ([]byte, []int) {
+	return file_ethos_notifications_v1_messages_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UnreadCountData) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// MarkAsReadRequest identifies a notification to mark as read.
+type MarkAsReadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Notification identifier.
+	NotificationId string `protobuf:"bytes,1,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MarkAsReadRequest) Reset() {
+	*x = MarkAsReadRequest{}
+	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkAsReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAsReadRequest) ProtoMessage() {}
+
+func (x *MarkAsReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAsReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkAsReadRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_notifications_v1_messages_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MarkAsReadRequest) GetNotificationId() string {
+	if x != nil {
+		return x.NotificationId
+	}
+	return ""
+}
+
+// SnoozeNotificationRequest pushes a notification back to a later time.
+type SnoozeNotificationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Notification identifier.
+	NotificationId string `protobuf:"bytes,1,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	// When to deliver the notification again, at most 30 days ahead.
+	Until         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=until,proto3" json:"until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SnoozeNotificationRequest) Reset() {
+	*x = SnoozeNotificationRequest{}
+	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnoozeNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnoozeNotificationRequest) ProtoMessage() {}
+
+func (x *SnoozeNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnoozeNotificationRequest.ProtoReflect.Descriptor instead.
+func (*SnoozeNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_notifications_v1_messages_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SnoozeNotificationRequest) GetNotificationId() string {
+	if x != nil {
+		return x.NotificationId
+	}
+	return ""
+}
+
+func (x *SnoozeNotificationRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+// MarkAllAsReadRequest is empty - uses auth context.
+type MarkAllAsReadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkAllAsReadRequest) Reset() {
+	*x = MarkAllAsReadRequest{}
+	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkAllAsReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAllAsReadRequest) ProtoMessage() {}
+
+func (x *MarkAllAsReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAllAsReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkAllAsReadRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_notifications_v1_messages_proto_rawDescGZIP(), []int{9}
+}
+
+// DeleteNotificationRequest identifies a notification to delete.
+type DeleteNotificationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Notification identifier.
+	NotificationId string `protobuf:"bytes,1,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeleteNotificationRequest) Reset() {
+	*x = DeleteNotificationRequest{}
+	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNotificationRequest) ProtoMessage() {}
+
+func (x *DeleteNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNotificationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_notifications_v1_messages_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteNotificationRequest) GetNotificationId() string {
+	if x != nil {
+		return x.NotificationId
+	}
+	return ""
+}
+
+// BatchMarkAsReadRequest lists notifications to mark as read together.
+type BatchMarkAsReadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Notification identifiers, at most 100; all must belong to the user.
+	NotificationIds []string `protobuf:"bytes,1,rep,name=notification_ids,json=notificationIds,proto3" json:"notification_ids,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BatchMarkAsReadRequest) Reset() {
+	*x = BatchMarkAsReadRequest{}
+	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchMarkAsReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchMarkAsReadRequest) ProtoMessage() {}
+
+func (x *BatchMarkAsReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchMarkAsReadRequest.ProtoReflect.Descriptor instead.
+func (*BatchMarkAsReadRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_notifications_v1_messages_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BatchMarkAsReadRequest) GetNotificationIds() []string {
+	if x != nil {
+		return x.NotificationIds
+	}
+	return nil
+}
+
+// BatchDeleteNotificationsRequest lists notifications to delete together.
+type BatchDeleteNotificationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Notification identifiers, at most 100; all must belong to the user.
+	NotificationIds []string `protobuf:"bytes,1,rep,name=notification_ids,json=notificationIds,proto3" json:"notification_ids,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BatchDeleteNotificationsRequest) Reset() {
+	*x = BatchDeleteNotificationsRequest{}
+	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDeleteNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteNotificationsRequest) ProtoMessage() {}
+
+func (x *BatchDeleteNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*BatchDeleteNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_notifications_v1_messages_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BatchDeleteNotificationsRequest) GetNotificationIds() []string {
+	if x != nil {
+		return x.NotificationIds
+	}
+	return nil
+}
+
+// NotificationPreferences holds the user's scheduled notification choices.
+type NotificationPreferences struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the end-of-day summary is sent.
+	DailySummary bool `protobuf:"varint,1,opt,name=daily_summary,json=dailySummary,proto3" json:"daily_summary,omitempty"`
+	// Start of quiet hours (HH:MM, user timezone).
+	QuietHoursStart *string `protobuf:"bytes,2,opt,name=quiet_hours_start,json=quietHoursStart,proto3,oneof" json:"quiet_hours_start,omitempty"`
+	// End of quiet hours (HH:MM, user timezone); may be before the start to wrap past midnight.
+	QuietHoursEnd *string `protobuf:"bytes,3,opt,name=quiet_hours_end,json=quietHoursEnd,proto3,oneof" json:"quiet_hours_end,omitempty"`
+	// Whether the weekly progress report is emailed.
+	WeeklyReport bool `protobuf:"varint,4,opt,name=weekly_report,json=weeklyReport,proto3" json:"weekly_report,omitempty"`
+	// Whether to be nudged after a stretch without logs.
+	Reengagement bool `protobuf:"varint,5,opt,name=reengagement,proto3" json:"reengagement,omitempty"`
+	// Whether do not disturb is on; habit reminders are not sent meanwhile.
+	DoNotDisturb bool `protobuf:"varint,6,opt,name=do_not_disturb,json=doNotDisturb,proto3" json:"do_not_disturb,omitempty"`
+	// When do not disturb switches itself off; unset means it stays on.
+	DoNotDisturbUntil *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=do_not_disturb_until,json=doNotDisturbUntil,proto3,oneof" json:"do_not_disturb_until,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *NotificationPreferences) Reset() {
+	*x = NotificationPreferences{}
+	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationPreferences) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationPreferences) ProtoMessage() {}
+
+func (x *NotificationPreferences) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationPreferences.ProtoReflect.Descriptor instead.
+func (*NotificationPreferences) Descriptor() ([]byte, []int) {
+	return file_ethos_notifications_v1_messages_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *NotificationPreferences) GetDailySummary() bool {
+	if x != nil {
+		return x.DailySummary
+	}
+	return false
+}
+
+func (x *NotificationPreferences) GetQuietHoursStart() string {
+	if x != nil && x.QuietHoursStart != nil {
+		return *x.QuietHoursStart
+	}
+	return ""
+}
+
+func (x *NotificationPreferences) GetQuietHoursEnd() string {
+	if x != nil && x.QuietHoursEnd != nil {
+		return *x.QuietHoursEnd
+	}
+	return ""
+}
+
+func (x *NotificationPreferences) GetWeeklyReport() bool {
+	if x != nil {
+		return x.WeeklyReport
+	}
+	return false
+}
+
+func (x *NotificationPreferences) GetReengagement() bool {
+	if x != nil {
+		return x.Reengagement
+	}
+	return false
+}
+
+func (x *NotificationPreferences) GetDoNotDisturb() bool {
+	if x != nil {
+		return x.DoNotDisturb
+	}
+	return false
+}
+
+func (x *NotificationPreferences) GetDoNotDisturbUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DoNotDisturbUntil
+	}
+	return nil
+}
+
+// GetNotificationPreferencesRequest is empty - uses auth context.
+type GetNotificationPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationPreferencesRequest) Reset() {
+	*x = GetNotificationPreferencesRequest{}
+	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationPreferencesRequest) ProtoMessage() {}
+
+func (x *GetNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*GetNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_notifications_v1_messages_proto_rawDescGZIP(), []int{14}
+}
+
+// UpdateNotificationPreferencesRequest changes the given preferences; unset fields are kept.
+type UpdateNotificationPreferencesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the end-of-day summary is sent.
+	DailySummary *bool `protobuf:"varint,1,opt,name=daily_summary,json=dailySummary,proto3,oneof" json:"daily_summary,omitempty"`
+	// Start of quiet hours (HH:MM); empty clears quiet hours.
+	QuietHoursStart *string `protobuf:"bytes,2,opt,name=quiet_hours_start,json=quietHoursStart,proto3,oneof" json:"quiet_hours_start,omitempty"`
+	// End of quiet hours (HH:MM); empty clears quiet hours.
+	QuietHoursEnd *string `protobuf:"bytes,3,opt,name=quiet_hours_end,json=quietHoursEnd,proto3,oneof" json:"quiet_hours_end,omitempty"`
+	// Whether the weekly progress report is emailed.
+	WeeklyReport *bool `protobuf:"varint,4,opt,name=weekly_report,json=weeklyReport,proto3,oneof" json:"weekly_report,omitempty"`
+	// Whether to be nudged after a stretch without logs.
+	Reengagement *bool `protobuf:"varint,5,opt,name=reengagement,proto3,oneof" json:"reengagement,omitempty"`
+	// Switch do not disturb on or off.
+	DoNotDisturb *bool `protobuf:"varint,6,opt,name=do_not_disturb,json=doNotDisturb,proto3,oneof" json:"do_not_disturb,omitempty"`
+	// With do_not_disturb on, when it switches itself off; unset keeps it on.
+	DoNotDisturbUntil *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=do_not_disturb_until,json=doNotDisturbUntil,proto3,oneof" json:"do_not_disturb_until,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UpdateNotificationPreferencesRequest) Reset() {
+	*x = UpdateNotificationPreferencesRequest{}
+	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotificationPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotificationPreferencesRequest) ProtoMessage() {}
+
+func (x *UpdateNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_notifications_v1_messages_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpdateNotificationPreferencesRequest) GetDailySummary() bool {
+	if x != nil && x.DailySummary != nil {
+		return *x.DailySummary
+	}
+	return false
+}
+
+func (x *UpdateNotificationPreferencesRequest) GetQuietHoursStart() string {
+	if x != nil && x.QuietHoursStart != nil {
+		return *x.QuietHoursStart
+	}
+	return ""
+}
+
+func (x *UpdateNotificationPreferencesRequest) GetQuietHoursEnd() string {
+	if x != nil && x.QuietHoursEnd != nil {
+		return *x.QuietHoursEnd
+	}
+	return ""
+}
+
+func (x *UpdateNotificationPreferencesRequest) GetWeeklyReport() bool {
+	if x != nil && x.WeeklyReport != nil {
+		return *x.WeeklyReport
+	}
+	return false
+}
+
+func (x *UpdateNotificationPreferencesRequest) GetReengagement() bool {
+	if x != nil && x.Reengagement != nil {
+		return *x.Reengagement
+	}
+	return false
+}
+
+func (x *UpdateNotificationPreferencesRequest) GetDoNotDisturb() bool {
+	if x != nil && x.DoNotDisturb != nil {
+		return *x.DoNotDisturb
+	}
+	return false
+}
+
+func (x *UpdateNotificationPreferencesRequest) GetDoNotDisturbUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DoNotDisturbUntil
+	}
+	return nil
+}
+
+// UnsubscribeRequest carries the token from a report email's unsubscribe link.
+type UnsubscribeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unsubscribe token.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Email kind to stop (weekly_report or reengagement); defaults to weekly_report.
+	Kind          string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnsubscribeRequest) Reset() {
+	*x = UnsubscribeRequest{}
+	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubscribeRequest) ProtoMessage() {}
+
+func (x *UnsubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubscribeRequest.ProtoReflect.Descriptor instead.
+func (*UnsubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_ethos_notifications_v1_messages_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UnsubscribeRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *UnsubscribeRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+// NotificationPreferencesResponse contains the user's preferences.
+type NotificationPreferencesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the request was successful.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Human-readable message.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Preferences data.
+	Data          *NotificationPreferences `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationPreferencesResponse) Reset() {
+	*x = NotificationPreferencesResponse{}
+	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationPreferencesResponse) ProtoMessage() {}
+
+func (x *NotificationPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*NotificationPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_ethos_notifications_v1_messages_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *NotificationPreferencesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *NotificationPreferencesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *NotificationPreferencesResponse) GetData() *NotificationPreferences {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_ethos_notifications_v1_messages_proto protoreflect.FileDescriptor
+
+const file_ethos_notifications_v1_messages_proto_rawDesc = "" +
+	"\n" +
+	"%ethos/notifications/v1/messages.proto\x12\x16ethos.notifications.v1\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a ethos/common/v1/pagination.proto\"\xd2\x03\n" +
+	"\fNotification\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12<\n" +
+	"\x04type\x18\x02 \x01(\x0e2(.ethos.notifications.v1.NotificationTypeR\x04type\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x12+\n" +
+	"\x04data\x18\x05 \x01(\v2\x17.google.protobuf.StructR\x04data\x12\x17\n" +
+	"\ais_read\x18\x06 \x01(\bR\x06isRead\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x128\n" +
+	"\aread_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x06readAt\x88\x01\x01\x12 \n" +
+	"\tgroup_key\x18\t \x01(\tH\x01R\bgroupKey\x88\x01\x01\x12\x1f\n" +
+	"\vgroup_count\x18\n" +
+	" \x01(\x05R\n" +
+	"groupCount\x12,\n" +
+	"\x12group_unread_count\x18\v \x01(\x05R\x10groupUnreadCountB\n" +
+	"\n" +
+	"\b_read_atB\f\n" +
+	"\n" +
+	"_group_key\"\xe9\x01\n" +
+	"\x19CreateNotificationRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x120\n" +
+	"\x04data\x18\x04 \x01(\v2\x17.google.protobuf.StructH\x00R\x04data\x88\x01\x01\x12>\n" +
+	"\n" +
+	"deliver_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\tdeliverAt\x88\x01\x01B\a\n" +
+	"\x05_dataB\r\n" +
+	"\v_deliver_at\"\xf6\x02\n" +
+	"\x18ListNotificationsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x19\n" +
+	"\bper_page\x18\x02 \x01(\x05R\aperPage\x12#\n" +
+	"\vunread_only\x18\x03 \x01(\bB\x02\x18\x01R\n" +
+	"unreadOnly\x12\x18\n" +
+	"\agrouped\x18\x04 \x01(\bR\agrouped\x12\x12\n" +
+	"\x04type\x18\x05 \x03(\tR\x04type\x12\x17\n" +
+	"\x04read\x18\x06 \x01(\bH\x00R\x04read\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"start_date\x18\a \x01(\tH\x01R\tstartDate\x88\x01\x01\x12\x1e\n" +
+	"\bend_date\x18\b \x01(\tH\x02R\aendDate\x88\x01\x01\x12\x1e\n" +
+	"\bhabit_id\x18\t \x01(\tH\x03R\ahabitId\x88\x01\x01\x12\x1d\n" +
+	"\akeyword\x18\n" +
+	" \x01(\tH\x04R\akeyword\x88\x01\x01B\a\n" +
+	"\x05_readB\r\n" +
+	"\v_start_dateB\v\n" +
+	"\t_end_dateB\v\n" +
+	"\t_habit_idB\n" +
+	"\n" +
+	"\b_keyword\"\xb4\x01\n" +
+	"\x19ListNotificationsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x128\n" +
+	"\x04data\x18\x03 \x03(\v2$.ethos.notifications.v1.NotificationR\x04data\x12)\n" +
+	"\x04meta\x18\x04 \x01(\v2\x15.ethos.common.v1.MetaR\x04meta\"\x17\n" +
+	"\x15GetUnreadCountRequest\"\x86\x01\n" +
+	"\x13UnreadCountResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12;\n" +
+	"\x04data\x18\x03 \x01(\v2'.ethos.notifications.v1.UnreadCountDataR\x04data\"'\n" +
+	"\x0fUnreadCountData\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count\"<\n" +
+	"\x11MarkAsReadRequest\x12'\n" +
+	"\x0fnotification_id\x18\x01 \x01(\tR\x0enotificationId\"v\n" +
+	"\x19SnoozeNotificationRequest\x12'\n" +
+	"\x0fnotification_id\x18\x01 \x01(\tR\x0enotificationId\x120\n" +
+	"\x05until\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05until\"\x16\n" +
+	"\x14MarkAllAsReadRequest\"D\n" +
+	"\x19DeleteNotificationRequest\x12'\n" +
+	"\x0fnotification_id\x18\x01 \x01(\tR\x0enotificationId\"C\n" +
+	"\x16BatchMarkAsReadRequest\x12)\n" +
+	"\x10notification_ids\x18\x01 \x03(\tR\x0fnotificationIds\"L\n" +
+	"\x1fBatchDeleteNotificationsRequest\x12)\n" +
+	"\x10notification_ids\x18\x01 \x03(\tR\x0fnotificationIds\"\xa0\x03\n" +
+	"\x17NotificationPreferences\x12#\n" +
+	"\rdaily_summary\x18\x01 \x01(\bR\fdailySummary\x12/\n" +
+	"\x11quiet_hours_start\x18\x02 \x01(\tH\x00R\x0fquietHoursStart\x88\x01\x01\x12+\n" +
+	"\x0fquiet_hours_end\x18\x03 \x01(\tH\x01R\rquietHoursEnd\x88\x01\x01\x12#\n" +
+	"\rweekly_report\x18\x04 \x01(\bR\fweeklyReport\x12\"\n" +
+	"\freengagement\x18\x05 \x01(\bR\freengagement\x12$\n" +
+	"\x0edo_not_disturb\x18\x06 \x01(\bR\fdoNotDisturb\x12P\n" +
+	"\x14do_not_disturb_until\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x02R\x11doNotDisturbUntil\x88\x01\x01B\x14\n" +
+	"\x12_quiet_hours_startB\x12\n" +
+	"\x10_quiet_hours_endB\x17\n" +
+	"\x15_do_not_disturb_until\"#\n" +
+	"!GetNotificationPreferencesRequest\"\x89\x04\n" +
+	"$UpdateNotificationPreferencesRequest\x12(\n" +
+	"\rdaily_summary\x18\x01 \x01(\bH\x00R\fdailySummary\x88\x01\x01\x12/\n" +
+	"\x11quiet_hours_start\x18\x02 \x01(\tH\x01R\x0fquietHoursStart\x88\x01\x01\x12+\n" +
+	"\x0fquiet_hours_end\x18\x03 \x01(\tH\x02R\rquietHoursEnd\x88\x01\x01\x12(\n" +
+	"\rweekly_report\x18\x04 \x01(\bH\x03R\fweeklyReport\x88\x01\x01\x12'\n" +
+	"\freengagement\x18\x05 \x01(\bH\x04R\freengagement\x88\x01\x01\x12)\n" +
+	"\x0edo_not_disturb\x18\x06 \x01(\bH\x05R\fdoNotDisturb\x88\x01\x01\x12P\n" +
+	"\x14do_not_disturb_until\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x06R\x11doNotDisturbUntil\x88\x01\x01B\x10\n" +
+	"\x0e_daily_summaryB\x14\n" +
+	"\x12_quiet_hours_startB\x12\n" +
+	"\x10_quiet_hours_endB\x10\n" +
+	"\x0e_weekly_reportB\x0f\n" +
+	"\r_reengagementB\x11\n" +
+	"\x0f_do_not_disturbB\x17\n" +
+	"\x15_do_not_disturb_until\">\n" +
+	"\x12UnsubscribeRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\"\x9a\x01\n" +
+	"\x1fNotificationPreferencesResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12C\n" +
+	"\x04data\x18\x03 \x01(\v2/.ethos.notifications.v1.NotificationPreferencesR\x04data*\xd7\x02\n" +
+	"\x10NotificationType\x12!\n" +
+	"\x1dNOTIFICATION_TYPE_UNSPECIFIED\x10\x00\x12&\n" +
+	"\"NOTIFICATION_TYPE_STREAK_MILESTONE\x10\x01\x12$\n" +
+	" NOTIFICATION_TYPE_HABIT_REMINDER\x10\x02\x12!\n" +
+	"\x1dNOTIFICATION_TYPE_ACHIEVEMENT\x10\x03\x12\x1c\n" +
+	"\x18NOTIFICATION_TYPE_SYSTEM\x10\x04\x12\x1d\n" +
+	"\x19NOTIFICATION_TYPE_WELCOME\x10\x05\x12#\n" +
+	"\x1fNOTIFICATION_TYPE_DAILY_SUMMARY\x10\x06\x12\"\n" +
+	"\x1eNOTIFICATION_TYPE_REENGAGEMENT\x10\a\x12)\n" +
+	"%NOTIFICATION_TYPE_REMINDER_ESCALATION\x10\bB\xf9\x01\n" +
+	"\x1acom.ethos.notifications.v1B\rMessagesProtoP\x01ZRgithub.com/semmidev/ethos-go/contracts/grpc/ethos/notifications/v1;notificationsv1\xa2\x02\x03ENX\xaa\x02\x16Ethos.Notifications.V1\xca\x02\x16Ethos\\Notifications\\V1\xe2\x02\"Ethos\\Notifications\\V1\\GPBMetadata\xea\x02\x18Ethos::Notifications::V1b\x06proto3"
+
+var (
+	file_ethos_notifications_v1_messages_proto_rawDescOnce sync.Once
+	file_ethos_notifications_v1_messages_proto_rawDescData []byte
+)
+
+func file_ethos_notifications_v1_messages_proto_rawDescGZIP() []byte {
+	file_ethos_notifications_v1_messages_proto_rawDescOnce.Do(func() {
+		file_ethos_notifications_v1_messages_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ethos_notifications_v1_messages_proto_rawDesc), len(file_ethos_notifications_v1_messages_proto_rawDesc)))
+	})
+	return file_ethos_notifications_v1_messages_proto_rawDescData
+}
+
+var file_ethos_notifications_v1_messages_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_ethos_notifications_v1_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_ethos_notifications_v1_messages_proto_goTypes = []any{
+	(NotificationType)(0),                        // 0: ethos.notifications.v1.NotificationType
+	(*Notification)(nil),                         // 1: ethos.notifications.v1.Notification
+	(*CreateNotificationRequest)(nil),            // 2: ethos.notifications.v1.CreateNotificationRequest
+	(*ListNotificationsRequest)(nil),             // 3: ethos.notifications.v1.ListNotificationsRequest
+	(*ListNotificationsResponse)(nil),            // 4: ethos.notifications.v1.ListNotificationsResponse
+	(*GetUnreadCountRequest)(nil),                // 5: ethos.notifications.v1.GetUnreadCountRequest
+	(*UnreadCountResponse)(nil),                  // 6: ethos.notifications.v1.UnreadCountResponse
+	(*UnreadCountData)(nil),                      // 7: ethos.notifications.v1.UnreadCountData
+	(*MarkAsReadRequest)(nil),                    // 8: ethos.notifications.v1.MarkAsReadRequest
+	(*SnoozeNotificationRequest)(nil),            // 9: ethos.notifications.v1.SnoozeNotificationRequest
+	(*MarkAllAsReadRequest)(nil),                 // 10: ethos.notifications.v1.MarkAllAsReadRequest
+	(*DeleteNotificationRequest)(nil),            // 11: ethos.notifications.v1.DeleteNotificationRequest
+	(*BatchMarkAsReadRequest)(nil),               // 12: ethos.notifications.v1.BatchMarkAsReadRequest
+	(*BatchDeleteNotificationsRequest)(nil),      // 13: ethos.notifications.v1.BatchDeleteNotificationsRequest
+	(*NotificationPreferences)(nil),              // 14: ethos.notifications.v1.NotificationPreferences
+	(*GetNotificationPreferencesRequest)(nil),    // 15: ethos.notifications.v1.GetNotificationPreferencesRequest
+	(*UpdateNotificationPreferencesRequest)(nil), // 16: ethos.notifications.v1.UpdateNotificationPreferencesRequest
+	(*UnsubscribeRequest)(nil),                   // 17: ethos.notifications.v1.UnsubscribeRequest
+	(*NotificationPreferencesResponse)(nil),      // 18: ethos.notifications.v1.NotificationPreferencesResponse
+	(*structpb.Struct)(nil),                      // 19: google.protobuf.Struct
+	(*timestamppb.Timestamp)(nil),                // 20: google.protobuf.Timestamp
+	(*v1.Meta)(nil),                              // 21: ethos.common.v1.Meta
+}
+var file_ethos_notifications_v1_messages_proto_depIdxs = []int32{
+	0,  // 0: ethos.notifications.v1.Notification.type:type_name -> ethos.notifications.v1.NotificationType
+	19, // 1: ethos.notifications.v1.Notification.data:type_name -> google.protobuf.Struct
+	20, // 2: ethos.notifications.v1.Notification.created_at:type_name -> google.protobuf.Timestamp
+	20, // 3: ethos.notifications.v1.Notification.read_at:type_name -> google.protobuf.Timestamp
+	19, // 4: ethos.notifications.v1.CreateNotificationRequest.data:type_name -> google.protobuf.Struct
+	20, // 5: ethos.notifications.v1.CreateNotificationRequest.deliver_at:type_name -> google.protobuf.Timestamp
+	1,  // 6: ethos.notifications.v1.ListNotificationsResponse.data:type_name -> ethos.notifications.v1.Notification
+	21, // 7: ethos.notifications.v1.ListNotificationsResponse.meta:type_name -> ethos.common.v1.Meta
+	7,  // 8: ethos.notifications.v1.UnreadCountResponse.data:type_name -> ethos.notifications.v1.UnreadCountData
+	20, // 9: ethos.notifications.v1.SnoozeNotificationRequest.until:type_name -> google.protobuf.Timestamp
+	20, // 10: ethos.notifications.v1.NotificationPreferences.do_not_disturb_until:type_name -> google.protobuf.Timestamp
+	20, // 11: ethos.notifications.v1.UpdateNotificationPreferencesRequest.do_not_disturb_until:type_name -> google.protobuf.Timestamp
+	14, // 12: ethos.notifications.v1.NotificationPreferencesResponse.data:type_name -> ethos.notifications.v1.NotificationPreferences
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_ethos_notifications_v1_messages_proto_init() }
+func file_ethos_notifications_v1_messages_proto_init() {
+	if File_ethos_notifications_v1_messages_proto != nil {
+		return
+	}
+	file_ethos_notifications_v1_messages_proto_msgTypes[0].OneofWrappers = []any{}
+	file_ethos_notifications_v1_messages_proto_msgTypes[1].OneofWrappers = []any{}
+	file_ethos_notifications_v1_messages_proto_msgTypes[2].OneofWrappers = []any{}
+	file_ethos_notifications_v1_messages_proto_msgTypes[13].OneofWrappers = []any{}
+	file_ethos_notifications_v1_messages_proto_msgTypes[15].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ethos_notifications_v1_messages_proto_rawDesc), len(file_ethos_notifications_v1_messages_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ethos_notifications_v1_messages_proto_goTypes,
+		DependencyIndexes: file_ethos_notifications_v1_messages_proto_depIdxs,
+		EnumInfos:         file_ethos_notifications_v1_messages_proto_enumTypes,
+		MessageInfos:      file_ethos_notifications_v1_messages_proto_msgTypes,
+	}.Build()
+	File_ethos_notifications_v1_messages_proto = out.File
+	file_ethos_notifications_v1_messages_proto_goTypes = nil
+	file_ethos_notifications_v1_messages_proto_depIdxs = nil
+}
@@ -0,0 +1,190 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: ethos/notifications/v1/notifications_service.proto
+
+package notificationsv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SuccessResponse for simple success/failure responses.
+type SuccessResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the operation was successful.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Human-readable message describing the result.
+	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuccessResponse) Reset() {
+	*x = SuccessResponse{}
+	mi := &file_ethos_notifications_v1_notifications_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuccessResponse) ProtoMessage() {}
+
+func (x *SuccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ethos_notifications_v1_notifications_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuccessResponse.ProtoReflect.Descriptor instead.
+func (*SuccessResponse) Descriptor() ([]byte, []int) {
+	return file_ethos_notifications_v1_notifications_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SuccessResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SuccessResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_ethos_notifications_v1_notifications_service_proto protoreflect.FileDescriptor
+
+const file_ethos_notifications_v1_notifications_service_proto_rawDesc = "" +
+	"\n" +
+	"2ethos/notifications/v1/notifications_service.proto\x12\x16ethos.notifications.v1\x1a\x1cgoogle/api/annotations.proto\x1a%ethos/notifications/v1/messages.proto\"E\n" +
+	"\x0fSuccessResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage2\x8e\x0f\n" +
+	"\x14NotificationsService\x12\x8e\x01\n" +
+	"\x12CreateNotification\x121.ethos.notifications.v1.CreateNotificationRequest\x1a'.ethos.notifications.v1.SuccessResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/notifications\x12\x93\x01\n" +
+	"\x11ListNotifications\x120.ethos.notifications.v1.ListNotificationsRequest\x1a1.ethos.notifications.v1.ListNotificationsResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/notifications\x12\x94\x01\n" +
+	"\x0eGetUnreadCount\x12-.ethos.notifications.v1.GetUnreadCountRequest\x1a+.ethos.notifications.v1.UnreadCountResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v1/notifications/unread-count\x12\x92\x01\n" +
+	"\n" +
+	"MarkAsRead\x12).ethos.notifications.v1.MarkAsReadRequest\x1a'.ethos.notifications.v1.SuccessResponse\"0\x82\xd3\xe4\x93\x02*\"(/v1/notifications/{notification_id}/read\x12\x8a\x01\n" +
+	"\rMarkAllAsRead\x12,.ethos.notifications.v1.MarkAllAsReadRequest\x1a'.ethos.notifications.v1.SuccessResponse\"\"\x82\xd3\xe4\x93\x02\x1c\"\x1a/v1/notifications/read-all\x12\xa7\x01\n" +
+	"\x12SnoozeNotification\x121.ethos.notifications.v1.SnoozeNotificationRequest\x1a'.ethos.notifications.v1.SuccessResponse\"5\x82\xd3\xe4\x93\x02/:\x01*\"*/v1/notifications/{notification_id}/snooze\x12\x9d\x01\n" +
+	"\x12DeleteNotification\x121.ethos.notifications.v1.DeleteNotificationRequest\x1a'.ethos.notifications.v1.SuccessResponse\"+\x82\xd3\xe4\x93\x02%*#/v1/notifications/{notification_id}\x12\x96\x01\n" +
+	"\x0fBatchMarkAsRead\x12..ethos.notifications.v1.BatchMarkAsReadRequest\x1a'.ethos.notifications.v1.SuccessResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/notifications:batchMarkRead\x12\xa6\x01\n" +
+	"\x18BatchDeleteNotifications\x127.ethos.notifications.v1.BatchDeleteNotificationsRequest\x1a'.ethos.notifications.v1.SuccessResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/notifications:batchDelete\x12\xb7\x01\n" +
+	"\x1aGetNotificationPreferences\x129.ethos.notifications.v1.GetNotificationPreferencesRequest\x1a7.ethos.notifications.v1.NotificationPreferencesResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/notifications/preferences\x12\xc0\x01\n" +
+	"\x1dUpdateNotificationPreferences\x12<.ethos.notifications.v1.UpdateNotificationPreferencesRequest\x1a7.ethos.notifications.v1.NotificationPreferencesResponse\"(\x82\xd3\xe4\x93\x02\":\x01*2\x1d/v1/notifications/preferences\x12\x8c\x01\n" +
+	"\vUnsubscribe\x12*.ethos.notifications.v1.UnsubscribeRequest\x1a'.ethos.notifications.v1.SuccessResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/notifications/unsubscribeB\x85\x02\n" +
+	"\x1acom.ethos.notifications.v1B\x19NotificationsServiceProtoP\x01ZRgithub.com/semmidev/ethos-go/contracts/grpc/ethos/notifications/v1;notificationsv1\xa2\x02\x03ENX\xaa\x02\x16Ethos.Notifications.V1\xca\x02\x16Ethos\\Notifications\\V1\xe2\x02\"Ethos\\Notifications\\V1\\GPBMetadata\xea\x02\x18Ethos::Notifications::V1b\x06proto3"
+
+var (
+	file_ethos_notifications_v1_notifications_service_proto_rawDescOnce sync.Once
+	file_ethos_notifications_v1_notifications_service_proto_rawDescData []byte
+)
+
+func file_ethos_notifications_v1_notifications_service_proto_rawDescGZIP() []byte {
+	file_ethos_notifications_v1_notifications_service_proto_rawDescOnce.Do(func() {
+		file_ethos_notifications_v1_notifications_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ethos_notifications_v1_notifications_service_proto_rawDesc), len(file_ethos_notifications_v1_notifications_service_proto_rawDesc)))
+	})
+	return file_ethos_notifications_v1_notifications_service_proto_rawDescData
+}
+
+var file_ethos_notifications_v1_notifications_service_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_ethos_notifications_v1_notifications_service_proto_goTypes = []any{
+	(*SuccessResponse)(nil),                      // 0: ethos.notifications.v1.SuccessResponse
+	(*CreateNotificationRequest)(nil),            // 1: ethos.notifications.v1.CreateNotificationRequest
+	(*ListNotificationsRequest)(nil),             // 2: ethos.notifications.v1.ListNotificationsRequest
+	(*GetUnreadCountRequest)(nil),                // 3: ethos.notifications.v1.GetUnreadCountRequest
+	(*MarkAsReadRequest)(nil),                    // 4: ethos.notifications.v1.MarkAsReadRequest
+	(*MarkAllAsReadRequest)(nil),                 // 5: ethos.notifications.v1.MarkAllAsReadRequest
+	(*SnoozeNotificationRequest)(nil),            // 6: ethos.notifications.v1.SnoozeNotificationRequest
+	(*DeleteNotificationRequest)(nil),            // 7: ethos.notifications.v1.DeleteNotificationRequest
+	(*BatchMarkAsReadRequest)(nil),               // 8: ethos.notifications.v1.BatchMarkAsReadRequest
+	(*BatchDeleteNotificationsRequest)(nil),      // 9: ethos.notifications.v1.BatchDeleteNotificationsRequest
+	(*GetNotificationPreferencesRequest)(nil),    // 10: ethos.notifications.v1.GetNotificationPreferencesRequest
+	(*UpdateNotificationPreferencesRequest)(nil), // 11: ethos.notifications.v1.UpdateNotificationPreferencesRequest
+	(*UnsubscribeRequest)(nil),                   // 12: ethos.notifications.v1.UnsubscribeRequest
+	(*ListNotificationsResponse)(nil),            // 13: ethos.notifications.v1.ListNotificationsResponse
+	(*UnreadCountResponse)(nil),                  // 14: ethos.notifications.v1.UnreadCountResponse
+	(*NotificationPreferencesResponse)(nil),      // 15: ethos.notifications.v1.NotificationPreferencesResponse
+}
+var file_ethos_notifications_v1_notifications_service_proto_depIdxs = []int32{
+	1,  // 0: ethos.notifications.v1.NotificationsService.CreateNotification:input_type -> ethos.notifications.v1.CreateNotificationRequest
+	2,  // 1: ethos.notifications.v1.NotificationsService.ListNotifications:input_type -> ethos.notifications.v1.ListNotificationsRequest
+	3,  // 2: ethos.notifications.v1.NotificationsService.GetUnreadCount:input_type -> ethos.notifications.v1.GetUnreadCountRequest
+	4,  // 3: ethos.notifications.v1.NotificationsService.MarkAsRead:input_type -> ethos.notifications.v1.MarkAsReadRequest
+	5,  // 4: ethos.notifications.v1.NotificationsService.MarkAllAsRead:input_type -> ethos.notifications.v1.MarkAllAsReadRequest
+	6,  // 5: ethos.notifications.v1.NotificationsService.SnoozeNotification:input_type -> ethos.notifications.v1.SnoozeNotificationRequest
+	7,  // 6: ethos.notifications.v1.NotificationsService.DeleteNotification:input_type -> ethos.notifications.v1.DeleteNotificationRequest
+	8,  // 7: ethos.notifications.v1.NotificationsService.BatchMarkAsRead:input_type -> ethos.notifications.v1.BatchMarkAsReadRequest
+	9,  // 8: ethos.notifications.v1.NotificationsService.BatchDeleteNotifications:input_type -> ethos.notifications.v1.BatchDeleteNotificationsRequest
+	10, // 9: ethos.notifications.v1.NotificationsService.GetNotificationPreferences:input_type -> ethos.notifications.v1.GetNotificationPreferencesRequest
+	11, // 10: ethos.notifications.v1.NotificationsService.UpdateNotificationPreferences:input_type -> ethos.notifications.v1.UpdateNotificationPreferencesRequest
+	12, // 11: ethos.notifications.v1.NotificationsService.Unsubscribe:input_type -> ethos.notifications.v1.UnsubscribeRequest
+	0,  // 12: ethos.notifications.v1.NotificationsService.CreateNotification:output_type -> ethos.notifications.v1.SuccessResponse
+	13, // 13: ethos.notifications.v1.NotificationsService.ListNotifications:output_type -> ethos.notifications.v1.ListNotificationsResponse
+	14, // 14: ethos.notifications.v1.NotificationsService.GetUnreadCount:output_type -> ethos.notifications.v1.UnreadCountResponse
+	0,  // 15: ethos.notifications.v1.NotificationsService.MarkAsRead:output_type -> ethos.notifications.v1.SuccessResponse
+	0,  // 16: ethos.notifications.v1.NotificationsService.MarkAllAsRead:output_type -> ethos.notifications.v1.SuccessResponse
+	0,  // 17: ethos.notifications.v1.NotificationsService.SnoozeNotification:output_type -> ethos.notifications.v1.SuccessResponse
+	0,  // 18: ethos.notifications.v1.NotificationsService.DeleteNotification:output_type -> ethos.notifications.v1.SuccessResponse
+	0,  // 19: ethos.notifications.v1.NotificationsService.BatchMarkAsRead:output_type -> ethos.notifications.v1.SuccessResponse
+	0,  // 20: ethos.notifications.v1.NotificationsService.BatchDeleteNotifications:output_type -> ethos.notifications.v1.SuccessResponse
+	15, // 21: ethos.notifications.v1.NotificationsService.GetNotificationPreferences:output_type -> ethos.notifications.v1.NotificationPreferencesResponse
+	15, // 22: ethos.notifications.v1.NotificationsService.UpdateNotificationPreferences:output_type -> ethos.notifications.v1.NotificationPreferencesResponse
+	0,  // 23: ethos.notifications.v1.NotificationsService.Unsubscribe:output_type -> ethos.notifications.v1.SuccessResponse
+	12, // [12:24] is the sub-list for method output_type
+	0,  // [0:12] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_ethos_notifications_v1_notifications_service_proto_init() }
+func file_ethos_notifications_v1_notifications_service_proto_init() {
+	if File_ethos_notifications_v1_notifications_service_proto != nil {
+		return
+	}
+	file_ethos_notifications_v1_messages_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ethos_notifications_v1_notifications_service_proto_rawDesc), len(file_ethos_notifications_v1_notifications_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ethos_notifications_v1_notifications_service_proto_goTypes,
+		DependencyIndexes: file_ethos_notifications_v1_notifications_service_proto_depIdxs,
+		MessageInfos:      file_ethos_notifications_v1_notifications_service_proto_msgTypes,
+	}.Build()
+	File_ethos_notifications_v1_notifications_service_proto = out.File
+	file_ethos_notifications_v1_notifications_service_proto_goTypes = nil
+	file_ethos_notifications_v1_notifications_service_proto_depIdxs = nil
+}
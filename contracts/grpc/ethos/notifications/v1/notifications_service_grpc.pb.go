@@ -19,12 +19,18 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	NotificationsService_CreateNotification_FullMethodName = "/ethos.notifications.v1.NotificationsService/CreateNotification"
-	NotificationsService_ListNotifications_FullMethodName  = "/ethos.notifications.v1.NotificationsService/ListNotifications"
-	NotificationsService_GetUnreadCount_FullMethodName     = "/ethos.notifications.v1.NotificationsService/GetUnreadCount"
-	NotificationsService_MarkAsRead_FullMethodName         = "/ethos.notifications.v1.NotificationsService/MarkAsRead"
-	NotificationsService_MarkAllAsRead_FullMethodName      = "/ethos.notifications.v1.NotificationsService/MarkAllAsRead"
-	NotificationsService_DeleteNotification_FullMethodName = "/ethos.notifications.v1.NotificationsService/DeleteNotification"
+	NotificationsService_CreateNotification_FullMethodName            = "/ethos.notifications.v1.NotificationsService/CreateNotification"
+	NotificationsService_ListNotifications_FullMethodName             = "/ethos.notifications.v1.NotificationsService/ListNotifications"
+	NotificationsService_GetUnreadCount_FullMethodName                = "/ethos.notifications.v1.NotificationsService/GetUnreadCount"
+	NotificationsService_MarkAsRead_FullMethodName                    = "/ethos.notifications.v1.NotificationsService/MarkAsRead"
+	NotificationsService_MarkAllAsRead_FullMethodName                 = "/ethos.notifications.v1.NotificationsService/MarkAllAsRead"
+	NotificationsService_SnoozeNotification_FullMethodName            = "/ethos.notifications.v1.NotificationsService/SnoozeNotification"
+	NotificationsService_DeleteNotification_FullMethodName            = "/ethos.notifications.v1.NotificationsService/DeleteNotification"
+	NotificationsService_BatchMarkAsRead_FullMethodName               = "/ethos.notifications.v1.NotificationsService/BatchMarkAsRead"
+	NotificationsService_BatchDeleteNotifications_FullMethodName      = "/ethos.notifications.v1.NotificationsService/BatchDeleteNotifications"
+	NotificationsService_GetNotificationPreferences_FullMethodName    = "/ethos.notifications.v1.NotificationsService/GetNotificationPreferences"
+	NotificationsService_UpdateNotificationPreferences_FullMethodName = "/ethos.notifications.v1.NotificationsService/UpdateNotificationPreferences"
+	NotificationsService_Unsubscribe_FullMethodName                   = "/ethos.notifications.v1.NotificationsService/Unsubscribe"
 )
 
 // NotificationsServiceClient is the client API for NotificationsService service.
@@ -43,8 +49,21 @@ type NotificationsServiceClient interface {
 	MarkAsRead(ctx context.Context, in *MarkAsReadRequest, opts ...grpc.CallOption) (*SuccessResponse, error)
 	// MarkAllAsRead marks all notifications as read.
 	MarkAllAsRead(ctx context.Context, in *MarkAllAsReadRequest, opts ...grpc.CallOption) (*SuccessResponse, error)
+	// SnoozeNotification hides a notification until the given time, when it
+	// is delivered again as unread.
+	SnoozeNotification(ctx context.Context, in *SnoozeNotificationRequest, opts ...grpc.CallOption) (*SuccessResponse, error)
 	// DeleteNotification deletes a notification.
 	DeleteNotification(ctx context.Context, in *DeleteNotificationRequest, opts ...grpc.CallOption) (*SuccessResponse, error)
+	// BatchMarkAsRead marks the listed notifications as read in one request.
+	BatchMarkAsRead(ctx context.Context, in *BatchMarkAsReadRequest, opts ...grpc.CallOption) (*SuccessResponse, error)
+	// BatchDeleteNotifications deletes the listed notifications in one request.
+	BatchDeleteNotifications(ctx context.Context, in *BatchDeleteNotificationsRequest, opts ...grpc.CallOption) (*SuccessResponse, error)
+	// GetNotificationPreferences returns the user's notification preferences.
+	GetNotificationPreferences(ctx context.Context, in *GetNotificationPreferencesRequest, opts ...grpc.CallOption) (*NotificationPreferencesResponse, error)
+	// UpdateNotificationPreferences changes the user's notification preferences.
+	UpdateNotificationPreferences(ctx context.Context, in *UpdateNotificationPreferencesRequest, opts ...grpc.CallOption) (*NotificationPreferencesResponse, error)
+	// Unsubscribe turns off one kind of email using the link token; no sign-in required.
+	Unsubscribe(ctx context.Context, in *UnsubscribeRequest, opts ...grpc.CallOption) (*SuccessResponse, error)
 }
 
 type notificationsServiceClient struct {
@@ -105,6 +124,16 @@ func (c *notificationsServiceClient) MarkAllAsRead(ctx context.Context, in *Mark
 	return out, nil
 }
 
+func (c *notificationsServiceClient) SnoozeNotification(ctx context.Context, in *SnoozeNotificationRequest, opts ...grpc.CallOption) (*SuccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuccessResponse)
+	err := c.cc.Invoke(ctx, NotificationsService_SnoozeNotification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *notificationsServiceClient) DeleteNotification(ctx context.Context, in *DeleteNotificationRequest, opts ...grpc.CallOption) (*SuccessResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SuccessResponse)
@@ -115,6 +144,56 @@ func (c *notificationsServiceClient) DeleteNotification(ctx context.Context, in
 	return out, nil
 }
 
+func (c *notificationsServiceClient) BatchMarkAsRead(ctx context.Context, in *BatchMarkAsReadRequest, opts ...grpc.CallOption) (*SuccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuccessResponse)
+	err := c.cc.Invoke(ctx, NotificationsService_BatchMarkAsRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationsServiceClient) BatchDeleteNotifications(ctx context.Context, in *BatchDeleteNotificationsRequest, opts ...grpc.CallOption) (*SuccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuccessResponse)
+	err := c.cc.Invoke(ctx, NotificationsService_BatchDeleteNotifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationsServiceClient) GetNotificationPreferences(ctx context.Context, in *GetNotificationPreferencesRequest, opts ...grpc.CallOption) (*NotificationPreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NotificationPreferencesResponse)
+	err := c.cc.Invoke(ctx, NotificationsService_GetNotificationPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationsServiceClient) UpdateNotificationPreferences(ctx context.Context, in *UpdateNotificationPreferencesRequest, opts ...grpc.CallOption) (*NotificationPreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NotificationPreferencesResponse)
+	err := c.cc.Invoke(ctx, NotificationsService_UpdateNotificationPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationsServiceClient) Unsubscribe(ctx context.Context, in *UnsubscribeRequest, opts ...grpc.CallOption) (*SuccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuccessResponse)
+	err := c.cc.Invoke(ctx, NotificationsService_Unsubscribe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NotificationsServiceServer is the server API for NotificationsService service.
 // All implementations must embed UnimplementedNotificationsServiceServer
 // for forward compatibility.
@@ -131,8 +210,21 @@ type NotificationsServiceServer interface {
 	MarkAsRead(context.Context, *MarkAsReadRequest) (*SuccessResponse, error)
 	// MarkAllAsRead marks all notifications as read.
 	MarkAllAsRead(context.Context, *MarkAllAsReadRequest) (*SuccessResponse, error)
+	// SnoozeNotification hides a notification until the given time, when it
+	// is delivered again as unread.
+	SnoozeNotification(context.Context, *SnoozeNotificationRequest) (*SuccessResponse, error)
 	// DeleteNotification deletes a notification.
 	DeleteNotification(context.Context, *DeleteNotificationRequest) (*SuccessResponse, error)
+	// BatchMarkAsRead marks the listed notifications as read in one request.
+	BatchMarkAsRead(context.Context, *BatchMarkAsReadRequest) (*SuccessResponse, error)
+	// BatchDeleteNotifications deletes the listed notifications in one request.
+	BatchDeleteNotifications(context.Context, *BatchDeleteNotificationsRequest) (*SuccessResponse, error)
+	// GetNotificationPreferences returns the user's notification preferences.
+	GetNotificationPreferences(context.Context, *GetNotificationPreferencesRequest) (*NotificationPreferencesResponse, error)
+	// UpdateNotificationPreferences changes the user's notification preferences.
+	UpdateNotificationPreferences(context.Context, *UpdateNotificationPreferencesRequest) (*NotificationPreferencesResponse, error)
+	// Unsubscribe turns off one kind of email using the link token; no sign-in required.
+	Unsubscribe(context.Context, *UnsubscribeRequest) (*SuccessResponse, error)
 	mustEmbedUnimplementedNotificationsServiceServer()
 }
 
@@ -158,9 +250,27 @@ func (UnimplementedNotificationsServiceServer) MarkAsRead(context.Context, *Mark
 func (UnimplementedNotificationsServiceServer) MarkAllAsRead(context.Context, *MarkAllAsReadRequest) (*SuccessResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method MarkAllAsRead not implemented")
 }
+func (UnimplementedNotificationsServiceServer) SnoozeNotification(context.Context, *SnoozeNotificationRequest) (*SuccessResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SnoozeNotification not implemented")
+}
 func (UnimplementedNotificationsServiceServer) DeleteNotification(context.Context, *DeleteNotificationRequest) (*SuccessResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteNotification not implemented")
 }
+func (UnimplementedNotificationsServiceServer) BatchMarkAsRead(context.Context, *BatchMarkAsReadRequest) (*SuccessResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchMarkAsRead not implemented")
+}
+func (UnimplementedNotificationsServiceServer) BatchDeleteNotifications(context.Context, *BatchDeleteNotificationsRequest) (*SuccessResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchDeleteNotifications not implemented")
+}
+func (UnimplementedNotificationsServiceServer) GetNotificationPreferences(context.Context, *GetNotificationPreferencesRequest) (*NotificationPreferencesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetNotificationPreferences not implemented")
+}
+func (UnimplementedNotificationsServiceServer) UpdateNotificationPreferences(context.Context, *UpdateNotificationPreferencesRequest) (*NotificationPreferencesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateNotificationPreferences not implemented")
+}
+func (UnimplementedNotificationsServiceServer) Unsubscribe(context.Context, *UnsubscribeRequest) (*SuccessResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Unsubscribe not implemented")
+}
 func (UnimplementedNotificationsServiceServer) mustEmbedUnimplementedNotificationsServiceServer() {}
 func (UnimplementedNotificationsServiceServer) testEmbeddedByValue()                              {}
 
@@ -272,6 +382,24 @@ func _NotificationsService_MarkAllAsRead_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NotificationsService_SnoozeNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnoozeNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationsServiceServer).SnoozeNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationsService_SnoozeNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationsServiceServer).SnoozeNotification(ctx, req.(*SnoozeNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _NotificationsService_DeleteNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteNotificationRequest)
 	if err := dec(in); err != nil {
@@ -290,6 +418,96 @@ func _NotificationsService_DeleteNotification_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NotificationsService_BatchMarkAsRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchMarkAsReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationsServiceServer).BatchMarkAsRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationsService_BatchMarkAsRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationsServiceServer).BatchMarkAsRead(ctx, req.(*BatchMarkAsReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationsService_BatchDeleteNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationsServiceServer).BatchDeleteNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationsService_BatchDeleteNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationsServiceServer).BatchDeleteNotifications(ctx, req.(*BatchDeleteNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationsService_GetNotificationPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNotificationPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationsServiceServer).GetNotificationPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationsService_GetNotificationPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationsServiceServer).GetNotificationPreferences(ctx, req.(*GetNotificationPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationsService_UpdateNotificationPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNotificationPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationsServiceServer).UpdateNotificationPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationsService_UpdateNotificationPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationsServiceServer).UpdateNotificationPreferences(ctx, req.(*UpdateNotificationPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationsService_Unsubscribe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsubscribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationsServiceServer).Unsubscribe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationsService_Unsubscribe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationsServiceServer).Unsubscribe(ctx, req.(*UnsubscribeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // NotificationsService_ServiceDesc is the grpc.ServiceDesc for NotificationsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -317,10 +535,34 @@ var NotificationsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MarkAllAsRead",
 			Handler:    _NotificationsService_MarkAllAsRead_Handler,
 		},
+		{
+			MethodName: "SnoozeNotification",
+			Handler:    _NotificationsService_SnoozeNotification_Handler,
+		},
 		{
 			MethodName: "DeleteNotification",
 			Handler:    _NotificationsService_DeleteNotification_Handler,
 		},
+		{
+			MethodName: "BatchMarkAsRead",
+			Handler:    _NotificationsService_BatchMarkAsRead_Handler,
+		},
+		{
+			MethodName: "BatchDeleteNotifications",
+			Handler:    _NotificationsService_BatchDeleteNotifications_Handler,
+		},
+		{
+			MethodName: "GetNotificationPreferences",
+			Handler:    _NotificationsService_GetNotificationPreferences_Handler,
+		},
+		{
+			MethodName: "UpdateNotificationPreferences",
+			Handler:    _NotificationsService_UpdateNotificationPreferences_Handler,
+		},
+		{
+			MethodName: "Unsubscribe",
+			Handler:    _NotificationsService_Unsubscribe_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethos/notifications/v1/notifications_service.proto",
@@ -0,0 +1,97 @@
+//go:build e2e
+
+package e2e
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// Client talks to the public HTTP API the same way the frontend does.
+type Client struct {
+	baseURL     string
+	http        *http.Client
+	accessToken string
+}
+
+func NewClient(baseURL string) *Client {
+	return &Client{
+		baseURL: baseURL,
+		http:    &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// WithToken returns a copy of the client that authenticates as the given
+// access token.
+func (c *Client) WithToken(accessToken string) *Client {
+	cp := *c
+	cp.accessToken = accessToken
+	return &cp
+}
+
+// Do sends a JSON request and decodes the JSON response into out (if not
+// nil). It fails the test on transport errors or when the status code
+// differs from wantStatus.
+func (c *Client) Do(t *testing.T, method, path string, body, out any, wantStatus int) {
+	t.Helper()
+
+	var reqBody io.Reader
+	if body != nil {
+		raw, err := json.Marshal(body)
+		if err != nil {
+			t.Fatalf("marshal %s %s body: %v", method, path, err)
+		}
+		reqBody = bytes.NewReader(raw)
+	}
+
+	req, err := http.NewRequest(method, c.baseURL+path, reqBody)
+	if err != nil {
+		t.Fatalf("build %s %s: %v", method, path, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.accessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+c.accessToken)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		t.Fatalf("%s %s: %v", method, path, err)
+	}
+	defer resp.Body.Close()
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("read %s %s response: %v", method, path, err)
+	}
+
+	if resp.StatusCode != wantStatus {
+		t.Fatalf("%s %s: got status %d, want %d: %s", method, path, resp.StatusCode, wantStatus, raw)
+	}
+
+	if out != nil {
+		if err := json.Unmarshal(raw, out); err != nil {
+			t.Fatalf("decode %s %s response: %v: %s", method, path, err, raw)
+		}
+	}
+}
+
+// Eventually polls fn until it returns nil or the timeout elapses.
+func Eventually(t *testing.T, timeout, interval time.Duration, fn func() error) {
+	t.Helper()
+
+	deadline := time.Now().Add(timeout)
+	for {
+		err := fn()
+		if err == nil {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("condition not met after %s: %v", timeout, err)
+		}
+		time.Sleep(interval)
+	}
+}
@@ -0,0 +1,83 @@
+//go:build e2e
+
+package e2e
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/docker/go-connections/nat"
+	"github.com/testcontainers/testcontainers-go"
+	tcnats "github.com/testcontainers/testcontainers-go/modules/nats"
+	tcpostgres "github.com/testcontainers/testcontainers-go/modules/postgres"
+	tcredis "github.com/testcontainers/testcontainers-go/modules/redis"
+)
+
+// dependencies are the addresses of the containers the stack runs against.
+type dependencies struct {
+	PostgresHost string
+	PostgresPort string
+	RedisHost    string
+	RedisPort    string
+	NATSURL      string
+}
+
+// startDependencies starts throwaway Postgres, Redis and NATS containers
+// with testcontainers-go and waits until each one is ready. Containers are
+// added to e.containers also on error, so Stop removes whatever was created;
+// if the test binary dies first, the testcontainers reaper does.
+func (e *Environment) startDependencies(ctx context.Context) (dependencies, error) {
+	var deps dependencies
+
+	pg, err := tcpostgres.Run(ctx, "postgres:17-alpine",
+		tcpostgres.WithDatabase(dbName),
+		tcpostgres.WithUsername(dbUser),
+		tcpostgres.WithPassword(dbPassword),
+		// The port opens before Postgres finishes its init scripts
+		tcpostgres.BasicWaitStrategies(),
+	)
+	e.containers = append(e.containers, pg)
+	if err != nil {
+		return deps, fmt.Errorf("start postgres: %w", err)
+	}
+	deps.PostgresHost, deps.PostgresPort, err = endpoint(ctx, pg, "5432/tcp")
+	if err != nil {
+		return deps, fmt.Errorf("postgres address: %w", err)
+	}
+
+	redis, err := tcredis.Run(ctx, "redis:8-alpine")
+	e.containers = append(e.containers, redis)
+	if err != nil {
+		return deps, fmt.Errorf("start redis: %w", err)
+	}
+	deps.RedisHost, deps.RedisPort, err = endpoint(ctx, redis, "6379/tcp")
+	if err != nil {
+		return deps, fmt.Errorf("redis address: %w", err)
+	}
+
+	// The module starts NATS with JetStream enabled
+	nats, err := tcnats.Run(ctx, "nats:2-alpine")
+	e.containers = append(e.containers, nats)
+	if err != nil {
+		return deps, fmt.Errorf("start nats: %w", err)
+	}
+	deps.NATSURL, err = nats.ConnectionString(ctx)
+	if err != nil {
+		return deps, fmt.Errorf("nats address: %w", err)
+	}
+
+	return deps, nil
+}
+
+// endpoint returns the host and mapped host port of a container port.
+func endpoint(ctx context.Context, c testcontainers.Container, port nat.Port) (string, string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", "", err
+	}
+	mapped, err := c.MappedPort(ctx, port)
+	if err != nil {
+		return "", "", err
+	}
+	return host, mapped.Port(), nil
+}
@@ -0,0 +1,16 @@
+//go:build e2e
+
+// Package e2e holds end-to-end tests that exercise the public HTTP API with
+// the API server and worker running against real Postgres, Redis and NATS.
+//
+// The dependencies are started as throwaway containers with testcontainers-go,
+// and the API and worker run in-process through the same entry points as
+// cmd/api and cmd/worker, so a run needs a working Docker daemon. The tests
+// are excluded from the default build; run them with:
+//
+//	make test-e2e
+//
+// or directly:
+//
+//	go test -tags e2e -count=1 -timeout 15m ./e2e/...
+package e2e
@@ -0,0 +1,255 @@
+//go:build e2e
+
+package e2e
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	_ "github.com/lib/pq"
+	"github.com/testcontainers/testcontainers-go"
+
+	"github.com/semmidev/ethos-go/config"
+	"github.com/semmidev/ethos-go/internal/apiserver"
+	"github.com/semmidev/ethos-go/internal/worker"
+)
+
+const (
+	dbUser     = "ethosgo"
+	dbPassword = "ethosgo-e2e"
+	dbName     = "ethosgo"
+
+	// e2eCodeSecret keys emailed code hashes, so tests can plant a code
+	e2eCodeSecret = "e2e-code-secret-that-is-at-least-32-chars"
+)
+
+// Environment is a running stack: dependency containers plus the API and
+// worker running in-process against them.
+type Environment struct {
+	BaseURL string
+	DB      *sqlx.DB
+
+	containers []testcontainers.Container
+	services   []*service
+	workDir    string
+}
+
+// service is cmd/api or cmd/worker running in a goroutine of the test
+// binary, logging to a file in the work dir.
+type service struct {
+	name    string
+	logPath string
+	cancel  context.CancelFunc
+	done    chan error
+}
+
+// StartEnvironment boots Postgres, Redis and NATS and starts the API and
+// worker in-process against them, the same way cmd/api and cmd/worker do.
+// The caller must call Stop, also when StartEnvironment returns an error.
+func StartEnvironment(ctx context.Context) (*Environment, error) {
+	workDir, err := os.MkdirTemp("", "ethos-e2e-*")
+	if err != nil {
+		return nil, fmt.Errorf("create work dir: %w", err)
+	}
+	env := &Environment{workDir: workDir}
+
+	deps, err := env.startDependencies(ctx)
+	if err != nil {
+		return env, err
+	}
+
+	env.DB, err = connectDB(ctx, deps.PostgresHost, deps.PostgresPort)
+	if err != nil {
+		return env, err
+	}
+
+	ports, err := freePorts(3)
+	if err != nil {
+		return env, err
+	}
+	apiPort, grpcPort, workerMetricsPort := ports[0], ports[1], ports[2]
+	env.BaseURL = "http://127.0.0.1:" + apiPort
+
+	cfg, err := loadConfig(map[string]string{
+		"APP_ENV":                   "test",
+		"APP_URL":                   env.BaseURL,
+		"APP_CLIENT_URL":            env.BaseURL,
+		"SERVER_HOST":               "127.0.0.1",
+		"SERVER_PORT":               apiPort,
+		"GRPC_PORT":                 grpcPort,
+		"WORKER_METRICS_PORT":       workerMetricsPort,
+		"DB_HOST":                   deps.PostgresHost,
+		"DB_PORT":                   deps.PostgresPort,
+		"DB_USER":                   dbUser,
+		"DB_PASSWORD":               dbPassword,
+		"DB_DB":                     dbName,
+		"DB_SSL_MODE":               "disable",
+		"REDIS_HOST":                deps.RedisHost,
+		"REDIS_PORT":                deps.RedisPort,
+		"REDIS_DB":                  "0",
+		"AUTH_JWT_SECRET":           "e2e-secret-key-that-is-at-least-32-chars",
+		"AUTH_CODE_SECRET":          e2eCodeSecret,
+		"AUTH_ACCESS_TOKEN_EXPIRY":  "15m",
+		"AUTH_REFRESH_TOKEN_EXPIRY": "24h",
+		"NATS_URL":                  deps.NATSURL,
+		"NATS_STREAM_NAME":          "ETHOS_EVENTS",
+		"NATS_CONSUMER_NAME":        "ethos-e2e",
+		"NATS_MAX_RECONNECTS":       "10",
+		// No mail server in the stack: delivery fails fast and the
+		// verification code is read from the database instead.
+		"SMTP_HOST":           "127.0.0.1",
+		"SMTP_PORT":           "1",
+		"LOGGER_LEVEL":        "debug",
+		"OTEL_ENABLE_TRACING": "false",
+		"OTEL_ENABLE_METRICS": "false",
+	})
+	if err != nil {
+		return env, err
+	}
+
+	// The API runs migrations on start-up, so it has to be healthy before
+	// the worker touches the database.
+	api := env.startService("api", cfg, func(ctx context.Context, cfg *config.Config) error {
+		return apiserver.Run(ctx, cfg, apiserver.Build{Version: "e2e", Commit: "e2e", Time: "e2e"})
+	})
+	if err := waitForHealthy(ctx, env.BaseURL+"/health", api, time.Minute); err != nil {
+		return env, err
+	}
+
+	env.startService("worker", cfg, func(ctx context.Context, cfg *config.Config) error {
+		return worker.Run(ctx, cfg, "e2e")
+	})
+
+	return env, nil
+}
+
+// Stop shuts the services down, terminates the containers and removes the
+// work dir. Service logs are kept and their paths returned when keepLogs is
+// true.
+func (e *Environment) Stop(keepLogs bool) []string {
+	// The worker goes first, as it depends on the API's migrations
+	for i := len(e.services) - 1; i >= 0; i-- {
+		e.services[i].stop()
+	}
+	if e.DB != nil {
+		e.DB.Close()
+	}
+	for _, c := range e.containers {
+		_ = testcontainers.TerminateContainer(c)
+	}
+
+	if keepLogs {
+		logs := make([]string, 0, len(e.services))
+		for _, s := range e.services {
+			logs = append(logs, s.logPath)
+		}
+		return logs
+	}
+	_ = os.RemoveAll(e.workDir)
+	return nil
+}
+
+// startService runs a service with its own copy of cfg, logging to
+// <name>.log in the work dir, until Stop cancels it.
+func (e *Environment) startService(name string, cfg *config.Config, run func(context.Context, *config.Config) error) *service {
+	svcCfg := *cfg
+	svcCfg.LoggerOutput = "file"
+	svcCfg.LoggerFile = filepath.Join(e.workDir, name+".log")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &service{name: name, logPath: svcCfg.LoggerFile, cancel: cancel, done: make(chan error, 1)}
+	go func() {
+		s.done <- run(ctx, &svcCfg)
+	}()
+	e.services = append(e.services, s)
+	return s
+}
+
+func (s *service) stop() {
+	s.cancel()
+	select {
+	case <-s.done:
+	case <-time.After(15 * time.Second):
+		fmt.Fprintf(os.Stderr, "e2e: %s did not stop within 15s\n", s.name)
+	}
+}
+
+// loadConfig loads the configuration the way the binaries do, from the
+// environment with vars set on top. The tests run in the e2e directory, so
+// a developer's .env in the repo root is never picked up.
+func loadConfig(vars map[string]string) (*config.Config, error) {
+	for k, v := range vars {
+		if err := os.Setenv(k, v); err != nil {
+			return nil, fmt.Errorf("set %s: %w", k, err)
+		}
+	}
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, fmt.Errorf("load config: %w", err)
+	}
+	return cfg, nil
+}
+
+func connectDB(ctx context.Context, host, port string) (*sqlx.DB, error) {
+	dsn := fmt.Sprintf("postgres://%s:%s@%s/%s?sslmode=disable", dbUser, dbPassword, net.JoinHostPort(host, port), dbName)
+
+	// The container's wait strategy has already seen Postgres accept
+	// connections
+	db, err := sqlx.ConnectContext(ctx, "postgres", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("connect to postgres: %w", err)
+	}
+	return db, nil
+}
+
+func waitForHealthy(ctx context.Context, url string, s *service, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return err
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err == nil {
+			resp.Body.Close()
+			if resp.StatusCode == http.StatusOK {
+				return nil
+			}
+		}
+
+		select {
+		case err := <-s.done:
+			return fmt.Errorf("%s exited before becoming healthy: %v (see %s)", s.name, err, s.logPath)
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(500 * time.Millisecond):
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("%s not healthy after %s (see %s)", s.name, timeout, s.logPath)
+		}
+	}
+}
+
+// freePorts reserves n distinct free ports. They are all held until the
+// last one is found, so none is handed out twice.
+func freePorts(n int) ([]string, error) {
+	ports := make([]string, 0, n)
+	for range n {
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			return nil, fmt.Errorf("find free port: %w", err)
+		}
+		defer l.Close()
+
+		_, port, _ := net.SplitHostPort(l.Addr().String())
+		ports = append(ports, port)
+	}
+	return ports, nil
+}
@@ -0,0 +1,182 @@
+//go:build e2e
+
+package e2e
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/semmidev/ethos-go/internal/auth/domain/user"
+)
+
+type envelope[T any] struct {
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+	Data    T      `json:"data"`
+}
+
+type loginData struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	SessionID    string `json:"session_id"`
+	UserID       string `json:"user_id"`
+}
+
+type habitData struct {
+	ID           string  `json:"id"`
+	Name         string  `json:"name"`
+	ReminderTime *string `json:"reminder_time"`
+	IsActive     bool    `json:"is_active"`
+}
+
+type notificationData struct {
+	ID    string `json:"id"`
+	Type  string `json:"type"`
+	Title string `json:"title"`
+}
+
+// TestUserJourney walks a new user through the core product loop:
+// register, verify, log in, create a habit, log it, and receive the
+// notifications produced by the worker.
+func TestUserJourney(t *testing.T) {
+	client := NewClient(env.BaseURL)
+	email := fmt.Sprintf("e2e-%s@example.com", uuid.NewString()[:8])
+	password := "e2e-Password-123"
+
+	var userID string
+	t.Run("register", func(t *testing.T) {
+		var resp envelope[struct {
+			UserID string `json:"user_id"`
+			Email  string `json:"email"`
+		}]
+		client.Do(t, http.MethodPost, "/api/v1/auth/register", map[string]any{
+			"name":     "E2E User",
+			"email":    email,
+			"password": password,
+		}, &resp, http.StatusOK)
+
+		if !resp.Success || resp.Data.UserID == "" {
+			t.Fatalf("unexpected register response: %+v", resp)
+		}
+		userID = resp.Data.UserID
+	})
+
+	t.Run("verify email", func(t *testing.T) {
+		// The worker can't deliver mail in this stack, and only a hash of
+		// the emailed code is stored, so replace it with a known code.
+		const code = "424242"
+		_, err := env.DB.Exec(`
+			UPDATE users SET verify_code_hash = $1, verify_attempts = 0
+			WHERE user_id = $2`, user.NewCodeHasher(e2eCodeSecret).Hash(uuid.MustParse(userID), code), userID)
+		if err != nil {
+			t.Fatalf("set verification code: %v", err)
+		}
+
+		client.Do(t, http.MethodPost, "/api/v1/auth/verify-email", map[string]any{
+			"email": email,
+			"code":  code,
+		}, nil, http.StatusOK)
+	})
+
+	var authed *Client
+	t.Run("login", func(t *testing.T) {
+		var resp envelope[loginData]
+		client.Do(t, http.MethodPost, "/api/v1/auth/login", map[string]any{
+			"email":    email,
+			"password": password,
+		}, &resp, http.StatusOK)
+
+		if resp.Data.AccessToken == "" || resp.Data.UserID != userID {
+			t.Fatalf("unexpected login response: %+v", resp)
+		}
+		authed = client.WithToken(resp.Data.AccessToken)
+	})
+	if authed == nil {
+		t.FailNow()
+	}
+
+	var habitID string
+	t.Run("create habit", func(t *testing.T) {
+		// Schedule the reminder for the next minute in the user's own
+		// timezone so the worker's per-minute scheduler picks it up.
+		var reminder string
+		err := env.DB.Get(&reminder, `
+			SELECT TO_CHAR((NOW() + INTERVAL '1 minute') AT TIME ZONE COALESCE(timezone, 'UTC'), 'HH24:MI')
+			FROM users WHERE user_id = $1`, userID)
+		if err != nil {
+			t.Fatalf("compute reminder time: %v", err)
+		}
+
+		var resp envelope[habitData]
+		authed.Do(t, http.MethodPost, "/api/v1/habits", map[string]any{
+			"name":          "Read 10 pages",
+			"frequency":     "daily",
+			"target_count":  1,
+			"reminder_time": reminder,
+		}, &resp, http.StatusOK)
+
+		if resp.Data.ID == "" || !resp.Data.IsActive {
+			t.Fatalf("unexpected create habit response: %+v", resp)
+		}
+		habitID = resp.Data.ID
+	})
+	if habitID == "" {
+		t.FailNow()
+	}
+
+	t.Run("welcome notification", func(t *testing.T) {
+		Eventually(t, 30*time.Second, time.Second, func() error {
+			return expectNotification(t, authed, "NOTIFICATION_TYPE_WELCOME")
+		})
+	})
+
+	t.Run("log habit", func(t *testing.T) {
+		// Log yesterday (in UTC) so today stays open for the reminder.
+		logDate := time.Now().UTC().AddDate(0, 0, -1).Format("2006-01-02")
+
+		var resp envelope[struct {
+			LogID string `json:"log_id"`
+		}]
+		authed.Do(t, http.MethodPost, "/api/v1/habits/"+habitID+"/logs", map[string]any{
+			"log_date": logDate,
+			"count":    1,
+		}, &resp, http.StatusOK)
+
+		if resp.Data.LogID == "" {
+			t.Fatalf("unexpected log habit response: %+v", resp)
+		}
+
+		var logs envelope[[]struct {
+			ID string `json:"id"`
+		}]
+		authed.Do(t, http.MethodGet, "/api/v1/habits/"+habitID+"/logs", nil, &logs, http.StatusOK)
+		if len(logs.Data) != 1 {
+			t.Fatalf("expected 1 log, got %d", len(logs.Data))
+		}
+	})
+
+	t.Run("reminder notification", func(t *testing.T) {
+		// The scheduler ticks once a minute and the reminder is set for
+		// the next one, so allow for up to two ticks plus processing.
+		Eventually(t, 150*time.Second, 2*time.Second, func() error {
+			return expectNotification(t, authed, "NOTIFICATION_TYPE_HABIT_REMINDER")
+		})
+	})
+}
+
+func expectNotification(t *testing.T, c *Client, notificationType string) error {
+	t.Helper()
+
+	var resp envelope[[]notificationData]
+	c.Do(t, http.MethodGet, "/api/v1/notifications", nil, &resp, http.StatusOK)
+	for _, n := range resp.Data {
+		if n.Type == notificationType {
+			return nil
+		}
+	}
+	return fmt.Errorf("no %s notification among %d", notificationType, len(resp.Data))
+}
@@ -0,0 +1,39 @@
+//go:build e2e
+
+package e2e
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+)
+
+// env is the stack shared by every test in the package. Tests must not
+// assume an empty database: each one registers its own user.
+var env *Environment
+
+func TestMain(m *testing.M) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	started, err := StartEnvironment(ctx)
+	cancel()
+	env = started
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "e2e: start environment: %v\n", err)
+		reportLogs(env.Stop(true))
+		os.Exit(1)
+	}
+
+	code := m.Run()
+	logs := env.Stop(code != 0)
+	reportLogs(logs)
+	os.Exit(code)
+}
+
+func reportLogs(paths []string) {
+	for _, p := range paths {
+		fmt.Fprintf(os.Stderr, "e2e: service log kept at %s\n", p)
+	}
+}
@@ -1,7 +1,7 @@
 import { useEffect } from 'react';
 import { BrowserRouter, Routes, Route, Navigate } from 'react-router-dom';
 import { MainLayout, AuthLayout } from './components/layout';
-import { LoginPage, RegisterPage, VerifyEmailPage, ForgotPasswordPage, ResetPasswordPage, GoogleCallbackPage } from './pages/auth';
+import { LoginPage, RegisterPage, VerifyEmailPage, ForgotPasswordPage, ResetPasswordPage, GoogleCallbackPage, MagicLinkPage, SSOCallbackPage } from './pages/auth';
 // ... (imports)
 
 // ...
@@ -79,6 +79,12 @@ function App() {
           <Route path="/reset-password" element={<ResetPasswordPage />} />
         </Route>
 
+        {/* Magic link - Standalone */}
+        <Route path="/auth/magic-link" element={<MagicLinkPage />} />
+
+        {/* Single sign-on callback - Standalone */}
+        <Route path="/auth/sso/callback" element={<SSOCallbackPage />} />
+
         {/* Protected routes */}
         <Route element={<MainLayout />}>
           <Route path="/dashboard" element={<DashboardPage />} />
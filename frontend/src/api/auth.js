@@ -13,15 +13,21 @@ export const authAPI = {
     return response.data;
   },
 
-  // Logout current session
-  logout: async (sessionId) => {
-    const response = await apiClient.post('/auth/logout', { session_id: sessionId });
+  // Logout current session (taken from the access token)
+  logout: async () => {
+    const response = await apiClient.post('/auth/logout', {});
     return response.data;
   },
 
   // Logout from all devices
-  logoutAll: async (userId) => {
-    const response = await apiClient.post('/auth/logout-all', { user_id: userId });
+  logoutAll: async () => {
+    const response = await apiClient.post('/auth/logout-all', {});
+    return response.data;
+  },
+
+  // Revoke one of the user's sessions
+  revokeSession: async (sessionId) => {
+    const response = await apiClient.delete(`/auth/sessions/${sessionId}`);
     return response.data;
   },
 
@@ -55,6 +61,12 @@ export const authAPI = {
     return response.data;
   },
 
+  // Deactivate Account (kept until the next login reactivates it)
+  deactivateAccount: async () => {
+    const response = await apiClient.post('/auth/deactivate', {});
+    return response.data;
+  },
+
   // Update user profile
   updateProfile: async (data) => {
     const response = await apiClient.put('/auth/profile', data);
@@ -100,4 +112,26 @@ export const authAPI = {
     const response = await apiClient.post('/auth/google/callback', { code });
     return response.data;
   },
+
+  // Single sign-on
+  getSSOLoginURL: async () => {
+    const response = await apiClient.get('/auth/sso/login');
+    return response.data;
+  },
+
+  ssoCallback: async (code, state) => {
+    const response = await apiClient.post('/auth/sso/callback', { code, state });
+    return response.data;
+  },
+
+  // Magic link
+  requestMagicLink: async (data) => {
+    const response = await apiClient.post('/auth/magic-link', data);
+    return response.data;
+  },
+
+  verifyMagicLink: async (token) => {
+    const response = await apiClient.get('/auth/magic-link/verify', { params: { token } });
+    return response.data;
+  },
 };
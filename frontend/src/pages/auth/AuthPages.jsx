@@ -4,10 +4,12 @@ import { Mail, Lock, User, ArrowRight, Shield, CheckCircle } from 'lucide-react'
 import { useTranslation } from 'react-i18next';
 import { Button } from '../../components/ui/Button';
 import { Input } from '../../components/ui/Input';
+import { ConfirmModal } from '../../components/ui/Modal';
 import { LanguageToggle } from '../../components/ui/LanguageToggle';
 import { useAuthStore } from '../../stores/authStore';
 import { useUIStore } from '../../stores/uiStore';
 import { authAPI } from '../../api/auth';
+import { SSO_STATE_KEY } from './SSOCallbackPage';
 
 export const AuthLayout = ({ children, title, subtitle, features = [] }) => (
   <div className="flex min-h-screen">
@@ -78,6 +80,7 @@ export function LoginPage() {
   const { addToast } = useUIStore();
   const [formData, setFormData] = useState({ email: '', password: '' });
   const [errors, setErrors] = useState({});
+  const [isReactivateModalOpen, setIsReactivateModalOpen] = useState(false);
 
   const validate = () => {
     const newErrors = {};
@@ -97,10 +100,22 @@ export function LoginPage() {
     e.preventDefault();
     if (!validate()) return;
 
-    const result = await login(formData);
+    await submitLogin(formData);
+  };
+
+  const handleReactivate = async () => {
+    setIsReactivateModalOpen(false);
+    await submitLogin({ ...formData, reactivate: true });
+  };
+
+  const submitLogin = async (data) => {
+    const result = await login(data);
     if (result.success) {
       addToast({ type: 'success', title: t('auth.welcomeBack'), message: t('auth.loginSuccess') });
       navigate('/dashboard');
+    } else if (result.errorCode === 'AUTH_ACCOUNT_DEACTIVATED') {
+      // Ask before switching a deactivated account back on
+      setIsReactivateModalOpen(true);
     } else {
       // Check if the error is due to email not being verified
       const isEmailNotVerified =
@@ -127,6 +142,19 @@ export function LoginPage() {
     if (errors[field]) setErrors({ ...errors, [field]: null });
   };
 
+  const handleMagicLink = async () => {
+    if (!formData.email || !/\S+@\S+\.\S+/.test(formData.email)) {
+      setErrors({ ...errors, email: t('auth.validation.emailInvalid') });
+      return;
+    }
+    try {
+      await authAPI.requestMagicLink({ email: formData.email });
+      addToast({ type: 'success', title: t('common.success'), message: t('auth.login.magicLinkSent') });
+    } catch (error) {
+      addToast({ type: 'error', title: t('common.error'), message: error.response?.data?.message || t('auth.loginFailed') });
+    }
+  };
+
   const handleGoogleLogin = async () => {
     try {
       const { data } = await authAPI.getGoogleLoginURL();
@@ -136,6 +164,16 @@ export function LoginPage() {
     }
   };
 
+  const handleSSOLogin = async () => {
+    try {
+      const { data } = await authAPI.getSSOLoginURL();
+      sessionStorage.setItem(SSO_STATE_KEY, data.state);
+      window.location.href = data.url;
+    } catch (error) {
+      addToast({ type: 'error', title: t('common.error'), message: error.response?.data?.message || t('auth.loginFailed') });
+    }
+  };
+
   return (
     <AuthLayout
       title={t('landing.hero.title') + ' ' + t('landing.hero.titleHighlight')}
@@ -211,6 +249,14 @@ export function LoginPage() {
           {t('auth.login.submitButton')}
           <ArrowRight size={16} />
         </Button>
+
+        <button type="button" className="w-full text-sm text-primary hover:text-primary/80 font-medium transition-colors" onClick={handleMagicLink}>
+          {t('auth.login.magicLink')}
+        </button>
+
+        <button type="button" className="w-full text-sm text-primary hover:text-primary/80 font-medium transition-colors" onClick={handleSSOLogin}>
+          {t('auth.login.sso')}
+        </button>
       </form>
 
       <p className="text-center text-sm text-base-content/60 mt-8">
@@ -219,6 +265,16 @@ export function LoginPage() {
           {t('auth.login.signUp')}
         </Link>
       </p>
+
+      <ConfirmModal
+        isOpen={isReactivateModalOpen}
+        onClose={() => setIsReactivateModalOpen(false)}
+        onConfirm={handleReactivate}
+        title={t('auth.accountDeactivated')}
+        message={t('auth.reactivatePrompt')}
+        confirmText={t('auth.reactivateButton')}
+        variant="primary"
+      />
     </AuthLayout>
   );
 }
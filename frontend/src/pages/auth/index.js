@@ -1,3 +1,5 @@
 export { LoginPage, RegisterPage } from './AuthPages';
 export { VerifyEmailPage, ForgotPasswordPage, ResetPasswordPage } from './VerificationPages';
 export { default as GoogleCallbackPage } from './GoogleCallbackPage';
+export { default as MagicLinkPage } from './MagicLinkPage';
+export { default as SSOCallbackPage, SSO_STATE_KEY } from './SSOCallbackPage';
@@ -99,6 +99,7 @@ export function SettingsPage() {
     revokeSession,
     revokeOtherSessions,
     deleteAccount,
+    deactivateAccount,
     exportData,
     fetchSessions,
     fetchProfile,
@@ -122,6 +123,7 @@ export function SettingsPage() {
   });
 
   const [isDeleteModalOpen, setIsDeleteModalOpen] = useState(false);
+  const [isDeactivateModalOpen, setIsDeactivateModalOpen] = useState(false);
   const [isPasswordModalOpen, setIsPasswordModalOpen] = useState(false);
   const [isSavingProfile, setIsSavingProfile] = useState(false);
   const [isSavingPassword, setIsSavingPassword] = useState(false);
@@ -198,6 +200,14 @@ export function SettingsPage() {
     }
   };
 
+  const handleDeactivateAccount = async () => {
+    const result = await deactivateAccount();
+    setIsDeactivateModalOpen(false);
+    if (!result.success) {
+      addToast({ type: 'error', title: t('common.error'), message: result.error });
+    }
+  };
+
   const handleRevokeSession = async (sessionId) => {
     const result = await revokeSession(sessionId);
     if (result.success) {
@@ -446,7 +456,9 @@ export function SettingsPage() {
                   <div>
                     <p className="text-sm font-medium text-base-content">{session.user_agent || 'Unknown Device'}</p>
                     <p className="text-xs text-base-content/50 mt-0.5">
-                      {session.client_ip} • {session.created_at ? format(new Date(session.created_at), 'PP p') : 'Unknown Date'}
+                      {session.client_ip}
+                      {(session.city || session.country) && ` (${[session.city, session.country].filter(Boolean).join(', ')})`}
+                      {' '}• {session.created_at ? format(new Date(session.created_at), 'PP p') : 'Unknown Date'}
                     </p>
                   </div>
                   <div className="flex items-center gap-3">
@@ -500,10 +512,15 @@ export function SettingsPage() {
             <div className="flex-1">
               <h3 className="text-base font-semibold text-error mb-1">{t('settings.danger.title')}</h3>
               <p className="text-sm text-error/70 mb-5">{t('settings.danger.subtitle')}</p>
-              <Button variant="danger" onClick={() => setIsDeleteModalOpen(true)}>
-                <Trash2 size={16} />
-                {t('settings.danger.deleteAccount')}
-              </Button>
+              <div className="flex flex-wrap gap-3">
+                <Button variant="secondary" onClick={() => setIsDeactivateModalOpen(true)}>
+                  {t('settings.danger.deactivateAccount')}
+                </Button>
+                <Button variant="danger" onClick={() => setIsDeleteModalOpen(true)}>
+                  <Trash2 size={16} />
+                  {t('settings.danger.deleteAccount')}
+                </Button>
+              </div>
             </div>
           </div>
         </div>
@@ -572,6 +589,16 @@ export function SettingsPage() {
         confirmText={t('settings.danger.deleteAccount')}
         variant="danger"
       />
+
+      <ConfirmModal
+        isOpen={isDeactivateModalOpen}
+        onClose={() => setIsDeactivateModalOpen(false)}
+        onConfirm={handleDeactivateAccount}
+        title={t('settings.danger.deactivateAccount')}
+        message={t('settings.danger.deactivateConfirm')}
+        confirmText={t('settings.danger.deactivateAccount')}
+        variant="warning"
+      />
     </div>
   );
 }
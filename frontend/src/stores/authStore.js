@@ -63,7 +63,7 @@ export const useAuthStore = create(
         const { sessionId } = get();
         try {
           if (sessionId) {
-            await authAPI.logout(sessionId);
+            await authAPI.logout();
           }
         } catch (error) {
           console.error('Logout error:', error);
@@ -84,7 +84,7 @@ export const useAuthStore = create(
         const { user } = get();
         try {
           if (user?.id) {
-            await authAPI.logoutAll(user.id);
+            await authAPI.logoutAll();
           }
         } catch (error) {
           console.error('Logout all error:', error);
@@ -104,7 +104,7 @@ export const useAuthStore = create(
       revokeSession: async (targetSessionId) => {
         set({ isLoading: true, error: null });
         try {
-          await authAPI.logout(targetSessionId);
+          await authAPI.revokeSession(targetSessionId);
 
           set((state) => {
             return {
@@ -162,6 +162,22 @@ export const useAuthStore = create(
         }
       },
 
+      deactivateAccount: async () => {
+        set({ isLoading: true, error: null });
+        try {
+          const response = await authAPI.deactivateAccount();
+          if (response.success) {
+            get().logout(); // Every session was signed out
+            return { success: true };
+          }
+          throw new Error(response.message || 'Failed to deactivate account');
+        } catch (error) {
+          const message = error.response?.data?.message || error.message || 'Failed to deactivate account';
+          set({ isLoading: false, error: message });
+          return { success: false, error: message };
+        }
+      },
+
       exportData: async () => {
         set({ isLoading: true, error: null });
         try {
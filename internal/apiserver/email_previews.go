@@ -0,0 +1,95 @@
+package apiserver
+
+import (
+	"time"
+
+	"github.com/semmidev/ethos-go/config"
+	"github.com/semmidev/ethos-go/internal/auth/domain/gateway"
+	"github.com/semmidev/ethos-go/internal/common/email"
+	"github.com/semmidev/ethos-go/internal/common/events/handlers"
+	habitsquery "github.com/semmidev/ethos-go/internal/habits/app/query"
+	notiftask "github.com/semmidev/ethos-go/internal/notifications/adapters/task"
+)
+
+// emailPreviewSamples is the example data the email preview renders each
+// template with
+func emailPreviewSamples(cfg *config.Config) map[string]email.PreviewSample {
+	const name = "Sam"
+
+	return map[string]email.PreviewSample{
+		email.TemplateVerification: func(locale string) any {
+			return gateway.PayloadSendVerifyEmail{
+				Name:                       name,
+				Email:                      "sam@example.com",
+				VerificationCode:           "123456",
+				VerificationCodeExpiration: 10,
+				Locale:                     locale,
+				From:                       cfg.AppName,
+			}
+		},
+		email.TemplateForgotPassword: func(locale string) any {
+			return gateway.PayloadSendForgotPasswordEmail{
+				Name:                       name,
+				Email:                      "sam@example.com",
+				VerificationCode:           "123456",
+				VerificationCodeExpiration: 10,
+				Locale:                     locale,
+				From:                       cfg.AppName,
+				ResetLink:                  cfg.AppClientURL + "/reset-password?email=sam@example.com&code=123456",
+			}
+		},
+		email.TemplateMagicLink: func(locale string) any {
+			return gateway.PayloadSendMagicLinkEmail{
+				Name:       name,
+				Email:      "sam@example.com",
+				Expiration: 15,
+				Locale:     locale,
+				From:       cfg.AppName,
+				LoginLink:  cfg.AppClientURL + "/auth/magic-link?token=preview",
+			}
+		},
+		email.TemplateWelcome: func(locale string) any {
+			return handlers.WelcomeEmail{
+				Locale: locale,
+				Name:   name,
+				From:   cfg.AppName,
+				AppURL: cfg.AppClientURL,
+			}
+		},
+		email.TemplateWeeklyReport: func(locale string) any {
+			days := make([]habitsquery.DailyAnalytics, 7)
+			start := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+			for i := range days {
+				day := start.AddDate(0, 0, i)
+				days[i] = habitsquery.DailyAnalytics{
+					DayName:              day.Weekday().String(),
+					Date:                 day.Format("2006-01-02"),
+					LogsCount:            i % 4,
+					CompletionPercentage: (i % 4) * 33,
+				}
+			}
+			return notiftask.WeeklyReport{
+				Locale:            locale,
+				Name:              name,
+				From:              cfg.AppName,
+				UnsubscribeURL:    cfg.AppClientURL + "/unsubscribe",
+				AverageCompletion: 47,
+				Days:              days,
+				BestStreaks:       []notiftask.ReportEntry{{Name: "Read 20 pages", Value: 12}, {Name: "Morning run", Value: 5}},
+				MostMissed:        []notiftask.ReportEntry{{Name: "Meditate", Value: 2}},
+			}
+		},
+		email.TemplateReengagement: func(locale string) any {
+			return notiftask.ReengagementEmail{
+				Locale:         locale,
+				Name:           name,
+				From:           cfg.AppName,
+				AppURL:         cfg.AppClientURL,
+				UnsubscribeURL: cfg.AppClientURL + "/unsubscribe",
+				DaysInactive:   4,
+				HabitName:      "Morning run",
+				Streak:         9,
+			}
+		},
+	}
+}
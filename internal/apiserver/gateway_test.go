@@ -0,0 +1,141 @@
+package apiserver
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	habitsv1 "github.com/semmidev/ethos-go/contracts/grpc/ethos/habits/v1"
+	"github.com/semmidev/ethos-go/internal/common/grpcutil"
+	"github.com/semmidev/ethos-go/internal/testutil"
+)
+
+// gatewayCall is what the habits server saw of a gateway request
+type gatewayCall struct {
+	service  string
+	habitID  string
+	metadata metadata.MD
+}
+
+// habitsServer answers GetHabit with err, or with a habit when err is nil
+type habitsServer struct {
+	habitsv1.UnimplementedHabitsServiceServer
+	calls chan gatewayCall
+	err   error
+}
+
+func (s *habitsServer) GetHabit(ctx context.Context, req *habitsv1.GetHabitRequest) (*habitsv1.HabitResponse, error) {
+	service, _ := grpcutil.ServiceFromContext(ctx)
+	md, _ := metadata.FromIncomingContext(ctx)
+	s.calls <- gatewayCall{service: service, habitID: req.GetHabitId(), metadata: md}
+
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &habitsv1.HabitResponse{
+		Success: true,
+		Message: "habit found",
+		Data:    &habitsv1.Habit{Id: req.GetHabitId(), Name: "Read"},
+	}, nil
+}
+
+func TestGateway(t *testing.T) {
+	Convey("Given the gateway connected to the gRPC server in memory", t, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		serviceAuth, err := grpcutil.NewServiceAuth("gateway-test-service-secret-32-chars")
+		So(err, ShouldBeNil)
+
+		server := &habitsServer{calls: make(chan gatewayCall, 1)}
+		grpcServer := grpc.NewServer(grpc.UnaryInterceptor(serviceAuth.UnaryServerInterceptor(nil)))
+		habitsv1.RegisterHabitsServiceServer(grpcServer, server)
+		defer grpcServer.Stop()
+
+		listener := bufconn.Listen(gatewayBufferSize)
+		go serveGateway(ctx, grpcServer, listener, testutil.NopLogger{})
+
+		gwMux, err := createGatewayMux(ctx, listener, serviceAuth)
+		So(err, ShouldBeNil)
+
+		get := func(path string, header http.Header) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(http.MethodGet, path, nil)
+			for k, v := range header {
+				req.Header[k] = v
+			}
+			rec := httptest.NewRecorder()
+			gwMux.ServeHTTP(rec, req)
+			return rec
+		}
+
+		Convey("When a habit is fetched over HTTP", func() {
+			rec := get("/v1/habits/habit-1", http.Header{
+				"Authorization": {"Bearer user-token"},
+				// A client must not be able to pass as another service
+				"Grpc-Metadata-X-Service-Token": {"forged"},
+			})
+
+			Convey("Then the call reaches the gRPC server as the gateway", func() {
+				call := <-server.calls
+				So(call.service, ShouldEqual, grpcutil.ServiceGateway)
+				So(call.habitID, ShouldEqual, "habit-1")
+				So(call.metadata.Get("authorization"), ShouldContain, "Bearer user-token")
+				So(call.metadata.Get(grpcutil.ServiceTokenHeader), ShouldHaveLength, 1)
+				So(call.metadata.Get(grpcutil.ServiceTokenHeader)[0], ShouldNotEqual, "forged")
+			})
+
+			Convey("Then the response is enveloped like a chi handler's", func() {
+				So(rec.Code, ShouldEqual, http.StatusOK)
+
+				var body struct {
+					Success bool   `json:"success"`
+					Message string `json:"message"`
+					Data    struct {
+						ID   string `json:"id"`
+						Name string `json:"name"`
+					} `json:"data"`
+				}
+				So(json.Unmarshal(rec.Body.Bytes(), &body), ShouldBeNil)
+				So(body.Success, ShouldBeTrue)
+				So(body.Message, ShouldEqual, "habit found")
+				So(body.Data.ID, ShouldEqual, "habit-1")
+				So(body.Data.Name, ShouldEqual, "Read")
+			})
+		})
+
+		Convey("When the gRPC server returns an error", func() {
+			server.err = status.Error(codes.NotFound, "habit not found")
+			rec := get("/v1/habits/missing", nil)
+			<-server.calls
+
+			Convey("Then it is mapped to the HTTP status and error body", func() {
+				So(rec.Code, ShouldEqual, http.StatusNotFound)
+
+				var body struct {
+					Message string `json:"message"`
+				}
+				So(json.Unmarshal(rec.Body.Bytes(), &body), ShouldBeNil)
+				So(body.Message, ShouldEqual, "habit not found")
+			})
+		})
+
+		Convey("When the in-memory listener is closed", func() {
+			So(listener.Close(), ShouldBeNil)
+			rec := get("/v1/habits/habit-1", nil)
+
+			Convey("Then the gateway reports the gRPC server as unavailable", func() {
+				So(rec.Code, ShouldEqual, http.StatusServiceUnavailable)
+				So(server.calls, ShouldBeEmpty)
+			})
+		})
+	})
+}
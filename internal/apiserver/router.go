@@ -0,0 +1,379 @@
+package apiserver
+
+import (
+	"net/http"
+	goruntime "runtime"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/semmidev/ethos-go/config"
+	"github.com/semmidev/ethos-go/internal/common/httputil"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/common/observability"
+	"github.com/semmidev/ethos-go/internal/common/storage"
+	"github.com/semmidev/ethos-go/internal/web"
+)
+
+// APIVersion is one version of the HTTP API, served under /api/<Name>.
+// Its handler serves the paths of that version's protos, which start with
+// /<Name> (e.g. /v1/habits), so a new version's gateway mux is mounted
+// beside the old ones without either changing.
+type APIVersion struct {
+	Name    string
+	Handler http.Handler
+	// Deprecation, when set, marks every endpoint of the version as slated
+	// for removal
+	Deprecation *httputil.Deprecation
+}
+
+// legacyDeprecatedAt is when the unversioned /api/* and bare /v1/* paths
+// were deprecated in favour of /api/v1/*
+var legacyDeprecatedAt = time.Date(2026, time.October, 17, 0, 0, 0, 0, time.UTC)
+
+// RouterConfig contains all dependencies needed for router setup
+type RouterConfig struct {
+	Config *config.Config
+	// Build is reported on /version and on every canonical log line
+	Build Build
+	// APIVersions are mounted side by side; the one named v1 also serves
+	// the deprecated legacy paths
+	APIVersions    []APIVersion
+	OTELProvider   *observability.Provider
+	Logger         logger.Logger
+	Sampler        *logger.Sampler
+	PayloadCapture *observability.PayloadCapture
+	SLO            *observability.SLOTracker
+	AuthMiddleware func(http.Handler) http.Handler
+	JWKSHandler    http.Handler
+
+	// PublicStatsHandler serves anonymized totals for the landing page
+	PublicStatsHandler http.Handler
+
+	// TestEmailHandler sends an operator's test email through the provider
+	TestEmailHandler http.Handler
+
+	// EmailLogHandler lists attempted emails from the email log
+	EmailLogHandler http.Handler
+
+	// QueueAdminHandler inspects the background task queues
+	QueueAdminHandler http.Handler
+
+	// AvatarUploadHandler accepts multipart avatar uploads, which the
+	// gRPC gateway cannot handle
+	AvatarUploadHandler http.Handler
+
+	// UploadsHandler serves locally stored uploads such as avatars
+	UploadsHandler http.Handler
+
+	// SCIMHandler serves SCIM user provisioning for identity providers; it
+	// checks the provisioning token itself
+	SCIMHandler http.Handler
+
+	// StripeWebhookHandler consumes Stripe subscription events; it checks
+	// the webhook signature itself
+	StripeWebhookHandler http.Handler
+
+	// EmailPreviewHandler renders email templates with sample data; it is
+	// only mounted outside production
+	EmailPreviewHandler http.Handler
+}
+
+// NewRouter creates and configures the main chi router with all routes and middleware
+func NewRouter(rc RouterConfig) chi.Router {
+	r := chi.NewRouter()
+
+	// Apply global middleware
+	applyGlobalMiddleware(r, rc)
+
+	// Mount utility endpoints
+	mountUtilityEndpoints(r, rc.Config, rc.Build, rc.OTELProvider)
+
+	// Publish token signing keys when asymmetric signing is enabled
+	if rc.JWKSHandler != nil {
+		r.Method(http.MethodGet, "/.well-known/jwks.json", rc.JWKSHandler)
+	}
+
+	// Anonymized aggregate stats for the marketing landing page
+	if rc.PublicStatsHandler != nil {
+		r.Method(http.MethodGet, "/stats/public", rc.PublicStatsHandler)
+	}
+
+	// Locally stored uploads, when the API serves them itself
+	if rc.UploadsHandler != nil {
+		r.Method(http.MethodGet, storage.LocalPathPrefix+"/*", rc.UploadsHandler)
+	}
+
+	// User provisioning by the enterprise identity provider
+	if rc.SCIMHandler != nil {
+		r.Mount("/scim/v2", rc.SCIMHandler)
+	}
+
+	// Subscription changes from the billing provider
+	if rc.StripeWebhookHandler != nil {
+		r.Method(http.MethodPost, "/webhooks/stripe", rc.StripeWebhookHandler)
+	}
+
+	// Operator endpoints, only when admin credentials are configured
+	mountAdminRoutes(r, rc)
+
+	// Email template previews for checking copy changes
+	if rc.EmailPreviewHandler != nil && rc.Config.AppEnv != "production" {
+		r.Method(http.MethodGet, "/dev/emails", rc.EmailPreviewHandler)
+	}
+
+	// Mount gRPC-Gateway API routes
+	mountGatewayRoutes(r, rc)
+
+	// Mount SPA frontend (must be last)
+	mountSPAHandler(r)
+
+	return r
+}
+
+// applyGlobalMiddleware adds all global middleware to the router
+func applyGlobalMiddleware(r chi.Router, rc RouterConfig) {
+	r.Use(middleware.RequestID)
+	r.Use(middleware.RealIP)
+	r.Use(corsMiddleware())
+	r.Use(observability.HTTPMiddleware(rc.Config.AppName, rc.SLO))
+
+	// Event middleware (Canonical Log Lines)
+	if rc.Logger != nil {
+		sampler := rc.Sampler
+		if sampler == nil {
+			sampler = newEventSampler(rc.Config)
+		}
+		r.Use(logger.EventMiddleware(logger.EventMiddlewareConfig{
+			ServiceName: rc.Config.AppName,
+			Version:     rc.Build.Version,
+			Environment: rc.Config.AppEnv,
+			Logger:      rc.Logger,
+			Sampler:     sampler,
+		}))
+		// Inside the event middleware so recovered panics are on the
+		// request's canonical log line
+		r.Use(observability.RecoveryMiddleware(rc.Logger))
+	} else {
+		r.Use(middleware.Logger)
+		r.Use(middleware.Recoverer)
+	}
+
+	// Inside the event middleware so captures are flagged on the request's
+	// canonical log line
+	if rc.PayloadCapture != nil {
+		r.Use(rc.PayloadCapture.Middleware)
+	}
+
+	// Inside the event middleware so timed out requests are logged with
+	// their 504
+	r.Use(httputil.Timeout(rc.Config.RequestTimeout, requestTimeoutRoutes(rc.Config)))
+}
+
+// exportPath is the data export's path under an API version
+const exportPath = "/auth/export"
+
+// requestTimeoutRoutes gives the data export, under every path it is
+// served at, its longer deadline
+func requestTimeoutRoutes(cfg *config.Config) map[string]time.Duration {
+	return map[string]time.Duration{
+		"/api/v1" + exportPath: cfg.RequestExportTimeout,
+		"/v1" + exportPath:     cfg.RequestExportTimeout,
+		"/api" + exportPath:    cfg.RequestExportTimeout,
+	}
+}
+
+// newEventSampler builds the canonical log line sampler from configuration.
+func newEventSampler(cfg *config.Config) *logger.Sampler {
+	return logger.NewSampler(logger.SamplerConfig{
+		Enabled:        true,
+		BaseRate:       cfg.EventSampleRate,
+		P99ThresholdMs: cfg.EventP99ThresholdMs,
+	})
+}
+
+// newPayloadCaptureConfig reads the debug capture selection from
+// configuration.
+func newPayloadCaptureConfig(cfg *config.Config) observability.PayloadCaptureConfig {
+	return observability.PayloadCaptureConfig{
+		Routes:   splitList(cfg.DebugCaptureRoutes),
+		Users:    splitList(cfg.DebugCaptureUsers),
+		MaxBytes: cfg.DebugCaptureMaxBytes,
+	}
+}
+
+// sloRoutes lists the API resources each SLO group covers
+var sloRoutes = map[string][]string{
+	"auth":          {"/auth"},
+	"habits":        {"/habits", "/habit-logs", "/dashboard", "/analytics", "/imports"},
+	"notifications": {"/notifications"},
+}
+
+// newSLOTracker builds the per route group SLO tracker from configuration.
+func newSLOTracker(cfg *config.Config) *observability.SLOTracker {
+	var groups []observability.SLOGroup
+	for name, target := range sloTargets(cfg) {
+		group := observability.SLOGroup{Name: name, Target: target}
+		for _, resource := range sloRoutes[name] {
+			group.Prefixes = append(group.Prefixes, "/api/v1"+resource, "/v1"+resource, "/api"+resource)
+		}
+		groups = append(groups, group)
+	}
+	return observability.NewSLOTracker(groups)
+}
+
+// sloTargets reads the SLO targets as fractions of successful requests.
+func sloTargets(cfg *config.Config) map[string]float64 {
+	return map[string]float64{
+		"auth":          cfg.SLOAuthTarget / 100,
+		"habits":        cfg.SLOHabitsTarget / 100,
+		"notifications": cfg.SLONotificationsTarget / 100,
+	}
+}
+
+// splitList splits a comma-separated setting, dropping empty items
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// mountUtilityEndpoints adds health, version, metrics, and ping endpoints
+func mountUtilityEndpoints(r chi.Router, cfg *config.Config, build Build, otelProvider *observability.Provider) {
+	// Health check
+	r.Get("/health", func(w http.ResponseWriter, r *http.Request) {
+		httputil.Success(w, r, map[string]string{
+			"status":  "healthy",
+			"service": cfg.AppName,
+		}, "Health check passed")
+	})
+
+	// Version info
+	r.Get("/version", func(w http.ResponseWriter, r *http.Request) {
+		httputil.Success(w, r, map[string]interface{}{
+			"version":    build.Version,
+			"commit":     build.Commit,
+			"build_time": build.Time,
+			"go_version": goruntime.Version(),
+			"os":         goruntime.GOOS,
+			"arch":       goruntime.GOARCH,
+			"env":        cfg.AppEnv,
+		}, "Version information")
+	})
+
+	// Prometheus metrics
+	if otelProvider.PrometheusExporter != nil {
+		r.Handle("/metrics", observability.MetricsHandler(cfg.MetricsUsername, cfg.MetricsPassword))
+	}
+
+	// Ping
+	r.Get("/ping", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"message":"pong"}`))
+	})
+}
+
+// mountAdminRoutes adds operator endpoints behind basic auth
+func mountAdminRoutes(r chi.Router, rc RouterConfig) {
+	cfg := rc.Config
+	if cfg.AdminUsername == "" {
+		return
+	}
+
+	r.Route("/admin", func(r chi.Router) {
+		r.Use(middleware.BasicAuth("admin", map[string]string{cfg.AdminUsername: cfg.AdminPassword}))
+
+		if rc.TestEmailHandler != nil {
+			r.Method(http.MethodPost, "/email/test", rc.TestEmailHandler)
+		}
+		if rc.EmailLogHandler != nil {
+			r.Method(http.MethodGet, "/emails", rc.EmailLogHandler)
+		}
+		if rc.QueueAdminHandler != nil {
+			r.Mount("/queues", rc.QueueAdminHandler)
+		}
+	})
+}
+
+// mountGatewayRoutes mounts each API version under /api/<version>, and v1
+// under its deprecated legacy paths
+func mountGatewayRoutes(r chi.Router, rc RouterConfig) {
+	var v1 http.Handler
+	for _, v := range rc.APIVersions {
+		// The version's mux expects its paths without the /api prefix
+		handler := http.StripPrefix("/api", v.Handler)
+		if v.Deprecation != nil {
+			handler = httputil.Deprecated(*v.Deprecation)(handler)
+		}
+		r.Mount("/api/"+v.Name, handler)
+		if v.Name == "v1" {
+			v1 = v.Handler
+		}
+	}
+
+	legacy := httputil.Deprecated(legacyDeprecation(rc.Config))
+
+	// Multipart uploads are served beside the gateway under the same paths
+	if rc.AvatarUploadHandler != nil {
+		upload := rc.AuthMiddleware(rc.AvatarUploadHandler)
+		r.Method(http.MethodPost, "/api/v1/auth/profile/avatar", upload)
+		r.Method(http.MethodPost, "/v1/auth/profile/avatar", legacy(upload))
+		r.Method(http.MethodPost, "/api/auth/profile/avatar", legacy(upload))
+	}
+
+	if v1 == nil {
+		return
+	}
+
+	// Legacy paths from before versioned prefixes: the proto paths at the
+	// root (/v1/*) and the unversioned /api/* paths
+	r.Mount("/v1", legacy(v1))
+	r.Mount("/api", legacy(http.StripPrefix("/api", http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		// Rewrite /api/auth/* to /v1/auth/*, /api/habits/* to /v1/habits/*, etc.
+		req.URL.Path = "/v1" + req.URL.Path
+		v1.ServeHTTP(w, req)
+	}))))
+}
+
+// legacyDeprecation announces the legacy paths' sunset and points callers
+// to the same endpoint under /api/v1
+func legacyDeprecation(cfg *config.Config) httputil.Deprecation {
+	sunset, _ := time.Parse(time.DateOnly, cfg.APILegacySunset)
+	return httputil.Deprecation{
+		Since:  legacyDeprecatedAt,
+		Sunset: sunset,
+		Successor: func(r *http.Request) string {
+			path := strings.TrimPrefix(r.URL.Path, "/api")
+			return "/api/v1" + strings.TrimPrefix(path, "/v1")
+		},
+	}
+}
+
+// mountSPAHandler serves the embedded frontend for SPA routing
+func mountSPAHandler(r chi.Router) {
+	if !web.IsFrontendBundled() {
+		return
+	}
+
+	spaHandler, err := web.NewSPAHandler()
+	if err != nil {
+		return
+	}
+
+	// Static assets
+	r.Handle("/assets/*", spaHandler)
+	r.Handle("/manifest.json", spaHandler)
+	r.Handle("/sw.js", spaHandler)
+	r.Handle("/robots.txt", spaHandler)
+	r.Handle("/favicon.ico", spaHandler)
+	r.Handle("/icons/*", spaHandler)
+
+	// Catch-all for SPA routing (must be last)
+	r.NotFound(spaHandler.ServeHTTP)
+}
@@ -0,0 +1,537 @@
+package apiserver
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/hibiken/asynq"
+	"github.com/jmoiron/sqlx"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/semmidev/ethos-go/config"
+	authv1 "github.com/semmidev/ethos-go/contracts/grpc/ethos/auth/v1"
+	habitsv1 "github.com/semmidev/ethos-go/contracts/grpc/ethos/habits/v1"
+	notificationsv1 "github.com/semmidev/ethos-go/contracts/grpc/ethos/notifications/v1"
+	authtask "github.com/semmidev/ethos-go/internal/auth/adapters/task"
+	authapp "github.com/semmidev/ethos-go/internal/auth/app"
+	authports "github.com/semmidev/ethos-go/internal/auth/ports"
+	authsvc "github.com/semmidev/ethos-go/internal/auth/service"
+	billingapp "github.com/semmidev/ethos-go/internal/billing/app"
+	billingsvc "github.com/semmidev/ethos-go/internal/billing/service"
+	"github.com/semmidev/ethos-go/internal/common/database"
+	"github.com/semmidev/ethos-go/internal/common/email"
+	"github.com/semmidev/ethos-go/internal/common/errreport"
+	"github.com/semmidev/ethos-go/internal/common/events"
+	"github.com/semmidev/ethos-go/internal/common/grpcutil"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/common/metrics"
+	"github.com/semmidev/ethos-go/internal/common/model"
+	"github.com/semmidev/ethos-go/internal/common/observability"
+	"github.com/semmidev/ethos-go/internal/common/outbox"
+	"github.com/semmidev/ethos-go/internal/common/startup"
+	commontask "github.com/semmidev/ethos-go/internal/common/task"
+	habittask "github.com/semmidev/ethos-go/internal/habits/adapters/task"
+	habitsapp "github.com/semmidev/ethos-go/internal/habits/app"
+	habitports "github.com/semmidev/ethos-go/internal/habits/ports"
+	habitsvc "github.com/semmidev/ethos-go/internal/habits/service"
+	notificationsapp "github.com/semmidev/ethos-go/internal/notifications/app"
+	notificationports "github.com/semmidev/ethos-go/internal/notifications/ports"
+	notificationsvc "github.com/semmidev/ethos-go/internal/notifications/service"
+	"github.com/semmidev/ethos-go/migrations"
+)
+
+// Build identifies the binary the server runs in; cmd/api fills it in from
+// variables injected via ldflags.
+type Build struct {
+	Version string
+	Commit  string
+	Time    string
+}
+
+// Run serves the HTTP and gRPC APIs until ctx is cancelled or the server
+// fails, then shuts down gracefully. cmd/api calls it with the loaded
+// configuration; the e2e tests call it in-process.
+func Run(ctx context.Context, cfg *config.Config, build Build) error {
+	// Setup logger
+	appLogger, err := logger.New(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to initialize logger: %w", err)
+	}
+
+	appLogger.Info(ctx, "starting app",
+		logger.Field{Key: "env", Value: cfg.AppEnv},
+		logger.Field{Key: "version", Value: build.Version},
+		logger.Field{Key: "commit", Value: build.Commit},
+		logger.Field{Key: "build_time", Value: build.Time},
+	)
+
+	// Internal errors and panics go to the error tracker, if configured
+	reporter, err := errreport.New(cfg, errreport.Release{
+		Version:     build.Version,
+		Commit:      build.Commit,
+		Environment: cfg.AppEnv,
+	}, func(ctx context.Context) string {
+		userID, _ := authports.GetUserIDFromContext(ctx)
+		return userID
+	}, appLogger)
+	if err != nil {
+		return fmt.Errorf("failed to initialize error reporter: %w", err)
+	}
+	errreport.SetDefault(reporter)
+	defer func() {
+		flushCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		reporter.Close(flushCtx)
+	}()
+
+	// Initialize infrastructure
+	otelProvider, db, asynqClient, err := initInfrastructure(ctx, cfg, build, appLogger)
+	if err != nil {
+		return err
+	}
+	defer otelProvider.Shutdown(ctx)
+	defer db.Close()
+	defer asynqClient.Close()
+
+	// Initialize application modules
+	authApp, habitsApp, notificationsApp, billingApp := initModules(ctx, cfg, db, asynqClient, appLogger)
+
+	// Internal gRPC calls authenticate the calling service
+	serviceAuth, err := grpcutil.NewServiceAuth(cfg.GRPCServiceSecret)
+	if err != nil {
+		return err
+	}
+
+	// Create and start gRPC server. The gateway reaches it through an
+	// in-memory listener, so HTTP does not depend on the TCP port being up.
+	grpcServer := createGRPCServer(cfg, authApp, habitsApp, notificationsApp, serviceAuth)
+	gatewayListener := bufconn.Listen(gatewayBufferSize)
+	if cfg.GRPCMode == config.GRPCModeSeparate {
+		go runGRPCServer(ctx, grpcServer, ":"+cfg.GRPCPort, appLogger)
+	}
+	go serveGateway(ctx, grpcServer, gatewayListener, appLogger)
+
+	// Create gRPC-Gateway and HTTP server
+	gwMux, err := createGatewayMux(ctx, gatewayListener, serviceAuth)
+	if err != nil {
+		return err
+	}
+
+	// Operators can send a test email to check the SMTP settings, and look
+	// up attempted emails in the email log
+	smtpClient, err := email.NewSMTPClient(cfg, appLogger)
+	if err != nil {
+		return fmt.Errorf("failed to initialize smtp client: %w", err)
+	}
+	emailLog := email.NewLogRepository(db)
+	testEmailSender := email.Audited(smtpClient, emailLog, smtpClient.Provider(), appLogger)
+
+	// Operators can inspect and requeue background tasks
+	inspector := asynq.NewInspector(asynqRedisOpt(cfg))
+	defer inspector.Close()
+
+	// Log level, event sampling and the page size cap can be changed
+	// without a restart
+	model.SetMaxPageLimit(cfg.PaginationMaxPerPage)
+	sampler := newEventSampler(cfg)
+	payloadCapture := observability.NewPayloadCapture(newPayloadCaptureConfig(cfg), authApp.RequestUserID, appLogger)
+	slo := newSLOTracker(cfg)
+	if err := observability.RegisterSLOMetrics(slo); err != nil {
+		return fmt.Errorf("failed to register slo metrics: %w", err)
+	}
+	if err := observability.RegisterBreakerMetrics(authApp.Breakers...); err != nil {
+		return fmt.Errorf("failed to register circuit breaker metrics: %w", err)
+	}
+	go config.Watch(ctx, func(newCfg *config.Config) {
+		logger.SetLevel(newCfg.LoggerLevel)
+		model.SetMaxPageLimit(newCfg.PaginationMaxPerPage)
+		sampler.Update(newCfg.EventSampleRate, newCfg.EventP99ThresholdMs)
+		payloadCapture.Update(newPayloadCaptureConfig(newCfg))
+		slo.UpdateTargets(sloTargets(newCfg))
+	})
+
+	router := NewRouter(RouterConfig{
+		Config:         cfg,
+		Build:          build,
+		APIVersions:    []APIVersion{{Name: "v1", Handler: gwMux}},
+		OTELProvider:   otelProvider,
+		Logger:         appLogger,
+		Sampler:        sampler,
+		PayloadCapture: payloadCapture,
+		SLO:            slo,
+		AuthMiddleware: authApp.AuthMiddleware,
+		JWKSHandler:    authApp.JWKSHandler,
+
+		AvatarUploadHandler: authApp.AvatarUploadHandler,
+		UploadsHandler:      authApp.UploadsHandler,
+		SCIMHandler:         authApp.SCIMHandler,
+
+		StripeWebhookHandler: billingApp.StripeWebhookHandler,
+
+		PublicStatsHandler: habitports.PublicStatsHandler(habitsApp.Queries.GetPublicStats),
+		TestEmailHandler:   email.SendTestEmailHandler(testEmailSender, cfg.AppName, smtpClient.Provider()),
+		EmailLogHandler:    email.ListEmailLogHandler(emailLog),
+		QueueAdminHandler:  observability.QueueAdminHandler(inspector),
+
+		EmailPreviewHandler: email.PreviewHandler(email.NewRenderer(email.Templates), emailPreviewSamples(cfg)),
+	})
+
+	// Unless gRPC has a port of its own, the HTTP server serves it too
+	var sharedGRPC *grpc.Server
+	if cfg.GRPCMode == config.GRPCModeShared {
+		sharedGRPC = grpcServer
+	}
+	httpServer := NewServer(cfg, router, sharedGRPC, appLogger)
+
+	// Start HTTP server
+	serverErrors := make(chan error, 1)
+	go func() {
+		if err := httpServer.Start(ctx); err != nil && err != http.ErrServerClosed {
+			serverErrors <- err
+		}
+	}()
+
+	// Wait for shutdown signal or error
+	select {
+	case err := <-serverErrors:
+		return fmt.Errorf("server error: %w", err)
+	case <-ctx.Done():
+		appLogger.Info(ctx, "shutdown signal received")
+	}
+
+	// Graceful shutdown
+	return gracefulShutdown(ctx, grpcServer, httpServer, appLogger)
+}
+
+// initInfrastructure initializes all infrastructure dependencies.
+func initInfrastructure(
+	ctx context.Context,
+	cfg *config.Config,
+	build Build,
+	appLogger logger.Logger,
+) (*observability.Provider, *sqlx.DB, *asynq.Client, error) {
+	// Dependencies that are still starting, as under docker-compose, are
+	// retried until the startup timeout
+	waiter := startup.NewWaiter(cfg.StartupTimeout, appLogger).
+		WithBackoff(cfg.StartupRetryInitialInterval, cfg.StartupRetryMaxInterval)
+
+	// Initialize OpenTelemetry
+	var otelProvider *observability.Provider
+	err := waiter.Retry(ctx, "otlp", func(ctx context.Context) error {
+		var err error
+		otelProvider, err = observability.New(ctx, observability.Config{
+			ServiceName:    cfg.AppName,
+			ServiceVersion: build.Version,
+			Environment:    cfg.AppEnv,
+			OTLPEndpoint:   cfg.OTLPEndpoint,
+			EnableTracing:  cfg.OTLPEnableTracing,
+			EnableMetrics:  cfg.OTLPEnableMetrics,
+			SampleRate:     cfg.OTLPSampleRate,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to initialize OpenTelemetry: %w", err)
+	}
+
+	appLogger.Info(ctx, "OpenTelemetry initialized",
+		logger.Field{Key: "tracing", Value: cfg.OTLPEnableTracing},
+		logger.Field{Key: "metrics", Value: cfg.OTLPEnableMetrics},
+	)
+
+	if _, err := observability.InitMetrics(ctx); err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to initialize metrics: %w", err)
+	}
+
+	// Initialize database
+	var db *sqlx.DB
+	err = waiter.Retry(ctx, "postgres", func(context.Context) error {
+		var err error
+		db, err = database.NewSQLXConnection(cfg)
+		return err
+	})
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to initialize database: %w", err)
+	}
+	appLogger.Info(ctx, "database connection established")
+
+	if cfg.DBDisableAutoMigrate {
+		appLogger.Info(ctx, "automatic migrations disabled, skipping")
+	} else {
+		if err := database.RunMigrations(cfg.DSN(), migrations.FS, "."); err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to run migrations: %w", err)
+		}
+		appLogger.Info(ctx, "database migrations completed")
+	}
+
+	// Asynq and the session denylist connect to Redis lazily, so check it
+	// is up before serving requests that need it
+	if err := waitForRedis(ctx, waiter, cfg); err != nil {
+		db.Close()
+		return nil, nil, nil, fmt.Errorf("failed to connect to redis: %w", err)
+	}
+	appLogger.Info(ctx, "redis connection established")
+
+	// Initialize Asynq client
+	redisOpt := asynqRedisOpt(cfg)
+	asynqClient := asynq.NewClient(redisOpt)
+	appLogger.Info(ctx, "asynq client initialized")
+
+	if cfg.OTLPEnableMetrics {
+		if err := registerBacklogMetrics(db, redisOpt); err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to register backlog metrics: %w", err)
+		}
+	}
+
+	return otelProvider, db, asynqClient, nil
+}
+
+// waitForRedis pings Redis until it answers
+func waitForRedis(ctx context.Context, waiter *startup.Waiter, cfg *config.Config) error {
+	client := redis.NewClient(&redis.Options{
+		Addr:     cfg.RedisDSN(),
+		Password: cfg.RedisPassword,
+		DB:       cfg.RedisDB,
+	})
+	defer client.Close()
+
+	return waiter.Retry(ctx, "redis", func(ctx context.Context) error {
+		return client.Ping(ctx).Err()
+	})
+}
+
+// asynqRedisOpt is the Redis connection Asynq clients use
+func asynqRedisOpt(cfg *config.Config) asynq.RedisClientOpt {
+	return asynq.RedisClientOpt{
+		Addr:     cfg.RedisDSN(),
+		Password: cfg.RedisPassword,
+		DB:       cfg.RedisDB,
+	}
+}
+
+// registerBacklogMetrics exports DB pool, Asynq queue and outbox gauges
+// on the /metrics endpoint.
+func registerBacklogMetrics(db *sqlx.DB, redisOpt asynq.RedisClientOpt) error {
+	if err := observability.RegisterDBPoolMetrics(db.DB); err != nil {
+		return err
+	}
+
+	if err := observability.RegisterQueueMetrics(asynq.NewInspector(redisOpt)); err != nil {
+		return err
+	}
+
+	return outbox.RegisterMetrics(outbox.NewRepository(db))
+}
+
+// initModules initializes all application modules.
+func initModules(
+	ctx context.Context,
+	cfg *config.Config,
+	db *sqlx.DB,
+	asynqClient *asynq.Client,
+	appLogger logger.Logger,
+) (authapp.Application, habitsapp.Application, notificationsapp.Application, billingapp.Application) {
+	metricsClient := metrics.NewPrometheusMetricsClient()
+	tracedDB := database.NewTracedDBTX(db, database.WithSlowQueryLog(cfg.DBSlowQueryThreshold, appLogger))
+
+	// Initialize Outbox publisher
+	// Events are validated against their schemas and sealed in envelopes
+	outboxRepo := outbox.NewRepository(tracedDB)
+	eventPublisher := events.NewEnvelopePublisher(outbox.NewPublisher(outboxRepo), events.NewModuleRegistry(), "ethos-api", appLogger)
+
+	// Initialize task dispatchers
+	tasks := commontask.NewAsynqDispatcher(asynqClient)
+	habitDispatcher := habittask.NewTaskDispatcher(tasks, appLogger)
+	authTaskDispatcher := authtask.NewTaskDispatcher(cfg, tasks)
+
+	// Initialize modules
+	authApp := authsvc.NewApplication(ctx, cfg, tracedDB, authTaskDispatcher, eventPublisher, appLogger, metricsClient)
+	habitsApp := habitsvc.NewApplication(ctx, cfg, tracedDB, habitDispatcher, eventPublisher, appLogger, metricsClient)
+	notificationsApp := notificationsvc.NewApplication(tracedDB, appLogger, metricsClient, cfg)
+	billingApp := billingsvc.NewApplication(cfg, tracedDB, appLogger, metricsClient)
+
+	return authApp, habitsApp, notificationsApp, billingApp
+}
+
+// createGRPCServer creates and configures the gRPC server.
+func createGRPCServer(
+	cfg *config.Config,
+	authApp authapp.Application,
+	habitsApp habitsapp.Application,
+	notificationsApp notificationsapp.Application,
+	serviceAuth *grpcutil.ServiceAuth,
+) *grpc.Server {
+	authGRPCServer := authports.NewAuthGRPCServer(
+		authApp.Commands.Register,
+		authApp.Commands.Login,
+		authApp.Commands.RefreshToken,
+		authApp.Commands.Logout,
+		authApp.Commands.LogoutAll,
+		authApp.Queries.ListSessions,
+		authApp.Queries.GetProfile,
+		authApp.Commands.UpdateProfile,
+		authApp.Queries.GetPreferences,
+		authApp.Commands.UpdatePreferences,
+		authApp.Queries.ListConsents,
+		authApp.Commands.AcceptConsent,
+		authApp.Commands.ChangePassword,
+		authApp.Commands.VerifyEmail,
+		authApp.Commands.ResendVerification,
+		authApp.Commands.ForgotPassword,
+		authApp.Commands.ResetPassword,
+		authApp.Commands.LoginGoogle,
+		authApp.Queries.GetGoogleAuthURL,
+		authApp.Commands.LoginSSO,
+		authApp.Queries.GetSSOAuthURL,
+		authApp.Commands.RequestMagicLink,
+		authApp.Commands.VerifyMagicLink,
+		authApp.Commands.RevokeSessions,
+		authApp.Commands.RevokeSession,
+		authApp.Commands.DeleteAccount,
+		authApp.Commands.DeactivateAccount,
+		authApp.Queries.ExportUserData,
+		authApp.Queries.IntrospectToken,
+	)
+
+	habitsGRPCServer := habitports.NewHabitsGRPCServer(habitsApp)
+	notificationsGRPCServer := notificationports.NewNotificationsGRPCServer(notificationsApp)
+
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			observability.UnaryServerInterceptor(),
+			grpcutil.UnaryTimeoutInterceptor(cfg.RequestTimeout, map[string]time.Duration{
+				authv1.AuthService_ExportUserData_FullMethodName: cfg.RequestExportTimeout,
+			}),
+			serviceAuth.UnaryServerInterceptor(authports.ServicePolicy),
+			authports.UnaryAuthInterceptor(authApp.AuthService),
+			grpcutil.UnaryLocaleInterceptor(),
+			authports.UnaryConsentInterceptor(authApp.ConsentGate),
+			authports.UnarySSOOnlyInterceptor(cfg.OIDCRequired),
+			errreport.UnaryServerInterceptor(),
+		),
+	)
+
+	authv1.RegisterAuthServiceServer(grpcServer, authGRPCServer)
+	habitsv1.RegisterHabitsServiceServer(grpcServer, habitsGRPCServer)
+	notificationsv1.RegisterNotificationsServiceServer(grpcServer, notificationsGRPCServer)
+	reflection.Register(grpcServer)
+
+	return grpcServer
+}
+
+// runGRPCServer starts the gRPC server.
+func runGRPCServer(ctx context.Context, server *grpc.Server, port string, appLogger logger.Logger) {
+	listener, err := net.Listen("tcp", port)
+	if err != nil {
+		appLogger.Error(ctx, err, "failed to listen on gRPC port")
+		return
+	}
+
+	appLogger.Info(ctx, "starting gRPC server", logger.Field{Key: "port", Value: port})
+	if err := server.Serve(listener); err != nil {
+		appLogger.Error(ctx, err, "gRPC server error")
+	}
+}
+
+// gatewayBufferSize is the in-memory connection buffer between the gateway
+// and the gRPC server
+const gatewayBufferSize = 1024 * 1024
+
+// serveGateway serves gRPC to the in-process gateway over an in-memory listener.
+func serveGateway(ctx context.Context, server *grpc.Server, listener *bufconn.Listener, appLogger logger.Logger) {
+	if err := server.Serve(listener); err != nil {
+		appLogger.Error(ctx, err, "gateway gRPC listener error")
+	}
+}
+
+// createGatewayMux creates the gRPC-Gateway mux. Calls still go through the
+// gRPC server, so its interceptors (service auth, user auth, locale) apply to
+// HTTP requests exactly as they do to direct gRPC clients.
+func createGatewayMux(ctx context.Context, listener *bufconn.Listener, serviceAuth *grpcutil.ServiceAuth) (*runtime.ServeMux, error) {
+	gwMux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(customHeaderMatcher),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
+		runtime.WithErrorHandler(grpcutil.CustomHTTPError),
+		runtime.WithForwardResponseRewriter(grpcutil.EnvelopeResponse),
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+			MarshalOptions: grpcutil.GatewayJSON,
+			UnmarshalOptions: protojson.UnmarshalOptions{
+				DiscardUnknown: true,
+			},
+		}),
+	)
+
+	conn, err := grpc.NewClient("passthrough:///gateway",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		serviceAuth.DialOption(grpcutil.ServiceGateway),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect gateway: %w", err)
+	}
+	go func() {
+		<-ctx.Done()
+		conn.Close()
+	}()
+
+	if err := authv1.RegisterAuthServiceHandler(ctx, gwMux, conn); err != nil {
+		return nil, fmt.Errorf("failed to register auth gateway: %w", err)
+	}
+	if err := habitsv1.RegisterHabitsServiceHandler(ctx, gwMux, conn); err != nil {
+		return nil, fmt.Errorf("failed to register habits gateway: %w", err)
+	}
+	if err := notificationsv1.RegisterNotificationsServiceHandler(ctx, gwMux, conn); err != nil {
+		return nil, fmt.Errorf("failed to register notifications gateway: %w", err)
+	}
+
+	return gwMux, nil
+}
+
+// gracefulShutdown handles graceful shutdown of all servers.
+func gracefulShutdown(ctx context.Context, grpcServer *grpc.Server, httpServer *Server, appLogger logger.Logger) error {
+	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer shutdownCancel()
+
+	grpcServer.GracefulStop()
+	appLogger.Info(ctx, "gRPC server stopped")
+
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("server forced to shutdown: %w", err)
+	}
+
+	appLogger.Info(ctx, "server stopped gracefully")
+	return nil
+}
+
+// customHeaderMatcher passes specific headers to gRPC metadata.
+func customHeaderMatcher(key string) (string, bool) {
+	switch key {
+	case "Authorization", "X-Request-Id", "X-Session-Id":
+		return key, true
+	case "Grpc-Metadata-" + http.CanonicalHeaderKey(grpcutil.ServiceTokenHeader):
+		// Only the gateway itself may attach a service token
+		return "", false
+	default:
+		return runtime.DefaultHeaderMatcher(key)
+	}
+}
+
+// outgoingHeaderMatcher returns response metadata as headers, sending
+// standard HTTP headers such as ETag under their own name
+func outgoingHeaderMatcher(key string) (string, bool) {
+	if key == "etag" {
+		return "ETag", true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
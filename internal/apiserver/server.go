@@ -0,0 +1,89 @@
+package apiserver
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/semmidev/ethos-go/config"
+	"github.com/semmidev/ethos-go/internal/common/listener"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+	"google.golang.org/grpc"
+)
+
+// Server wraps the HTTP server with graceful shutdown capabilities
+type Server struct {
+	httpServer *http.Server
+	socketMode os.FileMode
+	logger     logger.Logger
+}
+
+// NewServer creates a new HTTP server with the given configuration. When
+// grpcServer is not nil, gRPC calls on the same port are served by it, so
+// one load balancer listener carries both.
+func NewServer(cfg *config.Config, router chi.Router, grpcServer *grpc.Server, logger logger.Logger) *Server {
+	httpServer := &http.Server{
+		Addr:         cfg.ServerListenAddress(),
+		Handler:      router,
+		ReadTimeout:  15 * time.Second,
+		WriteTimeout: cfg.ServerWriteTimeout(),
+		IdleTimeout:  60 * time.Second,
+	}
+	if grpcServer != nil {
+		// gRPC clients speak HTTP/2 without TLS (h2c) with prior knowledge
+		httpServer.Protocols = new(http.Protocols)
+		httpServer.Protocols.SetHTTP1(true)
+		httpServer.Protocols.SetUnencryptedHTTP2(true)
+		httpServer.Handler = grpcMultiplexer(grpcServer, router)
+	}
+
+	return &Server{
+		httpServer: httpServer,
+		socketMode: cfg.ServerSocketFileMode(),
+		logger:     logger,
+	}
+}
+
+// grpcMultiplexer sends gRPC calls to the gRPC server, ahead of the HTTP
+// middleware, and every other request to next. gRPC calls therefore skip
+// the router's rate limiting, CORS, request logging and body size limit;
+// only the gRPC interceptors apply to them.
+func grpcMultiplexer(grpcServer *grpc.Server, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.ProtoMajor == 2 && strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc") {
+			grpcServer.ServeHTTP(w, r)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// Start begins listening for HTTP requests on a TCP address, a unix
+// socket or a socket passed by systemd. Shutdown closes the listener,
+// which removes a unix socket.
+func (s *Server) Start(ctx context.Context) error {
+	ln, err := listener.Listen(s.httpServer.Addr, s.socketMode)
+	if err != nil {
+		return fmt.Errorf("listen on %s: %w", s.httpServer.Addr, err)
+	}
+
+	s.logger.Info(ctx, "starting HTTP server",
+		logger.Field{Key: "addr", Value: s.httpServer.Addr},
+	)
+	return s.httpServer.Serve(ln)
+}
+
+// Shutdown gracefully stops the server
+func (s *Server) Shutdown(ctx context.Context) error {
+	s.logger.Info(ctx, "shutting down server...")
+	return s.httpServer.Shutdown(ctx)
+}
+
+// Addr returns the server address
+func (s *Server) Addr() string {
+	return s.httpServer.Addr
+}
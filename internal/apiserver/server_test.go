@@ -0,0 +1,90 @@
+package apiserver
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/semmidev/ethos-go/config"
+	"github.com/semmidev/ethos-go/internal/testutil"
+)
+
+func TestGRPCMultiplexer(t *testing.T) {
+	Convey("Given an HTTP server that shares its port with gRPC", t, func() {
+		grpcServer := grpc.NewServer()
+		healthpb.RegisterHealthServer(grpcServer, health.NewServer())
+
+		// The middleware stands in for the router's rate limiting, CORS,
+		// request logging and body size limit
+		var throughMiddleware atomic.Int32
+		router := chi.NewRouter()
+		router.Use(func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				throughMiddleware.Add(1)
+				next.ServeHTTP(w, r)
+			})
+		})
+		router.Get("/ping", func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte("pong"))
+		})
+
+		server := NewServer(&config.Config{}, router, grpcServer, testutil.NopLogger{})
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		So(err, ShouldBeNil)
+		go func() { _ = server.httpServer.Serve(ln) }()
+		defer server.httpServer.Close()
+
+		addr := ln.Addr().String()
+
+		Convey("A REST call goes through the HTTP middleware", func() {
+			resp, err := http.Get("http://" + addr + "/ping")
+			So(err, ShouldBeNil)
+			defer resp.Body.Close()
+			body, err := io.ReadAll(resp.Body)
+			So(err, ShouldBeNil)
+
+			So(resp.StatusCode, ShouldEqual, http.StatusOK)
+			So(string(body), ShouldEqual, "pong")
+			So(throughMiddleware.Load(), ShouldEqual, 1)
+		})
+
+		Convey("A gRPC call reaches the gRPC server and skips the HTTP middleware", func() {
+			conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+			So(err, ShouldBeNil)
+			defer conn.Close()
+
+			resp, err := healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{})
+			So(err, ShouldBeNil)
+			So(resp.Status, ShouldEqual, healthpb.HealthCheckResponse_SERVING)
+			So(throughMiddleware.Load(), ShouldEqual, 0)
+		})
+
+		Convey("An HTTP/2 call that is not gRPC goes through the HTTP middleware", func() {
+			client := &http.Client{Transport: &http.Transport{Protocols: unencryptedHTTP2()}}
+			resp, err := client.Get("http://" + addr + "/ping")
+			So(err, ShouldBeNil)
+			defer resp.Body.Close()
+
+			So(resp.ProtoMajor, ShouldEqual, 2)
+			So(resp.StatusCode, ShouldEqual, http.StatusOK)
+			So(throughMiddleware.Load(), ShouldEqual, 1)
+		})
+	})
+}
+
+// unencryptedHTTP2 makes a client speak HTTP/2 without TLS, as gRPC does
+func unencryptedHTTP2() *http.Protocols {
+	protocols := new(http.Protocols)
+	protocols.SetUnencryptedHTTP2(true)
+	return protocols
+}
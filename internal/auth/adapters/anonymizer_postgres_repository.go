@@ -0,0 +1,99 @@
+package adapters
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/semmidev/ethos-go/internal/auth/domain/user"
+	"github.com/semmidev/ethos-go/internal/common/database"
+	"github.com/semmidev/ethos-go/internal/common/email"
+)
+
+// AuditActionUserAnonymized is the audit log action of an anonymization
+const AuditActionUserAnonymized = "user.anonymized"
+
+// AnonymizerPostgresRepository implements user.Anonymizer
+type AnonymizerPostgresRepository struct {
+	db database.DBTX
+}
+
+var _ user.Anonymizer = (*AnonymizerPostgresRepository)(nil)
+
+// NewAnonymizerPostgresRepository creates a new anonymizer
+func NewAnonymizerPostgresRepository(db database.DBTX) *AnonymizerPostgresRepository {
+	return &AnonymizerPostgresRepository{db: db}
+}
+
+// Anonymize scrubs the user in a single statement, so it needs no
+// transaction of its own. Every other table is only touched when the user
+// row was, and habits, logs and their counts are left as they are.
+func (r *AnonymizerPostgresRepository) Anonymize(ctx context.Context, a user.Anonymization) error {
+	details, err := json.Marshal(map[string]string{"reason": a.Reason})
+	if err != nil {
+		return fmt.Errorf("marshal audit details: %w", err)
+	}
+
+	query := `
+		WITH target AS (
+			UPDATE users SET
+				name = $2,
+				email = $3,
+				avatar = NULL,
+				hashed_password = NULL,
+				auth_provider_id = NULL,
+				verify_code_hash = NULL,
+				verify_expires_at = NULL,
+				password_reset_code_hash = NULL,
+				password_reset_expires_at = NULL,
+				is_active = false,
+				updated_at = $4
+			WHERE user_id = $1
+			RETURNING user_id
+		), scrub_sessions AS (
+			UPDATE sessions SET user_agent = '', client_ip = '', city = '', country = '',
+				is_blocked = true, updated_at = $4
+			WHERE user_id IN (SELECT user_id FROM target)
+		), scrub_consents AS (
+			UPDATE consents SET client_ip = ''
+			WHERE user_id IN (SELECT user_id FROM target)
+		), scrub_notifications AS (
+			UPDATE notifications SET title = '', message = '', data = NULL
+			WHERE user_id IN (SELECT user_id FROM target)
+		), scrub_logs AS (
+			UPDATE habit_logs SET note = NULL
+			WHERE user_id IN (SELECT user_id FROM target) AND note IS NOT NULL
+		), scrub_vacations AS (
+			UPDATE habit_vacations SET reason = NULL
+			WHERE habit_id IN (SELECT habit_id FROM habits WHERE user_id IN (SELECT user_id FROM target))
+				AND reason IS NOT NULL
+		), rekey_emails AS (
+			UPDATE email_log SET recipient_hash = $6
+			WHERE recipient_hash = $5 AND EXISTS (SELECT 1 FROM target)
+		), audit AS (
+			INSERT INTO audit_log (actor, action, target_user_id, details, created_at)
+			SELECT $7, $8, user_id, $9, $4 FROM target
+		)
+		SELECT COUNT(*) FROM target
+	`
+
+	var rows int
+	err = r.db.GetContext(ctx, &rows, query,
+		a.UserID,
+		user.AnonymizedName,
+		user.AnonymizedEmail(a.UserID),
+		a.At,
+		email.HashRecipient(a.Email),
+		email.HashRecipient(user.AnonymizedEmail(a.UserID)),
+		a.Actor,
+		AuditActionUserAnonymized,
+		details,
+	)
+	if err != nil {
+		return fmt.Errorf("anonymize user: %w", err)
+	}
+	if rows == 0 {
+		return user.ErrNotFound
+	}
+	return nil
+}
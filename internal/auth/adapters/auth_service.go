@@ -64,5 +64,7 @@ func (s *AuthService) GetUserByID(ctx context.Context, userID string) (authctx.U
 	return authctx.User{
 		UserID: userID,
 		Email:  u.Email(),
+		Locale: u.Locale(),
+		Role:   u.Role(),
 	}, nil
 }
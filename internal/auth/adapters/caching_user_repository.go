@@ -0,0 +1,125 @@
+package adapters
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/semmidev/ethos-go/internal/auth/domain/user"
+)
+
+// CachingUserRepository adds a short-lived in-process cache to the user
+// lookup every authenticated request makes to check that the account is
+// still active. Commands keep reading through to the database; writes made
+// through this repository evict the user so changes show up immediately on
+// this instance, while other instances catch up within the TTL.
+type CachingUserRepository struct {
+	user.Repository
+	ttl time.Duration
+
+	mu         sync.Mutex
+	entries    map[uuid.UUID]cachedUser
+	generation uint64    // bumped on every eviction
+	nextSweep  time.Time // when expired entries are next removed
+}
+
+type cachedUser struct {
+	user      *user.User
+	expiresAt time.Time
+}
+
+// NewCachingUserRepository wraps repo with a cache holding users for ttl
+func NewCachingUserRepository(repo user.Repository, ttl time.Duration) *CachingUserRepository {
+	return &CachingUserRepository{
+		Repository: repo,
+		ttl:        ttl,
+		entries:    make(map[uuid.UUID]cachedUser),
+	}
+}
+
+// AuthReader returns the cached reader for authentication middleware. The
+// users it returns are shared between requests and must not be modified.
+func (r *CachingUserRepository) AuthReader() user.UserReader {
+	return cachedUserReader{r}
+}
+
+// Update saves the user and evicts it from the cache
+func (r *CachingUserRepository) Update(ctx context.Context, u *user.User) error {
+	defer r.evict(u.UserID())
+	return r.Repository.Update(ctx, u)
+}
+
+// Delete removes the user and evicts it from the cache
+func (r *CachingUserRepository) Delete(ctx context.Context, userID uuid.UUID) error {
+	defer r.evict(userID)
+	return r.Repository.Delete(ctx, userID)
+}
+
+func (r *CachingUserRepository) evict(userID uuid.UUID) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.entries, userID)
+	r.generation++
+}
+
+func (r *CachingUserRepository) findCached(ctx context.Context, userID uuid.UUID) (*user.User, error) {
+	r.mu.Lock()
+	entry, ok := r.entries[userID]
+	generation := r.generation
+	r.mu.Unlock()
+
+	if ok && time.Now().Before(entry.expiresAt) {
+		return entry.user, nil
+	}
+
+	u, err := r.Repository.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// A write during the lookup may have made u stale, so don't cache it
+	now := time.Now()
+	if r.generation == generation {
+		r.entries[userID] = cachedUser{user: u, expiresAt: now.Add(r.ttl)}
+	}
+	r.sweep(now)
+	return u, nil
+}
+
+// sweep removes expired entries, at most once per ttl, so users who stop
+// making requests don't stay in the cache. r.mu must be held.
+func (r *CachingUserRepository) sweep(now time.Time) {
+	if now.Before(r.nextSweep) {
+		return
+	}
+	for userID, entry := range r.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(r.entries, userID)
+		}
+	}
+	r.nextSweep = now.Add(r.ttl)
+}
+
+// Len returns the number of users cached, expired or not
+func (r *CachingUserRepository) Len() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.entries)
+}
+
+type cachedUserReader struct {
+	repo *CachingUserRepository
+}
+
+func (c cachedUserReader) FindByID(ctx context.Context, userID uuid.UUID) (*user.User, error) {
+	return c.repo.findCached(ctx, userID)
+}
+
+func (c cachedUserReader) FindByEmail(ctx context.Context, email string) (*user.User, error) {
+	return c.repo.FindByEmail(ctx, email)
+}
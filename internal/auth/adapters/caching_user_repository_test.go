@@ -0,0 +1,98 @@
+package adapters_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/auth/adapters"
+	"github.com/semmidev/ethos-go/internal/auth/domain/user"
+	"github.com/semmidev/ethos-go/internal/testutil"
+)
+
+// countingUserRepository counts the lookups that reach the database
+type countingUserRepository struct {
+	*testutil.UserRepository
+	lookups int
+}
+
+func (r *countingUserRepository) FindByID(ctx context.Context, userID uuid.UUID) (*user.User, error) {
+	r.lookups++
+	return r.UserRepository.FindByID(ctx, userID)
+}
+
+func TestCachingUserRepository(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given a user behind a caching repository", t, func() {
+		ctx := context.Background()
+		u := testutil.NewUserBuilder().Build()
+		db := &countingUserRepository{UserRepository: testutil.NewUserRepository(u)}
+		repo := adapters.NewCachingUserRepository(db, time.Minute)
+		reader := repo.AuthReader()
+
+		Convey("When the auth reader looks the user up repeatedly", func() {
+			for i := 0; i < 3; i++ {
+				found, err := reader.FindByID(ctx, u.UserID())
+				So(err, ShouldBeNil)
+				So(found.IsActive(), ShouldBeTrue)
+			}
+
+			Convey("Then only the first lookup reaches the database", func() {
+				So(db.lookups, ShouldEqual, 1)
+			})
+		})
+
+		Convey("When the account is deactivated through the repository", func() {
+			_, err := reader.FindByID(ctx, u.UserID())
+			So(err, ShouldBeNil)
+
+			changed, err := repo.FindByID(ctx, u.UserID())
+			So(err, ShouldBeNil)
+			changed.Deactivate(user.DeactivatedByUser)
+			So(repo.Update(ctx, changed), ShouldBeNil)
+
+			Convey("Then the auth reader sees the change right away", func() {
+				found, err := reader.FindByID(ctx, u.UserID())
+				So(err, ShouldBeNil)
+				So(found.IsActive(), ShouldBeFalse)
+			})
+		})
+
+		Convey("When the account is deleted", func() {
+			_, err := reader.FindByID(ctx, u.UserID())
+			So(err, ShouldBeNil)
+			So(repo.Delete(ctx, u.UserID()), ShouldBeNil)
+
+			Convey("Then the auth reader no longer finds it", func() {
+				_, err := reader.FindByID(ctx, u.UserID())
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given users who stop making requests", t, func() {
+		ctx := context.Background()
+		gone := testutil.NewUserBuilder().Build()
+		active := testutil.NewUserBuilder().Build()
+		repo := adapters.NewCachingUserRepository(testutil.NewUserRepository(gone, active), 10*time.Millisecond)
+		reader := repo.AuthReader()
+
+		_, err := reader.FindByID(ctx, gone.UserID())
+		So(err, ShouldBeNil)
+		So(repo.Len(), ShouldEqual, 1)
+
+		Convey("When another user is looked up after their entries expired", func() {
+			time.Sleep(20 * time.Millisecond)
+			_, err := reader.FindByID(ctx, active.UserID())
+			So(err, ShouldBeNil)
+
+			Convey("Then the expired entries are removed from the cache", func() {
+				So(repo.Len(), ShouldEqual, 1)
+			})
+		})
+	})
+}
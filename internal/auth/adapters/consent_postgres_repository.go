@@ -0,0 +1,70 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/semmidev/ethos-go/internal/auth/domain/consent"
+	"github.com/semmidev/ethos-go/internal/common/database"
+)
+
+// consentModel is the database representation of a Consent
+type consentModel struct {
+	UserID     uuid.UUID `db:"user_id"`
+	Document   string    `db:"document"`
+	Version    string    `db:"version"`
+	ClientIP   string    `db:"client_ip"`
+	AcceptedAt time.Time `db:"accepted_at"`
+}
+
+// ConsentPostgresRepository implements consent.Repository
+type ConsentPostgresRepository struct {
+	db database.DBTX
+}
+
+var _ consent.Repository = (*ConsentPostgresRepository)(nil)
+
+func NewConsentPostgresRepository(db database.DBTX) *ConsentPostgresRepository {
+	return &ConsentPostgresRepository{db: db}
+}
+
+func (r *ConsentPostgresRepository) Save(ctx context.Context, c *consent.Consent) error {
+	query := `
+		INSERT INTO consents (user_id, document, version, client_ip, accepted_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id, document, version) DO NOTHING
+	`
+	_, err := r.db.ExecContext(ctx, query,
+		c.UserID(),
+		c.Document(),
+		c.Version(),
+		c.ClientIP(),
+		c.AcceptedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("save consent: %w", err)
+	}
+	return nil
+}
+
+func (r *ConsentPostgresRepository) ListByUserID(ctx context.Context, userID uuid.UUID) ([]*consent.Consent, error) {
+	query := `
+		SELECT user_id, document, version, client_ip, accepted_at
+		FROM consents
+		WHERE user_id = $1
+		ORDER BY accepted_at DESC
+	`
+
+	var models []consentModel
+	if err := r.db.SelectContext(ctx, &models, query, userID); err != nil {
+		return nil, fmt.Errorf("list consents: %w", err)
+	}
+
+	consents := make([]*consent.Consent, len(models))
+	for i, m := range models {
+		consents[i] = consent.UnmarshalFromDatabase(m.UserID, m.Document, m.Version, m.ClientIP, m.AcceptedAt)
+	}
+	return consents, nil
+}
@@ -2,7 +2,9 @@ package adapters
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
+	"errors"
 	"time"
 
 	"github.com/semmidev/ethos-go/internal/auth/app/query"
@@ -21,7 +23,7 @@ func NewExportDataPostgresRepository(db database.DBTX) *ExportDataPostgresReposi
 
 // GetUserHabits fetches all habits for a user
 func (r *ExportDataPostgresRepository) GetUserHabits(ctx context.Context, userID string) ([]query.ExportedHabit, error) {
-	q := `SELECT habit_id, name, description, frequency, target_count, is_active, reminder_time, created_at
+	q := `SELECT habit_id, name, description, frequency, target_count, is_active, reminder_time, paused_until, created_at
 	      FROM habits WHERE user_id = $1 ORDER BY created_at`
 
 	rows, err := r.db.QueryxContext(ctx, q, userID)
@@ -33,14 +35,15 @@ func (r *ExportDataPostgresRepository) GetUserHabits(ctx context.Context, userID
 	var habits []query.ExportedHabit
 	for rows.Next() {
 		var h struct {
-			HabitID      string    `db:"habit_id"`
-			Name         string    `db:"name"`
-			Description  *string   `db:"description"`
-			Frequency    string    `db:"frequency"`
-			TargetCount  int       `db:"target_count"`
-			IsActive     bool      `db:"is_active"`
-			ReminderTime *string   `db:"reminder_time"`
-			CreatedAt    time.Time `db:"created_at"`
+			HabitID      string     `db:"habit_id"`
+			Name         string     `db:"name"`
+			Description  *string    `db:"description"`
+			Frequency    string     `db:"frequency"`
+			TargetCount  int        `db:"target_count"`
+			IsActive     bool       `db:"is_active"`
+			ReminderTime *string    `db:"reminder_time"`
+			PausedUntil  *time.Time `db:"paused_until"`
+			CreatedAt    time.Time  `db:"created_at"`
 		}
 		if err := rows.StructScan(&h); err != nil {
 			continue
@@ -53,6 +56,7 @@ func (r *ExportDataPostgresRepository) GetUserHabits(ctx context.Context, userID
 			TargetCount:  h.TargetCount,
 			IsActive:     h.IsActive,
 			ReminderTime: h.ReminderTime,
+			PausedUntil:  formatDate(h.PausedUntil),
 			CreatedAt:    h.CreatedAt,
 		})
 	}
@@ -95,6 +99,39 @@ func (r *ExportDataPostgresRepository) GetUserHabitLogs(ctx context.Context, use
 	return logs, nil
 }
 
+// GetUserHabitLogSummaries fetches the monthly summaries of a user's
+// compacted habit logs
+func (r *ExportDataPostgresRepository) GetUserHabitLogSummaries(ctx context.Context, userID string) ([]query.ExportedHabitLogSummary, error) {
+	q := `SELECT habit_id, month, days_logged, total_count
+	      FROM habit_log_monthly_summaries WHERE user_id = $1 ORDER BY month DESC, habit_id`
+
+	rows, err := r.db.QueryxContext(ctx, q, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var summaries []query.ExportedHabitLogSummary
+	for rows.Next() {
+		var s struct {
+			HabitID    string    `db:"habit_id"`
+			Month      time.Time `db:"month"`
+			DaysLogged int       `db:"days_logged"`
+			TotalCount int       `db:"total_count"`
+		}
+		if err := rows.StructScan(&s); err != nil {
+			continue
+		}
+		summaries = append(summaries, query.ExportedHabitLogSummary{
+			HabitID:    s.HabitID,
+			Month:      s.Month.Format("2006-01"),
+			DaysLogged: s.DaysLogged,
+			TotalCount: s.TotalCount,
+		})
+	}
+	return summaries, nil
+}
+
 // GetUserNotifications fetches all notifications for a user
 func (r *ExportDataPostgresRepository) GetUserNotifications(ctx context.Context, userID string) ([]query.ExportedNotif, error) {
 	q := `SELECT notification_id, type, title, message, data, is_read, created_at
@@ -132,3 +169,230 @@ func (r *ExportDataPostgresRepository) GetUserNotifications(ctx context.Context,
 	}
 	return notifications, nil
 }
+
+// GetUserSessions fetches all login sessions for a user
+func (r *ExportDataPostgresRepository) GetUserSessions(ctx context.Context, userID string) ([]query.ExportedSession, error) {
+	q := `SELECT session_id, user_agent, client_ip, city, country, is_blocked, expires_at, created_at, updated_at
+	      FROM sessions WHERE user_id = $1 ORDER BY created_at DESC`
+
+	rows, err := r.db.QueryxContext(ctx, q, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sessions []query.ExportedSession
+	for rows.Next() {
+		var s struct {
+			SessionID string    `db:"session_id"`
+			UserAgent string    `db:"user_agent"`
+			ClientIP  string    `db:"client_ip"`
+			City      string    `db:"city"`
+			Country   string    `db:"country"`
+			IsBlocked bool      `db:"is_blocked"`
+			ExpiresAt time.Time `db:"expires_at"`
+			CreatedAt time.Time `db:"created_at"`
+			UpdatedAt time.Time `db:"updated_at"`
+		}
+		if err := rows.StructScan(&s); err != nil {
+			continue
+		}
+		sessions = append(sessions, query.ExportedSession{
+			ID:        s.SessionID,
+			UserAgent: s.UserAgent,
+			ClientIP:  s.ClientIP,
+			City:      s.City,
+			Country:   s.Country,
+			IsBlocked: s.IsBlocked,
+			ExpiresAt: s.ExpiresAt,
+			CreatedAt: s.CreatedAt,
+			UpdatedAt: s.UpdatedAt,
+		})
+	}
+	return sessions, nil
+}
+
+// GetUserConsents fetches every legal document version a user accepted
+func (r *ExportDataPostgresRepository) GetUserConsents(ctx context.Context, userID string) ([]query.ExportedConsent, error) {
+	q := `SELECT document, version, client_ip, accepted_at
+	      FROM consents WHERE user_id = $1 ORDER BY accepted_at DESC`
+
+	rows, err := r.db.QueryxContext(ctx, q, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var consents []query.ExportedConsent
+	for rows.Next() {
+		var c struct {
+			Document   string    `db:"document"`
+			Version    string    `db:"version"`
+			ClientIP   string    `db:"client_ip"`
+			AcceptedAt time.Time `db:"accepted_at"`
+		}
+		if err := rows.StructScan(&c); err != nil {
+			continue
+		}
+		consents = append(consents, query.ExportedConsent{
+			Document:   c.Document,
+			Version:    c.Version,
+			ClientIP:   c.ClientIP,
+			AcceptedAt: c.AcceptedAt,
+		})
+	}
+	return consents, nil
+}
+
+// GetUserHabitStats fetches the stored statistics of every habit of a user
+func (r *ExportDataPostgresRepository) GetUserHabitStats(ctx context.Context, userID string) ([]query.ExportedHabitStats, error) {
+	q := `SELECT s.habit_id, s.current_streak, s.longest_streak, s.total_completions,
+	             s.last_completed_at, COALESCE(s.consistency_score, 0) AS consistency_score, s.updated_at
+	      FROM habit_stats s
+	      JOIN habits h ON h.habit_id = s.habit_id
+	      WHERE h.user_id = $1 ORDER BY h.created_at`
+
+	rows, err := r.db.QueryxContext(ctx, q, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var stats []query.ExportedHabitStats
+	for rows.Next() {
+		var s struct {
+			HabitID          string     `db:"habit_id"`
+			CurrentStreak    int        `db:"current_streak"`
+			LongestStreak    int        `db:"longest_streak"`
+			TotalCompletions int        `db:"total_completions"`
+			LastCompletedAt  *time.Time `db:"last_completed_at"`
+			ConsistencyScore float64    `db:"consistency_score"`
+			UpdatedAt        time.Time  `db:"updated_at"`
+		}
+		if err := rows.StructScan(&s); err != nil {
+			continue
+		}
+		stats = append(stats, query.ExportedHabitStats{
+			HabitID:          s.HabitID,
+			CurrentStreak:    s.CurrentStreak,
+			LongestStreak:    s.LongestStreak,
+			TotalCompletions: s.TotalCompletions,
+			LastCompletedAt:  formatDate(s.LastCompletedAt),
+			ConsistencyScore: s.ConsistencyScore,
+			UpdatedAt:        s.UpdatedAt,
+		})
+	}
+	return stats, nil
+}
+
+// GetUserHabitVacations fetches all vacations of a user's habits
+func (r *ExportDataPostgresRepository) GetUserHabitVacations(ctx context.Context, userID string) ([]query.ExportedVacation, error) {
+	q := `SELECT v.id, v.habit_id, v.start_date, v.end_date, v.reason, v.created_at
+	      FROM habit_vacations v
+	      JOIN habits h ON h.habit_id = v.habit_id
+	      WHERE h.user_id = $1 ORDER BY v.start_date DESC`
+
+	rows, err := r.db.QueryxContext(ctx, q, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var vacations []query.ExportedVacation
+	for rows.Next() {
+		var v struct {
+			ID        string     `db:"id"`
+			HabitID   string     `db:"habit_id"`
+			StartDate time.Time  `db:"start_date"`
+			EndDate   *time.Time `db:"end_date"`
+			Reason    *string    `db:"reason"`
+			CreatedAt time.Time  `db:"created_at"`
+		}
+		if err := rows.StructScan(&v); err != nil {
+			continue
+		}
+		vacations = append(vacations, query.ExportedVacation{
+			ID:        v.ID,
+			HabitID:   v.HabitID,
+			StartDate: v.StartDate.Format("2006-01-02"),
+			EndDate:   formatDate(v.EndDate),
+			Reason:    v.Reason,
+			CreatedAt: v.CreatedAt,
+		})
+	}
+	return vacations, nil
+}
+
+// GetUserNotificationPreferences fetches a user's notification settings
+func (r *ExportDataPostgresRepository) GetUserNotificationPreferences(ctx context.Context, userID string) (*query.ExportedNotifPreferences, error) {
+	q := `SELECT daily_summary, weekly_report, reengagement, quiet_hours_start, quiet_hours_end,
+	             do_not_disturb, do_not_disturb_until, updated_at
+	      FROM notification_preferences WHERE user_id = $1`
+
+	var p struct {
+		DailySummary      bool       `db:"daily_summary"`
+		WeeklyReport      bool       `db:"weekly_report"`
+		Reengagement      bool       `db:"reengagement"`
+		QuietHoursStart   *string    `db:"quiet_hours_start"`
+		QuietHoursEnd     *string    `db:"quiet_hours_end"`
+		DoNotDisturb      bool       `db:"do_not_disturb"`
+		DoNotDisturbUntil *time.Time `db:"do_not_disturb_until"`
+		UpdatedAt         time.Time  `db:"updated_at"`
+	}
+	if err := r.db.GetContext(ctx, &p, q, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &query.ExportedNotifPreferences{
+		DailySummary:      p.DailySummary,
+		WeeklyReport:      p.WeeklyReport,
+		Reengagement:      p.Reengagement,
+		QuietHoursStart:   p.QuietHoursStart,
+		QuietHoursEnd:     p.QuietHoursEnd,
+		DoNotDisturb:      p.DoNotDisturb,
+		DoNotDisturbUntil: p.DoNotDisturbUntil,
+		UpdatedAt:         p.UpdatedAt,
+	}, nil
+}
+
+// GetUserNotificationDeliveries fetches the scheduled notification sends of a user
+func (r *ExportDataPostgresRepository) GetUserNotificationDeliveries(ctx context.Context, userID string) ([]query.ExportedDelivery, error) {
+	q := `SELECT kind, period_date, created_at
+	      FROM notification_deliveries WHERE user_id = $1 ORDER BY period_date DESC, kind`
+
+	rows, err := r.db.QueryxContext(ctx, q, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var deliveries []query.ExportedDelivery
+	for rows.Next() {
+		var d struct {
+			Kind       string    `db:"kind"`
+			PeriodDate time.Time `db:"period_date"`
+			CreatedAt  time.Time `db:"created_at"`
+		}
+		if err := rows.StructScan(&d); err != nil {
+			continue
+		}
+		deliveries = append(deliveries, query.ExportedDelivery{
+			Kind:       d.Kind,
+			PeriodDate: d.PeriodDate.Format("2006-01-02"),
+			CreatedAt:  d.CreatedAt,
+		})
+	}
+	return deliveries, nil
+}
+
+// formatDate renders an optional DATE column as YYYY-MM-DD
+func formatDate(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := t.Format("2006-01-02")
+	return &s
+}
@@ -0,0 +1,90 @@
+// Package geoip locates session client IPs with a MaxMind GeoIP2 or
+// GeoLite2 City database.
+package geoip
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+	"os"
+
+	"github.com/semmidev/ethos-go/internal/auth/domain/session"
+)
+
+// Locator looks up IPs in a MaxMind City database loaded into memory
+type Locator struct {
+	db *reader
+}
+
+// Open loads the .mmdb file at path
+func Open(path string) (*Locator, error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read GeoIP database: %w", err)
+	}
+	return New(buf)
+}
+
+// New reads a database from the bytes of a .mmdb file
+func New(buf []byte) (*Locator, error) {
+	db, err := newReader(buf)
+	if err != nil {
+		return nil, err
+	}
+	return &Locator{db: db}, nil
+}
+
+// Locate returns the English city and country names for clientIP. Private,
+// loopback and unparseable addresses have no location.
+func (l *Locator) Locate(_ context.Context, clientIP string) (session.Location, error) {
+	addr, ok := parseIP(clientIP)
+	if !ok || addr.IsPrivate() || addr.IsLoopback() || addr.IsUnspecified() {
+		return session.Location{}, nil
+	}
+
+	record, err := l.db.lookup(addr)
+	if err != nil || record == nil {
+		return session.Location{}, err
+	}
+
+	country := englishName(record, "country")
+	if country == "" {
+		country = englishName(record, "registered_country")
+	}
+	return session.Location{
+		City:    englishName(record, "city"),
+		Country: country,
+	}, nil
+}
+
+// parseIP accepts a bare IP or a host:port peer address
+func parseIP(clientIP string) (netip.Addr, bool) {
+	if host, _, err := net.SplitHostPort(clientIP); err == nil {
+		clientIP = host
+	}
+	addr, err := netip.ParseAddr(clientIP)
+	return addr, err == nil
+}
+
+// englishName reads record[key].names.en
+func englishName(record any, key string) string {
+	path := []string{key, "names", "en"}
+	for _, k := range path {
+		m, ok := record.(map[string]any)
+		if !ok {
+			return ""
+		}
+		record = m[k]
+	}
+	name, _ := record.(string)
+	return name
+}
+
+// NopLocator is used when no GeoIP database is configured
+type NopLocator struct{}
+
+// Locate always returns an empty location
+func (NopLocator) Locate(context.Context, string) (session.Location, error) {
+	return session.Location{}, nil
+}
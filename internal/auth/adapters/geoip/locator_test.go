@@ -0,0 +1,136 @@
+package geoip_test
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/auth/adapters/geoip"
+	"github.com/semmidev/ethos-go/internal/auth/domain/session"
+)
+
+// mmdb encodes the subset of the MaxMind DB format the test database needs
+type mmdb struct{ bytes.Buffer }
+
+func (m *mmdb) control(typ, size int) {
+	if typ <= 7 {
+		m.WriteByte(byte(typ<<5 | size))
+		return
+	}
+	m.WriteByte(byte(size))
+	m.WriteByte(byte(typ - 7))
+}
+
+func (m *mmdb) str(s string) {
+	m.control(2, len(s))
+	m.WriteString(s)
+}
+
+func (m *mmdb) uint16(n int) {
+	m.control(5, 2)
+	m.WriteByte(byte(n >> 8))
+	m.WriteByte(byte(n))
+}
+
+// pointer refers to the value at offset in the data section
+func (m *mmdb) pointer(offset int) {
+	m.WriteByte(1<<5 | byte(offset>>8))
+	m.WriteByte(byte(offset))
+}
+
+func (m *mmdb) object(fields map[string]func()) {
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	m.control(7, len(keys))
+	for _, k := range keys {
+		m.str(k)
+		fields[k]()
+	}
+}
+
+// testDatabase builds an IPv4 database with a single node: 0.0.0.0/1 is in
+// Jakarta, Indonesia and 128.0.0.0/1 has no data.
+func testDatabase() []byte {
+	const nodeCount = 1
+
+	var data mmdb
+	data.str("Indonesia") // shared value at offset 0, reached through a pointer
+	recordOffset := data.Len()
+	data.object(map[string]func(){
+		"city": func() {
+			data.object(map[string]func(){
+				"names": func() { data.object(map[string]func(){"en": func() { data.str("Jakarta") }}) },
+			})
+		},
+		"country": func() {
+			data.object(map[string]func(){
+				"names": func() { data.object(map[string]func(){"en": func() { data.pointer(0) }}) },
+			})
+		},
+	})
+
+	var db mmdb
+	left := nodeCount + 16 + recordOffset
+	db.Write([]byte{byte(left >> 16), byte(left >> 8), byte(left)}) // 24-bit records
+	db.Write([]byte{0, 0, nodeCount})
+	db.Write(make([]byte, 16))
+	db.Write(data.Bytes())
+	db.WriteString("\xAB\xCD\xEFMaxMind.com")
+	db.object(map[string]func(){
+		"node_count":  func() { db.uint16(nodeCount) },
+		"record_size": func() { db.uint16(24) },
+		"ip_version":  func() { db.uint16(4) },
+	})
+	return db.Bytes()
+}
+
+func TestLocator(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given a GeoIP database", t, func() {
+		ctx := context.Background()
+		locator, err := geoip.New(testDatabase())
+		So(err, ShouldBeNil)
+
+		Convey("When a public IP in the database is located", func() {
+			location, err := locator.Locate(ctx, "36.68.1.1")
+
+			Convey("Then its city and country are returned", func() {
+				So(err, ShouldBeNil)
+				So(location, ShouldResemble, session.Location{City: "Jakarta", Country: "Indonesia"})
+			})
+		})
+
+		Convey("When the IP comes with a port, as peer addresses do", func() {
+			location, err := locator.Locate(ctx, "36.68.1.1:52814")
+			So(err, ShouldBeNil)
+			So(location.City, ShouldEqual, "Jakarta")
+		})
+
+		Convey("When the IP is not in the database", func() {
+			location, err := locator.Locate(ctx, "200.1.1.1")
+			So(err, ShouldBeNil)
+			So(location.IsZero(), ShouldBeTrue)
+		})
+
+		Convey("When the IP is private or unparseable", func() {
+			for _, ip := range []string{"10.0.0.1", "127.0.0.1", "unknown"} {
+				location, err := locator.Locate(ctx, ip)
+				So(err, ShouldBeNil)
+				So(location.IsZero(), ShouldBeTrue)
+			}
+		})
+	})
+
+	Convey("Given a file that is not a MaxMind database", t, func() {
+		_, err := geoip.New([]byte("not a database"))
+		So(err, ShouldNotBeNil)
+	})
+}
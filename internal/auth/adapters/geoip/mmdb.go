@@ -0,0 +1,331 @@
+package geoip
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"net/netip"
+)
+
+// This file reads MaxMind DB (.mmdb) files such as GeoLite2-City, following
+// https://maxmind.github.io/MaxMind-DB/. Only lookups are supported.
+
+// metadataMarker precedes the metadata map at the end of the file
+var metadataMarker = []byte("\xAB\xCD\xEFMaxMind.com")
+
+// dataSectionSeparator is the run of zero bytes between tree and data section
+const dataSectionSeparator = 16
+
+var errInvalidDatabase = errors.New("invalid MaxMind database")
+
+// Data section field types
+const (
+	typeExtended  = 0
+	typePointer   = 1
+	typeString    = 2
+	typeDouble    = 3
+	typeBytes     = 4
+	typeUint16    = 5
+	typeUint32    = 6
+	typeMap       = 7
+	typeInt32     = 8
+	typeUint64    = 9
+	typeUint128   = 10
+	typeArray     = 11
+	typeContainer = 12
+	typeEndMarker = 13
+	typeBool      = 14
+	typeFloat     = 15
+)
+
+// reader looks up records in an in-memory MaxMind database
+type reader struct {
+	tree       []byte
+	data       []byte
+	nodeCount  uint
+	recordSize uint
+	ipVersion  uint
+	ipv4Start  uint // node IPv4 lookups start from in an IPv6 tree
+}
+
+func newReader(buf []byte) (*reader, error) {
+	markerAt := bytes.LastIndex(buf, metadataMarker)
+	if markerAt < 0 {
+		return nil, fmt.Errorf("%w: metadata not found", errInvalidDatabase)
+	}
+
+	metaDecoder := decoder{buf: buf[markerAt+len(metadataMarker):]}
+	raw, _, err := metaDecoder.decode(0)
+	if err != nil {
+		return nil, fmt.Errorf("%w: metadata: %v", errInvalidDatabase, err)
+	}
+	meta, ok := raw.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%w: metadata is not a map", errInvalidDatabase)
+	}
+
+	r := &reader{
+		nodeCount:  uintField(meta, "node_count"),
+		recordSize: uintField(meta, "record_size"),
+		ipVersion:  uintField(meta, "ip_version"),
+	}
+	if r.recordSize != 24 && r.recordSize != 28 && r.recordSize != 32 {
+		return nil, fmt.Errorf("%w: unsupported record size %d", errInvalidDatabase, r.recordSize)
+	}
+
+	treeSize := r.nodeCount * r.recordSize / 4
+	if treeSize+dataSectionSeparator > uint(markerAt) {
+		return nil, fmt.Errorf("%w: search tree exceeds file", errInvalidDatabase)
+	}
+	r.tree = buf[:treeSize]
+	r.data = buf[treeSize+dataSectionSeparator : markerAt]
+
+	// IPv4 addresses live under ::/96 in IPv6 databases
+	if r.ipVersion == 6 {
+		node := uint(0)
+		for i := 0; i < 96 && node < r.nodeCount; i++ {
+			node = r.record(node, 0)
+		}
+		r.ipv4Start = node
+	}
+
+	return r, nil
+}
+
+// lookup returns the record for addr, or nil when the database has none
+func (r *reader) lookup(addr netip.Addr) (any, error) {
+	addr = addr.Unmap()
+
+	var ip []byte
+	node := uint(0)
+	switch {
+	case addr.Is4() && r.ipVersion == 6:
+		a := addr.As4()
+		ip, node = a[:], r.ipv4Start
+	case addr.Is4():
+		a := addr.As4()
+		ip = a[:]
+	case r.ipVersion == 6:
+		a := addr.As16()
+		ip = a[:]
+	default:
+		return nil, nil // IPv6 address in an IPv4-only database
+	}
+
+	for i := 0; i < len(ip)*8 && node < r.nodeCount; i++ {
+		bit := uint(ip[i>>3]>>(7-uint(i&7))) & 1
+		node = r.record(node, bit)
+	}
+
+	if node == r.nodeCount {
+		return nil, nil
+	}
+	if node < r.nodeCount {
+		return nil, fmt.Errorf("%w: lookup ended inside the tree", errInvalidDatabase)
+	}
+
+	offset := node - r.nodeCount - dataSectionSeparator
+	d := decoder{buf: r.data}
+	value, _, err := d.decode(offset)
+	return value, err
+}
+
+// record reads the left (bit 0) or right (bit 1) record of a tree node
+func (r *reader) record(node, bit uint) uint {
+	b := r.tree[node*r.recordSize/4:]
+	switch r.recordSize {
+	case 24:
+		if bit == 1 {
+			b = b[3:]
+		}
+		return uint(b[0])<<16 | uint(b[1])<<8 | uint(b[2])
+	case 28:
+		if bit == 0 {
+			return uint(b[3]&0xF0)<<20 | uint(b[0])<<16 | uint(b[1])<<8 | uint(b[2])
+		}
+		return uint(b[3]&0x0F)<<24 | uint(b[4])<<16 | uint(b[5])<<8 | uint(b[6])
+	default:
+		if bit == 1 {
+			b = b[4:]
+		}
+		return uint(binary.BigEndian.Uint32(b))
+	}
+}
+
+// decoder decodes values from a data section, where pointers are offsets
+// from the start of buf
+type decoder struct {
+	buf []byte
+}
+
+// decode returns the value at offset and the offset right after it
+func (d decoder) decode(offset uint) (any, uint, error) {
+	typ, size, offset, err := d.control(offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if typ == typePointer {
+		value, _, err := d.decode(size)
+		return value, offset, err
+	}
+
+	switch typ {
+	case typeMap:
+		return d.decodeMap(size, offset)
+	case typeArray:
+		return d.decodeArray(size, offset)
+	case typeBool:
+		return size != 0, offset, nil
+	}
+
+	field, next, err := d.bytes(offset, size)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	switch typ {
+	case typeString:
+		return string(field), next, nil
+	case typeDouble:
+		if size != 8 {
+			return nil, 0, fmt.Errorf("%w: double of size %d", errInvalidDatabase, size)
+		}
+		return math.Float64frombits(binary.BigEndian.Uint64(field)), next, nil
+	case typeFloat:
+		if size != 4 {
+			return nil, 0, fmt.Errorf("%w: float of size %d", errInvalidDatabase, size)
+		}
+		return float64(math.Float32frombits(binary.BigEndian.Uint32(field))), next, nil
+	case typeBytes, typeUint128:
+		return append([]byte(nil), field...), next, nil
+	case typeUint16, typeUint32, typeUint64:
+		var n uint64
+		for _, b := range field {
+			n = n<<8 | uint64(b)
+		}
+		return n, next, nil
+	case typeInt32:
+		var n uint32
+		for _, b := range field {
+			n = n<<8 | uint32(b)
+		}
+		return int64(int32(n)), next, nil
+	default:
+		return nil, 0, fmt.Errorf("%w: unexpected type %d", errInvalidDatabase, typ)
+	}
+}
+
+func (d decoder) decodeMap(size, offset uint) (any, uint, error) {
+	m := make(map[string]any, size)
+	for i := uint(0); i < size; i++ {
+		key, next, err := d.decode(offset)
+		if err != nil {
+			return nil, 0, err
+		}
+		k, ok := key.(string)
+		if !ok {
+			return nil, 0, fmt.Errorf("%w: map key is not a string", errInvalidDatabase)
+		}
+		if m[k], offset, err = d.decode(next); err != nil {
+			return nil, 0, err
+		}
+	}
+	return m, offset, nil
+}
+
+func (d decoder) decodeArray(size, offset uint) (any, uint, error) {
+	a := make([]any, size)
+	for i := range a {
+		var err error
+		if a[i], offset, err = d.decode(offset); err != nil {
+			return nil, 0, err
+		}
+	}
+	return a, offset, nil
+}
+
+// control reads a field's control byte(s). For pointers, size is the
+// target offset.
+func (d decoder) control(offset uint) (typ, size, next uint, err error) {
+	b, offset, err := d.bytes(offset, 1)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	ctrl := uint(b[0])
+	typ = ctrl >> 5
+
+	if typ == typePointer {
+		return d.pointer(ctrl, offset)
+	}
+
+	if typ == typeExtended {
+		b, offset, err = d.bytes(offset, 1)
+		if err != nil {
+			return 0, 0, 0, err
+		}
+		typ = 7 + uint(b[0])
+	}
+
+	size = ctrl & 0x1f
+	if size < 29 {
+		return typ, size, offset, nil
+	}
+
+	extra := size - 28
+	b, offset, err = d.bytes(offset, extra)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	var n uint
+	for _, c := range b {
+		n = n<<8 | uint(c)
+	}
+	switch extra {
+	case 1:
+		size = 29 + n
+	case 2:
+		size = 285 + n
+	default:
+		size = 65821 + n
+	}
+	return typ, size, offset, nil
+}
+
+func (d decoder) pointer(ctrl, offset uint) (typ, target, next uint, err error) {
+	sizeBits := (ctrl >> 3) & 0x3
+	b, offset, err := d.bytes(offset, sizeBits+1)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	var n uint
+	if sizeBits < 3 {
+		n = ctrl & 0x7
+	}
+	for _, c := range b {
+		n = n<<8 | uint(c)
+	}
+
+	switch sizeBits {
+	case 1:
+		n += 2048
+	case 2:
+		n += 526336
+	}
+	return typePointer, n, offset, nil
+}
+
+func (d decoder) bytes(offset, n uint) ([]byte, uint, error) {
+	if offset+n > uint(len(d.buf)) {
+		return nil, 0, fmt.Errorf("%w: unexpected end of data", errInvalidDatabase)
+	}
+	return d.buf[offset : offset+n], offset + n, nil
+}
+
+func uintField(m map[string]any, key string) uint {
+	n, _ := m[key].(uint64)
+	return uint(n)
+}
@@ -3,10 +3,14 @@ package google
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/google"
+
+	"github.com/semmidev/ethos-go/internal/common/breaker"
 )
 
 type UserInfo struct {
@@ -20,11 +24,18 @@ type UserInfo struct {
 }
 
 type Service struct {
-	config *oauth2.Config
+	config  *oauth2.Config
+	breaker *breaker.Breaker
 }
 
-func NewService(clientID, clientSecret, callbackURL string) *Service {
+// NewService creates the Google sign-in client. Calls to Google go through
+// b, which should judge errors with IsServiceFailure.
+func NewService(clientID, clientSecret, callbackURL string, b *breaker.Breaker) *Service {
+	if b == nil {
+		panic("nil circuit breaker")
+	}
 	return &Service{
+		breaker: b,
 		config: &oauth2.Config{
 			ClientID:     clientID,
 			ClientSecret: clientSecret,
@@ -42,21 +53,37 @@ func (s *Service) GetLoginURL(state string) string {
 	return s.config.AuthCodeURL(state, oauth2.AccessTypeOffline)
 }
 
+// GetUserInfo exchanges the authorization code and fetches the profile of
+// the user who signed in. While Google keeps failing it fails at once with
+// an error wrapping breaker.ErrOpen.
 func (s *Service) GetUserInfo(ctx context.Context, code string) (*UserInfo, error) {
+	var userInfo *UserInfo
+	err := s.breaker.Do(ctx, func(ctx context.Context) error {
+		var err error
+		userInfo, err = s.getUserInfo(ctx, code)
+		return err
+	})
+	return userInfo, err
+}
+
+func (s *Service) getUserInfo(ctx context.Context, code string) (*UserInfo, error) {
 	token, err := s.config.Exchange(ctx, code)
 	if err != nil {
 		return nil, fmt.Errorf("failed to exchange code: %w", err)
 	}
 
-	client := s.config.Client(ctx, token)
-	resp, err := client.Get("https://www.googleapis.com/oauth2/v2/userinfo")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://www.googleapis.com/oauth2/v2/userinfo", nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.config.Client(ctx, token).Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user info: %w", err)
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("failed to get user info, status: %d", resp.StatusCode)
+	if resp.StatusCode != http.StatusOK {
+		return nil, &statusError{statusCode: resp.StatusCode}
 	}
 
 	var userInfo UserInfo
@@ -66,3 +93,27 @@ func (s *Service) GetUserInfo(ctx context.Context, code string) (*UserInfo, erro
 
 	return &userInfo, nil
 }
+
+// statusError is an unexpected response from the user info endpoint
+type statusError struct {
+	statusCode int
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("failed to get user info, status: %d", e.statusCode)
+}
+
+// IsServiceFailure tells Google failing apart from it rejecting the
+// request, such as an expired or reused code, which says nothing about
+// its health
+func IsServiceFailure(err error) bool {
+	var retrieveErr *oauth2.RetrieveError
+	if errors.As(err, &retrieveErr) && retrieveErr.Response != nil {
+		return retrieveErr.Response.StatusCode >= http.StatusInternalServerError
+	}
+	var statusErr *statusError
+	if errors.As(err, &statusErr) {
+		return statusErr.statusCode >= http.StatusInternalServerError
+	}
+	return true
+}
@@ -0,0 +1,254 @@
+package adapters
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/semmidev/ethos-go/config"
+)
+
+// jwtKey is a single signing or verification key identified by its kid.
+type jwtKey struct {
+	id     string
+	method jwt.SigningMethod
+	// signKey is nil for verify-only keys (e.g. a public key of a retired
+	// signing key kept around until its tokens expire).
+	signKey   any
+	verifyKey any
+}
+
+// jwtKeySet holds the active signing key plus every key that is still
+// accepted for verification during a rotation window.
+//
+// Rotation: add the new key, point AUTH_JWT_KEY_ID at it and keep the old
+// one (in AUTH_JWT_PREVIOUS_KEYS or AUTH_JWT_KEYS_DIR) until the longest
+// token lifetime has passed, then remove it.
+type jwtKeySet struct {
+	active *jwtKey
+	byID   map[string]*jwtKey
+	// ordered lists keys by kid for stable iteration
+	ordered []*jwtKey
+}
+
+func loadJWTKeySet(cfg *config.Config) (*jwtKeySet, error) {
+	ks := &jwtKeySet{byID: make(map[string]*jwtKey)}
+
+	switch cfg.AuthJWTAlgorithm {
+	case "", "HS256":
+		if err := ks.add(hmacKey(cfg.AuthJWTKeyID, cfg.AuthJWTSecret)); err != nil {
+			return nil, err
+		}
+	case "RS256", "EdDSA":
+		if err := ks.loadDir(cfg.AuthJWTKeysDir); err != nil {
+			return nil, err
+		}
+		// The shared secret, if still configured, keeps verifying tokens
+		// issued before the switch to asymmetric signing.
+		if cfg.AuthJWTSecret != "" {
+			legacy := hmacKey("hs256-legacy", cfg.AuthJWTSecret)
+			legacy.signKey = nil
+			if err := ks.add(legacy); err != nil {
+				return nil, err
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unsupported JWT algorithm %q", cfg.AuthJWTAlgorithm)
+	}
+
+	previous, err := parsePreviousKeys(cfg.AuthJWTPreviousKeys)
+	if err != nil {
+		return nil, err
+	}
+	for _, k := range previous {
+		if err := ks.add(k); err != nil {
+			return nil, err
+		}
+	}
+
+	active, ok := ks.byID[cfg.AuthJWTKeyID]
+	if !ok {
+		return nil, fmt.Errorf("JWT signing key %q not found", cfg.AuthJWTKeyID)
+	}
+	if active.signKey == nil {
+		return nil, fmt.Errorf("JWT signing key %q has no private key", cfg.AuthJWTKeyID)
+	}
+	if alg := cfg.AuthJWTAlgorithm; alg != "" && active.method.Alg() != alg {
+		return nil, fmt.Errorf("JWT signing key %q is %s, expected %s", active.id, active.method.Alg(), alg)
+	}
+	ks.active = active
+
+	sort.Slice(ks.ordered, func(i, j int) bool { return ks.ordered[i].id < ks.ordered[j].id })
+	return ks, nil
+}
+
+func (ks *jwtKeySet) add(k *jwtKey) error {
+	if _, exists := ks.byID[k.id]; exists {
+		return fmt.Errorf("duplicate JWT key id %q", k.id)
+	}
+	ks.byID[k.id] = k
+	ks.ordered = append(ks.ordered, k)
+	return nil
+}
+
+// loadDir reads every <kid>.pem file in dir. Private keys can sign and
+// verify; public keys only verify.
+func (ks *jwtKeySet) loadDir(dir string) error {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return err
+	}
+	if len(paths) == 0 {
+		return fmt.Errorf("no *.pem keys found in %s", dir)
+	}
+
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		kid := strings.TrimSuffix(filepath.Base(path), ".pem")
+		key, err := parsePEMKey(kid, data)
+		if err != nil {
+			return fmt.Errorf("load JWT key %s: %w", path, err)
+		}
+		if err := ks.add(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// keyFunc resolves the verification key for a parsed token. Tokens without
+// a kid predate key rotation and are matched against every key using the
+// token's algorithm.
+func (ks *jwtKeySet) keyFunc(token *jwt.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+	if kid != "" {
+		k, ok := ks.byID[kid]
+		if !ok {
+			return nil, fmt.Errorf("unknown key id %q", kid)
+		}
+		if token.Method.Alg() != k.method.Alg() {
+			return nil, fmt.Errorf("unexpected signing method %v for key %q", token.Header["alg"], kid)
+		}
+		return k.verifyKey, nil
+	}
+
+	var keys jwt.VerificationKeySet
+	for _, k := range ks.ordered {
+		if k.method.Alg() == token.Method.Alg() {
+			keys.Keys = append(keys.Keys, k.verifyKey)
+		}
+	}
+	if len(keys.Keys) == 0 {
+		return nil, fmt.Errorf("unexpected signing method %v", token.Header["alg"])
+	}
+	return keys, nil
+}
+
+// sign signs claims with the active key and stamps its kid in the header.
+func (ks *jwtKeySet) sign(claims jwt.Claims) (string, error) {
+	tok := jwt.NewWithClaims(ks.active.method, claims)
+	tok.Header["kid"] = ks.active.id
+	return tok.SignedString(ks.active.signKey)
+}
+
+func hmacKey(kid, secret string) *jwtKey {
+	return &jwtKey{
+		id:        kid,
+		method:    jwt.SigningMethodHS256,
+		signKey:   []byte(secret),
+		verifyKey: []byte(secret),
+	}
+}
+
+// parsePreviousKeys parses AUTH_JWT_PREVIOUS_KEYS ("kid=secret,kid=secret")
+// into verify-only HMAC keys.
+func parsePreviousKeys(raw string) ([]*jwtKey, error) {
+	var keys []*jwtKey
+	for entry := range strings.SplitSeq(raw, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		kid, secret, ok := strings.Cut(entry, "=")
+		if !ok || kid == "" {
+			return nil, errors.New("AUTH_JWT_PREVIOUS_KEYS entries must look like kid=secret")
+		}
+		if len(secret) < 32 {
+			return nil, fmt.Errorf("previous JWT key %q must be at least 32 characters", kid)
+		}
+		k := hmacKey(kid, secret)
+		k.signKey = nil
+		keys = append(keys, k)
+	}
+	return keys, nil
+}
+
+// parsePEMKey accepts PKCS#8/PKCS#1 private keys and PKIX public keys for
+// RSA and Ed25519.
+func parsePEMKey(kid string, data []byte) (*jwtKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+
+	var parsed any
+	var err error
+	switch block.Type {
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		parsed, err = x509.ParsePKIXPublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block %q", block.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	k := &jwtKey{id: kid}
+	switch key := parsed.(type) {
+	case *rsa.PrivateKey:
+		k.method, k.signKey, k.verifyKey = jwt.SigningMethodRS256, key, key.Public()
+	case *rsa.PublicKey:
+		k.method, k.verifyKey = jwt.SigningMethodRS256, key
+	case ed25519.PrivateKey:
+		k.method, k.signKey, k.verifyKey = jwt.SigningMethodEdDSA, key, key.Public()
+	case ed25519.PublicKey:
+		k.method, k.verifyKey = jwt.SigningMethodEdDSA, key
+	default:
+		return nil, fmt.Errorf("unsupported key type %T", parsed)
+	}
+
+	if rsaKey, ok := k.verifyKey.(*rsa.PublicKey); ok && rsaKey.N.BitLen() < 2048 {
+		return nil, errors.New("RSA keys must be at least 2048 bits")
+	}
+
+	return k, nil
+}
+
+// publicKeys returns the verification keys of asymmetric keys by kid.
+func (ks *jwtKeySet) publicKeys() map[string]crypto.PublicKey {
+	keys := make(map[string]crypto.PublicKey)
+	for _, k := range ks.ordered {
+		if k.method.Alg() == jwt.SigningMethodHS256.Alg() {
+			continue
+		}
+		keys[k.id] = k.verifyKey
+	}
+	return keys
+}
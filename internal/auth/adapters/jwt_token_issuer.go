@@ -2,6 +2,7 @@ package adapters
 
 import (
 	"context"
+	"crypto"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -14,16 +15,32 @@ import (
 //
 // NOTE: This is an auth-module specific token implementation and is independent
 // from pkg/token.
+//
+// Tokens carry a kid header naming the key that signed them, so several
+// keys can be accepted at once while signing keys are rotated. Signing uses
+// HS256 with AUTH_JWT_SECRET by default, or RS256/EdDSA with PEM keys from
+// AUTH_JWT_KEYS_DIR.
 type JWTTokenIssuer struct {
-	secretKey []byte
-	issuer    string
+	keys   *jwtKeySet
+	issuer string
 }
 
-func NewJWTTokenIssuer(cfg *config.Config) *JWTTokenIssuer {
-	return &JWTTokenIssuer{
-		secretKey: []byte(cfg.AuthJWTSecret),
-		issuer:    cfg.AppName,
+func NewJWTTokenIssuer(cfg *config.Config) (*JWTTokenIssuer, error) {
+	keys, err := loadJWTKeySet(cfg)
+	if err != nil {
+		return nil, err
 	}
+
+	return &JWTTokenIssuer{
+		keys:   keys,
+		issuer: cfg.AppName,
+	}, nil
+}
+
+// PublicKeys returns the RS256/EdDSA public keys tokens may be signed
+// with, keyed by kid, for publishing as a JWKS. It is empty for HS256.
+func (j *JWTTokenIssuer) PublicKeys() map[string]crypto.PublicKey {
+	return j.keys.publicKeys()
 }
 
 type accessTokenClaims struct {
@@ -55,8 +72,7 @@ func (j *JWTTokenIssuer) IssueAccessToken(ctx context.Context, userID uuid.UUID,
 		Type:      "access",
 	}
 
-	tok := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return tok.SignedString(j.secretKey)
+	return j.keys.sign(claims)
 }
 
 func (j *JWTTokenIssuer) IssueRefreshToken(ctx context.Context, sessionID uuid.UUID, expiresAt time.Time) (string, error) {
@@ -74,15 +90,12 @@ func (j *JWTTokenIssuer) IssueRefreshToken(ctx context.Context, sessionID uuid.U
 		Type:      "refresh",
 	}
 
-	tok := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return tok.SignedString(j.secretKey)
+	return j.keys.sign(claims)
 }
 
 // VerifyAccessToken validates an access token and returns its claims.
 func (j *JWTTokenIssuer) VerifyAccessToken(ctx context.Context, tokenString string) (*service.TokenClaims, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &accessTokenClaims{}, func(token *jwt.Token) (interface{}, error) {
-		return j.secretKey, nil
-	})
+	token, err := jwt.ParseWithClaims(tokenString, &accessTokenClaims{}, j.keys.keyFunc)
 
 	if err != nil {
 		return nil, err
@@ -118,9 +131,7 @@ func (j *JWTTokenIssuer) VerifyAccessToken(ctx context.Context, tokenString stri
 
 // VerifyRefreshToken validates a refresh token and returns its claims.
 func (j *JWTTokenIssuer) VerifyRefreshToken(ctx context.Context, tokenString string) (*service.TokenClaims, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &refreshTokenClaims{}, func(token *jwt.Token) (interface{}, error) {
-		return j.secretKey, nil
-	})
+	token, err := jwt.ParseWithClaims(tokenString, &refreshTokenClaims{}, j.keys.keyFunc)
 
 	if err != nil {
 		return nil, err
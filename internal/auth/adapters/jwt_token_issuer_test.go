@@ -0,0 +1,189 @@
+package adapters_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/config"
+	"github.com/semmidev/ethos-go/internal/auth/adapters"
+)
+
+func TestJWTTokenIssuerKeyRotation(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	userID := uuid.New()
+	sessionID := uuid.New()
+	expiresAt := time.Now().Add(time.Hour)
+
+	oldSecret := strings.Repeat("o", 32)
+	newSecret := strings.Repeat("n", 32)
+
+	Convey("Given an issuer signing with the key being rotated out", t, func() {
+		oldIssuer, err := adapters.NewJWTTokenIssuer(&config.Config{
+			AppName:          "ethos-go",
+			AuthJWTAlgorithm: "HS256",
+			AuthJWTKeyID:     "2024-01",
+			AuthJWTSecret:    oldSecret,
+		})
+		So(err, ShouldBeNil)
+
+		oldToken, err := oldIssuer.IssueAccessToken(ctx, userID, sessionID, expiresAt)
+		So(err, ShouldBeNil)
+
+		Convey("Tokens carry the kid of the signing key", func() {
+			parsed, _, err := jwt.NewParser().ParseUnverified(oldToken, jwt.MapClaims{})
+			So(err, ShouldBeNil)
+			So(parsed.Header["kid"], ShouldEqual, "2024-01")
+		})
+
+		Convey("When the new key is active and the old key is kept as previous", func() {
+			newIssuer, err := adapters.NewJWTTokenIssuer(&config.Config{
+				AppName:             "ethos-go",
+				AuthJWTAlgorithm:    "HS256",
+				AuthJWTKeyID:        "2024-02",
+				AuthJWTSecret:       newSecret,
+				AuthJWTPreviousKeys: "2024-01=" + oldSecret,
+			})
+			So(err, ShouldBeNil)
+
+			Convey("Then tokens from the old key still verify", func() {
+				claims, err := newIssuer.VerifyAccessToken(ctx, oldToken)
+				So(err, ShouldBeNil)
+				So(claims.UserID, ShouldEqual, userID)
+				So(claims.SessionID, ShouldEqual, sessionID)
+			})
+
+			Convey("Then new tokens are signed with the new key", func() {
+				newToken, err := newIssuer.IssueAccessToken(ctx, userID, sessionID, expiresAt)
+				So(err, ShouldBeNil)
+
+				_, err = oldIssuer.VerifyAccessToken(ctx, newToken)
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When the old key has been removed", func() {
+			newIssuer, err := adapters.NewJWTTokenIssuer(&config.Config{
+				AppName:          "ethos-go",
+				AuthJWTAlgorithm: "HS256",
+				AuthJWTKeyID:     "2024-02",
+				AuthJWTSecret:    newSecret,
+			})
+			So(err, ShouldBeNil)
+
+			Convey("Then tokens from the old key are rejected", func() {
+				_, err := newIssuer.VerifyAccessToken(ctx, oldToken)
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given a token issued before kid headers existed", t, func() {
+		legacy := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+			"iss":        "ethos-go",
+			"sub":        userID.String(),
+			"iat":        time.Now().Unix(),
+			"exp":        expiresAt.Unix(),
+			"user_id":    userID.String(),
+			"session_id": sessionID.String(),
+			"type":       "access",
+		})
+		legacyToken, err := legacy.SignedString([]byte(oldSecret))
+		So(err, ShouldBeNil)
+
+		Convey("Then it verifies against the configured secrets", func() {
+			issuer, err := adapters.NewJWTTokenIssuer(&config.Config{
+				AppName:             "ethos-go",
+				AuthJWTAlgorithm:    "HS256",
+				AuthJWTKeyID:        "2024-02",
+				AuthJWTSecret:       newSecret,
+				AuthJWTPreviousKeys: "2024-01=" + oldSecret,
+			})
+			So(err, ShouldBeNil)
+
+			claims, err := issuer.VerifyAccessToken(ctx, legacyToken)
+			So(err, ShouldBeNil)
+			So(claims.UserID, ShouldEqual, userID)
+		})
+	})
+
+	Convey("Given an EdDSA key directory", t, func() {
+		dir := t.TempDir()
+		writeEd25519Key(t, dir, "ed-1")
+
+		cfg := &config.Config{
+			AppName:          "ethos-go",
+			AuthJWTAlgorithm: "EdDSA",
+			AuthJWTKeyID:     "ed-1",
+			AuthJWTKeysDir:   dir,
+			AuthJWTSecret:    oldSecret,
+		}
+		issuer, err := adapters.NewJWTTokenIssuer(cfg)
+		So(err, ShouldBeNil)
+
+		Convey("Then tokens are signed with EdDSA and verify", func() {
+			token, err := issuer.IssueRefreshToken(ctx, sessionID, expiresAt)
+			So(err, ShouldBeNil)
+
+			parsed, _, err := jwt.NewParser().ParseUnverified(token, jwt.MapClaims{})
+			So(err, ShouldBeNil)
+			So(parsed.Header["alg"], ShouldEqual, "EdDSA")
+
+			claims, err := issuer.VerifyRefreshToken(ctx, token)
+			So(err, ShouldBeNil)
+			So(claims.SessionID, ShouldEqual, sessionID)
+		})
+
+		Convey("Then HS256 tokens signed with the old shared secret still verify", func() {
+			hsIssuer, err := adapters.NewJWTTokenIssuer(&config.Config{
+				AppName:          "ethos-go",
+				AuthJWTAlgorithm: "HS256",
+				AuthJWTKeyID:     "hs256-legacy",
+				AuthJWTSecret:    oldSecret,
+			})
+			So(err, ShouldBeNil)
+
+			token, err := hsIssuer.IssueAccessToken(ctx, userID, sessionID, expiresAt)
+			So(err, ShouldBeNil)
+
+			_, err = issuer.VerifyAccessToken(ctx, token)
+			So(err, ShouldBeNil)
+		})
+
+		Convey("Then a signing key id that is not in the directory is rejected", func() {
+			cfg.AuthJWTKeyID = "missing"
+			_, err := adapters.NewJWTTokenIssuer(cfg)
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func writeEd25519Key(t *testing.T, dir, kid string) {
+	t.Helper()
+
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(priv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+	if err := os.WriteFile(filepath.Join(dir, kid+".pem"), data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
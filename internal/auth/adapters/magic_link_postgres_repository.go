@@ -0,0 +1,137 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/semmidev/ethos-go/internal/auth/domain/magiclink"
+	"github.com/semmidev/ethos-go/internal/common/database"
+)
+
+// magicLinkModel is the database representation of a MagicLink
+type magicLinkModel struct {
+	LinkID    uuid.UUID  `db:"link_id"`
+	UserID    uuid.UUID  `db:"user_id"`
+	TokenHash string     `db:"token_hash"`
+	ExpiresAt time.Time  `db:"expires_at"`
+	UsedAt    *time.Time `db:"used_at"`
+	CreatedAt time.Time  `db:"created_at"`
+}
+
+// MagicLinkPostgresRepository implements magiclink.Repository
+type MagicLinkPostgresRepository struct {
+	db database.DBTX
+}
+
+func NewMagicLinkPostgresRepository(db database.DBTX) *MagicLinkPostgresRepository {
+	return &MagicLinkPostgresRepository{db: db}
+}
+
+var (
+	_ magiclink.Repository = (*MagicLinkPostgresRepository)(nil)
+	_ magiclink.Maintainer = (*MagicLinkPostgresRepository)(nil)
+)
+
+func (r *MagicLinkPostgresRepository) Create(ctx context.Context, link *magiclink.MagicLink) error {
+	query := `
+		INSERT INTO magic_links (link_id, user_id, token_hash, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+	_, err := r.db.ExecContext(ctx, query,
+		link.LinkID(),
+		link.UserID(),
+		link.TokenHash(),
+		link.ExpiresAt(),
+		link.CreatedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("create magic link: %w", err)
+	}
+	return nil
+}
+
+func (r *MagicLinkPostgresRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*magiclink.MagicLink, error) {
+	query := `
+		SELECT link_id, user_id, token_hash, expires_at, used_at, created_at
+		FROM magic_links
+		WHERE token_hash = $1
+	`
+
+	var m magicLinkModel
+	if err := r.db.GetContext(ctx, &m, query, tokenHash); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, magiclink.ErrInvalidLink
+		}
+		return nil, fmt.Errorf("find magic link: %w", err)
+	}
+
+	return magiclink.UnmarshalFromDatabase(m.LinkID, m.UserID, m.TokenHash, m.ExpiresAt, m.UsedAt, m.CreatedAt), nil
+}
+
+func (r *MagicLinkPostgresRepository) MarkUsed(ctx context.Context, linkID uuid.UUID, usedAt time.Time) error {
+	query := `
+		UPDATE magic_links
+		SET used_at = $2
+		WHERE link_id = $1 AND used_at IS NULL
+	`
+	res, err := r.db.ExecContext(ctx, query, linkID, usedAt)
+	if err != nil {
+		return fmt.Errorf("mark magic link used: %w", err)
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("rows affected: %w", err)
+	}
+	if rows == 0 {
+		return magiclink.ErrInvalidLink
+	}
+	return nil
+}
+
+// CreateWithinLimit locks the user's row before counting, so concurrent
+// requests for one user count and insert one after another. Each statement
+// of the transaction sees the links committed before it started.
+func (r *MagicLinkPostgresRepository) CreateWithinLimit(ctx context.Context, link *magiclink.MagicLink, since time.Time, limit int) error {
+	return database.InTx(ctx, r.db, func(tx database.DBTX) error {
+		var userID uuid.UUID
+		lockQuery := `SELECT user_id FROM users WHERE user_id = $1 FOR UPDATE`
+		if err := tx.GetContext(ctx, &userID, lockQuery, link.UserID()); err != nil {
+			return fmt.Errorf("lock user: %w", err)
+		}
+
+		countQuery := `
+			SELECT COUNT(*)
+			FROM magic_links
+			WHERE user_id = $1 AND created_at >= $2
+		`
+		var count int
+		if err := tx.GetContext(ctx, &count, countQuery, link.UserID(), since); err != nil {
+			return fmt.Errorf("count magic links: %w", err)
+		}
+		if count >= limit {
+			return magiclink.ErrLimitReached
+		}
+
+		return NewMagicLinkPostgresRepository(tx).Create(ctx, link)
+	})
+}
+
+// DeleteExpired removes links past their expiry, used or not. Links from the
+// last hour are kept so they still count toward the hourly limit.
+func (r *MagicLinkPostgresRepository) DeleteExpired(ctx context.Context) (int64, error) {
+	query := `
+		DELETE FROM magic_links
+		WHERE expires_at < NOW() AND created_at < NOW() - INTERVAL '1 hour'
+	`
+
+	res, err := r.db.ExecContext(ctx, query)
+	if err != nil {
+		return 0, fmt.Errorf("delete expired magic links: %w", err)
+	}
+	return res.RowsAffected()
+}
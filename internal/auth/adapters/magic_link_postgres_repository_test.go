@@ -0,0 +1,151 @@
+package adapters_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/lib/pq"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/auth/adapters"
+	"github.com/semmidev/ethos-go/internal/auth/domain/magiclink"
+	"github.com/semmidev/ethos-go/internal/common/database"
+	"github.com/semmidev/ethos-go/migrations"
+)
+
+func TestCreateMagicLinkWithinLimit(t *testing.T) {
+	Convey("Given a user asking for a magic link", t, func() {
+		db, mock, err := sqlmock.New()
+		So(err, ShouldBeNil)
+		defer db.Close()
+
+		repo := adapters.NewMagicLinkPostgresRepository(sqlx.NewDb(db, "sqlmock"))
+		now := time.Now()
+		link, _, err := magiclink.New(uuid.New(), 15*time.Minute, now)
+		So(err, ShouldBeNil)
+		since := now.Add(-time.Hour)
+
+		// The user's row is locked before the links are counted
+		expectCount := func(count int) {
+			mock.ExpectBegin()
+			mock.ExpectQuery(`SELECT user_id FROM users WHERE user_id = \$1 FOR UPDATE`).
+				WithArgs(link.UserID()).
+				WillReturnRows(sqlmock.NewRows([]string{"user_id"}).AddRow(link.UserID()))
+			mock.ExpectQuery(`SELECT COUNT\(\*\)\s+FROM magic_links\s+WHERE user_id = \$1 AND created_at >= \$2`).
+				WithArgs(link.UserID(), since).
+				WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(count))
+		}
+
+		Convey("When they are under the limit", func() {
+			expectCount(1)
+			mock.ExpectExec(`INSERT INTO magic_links`).
+				WithArgs(link.LinkID(), link.UserID(), link.TokenHash(), link.ExpiresAt(), link.CreatedAt()).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectCommit()
+
+			err := repo.CreateWithinLimit(context.Background(), link, since, 2)
+
+			Convey("Then the link is stored", func() {
+				So(err, ShouldBeNil)
+				So(mock.ExpectationsWereMet(), ShouldBeNil)
+			})
+		})
+
+		Convey("When they have reached the limit", func() {
+			expectCount(2)
+			mock.ExpectRollback()
+
+			err := repo.CreateWithinLimit(context.Background(), link, since, 2)
+
+			Convey("Then nothing is stored", func() {
+				So(err, ShouldEqual, magiclink.ErrLimitReached)
+				So(mock.ExpectationsWereMet(), ShouldBeNil)
+			})
+		})
+	})
+}
+
+// TestCreateMagicLinkWithinLimitConcurrently races requests for one user
+// against Postgres. Like the habit benchmarks, it is skipped unless
+// ETHOS_BENCH_DSN is set.
+func TestCreateMagicLinkWithinLimitConcurrently(t *testing.T) {
+	dsn := os.Getenv("ETHOS_BENCH_DSN")
+	if dsn == "" {
+		t.Skip("ETHOS_BENCH_DSN not set")
+	}
+	if err := database.RunMigrations(dsn, migrations.FS, "."); err != nil {
+		t.Fatalf("run migrations: %v", err)
+	}
+	db, err := sqlx.Connect("postgres", dsn)
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	userID := uuid.New()
+	_, err = db.Exec(`INSERT INTO users (user_id, name, email, is_verified) VALUES ($1, 'Magic Link User', $2, true)`,
+		userID, fmt.Sprintf("magic-%s@example.com", userID))
+	if err != nil {
+		t.Fatalf("seed: %v", err)
+	}
+	t.Cleanup(func() {
+		// Links cascade from the user
+		_, _ = db.Exec(`DELETE FROM users WHERE user_id = $1`, userID)
+	})
+
+	Convey("Given a user with an hourly limit of 3 links", t, func() {
+		repo := adapters.NewMagicLinkPostgresRepository(db)
+		const limit, callers = 3, 20
+
+		Convey("When many requests arrive at once", func() {
+			var (
+				wg       sync.WaitGroup
+				mu       sync.Mutex
+				created  int
+				declined int
+			)
+			for i := 0; i < callers; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					now := time.Now()
+					link, _, err := magiclink.New(userID, 15*time.Minute, now)
+					if err != nil {
+						t.Error(err)
+						return
+					}
+					err = repo.CreateWithinLimit(context.Background(), link, now.Add(-time.Hour), limit)
+
+					mu.Lock()
+					defer mu.Unlock()
+					switch {
+					case err == nil:
+						created++
+					case errors.Is(err, magiclink.ErrLimitReached):
+						declined++
+					default:
+						t.Errorf("create magic link: %v", err)
+					}
+				}()
+			}
+			wg.Wait()
+
+			Convey("Then exactly the limit is created", func() {
+				So(created, ShouldEqual, limit)
+				So(declined, ShouldEqual, callers-limit)
+
+				var stored int
+				So(db.Get(&stored, `SELECT COUNT(*) FROM magic_links WHERE user_id = $1`, userID), ShouldBeNil)
+				So(stored, ShouldEqual, limit)
+			})
+		})
+	})
+}
@@ -0,0 +1,30 @@
+package oidc
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/semmidev/ethos-go/internal/auth/domain/user"
+)
+
+// ParseGroupRoles parses a comma-separated list of group=role pairs, e.g.
+// "ethos-admins=admin,staff=member", into a map from group to role
+func ParseGroupRoles(s string) (map[string]string, error) {
+	roles := map[string]string{}
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		group, role, ok := strings.Cut(pair, "=")
+		group, role = strings.TrimSpace(group), strings.TrimSpace(role)
+		if !ok || group == "" {
+			return nil, fmt.Errorf("invalid group role %q, expected group=role", pair)
+		}
+		if !user.IsRole(role) {
+			return nil, fmt.Errorf("group %q maps to unknown role %q", group, role)
+		}
+		roles[group] = role
+	}
+	return roles, nil
+}
@@ -0,0 +1,271 @@
+// Package oidc signs users in through an external OpenID Connect provider
+// for enterprise single sign-on.
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/oauth2"
+
+	"github.com/semmidev/ethos-go/internal/auth/domain/service"
+)
+
+// keyRefreshInterval limits how often an unknown key ID makes the provider
+// fetch its JWK set again, so forged tokens can't hammer it
+const keyRefreshInterval = time.Minute
+
+// ID token signing algorithms accepted from the provider. HMAC is left out:
+// with it the client secret would be the verification key.
+var signingMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+
+// Config describes the provider and this application's registration with it
+type Config struct {
+	IssuerURL    string
+	ClientID     string
+	ClientSecret string
+	CallbackURL  string
+	// GroupsClaim is the ID token claim listing the user's groups
+	GroupsClaim string
+}
+
+// metadata is the part of the provider's discovery document in use
+type metadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// Provider implements service.SSOProvider. The discovery document is
+// fetched on first use rather than at startup, so an unreachable provider
+// doesn't stop the API from starting; a failed fetch is retried next time.
+type Provider struct {
+	cfg    Config
+	client *http.Client
+
+	mu            sync.Mutex
+	meta          *metadata
+	keys          map[string]crypto.PublicKey
+	keysFetchedAt time.Time
+}
+
+var _ service.SSOProvider = (*Provider)(nil)
+
+// NewProvider creates a provider client that makes its requests with client
+func NewProvider(cfg Config, client *http.Client) *Provider {
+	if client == nil {
+		panic("nil http client")
+	}
+	return &Provider{cfg: cfg, client: client}
+}
+
+// LoginURL returns the provider's authorization URL for login, asking for
+// its nonce in the ID token and carrying its PKCE challenge
+func (p *Provider) LoginURL(ctx context.Context, login service.SSOLogin) (string, error) {
+	meta, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+	return p.oauthConfig(meta).AuthCodeURL(login.State,
+		oauth2.SetAuthURLParam("nonce", login.Nonce),
+		oauth2.S256ChallengeOption(login.CodeVerifier),
+	), nil
+}
+
+// Exchange trades an authorization code for the identity in the verified
+// ID token, which must carry login's nonce
+func (p *Provider) Exchange(ctx context.Context, code string, login service.SSOLogin) (*service.SSOIdentity, error) {
+	meta, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := p.oauthConfig(meta).Exchange(context.WithValue(ctx, oauth2.HTTPClient, p.client), code,
+		oauth2.VerifierOption(login.CodeVerifier),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("exchange code: %w", err)
+	}
+	rawIDToken, _ := token.Extra("id_token").(string)
+	if rawIDToken == "" {
+		return nil, errors.New("token response has no id_token")
+	}
+
+	claims := jwt.MapClaims{}
+	_, err = jwt.ParseWithClaims(rawIDToken, claims,
+		func(t *jwt.Token) (any, error) {
+			kid, _ := t.Header["kid"].(string)
+			return p.key(ctx, meta, kid)
+		},
+		jwt.WithValidMethods(signingMethods),
+		jwt.WithIssuer(meta.Issuer),
+		jwt.WithAudience(p.cfg.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(time.Minute),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("verify id_token: %w", err)
+	}
+	// A token issued for another sign-in, such as one replayed from
+	// elsewhere, carries another nonce
+	nonce, _ := claims["nonce"].(string)
+	if login.Nonce == "" || subtle.ConstantTimeCompare([]byte(nonce), []byte(login.Nonce)) != 1 {
+		return nil, errors.New("id_token nonce does not match the sign-in")
+	}
+
+	return p.identity(claims)
+}
+
+func (p *Provider) oauthConfig(meta *metadata) *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:     p.cfg.ClientID,
+		ClientSecret: p.cfg.ClientSecret,
+		RedirectURL:  p.cfg.CallbackURL,
+		Scopes:       []string{"openid", "email", "profile"},
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  meta.AuthorizationEndpoint,
+			TokenURL: meta.TokenEndpoint,
+		},
+	}
+}
+
+// identity reads the user out of verified ID token claims
+func (p *Provider) identity(claims jwt.MapClaims) (*service.SSOIdentity, error) {
+	subject, _ := claims["sub"].(string)
+	if subject == "" {
+		return nil, errors.New("id_token has no subject")
+	}
+	email, _ := claims["email"].(string)
+	name, _ := claims["name"].(string)
+
+	// Some providers send email_verified as a string
+	verified := false
+	switch v := claims["email_verified"].(type) {
+	case bool:
+		verified = v
+	case string:
+		verified = v == "true"
+	}
+
+	var groups []string
+	switch v := claims[p.cfg.GroupsClaim].(type) {
+	case string:
+		groups = []string{v}
+	case []any:
+		for _, g := range v {
+			if s, ok := g.(string); ok {
+				groups = append(groups, s)
+			}
+		}
+	}
+
+	return &service.SSOIdentity{
+		Subject:       subject,
+		Email:         email,
+		EmailVerified: verified,
+		Name:          name,
+		Groups:        groups,
+	}, nil
+}
+
+// discover returns the provider's metadata, fetching it on first use
+func (p *Provider) discover(ctx context.Context) (*metadata, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.meta != nil {
+		return p.meta, nil
+	}
+
+	issuer := strings.TrimSuffix(p.cfg.IssuerURL, "/")
+	body, err := p.get(ctx, issuer+"/.well-known/openid-configuration")
+	if err != nil {
+		return nil, fmt.Errorf("discover OIDC provider: %w", err)
+	}
+
+	var meta metadata
+	if err := json.Unmarshal(body, &meta); err != nil {
+		return nil, fmt.Errorf("decode OIDC discovery document: %w", err)
+	}
+	// The document must be about the configured issuer, otherwise tokens
+	// from another issuer would be accepted
+	if strings.TrimSuffix(meta.Issuer, "/") != issuer {
+		return nil, fmt.Errorf("OIDC discovery document is for issuer %q, expected %q", meta.Issuer, p.cfg.IssuerURL)
+	}
+	if meta.AuthorizationEndpoint == "" || meta.TokenEndpoint == "" || meta.JWKSURI == "" {
+		return nil, errors.New("OIDC discovery document is missing endpoints")
+	}
+
+	p.meta = &meta
+	return p.meta, nil
+}
+
+// key returns the provider's signing key kid. Providers rotate keys, so an
+// unknown kid refetches the JWK set, at most once per keyRefreshInterval.
+func (p *Provider) key(ctx context.Context, meta *metadata, kid string) (crypto.PublicKey, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if key, ok := p.lookupKey(kid); ok {
+		return key, nil
+	}
+	if time.Since(p.keysFetchedAt) < keyRefreshInterval {
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+
+	body, err := p.get(ctx, meta.JWKSURI)
+	if err != nil {
+		return nil, fmt.Errorf("fetch JWK set: %w", err)
+	}
+	keys, err := parseJWKS(body)
+	if err != nil {
+		return nil, err
+	}
+	p.keys = keys
+	p.keysFetchedAt = time.Now()
+
+	if key, ok := p.lookupKey(kid); ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("unknown signing key %q", kid)
+}
+
+// lookupKey finds a cached key. Tokens without a key ID can only mean the
+// provider's single key.
+func (p *Provider) lookupKey(kid string) (crypto.PublicKey, bool) {
+	if kid == "" && len(p.keys) == 1 {
+		for _, key := range p.keys {
+			return key, true
+		}
+	}
+	key, ok := p.keys[kid]
+	return key, ok
+}
+
+func (p *Provider) get(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: status %d", url, resp.StatusCode)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+}
@@ -0,0 +1,249 @@
+package oidc_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/auth/adapters/oidc"
+	"github.com/semmidev/ethos-go/internal/auth/domain/service"
+)
+
+// fakeIdP is an OpenID Connect provider whose token endpoint hands out the
+// ID token built from claims to whoever has the verifier for challenge
+type fakeIdP struct {
+	*httptest.Server
+	key       *rsa.PrivateKey
+	claims    jwt.MapClaims
+	challenge string
+}
+
+// login is the sign-in the tests complete
+var login = service.SSOLogin{
+	State:        "state-1",
+	Nonce:        "nonce-1",
+	CodeVerifier: "verifier-that-is-at-least-forty-three-characters-long",
+}
+
+// s256 is the PKCE challenge for verifier
+func s256(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func newFakeIdP(t *testing.T) *fakeIdP {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	idp := &fakeIdP{key: key}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 idp.URL,
+			"authorization_endpoint": idp.URL + "/authorize",
+			"token_endpoint":         idp.URL + "/token",
+			"jwks_uri":               idp.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]any{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"kid": "idp-key",
+				"use": "sig",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("code") != "good-code" || s256(r.FormValue("code_verifier")) != idp.challenge {
+			http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+			return
+		}
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, idp.claims)
+		token.Header["kid"] = "idp-key"
+		signed, err := token.SignedString(key)
+		if err != nil {
+			t.Error(err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{
+			"access_token": "access",
+			"token_type":   "Bearer",
+			"id_token":     signed,
+		})
+	})
+	idp.Server = httptest.NewServer(mux)
+	t.Cleanup(idp.Close)
+	return idp
+}
+
+func (idp *fakeIdP) validClaims() jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss":            idp.URL,
+		"aud":            "ethos",
+		"sub":            "subject-1",
+		"exp":            time.Now().Add(time.Hour).Unix(),
+		"nonce":          login.Nonce,
+		"email":          "jane@corp.example",
+		"email_verified": true,
+		"name":           "Jane",
+		"groups":         []string{"staff", "ethos-admins"},
+	}
+}
+
+func (idp *fakeIdP) provider() *oidc.Provider {
+	return oidc.NewProvider(oidc.Config{
+		IssuerURL:    idp.URL,
+		ClientID:     "ethos",
+		ClientSecret: "secret",
+		CallbackURL:  "https://ethos.example/auth/sso/callback",
+		GroupsClaim:  "groups",
+	}, idp.Client())
+}
+
+func TestProvider(t *testing.T) {
+	Convey("Given an OpenID Connect provider", t, func() {
+		idp := newFakeIdP(t)
+		idp.claims = idp.validClaims()
+		// As if the user had followed the login URL
+		idp.challenge = s256(login.CodeVerifier)
+		provider := idp.provider()
+		ctx := context.Background()
+
+		Convey("The login URL points at its authorization endpoint", func() {
+			loginURL, err := provider.LoginURL(ctx, login)
+			So(err, ShouldBeNil)
+
+			parsed, err := url.Parse(loginURL)
+			So(err, ShouldBeNil)
+			So(parsed.Path, ShouldEqual, "/authorize")
+			So(parsed.Query().Get("state"), ShouldEqual, "state-1")
+			So(parsed.Query().Get("nonce"), ShouldEqual, "nonce-1")
+			So(parsed.Query().Get("code_challenge"), ShouldEqual, s256(login.CodeVerifier))
+			So(parsed.Query().Get("code_challenge_method"), ShouldEqual, "S256")
+			So(parsed.Query().Get("client_id"), ShouldEqual, "ethos")
+			So(parsed.Query().Get("scope"), ShouldEqual, "openid email profile")
+		})
+
+		Convey("A code exchanges for the identity in the ID token", func() {
+			identity, err := provider.Exchange(ctx, "good-code", login)
+			So(err, ShouldBeNil)
+			So(identity.Subject, ShouldEqual, "subject-1")
+			So(identity.Email, ShouldEqual, "jane@corp.example")
+			So(identity.EmailVerified, ShouldBeTrue)
+			So(identity.Name, ShouldEqual, "Jane")
+			So(identity.Groups, ShouldResemble, []string{"staff", "ethos-admins"})
+		})
+
+		Convey("A single group and a string email_verified are understood", func() {
+			idp.claims["groups"] = "staff"
+			idp.claims["email_verified"] = "false"
+
+			identity, err := provider.Exchange(ctx, "good-code", login)
+			So(err, ShouldBeNil)
+			So(identity.Groups, ShouldResemble, []string{"staff"})
+			So(identity.EmailVerified, ShouldBeFalse)
+		})
+
+		Convey("A rejected code fails", func() {
+			_, err := provider.Exchange(ctx, "bad-code", login)
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("An ID token for another client is refused", func() {
+			idp.claims["aud"] = "someone-else"
+
+			_, err := provider.Exchange(ctx, "good-code", login)
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("An ID token from another issuer is refused", func() {
+			idp.claims["iss"] = "https://evil.example"
+
+			_, err := provider.Exchange(ctx, "good-code", login)
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("An ID token with another sign-in's nonce is refused", func() {
+			idp.claims["nonce"] = "nonce-2"
+
+			_, err := provider.Exchange(ctx, "good-code", login)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "nonce")
+		})
+
+		Convey("An ID token without a nonce is refused", func() {
+			delete(idp.claims, "nonce")
+
+			_, err := provider.Exchange(ctx, "good-code", login)
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("A code exchanged without the sign-in's verifier fails", func() {
+			other := login
+			other.CodeVerifier = "another-verifier-that-is-at-least-forty-three-characters"
+
+			_, err := provider.Exchange(ctx, "good-code", other)
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("An expired ID token is refused", func() {
+			idp.claims["exp"] = time.Now().Add(-time.Hour).Unix()
+
+			_, err := provider.Exchange(ctx, "good-code", login)
+			So(err, ShouldNotBeNil)
+		})
+	})
+
+	Convey("Given a discovery document for another issuer", t, func() {
+		idp := newFakeIdP(t)
+		provider := oidc.NewProvider(oidc.Config{IssuerURL: idp.URL + "/tenant", ClientID: "ethos"}, idp.Client())
+
+		Convey("The provider is not used", func() {
+			_, err := provider.LoginURL(context.Background(), login)
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestParseGroupRoles(t *testing.T) {
+	Convey("Group role mappings", t, func() {
+		Convey("Parse group=role pairs", func() {
+			roles, err := oidc.ParseGroupRoles(" ethos-admins=admin, staff = member ,")
+			So(err, ShouldBeNil)
+			So(roles, ShouldResemble, map[string]string{"ethos-admins": "admin", "staff": "member"})
+		})
+
+		Convey("May be empty", func() {
+			roles, err := oidc.ParseGroupRoles("")
+			So(err, ShouldBeNil)
+			So(roles, ShouldBeEmpty)
+		})
+
+		Convey("Reject unknown roles", func() {
+			_, err := oidc.ParseGroupRoles("staff=owner")
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Reject pairs without a group", func() {
+			_, err := oidc.ParseGroupRoles("=admin")
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
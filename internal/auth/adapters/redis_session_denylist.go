@@ -0,0 +1,49 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// deniedSessionKeyPrefix namespaces denylist entries in the shared Redis
+const deniedSessionKeyPrefix = "auth:denied_session:"
+
+// RedisSessionDenylist keeps revoked sessions in Redis, where entries
+// expire on their own once no access token of the session is still valid.
+type RedisSessionDenylist struct {
+	client redis.UniversalClient
+}
+
+// NewRedisSessionDenylist creates a new Redis-backed session denylist
+func NewRedisSessionDenylist(client redis.UniversalClient) *RedisSessionDenylist {
+	return &RedisSessionDenylist{client: client}
+}
+
+// Deny adds the session for ttl. A non-positive ttl means every token of
+// the session has already expired, so nothing is stored.
+func (d *RedisSessionDenylist) Deny(ctx context.Context, sessionID uuid.UUID, ttl time.Duration) error {
+	if ttl <= 0 {
+		return nil
+	}
+	if err := d.client.Set(ctx, deniedSessionKey(sessionID), 1, ttl).Err(); err != nil {
+		return fmt.Errorf("deny session: %w", err)
+	}
+	return nil
+}
+
+// IsDenied reports whether the session is on the denylist
+func (d *RedisSessionDenylist) IsDenied(ctx context.Context, sessionID uuid.UUID) (bool, error) {
+	n, err := d.client.Exists(ctx, deniedSessionKey(sessionID)).Result()
+	if err != nil {
+		return false, fmt.Errorf("check denied session: %w", err)
+	}
+	return n > 0, nil
+}
+
+func deniedSessionKey(sessionID uuid.UUID) string {
+	return deniedSessionKeyPrefix + sessionID.String()
+}
@@ -0,0 +1,63 @@
+package adapters
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/semmidev/ethos-go/internal/auth/domain/service"
+)
+
+// ssoLoginKeyPrefix namespaces started sign-ins in the shared Redis
+const ssoLoginKeyPrefix = "auth:sso_login:"
+
+// RedisSSOLoginStore keeps started single sign-on logins in Redis, where
+// they expire on their own if the callback never arrives.
+type RedisSSOLoginStore struct {
+	client redis.UniversalClient
+}
+
+var _ service.SSOLoginStore = (*RedisSSOLoginStore)(nil)
+
+// NewRedisSSOLoginStore creates a new Redis-backed sign-in store
+func NewRedisSSOLoginStore(client redis.UniversalClient) *RedisSSOLoginStore {
+	return &RedisSSOLoginStore{client: client}
+}
+
+// Save keeps login for ttl
+func (s *RedisSSOLoginStore) Save(ctx context.Context, login service.SSOLogin, ttl time.Duration) error {
+	value, err := json.Marshal(login)
+	if err != nil {
+		return fmt.Errorf("encode sso login: %w", err)
+	}
+	if err := s.client.Set(ctx, ssoLoginKey(login.State), value, ttl).Err(); err != nil {
+		return fmt.Errorf("save sso login: %w", err)
+	}
+	return nil
+}
+
+// Take removes and returns the sign-in for state in one command, so two
+// callbacks with the same state can't both get it
+func (s *RedisSSOLoginStore) Take(ctx context.Context, state string) (service.SSOLogin, bool, error) {
+	value, err := s.client.GetDel(ctx, ssoLoginKey(state)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return service.SSOLogin{}, false, nil
+	}
+	if err != nil {
+		return service.SSOLogin{}, false, fmt.Errorf("take sso login: %w", err)
+	}
+
+	var login service.SSOLogin
+	if err := json.Unmarshal(value, &login); err != nil {
+		return service.SSOLogin{}, false, fmt.Errorf("decode sso login: %w", err)
+	}
+	return login, true, nil
+}
+
+func ssoLoginKey(state string) string {
+	return ssoLoginKeyPrefix + state
+}
@@ -0,0 +1,43 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/semmidev/ethos-go/internal/auth/domain/service"
+	"github.com/semmidev/ethos-go/internal/auth/domain/session"
+)
+
+// RevokedSessionVerifier denies access tokens as soon as their session is
+// logged out, revoked or blocked, instead of honouring them until they
+// expire. Revoking commands put the session on the denylist, so each
+// request costs a single denylist lookup.
+type RevokedSessionVerifier struct {
+	service.TokenVerifier
+	denylist service.SessionDenylist
+}
+
+// NewRevokedSessionVerifier wraps verifier with a denylist check
+func NewRevokedSessionVerifier(verifier service.TokenVerifier, denylist service.SessionDenylist) *RevokedSessionVerifier {
+	return &RevokedSessionVerifier{
+		TokenVerifier: verifier,
+		denylist:      denylist,
+	}
+}
+
+// VerifyAccessToken verifies the token, then that its session isn't denied
+func (v *RevokedSessionVerifier) VerifyAccessToken(ctx context.Context, tokenString string) (*service.TokenClaims, error) {
+	claims, err := v.TokenVerifier.VerifyAccessToken(ctx, tokenString)
+	if err != nil {
+		return nil, err
+	}
+
+	denied, err := v.denylist.IsDenied(ctx, claims.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	if denied {
+		return nil, session.ErrSessionBlocked
+	}
+
+	return claims, nil
+}
@@ -0,0 +1,56 @@
+package adapters_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/config"
+	"github.com/semmidev/ethos-go/internal/auth/adapters"
+	"github.com/semmidev/ethos-go/internal/testutil"
+)
+
+func TestRevokedSessionVerifier(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	Convey("Given an access token for a signed-in session", t, func() {
+		issuer, err := adapters.NewJWTTokenIssuer(&config.Config{
+			AppName:          "ethos-go",
+			AuthJWTAlgorithm: "HS256",
+			AuthJWTKeyID:     "primary",
+			AuthJWTSecret:    strings.Repeat("s", 32),
+		})
+		So(err, ShouldBeNil)
+
+		u := testutil.NewUserBuilder().Build()
+		sess := testutil.NewSessionBuilder(u).Build()
+		token, err := issuer.IssueAccessToken(ctx, u.UserID(), sess.SessionID(), time.Now().Add(15*time.Minute))
+		So(err, ShouldBeNil)
+
+		denylist := testutil.NewSessionDenylist()
+		verifier := adapters.NewRevokedSessionVerifier(issuer, denylist)
+
+		Convey("When the session is not denied", func() {
+			claims, err := verifier.VerifyAccessToken(ctx, token)
+
+			Convey("Then the token is accepted", func() {
+				So(err, ShouldBeNil)
+				So(claims.SessionID, ShouldEqual, sess.SessionID())
+			})
+		})
+
+		Convey("When the session has been revoked", func() {
+			So(denylist.Deny(ctx, sess.SessionID(), 15*time.Minute), ShouldBeNil)
+			_, err := verifier.VerifyAccessToken(ctx, token)
+
+			Convey("Then the token is denied before it expires", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
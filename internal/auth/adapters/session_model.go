@@ -15,6 +15,8 @@ type SessionModel struct {
 	RefreshToken string    `db:"refresh_token"`
 	UserAgent    string    `db:"user_agent"`
 	ClientIP     string    `db:"client_ip"`
+	City         string    `db:"city"`
+	Country      string    `db:"country"`
 	IsBlocked    bool      `db:"is_blocked"`
 	ExpiresAt    time.Time `db:"expires_at"`
 	CreatedAt    time.Time `db:"created_at"`
@@ -29,6 +31,7 @@ func (m *SessionModel) ToSession() *session.Session {
 		m.RefreshToken,
 		m.UserAgent,
 		m.ClientIP,
+		session.Location{City: m.City, Country: m.Country},
 		m.IsBlocked,
 		m.ExpiresAt,
 		m.CreatedAt,
@@ -44,6 +47,8 @@ func SessionModelFromSession(s *session.Session) *SessionModel {
 		RefreshToken: s.RefreshToken(),
 		UserAgent:    s.UserAgent(),
 		ClientIP:     s.ClientIP(),
+		City:         s.Location().City,
+		Country:      s.Location().Country,
 		IsBlocked:    s.IsBlocked(),
 		ExpiresAt:    s.ExpiresAt(),
 		CreatedAt:    s.CreatedAt(),
@@ -8,6 +8,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/lib/pq"
+	"github.com/semmidev/ethos-go/internal/auth/app/query"
 	"github.com/semmidev/ethos-go/internal/auth/domain/session"
 	"github.com/semmidev/ethos-go/internal/auth/domain/user"
 	"github.com/semmidev/ethos-go/internal/common/database"
@@ -32,11 +33,18 @@ func (r *SessionPostgresRepository) ListSessions(
 	includeBlocked, includeExpired bool,
 	filter model.Filter,
 ) ([]*session.Session, int, error) {
+	// Dynamic sorting, by a whitelisted column only
+	orderColumn := filter.SortColumn(query.SessionSortColumns, "created_at")
+	orderDirection := "DESC"
+	if filter.SortDirection == "asc" {
+		orderDirection = "ASC"
+	}
+
 	// Base query
 	query := `
 		SELECT
 			session_id, user_id, refresh_token, user_agent,
-			client_ip, is_blocked, expires_at, created_at, updated_at
+			client_ip, city, country, is_blocked, expires_at, created_at, updated_at
 		FROM sessions
 		WHERE user_id = $1
 	`
@@ -51,25 +59,6 @@ func (r *SessionPostgresRepository) ListSessions(
 		query += " AND expires_at > NOW()"
 	}
 
-	// Dynamic sorting
-	orderColumn := "created_at"
-	orderDirection := "DESC"
-
-	if filter.SortBy != "" {
-		// Whitelist allowed columns to prevent SQL injection
-		allowedColumns := map[string]bool{
-			"created_at": true,
-			"expires_at": true,
-			"is_blocked": true,
-		}
-		if allowedColumns[filter.SortBy] {
-			orderColumn = filter.SortBy
-		}
-	}
-	if filter.SortDirection == "asc" {
-		orderDirection = "ASC"
-	}
-
 	query += fmt.Sprintf(" ORDER BY %s %s", orderColumn, orderDirection)
 
 	// Pagination
@@ -85,8 +74,10 @@ func (r *SessionPostgresRepository) ListSessions(
 	}
 
 	// Apply limit/offset to main query
-	query += fmt.Sprintf(" LIMIT $%d OFFSET $%d", argIdx, argIdx+1)
-	args = append(args, limit, offset)
+	if !filter.IsUnlimitedPage() {
+		query += fmt.Sprintf(" LIMIT $%d OFFSET $%d", argIdx, argIdx+1)
+		args = append(args, limit, offset)
+	}
 
 	var models []SessionModel
 	err = r.db.SelectContext(ctx, &models, query, args...)
@@ -107,9 +98,9 @@ func (r *SessionPostgresRepository) Create(ctx context.Context, s *session.Sessi
 	query := `
 		INSERT INTO sessions (
 			session_id, user_id, refresh_token, user_agent,
-			client_ip, is_blocked, expires_at, created_at, updated_at
+			client_ip, city, country, is_blocked, expires_at, created_at, updated_at
 		)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 	`
 
 	_, err := r.db.ExecContext(ctx, query,
@@ -118,6 +109,8 @@ func (r *SessionPostgresRepository) Create(ctx context.Context, s *session.Sessi
 		s.RefreshToken(),
 		s.UserAgent(),
 		s.ClientIP(),
+		s.Location().City,
+		s.Location().Country,
 		s.IsBlocked(),
 		s.ExpiresAt(),
 		s.CreatedAt(),
@@ -136,7 +129,7 @@ func (r *SessionPostgresRepository) FindByID(ctx context.Context, sessionID uuid
 	query := `
 		SELECT
 			session_id, user_id, refresh_token, user_agent,
-			client_ip, is_blocked, expires_at, created_at, updated_at
+			client_ip, city, country, is_blocked, expires_at, created_at, updated_at
 		FROM sessions
 		WHERE session_id = $1
 	`
@@ -159,7 +152,7 @@ func (r *SessionPostgresRepository) FindByRefreshToken(ctx context.Context, refr
 	query := `
 		SELECT
 			session_id, user_id, refresh_token, user_agent,
-			client_ip, is_blocked, expires_at, created_at, updated_at
+			client_ip, city, country, is_blocked, expires_at, created_at, updated_at
 		FROM sessions
 		WHERE refresh_token = $1
 	`
@@ -182,7 +175,7 @@ func (r *SessionPostgresRepository) FindAllByUserID(ctx context.Context, userID
 	query := `
 		SELECT
 			session_id, user_id, refresh_token, user_agent,
-			client_ip, is_blocked, expires_at, created_at, updated_at
+			client_ip, city, country, is_blocked, expires_at, created_at, updated_at
 		FROM sessions
 		WHERE user_id = $1
 		ORDER BY created_at DESC
@@ -208,15 +201,23 @@ func (r *SessionPostgresRepository) Update(ctx context.Context, s *session.Sessi
 		UPDATE sessions
 		SET
 			refresh_token = $2,
-			is_blocked = $3,
-			expires_at = $4,
-			updated_at = $5
+			user_agent = $3,
+			client_ip = $4,
+			city = $5,
+			country = $6,
+			is_blocked = $7,
+			expires_at = $8,
+			updated_at = $9
 		WHERE session_id = $1
 	`
 
 	result, err := r.db.ExecContext(ctx, query,
 		s.SessionID(),
 		s.RefreshToken(),
+		s.UserAgent(),
+		s.ClientIP(),
+		s.Location().City,
+		s.Location().Country,
 		s.IsBlocked(),
 		s.ExpiresAt(),
 		s.UpdatedAt(),
@@ -0,0 +1,66 @@
+package task
+
+import (
+	"context"
+
+	"github.com/hibiken/asynq"
+	"github.com/semmidev/ethos-go/internal/auth/domain/magiclink"
+	"github.com/semmidev/ethos-go/internal/auth/domain/user"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+)
+
+// TaskCodeCleanup is the unique identifier for the expired code cleanup task
+const TaskCodeCleanup = "auth:codes:cleanup"
+
+// NewCodeCleanupTask creates a new task clearing expired verification and
+// password reset codes and login links. It is not retried; the next scheduled run clears
+// whatever this one missed.
+func NewCodeCleanupTask() *asynq.Task {
+	return asynq.NewTask(TaskCodeCleanup, nil, asynq.MaxRetry(0))
+}
+
+// CodeCleanupProcessor clears expired codes and login links, so their
+// hashes and attempt counts are not kept past their use.
+type CodeCleanupProcessor struct {
+	users user.UserMaintainer
+	links magiclink.Maintainer
+	log   logger.Logger
+}
+
+// NewCodeCleanupProcessor creates a new processor instance with required dependencies.
+func NewCodeCleanupProcessor(users user.UserMaintainer, links magiclink.Maintainer, log logger.Logger) *CodeCleanupProcessor {
+	return &CodeCleanupProcessor{
+		users: users,
+		links: links,
+		log:   log,
+	}
+}
+
+// ProcessTask implements the asynq.Handler interface.
+func (p *CodeCleanupProcessor) ProcessTask(ctx context.Context, t *asynq.Task) error {
+	cleared, err := p.users.ClearExpiredCodes(ctx)
+	if err != nil {
+		p.log.Error(ctx, err, "failed to clear expired codes")
+		return err
+	}
+
+	if cleared > 0 {
+		p.log.Info(ctx, "expired codes cleared",
+			logger.Field{Key: "user_count", Value: cleared},
+		)
+	}
+
+	deleted, err := p.links.DeleteExpired(ctx)
+	if err != nil {
+		p.log.Error(ctx, err, "failed to delete expired login links")
+		return err
+	}
+
+	if deleted > 0 {
+		p.log.Info(ctx, "expired login links deleted",
+			logger.Field{Key: "link_count", Value: deleted},
+		)
+	}
+
+	return nil
+}
@@ -2,78 +2,70 @@ package task
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
+	"net/url"
+	"time"
 
-	"github.com/hibiken/asynq"
 	"github.com/semmidev/ethos-go/config"
 	"github.com/semmidev/ethos-go/internal/auth/domain/gateway"
+	commontask "github.com/semmidev/ethos-go/internal/common/task"
 )
 
 const (
 	TaskSendVerifyEmail         = "task:send_verify_email"
 	TaskSendForgotPasswordEmail = "task:send_forgot_password_email"
-
-	TaskSendForgotPasswordEmailSubject = "Permintaan Reset Password"
-	TaskSendVerifyEmailSubject         = "Verifikasi Email"
+	TaskSendMagicLinkEmail      = "task:send_magic_link_email"
 )
 
-// AsynqTaskDispatcher implements TaskDispatcher using Asynq
-type AsynqTaskDispatcher struct {
-	client *asynq.Client
-	cfg    *config.Config
+// emailTaskOptions retry transient SMTP failures, stop a hung connection
+// from holding a worker, and keep sent emails in Redis for an hour to help
+// with "I never got the code" reports
+var emailTaskOptions = []commontask.Option{
+	commontask.MaxRetry(5),
+	commontask.Timeout(time.Minute),
+	commontask.Retention(time.Hour),
+}
+
+// TaskDispatcher implements gateway.TaskDispatcher on the shared task queue
+type TaskDispatcher struct {
+	tasks commontask.Dispatcher
+	cfg   *config.Config
 }
 
-func NewAsynqTaskDispatcher(cfg *config.Config, client *asynq.Client) *AsynqTaskDispatcher {
-	return &AsynqTaskDispatcher{
-		client: client,
-		cfg:    cfg,
+func NewTaskDispatcher(cfg *config.Config, tasks commontask.Dispatcher) *TaskDispatcher {
+	return &TaskDispatcher{
+		tasks: tasks,
+		cfg:   cfg,
 	}
 }
 
-var _ gateway.TaskDispatcher = (*AsynqTaskDispatcher)(nil)
+var _ gateway.TaskDispatcher = (*TaskDispatcher)(nil)
 
-func (d *AsynqTaskDispatcher) DispatchSendVerifyEmail(
+func (d *TaskDispatcher) DispatchSendVerifyEmail(
 	ctx context.Context,
 	payload *gateway.PayloadSendVerifyEmail,
 ) error {
-	payload.Subject = TaskSendVerifyEmailSubject
 	payload.From = d.cfg.AppName
 
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return fmt.Errorf("failed to marshal task payload: %w", err)
-	}
-
-	task := asynq.NewTask(TaskSendVerifyEmail, jsonPayload)
-
-	_, err = d.client.EnqueueContext(ctx, task)
-	if err != nil {
-		return fmt.Errorf("failed to enqueue task: %w", err)
-	}
-
-	return nil
+	return d.tasks.Enqueue(ctx, TaskSendVerifyEmail, payload, emailTaskOptions...)
 }
 
-func (d *AsynqTaskDispatcher) DispatchSendForgotPasswordEmail(
+func (d *TaskDispatcher) DispatchSendForgotPasswordEmail(
 	ctx context.Context,
 	payload *gateway.PayloadSendForgotPasswordEmail,
 ) error {
-	payload.Subject = TaskSendForgotPasswordEmailSubject
 	payload.From = d.cfg.AppName
 	payload.ResetLink = fmt.Sprintf("%s/reset-password?email=%s&code=%s", d.cfg.AppClientURL, payload.Email, payload.VerificationCode)
 
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return fmt.Errorf("failed to marshal task payload: %w", err)
-	}
-
-	task := asynq.NewTask(TaskSendForgotPasswordEmail, jsonPayload)
+	return d.tasks.Enqueue(ctx, TaskSendForgotPasswordEmail, payload, emailTaskOptions...)
+}
 
-	_, err = d.client.EnqueueContext(ctx, task)
-	if err != nil {
-		return fmt.Errorf("failed to enqueue task: %w", err)
-	}
+func (d *TaskDispatcher) DispatchSendMagicLinkEmail(
+	ctx context.Context,
+	payload *gateway.PayloadSendMagicLinkEmail,
+) error {
+	payload.From = d.cfg.AppName
+	payload.LoginLink = fmt.Sprintf("%s/auth/magic-link?token=%s", d.cfg.AppClientURL, url.QueryEscape(payload.Token))
 
-	return nil
+	return d.tasks.Enqueue(ctx, TaskSendMagicLinkEmail, payload, emailTaskOptions...)
 }
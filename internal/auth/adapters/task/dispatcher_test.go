@@ -0,0 +1,75 @@
+package task_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/config"
+	authtask "github.com/semmidev/ethos-go/internal/auth/adapters/task"
+	"github.com/semmidev/ethos-go/internal/auth/domain/gateway"
+	commontask "github.com/semmidev/ethos-go/internal/common/task"
+)
+
+func TestTaskDispatcher(t *testing.T) {
+	Convey("Given an auth task dispatcher on an in-memory queue", t, func() {
+		ctx := context.Background()
+		cfg := &config.Config{AppName: "Ethos", AppClientURL: "https://ethos.example.com"}
+		tasks := commontask.NewInMemoryDispatcher()
+		dispatcher := authtask.NewTaskDispatcher(cfg, tasks)
+
+		Convey("A verification email is enqueued with the email retry policy", func() {
+			err := dispatcher.DispatchSendVerifyEmail(ctx, &gateway.PayloadSendVerifyEmail{
+				Email:            "sam@example.com",
+				VerificationCode: "123456",
+			})
+			So(err, ShouldBeNil)
+
+			enqueued := tasks.Tasks(authtask.TaskSendVerifyEmail)
+			So(enqueued, ShouldHaveLength, 1)
+			So(enqueued[0].Options.MaxRetry, ShouldEqual, 5)
+			So(enqueued[0].Options.Timeout, ShouldEqual, time.Minute)
+
+			var payload gateway.PayloadSendVerifyEmail
+			So(enqueued[0].Decode(&payload), ShouldBeNil)
+			So(payload.From, ShouldEqual, "Ethos")
+			So(payload.VerificationCode, ShouldEqual, "123456")
+		})
+
+		Convey("A forgot password email carries the reset link", func() {
+			err := dispatcher.DispatchSendForgotPasswordEmail(ctx, &gateway.PayloadSendForgotPasswordEmail{
+				Email:            "sam@example.com",
+				VerificationCode: "654321",
+			})
+			So(err, ShouldBeNil)
+
+			var payload gateway.PayloadSendForgotPasswordEmail
+			So(tasks.Tasks(authtask.TaskSendForgotPasswordEmail)[0].Decode(&payload), ShouldBeNil)
+			So(payload.ResetLink, ShouldEqual, "https://ethos.example.com/reset-password?email=sam@example.com&code=654321")
+		})
+
+		Convey("A magic link email carries the escaped login link", func() {
+			err := dispatcher.DispatchSendMagicLinkEmail(ctx, &gateway.PayloadSendMagicLinkEmail{
+				Email: "sam@example.com",
+				Token: "abc=def",
+			})
+			So(err, ShouldBeNil)
+
+			var payload gateway.PayloadSendMagicLinkEmail
+			So(tasks.Tasks(authtask.TaskSendMagicLinkEmail)[0].Decode(&payload), ShouldBeNil)
+			So(payload.LoginLink, ShouldEqual, "https://ethos.example.com/auth/magic-link?token=abc%3Ddef")
+		})
+
+		Convey("Queue failures are returned", func() {
+			tasks.Err = errors.New("redis: connection refused")
+
+			err := dispatcher.DispatchSendVerifyEmail(ctx, &gateway.PayloadSendVerifyEmail{Email: "sam@example.com"})
+
+			So(err, ShouldEqual, tasks.Err)
+			So(tasks.Tasks(""), ShouldBeEmpty)
+		})
+	})
+}
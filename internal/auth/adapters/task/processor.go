@@ -1,29 +1,29 @@
 package task
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
-	"html/template"
 
 	"github.com/hibiken/asynq"
 	"github.com/semmidev/ethos-go/internal/auth/domain/gateway"
-	"github.com/semmidev/ethos-go/internal/auth/infrastructure/assets"
 	"github.com/semmidev/ethos-go/internal/common/email"
+	"github.com/semmidev/ethos-go/internal/common/i18n"
 	"github.com/semmidev/ethos-go/internal/common/logger"
 )
 
 // TaskProcessor handles processing of auth-related background tasks
 type TaskProcessor struct {
-	logger logger.Logger
-	email  email.Email
+	logger    logger.Logger
+	email     email.Email
+	templates *email.Renderer
 }
 
-func NewTaskProcessor(l logger.Logger, email email.Email) *TaskProcessor {
+func NewTaskProcessor(l logger.Logger, sender email.Email, templates *email.Renderer) *TaskProcessor {
 	return &TaskProcessor{
-		logger: l,
-		email:  email,
+		logger:    l,
+		email:     sender,
+		templates: templates,
 	}
 }
 
@@ -34,23 +34,17 @@ func (p *TaskProcessor) ProcessTaskSendVerifyEmail(ctx context.Context, task *as
 		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
 	}
 
-	var tpl *template.Template
-	tpl, err := template.ParseFS(assets.EmbeddedFiles, assets.EmailVerificationTemplatePath)
-	if err != nil {
-		p.logger.Error(ctx, err, "failed to parse email template")
-		return fmt.Errorf("failed to parse email template: %w", err)
+	if payload.Locale == "" {
+		payload.Locale = i18n.DefaultLocale
 	}
 
-	var body bytes.Buffer
-	if err := tpl.ExecuteTemplate(&body, "htmlBody", payload); err != nil {
-		p.logger.Error(ctx, err, "failed to execute email template")
-		return fmt.Errorf("failed to execute email template: %w", err)
+	msg, err := p.templates.Render(email.TemplateVerification, payload.Locale, payload)
+	if err != nil {
+		p.logger.Error(ctx, err, "failed to render email template")
+		return fmt.Errorf("failed to render email template: %w", err)
 	}
 
-	htmlContent := body.String()
-	subject := payload.Subject
-
-	err = p.email.Send(payload.Email, subject, htmlContent, payload)
+	err = p.email.Send(ctx, email.TemplateVerification, payload.Email, msg.Subject, msg.HTML, payload)
 	if err != nil {
 		p.logger.Error(ctx, err, "failed to send verify email")
 		return fmt.Errorf("failed to send verify email: %w", err)
@@ -67,23 +61,17 @@ func (p *TaskProcessor) ProcessTaskSendForgotPasswordEmail(ctx context.Context,
 		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
 	}
 
-	var tpl *template.Template
-	tpl, err := template.ParseFS(assets.EmbeddedFiles, assets.EmailForgotPasswordTemplatePath)
-	if err != nil {
-		p.logger.Error(ctx, err, "failed to parse forgot password email template")
-		return fmt.Errorf("failed to parse forgot password email template: %w", err)
+	if payload.Locale == "" {
+		payload.Locale = i18n.DefaultLocale
 	}
 
-	var body bytes.Buffer
-	if err := tpl.ExecuteTemplate(&body, "htmlBody", payload); err != nil {
-		p.logger.Error(ctx, err, "failed to execute forgot password email template")
-		return fmt.Errorf("failed to execute forgot password email template: %w", err)
+	msg, err := p.templates.Render(email.TemplateForgotPassword, payload.Locale, payload)
+	if err != nil {
+		p.logger.Error(ctx, err, "failed to render forgot password email template")
+		return fmt.Errorf("failed to render forgot password email template: %w", err)
 	}
 
-	htmlContent := body.String()
-	subject := payload.Subject
-
-	err = p.email.Send(payload.Email, subject, htmlContent, payload)
+	err = p.email.Send(ctx, email.TemplateForgotPassword, payload.Email, msg.Subject, msg.HTML, payload)
 	if err != nil {
 		p.logger.Error(ctx, err, "failed to send forgot password email")
 		return fmt.Errorf("failed to send forgot password email: %w", err)
@@ -92,3 +80,30 @@ func (p *TaskProcessor) ProcessTaskSendForgotPasswordEmail(ctx context.Context,
 	p.logger.Info(ctx, "forgot password email sent", logger.Field{Key: "email", Value: payload.Email})
 	return nil
 }
+
+func (p *TaskProcessor) ProcessTaskSendMagicLinkEmail(ctx context.Context, task *asynq.Task) error {
+	var payload gateway.PayloadSendMagicLinkEmail
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		p.logger.Error(ctx, err, "failed to unmarshal payload")
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+
+	if payload.Locale == "" {
+		payload.Locale = i18n.DefaultLocale
+	}
+
+	msg, err := p.templates.Render(email.TemplateMagicLink, payload.Locale, payload)
+	if err != nil {
+		p.logger.Error(ctx, err, "failed to render magic link email template")
+		return fmt.Errorf("failed to render magic link email template: %w", err)
+	}
+
+	err = p.email.Send(ctx, email.TemplateMagicLink, payload.Email, msg.Subject, msg.HTML, payload)
+	if err != nil {
+		p.logger.Error(ctx, err, "failed to send magic link email")
+		return fmt.Errorf("failed to send magic link email: %w", err)
+	}
+
+	p.logger.Info(ctx, "magic link email sent", logger.Field{Key: "email", Value: payload.Email})
+	return nil
+}
@@ -11,9 +11,10 @@ import (
 // TaskSessionCleanup is the unique identifier for the session cleanup task
 const TaskSessionCleanup = "auth:session:cleanup"
 
-// NewSessionCleanupTask creates a new task for session cleanup.
+// NewSessionCleanupTask creates a new task for session cleanup. It is not
+// retried; the next scheduled run removes whatever this one missed.
 func NewSessionCleanupTask() *asynq.Task {
-	return asynq.NewTask(TaskSessionCleanup, nil)
+	return asynq.NewTask(TaskSessionCleanup, nil, asynq.MaxRetry(0))
 }
 
 // SessionCleanupProcessor handles the execution of session cleanup.
@@ -1,6 +1,7 @@
 package adapters
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
@@ -16,19 +17,32 @@ type UserModel struct {
 	HashedPassword         *string    `db:"hashed_password"`
 	AuthProvider           string     `db:"auth_provider"`
 	AuthProviderID         *string    `db:"auth_provider_id"`
+	Role                   string     `db:"role"`
 	Timezone               string     `db:"timezone"`
+	Locale                 string     `db:"locale"`
+	WeekStart              int        `db:"week_start"`
+	LogLockDays            *int       `db:"log_lock_days"`
+	Avatar                 *string    `db:"avatar"`
+	Preferences            string     `db:"preferences"`
 	IsActive               bool       `db:"is_active"`
+	DeactivatedBy          *string    `db:"deactivated_by"`
 	IsVerified             bool       `db:"is_verified"`
-	VerifyToken            *string    `db:"verify_token"`
+	VerifyCodeHash         *string    `db:"verify_code_hash"`
 	VerifyExpiresAt        *time.Time `db:"verify_expires_at"`
-	PasswordResetToken     *string    `db:"password_reset_token"`
+	VerifyAttempts         int        `db:"verify_attempts"`
+	PasswordResetCodeHash  *string    `db:"password_reset_code_hash"`
 	PasswordResetExpiresAt *time.Time `db:"password_reset_expires_at"`
+	PasswordResetAttempts  int        `db:"password_reset_attempts"`
 	CreatedAt              time.Time  `db:"created_at"`
 	UpdatedAt              time.Time  `db:"updated_at"`
 }
 
 // ToUser converts the database model to a domain entity
 func (m *UserModel) ToUser() *user.User {
+	var deactivatedBy string
+	if m.DeactivatedBy != nil {
+		deactivatedBy = *m.DeactivatedBy
+	}
 	return user.UnmarshalUserFromDatabase(
 		m.UserID,
 		m.Email,
@@ -36,13 +50,18 @@ func (m *UserModel) ToUser() *user.User {
 		m.HashedPassword,
 		m.AuthProvider,
 		m.AuthProviderID,
+		m.Role,
 		m.Timezone,
+		m.Locale,
+		time.Weekday(m.WeekStart),
+		m.LogLockDays,
+		m.Avatar,
+		user.PreferencesFromJSON([]byte(m.Preferences)),
 		m.IsActive,
+		deactivatedBy,
 		m.IsVerified,
-		m.VerifyToken,
-		m.VerifyExpiresAt,
-		m.PasswordResetToken,
-		m.PasswordResetExpiresAt,
+		user.UnmarshalOneTimeCode(m.VerifyCodeHash, m.VerifyExpiresAt, m.VerifyAttempts),
+		user.UnmarshalOneTimeCode(m.PasswordResetCodeHash, m.PasswordResetExpiresAt, m.PasswordResetAttempts),
 		m.CreatedAt,
 		m.UpdatedAt,
 	)
@@ -50,21 +69,38 @@ func (m *UserModel) ToUser() *user.User {
 
 // UserModelFromUser converts a domain entity to a database model
 func UserModelFromUser(u *user.User) *UserModel {
-	return &UserModel{
-		UserID:                 u.UserID(),
-		Email:                  u.Email(),
-		Name:                   u.Name(),
-		HashedPassword:         u.HashedPassword(),
-		AuthProvider:           u.AuthProvider(),
-		AuthProviderID:         u.AuthProviderID(),
-		Timezone:               u.Timezone(),
-		IsActive:               u.IsActive(),
-		IsVerified:             u.IsVerified(),
-		VerifyToken:            u.VerifyToken(),
-		VerifyExpiresAt:        u.VerifyExpiresAt(),
-		PasswordResetToken:     u.PasswordResetToken(),
-		PasswordResetExpiresAt: u.PasswordResetExpiresAt(),
-		CreatedAt:              u.CreatedAt(),
-		UpdatedAt:              u.UpdatedAt(),
+	// Preferences only hold strings and numbers, which always encode
+	preferences, _ := json.Marshal(u.Preferences())
+
+	m := &UserModel{
+		UserID:         u.UserID(),
+		Email:          u.Email(),
+		Name:           u.Name(),
+		HashedPassword: u.HashedPassword(),
+		AuthProvider:   u.AuthProvider(),
+		AuthProviderID: u.AuthProviderID(),
+		Role:           u.Role(),
+		Timezone:       u.Timezone(),
+		Locale:         u.Locale(),
+		WeekStart:      int(u.WeekStart()),
+		LogLockDays:    u.LogLockDays(),
+		Avatar:         u.Avatar(),
+		Preferences:    string(preferences),
+		IsActive:       u.IsActive(),
+		IsVerified:     u.IsVerified(),
+		CreatedAt:      u.CreatedAt(),
+		UpdatedAt:      u.UpdatedAt(),
+	}
+	if by := u.DeactivatedBy(); by != "" {
+		m.DeactivatedBy = &by
+	}
+	if c := u.VerifyCode(); c != nil {
+		hash, expiresAt := c.Hash(), c.ExpiresAt()
+		m.VerifyCodeHash, m.VerifyExpiresAt, m.VerifyAttempts = &hash, &expiresAt, c.Attempts()
+	}
+	if c := u.PasswordResetCode(); c != nil {
+		hash, expiresAt := c.Hash(), c.ExpiresAt()
+		m.PasswordResetCodeHash, m.PasswordResetExpiresAt, m.PasswordResetAttempts = &hash, &expiresAt, c.Attempts()
 	}
+	return m
 }
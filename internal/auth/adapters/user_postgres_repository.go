@@ -26,12 +26,13 @@ func (r *UserPostgresRepository) Create(ctx context.Context, u *user.User) error
 
 	query := `
 		INSERT INTO users (
-			user_id, email, name, hashed_password, auth_provider, auth_provider_id,
-			timezone, is_active, is_verified, verify_token, verify_expires_at,
-			password_reset_token, password_reset_expires_at,
+			user_id, email, name, hashed_password, auth_provider, auth_provider_id, role,
+			timezone, locale, week_start, log_lock_days, avatar, preferences, is_active, deactivated_by, is_verified,
+			verify_code_hash, verify_expires_at, verify_attempts,
+			password_reset_code_hash, password_reset_expires_at, password_reset_attempts,
 			created_at, updated_at
 		)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24)
 	`
 
 	_, err := r.db.ExecContext(ctx, query,
@@ -41,13 +42,22 @@ func (r *UserPostgresRepository) Create(ctx context.Context, u *user.User) error
 		model.HashedPassword,
 		model.AuthProvider,
 		model.AuthProviderID,
+		model.Role,
 		model.Timezone,
+		model.Locale,
+		model.WeekStart,
+		model.LogLockDays,
+		model.Avatar,
+		model.Preferences,
 		model.IsActive,
+		model.DeactivatedBy,
 		model.IsVerified,
-		model.VerifyToken,
+		model.VerifyCodeHash,
 		model.VerifyExpiresAt,
-		model.PasswordResetToken,
+		model.VerifyAttempts,
+		model.PasswordResetCodeHash,
 		model.PasswordResetExpiresAt,
+		model.PasswordResetAttempts,
 		model.CreatedAt,
 		model.UpdatedAt,
 	)
@@ -66,9 +76,10 @@ func (r *UserPostgresRepository) Create(ctx context.Context, u *user.User) error
 func (r *UserPostgresRepository) FindByEmail(ctx context.Context, email string) (*user.User, error) {
 	query := `
 		SELECT
-			user_id, email, name, hashed_password, auth_provider, auth_provider_id,
-			timezone, is_active, is_verified, verify_token, verify_expires_at,
-			password_reset_token, password_reset_expires_at,
+			user_id, email, name, hashed_password, auth_provider, auth_provider_id, role,
+			timezone, locale, week_start, log_lock_days, avatar, preferences, is_active, deactivated_by, is_verified,
+			verify_code_hash, verify_expires_at, verify_attempts,
+			password_reset_code_hash, password_reset_expires_at, password_reset_attempts,
 			created_at, updated_at
 		FROM users
 		WHERE email = $1
@@ -89,9 +100,10 @@ func (r *UserPostgresRepository) FindByEmail(ctx context.Context, email string)
 func (r *UserPostgresRepository) FindByID(ctx context.Context, userID uuid.UUID) (*user.User, error) {
 	query := `
 		SELECT
-			user_id, email, name, hashed_password, auth_provider, auth_provider_id,
-			timezone, is_active, is_verified, verify_token, verify_expires_at,
-			password_reset_token, password_reset_expires_at,
+			user_id, email, name, hashed_password, auth_provider, auth_provider_id, role,
+			timezone, locale, week_start, log_lock_days, avatar, preferences, is_active, deactivated_by, is_verified,
+			verify_code_hash, verify_expires_at, verify_attempts,
+			password_reset_code_hash, password_reset_expires_at, password_reset_attempts,
 			created_at, updated_at
 		FROM users
 		WHERE user_id = $1
@@ -112,9 +124,10 @@ func (r *UserPostgresRepository) FindByID(ctx context.Context, userID uuid.UUID)
 func (r *UserPostgresRepository) FindByAuthProvider(ctx context.Context, provider, providerID string) (*user.User, error) {
 	query := `
 		SELECT
-			user_id, email, name, hashed_password, auth_provider, auth_provider_id,
-			timezone, is_active, is_verified, verify_token, verify_expires_at,
-			password_reset_token, password_reset_expires_at,
+			user_id, email, name, hashed_password, auth_provider, auth_provider_id, role,
+			timezone, locale, week_start, log_lock_days, avatar, preferences, is_active, deactivated_by, is_verified,
+			verify_code_hash, verify_expires_at, verify_attempts,
+			password_reset_code_hash, password_reset_expires_at, password_reset_attempts,
 			created_at, updated_at
 		FROM users
 		WHERE auth_provider = $1 AND auth_provider_id = $2
@@ -144,15 +157,26 @@ func (r *UserPostgresRepository) Update(ctx context.Context, u *user.User) error
 			hashed_password = $3,
 			auth_provider = $4,
 			auth_provider_id = $5,
-			timezone = $6,
-			is_active = $7,
-			is_verified = $8,
-			verify_token = $9,
-			verify_expires_at = $10,
-			password_reset_token = $11,
-			password_reset_expires_at = $12,
-			updated_at = $13
-		WHERE user_id = $14
+			role = $6,
+			timezone = $7,
+			locale = $8,
+			week_start = $9,
+			log_lock_days = $10,
+			avatar = $11,
+			preferences = $12,
+			is_active = $13,
+			is_verified = $14,
+			verify_code_hash = $15,
+			verify_expires_at = $16,
+			verify_attempts = CASE WHEN verify_code_hash IS NOT DISTINCT FROM $15
+				THEN GREATEST(verify_attempts, $17) ELSE $17 END,
+			password_reset_code_hash = $18,
+			password_reset_expires_at = $19,
+			password_reset_attempts = CASE WHEN password_reset_code_hash IS NOT DISTINCT FROM $18
+				THEN GREATEST(password_reset_attempts, $20) ELSE $20 END,
+			updated_at = $21,
+			deactivated_by = $23
+		WHERE user_id = $22
 	`
 
 	res, err := r.db.ExecContext(ctx, query,
@@ -161,15 +185,24 @@ func (r *UserPostgresRepository) Update(ctx context.Context, u *user.User) error
 		model.HashedPassword,
 		model.AuthProvider,
 		model.AuthProviderID,
+		model.Role,
 		model.Timezone,
+		model.Locale,
+		model.WeekStart,
+		model.LogLockDays,
+		model.Avatar,
+		model.Preferences,
 		model.IsActive,
 		model.IsVerified,
-		model.VerifyToken,
+		model.VerifyCodeHash,
 		model.VerifyExpiresAt,
-		model.PasswordResetToken,
+		model.VerifyAttempts,
+		model.PasswordResetCodeHash,
 		model.PasswordResetExpiresAt,
+		model.PasswordResetAttempts,
 		model.UpdatedAt,
 		model.UserID,
+		model.DeactivatedBy,
 	)
 
 	if err != nil {
@@ -186,6 +219,58 @@ func (r *UserPostgresRepository) Update(ctx context.Context, u *user.User) error
 	return nil
 }
 
+// RecordCodeAttempt counts a guess at the user's pending code. The check
+// and the increment are one statement, so concurrent guesses each take
+// their own attempt and no more than MaxCodeAttempts are ever allowed.
+func (r *UserPostgresRepository) RecordCodeAttempt(ctx context.Context, userID uuid.UUID, kind user.CodeKind) (bool, error) {
+	var query string
+	switch kind {
+	case user.CodeEmailVerification:
+		query = `
+			UPDATE users SET verify_attempts = verify_attempts + 1
+			WHERE user_id = $1 AND verify_code_hash IS NOT NULL AND verify_attempts < $2
+			RETURNING verify_attempts`
+	case user.CodePasswordReset:
+		query = `
+			UPDATE users SET password_reset_attempts = password_reset_attempts + 1
+			WHERE user_id = $1 AND password_reset_code_hash IS NOT NULL AND password_reset_attempts < $2
+			RETURNING password_reset_attempts`
+	default:
+		return false, fmt.Errorf("unknown code kind %d", kind)
+	}
+
+	var attempts int
+	err := r.db.QueryRowxContext(ctx, query, userID, user.MaxCodeAttempts).Scan(&attempts)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("record code attempt: %w", err)
+	}
+	return true, nil
+}
+
+// ClearExpiredCodes removes verification and password reset codes that
+// can no longer be used
+func (r *UserPostgresRepository) ClearExpiredCodes(ctx context.Context) (int64, error) {
+	query := `
+		UPDATE users
+		SET
+			verify_code_hash = CASE WHEN verify_expires_at < NOW() THEN NULL ELSE verify_code_hash END,
+			verify_expires_at = CASE WHEN verify_expires_at < NOW() THEN NULL ELSE verify_expires_at END,
+			verify_attempts = CASE WHEN verify_expires_at < NOW() THEN 0 ELSE verify_attempts END,
+			password_reset_code_hash = CASE WHEN password_reset_expires_at < NOW() THEN NULL ELSE password_reset_code_hash END,
+			password_reset_expires_at = CASE WHEN password_reset_expires_at < NOW() THEN NULL ELSE password_reset_expires_at END,
+			password_reset_attempts = CASE WHEN password_reset_expires_at < NOW() THEN 0 ELSE password_reset_attempts END
+		WHERE verify_expires_at < NOW() OR password_reset_expires_at < NOW()
+	`
+	res, err := r.db.ExecContext(ctx, query)
+	if err != nil {
+		return 0, fmt.Errorf("clear expired codes: %w", err)
+	}
+	return res.RowsAffected()
+}
+
 func (r *UserPostgresRepository) Delete(ctx context.Context, userID uuid.UUID) error {
 	query := `DELETE FROM users WHERE user_id = $1`
 	res, err := r.db.ExecContext(ctx, query, userID)
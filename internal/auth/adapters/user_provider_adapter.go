@@ -40,6 +40,7 @@ func (a *UserProviderAdapter) GetUserByID(ctx context.Context, userID string) (*
 		Email:    u.Email(),
 		Name:     u.Name(),
 		Timezone: u.Timezone(),
+		Locale:   u.Locale(),
 	}, nil
 }
 
@@ -56,6 +57,7 @@ func (a *UserProviderAdapter) GetUserByEmail(ctx context.Context, email string)
 		Email:    u.Email(),
 		Name:     u.Name(),
 		Timezone: u.Timezone(),
+		Locale:   u.Locale(),
 	}, nil
 }
 
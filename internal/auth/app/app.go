@@ -8,6 +8,7 @@ import (
 	"github.com/semmidev/ethos-go/internal/auth/app/query"
 	authctx "github.com/semmidev/ethos-go/internal/auth/infrastructure/context"
 	"github.com/semmidev/ethos-go/internal/auth/infrastructure/token"
+	"github.com/semmidev/ethos-go/internal/common/breaker"
 )
 
 // AuthServiceInterface defines the interface for authentication used by gRPC interceptor
@@ -22,6 +23,27 @@ type Application struct {
 	Queries        Queries
 	AuthMiddleware func(http.Handler) http.Handler
 	AuthService    AuthServiceInterface
+	// JWKSHandler serves the public signing keys; nil when tokens are
+	// signed with a shared HMAC secret.
+	JWKSHandler http.Handler
+	// AvatarUploadHandler accepts multipart avatar uploads; it expects
+	// AuthMiddleware in front of it.
+	AvatarUploadHandler http.Handler
+	// UploadsHandler serves locally stored uploads; nil when uploads are
+	// served from elsewhere.
+	UploadsHandler http.Handler
+	// SCIMHandler serves SCIM 2.0 user provisioning for the identity
+	// provider; nil when no provisioning token is configured.
+	SCIMHandler http.Handler
+	// ConsentGate refuses API use until the current legal documents are
+	// accepted.
+	ConsentGate *ConsentGate
+	// RequestUserID reads the user ID from a request's access token, ""
+	// when there is no valid one.
+	RequestUserID func(r *http.Request) string
+	// Breakers guard the external services the module calls, so their
+	// state can be exported as metrics.
+	Breakers []*breaker.Breaker
 }
 
 // Commands groups all command handlers (write operations)
@@ -38,8 +60,21 @@ type Commands struct {
 	ForgotPassword     command.ForgotPasswordHandler
 	ResetPassword      command.ResetPasswordHandler
 	LoginGoogle        command.LoginGoogleHandler
+	LoginSSO           command.LoginSSOHandler
+	RequestMagicLink   command.RequestMagicLinkHandler
+	VerifyMagicLink    command.VerifyMagicLinkHandler
 	RevokeSessions     command.RevokeAllOtherSessionsHandler
+	RevokeSession      command.RevokeSessionHandler
 	DeleteAccount      command.DeleteAccountHandler
+	DeactivateAccount  command.DeactivateAccountHandler
+	UploadAvatar       command.UploadAvatarHandler
+	UpdatePreferences  command.UpdatePreferencesHandler
+	AcceptConsent      command.AcceptConsentHandler
+	// Account management by the identity provider through SCIM
+	ProvisionUser         command.ProvisionUserHandler
+	UpdateProvisionedUser command.UpdateProvisionedUserHandler
+	// Operator commands
+	AnonymizeUser command.AnonymizeUserHandler
 }
 
 // Queries groups all query handlers (read operations)
@@ -48,5 +83,10 @@ type Queries struct {
 	ListSessions     query.ListSessionsHandler
 	GetProfile       query.GetProfileHandler
 	GetGoogleAuthURL query.GetGoogleAuthURLHandler
+	GetSSOAuthURL    query.GetSSOAuthURLHandler
 	ExportUserData   query.ExportUserDataHandler
+	IntrospectToken  query.IntrospectTokenHandler
+	GetPreferences   query.GetPreferencesHandler
+	GetUserAccount   query.GetUserAccountHandler
+	ListConsents     query.ListConsentsHandler
 }
@@ -0,0 +1,84 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/semmidev/ethos-go/internal/auth/domain/consent"
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+)
+
+// AcceptConsentCommand records that the user accepted the current version
+// of a legal document
+type AcceptConsentCommand struct {
+	UserID   string
+	Document string // terms or privacy
+	Version  string // must be the document's current version
+	ClientIP string
+}
+
+// AcceptConsentHandler handles consent to legal documents
+type AcceptConsentHandler decorator.CommandHandler[AcceptConsentCommand]
+
+type acceptConsentHandler struct {
+	repo     consent.Repository
+	versions consent.Versions
+}
+
+// NewAcceptConsentHandler creates a new handler. versions holds the
+// current version of each document.
+func NewAcceptConsentHandler(
+	repo consent.Repository,
+	versions consent.Versions,
+	log logger.Logger,
+	metricsClient decorator.MetricsClient,
+) AcceptConsentHandler {
+	if repo == nil {
+		panic("nil consent repo")
+	}
+
+	return decorator.ApplyCommandDecorators(
+		acceptConsentHandler{
+			repo:     repo,
+			versions: versions,
+		},
+		log,
+		metricsClient,
+	)
+}
+
+// Handle only accepts a document's current version, so a client showing an
+// outdated document can't record consent to it
+func (h acceptConsentHandler) Handle(ctx context.Context, cmd AcceptConsentCommand) error {
+	userID, err := uuid.Parse(cmd.UserID)
+	if err != nil {
+		return apperror.ValidationFailed("invalid user ID")
+	}
+
+	c, err := consent.New(userID, cmd.Document, cmd.Version, cmd.ClientIP, time.Now())
+	switch {
+	case errors.Is(err, consent.ErrUnknownDocument):
+		return apperror.InvalidInput("document", err.Error())
+	case errors.Is(err, consent.ErrMissingVersion):
+		return apperror.InvalidInput("version", err.Error())
+	case err != nil:
+		return apperror.InternalError(err)
+	}
+
+	current := h.versions[cmd.Document]
+	if current == "" {
+		return apperror.InvalidInput("document", "no version of this document is published")
+	}
+	if cmd.Version != current {
+		return apperror.InvalidInput("version", "is not the current version").WithDetails("current_version", current)
+	}
+
+	if err := h.repo.Save(ctx, c); err != nil {
+		return apperror.InternalError(err)
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/auth/app"
+	"github.com/semmidev/ethos-go/internal/auth/app/command"
+	"github.com/semmidev/ethos-go/internal/auth/domain/consent"
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/testutil"
+)
+
+func TestAcceptConsentHandler(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given published terms and privacy policy versions", t, func() {
+		ctx := context.Background()
+		userID := uuid.NewString()
+		versions := consent.Versions{consent.DocumentTerms: "2026-10", consent.DocumentPrivacy: "2026-09"}
+
+		repo := testutil.NewConsentRepository()
+		gate := app.NewConsentGate(repo, versions)
+		handler := command.NewAcceptConsentHandler(repo, versions, testutil.NopLogger{}, &decorator.NoOpMetricsClient{})
+
+		accept := func(document, version string) error {
+			return handler.Handle(ctx, command.AcceptConsentCommand{
+				UserID:   userID,
+				Document: document,
+				Version:  version,
+				ClientIP: "10.0.0.1",
+			})
+		}
+
+		Convey("Then a user who accepted neither is blocked until both are accepted", func() {
+			appErr := apperror.GetAppError(gate.CheckConsents(ctx, userID))
+			So(appErr, ShouldNotBeNil)
+			So(appErr.Code, ShouldEqual, apperror.ErrCodeConsentRequired)
+			So(appErr.Details["documents"], ShouldResemble, []interface{}{consent.DocumentTerms, consent.DocumentPrivacy})
+
+			So(accept(consent.DocumentTerms, "2026-10"), ShouldBeNil)
+			appErr = apperror.GetAppError(gate.CheckConsents(ctx, userID))
+			So(appErr, ShouldNotBeNil)
+			So(appErr.Details["documents"], ShouldResemble, []interface{}{consent.DocumentPrivacy})
+
+			So(accept(consent.DocumentPrivacy, "2026-09"), ShouldBeNil)
+			So(gate.CheckConsents(ctx, userID), ShouldBeNil)
+		})
+
+		Convey("When the user accepts an outdated version", func() {
+			err := accept(consent.DocumentTerms, "2026-01")
+
+			Convey("Then it is rejected with the current version and nothing is recorded", func() {
+				appErr := apperror.GetAppError(err)
+				So(appErr, ShouldNotBeNil)
+				So(appErr.Code, ShouldEqual, apperror.ErrCodeInvalidInput)
+				So(appErr.Details["current_version"], ShouldEqual, "2026-10")
+
+				consents, err := repo.ListByUserID(ctx, uuid.MustParse(userID))
+				So(err, ShouldBeNil)
+				So(consents, ShouldBeEmpty)
+			})
+		})
+
+		Convey("When the user accepts an unknown document", func() {
+			err := accept("cookies", "2026-10")
+
+			Convey("Then it is rejected", func() {
+				appErr := apperror.GetAppError(err)
+				So(appErr, ShouldNotBeNil)
+				So(appErr.Code, ShouldEqual, apperror.ErrCodeInvalidInput)
+			})
+		})
+	})
+
+	Convey("Given no published versions", t, func() {
+		gate := app.NewConsentGate(testutil.NewConsentRepository(), consent.Versions{})
+
+		Convey("Then no user is blocked", func() {
+			So(gate.CheckConsents(context.Background(), uuid.NewString()), ShouldBeNil)
+		})
+	})
+}
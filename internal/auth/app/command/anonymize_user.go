@@ -0,0 +1,138 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	authevents "github.com/semmidev/ethos-go/contracts/events/auth"
+	"github.com/semmidev/ethos-go/internal/auth/domain/service"
+	"github.com/semmidev/ethos-go/internal/auth/domain/session"
+	"github.com/semmidev/ethos-go/internal/auth/domain/user"
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/events"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/common/storage"
+)
+
+// AnonymizeUserCommand forgets a user's personal data on an operator's
+// request, keeping the account's habits and logs for analytics
+type AnonymizeUserCommand struct {
+	UserID string
+	Actor  string // the operator, recorded in the audit log
+	Reason string
+}
+
+// AnonymizeUserHandler handles user anonymization
+type AnonymizeUserHandler decorator.CommandHandler[AnonymizeUserCommand]
+
+type anonymizeUserHandler struct {
+	userRepo    user.UserReader
+	anonymizer  user.Anonymizer
+	sessionRepo session.Repository
+	denylist    service.SessionDenylist
+	authService *session.AuthenticationService
+	store       storage.Store
+	publisher   events.Publisher
+}
+
+// NewAnonymizeUserHandler creates a new handler
+func NewAnonymizeUserHandler(
+	userRepo user.UserReader,
+	anonymizer user.Anonymizer,
+	sessionRepo session.Repository,
+	denylist service.SessionDenylist,
+	authService *session.AuthenticationService,
+	store storage.Store,
+	publisher events.Publisher,
+	log logger.Logger,
+	metricsClient decorator.MetricsClient,
+) AnonymizeUserHandler {
+	if userRepo == nil {
+		panic("nil user repo")
+	}
+	if anonymizer == nil {
+		panic("nil anonymizer")
+	}
+	if sessionRepo == nil {
+		panic("nil session repo")
+	}
+	if denylist == nil {
+		panic("nil session denylist")
+	}
+	if authService == nil {
+		panic("nil auth service")
+	}
+	if store == nil {
+		panic("nil store")
+	}
+	if publisher == nil {
+		panic("nil publisher")
+	}
+
+	return decorator.ApplyCommandDecorators(
+		anonymizeUserHandler{
+			userRepo:    userRepo,
+			anonymizer:  anonymizer,
+			sessionRepo: sessionRepo,
+			denylist:    denylist,
+			authService: authService,
+			store:       store,
+			publisher:   publisher,
+		},
+		log,
+		metricsClient,
+	)
+}
+
+func (h anonymizeUserHandler) Handle(ctx context.Context, cmd AnonymizeUserCommand) error {
+	userID, err := uuid.Parse(cmd.UserID)
+	if err != nil {
+		return apperror.ValidationFailed("invalid user ID")
+	}
+	if cmd.Actor == "" {
+		return apperror.InvalidInput("actor", "is required")
+	}
+
+	existingUser, err := h.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return apperror.NotFound("user", cmd.UserID)
+	}
+
+	// The account can no longer be signed in to, so deny the access tokens
+	// of its sessions before they are blocked
+	sessions, err := h.sessionRepo.FindAllByUserID(ctx, userID)
+	if err != nil {
+		return apperror.InternalError(err)
+	}
+	if err := denySessions(ctx, h.denylist, h.authService, sessions...); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	err = h.anonymizer.Anonymize(ctx, user.Anonymization{
+		UserID: userID,
+		Email:  existingUser.Email(),
+		Actor:  cmd.Actor,
+		Reason: cmd.Reason,
+		At:     now,
+	})
+	if errors.Is(err, user.ErrNotFound) {
+		return apperror.NotFound("user", cmd.UserID)
+	}
+	if err != nil {
+		return apperror.InternalError(err)
+	}
+
+	// As on account deletion, a failed delete only leaves an unreferenced
+	// object behind
+	if avatar := existingUser.Avatar(); avatar != nil && !storage.IsExternal(*avatar) {
+		_ = h.store.Delete(ctx, *avatar)
+	}
+
+	_ = h.publisher.Publish(ctx, authevents.NewUserAnonymized(cmd.UserID, now))
+
+	return nil
+}
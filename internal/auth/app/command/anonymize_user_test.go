@@ -0,0 +1,110 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/smartystreets/goconvey/convey"
+
+	authevents "github.com/semmidev/ethos-go/contracts/events/auth"
+	"github.com/semmidev/ethos-go/internal/auth/app/command"
+	"github.com/semmidev/ethos-go/internal/auth/domain/session"
+	"github.com/semmidev/ethos-go/internal/auth/domain/user"
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/storage"
+	"github.com/semmidev/ethos-go/internal/testutil"
+)
+
+func TestAnonymizeUserHandler(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given a signed in user with a password", t, func() {
+		ctx := context.Background()
+		u := testutil.NewUserBuilder().WithName("Sam").WithEmail("sam@example.com").WithHashedPassword("hashed").Build()
+		laptop := testutil.NewSessionBuilder(u).Build()
+
+		users := testutil.NewUserRepository(u)
+		anonymizer := testutil.NewUserAnonymizer(users)
+		denylist := testutil.NewSessionDenylist()
+		publisher := testutil.NewRecordingPublisher()
+		store, err := storage.NewLocalStore(t.TempDir(), "https://cdn.example.com/")
+		So(err, ShouldBeNil)
+
+		handler := command.NewAnonymizeUserHandler(
+			users,
+			anonymizer,
+			testutil.NewSessionRepository(laptop),
+			denylist,
+			session.NewAuthenticationService(15*time.Minute, 24*time.Hour),
+			store,
+			publisher,
+			testutil.NopLogger{},
+			&decorator.NoOpMetricsClient{},
+		)
+
+		Convey("When an operator anonymizes them", func() {
+			err := handler.Handle(ctx, command.AnonymizeUserCommand{
+				UserID: u.UserID().String(),
+				Actor:  "ops",
+				Reason: "TICKET-42",
+			})
+			So(err, ShouldBeNil)
+
+			Convey("Then their personal data is gone and the account is inactive", func() {
+				found, err := users.FindByID(ctx, u.UserID())
+				So(err, ShouldBeNil)
+				So(found.Name(), ShouldEqual, user.AnonymizedName)
+				So(found.Email(), ShouldEqual, user.AnonymizedEmail(u.UserID()))
+				So(found.HashedPassword(), ShouldBeNil)
+				So(found.IsActive(), ShouldBeFalse)
+
+				_, err = users.FindByEmail(ctx, "sam@example.com")
+				So(err, ShouldEqual, user.ErrNotFound)
+			})
+
+			Convey("Then the operator and reason are recorded with the forgotten address", func() {
+				recorded := anonymizer.Anonymizations()
+				So(recorded, ShouldHaveLength, 1)
+				So(recorded[0].Actor, ShouldEqual, "ops")
+				So(recorded[0].Reason, ShouldEqual, "TICKET-42")
+				So(recorded[0].Email, ShouldEqual, "sam@example.com")
+			})
+
+			Convey("Then their sessions' access tokens are denied", func() {
+				denied, err := denylist.IsDenied(ctx, laptop.SessionID())
+				So(err, ShouldBeNil)
+				So(denied, ShouldBeTrue)
+			})
+
+			Convey("Then downstream consumers are told", func() {
+				So(publisher.EventTypes(), ShouldResemble, []string{authevents.UserAnonymizedType})
+			})
+		})
+
+		Convey("When the user does not exist", func() {
+			err := handler.Handle(ctx, command.AnonymizeUserCommand{UserID: uuid.NewString(), Actor: "ops"})
+
+			Convey("Then it is not found and nothing is recorded", func() {
+				appErr := apperror.GetAppError(err)
+				So(appErr, ShouldNotBeNil)
+				So(appErr.Code, ShouldEqual, apperror.ErrCodeNotFound)
+				So(anonymizer.Anonymizations(), ShouldBeEmpty)
+				So(publisher.Events(), ShouldBeEmpty)
+			})
+		})
+
+		Convey("When no operator is given", func() {
+			err := handler.Handle(ctx, command.AnonymizeUserCommand{UserID: u.UserID().String()})
+
+			Convey("Then it is rejected", func() {
+				appErr := apperror.GetAppError(err)
+				So(appErr, ShouldNotBeNil)
+				So(appErr.Code, ShouldEqual, apperror.ErrCodeInvalidInput)
+				So(anonymizer.Anonymizations(), ShouldBeEmpty)
+			})
+		})
+	})
+}
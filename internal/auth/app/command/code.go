@@ -0,0 +1,19 @@
+package command
+
+import (
+	"time"
+
+	"github.com/semmidev/ethos-go/internal/auth/domain/user"
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+)
+
+// codeExpirationMinutes is the lifetime of emailed codes, as the emails
+// state it
+const codeExpirationMinutes = int(user.CodeTTL / time.Minute)
+
+// invalidCodeError is returned for every failed code check, including an
+// unknown email, so responses don't reveal whether an account exists or why
+// the code was refused
+func invalidCodeError() error {
+	return apperror.InvalidInput("code", user.ErrInvalidCode.Error())
+}
@@ -0,0 +1,101 @@
+package command
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/semmidev/ethos-go/internal/auth/domain/service"
+	"github.com/semmidev/ethos-go/internal/auth/domain/session"
+	"github.com/semmidev/ethos-go/internal/auth/domain/user"
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+)
+
+// DeactivateAccountCommand switches the user's account off without deleting
+// anything. Reminders and reports skip inactive accounts, and the next
+// successful login can reactivate it.
+type DeactivateAccountCommand struct {
+	UserID string
+}
+
+// DeactivateAccountHandler handles account deactivation
+type DeactivateAccountHandler decorator.CommandHandler[DeactivateAccountCommand]
+
+type deactivateAccountHandler struct {
+	userRepo    user.Repository
+	sessionRepo session.Repository
+	denylist    service.SessionDenylist
+	authService *session.AuthenticationService
+}
+
+// NewDeactivateAccountHandler creates a new handler
+func NewDeactivateAccountHandler(
+	userRepo user.Repository,
+	sessionRepo session.Repository,
+	denylist service.SessionDenylist,
+	authService *session.AuthenticationService,
+	log logger.Logger,
+	metricsClient decorator.MetricsClient,
+) DeactivateAccountHandler {
+	if userRepo == nil {
+		panic("nil user repo")
+	}
+	if sessionRepo == nil {
+		panic("nil session repo")
+	}
+	if denylist == nil {
+		panic("nil session denylist")
+	}
+	if authService == nil {
+		panic("nil auth service")
+	}
+
+	return decorator.ApplyCommandDecorators(
+		deactivateAccountHandler{
+			userRepo:    userRepo,
+			sessionRepo: sessionRepo,
+			denylist:    denylist,
+			authService: authService,
+		},
+		log,
+		metricsClient,
+	)
+}
+
+func (h deactivateAccountHandler) Handle(ctx context.Context, cmd DeactivateAccountCommand) error {
+	userID, err := uuid.Parse(cmd.UserID)
+	if err != nil {
+		return apperror.ValidationFailed("invalid user ID")
+	}
+
+	u, err := h.userRepo.FindByID(ctx, userID)
+	if errors.Is(err, user.ErrNotFound) {
+		return apperror.NotFound("user", cmd.UserID)
+	}
+	if err != nil {
+		return apperror.DatabaseError("find user", err)
+	}
+
+	// Every session is signed out, including the one making this request
+	sessions, err := h.sessionRepo.FindAllByUserID(ctx, userID)
+	if err != nil {
+		return apperror.DatabaseError("find sessions", err)
+	}
+	if err := denySessions(ctx, h.denylist, h.authService, sessions...); err != nil {
+		return err
+	}
+
+	if u.IsActive() {
+		u.Deactivate(user.DeactivatedByUser)
+		if err := h.userRepo.Update(ctx, u); err != nil {
+			return apperror.DatabaseError("deactivate user", err)
+		}
+	}
+
+	if err := h.sessionRepo.DeleteAllByUserID(ctx, userID); err != nil {
+		return apperror.DatabaseError("delete sessions", err)
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/auth/app/command"
+	"github.com/semmidev/ethos-go/internal/auth/domain/session"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/testutil"
+)
+
+func TestDeactivateAccountHandler(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given a user signed in on two devices", t, func() {
+		ctx := context.Background()
+		u := testutil.NewUserBuilder().Build()
+		laptop := testutil.NewSessionBuilder(u).Build()
+		phone := testutil.NewSessionBuilder(u).Build()
+
+		users := testutil.NewUserRepository(u)
+		sessions := testutil.NewSessionRepository(laptop, phone)
+		denylist := testutil.NewSessionDenylist()
+		authService := session.NewAuthenticationService(15*time.Minute, 24*time.Hour)
+		handler := command.NewDeactivateAccountHandler(users, sessions, denylist, authService, testutil.NopLogger{}, &decorator.NoOpMetricsClient{})
+
+		Convey("When the user deactivates their account", func() {
+			err := handler.Handle(ctx, command.DeactivateAccountCommand{UserID: u.UserID().String()})
+			So(err, ShouldBeNil)
+
+			Convey("Then the account is kept but inactive", func() {
+				found, err := users.FindByID(ctx, u.UserID())
+				So(err, ShouldBeNil)
+				So(found.IsActive(), ShouldBeFalse)
+			})
+
+			Convey("Then every session is signed out and its access token denied", func() {
+				So(sessions.Len(), ShouldEqual, 0)
+				for _, s := range []*session.Session{laptop, phone} {
+					denied, err := denylist.IsDenied(ctx, s.SessionID())
+					So(err, ShouldBeNil)
+					So(denied, ShouldBeTrue)
+				}
+			})
+
+			Convey("Then deactivating it again succeeds", func() {
+				So(handler.Handle(ctx, command.DeactivateAccountCommand{UserID: u.UserID().String()}), ShouldBeNil)
+			})
+		})
+	})
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/semmidev/ethos-go/internal/auth/domain/service"
 	"github.com/semmidev/ethos-go/internal/auth/domain/session"
 	"github.com/semmidev/ethos-go/internal/auth/domain/user"
 	"github.com/semmidev/ethos-go/internal/common/apperror"
 	"github.com/semmidev/ethos-go/internal/common/decorator"
 	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/common/storage"
 )
 
 // DeleteAccountCommand permanently deletes a user account
@@ -24,12 +26,18 @@ type DeleteAccountHandler decorator.CommandHandler[DeleteAccountCommand]
 type deleteAccountHandler struct {
 	userRepo    user.Repository
 	sessionRepo session.Repository
+	denylist    service.SessionDenylist
+	authService *session.AuthenticationService
+	store       storage.Store
 }
 
 // NewDeleteAccountHandler creates a new handler
 func NewDeleteAccountHandler(
 	userRepo user.Repository,
 	sessionRepo session.Repository,
+	denylist service.SessionDenylist,
+	authService *session.AuthenticationService,
+	store storage.Store,
 	log logger.Logger,
 	metricsClient decorator.MetricsClient,
 ) DeleteAccountHandler {
@@ -39,11 +47,23 @@ func NewDeleteAccountHandler(
 	if sessionRepo == nil {
 		panic("nil session repo")
 	}
+	if denylist == nil {
+		panic("nil session denylist")
+	}
+	if authService == nil {
+		panic("nil auth service")
+	}
+	if store == nil {
+		panic("nil store")
+	}
 
 	return decorator.ApplyCommandDecorators(
 		deleteAccountHandler{
 			userRepo:    userRepo,
 			sessionRepo: sessionRepo,
+			denylist:    denylist,
+			authService: authService,
+			store:       store,
 		},
 		log,
 		metricsClient,
@@ -61,7 +81,7 @@ func (h deleteAccountHandler) Handle(ctx context.Context, cmd DeleteAccountComma
 	}
 
 	// Verify user exists
-	_, err = h.userRepo.FindByID(ctx, userID)
+	existingUser, err := h.userRepo.FindByID(ctx, userID)
 	if err != nil {
 		return apperror.NotFound("user", cmd.UserID)
 	}
@@ -69,10 +89,25 @@ func (h deleteAccountHandler) Handle(ctx context.Context, cmd DeleteAccountComma
 	// Note: Password verification could be added here for email-based users
 	// For now, we rely on the frontend confirmation modal
 
+	// Sessions go with the user, so deny their access tokens first
+	sessions, err := h.sessionRepo.FindAllByUserID(ctx, userID)
+	if err != nil {
+		return apperror.InternalError(err)
+	}
+	if err := denySessions(ctx, h.denylist, h.authService, sessions...); err != nil {
+		return err
+	}
+
 	// Delete user (FK cascade will handle habits, logs, notifications)
 	if err := h.userRepo.Delete(ctx, userID); err != nil {
 		return apperror.InternalError(err)
 	}
 
+	// Uploaded files are not covered by the cascade; a failed delete only
+	// leaves an unreferenced object behind
+	if avatar := existingUser.Avatar(); avatar != nil && !storage.IsExternal(*avatar) {
+		_ = h.store.Delete(ctx, *avatar)
+	}
+
 	return nil
 }
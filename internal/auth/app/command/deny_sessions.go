@@ -0,0 +1,30 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/semmidev/ethos-go/internal/auth/domain/service"
+	"github.com/semmidev/ethos-go/internal/auth/domain/session"
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+)
+
+// denySessions puts sessions on the denylist until their newest access token
+// expires, so revoking them takes effect immediately. It runs before the
+// sessions are blocked or deleted: a failure leaves them untouched and the
+// request can simply be retried.
+func denySessions(
+	ctx context.Context,
+	denylist service.SessionDenylist,
+	authService *session.AuthenticationService,
+	sessions ...*session.Session,
+) error {
+	now := time.Now()
+	for _, sess := range sessions {
+		ttl := authService.AccessTokenRemaining(sess, now)
+		if err := denylist.Deny(ctx, sess.SessionID(), ttl); err != nil {
+			return apperror.InternalError(err)
+		}
+	}
+	return nil
+}
@@ -22,12 +22,14 @@ type ForgotPasswordHandler decorator.CommandHandler[ForgotPasswordCommand]
 
 type forgotPasswordHandler struct {
 	userRepo   user.Repository
+	codeHasher user.CodeHasher
 	validator  *validator.Validator
 	dispatcher gateway.TaskDispatcher
 }
 
 func NewForgotPasswordHandler(
 	userRepo user.Repository,
+	codeHasher user.CodeHasher,
 	validator *validator.Validator,
 	dispatcher gateway.TaskDispatcher,
 	log logger.Logger,
@@ -36,6 +38,7 @@ func NewForgotPasswordHandler(
 	return decorator.ApplyCommandDecorators(
 		forgotPasswordHandler{
 			userRepo:   userRepo,
+			codeHasher: codeHasher,
 			validator:  validator,
 			dispatcher: dispatcher,
 		},
@@ -60,10 +63,7 @@ func (h forgotPasswordHandler) Handle(ctx context.Context, cmd ForgotPasswordCom
 	if err != nil {
 		return apperror.InternalError(err)
 	}
-	expiresAt := time.Now().Add(15 * time.Minute)
-
-	// Use domain setter
-	u.SetPasswordResetToken(&code, &expiresAt)
+	u.IssuePasswordResetCode(h.codeHasher, code, time.Now())
 
 	if err := h.userRepo.Update(ctx, u); err != nil {
 		return apperror.InternalError(err)
@@ -74,8 +74,9 @@ func (h forgotPasswordHandler) Handle(ctx context.Context, cmd ForgotPasswordCom
 		UserID:                     u.UserID(),
 		Name:                       u.Name(),
 		Email:                      u.Email(),
+		Locale:                     u.Locale(),
 		VerificationCode:           code,
-		VerificationCodeExpiration: 15,
+		VerificationCodeExpiration: codeExpirationMinutes,
 	}
 
 	if err := h.dispatcher.DispatchSendForgotPasswordEmail(ctx, payload); err != nil {
@@ -2,9 +2,7 @@ package command
 
 import (
 	"context"
-	"time"
 
-	authevents "github.com/semmidev/ethos-go/internal/auth/domain/events"
 	"github.com/semmidev/ethos-go/internal/auth/domain/service"
 	"github.com/semmidev/ethos-go/internal/auth/domain/session"
 	"github.com/semmidev/ethos-go/internal/auth/domain/user"
@@ -12,7 +10,6 @@ import (
 	"github.com/semmidev/ethos-go/internal/common/decorator"
 	"github.com/semmidev/ethos-go/internal/common/events"
 	"github.com/semmidev/ethos-go/internal/common/logger"
-	"github.com/semmidev/ethos-go/internal/common/random"
 	"github.com/semmidev/ethos-go/internal/common/validator"
 )
 
@@ -22,6 +19,8 @@ type LoginCommand struct {
 	Password  string `json:"password" validate:"required"`
 	UserAgent string `json:"user_agent"`
 	ClientIP  string `json:"client_ip"`
+	// Reactivate confirms that a deactivated account should be switched back on
+	Reactivate bool `json:"reactivate"`
 }
 
 func (c LoginCommand) Validate() error {
@@ -42,13 +41,10 @@ type LoginResult struct {
 type LoginHandler decorator.CommandHandlerWithResult[LoginCommand, *LoginResult]
 
 type loginHandler struct {
-	sessionRepo    session.Repository
 	userRepo       user.Repository
 	passwordHasher service.PasswordHasher
-	tokenIssuer    service.TokenIssuer
-	authService    *session.AuthenticationService
 	validator      *validator.Validator
-	publisher      events.Publisher
+	sessions       sessionStarter
 }
 
 func NewLoginHandler(
@@ -57,6 +53,7 @@ func NewLoginHandler(
 	passwordHasher service.PasswordHasher,
 	tokenIssuer service.TokenIssuer,
 	authService *session.AuthenticationService,
+	geoLocator service.GeoLocator,
 	validator *validator.Validator,
 	publisher events.Publisher, // Injected publisher
 	log logger.Logger,
@@ -64,13 +61,16 @@ func NewLoginHandler(
 ) LoginHandler {
 	return decorator.ApplyCommandResultDecorators(
 		loginHandler{
-			sessionRepo:    sessionRepo,
 			userRepo:       userRepo,
 			passwordHasher: passwordHasher,
-			tokenIssuer:    tokenIssuer,
-			authService:    authService,
 			validator:      validator,
-			publisher:      publisher,
+			sessions: sessionStarter{
+				sessionRepo: sessionRepo,
+				tokenIssuer: tokenIssuer,
+				authService: authService,
+				geoLocator:  geoLocator,
+				publisher:   publisher,
+			},
 		},
 		log,
 		metricsClient,
@@ -114,55 +114,32 @@ func (h loginHandler) Handle(ctx context.Context, cmd LoginCommand) (*LoginResul
 		return nil, apperror.EmailNotVerified()
 	}
 
-	// Calculate token expiration times
-	now := time.Now()
-	accessTokenExpiry := now.Add(h.authService.AccessTokenTTL())
-	refreshTokenExpiry := now.Add(h.authService.RefreshTokenTTL())
+	if err := reactivate(ctx, h.userRepo, foundUser, cmd.Reactivate); err != nil {
+		return nil, err
+	}
 
-	// Generate session ID first
-	sessionID := random.NewUUID()
+	return h.sessions.start(ctx, foundUser, cmd.UserAgent, cmd.ClientIP)
+}
 
-	// Issue access token - includes session ID now
-	accessToken, err := h.tokenIssuer.IssueAccessToken(ctx, foundUser.UserID(), sessionID, accessTokenExpiry)
-	if err != nil {
-		return nil, apperror.InternalError(err)
+// reactivate switches a deactivated account back on once the user has
+// confirmed it. Until then the login is refused with its own error code, so
+// clients can ask for that confirmation and retry. Only accounts the user
+// deactivated themselves can be switched back on this way; one deactivated
+// through provisioning stays off until the identity provider restores it.
+func reactivate(ctx context.Context, userRepo user.Repository, u *user.User, confirmed bool) error {
+	if u.IsActive() {
+		return nil
 	}
-
-	// Issue refresh token
-	refreshToken, err := h.tokenIssuer.IssueRefreshToken(ctx, sessionID, refreshTokenExpiry)
-	if err != nil {
-		return nil, apperror.InternalError(err)
+	if !u.CanReactivate() {
+		return apperror.OperationNotAllowed("reactivate account", "the account was deactivated by the identity provider")
 	}
-
-	// Create session - use getter
-	newSession := session.NewSession(
-		sessionID,
-		foundUser.UserID(),
-		refreshToken,
-		cmd.UserAgent,
-		cmd.ClientIP,
-		refreshTokenExpiry,
-	)
-
-	// Persist the session
-	if err := h.sessionRepo.Create(ctx, newSession); err != nil {
-		return nil, apperror.DatabaseError("create session", err)
+	if !confirmed {
+		return apperror.AccountDeactivated()
 	}
 
-	// Publish UserLoggedIn event - use getters
-	event := authevents.NewUserLoggedIn(
-		foundUser.UserID().String(),
-		foundUser.Email(),
-		cmd.UserAgent,
-		cmd.ClientIP,
-	)
-	_ = h.publisher.Publish(ctx, event)
-
-	return &LoginResult{
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
-		SessionID:    sessionID.String(),
-		UserID:       foundUser.UserID().String(),
-		ExpiresAt:    accessTokenExpiry.Unix(),
-	}, nil
+	u.Activate()
+	if err := userRepo.Update(ctx, u); err != nil {
+		return apperror.DatabaseError("reactivate user", err)
+	}
+	return nil
 }
@@ -2,14 +2,14 @@ package command
 
 import (
 	"context"
-	"time"
+	"errors"
 
 	"github.com/semmidev/ethos-go/internal/auth/adapters/google"
-	authevents "github.com/semmidev/ethos-go/internal/auth/domain/events"
 	"github.com/semmidev/ethos-go/internal/auth/domain/service"
 	"github.com/semmidev/ethos-go/internal/auth/domain/session"
 	"github.com/semmidev/ethos-go/internal/auth/domain/user"
 	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/breaker"
 	"github.com/semmidev/ethos-go/internal/common/decorator"
 	"github.com/semmidev/ethos-go/internal/common/events"
 	"github.com/semmidev/ethos-go/internal/common/logger"
@@ -20,6 +20,10 @@ type LoginGoogleCommand struct {
 	Code      string
 	UserAgent string
 	ClientIP  string
+	// Locale is negotiated from the request and saved on new accounts
+	Locale string
+	// Reactivate confirms that a deactivated account should be switched back on
+	Reactivate bool
 }
 
 type LoginGoogleHandler decorator.CommandHandlerWithResult[LoginGoogleCommand, *LoginResult]
@@ -27,10 +31,7 @@ type LoginGoogleHandler decorator.CommandHandlerWithResult[LoginGoogleCommand, *
 type loginGoogleHandler struct {
 	googleService *google.Service
 	userRepo      user.Repository
-	sessionRepo   session.Repository
-	tokenIssuer   service.TokenIssuer
-	authService   *session.AuthenticationService
-	publisher     events.Publisher
+	sessions      sessionStarter
 }
 
 func NewLoginGoogleHandler(
@@ -39,6 +40,7 @@ func NewLoginGoogleHandler(
 	sessionRepo session.Repository,
 	tokenIssuer service.TokenIssuer,
 	authService *session.AuthenticationService,
+	geoLocator service.GeoLocator,
 	publisher events.Publisher, // Injected
 	log logger.Logger,
 	metricsClient decorator.MetricsClient,
@@ -47,10 +49,13 @@ func NewLoginGoogleHandler(
 		loginGoogleHandler{
 			googleService: googleService,
 			userRepo:      userRepo,
-			sessionRepo:   sessionRepo,
-			tokenIssuer:   tokenIssuer,
-			authService:   authService,
-			publisher:     publisher,
+			sessions: sessionStarter{
+				sessionRepo: sessionRepo,
+				tokenIssuer: tokenIssuer,
+				authService: authService,
+				geoLocator:  geoLocator,
+				publisher:   publisher,
+			},
 		},
 		log,
 		metricsClient,
@@ -60,6 +65,9 @@ func NewLoginGoogleHandler(
 func (h loginGoogleHandler) Handle(ctx context.Context, cmd LoginGoogleCommand) (*LoginResult, error) {
 	// 1. Get User Info from Google
 	userInfo, err := h.googleService.GetUserInfo(ctx, cmd.Code)
+	if errors.Is(err, breaker.ErrOpen) {
+		return nil, apperror.ExternalServiceError("Google", err)
+	}
 	if err != nil {
 		return nil, apperror.ValidationFailed("failed to verify google code: " + err.Error())
 	}
@@ -70,6 +78,9 @@ func (h loginGoogleHandler) Handle(ctx context.Context, cmd LoginGoogleCommand)
 		// If not found, create new user
 		userID := random.NewUUID()
 		newUser := user.NewGoogleUser(userID, userInfo.Email, userInfo.Name, userInfo.ID)
+		if cmd.Locale != "" {
+			_ = newUser.SetLocale(cmd.Locale) // unsupported locales keep the default
+		}
 
 		if err := h.userRepo.Create(ctx, newUser); err != nil {
 			return nil, apperror.InternalError(err)
@@ -83,52 +94,12 @@ func (h loginGoogleHandler) Handle(ctx context.Context, cmd LoginGoogleCommand)
 				// Non-critical
 			}
 		}
-	}
-
-	// 3. Create Session - use getters
-	now := time.Now()
-	accessTokenExpiry := now.Add(h.authService.AccessTokenTTL())
-	refreshTokenExpiry := now.Add(h.authService.RefreshTokenTTL())
-
-	sessionID := random.NewUUID()
-
-	accessToken, err := h.tokenIssuer.IssueAccessToken(ctx, foundUser.UserID(), sessionID, accessTokenExpiry)
-	if err != nil {
-		return nil, apperror.InternalError(err)
-	}
 
-	refreshToken, err := h.tokenIssuer.IssueRefreshToken(ctx, sessionID, refreshTokenExpiry)
-	if err != nil {
-		return nil, apperror.InternalError(err)
-	}
-
-	newSession := session.NewSession(
-		sessionID,
-		foundUser.UserID(),
-		refreshToken,
-		cmd.UserAgent,
-		cmd.ClientIP,
-		refreshTokenExpiry,
-	)
-
-	if err := h.sessionRepo.Create(ctx, newSession); err != nil {
-		return nil, apperror.DatabaseError("create session", err)
+		if err := reactivate(ctx, h.userRepo, foundUser, cmd.Reactivate); err != nil {
+			return nil, err
+		}
 	}
 
-	// Publish UserLoggedIn event - use getters
-	event := authevents.NewUserLoggedIn(
-		foundUser.UserID().String(),
-		foundUser.Email(),
-		cmd.UserAgent,
-		cmd.ClientIP,
-	)
-	_ = h.publisher.Publish(ctx, event)
-
-	return &LoginResult{
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
-		SessionID:    sessionID.String(),
-		UserID:       foundUser.UserID().String(),
-		ExpiresAt:    accessTokenExpiry.Unix(),
-	}, nil
+	// 3. Create Session
+	return h.sessions.start(ctx, foundUser, cmd.UserAgent, cmd.ClientIP)
 }
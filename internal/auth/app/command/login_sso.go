@@ -0,0 +1,176 @@
+package command
+
+import (
+	"context"
+	"errors"
+
+	"github.com/semmidev/ethos-go/internal/auth/domain/service"
+	"github.com/semmidev/ethos-go/internal/auth/domain/session"
+	"github.com/semmidev/ethos-go/internal/auth/domain/user"
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/events"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/common/random"
+)
+
+// SSOPolicy configures enterprise single sign-on
+type SSOPolicy struct {
+	// GroupRoles maps identity provider groups to roles. Users in no mapped
+	// group are members; users in several get the most privileged role.
+	GroupRoles map[string]string
+}
+
+// roleFor returns the role granted by groups
+func (p SSOPolicy) roleFor(groups []string) string {
+	role := user.RoleMember
+	for _, g := range groups {
+		if r, ok := p.GroupRoles[g]; ok {
+			role = user.HigherRole(role, r)
+		}
+	}
+	return role
+}
+
+type LoginSSOCommand struct {
+	Code string
+	// State is the one handed out with the login URL
+	State     string
+	UserAgent string
+	ClientIP  string
+	// Locale is negotiated from the request and saved on new accounts
+	Locale string
+	// Reactivate confirms that a deactivated account should be switched back on
+	Reactivate bool
+}
+
+type LoginSSOHandler decorator.CommandHandlerWithResult[LoginSSOCommand, *LoginResult]
+
+type loginSSOHandler struct {
+	provider service.SSOProvider
+	logins   service.SSOLoginStore
+	userRepo user.Repository
+	policy   SSOPolicy
+	sessions sessionStarter
+}
+
+// NewLoginSSOHandler creates the handler; provider is nil when single
+// sign-on is not configured.
+func NewLoginSSOHandler(
+	provider service.SSOProvider,
+	logins service.SSOLoginStore,
+	userRepo user.Repository,
+	sessionRepo session.Repository,
+	tokenIssuer service.TokenIssuer,
+	authService *session.AuthenticationService,
+	geoLocator service.GeoLocator,
+	publisher events.Publisher,
+	policy SSOPolicy,
+	log logger.Logger,
+	metricsClient decorator.MetricsClient,
+) LoginSSOHandler {
+	return decorator.ApplyCommandResultDecorators(
+		loginSSOHandler{
+			provider: provider,
+			logins:   logins,
+			userRepo: userRepo,
+			policy:   policy,
+			sessions: sessionStarter{
+				sessionRepo: sessionRepo,
+				tokenIssuer: tokenIssuer,
+				authService: authService,
+				geoLocator:  geoLocator,
+				publisher:   publisher,
+			},
+		},
+		log,
+		metricsClient,
+	)
+}
+
+func (h loginSSOHandler) Handle(ctx context.Context, cmd LoginSSOCommand) (*LoginResult, error) {
+	if h.provider == nil {
+		return nil, apperror.OperationNotAllowed("single sign-on", "single sign-on is not configured")
+	}
+
+	// The callback must finish a sign-in started here, otherwise anyone
+	// could sign a victim in to their own account with a code of theirs
+	login, ok, err := h.logins.Take(ctx, cmd.State)
+	if err != nil {
+		return nil, apperror.InternalError(err)
+	}
+	if !ok {
+		return nil, apperror.Unauthorized("the single sign-on request has expired or was not started here")
+	}
+
+	identity, err := h.provider.Exchange(ctx, cmd.Code, login)
+	if err != nil {
+		return nil, apperror.Unauthorized("single sign-on failed").WithError(err)
+	}
+	if identity.Email == "" {
+		return nil, apperror.ValidationFailed("the identity provider did not share an email address")
+	}
+
+	u, err := h.findOrProvision(ctx, identity, cmd.Locale)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := reactivate(ctx, h.userRepo, u, cmd.Reactivate); err != nil {
+		return nil, err
+	}
+
+	// The identity provider owns roles: they follow the user's groups on
+	// every login, so removing someone from a group takes effect next time
+	if role := h.policy.roleFor(identity.Groups); role != u.Role() {
+		_ = u.SetRole(role) // roles come from the parsed policy
+		if err := h.userRepo.Update(ctx, u); err != nil {
+			return nil, apperror.DatabaseError("update user role", err)
+		}
+	}
+
+	return h.sessions.start(ctx, u, cmd.UserAgent, cmd.ClientIP)
+}
+
+// findOrProvision returns the user behind identity. A user seen for the
+// first time is linked to the account with the same address or, without
+// one, created on the spot.
+func (h loginSSOHandler) findOrProvision(ctx context.Context, identity *service.SSOIdentity, locale string) (*user.User, error) {
+	u, err := h.userRepo.FindByAuthProvider(ctx, user.AuthProviderOIDC, identity.Subject)
+	if err == nil {
+		return u, nil
+	}
+	if !errors.Is(err, user.ErrNotFound) {
+		return nil, apperror.DatabaseError("find user", err)
+	}
+
+	subject := identity.Subject
+	u, err = h.userRepo.FindByEmail(ctx, identity.Email)
+	switch {
+	case err == nil:
+		// Linking hands the account to whoever the provider says owns the
+		// address, so the provider must have checked it, unless the
+		// provider created the account itself
+		if !identity.EmailVerified && !u.IsAwaitingSSOLink() {
+			return nil, apperror.OperationNotAllowed("single sign-on", "the identity provider has not verified this email address")
+		}
+		u.SetAuthProvider(user.AuthProviderOIDC, &subject)
+		if err := h.userRepo.Update(ctx, u); err != nil {
+			return nil, apperror.DatabaseError("link user", err)
+		}
+		return u, nil
+
+	case errors.Is(err, user.ErrNotFound):
+		u = user.NewOIDCUser(random.NewUUID(), identity.Email, identity.Name, subject)
+		if locale != "" {
+			_ = u.SetLocale(locale) // unsupported locales keep the default
+		}
+		if err := h.userRepo.Create(ctx, u); err != nil {
+			return nil, apperror.DatabaseError("create user", err)
+		}
+		return u, nil
+
+	default:
+		return nil, apperror.DatabaseError("find user", err)
+	}
+}
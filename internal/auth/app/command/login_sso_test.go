@@ -0,0 +1,201 @@
+package command_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/config"
+	"github.com/semmidev/ethos-go/internal/auth/adapters"
+	"github.com/semmidev/ethos-go/internal/auth/adapters/geoip"
+	"github.com/semmidev/ethos-go/internal/auth/app/command"
+	"github.com/semmidev/ethos-go/internal/auth/app/query"
+	"github.com/semmidev/ethos-go/internal/auth/domain/service"
+	"github.com/semmidev/ethos-go/internal/auth/domain/session"
+	"github.com/semmidev/ethos-go/internal/auth/domain/user"
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/random"
+	"github.com/semmidev/ethos-go/internal/testutil"
+)
+
+// fakeSSOProvider returns identity for the code "good-code". Like a real
+// provider, its ID token carries the nonce of the latest login URL.
+type fakeSSOProvider struct {
+	identity service.SSOIdentity
+	nonce    string
+}
+
+func (p *fakeSSOProvider) LoginURL(_ context.Context, login service.SSOLogin) (string, error) {
+	p.nonce = login.Nonce
+	return "https://idp.example/authorize?state=" + login.State, nil
+}
+
+func (p *fakeSSOProvider) Exchange(_ context.Context, code string, login service.SSOLogin) (*service.SSOIdentity, error) {
+	if code != "good-code" {
+		return nil, errors.New("invalid_grant")
+	}
+	if login.Nonce != p.nonce {
+		return nil, errors.New("id_token nonce does not match the sign-in")
+	}
+	identity := p.identity
+	return &identity, nil
+}
+
+func TestLoginSSO(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given single sign-on through an identity provider", t, func() {
+		ctx := context.Background()
+
+		issuer, err := adapters.NewJWTTokenIssuer(&config.Config{
+			AppName:          "ethos-go",
+			AuthJWTAlgorithm: "HS256",
+			AuthJWTKeyID:     "primary",
+			AuthJWTSecret:    strings.Repeat("s", 32),
+		})
+		So(err, ShouldBeNil)
+
+		provider := &fakeSSOProvider{identity: service.SSOIdentity{
+			Subject:       "subject-1",
+			Email:         "jane@corp.example",
+			EmailVerified: true,
+			Name:          "Jane",
+			Groups:        []string{"staff", "ethos-admins"},
+		}}
+		policy := command.SSOPolicy{GroupRoles: map[string]string{"ethos-admins": user.RoleAdmin}}
+
+		logins := testutil.NewSSOLoginStore()
+
+		// startLogin hands out a login URL and returns its state
+		startLogin := func() string {
+			result, err := query.NewGetSSOAuthURLHandler(
+				provider, logins, testutil.NopLogger{}, &decorator.NoOpMetricsClient{},
+			).Handle(ctx, query.GetSSOAuthURLQuery{})
+			So(err, ShouldBeNil)
+			So(result.URL, ShouldContainSubstring, result.State)
+			return result.State
+		}
+
+		newHandler := func(provider service.SSOProvider, users *testutil.UserRepository) command.LoginSSOHandler {
+			return command.NewLoginSSOHandler(
+				provider, logins, users, testutil.NewSessionRepository(), issuer,
+				session.NewAuthenticationService(15*time.Minute, 24*time.Hour),
+				geoip.NopLocator{}, testutil.NewRecordingPublisher(), policy,
+				testutil.NopLogger{}, &decorator.NoOpMetricsClient{},
+			)
+		}
+
+		Convey("A first sign-in provisions the account", func() {
+			users := testutil.NewUserRepository()
+
+			result, err := newHandler(provider, users).Handle(ctx, command.LoginSSOCommand{Code: "good-code", State: startLogin(), Locale: "id"})
+			So(err, ShouldBeNil)
+			So(result.AccessToken, ShouldNotBeEmpty)
+
+			u, err := users.FindByAuthProvider(ctx, user.AuthProviderOIDC, "subject-1")
+			So(err, ShouldBeNil)
+			So(u.Email(), ShouldEqual, "jane@corp.example")
+			So(u.Name(), ShouldEqual, "Jane")
+			So(u.IsVerified(), ShouldBeTrue)
+			So(u.Locale(), ShouldEqual, "id")
+			So(u.Role(), ShouldEqual, user.RoleAdmin)
+
+			Convey("And the role follows the groups on later sign-ins", func() {
+				provider.identity.Groups = []string{"staff"}
+
+				_, err := newHandler(provider, users).Handle(ctx, command.LoginSSOCommand{Code: "good-code", State: startLogin()})
+				So(err, ShouldBeNil)
+				So(users.Len(), ShouldEqual, 1)
+
+				u, err := users.FindByAuthProvider(ctx, user.AuthProviderOIDC, "subject-1")
+				So(err, ShouldBeNil)
+				So(u.Role(), ShouldEqual, user.RoleMember)
+			})
+		})
+
+		Convey("An existing account with the same verified email is linked", func() {
+			existing := testutil.NewUserBuilder().WithEmail("jane@corp.example").Build()
+			users := testutil.NewUserRepository(existing)
+
+			_, err := newHandler(provider, users).Handle(ctx, command.LoginSSOCommand{Code: "good-code", State: startLogin()})
+			So(err, ShouldBeNil)
+			So(users.Len(), ShouldEqual, 1)
+
+			u, err := users.FindByID(ctx, existing.UserID())
+			So(err, ShouldBeNil)
+			So(u.AuthProvider(), ShouldEqual, user.AuthProviderOIDC)
+			So(*u.AuthProviderID(), ShouldEqual, "subject-1")
+		})
+
+		Convey("An existing account is not linked to an unverified email", func() {
+			provider.identity.EmailVerified = false
+			existing := testutil.NewUserBuilder().WithEmail("jane@corp.example").Build()
+			users := testutil.NewUserRepository(existing)
+
+			_, err := newHandler(provider, users).Handle(ctx, command.LoginSSOCommand{Code: "good-code", State: startLogin()})
+			So(apperror.GetAppError(err).Code, ShouldEqual, apperror.ErrCodeOperationNotAllowed)
+
+			u, err := users.FindByID(ctx, existing.UserID())
+			So(err, ShouldBeNil)
+			So(u.AuthProvider(), ShouldNotEqual, user.AuthProviderOIDC)
+		})
+
+		Convey("An account the provider provisioned is linked even without a verified email", func() {
+			provider.identity.EmailVerified = false
+			provisioned := user.NewProvisionedUser(random.NewUUID(), "jane@corp.example", "Jane")
+			users := testutil.NewUserRepository(provisioned)
+
+			_, err := newHandler(provider, users).Handle(ctx, command.LoginSSOCommand{Code: "good-code", State: startLogin()})
+			So(err, ShouldBeNil)
+
+			u, err := users.FindByAuthProvider(ctx, user.AuthProviderOIDC, "subject-1")
+			So(err, ShouldBeNil)
+			So(u.UserID(), ShouldEqual, provisioned.UserID())
+		})
+
+		Convey("A code the provider rejects is unauthorized", func() {
+			_, err := newHandler(provider, testutil.NewUserRepository()).Handle(ctx, command.LoginSSOCommand{Code: "bad-code", State: startLogin()})
+			So(apperror.GetAppError(err).Code, ShouldEqual, apperror.ErrCodeUnauthorized)
+		})
+
+		Convey("A callback without a sign-in started here is unauthorized", func() {
+			users := testutil.NewUserRepository()
+			startLogin()
+
+			_, err := newHandler(provider, users).Handle(ctx, command.LoginSSOCommand{Code: "good-code", State: "forged-state"})
+			So(apperror.GetAppError(err).Code, ShouldEqual, apperror.ErrCodeUnauthorized)
+			So(users.Len(), ShouldEqual, 0)
+		})
+
+		Convey("A state can complete only one sign-in", func() {
+			state := startLogin()
+
+			_, err := newHandler(provider, testutil.NewUserRepository()).Handle(ctx, command.LoginSSOCommand{Code: "good-code", State: state})
+			So(err, ShouldBeNil)
+			So(logins.Len(), ShouldEqual, 0)
+
+			_, err = newHandler(provider, testutil.NewUserRepository()).Handle(ctx, command.LoginSSOCommand{Code: "good-code", State: state})
+			So(apperror.GetAppError(err).Code, ShouldEqual, apperror.ErrCodeUnauthorized)
+		})
+
+		Convey("An ID token with another sign-in's nonce is unauthorized", func() {
+			users := testutil.NewUserRepository()
+			state := startLogin()
+			startLogin()
+
+			_, err := newHandler(provider, users).Handle(ctx, command.LoginSSOCommand{Code: "good-code", State: state})
+			So(apperror.GetAppError(err).Code, ShouldEqual, apperror.ErrCodeUnauthorized)
+			So(users.Len(), ShouldEqual, 0)
+		})
+
+		Convey("Without a configured provider sign-in is refused", func() {
+			_, err := newHandler(nil, testutil.NewUserRepository()).Handle(ctx, command.LoginSSOCommand{Code: "good-code", State: startLogin()})
+			So(apperror.GetAppError(err).Code, ShouldEqual, apperror.ErrCodeOperationNotAllowed)
+		})
+	})
+}
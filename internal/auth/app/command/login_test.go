@@ -0,0 +1,120 @@
+package command_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/config"
+	"github.com/semmidev/ethos-go/internal/auth/adapters"
+	"github.com/semmidev/ethos-go/internal/auth/adapters/geoip"
+	"github.com/semmidev/ethos-go/internal/auth/app/command"
+	"github.com/semmidev/ethos-go/internal/auth/domain/session"
+	"github.com/semmidev/ethos-go/internal/auth/domain/user"
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/validator"
+	"github.com/semmidev/ethos-go/internal/testutil"
+)
+
+// plainPasswordHasher stores passwords as is, keeping tests fast
+type plainPasswordHasher struct{}
+
+func (plainPasswordHasher) Hash(_ context.Context, password string) (string, error) {
+	return password, nil
+}
+
+func (plainPasswordHasher) Compare(_ context.Context, hashed, plain string) (bool, error) {
+	return hashed == plain, nil
+}
+
+func TestLoginHandler(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given a deactivated account", t, func() {
+		ctx := context.Background()
+		u := testutil.NewUserBuilder().WithHashedPassword("secret-password").Inactive().Build()
+
+		issuer, err := adapters.NewJWTTokenIssuer(&config.Config{
+			AppName:          "ethos-go",
+			AuthJWTAlgorithm: "HS256",
+			AuthJWTKeyID:     "primary",
+			AuthJWTSecret:    strings.Repeat("s", 32),
+		})
+		So(err, ShouldBeNil)
+
+		users := testutil.NewUserRepository(u)
+		sessions := testutil.NewSessionRepository()
+		handler := command.NewLoginHandler(
+			sessions,
+			users,
+			plainPasswordHasher{},
+			issuer,
+			session.NewAuthenticationService(15*time.Minute, 24*time.Hour),
+			geoip.NopLocator{},
+			validator.New("en"),
+			testutil.NewRecordingPublisher(),
+			testutil.NopLogger{},
+			&decorator.NoOpMetricsClient{},
+		)
+		cmd := command.LoginCommand{Email: u.Email(), Password: "secret-password"}
+
+		Convey("When the user logs in without confirming reactivation", func() {
+			_, err := handler.Handle(ctx, cmd)
+
+			Convey("Then the login asks for confirmation and nothing changes", func() {
+				So(apperror.GetAppError(err).Code, ShouldEqual, apperror.ErrCodeAccountDeactivated)
+				So(sessions.Len(), ShouldEqual, 0)
+
+				found, _ := users.FindByID(ctx, u.UserID())
+				So(found.IsActive(), ShouldBeFalse)
+			})
+		})
+
+		Convey("When the user confirms reactivation", func() {
+			cmd.Reactivate = true
+			result, err := handler.Handle(ctx, cmd)
+
+			Convey("Then the account is active again and signed in", func() {
+				So(err, ShouldBeNil)
+				So(result.AccessToken, ShouldNotBeEmpty)
+				So(sessions.Len(), ShouldEqual, 1)
+
+				found, _ := users.FindByID(ctx, u.UserID())
+				So(found.IsActive(), ShouldBeTrue)
+			})
+		})
+
+		Convey("When the identity provider deactivated the account and the user confirms reactivation", func() {
+			u.Deactivate(user.DeactivatedByProvisioning)
+			So(users.Update(ctx, u), ShouldBeNil)
+
+			cmd.Reactivate = true
+			_, err := handler.Handle(ctx, cmd)
+
+			Convey("Then the login is refused and the account stays deactivated", func() {
+				So(apperror.GetAppError(err).Code, ShouldEqual, apperror.ErrCodeOperationNotAllowed)
+				So(sessions.Len(), ShouldEqual, 0)
+
+				found, _ := users.FindByID(ctx, u.UserID())
+				So(found.IsActive(), ShouldBeFalse)
+				So(found.DeactivatedBy(), ShouldEqual, user.DeactivatedByProvisioning)
+			})
+		})
+
+		Convey("When the password is wrong", func() {
+			cmd.Password = "wrong-password"
+			cmd.Reactivate = true
+			_, err := handler.Handle(ctx, cmd)
+
+			Convey("Then the account stays deactivated", func() {
+				So(apperror.GetAppError(err).Code, ShouldEqual, apperror.ErrCodeInvalidCredentials)
+				found, _ := users.FindByID(ctx, u.UserID())
+				So(found.IsActive(), ShouldBeFalse)
+			})
+		})
+	})
+}
@@ -2,31 +2,44 @@ package command
 
 import (
 	"context"
+	"errors"
 
 	"github.com/google/uuid"
+	"github.com/semmidev/ethos-go/internal/auth/domain/service"
 	"github.com/semmidev/ethos-go/internal/auth/domain/session"
 	"github.com/semmidev/ethos-go/internal/common/apperror"
 	"github.com/semmidev/ethos-go/internal/common/decorator"
 	"github.com/semmidev/ethos-go/internal/common/logger"
 )
 
+// LogoutCommand ends one of the user's sessions. Both IDs come from the
+// authenticated context or are checked against it, never trusted from input.
 type LogoutCommand struct {
 	SessionID string
+	UserID    string
 }
 
 type LogoutHandler decorator.CommandHandler[LogoutCommand]
 
 type logoutHandler struct {
 	sessionRepo session.Repository
+	denylist    service.SessionDenylist
+	authService *session.AuthenticationService
 }
 
 func NewLogoutHandler(
 	sessionRepo session.Repository,
+	denylist service.SessionDenylist,
+	authService *session.AuthenticationService,
 	log logger.Logger,
 	metricsClient decorator.MetricsClient,
 ) LogoutHandler {
 	return decorator.ApplyCommandDecorators(
-		logoutHandler{sessionRepo: sessionRepo},
+		logoutHandler{
+			sessionRepo: sessionRepo,
+			denylist:    denylist,
+			authService: authService,
+		},
 		log,
 		metricsClient,
 	)
@@ -38,9 +51,29 @@ func (h logoutHandler) Handle(ctx context.Context, cmd LogoutCommand) error {
 		return apperror.ValidationFailed("invalid session ID")
 	}
 
-	return h.sessionRepo.Delete(ctx, sessionID)
+	// Another user's session is reported as missing so IDs can't be probed
+	s, err := h.sessionRepo.FindByID(ctx, sessionID)
+	if errors.Is(err, session.ErrNotFound) || (err == nil && s.UserID().String() != cmd.UserID) {
+		return apperror.NotFound("Session", cmd.SessionID)
+	}
+	if err != nil {
+		return apperror.DatabaseError("find session", err)
+	}
+
+	if err := denySessions(ctx, h.denylist, h.authService, s); err != nil {
+		return err
+	}
+
+	if err := h.sessionRepo.Delete(ctx, sessionID); err != nil {
+		if errors.Is(err, session.ErrNotFound) {
+			return apperror.NotFound("Session", cmd.SessionID)
+		}
+		return apperror.DatabaseError("delete session", err)
+	}
+	return nil
 }
 
+// LogoutAllCommand ends every session of the authenticated user
 type LogoutAllCommand struct {
 	UserID string
 }
@@ -49,15 +82,23 @@ type LogoutAllHandler decorator.CommandHandler[LogoutAllCommand]
 
 type logoutAllHandler struct {
 	sessionRepo session.Repository
+	denylist    service.SessionDenylist
+	authService *session.AuthenticationService
 }
 
 func NewLogoutAllHandler(
 	sessionRepo session.Repository,
+	denylist service.SessionDenylist,
+	authService *session.AuthenticationService,
 	log logger.Logger,
 	metricsClient decorator.MetricsClient,
 ) LogoutAllHandler {
 	return decorator.ApplyCommandDecorators(
-		logoutAllHandler{sessionRepo: sessionRepo},
+		logoutAllHandler{
+			sessionRepo: sessionRepo,
+			denylist:    denylist,
+			authService: authService,
+		},
 		log,
 		metricsClient,
 	)
@@ -69,5 +110,13 @@ func (h logoutAllHandler) Handle(ctx context.Context, cmd LogoutAllCommand) erro
 		return apperror.ValidationFailed("invalid user ID")
 	}
 
+	sessions, err := h.sessionRepo.FindAllByUserID(ctx, userID)
+	if err != nil {
+		return apperror.DatabaseError("find sessions", err)
+	}
+	if err := denySessions(ctx, h.denylist, h.authService, sessions...); err != nil {
+		return err
+	}
+
 	return h.sessionRepo.DeleteAllByUserID(ctx, userID)
 }
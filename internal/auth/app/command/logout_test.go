@@ -0,0 +1,61 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/auth/app/command"
+	"github.com/semmidev/ethos-go/internal/auth/domain/session"
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/testutil"
+)
+
+func TestLogoutHandler(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given two users with a session each", t, func() {
+		ctx := context.Background()
+		alice := testutil.NewUserBuilder().Build()
+		bob := testutil.NewUserBuilder().WithEmail("bob@example.com").Build()
+		aliceSession := testutil.NewSessionBuilder(alice).Build()
+		bobSession := testutil.NewSessionBuilder(bob).Build()
+
+		sessions := testutil.NewSessionRepository(aliceSession, bobSession)
+		denylist := testutil.NewSessionDenylist()
+		authService := session.NewAuthenticationService(15*time.Minute, 24*time.Hour)
+		handler := command.NewLogoutHandler(sessions, denylist, authService, testutil.NopLogger{}, &decorator.NoOpMetricsClient{})
+
+		Convey("When a user ends their own session", func() {
+			err := handler.Handle(ctx, command.LogoutCommand{
+				SessionID: aliceSession.SessionID().String(),
+				UserID:    alice.UserID().String(),
+			})
+
+			Convey("Then it is deleted and its access token denied", func() {
+				So(err, ShouldBeNil)
+				So(sessions.Len(), ShouldEqual, 1)
+
+				denied, err := denylist.IsDenied(ctx, aliceSession.SessionID())
+				So(err, ShouldBeNil)
+				So(denied, ShouldBeTrue)
+			})
+		})
+
+		Convey("When a user tries to end someone else's session", func() {
+			err := handler.Handle(ctx, command.LogoutCommand{
+				SessionID: bobSession.SessionID().String(),
+				UserID:    alice.UserID().String(),
+			})
+
+			Convey("Then it is reported as not found and kept", func() {
+				So(apperror.GetAppError(err), ShouldNotBeNil)
+				So(apperror.GetAppError(err).Code, ShouldEqual, apperror.ErrCodeNotFound)
+				So(sessions.Len(), ShouldEqual, 2)
+			})
+		})
+	})
+}
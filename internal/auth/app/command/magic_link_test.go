@@ -0,0 +1,163 @@
+package command_test
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/config"
+	"github.com/semmidev/ethos-go/internal/auth/adapters"
+	"github.com/semmidev/ethos-go/internal/auth/adapters/geoip"
+	authtask "github.com/semmidev/ethos-go/internal/auth/adapters/task"
+	"github.com/semmidev/ethos-go/internal/auth/app/command"
+	"github.com/semmidev/ethos-go/internal/auth/domain/gateway"
+	"github.com/semmidev/ethos-go/internal/auth/domain/magiclink"
+	"github.com/semmidev/ethos-go/internal/auth/domain/session"
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	commontask "github.com/semmidev/ethos-go/internal/common/task"
+	"github.com/semmidev/ethos-go/internal/common/validator"
+	"github.com/semmidev/ethos-go/internal/testutil"
+)
+
+func TestMagicLinkLogin(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given an unverified user and magic link login", t, func() {
+		ctx := context.Background()
+		u := testutil.NewUserBuilder().Unverified().Build()
+
+		cfg := &config.Config{
+			AppName:          "ethos-go",
+			AppClientURL:     "https://ethos.example.com",
+			AuthJWTAlgorithm: "HS256",
+			AuthJWTKeyID:     "primary",
+			AuthJWTSecret:    strings.Repeat("s", 32),
+		}
+		issuer, err := adapters.NewJWTTokenIssuer(cfg)
+		So(err, ShouldBeNil)
+
+		users := testutil.NewUserRepository(u)
+		links := testutil.NewMagicLinkRepository()
+		sessions := testutil.NewSessionRepository()
+		tasks := commontask.NewInMemoryDispatcher()
+		policy := command.MagicLinkPolicy{Enabled: true, TTL: 15 * time.Minute, HourlyLimit: 2}
+
+		request := command.NewRequestMagicLinkHandler(
+			users, links, validator.New("en"), authtask.NewTaskDispatcher(cfg, tasks), policy,
+			testutil.NopLogger{}, &decorator.NoOpMetricsClient{},
+		)
+		verify := command.NewVerifyMagicLinkHandler(
+			users, links, sessions, issuer,
+			session.NewAuthenticationService(15*time.Minute, 24*time.Hour),
+			geoip.NopLocator{}, testutil.NewRecordingPublisher(), policy.Enabled,
+			testutil.NopLogger{}, &decorator.NoOpMetricsClient{},
+		)
+
+		sentTokens := func() []string {
+			var tokens []string
+			for _, task := range tasks.Tasks(authtask.TaskSendMagicLinkEmail) {
+				var payload gateway.PayloadSendMagicLinkEmail
+				So(task.Decode(&payload), ShouldBeNil)
+				tokens = append(tokens, payload.Token)
+			}
+			return tokens
+		}
+
+		Convey("When the user asks for a link", func() {
+			So(request.Handle(ctx, command.RequestMagicLinkCommand{Email: u.Email()}), ShouldBeNil)
+			tokens := sentTokens()
+			So(tokens, ShouldHaveLength, 1)
+
+			Convey("Then opening it signs the user in and verifies the address", func() {
+				result, err := verify.Handle(ctx, command.VerifyMagicLinkCommand{Token: tokens[0]})
+				So(err, ShouldBeNil)
+				So(result.AccessToken, ShouldNotBeEmpty)
+				So(sessions.Len(), ShouldEqual, 1)
+
+				found, _ := users.FindByID(ctx, u.UserID())
+				So(found.IsVerified(), ShouldBeTrue)
+
+				Convey("And the link does not work a second time", func() {
+					_, err := verify.Handle(ctx, command.VerifyMagicLinkCommand{Token: tokens[0]})
+					So(apperror.GetAppError(err).Code, ShouldEqual, apperror.ErrCodeUnauthorized)
+					So(sessions.Len(), ShouldEqual, 1)
+				})
+			})
+
+			Convey("Then only the emailed token's hash is stored", func() {
+				link, err := links.FindByTokenHash(ctx, magiclink.HashToken(tokens[0]))
+				So(err, ShouldBeNil)
+				So(link.TokenHash(), ShouldNotEqual, tokens[0])
+			})
+		})
+
+		Convey("When the address belongs to no account", func() {
+			err := request.Handle(ctx, command.RequestMagicLinkCommand{Email: "nobody@example.com"})
+
+			Convey("Then the request succeeds without sending anything", func() {
+				So(err, ShouldBeNil)
+				So(sentTokens(), ShouldBeEmpty)
+			})
+		})
+
+		Convey("When the user asks for more links than the hourly limit", func() {
+			for i := 0; i < 3; i++ {
+				So(request.Handle(ctx, command.RequestMagicLinkCommand{Email: u.Email()}), ShouldBeNil)
+			}
+
+			Convey("Then the extra request succeeds silently", func() {
+				So(sentTokens(), ShouldHaveLength, 2)
+			})
+		})
+
+		Convey("When many requests for the user arrive at once", func() {
+			var wg sync.WaitGroup
+			for i := 0; i < 20; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					_ = request.Handle(ctx, command.RequestMagicLinkCommand{Email: u.Email()})
+				}()
+			}
+			wg.Wait()
+
+			Convey("Then no more links than the hourly limit are created or sent", func() {
+				So(links.Len(), ShouldEqual, 2)
+				So(sentTokens(), ShouldHaveLength, 2)
+			})
+		})
+
+		Convey("When a link has expired", func() {
+			link, token, err := magiclink.New(u.UserID(), time.Minute, time.Now().Add(-time.Hour))
+			So(err, ShouldBeNil)
+			So(links.Create(ctx, link), ShouldBeNil)
+
+			_, err = verify.Handle(ctx, command.VerifyMagicLinkCommand{Token: token})
+
+			Convey("Then it is refused like an unknown token", func() {
+				_, unknown := verify.Handle(ctx, command.VerifyMagicLinkCommand{Token: "made-up"})
+				So(apperror.GetAppError(err).Code, ShouldEqual, apperror.ErrCodeUnauthorized)
+				So(unknown.Error(), ShouldEqual, err.Error())
+				So(sessions.Len(), ShouldEqual, 0)
+			})
+		})
+
+		Convey("When magic link login is disabled", func() {
+			off := command.NewRequestMagicLinkHandler(
+				users, links, validator.New("en"), authtask.NewTaskDispatcher(cfg, tasks),
+				command.MagicLinkPolicy{}, testutil.NopLogger{}, &decorator.NoOpMetricsClient{},
+			)
+			err := off.Handle(ctx, command.RequestMagicLinkCommand{Email: u.Email()})
+
+			Convey("Then the request is refused", func() {
+				So(apperror.GetAppError(err).Code, ShouldEqual, apperror.ErrCodeOperationNotAllowed)
+				So(sentTokens(), ShouldBeEmpty)
+			})
+		})
+	})
+}
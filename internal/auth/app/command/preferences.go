@@ -0,0 +1,120 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/semmidev/ethos-go/internal/auth/domain/user"
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/i18n"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+)
+
+// UpdatePreferencesCommand changes the given preferences and leaves the
+// others as they are
+type UpdatePreferencesCommand struct {
+	UserID    string
+	WeekStart *string
+	Locale    *string
+	Theme     *string
+	// DefaultReminderHour sets the suggested reminder hour (0-23)
+	DefaultReminderHour *int
+	// ClearDefaultReminderHour removes the suggested reminder hour
+	ClearDefaultReminderHour bool
+}
+
+// UpdatePreferencesResult contains the preferences after the update
+type UpdatePreferencesResult struct {
+	WeekStart           string
+	Locale              string
+	Theme               string
+	DefaultReminderHour *int
+}
+
+// UpdatePreferencesHandler handles preference updates
+type UpdatePreferencesHandler decorator.CommandHandlerWithResult[UpdatePreferencesCommand, UpdatePreferencesResult]
+
+type updatePreferencesHandler struct {
+	repo user.Repository
+}
+
+// NewUpdatePreferencesHandler creates a new handler with decorators
+func NewUpdatePreferencesHandler(
+	repo user.Repository,
+	log logger.Logger,
+	metricsClient decorator.MetricsClient,
+) UpdatePreferencesHandler {
+	if repo == nil {
+		panic("nil repo")
+	}
+
+	return decorator.ApplyCommandResultDecorators(
+		updatePreferencesHandler{repo: repo},
+		log,
+		metricsClient,
+	)
+}
+
+func (h updatePreferencesHandler) Handle(ctx context.Context, cmd UpdatePreferencesCommand) (UpdatePreferencesResult, error) {
+	userID, err := uuid.Parse(cmd.UserID)
+	if err != nil {
+		return UpdatePreferencesResult{}, apperror.ValidationFailed("invalid user ID")
+	}
+	if cmd.ClearDefaultReminderHour && cmd.DefaultReminderHour != nil {
+		return UpdatePreferencesResult{}, apperror.InvalidInput("default_reminder_hour",
+			"cannot be set and cleared at once")
+	}
+
+	existingUser, err := h.repo.FindByID(ctx, userID)
+	if err != nil {
+		return UpdatePreferencesResult{}, apperror.NotFound("user", cmd.UserID)
+	}
+
+	// Week start and locale are columns other modules query on; the rest
+	// live in the preferences object
+	if cmd.WeekStart != nil && *cmd.WeekStart != "" {
+		day, err := user.ParseWeekStart(*cmd.WeekStart)
+		if err == nil {
+			err = existingUser.SetWeekStart(day)
+		}
+		if err != nil {
+			return UpdatePreferencesResult{}, apperror.InvalidInput("week_start", "must be one of: sunday, monday, saturday")
+		}
+	}
+	if cmd.Locale != nil && *cmd.Locale != "" {
+		if err := existingUser.SetLocale(*cmd.Locale); err != nil {
+			return UpdatePreferencesResult{}, apperror.InvalidInput("locale",
+				fmt.Sprintf("must be one of: %s, %s", i18n.English, i18n.Indonesian))
+		}
+	}
+
+	preferences := existingUser.Preferences()
+	if cmd.Theme != nil && *cmd.Theme != "" {
+		theme, err := user.ParseTheme(*cmd.Theme)
+		if err == nil {
+			err = preferences.SetTheme(theme)
+		}
+		if err != nil {
+			return UpdatePreferencesResult{}, apperror.InvalidInput("theme", "must be one of: system, light, dark")
+		}
+	}
+	if cmd.DefaultReminderHour != nil || cmd.ClearDefaultReminderHour {
+		if err := preferences.SetDefaultReminderHour(cmd.DefaultReminderHour); err != nil {
+			return UpdatePreferencesResult{}, apperror.InvalidInput("default_reminder_hour", err.Error())
+		}
+	}
+	existingUser.SetPreferences(preferences)
+
+	if err := h.repo.Update(ctx, existingUser); err != nil {
+		return UpdatePreferencesResult{}, apperror.InternalError(err)
+	}
+
+	return UpdatePreferencesResult{
+		WeekStart:           user.WeekStartName(existingUser.WeekStart()),
+		Locale:              existingUser.Locale(),
+		Theme:               string(preferences.Theme()),
+		DefaultReminderHour: preferences.DefaultReminderHour(),
+	}, nil
+}
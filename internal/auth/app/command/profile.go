@@ -2,45 +2,64 @@ package command
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
-	authevents "github.com/semmidev/ethos-go/internal/auth/domain/events"
+	authevents "github.com/semmidev/ethos-go/contracts/events/auth"
 	"github.com/semmidev/ethos-go/internal/auth/domain/user"
 	"github.com/semmidev/ethos-go/internal/common/apperror"
 	"github.com/semmidev/ethos-go/internal/common/decorator"
 	"github.com/semmidev/ethos-go/internal/common/events"
+	"github.com/semmidev/ethos-go/internal/common/i18n"
 	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/common/storage"
 	"golang.org/x/crypto/bcrypt"
 )
 
+// maxLogLockDays caps the habit log lock at about ten years
+const maxLogLockDays = 3650
+
 // UpdateProfileCommand for updating user profile
 type UpdateProfileCommand struct {
 	UserID   string
 	Name     *string
 	Email    *string
 	Timezone *string
+	// Locale is the language for messages, notifications and emails
+	Locale *string
+	// WeekStart is the day weeks begin on: sunday, monday or saturday
+	WeekStart *string
+	// LogLockDays locks habit logs older than this many days; 0 removes the lock
+	LogLockDays *int
 }
 
 // UpdateProfileResult contains the updated profile data
 type UpdateProfileResult struct {
-	UserID    string
-	Name      string
-	Email     string
-	Timezone  string
-	CreatedAt time.Time
+	UserID      string
+	Name        string
+	Email       string
+	Timezone    string
+	Locale      string
+	WeekStart   string
+	LogLockDays *int
+	AvatarURL   string
+	Role        string
+	CreatedAt   time.Time
 }
 
 // UpdateProfileHandler handles profile updates
 type UpdateProfileHandler decorator.CommandHandlerWithResult[UpdateProfileCommand, UpdateProfileResult]
 
 type updateProfileHandler struct {
-	repo user.Repository
+	repo  user.Repository
+	store storage.Store
 }
 
 // NewUpdateProfileHandler creates a new handler with decorators
 func NewUpdateProfileHandler(
 	repo user.Repository,
+	store storage.Store,
 	log logger.Logger,
 	metricsClient decorator.MetricsClient,
 ) UpdateProfileHandler {
@@ -49,7 +68,7 @@ func NewUpdateProfileHandler(
 	}
 
 	return decorator.ApplyCommandResultDecorators(
-		updateProfileHandler{repo: repo},
+		updateProfileHandler{repo: repo, store: store},
 		log,
 		metricsClient,
 	)
@@ -74,23 +93,76 @@ func (h updateProfileHandler) Handle(ctx context.Context, cmd UpdateProfileComma
 		existingUser.SetEmail(*cmd.Email)
 	}
 	if cmd.Timezone != nil && *cmd.Timezone != "" {
-		existingUser.SetTimezone(*cmd.Timezone)
+		timezone, err := user.NewTimezone(*cmd.Timezone)
+		if err != nil {
+			return UpdateProfileResult{}, invalidTimezone(*cmd.Timezone)
+		}
+		existingUser.SetTimezone(timezone.String())
+	}
+	if cmd.Locale != nil && *cmd.Locale != "" {
+		if err := existingUser.SetLocale(*cmd.Locale); err != nil {
+			return UpdateProfileResult{}, apperror.InvalidInput("locale",
+				fmt.Sprintf("must be one of: %s, %s", i18n.English, i18n.Indonesian))
+		}
+	}
+	if cmd.WeekStart != nil && *cmd.WeekStart != "" {
+		day, err := user.ParseWeekStart(*cmd.WeekStart)
+		if err == nil {
+			err = existingUser.SetWeekStart(day)
+		}
+		if err != nil {
+			return UpdateProfileResult{}, apperror.InvalidInput("week_start", "must be one of: sunday, monday, saturday")
+		}
+	}
+	if cmd.LogLockDays != nil {
+		switch days := *cmd.LogLockDays; {
+		case days < 0 || days > maxLogLockDays:
+			return UpdateProfileResult{}, apperror.InvalidInput("log_lock_days",
+				fmt.Sprintf("must be between 0 and %d", maxLogLockDays))
+		case days == 0:
+			existingUser.SetLogLockDays(nil)
+		default:
+			existingUser.SetLogLockDays(&days)
+		}
 	}
 
 	if err := h.repo.Update(ctx, existingUser); err != nil {
 		return UpdateProfileResult{}, apperror.InternalError(err)
 	}
 
+	var avatarURL string
+	if avatar := existingUser.Avatar(); avatar != nil {
+		if avatarURL, err = storage.ResolveURL(ctx, h.store, *avatar); err != nil {
+			return UpdateProfileResult{}, apperror.InternalError(err)
+		}
+	}
+
 	// Use getters for returning data
 	return UpdateProfileResult{
-		UserID:    existingUser.UserID().String(),
-		Name:      existingUser.Name(),
-		Email:     existingUser.Email(),
-		Timezone:  existingUser.Timezone(),
-		CreatedAt: existingUser.CreatedAt(),
+		UserID:      existingUser.UserID().String(),
+		Name:        existingUser.Name(),
+		Email:       existingUser.Email(),
+		Timezone:    existingUser.Timezone(),
+		Locale:      existingUser.Locale(),
+		WeekStart:   user.WeekStartName(existingUser.WeekStart()),
+		LogLockDays: existingUser.LogLockDays(),
+		AvatarURL:   avatarURL,
+		Role:        existingUser.Role(),
+		CreatedAt:   existingUser.CreatedAt(),
 	}, nil
 }
 
+// invalidTimezone rejects a zone the tz database does not know, suggesting
+// the closest ones it does
+func invalidTimezone(raw string) *apperror.AppError {
+	suggestions := []any{}
+	for _, name := range user.SuggestTimezones(raw, 3) {
+		suggestions = append(suggestions, name)
+	}
+	return apperror.InvalidInput("timezone", "must be an IANA time zone such as Asia/Jakarta").
+		WithDetails("suggestions", suggestions)
+}
+
 // ChangePasswordCommand for changing user password
 type ChangePasswordCommand struct {
 	UserID          string
@@ -0,0 +1,221 @@
+package command
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	authevents "github.com/semmidev/ethos-go/contracts/events/auth"
+	"github.com/semmidev/ethos-go/internal/auth/domain/service"
+	"github.com/semmidev/ethos-go/internal/auth/domain/session"
+	"github.com/semmidev/ethos-go/internal/auth/domain/user"
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/events"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/common/random"
+	"github.com/semmidev/ethos-go/internal/common/validator"
+)
+
+// ProvisionUserCommand creates an account on behalf of the enterprise
+// identity provider. The user signs in through SSO, so there's no password.
+type ProvisionUserCommand struct {
+	Email  string `validate:"required,email"`
+	Name   string `validate:"required"`
+	Active bool
+}
+
+// ProvisionUserResult identifies the created account
+type ProvisionUserResult struct {
+	UserID uuid.UUID
+}
+
+// ProvisionUserHandler handles account provisioning
+type ProvisionUserHandler decorator.CommandHandlerWithResult[ProvisionUserCommand, *ProvisionUserResult]
+
+type provisionUserHandler struct {
+	userRepo  user.Repository
+	validator *validator.Validator
+	publisher events.Publisher
+}
+
+// NewProvisionUserHandler creates a new handler
+func NewProvisionUserHandler(
+	userRepo user.Repository,
+	validator *validator.Validator,
+	publisher events.Publisher,
+	log logger.Logger,
+	metricsClient decorator.MetricsClient,
+) ProvisionUserHandler {
+	if userRepo == nil {
+		panic("nil user repo")
+	}
+	if publisher == nil {
+		panic("nil publisher")
+	}
+
+	return decorator.ApplyCommandResultDecorators(
+		provisionUserHandler{
+			userRepo:  userRepo,
+			validator: validator,
+			publisher: publisher,
+		},
+		log,
+		metricsClient,
+	)
+}
+
+func (h provisionUserHandler) Handle(ctx context.Context, cmd ProvisionUserCommand) (*ProvisionUserResult, error) {
+	if err := h.validator.Validate(cmd); err != nil {
+		return nil, apperror.ValidationFailed(err.Error())
+	}
+
+	if _, err := h.userRepo.FindByEmail(ctx, cmd.Email); err == nil {
+		return nil, apperror.AlreadyExists("user", cmd.Email)
+	}
+
+	u := user.NewProvisionedUser(random.NewUUID(), cmd.Email, cmd.Name)
+	if !cmd.Active {
+		u.Deactivate(user.DeactivatedByProvisioning)
+	}
+
+	err := h.userRepo.Create(ctx, u)
+	if errors.Is(err, user.ErrAlreadyExists) {
+		return nil, apperror.AlreadyExists("user", cmd.Email)
+	}
+	if err != nil {
+		return nil, apperror.DatabaseError("create user", err)
+	}
+
+	event := authevents.NewUserRegistered(
+		u.UserID().String(),
+		u.Email(),
+		u.Name(),
+		u.AuthProvider(),
+	)
+	_ = h.publisher.Publish(ctx, event)
+
+	return &ProvisionUserResult{UserID: u.UserID()}, nil
+}
+
+// UpdateProvisionedUserCommand changes an account as the identity provider
+// sees it; nil fields are left alone. Deactivating signs out every session.
+type UpdateProvisionedUserCommand struct {
+	UserID string
+	Email  *string
+	Name   *string
+	Active *bool
+}
+
+// UpdateProvisionedUserHandler handles account updates from the identity
+// provider
+type UpdateProvisionedUserHandler decorator.CommandHandler[UpdateProvisionedUserCommand]
+
+type updateProvisionedUserHandler struct {
+	userRepo    user.Repository
+	sessionRepo session.Repository
+	denylist    service.SessionDenylist
+	authService *session.AuthenticationService
+	validator   *validator.Validator
+}
+
+// NewUpdateProvisionedUserHandler creates a new handler
+func NewUpdateProvisionedUserHandler(
+	userRepo user.Repository,
+	sessionRepo session.Repository,
+	denylist service.SessionDenylist,
+	authService *session.AuthenticationService,
+	validator *validator.Validator,
+	log logger.Logger,
+	metricsClient decorator.MetricsClient,
+) UpdateProvisionedUserHandler {
+	if userRepo == nil {
+		panic("nil user repo")
+	}
+	if sessionRepo == nil {
+		panic("nil session repo")
+	}
+	if denylist == nil {
+		panic("nil session denylist")
+	}
+	if authService == nil {
+		panic("nil auth service")
+	}
+
+	return decorator.ApplyCommandDecorators(
+		updateProvisionedUserHandler{
+			userRepo:    userRepo,
+			sessionRepo: sessionRepo,
+			denylist:    denylist,
+			authService: authService,
+			validator:   validator,
+		},
+		log,
+		metricsClient,
+	)
+}
+
+func (h updateProvisionedUserHandler) Handle(ctx context.Context, cmd UpdateProvisionedUserCommand) error {
+	userID, err := uuid.Parse(cmd.UserID)
+	if err != nil {
+		return apperror.NotFound("user", cmd.UserID)
+	}
+
+	u, err := h.userRepo.FindByID(ctx, userID)
+	if errors.Is(err, user.ErrNotFound) {
+		return apperror.NotFound("user", cmd.UserID)
+	}
+	if err != nil {
+		return apperror.DatabaseError("find user", err)
+	}
+
+	if cmd.Email != nil && *cmd.Email != u.Email() {
+		email := struct {
+			Email string `validate:"required,email"`
+		}{*cmd.Email}
+		if err := h.validator.Validate(email); err != nil {
+			return apperror.InvalidInput("email", "must be a valid email address")
+		}
+		if _, err := h.userRepo.FindByEmail(ctx, *cmd.Email); err == nil {
+			return apperror.AlreadyExists("user", *cmd.Email)
+		}
+		u.SetEmail(*cmd.Email)
+	}
+	if cmd.Name != nil && *cmd.Name != "" {
+		u.SetName(*cmd.Name)
+	}
+
+	deactivating := cmd.Active != nil && !*cmd.Active && u.IsActive()
+	if cmd.Active != nil {
+		if *cmd.Active {
+			u.Activate()
+		} else {
+			// Even an account the user already deactivated now stays off
+			// until it is provisioned again
+			u.Deactivate(user.DeactivatedByProvisioning)
+		}
+	}
+
+	if deactivating {
+		// Sign the user out everywhere, as account deactivation does
+		sessions, err := h.sessionRepo.FindAllByUserID(ctx, userID)
+		if err != nil {
+			return apperror.DatabaseError("find sessions", err)
+		}
+		if err := denySessions(ctx, h.denylist, h.authService, sessions...); err != nil {
+			return err
+		}
+	}
+
+	if err := h.userRepo.Update(ctx, u); err != nil {
+		return apperror.DatabaseError("update user", err)
+	}
+
+	if deactivating {
+		if err := h.sessionRepo.DeleteAllByUserID(ctx, userID); err != nil {
+			return apperror.DatabaseError("delete sessions", err)
+		}
+	}
+	return nil
+}
@@ -13,11 +13,16 @@ import (
 
 type RefreshTokenCommand struct {
 	RefreshToken string
+	UserAgent    string
+	ClientIP     string
 }
 
 type RefreshTokenResult struct {
 	AccessToken  string
 	RefreshToken string
+	SessionID    string
+	UserID       string
+	ExpiresAt    int64
 }
 
 type RefreshTokenHandler decorator.CommandHandlerWithResult[RefreshTokenCommand, *RefreshTokenResult]
@@ -26,12 +31,14 @@ type refreshTokenHandler struct {
 	sessionRepo session.Repository
 	tokenIssuer service.TokenIssuer
 	authService *session.AuthenticationService
+	geoLocator  service.GeoLocator
 }
 
 func NewRefreshTokenHandler(
 	sessionRepo session.Repository,
 	tokenIssuer service.TokenIssuer,
 	authService *session.AuthenticationService,
+	geoLocator service.GeoLocator,
 	log logger.Logger,
 	metricsClient decorator.MetricsClient,
 ) RefreshTokenHandler {
@@ -40,6 +47,7 @@ func NewRefreshTokenHandler(
 			sessionRepo: sessionRepo,
 			tokenIssuer: tokenIssuer,
 			authService: authService,
+			geoLocator:  geoLocator,
 		},
 		log,
 		metricsClient,
@@ -47,10 +55,11 @@ func NewRefreshTokenHandler(
 }
 
 func (h refreshTokenHandler) Handle(ctx context.Context, cmd RefreshTokenCommand) (*RefreshTokenResult, error) {
-	// Find session by refresh token
+	// Find session by refresh token. Tokens are rotated on every refresh, so
+	// a token that was already used matches no session.
 	sess, err := h.sessionRepo.FindByRefreshToken(ctx, cmd.RefreshToken)
 	if err != nil {
-		return nil, apperror.NotFound("session", "")
+		return nil, apperror.Unauthorized("invalid refresh token")
 	}
 
 	// Validate session
@@ -58,16 +67,9 @@ func (h refreshTokenHandler) Handle(ctx context.Context, cmd RefreshTokenCommand
 		return nil, apperror.SessionExpired(nil)
 	}
 
-	// Calculate new expiration times
+	// The session slides: each refresh extends it by the refresh token TTL
 	now := time.Now()
 	accessTokenExpiry := now.Add(h.authService.AccessTokenTTL())
-	// We might or might not want to extend the refresh token.
-	// Common practice: Rotation. Issue a new one, invalidate the old one.
-	// But let's check if we want to extend the session life or keep the original session end.
-	// If the session model has a fixed absolute expiry, we should respect it.
-	// If the session has a sliding window, we extend it.
-	// Looking at session.go (not read but inferred), it likely has ExpiresAt.
-	// Let's assume sliding window for now (refresh token extends session).
 	refreshTokenExpiry := now.Add(h.authService.RefreshTokenTTL())
 
 	// Issue new access token
@@ -82,9 +84,20 @@ func (h refreshTokenHandler) Handle(ctx context.Context, cmd RefreshTokenCommand
 		return nil, apperror.InternalError(err)
 	}
 
-	// Update session with new refresh token and expiry
+	// Rotate the refresh token, invalidating the one just used
 	sess.Refresh(newRefreshToken, refreshTokenExpiry)
 
+	// Keep the sessions list showing where the session was last used. A
+	// location that can't be looked up is cleared rather than left stale.
+	if cmd.ClientIP != sess.ClientIP() {
+		location, err := h.geoLocator.Locate(ctx, cmd.ClientIP)
+		if err != nil {
+			location = session.Location{}
+		}
+		sess.SetLocation(location)
+	}
+	sess.UpdateDevice(cmd.UserAgent, cmd.ClientIP)
+
 	if err := h.sessionRepo.Update(ctx, sess); err != nil {
 		return nil, apperror.DatabaseError("update session", err)
 	}
@@ -92,5 +105,8 @@ func (h refreshTokenHandler) Handle(ctx context.Context, cmd RefreshTokenCommand
 	return &RefreshTokenResult{
 		AccessToken:  accessToken,
 		RefreshToken: newRefreshToken,
+		SessionID:    sess.SessionID().String(),
+		UserID:       sess.UserID().String(),
+		ExpiresAt:    accessTokenExpiry.Unix(),
 	}, nil
 }
@@ -0,0 +1,97 @@
+package command_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/config"
+	"github.com/semmidev/ethos-go/internal/auth/adapters"
+	"github.com/semmidev/ethos-go/internal/auth/app/command"
+	"github.com/semmidev/ethos-go/internal/auth/domain/session"
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/random"
+	"github.com/semmidev/ethos-go/internal/testutil"
+)
+
+// fixedLocator places every address in the same city
+type fixedLocator struct{}
+
+func (fixedLocator) Locate(context.Context, string) (session.Location, error) {
+	return session.Location{City: "Bandung", Country: "ID"}, nil
+}
+
+func TestRefreshTokenHandler(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given a session started on a phone", t, func() {
+		ctx := context.Background()
+		sess := session.NewSession(random.NewUUID(), random.NewUUID(), "refresh-1", "ethos-ios/1.0", "10.0.0.1", time.Now().Add(time.Hour))
+
+		issuer, err := adapters.NewJWTTokenIssuer(&config.Config{
+			AppName:          "ethos-go",
+			AuthJWTAlgorithm: "HS256",
+			AuthJWTKeyID:     "primary",
+			AuthJWTSecret:    strings.Repeat("s", 32),
+		})
+		So(err, ShouldBeNil)
+
+		sessions := testutil.NewSessionRepository(sess)
+		handler := command.NewRefreshTokenHandler(
+			sessions,
+			issuer,
+			session.NewAuthenticationService(15*time.Minute, 24*time.Hour),
+			fixedLocator{},
+			testutil.NopLogger{},
+			&decorator.NoOpMetricsClient{},
+		)
+		refresh := func(token string) (*command.RefreshTokenResult, error) {
+			return handler.Handle(ctx, command.RefreshTokenCommand{
+				RefreshToken: token,
+				UserAgent:    "ethos-ios/1.1",
+				ClientIP:     "10.0.0.2",
+			})
+		}
+
+		Convey("When the app refreshes from a new network", func() {
+			result, err := refresh("refresh-1")
+			So(err, ShouldBeNil)
+
+			Convey("Then the refresh token is rotated", func() {
+				So(result.AccessToken, ShouldNotBeEmpty)
+				So(result.RefreshToken, ShouldNotEqual, "refresh-1")
+				So(result.SessionID, ShouldEqual, sess.SessionID().String())
+
+				_, err := refresh("refresh-1")
+				So(apperror.GetAppError(err).Code, ShouldEqual, apperror.ErrCodeUnauthorized)
+
+				_, err = refresh(result.RefreshToken)
+				So(err, ShouldBeNil)
+			})
+
+			Convey("Then the session records the device it was used from", func() {
+				found, err := sessions.FindByID(ctx, sess.SessionID())
+				So(err, ShouldBeNil)
+				So(found.UserAgent(), ShouldEqual, "ethos-ios/1.1")
+				So(found.ClientIP(), ShouldEqual, "10.0.0.2")
+				So(found.Location().City, ShouldEqual, "Bandung")
+			})
+		})
+
+		Convey("When the session has been revoked", func() {
+			sess.Block()
+			So(sessions.Update(ctx, sess), ShouldBeNil)
+
+			_, err := refresh("refresh-1")
+
+			Convey("Then the refresh is refused", func() {
+				So(err, ShouldNotBeNil)
+				So(apperror.GetAppError(err).Code, ShouldEqual, apperror.ErrCodeSessionExpired)
+			})
+		})
+	})
+}
@@ -6,7 +6,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
-	authevents "github.com/semmidev/ethos-go/internal/auth/domain/events"
+	authevents "github.com/semmidev/ethos-go/contracts/events/auth"
 	"github.com/semmidev/ethos-go/internal/auth/domain/gateway"
 	"github.com/semmidev/ethos-go/internal/auth/domain/service"
 	"github.com/semmidev/ethos-go/internal/auth/domain/user"
@@ -23,6 +23,8 @@ type RegisterCommand struct {
 	Name     string `json:"name" validate:"required"`
 	Email    string `json:"email" validate:"required,email"`
 	Password string `json:"password" validate:"required,min=8"`
+	// Locale is negotiated from the registration request; empty keeps the default
+	Locale string `json:"locale"`
 }
 
 func (c RegisterCommand) Validate() error {
@@ -43,6 +45,7 @@ type RegisterHandler decorator.CommandHandlerWithResult[RegisterCommand, *Regist
 type registerHandler struct {
 	userRepo       user.Repository
 	passwordHasher service.PasswordHasher
+	codeHasher     user.CodeHasher
 	validator      *validator.Validator
 	dispatcher     gateway.TaskDispatcher
 	eventPublisher events.Publisher
@@ -51,6 +54,7 @@ type registerHandler struct {
 func NewRegisterHandler(
 	userRepo user.Repository,
 	passwordHasher service.PasswordHasher,
+	codeHasher user.CodeHasher,
 	validator *validator.Validator,
 	dispatcher gateway.TaskDispatcher,
 	eventPublisher events.Publisher,
@@ -61,6 +65,7 @@ func NewRegisterHandler(
 		registerHandler{
 			userRepo:       userRepo,
 			passwordHasher: passwordHasher,
+			codeHasher:     codeHasher,
 			validator:      validator,
 			dispatcher:     dispatcher,
 			eventPublisher: eventPublisher,
@@ -98,16 +103,16 @@ func (h registerHandler) Handle(ctx context.Context, cmd RegisterCommand) (*Regi
 	// Create user
 	userID := random.NewUUID()
 	newUser := user.NewUser(userID, cmd.Email, cmd.Name, hashedPassword)
+	if cmd.Locale != "" {
+		_ = newUser.SetLocale(cmd.Locale) // unsupported locales keep the default
+	}
 
 	// Generate Verification OTP and set via domain setter
 	otp, err := random.GenerateNumericOTP(6)
 	if err != nil {
 		return nil, apperror.InternalError(fmt.Errorf("failed to generate otp: %w", err))
 	}
-	expiresAt := time.Now().Add(15 * time.Minute)
-
-	// Use domain setter instead of direct field assignment
-	newUser.SetVerifyToken(&otp, &expiresAt)
+	newUser.IssueVerifyCode(h.codeHasher, otp, time.Now())
 
 	// Save user
 	if err := h.userRepo.Create(ctx, newUser); err != nil {
@@ -119,8 +124,9 @@ func (h registerHandler) Handle(ctx context.Context, cmd RegisterCommand) (*Regi
 		UserID:                     newUser.UserID(),
 		Name:                       newUser.Name(),
 		Email:                      newUser.Email(),
+		Locale:                     newUser.Locale(),
 		VerificationCode:           otp,
-		VerificationCodeExpiration: 15,
+		VerificationCodeExpiration: codeExpirationMinutes,
 	}
 
 	// We don't fail registration if email fails (user can request resend)
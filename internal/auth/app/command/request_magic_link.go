@@ -0,0 +1,117 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/semmidev/ethos-go/internal/auth/domain/gateway"
+	"github.com/semmidev/ethos-go/internal/auth/domain/magiclink"
+	"github.com/semmidev/ethos-go/internal/auth/domain/user"
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/common/validator"
+)
+
+// MagicLinkPolicy configures passwordless login
+type MagicLinkPolicy struct {
+	Enabled bool
+	// TTL is how long a link can be used
+	TTL time.Duration
+	// HourlyLimit caps the links sent to one user per hour
+	HourlyLimit int
+}
+
+// magicLinkDisabledError is returned by both magic link commands while the
+// feature is switched off
+func magicLinkDisabledError() error {
+	return apperror.OperationNotAllowed("magic link login", "magic link login is disabled")
+}
+
+// RequestMagicLinkCommand asks for a login link to be emailed
+type RequestMagicLinkCommand struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+type RequestMagicLinkHandler decorator.CommandHandler[RequestMagicLinkCommand]
+
+type requestMagicLinkHandler struct {
+	userRepo   user.UserReader
+	links      magiclink.Repository
+	validator  *validator.Validator
+	dispatcher gateway.TaskDispatcher
+	policy     MagicLinkPolicy
+}
+
+func NewRequestMagicLinkHandler(
+	userRepo user.UserReader,
+	links magiclink.Repository,
+	validator *validator.Validator,
+	dispatcher gateway.TaskDispatcher,
+	policy MagicLinkPolicy,
+	log logger.Logger,
+	metricsClient decorator.MetricsClient,
+) RequestMagicLinkHandler {
+	if links == nil {
+		panic("nil magic link repository")
+	}
+	return decorator.ApplyCommandDecorators(
+		requestMagicLinkHandler{
+			userRepo:   userRepo,
+			links:      links,
+			validator:  validator,
+			dispatcher: dispatcher,
+			policy:     policy,
+		},
+		log,
+		metricsClient,
+	)
+}
+
+func (h requestMagicLinkHandler) Handle(ctx context.Context, cmd RequestMagicLinkCommand) error {
+	if !h.policy.Enabled {
+		return magicLinkDisabledError()
+	}
+	if err := h.validator.Validate(cmd); err != nil {
+		return apperror.ValidationFailed(err.Error())
+	}
+
+	// Unknown addresses succeed silently, like forgot password, so the
+	// endpoint can't be used to probe accounts
+	u, err := h.userRepo.FindByEmail(ctx, cmd.Email)
+	if err != nil {
+		return nil
+	}
+
+	now := time.Now()
+	link, token, err := magiclink.New(u.UserID(), h.policy.TTL, now)
+	if err != nil {
+		return apperror.InternalError(err)
+	}
+
+	// Past the limit the request also succeeds without sending, so the
+	// limit doesn't reveal the account either
+	err = h.links.CreateWithinLimit(ctx, link, now.Add(-time.Hour), h.policy.HourlyLimit)
+	if errors.Is(err, magiclink.ErrLimitReached) {
+		return nil
+	}
+	if err != nil {
+		return apperror.DatabaseError("create magic link", err)
+	}
+
+	payload := &gateway.PayloadSendMagicLinkEmail{
+		UserID:     u.UserID(),
+		Name:       u.Name(),
+		Email:      u.Email(),
+		Locale:     u.Locale(),
+		Token:      token,
+		Expiration: int(h.policy.TTL / time.Minute),
+	}
+	if err := h.dispatcher.DispatchSendMagicLinkEmail(ctx, payload); err != nil {
+		return fmt.Errorf("failed to enqueue email: %w", err)
+	}
+
+	return nil
+}
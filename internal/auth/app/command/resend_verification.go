@@ -22,12 +22,14 @@ type ResendVerificationHandler decorator.CommandHandler[ResendVerificationComman
 
 type resendVerificationHandler struct {
 	userRepo   user.Repository
+	codeHasher user.CodeHasher
 	validator  *validator.Validator
 	dispatcher gateway.TaskDispatcher
 }
 
 func NewResendVerificationHandler(
 	userRepo user.Repository,
+	codeHasher user.CodeHasher,
 	validator *validator.Validator,
 	dispatcher gateway.TaskDispatcher,
 	log logger.Logger,
@@ -36,6 +38,7 @@ func NewResendVerificationHandler(
 	return decorator.ApplyCommandDecorators(
 		resendVerificationHandler{
 			userRepo:   userRepo,
+			codeHasher: codeHasher,
 			validator:  validator,
 			dispatcher: dispatcher,
 		},
@@ -49,13 +52,11 @@ func (h resendVerificationHandler) Handle(ctx context.Context, cmd ResendVerific
 		return apperror.ValidationFailed(err.Error())
 	}
 
+	// Unknown and already verified addresses succeed silently, like
+	// forgot password, so the endpoint can't be used to probe accounts
 	u, err := h.userRepo.FindByEmail(ctx, cmd.Email)
-	if err != nil {
-		return apperror.NotFound("User", cmd.Email)
-	}
-
-	if u.IsVerified() {
-		return apperror.ValidationFailed("user already verified")
+	if err != nil || u.IsVerified() {
+		return nil
 	}
 
 	// Generate code
@@ -63,10 +64,7 @@ func (h resendVerificationHandler) Handle(ctx context.Context, cmd ResendVerific
 	if err != nil {
 		return apperror.InternalError(err)
 	}
-	expiresAt := time.Now().Add(15 * time.Minute)
-
-	// Use domain setter
-	u.SetVerifyToken(&code, &expiresAt)
+	u.IssueVerifyCode(h.codeHasher, code, time.Now())
 
 	if err := h.userRepo.Update(ctx, u); err != nil {
 		return apperror.InternalError(err)
@@ -77,8 +75,9 @@ func (h resendVerificationHandler) Handle(ctx context.Context, cmd ResendVerific
 		UserID:                     u.UserID(),
 		Name:                       u.Name(),
 		Email:                      u.Email(),
+		Locale:                     u.Locale(),
 		VerificationCode:           code,
-		VerificationCodeExpiration: 15,
+		VerificationCodeExpiration: codeExpirationMinutes,
 	}
 
 	if err := h.dispatcher.DispatchSendVerifyEmail(ctx, payload); err != nil {
@@ -4,7 +4,7 @@ import (
 	"context"
 	"time"
 
-	authevents "github.com/semmidev/ethos-go/internal/auth/domain/events"
+	authevents "github.com/semmidev/ethos-go/contracts/events/auth"
 	"github.com/semmidev/ethos-go/internal/auth/domain/service"
 	"github.com/semmidev/ethos-go/internal/auth/domain/user"
 	"github.com/semmidev/ethos-go/internal/common/apperror"
@@ -25,6 +25,7 @@ type ResetPasswordHandler decorator.CommandHandler[ResetPasswordCommand]
 type resetPasswordHandler struct {
 	userRepo       user.Repository
 	passwordHasher service.PasswordHasher
+	codeHasher     user.CodeHasher
 	validator      *validator.Validator
 	publisher      events.Publisher
 }
@@ -32,6 +33,7 @@ type resetPasswordHandler struct {
 func NewResetPasswordHandler(
 	userRepo user.Repository,
 	passwordHasher service.PasswordHasher,
+	codeHasher user.CodeHasher,
 	validator *validator.Validator,
 	publisher events.Publisher, // Injected
 	log logger.Logger,
@@ -41,6 +43,7 @@ func NewResetPasswordHandler(
 		resetPasswordHandler{
 			userRepo:       userRepo,
 			passwordHasher: passwordHasher,
+			codeHasher:     codeHasher,
 			validator:      validator,
 			publisher:      publisher,
 		},
@@ -56,15 +59,17 @@ func (h resetPasswordHandler) Handle(ctx context.Context, cmd ResetPasswordComma
 
 	u, err := h.userRepo.FindByEmail(ctx, cmd.Email)
 	if err != nil {
-		return apperror.NotFound("User", cmd.Email)
+		return invalidCodeError()
 	}
 
-	if u.PasswordResetToken() == nil || *u.PasswordResetToken() != cmd.Code {
-		return apperror.ValidationFailed("invalid reset token")
+	// Count the guess before checking it, so the code locks after too
+	// many guesses even when they arrive at once
+	allowed, err := h.userRepo.RecordCodeAttempt(ctx, u.UserID(), user.CodePasswordReset)
+	if err != nil {
+		return apperror.InternalError(err)
 	}
-
-	if u.PasswordResetExpiresAt() != nil && u.PasswordResetExpiresAt().Before(time.Now()) {
-		return apperror.ValidationFailed("reset token expired")
+	if !allowed || u.RedeemPasswordResetCode(h.codeHasher, cmd.Code, time.Now()) != nil {
+		return invalidCodeError()
 	}
 
 	// Hash new password
@@ -73,9 +78,7 @@ func (h resetPasswordHandler) Handle(ctx context.Context, cmd ResetPasswordComma
 		return apperror.InternalError(err)
 	}
 
-	// Use domain setters
 	u.SetHashedPassword(hashedPassword)
-	u.SetPasswordResetToken(nil, nil)
 
 	if err := h.userRepo.Update(ctx, u); err != nil {
 		return apperror.InternalError(err)
@@ -0,0 +1,86 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/auth/app/command"
+	"github.com/semmidev/ethos-go/internal/auth/domain/session"
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/testutil"
+)
+
+func TestRevokeSessionHandler(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given a user signed in on two devices and another user", t, func() {
+		ctx := context.Background()
+		alice := testutil.NewUserBuilder().Build()
+		bob := testutil.NewUserBuilder().WithEmail("bob@example.com").Build()
+		laptop := testutil.NewSessionBuilder(alice).Build()
+		phone := testutil.NewSessionBuilder(alice).Build()
+		bobSession := testutil.NewSessionBuilder(bob).Build()
+
+		sessions := testutil.NewSessionRepository(laptop, phone, bobSession)
+		denylist := testutil.NewSessionDenylist()
+		authService := session.NewAuthenticationService(15*time.Minute, 24*time.Hour)
+		handler := command.NewRevokeSessionHandler(sessions, denylist, authService, testutil.NopLogger{}, &decorator.NoOpMetricsClient{})
+
+		Convey("When the user revokes the lost phone", func() {
+			err := handler.Handle(ctx, command.RevokeSessionCommand{
+				SessionID: phone.SessionID().String(),
+				UserID:    alice.UserID().String(),
+			})
+
+			Convey("Then only that session is blocked and kept in the list", func() {
+				So(err, ShouldBeNil)
+				revoked, err := sessions.FindByID(ctx, phone.SessionID())
+				So(err, ShouldBeNil)
+				So(revoked.IsBlocked(), ShouldBeTrue)
+				So(revoked.IsValid(), ShouldBeFalse)
+
+				current, err := sessions.FindByID(ctx, laptop.SessionID())
+				So(err, ShouldBeNil)
+				So(current.IsBlocked(), ShouldBeFalse)
+			})
+
+			Convey("Then its access token is denied until it would have expired", func() {
+				ttl := denylist.TTL(phone.SessionID())
+				So(ttl, ShouldBeGreaterThan, 14*time.Minute)
+				So(ttl, ShouldBeLessThanOrEqualTo, 15*time.Minute)
+
+				denied, err := denylist.IsDenied(ctx, laptop.SessionID())
+				So(err, ShouldBeNil)
+				So(denied, ShouldBeFalse)
+			})
+
+			Convey("Then revoking it again succeeds", func() {
+				So(handler.Handle(ctx, command.RevokeSessionCommand{
+					SessionID: phone.SessionID().String(),
+					UserID:    alice.UserID().String(),
+				}), ShouldBeNil)
+			})
+		})
+
+		Convey("When the user tries to revoke another user's session", func() {
+			err := handler.Handle(ctx, command.RevokeSessionCommand{
+				SessionID: bobSession.SessionID().String(),
+				UserID:    alice.UserID().String(),
+			})
+
+			Convey("Then it is reported as not found and left alone", func() {
+				So(apperror.GetAppError(err).Code, ShouldEqual, apperror.ErrCodeNotFound)
+				untouched, _ := sessions.FindByID(ctx, bobSession.SessionID())
+				So(untouched.IsBlocked(), ShouldBeFalse)
+
+				denied, err := denylist.IsDenied(ctx, bobSession.SessionID())
+				So(err, ShouldBeNil)
+				So(denied, ShouldBeFalse)
+			})
+		})
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/semmidev/ethos-go/internal/auth/domain/service"
 	"github.com/semmidev/ethos-go/internal/auth/domain/session"
 	"github.com/semmidev/ethos-go/internal/common/apperror"
 	"github.com/semmidev/ethos-go/internal/common/decorator"
@@ -26,20 +27,34 @@ type RevokeAllOtherSessionsHandler decorator.CommandHandlerWithResult[RevokeAllO
 
 type revokeAllOtherSessionsHandler struct {
 	sessionRepo session.Repository
+	denylist    service.SessionDenylist
+	authService *session.AuthenticationService
 }
 
 // NewRevokeAllOtherSessionsHandler creates a new handler
 func NewRevokeAllOtherSessionsHandler(
 	sessionRepo session.Repository,
+	denylist service.SessionDenylist,
+	authService *session.AuthenticationService,
 	log logger.Logger,
 	metricsClient decorator.MetricsClient,
 ) RevokeAllOtherSessionsHandler {
 	if sessionRepo == nil {
 		panic("nil session repo")
 	}
+	if denylist == nil {
+		panic("nil session denylist")
+	}
+	if authService == nil {
+		panic("nil auth service")
+	}
 
 	return decorator.ApplyCommandResultDecorators(
-		revokeAllOtherSessionsHandler{sessionRepo: sessionRepo},
+		revokeAllOtherSessionsHandler{
+			sessionRepo: sessionRepo,
+			denylist:    denylist,
+			authService: authService,
+		},
 		log,
 		metricsClient,
 	)
@@ -70,6 +85,9 @@ func (h revokeAllOtherSessionsHandler) Handle(ctx context.Context, cmd RevokeAll
 		}
 
 		// Block/revoke this session
+		if err := denySessions(ctx, h.denylist, h.authService, sess); err != nil {
+			continue
+		}
 		sess.Block()
 		if err := h.sessionRepo.Update(ctx, sess); err != nil {
 			// Log but continue with other sessions
@@ -0,0 +1,83 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	authevents "github.com/semmidev/ethos-go/contracts/events/auth"
+	"github.com/semmidev/ethos-go/internal/auth/domain/service"
+	"github.com/semmidev/ethos-go/internal/auth/domain/session"
+	"github.com/semmidev/ethos-go/internal/auth/domain/user"
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/events"
+	"github.com/semmidev/ethos-go/internal/common/random"
+)
+
+// sessionStarter signs an authenticated user in on a new session. Every
+// login method ends with it, so sessions look the same however they began.
+type sessionStarter struct {
+	sessionRepo session.Repository
+	tokenIssuer service.TokenIssuer
+	authService *session.AuthenticationService
+	geoLocator  service.GeoLocator
+	publisher   events.Publisher
+}
+
+// start creates the session, issues its tokens and announces the login
+func (s sessionStarter) start(ctx context.Context, u *user.User, userAgent, clientIP string) (*LoginResult, error) {
+	// Calculate token expiration times
+	now := time.Now()
+	accessTokenExpiry := now.Add(s.authService.AccessTokenTTL())
+	refreshTokenExpiry := now.Add(s.authService.RefreshTokenTTL())
+
+	// Generate session ID first
+	sessionID := random.NewUUID()
+
+	// Issue access token - includes session ID now
+	accessToken, err := s.tokenIssuer.IssueAccessToken(ctx, u.UserID(), sessionID, accessTokenExpiry)
+	if err != nil {
+		return nil, apperror.InternalError(err)
+	}
+
+	// Issue refresh token
+	refreshToken, err := s.tokenIssuer.IssueRefreshToken(ctx, sessionID, refreshTokenExpiry)
+	if err != nil {
+		return nil, apperror.InternalError(err)
+	}
+
+	newSession := session.NewSession(
+		sessionID,
+		u.UserID(),
+		refreshToken,
+		userAgent,
+		clientIP,
+		refreshTokenExpiry,
+	)
+
+	// Best-effort: a failed lookup must not block the login
+	if location, err := s.geoLocator.Locate(ctx, clientIP); err == nil {
+		newSession.SetLocation(location)
+	}
+
+	// Persist the session
+	if err := s.sessionRepo.Create(ctx, newSession); err != nil {
+		return nil, apperror.DatabaseError("create session", err)
+	}
+
+	// Publish UserLoggedIn event
+	event := authevents.NewUserLoggedIn(
+		u.UserID().String(),
+		u.Email(),
+		userAgent,
+		clientIP,
+	)
+	_ = s.publisher.Publish(ctx, event)
+
+	return &LoginResult{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		SessionID:    sessionID.String(),
+		UserID:       u.UserID().String(),
+		ExpiresAt:    accessTokenExpiry.Unix(),
+	}, nil
+}
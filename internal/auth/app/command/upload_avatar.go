@@ -0,0 +1,116 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/semmidev/ethos-go/internal/auth/domain/user"
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/imaging"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/common/storage"
+)
+
+const (
+	// MaxAvatarBytes is the largest avatar upload accepted
+	MaxAvatarBytes = 5 << 20
+	// AvatarSize is the width and height avatars are stored at
+	AvatarSize = 256
+	// MinAvatarSize is the shortest side an uploaded avatar may have
+	MinAvatarSize = 64
+)
+
+// UploadAvatarCommand replaces the user's avatar with an uploaded image
+type UploadAvatarCommand struct {
+	UserID string
+	Image  []byte
+}
+
+// UploadAvatarResult contains the URL of the new avatar
+type UploadAvatarResult struct {
+	AvatarURL string
+}
+
+// UploadAvatarHandler handles avatar uploads
+type UploadAvatarHandler decorator.CommandHandlerWithResult[UploadAvatarCommand, UploadAvatarResult]
+
+type uploadAvatarHandler struct {
+	repo  user.Repository
+	store storage.Store
+}
+
+// NewUploadAvatarHandler creates a new handler with decorators
+func NewUploadAvatarHandler(
+	repo user.Repository,
+	store storage.Store,
+	log logger.Logger,
+	metricsClient decorator.MetricsClient,
+) UploadAvatarHandler {
+	if repo == nil {
+		panic("nil repo")
+	}
+	if store == nil {
+		panic("nil store")
+	}
+
+	return decorator.ApplyCommandResultDecorators(
+		uploadAvatarHandler{repo: repo, store: store},
+		log,
+		metricsClient,
+	)
+}
+
+func (h uploadAvatarHandler) Handle(ctx context.Context, cmd UploadAvatarCommand) (UploadAvatarResult, error) {
+	userID, err := uuid.Parse(cmd.UserID)
+	if err != nil {
+		return UploadAvatarResult{}, apperror.ValidationFailed("invalid user ID")
+	}
+	if len(cmd.Image) > MaxAvatarBytes {
+		return UploadAvatarResult{}, apperror.InvalidInput("avatar",
+			fmt.Sprintf("must not be larger than %d MB", MaxAvatarBytes>>20))
+	}
+
+	existingUser, err := h.repo.FindByID(ctx, userID)
+	if err != nil {
+		return UploadAvatarResult{}, apperror.NotFound("user", cmd.UserID)
+	}
+
+	// Re-encoding normalizes the format and size and strips metadata
+	avatar, err := imaging.SquareJPEG(cmd.Image, AvatarSize, MinAvatarSize)
+	if err != nil {
+		if errors.Is(err, imaging.ErrTooSmall) {
+			return UploadAvatarResult{}, apperror.InvalidInput("avatar",
+				fmt.Sprintf("must be at least %dx%d pixels", MinAvatarSize, MinAvatarSize))
+		}
+		return UploadAvatarResult{}, apperror.InvalidInput("avatar", err.Error())
+	}
+
+	// Every upload gets a fresh key so cached copies of the old avatar are
+	// never served in its place
+	key := fmt.Sprintf("avatars/%s/%s.jpg", userID, uuid.New())
+	if err := h.store.Put(ctx, key, "image/jpeg", avatar); err != nil {
+		return UploadAvatarResult{}, apperror.InternalError(fmt.Errorf("store avatar: %w", err))
+	}
+
+	previous := existingUser.Avatar()
+	existingUser.SetAvatar(&key)
+	if err := h.repo.Update(ctx, existingUser); err != nil {
+		_ = h.store.Delete(ctx, key)
+		return UploadAvatarResult{}, apperror.InternalError(err)
+	}
+
+	// The old image is unreferenced now; a failed delete only leaves an
+	// orphaned object behind
+	if previous != nil && !storage.IsExternal(*previous) {
+		_ = h.store.Delete(ctx, *previous)
+	}
+
+	url, err := h.store.URL(ctx, key)
+	if err != nil {
+		return UploadAvatarResult{}, apperror.InternalError(err)
+	}
+	return UploadAvatarResult{AvatarURL: url}, nil
+}
@@ -0,0 +1,95 @@
+package command_test
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/auth/app/command"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/storage"
+	"github.com/semmidev/ethos-go/internal/testutil"
+)
+
+func TestUploadAvatarHandler(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given a user and a local avatar store", t, func() {
+		ctx := context.Background()
+		u := testutil.NewUserBuilder().Build()
+		users := testutil.NewUserRepository(u)
+
+		dir := t.TempDir()
+		store, err := storage.NewLocalStore(dir, "https://cdn.example.com/")
+		So(err, ShouldBeNil)
+		handler := command.NewUploadAvatarHandler(users, store, testutil.NopLogger{}, &decorator.NoOpMetricsClient{})
+
+		upload := func(img []byte) (command.UploadAvatarResult, error) {
+			return handler.Handle(ctx, command.UploadAvatarCommand{UserID: u.UserID().String(), Image: img})
+		}
+
+		Convey("When a landscape PNG is uploaded", func() {
+			result, err := upload(encodePNG(600, 400))
+			So(err, ShouldBeNil)
+
+			found, err := users.FindByID(ctx, u.UserID())
+			So(err, ShouldBeNil)
+			So(found.Avatar(), ShouldNotBeNil)
+			key := *found.Avatar()
+
+			Convey("Then a square JPEG of the avatar size is stored under a new key", func() {
+				So(result.AvatarURL, ShouldEqual, "https://cdn.example.com/"+key)
+
+				data, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(key)))
+				So(err, ShouldBeNil)
+				cfg, err := jpeg.DecodeConfig(bytes.NewReader(data))
+				So(err, ShouldBeNil)
+				So(cfg.Width, ShouldEqual, command.AvatarSize)
+				So(cfg.Height, ShouldEqual, command.AvatarSize)
+			})
+
+			Convey("Then replacing it removes the previous image", func() {
+				_, err := upload(encodePNG(300, 300))
+				So(err, ShouldBeNil)
+
+				_, err = os.Stat(filepath.Join(dir, filepath.FromSlash(key)))
+				So(os.IsNotExist(err), ShouldBeTrue)
+			})
+		})
+
+		Convey("When the upload is not an image, it is rejected", func() {
+			_, err := upload([]byte("definitely not an image"))
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "avatar")
+		})
+
+		Convey("When the image is smaller than the minimum, it is rejected", func() {
+			_, err := upload(encodePNG(command.MinAvatarSize-1, 200))
+			So(err, ShouldNotBeNil)
+
+			found, _ := users.FindByID(ctx, u.UserID())
+			So(found.Avatar(), ShouldBeNil)
+		})
+	})
+}
+
+func encodePNG(width, height int) []byte {
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, color.NRGBA{R: uint8(x), G: uint8(y), B: 128, A: 255})
+		}
+	}
+
+	var buf bytes.Buffer
+	_ = png.Encode(&buf, img)
+	return buf.Bytes()
+}
@@ -4,7 +4,7 @@ import (
 	"context"
 	"time"
 
-	authevents "github.com/semmidev/ethos-go/internal/auth/domain/events"
+	authevents "github.com/semmidev/ethos-go/contracts/events/auth"
 	"github.com/semmidev/ethos-go/internal/auth/domain/user"
 	"github.com/semmidev/ethos-go/internal/common/apperror"
 	"github.com/semmidev/ethos-go/internal/common/decorator"
@@ -21,13 +21,15 @@ type VerifyEmailCommand struct {
 type VerifyEmailHandler decorator.CommandHandler[VerifyEmailCommand]
 
 type verifyEmailHandler struct {
-	userRepo  user.Repository
-	validator *validator.Validator
-	publisher events.Publisher
+	userRepo   user.Repository
+	codeHasher user.CodeHasher
+	validator  *validator.Validator
+	publisher  events.Publisher
 }
 
 func NewVerifyEmailHandler(
 	userRepo user.Repository,
+	codeHasher user.CodeHasher,
 	validator *validator.Validator,
 	publisher events.Publisher, // Injected publisher
 	log logger.Logger,
@@ -35,9 +37,10 @@ func NewVerifyEmailHandler(
 ) VerifyEmailHandler {
 	return decorator.ApplyCommandDecorators(
 		verifyEmailHandler{
-			userRepo:  userRepo,
-			validator: validator,
-			publisher: publisher,
+			userRepo:   userRepo,
+			codeHasher: codeHasher,
+			validator:  validator,
+			publisher:  publisher,
 		},
 		log,
 		metricsClient,
@@ -51,22 +54,23 @@ func (h verifyEmailHandler) Handle(ctx context.Context, cmd VerifyEmailCommand)
 
 	u, err := h.userRepo.FindByEmail(ctx, cmd.Email)
 	if err != nil {
-		return apperror.NotFound("User", cmd.Email)
+		return invalidCodeError()
 	}
 
 	if u.IsVerified() {
 		return nil
 	}
 
-	if u.VerifyToken() == nil || *u.VerifyToken() != cmd.Code {
-		return apperror.ValidationFailed("invalid verification code")
+	// Count the guess before checking it, so the code locks after too
+	// many guesses even when they arrive at once
+	allowed, err := h.userRepo.RecordCodeAttempt(ctx, u.UserID(), user.CodeEmailVerification)
+	if err != nil {
+		return apperror.InternalError(err)
 	}
-
-	if u.VerifyExpiresAt() != nil && u.VerifyExpiresAt().Before(time.Now()) {
-		return apperror.ValidationFailed("verification code expired")
+	if !allowed || u.RedeemVerifyCode(h.codeHasher, cmd.Code, time.Now()) != nil {
+		return invalidCodeError()
 	}
 
-	// Use domain method to mark as verified
 	u.MarkVerified()
 
 	if err := h.userRepo.Update(ctx, u); err != nil {
@@ -0,0 +1,90 @@
+package command_test
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/auth/app/command"
+	"github.com/semmidev/ethos-go/internal/auth/domain/user"
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/validator"
+	"github.com/semmidev/ethos-go/internal/testutil"
+)
+
+func TestVerifyEmailHandler(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given an unverified user with an emailed code", t, func() {
+		ctx := context.Background()
+		hasher := user.NewCodeHasher(strings.Repeat("k", 32))
+		u := testutil.NewUserBuilder().Unverified().Build()
+		u.IssueVerifyCode(hasher, "123456", time.Now())
+
+		users := testutil.NewUserRepository(u)
+		publisher := testutil.NewRecordingPublisher()
+		handler := command.NewVerifyEmailHandler(users, hasher, validator.New("en"), publisher, testutil.NopLogger{}, &decorator.NoOpMetricsClient{})
+
+		verify := func(email, code string) error {
+			return handler.Handle(ctx, command.VerifyEmailCommand{Email: email, Code: code})
+		}
+
+		Convey("When the right code is entered", func() {
+			So(verify(u.Email(), "123456"), ShouldBeNil)
+
+			Convey("Then the user is verified", func() {
+				found, err := users.FindByID(ctx, u.UserID())
+				So(err, ShouldBeNil)
+				So(found.IsVerified(), ShouldBeTrue)
+				So(found.VerifyCode(), ShouldBeNil)
+			})
+		})
+
+		Convey("When wrong codes are guessed until the code locks", func() {
+			for i := 0; i < user.MaxCodeAttempts; i++ {
+				So(verify(u.Email(), "000000"), ShouldNotBeNil)
+			}
+
+			Convey("Then the right code is refused too", func() {
+				err := verify(u.Email(), "123456")
+				So(apperror.GetAppError(err).Code, ShouldEqual, apperror.ErrCodeInvalidInput)
+
+				found, _ := users.FindByID(ctx, u.UserID())
+				So(found.IsVerified(), ShouldBeFalse)
+			})
+		})
+
+		Convey("When many wrong codes are guessed at once", func() {
+			var wg sync.WaitGroup
+			for i := 0; i < 4*user.MaxCodeAttempts; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					_ = verify(u.Email(), "000000")
+				}()
+			}
+			wg.Wait()
+
+			Convey("Then each counts, and the code locks", func() {
+				found, err := users.FindByID(ctx, u.UserID())
+				So(err, ShouldBeNil)
+				So(found.VerifyCode().Attempts(), ShouldEqual, user.MaxCodeAttempts)
+				So(verify(u.Email(), "123456"), ShouldNotBeNil)
+			})
+		})
+
+		Convey("When the email belongs to no account", func() {
+			unknown := verify("nobody@example.com", "123456")
+			wrong := verify(u.Email(), "000000")
+
+			Convey("Then the error matches a wrong code", func() {
+				So(unknown.Error(), ShouldEqual, wrong.Error())
+			})
+		})
+	})
+}
@@ -0,0 +1,116 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/semmidev/ethos-go/internal/auth/domain/magiclink"
+	"github.com/semmidev/ethos-go/internal/auth/domain/service"
+	"github.com/semmidev/ethos-go/internal/auth/domain/session"
+	"github.com/semmidev/ethos-go/internal/auth/domain/user"
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/events"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+)
+
+// VerifyMagicLinkCommand signs in with the token from an emailed login link
+type VerifyMagicLinkCommand struct {
+	Token     string
+	UserAgent string
+	ClientIP  string
+	// Reactivate confirms that a deactivated account should be switched back on
+	Reactivate bool
+}
+
+type VerifyMagicLinkHandler decorator.CommandHandlerWithResult[VerifyMagicLinkCommand, *LoginResult]
+
+type verifyMagicLinkHandler struct {
+	userRepo user.Repository
+	links    magiclink.Repository
+	sessions sessionStarter
+	enabled  bool
+}
+
+func NewVerifyMagicLinkHandler(
+	userRepo user.Repository,
+	links magiclink.Repository,
+	sessionRepo session.Repository,
+	tokenIssuer service.TokenIssuer,
+	authService *session.AuthenticationService,
+	geoLocator service.GeoLocator,
+	publisher events.Publisher,
+	enabled bool,
+	log logger.Logger,
+	metricsClient decorator.MetricsClient,
+) VerifyMagicLinkHandler {
+	if links == nil {
+		panic("nil magic link repository")
+	}
+	return decorator.ApplyCommandResultDecorators(
+		verifyMagicLinkHandler{
+			userRepo: userRepo,
+			links:    links,
+			sessions: sessionStarter{
+				sessionRepo: sessionRepo,
+				tokenIssuer: tokenIssuer,
+				authService: authService,
+				geoLocator:  geoLocator,
+				publisher:   publisher,
+			},
+			enabled: enabled,
+		},
+		log,
+		metricsClient,
+	)
+}
+
+func (h verifyMagicLinkHandler) Handle(ctx context.Context, cmd VerifyMagicLinkCommand) (*LoginResult, error) {
+	if !h.enabled {
+		return nil, magicLinkDisabledError()
+	}
+
+	now := time.Now()
+	link, err := h.links.FindByTokenHash(ctx, magiclink.HashToken(cmd.Token))
+	if err != nil {
+		return nil, magicLinkError(err)
+	}
+	if !link.Usable(now) {
+		return nil, magicLinkError(magiclink.ErrInvalidLink)
+	}
+
+	u, err := h.userRepo.FindByID(ctx, link.UserID())
+	if err != nil {
+		return nil, magicLinkError(magiclink.ErrInvalidLink)
+	}
+
+	// Checked before the link is used up, so a deactivated user can confirm
+	// and open the same link again
+	if err := reactivate(ctx, h.userRepo, u, cmd.Reactivate); err != nil {
+		return nil, err
+	}
+
+	if err := h.links.MarkUsed(ctx, link.LinkID(), now); err != nil {
+		return nil, magicLinkError(err)
+	}
+
+	// The link arrived by email, which proves the address as well as the
+	// verification code would
+	if !u.IsVerified() {
+		u.MarkVerified()
+		if err := h.userRepo.Update(ctx, u); err != nil {
+			return nil, apperror.DatabaseError("verify user", err)
+		}
+	}
+
+	return h.sessions.start(ctx, u, cmd.UserAgent, cmd.ClientIP)
+}
+
+// magicLinkError hides why a link was refused
+func magicLinkError(err error) error {
+	if errors.Is(err, magiclink.ErrInvalidLink) {
+		return apperror.Unauthorized(magiclink.ErrInvalidLink.Error())
+	}
+	return apperror.DatabaseError("use magic link", err)
+}
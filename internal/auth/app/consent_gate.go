@@ -0,0 +1,55 @@
+package app
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/semmidev/ethos-go/internal/auth/domain/consent"
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+)
+
+// ConsentGate keeps users who have not accepted the current legal
+// documents from using the API. A consent is never withdrawn and the
+// current versions only change on restart, so users found to have
+// accepted them are remembered and not looked up again.
+type ConsentGate struct {
+	repo     consent.Repository
+	versions consent.Versions
+	accepted sync.Map // user ID -> struct{}
+}
+
+// NewConsentGate creates a gate requiring the given document versions
+func NewConsentGate(repo consent.Repository, versions consent.Versions) *ConsentGate {
+	if repo == nil {
+		panic("nil consent repo")
+	}
+	return &ConsentGate{repo: repo, versions: versions}
+}
+
+// CheckConsents fails with AUTH_CONSENT_REQUIRED, listing the documents
+// to accept, while the user has not accepted the current version of every
+// published document
+func (g *ConsentGate) CheckConsents(ctx context.Context, userID string) error {
+	if !g.versions.Required() {
+		return nil
+	}
+	if _, ok := g.accepted.Load(userID); ok {
+		return nil
+	}
+
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return apperror.ValidationFailed("invalid user ID")
+	}
+	consents, err := g.repo.ListByUserID(ctx, uid)
+	if err != nil {
+		return apperror.InternalError(err)
+	}
+
+	if pending := g.versions.Pending(consents); len(pending) > 0 {
+		return apperror.ConsentRequired(pending)
+	}
+	g.accepted.Store(userID, struct{}{})
+	return nil
+}
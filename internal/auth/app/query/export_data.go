@@ -19,21 +19,45 @@ type ExportUserDataQuery struct {
 
 // ExportedData contains all user data bundled for GDPR export
 type ExportedData struct {
-	ExportedAt    time.Time          `json:"exported_at"`
-	User          ExportedUser       `json:"user"`
-	Habits        []ExportedHabit    `json:"habits"`
-	HabitLogs     []ExportedHabitLog `json:"habit_logs"`
-	Notifications []ExportedNotif    `json:"notifications"`
+	ExportedAt              time.Time                 `json:"exported_at"`
+	Manifest                []ManifestEntry           `json:"manifest"`
+	User                    ExportedUser              `json:"user"`
+	Sessions                []ExportedSession         `json:"sessions"`
+	Consents                []ExportedConsent         `json:"consents"`
+	Habits                  []ExportedHabit           `json:"habits"`
+	HabitLogs               []ExportedHabitLog        `json:"habit_logs"`
+	HabitLogSummaries       []ExportedHabitLogSummary `json:"habit_log_summaries"`
+	HabitStats              []ExportedHabitStats      `json:"habit_stats"`
+	HabitVacations          []ExportedVacation        `json:"habit_vacations"`
+	Notifications           []ExportedNotif           `json:"notifications"`
+	NotificationPreferences *ExportedNotifPreferences `json:"notification_preferences"`
+	NotificationDeliveries  []ExportedDelivery        `json:"notification_deliveries"`
 }
 
 type ExportedUser struct {
-	ID           string    `json:"id"`
-	Email        string    `json:"email"`
-	Name         string    `json:"name"`
-	Timezone     string    `json:"timezone"`
-	AuthProvider string    `json:"auth_provider"`
-	IsVerified   bool      `json:"is_verified"`
-	CreatedAt    time.Time `json:"created_at"`
+	ID           string           `json:"id"`
+	Email        string           `json:"email"`
+	Name         string           `json:"name"`
+	Timezone     string           `json:"timezone"`
+	Locale       string           `json:"locale"`
+	WeekStart    string           `json:"week_start"`
+	AuthProvider string           `json:"auth_provider"`
+	IsVerified   bool             `json:"is_verified"`
+	IsActive     bool             `json:"is_active"`
+	LogLockDays  *int             `json:"log_lock_days"`
+	Preferences  user.Preferences `json:"preferences"`
+	CreatedAt    time.Time        `json:"created_at"`
+	UpdatedAt    time.Time        `json:"updated_at"`
+}
+
+// ManifestEntry describes one file (top-level key) of the export. Categories
+// Ethos does not store are listed too, with an empty File, so the export
+// accounts for every kind of personal data.
+type ManifestEntry struct {
+	File        string `json:"file,omitempty"`
+	Category    string `json:"category"`
+	Description string `json:"description"`
+	Records     int    `json:"records"`
 }
 
 // ExportUserDataHandler handles data export queries
@@ -79,9 +103,27 @@ func (h exportUserDataHandler) Handle(ctx context.Context, q ExportUserDataQuery
 		Email:        u.Email(),
 		Name:         u.Name(),
 		Timezone:     u.Timezone(),
+		Locale:       u.Locale(),
+		WeekStart:    user.WeekStartName(u.WeekStart()),
 		AuthProvider: u.AuthProvider(),
 		IsVerified:   u.IsVerified(),
+		IsActive:     u.IsActive(),
+		LogLockDays:  u.LogLockDays(),
+		Preferences:  u.Preferences(),
 		CreatedAt:    u.CreatedAt(),
+		UpdatedAt:    u.UpdatedAt(),
+	}
+
+	// Fetch sessions via repository
+	sessions, err := h.exportRepo.GetUserSessions(ctx, q.UserID)
+	if err != nil {
+		sessions = []ExportedSession{} // graceful fallback
+	}
+
+	// Fetch accepted legal documents via repository
+	consents, err := h.exportRepo.GetUserConsents(ctx, q.UserID)
+	if err != nil {
+		consents = []ExportedConsent{} // graceful fallback
 	}
 
 	// Fetch habits via repository
@@ -96,6 +138,24 @@ func (h exportUserDataHandler) Handle(ctx context.Context, q ExportUserDataQuery
 		logs = []ExportedHabitLog{} // graceful fallback
 	}
 
+	// Fetch compacted habit log summaries via repository
+	summaries, err := h.exportRepo.GetUserHabitLogSummaries(ctx, q.UserID)
+	if err != nil {
+		summaries = []ExportedHabitLogSummary{} // graceful fallback
+	}
+
+	// Fetch habit stats via repository
+	stats, err := h.exportRepo.GetUserHabitStats(ctx, q.UserID)
+	if err != nil {
+		stats = []ExportedHabitStats{} // graceful fallback
+	}
+
+	// Fetch habit vacations via repository
+	vacations, err := h.exportRepo.GetUserHabitVacations(ctx, q.UserID)
+	if err != nil {
+		vacations = []ExportedVacation{} // graceful fallback
+	}
+
 	// Fetch notifications via repository
 	notifs, err := h.exportRepo.GetUserNotifications(ctx, q.UserID)
 	if err != nil {
@@ -116,13 +176,59 @@ func (h exportUserDataHandler) Handle(ctx context.Context, q ExportUserDataQuery
 		}
 	}
 
-	return ExportedData{
-		ExportedAt:    time.Now(),
-		User:          exportedUser,
-		Habits:        habits,
-		HabitLogs:     logs,
-		Notifications: notifications,
-	}, nil
+	// Fetch notification preferences via repository
+	prefs, err := h.exportRepo.GetUserNotificationPreferences(ctx, q.UserID)
+	if err != nil {
+		prefs = nil // graceful fallback
+	}
+
+	// Fetch notification deliveries via repository
+	deliveries, err := h.exportRepo.GetUserNotificationDeliveries(ctx, q.UserID)
+	if err != nil {
+		deliveries = []ExportedDelivery{} // graceful fallback
+	}
+
+	data := ExportedData{
+		ExportedAt:              time.Now(),
+		User:                    exportedUser,
+		Sessions:                sessions,
+		Consents:                consents,
+		Habits:                  habits,
+		HabitLogs:               logs,
+		HabitLogSummaries:       summaries,
+		HabitStats:              stats,
+		HabitVacations:          vacations,
+		Notifications:           notifications,
+		NotificationPreferences: prefs,
+		NotificationDeliveries:  deliveries,
+	}
+	data.Manifest = buildManifest(data)
+
+	return data, nil
+}
+
+// buildManifest describes every file of the export with its record count
+func buildManifest(d ExportedData) []ManifestEntry {
+	preferences := 0
+	if d.NotificationPreferences != nil {
+		preferences = 1
+	}
+
+	return []ManifestEntry{
+		{File: "user", Category: "Account", Description: "Profile and account settings", Records: 1},
+		{File: "sessions", Category: "Account", Description: "Login sessions with device and IP address; refresh tokens are omitted", Records: len(d.Sessions)},
+		{File: "consents", Category: "Account", Description: "Versions of the terms of service and privacy policy you accepted, with when and from which IP address", Records: len(d.Consents)},
+		{File: "habits", Category: "Habits", Description: "Habits you created, including archived and paused ones", Records: len(d.Habits)},
+		{File: "habit_logs", Category: "Habits", Description: "Every completion you logged, with notes", Records: len(d.HabitLogs)},
+		{File: "habit_log_summaries", Category: "Habits", Description: "Monthly totals of logs older than your plan keeps", Records: len(d.HabitLogSummaries)},
+		{File: "habit_stats", Category: "Habits", Description: "Streaks and totals derived from your habit logs", Records: len(d.HabitStats)},
+		{File: "habit_vacations", Category: "Habits", Description: "Vacations and pauses that protect your streaks", Records: len(d.HabitVacations)},
+		{File: "notifications", Category: "Notifications", Description: "In-app notifications sent to you", Records: len(d.Notifications)},
+		{File: "notification_preferences", Category: "Notifications", Description: "Notification opt-outs, quiet hours and do not disturb; unsubscribe tokens are omitted", Records: preferences},
+		{File: "notification_deliveries", Category: "Notifications", Description: "Record of scheduled summaries, reports and reminders sent to you", Records: len(d.NotificationDeliveries)},
+		{Category: "Push subscriptions", Description: "Not stored; browser push was removed and its data deleted"},
+		{Category: "Audit events", Description: "Not stored; Ethos keeps no audit log of account activity"},
+	}
 }
 
 // Ensure types are exported for JSON marshaling
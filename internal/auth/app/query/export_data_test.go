@@ -0,0 +1,144 @@
+package query_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/auth/app/query"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/testutil"
+)
+
+// exportRepo serves canned export rows
+type exportRepo struct {
+	sessions   []query.ExportedSession
+	consents   []query.ExportedConsent
+	habits     []query.ExportedHabit
+	vacations  []query.ExportedVacation
+	prefs      *query.ExportedNotifPreferences
+	deliveries []query.ExportedDelivery
+}
+
+func (r exportRepo) GetUserHabits(context.Context, string) ([]query.ExportedHabit, error) {
+	return r.habits, nil
+}
+
+func (r exportRepo) GetUserHabitLogs(context.Context, string) ([]query.ExportedHabitLog, error) {
+	return nil, nil
+}
+
+func (r exportRepo) GetUserHabitLogSummaries(context.Context, string) ([]query.ExportedHabitLogSummary, error) {
+	return nil, nil
+}
+
+func (r exportRepo) GetUserNotifications(context.Context, string) ([]query.ExportedNotif, error) {
+	return nil, nil
+}
+
+func (r exportRepo) GetUserSessions(context.Context, string) ([]query.ExportedSession, error) {
+	return r.sessions, nil
+}
+
+func (r exportRepo) GetUserConsents(context.Context, string) ([]query.ExportedConsent, error) {
+	return r.consents, nil
+}
+
+func (r exportRepo) GetUserHabitStats(context.Context, string) ([]query.ExportedHabitStats, error) {
+	return nil, nil
+}
+
+func (r exportRepo) GetUserHabitVacations(context.Context, string) ([]query.ExportedVacation, error) {
+	return r.vacations, nil
+}
+
+func (r exportRepo) GetUserNotificationPreferences(context.Context, string) (*query.ExportedNotifPreferences, error) {
+	return r.prefs, nil
+}
+
+func (r exportRepo) GetUserNotificationDeliveries(context.Context, string) ([]query.ExportedDelivery, error) {
+	return r.deliveries, nil
+}
+
+func TestExportUserDataHandler(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	Convey("Given a user with sessions, habits and notification settings", t, func() {
+		u := testutil.NewUserBuilder().Build()
+		repo := exportRepo{
+			sessions: []query.ExportedSession{
+				{ID: uuid.NewString(), UserAgent: "Firefox", ClientIP: "10.0.0.1", ExpiresAt: time.Now().Add(time.Hour)},
+				{ID: uuid.NewString(), UserAgent: "Safari", ClientIP: "10.0.0.2", ExpiresAt: time.Now().Add(time.Hour)},
+			},
+			consents: []query.ExportedConsent{
+				{Document: "terms", Version: "2026-01", ClientIP: "10.0.0.1", AcceptedAt: time.Now()},
+			},
+			habits:     []query.ExportedHabit{{ID: uuid.NewString(), Name: "Read"}},
+			vacations:  []query.ExportedVacation{{ID: uuid.NewString(), StartDate: "2026-01-01"}},
+			prefs:      &query.ExportedNotifPreferences{DailySummary: true},
+			deliveries: []query.ExportedDelivery{{Kind: "weekly_report", PeriodDate: "2026-01-04"}},
+		}
+		handler := query.NewExportUserDataHandler(
+			testutil.NewUserRepository(u),
+			repo,
+			testutil.NopLogger{},
+			&decorator.NoOpMetricsClient{},
+		)
+
+		Convey("When the data is exported", func() {
+			data, err := handler.Handle(ctx, query.ExportUserDataQuery{UserID: u.UserID().String()})
+			So(err, ShouldBeNil)
+
+			records := make(map[string]int)
+			for _, entry := range data.Manifest {
+				if entry.File != "" {
+					records[entry.File] = entry.Records
+				}
+			}
+
+			Convey("Then every category is included", func() {
+				So(data.Sessions, ShouldHaveLength, 2)
+				So(data.Consents, ShouldHaveLength, 1)
+				So(data.HabitVacations, ShouldHaveLength, 1)
+				So(data.NotificationPreferences, ShouldNotBeNil)
+				So(data.NotificationDeliveries, ShouldHaveLength, 1)
+			})
+
+			Convey("Then the manifest describes each file with its record count", func() {
+				So(records["user"], ShouldEqual, 1)
+				So(records["sessions"], ShouldEqual, 2)
+				So(records["consents"], ShouldEqual, 1)
+				So(records["habits"], ShouldEqual, 1)
+				So(records["habit_logs"], ShouldEqual, 0)
+				So(records["habit_log_summaries"], ShouldEqual, 0)
+				So(records["notification_preferences"], ShouldEqual, 1)
+
+				body, err := json.Marshal(data)
+				So(err, ShouldBeNil)
+
+				var files map[string]json.RawMessage
+				So(json.Unmarshal(body, &files), ShouldBeNil)
+				for file := range records {
+					So(files, ShouldContainKey, file)
+				}
+			})
+
+			Convey("Then categories that are not stored are still accounted for", func() {
+				var notStored []string
+				for _, entry := range data.Manifest {
+					if entry.File == "" {
+						notStored = append(notStored, entry.Category)
+					}
+				}
+				So(notStored, ShouldContain, "Push subscriptions")
+				So(notStored, ShouldContain, "Audit events")
+			})
+		})
+	})
+}
@@ -10,7 +10,15 @@ import (
 type ExportDataRepository interface {
 	GetUserHabits(ctx context.Context, userID string) ([]ExportedHabit, error)
 	GetUserHabitLogs(ctx context.Context, userID string) ([]ExportedHabitLog, error)
+	GetUserHabitLogSummaries(ctx context.Context, userID string) ([]ExportedHabitLogSummary, error)
 	GetUserNotifications(ctx context.Context, userID string) ([]ExportedNotif, error)
+	GetUserSessions(ctx context.Context, userID string) ([]ExportedSession, error)
+	GetUserConsents(ctx context.Context, userID string) ([]ExportedConsent, error)
+	GetUserHabitStats(ctx context.Context, userID string) ([]ExportedHabitStats, error)
+	GetUserHabitVacations(ctx context.Context, userID string) ([]ExportedVacation, error)
+	// GetUserNotificationPreferences returns nil when the user never saved any
+	GetUserNotificationPreferences(ctx context.Context, userID string) (*ExportedNotifPreferences, error)
+	GetUserNotificationDeliveries(ctx context.Context, userID string) ([]ExportedDelivery, error)
 }
 
 // ExportedHabit represents a habit for GDPR export
@@ -22,6 +30,7 @@ type ExportedHabit struct {
 	TargetCount  int       `json:"target_count"`
 	IsActive     bool      `json:"is_active"`
 	ReminderTime *string   `json:"reminder_time"`
+	PausedUntil  *string   `json:"paused_until"`
 	CreatedAt    time.Time `json:"created_at"`
 }
 
@@ -35,6 +44,15 @@ type ExportedHabitLog struct {
 	CreatedAt time.Time `json:"created_at"`
 }
 
+// ExportedHabitLogSummary represents a month of habit logs that were
+// compacted after their retention period, for GDPR export
+type ExportedHabitLogSummary struct {
+	HabitID    string `json:"habit_id"`
+	Month      string `json:"month"`
+	DaysLogged int    `json:"days_logged"`
+	TotalCount int    `json:"total_count"`
+}
+
 // ExportedNotif represents a notification for GDPR export
 type ExportedNotif struct {
 	ID        string    `json:"id"`
@@ -45,3 +63,67 @@ type ExportedNotif struct {
 	IsRead    bool      `json:"is_read"`
 	CreatedAt time.Time `json:"created_at"`
 }
+
+// ExportedSession represents a login session for GDPR export.
+// The refresh token is a credential, not personal data, and is left out.
+type ExportedSession struct {
+	ID        string    `json:"id"`
+	UserAgent string    `json:"user_agent"`
+	ClientIP  string    `json:"client_ip"`
+	City      string    `json:"city,omitempty"`
+	Country   string    `json:"country,omitempty"`
+	IsBlocked bool      `json:"is_blocked"`
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ExportedConsent represents an acceptance of a legal document version for
+// GDPR export
+type ExportedConsent struct {
+	Document   string    `json:"document"`
+	Version    string    `json:"version"`
+	ClientIP   string    `json:"client_ip"`
+	AcceptedAt time.Time `json:"accepted_at"`
+}
+
+// ExportedHabitStats represents derived habit statistics for GDPR export
+type ExportedHabitStats struct {
+	HabitID          string    `json:"habit_id"`
+	CurrentStreak    int       `json:"current_streak"`
+	LongestStreak    int       `json:"longest_streak"`
+	TotalCompletions int       `json:"total_completions"`
+	LastCompletedAt  *string   `json:"last_completed_at"`
+	ConsistencyScore float64   `json:"consistency_score"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+// ExportedVacation represents a habit vacation for GDPR export
+type ExportedVacation struct {
+	ID        string    `json:"id"`
+	HabitID   string    `json:"habit_id"`
+	StartDate string    `json:"start_date"`
+	EndDate   *string   `json:"end_date"`
+	Reason    *string   `json:"reason"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ExportedNotifPreferences represents notification settings for GDPR export.
+// The unsubscribe token is a credential and is left out.
+type ExportedNotifPreferences struct {
+	DailySummary      bool       `json:"daily_summary"`
+	WeeklyReport      bool       `json:"weekly_report"`
+	Reengagement      bool       `json:"reengagement"`
+	QuietHoursStart   *string    `json:"quiet_hours_start"`
+	QuietHoursEnd     *string    `json:"quiet_hours_end"`
+	DoNotDisturb      bool       `json:"do_not_disturb"`
+	DoNotDisturbUntil *time.Time `json:"do_not_disturb_until"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+}
+
+// ExportedDelivery represents a scheduled notification send for GDPR export
+type ExportedDelivery struct {
+	Kind       string    `json:"kind"`
+	PeriodDate string    `json:"period_date"`
+	CreatedAt  time.Time `json:"created_at"`
+}
@@ -0,0 +1,69 @@
+package query
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/semmidev/ethos-go/internal/auth/domain/user"
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+)
+
+// GetPreferencesQuery gets the user's preferences
+type GetPreferencesQuery struct {
+	UserID string
+}
+
+// PreferencesResult contains the user's preferences with defaults applied
+type PreferencesResult struct {
+	WeekStart string
+	Locale    string
+	Theme     string
+	// DefaultReminderHour is nil when the user has not picked one
+	DefaultReminderHour *int
+}
+
+// GetPreferencesHandler handles preferences queries
+type GetPreferencesHandler decorator.QueryHandler[GetPreferencesQuery, PreferencesResult]
+
+type getPreferencesHandler struct {
+	repo user.UserReader
+}
+
+// NewGetPreferencesHandler creates a new handler with decorators
+func NewGetPreferencesHandler(
+	repo user.UserReader,
+	log logger.Logger,
+	metricsClient decorator.MetricsClient,
+) GetPreferencesHandler {
+	if repo == nil {
+		panic("nil repo")
+	}
+
+	return decorator.ApplyQueryDecorators(
+		getPreferencesHandler{repo: repo},
+		log,
+		metricsClient,
+	)
+}
+
+func (h getPreferencesHandler) Handle(ctx context.Context, q GetPreferencesQuery) (PreferencesResult, error) {
+	userID, err := uuid.Parse(q.UserID)
+	if err != nil {
+		return PreferencesResult{}, apperror.ValidationFailed("invalid user ID")
+	}
+
+	existingUser, err := h.repo.FindByID(ctx, userID)
+	if err != nil {
+		return PreferencesResult{}, apperror.NotFound("user", q.UserID)
+	}
+
+	preferences := existingUser.Preferences()
+	return PreferencesResult{
+		WeekStart:           user.WeekStartName(existingUser.WeekStart()),
+		Locale:              existingUser.Locale(),
+		Theme:               string(preferences.Theme()),
+		DefaultReminderHour: preferences.DefaultReminderHour(),
+	}, nil
+}
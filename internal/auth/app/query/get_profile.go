@@ -9,6 +9,7 @@ import (
 	"github.com/semmidev/ethos-go/internal/common/apperror"
 	"github.com/semmidev/ethos-go/internal/common/decorator"
 	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/common/storage"
 )
 
 // GetProfileQuery gets user profile
@@ -18,23 +19,30 @@ type GetProfileQuery struct {
 
 // ProfileResult contains user profile data
 type ProfileResult struct {
-	UserID    string
-	Name      string
-	Email     string
-	Timezone  string
-	CreatedAt time.Time
+	UserID      string
+	Name        string
+	Email       string
+	Timezone    string
+	Locale      string
+	WeekStart   string
+	LogLockDays *int
+	AvatarURL   string
+	Role        string
+	CreatedAt   time.Time
 }
 
 // GetProfileHandler handles profile queries
 type GetProfileHandler decorator.QueryHandler[GetProfileQuery, ProfileResult]
 
 type getProfileHandler struct {
-	repo user.UserReader
+	repo  user.UserReader
+	store storage.Store
 }
 
 // NewGetProfileHandler creates a new handler with decorators
 func NewGetProfileHandler(
 	repo user.UserReader,
+	store storage.Store,
 	log logger.Logger,
 	metricsClient decorator.MetricsClient,
 ) GetProfileHandler {
@@ -43,7 +51,7 @@ func NewGetProfileHandler(
 	}
 
 	return decorator.ApplyQueryDecorators(
-		getProfileHandler{repo: repo},
+		getProfileHandler{repo: repo, store: store},
 		log,
 		metricsClient,
 	)
@@ -60,12 +68,25 @@ func (h getProfileHandler) Handle(ctx context.Context, q GetProfileQuery) (Profi
 		return ProfileResult{}, apperror.NotFound("user", q.UserID)
 	}
 
+	// Avatars are stored by key; signed URLs are minted on every read
+	var avatarURL string
+	if avatar := existingUser.Avatar(); avatar != nil {
+		if avatarURL, err = storage.ResolveURL(ctx, h.store, *avatar); err != nil {
+			return ProfileResult{}, apperror.InternalError(err)
+		}
+	}
+
 	// Use getter methods instead of direct field access
 	return ProfileResult{
-		UserID:    existingUser.UserID().String(),
-		Name:      existingUser.Name(),
-		Email:     existingUser.Email(),
-		Timezone:  existingUser.Timezone(),
-		CreatedAt: existingUser.CreatedAt(),
+		UserID:      existingUser.UserID().String(),
+		Name:        existingUser.Name(),
+		Email:       existingUser.Email(),
+		Timezone:    existingUser.Timezone(),
+		Locale:      existingUser.Locale(),
+		WeekStart:   user.WeekStartName(existingUser.WeekStart()),
+		LogLockDays: existingUser.LogLockDays(),
+		AvatarURL:   avatarURL,
+		Role:        existingUser.Role(),
+		CreatedAt:   existingUser.CreatedAt(),
 	}, nil
 }
@@ -17,6 +17,8 @@ type SessionDTO struct {
 	SessionID string    `json:"session_id"`
 	UserAgent string    `json:"user_agent"`
 	ClientIP  string    `json:"client_ip"`
+	City      string    `json:"city,omitempty"`    // Approximate, from GeoIP at login
+	Country   string    `json:"country,omitempty"` // Approximate, from GeoIP at login
 	IsBlocked bool      `json:"is_blocked"`
 	ExpiresAt time.Time `json:"expires_at"`
 	CreatedAt time.Time `json:"created_at"`
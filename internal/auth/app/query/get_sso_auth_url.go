@@ -0,0 +1,62 @@
+package query
+
+import (
+	"context"
+
+	"github.com/semmidev/ethos-go/internal/auth/domain/service"
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+)
+
+type GetSSOAuthURLQuery struct{}
+
+// SSOAuthURL is where to send the user to sign in with the identity
+// provider. The client keeps State and sends it with the callback, which is
+// refused unless it names a sign-in started here that hasn't expired.
+type SSOAuthURL struct {
+	URL   string
+	State string
+}
+
+type GetSSOAuthURLHandler decorator.QueryHandler[GetSSOAuthURLQuery, *SSOAuthURL]
+
+type getSSOAuthURLHandler struct {
+	provider service.SSOProvider
+	logins   service.SSOLoginStore
+}
+
+// NewGetSSOAuthURLHandler creates the handler; provider is nil when single
+// sign-on is not configured.
+func NewGetSSOAuthURLHandler(
+	provider service.SSOProvider,
+	logins service.SSOLoginStore,
+	log logger.Logger,
+	metricsClient decorator.MetricsClient,
+) GetSSOAuthURLHandler {
+	return decorator.ApplyQueryDecorators(
+		getSSOAuthURLHandler{provider: provider, logins: logins},
+		log,
+		metricsClient,
+	)
+}
+
+func (h getSSOAuthURLHandler) Handle(ctx context.Context, _ GetSSOAuthURLQuery) (*SSOAuthURL, error) {
+	if h.provider == nil {
+		return nil, apperror.OperationNotAllowed("single sign-on", "single sign-on is not configured")
+	}
+
+	login, err := service.NewSSOLogin()
+	if err != nil {
+		return nil, apperror.InternalError(err)
+	}
+	url, err := h.provider.LoginURL(ctx, login)
+	if err != nil {
+		return nil, apperror.ExternalServiceError("identity provider", err)
+	}
+	if err := h.logins.Save(ctx, login, service.SSOLoginTTL); err != nil {
+		return nil, apperror.InternalError(err)
+	}
+
+	return &SSOAuthURL{URL: url, State: login.State}, nil
+}
@@ -0,0 +1,96 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/semmidev/ethos-go/internal/auth/domain/user"
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+)
+
+// GetUserAccountQuery looks an account up by UserID or, when that is empty,
+// by Email. It backs user provisioning, so it sees inactive accounts too.
+type GetUserAccountQuery struct {
+	UserID string
+	Email  string
+}
+
+// UserAccount is the identity part of a user as the identity provider
+// manages it
+type UserAccount struct {
+	UserID    string
+	Email     string
+	Name      string
+	Active    bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// GetUserAccountHandler handles account lookups
+type GetUserAccountHandler decorator.QueryHandler[GetUserAccountQuery, *UserAccount]
+
+type getUserAccountHandler struct {
+	repo user.UserReader
+}
+
+// NewGetUserAccountHandler creates a new handler with decorators
+func NewGetUserAccountHandler(
+	repo user.UserReader,
+	log logger.Logger,
+	metricsClient decorator.MetricsClient,
+) GetUserAccountHandler {
+	if repo == nil {
+		panic("nil repo")
+	}
+
+	return decorator.ApplyQueryDecorators(
+		getUserAccountHandler{repo: repo},
+		log,
+		metricsClient,
+	)
+}
+
+func (h getUserAccountHandler) Handle(ctx context.Context, q GetUserAccountQuery) (*UserAccount, error) {
+	var (
+		u   *user.User
+		err error
+	)
+	switch {
+	case q.UserID != "":
+		userID, parseErr := uuid.Parse(q.UserID)
+		if parseErr != nil {
+			return nil, apperror.NotFound("user", q.UserID)
+		}
+		u, err = h.repo.FindByID(ctx, userID)
+	case q.Email != "":
+		u, err = h.repo.FindByEmail(ctx, q.Email)
+	default:
+		return nil, apperror.ValidationFailed("a user ID or email is required")
+	}
+
+	if errors.Is(err, user.ErrNotFound) {
+		return nil, apperror.NotFound("user", q.UserID+q.Email)
+	}
+	if err != nil {
+		return nil, apperror.DatabaseError("find user", err)
+	}
+
+	return NewUserAccount(u), nil
+}
+
+// NewUserAccount builds the account view of u
+func NewUserAccount(u *user.User) *UserAccount {
+	return &UserAccount{
+		UserID:    u.UserID().String(),
+		Email:     u.Email(),
+		Name:      u.Name(),
+		Active:    u.IsActive(),
+		CreatedAt: u.CreatedAt(),
+		UpdatedAt: u.UpdatedAt(),
+	}
+}
@@ -0,0 +1,113 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/semmidev/ethos-go/internal/auth/domain/service"
+	"github.com/semmidev/ethos-go/internal/auth/domain/session"
+	"github.com/semmidev/ethos-go/internal/auth/domain/user"
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+)
+
+// IntrospectTokenQuery asks whether an access token is currently valid.
+type IntrospectTokenQuery struct {
+	Token string `json:"-"` // never logged
+}
+
+// IntrospectTokenResult describes an access token. Only Active is set for
+// tokens that are not active.
+type IntrospectTokenResult struct {
+	Active    bool
+	UserID    string
+	SessionID string
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+}
+
+// IntrospectTokenHandler validates access tokens on behalf of other services.
+type IntrospectTokenHandler decorator.QueryHandler[IntrospectTokenQuery, IntrospectTokenResult]
+
+type introspectTokenHandler struct {
+	tokenVerifier service.TokenVerifier
+	sessionReader session.SessionReader
+	userReader    user.UserReader
+}
+
+// NewIntrospectTokenHandler creates a handler with its dependencies.
+func NewIntrospectTokenHandler(
+	tokenVerifier service.TokenVerifier,
+	sessionReader session.SessionReader,
+	userReader user.UserReader,
+	log logger.Logger,
+	metricsClient decorator.MetricsClient,
+) IntrospectTokenHandler {
+	if tokenVerifier == nil {
+		panic("nil token verifier")
+	}
+	if sessionReader == nil {
+		panic("nil session reader")
+	}
+	if userReader == nil {
+		panic("nil user reader")
+	}
+
+	return decorator.ApplyQueryDecorators(
+		introspectTokenHandler{
+			tokenVerifier: tokenVerifier,
+			sessionReader: sessionReader,
+			userReader:    userReader,
+		},
+		log,
+		metricsClient,
+	)
+}
+
+// Handle checks the token signature and expiry, then that its session has
+// not been revoked and its user is still active. An invalid token is not an
+// error; it is reported as inactive.
+func (h introspectTokenHandler) Handle(ctx context.Context, query IntrospectTokenQuery) (IntrospectTokenResult, error) {
+	inactive := IntrospectTokenResult{Active: false}
+
+	if query.Token == "" {
+		return inactive, apperror.InvalidInput("token", "token is required")
+	}
+
+	claims, err := h.tokenVerifier.VerifyAccessToken(ctx, query.Token)
+	if err != nil {
+		return inactive, nil
+	}
+
+	sess, err := h.sessionReader.FindByID(ctx, claims.SessionID)
+	if errors.Is(err, session.ErrNotFound) {
+		return inactive, nil
+	}
+	if err != nil {
+		return inactive, apperror.DatabaseError("find session", err)
+	}
+	if !sess.IsValid() || sess.UserID() != claims.UserID {
+		return inactive, nil
+	}
+
+	u, err := h.userReader.FindByID(ctx, claims.UserID)
+	if errors.Is(err, user.ErrNotFound) {
+		return inactive, nil
+	}
+	if err != nil {
+		return inactive, apperror.DatabaseError("find user", err)
+	}
+	if !u.IsActive() {
+		return inactive, nil
+	}
+
+	return IntrospectTokenResult{
+		Active:    true,
+		UserID:    claims.UserID.String(),
+		SessionID: claims.SessionID.String(),
+		IssuedAt:  time.Unix(claims.IssuedAt, 0),
+		ExpiresAt: time.Unix(claims.ExpiresAt, 0),
+	}, nil
+}
@@ -0,0 +1,110 @@
+package query_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/config"
+	"github.com/semmidev/ethos-go/internal/auth/adapters"
+	"github.com/semmidev/ethos-go/internal/auth/app/query"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/testutil"
+)
+
+func TestIntrospectTokenHandler(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	Convey("Given an access token for a signed-in user", t, func() {
+		issuer, err := adapters.NewJWTTokenIssuer(&config.Config{
+			AppName:          "ethos-go",
+			AuthJWTAlgorithm: "HS256",
+			AuthJWTKeyID:     "primary",
+			AuthJWTSecret:    strings.Repeat("s", 32),
+		})
+		So(err, ShouldBeNil)
+
+		u := testutil.NewUserBuilder().Build()
+		sessionBuilder := testutil.NewSessionBuilder(u)
+		users := testutil.NewUserRepository(u)
+
+		newHandler := func(sessions *testutil.SessionRepository) query.IntrospectTokenHandler {
+			return query.NewIntrospectTokenHandler(
+				issuer,
+				sessions,
+				users,
+				testutil.NopLogger{},
+				&decorator.NoOpMetricsClient{},
+			)
+		}
+
+		sess := sessionBuilder.Build()
+		expiresAt := time.Now().Add(15 * time.Minute).Truncate(time.Second)
+		token, err := issuer.IssueAccessToken(ctx, u.UserID(), sess.SessionID(), expiresAt)
+		So(err, ShouldBeNil)
+
+		Convey("When the session is valid", func() {
+			result, err := newHandler(testutil.NewSessionRepository(sess)).
+				Handle(ctx, query.IntrospectTokenQuery{Token: token})
+
+			Convey("Then the token is active and describes its subject", func() {
+				So(err, ShouldBeNil)
+				So(result.Active, ShouldBeTrue)
+				So(result.UserID, ShouldEqual, u.UserID().String())
+				So(result.SessionID, ShouldEqual, sess.SessionID().String())
+				So(result.ExpiresAt, ShouldEqual, expiresAt)
+			})
+		})
+
+		Convey("When the session has been revoked", func() {
+			revoked := sessionBuilder.Blocked().Build()
+			result, err := newHandler(testutil.NewSessionRepository(revoked)).
+				Handle(ctx, query.IntrospectTokenQuery{Token: token})
+
+			Convey("Then the token is inactive", func() {
+				So(err, ShouldBeNil)
+				So(result.Active, ShouldBeFalse)
+				So(result.UserID, ShouldBeEmpty)
+			})
+		})
+
+		Convey("When the session no longer exists", func() {
+			result, err := newHandler(testutil.NewSessionRepository()).
+				Handle(ctx, query.IntrospectTokenQuery{Token: token})
+
+			Convey("Then the token is inactive", func() {
+				So(err, ShouldBeNil)
+				So(result.Active, ShouldBeFalse)
+			})
+		})
+
+		Convey("When the token has expired", func() {
+			expired, err := issuer.IssueAccessToken(ctx, u.UserID(), sess.SessionID(), time.Now().Add(-time.Minute))
+			So(err, ShouldBeNil)
+
+			result, err := newHandler(testutil.NewSessionRepository(sess)).
+				Handle(ctx, query.IntrospectTokenQuery{Token: expired})
+
+			Convey("Then the token is inactive", func() {
+				So(err, ShouldBeNil)
+				So(result.Active, ShouldBeFalse)
+			})
+		})
+
+		Convey("When the token is garbage", func() {
+			result, err := newHandler(testutil.NewSessionRepository(sess)).
+				Handle(ctx, query.IntrospectTokenQuery{Token: uuid.NewString()})
+
+			Convey("Then the token is inactive", func() {
+				So(err, ShouldBeNil)
+				So(result.Active, ShouldBeFalse)
+			})
+		})
+	})
+}
@@ -0,0 +1,105 @@
+package query
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/semmidev/ethos-go/internal/auth/domain/consent"
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+)
+
+// ListConsentsQuery gets the legal documents the user must accept and the
+// consents they gave
+type ListConsentsQuery struct {
+	UserID string
+}
+
+// ConsentsResult lists the current version of every published document
+// and the user's consent history
+type ConsentsResult struct {
+	Documents []DocumentStatus
+	History   []ConsentRecord
+}
+
+// DocumentStatus tells whether the user accepted a document's current version
+type DocumentStatus struct {
+	Document       string
+	CurrentVersion string
+	Accepted       bool
+}
+
+// ConsentRecord is one version of a document the user accepted
+type ConsentRecord struct {
+	Document   string
+	Version    string
+	ClientIP   string
+	AcceptedAt time.Time
+}
+
+// ListConsentsHandler handles consent queries
+type ListConsentsHandler decorator.QueryHandler[ListConsentsQuery, ConsentsResult]
+
+type listConsentsHandler struct {
+	repo     consent.Repository
+	versions consent.Versions
+}
+
+// NewListConsentsHandler creates a new handler with decorators
+func NewListConsentsHandler(
+	repo consent.Repository,
+	versions consent.Versions,
+	log logger.Logger,
+	metricsClient decorator.MetricsClient,
+) ListConsentsHandler {
+	if repo == nil {
+		panic("nil consent repo")
+	}
+
+	return decorator.ApplyQueryDecorators(
+		listConsentsHandler{repo: repo, versions: versions},
+		log,
+		metricsClient,
+	)
+}
+
+func (h listConsentsHandler) Handle(ctx context.Context, q ListConsentsQuery) (ConsentsResult, error) {
+	userID, err := uuid.Parse(q.UserID)
+	if err != nil {
+		return ConsentsResult{}, apperror.ValidationFailed("invalid user ID")
+	}
+
+	consents, err := h.repo.ListByUserID(ctx, userID)
+	if err != nil {
+		return ConsentsResult{}, apperror.InternalError(err)
+	}
+
+	pending := h.versions.Pending(consents)
+	result := ConsentsResult{
+		Documents: []DocumentStatus{},
+		History:   make([]ConsentRecord, len(consents)),
+	}
+	for _, document := range consent.Documents {
+		if h.versions[document] == "" {
+			continue
+		}
+		result.Documents = append(result.Documents, DocumentStatus{
+			Document:       document,
+			CurrentVersion: h.versions[document],
+			Accepted:       !slices.Contains(pending, document),
+		})
+	}
+	for i, c := range consents {
+		result.History[i] = ConsentRecord{
+			Document:   c.Document(),
+			Version:    c.Version(),
+			ClientIP:   c.ClientIP(),
+			AcceptedAt: c.AcceptedAt(),
+		}
+	}
+
+	return result, nil
+}
@@ -26,6 +26,9 @@ type ListSessionsResult struct {
 	Pagination *model.Paging
 }
 
+// SessionSortColumns are the columns sessions can be sorted by
+var SessionSortColumns = []string{"created_at", "expires_at", "is_blocked"}
+
 // ListSessionsHandler retrieves all sessions for a user.
 type ListSessionsHandler decorator.QueryHandler[ListSessionsQuery, ListSessionsResult]
 
@@ -62,6 +65,9 @@ func (h listSessionsHandler) Handle(ctx context.Context, query ListSessionsQuery
 
 	// Validate filter
 	query.Filter.Validate()
+	if err := query.Filter.ValidateSort(SessionSortColumns); err != nil {
+		return ListSessionsResult{}, err
+	}
 
 	// Fetch sessions via read model
 	sessions, totalCount, err := h.readModel.ListSessions(ctx, userID, query.IncludeBlocked, query.IncludeExpired, query.Filter)
@@ -107,6 +113,8 @@ func toSessionDTO(s *session.Session, isCurrent bool) *SessionDTO {
 		SessionID: s.SessionID().String(),
 		UserAgent: s.UserAgent(),
 		ClientIP:  s.ClientIP(),
+		City:      s.Location().City,
+		Country:   s.Location().Country,
 		IsBlocked: s.IsBlocked(),
 		ExpiresAt: s.ExpiresAt(),
 		CreatedAt: s.CreatedAt(),
@@ -0,0 +1,104 @@
+package consent
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Legal documents users accept
+const (
+	DocumentTerms   = "terms"
+	DocumentPrivacy = "privacy"
+)
+
+// Documents lists every legal document in a stable order
+var Documents = []string{DocumentTerms, DocumentPrivacy}
+
+// IsDocument reports whether document is a known legal document
+func IsDocument(document string) bool {
+	return slices.Contains(Documents, document)
+}
+
+// Consent records that a user accepted one version of a legal document,
+// when, and from which IP address. Accepting a newer version adds a
+// consent; earlier ones are kept as history.
+// Fields are private to enforce encapsulation - use getters for read access.
+type Consent struct {
+	userID     uuid.UUID
+	document   string
+	version    string
+	clientIP   string
+	acceptedAt time.Time
+}
+
+// Getters for Consent fields
+
+func (c *Consent) UserID() uuid.UUID     { return c.userID }
+func (c *Consent) Document() string      { return c.document }
+func (c *Consent) Version() string       { return c.version }
+func (c *Consent) ClientIP() string      { return c.clientIP }
+func (c *Consent) AcceptedAt() time.Time { return c.acceptedAt }
+
+// New records the acceptance of a document version
+func New(userID uuid.UUID, document, version, clientIP string, acceptedAt time.Time) (*Consent, error) {
+	if !IsDocument(document) {
+		return nil, ErrUnknownDocument
+	}
+	if version == "" {
+		return nil, ErrMissingVersion
+	}
+
+	return &Consent{
+		userID:     userID,
+		document:   document,
+		version:    version,
+		clientIP:   clientIP,
+		acceptedAt: acceptedAt,
+	}, nil
+}
+
+// UnmarshalFromDatabase reconstructs a Consent from database fields
+func UnmarshalFromDatabase(userID uuid.UUID, document, version, clientIP string, acceptedAt time.Time) *Consent {
+	return &Consent{
+		userID:     userID,
+		document:   document,
+		version:    version,
+		clientIP:   clientIP,
+		acceptedAt: acceptedAt,
+	}
+}
+
+// Versions maps each legal document to its current version. Users must
+// accept the current version of every document that has one; documents
+// without a version are not required.
+type Versions map[string]string
+
+// Required reports whether any document must be accepted
+func (v Versions) Required() bool {
+	for _, document := range Documents {
+		if v[document] != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// Pending returns the documents whose current version is not among
+// accepted, in the order of Documents
+func (v Versions) Pending(accepted []*Consent) []string {
+	var pending []string
+	for _, document := range Documents {
+		current := v[document]
+		if current == "" {
+			continue
+		}
+		if !slices.ContainsFunc(accepted, func(c *Consent) bool {
+			return c.document == document && c.version == current
+		}) {
+			pending = append(pending, document)
+		}
+	}
+	return pending
+}
@@ -0,0 +1,9 @@
+package consent
+
+import "errors"
+
+// Domain errors
+var (
+	ErrUnknownDocument = errors.New("document must be terms or privacy")
+	ErrMissingVersion  = errors.New("document version is required")
+)
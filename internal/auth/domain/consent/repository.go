@@ -0,0 +1,17 @@
+package consent
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Repository stores consents
+type Repository interface {
+	// Save records a consent. Accepting a version that was already
+	// accepted keeps the first acceptance.
+	Save(ctx context.Context, c *Consent) error
+
+	// ListByUserID returns every consent of a user, newest first.
+	ListByUserID(ctx context.Context, userID uuid.UUID) ([]*Consent, error)
+}
@@ -12,10 +12,10 @@ type PayloadSendVerifyEmail struct {
 	Email                      string    `json:"email"`
 	VerificationCode           string    `json:"verification_code"`
 	VerificationCodeExpiration int       `json:"verification_code_expiration"` // in minutes
+	Locale                     string    `json:"locale"`
 
 	// fill by dispatcher
-	From    string `json:"from"`
-	Subject string `json:"subject"`
+	From string `json:"from"`
 }
 
 type PayloadSendForgotPasswordEmail struct {
@@ -24,15 +24,29 @@ type PayloadSendForgotPasswordEmail struct {
 	Email                      string    `json:"email"`
 	VerificationCode           string    `json:"verification_code"`
 	VerificationCodeExpiration int       `json:"verification_code_expiration"` // in minutes
+	Locale                     string    `json:"locale"`
 
 	// fill by dispatcher
 	From      string `json:"from"`
-	Subject   string `json:"subject"`
 	ResetLink string `json:"reset_link"`
 }
 
+type PayloadSendMagicLinkEmail struct {
+	UserID     uuid.UUID `json:"user_id"`
+	Name       string    `json:"name"`
+	Email      string    `json:"email"`
+	Token      string    `json:"token"`
+	Expiration int       `json:"expiration"` // in minutes
+	Locale     string    `json:"locale"`
+
+	// fill by dispatcher
+	From      string `json:"from"`
+	LoginLink string `json:"login_link"`
+}
+
 // TaskDispatcher defines the interface for dispatching background tasks
 type TaskDispatcher interface {
 	DispatchSendVerifyEmail(ctx context.Context, payload *PayloadSendVerifyEmail) error
 	DispatchSendForgotPasswordEmail(ctx context.Context, payload *PayloadSendForgotPasswordEmail) error
+	DispatchSendMagicLinkEmail(ctx context.Context, payload *PayloadSendMagicLinkEmail) error
 }
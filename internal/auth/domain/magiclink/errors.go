@@ -0,0 +1,12 @@
+package magiclink
+
+import "errors"
+
+// Domain errors
+var (
+	// ErrInvalidLink covers unknown, used and expired links alike
+	ErrInvalidLink = errors.New("invalid or expired login link")
+
+	// ErrLimitReached is returned when a user has been sent too many links
+	ErrLimitReached = errors.New("too many login links requested")
+)
@@ -0,0 +1,84 @@
+package magiclink
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/semmidev/ethos-go/internal/common/random"
+)
+
+// tokenBytes is the size of the random token carried by a link
+const tokenBytes = 32
+
+// MagicLink lets a user sign in by opening a link emailed to them. The link
+// carries a random token; only its hash is stored, so the table can't be
+// used to sign in. Each link works once, until it expires.
+// Fields are private to enforce encapsulation - use getters for read access.
+type MagicLink struct {
+	linkID    uuid.UUID
+	userID    uuid.UUID
+	tokenHash string
+	expiresAt time.Time
+	usedAt    *time.Time
+	createdAt time.Time
+}
+
+// Getters for MagicLink fields
+
+func (l *MagicLink) LinkID() uuid.UUID    { return l.linkID }
+func (l *MagicLink) UserID() uuid.UUID    { return l.userID }
+func (l *MagicLink) TokenHash() string    { return l.tokenHash }
+func (l *MagicLink) ExpiresAt() time.Time { return l.expiresAt }
+func (l *MagicLink) UsedAt() *time.Time   { return l.usedAt }
+func (l *MagicLink) CreatedAt() time.Time { return l.createdAt }
+
+// New creates a link for userID valid for ttl, and returns it with the token
+// to put in the emailed URL. The token is not kept anywhere else.
+func New(userID uuid.UUID, ttl time.Duration, now time.Time) (*MagicLink, string, error) {
+	buf := make([]byte, tokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(buf)
+
+	return &MagicLink{
+		linkID:    random.NewUUID(),
+		userID:    userID,
+		tokenHash: HashToken(token),
+		expiresAt: now.Add(ttl),
+		createdAt: now,
+	}, token, nil
+}
+
+// HashToken returns the stored form of a link token
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// Usable reports whether the link can still sign its user in
+func (l *MagicLink) Usable(now time.Time) bool {
+	return l.usedAt == nil && now.Before(l.expiresAt)
+}
+
+// UnmarshalFromDatabase reconstructs a MagicLink from database fields
+func UnmarshalFromDatabase(
+	linkID, userID uuid.UUID,
+	tokenHash string,
+	expiresAt time.Time,
+	usedAt *time.Time,
+	createdAt time.Time,
+) *MagicLink {
+	return &MagicLink{
+		linkID:    linkID,
+		userID:    userID,
+		tokenHash: tokenHash,
+		expiresAt: expiresAt,
+		usedAt:    usedAt,
+		createdAt: createdAt,
+	}
+}
@@ -0,0 +1,37 @@
+package magiclink
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Repository stores magic links
+type Repository interface {
+	// Create stores a new link.
+	Create(ctx context.Context, link *MagicLink) error
+
+	// CreateWithinLimit stores a new link unless its user already has limit
+	// links created since the given time, returning ErrLimitReached then.
+	// Counting and storing are one step, so concurrent requests can't both
+	// take the last link.
+	CreateWithinLimit(ctx context.Context, link *MagicLink, since time.Time, limit int) error
+
+	// FindByTokenHash looks up a link by the hash of its token.
+	// Returns ErrInvalidLink if there is none.
+	FindByTokenHash(ctx context.Context, tokenHash string) (*MagicLink, error)
+
+	// MarkUsed records that the link signed its user in. It returns
+	// ErrInvalidLink if the link was used already, so a link signs in at
+	// most once even when opened twice at the same time.
+	MarkUsed(ctx context.Context, linkID uuid.UUID, usedAt time.Time) error
+}
+
+// Maintainer provides maintenance operations for magic links.
+// Use this interface for cleanup tasks and bulk operations.
+type Maintainer interface {
+	// DeleteExpired removes links that have expired, returning how many.
+	// Links created within the last hour are kept for CreateWithinLimit.
+	DeleteExpired(ctx context.Context) (int64, error)
+}
@@ -0,0 +1,14 @@
+package service
+
+import (
+	"context"
+
+	"github.com/semmidev/ethos-go/internal/auth/domain/session"
+)
+
+// GeoLocator finds the approximate location of a client IP address so
+// sessions can show where they were started from. Lookups are best-effort:
+// an unknown IP yields an empty Location, not an error.
+type GeoLocator interface {
+	Locate(ctx context.Context, clientIP string) (session.Location, error)
+}
@@ -0,0 +1,19 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SessionDenylist remembers revoked sessions whose access tokens may still
+// be unexpired. Entries only need to outlive the last token issued for the
+// session, so Deny takes the token's remaining lifetime as the TTL.
+type SessionDenylist interface {
+	// Deny refuses access tokens of the session for the next ttl
+	Deny(ctx context.Context, sessionID uuid.UUID, ttl time.Duration) error
+
+	// IsDenied reports whether the session's access tokens are refused
+	IsDenied(ctx context.Context, sessionID uuid.UUID) (bool, error)
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"time"
+)
+
+// SSOLoginTTL is how long a started sign-in waits for the provider's callback
+const SSOLoginTTL = 10 * time.Minute
+
+// SSOIdentity is a user as asserted by the enterprise identity provider
+type SSOIdentity struct {
+	// Subject identifies the user at the provider and never changes
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+	// Groups are the provider groups the user belongs to
+	Groups []string
+}
+
+// SSOLogin is a started sign-in. Its values tie the provider's callback to
+// the login URL that was handed out, so a code obtained elsewhere can't be
+// used to sign someone in.
+type SSOLogin struct {
+	// State comes back unchanged on the callback and identifies the sign-in
+	State string
+	// Nonce is echoed in the ID token
+	Nonce string
+	// CodeVerifier is the PKCE secret whose challenge is in the login URL;
+	// only the code exchange reveals it
+	CodeVerifier string
+}
+
+// NewSSOLogin starts a sign-in with fresh random values
+func NewSSOLogin() (SSOLogin, error) {
+	var values [3]string
+	for i := range values {
+		buf := make([]byte, 32)
+		if _, err := rand.Read(buf); err != nil {
+			return SSOLogin{}, err
+		}
+		values[i] = base64.RawURLEncoding.EncodeToString(buf)
+	}
+	return SSOLogin{State: values[0], Nonce: values[1], CodeVerifier: values[2]}, nil
+}
+
+// SSOProvider signs users in through an external OpenID Connect provider.
+// The app layer only sees verified identities; discovery, code exchange and
+// ID token checks are the adapter's job.
+type SSOProvider interface {
+	// LoginURL returns the provider URL to send the user to for login. The
+	// provider redirects back with its state unchanged.
+	LoginURL(ctx context.Context, login SSOLogin) (string, error)
+
+	// Exchange trades an authorization code for the identity in the
+	// provider's verified ID token. The token must carry login's nonce.
+	Exchange(ctx context.Context, code string, login SSOLogin) (*SSOIdentity, error)
+}
+
+// SSOLoginStore keeps started sign-ins until their callback arrives
+type SSOLoginStore interface {
+	// Save keeps login for ttl
+	Save(ctx context.Context, login SSOLogin, ttl time.Duration) error
+
+	// Take removes and returns the sign-in started with state, so each can
+	// be completed only once. ok is false when there is none or it expired.
+	Take(ctx context.Context, state string) (login SSOLogin, ok bool, err error)
+}
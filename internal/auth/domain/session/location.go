@@ -0,0 +1,14 @@
+package session
+
+// Location is the approximate place a session was started from, looked up
+// from its client IP at login. Fields are empty when the IP could not be
+// located (private addresses, no GeoIP database configured).
+type Location struct {
+	City    string
+	Country string
+}
+
+// IsZero reports whether nothing is known about the location
+func (l Location) IsZero() bool {
+	return l.City == "" && l.Country == ""
+}
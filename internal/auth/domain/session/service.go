@@ -22,3 +22,15 @@ func (s *AuthenticationService) AccessTokenTTL() time.Duration {
 func (s *AuthenticationService) RefreshTokenTTL() time.Duration {
 	return s.refreshTokenTTL
 }
+
+// AccessTokenRemaining is how long the newest access token of s stays valid.
+// Access tokens are only issued at login and on refresh, both of which set
+// the session's UpdatedAt, so it must be called before the session is
+// blocked.
+func (s *AuthenticationService) AccessTokenRemaining(sess *Session, now time.Time) time.Duration {
+	remaining := sess.UpdatedAt().Add(s.accessTokenTTL).Sub(now)
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
@@ -17,6 +17,7 @@ type Session struct {
 	refreshToken string
 	userAgent    string
 	clientIP     string
+	location     Location
 	isBlocked    bool
 	expiresAt    time.Time
 	createdAt    time.Time
@@ -30,6 +31,7 @@ func (s *Session) UserID() uuid.UUID    { return s.userID }
 func (s *Session) RefreshToken() string { return s.refreshToken }
 func (s *Session) UserAgent() string    { return s.userAgent }
 func (s *Session) ClientIP() string     { return s.clientIP }
+func (s *Session) Location() Location   { return s.location }
 func (s *Session) IsBlocked() bool      { return s.isBlocked }
 func (s *Session) ExpiresAt() time.Time { return s.expiresAt }
 func (s *Session) CreatedAt() time.Time { return s.createdAt }
@@ -72,6 +74,7 @@ func UnmarshalSessionFromDatabase(
 	refreshToken string,
 	userAgent string,
 	clientIP string,
+	location Location,
 	isBlocked bool,
 	expiresAt time.Time,
 	createdAt time.Time,
@@ -83,6 +86,7 @@ func UnmarshalSessionFromDatabase(
 		refreshToken: refreshToken,
 		userAgent:    userAgent,
 		clientIP:     clientIP,
+		location:     location,
 		isBlocked:    isBlocked,
 		expiresAt:    expiresAt,
 		createdAt:    createdAt,
@@ -102,6 +106,20 @@ func (s *Session) IsValid() bool {
 	return !s.isBlocked && !s.IsExpired()
 }
 
+// SetLocation records where the session was started from, so users can
+// spot sessions from places they have never been.
+func (s *Session) SetLocation(location Location) {
+	s.location = location
+}
+
+// UpdateDevice records the device the session was last used from. Sessions
+// outlive network changes, so each refresh brings these up to date.
+func (s *Session) UpdateDevice(userAgent, clientIP string) {
+	s.userAgent = userAgent
+	s.clientIP = clientIP
+	s.updatedAt = time.Now()
+}
+
 // Block marks this session as blocked, preventing further use.
 // This is useful when we detect suspicious activity or when a user
 // explicitly logs out from a specific device.
@@ -0,0 +1,53 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AnonymizedName is the name an anonymized user is left with
+const AnonymizedName = "Anonymized user"
+
+// AnonymizedEmail is the address an anonymized user is left with. It is
+// unique per user, so the email constraint still holds, and on a reserved
+// domain that never receives mail.
+func AnonymizedEmail(userID uuid.UUID) string {
+	return "anonymized-" + userID.String() + "@anonymized.invalid"
+}
+
+// Anonymization describes a request to forget a user's personal data
+type Anonymization struct {
+	UserID uuid.UUID
+	// Email is the address being forgotten; records keyed by a hash of it
+	// are re-keyed to the anonymized address
+	Email  string
+	Actor  string
+	Reason string
+	At     time.Time
+}
+
+// Anonymizer forgets a user's personal data while keeping the account and
+// its habits and logs, so analytics still count them
+type Anonymizer interface {
+	// Anonymize scrubs the user's name, email, avatar and credentials,
+	// their sessions' client details, the IP addresses of their consents,
+	// their notifications and the notes on their habit logs, and writes an
+	// audit entry, all or nothing.
+	// Returns ErrNotFound if there is no such user.
+	Anonymize(ctx context.Context, a Anonymization) error
+}
+
+// Anonymize scrubs the user's personal data and deactivates the account
+func (u *User) Anonymize() {
+	u.name = AnonymizedName
+	u.email = AnonymizedEmail(u.userID)
+	u.avatar = nil
+	u.hashedPassword = nil
+	u.authProviderID = nil
+	u.verifyCode = nil
+	u.passwordResetCode = nil
+	u.isActive = false
+	u.updatedAt = time.Now()
+}
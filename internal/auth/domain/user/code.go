@@ -0,0 +1,88 @@
+package user
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// CodeTTL is how long an emailed verification or password reset code
+	// can be used
+	CodeTTL = 10 * time.Minute
+
+	// MaxCodeAttempts is how many wrong guesses lock a code. Codes are six
+	// digits, so five guesses leave a 1 in 200,000 chance per code.
+	MaxCodeAttempts = 5
+)
+
+// CodeKind names one of the emailed codes a user can hold
+type CodeKind int
+
+const (
+	CodeEmailVerification CodeKind = iota + 1
+	CodePasswordReset
+)
+
+// OneTimeCode is an emailed code that proves control of the user's mailbox.
+// Only a hash of the code is kept, so the stored value cannot be replayed.
+type OneTimeCode struct {
+	hash      string
+	expiresAt time.Time
+	attempts  int
+}
+
+func (c *OneTimeCode) Hash() string         { return c.hash }
+func (c *OneTimeCode) ExpiresAt() time.Time { return c.expiresAt }
+func (c *OneTimeCode) Attempts() int        { return c.attempts }
+
+// CodeHasher computes the stored form of emailed codes. Codes are only six
+// digits, so the hash is an HMAC keyed with a server secret: without the
+// secret, a leaked hash cannot be brute forced offline.
+type CodeHasher struct {
+	key []byte
+}
+
+// NewCodeHasher creates a hasher keyed with secret
+func NewCodeHasher(secret string) CodeHasher {
+	return CodeHasher{key: []byte(secret)}
+}
+
+// Hash returns the stored form of a code issued to userID. The user ID is
+// part of the message, so equal codes of different users hash differently.
+func (h CodeHasher) Hash(userID uuid.UUID, code string) string {
+	mac := hmac.New(sha256.New, h.key)
+	mac.Write([]byte(userID.String() + ":" + code))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func newOneTimeCode(hasher CodeHasher, userID uuid.UUID, code string, now time.Time) *OneTimeCode {
+	return &OneTimeCode{hash: hasher.Hash(userID, code), expiresAt: now.Add(CodeTTL)}
+}
+
+// redeem checks code against c and returns the code to keep: nil once it
+// matched, c otherwise. Expired and locked codes never match. All failures
+// return ErrInvalidCode so callers can't tell them apart. Wrong guesses are
+// counted by the repository before redeeming, see RecordCodeAttempt.
+func (c *OneTimeCode) redeem(hasher CodeHasher, userID uuid.UUID, code string, now time.Time) (*OneTimeCode, error) {
+	if c == nil || !now.Before(c.expiresAt) || c.attempts >= MaxCodeAttempts {
+		return c, ErrInvalidCode
+	}
+	if subtle.ConstantTimeCompare([]byte(hasher.Hash(userID, code)), []byte(c.hash)) != 1 {
+		return c, ErrInvalidCode
+	}
+	return nil, nil
+}
+
+// UnmarshalOneTimeCode reconstructs a code from database fields. It returns
+// nil when no code is stored.
+func UnmarshalOneTimeCode(hash *string, expiresAt *time.Time, attempts int) *OneTimeCode {
+	if hash == nil || expiresAt == nil {
+		return nil
+	}
+	return &OneTimeCode{hash: *hash, expiresAt: *expiresAt, attempts: attempts}
+}
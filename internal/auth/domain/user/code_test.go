@@ -0,0 +1,99 @@
+package user_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/auth/domain/user"
+	"github.com/semmidev/ethos-go/internal/common/random"
+)
+
+func TestVerifyCode(t *testing.T) {
+	t.Parallel()
+
+	hasher := user.NewCodeHasher(strings.Repeat("k", 32))
+
+	Convey("Given a user with a verification code", t, func() {
+		now := time.Now()
+		u := user.NewUser(random.NewUUID(), "test@example.com", "Test User", "hashed")
+		u.IssueVerifyCode(hasher, "123456", now)
+
+		Convey("Then only a keyed hash of the code is stored", func() {
+			unkeyed := sha256.Sum256([]byte(u.UserID().String() + ":123456"))
+			So(u.VerifyCode().Hash(), ShouldNotContainSubstring, "123456")
+			So(u.VerifyCode().Hash(), ShouldEqual, hasher.Hash(u.UserID(), "123456"))
+			So(u.VerifyCode().Hash(), ShouldNotEqual, hex.EncodeToString(unkeyed[:]))
+			So(u.VerifyCode().ExpiresAt(), ShouldEqual, now.Add(user.CodeTTL))
+		})
+
+		Convey("Then a hasher with another secret does not match it", func() {
+			other := user.NewCodeHasher(strings.Repeat("o", 32))
+			So(u.RedeemVerifyCode(other, "123456", now), ShouldEqual, user.ErrInvalidCode)
+		})
+
+		Convey("When the right code is entered", func() {
+			err := u.RedeemVerifyCode(hasher, "123456", now.Add(time.Minute))
+
+			Convey("Then it is accepted once", func() {
+				So(err, ShouldBeNil)
+				So(u.VerifyCode(), ShouldBeNil)
+				So(u.RedeemVerifyCode(hasher, "123456", now.Add(time.Minute)), ShouldEqual, user.ErrInvalidCode)
+			})
+		})
+
+		Convey("When the code has expired", func() {
+			err := u.RedeemVerifyCode(hasher, "123456", now.Add(user.CodeTTL))
+
+			Convey("Then it is refused", func() {
+				So(err, ShouldEqual, user.ErrInvalidCode)
+			})
+		})
+
+		Convey("When a wrong code is entered", func() {
+			So(u.RedeemVerifyCode(hasher, "000000", now), ShouldEqual, user.ErrInvalidCode)
+
+			Convey("Then the code is kept for another try", func() {
+				So(u.VerifyCode(), ShouldNotBeNil)
+				So(u.RedeemVerifyCode(hasher, "123456", now), ShouldBeNil)
+			})
+		})
+	})
+
+	Convey("Given a code locked after too many recorded guesses", t, func() {
+		now := time.Now()
+		u := user.NewUser(random.NewUUID(), "test@example.com", "Test User", "hashed")
+		hash, expiresAt := hasher.Hash(u.UserID(), "123456"), now.Add(user.CodeTTL)
+		locked := user.UnmarshalOneTimeCode(&hash, &expiresAt, user.MaxCodeAttempts)
+		u = user.UnmarshalUserFromDatabase(
+			u.UserID(), u.Email(), u.Name(), u.HashedPassword(), u.AuthProvider(), u.AuthProviderID(),
+			u.Role(), u.Timezone(), u.Locale(), u.WeekStart(), u.LogLockDays(), u.Avatar(), u.Preferences(),
+			u.IsActive(), u.DeactivatedBy(), u.IsVerified(), locked, nil, u.CreatedAt(), u.UpdatedAt(),
+		)
+
+		Convey("Then even the right code is refused", func() {
+			So(u.RedeemVerifyCode(hasher, "123456", now), ShouldEqual, user.ErrInvalidCode)
+		})
+
+		Convey("Then a new code starts a fresh count", func() {
+			u.IssueVerifyCode(hasher, "654321", now)
+			So(u.VerifyCode().Attempts(), ShouldEqual, 0)
+			So(u.RedeemVerifyCode(hasher, "654321", now), ShouldBeNil)
+		})
+	})
+
+	Convey("Given the same code issued to two users", t, func() {
+		a := user.NewUser(random.NewUUID(), "a@example.com", "A", "hashed")
+		b := user.NewUser(random.NewUUID(), "b@example.com", "B", "hashed")
+		a.IssuePasswordResetCode(hasher, "123456", time.Now())
+		b.IssuePasswordResetCode(hasher, "123456", time.Now())
+
+		Convey("Then the stored hashes differ", func() {
+			So(a.PasswordResetCode().Hash(), ShouldNotEqual, b.PasswordResetCode().Hash())
+		})
+	})
+}
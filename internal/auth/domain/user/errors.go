@@ -7,4 +7,14 @@ var (
 	ErrNotFound      = errors.New("user not found")
 	ErrAlreadyExists = errors.New("user already exists")
 	ErrInvalidEmail  = errors.New("invalid email format")
+
+	// ErrInvalidCode covers wrong, expired, locked and missing codes alike,
+	// so responses don't reveal which one it was
+	ErrInvalidCode = errors.New("invalid or expired code")
+
+	ErrUnsupportedLocale    = errors.New("unsupported locale")
+	ErrUnsupportedWeekStart = errors.New("week must start on sunday, monday or saturday")
+	ErrUnsupportedTheme     = errors.New("theme must be system, light or dark")
+	ErrInvalidReminderHour  = errors.New("reminder hour must be between 0 and 23")
+	ErrUnsupportedRole      = errors.New("role must be member or admin")
 )
@@ -0,0 +1,132 @@
+package user
+
+import (
+	"encoding/json"
+	"maps"
+	"strings"
+)
+
+// Theme is the color scheme the client renders in
+type Theme string
+
+const (
+	ThemeSystem Theme = "system"
+	ThemeLight  Theme = "light"
+	ThemeDark   Theme = "dark"
+)
+
+// DefaultTheme follows the device setting
+const DefaultTheme = ThemeSystem
+
+// Keys of the settings kept in the preferences object
+const (
+	preferenceTheme               = "theme"
+	preferenceDefaultReminderHour = "default_reminder_hour"
+)
+
+// ParseTheme parses a theme name such as "dark".
+func ParseTheme(name string) (Theme, error) {
+	switch theme := Theme(strings.ToLower(strings.TrimSpace(name))); theme {
+	case ThemeSystem, ThemeLight, ThemeDark:
+		return theme, nil
+	default:
+		return "", ErrUnsupportedTheme
+	}
+}
+
+// Preferences are per-user settings stored together as one JSON object, so
+// adding a setting only takes a pair of accessors here. Keys this version
+// does not know are kept as they are, so settings written by a newer release
+// survive a rollback. Preferences is a value: setters never change copies
+// taken before them.
+type Preferences struct {
+	values map[string]json.RawMessage
+}
+
+// PreferencesFromJSON reads the stored preferences object. Anything other
+// than a JSON object reads as no preferences, leaving every setting at its
+// default.
+func PreferencesFromJSON(data []byte) Preferences {
+	var values map[string]json.RawMessage
+	if err := json.Unmarshal(data, &values); err != nil {
+		return Preferences{}
+	}
+	return Preferences{values: values}
+}
+
+// MarshalJSON encodes the preferences as the object they are stored as
+func (p Preferences) MarshalJSON() ([]byte, error) {
+	if p.values == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.values)
+}
+
+// Theme returns the chosen color scheme, DefaultTheme when unset or no
+// longer supported
+func (p Preferences) Theme() Theme {
+	var name string
+	if !p.get(preferenceTheme, &name) {
+		return DefaultTheme
+	}
+	theme, err := ParseTheme(name)
+	if err != nil {
+		return DefaultTheme
+	}
+	return theme
+}
+
+// SetTheme sets the color scheme
+func (p *Preferences) SetTheme(theme Theme) error {
+	if _, err := ParseTheme(string(theme)); err != nil {
+		return err
+	}
+	p.set(preferenceTheme, theme)
+	return nil
+}
+
+// DefaultReminderHour returns the hour of day (0-23) clients suggest for a
+// new habit's reminder, or nil when the user has not picked one
+func (p Preferences) DefaultReminderHour() *int {
+	var hour int
+	if !p.get(preferenceDefaultReminderHour, &hour) || hour < 0 || hour > 23 {
+		return nil
+	}
+	return &hour
+}
+
+// SetDefaultReminderHour sets the suggested reminder hour; nil clears it
+func (p *Preferences) SetDefaultReminderHour(hour *int) error {
+	if hour == nil {
+		p.values = maps.Clone(p.values)
+		delete(p.values, preferenceDefaultReminderHour)
+		return nil
+	}
+	if *hour < 0 || *hour > 23 {
+		return ErrInvalidReminderHour
+	}
+	p.set(preferenceDefaultReminderHour, *hour)
+	return nil
+}
+
+// get decodes the setting under key into dst, reporting whether it was set
+// to a value of the right type
+func (p Preferences) get(key string, dst any) bool {
+	raw, ok := p.values[key]
+	if !ok {
+		return false
+	}
+	return json.Unmarshal(raw, dst) == nil
+}
+
+func (p *Preferences) set(key string, value any) {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		// Only strings and numbers are stored, which always encode
+		panic(err)
+	}
+	values := make(map[string]json.RawMessage, len(p.values)+1)
+	maps.Copy(values, p.values)
+	values[key] = raw
+	p.values = values
+}
@@ -0,0 +1,74 @@
+package user_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/auth/domain/user"
+)
+
+func TestPreferences(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given empty preferences", t, func() {
+		var p user.Preferences
+
+		Convey("Then every setting has its default", func() {
+			So(p.Theme(), ShouldEqual, user.DefaultTheme)
+			So(p.DefaultReminderHour(), ShouldBeNil)
+
+			data, err := json.Marshal(p)
+			So(err, ShouldBeNil)
+			So(string(data), ShouldEqual, "{}")
+		})
+
+		Convey("When settings are changed, they are validated", func() {
+			So(p.SetTheme("sepia"), ShouldEqual, user.ErrUnsupportedTheme)
+			So(p.SetDefaultReminderHour(intPtr(24)), ShouldEqual, user.ErrInvalidReminderHour)
+
+			So(p.SetTheme(user.ThemeDark), ShouldBeNil)
+			So(p.SetDefaultReminderHour(intPtr(0)), ShouldBeNil)
+			So(p.Theme(), ShouldEqual, user.ThemeDark)
+			So(*p.DefaultReminderHour(), ShouldEqual, 0)
+		})
+	})
+
+	Convey("Given stored preferences with a setting this version does not know", t, func() {
+		p := user.PreferencesFromJSON([]byte(`{"theme":"light","default_reminder_hour":7,"density":"compact"}`))
+
+		Convey("Then the known settings are read", func() {
+			So(p.Theme(), ShouldEqual, user.ThemeLight)
+			So(*p.DefaultReminderHour(), ShouldEqual, 7)
+		})
+
+		Convey("When a setting is changed, the unknown one is kept", func() {
+			So(p.SetDefaultReminderHour(nil), ShouldBeNil)
+
+			data, err := json.Marshal(p)
+			So(err, ShouldBeNil)
+			So(string(data), ShouldEqual, `{"density":"compact","theme":"light"}`)
+		})
+
+		Convey("When a copy is changed, the original is not", func() {
+			changed := p
+			So(changed.SetTheme(user.ThemeDark), ShouldBeNil)
+			So(changed.SetDefaultReminderHour(nil), ShouldBeNil)
+
+			So(p.Theme(), ShouldEqual, user.ThemeLight)
+			So(*p.DefaultReminderHour(), ShouldEqual, 7)
+		})
+	})
+
+	Convey("Given stored values of the wrong type or out of range", t, func() {
+		p := user.PreferencesFromJSON([]byte(`{"theme":3,"default_reminder_hour":42}`))
+
+		Convey("Then the defaults are used", func() {
+			So(p.Theme(), ShouldEqual, user.DefaultTheme)
+			So(p.DefaultReminderHour(), ShouldBeNil)
+		})
+	})
+}
+
+func intPtr(v int) *int { return &v }
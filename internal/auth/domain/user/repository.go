@@ -19,10 +19,28 @@ type UserWriter interface {
 	Delete(ctx context.Context, userID uuid.UUID) error
 }
 
+// UserMaintainer provides maintenance operations for users.
+// Use this interface for cleanup tasks and bulk operations.
+type UserMaintainer interface {
+	// ClearExpiredCodes removes verification and password reset codes that
+	// have expired, returning the number of users changed.
+	ClearExpiredCodes(ctx context.Context) (int64, error)
+}
+
 // Repository combines all user repository interfaces.
 // This is the full interface that adapters implement.
 // Consumers should depend on the smallest interface they need.
 type Repository interface {
 	UserReader // FindByEmail, FindByID
 	UserWriter // Create, Update, Delete
+
+	// FindByAuthProvider looks up a user by their ID at an external
+	// identity provider. Returns ErrNotFound if there is none.
+	FindByAuthProvider(ctx context.Context, provider, providerID string) (*User, error)
+
+	// RecordCodeAttempt counts a guess at the user's pending code of kind in
+	// a single statement, so concurrent guesses can't share an attempt. It
+	// returns false, counting nothing, when no code is pending or the code
+	// is already locked after MaxCodeAttempts guesses.
+	RecordCodeAttempt(ctx context.Context, userID uuid.UUID, kind CodeKind) (bool, error)
 }
@@ -0,0 +1,27 @@
+package user
+
+// Roles a user can hold, from least to most privileged
+const (
+	RoleMember = "member"
+	RoleAdmin  = "admin"
+)
+
+// roleRanks orders roles by privilege
+var roleRanks = map[string]int{
+	RoleMember: 0,
+	RoleAdmin:  1,
+}
+
+// IsRole reports whether role is a known role
+func IsRole(role string) bool {
+	_, ok := roleRanks[role]
+	return ok
+}
+
+// HigherRole returns the more privileged of two known roles
+func HigherRole(a, b string) string {
+	if roleRanks[b] > roleRanks[a] {
+		return b
+	}
+	return a
+}
@@ -1,11 +1,20 @@
 package user
 
 import (
+	_ "embed"
 	"errors"
+	"sort"
 	"strings"
 	"time"
 )
 
+// timezoneNames are the canonical IANA zones (from zone.tab) plus UTC,
+// one per line. They are only used to suggest corrections; validation
+// asks the tz database itself, which also accepts links like US/Eastern.
+//
+//go:embed timezones.txt
+var timezoneNames string
+
 // Timezone is a value object representing a validated timezone.
 // It ensures timezones can be loaded by the time package.
 type Timezone struct {
@@ -26,9 +35,10 @@ func NewTimezone(raw string) (Timezone, error) {
 		return NewTimezone(DefaultTimezone)
 	}
 
-	// Validate the timezone can be loaded
+	// Validate the timezone can be loaded. "Local" would mean the server's
+	// zone, which Postgres does not know by that name.
 	_, err := time.LoadLocation(raw)
-	if err != nil {
+	if err != nil || raw == "Local" {
 		return Timezone{}, ErrInvalidTimezone
 	}
 
@@ -75,3 +85,69 @@ func (t Timezone) Equals(other Timezone) bool {
 func (t Timezone) IsDefault() bool {
 	return t.value == DefaultTimezone
 }
+
+// SuggestTimezones returns up to limit IANA zones whose name or city is
+// close to raw, best first, e.g. Asia/Jakarta for "jakarta" or
+// America/New_York for "America/New York".
+func SuggestTimezones(raw string, limit int) []string {
+	query := normalizeZoneName(raw)
+	if query == "" || limit <= 0 {
+		return nil
+	}
+	maxDistance := max(2, len(query)/3)
+
+	type candidate struct {
+		name     string
+		distance int
+	}
+	var candidates []candidate
+	for _, name := range strings.Fields(timezoneNames) {
+		zone := normalizeZoneName(name)
+		city := zone[strings.LastIndexByte(zone, '/')+1:]
+
+		distance := min(editDistance(query, zone), editDistance(query, city))
+		if strings.Contains(zone, query) && len(query) >= 3 {
+			distance = min(distance, 1)
+		}
+		if distance <= maxDistance {
+			candidates = append(candidates, candidate{name, distance})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].distance < candidates[j].distance
+	})
+	suggestions := make([]string, 0, min(limit, len(candidates)))
+	for _, c := range candidates[:min(limit, len(candidates))] {
+		suggestions = append(suggestions, c.name)
+	}
+	return suggestions
+}
+
+// normalizeZoneName lowercases a zone and spells spaces and hyphens the way
+// zone names do
+func normalizeZoneName(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	return strings.NewReplacer(" ", "_", "-", "_").Replace(name)
+}
+
+// editDistance is the Levenshtein distance between a and b
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
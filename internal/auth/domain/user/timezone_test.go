@@ -0,0 +1,42 @@
+package user_test
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/auth/domain/user"
+)
+
+func TestTimezone(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given time zone names", t, func() {
+		Convey("Then IANA zones and their links are accepted", func() {
+			for _, name := range []string{"Asia/Jakarta", "America/New_York", "UTC", "US/Eastern"} {
+				tz, err := user.NewTimezone(name)
+				So(err, ShouldBeNil)
+				So(tz.String(), ShouldEqual, name)
+			}
+		})
+
+		Convey("Then anything else is rejected", func() {
+			for _, name := range []string{"Jakarta", "GMT+7", "Local", "Asia/Jakarta/Extra"} {
+				_, err := user.NewTimezone(name)
+				So(err, ShouldEqual, user.ErrInvalidTimezone)
+			}
+		})
+	})
+
+	Convey("Given a mistyped zone", t, func() {
+		Convey("Then close matches are suggested, best first", func() {
+			So(user.SuggestTimezones("jakarta", 3), ShouldResemble, []string{"Asia/Jakarta"})
+			So(user.SuggestTimezones("America/New York", 3)[0], ShouldEqual, "America/New_York")
+			So(user.SuggestTimezones("Europe/Londn", 3)[0], ShouldEqual, "Europe/London")
+		})
+
+		Convey("Then nothing is suggested for gibberish", func() {
+			So(user.SuggestTimezones("qqqqqqqqqq", 3), ShouldBeEmpty)
+		})
+	})
+}
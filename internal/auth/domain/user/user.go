@@ -4,45 +4,56 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/semmidev/ethos-go/internal/common/i18n"
 )
 
 // User represents a user account in the system
 // Fields are private to enforce encapsulation - use getters for read access
 type User struct {
-	userID                 uuid.UUID
-	email                  string
-	name                   string
-	hashedPassword         *string
-	authProvider           string
-	authProviderID         *string
-	timezone               string
-	isActive               bool
-	isVerified             bool
-	verifyToken            *string
-	verifyExpiresAt        *time.Time
-	passwordResetToken     *string
-	passwordResetExpiresAt *time.Time
-	createdAt              time.Time
-	updatedAt              time.Time
+	userID            uuid.UUID
+	email             string
+	name              string
+	hashedPassword    *string
+	authProvider      string
+	authProviderID    *string
+	role              string
+	timezone          string
+	locale            string
+	weekStart         time.Weekday
+	logLockDays       *int
+	avatar            *string
+	preferences       Preferences
+	isActive          bool
+	deactivatedBy     string
+	isVerified        bool
+	verifyCode        *OneTimeCode
+	passwordResetCode *OneTimeCode
+	createdAt         time.Time
+	updatedAt         time.Time
 }
 
 // Getters for User fields
 
-func (u *User) UserID() uuid.UUID                  { return u.userID }
-func (u *User) Email() string                      { return u.email }
-func (u *User) Name() string                       { return u.name }
-func (u *User) HashedPassword() *string            { return u.hashedPassword }
-func (u *User) AuthProvider() string               { return u.authProvider }
-func (u *User) AuthProviderID() *string            { return u.authProviderID }
-func (u *User) Timezone() string                   { return u.timezone }
-func (u *User) IsActive() bool                     { return u.isActive }
-func (u *User) IsVerified() bool                   { return u.isVerified }
-func (u *User) VerifyToken() *string               { return u.verifyToken }
-func (u *User) VerifyExpiresAt() *time.Time        { return u.verifyExpiresAt }
-func (u *User) PasswordResetToken() *string        { return u.passwordResetToken }
-func (u *User) PasswordResetExpiresAt() *time.Time { return u.passwordResetExpiresAt }
-func (u *User) CreatedAt() time.Time               { return u.createdAt }
-func (u *User) UpdatedAt() time.Time               { return u.updatedAt }
+func (u *User) UserID() uuid.UUID               { return u.userID }
+func (u *User) Email() string                   { return u.email }
+func (u *User) Name() string                    { return u.name }
+func (u *User) HashedPassword() *string         { return u.hashedPassword }
+func (u *User) AuthProvider() string            { return u.authProvider }
+func (u *User) AuthProviderID() *string         { return u.authProviderID }
+func (u *User) Role() string                    { return u.role }
+func (u *User) Timezone() string                { return u.timezone }
+func (u *User) Locale() string                  { return u.locale }
+func (u *User) WeekStart() time.Weekday         { return u.weekStart }
+func (u *User) LogLockDays() *int               { return u.logLockDays }
+func (u *User) Avatar() *string                 { return u.avatar }
+func (u *User) Preferences() Preferences        { return u.preferences }
+func (u *User) IsActive() bool                  { return u.isActive }
+func (u *User) DeactivatedBy() string           { return u.deactivatedBy }
+func (u *User) IsVerified() bool                { return u.isVerified }
+func (u *User) VerifyCode() *OneTimeCode        { return u.verifyCode }
+func (u *User) PasswordResetCode() *OneTimeCode { return u.passwordResetCode }
+func (u *User) CreatedAt() time.Time            { return u.createdAt }
+func (u *User) UpdatedAt() time.Time            { return u.updatedAt }
 
 // Setters for mutable fields (business operations)
 
@@ -66,41 +77,133 @@ func (u *User) SetTimezone(timezone string) {
 	u.updatedAt = time.Now()
 }
 
-func (u *User) SetVerifyToken(token *string, expiresAt *time.Time) {
-	u.verifyToken = token
-	u.verifyExpiresAt = expiresAt
+// SetLocale sets the language server-generated messages are written in
+func (u *User) SetLocale(locale string) error {
+	if !i18n.IsSupported(locale) {
+		return ErrUnsupportedLocale
+	}
+	u.locale = locale
+	u.updatedAt = time.Now()
+	return nil
+}
+
+// SetWeekStart sets the day the user's weeks begin on for weekly stats
+// and the weekly report
+func (u *User) SetWeekStart(day time.Weekday) error {
+	if !isWeekStart(day) {
+		return ErrUnsupportedWeekStart
+	}
+	u.weekStart = day
+	u.updatedAt = time.Now()
+	return nil
+}
+
+// SetLogLockDays locks habit logs older than days against changes.
+// A nil value removes the lock.
+func (u *User) SetLogLockDays(days *int) {
+	u.logLockDays = days
 	u.updatedAt = time.Now()
 }
 
-func (u *User) SetPasswordResetToken(token *string, expiresAt *time.Time) {
-	u.passwordResetToken = token
-	u.passwordResetExpiresAt = expiresAt
+// SetAvatar sets the storage key of the user's avatar image; nil removes it
+func (u *User) SetAvatar(key *string) {
+	u.avatar = key
 	u.updatedAt = time.Now()
 }
 
+// SetPreferences replaces the user's preferences object
+func (u *User) SetPreferences(preferences Preferences) {
+	u.preferences = preferences
+	u.updatedAt = time.Now()
+}
+
+// IssueVerifyCode replaces any pending email verification code with code,
+// valid for CodeTTL from now
+func (u *User) IssueVerifyCode(hasher CodeHasher, code string, now time.Time) {
+	u.verifyCode = newOneTimeCode(hasher, u.userID, code, now)
+	u.updatedAt = now
+}
+
+// RedeemVerifyCode checks an email verification code and consumes it when
+// it matches. Record the attempt with Repository.RecordCodeAttempt first,
+// so concurrent wrong guesses all count against the code.
+func (u *User) RedeemVerifyCode(hasher CodeHasher, code string, now time.Time) error {
+	next, err := u.verifyCode.redeem(hasher, u.userID, code, now)
+	u.verifyCode = next
+	if err == nil {
+		u.updatedAt = now
+	}
+	return err
+}
+
+// IssuePasswordResetCode replaces any pending password reset code with
+// code, valid for CodeTTL from now
+func (u *User) IssuePasswordResetCode(hasher CodeHasher, code string, now time.Time) {
+	u.passwordResetCode = newOneTimeCode(hasher, u.userID, code, now)
+	u.updatedAt = now
+}
+
+// RedeemPasswordResetCode checks a password reset code and consumes it when
+// it matches. Record the attempt with Repository.RecordCodeAttempt first,
+// so concurrent wrong guesses all count against the code.
+func (u *User) RedeemPasswordResetCode(hasher CodeHasher, code string, now time.Time) error {
+	next, err := u.passwordResetCode.redeem(hasher, u.userID, code, now)
+	u.passwordResetCode = next
+	if err == nil {
+		u.updatedAt = now
+	}
+	return err
+}
+
 func (u *User) MarkVerified() {
 	u.isVerified = true
-	u.verifyToken = nil
-	u.verifyExpiresAt = nil
+	u.verifyCode = nil
 	u.updatedAt = time.Now()
 }
 
-func (u *User) Deactivate() {
+// Who switched an account off
+const (
+	DeactivatedByUser         = "user"
+	DeactivatedByProvisioning = "provisioning"
+)
+
+// Deactivate switches the account off, recording who did: the user, or
+// the identity provider through provisioning
+func (u *User) Deactivate(by string) {
 	u.isActive = false
+	u.deactivatedBy = by
 	u.updatedAt = time.Now()
 }
 
 func (u *User) Activate() {
 	u.isActive = true
+	u.deactivatedBy = ""
 	u.updatedAt = time.Now()
 }
 
+// CanReactivate reports whether the user may switch their account back on
+// by signing in. An account the identity provider deactivated stays off
+// until it is provisioned again.
+func (u *User) CanReactivate() bool {
+	return u.isActive || u.deactivatedBy == DeactivatedByUser
+}
+
 func (u *User) SetAuthProvider(provider string, providerID *string) {
 	u.authProvider = provider
 	u.authProviderID = providerID
 	u.updatedAt = time.Now()
 }
 
+// SetRole changes what the user is allowed to do
+func (u *User) SetRole(role string) error {
+	if !IsRole(role) {
+		return ErrUnsupportedRole
+	}
+	u.role = role
+	u.updatedAt = time.Now()
+	return nil
+}
+
 // NewUser creates a new user (factory constructor)
 func NewUser(userID uuid.UUID, email, name, hashedPassword string) *User {
 	now := time.Now()
@@ -112,7 +215,10 @@ func NewUser(userID uuid.UUID, email, name, hashedPassword string) *User {
 		hashedPassword: &pwd,
 		authProvider:   "email",
 		authProviderID: nil,
+		role:           RoleMember,
 		timezone:       "Asia/Jakarta", // Default timezone
+		locale:         i18n.DefaultLocale,
+		weekStart:      DefaultWeekStart,
 		isActive:       true,
 		isVerified:     false,
 		createdAt:      now,
@@ -131,7 +237,10 @@ func NewGoogleUser(userID uuid.UUID, email, name, googleID string) *User {
 		hashedPassword: nil,
 		authProvider:   "google",
 		authProviderID: &providerID,
+		role:           RoleMember,
 		timezone:       "Asia/Jakarta", // Default
+		locale:         i18n.DefaultLocale,
+		weekStart:      DefaultWeekStart,
 		isActive:       true,
 		isVerified:     true, // Google users are verified implicitly
 		createdAt:      now,
@@ -139,6 +248,61 @@ func NewGoogleUser(userID uuid.UUID, email, name, googleID string) *User {
 	}
 }
 
+// AuthProviderOIDC is the auth provider of users created by enterprise SSO
+const AuthProviderOIDC = "oidc"
+
+// NewOIDCUser creates a new user signing in through the enterprise SSO
+// provider, identified there by subject
+func NewOIDCUser(userID uuid.UUID, email, name, subject string) *User {
+	now := time.Now()
+	providerID := subject
+	return &User{
+		userID:         userID,
+		email:          email,
+		name:           name,
+		hashedPassword: nil,
+		authProvider:   AuthProviderOIDC,
+		authProviderID: &providerID,
+		role:           RoleMember,
+		timezone:       "Asia/Jakarta", // Default
+		locale:         i18n.DefaultLocale,
+		weekStart:      DefaultWeekStart,
+		isActive:       true,
+		isVerified:     true, // the identity provider vouches for the address
+		createdAt:      now,
+		updatedAt:      now,
+	}
+}
+
+// NewProvisionedUser creates a user on behalf of the enterprise identity
+// provider through SCIM. The provider's subject isn't known until the
+// first SSO login, which links the account by email.
+func NewProvisionedUser(userID uuid.UUID, email, name string) *User {
+	now := time.Now()
+	return &User{
+		userID:         userID,
+		email:          email,
+		name:           name,
+		hashedPassword: nil,
+		authProvider:   AuthProviderOIDC,
+		authProviderID: nil,
+		role:           RoleMember,
+		timezone:       "Asia/Jakarta", // Default
+		locale:         i18n.DefaultLocale,
+		weekStart:      DefaultWeekStart,
+		isActive:       true,
+		isVerified:     true, // the identity provider vouches for the address
+		createdAt:      now,
+		updatedAt:      now,
+	}
+}
+
+// IsAwaitingSSOLink reports whether the user was provisioned by the
+// identity provider and hasn't signed in through it yet
+func (u *User) IsAwaitingSSOLink() bool {
+	return u.authProvider == AuthProviderOIDC && u.authProviderID == nil
+}
+
 // UnmarshalUserFromDatabase reconstructs a User from database fields
 // This is used by the adapter layer to convert from database model to domain entity
 func UnmarshalUserFromDatabase(
@@ -147,29 +311,39 @@ func UnmarshalUserFromDatabase(
 	hashedPassword *string,
 	authProvider string,
 	authProviderID *string,
-	timezone string,
-	isActive, isVerified bool,
-	verifyToken *string,
-	verifyExpiresAt *time.Time,
-	passwordResetToken *string,
-	passwordResetExpiresAt *time.Time,
+	role string,
+	timezone, locale string,
+	weekStart time.Weekday,
+	logLockDays *int,
+	avatar *string,
+	preferences Preferences,
+	isActive bool,
+	deactivatedBy string,
+	isVerified bool,
+	verifyCode *OneTimeCode,
+	passwordResetCode *OneTimeCode,
 	createdAt, updatedAt time.Time,
 ) *User {
 	return &User{
-		userID:                 userID,
-		email:                  email,
-		name:                   name,
-		hashedPassword:         hashedPassword,
-		authProvider:           authProvider,
-		authProviderID:         authProviderID,
-		timezone:               timezone,
-		isActive:               isActive,
-		isVerified:             isVerified,
-		verifyToken:            verifyToken,
-		verifyExpiresAt:        verifyExpiresAt,
-		passwordResetToken:     passwordResetToken,
-		passwordResetExpiresAt: passwordResetExpiresAt,
-		createdAt:              createdAt,
-		updatedAt:              updatedAt,
+		userID:            userID,
+		email:             email,
+		name:              name,
+		hashedPassword:    hashedPassword,
+		authProvider:      authProvider,
+		authProviderID:    authProviderID,
+		role:              role,
+		timezone:          timezone,
+		locale:            locale,
+		weekStart:         weekStart,
+		logLockDays:       logLockDays,
+		avatar:            avatar,
+		preferences:       preferences,
+		isActive:          isActive,
+		deactivatedBy:     deactivatedBy,
+		isVerified:        isVerified,
+		verifyCode:        verifyCode,
+		passwordResetCode: passwordResetCode,
+		createdAt:         createdAt,
+		updatedAt:         updatedAt,
 	}
 }
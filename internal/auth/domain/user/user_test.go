@@ -2,6 +2,7 @@ package user_test
 
 import (
 	"testing"
+	"time"
 
 	. "github.com/smartystreets/goconvey/convey"
 
@@ -96,3 +97,37 @@ func TestNewGoogleUser(t *testing.T) {
 		})
 	})
 }
+
+func TestUserWeekStart(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given a new user", t, func() {
+		u := user.NewUser(random.NewUUID(), "week@example.com", "Week User", "hashed")
+
+		Convey("Then weeks begin on Monday", func() {
+			So(u.WeekStart(), ShouldEqual, time.Monday)
+			So(user.WeekStartName(u.WeekStart()), ShouldEqual, "monday")
+		})
+
+		Convey("When the week start is set to Sunday", func() {
+			day, err := user.ParseWeekStart("Sunday")
+			So(err, ShouldBeNil)
+			So(u.SetWeekStart(day), ShouldBeNil)
+
+			Convey("Then it is stored", func() {
+				So(u.WeekStart(), ShouldEqual, time.Sunday)
+			})
+		})
+
+		Convey("When an unsupported day is given", func() {
+			_, parseErr := user.ParseWeekStart("wednesday")
+			setErr := u.SetWeekStart(time.Wednesday)
+
+			Convey("Then it is rejected and the week start is unchanged", func() {
+				So(parseErr, ShouldEqual, user.ErrUnsupportedWeekStart)
+				So(setErr, ShouldEqual, user.ErrUnsupportedWeekStart)
+				So(u.WeekStart(), ShouldEqual, time.Monday)
+			})
+		})
+	})
+}
@@ -0,0 +1,39 @@
+package user
+
+import (
+	"strings"
+	"time"
+)
+
+// DefaultWeekStart is the day weeks begin on unless the user picks another
+const DefaultWeekStart = time.Monday
+
+// weekStarts are the days a week may begin on, by API name
+var weekStarts = map[string]time.Weekday{
+	"sunday":   time.Sunday,
+	"monday":   time.Monday,
+	"saturday": time.Saturday,
+}
+
+// ParseWeekStart parses a week start day name such as "sunday".
+func ParseWeekStart(name string) (time.Weekday, error) {
+	day, ok := weekStarts[strings.ToLower(strings.TrimSpace(name))]
+	if !ok {
+		return 0, ErrUnsupportedWeekStart
+	}
+	return day, nil
+}
+
+// WeekStartName returns the API name of a week start day.
+func WeekStartName(day time.Weekday) string {
+	return strings.ToLower(day.String())
+}
+
+func isWeekStart(day time.Weekday) bool {
+	for _, d := range weekStarts {
+		if d == day {
+			return true
+		}
+	}
+	return false
+}
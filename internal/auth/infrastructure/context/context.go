@@ -15,6 +15,8 @@ type User struct {
 	UserID    string
 	SessionID string
 	Email     string
+	Locale    string
+	Role      string
 }
 
 func UserFromCtx(ctx context.Context) (User, error) {
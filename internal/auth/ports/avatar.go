@@ -0,0 +1,68 @@
+package ports
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/semmidev/ethos-go/internal/auth/app/command"
+	authctx "github.com/semmidev/ethos-go/internal/auth/infrastructure/context"
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/httputil"
+)
+
+// AvatarFormField is the multipart field the avatar image is sent in
+const AvatarFormField = "avatar"
+
+// avatarFormOverhead leaves room for the multipart framing around the file
+const avatarFormOverhead = 64 << 10
+
+// AvatarUploadResponse is the data returned for an upload
+type AvatarUploadResponse struct {
+	AvatarURL string `json:"avatar_url"`
+}
+
+// AvatarUploadHandler accepts a multipart/form-data upload of the user's
+// avatar. It runs outside the gRPC gateway, which cannot stream multipart
+// bodies, so it must be wrapped in AuthMiddleware.
+func AvatarUploadHandler(uploadAvatar command.UploadAvatarHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, err := authctx.UserFromCtx(r.Context())
+		if err != nil {
+			httputil.Error(w, r, apperror.Unauthorized("unauthorized"))
+			return
+		}
+
+		r.Body = http.MaxBytesReader(w, r.Body, command.MaxAvatarBytes+avatarFormOverhead)
+		file, _, err := r.FormFile(AvatarFormField)
+		if err != nil {
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				httputil.Error(w, r, apperror.InvalidInput(AvatarFormField,
+					fmt.Sprintf("must not be larger than %d MB", command.MaxAvatarBytes>>20)))
+				return
+			}
+			httputil.Error(w, r, apperror.InvalidInput(AvatarFormField, "an image file is required"))
+			return
+		}
+		defer file.Close()
+
+		image, err := io.ReadAll(file)
+		if err != nil {
+			httputil.Error(w, r, apperror.InvalidInput(AvatarFormField, "could not read the uploaded file"))
+			return
+		}
+
+		result, err := uploadAvatar.Handle(r.Context(), command.UploadAvatarCommand{
+			UserID: user.UserID,
+			Image:  image,
+		})
+		if err != nil {
+			httputil.Error(w, r, err)
+			return
+		}
+
+		httputil.Success(w, r, AvatarUploadResponse{AvatarURL: result.AvatarURL}, "Avatar updated successfully")
+	})
+}
@@ -10,72 +10,149 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/semmidev/ethos-go/internal/auth/app"
+	"github.com/semmidev/ethos-go/internal/auth/domain/user"
 	authctx "github.com/semmidev/ethos-go/internal/auth/infrastructure/context"
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/grpcutil"
+	"github.com/semmidev/ethos-go/internal/common/i18n"
 )
 
-// publicMethods lists gRPC methods that don't require authentication
-var publicMethods = map[string]bool{
-	"/ethos.auth.v1.AuthService/Register":           true,
-	"/ethos.auth.v1.AuthService/Login":              true,
-	"/ethos.auth.v1.AuthService/GoogleLogin":        true,
-	"/ethos.auth.v1.AuthService/GoogleCallback":     true,
-	"/ethos.auth.v1.AuthService/VerifyEmail":        true,
-	"/ethos.auth.v1.AuthService/ResendVerification": true,
-	"/ethos.auth.v1.AuthService/ForgotPassword":     true,
-	"/ethos.auth.v1.AuthService/ResetPassword":      true,
+// ServicePolicy decides which callers need a service token. Internal
+// methods are only for sibling services, so they need one and stay away
+// from the public HTTP gateway; every other method is open to direct gRPC
+// clients and left to UnaryAuthInterceptor.
+func ServicePolicy(service, fullMethod string) bool {
+	if service == "" || service == grpcutil.ServiceGateway {
+		return !methodPolicies[fullMethod].Internal
+	}
+	return true
 }
 
-// UnaryAuthInterceptor creates a gRPC unary interceptor for authentication
-func UnaryAuthInterceptor(authSvc app.AuthServiceInterface) grpc.UnaryServerInterceptor {
+// UnarySSOOnlyInterceptor refuses every sign-in method other than single
+// sign-on when required is set. Existing sessions keep working and can
+// still be refreshed.
+func UnarySSOOnlyInterceptor(required bool) grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
 		req interface{},
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (interface{}, error) {
-		// Skip authentication for public methods
-		if publicMethods[info.FullMethod] {
-			return handler(ctx, req)
+		if required && methodPolicies[info.FullMethod].NonSSOSignIn {
+			return nil, toGRPCError(ctx, apperror.OperationNotAllowed("sign in", "this deployment requires single sign-on"))
 		}
+		return handler(ctx, req)
+	}
+}
 
-		// Extract token from metadata
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			return nil, status.Error(codes.Unauthenticated, "missing metadata")
-		}
+// ConsentChecker fails while a user has not accepted the current legal
+// documents
+type ConsentChecker interface {
+	CheckConsents(ctx context.Context, userID string) error
+}
 
-		authHeader := md.Get("authorization")
-		if len(authHeader) == 0 {
-			// Also check grpcgateway-authorization header (set by gRPC-Gateway)
-			authHeader = md.Get("grpcgateway-authorization")
+// UnaryConsentInterceptor refuses calls from signed in users until they
+// accept the current terms of service and privacy policy. It must run after
+// authentication; calls without a user pass through.
+func UnaryConsentInterceptor(checker ConsentChecker) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if methodPolicies[info.FullMethod].ConsentExempt {
+			return handler(ctx, req)
+		}
+		user, err := authctx.UserFromCtx(ctx)
+		if err != nil {
+			return handler(ctx, req)
+		}
+		if err := checker.CheckConsents(ctx, user.UserID); err != nil {
+			return nil, toGRPCError(ctx, err)
 		}
-		if len(authHeader) == 0 {
-			return nil, status.Error(codes.Unauthenticated, "missing authorization header")
+		return handler(ctx, req)
+	}
+}
+
+// UnaryAuthInterceptor authenticates the signed in user and enforces each
+// method's access policy. Methods without a policy are refused.
+func UnaryAuthInterceptor(authSvc app.AuthServiceInterface) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		policy, ok := methodPolicies[info.FullMethod]
+		if !ok {
+			return nil, status.Errorf(codes.PermissionDenied, "no access policy for %s", info.FullMethod)
 		}
 
-		tokenString := strings.TrimPrefix(authHeader[0], "Bearer ")
-		if tokenString == authHeader[0] {
-			return nil, status.Error(codes.Unauthenticated, "invalid authorization header format")
+		switch policy.Access {
+		case AccessPublic:
+			return handler(ctx, req)
+		case AccessAdmin:
+			// Sibling services are trusted to act for an operator
+			if calledBySiblingService(ctx) {
+				return handler(ctx, req)
+			}
 		}
 
-		// Validate token
-		payload, err := authSvc.ValidateToken(ctx, tokenString)
+		authUser, err := authenticate(ctx, authSvc)
 		if err != nil {
-			return nil, status.Error(codes.Unauthenticated, "invalid token")
+			return nil, err
+		}
+		if policy.Access == AccessAdmin && authUser.Role != user.RoleAdmin {
+			return nil, status.Error(codes.PermissionDenied, "admin role required")
 		}
 
-		// Get user from claims
-		user, err := authSvc.GetUserByID(ctx, payload.UserID.String())
-		if err != nil {
-			return nil, status.Error(codes.Unauthenticated, "user not found")
+		// Add user to context; their saved locale takes precedence over
+		// the request's Accept-Language
+		ctx = authctx.ContextWithUser(ctx, authUser)
+		if authUser.Locale != "" {
+			ctx = i18n.WithLocale(ctx, authUser.Locale)
 		}
 
-		// Add session ID from payload
-		user.SessionID = payload.SessionID.String()
+		return handler(ctx, req)
+	}
+}
+
+// authenticate returns the user whose access token the call carries
+func authenticate(ctx context.Context, authSvc app.AuthServiceInterface) (authctx.User, error) {
+	// Extract token from metadata
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return authctx.User{}, status.Error(codes.Unauthenticated, "missing metadata")
+	}
+
+	authHeader := md.Get("authorization")
+	if len(authHeader) == 0 {
+		// Also check grpcgateway-authorization header (set by gRPC-Gateway)
+		authHeader = md.Get("grpcgateway-authorization")
+	}
+	if len(authHeader) == 0 {
+		return authctx.User{}, status.Error(codes.Unauthenticated, "missing authorization header")
+	}
+
+	tokenString := strings.TrimPrefix(authHeader[0], "Bearer ")
+	if tokenString == authHeader[0] {
+		return authctx.User{}, status.Error(codes.Unauthenticated, "invalid authorization header format")
+	}
 
-		// Add user to context
-		ctx = authctx.ContextWithUser(ctx, user)
+	// Validate token
+	payload, err := authSvc.ValidateToken(ctx, tokenString)
+	if err != nil {
+		return authctx.User{}, status.Error(codes.Unauthenticated, "invalid token")
+	}
 
-		return handler(ctx, req)
+	// Get user from claims
+	authUser, err := authSvc.GetUserByID(ctx, payload.UserID.String())
+	if err != nil {
+		return authctx.User{}, status.Error(codes.Unauthenticated, "user not found")
 	}
+
+	// Add session ID from payload
+	authUser.SessionID = payload.SessionID.String()
+	return authUser, nil
 }
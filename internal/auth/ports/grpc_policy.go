@@ -0,0 +1,150 @@
+package ports
+
+import (
+	"context"
+
+	authv1 "github.com/semmidev/ethos-go/contracts/grpc/ethos/auth/v1"
+	habitsv1 "github.com/semmidev/ethos-go/contracts/grpc/ethos/habits/v1"
+	notificationsv1 "github.com/semmidev/ethos-go/contracts/grpc/ethos/notifications/v1"
+	"github.com/semmidev/ethos-go/internal/common/grpcutil"
+)
+
+// Access says who may call a gRPC method
+type Access int
+
+const (
+	// AccessPublic methods need no signed in user: they sign users in, or
+	// the request carries its own credential, such as an emailed token
+	AccessPublic Access = iota + 1
+	// AccessAuthenticated methods need a valid access token
+	AccessAuthenticated
+	// AccessAdmin methods need a signed in admin, or a sibling service
+	// calling on an operator's behalf with its service token
+	AccessAdmin
+)
+
+// String returns the access level's name
+func (a Access) String() string {
+	switch a {
+	case AccessPublic:
+		return "public"
+	case AccessAuthenticated:
+		return "authenticated"
+	case AccessAdmin:
+		return "admin"
+	default:
+		return "unknown"
+	}
+}
+
+// MethodPolicy says who may call a gRPC method and which other
+// interceptors let it through
+type MethodPolicy struct {
+	Access Access
+	// Internal methods are for sibling services only: callers need a
+	// service token, and the HTTP gateway's is refused
+	Internal bool
+	// NonSSOSignIn methods create or recover an account without going
+	// through the identity provider, so they are refused when single
+	// sign-on is required
+	NonSSOSignIn bool
+	// ConsentExempt methods stay open to users who have not accepted the
+	// current legal documents, so they can accept them, or take their data
+	// and leave
+	ConsentExempt bool
+}
+
+// methodPolicies lists the policy of every gRPC method. A method missing
+// here is refused, so a new RPC stays closed until it is given a policy.
+var methodPolicies = map[string]MethodPolicy{
+	// Sign in and account recovery
+	authv1.AuthService_Register_FullMethodName:           {Access: AccessPublic, NonSSOSignIn: true},
+	authv1.AuthService_Login_FullMethodName:              {Access: AccessPublic, NonSSOSignIn: true},
+	authv1.AuthService_GoogleLogin_FullMethodName:        {Access: AccessPublic, NonSSOSignIn: true},
+	authv1.AuthService_GoogleCallback_FullMethodName:     {Access: AccessPublic, NonSSOSignIn: true},
+	authv1.AuthService_SSOLogin_FullMethodName:           {Access: AccessPublic},
+	authv1.AuthService_SSOCallback_FullMethodName:        {Access: AccessPublic},
+	authv1.AuthService_RequestMagicLink_FullMethodName:   {Access: AccessPublic, NonSSOSignIn: true},
+	authv1.AuthService_VerifyMagicLink_FullMethodName:    {Access: AccessPublic, NonSSOSignIn: true},
+	authv1.AuthService_RefreshToken_FullMethodName:       {Access: AccessPublic}, // the refresh token is the credential
+	authv1.AuthService_VerifyEmail_FullMethodName:        {Access: AccessPublic},
+	authv1.AuthService_ResendVerification_FullMethodName: {Access: AccessPublic},
+	authv1.AuthService_ForgotPassword_FullMethodName:     {Access: AccessPublic, NonSSOSignIn: true},
+	authv1.AuthService_ResetPassword_FullMethodName:      {Access: AccessPublic, NonSSOSignIn: true},
+	authv1.AuthService_IntrospectToken_FullMethodName:    {Access: AccessPublic, Internal: true}, // the token is the credential being checked
+
+	// Sessions and account
+	authv1.AuthService_Logout_FullMethodName:              {Access: AccessAuthenticated, ConsentExempt: true},
+	authv1.AuthService_LogoutAll_FullMethodName:           {Access: AccessAuthenticated, ConsentExempt: true},
+	authv1.AuthService_ListSessions_FullMethodName:        {Access: AccessAuthenticated},
+	authv1.AuthService_RevokeSession_FullMethodName:       {Access: AccessAuthenticated},
+	authv1.AuthService_RevokeOtherSessions_FullMethodName: {Access: AccessAuthenticated},
+	authv1.AuthService_GetProfile_FullMethodName:          {Access: AccessAuthenticated, ConsentExempt: true},
+	authv1.AuthService_UpdateProfile_FullMethodName:       {Access: AccessAuthenticated},
+	authv1.AuthService_GetPreferences_FullMethodName:      {Access: AccessAuthenticated},
+	authv1.AuthService_UpdatePreferences_FullMethodName:   {Access: AccessAuthenticated},
+	authv1.AuthService_ListConsents_FullMethodName:        {Access: AccessAuthenticated, ConsentExempt: true},
+	authv1.AuthService_AcceptConsent_FullMethodName:       {Access: AccessAuthenticated, ConsentExempt: true},
+	authv1.AuthService_ChangePassword_FullMethodName:      {Access: AccessAuthenticated},
+	authv1.AuthService_ExportUserData_FullMethodName:      {Access: AccessAuthenticated, ConsentExempt: true},
+	authv1.AuthService_DeleteAccount_FullMethodName:       {Access: AccessAuthenticated, ConsentExempt: true},
+	authv1.AuthService_DeactivateAccount_FullMethodName:   {Access: AccessAuthenticated, ConsentExempt: true},
+
+	// Habits
+	habitsv1.HabitsService_ListHabits_FullMethodName:           {Access: AccessAuthenticated},
+	habitsv1.HabitsService_CreateHabit_FullMethodName:          {Access: AccessAuthenticated},
+	habitsv1.HabitsService_GetHabit_FullMethodName:             {Access: AccessAuthenticated},
+	habitsv1.HabitsService_UpdateHabit_FullMethodName:          {Access: AccessAuthenticated},
+	habitsv1.HabitsService_DeleteHabit_FullMethodName:          {Access: AccessAuthenticated},
+	habitsv1.HabitsService_ActivateHabit_FullMethodName:        {Access: AccessAuthenticated},
+	habitsv1.HabitsService_DeactivateHabit_FullMethodName:      {Access: AccessAuthenticated},
+	habitsv1.HabitsService_GetHabitStats_FullMethodName:        {Access: AccessAuthenticated},
+	habitsv1.HabitsService_GetHabitInsights_FullMethodName:     {Access: AccessAuthenticated},
+	habitsv1.HabitsService_LogHabit_FullMethodName:             {Access: AccessAuthenticated},
+	habitsv1.HabitsService_GetHabitLogs_FullMethodName:         {Access: AccessAuthenticated},
+	habitsv1.HabitsService_UpdateHabitLog_FullMethodName:       {Access: AccessAuthenticated},
+	habitsv1.HabitsService_DeleteHabitLog_FullMethodName:       {Access: AccessAuthenticated},
+	habitsv1.HabitsService_UndoHabitLog_FullMethodName:         {Access: AccessAuthenticated},
+	habitsv1.HabitsService_SetHabitLogCount_FullMethodName:     {Access: AccessAuthenticated},
+	habitsv1.HabitsService_GetDashboard_FullMethodName:         {Access: AccessAuthenticated},
+	habitsv1.HabitsService_GetWeeklyAnalytics_FullMethodName:   {Access: AccessAuthenticated},
+	habitsv1.HabitsService_ComparePeriods_FullMethodName:       {Access: AccessAuthenticated},
+	habitsv1.HabitsService_GetHabitCorrelations_FullMethodName: {Access: AccessAuthenticated},
+	habitsv1.HabitsService_ReorderHabits_FullMethodName:        {Access: AccessAuthenticated},
+	habitsv1.HabitsService_PauseHabit_FullMethodName:           {Access: AccessAuthenticated},
+	habitsv1.HabitsService_StartVacation_FullMethodName:        {Access: AccessAuthenticated},
+	habitsv1.HabitsService_EndVacation_FullMethodName:          {Access: AccessAuthenticated},
+	habitsv1.HabitsService_ListVacations_FullMethodName:        {Access: AccessAuthenticated},
+	habitsv1.HabitsService_PreviewImport_FullMethodName:        {Access: AccessAuthenticated},
+	habitsv1.HabitsService_StartImport_FullMethodName:          {Access: AccessAuthenticated},
+	habitsv1.HabitsService_GetImport_FullMethodName:            {Access: AccessAuthenticated},
+	habitsv1.HabitsService_RecomputeHabitStats_FullMethodName:  {Access: AccessAdmin},
+
+	// Notifications
+	notificationsv1.NotificationsService_CreateNotification_FullMethodName:            {Access: AccessAuthenticated},
+	notificationsv1.NotificationsService_ListNotifications_FullMethodName:             {Access: AccessAuthenticated},
+	notificationsv1.NotificationsService_GetUnreadCount_FullMethodName:                {Access: AccessAuthenticated},
+	notificationsv1.NotificationsService_MarkAsRead_FullMethodName:                    {Access: AccessAuthenticated},
+	notificationsv1.NotificationsService_MarkAllAsRead_FullMethodName:                 {Access: AccessAuthenticated},
+	notificationsv1.NotificationsService_SnoozeNotification_FullMethodName:            {Access: AccessAuthenticated},
+	notificationsv1.NotificationsService_DeleteNotification_FullMethodName:            {Access: AccessAuthenticated},
+	notificationsv1.NotificationsService_BatchMarkAsRead_FullMethodName:               {Access: AccessAuthenticated},
+	notificationsv1.NotificationsService_BatchDeleteNotifications_FullMethodName:      {Access: AccessAuthenticated},
+	notificationsv1.NotificationsService_GetNotificationPreferences_FullMethodName:    {Access: AccessAuthenticated},
+	notificationsv1.NotificationsService_UpdateNotificationPreferences_FullMethodName: {Access: AccessAuthenticated},
+	notificationsv1.NotificationsService_Unsubscribe_FullMethodName:                   {Access: AccessPublic}, // the email link token is the credential
+}
+
+// PolicyFor returns the policy of the full gRPC method name, and false
+// when the method has none
+func PolicyFor(fullMethod string) (MethodPolicy, bool) {
+	policy, ok := methodPolicies[fullMethod]
+	return policy, ok
+}
+
+// calledBySiblingService reports whether the call came from another
+// service rather than through the public HTTP gateway
+func calledBySiblingService(ctx context.Context) bool {
+	service, ok := grpcutil.ServiceFromContext(ctx)
+	return ok && service != grpcutil.ServiceGateway
+}
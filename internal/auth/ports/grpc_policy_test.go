@@ -0,0 +1,314 @@
+package ports_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/google/uuid"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	authv1 "github.com/semmidev/ethos-go/contracts/grpc/ethos/auth/v1"
+	habitsv1 "github.com/semmidev/ethos-go/contracts/grpc/ethos/habits/v1"
+	notificationsv1 "github.com/semmidev/ethos-go/contracts/grpc/ethos/notifications/v1"
+	"github.com/semmidev/ethos-go/internal/auth/domain/user"
+	authctx "github.com/semmidev/ethos-go/internal/auth/infrastructure/context"
+	"github.com/semmidev/ethos-go/internal/auth/infrastructure/token"
+	"github.com/semmidev/ethos-go/internal/auth/ports"
+	"github.com/semmidev/ethos-go/internal/common/grpcutil"
+)
+
+// TestMethodAccessCoversEveryRPC fails when an RPC is added without
+// deciding who may call it
+func TestMethodAccessCoversEveryRPC(t *testing.T) {
+	t.Parallel()
+
+	services := []grpc.ServiceDesc{
+		authv1.AuthService_ServiceDesc,
+		habitsv1.HabitsService_ServiceDesc,
+		notificationsv1.NotificationsService_ServiceDesc,
+	}
+
+	Convey("Given every RPC the gRPC server registers", t, func() {
+		var methods []string
+		for _, service := range services {
+			for _, method := range service.Methods {
+				methods = append(methods, "/"+service.ServiceName+"/"+method.MethodName)
+			}
+			for _, stream := range service.Streams {
+				methods = append(methods, "/"+service.ServiceName+"/"+stream.StreamName)
+			}
+		}
+		So(methods, ShouldNotBeEmpty)
+
+		Convey("Then each has an access policy", func() {
+			for _, method := range methods {
+				_, ok := ports.PolicyFor(method)
+				So(ok, ShouldBeTrue)
+				if !ok {
+					t.Errorf("%s has no access policy in grpc_policy.go", method)
+				}
+			}
+		})
+
+		Convey("Then each policy's flags fit its access level", func() {
+			for _, method := range methods {
+				policy, _ := ports.PolicyFor(method)
+				if policy.NonSSOSignIn {
+					// Signing in is what a public method is for
+					So(policy.Access, ShouldEqual, ports.AccessPublic)
+				}
+				if policy.ConsentExempt {
+					// Consent is only checked for signed in users
+					So(policy.Access, ShouldEqual, ports.AccessAuthenticated)
+				}
+				if policy.Internal {
+					// Only sibling services reach an internal method, and
+					// they pass an admin check without a user
+					So(policy.Access, ShouldNotEqual, ports.AccessAdmin)
+				}
+			}
+		})
+	})
+}
+
+func TestPolicyInterceptors(t *testing.T) {
+	t.Parallel()
+
+	ok := func(context.Context, interface{}) (interface{}, error) { return "ok", nil }
+	call := func(interceptor grpc.UnaryServerInterceptor, ctx context.Context, method string) error {
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, ok)
+		return err
+	}
+
+	Convey("Given a deployment that requires single sign-on", t, func() {
+		interceptor := ports.UnarySSOOnlyInterceptor(true)
+
+		Convey("Password and magic link sign in are refused", func() {
+			So(call(interceptor, context.Background(), authv1.AuthService_Login_FullMethodName), ShouldNotBeNil)
+			So(call(interceptor, context.Background(), authv1.AuthService_RequestMagicLink_FullMethodName), ShouldNotBeNil)
+		})
+
+		Convey("Single sign-on and refreshing a session still work", func() {
+			So(call(interceptor, context.Background(), authv1.AuthService_SSOLogin_FullMethodName), ShouldBeNil)
+			So(call(interceptor, context.Background(), authv1.AuthService_RefreshToken_FullMethodName), ShouldBeNil)
+		})
+	})
+
+	Convey("Given a user who has not accepted the current legal documents", t, func() {
+		interceptor := ports.UnaryConsentInterceptor(consentPending{})
+		ctx := authctx.ContextWithUser(context.Background(), authctx.User{UserID: uuid.NewString()})
+
+		Convey("Accepting them and leaving stay open", func() {
+			So(call(interceptor, ctx, authv1.AuthService_AcceptConsent_FullMethodName), ShouldBeNil)
+			So(call(interceptor, ctx, authv1.AuthService_DeleteAccount_FullMethodName), ShouldBeNil)
+		})
+
+		Convey("Everything else is refused", func() {
+			So(call(interceptor, ctx, habitsv1.HabitsService_ListHabits_FullMethodName), ShouldNotBeNil)
+		})
+	})
+}
+
+// consentPending fails every consent check
+type consentPending struct{}
+
+func (consentPending) CheckConsents(context.Context, string) error {
+	return errors.New("consent required")
+}
+
+// tokenUsers resolves the access tokens "member" and "admin" to a user
+// holding that role
+type tokenUsers struct{}
+
+func (tokenUsers) ValidateToken(_ context.Context, tokenString string) (*token.Payload, error) {
+	if !user.IsRole(tokenString) {
+		return nil, errors.New("invalid token")
+	}
+	return &token.Payload{UserID: uuid.NewSHA1(uuid.Nil, []byte(tokenString)), SessionID: uuid.New()}, nil
+}
+
+func (tokenUsers) GetUserByID(_ context.Context, userID string) (authctx.User, error) {
+	role := user.RoleMember
+	if userID == uuid.NewSHA1(uuid.Nil, []byte(user.RoleAdmin)).String() {
+		role = user.RoleAdmin
+	}
+	return authctx.User{UserID: userID, Role: role}, nil
+}
+
+// policyHabitsServer answers the RPCs the policy tests call
+type policyHabitsServer struct {
+	habitsv1.UnimplementedHabitsServiceServer
+}
+
+func (policyHabitsServer) ListHabits(context.Context, *habitsv1.ListHabitsRequest) (*habitsv1.ListHabitsResponse, error) {
+	return &habitsv1.ListHabitsResponse{}, nil
+}
+
+func (policyHabitsServer) RecomputeHabitStats(context.Context, *habitsv1.RecomputeHabitStatsRequest) (*habitsv1.RecomputeHabitStatsResponse, error) {
+	return &habitsv1.RecomputeHabitStatsResponse{Success: true}, nil
+}
+
+func TestUnaryAuthInterceptorPolicy(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given the auth interceptor", t, func() {
+		interceptor := ports.UnaryAuthInterceptor(tokenUsers{})
+		ok := func(context.Context, interface{}) (interface{}, error) { return "ok", nil }
+
+		Convey("A method without a policy is refused", func() {
+			info := &grpc.UnaryServerInfo{FullMethod: "/ethos.habits.v1.HabitsService/Unlisted"}
+			_, err := interceptor(context.Background(), nil, info, ok)
+			So(status.Code(err), ShouldEqual, codes.PermissionDenied)
+		})
+
+		Convey("A public method is called without a token", func() {
+			info := &grpc.UnaryServerInfo{FullMethod: authv1.AuthService_Login_FullMethodName}
+			resp, err := interceptor(context.Background(), nil, info, ok)
+			So(err, ShouldBeNil)
+			So(resp, ShouldEqual, "ok")
+		})
+	})
+
+	Convey("Given a gRPC server behind service and user authentication", t, func() {
+		serviceAuth, err := grpcutil.NewServiceAuth("")
+		So(err, ShouldBeNil)
+
+		listener := bufconn.Listen(1 << 20)
+		server := grpc.NewServer(grpc.ChainUnaryInterceptor(
+			serviceAuth.UnaryServerInterceptor(ports.ServicePolicy),
+			ports.UnaryAuthInterceptor(tokenUsers{}),
+		))
+		habitsv1.RegisterHabitsServiceServer(server, policyHabitsServer{})
+		go server.Serve(listener)
+		Reset(server.Stop)
+
+		// client dials as service, or without a service token when empty
+		client := func(service string) habitsv1.HabitsServiceClient {
+			opts := []grpc.DialOption{
+				grpc.WithTransportCredentials(insecure.NewCredentials()),
+				grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+					return listener.DialContext(ctx)
+				}),
+			}
+			if service != "" {
+				opts = append(opts, serviceAuth.DialOption(service))
+			}
+			conn, err := grpc.NewClient("passthrough:///bufnet", opts...)
+			So(err, ShouldBeNil)
+			Reset(func() { conn.Close() })
+			return habitsv1.NewHabitsServiceClient(conn)
+		}
+		as := func(accessToken string) context.Context {
+			return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+accessToken)
+		}
+		gateway := client(grpcutil.ServiceGateway)
+
+		Convey("An authenticated method needs a valid access token", func() {
+			_, err := gateway.ListHabits(context.Background(), &habitsv1.ListHabitsRequest{})
+			So(status.Code(err), ShouldEqual, codes.Unauthenticated)
+
+			_, err = gateway.ListHabits(as("forged"), &habitsv1.ListHabitsRequest{})
+			So(status.Code(err), ShouldEqual, codes.Unauthenticated)
+
+			_, err = gateway.ListHabits(as(user.RoleMember), &habitsv1.ListHabitsRequest{})
+			So(err, ShouldBeNil)
+		})
+
+		Convey("An admin method through the gateway needs an admin", func() {
+			_, err := gateway.RecomputeHabitStats(context.Background(), &habitsv1.RecomputeHabitStatsRequest{})
+			So(status.Code(err), ShouldEqual, codes.Unauthenticated)
+
+			_, err = gateway.RecomputeHabitStats(as(user.RoleMember), &habitsv1.RecomputeHabitStatsRequest{})
+			So(status.Code(err), ShouldEqual, codes.PermissionDenied)
+
+			_, err = gateway.RecomputeHabitStats(as(user.RoleAdmin), &habitsv1.RecomputeHabitStatsRequest{})
+			So(err, ShouldBeNil)
+		})
+
+		Convey("An admin method called directly needs an admin", func() {
+			direct := client("")
+			_, err := direct.RecomputeHabitStats(as(user.RoleMember), &habitsv1.RecomputeHabitStatsRequest{})
+			So(status.Code(err), ShouldEqual, codes.PermissionDenied)
+
+			_, err = direct.RecomputeHabitStats(as(user.RoleAdmin), &habitsv1.RecomputeHabitStatsRequest{})
+			So(err, ShouldBeNil)
+		})
+
+		Convey("An admin method called by a sibling service needs no user", func() {
+			_, err := client(grpcutil.ServiceWorker).RecomputeHabitStats(context.Background(), &habitsv1.RecomputeHabitStatsRequest{})
+			So(err, ShouldBeNil)
+		})
+	})
+}
+
+// policyAuthServer answers the RPCs the service policy test calls
+type policyAuthServer struct {
+	authv1.UnimplementedAuthServiceServer
+}
+
+func (policyAuthServer) Login(context.Context, *authv1.LoginRequest) (*authv1.LoginResponse, error) {
+	return &authv1.LoginResponse{}, nil
+}
+
+func (policyAuthServer) IntrospectToken(context.Context, *authv1.IntrospectTokenRequest) (*authv1.IntrospectTokenResponse, error) {
+	return &authv1.IntrospectTokenResponse{}, nil
+}
+
+func TestServicePolicy(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given the gRPC port with the service policy in front of user authentication", t, func() {
+		serviceAuth, err := grpcutil.NewServiceAuth("")
+		So(err, ShouldBeNil)
+
+		listener := bufconn.Listen(1 << 20)
+		server := grpc.NewServer(grpc.ChainUnaryInterceptor(
+			serviceAuth.UnaryServerInterceptor(ports.ServicePolicy),
+			ports.UnaryAuthInterceptor(tokenUsers{}),
+		))
+		authv1.RegisterAuthServiceServer(server, policyAuthServer{})
+		go server.Serve(listener)
+		Reset(server.Stop)
+
+		client := func(opts ...grpc.DialOption) authv1.AuthServiceClient {
+			opts = append(opts,
+				grpc.WithTransportCredentials(insecure.NewCredentials()),
+				grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+					return listener.DialContext(ctx)
+				}),
+			)
+			conn, err := grpc.NewClient("passthrough:///bufnet", opts...)
+			So(err, ShouldBeNil)
+			Reset(func() { conn.Close() })
+			return authv1.NewAuthServiceClient(conn)
+		}
+
+		Convey("A direct client without a service token calls a public RPC", func() {
+			_, err := client().Login(context.Background(), &authv1.LoginRequest{})
+			So(err, ShouldBeNil)
+		})
+
+		Convey("A direct client without a service token cannot call an internal RPC", func() {
+			_, err := client().IntrospectToken(context.Background(), &authv1.IntrospectTokenRequest{})
+			So(status.Code(err), ShouldEqual, codes.Unauthenticated)
+		})
+
+		Convey("The gateway cannot call an internal RPC", func() {
+			_, err := client(serviceAuth.DialOption(grpcutil.ServiceGateway)).IntrospectToken(context.Background(), &authv1.IntrospectTokenRequest{})
+			So(status.Code(err), ShouldEqual, codes.PermissionDenied)
+		})
+
+		Convey("A sibling service calls an internal RPC", func() {
+			_, err := client(serviceAuth.DialOption(grpcutil.ServiceWorker)).IntrospectToken(context.Background(), &authv1.IntrospectTokenRequest{})
+			So(err, ShouldBeNil)
+		})
+	})
+}
@@ -11,13 +11,14 @@ import (
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	authv1 "github.com/semmidev/ethos-go/contracts/grpc/ethos/auth/v1"
+	commonv1 "github.com/semmidev/ethos-go/contracts/grpc/ethos/common/v1"
 	"github.com/semmidev/ethos-go/internal/auth/app/command"
 	"github.com/semmidev/ethos-go/internal/auth/app/query"
 	authctx "github.com/semmidev/ethos-go/internal/auth/infrastructure/context"
 	"github.com/semmidev/ethos-go/internal/common/grpcutil"
+	"github.com/semmidev/ethos-go/internal/common/i18n"
 	"github.com/semmidev/ethos-go/internal/common/model"
-	authv1 "github.com/semmidev/ethos-go/internal/generated/grpc/ethos/auth/v1"
-	commonv1 "github.com/semmidev/ethos-go/internal/generated/grpc/ethos/common/v1"
 )
 
 const (
@@ -69,11 +70,16 @@ type AuthGRPCServer struct {
 	authv1.UnimplementedAuthServiceServer
 	registerHandler           command.RegisterHandler
 	loginHandler              command.LoginHandler
+	refreshTokenHandler       command.RefreshTokenHandler
 	logoutHandler             command.LogoutHandler
 	logoutAllHandler          command.LogoutAllHandler
 	listSessionsHandler       query.ListSessionsHandler
 	getProfileHandler         query.GetProfileHandler
 	updateProfileHandler      command.UpdateProfileHandler
+	getPreferencesHandler     query.GetPreferencesHandler
+	updatePreferencesHandler  command.UpdatePreferencesHandler
+	listConsentsHandler       query.ListConsentsHandler
+	acceptConsentHandler      command.AcceptConsentHandler
 	changePasswordHandler     command.ChangePasswordHandler
 	verifyEmailHandler        command.VerifyEmailHandler
 	resendVerificationHandler command.ResendVerificationHandler
@@ -81,20 +87,32 @@ type AuthGRPCServer struct {
 	resetPasswordHandler      command.ResetPasswordHandler
 	loginGoogleHandler        command.LoginGoogleHandler
 	getGoogleAuthURLHandler   query.GetGoogleAuthURLHandler
+	loginSSOHandler           command.LoginSSOHandler
+	getSSOAuthURLHandler      query.GetSSOAuthURLHandler
+	requestMagicLinkHandler   command.RequestMagicLinkHandler
+	verifyMagicLinkHandler    command.VerifyMagicLinkHandler
 	revokeSessionsHandler     command.RevokeAllOtherSessionsHandler
+	revokeSessionHandler      command.RevokeSessionHandler
 	deleteAccountHandler      command.DeleteAccountHandler
+	deactivateAccountHandler  command.DeactivateAccountHandler
 	exportDataHandler         query.ExportUserDataHandler
+	introspectTokenHandler    query.IntrospectTokenHandler
 }
 
 // NewAuthGRPCServer creates a new AuthGRPCServer.
 func NewAuthGRPCServer(
 	registerHandler command.RegisterHandler,
 	loginHandler command.LoginHandler,
+	refreshTokenHandler command.RefreshTokenHandler,
 	logoutHandler command.LogoutHandler,
 	logoutAllHandler command.LogoutAllHandler,
 	listSessionsHandler query.ListSessionsHandler,
 	getProfileHandler query.GetProfileHandler,
 	updateProfileHandler command.UpdateProfileHandler,
+	getPreferencesHandler query.GetPreferencesHandler,
+	updatePreferencesHandler command.UpdatePreferencesHandler,
+	listConsentsHandler query.ListConsentsHandler,
+	acceptConsentHandler command.AcceptConsentHandler,
 	changePasswordHandler command.ChangePasswordHandler,
 	verifyEmailHandler command.VerifyEmailHandler,
 	resendVerificationHandler command.ResendVerificationHandler,
@@ -102,18 +120,30 @@ func NewAuthGRPCServer(
 	resetPasswordHandler command.ResetPasswordHandler,
 	loginGoogleHandler command.LoginGoogleHandler,
 	getGoogleAuthURLHandler query.GetGoogleAuthURLHandler,
+	loginSSOHandler command.LoginSSOHandler,
+	getSSOAuthURLHandler query.GetSSOAuthURLHandler,
+	requestMagicLinkHandler command.RequestMagicLinkHandler,
+	verifyMagicLinkHandler command.VerifyMagicLinkHandler,
 	revokeSessionsHandler command.RevokeAllOtherSessionsHandler,
+	revokeSessionHandler command.RevokeSessionHandler,
 	deleteAccountHandler command.DeleteAccountHandler,
+	deactivateAccountHandler command.DeactivateAccountHandler,
 	exportDataHandler query.ExportUserDataHandler,
+	introspectTokenHandler query.IntrospectTokenHandler,
 ) *AuthGRPCServer {
 	return &AuthGRPCServer{
 		registerHandler:           registerHandler,
 		loginHandler:              loginHandler,
+		refreshTokenHandler:       refreshTokenHandler,
 		logoutHandler:             logoutHandler,
 		logoutAllHandler:          logoutAllHandler,
 		listSessionsHandler:       listSessionsHandler,
 		getProfileHandler:         getProfileHandler,
 		updateProfileHandler:      updateProfileHandler,
+		getPreferencesHandler:     getPreferencesHandler,
+		updatePreferencesHandler:  updatePreferencesHandler,
+		listConsentsHandler:       listConsentsHandler,
+		acceptConsentHandler:      acceptConsentHandler,
 		changePasswordHandler:     changePasswordHandler,
 		verifyEmailHandler:        verifyEmailHandler,
 		resendVerificationHandler: resendVerificationHandler,
@@ -121,9 +151,16 @@ func NewAuthGRPCServer(
 		resetPasswordHandler:      resetPasswordHandler,
 		loginGoogleHandler:        loginGoogleHandler,
 		getGoogleAuthURLHandler:   getGoogleAuthURLHandler,
+		loginSSOHandler:           loginSSOHandler,
+		getSSOAuthURLHandler:      getSSOAuthURLHandler,
+		requestMagicLinkHandler:   requestMagicLinkHandler,
+		verifyMagicLinkHandler:    verifyMagicLinkHandler,
 		revokeSessionsHandler:     revokeSessionsHandler,
+		revokeSessionHandler:      revokeSessionHandler,
 		deleteAccountHandler:      deleteAccountHandler,
+		deactivateAccountHandler:  deactivateAccountHandler,
 		exportDataHandler:         exportDataHandler,
+		introspectTokenHandler:    introspectTokenHandler,
 	}
 }
 
@@ -133,11 +170,12 @@ func (s *AuthGRPCServer) Register(ctx context.Context, req *authv1.RegisterReque
 		Name:     req.Name,
 		Email:    req.Email,
 		Password: req.Password,
+		Locale:   i18n.FromContext(ctx),
 	}
 
 	result, err := s.registerHandler.Handle(ctx, cmd)
 	if err != nil {
-		return nil, toGRPCError(err)
+		return nil, toGRPCError(ctx, err)
 	}
 
 	return &authv1.RegisterResponse{
@@ -155,15 +193,16 @@ func (s *AuthGRPCServer) Register(ctx context.Context, req *authv1.RegisterReque
 func (s *AuthGRPCServer) Login(ctx context.Context, req *authv1.LoginRequest) (*authv1.LoginResponse, error) {
 	mtdt := extractClientMetadata(ctx)
 	cmd := command.LoginCommand{
-		Email:     req.Email,
-		Password:  req.Password,
-		UserAgent: mtdt.UserAgent,
-		ClientIP:  mtdt.ClientIP,
+		Email:      req.Email,
+		Password:   req.Password,
+		UserAgent:  mtdt.UserAgent,
+		ClientIP:   mtdt.ClientIP,
+		Reactivate: req.Reactivate,
 	}
 
 	result, err := s.loginHandler.Handle(ctx, cmd)
 	if err != nil {
-		return nil, toGRPCError(err)
+		return nil, toGRPCError(ctx, err)
 	}
 
 	return &authv1.LoginResponse{
@@ -184,7 +223,7 @@ func (s *AuthGRPCServer) GoogleLogin(ctx context.Context, req *authv1.GoogleLogi
 
 	url, err := s.getGoogleAuthURLHandler.Handle(ctx, query.GetGoogleAuthURLQuery{State: state})
 	if err != nil {
-		return nil, toGRPCError(err)
+		return nil, toGRPCError(ctx, err)
 	}
 
 	return &authv1.GoogleLoginResponse{
@@ -199,14 +238,130 @@ func (s *AuthGRPCServer) GoogleLogin(ctx context.Context, req *authv1.GoogleLogi
 func (s *AuthGRPCServer) GoogleCallback(ctx context.Context, req *authv1.GoogleCallbackRequest) (*authv1.LoginResponse, error) {
 	mtdt := extractClientMetadata(ctx)
 	cmd := command.LoginGoogleCommand{
-		Code:      req.Code,
-		UserAgent: mtdt.UserAgent,
-		ClientIP:  mtdt.ClientIP,
+		Code:       req.Code,
+		UserAgent:  mtdt.UserAgent,
+		ClientIP:   mtdt.ClientIP,
+		Locale:     i18n.FromContext(ctx),
+		Reactivate: req.Reactivate,
 	}
 
 	result, err := s.loginGoogleHandler.Handle(ctx, cmd)
 	if err != nil {
-		return nil, toGRPCError(err)
+		return nil, toGRPCError(ctx, err)
+	}
+
+	return &authv1.LoginResponse{
+		Success: true,
+		Data: &authv1.LoginData{
+			AccessToken:  result.AccessToken,
+			RefreshToken: result.RefreshToken,
+			SessionId:    result.SessionID,
+			UserId:       result.UserID,
+			ExpiresAt:    result.ExpiresAt,
+		},
+	}, nil
+}
+
+// SSOLogin returns the identity provider login URL.
+func (s *AuthGRPCServer) SSOLogin(ctx context.Context, req *authv1.SSOLoginRequest) (*authv1.SSOLoginResponse, error) {
+	result, err := s.getSSOAuthURLHandler.Handle(ctx, query.GetSSOAuthURLQuery{})
+	if err != nil {
+		return nil, toGRPCError(ctx, err)
+	}
+
+	return &authv1.SSOLoginResponse{
+		Success: true,
+		Data: &authv1.SSOLoginData{
+			Url:   result.URL,
+			State: result.State,
+		},
+	}, nil
+}
+
+// SSOCallback handles the identity provider callback.
+func (s *AuthGRPCServer) SSOCallback(ctx context.Context, req *authv1.SSOCallbackRequest) (*authv1.LoginResponse, error) {
+	mtdt := extractClientMetadata(ctx)
+	cmd := command.LoginSSOCommand{
+		Code:       req.Code,
+		State:      req.State,
+		UserAgent:  mtdt.UserAgent,
+		ClientIP:   mtdt.ClientIP,
+		Locale:     i18n.FromContext(ctx),
+		Reactivate: req.Reactivate,
+	}
+
+	result, err := s.loginSSOHandler.Handle(ctx, cmd)
+	if err != nil {
+		return nil, toGRPCError(ctx, err)
+	}
+
+	return &authv1.LoginResponse{
+		Success: true,
+		Data: &authv1.LoginData{
+			AccessToken:  result.AccessToken,
+			RefreshToken: result.RefreshToken,
+			SessionId:    result.SessionID,
+			UserId:       result.UserID,
+			ExpiresAt:    result.ExpiresAt,
+		},
+	}, nil
+}
+
+// RequestMagicLink emails a single-use login link.
+func (s *AuthGRPCServer) RequestMagicLink(ctx context.Context, req *authv1.RequestMagicLinkRequest) (*authv1.SuccessResponse, error) {
+	cmd := command.RequestMagicLinkCommand{
+		Email: req.Email,
+	}
+
+	if err := s.requestMagicLinkHandler.Handle(ctx, cmd); err != nil {
+		return nil, toGRPCError(ctx, err)
+	}
+
+	return &authv1.SuccessResponse{
+		Success: true,
+		Message: "Login link sent",
+	}, nil
+}
+
+// VerifyMagicLink signs in with the token from a login link.
+func (s *AuthGRPCServer) VerifyMagicLink(ctx context.Context, req *authv1.VerifyMagicLinkRequest) (*authv1.LoginResponse, error) {
+	mtdt := extractClientMetadata(ctx)
+	cmd := command.VerifyMagicLinkCommand{
+		Token:      req.Token,
+		UserAgent:  mtdt.UserAgent,
+		ClientIP:   mtdt.ClientIP,
+		Reactivate: req.Reactivate,
+	}
+
+	result, err := s.verifyMagicLinkHandler.Handle(ctx, cmd)
+	if err != nil {
+		return nil, toGRPCError(ctx, err)
+	}
+
+	return &authv1.LoginResponse{
+		Success: true,
+		Data: &authv1.LoginData{
+			AccessToken:  result.AccessToken,
+			RefreshToken: result.RefreshToken,
+			SessionId:    result.SessionID,
+			UserId:       result.UserID,
+			ExpiresAt:    result.ExpiresAt,
+		},
+	}, nil
+}
+
+// RefreshToken rotates the refresh token and issues a new access token.
+func (s *AuthGRPCServer) RefreshToken(ctx context.Context, req *authv1.RefreshTokenRequest) (*authv1.LoginResponse, error) {
+	mtdt := extractClientMetadata(ctx)
+	cmd := command.RefreshTokenCommand{
+		RefreshToken: req.RefreshToken,
+		UserAgent:    mtdt.UserAgent,
+		ClientIP:     mtdt.ClientIP,
+	}
+
+	result, err := s.refreshTokenHandler.Handle(ctx, cmd)
+	if err != nil {
+		return nil, toGRPCError(ctx, err)
 	}
 
 	return &authv1.LoginResponse{
@@ -223,12 +378,21 @@ func (s *AuthGRPCServer) GoogleCallback(ctx context.Context, req *authv1.GoogleC
 
 // Logout terminates the specified session.
 func (s *AuthGRPCServer) Logout(ctx context.Context, req *authv1.LogoutRequest) (*authv1.LogoutResponse, error) {
+	user, err := authctx.UserFromCtx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+	if req.SessionId != "" && req.SessionId != user.SessionID {
+		return nil, status.Error(codes.PermissionDenied, "session_id does not match the authenticated session")
+	}
+
 	cmd := command.LogoutCommand{
-		SessionID: req.SessionId,
+		SessionID: user.SessionID,
+		UserID:    user.UserID,
 	}
 
 	if err := s.logoutHandler.Handle(ctx, cmd); err != nil {
-		return nil, toGRPCError(err)
+		return nil, toGRPCError(ctx, err)
 	}
 
 	return &authv1.LogoutResponse{
@@ -237,14 +401,22 @@ func (s *AuthGRPCServer) Logout(ctx context.Context, req *authv1.LogoutRequest)
 	}, nil
 }
 
-// LogoutAll terminates all sessions for a user.
+// LogoutAll terminates all sessions of the authenticated user.
 func (s *AuthGRPCServer) LogoutAll(ctx context.Context, req *authv1.LogoutAllRequest) (*authv1.LogoutResponse, error) {
+	user, err := authctx.UserFromCtx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+	if req.UserId != "" && req.UserId != user.UserID {
+		return nil, status.Error(codes.PermissionDenied, "user_id does not match the authenticated user")
+	}
+
 	cmd := command.LogoutAllCommand{
-		UserID: req.UserId,
+		UserID: user.UserID,
 	}
 
 	if err := s.logoutAllHandler.Handle(ctx, cmd); err != nil {
-		return nil, toGRPCError(err)
+		return nil, toGRPCError(ctx, err)
 	}
 
 	return &authv1.LogoutResponse{
@@ -280,7 +452,7 @@ func (s *AuthGRPCServer) ListSessions(ctx context.Context, req *authv1.ListSessi
 
 	result, err := s.listSessionsHandler.Handle(ctx, q)
 	if err != nil {
-		return nil, toGRPCError(err)
+		return nil, toGRPCError(ctx, err)
 	}
 
 	sessions := make([]*authv1.Session, 0, len(result.Sessions))
@@ -294,6 +466,8 @@ func (s *AuthGRPCServer) ListSessions(ctx context.Context, req *authv1.ListSessi
 			CreatedAt: timestamppb.New(sess.CreatedAt),
 			IsActive:  sess.IsActive,
 			IsCurrent: sess.IsCurrent,
+			City:      sess.City,
+			Country:   sess.Country,
 		})
 	}
 
@@ -317,6 +491,28 @@ func (s *AuthGRPCServer) ListSessions(ctx context.Context, req *authv1.ListSessi
 	}, nil
 }
 
+// RevokeSession blocks one of the authenticated user's sessions.
+func (s *AuthGRPCServer) RevokeSession(ctx context.Context, req *authv1.RevokeSessionRequest) (*authv1.RevokeSessionResponse, error) {
+	user, err := authctx.UserFromCtx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	cmd := command.RevokeSessionCommand{
+		SessionID: req.SessionId,
+		UserID:    user.UserID,
+	}
+
+	if err := s.revokeSessionHandler.Handle(ctx, cmd); err != nil {
+		return nil, toGRPCError(ctx, err)
+	}
+
+	return &authv1.RevokeSessionResponse{
+		Success: true,
+		Message: "Session revoked successfully",
+	}, nil
+}
+
 // RevokeOtherSessions revokes all sessions except the current one.
 func (s *AuthGRPCServer) RevokeOtherSessions(ctx context.Context, req *authv1.RevokeOtherSessionsRequest) (*authv1.RevokeOtherSessionsResponse, error) {
 	user, err := authctx.UserFromCtx(ctx)
@@ -333,7 +529,7 @@ func (s *AuthGRPCServer) RevokeOtherSessions(ctx context.Context, req *authv1.Re
 
 	result, err := s.revokeSessionsHandler.Handle(ctx, cmd)
 	if err != nil {
-		return nil, toGRPCError(err)
+		return nil, toGRPCError(ctx, err)
 	}
 
 	return &authv1.RevokeOtherSessionsResponse{
@@ -354,18 +550,23 @@ func (s *AuthGRPCServer) GetProfile(ctx context.Context, req *authv1.GetProfileR
 		UserID: user.UserID,
 	})
 	if err != nil {
-		return nil, toGRPCError(err)
+		return nil, toGRPCError(ctx, err)
 	}
 
 	return &authv1.ProfileResponse{
 		Success: true,
 		Message: "Profile retrieved successfully",
 		Data: &authv1.ProfileData{
-			UserId:    result.UserID,
-			Name:      result.Name,
-			Email:     result.Email,
-			Timezone:  result.Timezone,
-			CreatedAt: timestamppb.New(result.CreatedAt),
+			UserId:      result.UserID,
+			Name:        result.Name,
+			Email:       result.Email,
+			Timezone:    result.Timezone,
+			CreatedAt:   timestamppb.New(result.CreatedAt),
+			LogLockDays: toProtoOptionalInt(result.LogLockDays),
+			Locale:      result.Locale,
+			WeekStart:   result.WeekStart,
+			AvatarUrl:   result.AvatarURL,
+			Role:        result.Role,
 		},
 	}, nil
 }
@@ -378,30 +579,167 @@ func (s *AuthGRPCServer) UpdateProfile(ctx context.Context, req *authv1.UpdatePr
 	}
 
 	cmd := command.UpdateProfileCommand{
-		UserID:   user.UserID,
-		Name:     req.Name,
-		Email:    req.Email,
-		Timezone: req.Timezone,
+		UserID:    user.UserID,
+		Name:      req.Name,
+		Email:     req.Email,
+		Timezone:  req.Timezone,
+		Locale:    req.Locale,
+		WeekStart: req.WeekStart,
+	}
+	if req.LogLockDays != nil {
+		days := int(*req.LogLockDays)
+		cmd.LogLockDays = &days
 	}
 
 	result, err := s.updateProfileHandler.Handle(ctx, cmd)
 	if err != nil {
-		return nil, toGRPCError(err)
+		return nil, toGRPCError(ctx, err)
 	}
 
 	return &authv1.ProfileResponse{
 		Success: true,
 		Message: "Profile updated successfully",
 		Data: &authv1.ProfileData{
-			UserId:    result.UserID,
-			Name:      result.Name,
-			Email:     result.Email,
-			Timezone:  result.Timezone,
-			CreatedAt: timestamppb.New(result.CreatedAt),
+			UserId:      result.UserID,
+			Name:        result.Name,
+			Email:       result.Email,
+			Timezone:    result.Timezone,
+			CreatedAt:   timestamppb.New(result.CreatedAt),
+			LogLockDays: toProtoOptionalInt(result.LogLockDays),
+			Locale:      result.Locale,
+			WeekStart:   result.WeekStart,
+			AvatarUrl:   result.AvatarURL,
+			Role:        result.Role,
+		},
+	}, nil
+}
+
+// GetPreferences retrieves the current user's preferences.
+func (s *AuthGRPCServer) GetPreferences(ctx context.Context, req *authv1.GetPreferencesRequest) (*authv1.PreferencesResponse, error) {
+	user, err := authctx.UserFromCtx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	result, err := s.getPreferencesHandler.Handle(ctx, query.GetPreferencesQuery{
+		UserID: user.UserID,
+	})
+	if err != nil {
+		return nil, toGRPCError(ctx, err)
+	}
+
+	return &authv1.PreferencesResponse{
+		Success: true,
+		Message: "Preferences retrieved successfully",
+		Data: &authv1.UserPreferences{
+			WeekStart:           result.WeekStart,
+			Locale:              result.Locale,
+			Theme:               result.Theme,
+			DefaultReminderHour: toProtoOptionalInt(result.DefaultReminderHour),
 		},
 	}, nil
 }
 
+// UpdatePreferences changes the given preferences of the current user.
+func (s *AuthGRPCServer) UpdatePreferences(ctx context.Context, req *authv1.UpdatePreferencesRequest) (*authv1.PreferencesResponse, error) {
+	user, err := authctx.UserFromCtx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	cmd := command.UpdatePreferencesCommand{
+		UserID:                   user.UserID,
+		WeekStart:                req.WeekStart,
+		Locale:                   req.Locale,
+		Theme:                    req.Theme,
+		ClearDefaultReminderHour: req.ClearDefaultReminderHour,
+	}
+	if req.DefaultReminderHour != nil {
+		hour := int(*req.DefaultReminderHour)
+		cmd.DefaultReminderHour = &hour
+	}
+
+	result, err := s.updatePreferencesHandler.Handle(ctx, cmd)
+	if err != nil {
+		return nil, toGRPCError(ctx, err)
+	}
+
+	return &authv1.PreferencesResponse{
+		Success: true,
+		Message: "Preferences updated successfully",
+		Data: &authv1.UserPreferences{
+			WeekStart:           result.WeekStart,
+			Locale:              result.Locale,
+			Theme:               result.Theme,
+			DefaultReminderHour: toProtoOptionalInt(result.DefaultReminderHour),
+		},
+	}, nil
+}
+
+// ListConsents lists the legal documents and the current user's consents.
+func (s *AuthGRPCServer) ListConsents(ctx context.Context, req *authv1.ListConsentsRequest) (*authv1.ConsentsResponse, error) {
+	user, err := authctx.UserFromCtx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	return s.consentsResponse(ctx, user.UserID, "Consents retrieved successfully")
+}
+
+// AcceptConsent records the current user's acceptance of a document version.
+func (s *AuthGRPCServer) AcceptConsent(ctx context.Context, req *authv1.AcceptConsentRequest) (*authv1.ConsentsResponse, error) {
+	user, err := authctx.UserFromCtx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	mtdt := extractClientMetadata(ctx)
+	err = s.acceptConsentHandler.Handle(ctx, command.AcceptConsentCommand{
+		UserID:   user.UserID,
+		Document: req.Document,
+		Version:  req.Version,
+		ClientIP: mtdt.ClientIP,
+	})
+	if err != nil {
+		return nil, toGRPCError(ctx, err)
+	}
+
+	return s.consentsResponse(ctx, user.UserID, "Consent recorded successfully")
+}
+
+func (s *AuthGRPCServer) consentsResponse(ctx context.Context, userID, message string) (*authv1.ConsentsResponse, error) {
+	result, err := s.listConsentsHandler.Handle(ctx, query.ListConsentsQuery{UserID: userID})
+	if err != nil {
+		return nil, toGRPCError(ctx, err)
+	}
+
+	data := &authv1.Consents{
+		Documents: make([]*authv1.LegalDocument, len(result.Documents)),
+		History:   make([]*authv1.Consent, len(result.History)),
+	}
+	for i, d := range result.Documents {
+		data.Documents[i] = &authv1.LegalDocument{
+			Document:       d.Document,
+			CurrentVersion: d.CurrentVersion,
+			Accepted:       d.Accepted,
+		}
+	}
+	for i, c := range result.History {
+		data.History[i] = &authv1.Consent{
+			Document:   c.Document,
+			Version:    c.Version,
+			AcceptedAt: timestamppb.New(c.AcceptedAt),
+			ClientIp:   c.ClientIP,
+		}
+	}
+
+	return &authv1.ConsentsResponse{
+		Success: true,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
 // ChangePassword changes the user's password.
 func (s *AuthGRPCServer) ChangePassword(ctx context.Context, req *authv1.ChangePasswordRequest) (*authv1.SuccessResponse, error) {
 	user, err := authctx.UserFromCtx(ctx)
@@ -416,7 +754,7 @@ func (s *AuthGRPCServer) ChangePassword(ctx context.Context, req *authv1.ChangeP
 	}
 
 	if err := s.changePasswordHandler.Handle(ctx, cmd); err != nil {
-		return nil, toGRPCError(err)
+		return nil, toGRPCError(ctx, err)
 	}
 
 	return &authv1.SuccessResponse{
@@ -433,7 +771,7 @@ func (s *AuthGRPCServer) VerifyEmail(ctx context.Context, req *authv1.VerifyEmai
 	}
 
 	if err := s.verifyEmailHandler.Handle(ctx, cmd); err != nil {
-		return nil, toGRPCError(err)
+		return nil, toGRPCError(ctx, err)
 	}
 
 	return &authv1.SuccessResponse{
@@ -449,7 +787,7 @@ func (s *AuthGRPCServer) ResendVerification(ctx context.Context, req *authv1.Res
 	}
 
 	if err := s.resendVerificationHandler.Handle(ctx, cmd); err != nil {
-		return nil, toGRPCError(err)
+		return nil, toGRPCError(ctx, err)
 	}
 
 	return &authv1.SuccessResponse{
@@ -465,7 +803,7 @@ func (s *AuthGRPCServer) ForgotPassword(ctx context.Context, req *authv1.ForgotP
 	}
 
 	if err := s.forgotPasswordHandler.Handle(ctx, cmd); err != nil {
-		return nil, toGRPCError(err)
+		return nil, toGRPCError(ctx, err)
 	}
 
 	return &authv1.SuccessResponse{
@@ -483,7 +821,7 @@ func (s *AuthGRPCServer) ResetPassword(ctx context.Context, req *authv1.ResetPas
 	}
 
 	if err := s.resetPasswordHandler.Handle(ctx, cmd); err != nil {
-		return nil, toGRPCError(err)
+		return nil, toGRPCError(ctx, err)
 	}
 
 	return &authv1.SuccessResponse{
@@ -503,7 +841,7 @@ func (s *AuthGRPCServer) ExportUserData(ctx context.Context, req *authv1.ExportU
 		UserID: user.UserID,
 	})
 	if err != nil {
-		return nil, toGRPCError(err)
+		return nil, toGRPCError(ctx, err)
 	}
 
 	dataBytes, err := json.Marshal(result)
@@ -541,7 +879,7 @@ func (s *AuthGRPCServer) DeleteAccount(ctx context.Context, req *authv1.DeleteAc
 	}
 
 	if err := s.deleteAccountHandler.Handle(ctx, cmd); err != nil {
-		return nil, toGRPCError(err)
+		return nil, toGRPCError(ctx, err)
 	}
 
 	return &authv1.SuccessResponse{
@@ -550,7 +888,54 @@ func (s *AuthGRPCServer) DeleteAccount(ctx context.Context, req *authv1.DeleteAc
 	}, nil
 }
 
+// DeactivateAccount switches off the authenticated user's account.
+func (s *AuthGRPCServer) DeactivateAccount(ctx context.Context, req *authv1.DeactivateAccountRequest) (*authv1.SuccessResponse, error) {
+	user, err := authctx.UserFromCtx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	cmd := command.DeactivateAccountCommand{UserID: user.UserID}
+	if err := s.deactivateAccountHandler.Handle(ctx, cmd); err != nil {
+		return nil, toGRPCError(ctx, err)
+	}
+
+	return &authv1.SuccessResponse{
+		Success: true,
+		Message: "Account deactivated successfully",
+	}, nil
+}
+
+// IntrospectToken reports whether an access token is currently valid.
+func (s *AuthGRPCServer) IntrospectToken(ctx context.Context, req *authv1.IntrospectTokenRequest) (*authv1.IntrospectTokenResponse, error) {
+	result, err := s.introspectTokenHandler.Handle(ctx, query.IntrospectTokenQuery{Token: req.Token})
+	if err != nil {
+		return nil, toGRPCError(ctx, err)
+	}
+
+	if !result.Active {
+		return &authv1.IntrospectTokenResponse{Active: false}, nil
+	}
+
+	return &authv1.IntrospectTokenResponse{
+		Active:    true,
+		Sub:       result.UserID,
+		SessionId: result.SessionID,
+		Exp:       result.ExpiresAt.Unix(),
+		Iat:       result.IssuedAt.Unix(),
+	}, nil
+}
+
 // toGRPCError converts application errors to gRPC status errors.
-func toGRPCError(err error) error {
-	return grpcutil.ToGRPCError(err)
+func toGRPCError(ctx context.Context, err error) error {
+	return grpcutil.ToGRPCError(ctx, err)
+}
+
+// toProtoOptionalInt converts an optional int to its protobuf form.
+func toProtoOptionalInt(v *int) *int32 {
+	if v == nil {
+		return nil
+	}
+	i := int32(*v)
+	return &i
 }
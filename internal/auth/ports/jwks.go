@@ -0,0 +1,91 @@
+package ports
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"sort"
+)
+
+// PublicKeySource lists the public keys access tokens may be signed with,
+// keyed by kid.
+type PublicKeySource interface {
+	PublicKeys() map[string]crypto.PublicKey
+}
+
+// jsonWebKey is a public key in RFC 7517 format.
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+	// RSA
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+	// Ed25519
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+// JWKSHandler serves the public signing keys as a JSON Web Key Set so other
+// services can verify Ethos access tokens without sharing a secret.
+// It returns nil when no asymmetric keys are configured.
+func JWKSHandler(source PublicKeySource) http.Handler {
+	keys := source.PublicKeys()
+	if len(keys) == 0 {
+		return nil
+	}
+
+	set := struct {
+		Keys []jsonWebKey `json:"keys"`
+	}{}
+	for kid, key := range keys {
+		if jwk, ok := toJSONWebKey(kid, key); ok {
+			set.Keys = append(set.Keys, jwk)
+		}
+	}
+	sort.Slice(set.Keys, func(i, j int) bool { return set.Keys[i].Kid < set.Keys[j].Kid })
+
+	// Keys only change on restart, so the document is encoded once
+	body, err := json.Marshal(set)
+	if err != nil {
+		return nil
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "public, max-age=300")
+		w.Write(body)
+	})
+}
+
+func toJSONWebKey(kid string, key crypto.PublicKey) (jsonWebKey, bool) {
+	b64 := base64.RawURLEncoding.EncodeToString
+
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		return jsonWebKey{
+			Kty: "RSA",
+			Use: "sig",
+			Alg: "RS256",
+			Kid: kid,
+			N:   b64(k.N.Bytes()),
+			E:   b64(big.NewInt(int64(k.E)).Bytes()),
+		}, true
+	case ed25519.PublicKey:
+		return jsonWebKey{
+			Kty: "OKP",
+			Use: "sig",
+			Alg: "EdDSA",
+			Kid: kid,
+			Crv: "Ed25519",
+			X:   b64(k),
+		}, true
+	}
+
+	return jsonWebKey{}, false
+}
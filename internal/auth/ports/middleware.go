@@ -94,6 +94,7 @@ func AuthMiddleware(tokenVerifier service.TokenVerifier, userReader user.UserRea
 				UserID:    claims.UserID.String(),
 				SessionID: claims.SessionID.String(),
 				Email:     foundUser.Email(),
+				Role:      foundUser.Role(),
 			})
 
 			// Enrich wide event with user context for Canonical Log Lines
@@ -106,6 +107,25 @@ func AuthMiddleware(tokenVerifier service.TokenVerifier, userReader user.UserRea
 	}
 }
 
+// BearerUserID returns a function that reads the user ID from a valid
+// access token in a request's Authorization header. It serves HTTP
+// middleware that runs before the gateway authenticates the request and
+// returns "" for anonymous requests and invalid tokens.
+func BearerUserID(tokenVerifier service.TokenVerifier) func(r *http.Request) string {
+	return func(r *http.Request) string {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || token == "" {
+			return ""
+		}
+
+		claims, err := tokenVerifier.VerifyAccessToken(r.Context(), token)
+		if err != nil {
+			return ""
+		}
+		return claims.UserID.String()
+	}
+}
+
 // OptionalAuthMiddleware is similar to AuthMiddleware but doesn't reject
 // requests with missing or invalid tokens. Instead, it populates the context
 // if a valid token is present, but allows the request through either way.
@@ -0,0 +1,55 @@
+package ports_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	. "github.com/smartystreets/goconvey/convey"
+
+	authv1 "github.com/semmidev/ethos-go/contracts/grpc/ethos/auth/v1"
+)
+
+// routeRecorder records which session RPC the gateway routed to
+type routeRecorder struct {
+	authv1.UnimplementedAuthServiceServer
+	called string
+}
+
+func (r *routeRecorder) RevokeSession(_ context.Context, req *authv1.RevokeSessionRequest) (*authv1.RevokeSessionResponse, error) {
+	r.called = "RevokeSession:" + req.SessionId
+	return &authv1.RevokeSessionResponse{Success: true}, nil
+}
+
+func (r *routeRecorder) RevokeOtherSessions(context.Context, *authv1.RevokeOtherSessionsRequest) (*authv1.RevokeOtherSessionsResponse, error) {
+	r.called = "RevokeOtherSessions"
+	return &authv1.RevokeOtherSessionsResponse{Success: true}, nil
+}
+
+func TestSessionRoutes(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given the auth gateway routes", t, func() {
+		recorder := &routeRecorder{}
+		mux := runtime.NewServeMux()
+		So(authv1.RegisterAuthServiceHandlerServer(context.Background(), mux, recorder), ShouldBeNil)
+
+		serve := func(path string) int {
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, httptest.NewRequest(http.MethodDelete, path, nil))
+			return w.Code
+		}
+
+		Convey("Then DELETE /sessions/other still revokes every other session", func() {
+			So(serve("/v1/auth/sessions/other"), ShouldEqual, http.StatusOK)
+			So(recorder.called, ShouldEqual, "RevokeOtherSessions")
+		})
+
+		Convey("Then DELETE /sessions/{id} revokes that session", func() {
+			So(serve("/v1/auth/sessions/3f2b8c1e-0000-4000-8000-000000000001"), ShouldEqual, http.StatusOK)
+			So(recorder.called, ShouldEqual, "RevokeSession:3f2b8c1e-0000-4000-8000-000000000001")
+		})
+	})
+}
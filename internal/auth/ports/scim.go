@@ -0,0 +1,453 @@
+package ports
+
+import (
+	"cmp"
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/semmidev/ethos-go/internal/auth/app/command"
+	"github.com/semmidev/ethos-go/internal/auth/app/query"
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/errreport"
+)
+
+// SCIM 2.0 schema URNs (RFC 7643, RFC 7644)
+const (
+	scimUserSchema     = "urn:ietf:params:scim:schemas:core:2.0:User"
+	scimListSchema     = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	scimErrorSchema    = "urn:ietf:params:scim:api:messages:2.0:Error"
+	scimContentType    = "application/scim+json"
+	scimMaxRequestSize = 1 << 20
+)
+
+// scimUserNameFilter is the only filter supported: userName eq "value"
+var scimUserNameFilter = regexp.MustCompile(`(?i)^\s*userName\s+eq\s+"((?:[^"\\]|\\.)*)"\s*$`)
+
+// SCIMUser is the SCIM core User resource, limited to the attributes an
+// account has
+type SCIMUser struct {
+	Schemas     []string      `json:"schemas"`
+	ID          string        `json:"id,omitempty"`
+	UserName    string        `json:"userName"`
+	Name        *SCIMName     `json:"name,omitempty"`
+	DisplayName string        `json:"displayName,omitempty"`
+	Emails      []SCIMEmail   `json:"emails,omitempty"`
+	Active      *bool         `json:"active,omitempty"`
+	Meta        *SCIMUserMeta `json:"meta,omitempty"`
+}
+
+// SCIMName is the components of a user's name
+type SCIMName struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+// SCIMEmail is one of a user's email addresses
+type SCIMEmail struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+// SCIMUserMeta is the resource metadata
+type SCIMUserMeta struct {
+	ResourceType string    `json:"resourceType"`
+	Created      time.Time `json:"created"`
+	LastModified time.Time `json:"lastModified"`
+	Location     string    `json:"location"`
+}
+
+// scimListResponse is a page of query results
+type scimListResponse struct {
+	Schemas      []string   `json:"schemas"`
+	TotalResults int        `json:"totalResults"`
+	StartIndex   int        `json:"startIndex"`
+	ItemsPerPage int        `json:"itemsPerPage"`
+	Resources    []SCIMUser `json:"Resources"`
+}
+
+// scimPatchRequest is a PATCH body of add and replace operations
+type scimPatchRequest struct {
+	Schemas    []string `json:"schemas"`
+	Operations []struct {
+		Op    string          `json:"op"`
+		Path  string          `json:"path"`
+		Value json.RawMessage `json:"value"`
+	} `json:"Operations"`
+}
+
+// scimError is the SCIM error response body
+type scimError struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+}
+
+// SCIMHandlers are the application handlers behind the SCIM endpoints
+type SCIMHandlers struct {
+	ProvisionUser         command.ProvisionUserHandler
+	UpdateProvisionedUser command.UpdateProvisionedUserHandler
+	GetUserAccount        query.GetUserAccountHandler
+}
+
+// SCIMHandler serves a minimal SCIM 2.0 Users endpoint so an enterprise
+// identity provider can create accounts, keep their name and email in step
+// and deactivate them. Requests must carry token as a bearer token. Mount
+// it at /scim/v2:
+//
+//	GET   /Users?filter=userName eq "..."  find an account by email
+//	POST  /Users                           create an account
+//	GET   /Users/{id}                      read an account
+//	PUT   /Users/{id}                      replace name, email and active
+//	PATCH /Users/{id}                      add or replace those attributes
+//
+// Users are never deleted through SCIM; setting active to false deactivates
+// the account and signs it out everywhere.
+func SCIMHandler(token string, handlers SCIMHandlers) http.Handler {
+	h := scimUsers{handlers: handlers}
+
+	r := chi.NewRouter()
+	r.Use(scimBearerAuth(token))
+	r.Get("/Users", h.list)
+	r.Post("/Users", h.create)
+	r.Get("/Users/{id}", h.get)
+	r.Put("/Users/{id}", h.replace)
+	r.Patch("/Users/{id}", h.patch)
+	return r
+}
+
+// scimBearerAuth rejects requests without the provisioning token
+func scimBearerAuth(token string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			presented, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if !ok || subtle.ConstantTimeCompare([]byte(presented), []byte(token)) != 1 {
+				writeSCIMError(w, http.StatusUnauthorized, "", "a valid provisioning token is required")
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+type scimUsers struct {
+	handlers SCIMHandlers
+}
+
+func (h scimUsers) list(w http.ResponseWriter, r *http.Request) {
+	filter := r.URL.Query().Get("filter")
+	match := scimUserNameFilter.FindStringSubmatch(filter)
+	if match == nil {
+		writeSCIMError(w, http.StatusBadRequest, "invalidFilter", `only filter=userName eq "..." is supported`)
+		return
+	}
+	userName, err := strconv.Unquote(`"` + match[1] + `"`)
+	if err != nil {
+		writeSCIMError(w, http.StatusBadRequest, "invalidFilter", "the filter value is not a valid string")
+		return
+	}
+
+	resources := []SCIMUser{}
+	account, err := h.handlers.GetUserAccount.Handle(r.Context(), query.GetUserAccountQuery{Email: userName})
+	switch {
+	case err == nil:
+		resources = append(resources, toSCIMUser(account))
+	case isAppErrorCode(err, apperror.ErrCodeNotFound):
+	default:
+		writeSCIMAppError(w, r, err)
+		return
+	}
+
+	writeSCIM(w, http.StatusOK, scimListResponse{
+		Schemas:      []string{scimListSchema},
+		TotalResults: len(resources),
+		StartIndex:   1,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	})
+}
+
+func (h scimUsers) create(w http.ResponseWriter, r *http.Request) {
+	var body SCIMUser
+	if !decodeSCIM(w, r, &body) {
+		return
+	}
+
+	active := true
+	if body.Active != nil {
+		active = *body.Active
+	}
+	result, err := h.handlers.ProvisionUser.Handle(r.Context(), command.ProvisionUserCommand{
+		Email:  body.email(),
+		Name:   cmp.Or(body.fullName(), body.UserName),
+		Active: active,
+	})
+	if err != nil {
+		writeSCIMAppError(w, r, err)
+		return
+	}
+
+	h.respond(w, r, result.UserID.String(), http.StatusCreated)
+}
+
+func (h scimUsers) get(w http.ResponseWriter, r *http.Request) {
+	h.respond(w, r, chi.URLParam(r, "id"), http.StatusOK)
+}
+
+// replace applies a full resource. Attributes the resource leaves out keep
+// their value, since an account can't do without a name or email.
+func (h scimUsers) replace(w http.ResponseWriter, r *http.Request) {
+	var body SCIMUser
+	if !decodeSCIM(w, r, &body) {
+		return
+	}
+
+	cmd := command.UpdateProvisionedUserCommand{
+		UserID: chi.URLParam(r, "id"),
+		Active: body.Active,
+	}
+	if email := body.email(); email != "" {
+		cmd.Email = &email
+	}
+	if name := body.fullName(); name != "" {
+		cmd.Name = &name
+	}
+	h.update(w, r, cmd)
+}
+
+func (h scimUsers) patch(w http.ResponseWriter, r *http.Request) {
+	var body scimPatchRequest
+	if !decodeSCIM(w, r, &body) {
+		return
+	}
+
+	cmd := command.UpdateProvisionedUserCommand{UserID: chi.URLParam(r, "id")}
+	for _, op := range body.Operations {
+		switch strings.ToLower(op.Op) {
+		case "add", "replace":
+		default:
+			writeSCIMError(w, http.StatusBadRequest, "mutability", "only add and replace operations are supported")
+			return
+		}
+
+		// Without a path the value is an object of attributes
+		attributes := map[string]json.RawMessage{}
+		if op.Path == "" {
+			if err := json.Unmarshal(op.Value, &attributes); err != nil {
+				writeSCIMError(w, http.StatusBadRequest, "invalidValue", "the operation value must be an object")
+				return
+			}
+		} else {
+			attributes[op.Path] = op.Value
+		}
+
+		for path, value := range attributes {
+			if err := applySCIMPatch(&cmd, path, value); err != nil {
+				writeSCIMError(w, http.StatusBadRequest, "invalidValue", err.Error())
+				return
+			}
+		}
+	}
+	h.update(w, r, cmd)
+}
+
+func (h scimUsers) update(w http.ResponseWriter, r *http.Request, cmd command.UpdateProvisionedUserCommand) {
+	if err := h.handlers.UpdateProvisionedUser.Handle(r.Context(), cmd); err != nil {
+		writeSCIMAppError(w, r, err)
+		return
+	}
+	h.respond(w, r, cmd.UserID, http.StatusOK)
+}
+
+// respond writes the current state of the account
+func (h scimUsers) respond(w http.ResponseWriter, r *http.Request, userID string, status int) {
+	account, err := h.handlers.GetUserAccount.Handle(r.Context(), query.GetUserAccountQuery{UserID: userID})
+	if err != nil {
+		writeSCIMAppError(w, r, err)
+		return
+	}
+	writeSCIM(w, status, toSCIMUser(account))
+}
+
+// applySCIMPatch sets the attribute at path. Attributes an account doesn't
+// have are ignored, since identity providers send many of them.
+func applySCIMPatch(cmd *command.UpdateProvisionedUserCommand, path string, value json.RawMessage) error {
+	switch strings.ToLower(path) {
+	case "active":
+		active, err := scimBool(value)
+		if err != nil {
+			return err
+		}
+		cmd.Active = &active
+	case "username", "emails", `emails[type eq "work"].value`, "emails[primary eq true].value":
+		email, err := scimEmailValue(value)
+		if err != nil {
+			return err
+		}
+		cmd.Email = &email
+	case "displayname", "name.formatted":
+		var name string
+		if err := json.Unmarshal(value, &name); err != nil {
+			return errInvalidSCIMValue(path)
+		}
+		cmd.Name = &name
+	case "name":
+		var name SCIMName
+		if err := json.Unmarshal(value, &name); err != nil {
+			return errInvalidSCIMValue(path)
+		}
+		if formatted := name.formatted(); formatted != "" {
+			cmd.Name = &formatted
+		}
+	}
+	return nil
+}
+
+// scimBool reads a boolean; some identity providers send "True" and "False"
+func scimBool(value json.RawMessage) (bool, error) {
+	var b bool
+	if err := json.Unmarshal(value, &b); err == nil {
+		return b, nil
+	}
+	var s string
+	if err := json.Unmarshal(value, &s); err == nil {
+		if b, err := strconv.ParseBool(strings.ToLower(s)); err == nil {
+			return b, nil
+		}
+	}
+	return false, errInvalidSCIMValue("active")
+}
+
+// scimEmailValue reads an address given as a string or as a list of
+// emails, preferring the primary one
+func scimEmailValue(value json.RawMessage) (string, error) {
+	var s string
+	if err := json.Unmarshal(value, &s); err == nil {
+		return s, nil
+	}
+	var emails []SCIMEmail
+	if err := json.Unmarshal(value, &emails); err == nil && len(emails) > 0 {
+		return SCIMUser{Emails: emails}.email(), nil
+	}
+	return "", errInvalidSCIMValue("emails")
+}
+
+type errInvalidSCIMValue string
+
+func (e errInvalidSCIMValue) Error() string {
+	return "invalid value for " + string(e)
+}
+
+// email is the account address: the primary email, else the first one,
+// else the userName
+func (u SCIMUser) email() string {
+	for _, e := range u.Emails {
+		if e.Primary && e.Value != "" {
+			return e.Value
+		}
+	}
+	for _, e := range u.Emails {
+		if e.Value != "" {
+			return e.Value
+		}
+	}
+	return u.UserName
+}
+
+// fullName is the account name: displayName, else the name components
+func (u SCIMUser) fullName() string {
+	if u.DisplayName != "" {
+		return u.DisplayName
+	}
+	if u.Name != nil {
+		return u.Name.formatted()
+	}
+	return ""
+}
+
+func (n SCIMName) formatted() string {
+	if n.Formatted != "" {
+		return n.Formatted
+	}
+	return strings.TrimSpace(n.GivenName + " " + n.FamilyName)
+}
+
+func toSCIMUser(a *query.UserAccount) SCIMUser {
+	active := a.Active
+	return SCIMUser{
+		Schemas:     []string{scimUserSchema},
+		ID:          a.UserID,
+		UserName:    a.Email,
+		Name:        &SCIMName{Formatted: a.Name},
+		DisplayName: a.Name,
+		Emails:      []SCIMEmail{{Value: a.Email, Type: "work", Primary: true}},
+		Active:      &active,
+		Meta: &SCIMUserMeta{
+			ResourceType: "User",
+			Created:      a.CreatedAt.UTC(),
+			LastModified: a.UpdatedAt.UTC(),
+			Location:     "/scim/v2/Users/" + a.UserID,
+		},
+	}
+}
+
+func decodeSCIM(w http.ResponseWriter, r *http.Request, v any) bool {
+	r.Body = http.MaxBytesReader(w, r.Body, scimMaxRequestSize)
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeSCIMError(w, http.StatusBadRequest, "invalidSyntax", "the request body is not valid JSON")
+		return false
+	}
+	return true
+}
+
+func writeSCIM(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", scimContentType)
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeSCIMError(w http.ResponseWriter, status int, scimType, detail string) {
+	writeSCIM(w, status, scimError{
+		Schemas:  []string{scimErrorSchema},
+		Status:   strconv.Itoa(status),
+		ScimType: scimType,
+		Detail:   detail,
+	})
+}
+
+// writeSCIMAppError writes err in the SCIM error format. Only the client
+// safe message of an AppError is shown.
+func writeSCIMAppError(w http.ResponseWriter, r *http.Request, err error) {
+	appErr := apperror.GetAppError(err)
+	if appErr == nil {
+		appErr = apperror.InternalError(err)
+	}
+
+	status := appErr.HTTPStatusCode()
+	if status >= http.StatusInternalServerError {
+		errreport.ReportRequest(r, err)
+	}
+
+	var scimType string
+	switch appErr.Code {
+	case apperror.ErrCodeAlreadyExists:
+		scimType = "uniqueness"
+	case apperror.ErrCodeValidationFailed, apperror.ErrCodeInvalidInput:
+		scimType = "invalidValue"
+	}
+	writeSCIMError(w, status, scimType, appErr.Message)
+}
+
+func isAppErrorCode(err error, code string) bool {
+	appErr := apperror.GetAppError(err)
+	return appErr != nil && appErr.Code == code
+}
@@ -0,0 +1,180 @@
+package ports_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	authevents "github.com/semmidev/ethos-go/contracts/events/auth"
+	"github.com/semmidev/ethos-go/internal/auth/app/command"
+	"github.com/semmidev/ethos-go/internal/auth/app/query"
+	"github.com/semmidev/ethos-go/internal/auth/domain/session"
+	"github.com/semmidev/ethos-go/internal/auth/domain/user"
+	"github.com/semmidev/ethos-go/internal/auth/ports"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/validator"
+	"github.com/semmidev/ethos-go/internal/testutil"
+)
+
+const scimToken = "provisioning-token-provisioning-token"
+
+func TestSCIMUsers(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given the SCIM Users endpoint", t, func() {
+		ctx := context.Background()
+		existing := testutil.NewUserBuilder().WithEmail("existing@corp.example").WithName("Existing").Build()
+		laptop := testutil.NewSessionBuilder(existing).Build()
+
+		users := testutil.NewUserRepository(existing)
+		sessions := testutil.NewSessionRepository(laptop)
+		denylist := testutil.NewSessionDenylist()
+		publisher := testutil.NewRecordingPublisher()
+		validate := validator.New("en")
+		log, metrics := testutil.NopLogger{}, &decorator.NoOpMetricsClient{}
+
+		handler := ports.SCIMHandler(scimToken, ports.SCIMHandlers{
+			ProvisionUser: command.NewProvisionUserHandler(users, validate, publisher, log, metrics),
+			UpdateProvisionedUser: command.NewUpdateProvisionedUserHandler(
+				users, sessions, denylist,
+				session.NewAuthenticationService(15*time.Minute, 24*time.Hour),
+				validate, log, metrics,
+			),
+			GetUserAccount: query.NewGetUserAccountHandler(users, log, metrics),
+		})
+
+		serve := func(method, target, body string) (*httptest.ResponseRecorder, map[string]any) {
+			req := httptest.NewRequest(method, target, strings.NewReader(body))
+			req.Header.Set("Authorization", "Bearer "+scimToken)
+			req.Header.Set("Content-Type", "application/scim+json")
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+
+			var resp map[string]any
+			_ = json.Unmarshal(w.Body.Bytes(), &resp)
+			return w, resp
+		}
+		existingPath := "/Users/" + existing.UserID().String()
+
+		Convey("Requests without the provisioning token are refused", func() {
+			req := httptest.NewRequest(http.MethodGet, existingPath, nil)
+			req.Header.Set("Authorization", "Bearer wrong-token")
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+			So(w.Code, ShouldEqual, http.StatusUnauthorized)
+		})
+
+		Convey("Creating a user provisions an SSO account", func() {
+			w, resp := serve(http.MethodPost, "/Users", `{
+				"schemas": ["urn:ietf:params:scim:schemas:core:2.0:User"],
+				"userName": "jane@corp.example",
+				"name": {"givenName": "Jane", "familyName": "Doe"},
+				"emails": [{"value": "jane@corp.example", "primary": true}],
+				"active": true
+			}`)
+			So(w.Code, ShouldEqual, http.StatusCreated)
+			So(w.Header().Get("Content-Type"), ShouldEqual, "application/scim+json")
+			So(resp["userName"], ShouldEqual, "jane@corp.example")
+			So(resp["displayName"], ShouldEqual, "Jane Doe")
+			So(resp["active"], ShouldEqual, true)
+
+			u, err := users.FindByEmail(ctx, "jane@corp.example")
+			So(err, ShouldBeNil)
+			So(resp["id"], ShouldEqual, u.UserID().String())
+			So(u.AuthProvider(), ShouldEqual, user.AuthProviderOIDC)
+			So(u.IsVerified(), ShouldBeTrue)
+			So(u.HashedPassword(), ShouldBeNil)
+
+			published := publisher.Events()
+			So(published, ShouldHaveLength, 1)
+			So(published[0].EventType(), ShouldEqual, authevents.UserRegisteredType)
+		})
+
+		Convey("Creating a user with a taken email conflicts", func() {
+			w, resp := serve(http.MethodPost, "/Users", `{"userName": "existing@corp.example"}`)
+			So(w.Code, ShouldEqual, http.StatusConflict)
+			So(resp["scimType"], ShouldEqual, "uniqueness")
+		})
+
+		Convey("Users can be found by userName", func() {
+			filter := url.QueryEscape(`userName eq "existing@corp.example"`)
+			w, resp := serve(http.MethodGet, "/Users?filter="+filter, "")
+			So(w.Code, ShouldEqual, http.StatusOK)
+			So(resp["totalResults"], ShouldEqual, 1)
+
+			filter = url.QueryEscape(`userName eq "nobody@corp.example"`)
+			w, resp = serve(http.MethodGet, "/Users?filter="+filter, "")
+			So(w.Code, ShouldEqual, http.StatusOK)
+			So(resp["totalResults"], ShouldEqual, 0)
+		})
+
+		Convey("Other filters are refused", func() {
+			w, resp := serve(http.MethodGet, "/Users?filter="+url.QueryEscape(`title eq "x"`), "")
+			So(w.Code, ShouldEqual, http.StatusBadRequest)
+			So(resp["scimType"], ShouldEqual, "invalidFilter")
+		})
+
+		Convey("Unknown users are not found", func() {
+			w, _ := serve(http.MethodGet, "/Users/3f2b8c1e-0000-4000-8000-000000000001", "")
+			So(w.Code, ShouldEqual, http.StatusNotFound)
+		})
+
+		Convey("PUT replaces the name and email", func() {
+			w, resp := serve(http.MethodPut, existingPath, `{
+				"userName": "renamed@corp.example",
+				"displayName": "Renamed"
+			}`)
+			So(w.Code, ShouldEqual, http.StatusOK)
+			So(resp["userName"], ShouldEqual, "renamed@corp.example")
+
+			u, err := users.FindByID(ctx, existing.UserID())
+			So(err, ShouldBeNil)
+			So(u.Email(), ShouldEqual, "renamed@corp.example")
+			So(u.Name(), ShouldEqual, "Renamed")
+			So(u.IsActive(), ShouldBeTrue)
+		})
+
+		Convey("PATCH setting active to false deactivates and signs out", func() {
+			w, resp := serve(http.MethodPatch, existingPath, `{
+				"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+				"Operations": [{"op": "Replace", "path": "active", "value": "False"}]
+			}`)
+			So(w.Code, ShouldEqual, http.StatusOK)
+			So(resp["active"], ShouldEqual, false)
+
+			u, err := users.FindByID(ctx, existing.UserID())
+			So(err, ShouldBeNil)
+			So(u.IsActive(), ShouldBeFalse)
+			So(sessions.Len(), ShouldEqual, 0)
+
+			denied, err := denylist.IsDenied(ctx, laptop.SessionID())
+			So(err, ShouldBeNil)
+			So(denied, ShouldBeTrue)
+		})
+
+		Convey("PATCH without a path applies the value's attributes", func() {
+			w, _ := serve(http.MethodPatch, existingPath, `{
+				"Operations": [{"op": "replace", "value": {"displayName": "Patched", "title": "ignored"}}]
+			}`)
+			So(w.Code, ShouldEqual, http.StatusOK)
+
+			u, err := users.FindByID(ctx, existing.UserID())
+			So(err, ShouldBeNil)
+			So(u.Name(), ShouldEqual, "Patched")
+		})
+
+		Convey("PATCH remove operations are refused", func() {
+			w, _ := serve(http.MethodPatch, existingPath, `{
+				"Operations": [{"op": "remove", "path": "displayName"}]
+			}`)
+			So(w.Code, ShouldEqual, http.StatusBadRequest)
+		})
+	})
+}
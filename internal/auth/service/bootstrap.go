@@ -2,21 +2,31 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"net/http"
 	"time"
 
+	"github.com/redis/go-redis/v9"
 	"github.com/semmidev/ethos-go/config"
 	"github.com/semmidev/ethos-go/internal/auth/adapters"
+	"github.com/semmidev/ethos-go/internal/auth/adapters/geoip"
 	"github.com/semmidev/ethos-go/internal/auth/adapters/google"
+	"github.com/semmidev/ethos-go/internal/auth/adapters/oidc"
 	"github.com/semmidev/ethos-go/internal/auth/app"
 	"github.com/semmidev/ethos-go/internal/auth/app/command"
 	"github.com/semmidev/ethos-go/internal/auth/app/query"
+	"github.com/semmidev/ethos-go/internal/auth/domain/consent"
 	"github.com/semmidev/ethos-go/internal/auth/domain/gateway"
+	"github.com/semmidev/ethos-go/internal/auth/domain/service"
 	"github.com/semmidev/ethos-go/internal/auth/domain/session"
+	"github.com/semmidev/ethos-go/internal/auth/domain/user"
 	"github.com/semmidev/ethos-go/internal/auth/ports"
+	"github.com/semmidev/ethos-go/internal/common/breaker"
 	"github.com/semmidev/ethos-go/internal/common/database"
 	"github.com/semmidev/ethos-go/internal/common/decorator"
 	"github.com/semmidev/ethos-go/internal/common/events"
 	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/common/storage"
 	"github.com/semmidev/ethos-go/internal/common/validator"
 )
 
@@ -31,34 +41,140 @@ func NewApplication(
 	metricsClient decorator.MetricsClient,
 ) app.Application {
 	// Create adapters (infrastructure)
-	userRepo := adapters.NewUserPostgresRepository(db)
+	var userRepo user.Repository = adapters.NewUserPostgresRepository(db)
+	var authUsers user.UserReader = userRepo
+	if cfg.AuthUserCacheTTL > 0 {
+		cachingRepo := adapters.NewCachingUserRepository(userRepo, cfg.AuthUserCacheTTL)
+		userRepo, authUsers = cachingRepo, cachingRepo.AuthReader()
+	}
 	sessionRepo := adapters.NewSessionPostgresRepository(db)
+	magicLinkRepo := adapters.NewMagicLinkPostgresRepository(db)
 	passwordHasher := adapters.NewBcryptPasswordHasher()
-	tokenIssuer := adapters.NewJWTTokenIssuer(cfg)
+	codeHasher := user.NewCodeHasher(cfg.AuthCodeSecret)
+	tokenIssuer, err := adapters.NewJWTTokenIssuer(cfg)
+	if err != nil {
+		panic(fmt.Sprintf("invalid JWT key configuration: %v", err))
+	}
 	validate := validator.New("en")
+	googleBreaker := breaker.New(breaker.Settings{
+		Name:             "google_oauth",
+		FailureThreshold: cfg.CircuitBreakerFailureThreshold,
+		OpenTimeout:      cfg.CircuitBreakerOpenTimeout,
+		IsFailure:        google.IsServiceFailure,
+	}, log)
 	googleService := google.NewService(
 		cfg.GoogleClientID,
 		cfg.GoogleClientSecret,
 		cfg.GoogleCallbackURL,
+		googleBreaker,
 	)
 
+	// Single sign-on stays off unless an OIDC issuer is configured; the
+	// provider is only assigned then so handlers see a nil interface.
+	var ssoProvider service.SSOProvider
+	if cfg.OIDCIssuerURL != "" {
+		ssoProvider = oidc.NewProvider(oidc.Config{
+			IssuerURL:    cfg.OIDCIssuerURL,
+			ClientID:     cfg.OIDCClientID,
+			ClientSecret: cfg.OIDCClientSecret,
+			CallbackURL:  cfg.OIDCCallbackURL,
+			GroupsClaim:  cfg.OIDCGroupsClaim,
+		}, &http.Client{Timeout: 10 * time.Second})
+	}
+	ssoGroupRoles, err := oidc.ParseGroupRoles(cfg.OIDCGroupRoles)
+	if err != nil {
+		panic(fmt.Sprintf("invalid OIDC group role mapping: %v", err))
+	}
+
+	var geoLocator service.GeoLocator = geoip.NopLocator{}
+	if cfg.GeoIPDatabasePath != "" {
+		locator, err := geoip.Open(cfg.GeoIPDatabasePath)
+		if err != nil {
+			panic(fmt.Sprintf("invalid GeoIP database: %v", err))
+		}
+		geoLocator = locator
+	}
+
+	// Users must accept the current version of each published legal document
+	consentRepo := adapters.NewConsentPostgresRepository(db)
+	consentVersions := consent.Versions{
+		consent.DocumentTerms:   cfg.LegalTermsVersion,
+		consent.DocumentPrivacy: cfg.LegalPrivacyVersion,
+	}
+
+	// Uploaded avatars live in the configured object store
+	avatarStore, err := storage.New(cfg)
+	if err != nil {
+		panic(fmt.Sprintf("invalid storage configuration: %v", err))
+	}
+
 	// Create domain services
 	authService := session.NewAuthenticationService(
 		time.Duration(cfg.AuthAccessTokenExpiry)*time.Minute,
 		time.Duration(cfg.AuthRefreshTokenExpiry)*time.Minute,
 	)
 
+	// Revoked sessions are denylisted in Redis until their access tokens
+	// expire; started single sign-on logins wait there for their callback
+	redisClient := redis.NewClient(&redis.Options{
+		Addr:     cfg.RedisDSN(),
+		Password: cfg.RedisPassword,
+		DB:       cfg.RedisDB,
+	})
+	sessionDenylist := adapters.NewRedisSessionDenylist(redisClient)
+	ssoLogins := adapters.NewRedisSSOLoginStore(redisClient)
+	accessVerifier := adapters.NewRevokedSessionVerifier(tokenIssuer, sessionDenylist)
+
 	// Create gRPC auth service
-	grpcAuthService := adapters.NewAuthService(tokenIssuer, userRepo)
+	grpcAuthService := adapters.NewAuthService(accessVerifier, authUsers)
+
+	uploadAvatar := command.NewUploadAvatarHandler(
+		userRepo,
+		avatarStore,
+		log,
+		metricsClient,
+	)
+
+	scimHandlers := ports.SCIMHandlers{
+		ProvisionUser: command.NewProvisionUserHandler(
+			userRepo,
+			validate,
+			eventPublisher,
+			log,
+			metricsClient,
+		),
+		UpdateProvisionedUser: command.NewUpdateProvisionedUserHandler(
+			userRepo,
+			sessionRepo,
+			sessionDenylist,
+			authService,
+			validate,
+			log,
+			metricsClient,
+		),
+		GetUserAccount: query.NewGetUserAccountHandler(
+			userRepo,
+			log,
+			metricsClient,
+		),
+	}
 
 	// Create command and query handlers
 	return app.Application{
-		AuthMiddleware: ports.AuthMiddleware(tokenIssuer, userRepo),
-		AuthService:    grpcAuthService,
+		AuthMiddleware:      ports.AuthMiddleware(accessVerifier, authUsers),
+		AuthService:         grpcAuthService,
+		JWKSHandler:         ports.JWKSHandler(tokenIssuer),
+		AvatarUploadHandler: ports.AvatarUploadHandler(uploadAvatar),
+		UploadsHandler:      uploadsHandler(cfg, avatarStore),
+		SCIMHandler:         scimHandler(cfg, scimHandlers),
+		RequestUserID:       ports.BearerUserID(accessVerifier),
+		ConsentGate:         app.NewConsentGate(consentRepo, consentVersions),
+		Breakers:            []*breaker.Breaker{googleBreaker},
 		Commands: app.Commands{
 			Register: command.NewRegisterHandler(
 				userRepo,
 				passwordHasher,
+				codeHasher,
 				validate,
 				dispatcher,
 				eventPublisher,
@@ -71,6 +187,7 @@ func NewApplication(
 				passwordHasher,
 				tokenIssuer,
 				authService,
+				geoLocator,
 				validate,
 				eventPublisher,
 				log,
@@ -78,11 +195,15 @@ func NewApplication(
 			),
 			Logout: command.NewLogoutHandler(
 				sessionRepo,
+				sessionDenylist,
+				authService,
 				log,
 				metricsClient,
 			),
 			LogoutAll: command.NewLogoutAllHandler(
 				sessionRepo,
+				sessionDenylist,
+				authService,
 				log,
 				metricsClient,
 			),
@@ -90,11 +211,13 @@ func NewApplication(
 				sessionRepo,
 				tokenIssuer,
 				authService,
+				geoLocator,
 				log,
 				metricsClient,
 			),
 			UpdateProfile: command.NewUpdateProfileHandler(
 				userRepo,
+				avatarStore,
 				log,
 				metricsClient,
 			),
@@ -106,6 +229,7 @@ func NewApplication(
 			),
 			VerifyEmail: command.NewVerifyEmailHandler(
 				userRepo,
+				codeHasher,
 				validate,
 				eventPublisher,
 				log,
@@ -113,6 +237,7 @@ func NewApplication(
 			),
 			ResendVerification: command.NewResendVerificationHandler(
 				userRepo,
+				codeHasher,
 				validate,
 				dispatcher,
 				log,
@@ -120,6 +245,7 @@ func NewApplication(
 			),
 			ForgotPassword: command.NewForgotPasswordHandler(
 				userRepo,
+				codeHasher,
 				validate,
 				dispatcher,
 				log,
@@ -128,6 +254,7 @@ func NewApplication(
 			ResetPassword: command.NewResetPasswordHandler(
 				userRepo,
 				passwordHasher,
+				codeHasher,
 				validate,
 				eventPublisher,
 				log,
@@ -139,18 +266,102 @@ func NewApplication(
 				sessionRepo,
 				tokenIssuer,
 				authService,
+				geoLocator,
 				eventPublisher,
 				log,
 				metricsClient,
 			),
+			LoginSSO: command.NewLoginSSOHandler(
+				ssoProvider,
+				ssoLogins,
+				userRepo,
+				sessionRepo,
+				tokenIssuer,
+				authService,
+				geoLocator,
+				eventPublisher,
+				command.SSOPolicy{GroupRoles: ssoGroupRoles},
+				log,
+				metricsClient,
+			),
+			RequestMagicLink: command.NewRequestMagicLinkHandler(
+				userRepo,
+				magicLinkRepo,
+				validate,
+				dispatcher,
+				command.MagicLinkPolicy{
+					Enabled:     cfg.AuthMagicLinkEnabled,
+					TTL:         cfg.AuthMagicLinkTTL,
+					HourlyLimit: cfg.AuthMagicLinkHourlyLimit,
+				},
+				log,
+				metricsClient,
+			),
+			VerifyMagicLink: command.NewVerifyMagicLinkHandler(
+				userRepo,
+				magicLinkRepo,
+				sessionRepo,
+				tokenIssuer,
+				authService,
+				geoLocator,
+				eventPublisher,
+				cfg.AuthMagicLinkEnabled,
+				log,
+				metricsClient,
+			),
 			RevokeSessions: command.NewRevokeAllOtherSessionsHandler(
 				sessionRepo,
+				sessionDenylist,
+				authService,
+				log,
+				metricsClient,
+			),
+			RevokeSession: command.NewRevokeSessionHandler(
+				sessionRepo,
+				sessionDenylist,
+				authService,
 				log,
 				metricsClient,
 			),
 			DeleteAccount: command.NewDeleteAccountHandler(
 				userRepo,
 				sessionRepo,
+				sessionDenylist,
+				authService,
+				avatarStore,
+				log,
+				metricsClient,
+			),
+			DeactivateAccount: command.NewDeactivateAccountHandler(
+				userRepo,
+				sessionRepo,
+				sessionDenylist,
+				authService,
+				log,
+				metricsClient,
+			),
+			UploadAvatar:          uploadAvatar,
+			ProvisionUser:         scimHandlers.ProvisionUser,
+			UpdateProvisionedUser: scimHandlers.UpdateProvisionedUser,
+			UpdatePreferences: command.NewUpdatePreferencesHandler(
+				userRepo,
+				log,
+				metricsClient,
+			),
+			AcceptConsent: command.NewAcceptConsentHandler(
+				consentRepo,
+				consentVersions,
+				log,
+				metricsClient,
+			),
+			AnonymizeUser: command.NewAnonymizeUserHandler(
+				userRepo,
+				adapters.NewAnonymizerPostgresRepository(db),
+				sessionRepo,
+				sessionDenylist,
+				authService,
+				avatarStore,
+				eventPublisher,
 				log,
 				metricsClient,
 			),
@@ -168,6 +379,7 @@ func NewApplication(
 			),
 			GetProfile: query.NewGetProfileHandler(
 				userRepo,
+				avatarStore,
 				log,
 				metricsClient,
 			),
@@ -176,12 +388,56 @@ func NewApplication(
 				log,
 				metricsClient,
 			),
+			GetSSOAuthURL: query.NewGetSSOAuthURLHandler(
+				ssoProvider,
+				ssoLogins,
+				log,
+				metricsClient,
+			),
+			GetUserAccount: scimHandlers.GetUserAccount,
+			ListConsents: query.NewListConsentsHandler(
+				consentRepo,
+				consentVersions,
+				log,
+				metricsClient,
+			),
 			ExportUserData: query.NewExportUserDataHandler(
 				userRepo,
 				adapters.NewExportDataPostgresRepository(db),
 				log,
 				metricsClient,
 			),
+			IntrospectToken: query.NewIntrospectTokenHandler(
+				tokenIssuer,
+				sessionRepo,
+				userRepo,
+				log,
+				metricsClient,
+			),
+			GetPreferences: query.NewGetPreferencesHandler(
+				userRepo,
+				log,
+				metricsClient,
+			),
 		},
 	}
 }
+
+// uploadsHandler serves a local store's objects from the API unless they
+// are published at a separate public URL
+func uploadsHandler(cfg *config.Config, store storage.Store) http.Handler {
+	local, ok := store.(*storage.LocalStore)
+	if !ok || cfg.StoragePublicURL != "" {
+		return nil
+	}
+	return local.Handler()
+}
+
+// scimHandler serves SCIM user provisioning when a provisioning token is
+// configured
+func scimHandler(cfg *config.Config, handlers ports.SCIMHandlers) http.Handler {
+	if cfg.SCIMToken == "" {
+		return nil
+	}
+	return ports.SCIMHandler(cfg.SCIMToken, handlers)
+}
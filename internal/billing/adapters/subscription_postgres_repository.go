@@ -0,0 +1,168 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/semmidev/ethos-go/internal/billing/domain/plan"
+	"github.com/semmidev/ethos-go/internal/billing/domain/subscription"
+	"github.com/semmidev/ethos-go/internal/common/database"
+)
+
+// subscriptionModel is the database representation of a Subscription
+type subscriptionModel struct {
+	StripeSubscriptionID string     `db:"stripe_subscription_id"`
+	StripeCustomerID     string     `db:"stripe_customer_id"`
+	UserID               string     `db:"user_id"`
+	StripePriceID        string     `db:"stripe_price_id"`
+	Plan                 string     `db:"plan"`
+	Status               string     `db:"status"`
+	CurrentPeriodEnd     *time.Time `db:"current_period_end"`
+	CancelAtPeriodEnd    bool       `db:"cancel_at_period_end"`
+	StripeEventAt        time.Time  `db:"stripe_event_at"`
+}
+
+func (m subscriptionModel) toDomain() *subscription.Subscription {
+	return subscription.UnmarshalFromDatabase(
+		m.StripeSubscriptionID,
+		m.StripeCustomerID,
+		m.UserID,
+		m.StripePriceID,
+		m.Plan,
+		subscription.Status(m.Status),
+		m.CurrentPeriodEnd,
+		m.CancelAtPeriodEnd,
+		m.StripeEventAt,
+	)
+}
+
+const subscriptionColumns = `
+	stripe_subscription_id, stripe_customer_id, user_id, stripe_price_id,
+	plan, status, current_period_end, cancel_at_period_end, stripe_event_at
+`
+
+// SubscriptionPostgresRepository implements subscription.Repository and
+// plan.Reader
+type SubscriptionPostgresRepository struct {
+	db database.DBTX
+}
+
+func NewSubscriptionPostgresRepository(db database.DBTX) *SubscriptionPostgresRepository {
+	return &SubscriptionPostgresRepository{db: db}
+}
+
+var (
+	_ subscription.Repository = (*SubscriptionPostgresRepository)(nil)
+	_ plan.Reader             = (*SubscriptionPostgresRepository)(nil)
+)
+
+func (r *SubscriptionPostgresRepository) FindByStripeID(ctx context.Context, stripeSubscriptionID string) (*subscription.Subscription, error) {
+	query := `SELECT ` + subscriptionColumns + ` FROM subscriptions WHERE stripe_subscription_id = $1`
+
+	var m subscriptionModel
+	if err := r.db.GetContext(ctx, &m, query, stripeSubscriptionID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, subscription.ErrNotFound
+		}
+		return nil, fmt.Errorf("find subscription: %w", err)
+	}
+	return m.toDomain(), nil
+}
+
+func (r *SubscriptionPostgresRepository) FindUserByCustomerID(ctx context.Context, stripeCustomerID string) (string, error) {
+	query := `SELECT user_id FROM subscriptions WHERE stripe_customer_id = $1 LIMIT 1`
+
+	var userID string
+	if err := r.db.GetContext(ctx, &userID, query, stripeCustomerID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", subscription.ErrUnknownUser
+		}
+		return "", fmt.Errorf("find subscription customer: %w", err)
+	}
+	return userID, nil
+}
+
+func (r *SubscriptionPostgresRepository) ListByUserID(ctx context.Context, userID string) ([]*subscription.Subscription, error) {
+	query := `SELECT ` + subscriptionColumns + ` FROM subscriptions WHERE user_id = $1 ORDER BY created_at`
+
+	var models []subscriptionModel
+	if err := r.db.SelectContext(ctx, &models, query, userID); err != nil {
+		return nil, fmt.Errorf("list subscriptions: %w", err)
+	}
+
+	subs := make([]*subscription.Subscription, 0, len(models))
+	for _, m := range models {
+		subs = append(subs, m.toDomain())
+	}
+	return subs, nil
+}
+
+// Save upserts the subscription and updates the user's plan in a single
+// statement. An event older than the stored one changes neither, so a
+// delivery that lost a race with a newer one cannot undo it.
+func (r *SubscriptionPostgresRepository) Save(ctx context.Context, sub *subscription.Subscription, userPlan string) error {
+	query := `
+		WITH saved AS (
+			INSERT INTO subscriptions (
+				stripe_subscription_id, stripe_customer_id, user_id, stripe_price_id,
+				plan, status, current_period_end, cancel_at_period_end, stripe_event_at
+			)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+			ON CONFLICT (stripe_subscription_id) DO UPDATE SET
+				stripe_price_id = EXCLUDED.stripe_price_id,
+				plan = EXCLUDED.plan,
+				status = EXCLUDED.status,
+				current_period_end = EXCLUDED.current_period_end,
+				cancel_at_period_end = EXCLUDED.cancel_at_period_end,
+				stripe_event_at = EXCLUDED.stripe_event_at,
+				updated_at = NOW()
+			WHERE subscriptions.stripe_event_at <= EXCLUDED.stripe_event_at
+			RETURNING user_id
+		)
+		UPDATE users
+		SET plan = $10, updated_at = NOW()
+		WHERE user_id IN (SELECT user_id FROM saved)
+	`
+	_, err := r.db.ExecContext(ctx, query,
+		sub.StripeSubscriptionID(),
+		sub.StripeCustomerID(),
+		sub.UserID(),
+		sub.PriceID(),
+		sub.Plan(),
+		string(sub.Status()),
+		sub.CurrentPeriodEnd(),
+		sub.CancelAtPeriodEnd(),
+		sub.EventAt(),
+		userPlan,
+	)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) {
+			switch pqErr.Code {
+			case "23503", // foreign_key_violation
+				"22P02": // invalid_text_representation (bad UUID format)
+				return subscription.ErrUnknownUser
+			}
+		}
+		return fmt.Errorf("save subscription: %w", err)
+	}
+	return nil
+}
+
+func (r *SubscriptionPostgresRepository) GetUserPlan(ctx context.Context, userID string) (string, error) {
+	query := `SELECT plan FROM users WHERE user_id = $1`
+
+	var userPlan string
+	if err := r.db.GetContext(ctx, &userPlan, query, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return plan.Free, nil
+		}
+		return "", fmt.Errorf("get user plan: %w", err)
+	}
+	return userPlan, nil
+}
@@ -0,0 +1,19 @@
+package app
+
+import (
+	"net/http"
+
+	"github.com/semmidev/ethos-go/internal/billing/app/command"
+)
+
+type Application struct {
+	Commands Commands
+
+	// StripeWebhookHandler consumes Stripe subscription events; nil when
+	// no webhook secret is configured
+	StripeWebhookHandler http.Handler
+}
+
+type Commands struct {
+	SyncSubscription command.SyncSubscriptionHandler
+}
@@ -0,0 +1,131 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/semmidev/ethos-go/internal/billing/domain/plan"
+	"github.com/semmidev/ethos-go/internal/billing/domain/subscription"
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+)
+
+// SyncSubscription command applies a Stripe subscription event and moves
+// the subscriber to the plan their subscriptions now give them
+type SyncSubscription struct {
+	SubscriptionID    string
+	CustomerID        string
+	UserID            string // From the subscription's metadata; may be empty on later events
+	PriceID           string
+	Status            string
+	CurrentPeriodEnd  *time.Time
+	CancelAtPeriodEnd bool
+	EventAt           time.Time
+}
+
+// SyncSubscriptionHandler processes subscription sync commands
+type SyncSubscriptionHandler decorator.CommandHandler[SyncSubscription]
+
+type syncSubscriptionHandler struct {
+	repo   subscription.Repository
+	prices plan.Prices
+}
+
+// NewSyncSubscriptionHandler creates a new handler with decorators
+func NewSyncSubscriptionHandler(
+	repo subscription.Repository,
+	prices plan.Prices,
+	log logger.Logger,
+	metricsClient decorator.MetricsClient,
+) SyncSubscriptionHandler {
+	if repo == nil {
+		panic("nil subscription repository")
+	}
+
+	return decorator.ApplyCommandDecorators(
+		syncSubscriptionHandler{
+			repo:   repo,
+			prices: prices,
+		},
+		log,
+		metricsClient,
+	)
+}
+
+func (h syncSubscriptionHandler) Handle(ctx context.Context, cmd SyncSubscription) error {
+	planName, ok := h.prices[cmd.PriceID]
+	if !ok {
+		return apperror.InvalidInput("price", "price "+cmd.PriceID+" is not mapped to a plan").WithError(plan.ErrUnknownPrice)
+	}
+
+	change := subscription.Change{
+		PriceID:           cmd.PriceID,
+		Plan:              planName,
+		Status:            subscription.Status(cmd.Status),
+		CurrentPeriodEnd:  cmd.CurrentPeriodEnd,
+		CancelAtPeriodEnd: cmd.CancelAtPeriodEnd,
+		EventAt:           cmd.EventAt,
+	}
+
+	sub, err := h.repo.FindByStripeID(ctx, cmd.SubscriptionID)
+	switch {
+	case errors.Is(err, subscription.ErrNotFound):
+		sub, err = h.newSubscription(ctx, cmd, change)
+		if err != nil {
+			return err
+		}
+	case err != nil:
+		return err
+	default:
+		if !sub.Apply(change) {
+			// A newer event was applied already
+			return nil
+		}
+	}
+
+	subs, err := h.repo.ListByUserID(ctx, sub.UserID())
+	if err != nil {
+		return err
+	}
+	for i, s := range subs {
+		if s.StripeSubscriptionID() == sub.StripeSubscriptionID() {
+			subs = append(subs[:i], subs[i+1:]...)
+			break
+		}
+	}
+
+	err = h.repo.Save(ctx, sub, subscription.UserPlan(append(subs, sub)))
+	if errors.Is(err, subscription.ErrUnknownUser) {
+		return apperror.NotFound("user", sub.UserID())
+	}
+	return err
+}
+
+// newSubscription creates the subscription for its first event. The user
+// comes from the metadata set at checkout or else from an earlier
+// subscription of the same customer.
+func (h syncSubscriptionHandler) newSubscription(
+	ctx context.Context,
+	cmd SyncSubscription,
+	change subscription.Change,
+) (*subscription.Subscription, error) {
+	userID := cmd.UserID
+	if userID == "" {
+		var err error
+		userID, err = h.repo.FindUserByCustomerID(ctx, cmd.CustomerID)
+		if errors.Is(err, subscription.ErrUnknownUser) {
+			return nil, apperror.NotFound("customer", cmd.CustomerID)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sub, err := subscription.New(cmd.SubscriptionID, cmd.CustomerID, userID, change)
+	if err != nil {
+		return nil, apperror.InvalidInput("subscription", err.Error())
+	}
+	return sub, nil
+}
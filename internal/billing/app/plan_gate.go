@@ -0,0 +1,66 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/semmidev/ethos-go/internal/billing/domain/plan"
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+)
+
+// Entitlement names reported in PLAN_LIMIT_EXCEEDED errors
+const (
+	LimitMaxActiveHabits   = "max_active_habits"
+	LimitAdvancedAnalytics = "advanced_analytics"
+)
+
+// PlanGate checks actions against the entitlements of the user's plan.
+// Other modules call it before doing what a plan may not allow.
+type PlanGate struct {
+	plans plan.Reader
+}
+
+// NewPlanGate creates a gate reading users' plans from plans
+func NewPlanGate(plans plan.Reader) *PlanGate {
+	if plans == nil {
+		panic("nil plan reader")
+	}
+	return &PlanGate{plans: plans}
+}
+
+// CheckActiveHabits fails with PLAN_LIMIT_EXCEEDED when the user's plan
+// does not allow them to have active habits at once
+func (g *PlanGate) CheckActiveHabits(ctx context.Context, userID string, active int) error {
+	userPlan, err := g.plans.GetUserPlan(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	limit := plan.EntitlementsOf(userPlan).MaxActiveHabits
+	if limit > 0 && active > limit {
+		return apperror.PlanLimitExceeded(
+			userPlan,
+			LimitMaxActiveHabits,
+			fmt.Sprintf("The %s plan allows up to %d active habits", userPlan, limit),
+		).WithDetails("max", limit)
+	}
+	return nil
+}
+
+// CheckAdvancedAnalytics fails with PLAN_LIMIT_EXCEEDED unless the user's
+// plan includes advanced analytics
+func (g *PlanGate) CheckAdvancedAnalytics(ctx context.Context, userID string) error {
+	userPlan, err := g.plans.GetUserPlan(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if !plan.EntitlementsOf(userPlan).AdvancedAnalytics {
+		return apperror.PlanLimitExceeded(
+			userPlan,
+			LimitAdvancedAnalytics,
+			fmt.Sprintf("Advanced analytics are not included in the %s plan", userPlan),
+		)
+	}
+	return nil
+}
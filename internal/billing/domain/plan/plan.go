@@ -0,0 +1,95 @@
+package plan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Plans a user can be on. Every user starts on Free; a Stripe subscription
+// to one of the configured prices moves them up.
+const (
+	Free = "free"
+	Pro  = "pro"
+)
+
+// Entitlements are what a plan allows
+type Entitlements struct {
+	// MaxActiveHabits caps the active and paused habits a user can have,
+	// 0 when unlimited
+	MaxActiveHabits int
+	// AdvancedAnalytics unlocks insights, period comparisons and habit
+	// correlations
+	AdvancedAnalytics bool
+}
+
+var entitlements = map[string]Entitlements{
+	Free: {MaxActiveHabits: 5},
+	Pro:  {AdvancedAnalytics: true},
+}
+
+// rank orders plans from least to most generous
+var rank = map[string]int{
+	Free: 0,
+	Pro:  1,
+}
+
+// EntitlementsOf returns what plan allows. Unknown plans get Free's
+// entitlements, so a typo never unlocks anything.
+func EntitlementsOf(plan string) Entitlements {
+	if e, ok := entitlements[plan]; ok {
+		return e
+	}
+	return entitlements[Free]
+}
+
+// IsValid reports whether plan is a known plan
+func IsValid(plan string) bool {
+	_, ok := rank[plan]
+	return ok
+}
+
+// Best returns the more generous of two plans
+func Best(a, b string) string {
+	if rank[b] > rank[a] {
+		return b
+	}
+	return a
+}
+
+// Prices maps Stripe price IDs to the plan a subscription to them grants
+type Prices map[string]string
+
+// ErrUnknownPrice is returned for a subscription to a price that is not
+// mapped to a plan
+var ErrUnknownPrice = errors.New("price is not mapped to a plan")
+
+// ParsePrices parses a comma separated list of price=plan pairs, such as
+// "price_monthly=pro,price_yearly=pro"
+func ParsePrices(s string) (Prices, error) {
+	prices := Prices{}
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		price, plan, ok := strings.Cut(pair, "=")
+		price, plan = strings.TrimSpace(price), strings.TrimSpace(plan)
+		if !ok || price == "" {
+			return nil, fmt.Errorf("%q is not a price=plan pair", pair)
+		}
+		if !IsValid(plan) || plan == Free {
+			return nil, fmt.Errorf("price %s: %q is not a paid plan", price, plan)
+		}
+		prices[price] = plan
+	}
+	return prices, nil
+}
+
+// Reader looks up the plan a user is on
+type Reader interface {
+	// GetUserPlan returns the user's plan, Free for unknown users
+	GetUserPlan(ctx context.Context, userID string) (string, error)
+}
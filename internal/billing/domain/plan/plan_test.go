@@ -0,0 +1,47 @@
+package plan_test
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/billing/domain/plan"
+)
+
+func TestParsePrices(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given Stripe prices configured per plan", t, func() {
+		Convey("When they map prices to paid plans", func() {
+			prices, err := plan.ParsePrices(" price_monthly = pro, price_yearly=pro ")
+
+			Convey("Then every price grants its plan", func() {
+				So(err, ShouldBeNil)
+				So(prices, ShouldResemble, plan.Prices{"price_monthly": plan.Pro, "price_yearly": plan.Pro})
+			})
+		})
+
+		Convey("When a price grants the free plan or an unknown one", func() {
+			_, err := plan.ParsePrices("price_monthly=free")
+			So(err, ShouldNotBeNil)
+
+			_, err = plan.ParsePrices("price_monthly=team")
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("When an entry is not price=plan", func() {
+			_, err := plan.ParsePrices("price_monthly")
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestEntitlementsOf(t *testing.T) {
+	t.Parallel()
+
+	Convey("Unknown plans get the free plan's entitlements", t, func() {
+		So(plan.EntitlementsOf("enterprise"), ShouldResemble, plan.EntitlementsOf(plan.Free))
+		So(plan.EntitlementsOf(plan.Pro).AdvancedAnalytics, ShouldBeTrue)
+		So(plan.EntitlementsOf(plan.Pro).MaxActiveHabits, ShouldEqual, 0)
+	})
+}
@@ -0,0 +1,12 @@
+package subscription
+
+import "errors"
+
+// Domain errors
+var (
+	ErrNotFound        = errors.New("subscription not found")
+	ErrMissingStripeID = errors.New("subscription or customer id missing")
+	// ErrUnknownUser is a subscription that cannot be tied to a user: it
+	// carries no user id and its customer has no other subscription
+	ErrUnknownUser = errors.New("subscription does not belong to a known user")
+)
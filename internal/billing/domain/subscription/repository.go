@@ -0,0 +1,22 @@
+package subscription
+
+import "context"
+
+// Repository stores subscriptions
+type Repository interface {
+	// FindByStripeID looks up a subscription by its Stripe id.
+	// Returns ErrNotFound if there is none.
+	FindByStripeID(ctx context.Context, stripeSubscriptionID string) (*Subscription, error)
+
+	// FindUserByCustomerID returns the user an earlier subscription of the
+	// Stripe customer belongs to. Returns ErrUnknownUser if there is none.
+	FindUserByCustomerID(ctx context.Context, stripeCustomerID string) (string, error)
+
+	// ListByUserID returns all of the user's subscriptions
+	ListByUserID(ctx context.Context, userID string) ([]*Subscription, error)
+
+	// Save creates or updates the subscription and moves its user to
+	// userPlan in one step. Returns ErrUnknownUser if the user does not
+	// exist.
+	Save(ctx context.Context, sub *Subscription, userPlan string) error
+}
@@ -0,0 +1,142 @@
+package subscription
+
+import (
+	"time"
+
+	"github.com/semmidev/ethos-go/internal/billing/domain/plan"
+)
+
+// Status is a Stripe subscription status
+type Status string
+
+// Stripe subscription statuses
+const (
+	StatusIncomplete        Status = "incomplete"
+	StatusIncompleteExpired Status = "incomplete_expired"
+	StatusTrialing          Status = "trialing"
+	StatusActive            Status = "active"
+	StatusPastDue           Status = "past_due"
+	StatusCanceled          Status = "canceled"
+	StatusUnpaid            Status = "unpaid"
+	StatusPaused            Status = "paused"
+)
+
+// GrantsPlan reports whether a subscription in this status gives its user
+// its plan. Past due subscriptions keep it while Stripe retries the payment.
+func (s Status) GrantsPlan() bool {
+	switch s {
+	case StatusTrialing, StatusActive, StatusPastDue:
+		return true
+	}
+	return false
+}
+
+// Subscription mirrors a user's Stripe subscription. It changes only through
+// Stripe's webhook events, applied in the order Stripe created them.
+// Fields are private to enforce encapsulation - use getters for read access.
+type Subscription struct {
+	stripeSubscriptionID string
+	stripeCustomerID     string
+	userID               string
+	priceID              string
+	plan                 string
+	status               Status
+	currentPeriodEnd     *time.Time
+	cancelAtPeriodEnd    bool
+	eventAt              time.Time
+}
+
+// Getters for Subscription fields
+
+func (s *Subscription) StripeSubscriptionID() string { return s.stripeSubscriptionID }
+func (s *Subscription) StripeCustomerID() string     { return s.stripeCustomerID }
+func (s *Subscription) UserID() string               { return s.userID }
+func (s *Subscription) PriceID() string              { return s.priceID }
+func (s *Subscription) Plan() string                 { return s.plan }
+func (s *Subscription) Status() Status               { return s.status }
+func (s *Subscription) CurrentPeriodEnd() *time.Time { return s.currentPeriodEnd }
+func (s *Subscription) CancelAtPeriodEnd() bool      { return s.cancelAtPeriodEnd }
+func (s *Subscription) EventAt() time.Time           { return s.eventAt }
+
+// Change is the state of a subscription carried by one Stripe event
+type Change struct {
+	PriceID           string
+	Plan              string
+	Status            Status
+	CurrentPeriodEnd  *time.Time
+	CancelAtPeriodEnd bool
+	EventAt           time.Time // When Stripe created the event
+}
+
+// New creates a subscription of userID from its first event
+func New(stripeSubscriptionID, stripeCustomerID, userID string, change Change) (*Subscription, error) {
+	if stripeSubscriptionID == "" || stripeCustomerID == "" {
+		return nil, ErrMissingStripeID
+	}
+	if userID == "" {
+		return nil, ErrUnknownUser
+	}
+
+	s := &Subscription{
+		stripeSubscriptionID: stripeSubscriptionID,
+		stripeCustomerID:     stripeCustomerID,
+		userID:               userID,
+	}
+	s.Apply(change)
+	return s, nil
+}
+
+// Apply updates the subscription to the state in change. Stripe does not
+// deliver events in order, so a change older than the last one applied is
+// ignored; Apply reports whether it was applied.
+func (s *Subscription) Apply(change Change) bool {
+	if change.EventAt.Before(s.eventAt) {
+		return false
+	}
+
+	s.priceID = change.PriceID
+	s.plan = change.Plan
+	s.status = change.Status
+	s.currentPeriodEnd = change.CurrentPeriodEnd
+	s.cancelAtPeriodEnd = change.CancelAtPeriodEnd
+	s.eventAt = change.EventAt
+	return true
+}
+
+// EffectivePlan is the plan the subscription gives its user right now
+func (s *Subscription) EffectivePlan() string {
+	if !s.status.GrantsPlan() {
+		return plan.Free
+	}
+	return s.plan
+}
+
+// UserPlan is the most generous plan the subscriptions give their user
+func UserPlan(subs []*Subscription) string {
+	best := plan.Free
+	for _, s := range subs {
+		best = plan.Best(best, s.EffectivePlan())
+	}
+	return best
+}
+
+// UnmarshalFromDatabase reconstructs a Subscription from database fields
+func UnmarshalFromDatabase(
+	stripeSubscriptionID, stripeCustomerID, userID, priceID, planName string,
+	status Status,
+	currentPeriodEnd *time.Time,
+	cancelAtPeriodEnd bool,
+	eventAt time.Time,
+) *Subscription {
+	return &Subscription{
+		stripeSubscriptionID: stripeSubscriptionID,
+		stripeCustomerID:     stripeCustomerID,
+		userID:               userID,
+		priceID:              priceID,
+		plan:                 planName,
+		status:               status,
+		currentPeriodEnd:     currentPeriodEnd,
+		cancelAtPeriodEnd:    cancelAtPeriodEnd,
+		eventAt:              eventAt,
+	}
+}
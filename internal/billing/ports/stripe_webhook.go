@@ -0,0 +1,197 @@
+package ports
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/semmidev/ethos-go/internal/billing/app/command"
+	"github.com/semmidev/ethos-go/internal/billing/domain/plan"
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/httputil"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+)
+
+const (
+	// stripeSignatureTolerance is how old a signed event may be, which
+	// bounds how long a captured request can be replayed
+	stripeSignatureTolerance = 5 * time.Minute
+	stripeMaxRequestSize     = 1 << 16
+)
+
+// Subscription event types consumed from Stripe
+const (
+	stripeSubscriptionCreated = "customer.subscription.created"
+	stripeSubscriptionUpdated = "customer.subscription.updated"
+	stripeSubscriptionDeleted = "customer.subscription.deleted"
+)
+
+// stripeEvent is the part of a Stripe event the webhook reads
+type stripeEvent struct {
+	ID      string `json:"id"`
+	Type    string `json:"type"`
+	Created int64  `json:"created"`
+	Data    struct {
+		Object stripeSubscription `json:"object"`
+	} `json:"data"`
+}
+
+// stripeSubscription is the part of a Stripe subscription object the
+// webhook reads. Newer API versions moved current_period_end onto the
+// subscription items, so it is read from either.
+type stripeSubscription struct {
+	ID                string            `json:"id"`
+	Customer          string            `json:"customer"`
+	Status            string            `json:"status"`
+	CancelAtPeriodEnd bool              `json:"cancel_at_period_end"`
+	CurrentPeriodEnd  int64             `json:"current_period_end"`
+	Metadata          map[string]string `json:"metadata"`
+	Items             struct {
+		Data []struct {
+			CurrentPeriodEnd int64 `json:"current_period_end"`
+			Price            struct {
+				ID string `json:"id"`
+			} `json:"price"`
+		} `json:"data"`
+	} `json:"items"`
+}
+
+// StripeWebhookHandler consumes Stripe's subscription events and keeps the
+// subscriptions table and users' plans in step with them. Requests must be
+// signed with secret, the webhook endpoint's signing secret. Checkout must
+// set the user's id as user_id in the subscription's metadata.
+//
+// Other event types are acknowledged and ignored. Events that cannot be
+// tied to an existing user or to a plan, such as a subscription to a price
+// that isn't configured, are acknowledged too, as retrying cannot help
+// them; any other failure is answered with an error so Stripe retries.
+func StripeWebhookHandler(secret string, handler command.SyncSubscriptionHandler, log logger.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		payload, err := io.ReadAll(io.LimitReader(r.Body, stripeMaxRequestSize))
+		if err != nil {
+			httputil.Error(w, r, apperror.InvalidInput("body", "could not read the request body"))
+			return
+		}
+
+		if err := verifyStripeSignature(payload, r.Header.Get("Stripe-Signature"), secret, time.Now()); err != nil {
+			httputil.Error(w, r, apperror.Unauthorized(err.Error()))
+			return
+		}
+
+		var event stripeEvent
+		if err := json.Unmarshal(payload, &event); err != nil {
+			httputil.Error(w, r, apperror.InvalidInput("body", "not a Stripe event"))
+			return
+		}
+
+		switch event.Type {
+		case stripeSubscriptionCreated, stripeSubscriptionUpdated, stripeSubscriptionDeleted:
+		default:
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+
+		cmd := syncSubscriptionCommand(event)
+		err = handler.Handle(ctx, cmd)
+		var appErr *apperror.AppError
+		switch {
+		case errors.Is(err, plan.ErrUnknownPrice):
+			log.Warn(ctx, "Stripe subscription event for a price without a plan ignored",
+				logger.Field{Key: "event_id", Value: event.ID},
+				logger.Field{Key: "subscription_id", Value: cmd.SubscriptionID},
+				logger.Field{Key: "price_id", Value: cmd.PriceID},
+			)
+			err = nil
+		case errors.As(err, &appErr) && appErr.Code == apperror.ErrCodeNotFound:
+			log.Warn(ctx, "Stripe subscription event for an unknown user ignored",
+				logger.Field{Key: "event_id", Value: event.ID},
+				logger.Field{Key: "subscription_id", Value: cmd.SubscriptionID},
+			)
+			err = nil
+		}
+		if err != nil {
+			httputil.Error(w, r, err)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+func syncSubscriptionCommand(event stripeEvent) command.SyncSubscription {
+	sub := event.Data.Object
+
+	cmd := command.SyncSubscription{
+		SubscriptionID:    sub.ID,
+		CustomerID:        sub.Customer,
+		UserID:            sub.Metadata["user_id"],
+		Status:            sub.Status,
+		CancelAtPeriodEnd: sub.CancelAtPeriodEnd,
+		EventAt:           time.Unix(event.Created, 0).UTC(),
+	}
+
+	periodEnd := sub.CurrentPeriodEnd
+	if len(sub.Items.Data) > 0 {
+		cmd.PriceID = sub.Items.Data[0].Price.ID
+		if periodEnd == 0 {
+			periodEnd = sub.Items.Data[0].CurrentPeriodEnd
+		}
+	}
+	if periodEnd > 0 {
+		end := time.Unix(periodEnd, 0).UTC()
+		cmd.CurrentPeriodEnd = &end
+	}
+
+	return cmd
+}
+
+// verifyStripeSignature checks a Stripe-Signature header: a timestamp t and
+// one or more v1 signatures, each an HMAC-SHA256 of "t.payload" keyed with
+// the signing secret
+func verifyStripeSignature(payload []byte, header, secret string, now time.Time) error {
+	var (
+		timestamp  string
+		signatures [][]byte
+	)
+	for _, part := range strings.Split(header, ",") {
+		key, value, _ := strings.Cut(strings.TrimSpace(part), "=")
+		switch key {
+		case "t":
+			timestamp = value
+		case "v1":
+			if sig, err := hex.DecodeString(value); err == nil {
+				signatures = append(signatures, sig)
+			}
+		}
+	}
+
+	signedAt, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil || len(signatures) == 0 {
+		return errors.New("missing or malformed Stripe-Signature header")
+	}
+	if age := now.Sub(time.Unix(signedAt, 0)); age > stripeSignatureTolerance || age < -stripeSignatureTolerance {
+		return errors.New("signature timestamp outside the tolerance")
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	expected := mac.Sum(nil)
+
+	for _, sig := range signatures {
+		if hmac.Equal(sig, expected) {
+			return nil
+		}
+	}
+	return errors.New("signature does not match")
+}
@@ -0,0 +1,170 @@
+package ports_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/billing/app/command"
+	"github.com/semmidev/ethos-go/internal/billing/domain/plan"
+	"github.com/semmidev/ethos-go/internal/billing/domain/subscription"
+	"github.com/semmidev/ethos-go/internal/billing/ports"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/testutil"
+)
+
+const webhookSecret = "whsec_test"
+
+func TestStripeWebhook(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given the Stripe webhook and a user on the free plan", t, func() {
+		ctx := context.Background()
+		userID := uuid.NewString()
+		repo := testutil.NewSubscriptionRepository(userID)
+		logs := testutil.NewRecordingLogger()
+
+		handler := ports.StripeWebhookHandler(
+			webhookSecret,
+			command.NewSyncSubscriptionHandler(
+				repo, plan.Prices{"price_pro": plan.Pro},
+				testutil.NopLogger{}, &decorator.NoOpMetricsClient{},
+			),
+			logs,
+		)
+
+		created := time.Now().Unix()
+		event := func(eventType, status, metadataUserID string, created int64) string {
+			return fmt.Sprintf(`{
+				"id": "evt_%d",
+				"type": %q,
+				"created": %d,
+				"data": {"object": {
+					"id": "sub_1",
+					"customer": "cus_1",
+					"status": %q,
+					"cancel_at_period_end": false,
+					"metadata": {"user_id": %q},
+					"items": {"data": [{"current_period_end": 1800000000, "price": {"id": "price_pro"}}]}
+				}}
+			}`, created, eventType, created, status, metadataUserID)
+		}
+		send := func(payload, signature string) int {
+			req := httptest.NewRequest(http.MethodPost, "/webhooks/stripe", strings.NewReader(payload))
+			req.Header.Set("Stripe-Signature", signature)
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+			return w.Code
+		}
+		userPlan := func() string {
+			p, err := repo.GetUserPlan(ctx, userID)
+			So(err, ShouldBeNil)
+			return p
+		}
+
+		Convey("When a subscription is created", func() {
+			payload := event("customer.subscription.created", "active", userID, created)
+			So(send(payload, sign(payload, time.Now())), ShouldEqual, http.StatusOK)
+
+			Convey("Then it is stored and the user moves to its plan", func() {
+				sub, err := repo.FindByStripeID(ctx, "sub_1")
+				So(err, ShouldBeNil)
+				So(sub.UserID(), ShouldEqual, userID)
+				So(sub.Status(), ShouldEqual, subscription.StatusActive)
+				So(sub.CurrentPeriodEnd().Unix(), ShouldEqual, 1800000000)
+				So(userPlan(), ShouldEqual, plan.Pro)
+			})
+
+			Convey("And it is deleted", func() {
+				payload := event("customer.subscription.deleted", "canceled", "", created+60)
+				So(send(payload, sign(payload, time.Now())), ShouldEqual, http.StatusOK)
+
+				Convey("Then the user is back on the free plan", func() {
+					So(userPlan(), ShouldEqual, plan.Free)
+				})
+
+				Convey("And an older update arrives late", func() {
+					payload := event("customer.subscription.updated", "active", "", created+30)
+					So(send(payload, sign(payload, time.Now())), ShouldEqual, http.StatusOK)
+
+					Convey("Then it is ignored", func() {
+						So(userPlan(), ShouldEqual, plan.Free)
+					})
+				})
+			})
+		})
+
+		Convey("When a subscription of a user that does not exist arrives", func() {
+			payload := event("customer.subscription.created", "active", uuid.NewString(), created)
+
+			Convey("Then it is acknowledged and not stored", func() {
+				So(send(payload, sign(payload, time.Now())), ShouldEqual, http.StatusOK)
+				_, err := repo.FindByStripeID(ctx, "sub_1")
+				So(err, ShouldEqual, subscription.ErrNotFound)
+			})
+		})
+
+		Convey("When a subscription to a price without a plan arrives", func() {
+			payload := strings.Replace(event("customer.subscription.created", "active", userID, created), "price_pro", "price_legacy", 1)
+			code := send(payload, sign(payload, time.Now()))
+
+			Convey("Then it is acknowledged with a warning and not stored", func() {
+				So(code, ShouldEqual, http.StatusOK)
+				_, err := repo.FindByStripeID(ctx, "sub_1")
+				So(err, ShouldEqual, subscription.ErrNotFound)
+				So(userPlan(), ShouldEqual, plan.Free)
+
+				entries := logs.Entries()
+				So(entries, ShouldHaveLength, 1)
+				So(entries[0].Level, ShouldEqual, "warn")
+				So(entries[0].Fields["price_id"], ShouldEqual, "price_legacy")
+			})
+		})
+
+		Convey("When an event is not signed with the secret", func() {
+			payload := event("customer.subscription.created", "active", userID, created)
+			code := send(payload, "t="+strconv.FormatInt(created, 10)+",v1="+strings.Repeat("0", 64))
+
+			Convey("Then it is refused", func() {
+				So(code, ShouldEqual, http.StatusUnauthorized)
+				So(userPlan(), ShouldEqual, plan.Free)
+			})
+		})
+
+		Convey("When a signed event is replayed much later", func() {
+			payload := event("customer.subscription.created", "active", userID, created)
+			code := send(payload, sign(payload, time.Now().Add(-time.Hour)))
+
+			Convey("Then it is refused", func() {
+				So(code, ShouldEqual, http.StatusUnauthorized)
+			})
+		})
+
+		Convey("When an event of another type arrives", func() {
+			payload := `{"id": "evt_2", "type": "invoice.paid", "created": 1, "data": {"object": {}}}`
+
+			Convey("Then it is acknowledged", func() {
+				So(send(payload, sign(payload, time.Now())), ShouldEqual, http.StatusOK)
+			})
+		})
+	})
+}
+
+// sign builds a Stripe-Signature header for payload signed at t
+func sign(payload string, t time.Time) string {
+	timestamp := strconv.FormatInt(t.Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(webhookSecret))
+	mac.Write([]byte(timestamp + "." + payload))
+	return "t=" + timestamp + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/semmidev/ethos-go/config"
+	"github.com/semmidev/ethos-go/internal/billing/adapters"
+	"github.com/semmidev/ethos-go/internal/billing/app"
+	"github.com/semmidev/ethos-go/internal/billing/app/command"
+	"github.com/semmidev/ethos-go/internal/billing/domain/plan"
+	"github.com/semmidev/ethos-go/internal/billing/ports"
+	"github.com/semmidev/ethos-go/internal/common/database"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+)
+
+// NewApplication creates and wires all dependencies for the billing module
+func NewApplication(
+	cfg *config.Config,
+	db database.DBTX,
+	log logger.Logger,
+	metricsClient decorator.MetricsClient,
+) app.Application {
+	repo := adapters.NewSubscriptionPostgresRepository(db)
+
+	prices, err := plan.ParsePrices(cfg.StripePricePlans)
+	if err != nil {
+		panic(fmt.Sprintf("invalid Stripe price plans: %v", err))
+	}
+
+	syncSubscription := command.NewSyncSubscriptionHandler(repo, prices, log, metricsClient)
+
+	return app.Application{
+		Commands: app.Commands{
+			SyncSubscription: syncSubscription,
+		},
+		StripeWebhookHandler: stripeWebhookHandler(cfg, syncSubscription, log),
+	}
+}
+
+// NewPlanGate creates the gate other modules check plan entitlements with
+func NewPlanGate(db database.DBTX) *app.PlanGate {
+	return app.NewPlanGate(adapters.NewSubscriptionPostgresRepository(db))
+}
+
+// stripeWebhookHandler consumes Stripe events when a webhook signing secret
+// is configured, and is nil otherwise
+func stripeWebhookHandler(cfg *config.Config, handler command.SyncSubscriptionHandler, log logger.Logger) http.Handler {
+	if cfg.StripeWebhookSecret == "" {
+		return nil
+	}
+	return ports.StripeWebhookHandler(cfg.StripeWebhookSecret, handler, log)
+}
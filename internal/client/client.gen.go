@@ -0,0 +1,11332 @@
+// Package client provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/oapi-codegen/runtime"
+)
+
+// Defines values for V1NotificationType.
+const (
+	NOTIFICATIONTYPEACHIEVEMENT        V1NotificationType = "NOTIFICATION_TYPE_ACHIEVEMENT"
+	NOTIFICATIONTYPEDAILYSUMMARY       V1NotificationType = "NOTIFICATION_TYPE_DAILY_SUMMARY"
+	NOTIFICATIONTYPEHABITREMINDER      V1NotificationType = "NOTIFICATION_TYPE_HABIT_REMINDER"
+	NOTIFICATIONTYPEREENGAGEMENT       V1NotificationType = "NOTIFICATION_TYPE_REENGAGEMENT"
+	NOTIFICATIONTYPEREMINDERESCALATION V1NotificationType = "NOTIFICATION_TYPE_REMINDER_ESCALATION"
+	NOTIFICATIONTYPESTREAKMILESTONE    V1NotificationType = "NOTIFICATION_TYPE_STREAK_MILESTONE"
+	NOTIFICATIONTYPESYSTEM             V1NotificationType = "NOTIFICATION_TYPE_SYSTEM"
+	NOTIFICATIONTYPEUNSPECIFIED        V1NotificationType = "NOTIFICATION_TYPE_UNSPECIFIED"
+	NOTIFICATIONTYPEWELCOME            V1NotificationType = "NOTIFICATION_TYPE_WELCOME"
+)
+
+// HabitsServiceEndVacationBody EndVacationRequest identifies a vacation to end.
+type HabitsServiceEndVacationBody struct {
+	// EndDate Last vacation day in YYYY-MM-DD format; defaults to today.
+	EndDate *string `json:"end_date,omitempty"`
+}
+
+// HabitsServiceLogHabitBody LogHabitRequest contains data for logging habit completion.
+type HabitsServiceLogHabitBody struct {
+	// Count Completion count.
+	Count *int32 `json:"count,omitempty"`
+
+	// LogDate Log date in YYYY-MM-DD format.
+	LogDate *string `json:"log_date,omitempty"`
+
+	// Note Optional note.
+	Note *string `json:"note,omitempty"`
+}
+
+// HabitsServicePauseHabitBody PauseHabitRequest pauses a habit until a date.
+type HabitsServicePauseHabitBody struct {
+	// Until Date (YYYY-MM-DD) the habit resumes on; must be after today.
+	Until *string `json:"until,omitempty"`
+}
+
+// HabitsServiceSetHabitLogCountBody SetHabitLogCountRequest sets a day's completion count.
+type HabitsServiceSetHabitLogCountBody struct {
+	// Count The day's completion count.
+	Count *int32 `json:"count,omitempty"`
+
+	// LogDate Log date in YYYY-MM-DD format (default: today).
+	LogDate *string `json:"log_date,omitempty"`
+}
+
+// HabitsServiceStartVacationBody StartVacationRequest contains data for starting a vacation.
+type HabitsServiceStartVacationBody struct {
+	// EndDate Last vacation day in YYYY-MM-DD format; omit for an open-ended vacation.
+	EndDate *string `json:"end_date,omitempty"`
+
+	// Reason Optional reason.
+	Reason *string `json:"reason,omitempty"`
+
+	// StartDate First vacation day in YYYY-MM-DD format.
+	StartDate *string `json:"start_date,omitempty"`
+}
+
+// HabitsServiceUndoHabitLogBody UndoHabitLogRequest identifies the day to undo a completion for.
+type HabitsServiceUndoHabitLogBody struct {
+	// LogDate Log date in YYYY-MM-DD format (default: today).
+	LogDate *string `json:"log_date,omitempty"`
+}
+
+// HabitsServiceUpdateHabitBody UpdateHabitRequest contains data for updating a habit.
+type HabitsServiceUpdateHabitBody struct {
+	// Description New description.
+	Description *string `json:"description,omitempty"`
+
+	// ExpectedVersion Version the client last read; the update fails with 409 Conflict if the
+	// habit has changed since. An If-Match header with the habit's ETag works
+	// too.
+	ExpectedVersion *int32 `json:"expected_version,omitempty"`
+
+	// Frequency New frequency.
+	Frequency *string `json:"frequency,omitempty"`
+
+	// Name New name.
+	Name *string `json:"name,omitempty"`
+
+	// Recurrence Recurrence describes on which weekdays and how often a habit repeats,
+	// e.g. days ["mon", "wed", "fri"] or interval 3 for every third day.
+	Recurrence *V1Recurrence `json:"recurrence,omitempty"`
+
+	// ReminderTime New reminder time.
+	ReminderTime *string `json:"reminder_time,omitempty"`
+
+	// TargetCount New target count.
+	TargetCount *int32 `json:"target_count,omitempty"`
+}
+
+// HabitsServiceUpdateHabitLogBody UpdateHabitLogRequest contains data for updating a habit log.
+type HabitsServiceUpdateHabitLogBody struct {
+	// Count New count.
+	Count *int32 `json:"count,omitempty"`
+
+	// LogDate New log date.
+	LogDate *string `json:"log_date,omitempty"`
+
+	// Note New note.
+	Note *string `json:"note,omitempty"`
+}
+
+// NotificationsServiceSnoozeNotificationBody SnoozeNotificationRequest pushes a notification back to a later time.
+type NotificationsServiceSnoozeNotificationBody struct {
+	// Until When to deliver the notification again, at most 30 days ahead.
+	Until *time.Time `json:"until,omitempty"`
+}
+
+// Ethosauthv1SuccessResponse SuccessResponse for simple success/failure responses.
+type Ethosauthv1SuccessResponse struct {
+	// Message Human-readable message describing the result.
+	Message *string `json:"message,omitempty"`
+
+	// Success Whether the operation was successful.
+	Success *bool `json:"success,omitempty"`
+}
+
+// Ethoshabitsv1SuccessResponse SuccessResponse for simple success/failure responses.
+type Ethoshabitsv1SuccessResponse struct {
+	// Message Human-readable message describing the result.
+	Message *string `json:"message,omitempty"`
+
+	// Success Whether the operation was successful.
+	Success *bool `json:"success,omitempty"`
+}
+
+// Ethosnotificationsv1SuccessResponse SuccessResponse for simple success/failure responses.
+type Ethosnotificationsv1SuccessResponse struct {
+	// Message Human-readable message describing the result.
+	Message *string `json:"message,omitempty"`
+
+	// Success Whether the operation was successful.
+	Success *bool `json:"success,omitempty"`
+}
+
+// ProtobufAny defines model for protobufAny.
+type ProtobufAny struct {
+	Type                 *string                `json:"@type,omitempty"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// RpcStatus defines model for rpcStatus.
+type RpcStatus struct {
+	Code    *int32         `json:"code,omitempty"`
+	Details *[]ProtobufAny `json:"details,omitempty"`
+	Message *string        `json:"message,omitempty"`
+}
+
+// V1AcceptConsentRequest AcceptConsentRequest names the document version being accepted.
+type V1AcceptConsentRequest struct {
+	// Document Document accepted: terms or privacy.
+	Document *string `json:"document,omitempty"`
+
+	// Version Version accepted; must be the document's current version.
+	Version *string `json:"version,omitempty"`
+}
+
+// V1BatchDeleteNotificationsRequest BatchDeleteNotificationsRequest lists notifications to delete together.
+type V1BatchDeleteNotificationsRequest struct {
+	// NotificationIds Notification identifiers, at most 100; all must belong to the user.
+	NotificationIds *[]string `json:"notification_ids,omitempty"`
+}
+
+// V1BatchMarkAsReadRequest BatchMarkAsReadRequest lists notifications to mark as read together.
+type V1BatchMarkAsReadRequest struct {
+	// NotificationIds Notification identifiers, at most 100; all must belong to the user.
+	NotificationIds *[]string `json:"notification_ids,omitempty"`
+}
+
+// V1ChangePasswordRequest ChangePasswordRequest contains password change data.
+type V1ChangePasswordRequest struct {
+	// CurrentPassword Current password for verification.
+	CurrentPassword *string `json:"current_password,omitempty"`
+
+	// NewPassword New password (min 8 chars).
+	NewPassword *string `json:"new_password,omitempty"`
+}
+
+// V1Consent Consent is one version of a document the user accepted.
+type V1Consent struct {
+	// AcceptedAt When it was accepted.
+	AcceptedAt *time.Time `json:"accepted_at,omitempty"`
+
+	// ClientIp Client IP address it was accepted from.
+	ClientIp *string `json:"client_ip,omitempty"`
+
+	// Document Document: terms or privacy.
+	Document *string `json:"document,omitempty"`
+
+	// Version Version accepted.
+	Version *string `json:"version,omitempty"`
+}
+
+// V1Consents Consents lists the published legal documents and the user's consents.
+type V1Consents struct {
+	// Documents Current version of every published document.
+	Documents *[]V1LegalDocument `json:"documents,omitempty"`
+
+	// History Every version the user accepted, newest first.
+	History *[]V1Consent `json:"history,omitempty"`
+}
+
+// V1ConsentsResponse ConsentsResponse contains the user's consents.
+type V1ConsentsResponse struct {
+	// Data Consents lists the published legal documents and the user's consents.
+	Data *V1Consents `json:"data,omitempty"`
+
+	// Message Human-readable message.
+	Message *string `json:"message,omitempty"`
+
+	// Success Whether the request was successful.
+	Success *bool `json:"success,omitempty"`
+}
+
+// V1CreateHabitRequest CreateHabitRequest contains data for creating a habit.
+type V1CreateHabitRequest struct {
+	// Description Habit description.
+	Description *string `json:"description,omitempty"`
+
+	// Frequency Habit frequency (default: daily).
+	Frequency *string `json:"frequency,omitempty"`
+
+	// Name Habit name (required).
+	Name *string `json:"name,omitempty"`
+
+	// Recurrence Recurrence describes on which weekdays and how often a habit repeats,
+	// e.g. days ["mon", "wed", "fri"] or interval 3 for every third day.
+	Recurrence *V1Recurrence `json:"recurrence,omitempty"`
+
+	// ReminderTime Reminder time in HH:MM format.
+	ReminderTime *string `json:"reminder_time,omitempty"`
+
+	// TargetCount Target count (default: 1).
+	TargetCount *int32 `json:"target_count,omitempty"`
+}
+
+// V1CreateNotificationRequest CreateNotificationRequest contains data for creating a notification.
+type V1CreateNotificationRequest struct {
+	// Data Additional data.
+	Data *map[string]interface{} `json:"data,omitempty"`
+
+	// DeliverAt Deliver the notification at this time instead of now, at most 30 days
+	// ahead. Until then it is pending and not listed.
+	DeliverAt *time.Time `json:"deliver_at,omitempty"`
+
+	// Message Notification message.
+	Message *string `json:"message,omitempty"`
+
+	// Title Notification title.
+	Title *string `json:"title,omitempty"`
+
+	// Type Notification type as string (streak_milestone, habit_reminder, etc.).
+	Type *string `json:"type,omitempty"`
+}
+
+// V1DailyAnalytics DailyAnalytics contains analytics for a single day.
+type V1DailyAnalytics struct {
+	// CompletionPercentage Completion percentage for this day (0-100).
+	CompletionPercentage *int32 `json:"completion_percentage,omitempty"`
+
+	// Date Date in YYYY-MM-DD format.
+	Date *string `json:"date,omitempty"`
+
+	// DayName Day of week name (Mon, Tue, etc.).
+	DayName *string `json:"day_name,omitempty"`
+
+	// LogsCount Number of habit logs on this day.
+	LogsCount *int32 `json:"logs_count,omitempty"`
+}
+
+// V1Dashboard Dashboard contains user dashboard data.
+type V1Dashboard struct {
+	// ActiveHabitsCount Number of active habits.
+	ActiveHabitsCount *int32 `json:"active_habits_count,omitempty"`
+
+	// CurrentStreak Current streak in days.
+	CurrentStreak *int32 `json:"current_streak,omitempty"`
+
+	// LongestStreak Longest streak achieved.
+	LongestStreak *int32 `json:"longest_streak,omitempty"`
+
+	// TotalLogs Total habit logs all time.
+	TotalLogs *int32 `json:"total_logs,omitempty"`
+
+	// TotalLogsToday Total logs created today.
+	TotalLogsToday *int32 `json:"total_logs_today,omitempty"`
+
+	// WeeklyCompletion Weekly completion percentage (0-100).
+	WeeklyCompletion *int32 `json:"weekly_completion,omitempty"`
+}
+
+// V1DashboardResponse DashboardResponse contains dashboard data.
+type V1DashboardResponse struct {
+	// Data Dashboard contains user dashboard data.
+	Data *V1Dashboard `json:"data,omitempty"`
+
+	// Message Human-readable message.
+	Message *string `json:"message,omitempty"`
+
+	// Success Whether the request was successful.
+	Success *bool `json:"success,omitempty"`
+}
+
+// V1DeactivateAccountRequest DeactivateAccountRequest deactivates the caller's account, taken from the
+// access token.
+type V1DeactivateAccountRequest = map[string]interface{}
+
+// V1DeleteAccountRequest DeleteAccountRequest requires password confirmation.
+type V1DeleteAccountRequest struct {
+	// Password Password confirmation for account deletion.
+	Password *string `json:"password,omitempty"`
+}
+
+// V1ExportUserDataResponse ExportUserDataResponse contains exported user data.
+type V1ExportUserDataResponse struct {
+	// Data Exported data as JSON.
+	Data *map[string]interface{} `json:"data,omitempty"`
+
+	// Success Whether the export was successful.
+	Success *bool `json:"success,omitempty"`
+}
+
+// V1ForgotPasswordRequest ForgotPasswordRequest contains the email for password reset.
+type V1ForgotPasswordRequest struct {
+	// Email Email address for password reset.
+	Email *string `json:"email,omitempty"`
+}
+
+// V1GetHabitLogsResponse GetHabitLogsResponse contains paginated habit logs.
+type V1GetHabitLogsResponse struct {
+	// Data List of habit logs.
+	Data *[]V1HabitLog `json:"data,omitempty"`
+
+	// Message Human-readable message.
+	Message *string `json:"message,omitempty"`
+
+	// Meta Meta contains metadata for the response.
+	Meta *V1Meta `json:"meta,omitempty"`
+
+	// Success Whether the request was successful.
+	Success *bool `json:"success,omitempty"`
+}
+
+// V1GoogleCallbackRequest GoogleCallbackRequest contains the OAuth callback code.
+type V1GoogleCallbackRequest struct {
+	// Code The authorization code from Google OAuth callback.
+	Code *string `json:"code,omitempty"`
+
+	// Reactivate Confirms reactivating a deactivated account, as in LoginRequest.
+	Reactivate *bool `json:"reactivate,omitempty"`
+}
+
+// V1GoogleLoginData GoogleLoginData contains the Google OAuth URL.
+type V1GoogleLoginData struct {
+	// Url The Google OAuth authorization URL.
+	Url *string `json:"url,omitempty"`
+}
+
+// V1GoogleLoginResponse GoogleLoginResponse contains the OAuth URL.
+type V1GoogleLoginResponse struct {
+	// Data GoogleLoginData contains the Google OAuth URL.
+	Data *V1GoogleLoginData `json:"data,omitempty"`
+
+	// Success Whether the request was successful.
+	Success *bool `json:"success,omitempty"`
+}
+
+// V1Habit Habit represents a user's habit.
+type V1Habit struct {
+	// CreatedAt Creation time.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// Description Habit description.
+	Description *string `json:"description,omitempty"`
+
+	// Frequency Habit frequency (daily, weekly, monthly).
+	Frequency *string `json:"frequency,omitempty"`
+
+	// Id Unique habit identifier.
+	Id *string `json:"id,omitempty"`
+
+	// IsActive Whether the habit is active.
+	IsActive *bool `json:"is_active,omitempty"`
+
+	// Name Habit name.
+	Name *string `json:"name,omitempty"`
+
+	// PausedUntil Date (YYYY-MM-DD) a paused habit resumes on.
+	PausedUntil *string `json:"paused_until,omitempty"`
+
+	// Position Position in the user's custom order, starting at 0.
+	Position *int32 `json:"position,omitempty"`
+
+	// Recurrence Recurrence describes on which weekdays and how often a habit repeats,
+	// e.g. days ["mon", "wed", "fri"] or interval 3 for every third day.
+	Recurrence *V1Recurrence `json:"recurrence,omitempty"`
+
+	// ReminderTime Daily reminder time in HH:MM format.
+	ReminderTime *string `json:"reminder_time,omitempty"`
+
+	// TargetCount Target count per frequency period.
+	TargetCount *int32 `json:"target_count,omitempty"`
+
+	// UpdatedAt Last update time.
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+
+	// Version Incremented on every update; send it back as expected_version (or in an
+	// If-Match header) to detect concurrent edits.
+	Version *int32 `json:"version,omitempty"`
+}
+
+// V1HabitCorrelation HabitCorrelation reads as: on the habit_days days habit_name was logged,
+// related_habit_name was also logged rate percent of the time.
+type V1HabitCorrelation struct {
+	// BaselineRate Percent of all days in the window the related habit was logged.
+	BaselineRate *float64 `json:"baseline_rate,omitempty"`
+
+	// HabitDays Days the habit was logged in the window.
+	HabitDays *int32 `json:"habit_days,omitempty"`
+
+	// HabitId Habit identifier.
+	HabitId *string `json:"habit_id,omitempty"`
+
+	// HabitName Habit name.
+	HabitName *string `json:"habit_name,omitempty"`
+
+	// Lift rate minus baseline_rate, in percentage points.
+	Lift *float64 `json:"lift,omitempty"`
+
+	// Rate shared_days as a percent of habit_days.
+	Rate *float64 `json:"rate,omitempty"`
+
+	// RelatedHabitId Related habit identifier.
+	RelatedHabitId *string `json:"related_habit_id,omitempty"`
+
+	// RelatedHabitName Related habit name.
+	RelatedHabitName *string `json:"related_habit_name,omitempty"`
+
+	// SharedDays Of those, days the related habit was also logged.
+	SharedDays *int32 `json:"shared_days,omitempty"`
+}
+
+// V1HabitCorrelations HabitCorrelations lists habit pairs over the last 90 days, strongest first.
+type V1HabitCorrelations struct {
+	// Correlations Pairs of habits each logged on at least 10 days, at most 20.
+	Correlations *[]V1HabitCorrelation `json:"correlations,omitempty"`
+
+	// EndDate Last day of the window in YYYY-MM-DD format.
+	EndDate *string `json:"end_date,omitempty"`
+
+	// StartDate First day of the window in YYYY-MM-DD format.
+	StartDate *string `json:"start_date,omitempty"`
+}
+
+// V1HabitCorrelationsResponse HabitCorrelationsResponse contains habit correlations.
+type V1HabitCorrelationsResponse struct {
+	// Data HabitCorrelations lists habit pairs over the last 90 days, strongest first.
+	Data *V1HabitCorrelations `json:"data,omitempty"`
+
+	// Message Human-readable message.
+	Message *string `json:"message,omitempty"`
+
+	// Success Whether the request was successful.
+	Success *bool `json:"success,omitempty"`
+}
+
+// V1HabitImport HabitImport is the progress of an import from another habit tracker.
+type V1HabitImport struct {
+	// CompletedAt Completion time; set when completed.
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+
+	// CreatedAt Creation time.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// Failure Why the import stopped; set when failed.
+	Failure *string `json:"failure,omitempty"`
+
+	// Id Unique import identifier.
+	Id *string `json:"id,omitempty"`
+
+	// ImportedHabits Number of habits written so far.
+	ImportedHabits *int32 `json:"imported_habits,omitempty"`
+
+	// ImportedLogs Number of logs written so far.
+	ImportedLogs *int32 `json:"imported_logs,omitempty"`
+
+	// Progress Share of habits written, from 0 to 100.
+	Progress *int32 `json:"progress,omitempty"`
+
+	// Source App the file was exported from: habitica, loop or streaks.
+	Source *string `json:"source,omitempty"`
+
+	// Status One of pending, running, completed or failed.
+	Status *string `json:"status,omitempty"`
+
+	// TotalHabits Number of habits in the file.
+	TotalHabits *int32 `json:"total_habits,omitempty"`
+
+	// TotalLogs Number of logs in the file.
+	TotalLogs *int32 `json:"total_logs,omitempty"`
+
+	// UpdatedAt Last update time.
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// V1HabitInsights HabitInsights shows on which weekdays and at which times a habit is completed.
+type V1HabitInsights struct {
+	// BestTimeOfDay Time of day with the most logs; empty until the habit has 5 logs.
+	BestTimeOfDay *string `json:"best_time_of_day,omitempty"`
+
+	// BestWeekday Weekday with the most logs; empty until the habit has 5 logs.
+	BestWeekday *string `json:"best_weekday,omitempty"`
+
+	// HabitId Habit identifier.
+	HabitId *string `json:"habit_id,omitempty"`
+
+	// HabitName Habit name.
+	HabitName *string `json:"habit_name,omitempty"`
+
+	// TimesOfDay Logs per time of day they were made in the user's timezone, morning first.
+	TimesOfDay *[]V1InsightBucket `json:"times_of_day,omitempty"`
+
+	// TotalLogs Number of logs analyzed.
+	TotalLogs *int32 `json:"total_logs,omitempty"`
+
+	// Weekdays Logs per weekday of the log date, Monday first.
+	Weekdays *[]V1InsightBucket `json:"weekdays,omitempty"`
+
+	// WorstWeekday Weekday with the fewest logs; empty until the habit has 5 logs.
+	WorstWeekday *string `json:"worst_weekday,omitempty"`
+}
+
+// V1HabitInsightsResponse HabitInsightsResponse contains habit insights.
+type V1HabitInsightsResponse struct {
+	// Data HabitInsights shows on which weekdays and at which times a habit is completed.
+	Data *V1HabitInsights `json:"data,omitempty"`
+
+	// Message Human-readable message.
+	Message *string `json:"message,omitempty"`
+
+	// Success Whether the request was successful.
+	Success *bool `json:"success,omitempty"`
+}
+
+// V1HabitLog HabitLog represents a habit completion log entry.
+type V1HabitLog struct {
+	// Count Completion count.
+	Count *int32 `json:"count,omitempty"`
+
+	// CreatedAt Creation time.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// HabitId Parent habit identifier.
+	HabitId *string `json:"habit_id,omitempty"`
+
+	// Id Unique log identifier.
+	Id *string `json:"id,omitempty"`
+
+	// LogDate Date of the log entry.
+	LogDate *string `json:"log_date,omitempty"`
+
+	// Note Optional note.
+	Note *string `json:"note,omitempty"`
+}
+
+// V1HabitPeriodDelta HabitPeriodDelta compares one habit between the two periods.
+type V1HabitPeriodDelta struct {
+	// CompletionRateChange Change in completion rate, in percentage points.
+	CompletionRateChange *float64 `json:"completion_rate_change,omitempty"`
+
+	// CompletionsChange Percent change in completions; unset when the previous period had none.
+	CompletionsChange *float64 `json:"completions_change,omitempty"`
+
+	// CurrentCompletionRate Completion rate in the current period (0-100).
+	CurrentCompletionRate *float64 `json:"current_completion_rate,omitempty"`
+
+	// CurrentCompletions Completions in the current period.
+	CurrentCompletions *int32 `json:"current_completions,omitempty"`
+
+	// CurrentLongestStreak Longest streak within the current period.
+	CurrentLongestStreak *int32 `json:"current_longest_streak,omitempty"`
+
+	// HabitId Habit identifier.
+	HabitId *string `json:"habit_id,omitempty"`
+
+	// HabitName Habit name.
+	HabitName *string `json:"habit_name,omitempty"`
+
+	// PreviousCompletionRate Completion rate in the previous period (0-100).
+	PreviousCompletionRate *float64 `json:"previous_completion_rate,omitempty"`
+
+	// PreviousCompletions Completions in the previous period.
+	PreviousCompletions *int32 `json:"previous_completions,omitempty"`
+
+	// PreviousLongestStreak Longest streak within the previous period.
+	PreviousLongestStreak *int32 `json:"previous_longest_streak,omitempty"`
+}
+
+// V1HabitResponse HabitResponse contains a single habit.
+type V1HabitResponse struct {
+	// Data Habit represents a user's habit.
+	Data *V1Habit `json:"data,omitempty"`
+
+	// Message Human-readable message.
+	Message *string `json:"message,omitempty"`
+
+	// Success Whether the request was successful.
+	Success *bool `json:"success,omitempty"`
+}
+
+// V1HabitStats HabitStats contains habit statistics.
+type V1HabitStats struct {
+	// CurrentStreak Current streak in days.
+	CurrentStreak *int32 `json:"current_streak,omitempty"`
+
+	// LongestStreak Longest streak achieved.
+	LongestStreak *int32 `json:"longest_streak,omitempty"`
+
+	// TotalLogs Total number of logs.
+	TotalLogs *int32 `json:"total_logs,omitempty"`
+}
+
+// V1HabitStatsResponse HabitStatsResponse contains habit statistics.
+type V1HabitStatsResponse struct {
+	// Data HabitStats contains habit statistics.
+	Data *V1HabitStats `json:"data,omitempty"`
+
+	// Message Human-readable message.
+	Message *string `json:"message,omitempty"`
+
+	// Success Whether the request was successful.
+	Success *bool `json:"success,omitempty"`
+}
+
+// V1ImportPreview ImportPreview describes what an import would create.
+type V1ImportPreview struct {
+	// Habits Habits that would be created.
+	Habits *[]V1ImportPreviewHabit `json:"habits,omitempty"`
+
+	// Source App the file was exported from.
+	Source *string `json:"source,omitempty"`
+
+	// TotalHabits Number of habits that would be created.
+	TotalHabits *int32 `json:"total_habits,omitempty"`
+
+	// TotalLogs Number of logs that would be created.
+	TotalLogs *int32 `json:"total_logs,omitempty"`
+
+	// Warnings Data in the file that would be skipped or approximated.
+	Warnings *[]string `json:"warnings,omitempty"`
+}
+
+// V1ImportPreviewHabit ImportPreviewHabit is one habit an import would create.
+type V1ImportPreviewHabit struct {
+	// FirstLogDate Earliest completion in YYYY-MM-DD format.
+	FirstLogDate *string `json:"first_log_date,omitempty"`
+
+	// Frequency Habit frequency: daily, weekly or monthly.
+	Frequency *string `json:"frequency,omitempty"`
+
+	// LastLogDate Latest completion in YYYY-MM-DD format.
+	LastLogDate *string `json:"last_log_date,omitempty"`
+
+	// Logs Number of days with completions.
+	Logs *int32 `json:"logs,omitempty"`
+
+	// Name Habit name.
+	Name *string `json:"name,omitempty"`
+
+	// Recurrence Recurrence describes on which weekdays and how often a habit repeats,
+	// e.g. days ["mon", "wed", "fri"] or interval 3 for every third day.
+	Recurrence *V1Recurrence `json:"recurrence,omitempty"`
+}
+
+// V1ImportPreviewResponse ImportPreviewResponse wraps an import preview.
+type V1ImportPreviewResponse struct {
+	// Data ImportPreview describes what an import would create.
+	Data *V1ImportPreview `json:"data,omitempty"`
+
+	// Message Human-readable message.
+	Message *string `json:"message,omitempty"`
+
+	// Success Whether the request was successful.
+	Success *bool `json:"success,omitempty"`
+}
+
+// V1ImportResponse ImportResponse wraps a single import.
+type V1ImportResponse struct {
+	// Data HabitImport is the progress of an import from another habit tracker.
+	Data *V1HabitImport `json:"data,omitempty"`
+
+	// Message Human-readable message.
+	Message *string `json:"message,omitempty"`
+
+	// Success Whether the request was successful.
+	Success *bool `json:"success,omitempty"`
+}
+
+// V1InsightBucket InsightBucket counts the logs made on one weekday or time of day.
+type V1InsightBucket struct {
+	// Logs Number of logs.
+	Logs *int32 `json:"logs,omitempty"`
+
+	// Name Weekday (Monday...Sunday) or time of day (morning, afternoon, evening, night).
+	Name *string `json:"name,omitempty"`
+
+	// Share Percent of all the habit's logs.
+	Share *float64 `json:"share,omitempty"`
+}
+
+// V1LegalDocument LegalDocument is the current version of a legal document.
+type V1LegalDocument struct {
+	// Accepted Whether the user accepted this version.
+	Accepted *bool `json:"accepted,omitempty"`
+
+	// CurrentVersion Version users must accept.
+	CurrentVersion *string `json:"current_version,omitempty"`
+
+	// Document Document: terms or privacy.
+	Document *string `json:"document,omitempty"`
+}
+
+// V1ListHabitsResponse ListHabitsResponse contains paginated habits.
+type V1ListHabitsResponse struct {
+	// Data List of habits.
+	Data *[]V1Habit `json:"data,omitempty"`
+
+	// Message Human-readable message.
+	Message *string `json:"message,omitempty"`
+
+	// Meta Meta contains metadata for the response.
+	Meta *V1Meta `json:"meta,omitempty"`
+
+	// Success Whether the request was successful.
+	Success *bool `json:"success,omitempty"`
+}
+
+// V1ListNotificationsResponse ListNotificationsResponse contains paginated notifications.
+type V1ListNotificationsResponse struct {
+	// Data List of notifications.
+	Data *[]V1Notification `json:"data,omitempty"`
+
+	// Message Human-readable message.
+	Message *string `json:"message,omitempty"`
+
+	// Meta Meta contains metadata for the response.
+	Meta *V1Meta `json:"meta,omitempty"`
+
+	// Success Whether the request was successful.
+	Success *bool `json:"success,omitempty"`
+}
+
+// V1ListSessionsResponse ListSessionsResponse contains paginated sessions.
+type V1ListSessionsResponse struct {
+	// Data List of sessions.
+	Data *[]V1Session `json:"data,omitempty"`
+
+	// Message Human-readable message.
+	Message *string `json:"message,omitempty"`
+
+	// Meta Meta contains metadata for the response.
+	Meta *V1Meta `json:"meta,omitempty"`
+
+	// Success Whether the request was successful.
+	Success *bool `json:"success,omitempty"`
+}
+
+// V1ListVacationsResponse ListVacationsResponse contains a habit's vacations.
+type V1ListVacationsResponse struct {
+	// Data List of vacations.
+	Data *[]V1Vacation `json:"data,omitempty"`
+
+	// Message Human-readable message.
+	Message *string `json:"message,omitempty"`
+
+	// Success Whether the request was successful.
+	Success *bool `json:"success,omitempty"`
+}
+
+// V1LogHabitData LogHabitData describes the day's log.
+type V1LogHabitData struct {
+	// LogId The day's log ID, which is an earlier log's when the habit was already
+	// logged that day.
+	LogId *string `json:"log_id,omitempty"`
+
+	// TotalToday The day's completion count after this change.
+	TotalToday *int32 `json:"total_today,omitempty"`
+}
+
+// V1LogHabitResponse LogHabitResponse contains the created log ID.
+type V1LogHabitResponse struct {
+	// Data LogHabitData describes the day's log.
+	Data *V1LogHabitData `json:"data,omitempty"`
+
+	// Message Human-readable message.
+	Message *string `json:"message,omitempty"`
+
+	// Success Whether the operation was successful.
+	Success *bool `json:"success,omitempty"`
+}
+
+// V1LoginData LoginData contains authentication tokens and session info.
+type V1LoginData struct {
+	// AccessToken JWT access token.
+	AccessToken *string `json:"access_token,omitempty"`
+
+	// ExpiresAt Token expiration time (Unix timestamp).
+	ExpiresAt *string `json:"expires_at,omitempty"`
+
+	// RefreshToken JWT refresh token.
+	RefreshToken *string `json:"refresh_token,omitempty"`
+
+	// SessionId Session identifier.
+	SessionId *string `json:"session_id,omitempty"`
+
+	// UserId User identifier.
+	UserId *string `json:"user_id,omitempty"`
+}
+
+// V1LoginRequest LoginRequest contains user credentials.
+type V1LoginRequest struct {
+	// Email User's email address.
+	Email *string `json:"email,omitempty"`
+
+	// Password User's password.
+	Password *string `json:"password,omitempty"`
+
+	// Reactivate Confirms reactivating a deactivated account. Without it, logging in to a
+	// deactivated account fails with AUTH_ACCOUNT_DEACTIVATED. An account the
+	// identity provider deactivated through provisioning cannot be reactivated
+	// this way; logging in to it fails with BUSINESS_OPERATION_NOT_ALLOWED.
+	Reactivate *bool `json:"reactivate,omitempty"`
+}
+
+// V1LoginResponse LoginResponse contains authentication tokens.
+type V1LoginResponse struct {
+	// Data LoginData contains authentication tokens and session info.
+	Data *V1LoginData `json:"data,omitempty"`
+
+	// Success Whether login was successful.
+	Success *bool `json:"success,omitempty"`
+}
+
+// V1LogoutAllRequest LogoutAllRequest ends every session of the caller, taken from the access token.
+type V1LogoutAllRequest struct {
+	// UserId Optional. When set it must be the access token's user ID, otherwise the
+	// request is rejected.
+	UserId *string `json:"user_id,omitempty"`
+}
+
+// V1LogoutRequest LogoutRequest ends the caller's current session, taken from the access token.
+type V1LogoutRequest struct {
+	// SessionId Optional. When set it must be the access token's session ID, otherwise
+	// the request is rejected.
+	SessionId *string `json:"session_id,omitempty"`
+}
+
+// V1LogoutResponse LogoutResponse contains the logout result.
+type V1LogoutResponse struct {
+	// Message Human-readable message.
+	Message *string `json:"message,omitempty"`
+
+	// Success Whether logout was successful.
+	Success *bool `json:"success,omitempty"`
+}
+
+// V1Meta Meta contains metadata for the response.
+type V1Meta struct {
+	// Pagination PaginationResponse contains pagination metadata for list responses.
+	Pagination *V1PaginationResponse `json:"pagination,omitempty"`
+}
+
+// V1Notification Notification represents a user notification.
+type V1Notification struct {
+	// CreatedAt Creation time.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// Data Additional notification data.
+	Data *map[string]interface{} `json:"data,omitempty"`
+
+	// GroupCount In grouped listings, the number of notifications in the thread this
+	// one, its latest, stands for.
+	GroupCount *int32 `json:"group_count,omitempty"`
+
+	// GroupKey Thread the notification belongs to, e.g. "habit:<habit_id>" for the
+	// reminders of one habit.
+	GroupKey *string `json:"group_key,omitempty"`
+
+	// GroupUnreadCount In grouped listings, how many of the thread's notifications are unread.
+	GroupUnreadCount *int32 `json:"group_unread_count,omitempty"`
+
+	// Id Unique notification identifier.
+	Id *string `json:"id,omitempty"`
+
+	// IsRead Whether the notification has been read.
+	IsRead *bool `json:"is_read,omitempty"`
+
+	// Message Notification message.
+	Message *string `json:"message,omitempty"`
+
+	// ReadAt Time when notification was read.
+	ReadAt *time.Time `json:"read_at,omitempty"`
+
+	// Title Notification title.
+	Title *string `json:"title,omitempty"`
+
+	// Type NotificationType represents the type of notification.
+	//
+	//  - NOTIFICATION_TYPE_UNSPECIFIED: Unspecified notification type.
+	//  - NOTIFICATION_TYPE_STREAK_MILESTONE: Streak milestone notification.
+	//  - NOTIFICATION_TYPE_HABIT_REMINDER: Habit reminder notification.
+	//  - NOTIFICATION_TYPE_ACHIEVEMENT: Achievement notification.
+	//  - NOTIFICATION_TYPE_SYSTEM: System notification.
+	//  - NOTIFICATION_TYPE_WELCOME: Welcome notification.
+	//  - NOTIFICATION_TYPE_DAILY_SUMMARY: End-of-day completion summary.
+	//  - NOTIFICATION_TYPE_REENGAGEMENT: Nudge after a stretch without logs.
+	//  - NOTIFICATION_TYPE_REMINDER_ESCALATION: Reminder for a habit missed several days in a row.
+	Type *V1NotificationType `json:"type,omitempty"`
+}
+
+// V1NotificationPreferences NotificationPreferences holds the user's scheduled notification choices.
+type V1NotificationPreferences struct {
+	// DailySummary Whether the end-of-day summary is sent.
+	DailySummary *bool `json:"daily_summary,omitempty"`
+
+	// DoNotDisturb Whether do not disturb is on; habit reminders are not sent meanwhile.
+	DoNotDisturb *bool `json:"do_not_disturb,omitempty"`
+
+	// DoNotDisturbUntil When do not disturb switches itself off; unset means it stays on.
+	DoNotDisturbUntil *time.Time `json:"do_not_disturb_until,omitempty"`
+
+	// QuietHoursEnd End of quiet hours (HH:MM, user timezone); may be before the start to wrap past midnight.
+	QuietHoursEnd *string `json:"quiet_hours_end,omitempty"`
+
+	// QuietHoursStart Start of quiet hours (HH:MM, user timezone).
+	QuietHoursStart *string `json:"quiet_hours_start,omitempty"`
+
+	// Reengagement Whether to be nudged after a stretch without logs.
+	Reengagement *bool `json:"reengagement,omitempty"`
+
+	// WeeklyReport Whether the weekly progress report is emailed.
+	WeeklyReport *bool `json:"weekly_report,omitempty"`
+}
+
+// V1NotificationPreferencesResponse NotificationPreferencesResponse contains the user's preferences.
+type V1NotificationPreferencesResponse struct {
+	// Data NotificationPreferences holds the user's scheduled notification choices.
+	Data *V1NotificationPreferences `json:"data,omitempty"`
+
+	// Message Human-readable message.
+	Message *string `json:"message,omitempty"`
+
+	// Success Whether the request was successful.
+	Success *bool `json:"success,omitempty"`
+}
+
+// V1NotificationType NotificationType represents the type of notification.
+//
+//   - NOTIFICATION_TYPE_UNSPECIFIED: Unspecified notification type.
+//   - NOTIFICATION_TYPE_STREAK_MILESTONE: Streak milestone notification.
+//   - NOTIFICATION_TYPE_HABIT_REMINDER: Habit reminder notification.
+//   - NOTIFICATION_TYPE_ACHIEVEMENT: Achievement notification.
+//   - NOTIFICATION_TYPE_SYSTEM: System notification.
+//   - NOTIFICATION_TYPE_WELCOME: Welcome notification.
+//   - NOTIFICATION_TYPE_DAILY_SUMMARY: End-of-day completion summary.
+//   - NOTIFICATION_TYPE_REENGAGEMENT: Nudge after a stretch without logs.
+//   - NOTIFICATION_TYPE_REMINDER_ESCALATION: Reminder for a habit missed several days in a row.
+type V1NotificationType string
+
+// V1PaginationResponse PaginationResponse contains pagination metadata for list responses.
+type V1PaginationResponse struct {
+	// CurrentPage Current page number (1-indexed).
+	CurrentPage *int32 `json:"current_page,omitempty"`
+
+	// From Index of the first item in the current page (1-indexed).
+	From *int32 `json:"from,omitempty"`
+
+	// HasNextPage Whether there is a next page.
+	HasNextPage *bool `json:"has_next_page,omitempty"`
+
+	// HasPreviousPage Whether there is a previous page.
+	HasPreviousPage *bool `json:"has_previous_page,omitempty"`
+
+	// LastPage Last page number.
+	LastPage *int32 `json:"last_page,omitempty"`
+
+	// PerPage Number of items per page.
+	PerPage *int32 `json:"per_page,omitempty"`
+
+	// To Index of the last item in the current page.
+	To *int32 `json:"to,omitempty"`
+
+	// TotalData Total number of items across all pages.
+	TotalData *int32 `json:"total_data,omitempty"`
+
+	// TotalDataInCurrentPage Number of items in the current page.
+	TotalDataInCurrentPage *int32 `json:"total_data_in_current_page,omitempty"`
+}
+
+// V1PeriodComparison PeriodComparison compares the current period so far with the same number
+// of days from the start of the previous period.
+type V1PeriodComparison struct {
+	// CompletionRateChange Change in completion rate, in percentage points.
+	CompletionRateChange *float64 `json:"completion_rate_change,omitempty"`
+
+	// CompletionsChange Percent change in completions; unset when the previous period had none.
+	CompletionsChange *float64 `json:"completions_change,omitempty"`
+
+	// Current PeriodSummary totals one period across the user's habits.
+	Current *V1PeriodSummary `json:"current,omitempty"`
+
+	// Habits Per-habit changes.
+	Habits *[]V1HabitPeriodDelta `json:"habits,omitempty"`
+
+	// LongestStreakChange Change in the longest streak, in days.
+	LongestStreakChange *int32 `json:"longest_streak_change,omitempty"`
+
+	// Period "week" or "month".
+	Period *string `json:"period,omitempty"`
+
+	// Previous PeriodSummary totals one period across the user's habits.
+	Previous *V1PeriodSummary `json:"previous,omitempty"`
+}
+
+// V1PeriodComparisonResponse PeriodComparisonResponse contains a period comparison.
+type V1PeriodComparisonResponse struct {
+	// Data PeriodComparison compares the current period so far with the same number
+	// of days from the start of the previous period.
+	Data *V1PeriodComparison `json:"data,omitempty"`
+
+	// Message Human-readable message.
+	Message *string `json:"message,omitempty"`
+
+	// Success Whether the request was successful.
+	Success *bool `json:"success,omitempty"`
+}
+
+// V1PeriodSummary PeriodSummary totals one period across the user's habits.
+type V1PeriodSummary struct {
+	// CompletionRate Share of habit-days that were logged (0-100).
+	CompletionRate *float64 `json:"completion_rate,omitempty"`
+
+	// Completions Total completions.
+	Completions *int32 `json:"completions,omitempty"`
+
+	// EndDate Last day (inclusive) in YYYY-MM-DD format.
+	EndDate *string `json:"end_date,omitempty"`
+
+	// LongestStreak Longest run of consecutive logged days of any habit.
+	LongestStreak *int32 `json:"longest_streak,omitempty"`
+
+	// StartDate First day in YYYY-MM-DD format.
+	StartDate *string `json:"start_date,omitempty"`
+}
+
+// V1PreferencesResponse PreferencesResponse contains user preferences.
+type V1PreferencesResponse struct {
+	// Data UserPreferences are per-user settings, with defaults filled in.
+	Data *V1UserPreferences `json:"data,omitempty"`
+
+	// Message Human-readable message.
+	Message *string `json:"message,omitempty"`
+
+	// Success Whether the request was successful.
+	Success *bool `json:"success,omitempty"`
+}
+
+// V1PreviewImportRequest PreviewImportRequest contains an export file to preview.
+type V1PreviewImportRequest struct {
+	// Data File contents.
+	Data *string `json:"data,omitempty"`
+
+	// Source App the file was exported from: habitica (JSON user data export), loop
+	// (Checkmarks.csv) or streaks (CSV export).
+	Source *string `json:"source,omitempty"`
+}
+
+// V1ProfileData ProfileData contains user profile information.
+type V1ProfileData struct {
+	// AvatarUrl URL of the user's avatar image, empty when none is set. It may be signed
+	// and expire, so fetch the profile again rather than storing it.
+	// Upload a new avatar with a multipart POST to /v1/auth/profile/avatar.
+	AvatarUrl *string `json:"avatar_url,omitempty"`
+
+	// CreatedAt Account creation time.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// Email User's email address.
+	Email *string `json:"email,omitempty"`
+
+	// Locale Language for messages, notifications and emails (e.g., en, id).
+	Locale *string `json:"locale,omitempty"`
+
+	// LogLockDays Habit logs older than this many days are locked (unset when disabled).
+	LogLockDays *int32 `json:"log_lock_days,omitempty"`
+
+	// Name User's display name.
+	Name *string `json:"name,omitempty"`
+
+	// Role Access role: member or admin. Accounts signed in through single sign-on
+	// take it from their identity provider groups.
+	Role *string `json:"role,omitempty"`
+
+	// Timezone User's timezone in IANA format (e.g., Asia/Jakarta).
+	Timezone *string `json:"timezone,omitempty"`
+
+	// UserId User identifier.
+	UserId *string `json:"user_id,omitempty"`
+
+	// WeekStart Day weeks begin on for weekly stats and the weekly report: sunday, monday or saturday.
+	WeekStart *string `json:"week_start,omitempty"`
+}
+
+// V1ProfileResponse ProfileResponse contains user profile data.
+type V1ProfileResponse struct {
+	// Data ProfileData contains user profile information.
+	Data *V1ProfileData `json:"data,omitempty"`
+
+	// Message Human-readable message.
+	Message *string `json:"message,omitempty"`
+
+	// Success Whether the request was successful.
+	Success *bool `json:"success,omitempty"`
+}
+
+// V1Recurrence Recurrence describes on which weekdays and how often a habit repeats,
+// e.g. days ["mon", "wed", "fri"] or interval 3 for every third day.
+type V1Recurrence struct {
+	// Days Weekday names (sun, mon, tue, wed, thu, fri, sat); empty means every day.
+	Days *[]string `json:"days,omitempty"`
+
+	// Interval Repeat every N periods of the habit's frequency (default: 1).
+	Interval *int32 `json:"interval,omitempty"`
+}
+
+// V1RefreshTokenRequest RefreshTokenRequest rotates a session's refresh token.
+type V1RefreshTokenRequest struct {
+	// RefreshToken The refresh token from the last login or refresh.
+	RefreshToken *string `json:"refresh_token,omitempty"`
+}
+
+// V1RegisterData RegisterData contains the registered user information.
+type V1RegisterData struct {
+	// Email The user's email address.
+	Email *string `json:"email,omitempty"`
+
+	// Name The user's display name.
+	Name *string `json:"name,omitempty"`
+
+	// UserId The created user's ID.
+	UserId *string `json:"user_id,omitempty"`
+}
+
+// V1RegisterRequest RegisterRequest contains user registration data.
+type V1RegisterRequest struct {
+	// Email User's email address.
+	Email *string `json:"email,omitempty"`
+
+	// Name User's display name (2-100 chars).
+	Name *string `json:"name,omitempty"`
+
+	// Password User's password (min 8 chars).
+	Password *string `json:"password,omitempty"`
+}
+
+// V1RegisterResponse RegisterResponse contains the result of registration.
+type V1RegisterResponse struct {
+	// Data RegisterData contains the registered user information.
+	Data *V1RegisterData `json:"data,omitempty"`
+
+	// Message Human-readable message.
+	Message *string `json:"message,omitempty"`
+
+	// Success Whether registration was successful.
+	Success *bool `json:"success,omitempty"`
+}
+
+// V1ReorderHabitsRequest ReorderHabitsRequest lists habit IDs in their new display order.
+// Habits not listed keep their relative order after the listed ones.
+type V1ReorderHabitsRequest struct {
+	// HabitIds Habit identifiers, first to last.
+	HabitIds *[]string `json:"habit_ids,omitempty"`
+}
+
+// V1RequestMagicLinkRequest RequestMagicLinkRequest asks for a login link to be emailed.
+type V1RequestMagicLinkRequest struct {
+	// Email Email address of the account.
+	Email *string `json:"email,omitempty"`
+}
+
+// V1ResendVerificationRequest ResendVerificationRequest contains the email to resend verification to.
+type V1ResendVerificationRequest struct {
+	// Email Email address to resend verification to.
+	Email *string `json:"email,omitempty"`
+}
+
+// V1ResetPasswordRequest ResetPasswordRequest contains password reset data.
+type V1ResetPasswordRequest struct {
+	// Code Password reset code.
+	Code *string `json:"code,omitempty"`
+
+	// Email Email address.
+	Email *string `json:"email,omitempty"`
+
+	// NewPassword New password (min 8 chars).
+	NewPassword *string `json:"new_password,omitempty"`
+}
+
+// V1RevokeOtherSessionsResponse RevokeOtherSessionsResponse contains the count of revoked sessions.
+type V1RevokeOtherSessionsResponse struct {
+	// Message Human-readable message.
+	Message *string `json:"message,omitempty"`
+
+	// RevokedCount Number of sessions that were revoked.
+	RevokedCount *int32 `json:"revoked_count,omitempty"`
+
+	// Success Whether the operation was successful.
+	Success *bool `json:"success,omitempty"`
+}
+
+// V1RevokeSessionResponse RevokeSessionResponse confirms the session was revoked.
+type V1RevokeSessionResponse struct {
+	// Message Human-readable message.
+	Message *string `json:"message,omitempty"`
+
+	// Success Whether the operation was successful.
+	Success *bool `json:"success,omitempty"`
+}
+
+// V1SSOCallbackRequest SSOCallbackRequest contains the identity provider callback code.
+type V1SSOCallbackRequest struct {
+	// Code The authorization code from the identity provider callback.
+	Code *string `json:"code,omitempty"`
+
+	// Reactivate Confirms reactivating a deactivated account, as in LoginRequest.
+	Reactivate *bool `json:"reactivate,omitempty"`
+
+	// State The state from the identity provider callback, which must be one
+	// SSOLogin handed out.
+	State *string `json:"state,omitempty"`
+}
+
+// V1SSOLoginData SSOLoginData contains the identity provider login URL.
+type V1SSOLoginData struct {
+	// State Opaque state embedded in the URL. Send it back with the callback within
+	// ten minutes; each state completes one sign-in.
+	State *string `json:"state,omitempty"`
+
+	// Url The identity provider authorization URL.
+	Url *string `json:"url,omitempty"`
+}
+
+// V1SSOLoginResponse SSOLoginResponse contains the identity provider login URL.
+type V1SSOLoginResponse struct {
+	// Data SSOLoginData contains the identity provider login URL.
+	Data *V1SSOLoginData `json:"data,omitempty"`
+
+	// Success Whether the request was successful.
+	Success *bool `json:"success,omitempty"`
+}
+
+// V1Session Session represents a user session.
+type V1Session struct {
+	// City Approximate city of the client IP at login, empty when unknown.
+	City *string `json:"city,omitempty"`
+
+	// ClientIp Client IP address.
+	ClientIp *string `json:"client_ip,omitempty"`
+
+	// Country Approximate country of the client IP at login, empty when unknown.
+	Country *string `json:"country,omitempty"`
+
+	// CreatedAt Session creation time.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// ExpiresAt Session expiration time.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+
+	// IsActive Whether the session is currently active.
+	IsActive *bool `json:"is_active,omitempty"`
+
+	// IsBlocked Whether the session is blocked.
+	IsBlocked *bool `json:"is_blocked,omitempty"`
+
+	// IsCurrent Whether this is the current session.
+	IsCurrent *bool `json:"is_current,omitempty"`
+
+	// SessionId Unique session identifier.
+	SessionId *string `json:"session_id,omitempty"`
+
+	// UserAgent Browser/client user agent string.
+	UserAgent *string `json:"user_agent,omitempty"`
+}
+
+// V1StartImportRequest StartImportRequest contains an export file to import.
+type V1StartImportRequest struct {
+	// Data File contents.
+	Data *string `json:"data,omitempty"`
+
+	// Source App the file was exported from: habitica, loop or streaks.
+	Source *string `json:"source,omitempty"`
+}
+
+// V1UnreadCountData UnreadCountData contains the unread count.
+type V1UnreadCountData struct {
+	// Count Number of unread notifications.
+	Count *int32 `json:"count,omitempty"`
+}
+
+// V1UnreadCountResponse UnreadCountResponse contains the unread notification count.
+type V1UnreadCountResponse struct {
+	// Data UnreadCountData contains the unread count.
+	Data *V1UnreadCountData `json:"data,omitempty"`
+
+	// Message Human-readable message.
+	Message *string `json:"message,omitempty"`
+
+	// Success Whether the request was successful.
+	Success *bool `json:"success,omitempty"`
+}
+
+// V1UnsubscribeRequest UnsubscribeRequest carries the token from a report email's unsubscribe link.
+type V1UnsubscribeRequest struct {
+	// Kind Email kind to stop (weekly_report or reengagement); defaults to weekly_report.
+	Kind *string `json:"kind,omitempty"`
+
+	// Token Unsubscribe token.
+	Token *string `json:"token,omitempty"`
+}
+
+// V1UpdateNotificationPreferencesRequest UpdateNotificationPreferencesRequest changes the given preferences; unset fields are kept.
+type V1UpdateNotificationPreferencesRequest struct {
+	// DailySummary Whether the end-of-day summary is sent.
+	DailySummary *bool `json:"daily_summary,omitempty"`
+
+	// DoNotDisturb Switch do not disturb on or off.
+	DoNotDisturb *bool `json:"do_not_disturb,omitempty"`
+
+	// DoNotDisturbUntil With do_not_disturb on, when it switches itself off; unset keeps it on.
+	DoNotDisturbUntil *time.Time `json:"do_not_disturb_until,omitempty"`
+
+	// QuietHoursEnd End of quiet hours (HH:MM); empty clears quiet hours.
+	QuietHoursEnd *string `json:"quiet_hours_end,omitempty"`
+
+	// QuietHoursStart Start of quiet hours (HH:MM); empty clears quiet hours.
+	QuietHoursStart *string `json:"quiet_hours_start,omitempty"`
+
+	// Reengagement Whether to be nudged after a stretch without logs.
+	Reengagement *bool `json:"reengagement,omitempty"`
+
+	// WeeklyReport Whether the weekly progress report is emailed.
+	WeeklyReport *bool `json:"weekly_report,omitempty"`
+}
+
+// V1UpdatePreferencesRequest UpdatePreferencesRequest contains the preferences to change.
+type V1UpdatePreferencesRequest struct {
+	// ClearDefaultReminderHour Remove the suggested reminder hour.
+	ClearDefaultReminderHour *bool `json:"clear_default_reminder_hour,omitempty"`
+
+	// DefaultReminderHour New suggested reminder hour, 0-23 (optional).
+	DefaultReminderHour *int32 `json:"default_reminder_hour,omitempty"`
+
+	// Locale New language: en or id (optional).
+	Locale *string `json:"locale,omitempty"`
+
+	// Theme New color scheme: system, light or dark (optional).
+	Theme *string `json:"theme,omitempty"`
+
+	// WeekStart New week start day: sunday, monday or saturday (optional).
+	WeekStart *string `json:"week_start,omitempty"`
+}
+
+// V1UpdateProfileRequest UpdateProfileRequest contains profile update data.
+type V1UpdateProfileRequest struct {
+	// Email New email address (optional).
+	Email *string `json:"email,omitempty"`
+
+	// Locale New language for messages, notifications and emails: en or id (optional).
+	Locale *string `json:"locale,omitempty"`
+
+	// LogLockDays Lock habit logs older than this many days; 0 removes the lock (optional).
+	LogLockDays *int32 `json:"log_lock_days,omitempty"`
+
+	// Name New display name (optional).
+	Name *string `json:"name,omitempty"`
+
+	// Timezone New timezone in IANA format (optional).
+	Timezone *string `json:"timezone,omitempty"`
+
+	// WeekStart New week start day: sunday, monday or saturday (optional).
+	WeekStart *string `json:"week_start,omitempty"`
+}
+
+// V1UserPreferences UserPreferences are per-user settings, with defaults filled in.
+type V1UserPreferences struct {
+	// DefaultReminderHour Hour of day (0-23) suggested as a new habit's reminder time (unset when not chosen).
+	DefaultReminderHour *int32 `json:"default_reminder_hour,omitempty"`
+
+	// Locale Language for messages, notifications and emails (e.g., en, id).
+	Locale *string `json:"locale,omitempty"`
+
+	// Theme Color scheme: system, light or dark.
+	Theme *string `json:"theme,omitempty"`
+
+	// WeekStart Day weeks begin on: sunday, monday or saturday.
+	WeekStart *string `json:"week_start,omitempty"`
+}
+
+// V1Vacation Vacation is a period during which a habit is not expected to be completed.
+type V1Vacation struct {
+	// CreatedAt Creation time.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// EndDate Last vacation day in YYYY-MM-DD format; unset while ongoing.
+	EndDate *string `json:"end_date,omitempty"`
+
+	// HabitId Parent habit identifier.
+	HabitId *string `json:"habit_id,omitempty"`
+
+	// Id Unique vacation identifier.
+	Id *string `json:"id,omitempty"`
+
+	// Reason Optional reason.
+	Reason *string `json:"reason,omitempty"`
+
+	// StartDate First vacation day in YYYY-MM-DD format.
+	StartDate *string `json:"start_date,omitempty"`
+}
+
+// V1VacationResponse VacationResponse wraps a single vacation.
+type V1VacationResponse struct {
+	// Data Vacation is a period during which a habit is not expected to be completed.
+	Data *V1Vacation `json:"data,omitempty"`
+
+	// Message Human-readable message.
+	Message *string `json:"message,omitempty"`
+
+	// Success Whether the request was successful.
+	Success *bool `json:"success,omitempty"`
+}
+
+// V1VerifyEmailRequest VerifyEmailRequest contains email verification data.
+type V1VerifyEmailRequest struct {
+	// Code Verification code.
+	Code *string `json:"code,omitempty"`
+
+	// Email Email address to verify.
+	Email *string `json:"email,omitempty"`
+}
+
+// V1WeeklyAnalytics WeeklyAnalytics contains weekly analytics data.
+type V1WeeklyAnalytics struct {
+	// AverageCompletion Average completion percentage for the week.
+	AverageCompletion *int32 `json:"average_completion,omitempty"`
+
+	// Days Daily analytics for each day of the week.
+	Days *[]V1DailyAnalytics `json:"days,omitempty"`
+}
+
+// V1WeeklyAnalyticsResponse WeeklyAnalyticsResponse contains weekly analytics.
+type V1WeeklyAnalyticsResponse struct {
+	// Data WeeklyAnalytics contains weekly analytics data.
+	Data *V1WeeklyAnalytics `json:"data,omitempty"`
+
+	// Message Human-readable message.
+	Message *string `json:"message,omitempty"`
+
+	// Success Whether the request was successful.
+	Success *bool `json:"success,omitempty"`
+}
+
+// HabitsServiceComparePeriodsParams defines parameters for HabitsServiceComparePeriods.
+type HabitsServiceComparePeriodsParams struct {
+	// Period "week" or "month"; defaults to month.
+	Period *string `form:"period,omitempty" json:"period,omitempty"`
+}
+
+// AuthServiceVerifyMagicLinkParams defines parameters for AuthServiceVerifyMagicLink.
+type AuthServiceVerifyMagicLinkParams struct {
+	// Token The token from the login link.
+	Token *string `form:"token,omitempty" json:"token,omitempty"`
+
+	// Reactivate Confirms reactivating a deactivated account, as in LoginRequest.
+	Reactivate *bool `form:"reactivate,omitempty" json:"reactivate,omitempty"`
+}
+
+// AuthServiceListSessionsParams defines parameters for AuthServiceListSessions.
+type AuthServiceListSessionsParams struct {
+	// Page Page number (1-indexed).
+	Page *int32 `form:"page,omitempty" json:"page,omitempty"`
+
+	// PerPage Number of items per page.
+	PerPage *int32 `form:"per_page,omitempty" json:"per_page,omitempty"`
+
+	// IncludeBlocked Include blocked sessions in results.
+	IncludeBlocked *bool `form:"include_blocked,omitempty" json:"include_blocked,omitempty"`
+
+	// IncludeExpired Include expired sessions in results.
+	IncludeExpired *bool `form:"include_expired,omitempty" json:"include_expired,omitempty"`
+}
+
+// HabitsServiceListHabitsParams defines parameters for HabitsServiceListHabits.
+type HabitsServiceListHabitsParams struct {
+	// Page Page number (1-indexed).
+	Page *int32 `form:"page,omitempty" json:"page,omitempty"`
+
+	// PerPage Number of items per page.
+	PerPage *int32 `form:"per_page,omitempty" json:"per_page,omitempty"`
+
+	// Active Filter for active habits only.
+	Active *bool `form:"active,omitempty" json:"active,omitempty"`
+
+	// Inactive Filter for inactive habits only.
+	Inactive *bool `form:"inactive,omitempty" json:"inactive,omitempty"`
+
+	// Keyword Search keyword.
+	Keyword *string `form:"keyword,omitempty" json:"keyword,omitempty"`
+
+	// StartDate Filter by start date.
+	StartDate *string `form:"start_date,omitempty" json:"start_date,omitempty"`
+
+	// EndDate Filter by end date.
+	EndDate *string `form:"end_date,omitempty" json:"end_date,omitempty"`
+
+	// SortBy Sort field.
+	SortBy *string `form:"sort_by,omitempty" json:"sort_by,omitempty"`
+
+	// SortDirection Sort direction (asc/desc).
+	SortDirection *string `form:"sort_direction,omitempty" json:"sort_direction,omitempty"`
+}
+
+// HabitsServiceGetHabitLogsParams defines parameters for HabitsServiceGetHabitLogs.
+type HabitsServiceGetHabitLogsParams struct {
+	// Page Page number.
+	Page *int32 `form:"page,omitempty" json:"page,omitempty"`
+
+	// PerPage Items per page.
+	PerPage *int32 `form:"per_page,omitempty" json:"per_page,omitempty"`
+
+	// StartDate Filter by start date.
+	StartDate *string `form:"start_date,omitempty" json:"start_date,omitempty"`
+
+	// EndDate Filter by end date.
+	EndDate *string `form:"end_date,omitempty" json:"end_date,omitempty"`
+
+	// Keyword Search keyword.
+	Keyword *string `form:"keyword,omitempty" json:"keyword,omitempty"`
+
+	// SortBy Sort field.
+	SortBy *string `form:"sort_by,omitempty" json:"sort_by,omitempty"`
+
+	// SortDirection Sort direction (asc/desc).
+	SortDirection *string `form:"sort_direction,omitempty" json:"sort_direction,omitempty"`
+}
+
+// NotificationsServiceListNotificationsParams defines parameters for NotificationsServiceListNotifications.
+type NotificationsServiceListNotificationsParams struct {
+	// Page Page number (1-indexed).
+	Page *int32 `form:"page,omitempty" json:"page,omitempty"`
+
+	// PerPage Number of items per page.
+	PerPage *int32 `form:"per_page,omitempty" json:"per_page,omitempty"`
+
+	// UnreadOnly Only return unread notifications. Deprecated: use read=false.
+	UnreadOnly *bool `form:"unread_only,omitempty" json:"unread_only,omitempty"`
+
+	// Grouped Collapse each thread into its latest notification, with counts.
+	Grouped *bool `form:"grouped,omitempty" json:"grouped,omitempty"`
+
+	// Type Only return notifications of these types (streak_milestone,
+	// habit_reminder, etc.). Repeat the parameter to select several.
+	Type *[]string `form:"type,omitempty" json:"type,omitempty"`
+
+	// Read Only return read notifications when true, unread ones when false.
+	Read *bool `form:"read,omitempty" json:"read,omitempty"`
+
+	// StartDate Only return notifications created on or after this day, in
+	// YYYY-MM-DD format (UTC).
+	StartDate *string `form:"start_date,omitempty" json:"start_date,omitempty"`
+
+	// EndDate Only return notifications created on or before this day, in
+	// YYYY-MM-DD format (UTC).
+	EndDate *string `form:"end_date,omitempty" json:"end_date,omitempty"`
+
+	// HabitId Only return notifications about this habit.
+	HabitId *string `form:"habit_id,omitempty" json:"habit_id,omitempty"`
+
+	// Keyword Only return notifications whose title or message contains this text,
+	// ignoring case.
+	Keyword *string `form:"keyword,omitempty" json:"keyword,omitempty"`
+}
+
+// AuthServiceDeleteAccountJSONRequestBody defines body for AuthServiceDeleteAccount for application/json ContentType.
+type AuthServiceDeleteAccountJSONRequestBody = V1DeleteAccountRequest
+
+// AuthServiceChangePasswordJSONRequestBody defines body for AuthServiceChangePassword for application/json ContentType.
+type AuthServiceChangePasswordJSONRequestBody = V1ChangePasswordRequest
+
+// AuthServiceAcceptConsentJSONRequestBody defines body for AuthServiceAcceptConsent for application/json ContentType.
+type AuthServiceAcceptConsentJSONRequestBody = V1AcceptConsentRequest
+
+// AuthServiceDeactivateAccountJSONRequestBody defines body for AuthServiceDeactivateAccount for application/json ContentType.
+type AuthServiceDeactivateAccountJSONRequestBody = V1DeactivateAccountRequest
+
+// AuthServiceForgotPasswordJSONRequestBody defines body for AuthServiceForgotPassword for application/json ContentType.
+type AuthServiceForgotPasswordJSONRequestBody = V1ForgotPasswordRequest
+
+// AuthServiceGoogleCallbackJSONRequestBody defines body for AuthServiceGoogleCallback for application/json ContentType.
+type AuthServiceGoogleCallbackJSONRequestBody = V1GoogleCallbackRequest
+
+// AuthServiceLoginJSONRequestBody defines body for AuthServiceLogin for application/json ContentType.
+type AuthServiceLoginJSONRequestBody = V1LoginRequest
+
+// AuthServiceLogoutJSONRequestBody defines body for AuthServiceLogout for application/json ContentType.
+type AuthServiceLogoutJSONRequestBody = V1LogoutRequest
+
+// AuthServiceLogoutAllJSONRequestBody defines body for AuthServiceLogoutAll for application/json ContentType.
+type AuthServiceLogoutAllJSONRequestBody = V1LogoutAllRequest
+
+// AuthServiceRequestMagicLinkJSONRequestBody defines body for AuthServiceRequestMagicLink for application/json ContentType.
+type AuthServiceRequestMagicLinkJSONRequestBody = V1RequestMagicLinkRequest
+
+// AuthServiceUpdatePreferencesJSONRequestBody defines body for AuthServiceUpdatePreferences for application/json ContentType.
+type AuthServiceUpdatePreferencesJSONRequestBody = V1UpdatePreferencesRequest
+
+// AuthServiceUpdateProfileJSONRequestBody defines body for AuthServiceUpdateProfile for application/json ContentType.
+type AuthServiceUpdateProfileJSONRequestBody = V1UpdateProfileRequest
+
+// AuthServiceRefreshTokenJSONRequestBody defines body for AuthServiceRefreshToken for application/json ContentType.
+type AuthServiceRefreshTokenJSONRequestBody = V1RefreshTokenRequest
+
+// AuthServiceRegisterJSONRequestBody defines body for AuthServiceRegister for application/json ContentType.
+type AuthServiceRegisterJSONRequestBody = V1RegisterRequest
+
+// AuthServiceResendVerificationJSONRequestBody defines body for AuthServiceResendVerification for application/json ContentType.
+type AuthServiceResendVerificationJSONRequestBody = V1ResendVerificationRequest
+
+// AuthServiceResetPasswordJSONRequestBody defines body for AuthServiceResetPassword for application/json ContentType.
+type AuthServiceResetPasswordJSONRequestBody = V1ResetPasswordRequest
+
+// AuthServiceSSOCallbackJSONRequestBody defines body for AuthServiceSSOCallback for application/json ContentType.
+type AuthServiceSSOCallbackJSONRequestBody = V1SSOCallbackRequest
+
+// AuthServiceVerifyEmailJSONRequestBody defines body for AuthServiceVerifyEmail for application/json ContentType.
+type AuthServiceVerifyEmailJSONRequestBody = V1VerifyEmailRequest
+
+// HabitsServiceUpdateHabitLogJSONRequestBody defines body for HabitsServiceUpdateHabitLog for application/json ContentType.
+type HabitsServiceUpdateHabitLogJSONRequestBody = HabitsServiceUpdateHabitLogBody
+
+// HabitsServiceCreateHabitJSONRequestBody defines body for HabitsServiceCreateHabit for application/json ContentType.
+type HabitsServiceCreateHabitJSONRequestBody = V1CreateHabitRequest
+
+// HabitsServiceReorderHabitsJSONRequestBody defines body for HabitsServiceReorderHabits for application/json ContentType.
+type HabitsServiceReorderHabitsJSONRequestBody = V1ReorderHabitsRequest
+
+// HabitsServiceUpdateHabitJSONRequestBody defines body for HabitsServiceUpdateHabit for application/json ContentType.
+type HabitsServiceUpdateHabitJSONRequestBody = HabitsServiceUpdateHabitBody
+
+// HabitsServiceLogHabitJSONRequestBody defines body for HabitsServiceLogHabit for application/json ContentType.
+type HabitsServiceLogHabitJSONRequestBody = HabitsServiceLogHabitBody
+
+// HabitsServiceSetHabitLogCountJSONRequestBody defines body for HabitsServiceSetHabitLogCount for application/json ContentType.
+type HabitsServiceSetHabitLogCountJSONRequestBody = HabitsServiceSetHabitLogCountBody
+
+// HabitsServiceUndoHabitLogJSONRequestBody defines body for HabitsServiceUndoHabitLog for application/json ContentType.
+type HabitsServiceUndoHabitLogJSONRequestBody = HabitsServiceUndoHabitLogBody
+
+// HabitsServicePauseHabitJSONRequestBody defines body for HabitsServicePauseHabit for application/json ContentType.
+type HabitsServicePauseHabitJSONRequestBody = HabitsServicePauseHabitBody
+
+// HabitsServiceStartVacationJSONRequestBody defines body for HabitsServiceStartVacation for application/json ContentType.
+type HabitsServiceStartVacationJSONRequestBody = HabitsServiceStartVacationBody
+
+// HabitsServiceEndVacationJSONRequestBody defines body for HabitsServiceEndVacation for application/json ContentType.
+type HabitsServiceEndVacationJSONRequestBody = HabitsServiceEndVacationBody
+
+// HabitsServiceStartImportJSONRequestBody defines body for HabitsServiceStartImport for application/json ContentType.
+type HabitsServiceStartImportJSONRequestBody = V1StartImportRequest
+
+// HabitsServicePreviewImportJSONRequestBody defines body for HabitsServicePreviewImport for application/json ContentType.
+type HabitsServicePreviewImportJSONRequestBody = V1PreviewImportRequest
+
+// NotificationsServiceCreateNotificationJSONRequestBody defines body for NotificationsServiceCreateNotification for application/json ContentType.
+type NotificationsServiceCreateNotificationJSONRequestBody = V1CreateNotificationRequest
+
+// NotificationsServiceUpdateNotificationPreferencesJSONRequestBody defines body for NotificationsServiceUpdateNotificationPreferences for application/json ContentType.
+type NotificationsServiceUpdateNotificationPreferencesJSONRequestBody = V1UpdateNotificationPreferencesRequest
+
+// NotificationsServiceUnsubscribeJSONRequestBody defines body for NotificationsServiceUnsubscribe for application/json ContentType.
+type NotificationsServiceUnsubscribeJSONRequestBody = V1UnsubscribeRequest
+
+// NotificationsServiceSnoozeNotificationJSONRequestBody defines body for NotificationsServiceSnoozeNotification for application/json ContentType.
+type NotificationsServiceSnoozeNotificationJSONRequestBody = NotificationsServiceSnoozeNotificationBody
+
+// NotificationsServiceBatchDeleteNotificationsJSONRequestBody defines body for NotificationsServiceBatchDeleteNotifications for application/json ContentType.
+type NotificationsServiceBatchDeleteNotificationsJSONRequestBody = V1BatchDeleteNotificationsRequest
+
+// NotificationsServiceBatchMarkAsReadJSONRequestBody defines body for NotificationsServiceBatchMarkAsRead for application/json ContentType.
+type NotificationsServiceBatchMarkAsReadJSONRequestBody = V1BatchMarkAsReadRequest
+
+// Getter for additional properties for ProtobufAny. Returns the specified
+// element and whether it was found
+func (a ProtobufAny) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for ProtobufAny
+func (a *ProtobufAny) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for ProtobufAny to handle AdditionalProperties
+func (a *ProtobufAny) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["@type"]; found {
+		err = json.Unmarshal(raw, &a.Type)
+		if err != nil {
+			return fmt.Errorf("error reading '@type': %w", err)
+		}
+		delete(object, "@type")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for ProtobufAny to handle AdditionalProperties
+func (a ProtobufAny) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	if a.Type != nil {
+		object["@type"], err = json.Marshal(a.Type)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '@type': %w", err)
+		}
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// HabitsServiceComparePeriods request
+	HabitsServiceComparePeriods(ctx context.Context, params *HabitsServiceComparePeriodsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// HabitsServiceGetHabitCorrelations request
+	HabitsServiceGetHabitCorrelations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// HabitsServiceGetWeeklyAnalytics request
+	HabitsServiceGetWeeklyAnalytics(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AuthServiceDeleteAccountWithBody request with any body
+	AuthServiceDeleteAccountWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AuthServiceDeleteAccount(ctx context.Context, body AuthServiceDeleteAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AuthServiceChangePasswordWithBody request with any body
+	AuthServiceChangePasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AuthServiceChangePassword(ctx context.Context, body AuthServiceChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AuthServiceListConsents request
+	AuthServiceListConsents(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AuthServiceAcceptConsentWithBody request with any body
+	AuthServiceAcceptConsentWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AuthServiceAcceptConsent(ctx context.Context, body AuthServiceAcceptConsentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AuthServiceDeactivateAccountWithBody request with any body
+	AuthServiceDeactivateAccountWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AuthServiceDeactivateAccount(ctx context.Context, body AuthServiceDeactivateAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AuthServiceExportUserData request
+	AuthServiceExportUserData(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AuthServiceForgotPasswordWithBody request with any body
+	AuthServiceForgotPasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AuthServiceForgotPassword(ctx context.Context, body AuthServiceForgotPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AuthServiceGoogleCallbackWithBody request with any body
+	AuthServiceGoogleCallbackWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AuthServiceGoogleCallback(ctx context.Context, body AuthServiceGoogleCallbackJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AuthServiceGoogleLogin request
+	AuthServiceGoogleLogin(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AuthServiceLoginWithBody request with any body
+	AuthServiceLoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AuthServiceLogin(ctx context.Context, body AuthServiceLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AuthServiceLogoutWithBody request with any body
+	AuthServiceLogoutWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AuthServiceLogout(ctx context.Context, body AuthServiceLogoutJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AuthServiceLogoutAllWithBody request with any body
+	AuthServiceLogoutAllWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AuthServiceLogoutAll(ctx context.Context, body AuthServiceLogoutAllJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AuthServiceRequestMagicLinkWithBody request with any body
+	AuthServiceRequestMagicLinkWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AuthServiceRequestMagicLink(ctx context.Context, body AuthServiceRequestMagicLinkJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AuthServiceVerifyMagicLink request
+	AuthServiceVerifyMagicLink(ctx context.Context, params *AuthServiceVerifyMagicLinkParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AuthServiceGetPreferences request
+	AuthServiceGetPreferences(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AuthServiceUpdatePreferencesWithBody request with any body
+	AuthServiceUpdatePreferencesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AuthServiceUpdatePreferences(ctx context.Context, body AuthServiceUpdatePreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AuthServiceGetProfile request
+	AuthServiceGetProfile(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AuthServiceUpdateProfileWithBody request with any body
+	AuthServiceUpdateProfileWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AuthServiceUpdateProfile(ctx context.Context, body AuthServiceUpdateProfileJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AuthServiceRefreshTokenWithBody request with any body
+	AuthServiceRefreshTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AuthServiceRefreshToken(ctx context.Context, body AuthServiceRefreshTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AuthServiceRegisterWithBody request with any body
+	AuthServiceRegisterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AuthServiceRegister(ctx context.Context, body AuthServiceRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AuthServiceResendVerificationWithBody request with any body
+	AuthServiceResendVerificationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AuthServiceResendVerification(ctx context.Context, body AuthServiceResendVerificationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AuthServiceResetPasswordWithBody request with any body
+	AuthServiceResetPasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AuthServiceResetPassword(ctx context.Context, body AuthServiceResetPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AuthServiceListSessions request
+	AuthServiceListSessions(ctx context.Context, params *AuthServiceListSessionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AuthServiceRevokeOtherSessions request
+	AuthServiceRevokeOtherSessions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AuthServiceRevokeSession request
+	AuthServiceRevokeSession(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AuthServiceSSOCallbackWithBody request with any body
+	AuthServiceSSOCallbackWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AuthServiceSSOCallback(ctx context.Context, body AuthServiceSSOCallbackJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AuthServiceSSOLogin request
+	AuthServiceSSOLogin(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AuthServiceVerifyEmailWithBody request with any body
+	AuthServiceVerifyEmailWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AuthServiceVerifyEmail(ctx context.Context, body AuthServiceVerifyEmailJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// HabitsServiceGetDashboard request
+	HabitsServiceGetDashboard(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// HabitsServiceDeleteHabitLog request
+	HabitsServiceDeleteHabitLog(ctx context.Context, logId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// HabitsServiceUpdateHabitLogWithBody request with any body
+	HabitsServiceUpdateHabitLogWithBody(ctx context.Context, logId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	HabitsServiceUpdateHabitLog(ctx context.Context, logId string, body HabitsServiceUpdateHabitLogJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// HabitsServiceListHabits request
+	HabitsServiceListHabits(ctx context.Context, params *HabitsServiceListHabitsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// HabitsServiceCreateHabitWithBody request with any body
+	HabitsServiceCreateHabitWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	HabitsServiceCreateHabit(ctx context.Context, body HabitsServiceCreateHabitJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// HabitsServiceReorderHabitsWithBody request with any body
+	HabitsServiceReorderHabitsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	HabitsServiceReorderHabits(ctx context.Context, body HabitsServiceReorderHabitsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// HabitsServiceDeleteHabit request
+	HabitsServiceDeleteHabit(ctx context.Context, habitId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// HabitsServiceGetHabit request
+	HabitsServiceGetHabit(ctx context.Context, habitId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// HabitsServiceUpdateHabitWithBody request with any body
+	HabitsServiceUpdateHabitWithBody(ctx context.Context, habitId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	HabitsServiceUpdateHabit(ctx context.Context, habitId string, body HabitsServiceUpdateHabitJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// HabitsServiceActivateHabit request
+	HabitsServiceActivateHabit(ctx context.Context, habitId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// HabitsServiceDeactivateHabit request
+	HabitsServiceDeactivateHabit(ctx context.Context, habitId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// HabitsServiceGetHabitInsights request
+	HabitsServiceGetHabitInsights(ctx context.Context, habitId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// HabitsServiceGetHabitLogs request
+	HabitsServiceGetHabitLogs(ctx context.Context, habitId string, params *HabitsServiceGetHabitLogsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// HabitsServiceLogHabitWithBody request with any body
+	HabitsServiceLogHabitWithBody(ctx context.Context, habitId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	HabitsServiceLogHabit(ctx context.Context, habitId string, body HabitsServiceLogHabitJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// HabitsServiceSetHabitLogCountWithBody request with any body
+	HabitsServiceSetHabitLogCountWithBody(ctx context.Context, habitId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	HabitsServiceSetHabitLogCount(ctx context.Context, habitId string, body HabitsServiceSetHabitLogCountJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// HabitsServiceUndoHabitLogWithBody request with any body
+	HabitsServiceUndoHabitLogWithBody(ctx context.Context, habitId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	HabitsServiceUndoHabitLog(ctx context.Context, habitId string, body HabitsServiceUndoHabitLogJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// HabitsServicePauseHabitWithBody request with any body
+	HabitsServicePauseHabitWithBody(ctx context.Context, habitId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	HabitsServicePauseHabit(ctx context.Context, habitId string, body HabitsServicePauseHabitJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// HabitsServiceGetHabitStats request
+	HabitsServiceGetHabitStats(ctx context.Context, habitId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// HabitsServiceListVacations request
+	HabitsServiceListVacations(ctx context.Context, habitId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// HabitsServiceStartVacationWithBody request with any body
+	HabitsServiceStartVacationWithBody(ctx context.Context, habitId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	HabitsServiceStartVacation(ctx context.Context, habitId string, body HabitsServiceStartVacationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// HabitsServiceEndVacationWithBody request with any body
+	HabitsServiceEndVacationWithBody(ctx context.Context, habitId string, vacationId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	HabitsServiceEndVacation(ctx context.Context, habitId string, vacationId string, body HabitsServiceEndVacationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// HabitsServiceStartImportWithBody request with any body
+	HabitsServiceStartImportWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	HabitsServiceStartImport(ctx context.Context, body HabitsServiceStartImportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// HabitsServicePreviewImportWithBody request with any body
+	HabitsServicePreviewImportWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	HabitsServicePreviewImport(ctx context.Context, body HabitsServicePreviewImportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// HabitsServiceGetImport request
+	HabitsServiceGetImport(ctx context.Context, importId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// NotificationsServiceListNotifications request
+	NotificationsServiceListNotifications(ctx context.Context, params *NotificationsServiceListNotificationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// NotificationsServiceCreateNotificationWithBody request with any body
+	NotificationsServiceCreateNotificationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	NotificationsServiceCreateNotification(ctx context.Context, body NotificationsServiceCreateNotificationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// NotificationsServiceGetNotificationPreferences request
+	NotificationsServiceGetNotificationPreferences(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// NotificationsServiceUpdateNotificationPreferencesWithBody request with any body
+	NotificationsServiceUpdateNotificationPreferencesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	NotificationsServiceUpdateNotificationPreferences(ctx context.Context, body NotificationsServiceUpdateNotificationPreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// NotificationsServiceMarkAllAsRead request
+	NotificationsServiceMarkAllAsRead(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// NotificationsServiceGetUnreadCount request
+	NotificationsServiceGetUnreadCount(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// NotificationsServiceUnsubscribeWithBody request with any body
+	NotificationsServiceUnsubscribeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	NotificationsServiceUnsubscribe(ctx context.Context, body NotificationsServiceUnsubscribeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// NotificationsServiceDeleteNotification request
+	NotificationsServiceDeleteNotification(ctx context.Context, notificationId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// NotificationsServiceMarkAsRead request
+	NotificationsServiceMarkAsRead(ctx context.Context, notificationId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// NotificationsServiceSnoozeNotificationWithBody request with any body
+	NotificationsServiceSnoozeNotificationWithBody(ctx context.Context, notificationId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	NotificationsServiceSnoozeNotification(ctx context.Context, notificationId string, body NotificationsServiceSnoozeNotificationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// NotificationsServiceBatchDeleteNotificationsWithBody request with any body
+	NotificationsServiceBatchDeleteNotificationsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	NotificationsServiceBatchDeleteNotifications(ctx context.Context, body NotificationsServiceBatchDeleteNotificationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// NotificationsServiceBatchMarkAsReadWithBody request with any body
+	NotificationsServiceBatchMarkAsReadWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	NotificationsServiceBatchMarkAsRead(ctx context.Context, body NotificationsServiceBatchMarkAsReadJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) HabitsServiceComparePeriods(ctx context.Context, params *HabitsServiceComparePeriodsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHabitsServiceComparePeriodsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) HabitsServiceGetHabitCorrelations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHabitsServiceGetHabitCorrelationsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) HabitsServiceGetWeeklyAnalytics(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHabitsServiceGetWeeklyAnalyticsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthServiceDeleteAccountWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthServiceDeleteAccountRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthServiceDeleteAccount(ctx context.Context, body AuthServiceDeleteAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthServiceDeleteAccountRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthServiceChangePasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthServiceChangePasswordRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthServiceChangePassword(ctx context.Context, body AuthServiceChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthServiceChangePasswordRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthServiceListConsents(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthServiceListConsentsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthServiceAcceptConsentWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthServiceAcceptConsentRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthServiceAcceptConsent(ctx context.Context, body AuthServiceAcceptConsentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthServiceAcceptConsentRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthServiceDeactivateAccountWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthServiceDeactivateAccountRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthServiceDeactivateAccount(ctx context.Context, body AuthServiceDeactivateAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthServiceDeactivateAccountRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthServiceExportUserData(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthServiceExportUserDataRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthServiceForgotPasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthServiceForgotPasswordRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthServiceForgotPassword(ctx context.Context, body AuthServiceForgotPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthServiceForgotPasswordRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthServiceGoogleCallbackWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthServiceGoogleCallbackRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthServiceGoogleCallback(ctx context.Context, body AuthServiceGoogleCallbackJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthServiceGoogleCallbackRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthServiceGoogleLogin(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthServiceGoogleLoginRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthServiceLoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthServiceLoginRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthServiceLogin(ctx context.Context, body AuthServiceLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthServiceLoginRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthServiceLogoutWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthServiceLogoutRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthServiceLogout(ctx context.Context, body AuthServiceLogoutJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthServiceLogoutRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthServiceLogoutAllWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthServiceLogoutAllRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthServiceLogoutAll(ctx context.Context, body AuthServiceLogoutAllJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthServiceLogoutAllRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthServiceRequestMagicLinkWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthServiceRequestMagicLinkRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthServiceRequestMagicLink(ctx context.Context, body AuthServiceRequestMagicLinkJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthServiceRequestMagicLinkRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthServiceVerifyMagicLink(ctx context.Context, params *AuthServiceVerifyMagicLinkParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthServiceVerifyMagicLinkRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthServiceGetPreferences(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthServiceGetPreferencesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthServiceUpdatePreferencesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthServiceUpdatePreferencesRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthServiceUpdatePreferences(ctx context.Context, body AuthServiceUpdatePreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthServiceUpdatePreferencesRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthServiceGetProfile(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthServiceGetProfileRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthServiceUpdateProfileWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthServiceUpdateProfileRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthServiceUpdateProfile(ctx context.Context, body AuthServiceUpdateProfileJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthServiceUpdateProfileRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthServiceRefreshTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthServiceRefreshTokenRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthServiceRefreshToken(ctx context.Context, body AuthServiceRefreshTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthServiceRefreshTokenRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthServiceRegisterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthServiceRegisterRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthServiceRegister(ctx context.Context, body AuthServiceRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthServiceRegisterRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthServiceResendVerificationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthServiceResendVerificationRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthServiceResendVerification(ctx context.Context, body AuthServiceResendVerificationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthServiceResendVerificationRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthServiceResetPasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthServiceResetPasswordRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthServiceResetPassword(ctx context.Context, body AuthServiceResetPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthServiceResetPasswordRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthServiceListSessions(ctx context.Context, params *AuthServiceListSessionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthServiceListSessionsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthServiceRevokeOtherSessions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthServiceRevokeOtherSessionsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthServiceRevokeSession(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthServiceRevokeSessionRequest(c.Server, sessionId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthServiceSSOCallbackWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthServiceSSOCallbackRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthServiceSSOCallback(ctx context.Context, body AuthServiceSSOCallbackJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthServiceSSOCallbackRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthServiceSSOLogin(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthServiceSSOLoginRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthServiceVerifyEmailWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthServiceVerifyEmailRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthServiceVerifyEmail(ctx context.Context, body AuthServiceVerifyEmailJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthServiceVerifyEmailRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) HabitsServiceGetDashboard(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHabitsServiceGetDashboardRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) HabitsServiceDeleteHabitLog(ctx context.Context, logId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHabitsServiceDeleteHabitLogRequest(c.Server, logId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) HabitsServiceUpdateHabitLogWithBody(ctx context.Context, logId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHabitsServiceUpdateHabitLogRequestWithBody(c.Server, logId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) HabitsServiceUpdateHabitLog(ctx context.Context, logId string, body HabitsServiceUpdateHabitLogJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHabitsServiceUpdateHabitLogRequest(c.Server, logId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) HabitsServiceListHabits(ctx context.Context, params *HabitsServiceListHabitsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHabitsServiceListHabitsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) HabitsServiceCreateHabitWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHabitsServiceCreateHabitRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) HabitsServiceCreateHabit(ctx context.Context, body HabitsServiceCreateHabitJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHabitsServiceCreateHabitRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) HabitsServiceReorderHabitsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHabitsServiceReorderHabitsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) HabitsServiceReorderHabits(ctx context.Context, body HabitsServiceReorderHabitsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHabitsServiceReorderHabitsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) HabitsServiceDeleteHabit(ctx context.Context, habitId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHabitsServiceDeleteHabitRequest(c.Server, habitId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) HabitsServiceGetHabit(ctx context.Context, habitId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHabitsServiceGetHabitRequest(c.Server, habitId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) HabitsServiceUpdateHabitWithBody(ctx context.Context, habitId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHabitsServiceUpdateHabitRequestWithBody(c.Server, habitId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) HabitsServiceUpdateHabit(ctx context.Context, habitId string, body HabitsServiceUpdateHabitJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHabitsServiceUpdateHabitRequest(c.Server, habitId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) HabitsServiceActivateHabit(ctx context.Context, habitId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHabitsServiceActivateHabitRequest(c.Server, habitId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) HabitsServiceDeactivateHabit(ctx context.Context, habitId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHabitsServiceDeactivateHabitRequest(c.Server, habitId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) HabitsServiceGetHabitInsights(ctx context.Context, habitId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHabitsServiceGetHabitInsightsRequest(c.Server, habitId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) HabitsServiceGetHabitLogs(ctx context.Context, habitId string, params *HabitsServiceGetHabitLogsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHabitsServiceGetHabitLogsRequest(c.Server, habitId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) HabitsServiceLogHabitWithBody(ctx context.Context, habitId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHabitsServiceLogHabitRequestWithBody(c.Server, habitId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) HabitsServiceLogHabit(ctx context.Context, habitId string, body HabitsServiceLogHabitJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHabitsServiceLogHabitRequest(c.Server, habitId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) HabitsServiceSetHabitLogCountWithBody(ctx context.Context, habitId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHabitsServiceSetHabitLogCountRequestWithBody(c.Server, habitId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) HabitsServiceSetHabitLogCount(ctx context.Context, habitId string, body HabitsServiceSetHabitLogCountJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHabitsServiceSetHabitLogCountRequest(c.Server, habitId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) HabitsServiceUndoHabitLogWithBody(ctx context.Context, habitId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHabitsServiceUndoHabitLogRequestWithBody(c.Server, habitId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) HabitsServiceUndoHabitLog(ctx context.Context, habitId string, body HabitsServiceUndoHabitLogJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHabitsServiceUndoHabitLogRequest(c.Server, habitId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) HabitsServicePauseHabitWithBody(ctx context.Context, habitId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHabitsServicePauseHabitRequestWithBody(c.Server, habitId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) HabitsServicePauseHabit(ctx context.Context, habitId string, body HabitsServicePauseHabitJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHabitsServicePauseHabitRequest(c.Server, habitId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) HabitsServiceGetHabitStats(ctx context.Context, habitId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHabitsServiceGetHabitStatsRequest(c.Server, habitId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) HabitsServiceListVacations(ctx context.Context, habitId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHabitsServiceListVacationsRequest(c.Server, habitId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) HabitsServiceStartVacationWithBody(ctx context.Context, habitId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHabitsServiceStartVacationRequestWithBody(c.Server, habitId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) HabitsServiceStartVacation(ctx context.Context, habitId string, body HabitsServiceStartVacationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHabitsServiceStartVacationRequest(c.Server, habitId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) HabitsServiceEndVacationWithBody(ctx context.Context, habitId string, vacationId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHabitsServiceEndVacationRequestWithBody(c.Server, habitId, vacationId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) HabitsServiceEndVacation(ctx context.Context, habitId string, vacationId string, body HabitsServiceEndVacationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHabitsServiceEndVacationRequest(c.Server, habitId, vacationId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) HabitsServiceStartImportWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHabitsServiceStartImportRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) HabitsServiceStartImport(ctx context.Context, body HabitsServiceStartImportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHabitsServiceStartImportRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) HabitsServicePreviewImportWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHabitsServicePreviewImportRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) HabitsServicePreviewImport(ctx context.Context, body HabitsServicePreviewImportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHabitsServicePreviewImportRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) HabitsServiceGetImport(ctx context.Context, importId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHabitsServiceGetImportRequest(c.Server, importId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) NotificationsServiceListNotifications(ctx context.Context, params *NotificationsServiceListNotificationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNotificationsServiceListNotificationsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) NotificationsServiceCreateNotificationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNotificationsServiceCreateNotificationRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) NotificationsServiceCreateNotification(ctx context.Context, body NotificationsServiceCreateNotificationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNotificationsServiceCreateNotificationRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) NotificationsServiceGetNotificationPreferences(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNotificationsServiceGetNotificationPreferencesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) NotificationsServiceUpdateNotificationPreferencesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNotificationsServiceUpdateNotificationPreferencesRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) NotificationsServiceUpdateNotificationPreferences(ctx context.Context, body NotificationsServiceUpdateNotificationPreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNotificationsServiceUpdateNotificationPreferencesRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) NotificationsServiceMarkAllAsRead(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNotificationsServiceMarkAllAsReadRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) NotificationsServiceGetUnreadCount(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNotificationsServiceGetUnreadCountRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) NotificationsServiceUnsubscribeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNotificationsServiceUnsubscribeRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) NotificationsServiceUnsubscribe(ctx context.Context, body NotificationsServiceUnsubscribeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNotificationsServiceUnsubscribeRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) NotificationsServiceDeleteNotification(ctx context.Context, notificationId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNotificationsServiceDeleteNotificationRequest(c.Server, notificationId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) NotificationsServiceMarkAsRead(ctx context.Context, notificationId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNotificationsServiceMarkAsReadRequest(c.Server, notificationId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) NotificationsServiceSnoozeNotificationWithBody(ctx context.Context, notificationId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNotificationsServiceSnoozeNotificationRequestWithBody(c.Server, notificationId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) NotificationsServiceSnoozeNotification(ctx context.Context, notificationId string, body NotificationsServiceSnoozeNotificationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNotificationsServiceSnoozeNotificationRequest(c.Server, notificationId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) NotificationsServiceBatchDeleteNotificationsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNotificationsServiceBatchDeleteNotificationsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) NotificationsServiceBatchDeleteNotifications(ctx context.Context, body NotificationsServiceBatchDeleteNotificationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNotificationsServiceBatchDeleteNotificationsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) NotificationsServiceBatchMarkAsReadWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNotificationsServiceBatchMarkAsReadRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) NotificationsServiceBatchMarkAsRead(ctx context.Context, body NotificationsServiceBatchMarkAsReadJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNotificationsServiceBatchMarkAsReadRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewHabitsServiceComparePeriodsRequest generates requests for HabitsServiceComparePeriods
+func NewHabitsServiceComparePeriodsRequest(server string, params *HabitsServiceComparePeriodsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/analytics/compare")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Period != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "period", runtime.ParamLocationQuery, *params.Period); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewHabitsServiceGetHabitCorrelationsRequest generates requests for HabitsServiceGetHabitCorrelations
+func NewHabitsServiceGetHabitCorrelationsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/analytics/correlations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewHabitsServiceGetWeeklyAnalyticsRequest generates requests for HabitsServiceGetWeeklyAnalytics
+func NewHabitsServiceGetWeeklyAnalyticsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/analytics/weekly")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAuthServiceDeleteAccountRequest calls the generic AuthServiceDeleteAccount builder with application/json body
+func NewAuthServiceDeleteAccountRequest(server string, body AuthServiceDeleteAccountJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAuthServiceDeleteAccountRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAuthServiceDeleteAccountRequestWithBody generates requests for AuthServiceDeleteAccount with any type of body
+func NewAuthServiceDeleteAccountRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/account/delete")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAuthServiceChangePasswordRequest calls the generic AuthServiceChangePassword builder with application/json body
+func NewAuthServiceChangePasswordRequest(server string, body AuthServiceChangePasswordJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAuthServiceChangePasswordRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAuthServiceChangePasswordRequestWithBody generates requests for AuthServiceChangePassword with any type of body
+func NewAuthServiceChangePasswordRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/change-password")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAuthServiceListConsentsRequest generates requests for AuthServiceListConsents
+func NewAuthServiceListConsentsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/consents")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAuthServiceAcceptConsentRequest calls the generic AuthServiceAcceptConsent builder with application/json body
+func NewAuthServiceAcceptConsentRequest(server string, body AuthServiceAcceptConsentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAuthServiceAcceptConsentRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAuthServiceAcceptConsentRequestWithBody generates requests for AuthServiceAcceptConsent with any type of body
+func NewAuthServiceAcceptConsentRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/consents")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAuthServiceDeactivateAccountRequest calls the generic AuthServiceDeactivateAccount builder with application/json body
+func NewAuthServiceDeactivateAccountRequest(server string, body AuthServiceDeactivateAccountJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAuthServiceDeactivateAccountRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAuthServiceDeactivateAccountRequestWithBody generates requests for AuthServiceDeactivateAccount with any type of body
+func NewAuthServiceDeactivateAccountRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/deactivate")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAuthServiceExportUserDataRequest generates requests for AuthServiceExportUserData
+func NewAuthServiceExportUserDataRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/export")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAuthServiceForgotPasswordRequest calls the generic AuthServiceForgotPassword builder with application/json body
+func NewAuthServiceForgotPasswordRequest(server string, body AuthServiceForgotPasswordJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAuthServiceForgotPasswordRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAuthServiceForgotPasswordRequestWithBody generates requests for AuthServiceForgotPassword with any type of body
+func NewAuthServiceForgotPasswordRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/forgot-password")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAuthServiceGoogleCallbackRequest calls the generic AuthServiceGoogleCallback builder with application/json body
+func NewAuthServiceGoogleCallbackRequest(server string, body AuthServiceGoogleCallbackJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAuthServiceGoogleCallbackRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAuthServiceGoogleCallbackRequestWithBody generates requests for AuthServiceGoogleCallback with any type of body
+func NewAuthServiceGoogleCallbackRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/google/callback")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAuthServiceGoogleLoginRequest generates requests for AuthServiceGoogleLogin
+func NewAuthServiceGoogleLoginRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/google/login")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAuthServiceLoginRequest calls the generic AuthServiceLogin builder with application/json body
+func NewAuthServiceLoginRequest(server string, body AuthServiceLoginJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAuthServiceLoginRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAuthServiceLoginRequestWithBody generates requests for AuthServiceLogin with any type of body
+func NewAuthServiceLoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/login")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAuthServiceLogoutRequest calls the generic AuthServiceLogout builder with application/json body
+func NewAuthServiceLogoutRequest(server string, body AuthServiceLogoutJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAuthServiceLogoutRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAuthServiceLogoutRequestWithBody generates requests for AuthServiceLogout with any type of body
+func NewAuthServiceLogoutRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/logout")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAuthServiceLogoutAllRequest calls the generic AuthServiceLogoutAll builder with application/json body
+func NewAuthServiceLogoutAllRequest(server string, body AuthServiceLogoutAllJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAuthServiceLogoutAllRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAuthServiceLogoutAllRequestWithBody generates requests for AuthServiceLogoutAll with any type of body
+func NewAuthServiceLogoutAllRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/logout-all")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAuthServiceRequestMagicLinkRequest calls the generic AuthServiceRequestMagicLink builder with application/json body
+func NewAuthServiceRequestMagicLinkRequest(server string, body AuthServiceRequestMagicLinkJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAuthServiceRequestMagicLinkRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAuthServiceRequestMagicLinkRequestWithBody generates requests for AuthServiceRequestMagicLink with any type of body
+func NewAuthServiceRequestMagicLinkRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/magic-link")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAuthServiceVerifyMagicLinkRequest generates requests for AuthServiceVerifyMagicLink
+func NewAuthServiceVerifyMagicLinkRequest(server string, params *AuthServiceVerifyMagicLinkParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/magic-link/verify")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Token != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "token", runtime.ParamLocationQuery, *params.Token); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Reactivate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "reactivate", runtime.ParamLocationQuery, *params.Reactivate); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAuthServiceGetPreferencesRequest generates requests for AuthServiceGetPreferences
+func NewAuthServiceGetPreferencesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/preferences")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAuthServiceUpdatePreferencesRequest calls the generic AuthServiceUpdatePreferences builder with application/json body
+func NewAuthServiceUpdatePreferencesRequest(server string, body AuthServiceUpdatePreferencesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAuthServiceUpdatePreferencesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAuthServiceUpdatePreferencesRequestWithBody generates requests for AuthServiceUpdatePreferences with any type of body
+func NewAuthServiceUpdatePreferencesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/preferences")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAuthServiceGetProfileRequest generates requests for AuthServiceGetProfile
+func NewAuthServiceGetProfileRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/profile")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAuthServiceUpdateProfileRequest calls the generic AuthServiceUpdateProfile builder with application/json body
+func NewAuthServiceUpdateProfileRequest(server string, body AuthServiceUpdateProfileJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAuthServiceUpdateProfileRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAuthServiceUpdateProfileRequestWithBody generates requests for AuthServiceUpdateProfile with any type of body
+func NewAuthServiceUpdateProfileRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/profile")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAuthServiceRefreshTokenRequest calls the generic AuthServiceRefreshToken builder with application/json body
+func NewAuthServiceRefreshTokenRequest(server string, body AuthServiceRefreshTokenJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAuthServiceRefreshTokenRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAuthServiceRefreshTokenRequestWithBody generates requests for AuthServiceRefreshToken with any type of body
+func NewAuthServiceRefreshTokenRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/refresh")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAuthServiceRegisterRequest calls the generic AuthServiceRegister builder with application/json body
+func NewAuthServiceRegisterRequest(server string, body AuthServiceRegisterJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAuthServiceRegisterRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAuthServiceRegisterRequestWithBody generates requests for AuthServiceRegister with any type of body
+func NewAuthServiceRegisterRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/register")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAuthServiceResendVerificationRequest calls the generic AuthServiceResendVerification builder with application/json body
+func NewAuthServiceResendVerificationRequest(server string, body AuthServiceResendVerificationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAuthServiceResendVerificationRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAuthServiceResendVerificationRequestWithBody generates requests for AuthServiceResendVerification with any type of body
+func NewAuthServiceResendVerificationRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/resend-verification")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAuthServiceResetPasswordRequest calls the generic AuthServiceResetPassword builder with application/json body
+func NewAuthServiceResetPasswordRequest(server string, body AuthServiceResetPasswordJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAuthServiceResetPasswordRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAuthServiceResetPasswordRequestWithBody generates requests for AuthServiceResetPassword with any type of body
+func NewAuthServiceResetPasswordRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/reset-password")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAuthServiceListSessionsRequest generates requests for AuthServiceListSessions
+func NewAuthServiceListSessionsRequest(server string, params *AuthServiceListSessionsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/sessions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PerPage != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "per_page", runtime.ParamLocationQuery, *params.PerPage); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.IncludeBlocked != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include_blocked", runtime.ParamLocationQuery, *params.IncludeBlocked); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.IncludeExpired != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include_expired", runtime.ParamLocationQuery, *params.IncludeExpired); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAuthServiceRevokeOtherSessionsRequest generates requests for AuthServiceRevokeOtherSessions
+func NewAuthServiceRevokeOtherSessionsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/sessions/other")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAuthServiceRevokeSessionRequest generates requests for AuthServiceRevokeSession
+func NewAuthServiceRevokeSessionRequest(server string, sessionId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "session_id", runtime.ParamLocationPath, sessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/sessions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAuthServiceSSOCallbackRequest calls the generic AuthServiceSSOCallback builder with application/json body
+func NewAuthServiceSSOCallbackRequest(server string, body AuthServiceSSOCallbackJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAuthServiceSSOCallbackRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAuthServiceSSOCallbackRequestWithBody generates requests for AuthServiceSSOCallback with any type of body
+func NewAuthServiceSSOCallbackRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/sso/callback")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAuthServiceSSOLoginRequest generates requests for AuthServiceSSOLogin
+func NewAuthServiceSSOLoginRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/sso/login")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAuthServiceVerifyEmailRequest calls the generic AuthServiceVerifyEmail builder with application/json body
+func NewAuthServiceVerifyEmailRequest(server string, body AuthServiceVerifyEmailJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAuthServiceVerifyEmailRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAuthServiceVerifyEmailRequestWithBody generates requests for AuthServiceVerifyEmail with any type of body
+func NewAuthServiceVerifyEmailRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/verify-email")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewHabitsServiceGetDashboardRequest generates requests for HabitsServiceGetDashboard
+func NewHabitsServiceGetDashboardRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/dashboard")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewHabitsServiceDeleteHabitLogRequest generates requests for HabitsServiceDeleteHabitLog
+func NewHabitsServiceDeleteHabitLogRequest(server string, logId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "log_id", runtime.ParamLocationPath, logId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/habit-logs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewHabitsServiceUpdateHabitLogRequest calls the generic HabitsServiceUpdateHabitLog builder with application/json body
+func NewHabitsServiceUpdateHabitLogRequest(server string, logId string, body HabitsServiceUpdateHabitLogJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewHabitsServiceUpdateHabitLogRequestWithBody(server, logId, "application/json", bodyReader)
+}
+
+// NewHabitsServiceUpdateHabitLogRequestWithBody generates requests for HabitsServiceUpdateHabitLog with any type of body
+func NewHabitsServiceUpdateHabitLogRequestWithBody(server string, logId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "log_id", runtime.ParamLocationPath, logId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/habit-logs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewHabitsServiceListHabitsRequest generates requests for HabitsServiceListHabits
+func NewHabitsServiceListHabitsRequest(server string, params *HabitsServiceListHabitsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/habits")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PerPage != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "per_page", runtime.ParamLocationQuery, *params.PerPage); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Active != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "active", runtime.ParamLocationQuery, *params.Active); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Inactive != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "inactive", runtime.ParamLocationQuery, *params.Inactive); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Keyword != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "keyword", runtime.ParamLocationQuery, *params.Keyword); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.StartDate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start_date", runtime.ParamLocationQuery, *params.StartDate); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EndDate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end_date", runtime.ParamLocationQuery, *params.EndDate); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SortBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort_by", runtime.ParamLocationQuery, *params.SortBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SortDirection != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort_direction", runtime.ParamLocationQuery, *params.SortDirection); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewHabitsServiceCreateHabitRequest calls the generic HabitsServiceCreateHabit builder with application/json body
+func NewHabitsServiceCreateHabitRequest(server string, body HabitsServiceCreateHabitJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewHabitsServiceCreateHabitRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewHabitsServiceCreateHabitRequestWithBody generates requests for HabitsServiceCreateHabit with any type of body
+func NewHabitsServiceCreateHabitRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/habits")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewHabitsServiceReorderHabitsRequest calls the generic HabitsServiceReorderHabits builder with application/json body
+func NewHabitsServiceReorderHabitsRequest(server string, body HabitsServiceReorderHabitsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewHabitsServiceReorderHabitsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewHabitsServiceReorderHabitsRequestWithBody generates requests for HabitsServiceReorderHabits with any type of body
+func NewHabitsServiceReorderHabitsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/habits/order")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewHabitsServiceDeleteHabitRequest generates requests for HabitsServiceDeleteHabit
+func NewHabitsServiceDeleteHabitRequest(server string, habitId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "habit_id", runtime.ParamLocationPath, habitId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/habits/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewHabitsServiceGetHabitRequest generates requests for HabitsServiceGetHabit
+func NewHabitsServiceGetHabitRequest(server string, habitId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "habit_id", runtime.ParamLocationPath, habitId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/habits/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewHabitsServiceUpdateHabitRequest calls the generic HabitsServiceUpdateHabit builder with application/json body
+func NewHabitsServiceUpdateHabitRequest(server string, habitId string, body HabitsServiceUpdateHabitJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewHabitsServiceUpdateHabitRequestWithBody(server, habitId, "application/json", bodyReader)
+}
+
+// NewHabitsServiceUpdateHabitRequestWithBody generates requests for HabitsServiceUpdateHabit with any type of body
+func NewHabitsServiceUpdateHabitRequestWithBody(server string, habitId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "habit_id", runtime.ParamLocationPath, habitId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/habits/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewHabitsServiceActivateHabitRequest generates requests for HabitsServiceActivateHabit
+func NewHabitsServiceActivateHabitRequest(server string, habitId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "habit_id", runtime.ParamLocationPath, habitId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/habits/%s/activate", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewHabitsServiceDeactivateHabitRequest generates requests for HabitsServiceDeactivateHabit
+func NewHabitsServiceDeactivateHabitRequest(server string, habitId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "habit_id", runtime.ParamLocationPath, habitId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/habits/%s/deactivate", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewHabitsServiceGetHabitInsightsRequest generates requests for HabitsServiceGetHabitInsights
+func NewHabitsServiceGetHabitInsightsRequest(server string, habitId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "habit_id", runtime.ParamLocationPath, habitId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/habits/%s/insights", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewHabitsServiceGetHabitLogsRequest generates requests for HabitsServiceGetHabitLogs
+func NewHabitsServiceGetHabitLogsRequest(server string, habitId string, params *HabitsServiceGetHabitLogsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "habit_id", runtime.ParamLocationPath, habitId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/habits/%s/logs", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PerPage != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "per_page", runtime.ParamLocationQuery, *params.PerPage); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.StartDate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start_date", runtime.ParamLocationQuery, *params.StartDate); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EndDate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end_date", runtime.ParamLocationQuery, *params.EndDate); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Keyword != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "keyword", runtime.ParamLocationQuery, *params.Keyword); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SortBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort_by", runtime.ParamLocationQuery, *params.SortBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SortDirection != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort_direction", runtime.ParamLocationQuery, *params.SortDirection); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewHabitsServiceLogHabitRequest calls the generic HabitsServiceLogHabit builder with application/json body
+func NewHabitsServiceLogHabitRequest(server string, habitId string, body HabitsServiceLogHabitJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewHabitsServiceLogHabitRequestWithBody(server, habitId, "application/json", bodyReader)
+}
+
+// NewHabitsServiceLogHabitRequestWithBody generates requests for HabitsServiceLogHabit with any type of body
+func NewHabitsServiceLogHabitRequestWithBody(server string, habitId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "habit_id", runtime.ParamLocationPath, habitId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/habits/%s/logs", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewHabitsServiceSetHabitLogCountRequest calls the generic HabitsServiceSetHabitLogCount builder with application/json body
+func NewHabitsServiceSetHabitLogCountRequest(server string, habitId string, body HabitsServiceSetHabitLogCountJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewHabitsServiceSetHabitLogCountRequestWithBody(server, habitId, "application/json", bodyReader)
+}
+
+// NewHabitsServiceSetHabitLogCountRequestWithBody generates requests for HabitsServiceSetHabitLogCount with any type of body
+func NewHabitsServiceSetHabitLogCountRequestWithBody(server string, habitId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "habit_id", runtime.ParamLocationPath, habitId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/habits/%s/logs/count", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewHabitsServiceUndoHabitLogRequest calls the generic HabitsServiceUndoHabitLog builder with application/json body
+func NewHabitsServiceUndoHabitLogRequest(server string, habitId string, body HabitsServiceUndoHabitLogJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewHabitsServiceUndoHabitLogRequestWithBody(server, habitId, "application/json", bodyReader)
+}
+
+// NewHabitsServiceUndoHabitLogRequestWithBody generates requests for HabitsServiceUndoHabitLog with any type of body
+func NewHabitsServiceUndoHabitLogRequestWithBody(server string, habitId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "habit_id", runtime.ParamLocationPath, habitId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/habits/%s/logs/undo", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewHabitsServicePauseHabitRequest calls the generic HabitsServicePauseHabit builder with application/json body
+func NewHabitsServicePauseHabitRequest(server string, habitId string, body HabitsServicePauseHabitJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewHabitsServicePauseHabitRequestWithBody(server, habitId, "application/json", bodyReader)
+}
+
+// NewHabitsServicePauseHabitRequestWithBody generates requests for HabitsServicePauseHabit with any type of body
+func NewHabitsServicePauseHabitRequestWithBody(server string, habitId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "habit_id", runtime.ParamLocationPath, habitId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/habits/%s/pause", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewHabitsServiceGetHabitStatsRequest generates requests for HabitsServiceGetHabitStats
+func NewHabitsServiceGetHabitStatsRequest(server string, habitId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "habit_id", runtime.ParamLocationPath, habitId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/habits/%s/stats", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewHabitsServiceListVacationsRequest generates requests for HabitsServiceListVacations
+func NewHabitsServiceListVacationsRequest(server string, habitId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "habit_id", runtime.ParamLocationPath, habitId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/habits/%s/vacations", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewHabitsServiceStartVacationRequest calls the generic HabitsServiceStartVacation builder with application/json body
+func NewHabitsServiceStartVacationRequest(server string, habitId string, body HabitsServiceStartVacationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewHabitsServiceStartVacationRequestWithBody(server, habitId, "application/json", bodyReader)
+}
+
+// NewHabitsServiceStartVacationRequestWithBody generates requests for HabitsServiceStartVacation with any type of body
+func NewHabitsServiceStartVacationRequestWithBody(server string, habitId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "habit_id", runtime.ParamLocationPath, habitId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/habits/%s/vacations", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewHabitsServiceEndVacationRequest calls the generic HabitsServiceEndVacation builder with application/json body
+func NewHabitsServiceEndVacationRequest(server string, habitId string, vacationId string, body HabitsServiceEndVacationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewHabitsServiceEndVacationRequestWithBody(server, habitId, vacationId, "application/json", bodyReader)
+}
+
+// NewHabitsServiceEndVacationRequestWithBody generates requests for HabitsServiceEndVacation with any type of body
+func NewHabitsServiceEndVacationRequestWithBody(server string, habitId string, vacationId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "habit_id", runtime.ParamLocationPath, habitId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "vacation_id", runtime.ParamLocationPath, vacationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/habits/%s/vacations/%s/end", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewHabitsServiceStartImportRequest calls the generic HabitsServiceStartImport builder with application/json body
+func NewHabitsServiceStartImportRequest(server string, body HabitsServiceStartImportJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewHabitsServiceStartImportRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewHabitsServiceStartImportRequestWithBody generates requests for HabitsServiceStartImport with any type of body
+func NewHabitsServiceStartImportRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/imports")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewHabitsServicePreviewImportRequest calls the generic HabitsServicePreviewImport builder with application/json body
+func NewHabitsServicePreviewImportRequest(server string, body HabitsServicePreviewImportJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewHabitsServicePreviewImportRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewHabitsServicePreviewImportRequestWithBody generates requests for HabitsServicePreviewImport with any type of body
+func NewHabitsServicePreviewImportRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/imports/preview")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewHabitsServiceGetImportRequest generates requests for HabitsServiceGetImport
+func NewHabitsServiceGetImportRequest(server string, importId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "import_id", runtime.ParamLocationPath, importId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/imports/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewNotificationsServiceListNotificationsRequest generates requests for NotificationsServiceListNotifications
+func NewNotificationsServiceListNotificationsRequest(server string, params *NotificationsServiceListNotificationsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/notifications")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PerPage != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "per_page", runtime.ParamLocationQuery, *params.PerPage); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.UnreadOnly != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "unread_only", runtime.ParamLocationQuery, *params.UnreadOnly); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Grouped != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "grouped", runtime.ParamLocationQuery, *params.Grouped); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Type != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "type", runtime.ParamLocationQuery, *params.Type); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Read != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "read", runtime.ParamLocationQuery, *params.Read); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.StartDate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start_date", runtime.ParamLocationQuery, *params.StartDate); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EndDate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end_date", runtime.ParamLocationQuery, *params.EndDate); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.HabitId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "habit_id", runtime.ParamLocationQuery, *params.HabitId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Keyword != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "keyword", runtime.ParamLocationQuery, *params.Keyword); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewNotificationsServiceCreateNotificationRequest calls the generic NotificationsServiceCreateNotification builder with application/json body
+func NewNotificationsServiceCreateNotificationRequest(server string, body NotificationsServiceCreateNotificationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewNotificationsServiceCreateNotificationRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewNotificationsServiceCreateNotificationRequestWithBody generates requests for NotificationsServiceCreateNotification with any type of body
+func NewNotificationsServiceCreateNotificationRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/notifications")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewNotificationsServiceGetNotificationPreferencesRequest generates requests for NotificationsServiceGetNotificationPreferences
+func NewNotificationsServiceGetNotificationPreferencesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/notifications/preferences")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewNotificationsServiceUpdateNotificationPreferencesRequest calls the generic NotificationsServiceUpdateNotificationPreferences builder with application/json body
+func NewNotificationsServiceUpdateNotificationPreferencesRequest(server string, body NotificationsServiceUpdateNotificationPreferencesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewNotificationsServiceUpdateNotificationPreferencesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewNotificationsServiceUpdateNotificationPreferencesRequestWithBody generates requests for NotificationsServiceUpdateNotificationPreferences with any type of body
+func NewNotificationsServiceUpdateNotificationPreferencesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/notifications/preferences")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewNotificationsServiceMarkAllAsReadRequest generates requests for NotificationsServiceMarkAllAsRead
+func NewNotificationsServiceMarkAllAsReadRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/notifications/read-all")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewNotificationsServiceGetUnreadCountRequest generates requests for NotificationsServiceGetUnreadCount
+func NewNotificationsServiceGetUnreadCountRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/notifications/unread-count")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewNotificationsServiceUnsubscribeRequest calls the generic NotificationsServiceUnsubscribe builder with application/json body
+func NewNotificationsServiceUnsubscribeRequest(server string, body NotificationsServiceUnsubscribeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewNotificationsServiceUnsubscribeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewNotificationsServiceUnsubscribeRequestWithBody generates requests for NotificationsServiceUnsubscribe with any type of body
+func NewNotificationsServiceUnsubscribeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/notifications/unsubscribe")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewNotificationsServiceDeleteNotificationRequest generates requests for NotificationsServiceDeleteNotification
+func NewNotificationsServiceDeleteNotificationRequest(server string, notificationId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "notification_id", runtime.ParamLocationPath, notificationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/notifications/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewNotificationsServiceMarkAsReadRequest generates requests for NotificationsServiceMarkAsRead
+func NewNotificationsServiceMarkAsReadRequest(server string, notificationId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "notification_id", runtime.ParamLocationPath, notificationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/notifications/%s/read", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewNotificationsServiceSnoozeNotificationRequest calls the generic NotificationsServiceSnoozeNotification builder with application/json body
+func NewNotificationsServiceSnoozeNotificationRequest(server string, notificationId string, body NotificationsServiceSnoozeNotificationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewNotificationsServiceSnoozeNotificationRequestWithBody(server, notificationId, "application/json", bodyReader)
+}
+
+// NewNotificationsServiceSnoozeNotificationRequestWithBody generates requests for NotificationsServiceSnoozeNotification with any type of body
+func NewNotificationsServiceSnoozeNotificationRequestWithBody(server string, notificationId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "notification_id", runtime.ParamLocationPath, notificationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/notifications/%s/snooze", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewNotificationsServiceBatchDeleteNotificationsRequest calls the generic NotificationsServiceBatchDeleteNotifications builder with application/json body
+func NewNotificationsServiceBatchDeleteNotificationsRequest(server string, body NotificationsServiceBatchDeleteNotificationsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewNotificationsServiceBatchDeleteNotificationsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewNotificationsServiceBatchDeleteNotificationsRequestWithBody generates requests for NotificationsServiceBatchDeleteNotifications with any type of body
+func NewNotificationsServiceBatchDeleteNotificationsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/notifications:batchDelete")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewNotificationsServiceBatchMarkAsReadRequest calls the generic NotificationsServiceBatchMarkAsRead builder with application/json body
+func NewNotificationsServiceBatchMarkAsReadRequest(server string, body NotificationsServiceBatchMarkAsReadJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewNotificationsServiceBatchMarkAsReadRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewNotificationsServiceBatchMarkAsReadRequestWithBody generates requests for NotificationsServiceBatchMarkAsRead with any type of body
+func NewNotificationsServiceBatchMarkAsReadRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/notifications:batchMarkRead")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// HabitsServiceComparePeriodsWithResponse request
+	HabitsServiceComparePeriodsWithResponse(ctx context.Context, params *HabitsServiceComparePeriodsParams, reqEditors ...RequestEditorFn) (*HabitsServiceComparePeriodsResponse, error)
+
+	// HabitsServiceGetHabitCorrelationsWithResponse request
+	HabitsServiceGetHabitCorrelationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HabitsServiceGetHabitCorrelationsResponse, error)
+
+	// HabitsServiceGetWeeklyAnalyticsWithResponse request
+	HabitsServiceGetWeeklyAnalyticsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HabitsServiceGetWeeklyAnalyticsResponse, error)
+
+	// AuthServiceDeleteAccountWithBodyWithResponse request with any body
+	AuthServiceDeleteAccountWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthServiceDeleteAccountResponse, error)
+
+	AuthServiceDeleteAccountWithResponse(ctx context.Context, body AuthServiceDeleteAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthServiceDeleteAccountResponse, error)
+
+	// AuthServiceChangePasswordWithBodyWithResponse request with any body
+	AuthServiceChangePasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthServiceChangePasswordResponse, error)
+
+	AuthServiceChangePasswordWithResponse(ctx context.Context, body AuthServiceChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthServiceChangePasswordResponse, error)
+
+	// AuthServiceListConsentsWithResponse request
+	AuthServiceListConsentsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AuthServiceListConsentsResponse, error)
+
+	// AuthServiceAcceptConsentWithBodyWithResponse request with any body
+	AuthServiceAcceptConsentWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthServiceAcceptConsentResponse, error)
+
+	AuthServiceAcceptConsentWithResponse(ctx context.Context, body AuthServiceAcceptConsentJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthServiceAcceptConsentResponse, error)
+
+	// AuthServiceDeactivateAccountWithBodyWithResponse request with any body
+	AuthServiceDeactivateAccountWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthServiceDeactivateAccountResponse, error)
+
+	AuthServiceDeactivateAccountWithResponse(ctx context.Context, body AuthServiceDeactivateAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthServiceDeactivateAccountResponse, error)
+
+	// AuthServiceExportUserDataWithResponse request
+	AuthServiceExportUserDataWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AuthServiceExportUserDataResponse, error)
+
+	// AuthServiceForgotPasswordWithBodyWithResponse request with any body
+	AuthServiceForgotPasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthServiceForgotPasswordResponse, error)
+
+	AuthServiceForgotPasswordWithResponse(ctx context.Context, body AuthServiceForgotPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthServiceForgotPasswordResponse, error)
+
+	// AuthServiceGoogleCallbackWithBodyWithResponse request with any body
+	AuthServiceGoogleCallbackWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthServiceGoogleCallbackResponse, error)
+
+	AuthServiceGoogleCallbackWithResponse(ctx context.Context, body AuthServiceGoogleCallbackJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthServiceGoogleCallbackResponse, error)
+
+	// AuthServiceGoogleLoginWithResponse request
+	AuthServiceGoogleLoginWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AuthServiceGoogleLoginResponse, error)
+
+	// AuthServiceLoginWithBodyWithResponse request with any body
+	AuthServiceLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthServiceLoginResponse, error)
+
+	AuthServiceLoginWithResponse(ctx context.Context, body AuthServiceLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthServiceLoginResponse, error)
+
+	// AuthServiceLogoutWithBodyWithResponse request with any body
+	AuthServiceLogoutWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthServiceLogoutResponse, error)
+
+	AuthServiceLogoutWithResponse(ctx context.Context, body AuthServiceLogoutJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthServiceLogoutResponse, error)
+
+	// AuthServiceLogoutAllWithBodyWithResponse request with any body
+	AuthServiceLogoutAllWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthServiceLogoutAllResponse, error)
+
+	AuthServiceLogoutAllWithResponse(ctx context.Context, body AuthServiceLogoutAllJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthServiceLogoutAllResponse, error)
+
+	// AuthServiceRequestMagicLinkWithBodyWithResponse request with any body
+	AuthServiceRequestMagicLinkWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthServiceRequestMagicLinkResponse, error)
+
+	AuthServiceRequestMagicLinkWithResponse(ctx context.Context, body AuthServiceRequestMagicLinkJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthServiceRequestMagicLinkResponse, error)
+
+	// AuthServiceVerifyMagicLinkWithResponse request
+	AuthServiceVerifyMagicLinkWithResponse(ctx context.Context, params *AuthServiceVerifyMagicLinkParams, reqEditors ...RequestEditorFn) (*AuthServiceVerifyMagicLinkResponse, error)
+
+	// AuthServiceGetPreferencesWithResponse request
+	AuthServiceGetPreferencesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AuthServiceGetPreferencesResponse, error)
+
+	// AuthServiceUpdatePreferencesWithBodyWithResponse request with any body
+	AuthServiceUpdatePreferencesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthServiceUpdatePreferencesResponse, error)
+
+	AuthServiceUpdatePreferencesWithResponse(ctx context.Context, body AuthServiceUpdatePreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthServiceUpdatePreferencesResponse, error)
+
+	// AuthServiceGetProfileWithResponse request
+	AuthServiceGetProfileWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AuthServiceGetProfileResponse, error)
+
+	// AuthServiceUpdateProfileWithBodyWithResponse request with any body
+	AuthServiceUpdateProfileWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthServiceUpdateProfileResponse, error)
+
+	AuthServiceUpdateProfileWithResponse(ctx context.Context, body AuthServiceUpdateProfileJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthServiceUpdateProfileResponse, error)
+
+	// AuthServiceRefreshTokenWithBodyWithResponse request with any body
+	AuthServiceRefreshTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthServiceRefreshTokenResponse, error)
+
+	AuthServiceRefreshTokenWithResponse(ctx context.Context, body AuthServiceRefreshTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthServiceRefreshTokenResponse, error)
+
+	// AuthServiceRegisterWithBodyWithResponse request with any body
+	AuthServiceRegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthServiceRegisterResponse, error)
+
+	AuthServiceRegisterWithResponse(ctx context.Context, body AuthServiceRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthServiceRegisterResponse, error)
+
+	// AuthServiceResendVerificationWithBodyWithResponse request with any body
+	AuthServiceResendVerificationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthServiceResendVerificationResponse, error)
+
+	AuthServiceResendVerificationWithResponse(ctx context.Context, body AuthServiceResendVerificationJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthServiceResendVerificationResponse, error)
+
+	// AuthServiceResetPasswordWithBodyWithResponse request with any body
+	AuthServiceResetPasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthServiceResetPasswordResponse, error)
+
+	AuthServiceResetPasswordWithResponse(ctx context.Context, body AuthServiceResetPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthServiceResetPasswordResponse, error)
+
+	// AuthServiceListSessionsWithResponse request
+	AuthServiceListSessionsWithResponse(ctx context.Context, params *AuthServiceListSessionsParams, reqEditors ...RequestEditorFn) (*AuthServiceListSessionsResponse, error)
+
+	// AuthServiceRevokeOtherSessionsWithResponse request
+	AuthServiceRevokeOtherSessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AuthServiceRevokeOtherSessionsResponse, error)
+
+	// AuthServiceRevokeSessionWithResponse request
+	AuthServiceRevokeSessionWithResponse(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*AuthServiceRevokeSessionResponse, error)
+
+	// AuthServiceSSOCallbackWithBodyWithResponse request with any body
+	AuthServiceSSOCallbackWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthServiceSSOCallbackResponse, error)
+
+	AuthServiceSSOCallbackWithResponse(ctx context.Context, body AuthServiceSSOCallbackJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthServiceSSOCallbackResponse, error)
+
+	// AuthServiceSSOLoginWithResponse request
+	AuthServiceSSOLoginWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AuthServiceSSOLoginResponse, error)
+
+	// AuthServiceVerifyEmailWithBodyWithResponse request with any body
+	AuthServiceVerifyEmailWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthServiceVerifyEmailResponse, error)
+
+	AuthServiceVerifyEmailWithResponse(ctx context.Context, body AuthServiceVerifyEmailJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthServiceVerifyEmailResponse, error)
+
+	// HabitsServiceGetDashboardWithResponse request
+	HabitsServiceGetDashboardWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HabitsServiceGetDashboardResponse, error)
+
+	// HabitsServiceDeleteHabitLogWithResponse request
+	HabitsServiceDeleteHabitLogWithResponse(ctx context.Context, logId string, reqEditors ...RequestEditorFn) (*HabitsServiceDeleteHabitLogResponse, error)
+
+	// HabitsServiceUpdateHabitLogWithBodyWithResponse request with any body
+	HabitsServiceUpdateHabitLogWithBodyWithResponse(ctx context.Context, logId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*HabitsServiceUpdateHabitLogResponse, error)
+
+	HabitsServiceUpdateHabitLogWithResponse(ctx context.Context, logId string, body HabitsServiceUpdateHabitLogJSONRequestBody, reqEditors ...RequestEditorFn) (*HabitsServiceUpdateHabitLogResponse, error)
+
+	// HabitsServiceListHabitsWithResponse request
+	HabitsServiceListHabitsWithResponse(ctx context.Context, params *HabitsServiceListHabitsParams, reqEditors ...RequestEditorFn) (*HabitsServiceListHabitsResponse, error)
+
+	// HabitsServiceCreateHabitWithBodyWithResponse request with any body
+	HabitsServiceCreateHabitWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*HabitsServiceCreateHabitResponse, error)
+
+	HabitsServiceCreateHabitWithResponse(ctx context.Context, body HabitsServiceCreateHabitJSONRequestBody, reqEditors ...RequestEditorFn) (*HabitsServiceCreateHabitResponse, error)
+
+	// HabitsServiceReorderHabitsWithBodyWithResponse request with any body
+	HabitsServiceReorderHabitsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*HabitsServiceReorderHabitsResponse, error)
+
+	HabitsServiceReorderHabitsWithResponse(ctx context.Context, body HabitsServiceReorderHabitsJSONRequestBody, reqEditors ...RequestEditorFn) (*HabitsServiceReorderHabitsResponse, error)
+
+	// HabitsServiceDeleteHabitWithResponse request
+	HabitsServiceDeleteHabitWithResponse(ctx context.Context, habitId string, reqEditors ...RequestEditorFn) (*HabitsServiceDeleteHabitResponse, error)
+
+	// HabitsServiceGetHabitWithResponse request
+	HabitsServiceGetHabitWithResponse(ctx context.Context, habitId string, reqEditors ...RequestEditorFn) (*HabitsServiceGetHabitResponse, error)
+
+	// HabitsServiceUpdateHabitWithBodyWithResponse request with any body
+	HabitsServiceUpdateHabitWithBodyWithResponse(ctx context.Context, habitId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*HabitsServiceUpdateHabitResponse, error)
+
+	HabitsServiceUpdateHabitWithResponse(ctx context.Context, habitId string, body HabitsServiceUpdateHabitJSONRequestBody, reqEditors ...RequestEditorFn) (*HabitsServiceUpdateHabitResponse, error)
+
+	// HabitsServiceActivateHabitWithResponse request
+	HabitsServiceActivateHabitWithResponse(ctx context.Context, habitId string, reqEditors ...RequestEditorFn) (*HabitsServiceActivateHabitResponse, error)
+
+	// HabitsServiceDeactivateHabitWithResponse request
+	HabitsServiceDeactivateHabitWithResponse(ctx context.Context, habitId string, reqEditors ...RequestEditorFn) (*HabitsServiceDeactivateHabitResponse, error)
+
+	// HabitsServiceGetHabitInsightsWithResponse request
+	HabitsServiceGetHabitInsightsWithResponse(ctx context.Context, habitId string, reqEditors ...RequestEditorFn) (*HabitsServiceGetHabitInsightsResponse, error)
+
+	// HabitsServiceGetHabitLogsWithResponse request
+	HabitsServiceGetHabitLogsWithResponse(ctx context.Context, habitId string, params *HabitsServiceGetHabitLogsParams, reqEditors ...RequestEditorFn) (*HabitsServiceGetHabitLogsResponse, error)
+
+	// HabitsServiceLogHabitWithBodyWithResponse request with any body
+	HabitsServiceLogHabitWithBodyWithResponse(ctx context.Context, habitId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*HabitsServiceLogHabitResponse, error)
+
+	HabitsServiceLogHabitWithResponse(ctx context.Context, habitId string, body HabitsServiceLogHabitJSONRequestBody, reqEditors ...RequestEditorFn) (*HabitsServiceLogHabitResponse, error)
+
+	// HabitsServiceSetHabitLogCountWithBodyWithResponse request with any body
+	HabitsServiceSetHabitLogCountWithBodyWithResponse(ctx context.Context, habitId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*HabitsServiceSetHabitLogCountResponse, error)
+
+	HabitsServiceSetHabitLogCountWithResponse(ctx context.Context, habitId string, body HabitsServiceSetHabitLogCountJSONRequestBody, reqEditors ...RequestEditorFn) (*HabitsServiceSetHabitLogCountResponse, error)
+
+	// HabitsServiceUndoHabitLogWithBodyWithResponse request with any body
+	HabitsServiceUndoHabitLogWithBodyWithResponse(ctx context.Context, habitId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*HabitsServiceUndoHabitLogResponse, error)
+
+	HabitsServiceUndoHabitLogWithResponse(ctx context.Context, habitId string, body HabitsServiceUndoHabitLogJSONRequestBody, reqEditors ...RequestEditorFn) (*HabitsServiceUndoHabitLogResponse, error)
+
+	// HabitsServicePauseHabitWithBodyWithResponse request with any body
+	HabitsServicePauseHabitWithBodyWithResponse(ctx context.Context, habitId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*HabitsServicePauseHabitResponse, error)
+
+	HabitsServicePauseHabitWithResponse(ctx context.Context, habitId string, body HabitsServicePauseHabitJSONRequestBody, reqEditors ...RequestEditorFn) (*HabitsServicePauseHabitResponse, error)
+
+	// HabitsServiceGetHabitStatsWithResponse request
+	HabitsServiceGetHabitStatsWithResponse(ctx context.Context, habitId string, reqEditors ...RequestEditorFn) (*HabitsServiceGetHabitStatsResponse, error)
+
+	// HabitsServiceListVacationsWithResponse request
+	HabitsServiceListVacationsWithResponse(ctx context.Context, habitId string, reqEditors ...RequestEditorFn) (*HabitsServiceListVacationsResponse, error)
+
+	// HabitsServiceStartVacationWithBodyWithResponse request with any body
+	HabitsServiceStartVacationWithBodyWithResponse(ctx context.Context, habitId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*HabitsServiceStartVacationResponse, error)
+
+	HabitsServiceStartVacationWithResponse(ctx context.Context, habitId string, body HabitsServiceStartVacationJSONRequestBody, reqEditors ...RequestEditorFn) (*HabitsServiceStartVacationResponse, error)
+
+	// HabitsServiceEndVacationWithBodyWithResponse request with any body
+	HabitsServiceEndVacationWithBodyWithResponse(ctx context.Context, habitId string, vacationId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*HabitsServiceEndVacationResponse, error)
+
+	HabitsServiceEndVacationWithResponse(ctx context.Context, habitId string, vacationId string, body HabitsServiceEndVacationJSONRequestBody, reqEditors ...RequestEditorFn) (*HabitsServiceEndVacationResponse, error)
+
+	// HabitsServiceStartImportWithBodyWithResponse request with any body
+	HabitsServiceStartImportWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*HabitsServiceStartImportResponse, error)
+
+	HabitsServiceStartImportWithResponse(ctx context.Context, body HabitsServiceStartImportJSONRequestBody, reqEditors ...RequestEditorFn) (*HabitsServiceStartImportResponse, error)
+
+	// HabitsServicePreviewImportWithBodyWithResponse request with any body
+	HabitsServicePreviewImportWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*HabitsServicePreviewImportResponse, error)
+
+	HabitsServicePreviewImportWithResponse(ctx context.Context, body HabitsServicePreviewImportJSONRequestBody, reqEditors ...RequestEditorFn) (*HabitsServicePreviewImportResponse, error)
+
+	// HabitsServiceGetImportWithResponse request
+	HabitsServiceGetImportWithResponse(ctx context.Context, importId string, reqEditors ...RequestEditorFn) (*HabitsServiceGetImportResponse, error)
+
+	// NotificationsServiceListNotificationsWithResponse request
+	NotificationsServiceListNotificationsWithResponse(ctx context.Context, params *NotificationsServiceListNotificationsParams, reqEditors ...RequestEditorFn) (*NotificationsServiceListNotificationsResponse, error)
+
+	// NotificationsServiceCreateNotificationWithBodyWithResponse request with any body
+	NotificationsServiceCreateNotificationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*NotificationsServiceCreateNotificationResponse, error)
+
+	NotificationsServiceCreateNotificationWithResponse(ctx context.Context, body NotificationsServiceCreateNotificationJSONRequestBody, reqEditors ...RequestEditorFn) (*NotificationsServiceCreateNotificationResponse, error)
+
+	// NotificationsServiceGetNotificationPreferencesWithResponse request
+	NotificationsServiceGetNotificationPreferencesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*NotificationsServiceGetNotificationPreferencesResponse, error)
+
+	// NotificationsServiceUpdateNotificationPreferencesWithBodyWithResponse request with any body
+	NotificationsServiceUpdateNotificationPreferencesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*NotificationsServiceUpdateNotificationPreferencesResponse, error)
+
+	NotificationsServiceUpdateNotificationPreferencesWithResponse(ctx context.Context, body NotificationsServiceUpdateNotificationPreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*NotificationsServiceUpdateNotificationPreferencesResponse, error)
+
+	// NotificationsServiceMarkAllAsReadWithResponse request
+	NotificationsServiceMarkAllAsReadWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*NotificationsServiceMarkAllAsReadResponse, error)
+
+	// NotificationsServiceGetUnreadCountWithResponse request
+	NotificationsServiceGetUnreadCountWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*NotificationsServiceGetUnreadCountResponse, error)
+
+	// NotificationsServiceUnsubscribeWithBodyWithResponse request with any body
+	NotificationsServiceUnsubscribeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*NotificationsServiceUnsubscribeResponse, error)
+
+	NotificationsServiceUnsubscribeWithResponse(ctx context.Context, body NotificationsServiceUnsubscribeJSONRequestBody, reqEditors ...RequestEditorFn) (*NotificationsServiceUnsubscribeResponse, error)
+
+	// NotificationsServiceDeleteNotificationWithResponse request
+	NotificationsServiceDeleteNotificationWithResponse(ctx context.Context, notificationId string, reqEditors ...RequestEditorFn) (*NotificationsServiceDeleteNotificationResponse, error)
+
+	// NotificationsServiceMarkAsReadWithResponse request
+	NotificationsServiceMarkAsReadWithResponse(ctx context.Context, notificationId string, reqEditors ...RequestEditorFn) (*NotificationsServiceMarkAsReadResponse, error)
+
+	// NotificationsServiceSnoozeNotificationWithBodyWithResponse request with any body
+	NotificationsServiceSnoozeNotificationWithBodyWithResponse(ctx context.Context, notificationId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*NotificationsServiceSnoozeNotificationResponse, error)
+
+	NotificationsServiceSnoozeNotificationWithResponse(ctx context.Context, notificationId string, body NotificationsServiceSnoozeNotificationJSONRequestBody, reqEditors ...RequestEditorFn) (*NotificationsServiceSnoozeNotificationResponse, error)
+
+	// NotificationsServiceBatchDeleteNotificationsWithBodyWithResponse request with any body
+	NotificationsServiceBatchDeleteNotificationsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*NotificationsServiceBatchDeleteNotificationsResponse, error)
+
+	NotificationsServiceBatchDeleteNotificationsWithResponse(ctx context.Context, body NotificationsServiceBatchDeleteNotificationsJSONRequestBody, reqEditors ...RequestEditorFn) (*NotificationsServiceBatchDeleteNotificationsResponse, error)
+
+	// NotificationsServiceBatchMarkAsReadWithBodyWithResponse request with any body
+	NotificationsServiceBatchMarkAsReadWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*NotificationsServiceBatchMarkAsReadResponse, error)
+
+	NotificationsServiceBatchMarkAsReadWithResponse(ctx context.Context, body NotificationsServiceBatchMarkAsReadJSONRequestBody, reqEditors ...RequestEditorFn) (*NotificationsServiceBatchMarkAsReadResponse, error)
+}
+
+type HabitsServiceComparePeriodsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1PeriodComparisonResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r HabitsServiceComparePeriodsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HabitsServiceComparePeriodsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HabitsServiceGetHabitCorrelationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1HabitCorrelationsResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r HabitsServiceGetHabitCorrelationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HabitsServiceGetHabitCorrelationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HabitsServiceGetWeeklyAnalyticsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1WeeklyAnalyticsResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r HabitsServiceGetWeeklyAnalyticsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HabitsServiceGetWeeklyAnalyticsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AuthServiceDeleteAccountResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Ethosauthv1SuccessResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r AuthServiceDeleteAccountResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuthServiceDeleteAccountResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AuthServiceChangePasswordResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Ethosauthv1SuccessResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r AuthServiceChangePasswordResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuthServiceChangePasswordResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AuthServiceListConsentsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1ConsentsResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r AuthServiceListConsentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuthServiceListConsentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AuthServiceAcceptConsentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1ConsentsResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r AuthServiceAcceptConsentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuthServiceAcceptConsentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AuthServiceDeactivateAccountResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Ethosauthv1SuccessResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r AuthServiceDeactivateAccountResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuthServiceDeactivateAccountResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AuthServiceExportUserDataResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1ExportUserDataResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r AuthServiceExportUserDataResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuthServiceExportUserDataResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AuthServiceForgotPasswordResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Ethosauthv1SuccessResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r AuthServiceForgotPasswordResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuthServiceForgotPasswordResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AuthServiceGoogleCallbackResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1LoginResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r AuthServiceGoogleCallbackResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuthServiceGoogleCallbackResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AuthServiceGoogleLoginResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1GoogleLoginResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r AuthServiceGoogleLoginResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuthServiceGoogleLoginResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AuthServiceLoginResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1LoginResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r AuthServiceLoginResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuthServiceLoginResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AuthServiceLogoutResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1LogoutResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r AuthServiceLogoutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuthServiceLogoutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AuthServiceLogoutAllResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1LogoutResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r AuthServiceLogoutAllResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuthServiceLogoutAllResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AuthServiceRequestMagicLinkResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Ethosauthv1SuccessResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r AuthServiceRequestMagicLinkResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuthServiceRequestMagicLinkResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AuthServiceVerifyMagicLinkResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1LoginResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r AuthServiceVerifyMagicLinkResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuthServiceVerifyMagicLinkResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AuthServiceGetPreferencesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1PreferencesResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r AuthServiceGetPreferencesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuthServiceGetPreferencesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AuthServiceUpdatePreferencesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1PreferencesResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r AuthServiceUpdatePreferencesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuthServiceUpdatePreferencesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AuthServiceGetProfileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1ProfileResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r AuthServiceGetProfileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuthServiceGetProfileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AuthServiceUpdateProfileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1ProfileResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r AuthServiceUpdateProfileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuthServiceUpdateProfileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AuthServiceRefreshTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1LoginResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r AuthServiceRefreshTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuthServiceRefreshTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AuthServiceRegisterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1RegisterResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r AuthServiceRegisterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuthServiceRegisterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AuthServiceResendVerificationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Ethosauthv1SuccessResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r AuthServiceResendVerificationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuthServiceResendVerificationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AuthServiceResetPasswordResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Ethosauthv1SuccessResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r AuthServiceResetPasswordResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuthServiceResetPasswordResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AuthServiceListSessionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1ListSessionsResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r AuthServiceListSessionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuthServiceListSessionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AuthServiceRevokeOtherSessionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1RevokeOtherSessionsResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r AuthServiceRevokeOtherSessionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuthServiceRevokeOtherSessionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AuthServiceRevokeSessionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1RevokeSessionResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r AuthServiceRevokeSessionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuthServiceRevokeSessionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AuthServiceSSOCallbackResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1LoginResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r AuthServiceSSOCallbackResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuthServiceSSOCallbackResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AuthServiceSSOLoginResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1SSOLoginResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r AuthServiceSSOLoginResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuthServiceSSOLoginResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AuthServiceVerifyEmailResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Ethosauthv1SuccessResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r AuthServiceVerifyEmailResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuthServiceVerifyEmailResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HabitsServiceGetDashboardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1DashboardResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r HabitsServiceGetDashboardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HabitsServiceGetDashboardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HabitsServiceDeleteHabitLogResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Ethoshabitsv1SuccessResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r HabitsServiceDeleteHabitLogResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HabitsServiceDeleteHabitLogResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HabitsServiceUpdateHabitLogResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Ethoshabitsv1SuccessResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r HabitsServiceUpdateHabitLogResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HabitsServiceUpdateHabitLogResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HabitsServiceListHabitsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1ListHabitsResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r HabitsServiceListHabitsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HabitsServiceListHabitsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HabitsServiceCreateHabitResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1HabitResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r HabitsServiceCreateHabitResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HabitsServiceCreateHabitResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HabitsServiceReorderHabitsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Ethoshabitsv1SuccessResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r HabitsServiceReorderHabitsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HabitsServiceReorderHabitsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HabitsServiceDeleteHabitResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Ethoshabitsv1SuccessResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r HabitsServiceDeleteHabitResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HabitsServiceDeleteHabitResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HabitsServiceGetHabitResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1HabitResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r HabitsServiceGetHabitResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HabitsServiceGetHabitResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HabitsServiceUpdateHabitResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1HabitResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r HabitsServiceUpdateHabitResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HabitsServiceUpdateHabitResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HabitsServiceActivateHabitResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Ethoshabitsv1SuccessResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r HabitsServiceActivateHabitResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HabitsServiceActivateHabitResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HabitsServiceDeactivateHabitResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Ethoshabitsv1SuccessResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r HabitsServiceDeactivateHabitResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HabitsServiceDeactivateHabitResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HabitsServiceGetHabitInsightsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1HabitInsightsResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r HabitsServiceGetHabitInsightsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HabitsServiceGetHabitInsightsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HabitsServiceGetHabitLogsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1GetHabitLogsResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r HabitsServiceGetHabitLogsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HabitsServiceGetHabitLogsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HabitsServiceLogHabitResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1LogHabitResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r HabitsServiceLogHabitResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HabitsServiceLogHabitResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HabitsServiceSetHabitLogCountResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1LogHabitResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r HabitsServiceSetHabitLogCountResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HabitsServiceSetHabitLogCountResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HabitsServiceUndoHabitLogResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Ethoshabitsv1SuccessResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r HabitsServiceUndoHabitLogResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HabitsServiceUndoHabitLogResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HabitsServicePauseHabitResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Ethoshabitsv1SuccessResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r HabitsServicePauseHabitResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HabitsServicePauseHabitResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HabitsServiceGetHabitStatsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1HabitStatsResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r HabitsServiceGetHabitStatsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HabitsServiceGetHabitStatsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HabitsServiceListVacationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1ListVacationsResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r HabitsServiceListVacationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HabitsServiceListVacationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HabitsServiceStartVacationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1VacationResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r HabitsServiceStartVacationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HabitsServiceStartVacationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HabitsServiceEndVacationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Ethoshabitsv1SuccessResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r HabitsServiceEndVacationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HabitsServiceEndVacationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HabitsServiceStartImportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1ImportResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r HabitsServiceStartImportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HabitsServiceStartImportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HabitsServicePreviewImportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1ImportPreviewResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r HabitsServicePreviewImportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HabitsServicePreviewImportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HabitsServiceGetImportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1ImportResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r HabitsServiceGetImportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HabitsServiceGetImportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type NotificationsServiceListNotificationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1ListNotificationsResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r NotificationsServiceListNotificationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r NotificationsServiceListNotificationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type NotificationsServiceCreateNotificationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Ethosnotificationsv1SuccessResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r NotificationsServiceCreateNotificationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r NotificationsServiceCreateNotificationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type NotificationsServiceGetNotificationPreferencesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1NotificationPreferencesResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r NotificationsServiceGetNotificationPreferencesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r NotificationsServiceGetNotificationPreferencesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type NotificationsServiceUpdateNotificationPreferencesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1NotificationPreferencesResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r NotificationsServiceUpdateNotificationPreferencesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r NotificationsServiceUpdateNotificationPreferencesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type NotificationsServiceMarkAllAsReadResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Ethosnotificationsv1SuccessResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r NotificationsServiceMarkAllAsReadResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r NotificationsServiceMarkAllAsReadResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type NotificationsServiceGetUnreadCountResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1UnreadCountResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r NotificationsServiceGetUnreadCountResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r NotificationsServiceGetUnreadCountResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type NotificationsServiceUnsubscribeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Ethosnotificationsv1SuccessResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r NotificationsServiceUnsubscribeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r NotificationsServiceUnsubscribeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type NotificationsServiceDeleteNotificationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Ethosnotificationsv1SuccessResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r NotificationsServiceDeleteNotificationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r NotificationsServiceDeleteNotificationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type NotificationsServiceMarkAsReadResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Ethosnotificationsv1SuccessResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r NotificationsServiceMarkAsReadResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r NotificationsServiceMarkAsReadResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type NotificationsServiceSnoozeNotificationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Ethosnotificationsv1SuccessResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r NotificationsServiceSnoozeNotificationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r NotificationsServiceSnoozeNotificationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type NotificationsServiceBatchDeleteNotificationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Ethosnotificationsv1SuccessResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r NotificationsServiceBatchDeleteNotificationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r NotificationsServiceBatchDeleteNotificationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type NotificationsServiceBatchMarkAsReadResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Ethosnotificationsv1SuccessResponse
+	JSONDefault  *RpcStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r NotificationsServiceBatchMarkAsReadResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r NotificationsServiceBatchMarkAsReadResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HabitsServiceComparePeriodsWithResponse request returning *HabitsServiceComparePeriodsResponse
+func (c *ClientWithResponses) HabitsServiceComparePeriodsWithResponse(ctx context.Context, params *HabitsServiceComparePeriodsParams, reqEditors ...RequestEditorFn) (*HabitsServiceComparePeriodsResponse, error) {
+	rsp, err := c.HabitsServiceComparePeriods(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHabitsServiceComparePeriodsResponse(rsp)
+}
+
+// HabitsServiceGetHabitCorrelationsWithResponse request returning *HabitsServiceGetHabitCorrelationsResponse
+func (c *ClientWithResponses) HabitsServiceGetHabitCorrelationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HabitsServiceGetHabitCorrelationsResponse, error) {
+	rsp, err := c.HabitsServiceGetHabitCorrelations(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHabitsServiceGetHabitCorrelationsResponse(rsp)
+}
+
+// HabitsServiceGetWeeklyAnalyticsWithResponse request returning *HabitsServiceGetWeeklyAnalyticsResponse
+func (c *ClientWithResponses) HabitsServiceGetWeeklyAnalyticsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HabitsServiceGetWeeklyAnalyticsResponse, error) {
+	rsp, err := c.HabitsServiceGetWeeklyAnalytics(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHabitsServiceGetWeeklyAnalyticsResponse(rsp)
+}
+
+// AuthServiceDeleteAccountWithBodyWithResponse request with arbitrary body returning *AuthServiceDeleteAccountResponse
+func (c *ClientWithResponses) AuthServiceDeleteAccountWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthServiceDeleteAccountResponse, error) {
+	rsp, err := c.AuthServiceDeleteAccountWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthServiceDeleteAccountResponse(rsp)
+}
+
+func (c *ClientWithResponses) AuthServiceDeleteAccountWithResponse(ctx context.Context, body AuthServiceDeleteAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthServiceDeleteAccountResponse, error) {
+	rsp, err := c.AuthServiceDeleteAccount(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthServiceDeleteAccountResponse(rsp)
+}
+
+// AuthServiceChangePasswordWithBodyWithResponse request with arbitrary body returning *AuthServiceChangePasswordResponse
+func (c *ClientWithResponses) AuthServiceChangePasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthServiceChangePasswordResponse, error) {
+	rsp, err := c.AuthServiceChangePasswordWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthServiceChangePasswordResponse(rsp)
+}
+
+func (c *ClientWithResponses) AuthServiceChangePasswordWithResponse(ctx context.Context, body AuthServiceChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthServiceChangePasswordResponse, error) {
+	rsp, err := c.AuthServiceChangePassword(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthServiceChangePasswordResponse(rsp)
+}
+
+// AuthServiceListConsentsWithResponse request returning *AuthServiceListConsentsResponse
+func (c *ClientWithResponses) AuthServiceListConsentsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AuthServiceListConsentsResponse, error) {
+	rsp, err := c.AuthServiceListConsents(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthServiceListConsentsResponse(rsp)
+}
+
+// AuthServiceAcceptConsentWithBodyWithResponse request with arbitrary body returning *AuthServiceAcceptConsentResponse
+func (c *ClientWithResponses) AuthServiceAcceptConsentWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthServiceAcceptConsentResponse, error) {
+	rsp, err := c.AuthServiceAcceptConsentWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthServiceAcceptConsentResponse(rsp)
+}
+
+func (c *ClientWithResponses) AuthServiceAcceptConsentWithResponse(ctx context.Context, body AuthServiceAcceptConsentJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthServiceAcceptConsentResponse, error) {
+	rsp, err := c.AuthServiceAcceptConsent(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthServiceAcceptConsentResponse(rsp)
+}
+
+// AuthServiceDeactivateAccountWithBodyWithResponse request with arbitrary body returning *AuthServiceDeactivateAccountResponse
+func (c *ClientWithResponses) AuthServiceDeactivateAccountWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthServiceDeactivateAccountResponse, error) {
+	rsp, err := c.AuthServiceDeactivateAccountWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthServiceDeactivateAccountResponse(rsp)
+}
+
+func (c *ClientWithResponses) AuthServiceDeactivateAccountWithResponse(ctx context.Context, body AuthServiceDeactivateAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthServiceDeactivateAccountResponse, error) {
+	rsp, err := c.AuthServiceDeactivateAccount(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthServiceDeactivateAccountResponse(rsp)
+}
+
+// AuthServiceExportUserDataWithResponse request returning *AuthServiceExportUserDataResponse
+func (c *ClientWithResponses) AuthServiceExportUserDataWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AuthServiceExportUserDataResponse, error) {
+	rsp, err := c.AuthServiceExportUserData(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthServiceExportUserDataResponse(rsp)
+}
+
+// AuthServiceForgotPasswordWithBodyWithResponse request with arbitrary body returning *AuthServiceForgotPasswordResponse
+func (c *ClientWithResponses) AuthServiceForgotPasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthServiceForgotPasswordResponse, error) {
+	rsp, err := c.AuthServiceForgotPasswordWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthServiceForgotPasswordResponse(rsp)
+}
+
+func (c *ClientWithResponses) AuthServiceForgotPasswordWithResponse(ctx context.Context, body AuthServiceForgotPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthServiceForgotPasswordResponse, error) {
+	rsp, err := c.AuthServiceForgotPassword(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthServiceForgotPasswordResponse(rsp)
+}
+
+// AuthServiceGoogleCallbackWithBodyWithResponse request with arbitrary body returning *AuthServiceGoogleCallbackResponse
+func (c *ClientWithResponses) AuthServiceGoogleCallbackWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthServiceGoogleCallbackResponse, error) {
+	rsp, err := c.AuthServiceGoogleCallbackWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthServiceGoogleCallbackResponse(rsp)
+}
+
+func (c *ClientWithResponses) AuthServiceGoogleCallbackWithResponse(ctx context.Context, body AuthServiceGoogleCallbackJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthServiceGoogleCallbackResponse, error) {
+	rsp, err := c.AuthServiceGoogleCallback(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthServiceGoogleCallbackResponse(rsp)
+}
+
+// AuthServiceGoogleLoginWithResponse request returning *AuthServiceGoogleLoginResponse
+func (c *ClientWithResponses) AuthServiceGoogleLoginWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AuthServiceGoogleLoginResponse, error) {
+	rsp, err := c.AuthServiceGoogleLogin(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthServiceGoogleLoginResponse(rsp)
+}
+
+// AuthServiceLoginWithBodyWithResponse request with arbitrary body returning *AuthServiceLoginResponse
+func (c *ClientWithResponses) AuthServiceLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthServiceLoginResponse, error) {
+	rsp, err := c.AuthServiceLoginWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthServiceLoginResponse(rsp)
+}
+
+func (c *ClientWithResponses) AuthServiceLoginWithResponse(ctx context.Context, body AuthServiceLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthServiceLoginResponse, error) {
+	rsp, err := c.AuthServiceLogin(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthServiceLoginResponse(rsp)
+}
+
+// AuthServiceLogoutWithBodyWithResponse request with arbitrary body returning *AuthServiceLogoutResponse
+func (c *ClientWithResponses) AuthServiceLogoutWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthServiceLogoutResponse, error) {
+	rsp, err := c.AuthServiceLogoutWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthServiceLogoutResponse(rsp)
+}
+
+func (c *ClientWithResponses) AuthServiceLogoutWithResponse(ctx context.Context, body AuthServiceLogoutJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthServiceLogoutResponse, error) {
+	rsp, err := c.AuthServiceLogout(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthServiceLogoutResponse(rsp)
+}
+
+// AuthServiceLogoutAllWithBodyWithResponse request with arbitrary body returning *AuthServiceLogoutAllResponse
+func (c *ClientWithResponses) AuthServiceLogoutAllWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthServiceLogoutAllResponse, error) {
+	rsp, err := c.AuthServiceLogoutAllWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthServiceLogoutAllResponse(rsp)
+}
+
+func (c *ClientWithResponses) AuthServiceLogoutAllWithResponse(ctx context.Context, body AuthServiceLogoutAllJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthServiceLogoutAllResponse, error) {
+	rsp, err := c.AuthServiceLogoutAll(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthServiceLogoutAllResponse(rsp)
+}
+
+// AuthServiceRequestMagicLinkWithBodyWithResponse request with arbitrary body returning *AuthServiceRequestMagicLinkResponse
+func (c *ClientWithResponses) AuthServiceRequestMagicLinkWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthServiceRequestMagicLinkResponse, error) {
+	rsp, err := c.AuthServiceRequestMagicLinkWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthServiceRequestMagicLinkResponse(rsp)
+}
+
+func (c *ClientWithResponses) AuthServiceRequestMagicLinkWithResponse(ctx context.Context, body AuthServiceRequestMagicLinkJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthServiceRequestMagicLinkResponse, error) {
+	rsp, err := c.AuthServiceRequestMagicLink(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthServiceRequestMagicLinkResponse(rsp)
+}
+
+// AuthServiceVerifyMagicLinkWithResponse request returning *AuthServiceVerifyMagicLinkResponse
+func (c *ClientWithResponses) AuthServiceVerifyMagicLinkWithResponse(ctx context.Context, params *AuthServiceVerifyMagicLinkParams, reqEditors ...RequestEditorFn) (*AuthServiceVerifyMagicLinkResponse, error) {
+	rsp, err := c.AuthServiceVerifyMagicLink(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthServiceVerifyMagicLinkResponse(rsp)
+}
+
+// AuthServiceGetPreferencesWithResponse request returning *AuthServiceGetPreferencesResponse
+func (c *ClientWithResponses) AuthServiceGetPreferencesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AuthServiceGetPreferencesResponse, error) {
+	rsp, err := c.AuthServiceGetPreferences(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthServiceGetPreferencesResponse(rsp)
+}
+
+// AuthServiceUpdatePreferencesWithBodyWithResponse request with arbitrary body returning *AuthServiceUpdatePreferencesResponse
+func (c *ClientWithResponses) AuthServiceUpdatePreferencesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthServiceUpdatePreferencesResponse, error) {
+	rsp, err := c.AuthServiceUpdatePreferencesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthServiceUpdatePreferencesResponse(rsp)
+}
+
+func (c *ClientWithResponses) AuthServiceUpdatePreferencesWithResponse(ctx context.Context, body AuthServiceUpdatePreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthServiceUpdatePreferencesResponse, error) {
+	rsp, err := c.AuthServiceUpdatePreferences(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthServiceUpdatePreferencesResponse(rsp)
+}
+
+// AuthServiceGetProfileWithResponse request returning *AuthServiceGetProfileResponse
+func (c *ClientWithResponses) AuthServiceGetProfileWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AuthServiceGetProfileResponse, error) {
+	rsp, err := c.AuthServiceGetProfile(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthServiceGetProfileResponse(rsp)
+}
+
+// AuthServiceUpdateProfileWithBodyWithResponse request with arbitrary body returning *AuthServiceUpdateProfileResponse
+func (c *ClientWithResponses) AuthServiceUpdateProfileWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthServiceUpdateProfileResponse, error) {
+	rsp, err := c.AuthServiceUpdateProfileWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthServiceUpdateProfileResponse(rsp)
+}
+
+func (c *ClientWithResponses) AuthServiceUpdateProfileWithResponse(ctx context.Context, body AuthServiceUpdateProfileJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthServiceUpdateProfileResponse, error) {
+	rsp, err := c.AuthServiceUpdateProfile(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthServiceUpdateProfileResponse(rsp)
+}
+
+// AuthServiceRefreshTokenWithBodyWithResponse request with arbitrary body returning *AuthServiceRefreshTokenResponse
+func (c *ClientWithResponses) AuthServiceRefreshTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthServiceRefreshTokenResponse, error) {
+	rsp, err := c.AuthServiceRefreshTokenWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthServiceRefreshTokenResponse(rsp)
+}
+
+func (c *ClientWithResponses) AuthServiceRefreshTokenWithResponse(ctx context.Context, body AuthServiceRefreshTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthServiceRefreshTokenResponse, error) {
+	rsp, err := c.AuthServiceRefreshToken(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthServiceRefreshTokenResponse(rsp)
+}
+
+// AuthServiceRegisterWithBodyWithResponse request with arbitrary body returning *AuthServiceRegisterResponse
+func (c *ClientWithResponses) AuthServiceRegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthServiceRegisterResponse, error) {
+	rsp, err := c.AuthServiceRegisterWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthServiceRegisterResponse(rsp)
+}
+
+func (c *ClientWithResponses) AuthServiceRegisterWithResponse(ctx context.Context, body AuthServiceRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthServiceRegisterResponse, error) {
+	rsp, err := c.AuthServiceRegister(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthServiceRegisterResponse(rsp)
+}
+
+// AuthServiceResendVerificationWithBodyWithResponse request with arbitrary body returning *AuthServiceResendVerificationResponse
+func (c *ClientWithResponses) AuthServiceResendVerificationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthServiceResendVerificationResponse, error) {
+	rsp, err := c.AuthServiceResendVerificationWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthServiceResendVerificationResponse(rsp)
+}
+
+func (c *ClientWithResponses) AuthServiceResendVerificationWithResponse(ctx context.Context, body AuthServiceResendVerificationJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthServiceResendVerificationResponse, error) {
+	rsp, err := c.AuthServiceResendVerification(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthServiceResendVerificationResponse(rsp)
+}
+
+// AuthServiceResetPasswordWithBodyWithResponse request with arbitrary body returning *AuthServiceResetPasswordResponse
+func (c *ClientWithResponses) AuthServiceResetPasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthServiceResetPasswordResponse, error) {
+	rsp, err := c.AuthServiceResetPasswordWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthServiceResetPasswordResponse(rsp)
+}
+
+func (c *ClientWithResponses) AuthServiceResetPasswordWithResponse(ctx context.Context, body AuthServiceResetPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthServiceResetPasswordResponse, error) {
+	rsp, err := c.AuthServiceResetPassword(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthServiceResetPasswordResponse(rsp)
+}
+
+// AuthServiceListSessionsWithResponse request returning *AuthServiceListSessionsResponse
+func (c *ClientWithResponses) AuthServiceListSessionsWithResponse(ctx context.Context, params *AuthServiceListSessionsParams, reqEditors ...RequestEditorFn) (*AuthServiceListSessionsResponse, error) {
+	rsp, err := c.AuthServiceListSessions(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthServiceListSessionsResponse(rsp)
+}
+
+// AuthServiceRevokeOtherSessionsWithResponse request returning *AuthServiceRevokeOtherSessionsResponse
+func (c *ClientWithResponses) AuthServiceRevokeOtherSessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AuthServiceRevokeOtherSessionsResponse, error) {
+	rsp, err := c.AuthServiceRevokeOtherSessions(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthServiceRevokeOtherSessionsResponse(rsp)
+}
+
+// AuthServiceRevokeSessionWithResponse request returning *AuthServiceRevokeSessionResponse
+func (c *ClientWithResponses) AuthServiceRevokeSessionWithResponse(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*AuthServiceRevokeSessionResponse, error) {
+	rsp, err := c.AuthServiceRevokeSession(ctx, sessionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthServiceRevokeSessionResponse(rsp)
+}
+
+// AuthServiceSSOCallbackWithBodyWithResponse request with arbitrary body returning *AuthServiceSSOCallbackResponse
+func (c *ClientWithResponses) AuthServiceSSOCallbackWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthServiceSSOCallbackResponse, error) {
+	rsp, err := c.AuthServiceSSOCallbackWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthServiceSSOCallbackResponse(rsp)
+}
+
+func (c *ClientWithResponses) AuthServiceSSOCallbackWithResponse(ctx context.Context, body AuthServiceSSOCallbackJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthServiceSSOCallbackResponse, error) {
+	rsp, err := c.AuthServiceSSOCallback(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthServiceSSOCallbackResponse(rsp)
+}
+
+// AuthServiceSSOLoginWithResponse request returning *AuthServiceSSOLoginResponse
+func (c *ClientWithResponses) AuthServiceSSOLoginWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AuthServiceSSOLoginResponse, error) {
+	rsp, err := c.AuthServiceSSOLogin(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthServiceSSOLoginResponse(rsp)
+}
+
+// AuthServiceVerifyEmailWithBodyWithResponse request with arbitrary body returning *AuthServiceVerifyEmailResponse
+func (c *ClientWithResponses) AuthServiceVerifyEmailWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthServiceVerifyEmailResponse, error) {
+	rsp, err := c.AuthServiceVerifyEmailWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthServiceVerifyEmailResponse(rsp)
+}
+
+func (c *ClientWithResponses) AuthServiceVerifyEmailWithResponse(ctx context.Context, body AuthServiceVerifyEmailJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthServiceVerifyEmailResponse, error) {
+	rsp, err := c.AuthServiceVerifyEmail(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthServiceVerifyEmailResponse(rsp)
+}
+
+// HabitsServiceGetDashboardWithResponse request returning *HabitsServiceGetDashboardResponse
+func (c *ClientWithResponses) HabitsServiceGetDashboardWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HabitsServiceGetDashboardResponse, error) {
+	rsp, err := c.HabitsServiceGetDashboard(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHabitsServiceGetDashboardResponse(rsp)
+}
+
+// HabitsServiceDeleteHabitLogWithResponse request returning *HabitsServiceDeleteHabitLogResponse
+func (c *ClientWithResponses) HabitsServiceDeleteHabitLogWithResponse(ctx context.Context, logId string, reqEditors ...RequestEditorFn) (*HabitsServiceDeleteHabitLogResponse, error) {
+	rsp, err := c.HabitsServiceDeleteHabitLog(ctx, logId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHabitsServiceDeleteHabitLogResponse(rsp)
+}
+
+// HabitsServiceUpdateHabitLogWithBodyWithResponse request with arbitrary body returning *HabitsServiceUpdateHabitLogResponse
+func (c *ClientWithResponses) HabitsServiceUpdateHabitLogWithBodyWithResponse(ctx context.Context, logId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*HabitsServiceUpdateHabitLogResponse, error) {
+	rsp, err := c.HabitsServiceUpdateHabitLogWithBody(ctx, logId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHabitsServiceUpdateHabitLogResponse(rsp)
+}
+
+func (c *ClientWithResponses) HabitsServiceUpdateHabitLogWithResponse(ctx context.Context, logId string, body HabitsServiceUpdateHabitLogJSONRequestBody, reqEditors ...RequestEditorFn) (*HabitsServiceUpdateHabitLogResponse, error) {
+	rsp, err := c.HabitsServiceUpdateHabitLog(ctx, logId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHabitsServiceUpdateHabitLogResponse(rsp)
+}
+
+// HabitsServiceListHabitsWithResponse request returning *HabitsServiceListHabitsResponse
+func (c *ClientWithResponses) HabitsServiceListHabitsWithResponse(ctx context.Context, params *HabitsServiceListHabitsParams, reqEditors ...RequestEditorFn) (*HabitsServiceListHabitsResponse, error) {
+	rsp, err := c.HabitsServiceListHabits(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHabitsServiceListHabitsResponse(rsp)
+}
+
+// HabitsServiceCreateHabitWithBodyWithResponse request with arbitrary body returning *HabitsServiceCreateHabitResponse
+func (c *ClientWithResponses) HabitsServiceCreateHabitWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*HabitsServiceCreateHabitResponse, error) {
+	rsp, err := c.HabitsServiceCreateHabitWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHabitsServiceCreateHabitResponse(rsp)
+}
+
+func (c *ClientWithResponses) HabitsServiceCreateHabitWithResponse(ctx context.Context, body HabitsServiceCreateHabitJSONRequestBody, reqEditors ...RequestEditorFn) (*HabitsServiceCreateHabitResponse, error) {
+	rsp, err := c.HabitsServiceCreateHabit(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHabitsServiceCreateHabitResponse(rsp)
+}
+
+// HabitsServiceReorderHabitsWithBodyWithResponse request with arbitrary body returning *HabitsServiceReorderHabitsResponse
+func (c *ClientWithResponses) HabitsServiceReorderHabitsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*HabitsServiceReorderHabitsResponse, error) {
+	rsp, err := c.HabitsServiceReorderHabitsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHabitsServiceReorderHabitsResponse(rsp)
+}
+
+func (c *ClientWithResponses) HabitsServiceReorderHabitsWithResponse(ctx context.Context, body HabitsServiceReorderHabitsJSONRequestBody, reqEditors ...RequestEditorFn) (*HabitsServiceReorderHabitsResponse, error) {
+	rsp, err := c.HabitsServiceReorderHabits(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHabitsServiceReorderHabitsResponse(rsp)
+}
+
+// HabitsServiceDeleteHabitWithResponse request returning *HabitsServiceDeleteHabitResponse
+func (c *ClientWithResponses) HabitsServiceDeleteHabitWithResponse(ctx context.Context, habitId string, reqEditors ...RequestEditorFn) (*HabitsServiceDeleteHabitResponse, error) {
+	rsp, err := c.HabitsServiceDeleteHabit(ctx, habitId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHabitsServiceDeleteHabitResponse(rsp)
+}
+
+// HabitsServiceGetHabitWithResponse request returning *HabitsServiceGetHabitResponse
+func (c *ClientWithResponses) HabitsServiceGetHabitWithResponse(ctx context.Context, habitId string, reqEditors ...RequestEditorFn) (*HabitsServiceGetHabitResponse, error) {
+	rsp, err := c.HabitsServiceGetHabit(ctx, habitId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHabitsServiceGetHabitResponse(rsp)
+}
+
+// HabitsServiceUpdateHabitWithBodyWithResponse request with arbitrary body returning *HabitsServiceUpdateHabitResponse
+func (c *ClientWithResponses) HabitsServiceUpdateHabitWithBodyWithResponse(ctx context.Context, habitId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*HabitsServiceUpdateHabitResponse, error) {
+	rsp, err := c.HabitsServiceUpdateHabitWithBody(ctx, habitId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHabitsServiceUpdateHabitResponse(rsp)
+}
+
+func (c *ClientWithResponses) HabitsServiceUpdateHabitWithResponse(ctx context.Context, habitId string, body HabitsServiceUpdateHabitJSONRequestBody, reqEditors ...RequestEditorFn) (*HabitsServiceUpdateHabitResponse, error) {
+	rsp, err := c.HabitsServiceUpdateHabit(ctx, habitId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHabitsServiceUpdateHabitResponse(rsp)
+}
+
+// HabitsServiceActivateHabitWithResponse request returning *HabitsServiceActivateHabitResponse
+func (c *ClientWithResponses) HabitsServiceActivateHabitWithResponse(ctx context.Context, habitId string, reqEditors ...RequestEditorFn) (*HabitsServiceActivateHabitResponse, error) {
+	rsp, err := c.HabitsServiceActivateHabit(ctx, habitId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHabitsServiceActivateHabitResponse(rsp)
+}
+
+// HabitsServiceDeactivateHabitWithResponse request returning *HabitsServiceDeactivateHabitResponse
+func (c *ClientWithResponses) HabitsServiceDeactivateHabitWithResponse(ctx context.Context, habitId string, reqEditors ...RequestEditorFn) (*HabitsServiceDeactivateHabitResponse, error) {
+	rsp, err := c.HabitsServiceDeactivateHabit(ctx, habitId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHabitsServiceDeactivateHabitResponse(rsp)
+}
+
+// HabitsServiceGetHabitInsightsWithResponse request returning *HabitsServiceGetHabitInsightsResponse
+func (c *ClientWithResponses) HabitsServiceGetHabitInsightsWithResponse(ctx context.Context, habitId string, reqEditors ...RequestEditorFn) (*HabitsServiceGetHabitInsightsResponse, error) {
+	rsp, err := c.HabitsServiceGetHabitInsights(ctx, habitId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHabitsServiceGetHabitInsightsResponse(rsp)
+}
+
+// HabitsServiceGetHabitLogsWithResponse request returning *HabitsServiceGetHabitLogsResponse
+func (c *ClientWithResponses) HabitsServiceGetHabitLogsWithResponse(ctx context.Context, habitId string, params *HabitsServiceGetHabitLogsParams, reqEditors ...RequestEditorFn) (*HabitsServiceGetHabitLogsResponse, error) {
+	rsp, err := c.HabitsServiceGetHabitLogs(ctx, habitId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHabitsServiceGetHabitLogsResponse(rsp)
+}
+
+// HabitsServiceLogHabitWithBodyWithResponse request with arbitrary body returning *HabitsServiceLogHabitResponse
+func (c *ClientWithResponses) HabitsServiceLogHabitWithBodyWithResponse(ctx context.Context, habitId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*HabitsServiceLogHabitResponse, error) {
+	rsp, err := c.HabitsServiceLogHabitWithBody(ctx, habitId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHabitsServiceLogHabitResponse(rsp)
+}
+
+func (c *ClientWithResponses) HabitsServiceLogHabitWithResponse(ctx context.Context, habitId string, body HabitsServiceLogHabitJSONRequestBody, reqEditors ...RequestEditorFn) (*HabitsServiceLogHabitResponse, error) {
+	rsp, err := c.HabitsServiceLogHabit(ctx, habitId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHabitsServiceLogHabitResponse(rsp)
+}
+
+// HabitsServiceSetHabitLogCountWithBodyWithResponse request with arbitrary body returning *HabitsServiceSetHabitLogCountResponse
+func (c *ClientWithResponses) HabitsServiceSetHabitLogCountWithBodyWithResponse(ctx context.Context, habitId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*HabitsServiceSetHabitLogCountResponse, error) {
+	rsp, err := c.HabitsServiceSetHabitLogCountWithBody(ctx, habitId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHabitsServiceSetHabitLogCountResponse(rsp)
+}
+
+func (c *ClientWithResponses) HabitsServiceSetHabitLogCountWithResponse(ctx context.Context, habitId string, body HabitsServiceSetHabitLogCountJSONRequestBody, reqEditors ...RequestEditorFn) (*HabitsServiceSetHabitLogCountResponse, error) {
+	rsp, err := c.HabitsServiceSetHabitLogCount(ctx, habitId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHabitsServiceSetHabitLogCountResponse(rsp)
+}
+
+// HabitsServiceUndoHabitLogWithBodyWithResponse request with arbitrary body returning *HabitsServiceUndoHabitLogResponse
+func (c *ClientWithResponses) HabitsServiceUndoHabitLogWithBodyWithResponse(ctx context.Context, habitId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*HabitsServiceUndoHabitLogResponse, error) {
+	rsp, err := c.HabitsServiceUndoHabitLogWithBody(ctx, habitId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHabitsServiceUndoHabitLogResponse(rsp)
+}
+
+func (c *ClientWithResponses) HabitsServiceUndoHabitLogWithResponse(ctx context.Context, habitId string, body HabitsServiceUndoHabitLogJSONRequestBody, reqEditors ...RequestEditorFn) (*HabitsServiceUndoHabitLogResponse, error) {
+	rsp, err := c.HabitsServiceUndoHabitLog(ctx, habitId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHabitsServiceUndoHabitLogResponse(rsp)
+}
+
+// HabitsServicePauseHabitWithBodyWithResponse request with arbitrary body returning *HabitsServicePauseHabitResponse
+func (c *ClientWithResponses) HabitsServicePauseHabitWithBodyWithResponse(ctx context.Context, habitId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*HabitsServicePauseHabitResponse, error) {
+	rsp, err := c.HabitsServicePauseHabitWithBody(ctx, habitId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHabitsServicePauseHabitResponse(rsp)
+}
+
+func (c *ClientWithResponses) HabitsServicePauseHabitWithResponse(ctx context.Context, habitId string, body HabitsServicePauseHabitJSONRequestBody, reqEditors ...RequestEditorFn) (*HabitsServicePauseHabitResponse, error) {
+	rsp, err := c.HabitsServicePauseHabit(ctx, habitId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHabitsServicePauseHabitResponse(rsp)
+}
+
+// HabitsServiceGetHabitStatsWithResponse request returning *HabitsServiceGetHabitStatsResponse
+func (c *ClientWithResponses) HabitsServiceGetHabitStatsWithResponse(ctx context.Context, habitId string, reqEditors ...RequestEditorFn) (*HabitsServiceGetHabitStatsResponse, error) {
+	rsp, err := c.HabitsServiceGetHabitStats(ctx, habitId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHabitsServiceGetHabitStatsResponse(rsp)
+}
+
+// HabitsServiceListVacationsWithResponse request returning *HabitsServiceListVacationsResponse
+func (c *ClientWithResponses) HabitsServiceListVacationsWithResponse(ctx context.Context, habitId string, reqEditors ...RequestEditorFn) (*HabitsServiceListVacationsResponse, error) {
+	rsp, err := c.HabitsServiceListVacations(ctx, habitId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHabitsServiceListVacationsResponse(rsp)
+}
+
+// HabitsServiceStartVacationWithBodyWithResponse request with arbitrary body returning *HabitsServiceStartVacationResponse
+func (c *ClientWithResponses) HabitsServiceStartVacationWithBodyWithResponse(ctx context.Context, habitId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*HabitsServiceStartVacationResponse, error) {
+	rsp, err := c.HabitsServiceStartVacationWithBody(ctx, habitId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHabitsServiceStartVacationResponse(rsp)
+}
+
+func (c *ClientWithResponses) HabitsServiceStartVacationWithResponse(ctx context.Context, habitId string, body HabitsServiceStartVacationJSONRequestBody, reqEditors ...RequestEditorFn) (*HabitsServiceStartVacationResponse, error) {
+	rsp, err := c.HabitsServiceStartVacation(ctx, habitId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHabitsServiceStartVacationResponse(rsp)
+}
+
+// HabitsServiceEndVacationWithBodyWithResponse request with arbitrary body returning *HabitsServiceEndVacationResponse
+func (c *ClientWithResponses) HabitsServiceEndVacationWithBodyWithResponse(ctx context.Context, habitId string, vacationId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*HabitsServiceEndVacationResponse, error) {
+	rsp, err := c.HabitsServiceEndVacationWithBody(ctx, habitId, vacationId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHabitsServiceEndVacationResponse(rsp)
+}
+
+func (c *ClientWithResponses) HabitsServiceEndVacationWithResponse(ctx context.Context, habitId string, vacationId string, body HabitsServiceEndVacationJSONRequestBody, reqEditors ...RequestEditorFn) (*HabitsServiceEndVacationResponse, error) {
+	rsp, err := c.HabitsServiceEndVacation(ctx, habitId, vacationId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHabitsServiceEndVacationResponse(rsp)
+}
+
+// HabitsServiceStartImportWithBodyWithResponse request with arbitrary body returning *HabitsServiceStartImportResponse
+func (c *ClientWithResponses) HabitsServiceStartImportWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*HabitsServiceStartImportResponse, error) {
+	rsp, err := c.HabitsServiceStartImportWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHabitsServiceStartImportResponse(rsp)
+}
+
+func (c *ClientWithResponses) HabitsServiceStartImportWithResponse(ctx context.Context, body HabitsServiceStartImportJSONRequestBody, reqEditors ...RequestEditorFn) (*HabitsServiceStartImportResponse, error) {
+	rsp, err := c.HabitsServiceStartImport(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHabitsServiceStartImportResponse(rsp)
+}
+
+// HabitsServicePreviewImportWithBodyWithResponse request with arbitrary body returning *HabitsServicePreviewImportResponse
+func (c *ClientWithResponses) HabitsServicePreviewImportWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*HabitsServicePreviewImportResponse, error) {
+	rsp, err := c.HabitsServicePreviewImportWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHabitsServicePreviewImportResponse(rsp)
+}
+
+func (c *ClientWithResponses) HabitsServicePreviewImportWithResponse(ctx context.Context, body HabitsServicePreviewImportJSONRequestBody, reqEditors ...RequestEditorFn) (*HabitsServicePreviewImportResponse, error) {
+	rsp, err := c.HabitsServicePreviewImport(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHabitsServicePreviewImportResponse(rsp)
+}
+
+// HabitsServiceGetImportWithResponse request returning *HabitsServiceGetImportResponse
+func (c *ClientWithResponses) HabitsServiceGetImportWithResponse(ctx context.Context, importId string, reqEditors ...RequestEditorFn) (*HabitsServiceGetImportResponse, error) {
+	rsp, err := c.HabitsServiceGetImport(ctx, importId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHabitsServiceGetImportResponse(rsp)
+}
+
+// NotificationsServiceListNotificationsWithResponse request returning *NotificationsServiceListNotificationsResponse
+func (c *ClientWithResponses) NotificationsServiceListNotificationsWithResponse(ctx context.Context, params *NotificationsServiceListNotificationsParams, reqEditors ...RequestEditorFn) (*NotificationsServiceListNotificationsResponse, error) {
+	rsp, err := c.NotificationsServiceListNotifications(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNotificationsServiceListNotificationsResponse(rsp)
+}
+
+// NotificationsServiceCreateNotificationWithBodyWithResponse request with arbitrary body returning *NotificationsServiceCreateNotificationResponse
+func (c *ClientWithResponses) NotificationsServiceCreateNotificationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*NotificationsServiceCreateNotificationResponse, error) {
+	rsp, err := c.NotificationsServiceCreateNotificationWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNotificationsServiceCreateNotificationResponse(rsp)
+}
+
+func (c *ClientWithResponses) NotificationsServiceCreateNotificationWithResponse(ctx context.Context, body NotificationsServiceCreateNotificationJSONRequestBody, reqEditors ...RequestEditorFn) (*NotificationsServiceCreateNotificationResponse, error) {
+	rsp, err := c.NotificationsServiceCreateNotification(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNotificationsServiceCreateNotificationResponse(rsp)
+}
+
+// NotificationsServiceGetNotificationPreferencesWithResponse request returning *NotificationsServiceGetNotificationPreferencesResponse
+func (c *ClientWithResponses) NotificationsServiceGetNotificationPreferencesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*NotificationsServiceGetNotificationPreferencesResponse, error) {
+	rsp, err := c.NotificationsServiceGetNotificationPreferences(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNotificationsServiceGetNotificationPreferencesResponse(rsp)
+}
+
+// NotificationsServiceUpdateNotificationPreferencesWithBodyWithResponse request with arbitrary body returning *NotificationsServiceUpdateNotificationPreferencesResponse
+func (c *ClientWithResponses) NotificationsServiceUpdateNotificationPreferencesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*NotificationsServiceUpdateNotificationPreferencesResponse, error) {
+	rsp, err := c.NotificationsServiceUpdateNotificationPreferencesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNotificationsServiceUpdateNotificationPreferencesResponse(rsp)
+}
+
+func (c *ClientWithResponses) NotificationsServiceUpdateNotificationPreferencesWithResponse(ctx context.Context, body NotificationsServiceUpdateNotificationPreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*NotificationsServiceUpdateNotificationPreferencesResponse, error) {
+	rsp, err := c.NotificationsServiceUpdateNotificationPreferences(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNotificationsServiceUpdateNotificationPreferencesResponse(rsp)
+}
+
+// NotificationsServiceMarkAllAsReadWithResponse request returning *NotificationsServiceMarkAllAsReadResponse
+func (c *ClientWithResponses) NotificationsServiceMarkAllAsReadWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*NotificationsServiceMarkAllAsReadResponse, error) {
+	rsp, err := c.NotificationsServiceMarkAllAsRead(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNotificationsServiceMarkAllAsReadResponse(rsp)
+}
+
+// NotificationsServiceGetUnreadCountWithResponse request returning *NotificationsServiceGetUnreadCountResponse
+func (c *ClientWithResponses) NotificationsServiceGetUnreadCountWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*NotificationsServiceGetUnreadCountResponse, error) {
+	rsp, err := c.NotificationsServiceGetUnreadCount(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNotificationsServiceGetUnreadCountResponse(rsp)
+}
+
+// NotificationsServiceUnsubscribeWithBodyWithResponse request with arbitrary body returning *NotificationsServiceUnsubscribeResponse
+func (c *ClientWithResponses) NotificationsServiceUnsubscribeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*NotificationsServiceUnsubscribeResponse, error) {
+	rsp, err := c.NotificationsServiceUnsubscribeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNotificationsServiceUnsubscribeResponse(rsp)
+}
+
+func (c *ClientWithResponses) NotificationsServiceUnsubscribeWithResponse(ctx context.Context, body NotificationsServiceUnsubscribeJSONRequestBody, reqEditors ...RequestEditorFn) (*NotificationsServiceUnsubscribeResponse, error) {
+	rsp, err := c.NotificationsServiceUnsubscribe(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNotificationsServiceUnsubscribeResponse(rsp)
+}
+
+// NotificationsServiceDeleteNotificationWithResponse request returning *NotificationsServiceDeleteNotificationResponse
+func (c *ClientWithResponses) NotificationsServiceDeleteNotificationWithResponse(ctx context.Context, notificationId string, reqEditors ...RequestEditorFn) (*NotificationsServiceDeleteNotificationResponse, error) {
+	rsp, err := c.NotificationsServiceDeleteNotification(ctx, notificationId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNotificationsServiceDeleteNotificationResponse(rsp)
+}
+
+// NotificationsServiceMarkAsReadWithResponse request returning *NotificationsServiceMarkAsReadResponse
+func (c *ClientWithResponses) NotificationsServiceMarkAsReadWithResponse(ctx context.Context, notificationId string, reqEditors ...RequestEditorFn) (*NotificationsServiceMarkAsReadResponse, error) {
+	rsp, err := c.NotificationsServiceMarkAsRead(ctx, notificationId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNotificationsServiceMarkAsReadResponse(rsp)
+}
+
+// NotificationsServiceSnoozeNotificationWithBodyWithResponse request with arbitrary body returning *NotificationsServiceSnoozeNotificationResponse
+func (c *ClientWithResponses) NotificationsServiceSnoozeNotificationWithBodyWithResponse(ctx context.Context, notificationId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*NotificationsServiceSnoozeNotificationResponse, error) {
+	rsp, err := c.NotificationsServiceSnoozeNotificationWithBody(ctx, notificationId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNotificationsServiceSnoozeNotificationResponse(rsp)
+}
+
+func (c *ClientWithResponses) NotificationsServiceSnoozeNotificationWithResponse(ctx context.Context, notificationId string, body NotificationsServiceSnoozeNotificationJSONRequestBody, reqEditors ...RequestEditorFn) (*NotificationsServiceSnoozeNotificationResponse, error) {
+	rsp, err := c.NotificationsServiceSnoozeNotification(ctx, notificationId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNotificationsServiceSnoozeNotificationResponse(rsp)
+}
+
+// NotificationsServiceBatchDeleteNotificationsWithBodyWithResponse request with arbitrary body returning *NotificationsServiceBatchDeleteNotificationsResponse
+func (c *ClientWithResponses) NotificationsServiceBatchDeleteNotificationsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*NotificationsServiceBatchDeleteNotificationsResponse, error) {
+	rsp, err := c.NotificationsServiceBatchDeleteNotificationsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNotificationsServiceBatchDeleteNotificationsResponse(rsp)
+}
+
+func (c *ClientWithResponses) NotificationsServiceBatchDeleteNotificationsWithResponse(ctx context.Context, body NotificationsServiceBatchDeleteNotificationsJSONRequestBody, reqEditors ...RequestEditorFn) (*NotificationsServiceBatchDeleteNotificationsResponse, error) {
+	rsp, err := c.NotificationsServiceBatchDeleteNotifications(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNotificationsServiceBatchDeleteNotificationsResponse(rsp)
+}
+
+// NotificationsServiceBatchMarkAsReadWithBodyWithResponse request with arbitrary body returning *NotificationsServiceBatchMarkAsReadResponse
+func (c *ClientWithResponses) NotificationsServiceBatchMarkAsReadWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*NotificationsServiceBatchMarkAsReadResponse, error) {
+	rsp, err := c.NotificationsServiceBatchMarkAsReadWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNotificationsServiceBatchMarkAsReadResponse(rsp)
+}
+
+func (c *ClientWithResponses) NotificationsServiceBatchMarkAsReadWithResponse(ctx context.Context, body NotificationsServiceBatchMarkAsReadJSONRequestBody, reqEditors ...RequestEditorFn) (*NotificationsServiceBatchMarkAsReadResponse, error) {
+	rsp, err := c.NotificationsServiceBatchMarkAsRead(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNotificationsServiceBatchMarkAsReadResponse(rsp)
+}
+
+// ParseHabitsServiceComparePeriodsResponse parses an HTTP response from a HabitsServiceComparePeriodsWithResponse call
+func ParseHabitsServiceComparePeriodsResponse(rsp *http.Response) (*HabitsServiceComparePeriodsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HabitsServiceComparePeriodsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1PeriodComparisonResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseHabitsServiceGetHabitCorrelationsResponse parses an HTTP response from a HabitsServiceGetHabitCorrelationsWithResponse call
+func ParseHabitsServiceGetHabitCorrelationsResponse(rsp *http.Response) (*HabitsServiceGetHabitCorrelationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HabitsServiceGetHabitCorrelationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1HabitCorrelationsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseHabitsServiceGetWeeklyAnalyticsResponse parses an HTTP response from a HabitsServiceGetWeeklyAnalyticsWithResponse call
+func ParseHabitsServiceGetWeeklyAnalyticsResponse(rsp *http.Response) (*HabitsServiceGetWeeklyAnalyticsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HabitsServiceGetWeeklyAnalyticsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1WeeklyAnalyticsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAuthServiceDeleteAccountResponse parses an HTTP response from a AuthServiceDeleteAccountWithResponse call
+func ParseAuthServiceDeleteAccountResponse(rsp *http.Response) (*AuthServiceDeleteAccountResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AuthServiceDeleteAccountResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Ethosauthv1SuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAuthServiceChangePasswordResponse parses an HTTP response from a AuthServiceChangePasswordWithResponse call
+func ParseAuthServiceChangePasswordResponse(rsp *http.Response) (*AuthServiceChangePasswordResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AuthServiceChangePasswordResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Ethosauthv1SuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAuthServiceListConsentsResponse parses an HTTP response from a AuthServiceListConsentsWithResponse call
+func ParseAuthServiceListConsentsResponse(rsp *http.Response) (*AuthServiceListConsentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AuthServiceListConsentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1ConsentsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAuthServiceAcceptConsentResponse parses an HTTP response from a AuthServiceAcceptConsentWithResponse call
+func ParseAuthServiceAcceptConsentResponse(rsp *http.Response) (*AuthServiceAcceptConsentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AuthServiceAcceptConsentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1ConsentsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAuthServiceDeactivateAccountResponse parses an HTTP response from a AuthServiceDeactivateAccountWithResponse call
+func ParseAuthServiceDeactivateAccountResponse(rsp *http.Response) (*AuthServiceDeactivateAccountResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AuthServiceDeactivateAccountResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Ethosauthv1SuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAuthServiceExportUserDataResponse parses an HTTP response from a AuthServiceExportUserDataWithResponse call
+func ParseAuthServiceExportUserDataResponse(rsp *http.Response) (*AuthServiceExportUserDataResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AuthServiceExportUserDataResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1ExportUserDataResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAuthServiceForgotPasswordResponse parses an HTTP response from a AuthServiceForgotPasswordWithResponse call
+func ParseAuthServiceForgotPasswordResponse(rsp *http.Response) (*AuthServiceForgotPasswordResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AuthServiceForgotPasswordResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Ethosauthv1SuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAuthServiceGoogleCallbackResponse parses an HTTP response from a AuthServiceGoogleCallbackWithResponse call
+func ParseAuthServiceGoogleCallbackResponse(rsp *http.Response) (*AuthServiceGoogleCallbackResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AuthServiceGoogleCallbackResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1LoginResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAuthServiceGoogleLoginResponse parses an HTTP response from a AuthServiceGoogleLoginWithResponse call
+func ParseAuthServiceGoogleLoginResponse(rsp *http.Response) (*AuthServiceGoogleLoginResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AuthServiceGoogleLoginResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1GoogleLoginResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAuthServiceLoginResponse parses an HTTP response from a AuthServiceLoginWithResponse call
+func ParseAuthServiceLoginResponse(rsp *http.Response) (*AuthServiceLoginResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AuthServiceLoginResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1LoginResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAuthServiceLogoutResponse parses an HTTP response from a AuthServiceLogoutWithResponse call
+func ParseAuthServiceLogoutResponse(rsp *http.Response) (*AuthServiceLogoutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AuthServiceLogoutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1LogoutResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAuthServiceLogoutAllResponse parses an HTTP response from a AuthServiceLogoutAllWithResponse call
+func ParseAuthServiceLogoutAllResponse(rsp *http.Response) (*AuthServiceLogoutAllResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AuthServiceLogoutAllResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1LogoutResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAuthServiceRequestMagicLinkResponse parses an HTTP response from a AuthServiceRequestMagicLinkWithResponse call
+func ParseAuthServiceRequestMagicLinkResponse(rsp *http.Response) (*AuthServiceRequestMagicLinkResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AuthServiceRequestMagicLinkResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Ethosauthv1SuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAuthServiceVerifyMagicLinkResponse parses an HTTP response from a AuthServiceVerifyMagicLinkWithResponse call
+func ParseAuthServiceVerifyMagicLinkResponse(rsp *http.Response) (*AuthServiceVerifyMagicLinkResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AuthServiceVerifyMagicLinkResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1LoginResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAuthServiceGetPreferencesResponse parses an HTTP response from a AuthServiceGetPreferencesWithResponse call
+func ParseAuthServiceGetPreferencesResponse(rsp *http.Response) (*AuthServiceGetPreferencesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AuthServiceGetPreferencesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1PreferencesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAuthServiceUpdatePreferencesResponse parses an HTTP response from a AuthServiceUpdatePreferencesWithResponse call
+func ParseAuthServiceUpdatePreferencesResponse(rsp *http.Response) (*AuthServiceUpdatePreferencesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AuthServiceUpdatePreferencesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1PreferencesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAuthServiceGetProfileResponse parses an HTTP response from a AuthServiceGetProfileWithResponse call
+func ParseAuthServiceGetProfileResponse(rsp *http.Response) (*AuthServiceGetProfileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AuthServiceGetProfileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1ProfileResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAuthServiceUpdateProfileResponse parses an HTTP response from a AuthServiceUpdateProfileWithResponse call
+func ParseAuthServiceUpdateProfileResponse(rsp *http.Response) (*AuthServiceUpdateProfileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AuthServiceUpdateProfileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1ProfileResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAuthServiceRefreshTokenResponse parses an HTTP response from a AuthServiceRefreshTokenWithResponse call
+func ParseAuthServiceRefreshTokenResponse(rsp *http.Response) (*AuthServiceRefreshTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AuthServiceRefreshTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1LoginResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAuthServiceRegisterResponse parses an HTTP response from a AuthServiceRegisterWithResponse call
+func ParseAuthServiceRegisterResponse(rsp *http.Response) (*AuthServiceRegisterResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AuthServiceRegisterResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1RegisterResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAuthServiceResendVerificationResponse parses an HTTP response from a AuthServiceResendVerificationWithResponse call
+func ParseAuthServiceResendVerificationResponse(rsp *http.Response) (*AuthServiceResendVerificationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AuthServiceResendVerificationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Ethosauthv1SuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAuthServiceResetPasswordResponse parses an HTTP response from a AuthServiceResetPasswordWithResponse call
+func ParseAuthServiceResetPasswordResponse(rsp *http.Response) (*AuthServiceResetPasswordResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AuthServiceResetPasswordResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Ethosauthv1SuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAuthServiceListSessionsResponse parses an HTTP response from a AuthServiceListSessionsWithResponse call
+func ParseAuthServiceListSessionsResponse(rsp *http.Response) (*AuthServiceListSessionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AuthServiceListSessionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1ListSessionsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAuthServiceRevokeOtherSessionsResponse parses an HTTP response from a AuthServiceRevokeOtherSessionsWithResponse call
+func ParseAuthServiceRevokeOtherSessionsResponse(rsp *http.Response) (*AuthServiceRevokeOtherSessionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AuthServiceRevokeOtherSessionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1RevokeOtherSessionsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAuthServiceRevokeSessionResponse parses an HTTP response from a AuthServiceRevokeSessionWithResponse call
+func ParseAuthServiceRevokeSessionResponse(rsp *http.Response) (*AuthServiceRevokeSessionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AuthServiceRevokeSessionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1RevokeSessionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAuthServiceSSOCallbackResponse parses an HTTP response from a AuthServiceSSOCallbackWithResponse call
+func ParseAuthServiceSSOCallbackResponse(rsp *http.Response) (*AuthServiceSSOCallbackResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AuthServiceSSOCallbackResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1LoginResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAuthServiceSSOLoginResponse parses an HTTP response from a AuthServiceSSOLoginWithResponse call
+func ParseAuthServiceSSOLoginResponse(rsp *http.Response) (*AuthServiceSSOLoginResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AuthServiceSSOLoginResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1SSOLoginResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAuthServiceVerifyEmailResponse parses an HTTP response from a AuthServiceVerifyEmailWithResponse call
+func ParseAuthServiceVerifyEmailResponse(rsp *http.Response) (*AuthServiceVerifyEmailResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AuthServiceVerifyEmailResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Ethosauthv1SuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseHabitsServiceGetDashboardResponse parses an HTTP response from a HabitsServiceGetDashboardWithResponse call
+func ParseHabitsServiceGetDashboardResponse(rsp *http.Response) (*HabitsServiceGetDashboardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HabitsServiceGetDashboardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1DashboardResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseHabitsServiceDeleteHabitLogResponse parses an HTTP response from a HabitsServiceDeleteHabitLogWithResponse call
+func ParseHabitsServiceDeleteHabitLogResponse(rsp *http.Response) (*HabitsServiceDeleteHabitLogResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HabitsServiceDeleteHabitLogResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Ethoshabitsv1SuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseHabitsServiceUpdateHabitLogResponse parses an HTTP response from a HabitsServiceUpdateHabitLogWithResponse call
+func ParseHabitsServiceUpdateHabitLogResponse(rsp *http.Response) (*HabitsServiceUpdateHabitLogResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HabitsServiceUpdateHabitLogResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Ethoshabitsv1SuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseHabitsServiceListHabitsResponse parses an HTTP response from a HabitsServiceListHabitsWithResponse call
+func ParseHabitsServiceListHabitsResponse(rsp *http.Response) (*HabitsServiceListHabitsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HabitsServiceListHabitsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1ListHabitsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseHabitsServiceCreateHabitResponse parses an HTTP response from a HabitsServiceCreateHabitWithResponse call
+func ParseHabitsServiceCreateHabitResponse(rsp *http.Response) (*HabitsServiceCreateHabitResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HabitsServiceCreateHabitResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1HabitResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseHabitsServiceReorderHabitsResponse parses an HTTP response from a HabitsServiceReorderHabitsWithResponse call
+func ParseHabitsServiceReorderHabitsResponse(rsp *http.Response) (*HabitsServiceReorderHabitsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HabitsServiceReorderHabitsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Ethoshabitsv1SuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseHabitsServiceDeleteHabitResponse parses an HTTP response from a HabitsServiceDeleteHabitWithResponse call
+func ParseHabitsServiceDeleteHabitResponse(rsp *http.Response) (*HabitsServiceDeleteHabitResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HabitsServiceDeleteHabitResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Ethoshabitsv1SuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseHabitsServiceGetHabitResponse parses an HTTP response from a HabitsServiceGetHabitWithResponse call
+func ParseHabitsServiceGetHabitResponse(rsp *http.Response) (*HabitsServiceGetHabitResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HabitsServiceGetHabitResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1HabitResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseHabitsServiceUpdateHabitResponse parses an HTTP response from a HabitsServiceUpdateHabitWithResponse call
+func ParseHabitsServiceUpdateHabitResponse(rsp *http.Response) (*HabitsServiceUpdateHabitResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HabitsServiceUpdateHabitResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1HabitResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseHabitsServiceActivateHabitResponse parses an HTTP response from a HabitsServiceActivateHabitWithResponse call
+func ParseHabitsServiceActivateHabitResponse(rsp *http.Response) (*HabitsServiceActivateHabitResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HabitsServiceActivateHabitResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Ethoshabitsv1SuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseHabitsServiceDeactivateHabitResponse parses an HTTP response from a HabitsServiceDeactivateHabitWithResponse call
+func ParseHabitsServiceDeactivateHabitResponse(rsp *http.Response) (*HabitsServiceDeactivateHabitResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HabitsServiceDeactivateHabitResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Ethoshabitsv1SuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseHabitsServiceGetHabitInsightsResponse parses an HTTP response from a HabitsServiceGetHabitInsightsWithResponse call
+func ParseHabitsServiceGetHabitInsightsResponse(rsp *http.Response) (*HabitsServiceGetHabitInsightsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HabitsServiceGetHabitInsightsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1HabitInsightsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseHabitsServiceGetHabitLogsResponse parses an HTTP response from a HabitsServiceGetHabitLogsWithResponse call
+func ParseHabitsServiceGetHabitLogsResponse(rsp *http.Response) (*HabitsServiceGetHabitLogsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HabitsServiceGetHabitLogsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1GetHabitLogsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseHabitsServiceLogHabitResponse parses an HTTP response from a HabitsServiceLogHabitWithResponse call
+func ParseHabitsServiceLogHabitResponse(rsp *http.Response) (*HabitsServiceLogHabitResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HabitsServiceLogHabitResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1LogHabitResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseHabitsServiceSetHabitLogCountResponse parses an HTTP response from a HabitsServiceSetHabitLogCountWithResponse call
+func ParseHabitsServiceSetHabitLogCountResponse(rsp *http.Response) (*HabitsServiceSetHabitLogCountResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HabitsServiceSetHabitLogCountResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1LogHabitResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseHabitsServiceUndoHabitLogResponse parses an HTTP response from a HabitsServiceUndoHabitLogWithResponse call
+func ParseHabitsServiceUndoHabitLogResponse(rsp *http.Response) (*HabitsServiceUndoHabitLogResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HabitsServiceUndoHabitLogResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Ethoshabitsv1SuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseHabitsServicePauseHabitResponse parses an HTTP response from a HabitsServicePauseHabitWithResponse call
+func ParseHabitsServicePauseHabitResponse(rsp *http.Response) (*HabitsServicePauseHabitResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HabitsServicePauseHabitResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Ethoshabitsv1SuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseHabitsServiceGetHabitStatsResponse parses an HTTP response from a HabitsServiceGetHabitStatsWithResponse call
+func ParseHabitsServiceGetHabitStatsResponse(rsp *http.Response) (*HabitsServiceGetHabitStatsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HabitsServiceGetHabitStatsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1HabitStatsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseHabitsServiceListVacationsResponse parses an HTTP response from a HabitsServiceListVacationsWithResponse call
+func ParseHabitsServiceListVacationsResponse(rsp *http.Response) (*HabitsServiceListVacationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HabitsServiceListVacationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1ListVacationsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseHabitsServiceStartVacationResponse parses an HTTP response from a HabitsServiceStartVacationWithResponse call
+func ParseHabitsServiceStartVacationResponse(rsp *http.Response) (*HabitsServiceStartVacationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HabitsServiceStartVacationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1VacationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseHabitsServiceEndVacationResponse parses an HTTP response from a HabitsServiceEndVacationWithResponse call
+func ParseHabitsServiceEndVacationResponse(rsp *http.Response) (*HabitsServiceEndVacationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HabitsServiceEndVacationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Ethoshabitsv1SuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseHabitsServiceStartImportResponse parses an HTTP response from a HabitsServiceStartImportWithResponse call
+func ParseHabitsServiceStartImportResponse(rsp *http.Response) (*HabitsServiceStartImportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HabitsServiceStartImportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1ImportResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseHabitsServicePreviewImportResponse parses an HTTP response from a HabitsServicePreviewImportWithResponse call
+func ParseHabitsServicePreviewImportResponse(rsp *http.Response) (*HabitsServicePreviewImportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HabitsServicePreviewImportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1ImportPreviewResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseHabitsServiceGetImportResponse parses an HTTP response from a HabitsServiceGetImportWithResponse call
+func ParseHabitsServiceGetImportResponse(rsp *http.Response) (*HabitsServiceGetImportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HabitsServiceGetImportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1ImportResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseNotificationsServiceListNotificationsResponse parses an HTTP response from a NotificationsServiceListNotificationsWithResponse call
+func ParseNotificationsServiceListNotificationsResponse(rsp *http.Response) (*NotificationsServiceListNotificationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &NotificationsServiceListNotificationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1ListNotificationsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseNotificationsServiceCreateNotificationResponse parses an HTTP response from a NotificationsServiceCreateNotificationWithResponse call
+func ParseNotificationsServiceCreateNotificationResponse(rsp *http.Response) (*NotificationsServiceCreateNotificationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &NotificationsServiceCreateNotificationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Ethosnotificationsv1SuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseNotificationsServiceGetNotificationPreferencesResponse parses an HTTP response from a NotificationsServiceGetNotificationPreferencesWithResponse call
+func ParseNotificationsServiceGetNotificationPreferencesResponse(rsp *http.Response) (*NotificationsServiceGetNotificationPreferencesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &NotificationsServiceGetNotificationPreferencesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1NotificationPreferencesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseNotificationsServiceUpdateNotificationPreferencesResponse parses an HTTP response from a NotificationsServiceUpdateNotificationPreferencesWithResponse call
+func ParseNotificationsServiceUpdateNotificationPreferencesResponse(rsp *http.Response) (*NotificationsServiceUpdateNotificationPreferencesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &NotificationsServiceUpdateNotificationPreferencesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1NotificationPreferencesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseNotificationsServiceMarkAllAsReadResponse parses an HTTP response from a NotificationsServiceMarkAllAsReadWithResponse call
+func ParseNotificationsServiceMarkAllAsReadResponse(rsp *http.Response) (*NotificationsServiceMarkAllAsReadResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &NotificationsServiceMarkAllAsReadResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Ethosnotificationsv1SuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseNotificationsServiceGetUnreadCountResponse parses an HTTP response from a NotificationsServiceGetUnreadCountWithResponse call
+func ParseNotificationsServiceGetUnreadCountResponse(rsp *http.Response) (*NotificationsServiceGetUnreadCountResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &NotificationsServiceGetUnreadCountResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1UnreadCountResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseNotificationsServiceUnsubscribeResponse parses an HTTP response from a NotificationsServiceUnsubscribeWithResponse call
+func ParseNotificationsServiceUnsubscribeResponse(rsp *http.Response) (*NotificationsServiceUnsubscribeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &NotificationsServiceUnsubscribeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Ethosnotificationsv1SuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseNotificationsServiceDeleteNotificationResponse parses an HTTP response from a NotificationsServiceDeleteNotificationWithResponse call
+func ParseNotificationsServiceDeleteNotificationResponse(rsp *http.Response) (*NotificationsServiceDeleteNotificationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &NotificationsServiceDeleteNotificationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Ethosnotificationsv1SuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseNotificationsServiceMarkAsReadResponse parses an HTTP response from a NotificationsServiceMarkAsReadWithResponse call
+func ParseNotificationsServiceMarkAsReadResponse(rsp *http.Response) (*NotificationsServiceMarkAsReadResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &NotificationsServiceMarkAsReadResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Ethosnotificationsv1SuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseNotificationsServiceSnoozeNotificationResponse parses an HTTP response from a NotificationsServiceSnoozeNotificationWithResponse call
+func ParseNotificationsServiceSnoozeNotificationResponse(rsp *http.Response) (*NotificationsServiceSnoozeNotificationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &NotificationsServiceSnoozeNotificationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Ethosnotificationsv1SuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseNotificationsServiceBatchDeleteNotificationsResponse parses an HTTP response from a NotificationsServiceBatchDeleteNotificationsWithResponse call
+func ParseNotificationsServiceBatchDeleteNotificationsResponse(rsp *http.Response) (*NotificationsServiceBatchDeleteNotificationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &NotificationsServiceBatchDeleteNotificationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Ethosnotificationsv1SuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseNotificationsServiceBatchMarkAsReadResponse parses an HTTP response from a NotificationsServiceBatchMarkAsReadWithResponse call
+func ParseNotificationsServiceBatchMarkAsReadResponse(rsp *http.Response) (*NotificationsServiceBatchMarkAsReadResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &NotificationsServiceBatchMarkAsReadResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Ethosnotificationsv1SuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RpcStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
@@ -0,0 +1,141 @@
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	habitsv1 "github.com/semmidev/ethos-go/contracts/grpc/ethos/habits/v1"
+	"github.com/semmidev/ethos-go/internal/client"
+	"github.com/semmidev/ethos-go/internal/common/grpcutil"
+)
+
+// habitsServer answers GetHabit with err, or with a habit when err is nil,
+// and records the Authorization header the gateway forwarded
+type habitsServer struct {
+	habitsv1.UnimplementedHabitsServiceServer
+	authorization []string
+	err           error
+}
+
+func (s *habitsServer) GetHabit(ctx context.Context, req *habitsv1.GetHabitRequest) (*habitsv1.HabitResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	s.authorization = md.Get("authorization")
+
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &habitsv1.HabitResponse{
+		Success: true,
+		Message: "habit found",
+		Data: &habitsv1.Habit{
+			Id:          req.GetHabitId(),
+			Name:        "Read",
+			Frequency:   "daily",
+			TargetCount: 2,
+			CreatedAt:   timestamppb.New(time.Date(2026, time.October, 1, 8, 0, 0, 0, time.UTC)),
+		},
+	}, nil
+}
+
+// newGateway serves the habits API with the marshaler and error handler
+// the API's gateway uses, so the client reads what the server really writes
+func newGateway(server habitsv1.HabitsServiceServer) *httptest.Server {
+	mux := runtime.NewServeMux(
+		runtime.WithErrorHandler(grpcutil.CustomHTTPError),
+		runtime.WithForwardResponseRewriter(grpcutil.EnvelopeResponse),
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+			MarshalOptions:   grpcutil.GatewayJSON,
+			UnmarshalOptions: protojson.UnmarshalOptions{DiscardUnknown: true},
+		}),
+	)
+	if err := habitsv1.RegisterHabitsServiceHandlerServer(context.Background(), mux, server); err != nil {
+		panic(err)
+	}
+	return httptest.NewServer(mux)
+}
+
+func TestClient(t *testing.T) {
+	Convey("Given a client of the habits API", t, func() {
+		server := &habitsServer{}
+		gateway := newGateway(server)
+		defer gateway.Close()
+
+		c, err := client.NewClientWithResponses(gateway.URL,
+			client.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+				req.Header.Set("Authorization", "Bearer user-token")
+				return nil
+			}))
+		So(err, ShouldBeNil)
+
+		Convey("When a habit is fetched", func() {
+			resp, err := c.HabitsServiceGetHabitWithResponse(context.Background(), "habit-1")
+
+			Convey("Then the request is authenticated by the request editor", func() {
+				So(err, ShouldBeNil)
+				So(server.authorization, ShouldContain, "Bearer user-token")
+			})
+
+			Convey("Then the response is decoded, multi-word fields included", func() {
+				So(err, ShouldBeNil)
+				So(resp.StatusCode(), ShouldEqual, http.StatusOK)
+				So(resp.JSON200, ShouldNotBeNil)
+				So(*resp.JSON200.Success, ShouldBeTrue)
+				So(*resp.JSON200.Message, ShouldEqual, "habit found")
+
+				habit := resp.JSON200.Data
+				So(habit, ShouldNotBeNil)
+				So(*habit.Id, ShouldEqual, "habit-1")
+				So(*habit.Name, ShouldEqual, "Read")
+				So(*habit.Frequency, ShouldEqual, "daily")
+				So(habit.TargetCount, ShouldNotBeNil)
+				So(*habit.TargetCount, ShouldEqual, 2)
+				So(habit.CreatedAt, ShouldNotBeNil)
+				So(habit.CreatedAt.Equal(time.Date(2026, time.October, 1, 8, 0, 0, 0, time.UTC)), ShouldBeTrue)
+			})
+		})
+
+		Convey("When the API returns an error", func() {
+			server.err = status.Error(codes.NotFound, "habit not found")
+			resp, err := c.HabitsServiceGetHabitWithResponse(context.Background(), "missing")
+
+			Convey("Then its status and message are available, and no habit is decoded", func() {
+				So(err, ShouldBeNil)
+				So(resp.StatusCode(), ShouldEqual, http.StatusNotFound)
+				So(resp.JSON200, ShouldBeNil)
+				So(resp.JSONDefault, ShouldNotBeNil)
+				So(*resp.JSONDefault.Message, ShouldEqual, "habit not found")
+			})
+		})
+
+		Convey("When the API cannot be reached", func() {
+			gateway.Close()
+			_, err := c.HabitsServiceGetHabitWithResponse(context.Background(), "habit-1")
+
+			Convey("Then the transport error is returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given a server URL that does not parse", t, func() {
+		c, err := client.NewClientWithResponses("http://[::1")
+		So(err, ShouldBeNil)
+
+		_, err = c.HabitsServiceGetHabitWithResponse(context.Background(), "habit-1")
+
+		Convey("Then the request is not sent", func() {
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
@@ -0,0 +1,14 @@
+// Package client is a Go client for the Ethos HTTP API. It is generated by
+// oapi-codegen from the gateway's OpenAPI spec in docs/openapi, so services
+// calling the API use the same request and response types the server
+// publishes instead of hand-written ones. Do not edit client.gen.go; run
+// `make generate-client-go` after changing the protos.
+//
+// Requests are authenticated with a request editor:
+//
+//	c, err := client.NewClientWithResponses(baseURL,
+//		client.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+//			req.Header.Set("Authorization", "Bearer "+token)
+//			return nil
+//		}))
+package client
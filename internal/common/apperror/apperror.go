@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+
+	"github.com/semmidev/ethos-go/contracts/envelope"
 )
 
 // AppError is our standard error type that carries rich context about what went wrong.
@@ -95,31 +97,37 @@ func GetAppError(err error) *AppError {
 	return nil
 }
 
-// Common error codes - define these as constants for consistency
+// Common error codes, defined in the contracts module for clients
 const (
-	ErrCodeInvalidCredentials     = "AUTH_INVALID_CREDENTIALS"
-	ErrCodeEmailNotVerified       = "AUTH_EMAIL_NOT_VERIFIED"
-	ErrCodeSessionExpired         = "AUTH_SESSION_EXPIRED"
-	ErrCodeSessionBlocked         = "AUTH_SESSION_BLOCKED"
-	ErrCodeInvalidToken           = "AUTH_INVALID_TOKEN"
-	ErrCodeTokenExpired           = "AUTH_TOKEN_EXPIRED"
-	ErrCodeUnauthorized           = "AUTH_UNAUTHORIZED"
-	ErrCodeInsufficientPermission = "AUTH_INSUFFICIENT_PERMISSION"
-
-	ErrCodeNotFound      = "RESOURCE_NOT_FOUND"
-	ErrCodeAlreadyExists = "RESOURCE_ALREADY_EXISTS"
-	ErrCodeConflict      = "RESOURCE_CONFLICT"
-
-	ErrCodeValidationFailed = "VALIDATION_FAILED"
-	ErrCodeInvalidInput     = "VALIDATION_INVALID_INPUT"
-	ErrCodeMissingField     = "VALIDATION_MISSING_FIELD"
-
-	ErrCodeInternalError        = "INTERNAL_ERROR"
-	ErrCodeDatabaseError        = "INTERNAL_DATABASE_ERROR"
-	ErrCodeExternalServiceError = "INTERNAL_EXTERNAL_SERVICE_ERROR"
-
-	ErrCodeBusinessRuleViolation = "BUSINESS_RULE_VIOLATION"
-	ErrCodeOperationNotAllowed   = "BUSINESS_OPERATION_NOT_ALLOWED"
+	ErrCodeInvalidCredentials     = envelope.CodeInvalidCredentials
+	ErrCodeEmailNotVerified       = envelope.CodeEmailNotVerified
+	ErrCodeAccountDeactivated     = envelope.CodeAccountDeactivated
+	ErrCodeSessionExpired         = envelope.CodeSessionExpired
+	ErrCodeSessionBlocked         = envelope.CodeSessionBlocked
+	ErrCodeInvalidToken           = envelope.CodeInvalidToken
+	ErrCodeTokenExpired           = envelope.CodeTokenExpired
+	ErrCodeUnauthorized           = envelope.CodeUnauthorized
+	ErrCodeInsufficientPermission = envelope.CodeInsufficientPermission
+	ErrCodeConsentRequired        = envelope.CodeConsentRequired
+
+	ErrCodeNotFound      = envelope.CodeNotFound
+	ErrCodeAlreadyExists = envelope.CodeAlreadyExists
+	ErrCodeConflict      = envelope.CodeConflict
+
+	ErrCodeValidationFailed = envelope.CodeValidationFailed
+	ErrCodeInvalidInput     = envelope.CodeInvalidInput
+	ErrCodeMissingField     = envelope.CodeMissingField
+
+	ErrCodeInternalError        = envelope.CodeInternalError
+	ErrCodeDatabaseError        = envelope.CodeDatabaseError
+	ErrCodeExternalServiceError = envelope.CodeExternalServiceError
+
+	ErrCodeBusinessRuleViolation = envelope.CodeBusinessRuleViolation
+	ErrCodeOperationNotAllowed   = envelope.CodeOperationNotAllowed
+	ErrCodePlanLimitExceeded     = envelope.CodePlanLimitExceeded
+
+	ErrCodeRateLimited    = envelope.CodeRateLimited
+	ErrCodeRequestTimeout = envelope.CodeRequestTimeout
 )
 
 // Pre-defined common errors for consistency
@@ -143,6 +151,15 @@ func EmailNotVerified() *AppError {
 	)
 }
 
+func AccountDeactivated() *AppError {
+	return New(
+		ErrCodeAccountDeactivated,
+		"This account is deactivated. Log in again to reactivate it",
+		http.StatusForbidden,
+		nil,
+	)
+}
+
 func SessionExpired(err error) *AppError {
 	return New(
 		ErrCodeSessionExpired,
@@ -206,6 +223,17 @@ func AlreadyExists(resource string, identifier string) *AppError {
 	).WithDetails("resource", resource).WithDetails("identifier", identifier)
 }
 
+// Conflict reports a write rejected because the resource changed since the
+// client read it
+func Conflict(resource string, message string) *AppError {
+	return New(
+		ErrCodeConflict,
+		message,
+		http.StatusConflict,
+		nil,
+	).WithDetails("resource", resource)
+}
+
 func ValidationFailed(message string) *AppError {
 	return New(
 		ErrCodeValidationFailed,
@@ -253,6 +281,15 @@ func DatabaseError(operation string, err error) *AppError {
 	).WithDetails("operation", operation)
 }
 
+func ExternalServiceError(service string, err error) *AppError {
+	return New(
+		ErrCodeExternalServiceError,
+		fmt.Sprintf("The %s service returned an error", service),
+		http.StatusBadGateway,
+		err,
+	).WithDetails("service", service)
+}
+
 func BusinessRuleViolation(rule string, message string) *AppError {
 	return New(
 		ErrCodeBusinessRuleViolation,
@@ -270,3 +307,40 @@ func OperationNotAllowed(operation string, reason string) *AppError {
 		nil,
 	).WithDetails("operation", operation).WithDetails("reason", reason)
 }
+
+// PlanLimitExceeded reports an action the user's plan does not allow.
+// limit names the entitlement, so clients can offer the right upgrade.
+func PlanLimitExceeded(plan string, limit string, message string) *AppError {
+	return New(
+		ErrCodePlanLimitExceeded,
+		message,
+		http.StatusForbidden,
+		nil,
+	).WithDetails("plan", plan).WithDetails("limit", limit)
+}
+
+// ConsentRequired reports that the user must accept the current version of
+// the given legal documents before going on
+func ConsentRequired(documents []string) *AppError {
+	pending := make([]interface{}, len(documents))
+	for i, document := range documents {
+		pending[i] = document
+	}
+	return New(
+		ErrCodeConsentRequired,
+		"The updated terms must be accepted to continue",
+		http.StatusForbidden,
+		nil,
+	).WithDetails("documents", pending)
+}
+
+// RequestTimeout reports a request that did not finish within its
+// deadline, so its work was cancelled
+func RequestTimeout() *AppError {
+	return New(
+		ErrCodeRequestTimeout,
+		"The request took too long. Please try again",
+		http.StatusGatewayTimeout,
+		nil,
+	)
+}
@@ -156,6 +156,18 @@ func TestPredefinedErrors(t *testing.T) {
 			expectedCode:   apperror.ErrCodeAlreadyExists,
 			expectedStatus: http.StatusConflict,
 		},
+		{
+			name:           "PlanLimitExceeded",
+			err:            apperror.PlanLimitExceeded("free", "max_active_habits", "limit reached"),
+			expectedCode:   apperror.ErrCodePlanLimitExceeded,
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "ConsentRequired",
+			err:            apperror.ConsentRequired([]string{"terms"}),
+			expectedCode:   apperror.ErrCodeConsentRequired,
+			expectedStatus: http.StatusForbidden,
+		},
 		{
 			name:           "ValidationFailed",
 			err:            apperror.ValidationFailed("invalid input"),
@@ -0,0 +1,210 @@
+// Package breaker stops calling an external service that keeps failing,
+// so requests and tasks fail fast instead of each waiting on it while it
+// is down.
+package breaker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/semmidev/ethos-go/internal/common/logger"
+)
+
+// Default settings
+const (
+	DefaultFailureThreshold = 5
+	DefaultOpenTimeout      = 30 * time.Second
+)
+
+// ErrOpen is returned, wrapped, for calls refused while the breaker is open
+var ErrOpen = errors.New("circuit breaker is open")
+
+// State is the state of a breaker
+type State int
+
+const (
+	// Closed lets calls through
+	Closed State = iota
+	// HalfOpen lets a single probe through to see if the service is back
+	HalfOpen
+	// Open refuses calls
+	Open
+)
+
+func (s State) String() string {
+	switch s {
+	case Closed:
+		return "closed"
+	case HalfOpen:
+		return "half_open"
+	case Open:
+		return "open"
+	default:
+		return "unknown"
+	}
+}
+
+// Settings configure a breaker
+type Settings struct {
+	// Name identifies the service in logs and metrics
+	Name string
+	// FailureThreshold is how many calls in a row must fail to open the
+	// breaker
+	FailureThreshold int
+	// OpenTimeout is how long the breaker stays open before it lets a
+	// probe through
+	OpenTimeout time.Duration
+	// IsFailure reports whether an error means the service is failing.
+	// Errors it rejects, such as a bad request, neither open the breaker
+	// nor keep it open. Nil counts every error.
+	IsFailure func(err error) bool
+}
+
+// Stats is a snapshot of a breaker for metrics
+type Stats struct {
+	Name  string
+	State State
+	// Rejected is how many calls were refused since the breaker was created
+	Rejected int64
+}
+
+// Breaker opens after FailureThreshold consecutive failures and refuses
+// calls for OpenTimeout. It then lets one probe through: a success closes
+// it, a failure opens it again.
+type Breaker struct {
+	settings Settings
+	log      logger.Logger
+	now      func() time.Time
+
+	mu       sync.Mutex
+	state    State
+	failures int
+	openedAt time.Time
+	probing  bool
+	rejected int64
+}
+
+// New creates a closed breaker. Zero settings get their defaults.
+func New(settings Settings, log logger.Logger) *Breaker {
+	if log == nil {
+		panic("nil logger")
+	}
+	if settings.FailureThreshold <= 0 {
+		settings.FailureThreshold = DefaultFailureThreshold
+	}
+	if settings.OpenTimeout <= 0 {
+		settings.OpenTimeout = DefaultOpenTimeout
+	}
+	return &Breaker{settings: settings, log: log, now: time.Now}
+}
+
+// WithClock replaces the breaker's clock, for tests
+func (b *Breaker) WithClock(now func() time.Time) *Breaker {
+	b.now = now
+	return b
+}
+
+// Name is the name of the service the breaker guards
+func (b *Breaker) Name() string {
+	return b.settings.Name
+}
+
+// Do calls fn unless the breaker is open, in which case it returns an
+// error wrapping ErrOpen without calling it
+func (b *Breaker) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	if err := b.allow(ctx); err != nil {
+		return err
+	}
+	err := fn(ctx)
+	b.record(ctx, err)
+	return err
+}
+
+// Stats returns the breaker's state and counters
+func (b *Breaker) Stats() Stats {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return Stats{Name: b.settings.Name, State: b.currentState(), Rejected: b.rejected}
+}
+
+func (b *Breaker) allow(ctx context.Context) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.currentState() {
+	case Open:
+		b.rejected++
+		return fmt.Errorf("%s: %w", b.settings.Name, ErrOpen)
+	case HalfOpen:
+		if b.probing {
+			b.rejected++
+			return fmt.Errorf("%s: %w", b.settings.Name, ErrOpen)
+		}
+		b.transition(ctx, HalfOpen)
+		b.probing = true
+	}
+	return nil
+}
+
+func (b *Breaker) record(ctx context.Context, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	probe := b.probing
+	b.probing = false
+
+	switch {
+	case errors.Is(err, context.Canceled):
+		// The caller giving up says nothing about the service; a probe is
+		// tried again by the next call
+		return
+	case err == nil || !b.isFailure(err):
+		b.failures = 0
+		if probe {
+			b.transition(ctx, Closed)
+		}
+		return
+	}
+
+	b.failures++
+	if probe || b.failures >= b.settings.FailureThreshold {
+		b.openedAt = b.now()
+		b.transition(ctx, Open)
+	}
+}
+
+// currentState is the state, with an open breaker whose timeout passed
+// being half-open
+func (b *Breaker) currentState() State {
+	if b.state == Open && b.now().Sub(b.openedAt) >= b.settings.OpenTimeout {
+		return HalfOpen
+	}
+	return b.state
+}
+
+func (b *Breaker) transition(ctx context.Context, to State) {
+	if b.state == to {
+		return
+	}
+	fields := []logger.Field{
+		{Key: "breaker", Value: b.settings.Name},
+		{Key: "from", Value: b.state.String()},
+		{Key: "to", Value: to.String()},
+	}
+	if to == Open {
+		b.log.Warn(ctx, "circuit breaker opened", append(fields, logger.Field{Key: "failures", Value: b.failures})...)
+	} else {
+		b.log.Info(ctx, "circuit breaker state changed", fields...)
+	}
+	b.state = to
+}
+
+func (b *Breaker) isFailure(err error) bool {
+	if b.settings.IsFailure == nil {
+		return true
+	}
+	return b.settings.IsFailure(err)
+}
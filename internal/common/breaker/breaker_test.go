@@ -0,0 +1,111 @@
+package breaker_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/common/breaker"
+	"github.com/semmidev/ethos-go/internal/testutil"
+)
+
+func TestBreaker(t *testing.T) {
+	t.Parallel()
+
+	errDown := errors.New("connection refused")
+	errRejected := errors.New("invalid_grant")
+
+	Convey("Given a breaker opening after 3 failures for a minute", t, func() {
+		ctx := context.Background()
+		now := time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)
+		b := breaker.New(breaker.Settings{
+			Name:             "smtp",
+			FailureThreshold: 3,
+			OpenTimeout:      time.Minute,
+			IsFailure:        func(err error) bool { return !errors.Is(err, errRejected) },
+		}, testutil.NopLogger{}).WithClock(func() time.Time { return now })
+
+		calls := 0
+		call := func(err error) error {
+			return b.Do(ctx, func(context.Context) error {
+				calls++
+				return err
+			})
+		}
+		open := func() {
+			for range 3 {
+				call(errDown)
+			}
+		}
+
+		Convey("When calls fail, but fewer times in a row than the threshold", func() {
+			call(errDown)
+			call(errDown)
+			So(call(nil), ShouldBeNil)
+			call(errDown)
+
+			Convey("Then it stays closed", func() {
+				So(b.Stats().State, ShouldEqual, breaker.Closed)
+			})
+		})
+
+		Convey("When the service rejects requests without failing", func() {
+			for range 5 {
+				call(errRejected)
+			}
+
+			Convey("Then it stays closed", func() {
+				So(b.Stats().State, ShouldEqual, breaker.Closed)
+			})
+		})
+
+		Convey("When calls fail as many times in a row as the threshold", func() {
+			open()
+			err := call(nil)
+
+			Convey("Then further calls fail at once without reaching the service", func() {
+				So(errors.Is(err, breaker.ErrOpen), ShouldBeTrue)
+				So(calls, ShouldEqual, 3)
+				So(b.Stats().State, ShouldEqual, breaker.Open)
+				So(b.Stats().Rejected, ShouldEqual, 1)
+			})
+		})
+
+		Convey("When the open timeout has passed", func() {
+			open()
+			now = now.Add(time.Minute)
+
+			Convey("Then a single probe is let through while it is half-open", func() {
+				So(b.Stats().State, ShouldEqual, breaker.HalfOpen)
+
+				var concurrent error
+				err := b.Do(ctx, func(context.Context) error {
+					concurrent = call(nil)
+					return nil
+				})
+				So(err, ShouldBeNil)
+				So(errors.Is(concurrent, breaker.ErrOpen), ShouldBeTrue)
+			})
+
+			Convey("Then a successful probe closes it", func() {
+				So(call(nil), ShouldBeNil)
+				So(b.Stats().State, ShouldEqual, breaker.Closed)
+			})
+
+			Convey("Then a failed probe opens it again", func() {
+				So(call(errDown), ShouldEqual, errDown)
+				So(b.Stats().State, ShouldEqual, breaker.Open)
+				So(errors.Is(call(nil), breaker.ErrOpen), ShouldBeTrue)
+			})
+
+			Convey("Then a probe the caller gave up on is tried again", func() {
+				So(call(context.Canceled), ShouldEqual, context.Canceled)
+				So(call(nil), ShouldBeNil)
+				So(b.Stats().State, ShouldEqual, breaker.Closed)
+			})
+		})
+	})
+}
@@ -18,14 +18,9 @@ import (
 //
 //	database.RunMigrations(databaseURL, migrationsFS, "migrations")
 func RunMigrations(databaseURL string, fs embed.FS, path string) error {
-	source, err := iofs.New(fs, path)
-	if err != nil {
-		return fmt.Errorf("failed to create migration source: %w", err)
-	}
-
-	m, err := migrate.NewWithSourceInstance("iofs", source, databaseURL)
+	m, err := newMigrate(databaseURL, fs, path)
 	if err != nil {
-		return fmt.Errorf("failed to create migrate instance: %w", err)
+		return err
 	}
 	defer m.Close()
 
@@ -45,18 +40,65 @@ func RunMigrations(databaseURL string, fs embed.FS, path string) error {
 	return nil
 }
 
+// RollbackMigrations applies the given number of down migrations.
+// A steps value of zero or less is rejected so a typo can't wipe the schema;
+// use a large number explicitly to roll everything back.
+func RollbackMigrations(databaseURL string, fs embed.FS, path string, steps int) error {
+	if steps <= 0 {
+		return fmt.Errorf("rollback steps must be positive, got %d", steps)
+	}
+
+	m, err := newMigrate(databaseURL, fs, path)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	if err := m.Steps(-steps); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return fmt.Errorf("failed to roll back migrations: %w", err)
+	}
+
+	return nil
+}
+
+// ForceMigration sets the recorded version without running any migration
+// and clears the dirty flag. Use it to recover after a migration failed
+// halfway and the schema was repaired by hand.
+func ForceMigration(databaseURL string, fs embed.FS, path string, version int) error {
+	m, err := newMigrate(databaseURL, fs, path)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	if err := m.Force(version); err != nil {
+		return fmt.Errorf("failed to force migration version: %w", err)
+	}
+
+	return nil
+}
+
 // MigrationInfo returns the current migration version
 func MigrationInfo(databaseURL string, fs embed.FS, path string) (version uint, dirty bool, err error) {
+	m, err := newMigrate(databaseURL, fs, path)
+	if err != nil {
+		return 0, false, err
+	}
+	defer m.Close()
+
+	return m.Version()
+}
+
+func newMigrate(databaseURL string, fs embed.FS, path string) (*migrate.Migrate, error) {
 	source, err := iofs.New(fs, path)
 	if err != nil {
-		return 0, false, fmt.Errorf("failed to create migration source: %w", err)
+		return nil, fmt.Errorf("failed to create migration source: %w", err)
 	}
 
 	m, err := migrate.NewWithSourceInstance("iofs", source, databaseURL)
 	if err != nil {
-		return 0, false, fmt.Errorf("failed to create migrate instance: %w", err)
+		return nil, fmt.Errorf("failed to create migrate instance: %w", err)
 	}
-	defer m.Close()
 
-	return m.Version()
+	return m, nil
 }
@@ -0,0 +1,160 @@
+package database_test
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/golang-migrate/migrate/v4"
+	_ "github.com/golang-migrate/migrate/v4/database/stub"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/common/database"
+	"github.com/semmidev/ethos-go/migrations"
+)
+
+// stubURL selects golang-migrate's in-memory driver. Each call starts from
+// an empty schema, as every open gets a fresh instance.
+const stubURL = "stub://"
+
+// embeddedVersions maps each embedded migration version to its directions
+func embeddedVersions(t *testing.T) map[int][]string {
+	t.Helper()
+
+	files, err := fs.Glob(migrations.FS, "*.sql")
+	if err != nil {
+		t.Fatalf("list migrations: %v", err)
+	}
+
+	versions := make(map[int][]string)
+	for _, name := range files {
+		prefix, _, _ := strings.Cut(name, "_")
+		version, err := strconv.Atoi(prefix)
+		if err != nil {
+			t.Fatalf("migration %s has no numeric version", name)
+		}
+		switch {
+		case strings.HasSuffix(name, ".up.sql"):
+			versions[version] = append(versions[version], "up")
+		case strings.HasSuffix(name, ".down.sql"):
+			versions[version] = append(versions[version], "down")
+		default:
+			t.Fatalf("migration %s is neither up nor down", name)
+		}
+	}
+	return versions
+}
+
+func TestEmbeddedMigrations(t *testing.T) {
+	Convey("Given the embedded migrations", t, func() {
+		versions := embeddedVersions(t)
+		So(versions, ShouldNotBeEmpty)
+
+		Convey("Then every version can be applied and rolled back", func() {
+			for _, directions := range versions {
+				So(directions, ShouldHaveLength, 2)
+				So(directions, ShouldContain, "up")
+				So(directions, ShouldContain, "down")
+			}
+		})
+
+		Convey("Then the versions have no gaps", func() {
+			for version := 1; version <= len(versions); version++ {
+				So(versions, ShouldContainKey, version)
+			}
+		})
+	})
+}
+
+func TestMigrations(t *testing.T) {
+	Convey("Given an empty schema", t, func() {
+		Convey("When every migration is applied", func() {
+			err := database.RunMigrations(stubURL, migrations.FS, ".")
+
+			Convey("Then they all run", func() {
+				So(err, ShouldBeNil)
+			})
+		})
+
+		Convey("When its status is read", func() {
+			_, _, err := database.MigrationInfo(stubURL, migrations.FS, ".")
+
+			Convey("Then no version is recorded", func() {
+				So(errors.Is(err, migrate.ErrNilVersion), ShouldBeTrue)
+			})
+		})
+
+		Convey("When a version is forced", func() {
+			err := database.ForceMigration(stubURL, migrations.FS, ".", 3)
+
+			Convey("Then it is recorded without running anything", func() {
+				So(err, ShouldBeNil)
+			})
+		})
+
+		Convey("When it is rolled back", func() {
+			err := database.RollbackMigrations(stubURL, migrations.FS, ".", 1)
+
+			Convey("Then there is nothing to roll back", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "failed to roll back migrations")
+			})
+		})
+	})
+
+	Convey("Given a rollback of zero steps", t, func() {
+		err := database.RollbackMigrations(stubURL, migrations.FS, ".", 0)
+
+		Convey("Then it is refused before touching the database", func() {
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "rollback steps must be positive")
+		})
+	})
+
+	Convey("Given a database URL with an unknown scheme", t, func() {
+		err := database.RunMigrations("nosuchdb://localhost", migrations.FS, ".")
+
+		Convey("Then no migration is attempted", func() {
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "failed to create migrate instance")
+		})
+	})
+}
+
+// TestMigrationRoundTrip rolls the latest migration back and forth against
+// Postgres. Like the habit benchmarks, it is skipped unless ETHOS_BENCH_DSN
+// is set.
+func TestMigrationRoundTrip(t *testing.T) {
+	dsn := os.Getenv("ETHOS_BENCH_DSN")
+	if dsn == "" {
+		t.Skip("ETHOS_BENCH_DSN not set")
+	}
+	latest := uint(len(embeddedVersions(t)))
+
+	Convey("Given a fully migrated database", t, func() {
+		So(database.RunMigrations(dsn, migrations.FS, "."), ShouldBeNil)
+
+		version, dirty, err := database.MigrationInfo(dsn, migrations.FS, ".")
+		So(err, ShouldBeNil)
+		So(version, ShouldEqual, latest)
+		So(dirty, ShouldBeFalse)
+
+		Convey("When the latest migration is rolled back", func() {
+			So(database.RollbackMigrations(dsn, migrations.FS, ".", 1), ShouldBeNil)
+
+			Convey("Then the schema is one version behind, and up restores it", func() {
+				version, _, err := database.MigrationInfo(dsn, migrations.FS, ".")
+				So(err, ShouldBeNil)
+				So(version, ShouldEqual, latest-1)
+
+				So(database.RunMigrations(dsn, migrations.FS, "."), ShouldBeNil)
+				version, _, err = database.MigrationInfo(dsn, migrations.FS, ".")
+				So(err, ShouldBeNil)
+				So(version, ShouldEqual, latest)
+			})
+		})
+	})
+}
@@ -0,0 +1,37 @@
+package database
+
+import (
+	"errors"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/semmidev/ethos-go/internal/common/retry"
+)
+
+// TxRetryPolicy retries a whole transaction that Postgres aborted to
+// resolve a conflict with a concurrent one. The transaction was rolled
+// back, so running it again from the start is safe.
+var TxRetryPolicy = retry.Policy{
+	MaxAttempts:     3,
+	InitialInterval: 20 * time.Millisecond,
+	MaxInterval:     200 * time.Millisecond,
+	Retryable:       IsSerializationFailure,
+}
+
+// IsSerializationFailure reports whether err is Postgres aborting a
+// transaction over a serialization failure or a deadlock, which succeed
+// when the transaction is retried
+func IsSerializationFailure(err error) bool {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return false
+	}
+	switch pqErr.Code {
+	case "40001", // serialization_failure
+		"40P01": // deadlock_detected
+		return true
+	default:
+		return false
+	}
+}
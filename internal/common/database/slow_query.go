@@ -0,0 +1,64 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/semmidev/ethos-go/internal/common/logger"
+)
+
+// TracedOption configures a TracedDBTX
+type TracedOption func(*TracedDBTX)
+
+// WithSlowQueryLog logs every statement that takes longer than threshold as
+// a "slow_query" warning. Parameter values are never logged, only their
+// position and type, since they routinely hold emails and tokens. A zero
+// threshold disables the log.
+func WithSlowQueryLog(threshold time.Duration, log logger.Logger) TracedOption {
+	return func(t *TracedDBTX) {
+		if threshold > 0 && log != nil {
+			t.slowThreshold = threshold
+			t.log = log
+		}
+	}
+}
+
+// logIfSlow reports a statement that ran past the slow query threshold
+func (t *TracedDBTX) logIfSlow(ctx context.Context, operation, query string, args []any, err error, duration time.Duration) {
+	if t.log == nil || duration < t.slowThreshold {
+		return
+	}
+
+	trace.SpanFromContext(ctx).SetAttributes(attribute.Bool("db.slow", true))
+
+	fields := []logger.Field{
+		{Key: "operation", Value: operation},
+		{Key: "statement", Value: truncateQuery(strings.Join(strings.Fields(query), " "))},
+		{Key: "params", Value: describeArgs(args)},
+		{Key: "duration_ms", Value: duration.Milliseconds()},
+		{Key: "threshold_ms", Value: t.slowThreshold.Milliseconds()},
+	}
+	if err != nil {
+		fields = append(fields, logger.Field{Key: "error", Value: err.Error()})
+	}
+	t.log.Warn(ctx, "slow_query", fields...)
+}
+
+// describeArgs lists the statement parameters by position and type with
+// their values left out, e.g. "$1=string $2=int64 $3=NULL"
+func describeArgs(args []any) string {
+	described := make([]string, len(args))
+	for i, arg := range args {
+		kind := "NULL"
+		if arg != nil {
+			kind = fmt.Sprintf("%T", arg)
+		}
+		described[i] = fmt.Sprintf("$%d=%s", i+1, kind)
+	}
+	return strings.Join(described, " ")
+}
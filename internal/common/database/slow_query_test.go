@@ -0,0 +1,62 @@
+package database_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/common/database"
+	"github.com/semmidev/ethos-go/internal/testutil"
+)
+
+func TestSlowQueryLog(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given a traced connection with a 20ms slow query threshold", t, func() {
+		db, mock, err := sqlmock.New()
+		So(err, ShouldBeNil)
+		defer db.Close()
+
+		log := testutil.NewRecordingLogger()
+		traced := database.NewTracedDBTX(sqlx.NewDb(db, "sqlmock"), database.WithSlowQueryLog(20*time.Millisecond, log))
+		ctx := context.Background()
+
+		Convey("When a statement runs past the threshold", func() {
+			mock.ExpectExec(`UPDATE users`).
+				WithArgs("ana@example.com", 42, nil).
+				WillDelayFor(40 * time.Millisecond).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+
+			_, err := traced.ExecContext(ctx, "UPDATE users\n   SET email = $1, streak = $2, avatar = $3", "ana@example.com", 42, nil)
+			So(err, ShouldBeNil)
+
+			Convey("Then it is logged with its parameter values left out", func() {
+				entries := log.Entries()
+				So(entries, ShouldHaveLength, 1)
+				So(entries[0].Level, ShouldEqual, "warn")
+				So(entries[0].Message, ShouldEqual, "slow_query")
+
+				fields := entries[0].Fields
+				So(fields["operation"], ShouldEqual, "exec")
+				So(fields["statement"], ShouldEqual, "UPDATE users SET email = $1, streak = $2, avatar = $3")
+				So(fields["params"], ShouldEqual, "$1=string $2=int $3=NULL")
+				So(fields["duration_ms"], ShouldBeGreaterThanOrEqualTo, 40)
+				So(fields["threshold_ms"], ShouldEqual, 20)
+			})
+		})
+
+		Convey("When a statement finishes in time, nothing is logged", func() {
+			mock.ExpectExec(`DELETE FROM sessions`).WillReturnResult(sqlmock.NewResult(0, 0))
+
+			_, err := traced.ExecContext(ctx, "DELETE FROM sessions")
+			So(err, ShouldBeNil)
+			So(log.Entries(), ShouldBeEmpty)
+		})
+
+		So(mock.ExpectationsWereMet(), ShouldBeNil)
+	})
+}
@@ -9,9 +9,16 @@ import (
 	"github.com/semmidev/ethos-go/config"
 )
 
-// NewSQLXConnection creates a new sqlx database connection
+// NewSQLXConnection creates a new sqlx database connection. Every pooled
+// connection runs with the configured statement_timeout.
 func NewSQLXConnection(cfg *config.Config) (*sqlx.DB, error) {
-	db, err := sqlx.Connect("postgres", cfg.DSN())
+	dsn := cfg.DSN()
+	if cfg.DBStatementTimeout > 0 {
+		// lib/pq passes unknown parameters to the server as session settings
+		dsn += fmt.Sprintf("&statement_timeout=%d", cfg.DBStatementTimeout.Milliseconds())
+	}
+
+	db, err := sqlx.Connect("postgres", dsn)
 	if err != nil {
 		return nil, fmt.Errorf("connect to database: %w", err)
 	}
@@ -10,6 +10,9 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
+
+	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/common/observability"
 )
 
 const tracerName = "github.com/semmidev/ethos-go/database"
@@ -19,6 +22,10 @@ const tracerName = "github.com/semmidev/ethos-go/database"
 type TracedDBTX struct {
 	db     DBTX
 	tracer trace.Tracer
+
+	// slowThreshold and log are set by WithSlowQueryLog
+	slowThreshold time.Duration
+	log           logger.Logger
 }
 
 // NewTracedDBTX creates a new traced database wrapper.
@@ -27,13 +34,17 @@ type TracedDBTX struct {
 // Example:
 //
 //	db, _ := sqlx.Connect("postgres", dsn)
-//	tracedDB := database.NewTracedDBTX(db)
+//	tracedDB := database.NewTracedDBTX(db, database.WithSlowQueryLog(200*time.Millisecond, log))
 //	userRepo := adapters.NewUserPostgresRepository(tracedDB)
-func NewTracedDBTX(db DBTX) *TracedDBTX {
-	return &TracedDBTX{
+func NewTracedDBTX(db DBTX, opts ...TracedOption) *TracedDBTX {
+	t := &TracedDBTX{
 		db:     db,
 		tracer: otel.Tracer(tracerName),
 	}
+	for _, opt := range opts {
+		opt(t)
+	}
+	return t
 }
 
 // ExecContext executes a query with tracing
@@ -54,6 +65,8 @@ func (t *TracedDBTX) ExecContext(ctx context.Context, query string, args ...any)
 	duration := time.Since(start)
 
 	span.SetAttributes(attribute.Float64("db.duration_ms", float64(duration.Milliseconds())))
+	recordQuery(ctx, query, err, duration)
+	t.logIfSlow(ctx, "exec", query, args, err, duration)
 
 	if err != nil {
 		span.RecordError(err)
@@ -86,6 +99,8 @@ func (t *TracedDBTX) GetContext(ctx context.Context, dest interface{}, query str
 	duration := time.Since(start)
 
 	span.SetAttributes(attribute.Float64("db.duration_ms", float64(duration.Milliseconds())))
+	recordQuery(ctx, query, err, duration)
+	t.logIfSlow(ctx, "get", query, args, err, duration)
 
 	if err != nil && err != sql.ErrNoRows {
 		span.RecordError(err)
@@ -118,6 +133,8 @@ func (t *TracedDBTX) SelectContext(ctx context.Context, dest interface{}, query
 	duration := time.Since(start)
 
 	span.SetAttributes(attribute.Float64("db.duration_ms", float64(duration.Milliseconds())))
+	recordQuery(ctx, query, err, duration)
+	t.logIfSlow(ctx, "select", query, args, err, duration)
 
 	if err != nil {
 		span.RecordError(err)
@@ -163,6 +180,8 @@ func (t *TracedDBTX) QueryxContext(ctx context.Context, query string, args ...in
 	duration := time.Since(start)
 
 	span.SetAttributes(attribute.Float64("db.duration_ms", float64(duration.Milliseconds())))
+	recordQuery(ctx, query, err, duration)
+	t.logIfSlow(ctx, "query", query, args, err, duration)
 
 	if err != nil {
 		span.RecordError(err)
@@ -227,6 +246,8 @@ func (t *TracedDBTX) NamedExecContext(ctx context.Context, query string, arg int
 	duration := time.Since(start)
 
 	span.SetAttributes(attribute.Float64("db.duration_ms", float64(duration.Milliseconds())))
+	recordQuery(ctx, query, err, duration)
+	t.logIfSlow(ctx, "named_exec", query, []any{arg}, err, duration)
 
 	if err != nil {
 		span.RecordError(err)
@@ -246,6 +267,22 @@ func (t *TracedDBTX) Unwrap() DBTX {
 	return t.db
 }
 
+// recordQuery feeds the shared DB metrics, labeled with the statement's
+// operation and table; a missing row is not a failure.
+func recordQuery(ctx context.Context, query string, err error, duration time.Duration) {
+	m := observability.GetMetrics()
+	if m == nil {
+		return
+	}
+
+	status := "success"
+	if err != nil && err != sql.ErrNoRows {
+		status = "error"
+	}
+	operation, table := observability.QueryLabels(query)
+	m.RecordDBQuery(ctx, operation, table, status, duration)
+}
+
 func truncateQuery(query string) string {
 	const maxLen = 500
 	if len(query) > maxLen {
@@ -0,0 +1,65 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type txKey struct{}
+
+// WithTx returns a context carrying tx. Stores that write alongside a
+// module's repositories, such as the event outbox, look it up with
+// FromContext so their writes commit or roll back with the transaction.
+func WithTx(ctx context.Context, tx DBTX) context.Context {
+	return context.WithValue(ctx, txKey{}, tx)
+}
+
+// FromContext returns the transaction carried by ctx, or db when there is
+// none
+func FromContext(ctx context.Context, db DBTX) DBTX {
+	if tx, ok := ctx.Value(txKey{}).(DBTX); ok {
+		return tx
+	}
+	return db
+}
+
+// InTx runs fn in a transaction on db, committing when it succeeds and
+// rolling back when it fails. When db is a transaction already, fn runs in
+// it and the caller commits.
+func InTx(ctx context.Context, db DBTX, fn func(tx DBTX) error) (err error) {
+	if traced, ok := db.(*TracedDBTX); ok {
+		db = traced.Unwrap()
+	}
+	if tx, ok := db.(*sqlx.Tx); ok {
+		return fn(tx)
+	}
+	conn, ok := db.(*sqlx.DB)
+	if !ok {
+		return errors.New("InTx: db must be *sqlx.DB or *sqlx.Tx")
+	}
+
+	tx, err := conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	if err := fn(tx); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return errors.Join(err, fmt.Errorf("rollback: %w", rbErr))
+		}
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+// Package dateutil parses the dates and timestamps clients send and formats
+// dates for responses. Parsing is strict: a value that is not exactly a
+// calendar date (or an RFC 3339 timestamp where one is accepted) is a
+// validation error naming the offending field, never silently dropped.
+package dateutil
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/i18n"
+)
+
+// DateLayout is the wire format of calendar dates
+const DateLayout = "2006-01-02"
+
+// ParseDate parses a YYYY-MM-DD date as midnight UTC
+func ParseDate(field, value string) (time.Time, error) {
+	t, err := time.Parse(DateLayout, value)
+	if err != nil {
+		return time.Time{}, apperror.InvalidInput(field, "must be a date in YYYY-MM-DD format")
+	}
+	return t, nil
+}
+
+// ParseOptionalDate parses value like ParseDate and returns nil when it is
+// unset
+func ParseOptionalDate(field string, value *string) (*time.Time, error) {
+	if value == nil {
+		return nil, nil
+	}
+	t, err := ParseDate(field, *value)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// ParseTimestamp parses an RFC 3339 timestamp, with or without fractional
+// seconds. A bare YYYY-MM-DD date is accepted as midnight UTC.
+func ParseTimestamp(field, value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(DateLayout, value); err == nil {
+		return t, nil
+	}
+	return time.Time{}, apperror.InvalidInput(field, "must be an RFC 3339 timestamp or a date in YYYY-MM-DD format")
+}
+
+// FormatDate formats the calendar date of t in DateLayout
+func FormatDate(t time.Time) string {
+	return t.Format(DateLayout)
+}
+
+// FormatOptionalDate formats t like FormatDate and returns nil when it is
+// unset
+func FormatOptionalDate(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := FormatDate(*t)
+	return &s
+}
+
+// monthNames holds month names for locales whose names differ from English
+var monthNames = map[string][12]string{
+	i18n.Indonesian: {
+		"Januari", "Februari", "Maret", "April", "Mei", "Juni",
+		"Juli", "Agustus", "September", "Oktober", "November", "Desember",
+	},
+}
+
+// FormatLongDate formats the calendar date of t for people reading locale,
+// e.g. "October 17, 2026" in English and "17 Oktober 2026" in Indonesian.
+// Unsupported locales use English.
+func FormatLongDate(t time.Time, locale string) string {
+	switch i18n.Normalize(locale) {
+	case i18n.Indonesian:
+		return fmt.Sprintf("%d %s %d", t.Day(), monthNames[i18n.Indonesian][t.Month()-1], t.Year())
+	default:
+		return t.Format("January 2, 2006")
+	}
+}
@@ -0,0 +1,92 @@
+package dateutil_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/dateutil"
+)
+
+func TestParseDate(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given a date sent by a client", t, func() {
+		Convey("Then a calendar date parses as midnight UTC", func() {
+			d, err := dateutil.ParseDate("log_date", "2026-02-28")
+			So(err, ShouldBeNil)
+			So(d, ShouldEqual, time.Date(2026, 2, 28, 0, 0, 0, 0, time.UTC))
+		})
+
+		Convey("Then anything else is an invalid input naming the field", func() {
+			for _, value := range []string{"", "2026-2-28", "28/02/2026", "2026-02-30", "2026-02-28T10:00:00Z", " 2026-02-28"} {
+				_, err := dateutil.ParseDate("log_date", value)
+
+				var appErr *apperror.AppError
+				So(errors.As(err, &appErr), ShouldBeTrue)
+				So(appErr.Code, ShouldEqual, apperror.ErrCodeInvalidInput)
+				So(appErr.Details["field"], ShouldEqual, "log_date")
+			}
+		})
+
+		Convey("Then an unset optional date stays unset", func() {
+			d, err := dateutil.ParseOptionalDate("end_date", nil)
+			So(err, ShouldBeNil)
+			So(d, ShouldBeNil)
+
+			bad := "tomorrow"
+			_, err = dateutil.ParseOptionalDate("end_date", &bad)
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestParseTimestamp(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given a timestamp sent by a client", t, func() {
+		Convey("Then RFC 3339 with or without fractional seconds parses", func() {
+			ts, err := dateutil.ParseTimestamp("since", "2026-10-17T08:30:00+07:00")
+			So(err, ShouldBeNil)
+			So(ts.UTC(), ShouldEqual, time.Date(2026, 10, 17, 1, 30, 0, 0, time.UTC))
+
+			ts, err = dateutil.ParseTimestamp("since", "2026-10-17T01:30:00.250Z")
+			So(err, ShouldBeNil)
+			So(ts.Nanosecond(), ShouldEqual, 250_000_000)
+		})
+
+		Convey("Then a bare date is midnight UTC", func() {
+			ts, err := dateutil.ParseTimestamp("since", "2026-10-17")
+			So(err, ShouldBeNil)
+			So(ts, ShouldEqual, time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC))
+		})
+
+		Convey("Then a timestamp without a zone is rejected", func() {
+			_, err := dateutil.ParseTimestamp("since", "2026-10-17 08:30:00")
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestFormatDate(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given a date", t, func() {
+		d := time.Date(2026, 10, 7, 15, 4, 5, 0, time.UTC)
+
+		Convey("Then it is written in the wire format", func() {
+			So(dateutil.FormatDate(d), ShouldEqual, "2026-10-07")
+			So(dateutil.FormatOptionalDate(nil), ShouldBeNil)
+			So(*dateutil.FormatOptionalDate(&d), ShouldEqual, "2026-10-07")
+		})
+
+		Convey("Then it is written for people in their language", func() {
+			So(dateutil.FormatLongDate(d, "en"), ShouldEqual, "October 7, 2026")
+			So(dateutil.FormatLongDate(d, "id-ID"), ShouldEqual, "7 Oktober 2026")
+			So(dateutil.FormatLongDate(d, "fr"), ShouldEqual, "October 7, 2026")
+		})
+	})
+}
@@ -0,0 +1,118 @@
+package email
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/mail"
+	"strconv"
+	"time"
+
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/httputil"
+)
+
+// TestEmailRequest names the mailbox that receives the test email
+type TestEmailRequest struct {
+	To string `json:"to"`
+}
+
+// TestEmailResult reports how the provider handled the test email
+type TestEmailResult struct {
+	Provider  string `json:"provider"`
+	Recipient string `json:"recipient"`
+	LatencyMs int64  `json:"latency_ms"`
+	Response  string `json:"response"`
+}
+
+const testEmailBody = `<p>This is a test email from %s.</p>
+<p>If you can read this, outgoing email is configured correctly.</p>
+<p>Sent at %s.</p>`
+
+// SendTestEmailHandler sends a test email through sender so operators can
+// check the provider configuration before users depend on it. provider
+// describes the sender in the response, e.g. "smtp://smtp.example.com:587".
+// Failures are reported as 502 with the provider's error and the latency.
+func SendTestEmailHandler(sender Email, appName, provider string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req TestEmailRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			httputil.Error(w, r, apperror.ValidationFailed("invalid request body"))
+			return
+		}
+		if _, err := mail.ParseAddress(req.To); err != nil {
+			httputil.Error(w, r, apperror.InvalidInput("to", "must be an email address"))
+			return
+		}
+
+		now := time.Now()
+		subject := fmt.Sprintf("%s test email", appName)
+		body := fmt.Sprintf(testEmailBody, appName, now.UTC().Format(time.RFC1123))
+
+		err := sender.Send(r.Context(), TypeTest, req.To, subject, body, nil)
+		latency := time.Since(now).Milliseconds()
+		if err != nil {
+			httputil.Error(w, r, apperror.ExternalServiceError("email", err).
+				WithDetails("provider", provider).
+				WithDetails("latency_ms", latency).
+				WithDetails("response", err.Error()))
+			return
+		}
+
+		httputil.Success(w, r, TestEmailResult{
+			Provider:  provider,
+			Recipient: req.To,
+			LatencyMs: latency,
+			Response:  "accepted",
+		}, "Test email sent")
+	})
+}
+
+const (
+	defaultEmailLogLimit = 50
+	maxEmailLogLimit     = 200
+)
+
+// EmailLogLister lists entries from the email log
+type EmailLogLister interface {
+	List(ctx context.Context, filter LogFilter) ([]LogEntry, error)
+}
+
+// ListEmailLogHandler lists attempted emails, newest first, so support can
+// check whether an email went out. Entries can be filtered by recipient
+// address (hashed before the lookup), type and status.
+func ListEmailLogHandler(logs EmailLogLister) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+
+		filter := LogFilter{
+			EmailType: query.Get("type"),
+			Status:    query.Get("status"),
+			Limit:     defaultEmailLogLimit,
+		}
+		if recipient := query.Get("recipient"); recipient != "" {
+			filter.RecipientHash = HashRecipient(recipient)
+		}
+		if filter.Status != "" && filter.Status != StatusSent && filter.Status != StatusFailed {
+			httputil.Error(w, r, apperror.InvalidInput("status", "must be one of sent, failed"))
+			return
+		}
+		if v := query.Get("limit"); v != "" {
+			limit, err := strconv.Atoi(v)
+			if err != nil || limit < 1 || limit > maxEmailLogLimit {
+				httputil.Error(w, r, apperror.InvalidInput("limit", "must be between 1 and 200"))
+				return
+			}
+			filter.Limit = limit
+		}
+
+		entries, err := logs.List(r.Context(), filter)
+		if err != nil {
+			httputil.Error(w, r, err)
+			return
+		}
+
+		httputil.Success(w, r, entries, "Email log retrieved")
+	})
+}
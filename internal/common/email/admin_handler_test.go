@@ -0,0 +1,89 @@
+package email_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/email"
+	"github.com/semmidev/ethos-go/internal/common/httputil"
+)
+
+// fakeSender records the last email and fails with err when set
+type fakeSender struct {
+	err       error
+	recipient string
+}
+
+func (s *fakeSender) Send(_ context.Context, _, recipient, _, _ string, _ any) error {
+	s.recipient = recipient
+	return s.err
+}
+
+type testEmailResponse struct {
+	Success bool                  `json:"success"`
+	Data    email.TestEmailResult `json:"data"`
+	Error   httputil.ErrorBody    `json:"error"`
+}
+
+func sendTestEmail(sender email.Email, body string) (int, testEmailResponse) {
+	handler := email.SendTestEmailHandler(sender, "Ethos", "smtp://smtp.example.com:587")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/admin/email/test", strings.NewReader(body)))
+
+	var resp testEmailResponse
+	So(json.Unmarshal(w.Body.Bytes(), &resp), ShouldBeNil)
+	return w.Code, resp
+}
+
+func TestSendTestEmailHandler(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given a working email provider", t, func() {
+		sender := &fakeSender{}
+
+		Convey("When an operator sends a test email", func() {
+			code, resp := sendTestEmail(sender, `{"to": "ops@example.com"}`)
+
+			Convey("Then it is sent and the provider is reported", func() {
+				So(code, ShouldEqual, http.StatusOK)
+				So(sender.recipient, ShouldEqual, "ops@example.com")
+				So(resp.Data.Provider, ShouldEqual, "smtp://smtp.example.com:587")
+				So(resp.Data.Recipient, ShouldEqual, "ops@example.com")
+			})
+		})
+
+		Convey("When the recipient is not an email address", func() {
+			code, resp := sendTestEmail(sender, `{"to": "ops"}`)
+
+			Convey("Then nothing is sent", func() {
+				So(code, ShouldEqual, http.StatusBadRequest)
+				So(resp.Error.Code, ShouldEqual, apperror.ErrCodeInvalidInput)
+				So(sender.recipient, ShouldBeEmpty)
+			})
+		})
+	})
+
+	Convey("Given a misconfigured email provider", t, func() {
+		sender := &fakeSender{err: errors.New("535 5.7.8 Username and Password not accepted")}
+
+		Convey("When an operator sends a test email", func() {
+			code, resp := sendTestEmail(sender, `{"to": "ops@example.com"}`)
+
+			Convey("Then the provider's response is returned as a bad gateway", func() {
+				So(code, ShouldEqual, http.StatusBadGateway)
+				So(resp.Error.Code, ShouldEqual, apperror.ErrCodeExternalServiceError)
+				So(resp.Error.Details["response"], ShouldContainSubstring, "Username and Password not accepted")
+				So(resp.Error.Details["provider"], ShouldEqual, "smtp://smtp.example.com:587")
+				So(resp.Error.Details, ShouldContainKey, "latency_ms")
+			})
+		})
+	})
+}
@@ -0,0 +1,31 @@
+package email
+
+import (
+	"context"
+
+	"github.com/semmidev/ethos-go/internal/common/breaker"
+)
+
+type guardedEmail struct {
+	next    Email
+	breaker *breaker.Breaker
+}
+
+// Guarded sends through next behind b, so while the mail server keeps
+// failing sends fail at once, and the tasks sending them are retried
+// later, instead of each waiting on it
+func Guarded(next Email, b *breaker.Breaker) Email {
+	if next == nil {
+		panic("nil email sender")
+	}
+	if b == nil {
+		panic("nil circuit breaker")
+	}
+	return &guardedEmail{next: next, breaker: b}
+}
+
+func (g *guardedEmail) Send(ctx context.Context, emailType, recipient, subject, htmlContent string, data any) error {
+	return g.breaker.Do(ctx, func(ctx context.Context) error {
+		return g.next.Send(ctx, emailType, recipient, subject, htmlContent, data)
+	})
+}
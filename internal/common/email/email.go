@@ -1,5 +1,16 @@
 package email
 
+import "context"
+
+// Types of emails not rendered from a template. Templated emails use their
+// template name as their type.
+const (
+	TypeTest     = "test"
+	TypeOpsAlert = "ops-alert"
+)
+
+// Email sends an email. emailType says what the email is for, e.g. its
+// template name, so sends can be audited per type.
 type Email interface {
-	Send(recipient, subject string, htmlContent string, data any) error
+	Send(ctx context.Context, emailType, recipient, subject, htmlContent string, data any) error
 }
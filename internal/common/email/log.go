@@ -0,0 +1,146 @@
+package email
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/semmidev/ethos-go/internal/common/database"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+)
+
+// Outcomes of a send recorded in the email log
+const (
+	StatusSent   = "sent"
+	StatusFailed = "failed"
+)
+
+// LogEntry is one attempted email in the email log
+type LogEntry struct {
+	ID            uuid.UUID `db:"id" json:"id"`
+	EmailType     string    `db:"email_type" json:"type"`
+	RecipientHash string    `db:"recipient_hash" json:"recipient_hash"`
+	Provider      string    `db:"provider" json:"provider"`
+	Status        string    `db:"status" json:"status"`
+	Error         *string   `db:"error" json:"error,omitempty"`
+	LatencyMs     int64     `db:"latency_ms" json:"latency_ms"`
+	CreatedAt     time.Time `db:"created_at" json:"created_at"`
+}
+
+// LogFilter narrows a listing of the email log. Empty fields match
+// everything.
+type LogFilter struct {
+	RecipientHash string
+	EmailType     string
+	Status        string
+	Limit         int
+}
+
+// HashRecipient returns the hash the email log stores in place of an
+// address. Addresses are trimmed and lowercased first, so any spelling of
+// an address finds its entries.
+func HashRecipient(recipient string) string {
+	sum := sha256.Sum256([]byte(strings.ToLower(strings.TrimSpace(recipient))))
+	return hex.EncodeToString(sum[:])
+}
+
+// LogRepository reads and writes the email log
+type LogRepository struct {
+	db database.DBTX
+}
+
+// NewLogRepository creates a new email log repository
+func NewLogRepository(db database.DBTX) *LogRepository {
+	return &LogRepository{db: db}
+}
+
+// Insert records an attempted email
+func (r *LogRepository) Insert(ctx context.Context, entry LogEntry) error {
+	query := `
+		INSERT INTO email_log (email_type, recipient_hash, provider, status, error, latency_ms)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+	_, err := r.db.ExecContext(ctx, query,
+		entry.EmailType,
+		entry.RecipientHash,
+		entry.Provider,
+		entry.Status,
+		entry.Error,
+		entry.LatencyMs,
+	)
+	return err
+}
+
+// List returns the entries matching filter, newest first
+func (r *LogRepository) List(ctx context.Context, filter LogFilter) ([]LogEntry, error) {
+	query := `
+		SELECT id, email_type, recipient_hash, provider, status, error, latency_ms, created_at
+		FROM email_log
+		WHERE ($1 = '' OR recipient_hash = $1)
+		  AND ($2 = '' OR email_type = $2)
+		  AND ($3 = '' OR status = $3)
+		ORDER BY created_at DESC
+		LIMIT $4
+	`
+	entries := []LogEntry{}
+	err := r.db.SelectContext(ctx, &entries, query,
+		filter.RecipientHash, filter.EmailType, filter.Status, filter.Limit)
+	return entries, err
+}
+
+// LogWriter stores email log entries
+type LogWriter interface {
+	Insert(ctx context.Context, entry LogEntry) error
+}
+
+type auditedEmail struct {
+	next     Email
+	log      LogWriter
+	provider string
+	logger   logger.Logger
+}
+
+// Audited records every email sent through next in the email log, whether
+// or not it was delivered. A failure to write the log is logged but never
+// fails the send.
+func Audited(next Email, log LogWriter, provider string, l logger.Logger) Email {
+	if next == nil {
+		panic("nil email sender")
+	}
+	if log == nil {
+		panic("nil email log")
+	}
+	if l == nil {
+		panic("nil logger")
+	}
+	return &auditedEmail{next: next, log: log, provider: provider, logger: l}
+}
+
+func (a *auditedEmail) Send(ctx context.Context, emailType, recipient, subject, htmlContent string, data any) error {
+	start := time.Now()
+	err := a.next.Send(ctx, emailType, recipient, subject, htmlContent, data)
+
+	entry := LogEntry{
+		EmailType:     emailType,
+		RecipientHash: HashRecipient(recipient),
+		Provider:      a.provider,
+		Status:        StatusSent,
+		LatencyMs:     time.Since(start).Milliseconds(),
+	}
+	if err != nil {
+		msg := err.Error()
+		entry.Status = StatusFailed
+		entry.Error = &msg
+	}
+	// The send has already happened, so the entry is written even if the
+	// caller's context was cancelled meanwhile
+	if logErr := a.log.Insert(context.WithoutCancel(ctx), entry); logErr != nil {
+		a.logger.Error(ctx, logErr, "failed to write email log",
+			logger.Field{Key: "email_type", Value: emailType})
+	}
+
+	return err
+}
@@ -0,0 +1,136 @@
+package email_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/email"
+	"github.com/semmidev/ethos-go/internal/common/httputil"
+	"github.com/semmidev/ethos-go/internal/testutil"
+)
+
+// fakeLog keeps email log entries in memory and fails writes with err
+type fakeLog struct {
+	err     error
+	entries []email.LogEntry
+	filter  email.LogFilter
+}
+
+func (l *fakeLog) Insert(_ context.Context, entry email.LogEntry) error {
+	if l.err != nil {
+		return l.err
+	}
+	l.entries = append(l.entries, entry)
+	return nil
+}
+
+func (l *fakeLog) List(_ context.Context, filter email.LogFilter) ([]email.LogEntry, error) {
+	l.filter = filter
+	return l.entries, nil
+}
+
+func TestAudited(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given an audited email sender", t, func() {
+		sender := &fakeSender{}
+		log := &fakeLog{}
+		audited := email.Audited(sender, log, "smtp://smtp.example.com:587", testutil.NopLogger{})
+
+		Convey("When an email is delivered", func() {
+			err := audited.Send(context.Background(), "email-forgot-password", " Ada@Example.com", "Reset", "<p>reset</p>", nil)
+
+			Convey("Then it is logged as sent without the address", func() {
+				So(err, ShouldBeNil)
+				So(log.entries, ShouldHaveLength, 1)
+				entry := log.entries[0]
+				So(entry.EmailType, ShouldEqual, "email-forgot-password")
+				So(entry.Status, ShouldEqual, email.StatusSent)
+				So(entry.Provider, ShouldEqual, "smtp://smtp.example.com:587")
+				So(entry.Error, ShouldBeNil)
+				So(entry.RecipientHash, ShouldEqual, email.HashRecipient("ada@example.com"))
+				So(entry.RecipientHash, ShouldNotContainSubstring, "ada")
+			})
+		})
+
+		Convey("When the provider rejects an email", func() {
+			sender.err = errors.New("550 mailbox unavailable")
+			err := audited.Send(context.Background(), "verification", "ada@example.com", "Verify", "<p>verify</p>", nil)
+
+			Convey("Then it is logged as failed with the provider's error", func() {
+				So(err, ShouldEqual, sender.err)
+				So(log.entries, ShouldHaveLength, 1)
+				So(log.entries[0].Status, ShouldEqual, email.StatusFailed)
+				So(*log.entries[0].Error, ShouldEqual, "550 mailbox unavailable")
+			})
+		})
+
+		Convey("When the log cannot be written", func() {
+			log.err = errors.New("connection refused")
+			err := audited.Send(context.Background(), "welcome", "ada@example.com", "Welcome", "<p>hi</p>", nil)
+
+			Convey("Then the email is still sent", func() {
+				So(err, ShouldBeNil)
+				So(sender.recipient, ShouldEqual, "ada@example.com")
+			})
+		})
+	})
+}
+
+type emailLogResponse struct {
+	Data  []email.LogEntry   `json:"data"`
+	Error httputil.ErrorBody `json:"error"`
+}
+
+func listEmailLog(log *fakeLog, query string) (int, emailLogResponse) {
+	w := httptest.NewRecorder()
+	email.ListEmailLogHandler(log).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/admin/emails"+query, nil))
+
+	var resp emailLogResponse
+	So(json.Unmarshal(w.Body.Bytes(), &resp), ShouldBeNil)
+	return w.Code, resp
+}
+
+func TestListEmailLogHandler(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given an email log", t, func() {
+		log := &fakeLog{entries: []email.LogEntry{{EmailType: "email-forgot-password", Status: email.StatusSent}}}
+
+		Convey("When support looks up a recipient", func() {
+			code, resp := listEmailLog(log, "?recipient=Ada@Example.com&type=email-forgot-password&limit=10")
+
+			Convey("Then the entries for the hashed address are returned", func() {
+				So(code, ShouldEqual, http.StatusOK)
+				So(resp.Data, ShouldHaveLength, 1)
+				So(log.filter.RecipientHash, ShouldEqual, email.HashRecipient("ada@example.com"))
+				So(log.filter.EmailType, ShouldEqual, "email-forgot-password")
+				So(log.filter.Limit, ShouldEqual, 10)
+			})
+		})
+
+		Convey("When the status is unknown", func() {
+			code, resp := listEmailLog(log, "?status=bounced")
+
+			Convey("Then the request is rejected", func() {
+				So(code, ShouldEqual, http.StatusBadRequest)
+				So(resp.Error.Code, ShouldEqual, apperror.ErrCodeInvalidInput)
+			})
+		})
+
+		Convey("When the limit is too large", func() {
+			code, _ := listEmailLog(log, "?limit=1000")
+
+			Convey("Then the request is rejected", func() {
+				So(code, ShouldEqual, http.StatusBadRequest)
+			})
+		})
+	})
+}
@@ -0,0 +1,54 @@
+package email
+
+import (
+	"net/http"
+
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/httputil"
+	"github.com/semmidev/ethos-go/internal/common/i18n"
+)
+
+// PreviewSample returns example data for a template in locale
+type PreviewSample func(locale string) any
+
+// PreviewHandler renders templates with sample data so copy changes can be
+// checked in a browser. ?name=welcome&locale=id renders one template and
+// the subject is sent in the X-Email-Subject header; without a name it
+// lists the templates that have samples. Mount it outside production only.
+func PreviewHandler(renderer *Renderer, samples map[string]PreviewSample) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := r.URL.Query().Get("name")
+		if name == "" {
+			names := make([]string, 0, len(samples))
+			for _, n := range TemplateNames {
+				if _, ok := samples[n]; ok {
+					names = append(names, n)
+				}
+			}
+			httputil.Success(w, r, map[string]any{"templates": names}, "Email templates")
+			return
+		}
+
+		sample, ok := samples[name]
+		if !ok {
+			httputil.Error(w, r, apperror.NotFound("email template", name))
+			return
+		}
+
+		locale := r.URL.Query().Get("locale")
+		if locale == "" {
+			locale = i18n.DefaultLocale
+		}
+
+		msg, err := renderer.Render(name, locale, sample(locale))
+		if err != nil {
+			httputil.Error(w, r, apperror.InternalError(err))
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Header().Set("X-Email-Subject", msg.Subject)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(msg.HTML))
+	})
+}
@@ -0,0 +1,115 @@
+package email
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"html"
+	"html/template"
+	"io/fs"
+	"strings"
+	"sync"
+
+	"github.com/semmidev/ethos-go/internal/common/i18n"
+)
+
+// Templates holds the transactional email templates. Each template defines
+// a "subject" and an "htmlBody" block.
+//
+//go:embed "template"
+var Templates embed.FS
+
+// Names of the templates in Templates
+const (
+	TemplateVerification   = "email-verification"
+	TemplateForgotPassword = "email-forgot-password"
+	TemplateMagicLink      = "email-magic-link"
+	TemplateWelcome        = "welcome"
+	TemplateWeeklyReport   = "weekly-report"
+	TemplateReengagement   = "reengagement"
+)
+
+// TemplateNames lists every template in Templates
+var TemplateNames = []string{
+	TemplateVerification,
+	TemplateForgotPassword,
+	TemplateMagicLink,
+	TemplateWelcome,
+	TemplateWeeklyReport,
+	TemplateReengagement,
+}
+
+// Message is a rendered email
+type Message struct {
+	Subject string
+	HTML    string
+}
+
+// Renderer renders email templates in the recipient's locale. A locale
+// variant template/<name>.<locale>.tmpl replaces template/<name>.tmpl when
+// present; otherwise the shared template is rendered with T bound to the
+// locale.
+type Renderer struct {
+	files fs.FS
+
+	mu    sync.Mutex
+	cache map[string]*template.Template
+}
+
+func NewRenderer(files fs.FS) *Renderer {
+	return &Renderer{
+		files: files,
+		cache: make(map[string]*template.Template),
+	}
+}
+
+// Render executes the named template with data. Unsupported locales fall
+// back to the default locale.
+func (r *Renderer) Render(name, locale string, data any) (Message, error) {
+	if !i18n.IsSupported(locale) {
+		locale = i18n.DefaultLocale
+	}
+
+	tpl, err := r.lookup(name, locale)
+	if err != nil {
+		return Message{}, err
+	}
+
+	var subject, body bytes.Buffer
+	if err := tpl.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return Message{}, fmt.Errorf("failed to execute %s subject: %w", name, err)
+	}
+	if err := tpl.ExecuteTemplate(&body, "htmlBody", data); err != nil {
+		return Message{}, fmt.Errorf("failed to execute %s template: %w", name, err)
+	}
+
+	// The subject is a header, not HTML, so undo html/template's escaping
+	return Message{
+		Subject: html.UnescapeString(strings.TrimSpace(subject.String())),
+		HTML:    body.String(),
+	}, nil
+}
+
+// lookup parses the template for name in locale once and caches it
+func (r *Renderer) lookup(name, locale string) (*template.Template, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := name + "." + locale
+	if tpl, ok := r.cache[key]; ok {
+		return tpl, nil
+	}
+
+	path := "template/" + key + ".tmpl"
+	if _, err := fs.Stat(r.files, path); err != nil {
+		path = "template/" + name + ".tmpl"
+	}
+
+	tpl, err := template.New("").Funcs(i18n.FuncMap(locale)).ParseFS(r.files, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s template: %w", name, err)
+	}
+
+	r.cache[key] = tpl
+	return tpl, nil
+}
@@ -0,0 +1,128 @@
+package email_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"testing/fstest"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/common/email"
+)
+
+type welcomeData struct {
+	Locale string
+	Name   string
+	From   string
+	AppURL string
+}
+
+func TestRenderer(t *testing.T) {
+	Convey("Given the embedded templates", t, func() {
+		renderer := email.NewRenderer(email.Templates)
+
+		Convey("Every template defines a subject and a body", func() {
+			data := map[string]any{
+				"Locale": "en", "Name": "Sam", "From": "Ethos", "AppURL": "https://ethos.example.com",
+				"VerificationCode": "123456", "VerificationCodeExpiration": 15,
+				"UnsubscribeURL": "https://ethos.example.com/unsubscribe", "AverageCompletion": 50,
+				"Days": []any{}, "BestStreaks": []any{}, "MostMissed": []any{},
+				"DaysInactive": 3, "HabitName": "Read", "Streak": 4,
+				"LoginLink": "https://ethos.example.com/auth/magic-link?token=abc", "Expiration": 15,
+			}
+			for _, name := range email.TemplateNames {
+				for _, locale := range []string{"en", "id"} {
+					msg, err := renderer.Render(name, locale, data)
+
+					So(err, ShouldBeNil)
+					So(msg.Subject, ShouldNotBeEmpty)
+					So(msg.HTML, ShouldContainSubstring, "<html")
+				}
+			}
+		})
+
+		Convey("The welcome email renders in the recipient's locale", func() {
+			data := welcomeData{Locale: "id", Name: "Sam", From: "Ethos", AppURL: "https://ethos.example.com"}
+
+			msg, err := renderer.Render(email.TemplateWelcome, "id", data)
+
+			So(err, ShouldBeNil)
+			So(msg.Subject, ShouldEqual, "Selamat datang di Ethos, Sam!")
+			So(msg.HTML, ShouldContainSubstring, `href="https://ethos.example.com"`)
+		})
+
+		Convey("Unsupported locales fall back to English", func() {
+			msg, err := renderer.Render(email.TemplateWelcome, "xx", welcomeData{Name: "Sam"})
+
+			So(err, ShouldBeNil)
+			So(msg.Subject, ShouldEqual, "Welcome to Ethos, Sam!")
+		})
+
+		Convey("Subjects are not HTML-escaped", func() {
+			msg, err := renderer.Render(email.TemplateWelcome, "en", welcomeData{Name: "Sam & Alex"})
+
+			So(err, ShouldBeNil)
+			So(msg.Subject, ShouldEqual, "Welcome to Ethos, Sam & Alex!")
+		})
+	})
+
+	Convey("Given a template with a locale variant", t, func() {
+		files := fstest.MapFS{
+			"template/greeting.tmpl":    {Data: []byte(`{{define "subject"}}{{T "Hello, %s" .}}{{end}}{{define "htmlBody"}}shared{{end}}`)},
+			"template/greeting.id.tmpl": {Data: []byte(`{{define "subject"}}Halo{{end}}{{define "htmlBody"}}variant{{end}}`)},
+		}
+		renderer := email.NewRenderer(files)
+
+		Convey("The variant is rendered for its locale", func() {
+			msg, err := renderer.Render("greeting", "id", "Sam")
+
+			So(err, ShouldBeNil)
+			So(msg.Subject, ShouldEqual, "Halo")
+			So(msg.HTML, ShouldEqual, "variant")
+		})
+
+		Convey("Other locales render the shared template", func() {
+			msg, err := renderer.Render("greeting", "en", "Sam")
+
+			So(err, ShouldBeNil)
+			So(msg.Subject, ShouldEqual, "Hello, Sam")
+			So(msg.HTML, ShouldEqual, "shared")
+		})
+	})
+}
+
+func TestPreviewHandler(t *testing.T) {
+	Convey("Given the preview handler", t, func() {
+		handler := email.PreviewHandler(email.NewRenderer(email.Templates), map[string]email.PreviewSample{
+			email.TemplateWelcome: func(locale string) any {
+				return welcomeData{Locale: locale, Name: "Sam", From: "Ethos"}
+			},
+		})
+
+		Convey("A template renders as HTML with its subject in a header", func() {
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/dev/emails?name=welcome&locale=id", nil))
+
+			So(w.Code, ShouldEqual, http.StatusOK)
+			So(w.Header().Get("Content-Type"), ShouldStartWith, "text/html")
+			So(w.Header().Get("X-Email-Subject"), ShouldEqual, "Selamat datang di Ethos, Sam!")
+			So(w.Body.String(), ShouldContainSubstring, `<html lang="id">`)
+		})
+
+		Convey("Templates without a sample are not found", func() {
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/dev/emails?name=weekly-report", nil))
+
+			So(w.Code, ShouldEqual, http.StatusNotFound)
+		})
+
+		Convey("Without a name the previewable templates are listed", func() {
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/dev/emails", nil))
+
+			So(w.Code, ShouldEqual, http.StatusOK)
+			So(w.Body.String(), ShouldContainSubstring, `"templates":["welcome"]`)
+		})
+	})
+}
@@ -1,7 +1,10 @@
 package email
 
 import (
+	"context"
 	"fmt"
+	"net"
+	"strconv"
 
 	"github.com/semmidev/ethos-go/config"
 	"github.com/semmidev/ethos-go/internal/common/logger"
@@ -25,7 +28,12 @@ func NewSMTPClient(cfg *config.Config, l logger.Logger) (*SMTPClient, error) {
 	}, nil
 }
 
-func (s *SMTPClient) Send(recipient, subject string, htmlContent string, data any) error {
+// Provider describes the SMTP server emails are sent through
+func (s *SMTPClient) Provider() string {
+	return "smtp://" + net.JoinHostPort(s.cfg.SMTPHost, strconv.Itoa(s.cfg.SMTPPort))
+}
+
+func (s *SMTPClient) Send(_ context.Context, _, recipient, subject, htmlContent string, _ any) error {
 	m := mail.NewMessage()
 
 	m.SetHeader("From", m.FormatAddress(s.cfg.SMTPUser, s.cfg.AppName))
@@ -0,0 +1,297 @@
+// Package errreport sends internal errors and recovered panics to an error
+// tracking service such as Sentry or Rollbar.
+//
+// Reports are tagged with the release they happened in and the user,
+// request and trace they belong to. They are sent in the background so a
+// slow or unreachable service never holds up a request; when reports come
+// in faster than they can be sent, the excess is dropped.
+package errreport
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+
+	"github.com/semmidev/ethos-go/config"
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+)
+
+// Providers selectable with ERROR_REPORTER
+const (
+	ProviderSentry  = "sentry"
+	ProviderRollbar = "rollbar"
+)
+
+// queueSize is how many reports may wait to be sent
+const queueSize = 64
+
+// Release identifies the build an error happened in
+type Release struct {
+	Version     string
+	Commit      string
+	Environment string
+}
+
+// Frame is one call in a stack trace, innermost last
+type Frame struct {
+	Function string
+	File     string
+	Line     int
+}
+
+// Event is an error report as handed to a Transport
+type Event struct {
+	ID      string
+	Time    time.Time
+	Release Release
+
+	// Type classifies the error for grouping, e.g. "*pq.Error" or "panic"
+	Type    string
+	Message string
+	Stack   []Frame
+
+	UserID    string
+	RequestID string
+	TraceID   string
+	// Method and URL are set for HTTP requests, RPC for gRPC calls
+	Method string
+	URL    string
+	RPC    string
+}
+
+// Transport delivers events to an error tracking service
+type Transport interface {
+	Send(ctx context.Context, event *Event) error
+}
+
+// Reporter builds events from errors and sends them in the background. A
+// nil or disabled Reporter ignores every report.
+type Reporter struct {
+	transport Transport
+	release   Release
+	userID    func(ctx context.Context) string
+	log       logger.Logger
+
+	queue     chan *Event
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// New creates the reporter selected by the configuration; with no provider
+// configured it returns a disabled Reporter. userID resolves the
+// authenticated user of a request context, "" if none.
+func New(cfg *config.Config, release Release, userID func(ctx context.Context) string, log logger.Logger) (*Reporter, error) {
+	var transport Transport
+	switch cfg.ErrorReporter {
+	case "":
+		return &Reporter{}, nil
+	case ProviderSentry:
+		sentry, err := NewSentryTransport(cfg.SentryDSN)
+		if err != nil {
+			return nil, err
+		}
+		transport = sentry
+	case ProviderRollbar:
+		transport = NewRollbarTransport(cfg.RollbarToken)
+	default:
+		return nil, fmt.Errorf("unknown error reporter %q", cfg.ErrorReporter)
+	}
+	return NewReporter(transport, release, userID, log), nil
+}
+
+// NewReporter creates a reporter that sends through transport
+func NewReporter(transport Transport, release Release, userID func(ctx context.Context) string, log logger.Logger) *Reporter {
+	if transport == nil {
+		panic("nil transport")
+	}
+	if log == nil {
+		panic("nil logger")
+	}
+
+	r := &Reporter{
+		transport: transport,
+		release:   release,
+		userID:    userID,
+		log:       log,
+		queue:     make(chan *Event, queueSize),
+		done:      make(chan struct{}),
+	}
+	go r.run()
+	return r
+}
+
+// Report sends err with the request context found in ctx
+func (r *Reporter) Report(ctx context.Context, err error) {
+	if r == nil || r.transport == nil || err == nil {
+		return
+	}
+	event := r.newEvent(ctx, errorType(err), err.Error(), callers(3))
+	r.enqueue(ctx, event)
+}
+
+// ReportRequest sends err with the details of the HTTP request it failed
+func (r *Reporter) ReportRequest(req *http.Request, err error) {
+	if r == nil || r.transport == nil || err == nil {
+		return
+	}
+	event := r.newEvent(req.Context(), errorType(err), err.Error(), callers(3))
+	event.Method = req.Method
+	event.URL = req.URL.Path
+	r.enqueue(req.Context(), event)
+}
+
+// ReportPanic sends a value recovered from a panic. It must be called from
+// the deferred function that recovered, so the stack still shows where the
+// panic happened.
+func (r *Reporter) ReportPanic(ctx context.Context, recovered any) {
+	if r == nil || r.transport == nil {
+		return
+	}
+	event := r.newEvent(ctx, "panic", fmt.Sprint(recovered), callers(3))
+	r.enqueue(ctx, event)
+}
+
+// Close sends the reports still queued, giving up when ctx is done
+func (r *Reporter) Close(ctx context.Context) {
+	if r == nil || r.transport == nil {
+		return
+	}
+	r.closeOnce.Do(func() { close(r.queue) })
+	select {
+	case <-r.done:
+	case <-ctx.Done():
+	}
+}
+
+func (r *Reporter) newEvent(ctx context.Context, errType, message string, stack []Frame) *Event {
+	event := &Event{
+		ID:        newEventID(),
+		Time:      time.Now().UTC(),
+		Release:   r.release,
+		Type:      errType,
+		Message:   message,
+		Stack:     stack,
+		RequestID: middleware.GetReqID(ctx),
+	}
+	if r.userID != nil {
+		event.UserID = r.userID(ctx)
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		event.TraceID = sc.TraceID().String()
+	}
+	if method, ok := grpc.Method(ctx); ok {
+		event.RPC = method
+	}
+	return event
+}
+
+func (r *Reporter) enqueue(ctx context.Context, event *Event) {
+	defer func() {
+		// The queue is closed during shutdown; late reports are dropped
+		_ = recover()
+	}()
+
+	select {
+	case r.queue <- event:
+	default:
+		r.log.Warn(ctx, "error report dropped, queue full",
+			logger.Field{Key: "event_id", Value: event.ID},
+		)
+	}
+}
+
+func (r *Reporter) run() {
+	defer close(r.done)
+	for event := range r.queue {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		if err := r.transport.Send(ctx, event); err != nil {
+			r.log.Error(ctx, err, "failed to send error report",
+				logger.Field{Key: "event_id", Value: event.ID},
+			)
+		}
+		cancel()
+	}
+}
+
+// errorType names the kind of err for grouping: the code of an application
+// error, otherwise the Go type of the innermost wrapped error
+func errorType(err error) string {
+	if appErr := apperror.GetAppError(err); appErr != nil && appErr.Code != "" {
+		return "apperror." + appErr.Code
+	}
+	for {
+		next := errors.Unwrap(err)
+		if next == nil {
+			return fmt.Sprintf("%T", err)
+		}
+		err = next
+	}
+}
+
+// callers returns the stack from the frame skip counts up to, as for
+// runtime.Callers, innermost last and without runtime frames. Reporter
+// methods pass 3 to start at whoever called them.
+func callers(skip int) []Frame {
+	pcs := make([]uintptr, 64)
+	n := runtime.Callers(skip, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+
+	var stack []Frame
+	for {
+		frame, more := frames.Next()
+		if !strings.HasPrefix(frame.Function, "runtime.") {
+			stack = append(stack, Frame{Function: frame.Function, File: frame.File, Line: frame.Line})
+		}
+		if !more {
+			break
+		}
+	}
+
+	for i, j := 0, len(stack)-1; i < j; i, j = i+1, j-1 {
+		stack[i], stack[j] = stack[j], stack[i]
+	}
+	return stack
+}
+
+func newEventID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
+
+var defaultReporter atomic.Pointer[Reporter]
+
+// SetDefault makes r the reporter used by the package-level functions,
+// which shared code such as the HTTP and gRPC error helpers reports through
+func SetDefault(r *Reporter) {
+	defaultReporter.Store(r)
+}
+
+// Report sends err through the default reporter
+func Report(ctx context.Context, err error) {
+	defaultReporter.Load().Report(ctx, err)
+}
+
+// ReportRequest sends err for an HTTP request through the default reporter
+func ReportRequest(req *http.Request, err error) {
+	defaultReporter.Load().ReportRequest(req, err)
+}
+
+// ReportPanic sends a recovered panic through the default reporter. Like
+// Reporter.ReportPanic, it must be called from the recovering function.
+func ReportPanic(ctx context.Context, recovered any) {
+	defaultReporter.Load().ReportPanic(ctx, recovered)
+}
@@ -0,0 +1,161 @@
+package errreport_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/errreport"
+	"github.com/semmidev/ethos-go/internal/testutil"
+)
+
+type userKey struct{}
+
+// recordingTransport keeps the events it is sent
+type recordingTransport struct {
+	mu     sync.Mutex
+	events []*errreport.Event
+}
+
+func (t *recordingTransport) Send(_ context.Context, event *errreport.Event) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.events = append(t.events, event)
+	return nil
+}
+
+func newReporter(transport errreport.Transport) *errreport.Reporter {
+	release := errreport.Release{Version: "1.4.0", Commit: "abc123", Environment: "production"}
+	userID := func(ctx context.Context) string {
+		id, _ := ctx.Value(userKey{}).(string)
+		return id
+	}
+	return errreport.NewReporter(transport, release, userID, testutil.NopLogger{})
+}
+
+func flush(r *errreport.Reporter) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	r.Close(ctx)
+}
+
+func TestReporter(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given a reporter", t, func() {
+		transport := &recordingTransport{}
+		reporter := newReporter(transport)
+
+		Convey("When a request fails with an internal error", func() {
+			req := httptest.NewRequest(http.MethodPost, "/v1/habits?secret=x", nil)
+			req = req.WithContext(context.WithValue(req.Context(), userKey{}, "user-1"))
+			cause := apperror.InternalError(fmt.Errorf("load habit: %w", errors.New("connection reset")))
+
+			reporter.ReportRequest(req, cause)
+			flush(reporter)
+
+			Convey("Then the event carries the release, user and request", func() {
+				So(transport.events, ShouldHaveLength, 1)
+				event := transport.events[0]
+				So(event.ID, ShouldHaveLength, 32)
+				So(event.Release.Commit, ShouldEqual, "abc123")
+				So(event.UserID, ShouldEqual, "user-1")
+				So(event.Method, ShouldEqual, http.MethodPost)
+				So(event.URL, ShouldEqual, "/v1/habits")
+				So(event.Message, ShouldContainSubstring, "connection reset")
+				So(event.Stack[len(event.Stack)-1].Function, ShouldContainSubstring, "TestReporter")
+			})
+		})
+
+		Convey("When a gRPC handler panics, the panic is reported and the call fails", func() {
+			errreport.SetDefault(reporter)
+			defer errreport.SetDefault(nil)
+
+			intercept := errreport.UnaryServerInterceptor()
+			_, err := intercept(context.Background(), nil, &grpc.UnaryServerInfo{}, func(context.Context, any) (any, error) {
+				panic("nil map")
+			})
+			flush(reporter)
+
+			So(status.Code(err), ShouldEqual, codes.Internal)
+			So(transport.events, ShouldHaveLength, 1)
+			So(transport.events[0].Type, ShouldEqual, "panic")
+			So(transport.events[0].Message, ShouldEqual, "nil map")
+		})
+	})
+
+	Convey("Given a disabled reporter", t, func() {
+		var reporter *errreport.Reporter
+
+		Convey("Then reports are ignored", func() {
+			So(func() { reporter.Report(context.Background(), errors.New("boom")) }, ShouldNotPanic)
+		})
+	})
+}
+
+func TestSentryTransport(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given a Sentry project", t, func() {
+		var auth, path string
+		var lines []map[string]any
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			auth, path = r.Header.Get("X-Sentry-Auth"), r.URL.Path
+			scanner := bufio.NewScanner(r.Body)
+			for scanner.Scan() {
+				var line map[string]any
+				_ = json.Unmarshal(scanner.Bytes(), &line)
+				lines = append(lines, line)
+			}
+		}))
+		defer server.Close()
+
+		dsn := "http://publickey@" + server.Listener.Addr().String() + "/42"
+		transport, err := errreport.NewSentryTransport(dsn)
+		So(err, ShouldBeNil)
+
+		Convey("When an event is sent, it arrives as an envelope for the project", func() {
+			err := transport.Send(context.Background(), &errreport.Event{
+				ID:      "0123456789abcdef0123456789abcdef",
+				Time:    time.Date(2026, 10, 1, 8, 0, 0, 0, time.UTC),
+				Release: errreport.Release{Version: "1.4.0", Commit: "abc123", Environment: "production"},
+				Type:    "*net.OpError",
+				Message: "connection reset",
+				UserID:  "user-1",
+				TraceID: "4bf92f3577b34da6a3ce929d0e0e4736",
+			})
+			So(err, ShouldBeNil)
+
+			So(path, ShouldEqual, "/api/42/envelope/")
+			So(auth, ShouldContainSubstring, "sentry_key=publickey")
+			So(lines, ShouldHaveLength, 3)
+			So(lines[1]["type"], ShouldEqual, "event")
+
+			event := lines[2]
+			So(event["release"], ShouldEqual, "1.4.0")
+			So(event["user"], ShouldResemble, map[string]any{"id": "user-1"})
+			So(event["tags"], ShouldResemble, map[string]any{
+				"commit":   "abc123",
+				"trace_id": "4bf92f3577b34da6a3ce929d0e0e4736",
+			})
+		})
+
+		Convey("Then a DSN without a project is rejected", func() {
+			_, err := errreport.NewSentryTransport("https://publickey@sentry.example.com/")
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
@@ -0,0 +1,96 @@
+package errreport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"time"
+)
+
+// RollbarEndpoint is Rollbar's item API
+const RollbarEndpoint = "https://api.rollbar.com/api/1/item/"
+
+// RollbarTransport sends events to Rollbar with a project access token
+type RollbarTransport struct {
+	endpoint string
+	token    string
+	client   *http.Client
+}
+
+// NewRollbarTransport creates a transport for a post_server_item token
+func NewRollbarTransport(token string) *RollbarTransport {
+	return &RollbarTransport{
+		endpoint: RollbarEndpoint,
+		token:    token,
+		client:   &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// WithEndpoint sends to endpoint instead of RollbarEndpoint
+func (t *RollbarTransport) WithEndpoint(endpoint string) *RollbarTransport {
+	t.endpoint = endpoint
+	return t
+}
+
+// Send implements Transport
+func (t *RollbarTransport) Send(ctx context.Context, event *Event) error {
+	payload, err := json.Marshal(map[string]any{"data": rollbarItem(event)})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Rollbar-Access-Token", t.token)
+
+	return send(t.client, req, "rollbar")
+}
+
+func rollbarItem(event *Event) map[string]any {
+	frames := make([]map[string]any, len(event.Stack))
+	for i, f := range event.Stack {
+		frames[i] = map[string]any{"filename": f.File, "lineno": f.Line, "method": f.Function}
+	}
+
+	custom := map[string]string{}
+	if event.RequestID != "" {
+		custom["request_id"] = event.RequestID
+	}
+	if event.TraceID != "" {
+		custom["trace_id"] = event.TraceID
+	}
+	if event.RPC != "" {
+		custom["rpc"] = event.RPC
+	}
+
+	host, _ := os.Hostname()
+	item := map[string]any{
+		"uuid":         event.ID,
+		"timestamp":    event.Time.Unix(),
+		"environment":  event.Release.Environment,
+		"level":        "error",
+		"platform":     "go",
+		"language":     "go",
+		"code_version": event.Release.Commit,
+		"server":       map[string]string{"host": host, "code_version": event.Release.Version},
+		"custom":       custom,
+		"body": map[string]any{
+			"trace": map[string]any{
+				"frames":    frames,
+				"exception": map[string]string{"class": event.Type, "message": event.Message},
+			},
+		},
+	}
+	if event.UserID != "" {
+		item["person"] = map[string]string{"id": event.UserID}
+	}
+	if event.Method != "" {
+		item["request"] = map[string]string{"method": event.Method, "url": event.URL}
+	}
+	return item
+}
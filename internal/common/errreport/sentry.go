@@ -0,0 +1,144 @@
+package errreport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// SentryTransport sends events to Sentry's envelope endpoint
+type SentryTransport struct {
+	endpoint string
+	key      string
+	dsn      string
+	client   *http.Client
+}
+
+// NewSentryTransport creates a transport for a project DSN such as
+// https://<key>@o1.ingest.sentry.io/<project>
+func NewSentryTransport(dsn string) (*SentryTransport, error) {
+	u, err := url.Parse(dsn)
+	if err != nil || u.User == nil || u.User.Username() == "" || u.Host == "" {
+		return nil, errors.New("invalid sentry dsn")
+	}
+	path := strings.Trim(u.Path, "/")
+	slash := strings.LastIndexByte(path, '/')
+	prefix, project := "", path
+	if slash >= 0 {
+		prefix, project = "/"+path[:slash], path[slash+1:]
+	}
+	if project == "" {
+		return nil, errors.New("invalid sentry dsn: missing project id")
+	}
+
+	return &SentryTransport{
+		endpoint: fmt.Sprintf("%s://%s%s/api/%s/envelope/", u.Scheme, u.Host, prefix, project),
+		key:      u.User.Username(),
+		dsn:      dsn,
+		client:   &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+// Send implements Transport
+func (t *SentryTransport) Send(ctx context.Context, event *Event) error {
+	payload, err := json.Marshal(sentryEvent(event))
+	if err != nil {
+		return err
+	}
+	header, err := json.Marshal(map[string]string{
+		"event_id": event.ID,
+		"sent_at":  time.Now().UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		return err
+	}
+	item, err := json.Marshal(map[string]any{"type": "event", "length": len(payload)})
+	if err != nil {
+		return err
+	}
+
+	var body bytes.Buffer
+	for _, line := range [][]byte{header, item, payload} {
+		body.Write(line)
+		body.WriteByte('\n')
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.endpoint, &body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-sentry-envelope")
+	req.Header.Set("X-Sentry-Auth", "Sentry sentry_version=7, sentry_client=ethos-go/1.0, sentry_key="+t.key)
+
+	return send(t.client, req, "sentry")
+}
+
+func sentryEvent(event *Event) map[string]any {
+	frames := make([]map[string]any, len(event.Stack))
+	for i, f := range event.Stack {
+		frames[i] = map[string]any{
+			"function": f.Function,
+			"abs_path": f.File,
+			"lineno":   f.Line,
+			"in_app":   strings.HasPrefix(f.Function, "github.com/semmidev/ethos-go/"),
+		}
+	}
+
+	tags := map[string]string{"commit": event.Release.Commit}
+	if event.RequestID != "" {
+		tags["request_id"] = event.RequestID
+	}
+	if event.TraceID != "" {
+		tags["trace_id"] = event.TraceID
+	}
+	if event.RPC != "" {
+		tags["rpc"] = event.RPC
+	}
+
+	out := map[string]any{
+		"event_id":    event.ID,
+		"timestamp":   event.Time.Format(time.RFC3339Nano),
+		"platform":    "go",
+		"level":       "error",
+		"release":     event.Release.Version,
+		"environment": event.Release.Environment,
+		"tags":        tags,
+		"exception": map[string]any{
+			"values": []map[string]any{{
+				"type":       event.Type,
+				"value":      event.Message,
+				"stacktrace": map[string]any{"frames": frames},
+			}},
+		},
+	}
+	if event.UserID != "" {
+		out["user"] = map[string]string{"id": event.UserID}
+	}
+	if event.Method != "" {
+		out["request"] = map[string]string{"method": event.Method, "url": event.URL}
+	}
+	return out
+}
+
+// send posts req and turns a non-2xx response into an error
+func send(client *http.Client, req *http.Request, service string) error {
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("%s responded %d: %s", service, resp.StatusCode, bytes.TrimSpace(msg))
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return nil
+}
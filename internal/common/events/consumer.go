@@ -2,29 +2,28 @@ package events
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/nats-io/nats.go"
 	"github.com/nats-io/nats.go/jetstream"
 	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/common/observability"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
-// Handler processes a specific type of event
-type Handler interface {
-	// Handle processes the event
-	Handle(ctx context.Context, data []byte) error
-	// EventType returns the event type this handler processes
-	EventType() string
-}
-
 // Consumer subscribes to and processes domain events
 type Consumer struct {
 	nc       *nats.Conn
 	js       jetstream.JetStream
 	stream   jetstream.Stream
-	handlers map[string]Handler
+	consumer jetstream.Consumer
+	handlers map[string][]Handler
+	registry *Registry
+	name     string
 	logger   logger.Logger
 	ctx      context.Context
 	cancel   context.CancelFunc
@@ -38,6 +37,10 @@ type ConsumerConfig struct {
 	MaxDeliver    int
 	AckWait       time.Duration
 	FilterSubject string
+
+	// Registry validates each event against its schema before it is
+	// handled; nil skips validation
+	Registry *Registry
 }
 
 // NewConsumer creates a new event consumer
@@ -77,16 +80,20 @@ func NewConsumer(ctx context.Context, cfg ConsumerConfig, log logger.Logger) (*C
 		nc:       nc,
 		js:       js,
 		stream:   stream,
-		handlers: make(map[string]Handler),
+		handlers: make(map[string][]Handler),
+		registry: cfg.Registry,
 		logger:   log,
 		ctx:      consumerCtx,
 		cancel:   cancel,
 	}, nil
 }
 
-// RegisterHandler registers a handler for a specific event type
+// RegisterHandler registers a handler for a specific event type. Several
+// handlers may share a type; they run in registration order and a failure
+// in any of them redelivers the event to all, so handlers must be
+// idempotent.
 func (c *Consumer) RegisterHandler(h Handler) {
-	c.handlers[h.EventType()] = h
+	c.handlers[h.EventType()] = append(c.handlers[h.EventType()], h)
 	c.logger.Info(c.ctx, "registered event handler",
 		logger.Field{Key: "event_type", Value: h.EventType()},
 	)
@@ -107,6 +114,8 @@ func (c *Consumer) Start(ctx context.Context, consumerName, queueGroup string) e
 	if err != nil {
 		return fmt.Errorf("create consumer: %w", err)
 	}
+	c.consumer = consumer
+	c.name = consumerName
 
 	c.logger.Info(ctx, "starting event consumer",
 		logger.Field{Key: "consumer", Value: consumerName},
@@ -136,7 +145,28 @@ func (c *Consumer) handleMessage(ctx context.Context, msg jetstream.Msg) {
 	subject := msg.Subject()
 	eventType := subject[len(SubjectPrefix)+1:] // Remove "ethos." prefix
 
-	handler, ok := c.handlers[eventType]
+	// Continue the publisher's trace
+	ctx = observability.ContextWithHeaders(ctx, msg.Headers())
+	ctx, span := observability.Tracer("events").Start(ctx, "event.handle "+eventType,
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			attribute.String("messaging.system", "nats"),
+			attribute.String("messaging.destination", subject),
+			attribute.String("event.type", eventType),
+		),
+	)
+	defer span.End()
+
+	// Replays target a single consumer
+	if target := msg.Headers().Get(ReplayHeader); target != "" {
+		span.SetAttributes(attribute.String("event.replay_for", target))
+		if target != c.name {
+			msg.Ack()
+			return
+		}
+	}
+
+	handlers, ok := c.handlers[eventType]
 	if !ok {
 		c.logger.Debug(ctx, "no handler for event type",
 			logger.Field{Key: "event_type", Value: eventType},
@@ -147,15 +177,43 @@ func (c *Consumer) handleMessage(ctx context.Context, msg jetstream.Msg) {
 		return
 	}
 
+	env, err := c.open(msg.Data())
+	if err != nil {
+		c.logger.Error(ctx, err, "rejected malformed event",
+			logger.Field{Key: "event_type", Value: eventType},
+		)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		recordHandled(ctx, eventType, "invalid", time.Time{})
+		// Redelivery cannot fix the payload
+		msg.Term()
+		return
+	}
+	span.SetAttributes(
+		attribute.Int("event.schema_version", env.SchemaVersion),
+		attribute.String("event.producer", env.Producer),
+	)
+
 	// Process the event
-	if err := handler.Handle(ctx, msg.Data()); err != nil {
+	ctx = WithEnvelope(ctx, env)
+	var errs []error
+	for _, handler := range handlers {
+		if err := handler.Handle(ctx, env.Data); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
 		c.logger.Error(ctx, err, "failed to handle event",
 			logger.Field{Key: "event_type", Value: eventType},
 		)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		recordHandled(ctx, eventType, "error", env.OccurredAt())
 		// Nak for redelivery
 		msg.Nak()
 		return
 	}
+	recordHandled(ctx, eventType, "success", env.OccurredAt())
 
 	// Acknowledge successful processing
 	msg.Ack()
@@ -164,18 +222,42 @@ func (c *Consumer) handleMessage(ctx context.Context, msg jetstream.Msg) {
 	)
 }
 
+// Lag reports messages not yet delivered to this consumer and messages
+// delivered but not yet acknowledged. It fails until Start has been called.
+func (c *Consumer) Lag(ctx context.Context) (pending, ackPending uint64, err error) {
+	if c.consumer == nil {
+		return 0, 0, errors.New("consumer not started")
+	}
+
+	info, err := c.consumer.Info(ctx)
+	if err != nil {
+		return 0, 0, fmt.Errorf("consumer info: %w", err)
+	}
+	return info.NumPending, uint64(info.NumAckPending), nil
+}
+
+// open unwraps the event envelope, validating it when a registry is set
+func (c *Consumer) open(data []byte) (*Envelope, error) {
+	if c.registry == nil {
+		return OpenEnvelope(data)
+	}
+	return c.registry.Open(data)
+}
+
+// recordHandled feeds the end-to-end latency histogram using the
+// envelope's occurred_at timestamp.
+func recordHandled(ctx context.Context, eventType, status string, occurredAt time.Time) {
+	m := observability.GetMetrics()
+	if m == nil {
+		return
+	}
+
+	m.RecordEventHandled(ctx, eventType, status, occurredAt)
+}
+
 // Close stops the consumer and closes the connection
 func (c *Consumer) Close() error {
 	c.cancel()
 	c.nc.Close()
 	return nil
 }
-
-// ParseEvent is a helper to unmarshal event data
-func ParseEvent[T any](data []byte) (*T, error) {
-	var event T
-	if err := json.Unmarshal(data, &event); err != nil {
-		return nil, fmt.Errorf("unmarshal event: %w", err)
-	}
-	return &event, nil
-}
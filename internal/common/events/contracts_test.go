@@ -0,0 +1,55 @@
+package events_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	habitevents "github.com/semmidev/ethos-go/contracts/events/habits"
+	"github.com/semmidev/ethos-go/internal/common/events"
+)
+
+func TestContractHandlers(t *testing.T) {
+	Convey("Given a registry with the habits schemas", t, func() {
+		registry := events.NewRegistry()
+		habitevents.RegisterSchemas(registry)
+		ctx := context.Background()
+
+		logDate := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+		published := habitevents.NewHabitCompletionCorrected("habit-1", "user-1", "log-1", logDate, -1, 2)
+
+		env, err := registry.Seal(ctx, "ethos-api", published)
+		So(err, ShouldBeNil)
+		raw, err := json.Marshal(env)
+		So(err, ShouldBeNil)
+		opened, err := registry.Open(raw)
+		So(err, ShouldBeNil)
+
+		Convey("The typed handler receives the event as it was published", func() {
+			var received *habitevents.HabitCompleted
+			handler := habitevents.OnHabitCompleted(func(_ context.Context, event *habitevents.HabitCompleted) error {
+				received = event
+				return nil
+			})
+
+			So(handler.EventType(), ShouldEqual, habitevents.HabitCompletedType)
+			So(handler.Handle(ctx, opened.Data), ShouldBeNil)
+			So(received.EventID(), ShouldEqual, published.EventID())
+			So(received.HabitID, ShouldEqual, "habit-1")
+			So(received.LogDate.Equal(logDate), ShouldBeTrue)
+			So(received.Count, ShouldEqual, -1)
+			So(received.Correction, ShouldBeTrue)
+		})
+
+		Convey("Any habits event can be read for its common fields", func() {
+			event, err := habitevents.ParseHabitEvent(opened.Data)
+
+			So(err, ShouldBeNil)
+			So(event.EventType(), ShouldEqual, habitevents.HabitCompletedType)
+			So(event.UserID, ShouldEqual, "user-1")
+		})
+	})
+}
@@ -0,0 +1,98 @@
+package events
+
+import (
+	"context"
+
+	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/common/observability"
+)
+
+type envelopeKey struct{}
+
+// WithEnvelope stores the envelope of the event being handled
+func WithEnvelope(ctx context.Context, env *Envelope) context.Context {
+	return context.WithValue(ctx, envelopeKey{}, env)
+}
+
+// EnvelopeFromContext returns the envelope of the event being handled, so
+// handlers can branch on SchemaVersion while a new version rolls out
+func EnvelopeFromContext(ctx context.Context) (*Envelope, bool) {
+	env, ok := ctx.Value(envelopeKey{}).(*Envelope)
+	return env, ok
+}
+
+// EnvelopePublisher seals events into envelopes before handing them to the
+// next publisher, rejecting events that do not match their schema. Callers
+// often ignore publish errors, so rejections are also logged.
+type EnvelopePublisher struct {
+	next     Publisher
+	registry *Registry
+	producer string
+	logger   logger.Logger
+}
+
+var _ Publisher = (*EnvelopePublisher)(nil)
+
+// NewEnvelopePublisher wraps next; producer names the service publishing,
+// e.g. "ethos-api"
+func NewEnvelopePublisher(next Publisher, registry *Registry, producer string, log logger.Logger) *EnvelopePublisher {
+	return &EnvelopePublisher{
+		next:     next,
+		registry: registry,
+		producer: producer,
+		logger:   log,
+	}
+}
+
+func (p *EnvelopePublisher) Publish(ctx context.Context, event Event) error {
+	env, err := p.seal(ctx, event)
+	if err != nil {
+		return err
+	}
+	return p.next.Publish(ctx, env)
+}
+
+func (p *EnvelopePublisher) PublishAll(ctx context.Context, events []Event) error {
+	sealed := make([]Event, 0, len(events))
+	for _, event := range events {
+		env, err := p.seal(ctx, event)
+		if err != nil {
+			return err
+		}
+		sealed = append(sealed, env)
+	}
+	return p.next.PublishAll(ctx, sealed)
+}
+
+func (p *EnvelopePublisher) seal(ctx context.Context, event Event) (*Envelope, error) {
+	env, err := p.registry.Seal(ctx, p.producer, event)
+	if err != nil {
+		p.logger.Error(ctx, err, "rejected event that does not match its schema",
+			logger.Field{Key: "event_type", Value: event.EventType()},
+			logger.Field{Key: "event_id", Value: event.EventID()},
+		)
+		return nil, err
+	}
+	return env, nil
+}
+
+func (p *EnvelopePublisher) Close() error {
+	return p.next.Close()
+}
+
+// seal wraps data, already validated as version of event's schema
+func seal(ctx context.Context, producer string, event Event, version int, data []byte) *Envelope {
+	return &Envelope{
+		BaseEvent: BaseEvent{
+			ID:          event.EventID(),
+			Type:        event.EventType(),
+			Occurred:    event.OccurredAt(),
+			AggregateId: event.AggregateID(),
+			AggType:     event.AggregateType(),
+		},
+		SchemaVersion: version,
+		Producer:      producer,
+		TraceContext:  observability.TraceCarrier(ctx),
+		Data:          data,
+	}
+}
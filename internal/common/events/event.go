@@ -1,57 +1,47 @@
 package events
 
 import (
-	"time"
+	"context"
 
-	"github.com/semmidev/ethos-go/internal/common/random"
+	contractevents "github.com/semmidev/ethos-go/contracts/events"
 )
 
-// Event is the base interface for all domain events
-type Event interface {
-	// EventID returns a unique identifier for this event instance
-	EventID() string
-	// EventType returns the type of event (e.g., "user.registered")
-	EventType() string
-	// OccurredAt returns when the event occurred
-	OccurredAt() time.Time
-	// AggregateID returns the ID of the aggregate that produced this event
-	AggregateID() string
-	// AggregateType returns the type of the aggregate (e.g., "user", "habit")
-	AggregateType() string
+// The event types are defined in the contracts module, so services outside
+// this repository decode the same structs; they are aliased here for the
+// modules publishing and consuming them.
+type (
+	// Event is the base interface for all domain events
+	Event = contractevents.Event
+	// BaseEvent provides common implementation for all events
+	BaseEvent = contractevents.BaseEvent
+	// EventMetadata contains optional metadata for events
+	EventMetadata = contractevents.EventMetadata
+	// Envelope is the wire format of every published event
+	Envelope = contractevents.Envelope
+	// Handler processes a specific type of event
+	Handler = contractevents.Handler
+	// TypedHandler is a Handler that decodes each event into T
+	TypedHandler[T any] = contractevents.TypedHandler[T]
+)
+
+// NewBaseEvent creates a new base event with auto-generated ID and current timestamp
+func NewBaseEvent(eventType, aggregateType, aggregateID string) BaseEvent {
+	return contractevents.NewBaseEvent(eventType, aggregateType, aggregateID)
 }
 
-// BaseEvent provides common implementation for all events
-type BaseEvent struct {
-	ID          string    `json:"event_id"`
-	Type        string    `json:"event_type"`
-	Occurred    time.Time `json:"occurred_at"`
-	AggregateId string    `json:"aggregate_id"`
-	AggType     string    `json:"aggregate_type"`
+// OpenEnvelope decodes a published event without validating its data
+func OpenEnvelope(raw []byte) (*Envelope, error) {
+	return contractevents.OpenEnvelope(raw)
 }
 
-// NewBaseEvent creates a new base event with auto-generated ID and current timestamp
-func NewBaseEvent(eventType, aggregateType, aggregateID string) BaseEvent {
-	return BaseEvent{
-		ID:          random.NewUUID().String(),
-		Type:        eventType,
-		Occurred:    time.Now().UTC(),
-		AggregateId: aggregateID,
-		AggType:     aggregateType,
-	}
+// ParseEvent is a helper to unmarshal event data
+func ParseEvent[T any](data []byte) (*T, error) {
+	return contractevents.ParseEvent[T](data)
 }
 
-func (e BaseEvent) EventID() string       { return e.ID }
-func (e BaseEvent) EventType() string     { return e.Type }
-func (e BaseEvent) OccurredAt() time.Time { return e.Occurred }
-func (e BaseEvent) AggregateID() string   { return e.AggregateId }
-func (e BaseEvent) AggregateType() string { return e.AggType }
-
-// EventMetadata contains optional metadata for events
-type EventMetadata struct {
-	CorrelationID string            `json:"correlation_id,omitempty"`
-	CausationID   string            `json:"causation_id,omitempty"`
-	UserID        string            `json:"user_id,omitempty"`
-	Source        string            `json:"source,omitempty"`
-	Version       int               `json:"version,omitempty"`
-	Tags          map[string]string `json:"tags,omitempty"`
+// NewTypedHandler creates a handler for eventType
+func NewTypedHandler[T any](eventType string, handle func(ctx context.Context, event *T) error) *TypedHandler[T] {
+	return contractevents.NewTypedHandler(eventType, handle)
 }
+
+var _ contractevents.SchemaRegistry = (*Registry)(nil)
@@ -2,10 +2,11 @@ package handlers
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 
-	"github.com/semmidev/ethos-go/internal/common/events"
+	authevents "github.com/semmidev/ethos-go/contracts/events/auth"
+	habitevents "github.com/semmidev/ethos-go/contracts/events/habits"
+	"github.com/semmidev/ethos-go/internal/common/email"
+	"github.com/semmidev/ethos-go/internal/common/i18n"
 	"github.com/semmidev/ethos-go/internal/common/logger"
 	"github.com/semmidev/ethos-go/internal/common/ports"
 	notifDomain "github.com/semmidev/ethos-go/internal/notifications/domain"
@@ -16,30 +17,51 @@ import (
 //   - Listens to Auth module events
 //   - Uses UserProvider interface to access user data (owned by Auth)
 //   - Creates notifications in Notifications module
+//   - Sends the welcome email
 type UserRegisteredHandler struct {
 	logger       logger.Logger
 	userProvider ports.UserProvider                 // From Auth module (via interface)
 	notifRepo    notifDomain.NotificationRepository // From Notifications module
+	email        email.Email
+	templates    *email.Renderer
+	from         string
+	appURL       string
 }
 
 func NewUserRegisteredHandler(
 	log logger.Logger,
 	userProvider ports.UserProvider,
 	notifRepo notifDomain.NotificationRepository,
+	sender email.Email,
+	templates *email.Renderer,
+	from string,
+	appURL string,
 ) *UserRegisteredHandler {
 	return &UserRegisteredHandler{
 		logger:       log,
 		userProvider: userProvider,
 		notifRepo:    notifRepo,
+		email:        sender,
+		templates:    templates,
+		from:         from,
+		appURL:       appURL,
 	}
 }
 
+// WelcomeEmail is the data rendered into the welcome email
+type WelcomeEmail struct {
+	Locale string
+	Name   string
+	From   string
+	AppURL string
+}
+
 func (h *UserRegisteredHandler) EventType() string {
-	return "auth.user.registered"
+	return authevents.UserRegisteredType
 }
 
 func (h *UserRegisteredHandler) Handle(ctx context.Context, data []byte) error {
-	event, err := events.ParseEvent[UserRegisteredEvent](data)
+	event, err := authevents.ParseUserRegistered(data)
 	if err != nil {
 		return err
 	}
@@ -64,8 +86,8 @@ func (h *UserRegisteredHandler) Handle(ctx context.Context, data []byte) error {
 		notification, err := notifDomain.NewNotification(
 			userInfo.UserID,
 			notifDomain.TypeWelcome,
-			fmt.Sprintf("Welcome to Ethos, %s!", userInfo.Name),
-			"Start building better habits today. Create your first habit to get started!",
+			i18n.T(userInfo.Locale, "Welcome to Ethos, %s!", userInfo.Name),
+			i18n.T(userInfo.Locale, "Start building better habits today. Create your first habit to get started!"),
 			map[string]interface{}{
 				"user_email":    userInfo.Email,
 				"user_timezone": userInfo.Timezone,
@@ -86,21 +108,40 @@ func (h *UserRegisteredHandler) Handle(ctx context.Context, data []byte) error {
 			logger.Field{Key: "user_id", Value: userInfo.UserID},
 			logger.Field{Key: "notification_id", Value: notification.ID},
 		)
+
+		// Example 3: Send the welcome email
+		h.sendWelcomeEmail(ctx, userInfo)
 	}
 
 	return nil
 }
 
-// UserRegisteredEvent represents the event data
-type UserRegisteredEvent struct {
-	EventID      string `json:"event_id"`
-	EventType    string `json:"event_type"`
-	OccurredAt   string `json:"occurred_at"`
-	AggregateID  string `json:"aggregate_id"`
-	UserID       string `json:"user_id"`
-	Email        string `json:"email"`
-	Name         string `json:"name"`
-	AuthProvider string `json:"auth_provider"`
+// sendWelcomeEmail greets a new user by email. Failures are only logged so
+// the event is not redelivered and the notification duplicated.
+func (h *UserRegisteredHandler) sendWelcomeEmail(ctx context.Context, userInfo *ports.UserInfo) {
+	locale := userInfo.Locale
+	if !i18n.IsSupported(locale) {
+		locale = i18n.DefaultLocale
+	}
+
+	data := WelcomeEmail{
+		Locale: locale,
+		Name:   userInfo.Name,
+		From:   h.from,
+		AppURL: h.appURL,
+	}
+
+	msg, err := h.templates.Render(email.TemplateWelcome, locale, data)
+	if err != nil {
+		h.logger.Error(ctx, err, "failed to render welcome email")
+		return
+	}
+
+	if err := h.email.Send(ctx, email.TemplateWelcome, userInfo.Email, msg.Subject, msg.HTML, data); err != nil {
+		h.logger.Error(ctx, err, "failed to send welcome email",
+			logger.Field{Key: "user_id", Value: userInfo.UserID},
+		)
+	}
 }
 
 // HabitCreatedHandler handles HabitCreated events
@@ -113,12 +154,12 @@ func NewHabitCreatedHandler(log logger.Logger) *HabitCreatedHandler {
 }
 
 func (h *HabitCreatedHandler) EventType() string {
-	return "habits.habit.created"
+	return habitevents.HabitCreatedType
 }
 
 func (h *HabitCreatedHandler) Handle(ctx context.Context, data []byte) error {
-	var event HabitCreatedEvent
-	if err := json.Unmarshal(data, &event); err != nil {
+	event, err := habitevents.ParseHabitCreated(data)
+	if err != nil {
 		return err
 	}
 
@@ -133,19 +174,6 @@ func (h *HabitCreatedHandler) Handle(ctx context.Context, data []byte) error {
 	return nil
 }
 
-// HabitCreatedEvent represents the event data
-type HabitCreatedEvent struct {
-	EventID     string `json:"event_id"`
-	EventType   string `json:"event_type"`
-	OccurredAt  string `json:"occurred_at"`
-	AggregateID string `json:"aggregate_id"`
-	HabitID     string `json:"habit_id"`
-	UserID      string `json:"user_id"`
-	Name        string `json:"name"`
-	Frequency   string `json:"frequency"`
-	TargetCount int    `json:"target_count"`
-}
-
 // HabitCompletedHandler handles HabitCompleted events
 type HabitCompletedHandler struct {
 	logger logger.Logger
@@ -156,12 +184,12 @@ func NewHabitCompletedHandler(log logger.Logger) *HabitCompletedHandler {
 }
 
 func (h *HabitCompletedHandler) EventType() string {
-	return "habits.habit.completed"
+	return habitevents.HabitCompletedType
 }
 
 func (h *HabitCompletedHandler) Handle(ctx context.Context, data []byte) error {
-	var event HabitCompletedEvent
-	if err := json.Unmarshal(data, &event); err != nil {
+	event, err := habitevents.ParseHabitCompleted(data)
+	if err != nil {
 		return err
 	}
 
@@ -169,6 +197,7 @@ func (h *HabitCompletedHandler) Handle(ctx context.Context, data []byte) error {
 		logger.Field{Key: "habit_id", Value: event.HabitID},
 		logger.Field{Key: "user_id", Value: event.UserID},
 		logger.Field{Key: "count", Value: event.Count},
+		logger.Field{Key: "correction", Value: event.Correction},
 	)
 
 	// Example: Check for streak milestones, send notifications, etc.
@@ -176,16 +205,44 @@ func (h *HabitCompletedHandler) Handle(ctx context.Context, data []byte) error {
 	return nil
 }
 
-// HabitCompletedEvent represents the event data
-type HabitCompletedEvent struct {
-	EventID     string `json:"event_id"`
-	EventType   string `json:"event_type"`
-	OccurredAt  string `json:"occurred_at"`
-	AggregateID string `json:"aggregate_id"`
-	HabitID     string `json:"habit_id"`
-	UserID      string `json:"user_id"`
-	LogID       string `json:"log_id"`
-	LogDate     string `json:"log_date"`
-	Count       int    `json:"count"`
-	TotalToday  int    `json:"total_today"`
+// DashboardProjectionHandler refreshes the owner's stored dashboard when
+// one of their habits or logs changes. Register one per event type that
+// affects the dashboard.
+type DashboardProjectionHandler struct {
+	logger    logger.Logger
+	eventType string
+	refresh   func(ctx context.Context, userID string) error
+}
+
+func NewDashboardProjectionHandler(
+	log logger.Logger,
+	eventType string,
+	refresh func(ctx context.Context, userID string) error,
+) *DashboardProjectionHandler {
+	return &DashboardProjectionHandler{
+		logger:    log,
+		eventType: eventType,
+		refresh:   refresh,
+	}
+}
+
+func (h *DashboardProjectionHandler) EventType() string {
+	return h.eventType
+}
+
+func (h *DashboardProjectionHandler) Handle(ctx context.Context, data []byte) error {
+	event, err := habitevents.ParseHabitEvent(data)
+	if err != nil {
+		return err
+	}
+
+	if err := h.refresh(ctx, event.UserID); err != nil {
+		h.logger.Error(ctx, err, "failed to refresh dashboard projection",
+			logger.Field{Key: "event_type", Value: h.eventType},
+			logger.Field{Key: "user_id", Value: event.UserID},
+		)
+		return err
+	}
+
+	return nil
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/nats-io/nats.go"
 	"github.com/nats-io/nats.go/jetstream"
 	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/common/observability"
 )
 
 const (
@@ -107,9 +108,23 @@ func (p *NATSPublisher) Publish(ctx context.Context, event Event) error {
 		return fmt.Errorf("marshal event: %w", err)
 	}
 
+	msg := &nats.Msg{
+		Subject: subject,
+		Data:    data,
+		Header:  nats.Header{},
+	}
+	// Carry the trace context so consumers join the publisher's trace
+	observability.InjectHeaders(ctx, msg.Header)
+
+	msgID := event.EventID()
+	if replay, ok := ReplayFromContext(ctx); ok {
+		msg.Header.Set(ReplayHeader, replay.Consumer)
+		msgID += "/replay/" + replay.RunID
+	}
+
 	// Publish with deduplication ID
-	_, err = p.js.Publish(ctx, subject, data,
-		jetstream.WithMsgID(event.EventID()),
+	_, err = p.js.PublishMsg(ctx, msg,
+		jetstream.WithMsgID(msgID),
 	)
 	if err != nil {
 		p.logger.Error(ctx, err, "failed to publish event",
@@ -155,3 +170,16 @@ var _ Publisher = (*NATSPublisher)(nil)
 
 // ErrPublishFailed is returned when event publishing fails
 var ErrPublishFailed = errors.New("failed to publish event")
+
+// IsTransient reports whether a publish failed because NATS was briefly
+// unreachable, such as while reconnecting, so publishing again may succeed.
+// Publishes carry the event ID as their deduplication ID, so one that
+// reached the stream before failing is not stored twice.
+func IsTransient(err error) bool {
+	return errors.Is(err, nats.ErrTimeout) ||
+		errors.Is(err, nats.ErrNoResponders) ||
+		errors.Is(err, nats.ErrConnectionReconnecting) ||
+		errors.Is(err, nats.ErrDisconnected) ||
+		errors.Is(err, jetstream.ErrNoStreamResponse) ||
+		errors.Is(err, context.DeadlineExceeded)
+}
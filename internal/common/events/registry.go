@@ -0,0 +1,153 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/go-playground/validator/v10"
+	contractevents "github.com/semmidev/ethos-go/contracts/events"
+	authevents "github.com/semmidev/ethos-go/contracts/events/auth"
+	habitevents "github.com/semmidev/ethos-go/contracts/events/habits"
+)
+
+var (
+	// ErrUnknownSchema is returned for event types or versions that have
+	// no registered schema
+	ErrUnknownSchema = errors.New("unknown event schema")
+	// ErrInvalidPayload is returned for event data that does not match its
+	// schema
+	ErrInvalidPayload = contractevents.ErrInvalidPayload
+)
+
+// Registry holds the versioned schemas of published events. A schema is
+// the Go struct an event version decodes into: unknown fields are rejected
+// and `validate` tags are enforced. Producers publish the latest version of
+// each type; consumers accept every registered version, so register a new
+// version with the consumers before producers start publishing it.
+type Registry struct {
+	mu       sync.RWMutex
+	schemas  map[string]map[int]reflect.Type
+	latest   map[string]int
+	validate *validator.Validate
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		schemas:  make(map[string]map[int]reflect.Type),
+		latest:   make(map[string]int),
+		validate: validator.New(),
+	}
+}
+
+// NewModuleRegistry returns a registry holding the schema of every event
+// the modules publish, as every binary that seals or opens them needs
+func NewModuleRegistry() *Registry {
+	registry := NewRegistry()
+	authevents.RegisterSchemas(registry)
+	habitevents.RegisterSchemas(registry)
+	return registry
+}
+
+// Register adds version of eventType's schema. schema is a value or
+// pointer of the struct type. Registering a version twice panics.
+func (r *Registry) Register(eventType string, version int, schema any) {
+	t := reflect.TypeOf(schema)
+	if t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("events: schema for %s v%d must be a struct", eventType, version))
+	}
+	if version < 1 {
+		panic(fmt.Sprintf("events: schema version for %s must be at least 1", eventType))
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	versions, ok := r.schemas[eventType]
+	if !ok {
+		versions = make(map[int]reflect.Type)
+		r.schemas[eventType] = versions
+	}
+	if _, ok := versions[version]; ok {
+		panic(fmt.Sprintf("events: schema %s v%d registered twice", eventType, version))
+	}
+
+	versions[version] = t
+	if version > r.latest[eventType] {
+		r.latest[eventType] = version
+	}
+}
+
+// Latest returns the newest registered version of eventType
+func (r *Registry) Latest(eventType string) (int, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	version, ok := r.latest[eventType]
+	return version, ok
+}
+
+// Validate checks data against version of eventType's schema
+func (r *Registry) Validate(eventType string, version int, data []byte) error {
+	r.mu.RLock()
+	t, ok := r.schemas[eventType][version]
+	r.mu.RUnlock()
+	if !ok {
+		return fmt.Errorf("%w: %s v%d", ErrUnknownSchema, eventType, version)
+	}
+
+	v := reflect.New(t).Interface()
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		return fmt.Errorf("%w: %s v%d: %v", ErrInvalidPayload, eventType, version, err)
+	}
+	if err := r.validate.Struct(v); err != nil {
+		return fmt.Errorf("%w: %s v%d: %v", ErrInvalidPayload, eventType, version, err)
+	}
+
+	return nil
+}
+
+// Seal validates event against the latest schema of its type and wraps it
+// in an envelope. Envelopes are returned unchanged.
+func (r *Registry) Seal(ctx context.Context, producer string, event Event) (*Envelope, error) {
+	if env, ok := event.(*Envelope); ok {
+		return env, nil
+	}
+
+	version, ok := r.Latest(event.EventType())
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownSchema, event.EventType())
+	}
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		return nil, fmt.Errorf("marshal event: %w", err)
+	}
+	if err := r.Validate(event.EventType(), version, data); err != nil {
+		return nil, err
+	}
+
+	return seal(ctx, producer, event, version, data), nil
+}
+
+// Open decodes a published event and validates its data against the
+// schema version it was published with
+func (r *Registry) Open(raw []byte) (*Envelope, error) {
+	env, err := OpenEnvelope(raw)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.Validate(env.EventType(), env.SchemaVersion, env.Data); err != nil {
+		return nil, err
+	}
+	return env, nil
+}
@@ -0,0 +1,127 @@
+package events_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	authevents "github.com/semmidev/ethos-go/contracts/events/auth"
+	habitevents "github.com/semmidev/ethos-go/contracts/events/habits"
+	"github.com/semmidev/ethos-go/internal/common/events"
+)
+
+const greetedType = "test.user.greeted"
+
+type greetedV1 struct {
+	events.BaseEvent
+	UserID string `json:"user_id" validate:"required"`
+}
+
+type greetedV2 struct {
+	events.BaseEvent
+	UserID   string `json:"user_id" validate:"required"`
+	Greeting string `json:"greeting" validate:"required"`
+}
+
+func TestRegistry(t *testing.T) {
+	Convey("Given a registry with one schema version", t, func() {
+		registry := events.NewRegistry()
+		registry.Register(greetedType, 1, greetedV1{})
+		ctx := context.Background()
+
+		Convey("Seal validates the event and wraps it in an envelope", func() {
+			event := greetedV1{BaseEvent: events.NewBaseEvent(greetedType, "user", "u1"), UserID: "u1"}
+
+			env, err := registry.Seal(ctx, "ethos-api", event)
+
+			So(err, ShouldBeNil)
+			So(env.EventID(), ShouldEqual, event.EventID())
+			So(env.SchemaVersion, ShouldEqual, 1)
+			So(env.Producer, ShouldEqual, "ethos-api")
+			So(string(env.Data), ShouldContainSubstring, `"user_id":"u1"`)
+		})
+
+		Convey("Events of an unknown type are rejected", func() {
+			event := greetedV1{BaseEvent: events.NewBaseEvent("test.user.waved", "user", "u1"), UserID: "u1"}
+
+			_, err := registry.Seal(ctx, "ethos-api", event)
+
+			So(err, ShouldWrap, events.ErrUnknownSchema)
+		})
+
+		Convey("Events missing required fields are rejected", func() {
+			event := greetedV1{BaseEvent: events.NewBaseEvent(greetedType, "user", "u1")}
+
+			_, err := registry.Seal(ctx, "ethos-api", event)
+
+			So(err, ShouldWrap, events.ErrInvalidPayload)
+		})
+
+		Convey("A sealed envelope opens to the same data", func() {
+			event := greetedV1{BaseEvent: events.NewBaseEvent(greetedType, "user", "u1"), UserID: "u1"}
+			env, _ := registry.Seal(ctx, "ethos-api", event)
+			raw, _ := json.Marshal(env)
+
+			opened, err := registry.Open(raw)
+
+			So(err, ShouldBeNil)
+			So(opened.EventType(), ShouldEqual, greetedType)
+			So(opened.SchemaVersion, ShouldEqual, 1)
+			So(string(opened.Data), ShouldEqual, string(env.Data))
+		})
+
+		Convey("A message published before envelopes opens as version 1", func() {
+			raw, _ := json.Marshal(greetedV1{BaseEvent: events.NewBaseEvent(greetedType, "user", "u1"), UserID: "u1"})
+
+			opened, err := registry.Open(raw)
+
+			So(err, ShouldBeNil)
+			So(opened.SchemaVersion, ShouldEqual, 1)
+			So(opened.AggregateID(), ShouldEqual, "u1")
+			So(string(opened.Data), ShouldEqual, string(raw))
+		})
+
+		Convey("Registering the same version twice panics", func() {
+			So(func() { registry.Register(greetedType, 1, greetedV1{}) }, ShouldPanic)
+		})
+
+		Convey("When a second version is registered", func() {
+			registry.Register(greetedType, 2, greetedV2{})
+
+			Convey("Producers seal with the new version", func() {
+				event := greetedV2{BaseEvent: events.NewBaseEvent(greetedType, "user", "u1"), UserID: "u1", Greeting: "hi"}
+
+				env, err := registry.Seal(ctx, "ethos-api", event)
+
+				So(err, ShouldBeNil)
+				So(env.SchemaVersion, ShouldEqual, 2)
+			})
+
+			Convey("Version 1 messages are still accepted", func() {
+				v1 := greetedV1{BaseEvent: events.NewBaseEvent(greetedType, "user", "u1"), UserID: "u1"}
+				raw, _ := json.Marshal(v1)
+
+				opened, err := registry.Open(raw)
+
+				So(err, ShouldBeNil)
+				So(opened.SchemaVersion, ShouldEqual, 1)
+			})
+		})
+	})
+}
+
+func TestModuleRegistry(t *testing.T) {
+	Convey("Given the registry every binary builds", t, func() {
+		registry := events.NewModuleRegistry()
+		ctx := context.Background()
+
+		Convey("It seals the events of every module", func() {
+			_, err := registry.Seal(ctx, "ethos-api", authevents.NewUserVerified("user-1", "user@example.com"))
+			So(err, ShouldBeNil)
+			_, err = registry.Seal(ctx, "ethos-api", habitevents.NewHabitDeleted("habit-1", "user-1"))
+			So(err, ShouldBeNil)
+		})
+	})
+}
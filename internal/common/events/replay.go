@@ -0,0 +1,29 @@
+package events
+
+import "context"
+
+// ReplayHeader names the consumer a replayed message is meant for. Every
+// durable consumer sees the message; the others acknowledge and skip it.
+const ReplayHeader = "Ethos-Replay-For"
+
+// Replay identifies one replay run of historical events
+type Replay struct {
+	// Consumer is the durable consumer name that should handle the events
+	Consumer string
+	// RunID keeps a run's messages apart from the originals and from
+	// earlier runs, which JetStream would otherwise drop as duplicates
+	RunID string
+}
+
+type replayKey struct{}
+
+// WithReplay marks events published with ctx as a replay for one consumer
+func WithReplay(ctx context.Context, replay Replay) context.Context {
+	return context.WithValue(ctx, replayKey{}, replay)
+}
+
+// ReplayFromContext returns the replay events published with ctx belong to
+func ReplayFromContext(ctx context.Context) (Replay, bool) {
+	replay, ok := ctx.Value(replayKey{}).(Replay)
+	return replay, ok
+}
@@ -1,25 +1,39 @@
 package grpcutil
 
 import (
+	"context"
+	"errors"
 	"net/http"
 
 	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/errreport"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/structpb"
 )
 
-// ToGRPCError converts application errors to gRPC status errors with rich details.
-func ToGRPCError(err error) error {
+// ToGRPCError converts application errors to gRPC status errors with rich
+// details. Internal errors are sent to the error reporter with the call's
+// context.
+func ToGRPCError(ctx context.Context, err error) error {
 	if err == nil {
 		return nil
 	}
+	// Whatever failed once the deadline passed failed because of it
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) && !apperror.IsAppError(err) {
+		err = apperror.RequestTimeout().WithError(err)
+	}
 
 	appErr := apperror.GetAppError(err)
 	if appErr == nil {
 		// Generic internal error
+		errreport.Report(ctx, err)
 		return status.Error(codes.Internal, err.Error())
 	}
+	// Timeouts are expected under load; they show in the request metrics
+	if appErr.StatusCode >= http.StatusInternalServerError && appErr.StatusCode != http.StatusGatewayTimeout {
+		errreport.Report(ctx, err)
+	}
 
 	code := toGRPCCode(appErr.StatusCode)
 	st := status.New(code, appErr.Message)
@@ -70,6 +84,8 @@ func toGRPCCode(httpStatus int) codes.Code {
 		return codes.Unimplemented
 	case http.StatusServiceUnavailable:
 		return codes.Unavailable
+	case http.StatusGatewayTimeout:
+		return codes.DeadlineExceeded
 	case http.StatusInternalServerError:
 		return codes.Internal
 	default:
@@ -5,57 +5,123 @@ import (
 	"encoding/json"
 	"net/http"
 
+	"github.com/go-chi/chi/v5/middleware"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
-)
 
-type StandardResponse struct {
-	Success bool        `json:"success"`
-	Message string      `json:"message,omitempty"`
-	Data    interface{} `json:"data,omitempty"`
-	Meta    interface{} `json:"meta,omitempty"`
-	Error   interface{} `json:"error,omitempty"`
-}
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/httputil"
+)
 
-// CustomHTTPError is a custom error handler for gRPC-Gateway
+// CustomHTTPError is a custom error handler for gRPC-Gateway. It writes the
+// same envelope as httputil.Error so both HTTP surfaces fail the same way.
 func CustomHTTPError(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
 	st, _ := status.FromError(err)
-	httpStatus := runtime.HTTPStatusFromCode(st.Code())
+	httpStatus := gatewayHTTPStatus(st.Code())
 
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(httpStatus)
-
-	resp := StandardResponse{
-		Success: false,
-		Message: st.Message(),
-	}
+	body := httputil.ErrorBody{Message: st.Message()}
 
-	// Extract error details
-	errorResponse := map[string]interface{}{
-		"code":    st.Code().String(), // Use the gRPC code name for now, e.g. "INVALID_ARGUMENT"
-		"message": st.Message(),
-	}
-
-	// Check for details in the status
+	// ToGRPCError attaches the AppError details, with its code under "_code"
 	if details := st.Details(); len(details) > 0 {
-		// We expect the first detail to be our structpb.Struct map
 		if s, ok := details[0].(*structpb.Struct); ok {
 			detailsMap := s.AsMap()
-			// Check for injected app error code
-			if appCode, ok := detailsMap["_code"]; ok {
-				if codeStr, ok := appCode.(string); ok {
-					errorResponse["code"] = codeStr
-				}
-				delete(detailsMap, "_code")
+			if appCode, ok := detailsMap["_code"].(string); ok {
+				body.Code = appCode
 			}
-			errorResponse["details"] = detailsMap
+			delete(detailsMap, "_code")
+			if len(detailsMap) > 0 {
+				body.Details = detailsMap
+			}
+		}
+	}
+
+	// The gateway gave up at the request's deadline before the server's
+	// REQUEST_TIMEOUT error arrived
+	if body.Code == "" && st.Code() == codes.DeadlineExceeded {
+		timeout := apperror.RequestTimeout()
+		body.Code, body.Message = timeout.Code, timeout.Message
+	}
+
+	if body.Code == "" {
+		body.Message = httputil.SafeMessage(httpStatus, body.Message)
+	}
+
+	httputil.WriteError(w, r, httpStatus, body)
+}
+
+// gatewayHTTPStatus maps a gRPC code to the HTTP status httputil.Error would
+// use for the same AppError. FailedPrecondition only comes from 422 business
+// rule violations, which the gateway default would turn into a 400.
+func gatewayHTTPStatus(code codes.Code) int {
+	if code == codes.FailedPrecondition {
+		return http.StatusUnprocessableEntity
+	}
+	return runtime.HTTPStatusFromCode(code)
+}
+
+// GatewayJSON are the JSON options of the gateway's marshaler
+var GatewayJSON = protojson.MarshalOptions{
+	UseProtoNames:   true,
+	EmitUnpopulated: true,
+}
+
+// EnvelopeResponse rewrites gateway responses into the envelope chi
+// handlers write with httputil.Success, so both HTTP surfaces succeed the
+// same way. The success, message, data and meta fields of a response map
+// onto the envelope; other fields become data, and a response without a
+// success field is data as a whole. Register it with
+// runtime.WithForwardResponseRewriter.
+func EnvelopeResponse(ctx context.Context, resp proto.Message) (any, error) {
+	raw, err := GatewayJSON.Marshal(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := httputil.StandardResponse{
+		Success:   true,
+		RequestID: middleware.GetReqID(ctx),
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil, err
+	}
+	success, ok := fields["success"]
+	if !ok {
+		envelope.Data = json.RawMessage(raw)
+		return envelope, nil
+	}
+	if err := json.Unmarshal(success, &envelope.Success); err != nil {
+		return nil, err
+	}
+	if message, ok := fields["message"]; ok {
+		if err := json.Unmarshal(message, &envelope.Message); err != nil {
+			return nil, err
 		}
 	}
+	if meta := fields["meta"]; !isNull(meta) {
+		envelope.Meta = meta
+	}
+	if data := fields["data"]; !isNull(data) {
+		envelope.Data = data
+	}
+
+	delete(fields, "success")
+	delete(fields, "message")
+	delete(fields, "meta")
+	delete(fields, "data")
+	if len(fields) > 0 && envelope.Data == nil {
+		envelope.Data = fields
+	}
 
-	resp.Error = errorResponse
+	return envelope, nil
+}
 
-	// Use encoding/json to marshal the response
-	enc := json.NewEncoder(w)
-	_ = enc.Encode(resp)
+// isNull reports whether a JSON value is missing or null
+func isNull(v json.RawMessage) bool {
+	return len(v) == 0 || string(v) == "null"
 }
@@ -0,0 +1,182 @@
+package grpcutil_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	commonv1 "github.com/semmidev/ethos-go/contracts/grpc/ethos/common/v1"
+	habitsv1 "github.com/semmidev/ethos-go/contracts/grpc/ethos/habits/v1"
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/grpcutil"
+	"github.com/semmidev/ethos-go/internal/common/httputil"
+	"github.com/semmidev/ethos-go/internal/common/model"
+)
+
+type errorResponse struct {
+	Success bool               `json:"success"`
+	Message string             `json:"message"`
+	Error   httputil.ErrorBody `json:"error"`
+}
+
+func newRequest() *http.Request {
+	r := httptest.NewRequest(http.MethodGet, "/v1/habits/123", nil)
+	return r.WithContext(context.WithValue(r.Context(), middleware.RequestIDKey, "req-42"))
+}
+
+func viaGateway(err error) (int, errorResponse) {
+	w := httptest.NewRecorder()
+	grpcutil.CustomHTTPError(context.Background(), nil, nil, w, newRequest(), err)
+	var resp errorResponse
+	So(json.Unmarshal(w.Body.Bytes(), &resp), ShouldBeNil)
+	return w.Code, resp
+}
+
+func viaChi(err error) (int, errorResponse) {
+	w := httptest.NewRecorder()
+	httputil.Error(w, newRequest(), err)
+	var resp errorResponse
+	So(json.Unmarshal(w.Body.Bytes(), &resp), ShouldBeNil)
+	return w.Code, resp
+}
+
+func TestErrorEnvelope(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given the two HTTP surfaces", t, func() {
+		Convey("When an app error reaches both", func() {
+			appErr := apperror.InvalidInput("week_start", "must be one of: sunday, monday, saturday")
+			gwStatus, gwResp := viaGateway(grpcutil.ToGRPCError(context.Background(), appErr))
+			chiStatus, chiResp := viaChi(appErr)
+
+			Convey("Then they respond identically", func() {
+				So(gwStatus, ShouldEqual, http.StatusBadRequest)
+				So(chiStatus, ShouldEqual, gwStatus)
+				So(gwResp, ShouldResemble, chiResp)
+				So(gwResp.Error.Code, ShouldEqual, apperror.ErrCodeInvalidInput)
+				So(gwResp.Error.Details["field"], ShouldEqual, "week_start")
+				So(gwResp.Error.RequestID, ShouldEqual, "req-42")
+			})
+		})
+
+		Convey("When a business rule is violated", func() {
+			appErr := apperror.BusinessRuleViolation("habit_paused", "Habit is paused")
+			gwStatus, gwResp := viaGateway(grpcutil.ToGRPCError(context.Background(), appErr))
+			chiStatus, chiResp := viaChi(appErr)
+
+			Convey("Then both use 422", func() {
+				So(gwStatus, ShouldEqual, http.StatusUnprocessableEntity)
+				So(chiStatus, ShouldEqual, gwStatus)
+				So(gwResp, ShouldResemble, chiResp)
+			})
+		})
+
+		Convey("When an unexpected error reaches both", func() {
+			err := errors.New("pq: connection refused")
+			gwStatus, gwResp := viaGateway(grpcutil.ToGRPCError(context.Background(), err))
+			chiStatus, chiResp := viaChi(err)
+
+			Convey("Then neither leaks the cause", func() {
+				So(gwStatus, ShouldEqual, http.StatusInternalServerError)
+				So(chiStatus, ShouldEqual, gwStatus)
+				So(gwResp, ShouldResemble, chiResp)
+				So(gwResp.Error.Code, ShouldEqual, apperror.ErrCodeInternalError)
+				So(gwResp.Message, ShouldEqual, "An unexpected error occurred")
+			})
+		})
+
+		Convey("When a handler returns a plain gRPC status", func() {
+			status, resp := viaGateway(status.Error(codes.NotFound, "session not found"))
+
+			Convey("Then the code is derived from the status", func() {
+				So(status, ShouldEqual, http.StatusNotFound)
+				So(resp.Error.Code, ShouldEqual, apperror.ErrCodeNotFound)
+				So(resp.Message, ShouldEqual, "session not found")
+			})
+		})
+	})
+}
+
+// gatewaySuccess rewrites resp like the gateway does and decodes the body
+func gatewaySuccess(resp proto.Message) map[string]any {
+	ctx := context.WithValue(context.Background(), middleware.RequestIDKey, "req-42")
+	envelope, err := grpcutil.EnvelopeResponse(ctx, resp)
+	So(err, ShouldBeNil)
+	marshaler := &runtime.JSONPb{MarshalOptions: grpcutil.GatewayJSON}
+	body, err := marshaler.Marshal(envelope)
+	So(err, ShouldBeNil)
+
+	var decoded map[string]any
+	So(json.Unmarshal(body, &decoded), ShouldBeNil)
+	return decoded
+}
+
+func chiSuccess(write func(w http.ResponseWriter, r *http.Request)) map[string]any {
+	w := httptest.NewRecorder()
+	write(w, newRequest())
+	var decoded map[string]any
+	So(json.Unmarshal(w.Body.Bytes(), &decoded), ShouldBeNil)
+	return decoded
+}
+
+func TestSuccessEnvelope(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given the two HTTP surfaces", t, func() {
+		Convey("When both return a page of items", func() {
+			gw := gatewaySuccess(&habitsv1.ListHabitsResponse{
+				Success: true,
+				Message: "Habits retrieved",
+				Data:    []*habitsv1.Habit{{Id: "habit-1", Name: "Read"}},
+				Meta: &commonv1.Meta{Pagination: &commonv1.PaginationResponse{
+					CurrentPage: 1, PerPage: 20, TotalData: 1, TotalDataInCurrentPage: 1, LastPage: 1, From: 1, To: 1,
+				}},
+			})
+			paging, err := model.NewPaging(1, 20, 1)
+			So(err, ShouldBeNil)
+			chi := chiSuccess(func(w http.ResponseWriter, r *http.Request) {
+				httputil.SuccessPaginated(w, r, []map[string]string{{"id": "habit-1"}}, paging, "Habits retrieved")
+			})
+
+			Convey("Then the envelopes match apart from the data", func() {
+				So(gw["success"], ShouldEqual, true)
+				So(gw["message"], ShouldEqual, chi["message"])
+				So(gw["meta"], ShouldResemble, chi["meta"])
+				So(gw["request_id"], ShouldEqual, "req-42")
+				So(chi["request_id"], ShouldEqual, "req-42")
+				So(gw["data"].([]any)[0].(map[string]any)["id"], ShouldEqual, "habit-1")
+			})
+		})
+
+		Convey("When the gateway response has no data", func() {
+			gw := gatewaySuccess(&commonv1.SuccessResponse{Success: true, Message: "Habit deleted"})
+
+			Convey("Then data and meta are left out, as chi leaves them out", func() {
+				So(gw, ShouldResemble, map[string]any{
+					"success":    true,
+					"message":    "Habit deleted",
+					"request_id": "req-42",
+				})
+			})
+		})
+
+		Convey("When the gateway response has fields beside data", func() {
+			gw := gatewaySuccess(&habitsv1.RecomputeHabitStatsResponse{Success: true, Checked: 12, Repaired: 2})
+
+			Convey("Then they are moved into data", func() {
+				So(gw["data"], ShouldResemble, map[string]any{"checked": 12.0, "repaired": 2.0})
+				So(gw, ShouldNotContainKey, "checked")
+			})
+		})
+	})
+}
@@ -0,0 +1,69 @@
+package grpcutil
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/semmidev/ethos-go/internal/common/i18n"
+)
+
+const (
+	acceptLanguageHeader        = "accept-language"
+	gatewayAcceptLanguageHeader = "grpcgateway-accept-language" // set by gRPC-Gateway
+)
+
+// UnaryLocaleInterceptor resolves the locale of each call and translates the
+// response's message field into it. A locale already in the context (the
+// authenticated user's preference) wins over the Accept-Language header, so
+// this interceptor must run after authentication.
+func UnaryLocaleInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		locale, ok := i18n.LocaleFromContext(ctx)
+		if !ok {
+			locale = i18n.Negotiate(acceptLanguage(ctx))
+			ctx = i18n.WithLocale(ctx, locale)
+		}
+
+		resp, err := handler(ctx, req)
+		if msg, ok := resp.(proto.Message); ok && err == nil {
+			translateMessageField(msg, locale)
+		}
+		return resp, err
+	}
+}
+
+// acceptLanguage reads the Accept-Language header from incoming metadata
+func acceptLanguage(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	for _, key := range []string{acceptLanguageHeader, gatewayAcceptLanguageHeader} {
+		if values := md.Get(key); len(values) > 0 {
+			return values[0]
+		}
+	}
+	return ""
+}
+
+// translateMessageField translates the top-level "message" string of a
+// response in place
+func translateMessageField(msg proto.Message, locale string) {
+	m := msg.ProtoReflect()
+	field := m.Descriptor().Fields().ByName("message")
+	if field == nil || field.Kind() != protoreflect.StringKind || field.IsList() {
+		return
+	}
+	if text := m.Get(field).String(); text != "" {
+		m.Set(field, protoreflect.ValueOfString(i18n.T(locale, text)))
+	}
+}
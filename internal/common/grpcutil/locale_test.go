@@ -0,0 +1,53 @@
+package grpcutil_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	authv1 "github.com/semmidev/ethos-go/contracts/grpc/ethos/auth/v1"
+	"github.com/semmidev/ethos-go/internal/common/grpcutil"
+	"github.com/semmidev/ethos-go/internal/common/i18n"
+)
+
+func TestUnaryLocaleInterceptor(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given the locale interceptor", t, func() {
+		interceptor := grpcutil.UnaryLocaleInterceptor()
+		info := &grpc.UnaryServerInfo{FullMethod: "/ethos.auth.v1.AuthService/Logout"}
+
+		var handlerLocale string
+		handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+			handlerLocale = i18n.FromContext(ctx)
+			return &authv1.SuccessResponse{Success: true, Message: "Logged out successfully"}, nil
+		}
+
+		Convey("When the gateway forwards an Indonesian Accept-Language", func() {
+			ctx := metadata.NewIncomingContext(context.Background(),
+				metadata.Pairs("grpcgateway-accept-language", "id-ID,id;q=0.9"))
+			resp, err := interceptor(ctx, nil, info, handler)
+
+			Convey("Then the handler sees the locale and the message is translated", func() {
+				So(err, ShouldBeNil)
+				So(handlerLocale, ShouldEqual, i18n.Indonesian)
+				So(resp.(*authv1.SuccessResponse).Message, ShouldEqual, "Berhasil keluar")
+			})
+		})
+
+		Convey("When the user's saved locale is already in the context", func() {
+			ctx := metadata.NewIncomingContext(i18n.WithLocale(context.Background(), i18n.English),
+				metadata.Pairs("accept-language", "id"))
+			resp, err := interceptor(ctx, nil, info, handler)
+
+			Convey("Then it wins over the header", func() {
+				So(err, ShouldBeNil)
+				So(handlerLocale, ShouldEqual, i18n.English)
+				So(resp.(*authv1.SuccessResponse).Message, ShouldEqual, "Logged out successfully")
+			})
+		})
+	})
+}
@@ -0,0 +1,158 @@
+package grpcutil
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Service names used for service-to-service authentication.
+const (
+	// ServiceGateway is the in-process gRPC-Gateway serving public HTTP traffic.
+	ServiceGateway = "gateway"
+	// ServiceWorker is the background worker.
+	ServiceWorker = "worker"
+	// ServiceCLI is the ethosctl operator CLI.
+	ServiceCLI = "ethosctl"
+)
+
+const (
+	// ServiceTokenHeader is the metadata key carrying the caller's service token.
+	ServiceTokenHeader = "x-service-token"
+
+	serviceTokenAudience = "ethos-grpc"
+	serviceTokenTTL      = time.Minute
+)
+
+// ServicePolicy reports whether service may call the full gRPC method name.
+// service is empty for callers without a service token, such as mobile
+// clients calling the gRPC port directly.
+type ServicePolicy func(service, fullMethod string) bool
+
+type serviceContextKey struct{}
+
+// ServiceFromContext returns the authenticated calling service.
+func ServiceFromContext(ctx context.Context) (string, bool) {
+	service, ok := ctx.Value(serviceContextKey{}).(string)
+	return service, ok
+}
+
+// ServiceAuth issues and verifies short-lived HS256 tokens that identify
+// the calling service on internal gRPC connections. All services share
+// one secret; the token's issuer names the caller.
+type ServiceAuth struct {
+	secret []byte
+}
+
+// NewServiceAuth creates a ServiceAuth for the shared secret. An empty
+// secret is replaced with a random one, which only lets callers in the
+// same process (the gateway) authenticate.
+func NewServiceAuth(secret string) (*ServiceAuth, error) {
+	if secret == "" {
+		key := make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			return nil, fmt.Errorf("generate service secret: %w", err)
+		}
+		return &ServiceAuth{secret: key}, nil
+	}
+
+	return &ServiceAuth{secret: []byte(secret)}, nil
+}
+
+// DialOption attaches a token for service to every call on the connection.
+func (a *ServiceAuth) DialOption(service string) grpc.DialOption {
+	return grpc.WithPerRPCCredentials(serviceCredentials{auth: a, service: service})
+}
+
+// UnaryServerInterceptor rejects calls with an invalid service token and
+// calls that policy does not allow for the calling service. Calls without
+// a token are let through only when policy allows them for the empty
+// service; a nil policy requires a token on every call.
+func (a *ServiceAuth) UnaryServerInterceptor(policy ServicePolicy) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		tokens := md.Get(ServiceTokenHeader)
+		if len(tokens) == 0 {
+			if policy == nil || !policy("", info.FullMethod) {
+				return nil, status.Error(codes.Unauthenticated, "missing service token")
+			}
+			return handler(ctx, req)
+		}
+
+		service, err := a.verify(tokens[0])
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, "invalid service token")
+		}
+
+		if policy != nil && !policy(service, info.FullMethod) {
+			return nil, status.Errorf(codes.PermissionDenied, "service %q may not call %s", service, info.FullMethod)
+		}
+
+		return handler(context.WithValue(ctx, serviceContextKey{}, service), req)
+	}
+}
+
+func (a *ServiceAuth) sign(service string) (string, error) {
+	now := time.Now()
+	claims := jwt.RegisteredClaims{
+		Issuer:    service,
+		Audience:  jwt.ClaimStrings{serviceTokenAudience},
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(now.Add(serviceTokenTTL)),
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(a.secret)
+}
+
+func (a *ServiceAuth) verify(token string) (string, error) {
+	var claims jwt.RegisteredClaims
+	_, err := jwt.ParseWithClaims(token, &claims,
+		func(*jwt.Token) (any, error) { return a.secret, nil },
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithAudience(serviceTokenAudience),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(30*time.Second),
+	)
+	if err != nil {
+		return "", err
+	}
+	if claims.Issuer == "" {
+		return "", errors.New("service token has no issuer")
+	}
+	return claims.Issuer, nil
+}
+
+// serviceCredentials signs a fresh token for each call.
+type serviceCredentials struct {
+	auth    *ServiceAuth
+	service string
+}
+
+func (c serviceCredentials) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	token, err := c.auth.sign(c.service)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{ServiceTokenHeader: token}, nil
+}
+
+// RequireTransportSecurity is false because the gateway dials the gRPC
+// server over loopback without TLS.
+func (serviceCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
+var _ credentials.PerRPCCredentials = serviceCredentials{}
@@ -0,0 +1,91 @@
+package grpcutil_test
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/semmidev/ethos-go/internal/common/grpcutil"
+)
+
+func TestServiceAuth(t *testing.T) {
+	t.Parallel()
+
+	secret := strings.Repeat("s", 32)
+	const checkMethod = "/grpc.health.v1.Health/Check"
+
+	Convey("Given a gRPC server requiring service tokens", t, func() {
+		serverAuth, err := grpcutil.NewServiceAuth(secret)
+		So(err, ShouldBeNil)
+
+		var calledBy string
+		anonymous := false
+		policy := func(service, fullMethod string) bool {
+			calledBy = service
+			if service == "" {
+				return anonymous
+			}
+			return !(service == grpcutil.ServiceGateway && fullMethod == checkMethod)
+		}
+
+		listener := bufconn.Listen(1 << 20)
+		server := grpc.NewServer(grpc.UnaryInterceptor(serverAuth.UnaryServerInterceptor(policy)))
+		healthpb.RegisterHealthServer(server, health.NewServer())
+		go server.Serve(listener)
+		Reset(server.Stop)
+
+		call := func(opts ...grpc.DialOption) error {
+			opts = append(opts,
+				grpc.WithTransportCredentials(insecure.NewCredentials()),
+				grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+					return listener.DialContext(ctx)
+				}),
+			)
+			conn, err := grpc.NewClient("passthrough:///bufnet", opts...)
+			So(err, ShouldBeNil)
+			defer conn.Close()
+
+			_, err = healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{})
+			return err
+		}
+
+		Convey("A call without a service token is unauthenticated", func() {
+			So(status.Code(call()), ShouldEqual, codes.Unauthenticated)
+		})
+
+		Convey("A call without a service token is let through when the policy allows it", func() {
+			anonymous = true
+			So(call(), ShouldBeNil)
+			So(calledBy, ShouldBeEmpty)
+		})
+
+		Convey("A token signed with another secret is unauthenticated", func() {
+			other, err := grpcutil.NewServiceAuth(strings.Repeat("x", 32))
+			So(err, ShouldBeNil)
+
+			So(status.Code(call(other.DialOption(grpcutil.ServiceWorker))), ShouldEqual, codes.Unauthenticated)
+		})
+
+		Convey("An allowed service is let through", func() {
+			clientAuth, err := grpcutil.NewServiceAuth(secret)
+			So(err, ShouldBeNil)
+
+			So(call(clientAuth.DialOption(grpcutil.ServiceWorker)), ShouldBeNil)
+			So(calledBy, ShouldEqual, grpcutil.ServiceWorker)
+		})
+
+		Convey("A service the policy rejects is denied", func() {
+			So(status.Code(call(serverAuth.DialOption(grpcutil.ServiceGateway))), ShouldEqual, codes.PermissionDenied)
+		})
+	})
+}
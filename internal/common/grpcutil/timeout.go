@@ -0,0 +1,36 @@
+package grpcutil
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// UnaryTimeoutInterceptor runs each call within a deadline: timeout, or
+// the one methods sets for its full method name. A deadline the client
+// set wins when it is earlier, so calls from the gateway end with the
+// HTTP request. A call that fails once its deadline has passed fails with
+// a REQUEST_TIMEOUT error, whatever it was doing when it was cancelled.
+func UnaryTimeoutInterceptor(timeout time.Duration, methods map[string]time.Duration) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		callTimeout := timeout
+		if methodTimeout, ok := methods[info.FullMethod]; ok {
+			callTimeout = methodTimeout
+		}
+		ctx, cancel := context.WithTimeout(ctx, callTimeout)
+		defer cancel()
+
+		resp, err := handler(ctx, req)
+		if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return nil, ToGRPCError(ctx, err)
+		}
+		return resp, err
+	}
+}
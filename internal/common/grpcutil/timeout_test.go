@@ -0,0 +1,71 @@
+package grpcutil_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/semmidev/ethos-go/internal/common/grpcutil"
+)
+
+func TestUnaryTimeoutInterceptor(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given calls served within a short deadline and a slow method", t, func() {
+		const slowMethod = "/ethos.auth.v1.AuthService/ExportUserData"
+		interceptor := grpcutil.UnaryTimeoutInterceptor(10*time.Millisecond, map[string]time.Duration{
+			slowMethod: time.Second,
+		})
+
+		var deadline time.Duration
+		handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+			until, _ := ctx.Deadline()
+			deadline = time.Until(until)
+			select {
+			case <-ctx.Done():
+				// As a database driver reports a cancelled query
+				return nil, errors.New("pq: canceling statement due to user request")
+			case <-time.After(50 * time.Millisecond):
+				return "done", nil
+			}
+		}
+		call := func(ctx context.Context, method string) (interface{}, error) {
+			return interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		}
+
+		Convey("When a call fails once its deadline has passed", func() {
+			_, err := call(context.Background(), "/ethos.habits.v1.HabitsService/ListHabits")
+
+			Convey("Then it fails as a timeout", func() {
+				So(status.Code(err), ShouldEqual, codes.DeadlineExceeded)
+			})
+		})
+
+		Convey("When the slow method is called", func() {
+			resp, err := call(context.Background(), slowMethod)
+
+			Convey("Then it gets its longer deadline", func() {
+				So(err, ShouldBeNil)
+				So(resp, ShouldEqual, "done")
+				So(deadline, ShouldBeGreaterThan, 500*time.Millisecond)
+			})
+		})
+
+		Convey("When the client set an earlier deadline", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+			defer cancel()
+			_, err := call(ctx, slowMethod)
+
+			Convey("Then the client's deadline wins", func() {
+				So(status.Code(err), ShouldEqual, codes.DeadlineExceeded)
+				So(deadline, ShouldBeLessThanOrEqualTo, 5*time.Millisecond)
+			})
+		})
+	})
+}
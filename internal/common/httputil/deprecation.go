@@ -0,0 +1,44 @@
+package httputil
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/semmidev/ethos-go/internal/common/logger"
+)
+
+// Deprecation describes endpoints slated for removal
+type Deprecation struct {
+	// Since is when the endpoints were deprecated
+	Since time.Time
+	// Sunset is when they stop working; zero while no date is set
+	Sunset time.Time
+	// Successor returns the path replacing the requested one, or "" when
+	// there is none
+	Successor func(r *http.Request) string
+}
+
+// Deprecated announces on every response of next that the endpoint is
+// deprecated, with the Deprecation (RFC 9745) and Sunset (RFC 8594) headers
+// and a successor-version link. Requests are flagged on the canonical log
+// line so remaining callers can be found before the sunset.
+func Deprecated(d Deprecation) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			h := w.Header()
+			h.Set("Deprecation", fmt.Sprintf("@%d", d.Since.Unix()))
+			if !d.Sunset.IsZero() {
+				h.Set("Sunset", d.Sunset.UTC().Format(http.TimeFormat))
+			}
+			if d.Successor != nil {
+				if successor := d.Successor(r); successor != "" {
+					h.Add("Link", fmt.Sprintf("<%s>; rel=\"successor-version\"", successor))
+				}
+			}
+			logger.SetCustom(r.Context(), "deprecated_endpoint", true)
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
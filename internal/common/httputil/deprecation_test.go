@@ -0,0 +1,49 @@
+package httputil_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/common/httputil"
+)
+
+func TestDeprecated(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given a deprecated endpoint", t, func() {
+		ok := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		})
+		deprecation := httputil.Deprecation{
+			Since: time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC),
+			Successor: func(r *http.Request) string {
+				return "/api/v1" + r.URL.Path
+			},
+		}
+
+		Convey("When it is called, the response announces the deprecation", func() {
+			w := httptest.NewRecorder()
+			httputil.Deprecated(deprecation)(ok).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/habits", nil))
+
+			So(w.Code, ShouldEqual, http.StatusNoContent)
+			So(w.Header().Get("Deprecation"), ShouldEqual, "@1792195200")
+			So(w.Header().Get("Link"), ShouldEqual, `</api/v1/habits>; rel="successor-version"`)
+
+			Convey("Then no sunset is announced before one is set", func() {
+				So(w.Header().Get("Sunset"), ShouldBeEmpty)
+			})
+		})
+
+		Convey("When a sunset is set, it is announced as an HTTP date", func() {
+			deprecation.Sunset = time.Date(2027, 4, 1, 0, 0, 0, 0, time.UTC)
+			w := httptest.NewRecorder()
+			httputil.Deprecated(deprecation)(ok).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/habits", nil))
+
+			So(w.Header().Get("Sunset"), ShouldEqual, "Thu, 01 Apr 2027 00:00:00 GMT")
+		})
+	})
+}
@@ -0,0 +1,91 @@
+package httputil
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"github.com/semmidev/ethos-go/contracts/envelope"
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+)
+
+// unexpectedErrorMessage replaces the message of errors that carry no
+// application code, since those may contain internal details
+const unexpectedErrorMessage = "An unexpected error occurred"
+
+// ErrorBody is the "error" object of every failed HTTP response (see
+// envelope.Error). Handlers behind the gRPC-Gateway and plain chi handlers
+// both write it through WriteError, so clients need a single parser.
+//
+// Code is the apperror code when the error is an *apperror.AppError. Other
+// errors get a code from their HTTP status (see CodeForStatus) and, when the
+// status is 5xx, a generic message.
+type ErrorBody = envelope.Error
+
+// WriteError writes the failed-response envelope. The message is translated
+// into the request's locale and the request ID is attached when known.
+func WriteError(w http.ResponseWriter, r *http.Request, statusCode int, body ErrorBody) {
+	if body.Code == "" {
+		body.Code = CodeForStatus(statusCode)
+	}
+	body.Message = translate(r, body.Message)
+	body.RequestID = requestID(r)
+
+	render.Status(r, statusCode)
+	render.JSON(w, r, StandardResponse{
+		Success:   false,
+		Message:   body.Message,
+		Error:     body,
+		RequestID: body.RequestID,
+	})
+}
+
+// CodeForStatus returns the error code for an error without an application
+// code. gRPC statuses reach it through their gateway HTTP status:
+//
+//	gRPC status         HTTP  code
+//	InvalidArgument     400   VALIDATION_FAILED
+//	Unauthenticated     401   AUTH_UNAUTHORIZED
+//	PermissionDenied    403   AUTH_INSUFFICIENT_PERMISSION
+//	NotFound            404   RESOURCE_NOT_FOUND
+//	AlreadyExists       409   RESOURCE_CONFLICT
+//	FailedPrecondition  422   BUSINESS_RULE_VIOLATION
+//	ResourceExhausted   429   RATE_LIMITED
+//	anything else       5xx   INTERNAL_ERROR
+func CodeForStatus(statusCode int) string {
+	switch statusCode {
+	case http.StatusBadRequest:
+		return apperror.ErrCodeValidationFailed
+	case http.StatusUnauthorized:
+		return apperror.ErrCodeUnauthorized
+	case http.StatusForbidden:
+		return apperror.ErrCodeInsufficientPermission
+	case http.StatusNotFound:
+		return apperror.ErrCodeNotFound
+	case http.StatusConflict:
+		return apperror.ErrCodeConflict
+	case http.StatusUnprocessableEntity:
+		return apperror.ErrCodeBusinessRuleViolation
+	case http.StatusTooManyRequests:
+		return apperror.ErrCodeRateLimited
+	default:
+		return apperror.ErrCodeInternalError
+	}
+}
+
+// SafeMessage hides the message of unexpected server errors
+func SafeMessage(statusCode int, message string) string {
+	if statusCode >= http.StatusInternalServerError {
+		return unexpectedErrorMessage
+	}
+	return message
+}
+
+// requestID returns the ID set by the RequestID middleware, falling back to
+// the client-supplied header
+func requestID(r *http.Request) string {
+	if id := middleware.GetReqID(r.Context()); id != "" {
+		return id
+	}
+	return r.Header.Get(middleware.RequestIDHeader)
+}
@@ -1,35 +1,34 @@
 package httputil
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"strings"
 
 	"github.com/go-chi/render"
+	"github.com/semmidev/ethos-go/contracts/envelope"
 	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/errreport"
+	"github.com/semmidev/ethos-go/internal/common/i18n"
 	"github.com/semmidev/ethos-go/internal/common/model"
 )
 
-// StandardResponse is the unified response structure
-type StandardResponse struct {
-	Success bool        `json:"success"`
-	Message string      `json:"message,omitempty"`
-	Data    interface{} `json:"data,omitempty"`
-	Meta    interface{} `json:"meta,omitempty"`
-	Error   interface{} `json:"error,omitempty"`
-}
+// StandardResponse is the unified response structure. Chi handlers write
+// it directly and gateway responses are rewritten into it (see
+// grpcutil.EnvelopeResponse), so every success has the same shape.
+type StandardResponse = envelope.Response
 
 // ResponseMeta contains metadata for responses (e.g., pagination)
-type ResponseMeta struct {
-	Pagination *model.Paging `json:"pagination,omitempty"`
-}
+type ResponseMeta = envelope.Meta
 
 // Success processes a successful request and returns a JSON response
 func Success(w http.ResponseWriter, r *http.Request, data interface{}, message string) {
 	resp := StandardResponse{
-		Success: true,
-		Message: message,
-		Data:    data,
+		Success:   true,
+		Message:   translate(r, message),
+		Data:      data,
+		RequestID: requestID(r),
 	}
 	render.Status(r, http.StatusOK)
 	render.JSON(w, r, resp)
@@ -38,10 +37,11 @@ func Success(w http.ResponseWriter, r *http.Request, data interface{}, message s
 // SuccessWithMeta processes a successful request with metadata (e.g., pagination)
 func SuccessWithMeta(w http.ResponseWriter, r *http.Request, data interface{}, meta *ResponseMeta, message string) {
 	resp := StandardResponse{
-		Success: true,
-		Message: message,
-		Data:    data,
-		Meta:    meta,
+		Success:   true,
+		Message:   translate(r, message),
+		Data:      data,
+		Meta:      meta,
+		RequestID: requestID(r),
 	}
 	render.Status(r, http.StatusOK)
 	render.JSON(w, r, resp)
@@ -59,9 +59,10 @@ func SuccessPaginated(w http.ResponseWriter, r *http.Request, data interface{},
 // Created processes a creation request and returns a 201 JSON response
 func Created(w http.ResponseWriter, r *http.Request, data interface{}, message string) {
 	resp := StandardResponse{
-		Success: true,
-		Message: message,
-		Data:    data,
+		Success:   true,
+		Message:   translate(r, message),
+		Data:      data,
+		RequestID: requestID(r),
 	}
 	render.Status(r, http.StatusCreated)
 	render.JSON(w, r, resp)
@@ -69,84 +70,62 @@ func Created(w http.ResponseWriter, r *http.Request, data interface{}, message s
 
 // Error processes an error and returns a JSON error response
 func Error(w http.ResponseWriter, r *http.Request, err error) {
-	// Default values
-	statusCode := http.StatusInternalServerError
-	resp := StandardResponse{
-		Success: false,
-		Message: "Internal Server Error",
+	// Whatever failed once the deadline passed failed because of it
+	if errors.Is(r.Context().Err(), context.DeadlineExceeded) && !apperror.IsAppError(err) {
+		err = apperror.RequestTimeout().WithError(err)
 	}
 
 	var appErr *apperror.AppError
 	if errors.As(err, &appErr) {
-		statusCode = appErr.HTTPStatusCode()
-		// If message is set in AppError, use it.
-		// NOTE: appErr.Error() usually combines Message + underlying Err.
-		// For the response "message" field, we might prefer just appErr.Message which is user-safe.
-		// But appErr.Error() might include internal error info if configured so?
-		// apperror.go: Error() returns fmt.Sprintf("%s: %v", e.Message, e.Err) if Err != nil
-		// apperror.go: Message is "human-readable error message safe to show to clients"
-
-		// Let's use Message for the top level message field
-		resp.Message = appErr.Message
-
-		errData := map[string]interface{}{
-			"code":    appErr.Code,
-			"message": appErr.Message,
+		// Timeouts are expected under load; they show in the request metrics
+		if appErr.HTTPStatusCode() >= http.StatusInternalServerError && appErr.HTTPStatusCode() != http.StatusGatewayTimeout {
+			errreport.ReportRequest(r, err)
 		}
+		// Message is safe to show to clients; the wrapped error is not
+		WriteError(w, r, appErr.HTTPStatusCode(), ErrorBody{
+			Code:    appErr.Code,
+			Message: appErr.Message,
+			Details: appErr.Details,
+		})
+		return
+	}
 
-		if len(appErr.Details) > 0 {
-			errData["details"] = appErr.Details
-		}
+	// Handle domain errors by inspecting error message patterns
+	errMsg := err.Error()
+	errMsgLower := strings.ToLower(errMsg)
 
-		resp.Error = errData
-	} else {
-		// Handle domain errors by inspecting error message patterns
-		errMsg := err.Error()
-		errMsgLower := strings.ToLower(errMsg)
-
-		switch {
-		case strings.Contains(errMsgLower, "not found"):
-			statusCode = http.StatusNotFound
-			resp.Message = capitalizeFirst(errMsg)
-			resp.Error = map[string]interface{}{
-				"code":    "NOT_FOUND",
-				"message": capitalizeFirst(errMsg),
-			}
-		case strings.Contains(errMsgLower, "unauthorized") ||
-			strings.Contains(errMsgLower, "cannot access"):
-			statusCode = http.StatusForbidden
-			resp.Message = capitalizeFirst(errMsg)
-			resp.Error = map[string]interface{}{
-				"code":    "FORBIDDEN",
-				"message": capitalizeFirst(errMsg),
-			}
-		case strings.Contains(errMsgLower, "already"):
-			statusCode = http.StatusConflict
-			resp.Message = capitalizeFirst(errMsg)
-			resp.Error = map[string]interface{}{
-				"code":    "CONFLICT",
-				"message": capitalizeFirst(errMsg),
-			}
-		case strings.Contains(errMsgLower, "invalid") ||
-			strings.Contains(errMsgLower, "empty") ||
-			strings.Contains(errMsgLower, "must be"):
-			statusCode = http.StatusBadRequest
-			resp.Message = capitalizeFirst(errMsg)
-			resp.Error = map[string]interface{}{
-				"code":    "VALIDATION_ERROR",
-				"message": capitalizeFirst(errMsg),
-			}
-		default:
-			// Generic internal error - don't expose internal error details
-			resp.Error = map[string]interface{}{
-				"code":    "INTERNAL_ERROR",
-				"message": "An unexpected error occurred",
-			}
-		}
+	statusCode := http.StatusInternalServerError
+	switch {
+	case strings.Contains(errMsgLower, "not found"):
+		statusCode = http.StatusNotFound
+	case strings.Contains(errMsgLower, "unauthorized") ||
+		strings.Contains(errMsgLower, "cannot access"):
+		statusCode = http.StatusForbidden
+	case strings.Contains(errMsgLower, "already"):
+		statusCode = http.StatusConflict
+	case strings.Contains(errMsgLower, "invalid") ||
+		strings.Contains(errMsgLower, "empty") ||
+		strings.Contains(errMsgLower, "must be"):
+		statusCode = http.StatusBadRequest
 	}
 
-	render.Status(r, statusCode)
-	render.JSON(w, r, resp)
+	if statusCode == http.StatusInternalServerError {
+		errreport.ReportRequest(r, err)
+	}
+
+	// Generic internal errors don't expose internal error details
+	WriteError(w, r, statusCode, ErrorBody{
+		Message: SafeMessage(statusCode, capitalizeFirst(errMsg)),
+	})
+}
+
+// translate renders a response message in the request's locale
+func translate(r *http.Request, message string) string {
+	locale, ok := i18n.LocaleFromContext(r.Context())
+	if !ok {
+		locale = i18n.Negotiate(r.Header.Get("Accept-Language"))
+	}
+	return i18n.T(locale, message)
 }
 
 // capitalizeFirst capitalizes the first letter of a string
@@ -0,0 +1,47 @@
+package httputil
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5/middleware"
+
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+)
+
+// Timeout serves each request within a deadline: timeout, or the one of
+// the longest prefix in routes its path starts with, for endpoints that
+// are slow by design. The request's context, and so the database calls
+// made with it, is cancelled at the deadline. A handler that gives up
+// without answering gets a 504 with a REQUEST_TIMEOUT error written for
+// it.
+func Timeout(timeout time.Duration, routes map[string]time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx, cancel := context.WithTimeout(r.Context(), routeTimeout(r.URL.Path, timeout, routes))
+			defer cancel()
+
+			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			next.ServeHTTP(ww, r.WithContext(ctx))
+
+			if ww.Status() == 0 && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				Error(w, r, apperror.RequestTimeout())
+			}
+		})
+	}
+}
+
+// routeTimeout is the deadline of requests for path
+func routeTimeout(path string, timeout time.Duration, routes map[string]time.Duration) time.Duration {
+	longest := -1
+	for prefix, routeTimeout := range routes {
+		if strings.HasPrefix(path, prefix) && len(prefix) > longest {
+			longest = len(prefix)
+			timeout = routeTimeout
+		}
+	}
+	return timeout
+}
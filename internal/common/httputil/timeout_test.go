@@ -0,0 +1,60 @@
+package httputil_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/httputil"
+)
+
+func TestTimeout(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given requests served within a short deadline and a slow route", t, func() {
+		timeout := httputil.Timeout(10*time.Millisecond, map[string]time.Duration{
+			"/api/v1/auth/export": time.Second,
+		})
+
+		var deadline time.Duration
+		slow := timeout(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			until, _ := r.Context().Deadline()
+			deadline = time.Until(until)
+			select {
+			case <-r.Context().Done():
+			case <-time.After(50 * time.Millisecond):
+				w.WriteHeader(http.StatusNoContent)
+			}
+		}))
+		serve := func(path string) *httptest.ResponseRecorder {
+			w := httptest.NewRecorder()
+			slow.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+			return w
+		}
+
+		Convey("When a handler gives up at the deadline", func() {
+			w := serve("/api/v1/habits")
+
+			Convey("Then it fails with a typed 504", func() {
+				var body httputil.StandardResponse
+				So(json.Unmarshal(w.Body.Bytes(), &body), ShouldBeNil)
+				So(w.Code, ShouldEqual, http.StatusGatewayTimeout)
+				So(body.Error.(map[string]interface{})["code"], ShouldEqual, apperror.ErrCodeRequestTimeout)
+			})
+		})
+
+		Convey("When the slow route is called", func() {
+			w := serve("/api/v1/auth/export")
+
+			Convey("Then it gets its longer deadline", func() {
+				So(w.Code, ShouldEqual, http.StatusNoContent)
+				So(deadline, ShouldBeGreaterThan, 500*time.Millisecond)
+			})
+		})
+	})
+}
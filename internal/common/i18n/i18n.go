@@ -0,0 +1,118 @@
+// Package i18n translates server-generated text (response messages,
+// notifications and emails) into the user's language.
+//
+// Messages are written in English in the code and used as catalog keys, so
+// English needs no catalog and a missing translation falls back to the
+// English source text.
+package i18n
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"path"
+	"strings"
+)
+
+// Supported locales
+const (
+	English    = "en"
+	Indonesian = "id"
+
+	// DefaultLocale is used when neither the user nor the request names a
+	// supported locale
+	DefaultLocale = English
+)
+
+//go:embed locales/*.json
+var localeFiles embed.FS
+
+// catalogs maps a locale to its translations keyed by English source text
+var catalogs = loadCatalogs()
+
+func loadCatalogs() map[string]map[string]string {
+	catalogs := map[string]map[string]string{English: {}}
+
+	entries, err := localeFiles.ReadDir("locales")
+	if err != nil {
+		panic(fmt.Sprintf("i18n: read locales: %v", err))
+	}
+	for _, entry := range entries {
+		data, err := localeFiles.ReadFile(path.Join("locales", entry.Name()))
+		if err != nil {
+			panic(fmt.Sprintf("i18n: read %s: %v", entry.Name(), err))
+		}
+		var messages map[string]string
+		if err := json.Unmarshal(data, &messages); err != nil {
+			panic(fmt.Sprintf("i18n: parse %s: %v", entry.Name(), err))
+		}
+		catalogs[strings.TrimSuffix(entry.Name(), ".json")] = messages
+	}
+
+	return catalogs
+}
+
+// IsSupported reports whether locale has a catalog
+func IsSupported(locale string) bool {
+	_, ok := catalogs[locale]
+	return ok
+}
+
+// Normalize reduces a language tag such as "id-ID" or "en_US" to a
+// supported locale, or returns "" when the language is not supported.
+func Normalize(tag string) string {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if i := strings.IndexAny(tag, "-_"); i >= 0 {
+		tag = tag[:i]
+	}
+	if !IsSupported(tag) {
+		return ""
+	}
+	return tag
+}
+
+// T translates message into locale and formats it with args. Unknown
+// locales and messages without a translation use the English text.
+func T(locale, message string, args ...any) string {
+	if translated, ok := catalogs[Normalize(locale)][message]; ok && translated != "" {
+		message = translated
+	}
+	if len(args) == 0 {
+		return message
+	}
+	return fmt.Sprintf(message, args...)
+}
+
+// FuncMap exposes T to templates bound to locale:
+//
+//	{{T "Hello, %s" .Name}}
+func FuncMap(locale string) template.FuncMap {
+	return template.FuncMap{
+		"T": func(message string, args ...any) string {
+			return T(locale, message, args...)
+		},
+	}
+}
+
+type ctxKey struct{}
+
+// WithLocale stores the locale responses for this request are written in
+func WithLocale(ctx context.Context, locale string) context.Context {
+	return context.WithValue(ctx, ctxKey{}, locale)
+}
+
+// LocaleFromContext returns the locale stored in ctx, if any
+func LocaleFromContext(ctx context.Context) (string, bool) {
+	locale, ok := ctx.Value(ctxKey{}).(string)
+	return locale, ok && locale != ""
+}
+
+// FromContext returns the locale stored in ctx or DefaultLocale
+func FromContext(ctx context.Context) string {
+	if locale, ok := LocaleFromContext(ctx); ok {
+		return locale
+	}
+	return DefaultLocale
+}
@@ -0,0 +1,139 @@
+package i18n_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"html/template"
+	"os"
+	"regexp"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/common/i18n"
+)
+
+func TestTranslate(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given the message catalogs", t, func() {
+		Convey("When a message is translated into Indonesian", func() {
+			msg := i18n.T(i18n.Indonesian, "Welcome to Ethos, %s!", "Sam")
+
+			Convey("Then the translation is formatted with the arguments", func() {
+				So(msg, ShouldEqual, "Selamat datang di Ethos, Sam!")
+			})
+		})
+
+		Convey("When a message is translated into English", func() {
+			msg := i18n.T(i18n.English, "Welcome to Ethos, %s!", "Sam")
+
+			Convey("Then the source text is used", func() {
+				So(msg, ShouldEqual, "Welcome to Ethos, Sam!")
+			})
+		})
+
+		Convey("When the locale is a regional tag", func() {
+			So(i18n.T("id-ID", "Habit created successfully"), ShouldEqual, "Kebiasaan berhasil dibuat")
+		})
+
+		Convey("When the message has no translation or the locale is unknown", func() {
+			So(i18n.T(i18n.Indonesian, "habit not found"), ShouldEqual, "habit not found")
+			So(i18n.T("fr", "Habit created successfully"), ShouldEqual, "Habit created successfully")
+		})
+
+		Convey("When a template uses T", func() {
+			tpl := template.Must(template.New("t").Funcs(i18n.FuncMap(i18n.Indonesian)).Parse(`{{T "Hello, %s" .}}`))
+			var out bytes.Buffer
+			err := tpl.Execute(&out, "<Sam>")
+
+			Convey("Then it renders translated and escaped", func() {
+				So(err, ShouldBeNil)
+				So(out.String(), ShouldEqual, "Halo, &lt;Sam&gt;")
+			})
+		})
+	})
+}
+
+func TestCatalogsKeepFormatVerbs(t *testing.T) {
+	t.Parallel()
+
+	verbs := regexp.MustCompile(`%[-+# 0-9.]*[a-zA-Z%]`)
+
+	Convey("Given the Indonesian catalog", t, func() {
+		data, err := os.ReadFile("locales/id.json")
+		So(err, ShouldBeNil)
+
+		var messages map[string]string
+		So(json.Unmarshal(data, &messages), ShouldBeNil)
+
+		Convey("Then every translation uses the same format verbs as its source", func() {
+			for source, translated := range messages {
+				So(verbs.FindAllString(translated, -1), ShouldResemble, verbs.FindAllString(source, -1))
+			}
+		})
+	})
+}
+
+func TestNegotiate(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given Accept-Language headers", t, func() {
+		Convey("When the preferred language is supported", func() {
+			So(i18n.Negotiate("id-ID,id;q=0.9,en-US;q=0.8"), ShouldEqual, i18n.Indonesian)
+		})
+
+		Convey("When a higher quality value comes later", func() {
+			So(i18n.Negotiate("en;q=0.5, id;q=0.8"), ShouldEqual, i18n.Indonesian)
+		})
+
+		Convey("When unsupported languages come first", func() {
+			So(i18n.Negotiate("fr-FR, de;q=0.9, id;q=0.3"), ShouldEqual, i18n.Indonesian)
+		})
+
+		Convey("When a supported language is refused with q=0", func() {
+			So(i18n.Negotiate("id;q=0, fr"), ShouldEqual, i18n.DefaultLocale)
+		})
+
+		Convey("When the header is empty or names nothing supported", func() {
+			So(i18n.Negotiate(""), ShouldEqual, i18n.DefaultLocale)
+			So(i18n.Negotiate("*"), ShouldEqual, i18n.DefaultLocale)
+		})
+	})
+
+	Convey("Given a saved user preference", t, func() {
+		Convey("Then it wins over the header", func() {
+			So(i18n.Resolve(i18n.Indonesian, "en"), ShouldEqual, i18n.Indonesian)
+		})
+
+		Convey("Then an empty preference falls back to the header", func() {
+			So(i18n.Resolve("", "id"), ShouldEqual, i18n.Indonesian)
+		})
+	})
+}
+
+func TestContext(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given a context", t, func() {
+		ctx := context.Background()
+
+		Convey("When no locale is stored", func() {
+			_, ok := i18n.LocaleFromContext(ctx)
+
+			Convey("Then the default locale is used", func() {
+				So(ok, ShouldBeFalse)
+				So(i18n.FromContext(ctx), ShouldEqual, i18n.DefaultLocale)
+			})
+		})
+
+		Convey("When a locale is stored", func() {
+			ctx = i18n.WithLocale(ctx, i18n.Indonesian)
+
+			Convey("Then it is returned", func() {
+				So(i18n.FromContext(ctx), ShouldEqual, i18n.Indonesian)
+			})
+		})
+	})
+}
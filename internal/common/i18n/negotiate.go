@@ -0,0 +1,66 @@
+package i18n
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Negotiate picks the supported locale the client prefers most from an
+// Accept-Language header, falling back to DefaultLocale.
+func Negotiate(acceptLanguage string) string {
+	if locale := negotiate(acceptLanguage); locale != "" {
+		return locale
+	}
+	return DefaultLocale
+}
+
+// negotiate returns "" when the header names no supported locale
+func negotiate(acceptLanguage string) string {
+	type candidate struct {
+		locale string
+		q      float64
+	}
+
+	var candidates []candidate
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+
+		q := 1.0
+		for _, param := range strings.Split(params, ";") {
+			key, value, ok := strings.Cut(strings.TrimSpace(param), "=")
+			if !ok || strings.TrimSpace(key) != "q" {
+				continue
+			}
+			parsed, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			if err != nil {
+				parsed = 0
+			}
+			q = parsed
+		}
+
+		locale := Normalize(tag)
+		if locale == "" || q <= 0 {
+			continue
+		}
+		candidates = append(candidates, candidate{locale: locale, q: q})
+	}
+
+	// Ties keep header order
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].q > candidates[j].q
+	})
+	if len(candidates) == 0 {
+		return ""
+	}
+	return candidates[0].locale
+}
+
+// Resolve picks the locale for a request: the user's saved preference, then
+// the Accept-Language header, then DefaultLocale.
+func Resolve(preference, acceptLanguage string) string {
+	if locale := Normalize(preference); locale != "" {
+		return locale
+	}
+	return Negotiate(acceptLanguage)
+}
@@ -0,0 +1,131 @@
+// Package imaging validates and normalizes user-uploaded images.
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/draw"
+	"image/jpeg"
+
+	// Registered decoders for the accepted upload formats
+	_ "image/gif"
+	_ "image/png"
+)
+
+// MaxPixels bounds the decoded size of an upload so a small, highly
+// compressed file cannot exhaust memory
+const MaxPixels = 40_000_000
+
+// JPEGQuality is the quality normalized images are encoded with
+const JPEGQuality = 85
+
+var (
+	// ErrUnsupportedFormat is returned for data that is not a JPEG, PNG or
+	// GIF image
+	ErrUnsupportedFormat = errors.New("image must be a JPEG, PNG or GIF")
+	// ErrTooLarge is returned for images with more than MaxPixels pixels
+	ErrTooLarge = errors.New("image dimensions are too large")
+	// ErrTooSmall is returned for images smaller than the requested minimum
+	ErrTooSmall = errors.New("image dimensions are too small")
+)
+
+// SquareJPEG crops the middle square out of the image in data, scales it to
+// size×size and encodes it as a JPEG. Images must be at least minSize on
+// their shorter side; smaller squares are scaled up. Re-encoding also drops
+// any metadata, such as EXIF location, the upload carried.
+func SquareJPEG(data []byte, size, minSize int) ([]byte, error) {
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil || !supported(format) {
+		return nil, ErrUnsupportedFormat
+	}
+	if cfg.Width*cfg.Height > MaxPixels {
+		return nil, ErrTooLarge
+	}
+	if min(cfg.Width, cfg.Height) < minSize {
+		return nil, ErrTooSmall
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupportedFormat
+	}
+
+	dst := Resize(CenterSquare(src), size, size)
+
+	var out bytes.Buffer
+	if err := jpeg.Encode(&out, dst, &jpeg.Options{Quality: JPEGQuality}); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+// CenterSquare returns the largest square centered in img
+func CenterSquare(img image.Image) image.Image {
+	b := img.Bounds()
+	side := min(b.Dx(), b.Dy())
+	x0 := b.Min.X + (b.Dx()-side)/2
+	y0 := b.Min.Y + (b.Dy()-side)/2
+	crop := image.Rect(x0, y0, x0+side, y0+side)
+
+	if sub, ok := img.(interface {
+		SubImage(r image.Rectangle) image.Image
+	}); ok {
+		return sub.SubImage(crop)
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, side, side))
+	draw.Draw(dst, dst.Bounds(), img, crop.Min, draw.Src)
+	return dst
+}
+
+// Resize scales img to width×height. Each destination pixel averages the
+// source pixels it covers, which avoids the aliasing of nearest-neighbour
+// sampling when shrinking; enlarging repeats source pixels. Transparent
+// areas are composited onto white since JPEG has no alpha channel.
+func Resize(img image.Image, width, height int) *image.RGBA {
+	src := image.NewRGBA(image.Rect(0, 0, img.Bounds().Dx(), img.Bounds().Dy()))
+	draw.Draw(src, src.Bounds(), image.White, image.Point{}, draw.Src)
+	draw.Draw(src, src.Bounds(), img, img.Bounds().Min, draw.Over)
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	sw, sh := src.Bounds().Dx(), src.Bounds().Dy()
+
+	for y := 0; y < height; y++ {
+		y0 := y * sh / height
+		y1 := max((y+1)*sh/height, y0+1)
+		for x := 0; x < width; x++ {
+			x0 := x * sw / width
+			x1 := max((x+1)*sw/width, x0+1)
+
+			var r, g, b, a, n int
+			for sy := y0; sy < y1; sy++ {
+				row := src.Pix[sy*src.Stride:]
+				for sx := x0; sx < x1; sx++ {
+					p := row[sx*4 : sx*4+4]
+					r += int(p[0])
+					g += int(p[1])
+					b += int(p[2])
+					a += int(p[3])
+					n++
+				}
+			}
+
+			i := dst.PixOffset(x, y)
+			dst.Pix[i+0] = uint8(r / n)
+			dst.Pix[i+1] = uint8(g / n)
+			dst.Pix[i+2] = uint8(b / n)
+			dst.Pix[i+3] = uint8(a / n)
+		}
+	}
+	return dst
+}
+
+func supported(format string) bool {
+	switch format {
+	case "jpeg", "png", "gif":
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,141 @@
+// Package leader elects one process among replicas to run work that must
+// not run concurrently, such as the task scheduler.
+package leader
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"hash/fnv"
+	"time"
+
+	"github.com/semmidev/ethos-go/internal/common/logger"
+)
+
+// DefaultCheckInterval is how often a standby retries the lock and the
+// leader confirms it still holds it
+const DefaultCheckInterval = 5 * time.Second
+
+// Elector campaigns for leadership with a Postgres session-level advisory
+// lock. The lock lives on one dedicated connection; when the leader exits
+// or loses that connection Postgres releases the lock and a standby takes
+// over on its next attempt.
+type Elector struct {
+	db       *sql.DB
+	name     string
+	key      int64
+	interval time.Duration
+	log      logger.Logger
+}
+
+// NewElector creates an elector for the named role. Every replica must use
+// the same name to compete for the same lock.
+func NewElector(db *sql.DB, name string, log logger.Logger) *Elector {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(name))
+
+	return &Elector{
+		db:       db,
+		name:     name,
+		key:      int64(h.Sum64()),
+		interval: DefaultCheckInterval,
+		log:      log,
+	}
+}
+
+// WithInterval sets how often the lock is tried and checked
+func (e *Elector) WithInterval(interval time.Duration) *Elector {
+	e.interval = interval
+	return e
+}
+
+// Run campaigns until ctx is done. Each time leadership is won, lead is
+// called with a context that is canceled when leadership is lost; lead
+// must return soon after. Run returns after the last lead has returned.
+func (e *Elector) Run(ctx context.Context, lead func(ctx context.Context)) {
+	fields := logger.Field{Key: "role", Value: e.name}
+
+	for {
+		conn, acquired, err := e.tryAcquire(ctx)
+		if err != nil && ctx.Err() == nil {
+			e.log.Warn(ctx, "leader election attempt failed", fields,
+				logger.Field{Key: "error", Value: err.Error()})
+		}
+
+		if acquired {
+			e.log.Info(ctx, "acquired leadership", fields)
+			e.hold(ctx, conn, lead)
+			e.log.Info(ctx, "released leadership", fields)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(e.interval):
+		}
+	}
+}
+
+// tryAcquire takes the lock on a fresh connection, which is returned
+// only when the lock was acquired
+func (e *Elector) tryAcquire(ctx context.Context) (*sql.Conn, bool, error) {
+	conn, err := e.db.Conn(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var acquired bool
+	if err := conn.QueryRowContext(ctx, `SELECT pg_try_advisory_lock($1)`, e.key).Scan(&acquired); err != nil {
+		discard(conn)
+		return nil, false, err
+	}
+	if !acquired {
+		_ = conn.Close()
+		return nil, false, nil
+	}
+	return conn, true, nil
+}
+
+// hold runs lead while checking the lock's connection stays alive, then
+// gives up the lock by discarding the connection
+func (e *Elector) hold(ctx context.Context, conn *sql.Conn, lead func(ctx context.Context)) {
+	leadCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		lead(leadCtx)
+	}()
+
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for leading := true; leading; {
+		select {
+		case <-ctx.Done():
+			leading = false
+		case <-done:
+			leading = false
+		case <-ticker.C:
+			checkCtx, cancelCheck := context.WithTimeout(ctx, e.interval)
+			err := conn.PingContext(checkCtx)
+			cancelCheck()
+			if err != nil && ctx.Err() == nil {
+				e.log.Warn(ctx, "lost leadership lock connection",
+					logger.Field{Key: "role", Value: e.name},
+					logger.Field{Key: "error", Value: err.Error()})
+				leading = false
+			}
+		}
+	}
+
+	cancel()
+	<-done
+	discard(conn)
+}
+
+// discard closes the underlying connection instead of returning it to the
+// pool, which releases any advisory lock held on it
+func discard(conn *sql.Conn) {
+	_ = conn.Raw(func(any) error { return driver.ErrBadConn })
+	_ = conn.Close()
+}
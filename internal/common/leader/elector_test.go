@@ -0,0 +1,76 @@
+package leader_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/common/leader"
+	"github.com/semmidev/ethos-go/internal/testutil"
+)
+
+func TestElector(t *testing.T) {
+	Convey("Given an elector", t, func() {
+		db, mock, err := sqlmock.New()
+		So(err, ShouldBeNil)
+		defer db.Close()
+
+		elector := leader.NewElector(db, "worker-scheduler", testutil.NopLogger{}).WithInterval(10 * time.Millisecond)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		Convey("When it wins the lock, it leads until stopped", func() {
+			mock.ExpectQuery(`SELECT pg_try_advisory_lock\(\$1\)`).
+				WillReturnRows(sqlmock.NewRows([]string{"pg_try_advisory_lock"}).AddRow(true))
+
+			leading := make(chan struct{})
+			stepped := make(chan struct{})
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				elector.Run(ctx, func(leadCtx context.Context) {
+					close(leading)
+					<-leadCtx.Done()
+					close(stepped)
+				})
+			}()
+
+			So(waitFor(leading), ShouldBeTrue)
+			cancel()
+			So(waitFor(stepped), ShouldBeTrue)
+			So(waitFor(done), ShouldBeTrue)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("When another replica holds the lock, it keeps waiting", func() {
+			for i := 0; i < 3; i++ {
+				mock.ExpectQuery(`SELECT pg_try_advisory_lock\(\$1\)`).
+					WillReturnRows(sqlmock.NewRows([]string{"pg_try_advisory_lock"}).AddRow(false))
+			}
+
+			led := false
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				elector.Run(ctx, func(context.Context) { led = true })
+			}()
+
+			time.Sleep(25 * time.Millisecond)
+			cancel()
+			So(waitFor(done), ShouldBeTrue)
+			So(led, ShouldBeFalse)
+		})
+	})
+}
+
+func waitFor(ch <-chan struct{}) bool {
+	select {
+	case <-ch:
+		return true
+	case <-time.After(time.Second):
+		return false
+	}
+}
@@ -0,0 +1,124 @@
+// Package listener opens the socket a server accepts connections on, which
+// may be a TCP address, a unix socket or a socket passed by systemd socket
+// activation.
+package listener
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Address prefixes
+const (
+	UnixPrefix    = "unix:"
+	SystemdPrefix = "systemd"
+)
+
+// listenFdsStart is the first file descriptor systemd passes, after stdin,
+// stdout and stderr
+const listenFdsStart = 3
+
+// Listen opens the listener for address, which is one of
+//
+//	host:port           a TCP socket
+//	unix:/path/to.sock  a unix socket, created with mode
+//	systemd             the first socket passed by systemd
+//	systemd:NAME        the socket passed by systemd with FileDescriptorName=NAME
+//
+// A unix socket left behind by a process that died is replaced, and the
+// socket is removed when the listener is closed. Sockets passed by systemd
+// belong to systemd and are left as they are.
+func Listen(address string, mode fs.FileMode) (net.Listener, error) {
+	switch {
+	case strings.HasPrefix(address, UnixPrefix):
+		return listenUnix(strings.TrimPrefix(address, UnixPrefix), mode)
+	case address == SystemdPrefix:
+		return listenSystemd("")
+	case strings.HasPrefix(address, SystemdPrefix+":"):
+		return listenSystemd(strings.TrimPrefix(address, SystemdPrefix+":"))
+	default:
+		return net.Listen("tcp", address)
+	}
+}
+
+func listenUnix(path string, mode fs.FileMode) (net.Listener, error) {
+	if path == "" {
+		return nil, errors.New("unix socket path is empty")
+	}
+	if err := removeStaleSocket(path); err != nil {
+		return nil, err
+	}
+
+	ln, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+	// The socket is created with the umask applied; set the permissions
+	// the reverse proxy needs explicitly
+	if err := os.Chmod(path, mode); err != nil {
+		ln.Close()
+		return nil, fmt.Errorf("set unix socket permissions: %w", err)
+	}
+	return ln, nil
+}
+
+// removeStaleSocket removes a unix socket nothing listens on any more.
+// Anything else at path is left alone and reported.
+func removeStaleSocket(path string) error {
+	info, err := os.Lstat(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if info.Mode().Type() != fs.ModeSocket {
+		return fmt.Errorf("%s exists and is not a unix socket", path)
+	}
+
+	if conn, err := net.Dial("unix", path); err == nil {
+		conn.Close()
+		return fmt.Errorf("unix socket %s is in use by another process", path)
+	}
+	return os.Remove(path)
+}
+
+// listenSystemd takes over a socket passed by systemd, following the
+// sd_listen_fds protocol. An empty name takes the first socket.
+func listenSystemd(name string) (net.Listener, error) {
+	pid, err := strconv.Atoi(os.Getenv("LISTEN_PID"))
+	if err != nil || pid != os.Getpid() {
+		return nil, errors.New("no sockets passed by systemd; is the service socket activated?")
+	}
+	count, err := strconv.Atoi(os.Getenv("LISTEN_FDS"))
+	if err != nil || count < 1 {
+		return nil, errors.New("no sockets passed by systemd; is the service socket activated?")
+	}
+	names := strings.Split(os.Getenv("LISTEN_FDNAMES"), ":")
+
+	for i := range count {
+		fdName := ""
+		if i < len(names) {
+			fdName = names[i]
+		}
+		if name != "" && fdName != name {
+			continue
+		}
+
+		fd := listenFdsStart + i
+		f := os.NewFile(uintptr(fd), fdName)
+		// FileListener duplicates the descriptor, so the original is closed
+		ln, err := net.FileListener(f)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("systemd socket %d: %w", fd, err)
+		}
+		return ln, nil
+	}
+	return nil, fmt.Errorf("no socket named %q passed by systemd", name)
+}
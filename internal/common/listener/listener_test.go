@@ -0,0 +1,97 @@
+package listener_test
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/common/listener"
+)
+
+func TestListen(t *testing.T) {
+	Convey("Given a TCP address", t, func() {
+		ln, err := listener.Listen("127.0.0.1:0", 0o660)
+		So(err, ShouldBeNil)
+		defer ln.Close()
+
+		Convey("Then it listens on TCP", func() {
+			So(ln.Addr().Network(), ShouldEqual, "tcp")
+		})
+	})
+
+	Convey("Given a unix socket address", t, func() {
+		// Socket paths are limited to about 100 bytes, so keep them short
+		dir, err := os.MkdirTemp("", "ethos")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+		path := filepath.Join(dir, "api.sock")
+
+		Convey("When it is opened", func() {
+			ln, err := listener.Listen("unix:"+path, 0o660)
+			So(err, ShouldBeNil)
+
+			Convey("Then the socket has the requested permissions", func() {
+				info, err := os.Stat(path)
+				So(err, ShouldBeNil)
+				So(info.Mode().Type(), ShouldEqual, os.ModeSocket)
+				So(info.Mode().Perm(), ShouldEqual, os.FileMode(0o660))
+				ln.Close()
+			})
+
+			Convey("Then a second server can't take it over", func() {
+				_, err := listener.Listen("unix:"+path, 0o660)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "in use")
+				ln.Close()
+			})
+
+			Convey("Then closing it removes the socket", func() {
+				So(ln.Close(), ShouldBeNil)
+				_, err := os.Stat(path)
+				So(os.IsNotExist(err), ShouldBeTrue)
+			})
+		})
+
+		Convey("When a socket was left behind by a process that died", func() {
+			stale, err := net.Listen("unix", path)
+			So(err, ShouldBeNil)
+			stale.(*net.UnixListener).SetUnlinkOnClose(false)
+			So(stale.Close(), ShouldBeNil)
+
+			ln, err := listener.Listen("unix:"+path, 0o660)
+
+			Convey("Then it is replaced", func() {
+				So(err, ShouldBeNil)
+				So(ln.Close(), ShouldBeNil)
+			})
+		})
+
+		Convey("When something other than a socket is at the path", func() {
+			So(os.WriteFile(path, []byte("keep me"), 0o600), ShouldBeNil)
+
+			_, err := listener.Listen("unix:"+path, 0o660)
+
+			Convey("Then it is refused and the file is kept", func() {
+				So(err, ShouldNotBeNil)
+				content, err := os.ReadFile(path)
+				So(err, ShouldBeNil)
+				So(string(content), ShouldEqual, "keep me")
+			})
+		})
+	})
+
+	Convey("Given a systemd address without socket activation", t, func() {
+		t.Setenv("LISTEN_PID", "")
+		t.Setenv("LISTEN_FDS", "")
+
+		_, err := listener.Listen("systemd", 0o660)
+
+		Convey("Then it fails with a hint", func() {
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "socket activated")
+		})
+	})
+}
@@ -2,12 +2,16 @@ package logger
 
 import (
 	"math/rand"
+	"sync"
 )
 
 // Sampler determines whether an event should be kept or dropped.
 // Philosophy: Always keep errors, slow requests, and VIP users.
 // For normal requests, apply probabilistic sampling to reduce costs.
 type Sampler struct {
+	// mu guards the fields below so Update can be called while serving requests
+	mu sync.RWMutex
+
 	// BaseRate is the sampling rate for normal successful requests (e.g., 0.05 = 5%)
 	BaseRate float64
 
@@ -45,6 +49,15 @@ func DefaultSampler() *Sampler {
 	}
 }
 
+// Update changes the sampling rate and slow-request threshold at runtime,
+// e.g. after a configuration reload.
+func (s *Sampler) Update(baseRate float64, p99ThresholdMs int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.BaseRate = baseRate
+	s.P99ThresholdMs = p99ThresholdMs
+}
+
 // ShouldSample determines if an event should be kept based on tail sampling rules.
 // Events are ALWAYS kept if:
 // 1. Sampling is disabled
@@ -54,6 +67,9 @@ func DefaultSampler() *Sampler {
 //
 // Otherwise, random sampling is applied at the configured BaseRate.
 func (s *Sampler) ShouldSample(event *Event) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
 	// If sampling is disabled, always keep
 	if !s.Enabled {
 		return true
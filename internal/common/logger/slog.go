@@ -16,6 +16,16 @@ import (
 	"gopkg.in/natefinch/lumberjack.v2"
 )
 
+// level is shared by every handler created by New so SetLevel takes effect
+// without rebuilding the logger.
+var level = new(slog.LevelVar)
+
+// SetLevel changes the minimum log level at runtime ("debug", "info",
+// "warn" or "error"; anything else falls back to info).
+func SetLevel(lvl string) {
+	level.Set(parseLevel(lvl))
+}
+
 type slogLogger struct {
 	handler slog.Handler
 }
@@ -27,7 +37,7 @@ type slogLogger struct {
 func New(cfg *config.Config) (Logger, error) {
 	var handlers []slog.Handler
 
-	level := parseLevel(cfg.LoggerLevel)
+	SetLevel(cfg.LoggerLevel)
 
 	rotator := &lumberjack.Logger{
 		// Lokasi & nama file log utama. ->/var/log/app.log
@@ -1,10 +1,10 @@
 package metrics
 
 import (
+	"errors"
 	"sync"
 
 	"github.com/prometheus/client_golang/prometheus"
-	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/semmidev/ethos-go/internal/common/decorator"
 )
 
@@ -34,10 +34,7 @@ func (c *PrometheusMetricsClient) Inc(key string, value int) {
 		c.mu.Lock()
 		// Double-check after acquiring write lock
 		if counter, exists = c.counters[key]; !exists {
-			counter = promauto.NewCounter(prometheus.CounterOpts{
-				Name: sanitizeMetricName(key),
-				Help: "Auto-generated counter for " + key,
-			})
+			counter = registerCounter(key)
 			c.counters[key] = counter
 		}
 		c.mu.Unlock()
@@ -46,6 +43,24 @@ func (c *PrometheusMetricsClient) Inc(key string, value int) {
 	counter.Add(float64(value))
 }
 
+// registerCounter registers the counter for key, or returns the one already
+// registered by another client in the process, as when the e2e tests run
+// the API and the worker side by side
+func registerCounter(key string) prometheus.Counter {
+	counter := prometheus.NewCounter(prometheus.CounterOpts{
+		Name: sanitizeMetricName(key),
+		Help: "Auto-generated counter for " + key,
+	})
+	if err := prometheus.Register(counter); err != nil {
+		var registered prometheus.AlreadyRegisteredError
+		if !errors.As(err, &registered) {
+			panic(err)
+		}
+		return registered.ExistingCollector.(prometheus.Counter)
+	}
+	return counter
+}
+
 // sanitizeMetricName converts arbitrary strings to valid Prometheus metric names
 func sanitizeMetricName(name string) string {
 	result := make([]byte, 0, len(name))
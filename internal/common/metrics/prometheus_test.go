@@ -0,0 +1,43 @@
+package metrics_test
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/common/metrics"
+)
+
+func TestPrometheusMetricsClient(t *testing.T) {
+	Convey("Given two clients in one process, as when the API and worker run side by side", t, func() {
+		api := metrics.NewPrometheusMetricsClient()
+		worker := metrics.NewPrometheusMetricsClient()
+
+		Convey("When both count the same key", func() {
+			So(func() {
+				api.Inc("commands.metrics_test.success", 1)
+				worker.Inc("commands.metrics_test.success", 2)
+			}, ShouldNotPanic)
+
+			Convey("Then they share the registered counter", func() {
+				families, err := prometheus.DefaultGatherer.Gather()
+				So(err, ShouldBeNil)
+
+				var total float64
+				for _, f := range families {
+					if f.GetName() == "commands_metrics_test_success" {
+						total = f.GetMetric()[0].GetCounter().GetValue()
+					}
+				}
+				So(total, ShouldEqual, 3)
+			})
+		})
+
+		Convey("When a key cannot form a metric name", func() {
+			Convey("Then registering it still fails loudly", func() {
+				So(func() { api.Inc("", 1) }, ShouldPanic)
+			})
+		})
+	})
+}
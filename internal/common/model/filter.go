@@ -3,9 +3,15 @@ package model
 import (
 	"errors"
 	"net/http"
+	"slices"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
+
+	"github.com/semmidev/ethos-go/contracts/envelope"
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/dateutil"
 )
 
 // Filter represents comprehensive filter options for list queries
@@ -28,6 +34,10 @@ type Filter struct {
 	// Status Filters
 	IsActive   *bool `json:"is_active,omitempty" form:"is_active" query:"is_active"`       // filter by active status
 	IsInactive *bool `json:"is_inactive,omitempty" form:"is_inactive" query:"is_inactive"` // filter by inactive status
+
+	// AllowUnlimited lets PerPage be UnlimitedPage. Only internal and admin
+	// callers set it; filters parsed from requests never do.
+	AllowUnlimited bool `json:"-" form:"-" query:"-"`
 }
 
 // ListResponse is a generic wrapper for paginated list responses
@@ -47,13 +57,38 @@ const (
 	DefaultCurrentPage     int    = 1
 	DefaultColumnDirection string = AscDirection
 	UnlimitedPage          int    = -1
+	DefaultMaxPageLimit    int    = 100
 )
 
+// maxPageLimit is the largest PerPage Validate allows
+var maxPageLimit atomic.Int64
+
+func init() {
+	maxPageLimit.Store(int64(DefaultMaxPageLimit))
+}
+
+// SetMaxPageLimit sets the largest PerPage Validate allows. It is safe to
+// call while requests are served, so the limit can be reloaded.
+func SetMaxPageLimit(limit int) {
+	if limit < 1 {
+		limit = DefaultMaxPageLimit
+	}
+	maxPageLimit.Store(int64(limit))
+}
+
+// MaxPageLimit returns the largest PerPage Validate allows
+func MaxPageLimit() int {
+	return int(maxPageLimit.Load())
+}
+
 func (f *Filter) GetLimit() int {
 	return f.PerPage
 }
 
 func (f *Filter) GetOffset() int {
+	if f.IsUnlimitedPage() {
+		return 0
+	}
 	// ex:
 	// page 1 -> (1 - 1) * 10 = 0
 	// page 2 -> (2 - 1) * 10 = 10
@@ -81,17 +116,9 @@ func isUnlimitedPage(perPage int) bool {
 	return perPage == UnlimitedPage
 }
 
-type Paging struct {
-	HasPreviousPage        bool `json:"has_previous_page"`
-	HasNextPage            bool `json:"has_next_page"`
-	CurrentPage            int  `json:"current_page"`
-	PerPage                int  `json:"per_page"`
-	TotalData              int  `json:"total_data"`
-	TotalDataInCurrentPage int  `json:"total_data_in_current_page"`
-	LastPage               int  `json:"last_page"`
-	From                   int  `json:"from"`
-	To                     int  `json:"to"`
-}
+// Paging describes the page of a list response. It is part of the
+// response envelope clients parse, defined in the contracts module.
+type Paging = envelope.Paging
 
 var ErrPaging = errors.New("per_page harus lebih besar dari 0 dan offset tidak boleh negatif")
 
@@ -158,8 +185,9 @@ func NewPaging(currentPage, perPage, totalData int) (*Paging, error) {
 	}, nil
 }
 
-// FilterFromRequest parses filter parameters from an HTTP request
-func FilterFromRequest(r *http.Request) Filter {
+// FilterFromRequest parses filter parameters from an HTTP request. A
+// malformed start_date or end_date is a validation error.
+func FilterFromRequest(r *http.Request) (Filter, error) {
 	query := r.URL.Query()
 
 	filter := NewFilter()
@@ -208,14 +236,18 @@ func FilterFromRequest(r *http.Request) Filter {
 
 	// Parse date range
 	if startDate := query.Get("start_date"); startDate != "" {
-		if t, err := time.Parse("2006-01-02", startDate); err == nil {
-			filter.StartDate = &t
+		t, err := dateutil.ParseDate("start_date", startDate)
+		if err != nil {
+			return Filter{}, err
 		}
+		filter.StartDate = &t
 	}
 	if endDate := query.Get("end_date"); endDate != "" {
-		if t, err := time.Parse("2006-01-02", endDate); err == nil {
-			filter.EndDate = &t
+		t, err := dateutil.ParseDate("end_date", endDate)
+		if err != nil {
+			return Filter{}, err
 		}
+		filter.EndDate = &t
 	}
 
 	// Parse status filters
@@ -228,23 +260,32 @@ func FilterFromRequest(r *http.Request) Filter {
 		filter.IsInactive = &b
 	}
 
-	return filter
+	return filter, nil
 }
 
-// Validate validates the filter and sets defaults for invalid values
+// Validate validates the filter and sets defaults for invalid values.
+// PerPage is capped at MaxPageLimit, which unlimited pages are too unless
+// AllowUnlimited is set.
 func (f *Filter) Validate() {
 	if f.CurrentPage < 1 {
 		f.CurrentPage = DefaultCurrentPage
 	}
-	if f.PerPage < 1 && f.PerPage != UnlimitedPage {
+	switch {
+	case f.PerPage == UnlimitedPage && f.AllowUnlimited:
+	case f.PerPage == UnlimitedPage:
+		f.PerPage = MaxPageLimit()
+	case f.PerPage < 1:
 		f.PerPage = DefaultPageLimit
+	case f.PerPage > MaxPageLimit():
+		f.PerPage = MaxPageLimit()
 	}
 	if f.SortDirection != AscDirection && f.SortDirection != DescDirection {
 		f.SortDirection = DefaultColumnDirection
 	}
 }
 
-// AllowedSortColumns validates if the sort column is in the allowed list
+// ValidateSortBy reports whether the sort column is in the allowed list,
+// normalizing its case to the allowed column's
 func (f *Filter) ValidateSortBy(allowedColumns []string) bool {
 	if f.SortBy == "" {
 		return true
@@ -258,6 +299,25 @@ func (f *Filter) ValidateSortBy(allowedColumns []string) bool {
 	return false
 }
 
+// ValidateSort is ValidateSortBy for query handlers: a column not in the
+// allowed list is an invalid input error naming the allowed ones
+func (f *Filter) ValidateSort(allowedColumns []string) error {
+	if !f.ValidateSortBy(allowedColumns) {
+		return apperror.InvalidInput("sort_by", "must be one of "+strings.Join(allowedColumns, ", "))
+	}
+	return nil
+}
+
+// SortColumn returns SortBy when it is one of allowedColumns and fallback
+// otherwise. Repositories build ORDER BY from it, so a filter that was
+// never validated still can't inject SQL.
+func (f *Filter) SortColumn(allowedColumns []string, fallback string) string {
+	if slices.Contains(allowedColumns, f.SortBy) {
+		return f.SortBy
+	}
+	return fallback
+}
+
 // ActiveOnly returns true if only active items should be returned
 func (f *Filter) ActiveOnly() bool {
 	return f.IsActive != nil && *f.IsActive && (f.IsInactive == nil || !*f.IsInactive)
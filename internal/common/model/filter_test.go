@@ -0,0 +1,93 @@
+package model_test
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/model"
+)
+
+func TestFilterValidate(t *testing.T) {
+	Convey("Given the default page size cap", t, func() {
+		model.SetMaxPageLimit(model.DefaultMaxPageLimit)
+
+		Convey("Then a page larger than the cap is capped", func() {
+			f := model.Filter{PerPage: 100000}
+			f.Validate()
+			So(f.PerPage, ShouldEqual, model.DefaultMaxPageLimit)
+		})
+
+		Convey("Then a page within the cap is kept", func() {
+			f := model.Filter{PerPage: 50}
+			f.Validate()
+			So(f.PerPage, ShouldEqual, 50)
+		})
+
+		Convey("Then an unlimited page from a request is capped", func() {
+			f := model.Filter{PerPage: model.UnlimitedPage}
+			f.Validate()
+			So(f.PerPage, ShouldEqual, model.DefaultMaxPageLimit)
+			So(f.GetOffset(), ShouldEqual, 0)
+		})
+
+		Convey("Then an internal caller may still ask for every row", func() {
+			f := model.Filter{CurrentPage: 3, PerPage: model.UnlimitedPage, AllowUnlimited: true}
+			f.Validate()
+			So(f.IsUnlimitedPage(), ShouldBeTrue)
+			So(f.GetOffset(), ShouldEqual, 0)
+		})
+	})
+
+	Convey("Given a lowered page size cap", t, func() {
+		model.SetMaxPageLimit(10)
+		defer model.SetMaxPageLimit(model.DefaultMaxPageLimit)
+
+		f := model.Filter{PerPage: 20}
+		f.Validate()
+
+		Convey("Then pages are capped at it", func() {
+			So(f.PerPage, ShouldEqual, 10)
+			So(model.MaxPageLimit(), ShouldEqual, 10)
+		})
+	})
+}
+
+func TestFilterSort(t *testing.T) {
+	columns := []string{"name", "created_at"}
+
+	Convey("Given a sort column in a different case", t, func() {
+		f := model.Filter{SortBy: "Created_At"}
+
+		Convey("Then it is valid and normalized", func() {
+			So(f.ValidateSort(columns), ShouldBeNil)
+			So(f.SortColumn(columns, "name"), ShouldEqual, "created_at")
+		})
+	})
+
+	Convey("Given a sort column that is not allowed", t, func() {
+		f := model.Filter{SortBy: "password; DROP TABLE users"}
+
+		Convey("Then it is an invalid input naming the allowed columns", func() {
+			appErr := apperror.GetAppError(f.ValidateSort(columns))
+			So(appErr, ShouldNotBeNil)
+			So(appErr.Code, ShouldEqual, apperror.ErrCodeInvalidInput)
+			So(appErr.Details["field"], ShouldEqual, "sort_by")
+			So(appErr.Details["reason"], ShouldContainSubstring, "name, created_at")
+		})
+
+		Convey("Then repositories fall back to their default order", func() {
+			So(f.SortColumn(columns, "name"), ShouldEqual, "name")
+		})
+	})
+
+	Convey("Given no sort column", t, func() {
+		f := model.Filter{}
+
+		Convey("Then it is valid and the default order is used", func() {
+			So(f.ValidateSort(columns), ShouldBeNil)
+			So(f.SortColumn(columns, "name"), ShouldEqual, "name")
+		})
+	})
+}
@@ -44,10 +44,10 @@ func (db *InstrumentedDB) ExecContext(ctx context.Context, query string, args ..
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
-		recordDBMetrics(ctx, "exec", "error", duration)
+		recordDBMetrics(ctx, query, "error", duration)
 	} else {
 		span.SetStatus(codes.Ok, "")
-		recordDBMetrics(ctx, "exec", "success", duration)
+		recordDBMetrics(ctx, query, "success", duration)
 	}
 
 	return result, err
@@ -88,10 +88,10 @@ func (db *InstrumentedDB) GetContext(ctx context.Context, dest interface{}, quer
 	if err != nil && err != sql.ErrNoRows {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
-		recordDBMetrics(ctx, "get", "error", duration)
+		recordDBMetrics(ctx, query, "error", duration)
 	} else {
 		span.SetStatus(codes.Ok, "")
-		recordDBMetrics(ctx, "get", "success", duration)
+		recordDBMetrics(ctx, query, "success", duration)
 	}
 
 	return err
@@ -116,10 +116,10 @@ func (db *InstrumentedDB) SelectContext(ctx context.Context, dest interface{}, q
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
-		recordDBMetrics(ctx, "select", "error", duration)
+		recordDBMetrics(ctx, query, "error", duration)
 	} else {
 		span.SetStatus(codes.Ok, "")
-		recordDBMetrics(ctx, "select", "success", duration)
+		recordDBMetrics(ctx, query, "success", duration)
 	}
 
 	return err
@@ -156,9 +156,10 @@ func truncateQuery(query string) string {
 	return query
 }
 
-func recordDBMetrics(ctx context.Context, operation, status string, duration time.Duration) {
+func recordDBMetrics(ctx context.Context, query, status string, duration time.Duration) {
 	metrics := GetMetrics()
 	if metrics != nil {
-		metrics.RecordDBQuery(ctx, operation, "unknown", status, duration)
+		operation, table := QueryLabels(query)
+		metrics.RecordDBQuery(ctx, operation, table, status, duration)
 	}
 }
@@ -0,0 +1,59 @@
+package observability
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hibiken/asynq"
+)
+
+// DeadTask describes a task that exhausted its retries and was moved to
+// the dead (archived) queue
+type DeadTask struct {
+	ID       string
+	Queue    string
+	Type     string
+	Retried  int
+	MaxRetry int
+	Err      error
+}
+
+// IsDead reports whether a task failing with err after retried of
+// maxRetry retries is archived rather than retried, mirroring Asynq
+func IsDead(err error, retried, maxRetry int) bool {
+	if errors.Is(err, asynq.RevokeTask) {
+		return false
+	}
+	return retried >= maxRetry || errors.Is(err, asynq.SkipRetry)
+}
+
+// DeadTaskErrorHandler is an Asynq error handler that counts tasks landing
+// in the dead queue and passes them to alert. Failures that will be retried
+// are ignored. alert may be nil.
+func DeadTaskErrorHandler(alert func(ctx context.Context, task DeadTask)) asynq.ErrorHandler {
+	return asynq.ErrorHandlerFunc(func(ctx context.Context, task *asynq.Task, err error) {
+		retried, _ := asynq.GetRetryCount(ctx)
+		maxRetry, _ := asynq.GetMaxRetry(ctx)
+		if !IsDead(err, retried, maxRetry) {
+			return
+		}
+
+		id, _ := asynq.GetTaskID(ctx)
+		queue, _ := asynq.GetQueueName(ctx)
+		dead := DeadTask{
+			ID:       id,
+			Queue:    queue,
+			Type:     task.Type(),
+			Retried:  retried,
+			MaxRetry: maxRetry,
+			Err:      err,
+		}
+
+		if m := GetMetrics(); m != nil {
+			m.RecordTaskDead(ctx, dead.Type, dead.Queue)
+		}
+		if alert != nil {
+			alert(ctx, dead)
+		}
+	})
+}
@@ -0,0 +1,38 @@
+package observability_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hibiken/asynq"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/common/observability"
+)
+
+func TestIsDead(t *testing.T) {
+	Convey("Given a failed task", t, func() {
+		err := errors.New("smtp: connection refused")
+
+		Convey("It is retried while retries remain", func() {
+			So(observability.IsDead(err, 2, 5), ShouldBeFalse)
+		})
+
+		Convey("It is dead once the retries are used up", func() {
+			So(observability.IsDead(err, 5, 5), ShouldBeTrue)
+		})
+
+		Convey("Tasks without retries die on the first failure", func() {
+			So(observability.IsDead(err, 0, 0), ShouldBeTrue)
+		})
+
+		Convey("SkipRetry kills it straight away", func() {
+			So(observability.IsDead(fmt.Errorf("bad payload: %w", asynq.SkipRetry), 0, 5), ShouldBeTrue)
+		})
+
+		Convey("Revoked tasks are dropped, not archived", func() {
+			So(observability.IsDead(asynq.RevokeTask, 5, 5), ShouldBeFalse)
+		})
+	})
+}
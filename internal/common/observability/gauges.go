@@ -0,0 +1,238 @@
+package observability
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/semmidev/ethos-go/internal/common/breaker"
+)
+
+// RegisterDBPoolMetrics exports connection pool statistics for db.
+// Values are read from db.Stats() on every collection.
+func RegisterDBPoolMetrics(db *sql.DB) error {
+	meter := otel.Meter(instrumentationName)
+
+	conns, err := meter.Int64ObservableGauge(
+		"db_pool_connections",
+		metric.WithDescription("Database connections in the pool by state"),
+		metric.WithUnit("{connection}"),
+	)
+	if err != nil {
+		return err
+	}
+
+	waits, err := meter.Int64ObservableCounter(
+		"db_pool_wait_total",
+		metric.WithDescription("Total number of times a query waited for a free connection"),
+		metric.WithUnit("{wait}"),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		s := db.Stats()
+		o.ObserveInt64(conns, int64(s.InUse), metric.WithAttributes(attribute.String("state", "in_use")))
+		o.ObserveInt64(conns, int64(s.Idle), metric.WithAttributes(attribute.String("state", "idle")))
+		o.ObserveInt64(conns, int64(s.MaxOpenConnections), metric.WithAttributes(attribute.String("state", "max_open")))
+		o.ObserveInt64(waits, s.WaitCount)
+		return nil
+	}, conns, waits)
+	return err
+}
+
+// RegisterQueueMetrics exports the size of every Asynq queue by task state,
+// plus the age of the oldest pending task.
+func RegisterQueueMetrics(inspector *asynq.Inspector) error {
+	meter := otel.Meter(instrumentationName)
+
+	size, err := meter.Int64ObservableGauge(
+		"asynq_queue_tasks",
+		metric.WithDescription("Tasks in an Asynq queue by state"),
+		metric.WithUnit("{task}"),
+	)
+	if err != nil {
+		return err
+	}
+
+	latency, err := meter.Float64ObservableGauge(
+		"asynq_queue_latency_seconds",
+		metric.WithDescription("Age of the oldest pending task in an Asynq queue"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		queues, err := inspector.Queues()
+		if err != nil {
+			return err
+		}
+
+		for _, q := range queues {
+			info, err := inspector.GetQueueInfo(q)
+			if err != nil {
+				continue
+			}
+
+			for state, n := range map[string]int{
+				"pending":   info.Pending,
+				"active":    info.Active,
+				"scheduled": info.Scheduled,
+				"retry":     info.Retry,
+				"archived":  info.Archived,
+			} {
+				o.ObserveInt64(size, int64(n), metric.WithAttributes(
+					attribute.String("queue", q),
+					attribute.String("state", state),
+				))
+			}
+			o.ObserveFloat64(latency, info.Latency.Seconds(), metric.WithAttributes(attribute.String("queue", q)))
+		}
+		return nil
+	}, size, latency)
+	return err
+}
+
+// OutboxStats is a snapshot of the transactional outbox backlog.
+type OutboxStats struct {
+	// Pending is the number of events not yet published.
+	Pending int64
+	// Failed is the number of pending events that have failed at least once.
+	Failed int64
+	// OldestPending is when the oldest unpublished event was written,
+	// or nil when the outbox is drained.
+	OldestPending *time.Time
+}
+
+// RegisterOutboxMetrics exports outbox backlog size and lag. stats is
+// called on every collection, so it should be a single cheap query.
+func RegisterOutboxMetrics(stats func(ctx context.Context) (OutboxStats, error)) error {
+	meter := otel.Meter(instrumentationName)
+
+	pending, err := meter.Int64ObservableGauge(
+		"outbox_pending_events",
+		metric.WithDescription("Unpublished events in the outbox"),
+		metric.WithUnit("{event}"),
+	)
+	if err != nil {
+		return err
+	}
+
+	failed, err := meter.Int64ObservableGauge(
+		"outbox_failed_events",
+		metric.WithDescription("Unpublished outbox events that have failed at least once"),
+		metric.WithUnit("{event}"),
+	)
+	if err != nil {
+		return err
+	}
+
+	lag, err := meter.Float64ObservableGauge(
+		"outbox_lag_seconds",
+		metric.WithDescription("Age of the oldest unpublished outbox event"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = meter.RegisterCallback(func(ctx context.Context, o metric.Observer) error {
+		s, err := stats(ctx)
+		if err != nil {
+			return err
+		}
+
+		o.ObserveInt64(pending, s.Pending)
+		o.ObserveInt64(failed, s.Failed)
+		if s.OldestPending != nil {
+			o.ObserveFloat64(lag, time.Since(*s.OldestPending).Seconds())
+		} else {
+			o.ObserveFloat64(lag, 0)
+		}
+		return nil
+	}, pending, failed, lag)
+	return err
+}
+
+// RegisterConsumerLagMetrics exports how far an event consumer is behind
+// its stream. lag reports messages not yet delivered and messages delivered
+// but not yet acknowledged.
+func RegisterConsumerLagMetrics(consumer string, lag func(ctx context.Context) (pending, ackPending uint64, err error)) error {
+	meter := otel.Meter(instrumentationName)
+
+	gauge, err := meter.Int64ObservableGauge(
+		"event_consumer_lag_messages",
+		metric.WithDescription("Messages an event consumer has not finished, by state"),
+		metric.WithUnit("{message}"),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = meter.RegisterCallback(func(ctx context.Context, o metric.Observer) error {
+		pending, ackPending, err := lag(ctx)
+		if err != nil {
+			return err
+		}
+
+		o.ObserveInt64(gauge, int64(pending), metric.WithAttributes(
+			attribute.String("consumer", consumer),
+			attribute.String("state", "pending"),
+		))
+		o.ObserveInt64(gauge, int64(ackPending), metric.WithAttributes(
+			attribute.String("consumer", consumer),
+			attribute.String("state", "ack_pending"),
+		))
+		return nil
+	}, gauge)
+	return err
+}
+
+// RegisterBreakerMetrics exports the state of circuit breakers and how
+// many calls each refused. The state gauge is 1 for a breaker's current
+// state and 0 for the others.
+func RegisterBreakerMetrics(breakers ...*breaker.Breaker) error {
+	meter := otel.Meter(instrumentationName)
+
+	state, err := meter.Int64ObservableGauge(
+		"circuit_breaker_state",
+		metric.WithDescription("Whether a circuit breaker is in a state"),
+	)
+	if err != nil {
+		return err
+	}
+
+	rejected, err := meter.Int64ObservableCounter(
+		"circuit_breaker_rejected_total",
+		metric.WithDescription("Total number of calls a circuit breaker refused"),
+		metric.WithUnit("{call}"),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		for _, b := range breakers {
+			stats := b.Stats()
+			name := attribute.String("breaker", stats.Name)
+			for _, s := range []breaker.State{breaker.Closed, breaker.HalfOpen, breaker.Open} {
+				var value int64
+				if s == stats.State {
+					value = 1
+				}
+				o.ObserveInt64(state, value, metric.WithAttributes(name, attribute.String("state", s.String())))
+			}
+			o.ObserveInt64(rejected, stats.Rejected, metric.WithAttributes(name))
+		}
+		return nil
+	}, state, rejected)
+	return err
+}
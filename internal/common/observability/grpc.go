@@ -0,0 +1,24 @@
+package observability
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor records request count and latency for every unary
+// gRPC call, labelled by full method name and status code.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+
+		if m := GetMetrics(); m != nil {
+			m.RecordGRPCRequest(ctx, info.FullMethod, status.Code(err).String(), time.Since(start))
+		}
+
+		return resp, err
+	}
+}
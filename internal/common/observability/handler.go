@@ -0,0 +1,31 @@
+package observability
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// MetricsHandler serves the Prometheus scrape endpoint. When username is
+// non-empty the endpoint requires HTTP basic auth.
+func MetricsHandler(username, password string) http.Handler {
+	handler := promhttp.Handler()
+	if username == "" {
+		return handler
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, pass, ok := r.BasicAuth()
+		// Both are compared in constant time and in full, so the response
+		// time tells nothing about either
+		userOK := subtle.ConstantTimeCompare([]byte(user), []byte(username))
+		passOK := subtle.ConstantTimeCompare([]byte(pass), []byte(password))
+		if !ok || userOK&passOK != 1 {
+			w.Header().Set("WWW-Authenticate", `Basic realm="metrics"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,83 @@
+package observability_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/common/observability"
+)
+
+func TestMetricsHandler(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		username, password string
+		sendAuth           bool
+		sendUser, sendPass string
+		wantStatus         int
+	}{
+		{
+			name:       "no credentials",
+			username:   "prometheus",
+			password:   "scrape-secret",
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "wrong password",
+			username:   "prometheus",
+			password:   "scrape-secret",
+			sendAuth:   true,
+			sendUser:   "prometheus",
+			sendPass:   "guess",
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "wrong username",
+			username:   "prometheus",
+			password:   "scrape-secret",
+			sendAuth:   true,
+			sendUser:   "grafana",
+			sendPass:   "scrape-secret",
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "correct credentials",
+			username:   "prometheus",
+			password:   "scrape-secret",
+			sendAuth:   true,
+			sendUser:   "prometheus",
+			sendPass:   "scrape-secret",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "auth off when no credentials are configured",
+			wantStatus: http.StatusOK,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			Convey("When metrics are scraped with "+tc.name, t, func() {
+				req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+				if tc.sendAuth {
+					req.SetBasicAuth(tc.sendUser, tc.sendPass)
+				}
+				w := httptest.NewRecorder()
+				observability.MetricsHandler(tc.username, tc.password).ServeHTTP(w, req)
+
+				Convey("Then the response has the expected status", func() {
+					So(w.Code, ShouldEqual, tc.wantStatus)
+					if tc.wantStatus == http.StatusUnauthorized {
+						So(w.Header().Get("WWW-Authenticate"), ShouldEqual, `Basic realm="metrics"`)
+					} else {
+						So(w.Body.String(), ShouldContainSubstring, "# TYPE")
+					}
+				})
+			})
+		})
+	}
+}
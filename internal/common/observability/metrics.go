@@ -15,6 +15,11 @@ type Metrics struct {
 	HTTPRequestsTotal   metric.Int64Counter
 	HTTPRequestDuration metric.Float64Histogram
 	HTTPRequestsActive  metric.Int64UpDownCounter
+	HTTPPanicsTotal     metric.Int64Counter
+
+	// gRPC metrics
+	GRPCRequestsTotal   metric.Int64Counter
+	GRPCRequestDuration metric.Float64Histogram
 
 	// Database metrics
 	DBQueryDuration   metric.Float64Histogram
@@ -31,6 +36,12 @@ type Metrics struct {
 	AuthAttemptsTotal metric.Int64Counter
 	ActiveSessions    metric.Int64UpDownCounter
 
+	// Async pipeline metrics
+	OutboxPublishedTotal metric.Int64Counter
+	EventsHandledTotal   metric.Int64Counter
+	EventLatency         metric.Float64Histogram
+	TasksDeadTotal       metric.Int64Counter
+
 	// Business metrics
 	HabitsCreated   metric.Int64Counter
 	HabitLogsTotal  metric.Int64Counter
@@ -75,6 +86,35 @@ func InitMetrics(ctx context.Context) (*Metrics, error) {
 		return nil, err
 	}
 
+	m.HTTPPanicsTotal, err = meter.Int64Counter(
+		"http_panics_total",
+		metric.WithDescription("Total number of HTTP handler panics recovered"),
+		metric.WithUnit("{panic}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// gRPC metrics
+	m.GRPCRequestsTotal, err = meter.Int64Counter(
+		"grpc_server_requests_total",
+		metric.WithDescription("Total number of gRPC requests handled"),
+		metric.WithUnit("{request}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	m.GRPCRequestDuration, err = meter.Float64Histogram(
+		"grpc_server_request_duration_seconds",
+		metric.WithDescription("gRPC request duration in seconds"),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(0.001, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	// Database metrics
 	m.DBQueryDuration, err = meter.Float64Histogram(
 		"db_query_duration_seconds",
@@ -160,6 +200,44 @@ func InitMetrics(ctx context.Context) (*Metrics, error) {
 		return nil, err
 	}
 
+	// Async pipeline metrics
+	m.OutboxPublishedTotal, err = meter.Int64Counter(
+		"outbox_published_total",
+		metric.WithDescription("Total number of outbox publish attempts"),
+		metric.WithUnit("{event}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	m.EventsHandledTotal, err = meter.Int64Counter(
+		"events_handled_total",
+		metric.WithDescription("Total number of domain events handled by consumers"),
+		metric.WithUnit("{event}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	m.EventLatency, err = meter.Float64Histogram(
+		"event_end_to_end_latency_seconds",
+		metric.WithDescription("Time from an event occurring to a consumer finishing with it"),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120, 300, 600),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	m.TasksDeadTotal, err = meter.Int64Counter(
+		"asynq_tasks_dead_total",
+		metric.WithDescription("Total number of background tasks moved to the dead queue"),
+		metric.WithUnit("{task}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	// Business metrics
 	m.HabitsCreated, err = meter.Int64Counter(
 		"habits_created_total",
@@ -209,6 +287,25 @@ func (m *Metrics) RecordHTTPRequest(ctx context.Context, method, path string, st
 	m.HTTPRequestDuration.Record(ctx, duration.Seconds(), metric.WithAttributes(attrs...))
 }
 
+// RecordHTTPPanic records a panic recovered from an HTTP handler
+func (m *Metrics) RecordHTTPPanic(ctx context.Context, method, path string) {
+	m.HTTPPanicsTotal.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("http.method", method),
+		attribute.String("http.path", path),
+	))
+}
+
+// RecordGRPCRequest records gRPC request metrics
+func (m *Metrics) RecordGRPCRequest(ctx context.Context, method, code string, duration time.Duration) {
+	attrs := []attribute.KeyValue{
+		attribute.String("grpc.method", method),
+		attribute.String("grpc.code", code),
+	}
+
+	m.GRPCRequestsTotal.Add(ctx, 1, metric.WithAttributes(attrs...))
+	m.GRPCRequestDuration.Record(ctx, duration.Seconds(), metric.WithAttributes(attrs...))
+}
+
 // RecordDBQuery records database query metrics
 func (m *Metrics) RecordDBQuery(ctx context.Context, operation, table, status string, duration time.Duration) {
 	attrs := []attribute.KeyValue{
@@ -232,6 +329,36 @@ func (m *Metrics) RecordCommand(ctx context.Context, command, status string, dur
 	m.CommandDuration.Record(ctx, duration.Seconds(), metric.WithAttributes(attrs...))
 }
 
+// RecordOutboxPublish records an outbox publish attempt
+func (m *Metrics) RecordOutboxPublish(ctx context.Context, eventType, status string) {
+	m.OutboxPublishedTotal.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("event_type", eventType),
+		attribute.String("status", status),
+	))
+}
+
+// RecordEventHandled records a consumed event and, when its occurrence
+// time is known, the end-to-end latency from occurrence to handling
+func (m *Metrics) RecordEventHandled(ctx context.Context, eventType, status string, occurredAt time.Time) {
+	attrs := []attribute.KeyValue{
+		attribute.String("event_type", eventType),
+		attribute.String("status", status),
+	}
+
+	m.EventsHandledTotal.Add(ctx, 1, metric.WithAttributes(attrs...))
+	if !occurredAt.IsZero() {
+		m.EventLatency.Record(ctx, time.Since(occurredAt).Seconds(), metric.WithAttributes(attrs...))
+	}
+}
+
+// RecordTaskDead records a task that exhausted its retries
+func (m *Metrics) RecordTaskDead(ctx context.Context, taskType, queue string) {
+	m.TasksDeadTotal.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("task_type", taskType),
+		attribute.String("queue", queue),
+	))
+}
+
 // RecordAuthAttempt records authentication attempt
 func (m *Metrics) RecordAuthAttempt(ctx context.Context, authType, status string) {
 	attrs := []attribute.KeyValue{
@@ -9,8 +9,9 @@ import (
 	"go.opentelemetry.io/otel/trace"
 )
 
-// HTTPMiddleware returns an HTTP middleware that instruments requests with OpenTelemetry
-func HTTPMiddleware(serviceName string) func(http.Handler) http.Handler {
+// HTTPMiddleware returns an HTTP middleware that instruments requests with
+// OpenTelemetry. Requests are also counted towards slo when it is not nil.
+func HTTPMiddleware(serviceName string, slo *SLOTracker) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		// Wrap with otelhttp for automatic tracing
 		handler := otelhttp.NewHandler(next, serviceName,
@@ -41,6 +42,9 @@ func HTTPMiddleware(serviceName string) func(http.Handler) http.Handler {
 			if metrics != nil {
 				metrics.RecordHTTPRequest(r.Context(), r.Method, r.URL.Path, wrapped.statusCode, duration)
 			}
+			if slo != nil {
+				slo.Record(r.URL.Path, wrapped.statusCode)
+			}
 
 			// Add response attributes to span
 			span := trace.SpanFromContext(r.Context())
@@ -0,0 +1,192 @@
+package observability
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/semmidev/ethos-go/internal/common/logger"
+)
+
+// DefaultCaptureMaxBytes is how much of each body is captured unless
+// configured otherwise
+const DefaultCaptureMaxBytes = 8 << 10
+
+// captureLogStream tags capture log lines so they can be routed or queried
+// apart from the rest of the logs
+const captureLogStream = "debug_capture"
+
+// PayloadCaptureConfig selects the requests whose payloads are captured
+type PayloadCaptureConfig struct {
+	// Routes are URL path prefixes such as /v1/habits
+	Routes []string
+	// Users are the IDs of users whose requests are captured on any route
+	Users []string
+	// MaxBytes caps how much of each body is kept
+	MaxBytes int
+}
+
+// PayloadCapture records the redacted request and response bodies of
+// selected requests, to diagnose client-reported issues. Captures go to the
+// log as "debug_payload_captured" lines on the debug_capture stream and are
+// attached to the request's span. Nothing is captured until routes or users
+// are selected, and the selection can be changed while serving.
+type PayloadCapture struct {
+	mu       sync.RWMutex
+	routes   []string
+	users    map[string]bool
+	maxBytes int
+
+	// userID identifies the authenticated user of a request, "" if none
+	userID func(r *http.Request) string
+	log    logger.Logger
+}
+
+// NewPayloadCapture creates a capture middleware. userID resolves the user
+// of a request for per-user selection; it is only called while users are
+// selected.
+func NewPayloadCapture(cfg PayloadCaptureConfig, userID func(r *http.Request) string, log logger.Logger) *PayloadCapture {
+	c := &PayloadCapture{userID: userID, log: log}
+	c.Update(cfg)
+	return c
+}
+
+// Update replaces the selection, e.g. after a configuration reload
+func (c *PayloadCapture) Update(cfg PayloadCaptureConfig) {
+	users := make(map[string]bool, len(cfg.Users))
+	for _, id := range cfg.Users {
+		users[id] = true
+	}
+	maxBytes := cfg.MaxBytes
+	if maxBytes <= 0 {
+		maxBytes = DefaultCaptureMaxBytes
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.routes = append([]string(nil), cfg.Routes...)
+	c.users = users
+	c.maxBytes = maxBytes
+}
+
+// Middleware captures the payloads of selected requests
+func (c *PayloadCapture) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		maxBytes, ok := c.selected(r)
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		start := time.Now()
+		reqBody := &limitedBuffer{limit: maxBytes}
+		if r.Body != nil && r.Body != http.NoBody {
+			r.Body = teeReadCloser{Reader: io.TeeReader(r.Body, reqBody), Closer: r.Body}
+		}
+		cw := &captureWriter{ResponseWriter: w, statusCode: http.StatusOK, body: limitedBuffer{limit: maxBytes}}
+
+		next.ServeHTTP(cw, r)
+
+		c.record(r, cw, reqBody, time.Since(start))
+	})
+}
+
+// selected reports whether r is captured and how many body bytes to keep
+func (c *PayloadCapture) selected(r *http.Request) (int, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for _, prefix := range c.routes {
+		if strings.HasPrefix(r.URL.Path, prefix) {
+			return c.maxBytes, true
+		}
+	}
+	if len(c.users) > 0 && c.userID != nil {
+		if id := c.userID(r); id != "" && c.users[id] {
+			return c.maxBytes, true
+		}
+	}
+	return 0, false
+}
+
+func (c *PayloadCapture) record(r *http.Request, cw *captureWriter, reqBody *limitedBuffer, duration time.Duration) {
+	ctx := r.Context()
+	request := RedactBody(r.Header.Get("Content-Type"), reqBody.Bytes(), reqBody.truncated)
+	response := RedactBody(cw.Header().Get("Content-Type"), cw.body.Bytes(), cw.body.truncated)
+	query := RedactQuery(r.URL.RawQuery)
+
+	trace.SpanFromContext(ctx).AddEvent("debug.payload", trace.WithAttributes(
+		attribute.String("http.request.query", query),
+		attribute.String("http.request.body", request),
+		attribute.String("http.response.body", response),
+	))
+	logger.SetCustom(ctx, "payload_captured", true)
+
+	c.log.Info(ctx, "debug_payload_captured",
+		logger.Field{Key: "stream", Value: captureLogStream},
+		logger.Field{Key: "method", Value: r.Method},
+		logger.Field{Key: "path", Value: r.URL.Path},
+		logger.Field{Key: "query", Value: query},
+		logger.Field{Key: "status_code", Value: cw.statusCode},
+		logger.Field{Key: "duration_ms", Value: duration.Milliseconds()},
+		logger.Field{Key: "request_body", Value: request},
+		logger.Field{Key: "response_body", Value: response},
+	)
+}
+
+// limitedBuffer keeps the first limit bytes written to it
+type limitedBuffer struct {
+	bytes.Buffer
+	limit     int
+	truncated bool
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if room := b.limit - b.Len(); room < len(p) {
+		b.truncated = true
+		if room > 0 {
+			b.Buffer.Write(p[:room])
+		}
+		return len(p), nil
+	}
+	return b.Buffer.Write(p)
+}
+
+type teeReadCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// captureWriter copies the start of the response body
+type captureWriter struct {
+	http.ResponseWriter
+	statusCode  int
+	wroteHeader bool
+	body        limitedBuffer
+}
+
+func (w *captureWriter) WriteHeader(code int) {
+	if !w.wroteHeader {
+		w.statusCode = code
+		w.wroteHeader = true
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *captureWriter) Write(b []byte) (int, error) {
+	w.wroteHeader = true
+	n, err := w.ResponseWriter.Write(b)
+	_, _ = w.body.Write(b[:n])
+	return n, err
+}
+
+// Unwrap returns the original ResponseWriter for compatibility
+func (w *captureWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
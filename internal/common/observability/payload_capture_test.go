@@ -0,0 +1,112 @@
+package observability_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/common/observability"
+	"github.com/semmidev/ethos-go/internal/testutil"
+)
+
+func TestPayloadCapture(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given a capture selecting the auth routes and one user", t, func() {
+		log := testutil.NewRecordingLogger()
+		userOf := func(r *http.Request) string { return r.Header.Get("X-Test-User") }
+		capture := observability.NewPayloadCapture(observability.PayloadCaptureConfig{
+			Routes: []string{"/v1/auth"},
+			Users:  []string{"user-1"},
+		}, userOf, log)
+
+		var handlerSaw string
+		handler := capture.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			handlerSaw = string(body)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"data":{"access_token":"eyJhbGciOiJIUzI1NiJ9.eyJzdWIiOiIxIn0.sig","email":"ana@example.com"}}`))
+		}))
+
+		serve := func(path, user, body string) *httptest.ResponseRecorder {
+			r := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+			r.Header.Set("Content-Type", "application/json")
+			r.Header.Set("X-Test-User", user)
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+			return w
+		}
+
+		Convey("When a selected route is called, the handler and client are unaffected", func() {
+			body := `{"email":"ana@example.com","password":"hunter22","name":"Ana"}`
+			w := serve("/v1/auth/login?token=abc&page=2", "", body)
+
+			So(handlerSaw, ShouldEqual, body)
+			So(w.Code, ShouldEqual, http.StatusCreated)
+			So(w.Body.String(), ShouldContainSubstring, "ana@example.com")
+
+			Convey("Then one capture is logged with credentials and emails redacted", func() {
+				entries := log.Entries()
+				So(entries, ShouldHaveLength, 1)
+				fields := entries[0].Fields
+				So(entries[0].Message, ShouldEqual, "debug_payload_captured")
+				So(fields["stream"], ShouldEqual, "debug_capture")
+				So(fields["status_code"], ShouldEqual, http.StatusCreated)
+				So(fields["query"], ShouldEqual, "page=2&token=[REDACTED]")
+				So(fields["request_body"], ShouldEqual, `{"email":"[EMAIL]","name":"Ana","password":"[REDACTED]"}`)
+				So(fields["response_body"], ShouldEqual, `{"data":{"access_token":"[REDACTED]","email":"[EMAIL]"}}`)
+			})
+		})
+
+		Convey("When a selected user calls any route, it is captured", func() {
+			serve("/v1/habits", "user-1", `{}`)
+			So(log.Entries(), ShouldHaveLength, 1)
+		})
+
+		Convey("When anyone else calls another route, nothing is captured", func() {
+			serve("/v1/habits", "user-2", `{}`)
+			So(log.Entries(), ShouldBeEmpty)
+		})
+
+		Convey("When the selection is cleared, nothing is captured", func() {
+			capture.Update(observability.PayloadCaptureConfig{})
+			serve("/v1/auth/login", "user-1", `{}`)
+			So(log.Entries(), ShouldBeEmpty)
+		})
+
+		Convey("When a body exceeds the limit, the kept part is still redacted", func() {
+			capture.Update(observability.PayloadCaptureConfig{Routes: []string{"/"}, MaxBytes: 40})
+			serve("/v1/auth/register", "", `{"password":"hunter22hunter22","email":"ana@example.com"}`)
+
+			request := log.Entries()[0].Fields["request_body"].(string)
+			So(request, ShouldNotContainSubstring, "hunter22")
+			So(request, ShouldEndWith, "[truncated]")
+		})
+	})
+}
+
+func TestRedactBody(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given bodies of different types", t, func() {
+		Convey("Then form fields are redacted by name", func() {
+			So(observability.RedactBody("application/x-www-form-urlencoded", []byte("client_secret=s3&state=x"), false),
+				ShouldEqual, "client_secret=[REDACTED]&state=x")
+		})
+
+		Convey("Then binary bodies are summarized, not recorded", func() {
+			So(observability.RedactBody("image/png", []byte{0x89, 'P', 'N', 'G'}, false),
+				ShouldEqual, "[4 bytes of image/png omitted]")
+		})
+
+		Convey("Then tokens and emails in text are masked", func() {
+			So(observability.RedactBody("text/plain", []byte("mail bob@example.org with eyJa.eyJb.c"), false),
+				ShouldEqual, "mail [EMAIL] with [TOKEN]")
+		})
+	})
+}
@@ -0,0 +1,146 @@
+package observability
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hibiken/asynq"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// traceContextField is the JSON field that carries W3C trace context
+// (traceparent/tracestate) inside task payloads and outbox metadata.
+const traceContextField = "trace_context"
+
+// TraceCarrier returns the trace context of ctx as a string map, or nil
+// when ctx has no active span.
+func TraceCarrier(ctx context.Context) map[string]string {
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	if len(carrier) == 0 {
+		return nil
+	}
+	return carrier
+}
+
+// ContextWithCarrier returns ctx joined to the trace described by carrier.
+func ContextWithCarrier(ctx context.Context, carrier map[string]string) context.Context {
+	if len(carrier) == 0 {
+		return ctx
+	}
+	return otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier(carrier))
+}
+
+// InjectHeaders adds the trace context of ctx to message headers, such as
+// a NATS message's.
+func InjectHeaders(ctx context.Context, header map[string][]string) {
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(header))
+}
+
+// ContextWithHeaders returns ctx joined to the trace carried in message
+// headers by InjectHeaders.
+func ContextWithHeaders(ctx context.Context, header map[string][]string) context.Context {
+	if len(header) == 0 {
+		return ctx
+	}
+	return otel.GetTextMapPropagator().Extract(ctx, propagation.HeaderCarrier(header))
+}
+
+// InjectTraceMetadata returns a JSON object holding the trace context of
+// ctx, suitable for a metadata column.
+func InjectTraceMetadata(ctx context.Context) json.RawMessage {
+	carrier := TraceCarrier(ctx)
+	if carrier == nil {
+		return json.RawMessage(`{}`)
+	}
+
+	data, err := json.Marshal(map[string]interface{}{traceContextField: carrier})
+	if err != nil {
+		return json.RawMessage(`{}`)
+	}
+	return data
+}
+
+// ExtractTraceMetadata is the inverse of InjectTraceMetadata.
+func ExtractTraceMetadata(ctx context.Context, metadata []byte) context.Context {
+	var m struct {
+		TraceContext map[string]string `json:"trace_context"`
+	}
+	if len(metadata) == 0 || json.Unmarshal(metadata, &m) != nil {
+		return ctx
+	}
+	return ContextWithCarrier(ctx, m.TraceContext)
+}
+
+// NewTracedTask creates an Asynq task whose JSON payload also carries the
+// trace context of ctx, so the worker's span joins the caller's trace.
+// Task payload structs ignore the extra field when unmarshalled.
+func NewTracedTask(ctx context.Context, typename string, payload []byte, opts ...asynq.Option) *asynq.Task {
+	return asynq.NewTask(typename, injectPayload(ctx, payload), opts...)
+}
+
+func injectPayload(ctx context.Context, payload []byte) []byte {
+	carrier := TraceCarrier(ctx)
+	if carrier == nil {
+		return payload
+	}
+
+	fields := map[string]json.RawMessage{}
+	if len(payload) > 0 {
+		// Only JSON objects can carry the extra field
+		if err := json.Unmarshal(payload, &fields); err != nil {
+			return payload
+		}
+	}
+
+	encoded, err := json.Marshal(carrier)
+	if err != nil {
+		return payload
+	}
+	fields[traceContextField] = encoded
+
+	out, err := json.Marshal(fields)
+	if err != nil {
+		return payload
+	}
+	return out
+}
+
+// AsynqTracingMiddleware starts a consumer span around every task,
+// parented to the trace context carried in the task payload.
+func AsynqTracingMiddleware() asynq.MiddlewareFunc {
+	tracer := Tracer("asynq")
+
+	return func(next asynq.Handler) asynq.Handler {
+		return asynq.HandlerFunc(func(ctx context.Context, t *asynq.Task) error {
+			var envelope struct {
+				TraceContext map[string]string `json:"trace_context"`
+			}
+			if len(t.Payload()) > 0 {
+				_ = json.Unmarshal(t.Payload(), &envelope)
+			}
+			ctx = ContextWithCarrier(ctx, envelope.TraceContext)
+
+			ctx, span := tracer.Start(ctx, "asynq.process "+t.Type(),
+				trace.WithSpanKind(trace.SpanKindConsumer),
+				trace.WithAttributes(
+					attribute.String("messaging.system", "asynq"),
+					attribute.String("messaging.operation", "process"),
+					attribute.String("asynq.task_type", t.Type()),
+				),
+			)
+			defer span.End()
+
+			err := next.ProcessTask(ctx, t)
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+			return err
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package observability_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hibiken/asynq"
+	"github.com/nats-io/nats.go"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/semmidev/ethos-go/internal/common/observability"
+)
+
+// useTracing records spans and propagates W3C trace context, as the
+// configured provider does, until the test ends
+func useTracing(t *testing.T) *tracetest.SpanRecorder {
+	prevProvider, prevPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prevProvider)
+		otel.SetTextMapPropagator(prevPropagator)
+	})
+
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	return recorder
+}
+
+func TestTracePropagation(t *testing.T) {
+	recorder := useTracing(t)
+
+	Convey("Given a request's span", t, func() {
+		ctx, span := observability.StartSpan(context.Background(), "request")
+		defer span.End()
+		sent := span.SpanContext()
+
+		// sameTrace checks that ctx continues the request's span
+		sameTrace := func(ctx context.Context) {
+			got := trace.SpanContextFromContext(ctx)
+			So(got.TraceID(), ShouldEqual, sent.TraceID())
+			So(got.SpanID(), ShouldEqual, sent.SpanID())
+			So(got.IsSampled(), ShouldBeTrue)
+		}
+
+		Convey("When a task is enqueued with it and processed by the worker", func() {
+			task := observability.NewTracedTask(ctx, "email:send", []byte(`{"user_id":"user-1"}`))
+
+			var processed context.Context
+			handler := observability.AsynqTracingMiddleware()(asynq.HandlerFunc(func(ctx context.Context, t *asynq.Task) error {
+				processed = ctx
+				return nil
+			}))
+			So(handler.ProcessTask(context.Background(), task), ShouldBeNil)
+
+			Convey("Then the task's span is a child of the request's span", func() {
+				got := trace.SpanContextFromContext(processed)
+				So(got.TraceID(), ShouldEqual, sent.TraceID())
+
+				spans := recorder.Ended()
+				consumer := spans[len(spans)-1]
+				So(consumer.Name(), ShouldEqual, "asynq.process email:send")
+				So(consumer.SpanContext().SpanID(), ShouldEqual, got.SpanID())
+				So(consumer.Parent().SpanID(), ShouldEqual, sent.SpanID())
+			})
+
+			Convey("Then the payload's own fields are kept", func() {
+				var payload struct {
+					UserID string `json:"user_id"`
+				}
+				So(json.Unmarshal(task.Payload(), &payload), ShouldBeNil)
+				So(payload.UserID, ShouldEqual, "user-1")
+			})
+		})
+
+		Convey("When a task payload is not a JSON object", func() {
+			task := observability.NewTracedTask(ctx, "email:send", []byte(`"plain"`))
+
+			Convey("Then it is enqueued unchanged", func() {
+				So(string(task.Payload()), ShouldEqual, `"plain"`)
+			})
+		})
+
+		Convey("When it is stored in outbox metadata and read back", func() {
+			metadata := observability.InjectTraceMetadata(ctx)
+
+			Convey("Then the trace and span IDs survive", func() {
+				sameTrace(observability.ExtractTraceMetadata(context.Background(), metadata))
+			})
+		})
+
+		Convey("When it is carried in NATS headers and read back", func() {
+			header := nats.Header{}
+			observability.InjectHeaders(ctx, header)
+
+			Convey("Then the trace and span IDs survive", func() {
+				// The header carrier stores keys in canonical form
+				So(header.Get("Traceparent"), ShouldNotBeEmpty)
+				sameTrace(observability.ContextWithHeaders(context.Background(), header))
+			})
+		})
+	})
+
+	Convey("Given no active span", t, func() {
+		ctx := context.Background()
+
+		Convey("Then nothing is carried and nothing is joined", func() {
+			So(string(observability.InjectTraceMetadata(ctx)), ShouldEqual, `{}`)
+			So(string(observability.NewTracedTask(ctx, "email:send", []byte(`{}`)).Payload()), ShouldEqual, `{}`)
+
+			So(trace.SpanContextFromContext(observability.ExtractTraceMetadata(ctx, []byte(`{}`))).IsValid(), ShouldBeFalse)
+			So(trace.SpanContextFromContext(observability.ExtractTraceMetadata(ctx, []byte(`not json`))).IsValid(), ShouldBeFalse)
+			So(trace.SpanContextFromContext(observability.ContextWithHeaders(ctx, nats.Header{})).IsValid(), ShouldBeFalse)
+		})
+	})
+}
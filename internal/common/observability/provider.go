@@ -178,10 +178,11 @@ func initMeter(ctx context.Context, cfg Config, res *resource.Resource) (*metric
 		))
 	}
 
-	mp := metric.NewMeterProvider(
-		metric.WithResource(res),
-		metric.WithReader(promExporter),
-	)
+	opts := []metric.Option{metric.WithResource(res)}
+	for _, r := range readers {
+		opts = append(opts, metric.WithReader(r))
+	}
+	mp := metric.NewMeterProvider(opts...)
 
 	shutdown := func(ctx context.Context) error {
 		return mp.Shutdown(ctx)
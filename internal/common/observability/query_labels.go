@@ -0,0 +1,214 @@
+package observability
+
+import (
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// Labels used when a statement cannot be classified
+const (
+	QueryOperationOther = "other"
+	QueryTableUnknown   = "unknown"
+)
+
+// maxCachedQueryLabels bounds the label cache. Repository queries are
+// constants, so the cache only fills up when statements are built
+// dynamically; those are then parsed on every call.
+const maxCachedQueryLabels = 2048
+
+type queryLabels struct {
+	operation string
+	table     string
+}
+
+var (
+	queryLabelCache     sync.Map // query -> queryLabels
+	queryLabelCacheSize atomic.Int64
+)
+
+// QueryLabels classifies a SQL statement for the db_queries metrics: the
+// operation is one of select, insert, update, delete or other, and the table
+// is the one the statement reads from or writes to, without its schema.
+// Statements it cannot make sense of are labeled "unknown" rather than
+// guessed at, which keeps the label cardinality to the tables in the schema.
+func QueryLabels(query string) (operation, table string) {
+	if cached, ok := queryLabelCache.Load(query); ok {
+		labels := cached.(queryLabels)
+		return labels.operation, labels.table
+	}
+
+	operation, table = parseQueryLabels(query)
+	if queryLabelCacheSize.Load() < maxCachedQueryLabels {
+		if _, loaded := queryLabelCache.LoadOrStore(query, queryLabels{operation, table}); !loaded {
+			queryLabelCacheSize.Add(1)
+		}
+	}
+	return operation, table
+}
+
+// sqlToken is a word or an opening parenthesis, with the nesting depth it
+// was found at
+type sqlToken struct {
+	word  string
+	open  bool
+	depth int
+}
+
+func parseQueryLabels(query string) (string, string) {
+	tokens := tokenizeSQL(query)
+
+	// The statement's verb is the first one outside parentheses, which
+	// skips over the bodies of WITH clauses
+	verb := -1
+	for i, tok := range tokens {
+		if tok.depth > 0 || tok.open {
+			continue
+		}
+		switch tok.word {
+		case "select", "insert", "update", "delete":
+			verb = i
+		}
+		if verb >= 0 {
+			break
+		}
+	}
+	if verb < 0 {
+		return QueryOperationOther, QueryTableUnknown
+	}
+
+	operation := tokens[verb].word
+	rest := tokens[verb+1:]
+	switch operation {
+	case "insert":
+		return operation, tableAfter(rest, "into", 0)
+	case "update":
+		if len(rest) > 0 && rest[0].word == "only" {
+			rest = rest[1:]
+		}
+		if len(rest) > 0 {
+			return operation, tableName(rest[0])
+		}
+		return operation, QueryTableUnknown
+	case "delete":
+		return operation, tableAfter(rest, "from", 0)
+	default:
+		table := tableAfter(rest, "from", 0)
+		if table == QueryTableUnknown {
+			// e.g. SELECT EXISTS (SELECT 1 FROM users ...)
+			table = tableAfter(rest, "from", -1)
+		}
+		return operation, table
+	}
+}
+
+// tableAfter returns the table named after the first keyword at depth, or
+// at any depth when depth is negative
+func tableAfter(tokens []sqlToken, keyword string, depth int) string {
+	for i, tok := range tokens {
+		if tok.word != keyword || (depth >= 0 && tok.depth != depth) {
+			continue
+		}
+		if i+1 < len(tokens) {
+			return tableName(tokens[i+1])
+		}
+		break
+	}
+	return QueryTableUnknown
+}
+
+// tableName turns an identifier such as public."users" into users
+func tableName(tok sqlToken) string {
+	if tok.open || tok.word == "" {
+		return QueryTableUnknown
+	}
+	name := tok.word
+	if dot := strings.LastIndexByte(name, '.'); dot >= 0 {
+		name = name[dot+1:]
+	}
+	name = strings.ReplaceAll(name, `"`, "")
+	if name == "" || !isIdentifier(name) {
+		return QueryTableUnknown
+	}
+	return name
+}
+
+func isIdentifier(name string) bool {
+	for i, r := range name {
+		switch {
+		case r == '_', r >= 'a' && r <= 'z':
+		case r >= '0' && r <= '9' && i > 0:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// tokenizeSQL splits a statement into lowercase words and opening
+// parentheses, skipping literals, comments, parameters and operators
+func tokenizeSQL(query string) []sqlToken {
+	var tokens []sqlToken
+	depth := 0
+	for i := 0; i < len(query); {
+		c := query[i]
+		switch {
+		case c == '(':
+			tokens = append(tokens, sqlToken{open: true, depth: depth})
+			depth++
+			i++
+		case c == ')':
+			if depth > 0 {
+				depth--
+			}
+			i++
+		case c == '\'':
+			i = skipQuoted(query, i, '\'')
+		case c == '-' && strings.HasPrefix(query[i:], "--"):
+			if end := strings.IndexByte(query[i:], '\n'); end >= 0 {
+				i += end
+			} else {
+				i = len(query)
+			}
+		case c == '/' && strings.HasPrefix(query[i:], "/*"):
+			if end := strings.Index(query[i+2:], "*/"); end >= 0 {
+				i += end + 4
+			} else {
+				i = len(query)
+			}
+		case isWordByte(c) || c == '"':
+			start := i
+			for i < len(query) && (isWordByte(query[i]) || query[i] == '.' || query[i] == '"') {
+				if query[i] == '"' {
+					i = skipQuoted(query, i, '"')
+					continue
+				}
+				i++
+			}
+			tokens = append(tokens, sqlToken{word: strings.ToLower(query[start:i]), depth: depth})
+		default:
+			i++
+		}
+	}
+	return tokens
+}
+
+// skipQuoted returns the index just past the literal or quoted identifier
+// that starts at i, where doubled quotes are escapes
+func skipQuoted(query string, i int, quote byte) int {
+	for i++; i < len(query); i++ {
+		if query[i] != quote {
+			continue
+		}
+		if i+1 < len(query) && query[i+1] == quote {
+			i++
+			continue
+		}
+		return i + 1
+	}
+	return len(query)
+}
+
+func isWordByte(c byte) bool {
+	return c == '_' || c == '$' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
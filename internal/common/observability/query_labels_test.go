@@ -0,0 +1,38 @@
+package observability_test
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/common/observability"
+)
+
+func TestQueryLabels(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given statements of each kind", t, func() {
+		cases := []struct {
+			query, operation, table string
+		}{
+			{"SELECT id, email FROM users WHERE email = $1", "select", "users"},
+			{"select * from public.\"habit_logs\" hl join habits h on h.id = hl.habit_id", "select", "habit_logs"},
+			{"INSERT INTO sessions (id, user_id) VALUES ($1, $2) ON CONFLICT (id) DO UPDATE SET user_id = $2", "insert", "sessions"},
+			{"UPDATE users SET name = $1 WHERE user_id = $2", "update", "users"},
+			{"DELETE FROM notifications WHERE created_at < $1", "delete", "notifications"},
+			{"SELECT EXISTS(SELECT 1 FROM habits WHERE id = $1)", "select", "habits"},
+			{"WITH recent AS (SELECT * FROM habit_logs WHERE log_date > $1) UPDATE habits SET streak = 0", "update", "habits"},
+			{"-- FROM comments\nSELECT 'FROM strings' AS x FROM outbox", "select", "outbox"},
+			{"SELECT count(*) FROM (SELECT 1 FROM users) t", "select", "unknown"},
+			{"SELECT 1", "select", "unknown"},
+			{"LISTEN outbox_events", "other", "unknown"},
+		}
+
+		Convey("Then each is labeled with its operation and table", func() {
+			for _, c := range cases {
+				operation, table := observability.QueryLabels(c.query)
+				So(operation+" "+table, ShouldEqual, c.operation+" "+c.table)
+			}
+		})
+	})
+}
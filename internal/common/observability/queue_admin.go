@@ -0,0 +1,264 @@
+package observability
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/hibiken/asynq"
+
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/httputil"
+)
+
+// TaskInspector is the part of *asynq.Inspector the queue admin uses
+type TaskInspector interface {
+	Queues() ([]string, error)
+	GetQueueInfo(queue string) (*asynq.QueueInfo, error)
+	GetTaskInfo(queue, id string) (*asynq.TaskInfo, error)
+	ListPendingTasks(queue string, opts ...asynq.ListOption) ([]*asynq.TaskInfo, error)
+	ListActiveTasks(queue string, opts ...asynq.ListOption) ([]*asynq.TaskInfo, error)
+	ListRetryTasks(queue string, opts ...asynq.ListOption) ([]*asynq.TaskInfo, error)
+	ListArchivedTasks(queue string, opts ...asynq.ListOption) ([]*asynq.TaskInfo, error)
+	RunTask(queue, id string) error
+	DeleteTask(queue, id string) error
+}
+
+var _ TaskInspector = (*asynq.Inspector)(nil)
+
+const (
+	defaultTaskPageSize = 20
+	maxTaskPageSize     = 100
+)
+
+// QueueStats is one queue's task counts
+type QueueStats struct {
+	Queue       string `json:"queue"`
+	Size        int    `json:"size"`
+	Pending     int    `json:"pending"`
+	Active      int    `json:"active"`
+	Scheduled   int    `json:"scheduled"`
+	Retry       int    `json:"retry"`
+	Dead        int    `json:"dead"`
+	Completed   int    `json:"completed"`
+	Processed   int    `json:"processed_today"`
+	Failed      int    `json:"failed_today"`
+	Paused      bool   `json:"paused"`
+	LatencyMs   int64  `json:"latency_ms"`
+	MemoryBytes int64  `json:"memory_bytes"`
+}
+
+// TaskSummary is a task as listed by the queue admin
+type TaskSummary struct {
+	ID            string          `json:"id"`
+	Queue         string          `json:"queue"`
+	Type          string          `json:"type"`
+	State         string          `json:"state"`
+	Payload       json.RawMessage `json:"payload,omitempty"`
+	MaxRetry      int             `json:"max_retry"`
+	Retried       int             `json:"retried"`
+	LastError     string          `json:"last_error,omitempty"`
+	LastFailedAt  *time.Time      `json:"last_failed_at,omitempty"`
+	NextProcessAt *time.Time      `json:"next_process_at,omitempty"`
+}
+
+// QueueAdminHandler serves read-only views of the Asynq queues plus
+// requeue and delete for dead (archived) tasks, so operators do not need a
+// separate asynqmon deployment:
+//
+//	GET    /                              stats for every queue
+//	GET    /{queue}/tasks?state=&page=    pending, active, retry or dead tasks
+//	POST   /{queue}/dead/{id}/requeue     move a dead task back to pending
+//	DELETE /{queue}/dead/{id}             delete a dead task
+//
+// Mount it behind admin authentication.
+func QueueAdminHandler(inspector TaskInspector) http.Handler {
+	h := queueAdmin{inspector: inspector}
+
+	r := chi.NewRouter()
+	r.Get("/", h.listQueues)
+	r.Get("/{queue}/tasks", h.listTasks)
+	r.Post("/{queue}/dead/{id}/requeue", h.requeueDeadTask)
+	r.Delete("/{queue}/dead/{id}", h.deleteDeadTask)
+	return r
+}
+
+type queueAdmin struct {
+	inspector TaskInspector
+}
+
+func (h queueAdmin) listQueues(w http.ResponseWriter, r *http.Request) {
+	queues, err := h.inspector.Queues()
+	if err != nil {
+		httputil.Error(w, r, apperror.ExternalServiceError("redis", err))
+		return
+	}
+
+	stats := make([]QueueStats, 0, len(queues))
+	for _, q := range queues {
+		info, err := h.inspector.GetQueueInfo(q)
+		if err != nil {
+			httputil.Error(w, r, inspectorError(err, "queue", q))
+			return
+		}
+		stats = append(stats, QueueStats{
+			Queue:       info.Queue,
+			Size:        info.Size,
+			Pending:     info.Pending,
+			Active:      info.Active,
+			Scheduled:   info.Scheduled,
+			Retry:       info.Retry,
+			Dead:        info.Archived,
+			Completed:   info.Completed,
+			Processed:   info.Processed,
+			Failed:      info.Failed,
+			Paused:      info.Paused,
+			LatencyMs:   info.Latency.Milliseconds(),
+			MemoryBytes: info.MemoryUsage,
+		})
+	}
+
+	httputil.Success(w, r, stats, "Queue stats retrieved")
+}
+
+func (h queueAdmin) listTasks(w http.ResponseWriter, r *http.Request) {
+	queue := chi.URLParam(r, "queue")
+	query := r.URL.Query()
+
+	list, ok := map[string]func(string, ...asynq.ListOption) ([]*asynq.TaskInfo, error){
+		"pending": h.inspector.ListPendingTasks,
+		"active":  h.inspector.ListActiveTasks,
+		"retry":   h.inspector.ListRetryTasks,
+		"dead":    h.inspector.ListArchivedTasks,
+	}[query.Get("state")]
+	if !ok {
+		httputil.Error(w, r, apperror.InvalidInput("state", "must be one of pending, active, retry, dead"))
+		return
+	}
+
+	page, err := intParam(query.Get("page"), 1)
+	if err != nil || page < 1 {
+		httputil.Error(w, r, apperror.InvalidInput("page", "must be a positive number"))
+		return
+	}
+	pageSize, err := intParam(query.Get("page_size"), defaultTaskPageSize)
+	if err != nil || pageSize < 1 || pageSize > maxTaskPageSize {
+		httputil.Error(w, r, apperror.InvalidInput("page_size", "must be between 1 and 100"))
+		return
+	}
+
+	tasks, err := list(queue, asynq.Page(page), asynq.PageSize(pageSize))
+	if err != nil {
+		httputil.Error(w, r, inspectorError(err, "queue", queue))
+		return
+	}
+
+	summaries := make([]TaskSummary, 0, len(tasks))
+	for _, t := range tasks {
+		summaries = append(summaries, summarizeTask(t))
+	}
+
+	httputil.Success(w, r, summaries, "Tasks retrieved")
+}
+
+func (h queueAdmin) requeueDeadTask(w http.ResponseWriter, r *http.Request) {
+	queue, id, ok := h.deadTask(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.inspector.RunTask(queue, id); err != nil {
+		httputil.Error(w, r, inspectorError(err, "task", id))
+		return
+	}
+
+	httputil.Success(w, r, nil, "Task requeued")
+}
+
+func (h queueAdmin) deleteDeadTask(w http.ResponseWriter, r *http.Request) {
+	queue, id, ok := h.deadTask(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.inspector.DeleteTask(queue, id); err != nil {
+		httputil.Error(w, r, inspectorError(err, "task", id))
+		return
+	}
+
+	httputil.Success(w, r, nil, "Task deleted")
+}
+
+// deadTask resolves the task in the URL and checks it is dead, writing the
+// error response when it is not
+func (h queueAdmin) deadTask(w http.ResponseWriter, r *http.Request) (string, string, bool) {
+	queue, id := chi.URLParam(r, "queue"), chi.URLParam(r, "id")
+
+	info, err := h.inspector.GetTaskInfo(queue, id)
+	if err != nil {
+		httputil.Error(w, r, inspectorError(err, "task", id))
+		return "", "", false
+	}
+	if info.State != asynq.TaskStateArchived {
+		httputil.Error(w, r, apperror.OperationNotAllowed("modify task", "only dead tasks can be requeued or deleted"))
+		return "", "", false
+	}
+
+	return queue, id, true
+}
+
+// summarizeTask converts a task for the response. JSON payloads are
+// embedded as-is; other payloads are sent as a JSON string.
+func summarizeTask(t *asynq.TaskInfo) TaskSummary {
+	s := TaskSummary{
+		ID:        t.ID,
+		Queue:     t.Queue,
+		Type:      t.Type,
+		State:     taskStateName(t.State),
+		MaxRetry:  t.MaxRetry,
+		Retried:   t.Retried,
+		LastError: t.LastErr,
+	}
+
+	if len(t.Payload) > 0 {
+		if json.Valid(t.Payload) {
+			s.Payload = t.Payload
+		} else {
+			s.Payload, _ = json.Marshal(string(t.Payload))
+		}
+	}
+	if !t.LastFailedAt.IsZero() {
+		s.LastFailedAt = &t.LastFailedAt
+	}
+	if !t.NextProcessAt.IsZero() {
+		s.NextProcessAt = &t.NextProcessAt
+	}
+
+	return s
+}
+
+// taskStateName names a task state, calling archived tasks dead
+func taskStateName(state asynq.TaskState) string {
+	if state == asynq.TaskStateArchived {
+		return "dead"
+	}
+	return state.String()
+}
+
+// inspectorError maps Asynq's not-found errors to 404
+func inspectorError(err error, resource, identifier string) error {
+	if errors.Is(err, asynq.ErrQueueNotFound) || errors.Is(err, asynq.ErrTaskNotFound) {
+		return apperror.NotFound(resource, identifier)
+	}
+	return apperror.ExternalServiceError("redis", err)
+}
+
+func intParam(value string, fallback int) (int, error) {
+	if value == "" {
+		return fallback, nil
+	}
+	return strconv.Atoi(value)
+}
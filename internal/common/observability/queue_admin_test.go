@@ -0,0 +1,181 @@
+package observability_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hibiken/asynq"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/common/observability"
+)
+
+// fakeInspector serves one queue of tasks and records requeues and deletes
+type fakeInspector struct {
+	tasks    map[string]*asynq.TaskInfo
+	requeued []string
+	deleted  []string
+}
+
+func newFakeInspector(tasks ...*asynq.TaskInfo) *fakeInspector {
+	f := &fakeInspector{tasks: make(map[string]*asynq.TaskInfo)}
+	for _, t := range tasks {
+		f.tasks[t.ID] = t
+	}
+	return f
+}
+
+func (f *fakeInspector) Queues() ([]string, error) { return []string{"default"}, nil }
+
+func (f *fakeInspector) GetQueueInfo(queue string) (*asynq.QueueInfo, error) {
+	info := &asynq.QueueInfo{Queue: queue}
+	for _, t := range f.tasks {
+		info.Size++
+		if t.State == asynq.TaskStateArchived {
+			info.Archived++
+		}
+	}
+	return info, nil
+}
+
+func (f *fakeInspector) GetTaskInfo(queue, id string) (*asynq.TaskInfo, error) {
+	t, ok := f.tasks[id]
+	if !ok || queue != "default" {
+		return nil, fmt.Errorf("asynq: %w", asynq.ErrTaskNotFound)
+	}
+	return t, nil
+}
+
+func (f *fakeInspector) list(queue string, state asynq.TaskState) ([]*asynq.TaskInfo, error) {
+	if queue != "default" {
+		return nil, fmt.Errorf("asynq: %w", asynq.ErrQueueNotFound)
+	}
+	var tasks []*asynq.TaskInfo
+	for _, t := range f.tasks {
+		if t.State == state {
+			tasks = append(tasks, t)
+		}
+	}
+	return tasks, nil
+}
+
+func (f *fakeInspector) ListPendingTasks(queue string, _ ...asynq.ListOption) ([]*asynq.TaskInfo, error) {
+	return f.list(queue, asynq.TaskStatePending)
+}
+
+func (f *fakeInspector) ListActiveTasks(queue string, _ ...asynq.ListOption) ([]*asynq.TaskInfo, error) {
+	return f.list(queue, asynq.TaskStateActive)
+}
+
+func (f *fakeInspector) ListRetryTasks(queue string, _ ...asynq.ListOption) ([]*asynq.TaskInfo, error) {
+	return f.list(queue, asynq.TaskStateRetry)
+}
+
+func (f *fakeInspector) ListArchivedTasks(queue string, _ ...asynq.ListOption) ([]*asynq.TaskInfo, error) {
+	return f.list(queue, asynq.TaskStateArchived)
+}
+
+func (f *fakeInspector) RunTask(_, id string) error {
+	f.requeued = append(f.requeued, id)
+	return nil
+}
+
+func (f *fakeInspector) DeleteTask(_, id string) error {
+	f.deleted = append(f.deleted, id)
+	return nil
+}
+
+type queueAdminResponse struct {
+	Success bool            `json:"success"`
+	Data    json.RawMessage `json:"data"`
+}
+
+func serveQueueAdmin(inspector observability.TaskInspector, method, target string) (int, queueAdminResponse) {
+	w := httptest.NewRecorder()
+	observability.QueueAdminHandler(inspector).ServeHTTP(w, httptest.NewRequest(method, target, nil))
+
+	var resp queueAdminResponse
+	_ = json.Unmarshal(w.Body.Bytes(), &resp)
+	return w.Code, resp
+}
+
+func TestQueueAdminHandler(t *testing.T) {
+	Convey("Given a queue with a pending and a dead task", t, func() {
+		inspector := newFakeInspector(
+			&asynq.TaskInfo{ID: "p1", Queue: "default", Type: "task:send_verify_email", State: asynq.TaskStatePending, Payload: []byte(`{"email":"sam@example.com"}`)},
+			&asynq.TaskInfo{ID: "d1", Queue: "default", Type: "task:send_verify_email", State: asynq.TaskStateArchived, LastErr: "smtp: connection refused"},
+		)
+
+		Convey("Queue stats count dead tasks", func() {
+			code, resp := serveQueueAdmin(inspector, http.MethodGet, "/")
+
+			var stats []observability.QueueStats
+			So(code, ShouldEqual, http.StatusOK)
+			So(json.Unmarshal(resp.Data, &stats), ShouldBeNil)
+			So(stats, ShouldHaveLength, 1)
+			So(stats[0].Size, ShouldEqual, 2)
+			So(stats[0].Dead, ShouldEqual, 1)
+		})
+
+		Convey("Dead tasks are listed with their last error", func() {
+			code, resp := serveQueueAdmin(inspector, http.MethodGet, "/default/tasks?state=dead")
+
+			var tasks []observability.TaskSummary
+			So(code, ShouldEqual, http.StatusOK)
+			So(json.Unmarshal(resp.Data, &tasks), ShouldBeNil)
+			So(tasks, ShouldHaveLength, 1)
+			So(tasks[0].State, ShouldEqual, "dead")
+			So(tasks[0].LastError, ShouldEqual, "smtp: connection refused")
+		})
+
+		Convey("JSON payloads are embedded", func() {
+			_, resp := serveQueueAdmin(inspector, http.MethodGet, "/default/tasks?state=pending")
+
+			var tasks []observability.TaskSummary
+			So(json.Unmarshal(resp.Data, &tasks), ShouldBeNil)
+			So(string(tasks[0].Payload), ShouldEqual, `{"email":"sam@example.com"}`)
+		})
+
+		Convey("An unknown state is rejected", func() {
+			code, _ := serveQueueAdmin(inspector, http.MethodGet, "/default/tasks?state=completed")
+
+			So(code, ShouldEqual, http.StatusBadRequest)
+		})
+
+		Convey("An unknown queue is not found", func() {
+			code, _ := serveQueueAdmin(inspector, http.MethodGet, "/missing/tasks?state=pending")
+
+			So(code, ShouldEqual, http.StatusNotFound)
+		})
+
+		Convey("A dead task can be requeued", func() {
+			code, _ := serveQueueAdmin(inspector, http.MethodPost, "/default/dead/d1/requeue")
+
+			So(code, ShouldEqual, http.StatusOK)
+			So(inspector.requeued, ShouldResemble, []string{"d1"})
+		})
+
+		Convey("A dead task can be deleted", func() {
+			code, _ := serveQueueAdmin(inspector, http.MethodDelete, "/default/dead/d1")
+
+			So(code, ShouldEqual, http.StatusOK)
+			So(inspector.deleted, ShouldResemble, []string{"d1"})
+		})
+
+		Convey("Tasks that are not dead cannot be requeued or deleted", func() {
+			code, _ := serveQueueAdmin(inspector, http.MethodDelete, "/default/dead/p1")
+
+			So(code, ShouldNotEqual, http.StatusOK)
+			So(inspector.deleted, ShouldBeEmpty)
+		})
+
+		Convey("Unknown tasks are not found", func() {
+			code, _ := serveQueueAdmin(inspector, http.MethodPost, "/default/dead/nope/requeue")
+
+			So(code, ShouldEqual, http.StatusNotFound)
+		})
+	})
+}
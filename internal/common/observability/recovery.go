@@ -0,0 +1,55 @@
+package observability
+
+import (
+	"fmt"
+	"net/http"
+	"runtime/debug"
+
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/errreport"
+	"github.com/semmidev/ethos-go/internal/common/httputil"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+)
+
+// RecoveryMiddleware turns a panic in a handler into a 500 with the usual
+// INTERNAL_ERROR envelope. The panic is logged with its stack, counted in
+// http_panics_total, reported to the error reporter and attached to the
+// request's canonical log line.
+func RecoveryMiddleware(log logger.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer func() {
+				rec := recover()
+				if rec == nil {
+					return
+				}
+				if rec == http.ErrAbortHandler {
+					// Deliberate abort of the response; net/http handles it
+					panic(rec)
+				}
+
+				ctx := r.Context()
+				stack := debug.Stack()
+				errreport.ReportPanic(ctx, rec)
+
+				log.Error(ctx, fmt.Errorf("panic: %v", rec), "panic recovered",
+					logger.Field{Key: "method", Value: r.Method},
+					logger.Field{Key: "path", Value: r.URL.Path},
+					logger.Field{Key: "stack", Value: string(stack)},
+				)
+				logger.AddErrorWithStack(ctx, "PanicError", "panic", "Internal server error (panic recovered)", string(stack), false)
+				if m := GetMetrics(); m != nil {
+					m.RecordHTTPPanic(ctx, r.Method, r.URL.Path)
+				}
+
+				appErr := apperror.InternalError(nil)
+				httputil.WriteError(w, r, appErr.HTTPStatusCode(), httputil.ErrorBody{
+					Code:    appErr.Code,
+					Message: appErr.Message,
+				})
+			}()
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
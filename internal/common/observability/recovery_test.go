@@ -0,0 +1,60 @@
+package observability_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/common/observability"
+	"github.com/semmidev/ethos-go/internal/testutil"
+)
+
+func TestRecoveryMiddleware(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given a handler that panics", t, func() {
+		log := testutil.NewRecordingLogger()
+		handler := observability.RecoveryMiddleware(log)(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+			var habits map[string]int
+			habits["streak"]++
+		}))
+
+		Convey("When it is called, the client gets an INTERNAL_ERROR envelope", func() {
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/v1/habits", nil))
+
+			So(w.Code, ShouldEqual, http.StatusInternalServerError)
+			var body struct {
+				Success bool `json:"success"`
+				Error   struct {
+					Code string `json:"code"`
+				} `json:"error"`
+			}
+			So(json.Unmarshal(w.Body.Bytes(), &body), ShouldBeNil)
+			So(body.Success, ShouldBeFalse)
+			So(body.Error.Code, ShouldEqual, "INTERNAL_ERROR")
+
+			Convey("Then the panic is logged with where it happened", func() {
+				entries := log.Entries()
+				So(entries, ShouldHaveLength, 1)
+				So(entries[0].Level, ShouldEqual, "error")
+				So(entries[0].Fields["path"], ShouldEqual, "/v1/habits")
+				So(entries[0].Fields["stack"], ShouldContainSubstring, "TestRecoveryMiddleware")
+			})
+		})
+
+		Convey("When the handler aborts on purpose, the abort is not swallowed", func() {
+			aborting := observability.RecoveryMiddleware(log)(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+				panic(http.ErrAbortHandler)
+			}))
+
+			So(func() {
+				aborting.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+			}, ShouldPanicWith, http.ErrAbortHandler)
+			So(log.Entries(), ShouldBeEmpty)
+		})
+	})
+}
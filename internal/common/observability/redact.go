@@ -0,0 +1,167 @@
+package observability
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Redaction placeholders
+const (
+	redacted      = "[REDACTED]"
+	redactedEmail = "[EMAIL]"
+	redactedToken = "[TOKEN]"
+)
+
+var (
+	emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`)
+	jwtPattern   = regexp.MustCompile(`eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`)
+
+	// sensitiveJSONField matches a sensitive string field in JSON that could
+	// not be parsed, typically because it was truncated
+	sensitiveJSONField = regexp.MustCompile(`(?i)("[a-z_]*(?:password|passwd|token|secret|authorization|cookie|api_?key|credential|signature)[a-z_]*"\s*:\s*)"(?:[^"\\]|\\.)*"?`)
+)
+
+// sensitiveKeyParts mark a field, parameter or header whose value is
+// replaced whole
+var sensitiveKeyParts = []string{
+	"password", "passwd", "token", "secret", "authorization", "cookie",
+	"apikey", "api_key", "credential", "signature",
+}
+
+// isSensitiveKey reports whether values under key must never be recorded
+func isSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, part := range sensitiveKeyParts {
+		if strings.Contains(key, part) {
+			return true
+		}
+	}
+	return false
+}
+
+// RedactString masks email addresses and JWTs in free text
+func RedactString(s string) string {
+	s = jwtPattern.ReplaceAllString(s, redactedToken)
+	return emailPattern.ReplaceAllString(s, redactedEmail)
+}
+
+// RedactBody returns a printable, redacted form of a request or response
+// body. JSON and form fields named like credentials are replaced whole and
+// emails and tokens are masked everywhere; bodies that are not text are
+// summarized by size and type only. truncated marks a body cut off at the
+// capture limit.
+func RedactBody(contentType string, body []byte, truncated bool) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	var out string
+	switch {
+	case mediaType == "application/x-www-form-urlencoded":
+		out = redactForm(string(body))
+	case strings.HasSuffix(mediaType, "json") || (mediaType == "" && looksLikeJSON(body)):
+		out = redactJSON(body)
+	case strings.HasPrefix(mediaType, "text/") || mediaType == "application/xml":
+		out = RedactString(string(body))
+	default:
+		return fmt.Sprintf("[%d bytes of %s omitted]", len(body), orUnknown(mediaType))
+	}
+
+	if truncated {
+		out += "…[truncated]"
+	}
+	return out
+}
+
+// RedactQuery masks sensitive parameters of a raw query string
+func RedactQuery(rawQuery string) string {
+	if rawQuery == "" {
+		return ""
+	}
+	return redactForm(rawQuery)
+}
+
+func redactJSON(body []byte) string {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		// Truncated or malformed: mask what can be recognized in the text
+		return RedactString(sensitiveJSONField.ReplaceAllString(string(body), `${1}"`+redacted+`"`))
+	}
+
+	out, err := json.Marshal(redactValue(value))
+	if err != nil {
+		return redacted
+	}
+	return string(out)
+}
+
+func redactValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, field := range v {
+			if isSensitiveKey(key) {
+				v[key] = redacted
+				continue
+			}
+			v[key] = redactValue(field)
+		}
+		return v
+	case []any:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+		return v
+	case string:
+		return RedactString(v)
+	default:
+		return v
+	}
+}
+
+// redactForm returns the decoded pairs of a form or query string, sorted by
+// key, for reading rather than replaying
+func redactForm(raw string) string {
+	values, err := url.ParseQuery(raw)
+	if err != nil {
+		return RedactString(raw)
+	}
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var pairs []string
+	for _, key := range keys {
+		for _, value := range values[key] {
+			if isSensitiveKey(key) {
+				value = redacted
+			}
+			pairs = append(pairs, key+"="+RedactString(value))
+		}
+	}
+	return strings.Join(pairs, "&")
+}
+
+func looksLikeJSON(body []byte) bool {
+	trimmed := bytes.TrimSpace(body)
+	return len(trimmed) > 0 && (trimmed[0] == '{' || trimmed[0] == '[')
+}
+
+func orUnknown(mediaType string) string {
+	if mediaType == "" {
+		return "unknown type"
+	}
+	return mediaType
+}
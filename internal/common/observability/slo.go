@@ -0,0 +1,196 @@
+package observability
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// SLO burn rates are reported over these windows; alerting pairs a long
+// window, which shows the budget is really being spent, with a short one,
+// which shows it still is
+var sloWindows = []struct {
+	label  string
+	window time.Duration
+}{
+	{"5m", 5 * time.Minute},
+	{"1h", time.Hour},
+}
+
+// sloBucket is the resolution of the rolling windows
+const sloBucket = 10 * time.Second
+
+// sloBuckets covers the longest window
+const sloBuckets = int(time.Hour / sloBucket)
+
+// SLOGroup is an availability objective for the routes under some path
+// prefixes
+type SLOGroup struct {
+	// Name labels the group's metrics, e.g. "habits"
+	Name string
+	// Prefixes are the URL paths the group covers, e.g. /v1/habits
+	Prefixes []string
+	// Target is the fraction of requests that must not fail with a 5xx,
+	// e.g. 0.999
+	Target float64
+}
+
+// SLOTracker keeps rolling request and error counts per SLO group and
+// exports them as burn rates: the error ratio over a window divided by the
+// error budget (1 - target). A burn rate of 1 spends the budget exactly over
+// the SLO period; alerting rules only compare it with thresholds.
+type SLOTracker struct {
+	mu     sync.Mutex
+	groups []*sloGroup
+	now    func() time.Time
+}
+
+type sloGroup struct {
+	SLOGroup
+	buckets [sloBuckets]sloCount
+}
+
+// sloCount holds the requests seen in one bucket; slot is the bucket's
+// index since the epoch, so stale entries are recognized and skipped
+type sloCount struct {
+	slot   int64
+	total  int64
+	errors int64
+}
+
+// NewSLOTracker creates a tracker for groups. A request counts towards the
+// first group with a matching prefix; requests outside every group are
+// ignored.
+func NewSLOTracker(groups []SLOGroup) *SLOTracker {
+	t := &SLOTracker{now: time.Now}
+	for _, g := range groups {
+		t.groups = append(t.groups, &sloGroup{SLOGroup: g})
+	}
+	return t
+}
+
+// WithClock sets the clock the windows are measured with
+func (t *SLOTracker) WithClock(now func() time.Time) *SLOTracker {
+	t.now = now
+	return t
+}
+
+// UpdateTargets changes the targets of the named groups, e.g. after a
+// configuration reload. The counts are kept.
+func (t *SLOTracker) UpdateTargets(targets map[string]float64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, g := range t.groups {
+		if target, ok := targets[g.Name]; ok {
+			g.Target = target
+		}
+	}
+}
+
+// Record counts a finished request
+func (t *SLOTracker) Record(path string, statusCode int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	g := t.group(path)
+	if g == nil {
+		return
+	}
+
+	slot := t.now().UnixNano() / int64(sloBucket)
+	b := &g.buckets[slot%int64(sloBuckets)]
+	if b.slot != slot {
+		*b = sloCount{slot: slot}
+	}
+	b.total++
+	if statusCode >= http.StatusInternalServerError {
+		b.errors++
+	}
+}
+
+// BurnRate returns how fast the named group spends its error budget over
+// the last window. It is 0 when the group saw no requests.
+func (t *SLOTracker) BurnRate(group string, window time.Duration) float64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, g := range t.groups {
+		if g.Name == group {
+			return g.burnRate(t.now(), window)
+		}
+	}
+	return 0
+}
+
+func (t *SLOTracker) group(path string) *sloGroup {
+	for _, g := range t.groups {
+		for _, prefix := range g.Prefixes {
+			if strings.HasPrefix(path, prefix) {
+				return g
+			}
+		}
+	}
+	return nil
+}
+
+func (g *sloGroup) burnRate(now time.Time, window time.Duration) float64 {
+	current := now.UnixNano() / int64(sloBucket)
+	oldest := current - int64(window/sloBucket) + 1
+
+	var total, errors int64
+	for _, b := range g.buckets {
+		if b.slot >= oldest && b.slot <= current {
+			total += b.total
+			errors += b.errors
+		}
+	}
+	if total == 0 || g.Target >= 1 {
+		return 0
+	}
+	return float64(errors) / float64(total) / (1 - g.Target)
+}
+
+// RegisterSLOMetrics exports the tracker's burn rates as slo_burn_rate,
+// labeled by group and window, and its targets as slo_target.
+func RegisterSLOMetrics(t *SLOTracker) error {
+	meter := otel.Meter(instrumentationName)
+
+	burnRate, err := meter.Float64ObservableGauge(
+		"slo_burn_rate",
+		metric.WithDescription("Error budget burn rate by SLO group and window"),
+	)
+	if err != nil {
+		return err
+	}
+
+	target, err := meter.Float64ObservableGauge(
+		"slo_target",
+		metric.WithDescription("Fraction of requests that must succeed by SLO group"),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+
+		now := t.now()
+		for _, g := range t.groups {
+			group := attribute.String("slo_group", g.Name)
+			o.ObserveFloat64(target, g.Target, metric.WithAttributes(group))
+			for _, w := range sloWindows {
+				o.ObserveFloat64(burnRate, g.burnRate(now, w.window),
+					metric.WithAttributes(group, attribute.String("window", w.label)))
+			}
+		}
+		return nil
+	}, burnRate, target)
+	return err
+}
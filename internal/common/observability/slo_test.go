@@ -0,0 +1,58 @@
+package observability_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/common/observability"
+)
+
+func TestSLOTracker(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given a tracker with a 99% SLO on the habits routes", t, func() {
+		now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+		tracker := observability.NewSLOTracker([]observability.SLOGroup{
+			{Name: "habits", Prefixes: []string{"/v1/habits"}, Target: 0.99},
+		}).WithClock(func() time.Time { return now })
+
+		Convey("When 2% of requests fail, the budget burns at twice the sustainable rate", func() {
+			for i := 0; i < 100; i++ {
+				status := http.StatusOK
+				if i < 2 {
+					status = http.StatusServiceUnavailable
+				}
+				tracker.Record("/v1/habits/123", status)
+			}
+			tracker.Record("/v1/auth/login", http.StatusInternalServerError)
+
+			So(tracker.BurnRate("habits", 5*time.Minute), ShouldAlmostEqual, 2, 1e-9)
+			So(tracker.BurnRate("habits", time.Hour), ShouldAlmostEqual, 2, 1e-9)
+
+			Convey("Then the errors leave the short window before the long one", func() {
+				now = now.Add(10 * time.Minute)
+				for i := 0; i < 100; i++ {
+					tracker.Record("/v1/habits", http.StatusNotFound)
+				}
+
+				So(tracker.BurnRate("habits", 5*time.Minute), ShouldEqual, 0)
+				So(tracker.BurnRate("habits", time.Hour), ShouldAlmostEqual, 1, 1e-9)
+
+				now = now.Add(time.Hour)
+				So(tracker.BurnRate("habits", time.Hour), ShouldEqual, 0)
+			})
+
+			Convey("Then a tightened target burns faster", func() {
+				tracker.UpdateTargets(map[string]float64{"habits": 0.999})
+				So(tracker.BurnRate("habits", time.Hour), ShouldAlmostEqual, 20, 1e-9)
+			})
+		})
+
+		Convey("When nothing was requested, nothing burns", func() {
+			So(tracker.BurnRate("habits", time.Hour), ShouldEqual, 0)
+		})
+	})
+}
@@ -0,0 +1,115 @@
+package outbox
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/semmidev/ethos-go/internal/common/logger"
+)
+
+// NotifyChannel is the Postgres channel the outbox insert trigger notifies
+const NotifyChannel = "outbox_inserted"
+
+// listenerPingInterval keeps an idle listen connection from being dropped
+// unnoticed
+const listenerPingInterval = 90 * time.Second
+
+// Listener wakes the processor when events are committed to the outbox,
+// using Postgres LISTEN/NOTIFY. While its connection is down it wakes the
+// processor every pollInterval instead, so delivery never waits on the
+// processor's slower fallback poll.
+type Listener struct {
+	listener     *pq.Listener
+	wakeups      chan struct{}
+	pollInterval time.Duration
+	connected    atomic.Bool
+	logger       logger.Logger
+}
+
+// NewListener opens a listen connection to the database at dsn
+func NewListener(dsn string, pollInterval time.Duration, log logger.Logger) (*Listener, error) {
+	l := &Listener{
+		wakeups:      make(chan struct{}, 1),
+		pollInterval: pollInterval,
+		logger:       log,
+	}
+
+	l.listener = pq.NewListener(dsn, time.Second, time.Minute, l.onEvent)
+	if err := l.listener.Listen(NotifyChannel); err != nil {
+		_ = l.listener.Close()
+		return nil, err
+	}
+	l.connected.Store(true)
+
+	return l, nil
+}
+
+// Wakeups receives a value whenever the outbox may have new entries.
+// Wakeups that arrive while one is pending are merged.
+func (l *Listener) Wakeups() <-chan struct{} {
+	return l.wakeups
+}
+
+// Run forwards notifications until ctx is canceled
+func (l *Listener) Run(ctx context.Context) {
+	poll := time.NewTicker(l.pollInterval)
+	defer poll.Stop()
+	ping := time.NewTicker(listenerPingInterval)
+	defer ping.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-l.listener.Notify:
+			// A nil notification follows a reconnect, after which
+			// notifications sent meanwhile are lost; wake up either way
+			l.wake()
+		case <-poll.C:
+			if !l.connected.Load() {
+				l.wake()
+			}
+		case <-ping.C:
+			_ = l.listener.Ping()
+		}
+	}
+}
+
+// Close closes the listen connection
+func (l *Listener) Close() error {
+	return l.listener.Close()
+}
+
+func (l *Listener) wake() {
+	select {
+	case l.wakeups <- struct{}{}:
+	default:
+	}
+}
+
+func (l *Listener) onEvent(event pq.ListenerEventType, err error) {
+	ctx := context.Background()
+
+	switch event {
+	case pq.ListenerEventConnected, pq.ListenerEventReconnected:
+		l.connected.Store(true)
+	case pq.ListenerEventDisconnected:
+		l.connected.Store(false)
+		l.logger.Warn(ctx, "outbox listener disconnected; polling until it reconnects",
+			logger.Field{Key: "poll_interval", Value: l.pollInterval.String()},
+			logger.Field{Key: "error", Value: errString(err)},
+		)
+	case pq.ListenerEventConnectionAttemptFailed:
+		l.connected.Store(false)
+	}
+}
+
+func errString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
@@ -0,0 +1,23 @@
+package outbox
+
+import (
+	"context"
+
+	"github.com/semmidev/ethos-go/internal/common/observability"
+)
+
+// RegisterMetrics exports the outbox backlog size and lag, read from repo
+// on every metrics collection.
+func RegisterMetrics(repo *Repository) error {
+	return observability.RegisterOutboxMetrics(func(ctx context.Context) (observability.OutboxStats, error) {
+		s, err := repo.Stats(ctx)
+		if err != nil {
+			return observability.OutboxStats{}, err
+		}
+		return observability.OutboxStats{
+			Pending:       s.Pending,
+			Failed:        s.Failed,
+			OldestPending: s.OldestPending,
+		}, nil
+	})
+}
@@ -2,19 +2,46 @@ package outbox
 
 import (
 	"context"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/semmidev/ethos-go/internal/common/events"
 	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/common/observability"
+	"github.com/semmidev/ethos-go/internal/common/retry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
-// Processor polls the outbox and publishes events
+// Processor polls the outbox and publishes events. Each entry is marked
+// published only after the broker acknowledged it, so an interrupted batch
+// leaves the rest of its entries for the next poll.
 type Processor struct {
 	repo      *Repository
 	publisher events.Publisher
 	logger    logger.Logger
 	interval  time.Duration
 	batchSize int
+	wakeups   <-chan struct{}
+	retry     retry.Policy
+
+	started  atomic.Bool
+	stopping chan struct{}
+	stopOnce sync.Once
+	done     chan struct{}
+	abort    context.CancelFunc
+	abortMu  sync.Mutex
+}
+
+// PublishRetryPolicy retries publishing an entry while NATS reconnects,
+// before the entry is marked failed and left for the next poll
+var PublishRetryPolicy = retry.Policy{
+	MaxAttempts:     3,
+	InitialInterval: 100 * time.Millisecond,
+	MaxInterval:     time.Second,
+	Retryable:       events.IsTransient,
 }
 
 // NewProcessor creates a new outbox processor
@@ -37,17 +64,53 @@ func NewProcessor(
 		logger:    log,
 		interval:  interval,
 		batchSize: batchSize,
+		retry:     PublishRetryPolicy,
+		stopping:  make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+}
+
+// WithWakeups processes the outbox whenever wakeups receives, such as from
+// a Listener, and slows polling to fallback as a safety net for missed
+// wakeups
+func (p *Processor) WithWakeups(wakeups <-chan struct{}, fallback time.Duration) *Processor {
+	p.wakeups = wakeups
+	if fallback > 0 {
+		p.interval = fallback
 	}
+	return p
 }
 
-// Start begins the outbox polling loop
+// WithRetry replaces the policy publishing each entry is retried with
+func (p *Processor) WithRetry(policy retry.Policy) *Processor {
+	p.retry = policy
+	return p
+}
+
+// Start runs the outbox polling loop until ctx is canceled or Stop is
+// called. The entry being published when that happens is finished first.
 func (p *Processor) Start(ctx context.Context) {
+	if !p.started.CompareAndSwap(false, true) {
+		return
+	}
+	defer close(p.done)
+
+	// Publishing outlives ctx so a shutdown never cuts an entry between the
+	// broker ack and marking it published; Stop aborts it when draining
+	// takes too long
+	publishCtx, abort := context.WithCancel(context.WithoutCancel(ctx))
+	defer abort()
+	p.abortMu.Lock()
+	p.abort = abort
+	p.abortMu.Unlock()
+
 	ticker := time.NewTicker(p.interval)
 	defer ticker.Stop()
 
 	p.logger.Info(ctx, "outbox processor started",
 		logger.Field{Key: "interval", Value: p.interval.String()},
 		logger.Field{Key: "batch_size", Value: p.batchSize},
+		logger.Field{Key: "wakeups", Value: p.wakeups != nil},
 	)
 
 	for {
@@ -55,21 +118,64 @@ func (p *Processor) Start(ctx context.Context) {
 		case <-ctx.Done():
 			p.logger.Info(ctx, "outbox processor stopped")
 			return
+		case <-p.stopping:
+			p.logger.Info(ctx, "outbox processor stopped")
+			return
 		case <-ticker.C:
-			p.process(ctx)
+			p.drain(publishCtx, ctx)
+		case <-p.wakeups:
+			p.drain(publishCtx, ctx)
 		}
 	}
 }
 
-func (p *Processor) process(ctx context.Context) {
+// Stop stops polling and waits for the entry in flight to be published and
+// marked. When ctx ends first the publish is aborted and ctx's error is
+// returned; the entry stays unpublished and is sent again on restart.
+func (p *Processor) Stop(ctx context.Context) error {
+	p.stopOnce.Do(func() { close(p.stopping) })
+	if !p.started.Load() {
+		return nil
+	}
+
+	select {
+	case <-p.done:
+		return nil
+	case <-ctx.Done():
+		p.abortMu.Lock()
+		if p.abort != nil {
+			p.abort()
+		}
+		p.abortMu.Unlock()
+		<-p.done
+		return ctx.Err()
+	}
+}
+
+// drain processes batches until one comes back short, so a backlog is not
+// left waiting for the next poll. A full batch that published nothing stops
+// it too: the same failing entries would be fetched again straight away.
+func (p *Processor) drain(ctx, runCtx context.Context) {
+	for {
+		fetched, published := p.process(ctx, runCtx)
+		if fetched < p.batchSize || published == 0 || p.shuttingDown(runCtx) {
+			return
+		}
+	}
+}
+
+// process publishes one batch, checking between entries whether the
+// processor is shutting down, and returns how many entries it fetched and
+// how many of them were published
+func (p *Processor) process(ctx, runCtx context.Context) (fetched, published int) {
 	entries, err := p.repo.GetUnpublished(ctx, p.batchSize)
 	if err != nil {
 		p.logger.Error(ctx, err, "failed to get unpublished outbox entries")
-		return
+		return 0, 0
 	}
 
 	if len(entries) == 0 {
-		return
+		return 0, 0
 	}
 
 	p.logger.Debug(ctx, "processing outbox entries",
@@ -77,30 +183,72 @@ func (p *Processor) process(ctx context.Context) {
 	)
 
 	for _, entry := range entries {
-		// Create a simple event wrapper for publishing
-		evt := &outboxEvent{
-			id:            entry.ID.String(),
-			eventType:     entry.EventType,
-			aggregateID:   entry.AggregateID,
-			aggregateType: entry.AggregateType,
-			createdAt:     entry.CreatedAt,
-			payload:       entry.Payload,
+		if p.shuttingDown(runCtx) {
+			return len(entries), published
 		}
-
-		if err := p.publisher.Publish(ctx, evt); err != nil {
-			p.logger.Error(ctx, err, "failed to publish outbox event",
-				logger.Field{Key: "event_id", Value: entry.ID.String()},
-				logger.Field{Key: "event_type", Value: entry.EventType},
-			)
-			_ = p.repo.MarkFailed(ctx, entry.ID, err.Error())
-			continue
+		if p.publish(ctx, entry) {
+			published++
 		}
+	}
+	return len(entries), published
+}
+
+func (p *Processor) shuttingDown(runCtx context.Context) bool {
+	select {
+	case <-runCtx.Done():
+		return true
+	case <-p.stopping:
+		return true
+	default:
+		return false
+	}
+}
 
-		if err := p.repo.MarkPublished(ctx, entry.ID); err != nil {
-			p.logger.Error(ctx, err, "failed to mark event as published",
-				logger.Field{Key: "event_id", Value: entry.ID.String()},
-			)
+// publish sends one entry, continuing the trace of the request that wrote
+// it, and reports whether the broker acknowledged it
+func (p *Processor) publish(ctx context.Context, entry OutboxEntry) bool {
+	ctx = observability.ExtractTraceMetadata(ctx, entry.Metadata)
+	ctx, span := observability.Tracer("outbox").Start(ctx, "outbox.publish "+entry.EventType,
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			attribute.String("event.id", entry.ID.String()),
+			attribute.String("event.type", entry.EventType),
+			attribute.Int("outbox.retry_count", entry.RetryCount),
+		),
+	)
+	defer span.End()
+
+	err := retry.Do(ctx, p.retry, func(ctx context.Context) error {
+		return p.publisher.Publish(ctx, newOutboxEvent(entry))
+	})
+	if err != nil {
+		if ctx.Err() != nil {
+			// Aborted by shutdown; not the entry's failure
+			return false
 		}
+		p.logger.Error(ctx, err, "failed to publish outbox event",
+			logger.Field{Key: "event_id", Value: entry.ID.String()},
+			logger.Field{Key: "event_type", Value: entry.EventType},
+		)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		_ = p.repo.MarkFailed(ctx, entry.ID, err.Error())
+		recordPublish(ctx, entry.EventType, "error")
+		return false
+	}
+	recordPublish(ctx, entry.EventType, "success")
+
+	if err := p.repo.MarkPublished(ctx, entry.ID); err != nil {
+		p.logger.Error(ctx, err, "failed to mark event as published",
+			logger.Field{Key: "event_id", Value: entry.ID.String()},
+		)
+	}
+	return true
+}
+
+func recordPublish(ctx context.Context, eventType, status string) {
+	if m := observability.GetMetrics(); m != nil {
+		m.RecordOutboxPublish(ctx, eventType, status)
 	}
 }
 
@@ -114,6 +262,18 @@ type outboxEvent struct {
 	payload       []byte
 }
 
+// newOutboxEvent wraps entry for publishing
+func newOutboxEvent(entry OutboxEntry) *outboxEvent {
+	return &outboxEvent{
+		id:            entry.ID.String(),
+		eventType:     entry.EventType,
+		aggregateID:   entry.AggregateID,
+		aggregateType: entry.AggregateType,
+		createdAt:     entry.CreatedAt,
+		payload:       entry.Payload,
+	}
+}
+
 func (e *outboxEvent) EventID() string       { return e.id }
 func (e *outboxEvent) EventType() string     { return e.eventType }
 func (e *outboxEvent) OccurredAt() time.Time { return e.createdAt }
@@ -0,0 +1,240 @@
+package outbox_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/nats-io/nats.go"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/common/events"
+	"github.com/semmidev/ethos-go/internal/common/outbox"
+	"github.com/semmidev/ethos-go/internal/common/retry"
+	"github.com/semmidev/ethos-go/internal/testutil"
+)
+
+// gatedPublisher blocks each publish until released
+type gatedPublisher struct {
+	*testutil.RecordingPublisher
+	started chan struct{}
+	release chan struct{}
+}
+
+func (p *gatedPublisher) Publish(ctx context.Context, event events.Event) error {
+	p.started <- struct{}{}
+	select {
+	case <-p.release:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	return p.RecordingPublisher.Publish(ctx, event)
+}
+
+func TestProcessorShutdown(t *testing.T) {
+	Convey("Given a processor publishing a batch of two entries", t, func() {
+		db, mock, err := sqlmock.New()
+		So(err, ShouldBeNil)
+		defer db.Close()
+
+		mock.ExpectQuery(`FROM outbox\s+WHERE published = FALSE`).
+			WillReturnRows(outboxRows("habits.habit.created", "habits.habit.completed"))
+
+		publisher := &gatedPublisher{
+			RecordingPublisher: testutil.NewRecordingPublisher(),
+			started:            make(chan struct{}, 1),
+			release:            make(chan struct{}),
+		}
+		processor := outbox.NewProcessor(outbox.NewRepository(sqlx.NewDb(db, "sqlmock")), publisher, testutil.NopLogger{}, time.Millisecond, 10)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go processor.Start(ctx)
+		<-publisher.started
+
+		Convey("When shutdown begins mid-publish, the entry in flight is still marked published", func() {
+			mock.ExpectExec(`UPDATE outbox\s+SET published = TRUE`).
+				WithArgs(sqlmock.AnyArg()).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+
+			cancel()
+			close(publisher.release)
+
+			stopCtx, cancelStop := context.WithTimeout(context.Background(), time.Second)
+			defer cancelStop()
+			So(processor.Stop(stopCtx), ShouldBeNil)
+			So(publisher.EventTypes(), ShouldResemble, []string{"habits.habit.created"})
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("When the drain deadline passes, the publish is aborted and the entry left unpublished", func() {
+			stopCtx, cancelStop := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancelStop()
+			So(processor.Stop(stopCtx), ShouldEqual, context.DeadlineExceeded)
+			So(publisher.Events(), ShouldBeEmpty)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
+
+func TestProcessorWakeups(t *testing.T) {
+	Convey("Given a processor that polls rarely but listens for wakeups", t, func() {
+		db, mock, err := sqlmock.New()
+		So(err, ShouldBeNil)
+		defer db.Close()
+
+		publisher := testutil.NewRecordingPublisher()
+		wakeups := make(chan struct{}, 1)
+		processor := outbox.NewProcessor(outbox.NewRepository(sqlx.NewDb(db, "sqlmock")), publisher, testutil.NopLogger{}, time.Millisecond, 10).
+			WithWakeups(wakeups, time.Hour)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go processor.Start(ctx)
+
+		Convey("When it is woken, the outbox is processed right away", func() {
+			mock.ExpectQuery(`FROM outbox\s+WHERE published = FALSE`).
+				WillReturnRows(outboxRows("habits.habit.created"))
+			mock.ExpectExec(`UPDATE outbox\s+SET published = TRUE`).
+				WithArgs(sqlmock.AnyArg()).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+
+			wakeups <- struct{}{}
+
+			stopCtx, cancelStop := context.WithTimeout(context.Background(), time.Second)
+			defer cancelStop()
+			So(eventually(func() bool { return len(publisher.Events()) == 1 }), ShouldBeTrue)
+			So(processor.Stop(stopCtx), ShouldBeNil)
+			So(publisher.EventTypes(), ShouldResemble, []string{"habits.habit.created"})
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
+
+// eventually reports whether cond becomes true within a second
+func eventually(cond func() bool) bool {
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		if cond() {
+			return true
+		}
+		time.Sleep(time.Millisecond)
+	}
+	return false
+}
+
+// flakyPublisher fails its first publishes with err
+type flakyPublisher struct {
+	*testutil.RecordingPublisher
+	failures int
+	err      error
+}
+
+func (p *flakyPublisher) Publish(ctx context.Context, event events.Event) error {
+	if p.failures > 0 {
+		p.failures--
+		return p.err
+	}
+	return p.RecordingPublisher.Publish(ctx, event)
+}
+
+func TestProcessorRetry(t *testing.T) {
+	Convey("Given a processor whose broker is briefly reconnecting", t, func() {
+		db, mock, err := sqlmock.New()
+		So(err, ShouldBeNil)
+		defer db.Close()
+
+		publisher := &flakyPublisher{
+			RecordingPublisher: testutil.NewRecordingPublisher(),
+			failures:           2,
+			err:                fmt.Errorf("publish event: %w", nats.ErrConnectionReconnecting),
+		}
+		processor := outbox.NewProcessor(outbox.NewRepository(sqlx.NewDb(db, "sqlmock")), publisher, testutil.NopLogger{}, time.Millisecond, 10).
+			WithRetry(retry.Policy{
+				MaxAttempts:     3,
+				InitialInterval: time.Millisecond,
+				MaxInterval:     time.Millisecond,
+				Retryable:       events.IsTransient,
+			})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		Convey("When an entry is processed, it is published once reconnected and marked published", func() {
+			mock.ExpectQuery(`FROM outbox\s+WHERE published = FALSE`).
+				WillReturnRows(outboxRows("habits.habit.created"))
+			mock.ExpectExec(`UPDATE outbox\s+SET published = TRUE`).
+				WithArgs(sqlmock.AnyArg()).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+
+			go processor.Start(ctx)
+
+			stopCtx, cancelStop := context.WithTimeout(context.Background(), time.Second)
+			defer cancelStop()
+			So(eventually(func() bool { return len(publisher.Events()) == 1 }), ShouldBeTrue)
+			So(processor.Stop(stopCtx), ShouldBeNil)
+			So(publisher.EventTypes(), ShouldResemble, []string{"habits.habit.created"})
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
+
+// failingPublisher fails every publish and counts the attempts
+type failingPublisher struct {
+	*testutil.RecordingPublisher
+	attempts atomic.Int32
+}
+
+func (p *failingPublisher) Publish(context.Context, events.Event) error {
+	p.attempts.Add(1)
+	return errors.New("subject rejected")
+}
+
+func TestProcessorDrain(t *testing.T) {
+	Convey("Given a processor whose outbox holds a full batch of entries that always fail", t, func() {
+		db, mock, err := sqlmock.New()
+		So(err, ShouldBeNil)
+		defer db.Close()
+
+		publisher := &failingPublisher{RecordingPublisher: testutil.NewRecordingPublisher()}
+		wakeups := make(chan struct{}, 1)
+		processor := outbox.NewProcessor(outbox.NewRepository(sqlx.NewDb(db, "sqlmock")), publisher, testutil.NopLogger{}, time.Millisecond, 2).
+			WithWakeups(wakeups, time.Hour).
+			WithRetry(retry.Policy{MaxAttempts: 1})
+
+		for range 2 {
+			mock.ExpectQuery(`FROM outbox\s+WHERE published = FALSE`).
+				WillReturnRows(outboxRows("habits.habit.created", "habits.habit.updated"))
+			for range 2 {
+				mock.ExpectExec(`UPDATE outbox\s+SET retry_count = retry_count \+ 1`).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			}
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go processor.Start(ctx)
+
+		Convey("When it is woken, the batch is tried once and left for the next poll", func() {
+			wakeups <- struct{}{}
+			So(eventually(func() bool { return publisher.attempts.Load() == 2 }), ShouldBeTrue)
+			time.Sleep(20 * time.Millisecond)
+			So(publisher.attempts.Load(), ShouldEqual, 2)
+			So(mock.ExpectationsWereMet(), ShouldNotBeNil)
+
+			wakeups <- struct{}{}
+			So(eventually(func() bool { return publisher.attempts.Load() == 4 }), ShouldBeTrue)
+
+			stopCtx, cancelStop := context.WithTimeout(context.Background(), time.Second)
+			defer cancelStop()
+			So(processor.Stop(stopCtx), ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
@@ -0,0 +1,193 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/semmidev/ethos-go/internal/common/events"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/common/random"
+)
+
+// ReplayFilter selects published outbox entries to replay. At least an
+// aggregate ID or a start time is required, so a typo cannot replay the
+// whole history.
+type ReplayFilter struct {
+	AggregateType string
+	AggregateID   string
+	EventType     string
+	Since         time.Time
+	Until         time.Time
+}
+
+func (f ReplayFilter) validate() error {
+	if f.AggregateID == "" && f.Since.IsZero() {
+		return errors.New("replay needs an aggregate ID or a start time")
+	}
+	if !f.Since.IsZero() && !f.Until.IsZero() && !f.Until.After(f.Since) {
+		return errors.New("replay end time must be after its start time")
+	}
+	return nil
+}
+
+// ListPublished returns up to limit published entries matching filter in
+// the order they were written, starting after the entry (afterTime,
+// afterID). Pass a zero time and uuid.Nil for the first page.
+func (r *Repository) ListPublished(ctx context.Context, filter ReplayFilter, afterTime time.Time, afterID uuid.UUID, limit int) ([]OutboxEntry, error) {
+	conds := []string{"published = TRUE", "(created_at, id) > ($1, $2)"}
+	args := []any{afterTime, afterID}
+
+	add := func(cond string, arg any) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
+	}
+	if filter.AggregateType != "" {
+		add("aggregate_type = $%d", filter.AggregateType)
+	}
+	if filter.AggregateID != "" {
+		add("aggregate_id = $%d", filter.AggregateID)
+	}
+	if filter.EventType != "" {
+		add("event_type = $%d", filter.EventType)
+	}
+	if !filter.Since.IsZero() {
+		add("created_at >= $%d", filter.Since)
+	}
+	if !filter.Until.IsZero() {
+		add("created_at < $%d", filter.Until)
+	}
+	args = append(args, limit)
+
+	query := fmt.Sprintf(`
+		SELECT id, event_type, aggregate_type, aggregate_id, payload, metadata,
+		       created_at, published_at, published, retry_count, last_error
+		FROM outbox
+		WHERE %s
+		ORDER BY created_at ASC, id ASC
+		LIMIT $%d
+	`, strings.Join(conds, " AND "), len(args))
+
+	var entries []OutboxEntry
+	err := r.db.SelectContext(ctx, &entries, query, args...)
+	return entries, err
+}
+
+// ReplayOptions configures a replay run
+type ReplayOptions struct {
+	Filter ReplayFilter
+	// Consumer is the durable consumer that handles the replayed events;
+	// other consumers skip them
+	Consumer string
+	// DryRun lists the matching entries without publishing them
+	DryRun bool
+	// Rate caps published events per second; 0 means unlimited
+	Rate int
+	// BatchSize is the number of entries read per query; defaults to 100
+	BatchSize int
+	// OnEntry, when set, is called for every matching entry before it is
+	// published
+	OnEntry func(OutboxEntry)
+}
+
+// ReplayResult summarises a replay run
+type ReplayResult struct {
+	RunID     string
+	Matched   int
+	Published int
+}
+
+// Replayer republishes historical outbox entries to a single consumer,
+// e.g. to rebuild a projection after a handler bug was fixed. Handlers
+// must be idempotent, as the consumer sees each event again.
+type Replayer struct {
+	repo      *Repository
+	publisher events.Publisher
+	logger    logger.Logger
+}
+
+// NewReplayer creates a replayer publishing to the event bus, not to the
+// outbox, so only the targeted consumer is affected
+func NewReplayer(repo *Repository, publisher events.Publisher, log logger.Logger) *Replayer {
+	return &Replayer{
+		repo:      repo,
+		publisher: publisher,
+		logger:    log,
+	}
+}
+
+// Replay publishes every entry matching opts.Filter in order, stopping at
+// the first publish error
+func (r *Replayer) Replay(ctx context.Context, opts ReplayOptions) (ReplayResult, error) {
+	if err := opts.Filter.validate(); err != nil {
+		return ReplayResult{}, err
+	}
+	if opts.Consumer == "" {
+		return ReplayResult{}, errors.New("replay needs a target consumer")
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = 100
+	}
+
+	result := ReplayResult{RunID: random.NewUUID().String()}
+	ctx = events.WithReplay(ctx, events.Replay{Consumer: opts.Consumer, RunID: result.RunID})
+
+	var throttle <-chan time.Time
+	if opts.Rate > 0 && !opts.DryRun {
+		ticker := time.NewTicker(time.Second / time.Duration(opts.Rate))
+		defer ticker.Stop()
+		throttle = ticker.C
+	}
+
+	var afterTime time.Time
+	afterID := uuid.Nil
+	for {
+		entries, err := r.repo.ListPublished(ctx, opts.Filter, afterTime, afterID, opts.BatchSize)
+		if err != nil {
+			return result, fmt.Errorf("list outbox entries: %w", err)
+		}
+
+		for _, entry := range entries {
+			result.Matched++
+			if opts.OnEntry != nil {
+				opts.OnEntry(entry)
+			}
+			if opts.DryRun {
+				continue
+			}
+
+			if throttle != nil {
+				select {
+				case <-ctx.Done():
+					return result, ctx.Err()
+				case <-throttle:
+				}
+			}
+
+			if err := r.publisher.Publish(ctx, newOutboxEvent(entry)); err != nil {
+				return result, fmt.Errorf("replay event %s: %w", entry.ID, err)
+			}
+			result.Published++
+		}
+
+		if len(entries) < opts.BatchSize {
+			break
+		}
+		last := entries[len(entries)-1]
+		afterTime, afterID = last.CreatedAt, last.ID
+	}
+
+	r.logger.Info(ctx, "outbox replay finished",
+		logger.Field{Key: "run_id", Value: result.RunID},
+		logger.Field{Key: "consumer", Value: opts.Consumer},
+		logger.Field{Key: "matched", Value: result.Matched},
+		logger.Field{Key: "published", Value: result.Published},
+		logger.Field{Key: "dry_run", Value: opts.DryRun},
+	)
+
+	return result, nil
+}
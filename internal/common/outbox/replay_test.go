@@ -0,0 +1,96 @@
+package outbox_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/common/outbox"
+	"github.com/semmidev/ethos-go/internal/testutil"
+)
+
+var outboxColumns = []string{
+	"id", "event_type", "aggregate_type", "aggregate_id", "payload", "metadata",
+	"created_at", "published_at", "published", "retry_count", "last_error",
+}
+
+func outboxRows(eventTypes ...string) *sqlmock.Rows {
+	rows := sqlmock.NewRows(outboxColumns)
+	created := time.Date(2026, 9, 1, 8, 0, 0, 0, time.UTC)
+	for i, eventType := range eventTypes {
+		rows.AddRow(
+			fmt.Sprintf("00000000-0000-0000-0000-%012d", i+1), eventType, "habit", "h1",
+			[]byte(`{"habit_id":"h1"}`), []byte(`{}`),
+			created.Add(time.Duration(i)*time.Minute), created, true, 0, nil,
+		)
+	}
+	return rows
+}
+
+func TestReplayer(t *testing.T) {
+	Convey("Given two published events for a habit", t, func() {
+		db, mock, err := sqlmock.New()
+		So(err, ShouldBeNil)
+		defer db.Close()
+
+		mock.ExpectQuery(`FROM outbox\s+WHERE published = TRUE AND \(created_at, id\) > \(\$1, \$2\) AND aggregate_id = \$3`).
+			WithArgs(time.Time{}, sqlmock.AnyArg(), "h1", 100).
+			WillReturnRows(outboxRows("habits.habit.created", "habits.habit.completed"))
+
+		publisher := testutil.NewRecordingPublisher()
+		replayer := outbox.NewReplayer(outbox.NewRepository(sqlx.NewDb(db, "sqlmock")), publisher, testutil.NopLogger{})
+		filter := outbox.ReplayFilter{AggregateID: "h1"}
+
+		Convey("They are republished in order", func() {
+			result, err := replayer.Replay(context.Background(), outbox.ReplayOptions{Filter: filter, Consumer: "ethos-worker"})
+
+			So(err, ShouldBeNil)
+			So(result.Matched, ShouldEqual, 2)
+			So(result.Published, ShouldEqual, 2)
+			So(publisher.EventTypes(), ShouldResemble, []string{"habits.habit.created", "habits.habit.completed"})
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("A dry run lists them without publishing", func() {
+			var listed []string
+			result, err := replayer.Replay(context.Background(), outbox.ReplayOptions{
+				Filter:   filter,
+				Consumer: "ethos-worker",
+				DryRun:   true,
+				OnEntry:  func(e outbox.OutboxEntry) { listed = append(listed, e.EventType) },
+			})
+
+			So(err, ShouldBeNil)
+			So(result.Matched, ShouldEqual, 2)
+			So(result.Published, ShouldEqual, 0)
+			So(listed, ShouldHaveLength, 2)
+			So(publisher.Events(), ShouldBeEmpty)
+		})
+	})
+
+	Convey("Given a replayer", t, func() {
+		replayer := outbox.NewReplayer(outbox.NewRepository(nil), testutil.NewRecordingPublisher(), testutil.NopLogger{})
+
+		Convey("A filter without an aggregate or start time is rejected", func() {
+			_, err := replayer.Replay(context.Background(), outbox.ReplayOptions{
+				Filter:   outbox.ReplayFilter{EventType: "habits.habit.created"},
+				Consumer: "ethos-worker",
+			})
+
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("A target consumer is required", func() {
+			_, err := replayer.Replay(context.Background(), outbox.ReplayOptions{
+				Filter: outbox.ReplayFilter{AggregateID: "h1"},
+			})
+
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/semmidev/ethos-go/internal/common/database"
 	"github.com/semmidev/ethos-go/internal/common/events"
+	"github.com/semmidev/ethos-go/internal/common/observability"
 	"github.com/semmidev/ethos-go/internal/common/random"
 )
 
@@ -36,7 +37,9 @@ func NewRepository(db database.DBTX) *Repository {
 	return &Repository{db: db}
 }
 
-// Insert adds an event to the outbox (should be called within a transaction)
+// Insert adds an event to the outbox. It joins the transaction carried by
+// ctx (see database.WithTx), so the event is only published if the change
+// that raised it commits.
 func (r *Repository) Insert(ctx context.Context, event events.Event, aggregateType string) error {
 	payload, err := json.Marshal(event)
 	if err != nil {
@@ -44,15 +47,17 @@ func (r *Repository) Insert(ctx context.Context, event events.Event, aggregateTy
 	}
 
 	query := `
-		INSERT INTO outbox (id, event_type, aggregate_type, aggregate_id, payload)
-		VALUES ($1, $2, $3, $4, $5)
+		INSERT INTO outbox (id, event_type, aggregate_type, aggregate_id, payload, metadata)
+		VALUES ($1, $2, $3, $4, $5, $6)
 	`
-	_, err = r.db.ExecContext(ctx, query,
+	_, err = database.FromContext(ctx, r.db).ExecContext(ctx, query,
 		random.NewUUID(),
 		event.EventType(),
 		aggregateType,
 		event.AggregateID(),
 		payload,
+		// The trace context lets the processor continue the request's trace
+		observability.InjectTraceMetadata(ctx),
 	)
 	return err
 }
@@ -107,3 +112,41 @@ func (r *Repository) CleanupOld(ctx context.Context, olderThan time.Duration) (i
 	}
 	return result.RowsAffected()
 }
+
+// RequeueFailed resets the retry state of unpublished entries that have
+// failed at least once, so the processor treats them as fresh. It returns
+// the number of entries requeued.
+func (r *Repository) RequeueFailed(ctx context.Context) (int64, error) {
+	query := `
+		UPDATE outbox
+		SET retry_count = 0, last_error = NULL
+		WHERE published = FALSE AND retry_count > 0
+	`
+	result, err := r.db.ExecContext(ctx, query)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// Stats summarises the unpublished backlog
+type Stats struct {
+	Pending       int64      `db:"pending"`
+	Failed        int64      `db:"failed"`
+	OldestPending *time.Time `db:"oldest_pending"`
+}
+
+// Stats returns the size and age of the unpublished backlog
+func (r *Repository) Stats(ctx context.Context) (Stats, error) {
+	query := `
+		SELECT
+			COUNT(*) AS pending,
+			COUNT(*) FILTER (WHERE retry_count > 0) AS failed,
+			MIN(created_at) AS oldest_pending
+		FROM outbox
+		WHERE published = FALSE
+	`
+	var s Stats
+	err := r.db.GetContext(ctx, &s, query)
+	return s, err
+}
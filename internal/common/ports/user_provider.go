@@ -13,6 +13,7 @@ type UserInfo struct {
 	Email    string
 	Name     string
 	Timezone string
+	Locale   string
 }
 
 // UserProvider is an interface that allows other modules to query user data
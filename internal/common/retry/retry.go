@@ -0,0 +1,85 @@
+// Package retry calls an operation again after transient failures, such
+// as a deadlock or a broker reconnecting, with exponential backoff and
+// full jitter. Only retry operations that are safe to repeat: a rolled
+// back transaction, or a publish the broker deduplicates.
+package retry
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"time"
+)
+
+// Default policy settings
+const (
+	DefaultMaxAttempts     = 3
+	DefaultInitialInterval = 50 * time.Millisecond
+	DefaultMaxInterval     = time.Second
+)
+
+// Policy says how often an operation is tried and which of its errors are
+// worth trying again
+type Policy struct {
+	// MaxAttempts is how many times the operation is tried in all,
+	// including the first
+	MaxAttempts int
+	// InitialInterval bounds the wait after the first failed attempt. The
+	// bound doubles after every further one up to MaxInterval.
+	InitialInterval time.Duration
+	MaxInterval     time.Duration
+	// Retryable reports whether err is transient. Nil retries nothing, so
+	// a policy never repeats an operation by accident.
+	Retryable func(err error) bool
+}
+
+// Do calls fn until it succeeds, fails with an error the policy does not
+// retry, or has been tried MaxAttempts times, and returns its last error.
+// It stops waiting, returning fn's last error, once ctx is done. Zero
+// settings get their defaults.
+func Do(ctx context.Context, policy Policy, fn func(ctx context.Context) error) error {
+	policy = policy.withDefaults()
+
+	for attempt := 1; ; attempt++ {
+		err := fn(ctx)
+		if err == nil || attempt >= policy.MaxAttempts || !policy.retryable(err) || ctx.Err() != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("gave up retrying after %d attempts: %w", attempt, err)
+		case <-time.After(Backoff(policy.InitialInterval, policy.MaxInterval, attempt)):
+		}
+	}
+}
+
+// Backoff is a random wait of up to initial * 2^(attempt-1), capped at max
+func Backoff(initial, max time.Duration, attempt int) time.Duration {
+	ceiling := initial
+	for i := 1; i < attempt && ceiling < max; i++ {
+		ceiling *= 2
+	}
+	ceiling = min(ceiling, max)
+	if ceiling <= 0 {
+		return 0
+	}
+	return rand.N(ceiling) + 1
+}
+
+func (p Policy) withDefaults() Policy {
+	if p.MaxAttempts <= 0 {
+		p.MaxAttempts = DefaultMaxAttempts
+	}
+	if p.InitialInterval <= 0 {
+		p.InitialInterval = DefaultInitialInterval
+	}
+	if p.MaxInterval <= 0 {
+		p.MaxInterval = DefaultMaxInterval
+	}
+	return p
+}
+
+func (p Policy) retryable(err error) bool {
+	return p.Retryable != nil && p.Retryable(err)
+}
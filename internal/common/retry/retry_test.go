@@ -0,0 +1,101 @@
+package retry_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/common/retry"
+)
+
+func TestDo(t *testing.T) {
+	t.Parallel()
+
+	errBusy := errors.New("deadlock detected")
+	errInvalid := errors.New("invalid input")
+
+	Convey("Given a policy retrying busy errors three times", t, func() {
+		policy := retry.Policy{
+			MaxAttempts:     3,
+			InitialInterval: time.Millisecond,
+			MaxInterval:     time.Millisecond,
+			Retryable:       func(err error) bool { return errors.Is(err, errBusy) },
+		}
+
+		calls := 0
+		failing := func(errs ...error) func(context.Context) error {
+			return func(context.Context) error {
+				calls++
+				if calls <= len(errs) {
+					return errs[calls-1]
+				}
+				return nil
+			}
+		}
+
+		Convey("When the operation fails transiently, then succeeds", func() {
+			err := retry.Do(context.Background(), policy, failing(errBusy, errBusy))
+
+			Convey("Then it succeeds on the last attempt", func() {
+				So(err, ShouldBeNil)
+				So(calls, ShouldEqual, 3)
+			})
+		})
+
+		Convey("When the operation keeps failing transiently", func() {
+			err := retry.Do(context.Background(), policy, failing(errBusy, errBusy, errBusy, errBusy))
+
+			Convey("Then it gives up after the last attempt with its error", func() {
+				So(err, ShouldEqual, errBusy)
+				So(calls, ShouldEqual, 3)
+			})
+		})
+
+		Convey("When the operation fails with an error the policy doesn't retry", func() {
+			err := retry.Do(context.Background(), policy, failing(errInvalid))
+
+			Convey("Then it is not tried again", func() {
+				So(err, ShouldEqual, errInvalid)
+				So(calls, ShouldEqual, 1)
+			})
+		})
+
+		Convey("When the policy says nothing is retryable", func() {
+			policy.Retryable = nil
+			err := retry.Do(context.Background(), policy, failing(errBusy))
+
+			Convey("Then it is not tried again", func() {
+				So(err, ShouldEqual, errBusy)
+				So(calls, ShouldEqual, 1)
+			})
+		})
+
+		Convey("When the context ends while waiting to retry", func() {
+			policy.InitialInterval = time.Hour
+			policy.MaxInterval = time.Hour
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+			defer cancel()
+			err := retry.Do(ctx, policy, failing(errBusy, errBusy))
+
+			Convey("Then it stops with the operation's error", func() {
+				So(errors.Is(err, errBusy), ShouldBeTrue)
+				So(calls, ShouldEqual, 1)
+			})
+		})
+	})
+}
+
+func TestBackoff(t *testing.T) {
+	t.Parallel()
+
+	Convey("Backoff waits at most initial doubled per attempt, capped at max", t, func() {
+		for range 100 {
+			So(retry.Backoff(10*time.Millisecond, time.Second, 1), ShouldBeBetweenOrEqual, time.Nanosecond, 10*time.Millisecond)
+			So(retry.Backoff(10*time.Millisecond, time.Second, 3), ShouldBeLessThanOrEqualTo, 40*time.Millisecond)
+			So(retry.Backoff(10*time.Millisecond, time.Second, 20), ShouldBeLessThanOrEqualTo, time.Second)
+		}
+	})
+}
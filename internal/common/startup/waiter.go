@@ -0,0 +1,87 @@
+// Package startup waits for the dependencies a process needs at boot, so
+// the API and worker survive Postgres or Redis coming up a little after
+// them, as they do under docker-compose.
+package startup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/common/retry"
+)
+
+// Default backoff between attempts
+const (
+	DefaultInitialInterval = 500 * time.Millisecond
+	DefaultMaxInterval     = 10 * time.Second
+)
+
+// Waiter retries the initialization of dependencies until they succeed or
+// a deadline shared by all of them passes. Failed attempts are retried
+// with exponential backoff and full jitter, so replicas starting together
+// don't retry in lockstep.
+type Waiter struct {
+	deadline time.Time
+	initial  time.Duration
+	max      time.Duration
+	log      logger.Logger
+}
+
+// NewWaiter creates a waiter giving every dependency, together, timeout
+// from now to come up
+func NewWaiter(timeout time.Duration, log logger.Logger) *Waiter {
+	return &Waiter{
+		deadline: time.Now().Add(timeout),
+		initial:  DefaultInitialInterval,
+		max:      DefaultMaxInterval,
+		log:      log,
+	}
+}
+
+// WithBackoff sets the wait after the first failed attempt, which doubles
+// after every further one up to max
+func (w *Waiter) WithBackoff(initial, max time.Duration) *Waiter {
+	w.initial = initial
+	w.max = max
+	return w
+}
+
+// Retry calls init until it succeeds, logging every failed attempt. init
+// gets a context that ends at the deadline. Retry returns the last error
+// once the next attempt would start after the deadline, or once ctx is
+// done.
+func (w *Waiter) Retry(ctx context.Context, dependency string, init func(ctx context.Context) error) error {
+	ctx, cancel := context.WithDeadline(ctx, w.deadline)
+	defer cancel()
+
+	fields := logger.Field{Key: "dependency", Value: dependency}
+
+	for attempt := 1; ; attempt++ {
+		err := init(ctx)
+		if err == nil {
+			if attempt > 1 {
+				w.log.Info(ctx, "dependency ready", fields, logger.Field{Key: "attempts", Value: attempt})
+			}
+			return nil
+		}
+
+		wait := retry.Backoff(w.initial, w.max, attempt)
+		if time.Now().Add(wait).After(w.deadline) {
+			return fmt.Errorf("%s not ready after %d attempts: %w", dependency, attempt, err)
+		}
+
+		w.log.Warn(ctx, "dependency not ready, retrying", fields,
+			logger.Field{Key: "attempt", Value: attempt},
+			logger.Field{Key: "retry_in", Value: wait.String()},
+			logger.Field{Key: "error", Value: err.Error()},
+		)
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%s not ready after %d attempts: %w", dependency, attempt, err)
+		case <-time.After(wait):
+		}
+	}
+}
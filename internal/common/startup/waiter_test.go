@@ -0,0 +1,77 @@
+package startup_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/common/startup"
+	"github.com/semmidev/ethos-go/internal/testutil"
+)
+
+func TestWaiter(t *testing.T) {
+	t.Parallel()
+
+	errRefused := errors.New("connection refused")
+
+	Convey("Given a waiter with a short backoff", t, func() {
+		ctx := context.Background()
+		waiter := startup.NewWaiter(time.Second, testutil.NopLogger{}).WithBackoff(time.Millisecond, 5*time.Millisecond)
+
+		Convey("When a dependency comes up after a few attempts", func() {
+			attempts := 0
+			err := waiter.Retry(ctx, "postgres", func(context.Context) error {
+				attempts++
+				if attempts < 3 {
+					return errRefused
+				}
+				return nil
+			})
+
+			Convey("Then it is retried until it is ready", func() {
+				So(err, ShouldBeNil)
+				So(attempts, ShouldEqual, 3)
+			})
+		})
+
+		Convey("When the caller gives up", func() {
+			ctx, cancel := context.WithCancel(ctx)
+			attempts := 0
+			err := waiter.Retry(ctx, "redis", func(context.Context) error {
+				attempts++
+				cancel()
+				return errRefused
+			})
+
+			Convey("Then it stops retrying with the last error", func() {
+				So(errors.Is(err, errRefused), ShouldBeTrue)
+				So(attempts, ShouldEqual, 1)
+			})
+		})
+	})
+
+	Convey("Given a waiter whose deadline passes before the next attempt", t, func() {
+		waiter := startup.NewWaiter(20*time.Millisecond, testutil.NopLogger{}).WithBackoff(5*time.Millisecond, 5*time.Millisecond)
+
+		Convey("When a dependency never comes up", func() {
+			start := time.Now()
+			attempts := 0
+			err := waiter.Retry(context.Background(), "nats", func(ctx context.Context) error {
+				attempts++
+				_, hasDeadline := ctx.Deadline()
+				So(hasDeadline, ShouldBeTrue)
+				return errRefused
+			})
+
+			Convey("Then it fails with the last error once the deadline is reached", func() {
+				So(errors.Is(err, errRefused), ShouldBeTrue)
+				So(err.Error(), ShouldContainSubstring, "nats not ready")
+				So(attempts, ShouldBeGreaterThan, 1)
+				So(time.Since(start), ShouldBeLessThan, time.Second)
+			})
+		})
+	})
+}
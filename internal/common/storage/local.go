@@ -0,0 +1,122 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// LocalPathPrefix is where the API serves a local store's objects when no
+// public URL is configured
+const LocalPathPrefix = "/uploads"
+
+// LocalStore keeps objects as files under a directory. The API serves them
+// through Handler unless a web server or CDN in front of the directory is
+// configured as the public URL.
+type LocalStore struct {
+	dir       string
+	publicURL string
+}
+
+// NewLocalStore creates a store writing under dir and linking objects below
+// publicURL, which defaults to LocalPathPrefix on the API itself
+func NewLocalStore(dir, publicURL string) (*LocalStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	if publicURL == "" {
+		publicURL = LocalPathPrefix
+	}
+	return &LocalStore{dir: dir, publicURL: strings.TrimRight(publicURL, "/")}, nil
+}
+
+// Put writes the object to a temporary file and renames it into place so
+// readers never see a partial file
+func (s *LocalStore) Put(_ context.Context, key, _ string, data []byte) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+
+	path := filepath.Join(s.dir, filepath.FromSlash(key))
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// Delete removes the object's file
+func (s *LocalStore) Delete(_ context.Context, key string) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+
+	err := os.Remove(filepath.Join(s.dir, filepath.FromSlash(key)))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// URL links the object below the public URL
+func (s *LocalStore) URL(_ context.Context, key string) (string, error) {
+	if err := validateKey(key); err != nil {
+		return "", err
+	}
+	return s.publicURL + "/" + key, nil
+}
+
+// Handler serves the stored objects; mount it at LocalPathPrefix. Keys are
+// random, so responses may be cached for good.
+func (s *LocalStore) Handler() http.Handler {
+	files := http.StripPrefix(LocalPathPrefix, http.FileServer(noListing{http.Dir(s.dir)}))
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		files.ServeHTTP(w, r)
+	})
+}
+
+// noListing hides directory listings
+type noListing struct {
+	fs http.FileSystem
+}
+
+func (n noListing) Open(name string) (http.File, error) {
+	f, err := n.fs.Open(name)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	if info.IsDir() {
+		_ = f.Close()
+		return nil, fs.ErrNotExist
+	}
+	return f, nil
+}
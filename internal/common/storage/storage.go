@@ -0,0 +1,84 @@
+// Package storage keeps user uploads, such as avatars, in an object store.
+//
+// Objects are addressed by slash-separated keys. Callers persist the key and
+// ask the store for a URL whenever they hand the object to a client, so a
+// store may return short-lived signed URLs.
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/semmidev/ethos-go/config"
+)
+
+// Drivers selectable with STORAGE_DRIVER
+const (
+	DriverLocal = "local"
+	DriverS3    = "s3"
+)
+
+// ErrInvalidKey is returned for keys that are empty or could escape the
+// store, such as keys containing ".." segments
+var ErrInvalidKey = errors.New("invalid object key")
+
+// Store saves objects and resolves the URLs clients fetch them from
+type Store interface {
+	// Put stores data under key, replacing any existing object
+	Put(ctx context.Context, key, contentType string, data []byte) error
+	// Delete removes the object under key; a missing object is not an error
+	Delete(ctx context.Context, key string) error
+	// URL returns where clients can download the object under key
+	URL(ctx context.Context, key string) (string, error)
+}
+
+// New creates the store selected by the configuration
+func New(cfg *config.Config) (Store, error) {
+	switch cfg.StorageDriver {
+	case DriverLocal:
+		return NewLocalStore(cfg.StorageLocalDir, cfg.StoragePublicURL)
+	case DriverS3:
+		return NewS3Store(S3Config{
+			Bucket:    cfg.StorageS3Bucket,
+			Region:    cfg.StorageS3Region,
+			Endpoint:  cfg.StorageS3Endpoint,
+			AccessKey: cfg.StorageS3AccessKey,
+			SecretKey: cfg.StorageS3SecretKey,
+			PublicURL: cfg.StoragePublicURL,
+			URLExpiry: cfg.StorageURLExpiry,
+		})
+	default:
+		return nil, fmt.Errorf("unknown storage driver %q", cfg.StorageDriver)
+	}
+}
+
+// ResolveURL returns the URL of a stored reference. References that are
+// already absolute URLs, such as avatars set before uploads were stored,
+// are returned unchanged.
+func ResolveURL(ctx context.Context, store Store, ref string) (string, error) {
+	if ref == "" || IsExternal(ref) {
+		return ref, nil
+	}
+	return store.URL(ctx, ref)
+}
+
+// IsExternal reports whether ref is an absolute URL rather than a key
+func IsExternal(ref string) bool {
+	return strings.HasPrefix(ref, "http://") || strings.HasPrefix(ref, "https://")
+}
+
+// validateKey rejects keys that are empty, absolute or contain empty, "."
+// or ".." segments
+func validateKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return ErrInvalidKey
+	}
+	for _, segment := range strings.Split(key, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return ErrInvalidKey
+		}
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+
+	"github.com/semmidev/ethos-go/internal/common/observability"
+)
+
+// AsynqDispatcher enqueues tasks on Asynq, carrying the caller's trace
+type AsynqDispatcher struct {
+	client *asynq.Client
+}
+
+var _ Dispatcher = (*AsynqDispatcher)(nil)
+
+func NewAsynqDispatcher(client *asynq.Client) *AsynqDispatcher {
+	return &AsynqDispatcher{client: client}
+}
+
+func (d *AsynqDispatcher) Enqueue(ctx context.Context, taskType string, payload any, opts ...Option) error {
+	data, err := encodePayload(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task payload: %w", err)
+	}
+
+	t := observability.NewTracedTask(ctx, taskType, data, asynqOptions(NewOptions(opts...))...)
+	if _, err := d.client.EnqueueContext(ctx, t); err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	return nil
+}
+
+func asynqOptions(o Options) []asynq.Option {
+	var opts []asynq.Option
+	if o.MaxRetry >= 0 {
+		opts = append(opts, asynq.MaxRetry(o.MaxRetry))
+	}
+	if o.Timeout > 0 {
+		opts = append(opts, asynq.Timeout(o.Timeout))
+	}
+	if o.Retention > 0 {
+		opts = append(opts, asynq.Retention(o.Retention))
+	}
+	return opts
+}
+
+func encodePayload(payload any) ([]byte, error) {
+	switch p := payload.(type) {
+	case nil:
+		return nil, nil
+	case []byte:
+		return p, nil
+	default:
+		return json.Marshal(p)
+	}
+}
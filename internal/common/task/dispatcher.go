@@ -0,0 +1,52 @@
+package task
+
+import (
+	"context"
+	"time"
+)
+
+// Dispatcher enqueues background tasks for the worker. Payloads are JSON
+// encoded; a []byte payload is sent as-is.
+type Dispatcher interface {
+	Enqueue(ctx context.Context, taskType string, payload any, opts ...Option) error
+}
+
+// Options control how a task is retried and kept. Zero values leave the
+// queue's defaults in place.
+type Options struct {
+	// MaxRetry is the number of retries after a failure; -1 keeps the
+	// queue default
+	MaxRetry int
+	// Timeout bounds a single attempt
+	Timeout time.Duration
+	// Retention keeps a completed task around for inspection
+	Retention time.Duration
+}
+
+// Option sets one of the task Options
+type Option func(*Options)
+
+// MaxRetry sets how many times a failed task is retried before it moves
+// to the dead queue
+func MaxRetry(n int) Option {
+	return func(o *Options) { o.MaxRetry = n }
+}
+
+// Timeout bounds how long a single attempt may run
+func Timeout(d time.Duration) Option {
+	return func(o *Options) { o.Timeout = d }
+}
+
+// Retention keeps the completed task for d
+func Retention(d time.Duration) Option {
+	return func(o *Options) { o.Retention = d }
+}
+
+// NewOptions applies opts over the defaults
+func NewOptions(opts ...Option) Options {
+	o := Options{MaxRetry: -1}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
@@ -0,0 +1,67 @@
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// Enqueued is a task recorded by InMemoryDispatcher
+type Enqueued struct {
+	Type    string
+	Payload []byte
+	Options Options
+}
+
+// Decode unmarshals the task's JSON payload into v
+func (e Enqueued) Decode(v any) error {
+	return json.Unmarshal(e.Payload, v)
+}
+
+// InMemoryDispatcher records tasks instead of enqueueing them, for tests
+// and tools that must not reach the queue. Set Err to make every enqueue
+// fail.
+type InMemoryDispatcher struct {
+	mu    sync.Mutex
+	tasks []Enqueued
+	Err   error
+}
+
+var _ Dispatcher = (*InMemoryDispatcher)(nil)
+
+func NewInMemoryDispatcher() *InMemoryDispatcher {
+	return &InMemoryDispatcher{}
+}
+
+func (d *InMemoryDispatcher) Enqueue(_ context.Context, taskType string, payload any, opts ...Option) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.Err != nil {
+		return d.Err
+	}
+
+	data, err := encodePayload(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task payload: %w", err)
+	}
+
+	d.tasks = append(d.tasks, Enqueued{Type: taskType, Payload: data, Options: NewOptions(opts...)})
+	return nil
+}
+
+// Tasks returns the recorded tasks of taskType, or all of them when
+// taskType is empty
+func (d *InMemoryDispatcher) Tasks(taskType string) []Enqueued {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var tasks []Enqueued
+	for _, t := range d.tasks {
+		if taskType == "" || t.Type == taskType {
+			tasks = append(tasks, t)
+		}
+	}
+	return tasks
+}
@@ -1,215 +0,0 @@
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.36.11
-// 	protoc        (unknown)
-// source: ethos/auth/v1/auth_service.proto
-
-package authv1
-
-import (
-	_ "google.golang.org/genproto/googleapis/api/annotations"
-	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
-	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	reflect "reflect"
-	sync "sync"
-	unsafe "unsafe"
-)
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-// SuccessResponse for simple success/failure responses.
-type SuccessResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Whether the operation was successful.
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	// Human-readable message describing the result.
-	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *SuccessResponse) Reset() {
-	*x = SuccessResponse{}
-	mi := &file_ethos_auth_v1_auth_service_proto_msgTypes[0]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *SuccessResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*SuccessResponse) ProtoMessage() {}
-
-func (x *SuccessResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_auth_v1_auth_service_proto_msgTypes[0]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use SuccessResponse.ProtoReflect.Descriptor instead.
-func (*SuccessResponse) Descriptor() ([]byte, []int) {
-	return file_ethos_auth_v1_auth_service_proto_rawDescGZIP(), []int{0}
-}
-
-func (x *SuccessResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-func (x *SuccessResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-var File_ethos_auth_v1_auth_service_proto protoreflect.FileDescriptor
-
-const file_ethos_auth_v1_auth_service_proto_rawDesc = "" +
-	"\n" +
-	" ethos/auth/v1/auth_service.proto\x12\rethos.auth.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1cethos/auth/v1/messages.proto\"E\n" +
-	"\x0fSuccessResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage2\xdc\x0f\n" +
-	"\vAuthService\x12i\n" +
-	"\bRegister\x12\x1e.ethos.auth.v1.RegisterRequest\x1a\x1f.ethos.auth.v1.RegisterResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/auth/register\x12]\n" +
-	"\x05Login\x12\x1b.ethos.auth.v1.LoginRequest\x1a\x1c.ethos.auth.v1.LoginResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/auth/login\x12s\n" +
-	"\vGoogleLogin\x12!.ethos.auth.v1.GoogleLoginRequest\x1a\".ethos.auth.v1.GoogleLoginResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/auth/google/login\x12y\n" +
-	"\x0eGoogleCallback\x12$.ethos.auth.v1.GoogleCallbackRequest\x1a\x1c.ethos.auth.v1.LoginResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/auth/google/callback\x12a\n" +
-	"\x06Logout\x12\x1c.ethos.auth.v1.LogoutRequest\x1a\x1d.ethos.auth.v1.LogoutResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/auth/logout\x12k\n" +
-	"\tLogoutAll\x12\x1f.ethos.auth.v1.LogoutAllRequest\x1a\x1d.ethos.auth.v1.LogoutResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/auth/logout-all\x12r\n" +
-	"\fListSessions\x12\".ethos.auth.v1.ListSessionsRequest\x1a#.ethos.auth.v1.ListSessionsResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/auth/sessions\x12\x8d\x01\n" +
-	"\x13RevokeOtherSessions\x12).ethos.auth.v1.RevokeOtherSessionsRequest\x1a*.ethos.auth.v1.RevokeOtherSessionsResponse\"\x1f\x82\xd3\xe4\x93\x02\x19*\x17/v1/auth/sessions/other\x12h\n" +
-	"\n" +
-	"GetProfile\x12 .ethos.auth.v1.GetProfileRequest\x1a\x1e.ethos.auth.v1.ProfileResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/auth/profile\x12q\n" +
-	"\rUpdateProfile\x12#.ethos.auth.v1.UpdateProfileRequest\x1a\x1e.ethos.auth.v1.ProfileResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\x1a\x10/v1/auth/profile\x12{\n" +
-	"\x0eChangePassword\x12$.ethos.auth.v1.ChangePasswordRequest\x1a\x1e.ethos.auth.v1.SuccessResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/auth/change-password\x12r\n" +
-	"\vVerifyEmail\x12!.ethos.auth.v1.VerifyEmailRequest\x1a\x1e.ethos.auth.v1.SuccessResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/auth/verify-email\x12\x87\x01\n" +
-	"\x12ResendVerification\x12(.ethos.auth.v1.ResendVerificationRequest\x1a\x1e.ethos.auth.v1.SuccessResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/auth/resend-verification\x12{\n" +
-	"\x0eForgotPassword\x12$.ethos.auth.v1.ForgotPasswordRequest\x1a\x1e.ethos.auth.v1.SuccessResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/auth/forgot-password\x12x\n" +
-	"\rResetPassword\x12#.ethos.auth.v1.ResetPasswordRequest\x1a\x1e.ethos.auth.v1.SuccessResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/auth/reset-password\x12v\n" +
-	"\x0eExportUserData\x12$.ethos.auth.v1.ExportUserDataRequest\x1a%.ethos.auth.v1.ExportUserDataResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/auth/export\x12x\n" +
-	"\rDeleteAccount\x12#.ethos.auth.v1.DeleteAccountRequest\x1a\x1e.ethos.auth.v1.SuccessResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/auth/account/deleteB\xc6\x01\n" +
-	"\x11com.ethos.auth.v1B\x10AuthServiceProtoP\x01ZIgithub.com/semmidev/ethos-go/internal/generated/grpc/ethos/auth/v1;authv1\xa2\x02\x03EAX\xaa\x02\rEthos.Auth.V1\xca\x02\rEthos\\Auth\\V1\xe2\x02\x19Ethos\\Auth\\V1\\GPBMetadata\xea\x02\x0fEthos::Auth::V1b\x06proto3"
-
-var (
-	file_ethos_auth_v1_auth_service_proto_rawDescOnce sync.Once
-	file_ethos_auth_v1_auth_service_proto_rawDescData []byte
-)
-
-func file_ethos_auth_v1_auth_service_proto_rawDescGZIP() []byte {
-	file_ethos_auth_v1_auth_service_proto_rawDescOnce.Do(func() {
-		file_ethos_auth_v1_auth_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ethos_auth_v1_auth_service_proto_rawDesc), len(file_ethos_auth_v1_auth_service_proto_rawDesc)))
-	})
-	return file_ethos_auth_v1_auth_service_proto_rawDescData
-}
-
-var file_ethos_auth_v1_auth_service_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
-var file_ethos_auth_v1_auth_service_proto_goTypes = []any{
-	(*SuccessResponse)(nil),             // 0: ethos.auth.v1.SuccessResponse
-	(*RegisterRequest)(nil),             // 1: ethos.auth.v1.RegisterRequest
-	(*LoginRequest)(nil),                // 2: ethos.auth.v1.LoginRequest
-	(*GoogleLoginRequest)(nil),          // 3: ethos.auth.v1.GoogleLoginRequest
-	(*GoogleCallbackRequest)(nil),       // 4: ethos.auth.v1.GoogleCallbackRequest
-	(*LogoutRequest)(nil),               // 5: ethos.auth.v1.LogoutRequest
-	(*LogoutAllRequest)(nil),            // 6: ethos.auth.v1.LogoutAllRequest
-	(*ListSessionsRequest)(nil),         // 7: ethos.auth.v1.ListSessionsRequest
-	(*RevokeOtherSessionsRequest)(nil),  // 8: ethos.auth.v1.RevokeOtherSessionsRequest
-	(*GetProfileRequest)(nil),           // 9: ethos.auth.v1.GetProfileRequest
-	(*UpdateProfileRequest)(nil),        // 10: ethos.auth.v1.UpdateProfileRequest
-	(*ChangePasswordRequest)(nil),       // 11: ethos.auth.v1.ChangePasswordRequest
-	(*VerifyEmailRequest)(nil),          // 12: ethos.auth.v1.VerifyEmailRequest
-	(*ResendVerificationRequest)(nil),   // 13: ethos.auth.v1.ResendVerificationRequest
-	(*ForgotPasswordRequest)(nil),       // 14: ethos.auth.v1.ForgotPasswordRequest
-	(*ResetPasswordRequest)(nil),        // 15: ethos.auth.v1.ResetPasswordRequest
-	(*ExportUserDataRequest)(nil),       // 16: ethos.auth.v1.ExportUserDataRequest
-	(*DeleteAccountRequest)(nil),        // 17: ethos.auth.v1.DeleteAccountRequest
-	(*RegisterResponse)(nil),            // 18: ethos.auth.v1.RegisterResponse
-	(*LoginResponse)(nil),               // 19: ethos.auth.v1.LoginResponse
-	(*GoogleLoginResponse)(nil),         // 20: ethos.auth.v1.GoogleLoginResponse
-	(*LogoutResponse)(nil),              // 21: ethos.auth.v1.LogoutResponse
-	(*ListSessionsResponse)(nil),        // 22: ethos.auth.v1.ListSessionsResponse
-	(*RevokeOtherSessionsResponse)(nil), // 23: ethos.auth.v1.RevokeOtherSessionsResponse
-	(*ProfileResponse)(nil),             // 24: ethos.auth.v1.ProfileResponse
-	(*ExportUserDataResponse)(nil),      // 25: ethos.auth.v1.ExportUserDataResponse
-}
-var file_ethos_auth_v1_auth_service_proto_depIdxs = []int32{
-	1,  // 0: ethos.auth.v1.AuthService.Register:input_type -> ethos.auth.v1.RegisterRequest
-	2,  // 1: ethos.auth.v1.AuthService.Login:input_type -> ethos.auth.v1.LoginRequest
-	3,  // 2: ethos.auth.v1.AuthService.GoogleLogin:input_type -> ethos.auth.v1.GoogleLoginRequest
-	4,  // 3: ethos.auth.v1.AuthService.GoogleCallback:input_type -> ethos.auth.v1.GoogleCallbackRequest
-	5,  // 4: ethos.auth.v1.AuthService.Logout:input_type -> ethos.auth.v1.LogoutRequest
-	6,  // 5: ethos.auth.v1.AuthService.LogoutAll:input_type -> ethos.auth.v1.LogoutAllRequest
-	7,  // 6: ethos.auth.v1.AuthService.ListSessions:input_type -> ethos.auth.v1.ListSessionsRequest
-	8,  // 7: ethos.auth.v1.AuthService.RevokeOtherSessions:input_type -> ethos.auth.v1.RevokeOtherSessionsRequest
-	9,  // 8: ethos.auth.v1.AuthService.GetProfile:input_type -> ethos.auth.v1.GetProfileRequest
-	10, // 9: ethos.auth.v1.AuthService.UpdateProfile:input_type -> ethos.auth.v1.UpdateProfileRequest
-	11, // 10: ethos.auth.v1.AuthService.ChangePassword:input_type -> ethos.auth.v1.ChangePasswordRequest
-	12, // 11: ethos.auth.v1.AuthService.VerifyEmail:input_type -> ethos.auth.v1.VerifyEmailRequest
-	13, // 12: ethos.auth.v1.AuthService.ResendVerification:input_type -> ethos.auth.v1.ResendVerificationRequest
-	14, // 13: ethos.auth.v1.AuthService.ForgotPassword:input_type -> ethos.auth.v1.ForgotPasswordRequest
-	15, // 14: ethos.auth.v1.AuthService.ResetPassword:input_type -> ethos.auth.v1.ResetPasswordRequest
-	16, // 15: ethos.auth.v1.AuthService.ExportUserData:input_type -> ethos.auth.v1.ExportUserDataRequest
-	17, // 16: ethos.auth.v1.AuthService.DeleteAccount:input_type -> ethos.auth.v1.DeleteAccountRequest
-	18, // 17: ethos.auth.v1.AuthService.Register:output_type -> ethos.auth.v1.RegisterResponse
-	19, // 18: ethos.auth.v1.AuthService.Login:output_type -> ethos.auth.v1.LoginResponse
-	20, // 19: ethos.auth.v1.AuthService.GoogleLogin:output_type -> ethos.auth.v1.GoogleLoginResponse
-	19, // 20: ethos.auth.v1.AuthService.GoogleCallback:output_type -> ethos.auth.v1.LoginResponse
-	21, // 21: ethos.auth.v1.AuthService.Logout:output_type -> ethos.auth.v1.LogoutResponse
-	21, // 22: ethos.auth.v1.AuthService.LogoutAll:output_type -> ethos.auth.v1.LogoutResponse
-	22, // 23: ethos.auth.v1.AuthService.ListSessions:output_type -> ethos.auth.v1.ListSessionsResponse
-	23, // 24: ethos.auth.v1.AuthService.RevokeOtherSessions:output_type -> ethos.auth.v1.RevokeOtherSessionsResponse
-	24, // 25: ethos.auth.v1.AuthService.GetProfile:output_type -> ethos.auth.v1.ProfileResponse
-	24, // 26: ethos.auth.v1.AuthService.UpdateProfile:output_type -> ethos.auth.v1.ProfileResponse
-	0,  // 27: ethos.auth.v1.AuthService.ChangePassword:output_type -> ethos.auth.v1.SuccessResponse
-	0,  // 28: ethos.auth.v1.AuthService.VerifyEmail:output_type -> ethos.auth.v1.SuccessResponse
-	0,  // 29: ethos.auth.v1.AuthService.ResendVerification:output_type -> ethos.auth.v1.SuccessResponse
-	0,  // 30: ethos.auth.v1.AuthService.ForgotPassword:output_type -> ethos.auth.v1.SuccessResponse
-	0,  // 31: ethos.auth.v1.AuthService.ResetPassword:output_type -> ethos.auth.v1.SuccessResponse
-	25, // 32: ethos.auth.v1.AuthService.ExportUserData:output_type -> ethos.auth.v1.ExportUserDataResponse
-	0,  // 33: ethos.auth.v1.AuthService.DeleteAccount:output_type -> ethos.auth.v1.SuccessResponse
-	17, // [17:34] is the sub-list for method output_type
-	0,  // [0:17] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
-}
-
-func init() { file_ethos_auth_v1_auth_service_proto_init() }
-func file_ethos_auth_v1_auth_service_proto_init() {
-	if File_ethos_auth_v1_auth_service_proto != nil {
-		return
-	}
-	file_ethos_auth_v1_messages_proto_init()
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ethos_auth_v1_auth_service_proto_rawDesc), len(file_ethos_auth_v1_auth_service_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   1,
-			NumExtensions: 0,
-			NumServices:   1,
-		},
-		GoTypes:           file_ethos_auth_v1_auth_service_proto_goTypes,
-		DependencyIndexes: file_ethos_auth_v1_auth_service_proto_depIdxs,
-		MessageInfos:      file_ethos_auth_v1_auth_service_proto_msgTypes,
-	}.Build()
-	File_ethos_auth_v1_auth_service_proto = out.File
-	file_ethos_auth_v1_auth_service_proto_goTypes = nil
-	file_ethos_auth_v1_auth_service_proto_depIdxs = nil
-}
@@ -1,647 +0,0 @@
-// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
-// versions:
-// - protoc-gen-go-grpc v1.6.0
-// - protoc             (unknown)
-// source: ethos/habits/v1/habits_service.proto
-
-package habitsv1
-
-import (
-	context "context"
-	grpc "google.golang.org/grpc"
-	codes "google.golang.org/grpc/codes"
-	status "google.golang.org/grpc/status"
-)
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-// Requires gRPC-Go v1.64.0 or later.
-const _ = grpc.SupportPackageIsVersion9
-
-const (
-	HabitsService_ListHabits_FullMethodName         = "/ethos.habits.v1.HabitsService/ListHabits"
-	HabitsService_CreateHabit_FullMethodName        = "/ethos.habits.v1.HabitsService/CreateHabit"
-	HabitsService_GetHabit_FullMethodName           = "/ethos.habits.v1.HabitsService/GetHabit"
-	HabitsService_UpdateHabit_FullMethodName        = "/ethos.habits.v1.HabitsService/UpdateHabit"
-	HabitsService_DeleteHabit_FullMethodName        = "/ethos.habits.v1.HabitsService/DeleteHabit"
-	HabitsService_ActivateHabit_FullMethodName      = "/ethos.habits.v1.HabitsService/ActivateHabit"
-	HabitsService_DeactivateHabit_FullMethodName    = "/ethos.habits.v1.HabitsService/DeactivateHabit"
-	HabitsService_GetHabitStats_FullMethodName      = "/ethos.habits.v1.HabitsService/GetHabitStats"
-	HabitsService_LogHabit_FullMethodName           = "/ethos.habits.v1.HabitsService/LogHabit"
-	HabitsService_GetHabitLogs_FullMethodName       = "/ethos.habits.v1.HabitsService/GetHabitLogs"
-	HabitsService_UpdateHabitLog_FullMethodName     = "/ethos.habits.v1.HabitsService/UpdateHabitLog"
-	HabitsService_DeleteHabitLog_FullMethodName     = "/ethos.habits.v1.HabitsService/DeleteHabitLog"
-	HabitsService_GetDashboard_FullMethodName       = "/ethos.habits.v1.HabitsService/GetDashboard"
-	HabitsService_GetWeeklyAnalytics_FullMethodName = "/ethos.habits.v1.HabitsService/GetWeeklyAnalytics"
-)
-
-// HabitsServiceClient is the client API for HabitsService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-//
-// HabitsService provides habit tracking functionality.
-type HabitsServiceClient interface {
-	// ListHabits returns all habits for the authenticated user.
-	ListHabits(ctx context.Context, in *ListHabitsRequest, opts ...grpc.CallOption) (*ListHabitsResponse, error)
-	// CreateHabit creates a new habit.
-	CreateHabit(ctx context.Context, in *CreateHabitRequest, opts ...grpc.CallOption) (*HabitResponse, error)
-	// GetHabit retrieves a habit by ID.
-	GetHabit(ctx context.Context, in *GetHabitRequest, opts ...grpc.CallOption) (*HabitResponse, error)
-	// UpdateHabit updates a habit.
-	UpdateHabit(ctx context.Context, in *UpdateHabitRequest, opts ...grpc.CallOption) (*HabitResponse, error)
-	// DeleteHabit deletes a habit.
-	DeleteHabit(ctx context.Context, in *DeleteHabitRequest, opts ...grpc.CallOption) (*SuccessResponse, error)
-	// ActivateHabit activates a habit.
-	ActivateHabit(ctx context.Context, in *ActivateHabitRequest, opts ...grpc.CallOption) (*SuccessResponse, error)
-	// DeactivateHabit deactivates a habit.
-	DeactivateHabit(ctx context.Context, in *DeactivateHabitRequest, opts ...grpc.CallOption) (*SuccessResponse, error)
-	// GetHabitStats retrieves habit statistics.
-	GetHabitStats(ctx context.Context, in *GetHabitStatsRequest, opts ...grpc.CallOption) (*HabitStatsResponse, error)
-	// LogHabit logs a habit completion.
-	LogHabit(ctx context.Context, in *LogHabitRequest, opts ...grpc.CallOption) (*LogHabitResponse, error)
-	// GetHabitLogs retrieves logs for a habit.
-	GetHabitLogs(ctx context.Context, in *GetHabitLogsRequest, opts ...grpc.CallOption) (*GetHabitLogsResponse, error)
-	// UpdateHabitLog updates a habit log.
-	UpdateHabitLog(ctx context.Context, in *UpdateHabitLogRequest, opts ...grpc.CallOption) (*SuccessResponse, error)
-	// DeleteHabitLog deletes a habit log.
-	DeleteHabitLog(ctx context.Context, in *DeleteHabitLogRequest, opts ...grpc.CallOption) (*SuccessResponse, error)
-	// GetDashboard retrieves the user's dashboard data.
-	GetDashboard(ctx context.Context, in *GetDashboardRequest, opts ...grpc.CallOption) (*DashboardResponse, error)
-	// GetWeeklyAnalytics retrieves weekly analytics data.
-	GetWeeklyAnalytics(ctx context.Context, in *GetWeeklyAnalyticsRequest, opts ...grpc.CallOption) (*WeeklyAnalyticsResponse, error)
-}
-
-type habitsServiceClient struct {
-	cc grpc.ClientConnInterface
-}
-
-func NewHabitsServiceClient(cc grpc.ClientConnInterface) HabitsServiceClient {
-	return &habitsServiceClient{cc}
-}
-
-func (c *habitsServiceClient) ListHabits(ctx context.Context, in *ListHabitsRequest, opts ...grpc.CallOption) (*ListHabitsResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListHabitsResponse)
-	err := c.cc.Invoke(ctx, HabitsService_ListHabits_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *habitsServiceClient) CreateHabit(ctx context.Context, in *CreateHabitRequest, opts ...grpc.CallOption) (*HabitResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(HabitResponse)
-	err := c.cc.Invoke(ctx, HabitsService_CreateHabit_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *habitsServiceClient) GetHabit(ctx context.Context, in *GetHabitRequest, opts ...grpc.CallOption) (*HabitResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(HabitResponse)
-	err := c.cc.Invoke(ctx, HabitsService_GetHabit_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *habitsServiceClient) UpdateHabit(ctx context.Context, in *UpdateHabitRequest, opts ...grpc.CallOption) (*HabitResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(HabitResponse)
-	err := c.cc.Invoke(ctx, HabitsService_UpdateHabit_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *habitsServiceClient) DeleteHabit(ctx context.Context, in *DeleteHabitRequest, opts ...grpc.CallOption) (*SuccessResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(SuccessResponse)
-	err := c.cc.Invoke(ctx, HabitsService_DeleteHabit_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *habitsServiceClient) ActivateHabit(ctx context.Context, in *ActivateHabitRequest, opts ...grpc.CallOption) (*SuccessResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(SuccessResponse)
-	err := c.cc.Invoke(ctx, HabitsService_ActivateHabit_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *habitsServiceClient) DeactivateHabit(ctx context.Context, in *DeactivateHabitRequest, opts ...grpc.CallOption) (*SuccessResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(SuccessResponse)
-	err := c.cc.Invoke(ctx, HabitsService_DeactivateHabit_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *habitsServiceClient) GetHabitStats(ctx context.Context, in *GetHabitStatsRequest, opts ...grpc.CallOption) (*HabitStatsResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(HabitStatsResponse)
-	err := c.cc.Invoke(ctx, HabitsService_GetHabitStats_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *habitsServiceClient) LogHabit(ctx context.Context, in *LogHabitRequest, opts ...grpc.CallOption) (*LogHabitResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(LogHabitResponse)
-	err := c.cc.Invoke(ctx, HabitsService_LogHabit_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *habitsServiceClient) GetHabitLogs(ctx context.Context, in *GetHabitLogsRequest, opts ...grpc.CallOption) (*GetHabitLogsResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetHabitLogsResponse)
-	err := c.cc.Invoke(ctx, HabitsService_GetHabitLogs_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *habitsServiceClient) UpdateHabitLog(ctx context.Context, in *UpdateHabitLogRequest, opts ...grpc.CallOption) (*SuccessResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(SuccessResponse)
-	err := c.cc.Invoke(ctx, HabitsService_UpdateHabitLog_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *habitsServiceClient) DeleteHabitLog(ctx context.Context, in *DeleteHabitLogRequest, opts ...grpc.CallOption) (*SuccessResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(SuccessResponse)
-	err := c.cc.Invoke(ctx, HabitsService_DeleteHabitLog_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *habitsServiceClient) GetDashboard(ctx context.Context, in *GetDashboardRequest, opts ...grpc.CallOption) (*DashboardResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DashboardResponse)
-	err := c.cc.Invoke(ctx, HabitsService_GetDashboard_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *habitsServiceClient) GetWeeklyAnalytics(ctx context.Context, in *GetWeeklyAnalyticsRequest, opts ...grpc.CallOption) (*WeeklyAnalyticsResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(WeeklyAnalyticsResponse)
-	err := c.cc.Invoke(ctx, HabitsService_GetWeeklyAnalytics_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-// HabitsServiceServer is the server API for HabitsService service.
-// All implementations must embed UnimplementedHabitsServiceServer
-// for forward compatibility.
-//
-// HabitsService provides habit tracking functionality.
-type HabitsServiceServer interface {
-	// ListHabits returns all habits for the authenticated user.
-	ListHabits(context.Context, *ListHabitsRequest) (*ListHabitsResponse, error)
-	// CreateHabit creates a new habit.
-	CreateHabit(context.Context, *CreateHabitRequest) (*HabitResponse, error)
-	// GetHabit retrieves a habit by ID.
-	GetHabit(context.Context, *GetHabitRequest) (*HabitResponse, error)
-	// UpdateHabit updates a habit.
-	UpdateHabit(context.Context, *UpdateHabitRequest) (*HabitResponse, error)
-	// DeleteHabit deletes a habit.
-	DeleteHabit(context.Context, *DeleteHabitRequest) (*SuccessResponse, error)
-	// ActivateHabit activates a habit.
-	ActivateHabit(context.Context, *ActivateHabitRequest) (*SuccessResponse, error)
-	// DeactivateHabit deactivates a habit.
-	DeactivateHabit(context.Context, *DeactivateHabitRequest) (*SuccessResponse, error)
-	// GetHabitStats retrieves habit statistics.
-	GetHabitStats(context.Context, *GetHabitStatsRequest) (*HabitStatsResponse, error)
-	// LogHabit logs a habit completion.
-	LogHabit(context.Context, *LogHabitRequest) (*LogHabitResponse, error)
-	// GetHabitLogs retrieves logs for a habit.
-	GetHabitLogs(context.Context, *GetHabitLogsRequest) (*GetHabitLogsResponse, error)
-	// UpdateHabitLog updates a habit log.
-	UpdateHabitLog(context.Context, *UpdateHabitLogRequest) (*SuccessResponse, error)
-	// DeleteHabitLog deletes a habit log.
-	DeleteHabitLog(context.Context, *DeleteHabitLogRequest) (*SuccessResponse, error)
-	// GetDashboard retrieves the user's dashboard data.
-	GetDashboard(context.Context, *GetDashboardRequest) (*DashboardResponse, error)
-	// GetWeeklyAnalytics retrieves weekly analytics data.
-	GetWeeklyAnalytics(context.Context, *GetWeeklyAnalyticsRequest) (*WeeklyAnalyticsResponse, error)
-	mustEmbedUnimplementedHabitsServiceServer()
-}
-
-// UnimplementedHabitsServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedHabitsServiceServer struct{}
-
-func (UnimplementedHabitsServiceServer) ListHabits(context.Context, *ListHabitsRequest) (*ListHabitsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListHabits not implemented")
-}
-func (UnimplementedHabitsServiceServer) CreateHabit(context.Context, *CreateHabitRequest) (*HabitResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateHabit not implemented")
-}
-func (UnimplementedHabitsServiceServer) GetHabit(context.Context, *GetHabitRequest) (*HabitResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetHabit not implemented")
-}
-func (UnimplementedHabitsServiceServer) UpdateHabit(context.Context, *UpdateHabitRequest) (*HabitResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdateHabit not implemented")
-}
-func (UnimplementedHabitsServiceServer) DeleteHabit(context.Context, *DeleteHabitRequest) (*SuccessResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteHabit not implemented")
-}
-func (UnimplementedHabitsServiceServer) ActivateHabit(context.Context, *ActivateHabitRequest) (*SuccessResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ActivateHabit not implemented")
-}
-func (UnimplementedHabitsServiceServer) DeactivateHabit(context.Context, *DeactivateHabitRequest) (*SuccessResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeactivateHabit not implemented")
-}
-func (UnimplementedHabitsServiceServer) GetHabitStats(context.Context, *GetHabitStatsRequest) (*HabitStatsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetHabitStats not implemented")
-}
-func (UnimplementedHabitsServiceServer) LogHabit(context.Context, *LogHabitRequest) (*LogHabitResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method LogHabit not implemented")
-}
-func (UnimplementedHabitsServiceServer) GetHabitLogs(context.Context, *GetHabitLogsRequest) (*GetHabitLogsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetHabitLogs not implemented")
-}
-func (UnimplementedHabitsServiceServer) UpdateHabitLog(context.Context, *UpdateHabitLogRequest) (*SuccessResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdateHabitLog not implemented")
-}
-func (UnimplementedHabitsServiceServer) DeleteHabitLog(context.Context, *DeleteHabitLogRequest) (*SuccessResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteHabitLog not implemented")
-}
-func (UnimplementedHabitsServiceServer) GetDashboard(context.Context, *GetDashboardRequest) (*DashboardResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetDashboard not implemented")
-}
-func (UnimplementedHabitsServiceServer) GetWeeklyAnalytics(context.Context, *GetWeeklyAnalyticsRequest) (*WeeklyAnalyticsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetWeeklyAnalytics not implemented")
-}
-func (UnimplementedHabitsServiceServer) mustEmbedUnimplementedHabitsServiceServer() {}
-func (UnimplementedHabitsServiceServer) testEmbeddedByValue()                       {}
-
-// UnsafeHabitsServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to HabitsServiceServer will
-// result in compilation errors.
-type UnsafeHabitsServiceServer interface {
-	mustEmbedUnimplementedHabitsServiceServer()
-}
-
-func RegisterHabitsServiceServer(s grpc.ServiceRegistrar, srv HabitsServiceServer) {
-	// If the following call panics, it indicates UnimplementedHabitsServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
-	}
-	s.RegisterService(&HabitsService_ServiceDesc, srv)
-}
-
-func _HabitsService_ListHabits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListHabitsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(HabitsServiceServer).ListHabits(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: HabitsService_ListHabits_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HabitsServiceServer).ListHabits(ctx, req.(*ListHabitsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _HabitsService_CreateHabit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateHabitRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(HabitsServiceServer).CreateHabit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: HabitsService_CreateHabit_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HabitsServiceServer).CreateHabit(ctx, req.(*CreateHabitRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _HabitsService_GetHabit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetHabitRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(HabitsServiceServer).GetHabit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: HabitsService_GetHabit_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HabitsServiceServer).GetHabit(ctx, req.(*GetHabitRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _HabitsService_UpdateHabit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateHabitRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(HabitsServiceServer).UpdateHabit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: HabitsService_UpdateHabit_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HabitsServiceServer).UpdateHabit(ctx, req.(*UpdateHabitRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _HabitsService_DeleteHabit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteHabitRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(HabitsServiceServer).DeleteHabit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: HabitsService_DeleteHabit_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HabitsServiceServer).DeleteHabit(ctx, req.(*DeleteHabitRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _HabitsService_ActivateHabit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ActivateHabitRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(HabitsServiceServer).ActivateHabit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: HabitsService_ActivateHabit_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HabitsServiceServer).ActivateHabit(ctx, req.(*ActivateHabitRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _HabitsService_DeactivateHabit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeactivateHabitRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(HabitsServiceServer).DeactivateHabit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: HabitsService_DeactivateHabit_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HabitsServiceServer).DeactivateHabit(ctx, req.(*DeactivateHabitRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _HabitsService_GetHabitStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetHabitStatsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(HabitsServiceServer).GetHabitStats(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: HabitsService_GetHabitStats_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HabitsServiceServer).GetHabitStats(ctx, req.(*GetHabitStatsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _HabitsService_LogHabit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LogHabitRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(HabitsServiceServer).LogHabit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: HabitsService_LogHabit_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HabitsServiceServer).LogHabit(ctx, req.(*LogHabitRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _HabitsService_GetHabitLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetHabitLogsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(HabitsServiceServer).GetHabitLogs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: HabitsService_GetHabitLogs_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HabitsServiceServer).GetHabitLogs(ctx, req.(*GetHabitLogsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _HabitsService_UpdateHabitLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateHabitLogRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(HabitsServiceServer).UpdateHabitLog(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: HabitsService_UpdateHabitLog_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HabitsServiceServer).UpdateHabitLog(ctx, req.(*UpdateHabitLogRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _HabitsService_DeleteHabitLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteHabitLogRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(HabitsServiceServer).DeleteHabitLog(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: HabitsService_DeleteHabitLog_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HabitsServiceServer).DeleteHabitLog(ctx, req.(*DeleteHabitLogRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _HabitsService_GetDashboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDashboardRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(HabitsServiceServer).GetDashboard(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: HabitsService_GetDashboard_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HabitsServiceServer).GetDashboard(ctx, req.(*GetDashboardRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _HabitsService_GetWeeklyAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetWeeklyAnalyticsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(HabitsServiceServer).GetWeeklyAnalytics(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: HabitsService_GetWeeklyAnalytics_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HabitsServiceServer).GetWeeklyAnalytics(ctx, req.(*GetWeeklyAnalyticsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-// HabitsService_ServiceDesc is the grpc.ServiceDesc for HabitsService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var HabitsService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "ethos.habits.v1.HabitsService",
-	HandlerType: (*HabitsServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ListHabits",
-			Handler:    _HabitsService_ListHabits_Handler,
-		},
-		{
-			MethodName: "CreateHabit",
-			Handler:    _HabitsService_CreateHabit_Handler,
-		},
-		{
-			MethodName: "GetHabit",
-			Handler:    _HabitsService_GetHabit_Handler,
-		},
-		{
-			MethodName: "UpdateHabit",
-			Handler:    _HabitsService_UpdateHabit_Handler,
-		},
-		{
-			MethodName: "DeleteHabit",
-			Handler:    _HabitsService_DeleteHabit_Handler,
-		},
-		{
-			MethodName: "ActivateHabit",
-			Handler:    _HabitsService_ActivateHabit_Handler,
-		},
-		{
-			MethodName: "DeactivateHabit",
-			Handler:    _HabitsService_DeactivateHabit_Handler,
-		},
-		{
-			MethodName: "GetHabitStats",
-			Handler:    _HabitsService_GetHabitStats_Handler,
-		},
-		{
-			MethodName: "LogHabit",
-			Handler:    _HabitsService_LogHabit_Handler,
-		},
-		{
-			MethodName: "GetHabitLogs",
-			Handler:    _HabitsService_GetHabitLogs_Handler,
-		},
-		{
-			MethodName: "UpdateHabitLog",
-			Handler:    _HabitsService_UpdateHabitLog_Handler,
-		},
-		{
-			MethodName: "DeleteHabitLog",
-			Handler:    _HabitsService_DeleteHabitLog_Handler,
-		},
-		{
-			MethodName: "GetDashboard",
-			Handler:    _HabitsService_GetDashboard_Handler,
-		},
-		{
-			MethodName: "GetWeeklyAnalytics",
-			Handler:    _HabitsService_GetWeeklyAnalytics_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "ethos/habits/v1/habits_service.proto",
-}
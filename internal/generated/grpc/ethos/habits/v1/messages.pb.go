@@ -1,2261 +0,0 @@
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.36.11
-// 	protoc        (unknown)
-// source: ethos/habits/v1/messages.proto
-
-package habitsv1
-
-import (
-	v1 "github.com/semmidev/ethos-go/internal/generated/grpc/ethos/common/v1"
-	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
-	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
-	reflect "reflect"
-	sync "sync"
-	unsafe "unsafe"
-)
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-// Frequency represents habit recurrence patterns.
-type Frequency int32
-
-const (
-	// Unspecified frequency.
-	Frequency_FREQUENCY_UNSPECIFIED Frequency = 0
-	// Daily habit.
-	Frequency_FREQUENCY_DAILY Frequency = 1
-	// Weekly habit.
-	Frequency_FREQUENCY_WEEKLY Frequency = 2
-	// Monthly habit.
-	Frequency_FREQUENCY_MONTHLY Frequency = 3
-)
-
-// Enum value maps for Frequency.
-var (
-	Frequency_name = map[int32]string{
-		0: "FREQUENCY_UNSPECIFIED",
-		1: "FREQUENCY_DAILY",
-		2: "FREQUENCY_WEEKLY",
-		3: "FREQUENCY_MONTHLY",
-	}
-	Frequency_value = map[string]int32{
-		"FREQUENCY_UNSPECIFIED": 0,
-		"FREQUENCY_DAILY":       1,
-		"FREQUENCY_WEEKLY":      2,
-		"FREQUENCY_MONTHLY":     3,
-	}
-)
-
-func (x Frequency) Enum() *Frequency {
-	p := new(Frequency)
-	*p = x
-	return p
-}
-
-func (x Frequency) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
-}
-
-func (Frequency) Descriptor() protoreflect.EnumDescriptor {
-	return file_ethos_habits_v1_messages_proto_enumTypes[0].Descriptor()
-}
-
-func (Frequency) Type() protoreflect.EnumType {
-	return &file_ethos_habits_v1_messages_proto_enumTypes[0]
-}
-
-func (x Frequency) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
-}
-
-// Deprecated: Use Frequency.Descriptor instead.
-func (Frequency) EnumDescriptor() ([]byte, []int) {
-	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{0}
-}
-
-// Habit represents a user's habit.
-type Habit struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Unique habit identifier.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Habit name.
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Habit description.
-	Description *string `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	// Habit frequency (daily, weekly, monthly).
-	Frequency string `protobuf:"bytes,4,opt,name=frequency,proto3" json:"frequency,omitempty"`
-	// Target count per frequency period.
-	TargetCount int32 `protobuf:"varint,5,opt,name=target_count,json=targetCount,proto3" json:"target_count,omitempty"`
-	// Daily reminder time in HH:MM format.
-	ReminderTime *string `protobuf:"bytes,6,opt,name=reminder_time,json=reminderTime,proto3,oneof" json:"reminder_time,omitempty"`
-	// Whether the habit is active.
-	IsActive bool `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	// Creation time.
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	// Last update time.
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *Habit) Reset() {
-	*x = Habit{}
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[0]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *Habit) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Habit) ProtoMessage() {}
-
-func (x *Habit) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[0]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Habit.ProtoReflect.Descriptor instead.
-func (*Habit) Descriptor() ([]byte, []int) {
-	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{0}
-}
-
-func (x *Habit) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *Habit) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Habit) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
-	}
-	return ""
-}
-
-func (x *Habit) GetFrequency() string {
-	if x != nil {
-		return x.Frequency
-	}
-	return ""
-}
-
-func (x *Habit) GetTargetCount() int32 {
-	if x != nil {
-		return x.TargetCount
-	}
-	return 0
-}
-
-func (x *Habit) GetReminderTime() string {
-	if x != nil && x.ReminderTime != nil {
-		return *x.ReminderTime
-	}
-	return ""
-}
-
-func (x *Habit) GetIsActive() bool {
-	if x != nil {
-		return x.IsActive
-	}
-	return false
-}
-
-func (x *Habit) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
-}
-
-func (x *Habit) GetUpdatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return nil
-}
-
-// HabitLog represents a habit completion log entry.
-type HabitLog struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Unique log identifier.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Parent habit identifier.
-	HabitId string `protobuf:"bytes,2,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
-	// Date of the log entry.
-	LogDate string `protobuf:"bytes,3,opt,name=log_date,json=logDate,proto3" json:"log_date,omitempty"`
-	// Completion count.
-	Count int32 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
-	// Optional note.
-	Note *string `protobuf:"bytes,5,opt,name=note,proto3,oneof" json:"note,omitempty"`
-	// Creation time.
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *HabitLog) Reset() {
-	*x = HabitLog{}
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[1]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *HabitLog) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*HabitLog) ProtoMessage() {}
-
-func (x *HabitLog) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[1]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use HabitLog.ProtoReflect.Descriptor instead.
-func (*HabitLog) Descriptor() ([]byte, []int) {
-	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{1}
-}
-
-func (x *HabitLog) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *HabitLog) GetHabitId() string {
-	if x != nil {
-		return x.HabitId
-	}
-	return ""
-}
-
-func (x *HabitLog) GetLogDate() string {
-	if x != nil {
-		return x.LogDate
-	}
-	return ""
-}
-
-func (x *HabitLog) GetCount() int32 {
-	if x != nil {
-		return x.Count
-	}
-	return 0
-}
-
-func (x *HabitLog) GetNote() string {
-	if x != nil && x.Note != nil {
-		return *x.Note
-	}
-	return ""
-}
-
-func (x *HabitLog) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
-}
-
-// HabitStats contains habit statistics.
-type HabitStats struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Total number of logs.
-	TotalLogs int32 `protobuf:"varint,1,opt,name=total_logs,json=totalLogs,proto3" json:"total_logs,omitempty"`
-	// Current streak in days.
-	CurrentStreak int32 `protobuf:"varint,2,opt,name=current_streak,json=currentStreak,proto3" json:"current_streak,omitempty"`
-	// Longest streak achieved.
-	LongestStreak int32 `protobuf:"varint,3,opt,name=longest_streak,json=longestStreak,proto3" json:"longest_streak,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *HabitStats) Reset() {
-	*x = HabitStats{}
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[2]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *HabitStats) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*HabitStats) ProtoMessage() {}
-
-func (x *HabitStats) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[2]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use HabitStats.ProtoReflect.Descriptor instead.
-func (*HabitStats) Descriptor() ([]byte, []int) {
-	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{2}
-}
-
-func (x *HabitStats) GetTotalLogs() int32 {
-	if x != nil {
-		return x.TotalLogs
-	}
-	return 0
-}
-
-func (x *HabitStats) GetCurrentStreak() int32 {
-	if x != nil {
-		return x.CurrentStreak
-	}
-	return 0
-}
-
-func (x *HabitStats) GetLongestStreak() int32 {
-	if x != nil {
-		return x.LongestStreak
-	}
-	return 0
-}
-
-// Dashboard contains user dashboard data.
-type Dashboard struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Number of active habits.
-	ActiveHabitsCount int32 `protobuf:"varint,1,opt,name=active_habits_count,json=activeHabitsCount,proto3" json:"active_habits_count,omitempty"`
-	// Total logs created today.
-	TotalLogsToday int32 `protobuf:"varint,2,opt,name=total_logs_today,json=totalLogsToday,proto3" json:"total_logs_today,omitempty"`
-	// Current streak in days.
-	CurrentStreak int32 `protobuf:"varint,3,opt,name=current_streak,json=currentStreak,proto3" json:"current_streak,omitempty"`
-	// Longest streak achieved.
-	LongestStreak int32 `protobuf:"varint,4,opt,name=longest_streak,json=longestStreak,proto3" json:"longest_streak,omitempty"`
-	// Weekly completion percentage (0-100).
-	WeeklyCompletion int32 `protobuf:"varint,5,opt,name=weekly_completion,json=weeklyCompletion,proto3" json:"weekly_completion,omitempty"`
-	// Total habit logs all time.
-	TotalLogs     int32 `protobuf:"varint,6,opt,name=total_logs,json=totalLogs,proto3" json:"total_logs,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *Dashboard) Reset() {
-	*x = Dashboard{}
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[3]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *Dashboard) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Dashboard) ProtoMessage() {}
-
-func (x *Dashboard) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[3]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Dashboard.ProtoReflect.Descriptor instead.
-func (*Dashboard) Descriptor() ([]byte, []int) {
-	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{3}
-}
-
-func (x *Dashboard) GetActiveHabitsCount() int32 {
-	if x != nil {
-		return x.ActiveHabitsCount
-	}
-	return 0
-}
-
-func (x *Dashboard) GetTotalLogsToday() int32 {
-	if x != nil {
-		return x.TotalLogsToday
-	}
-	return 0
-}
-
-func (x *Dashboard) GetCurrentStreak() int32 {
-	if x != nil {
-		return x.CurrentStreak
-	}
-	return 0
-}
-
-func (x *Dashboard) GetLongestStreak() int32 {
-	if x != nil {
-		return x.LongestStreak
-	}
-	return 0
-}
-
-func (x *Dashboard) GetWeeklyCompletion() int32 {
-	if x != nil {
-		return x.WeeklyCompletion
-	}
-	return 0
-}
-
-func (x *Dashboard) GetTotalLogs() int32 {
-	if x != nil {
-		return x.TotalLogs
-	}
-	return 0
-}
-
-// DailyAnalytics contains analytics for a single day.
-type DailyAnalytics struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Day of week name (Mon, Tue, etc.).
-	DayName string `protobuf:"bytes,1,opt,name=day_name,json=dayName,proto3" json:"day_name,omitempty"`
-	// Date in YYYY-MM-DD format.
-	Date string `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
-	// Number of habit logs on this day.
-	LogsCount int32 `protobuf:"varint,3,opt,name=logs_count,json=logsCount,proto3" json:"logs_count,omitempty"`
-	// Completion percentage for this day (0-100).
-	CompletionPercentage int32 `protobuf:"varint,4,opt,name=completion_percentage,json=completionPercentage,proto3" json:"completion_percentage,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
-}
-
-func (x *DailyAnalytics) Reset() {
-	*x = DailyAnalytics{}
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[4]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *DailyAnalytics) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*DailyAnalytics) ProtoMessage() {}
-
-func (x *DailyAnalytics) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[4]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use DailyAnalytics.ProtoReflect.Descriptor instead.
-func (*DailyAnalytics) Descriptor() ([]byte, []int) {
-	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{4}
-}
-
-func (x *DailyAnalytics) GetDayName() string {
-	if x != nil {
-		return x.DayName
-	}
-	return ""
-}
-
-func (x *DailyAnalytics) GetDate() string {
-	if x != nil {
-		return x.Date
-	}
-	return ""
-}
-
-func (x *DailyAnalytics) GetLogsCount() int32 {
-	if x != nil {
-		return x.LogsCount
-	}
-	return 0
-}
-
-func (x *DailyAnalytics) GetCompletionPercentage() int32 {
-	if x != nil {
-		return x.CompletionPercentage
-	}
-	return 0
-}
-
-// WeeklyAnalytics contains weekly analytics data.
-type WeeklyAnalytics struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Daily analytics for each day of the week.
-	Days []*DailyAnalytics `protobuf:"bytes,1,rep,name=days,proto3" json:"days,omitempty"`
-	// Average completion percentage for the week.
-	AverageCompletion int32 `protobuf:"varint,2,opt,name=average_completion,json=averageCompletion,proto3" json:"average_completion,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
-}
-
-func (x *WeeklyAnalytics) Reset() {
-	*x = WeeklyAnalytics{}
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[5]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *WeeklyAnalytics) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*WeeklyAnalytics) ProtoMessage() {}
-
-func (x *WeeklyAnalytics) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[5]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use WeeklyAnalytics.ProtoReflect.Descriptor instead.
-func (*WeeklyAnalytics) Descriptor() ([]byte, []int) {
-	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *WeeklyAnalytics) GetDays() []*DailyAnalytics {
-	if x != nil {
-		return x.Days
-	}
-	return nil
-}
-
-func (x *WeeklyAnalytics) GetAverageCompletion() int32 {
-	if x != nil {
-		return x.AverageCompletion
-	}
-	return 0
-}
-
-// ListHabitsRequest contains filters for listing habits.
-type ListHabitsRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Page number (1-indexed).
-	Page int32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	// Number of items per page.
-	PerPage int32 `protobuf:"varint,2,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
-	// Filter for active habits only.
-	Active *bool `protobuf:"varint,3,opt,name=active,proto3,oneof" json:"active,omitempty"`
-	// Filter for inactive habits only.
-	Inactive *bool `protobuf:"varint,4,opt,name=inactive,proto3,oneof" json:"inactive,omitempty"`
-	// Search keyword.
-	Keyword *string `protobuf:"bytes,5,opt,name=keyword,proto3,oneof" json:"keyword,omitempty"`
-	// Filter by start date.
-	StartDate *string `protobuf:"bytes,6,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"`
-	// Filter by end date.
-	EndDate *string `protobuf:"bytes,7,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`
-	// Sort field.
-	SortBy *string `protobuf:"bytes,8,opt,name=sort_by,json=sortBy,proto3,oneof" json:"sort_by,omitempty"`
-	// Sort direction (asc/desc).
-	SortDirection *string `protobuf:"bytes,9,opt,name=sort_direction,json=sortDirection,proto3,oneof" json:"sort_direction,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListHabitsRequest) Reset() {
-	*x = ListHabitsRequest{}
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[6]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListHabitsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListHabitsRequest) ProtoMessage() {}
-
-func (x *ListHabitsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[6]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListHabitsRequest.ProtoReflect.Descriptor instead.
-func (*ListHabitsRequest) Descriptor() ([]byte, []int) {
-	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{6}
-}
-
-func (x *ListHabitsRequest) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *ListHabitsRequest) GetPerPage() int32 {
-	if x != nil {
-		return x.PerPage
-	}
-	return 0
-}
-
-func (x *ListHabitsRequest) GetActive() bool {
-	if x != nil && x.Active != nil {
-		return *x.Active
-	}
-	return false
-}
-
-func (x *ListHabitsRequest) GetInactive() bool {
-	if x != nil && x.Inactive != nil {
-		return *x.Inactive
-	}
-	return false
-}
-
-func (x *ListHabitsRequest) GetKeyword() string {
-	if x != nil && x.Keyword != nil {
-		return *x.Keyword
-	}
-	return ""
-}
-
-func (x *ListHabitsRequest) GetStartDate() string {
-	if x != nil && x.StartDate != nil {
-		return *x.StartDate
-	}
-	return ""
-}
-
-func (x *ListHabitsRequest) GetEndDate() string {
-	if x != nil && x.EndDate != nil {
-		return *x.EndDate
-	}
-	return ""
-}
-
-func (x *ListHabitsRequest) GetSortBy() string {
-	if x != nil && x.SortBy != nil {
-		return *x.SortBy
-	}
-	return ""
-}
-
-func (x *ListHabitsRequest) GetSortDirection() string {
-	if x != nil && x.SortDirection != nil {
-		return *x.SortDirection
-	}
-	return ""
-}
-
-// ListHabitsResponse contains paginated habits.
-type ListHabitsResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Whether the request was successful.
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	// Human-readable message.
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	// List of habits.
-	Data []*Habit `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
-	// Pagination metadata.
-	Meta          *v1.Meta `protobuf:"bytes,4,opt,name=meta,proto3" json:"meta,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListHabitsResponse) Reset() {
-	*x = ListHabitsResponse{}
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[7]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListHabitsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListHabitsResponse) ProtoMessage() {}
-
-func (x *ListHabitsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[7]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListHabitsResponse.ProtoReflect.Descriptor instead.
-func (*ListHabitsResponse) Descriptor() ([]byte, []int) {
-	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{7}
-}
-
-func (x *ListHabitsResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-func (x *ListHabitsResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-func (x *ListHabitsResponse) GetData() []*Habit {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-func (x *ListHabitsResponse) GetMeta() *v1.Meta {
-	if x != nil {
-		return x.Meta
-	}
-	return nil
-}
-
-// CreateHabitRequest contains data for creating a habit.
-type CreateHabitRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Habit name (required).
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Habit description.
-	Description *string `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	// Habit frequency (default: daily).
-	Frequency *string `protobuf:"bytes,3,opt,name=frequency,proto3,oneof" json:"frequency,omitempty"`
-	// Target count (default: 1).
-	TargetCount *int32 `protobuf:"varint,4,opt,name=target_count,json=targetCount,proto3,oneof" json:"target_count,omitempty"`
-	// Reminder time in HH:MM format.
-	ReminderTime  *string `protobuf:"bytes,5,opt,name=reminder_time,json=reminderTime,proto3,oneof" json:"reminder_time,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CreateHabitRequest) Reset() {
-	*x = CreateHabitRequest{}
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[8]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CreateHabitRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CreateHabitRequest) ProtoMessage() {}
-
-func (x *CreateHabitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[8]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CreateHabitRequest.ProtoReflect.Descriptor instead.
-func (*CreateHabitRequest) Descriptor() ([]byte, []int) {
-	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *CreateHabitRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *CreateHabitRequest) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
-	}
-	return ""
-}
-
-func (x *CreateHabitRequest) GetFrequency() string {
-	if x != nil && x.Frequency != nil {
-		return *x.Frequency
-	}
-	return ""
-}
-
-func (x *CreateHabitRequest) GetTargetCount() int32 {
-	if x != nil && x.TargetCount != nil {
-		return *x.TargetCount
-	}
-	return 0
-}
-
-func (x *CreateHabitRequest) GetReminderTime() string {
-	if x != nil && x.ReminderTime != nil {
-		return *x.ReminderTime
-	}
-	return ""
-}
-
-// HabitResponse contains a single habit.
-type HabitResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Whether the request was successful.
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	// Human-readable message.
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	// The habit data.
-	Data          *Habit `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *HabitResponse) Reset() {
-	*x = HabitResponse{}
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[9]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *HabitResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*HabitResponse) ProtoMessage() {}
-
-func (x *HabitResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[9]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use HabitResponse.ProtoReflect.Descriptor instead.
-func (*HabitResponse) Descriptor() ([]byte, []int) {
-	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *HabitResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-func (x *HabitResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-func (x *HabitResponse) GetData() *Habit {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-// GetHabitRequest identifies a habit to retrieve.
-type GetHabitRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Habit identifier.
-	HabitId       string `protobuf:"bytes,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetHabitRequest) Reset() {
-	*x = GetHabitRequest{}
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[10]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetHabitRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetHabitRequest) ProtoMessage() {}
-
-func (x *GetHabitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[10]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetHabitRequest.ProtoReflect.Descriptor instead.
-func (*GetHabitRequest) Descriptor() ([]byte, []int) {
-	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *GetHabitRequest) GetHabitId() string {
-	if x != nil {
-		return x.HabitId
-	}
-	return ""
-}
-
-// UpdateHabitRequest contains data for updating a habit.
-type UpdateHabitRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Habit identifier.
-	HabitId string `protobuf:"bytes,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
-	// New name.
-	Name *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	// New description.
-	Description *string `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	// New frequency.
-	Frequency *string `protobuf:"bytes,4,opt,name=frequency,proto3,oneof" json:"frequency,omitempty"`
-	// New target count.
-	TargetCount *int32 `protobuf:"varint,5,opt,name=target_count,json=targetCount,proto3,oneof" json:"target_count,omitempty"`
-	// New reminder time.
-	ReminderTime  *string `protobuf:"bytes,6,opt,name=reminder_time,json=reminderTime,proto3,oneof" json:"reminder_time,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *UpdateHabitRequest) Reset() {
-	*x = UpdateHabitRequest{}
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[11]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *UpdateHabitRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*UpdateHabitRequest) ProtoMessage() {}
-
-func (x *UpdateHabitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[11]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use UpdateHabitRequest.ProtoReflect.Descriptor instead.
-func (*UpdateHabitRequest) Descriptor() ([]byte, []int) {
-	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{11}
-}
-
-func (x *UpdateHabitRequest) GetHabitId() string {
-	if x != nil {
-		return x.HabitId
-	}
-	return ""
-}
-
-func (x *UpdateHabitRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
-	}
-	return ""
-}
-
-func (x *UpdateHabitRequest) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
-	}
-	return ""
-}
-
-func (x *UpdateHabitRequest) GetFrequency() string {
-	if x != nil && x.Frequency != nil {
-		return *x.Frequency
-	}
-	return ""
-}
-
-func (x *UpdateHabitRequest) GetTargetCount() int32 {
-	if x != nil && x.TargetCount != nil {
-		return *x.TargetCount
-	}
-	return 0
-}
-
-func (x *UpdateHabitRequest) GetReminderTime() string {
-	if x != nil && x.ReminderTime != nil {
-		return *x.ReminderTime
-	}
-	return ""
-}
-
-// DeleteHabitRequest identifies a habit to delete.
-type DeleteHabitRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Habit identifier.
-	HabitId       string `protobuf:"bytes,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *DeleteHabitRequest) Reset() {
-	*x = DeleteHabitRequest{}
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[12]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *DeleteHabitRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*DeleteHabitRequest) ProtoMessage() {}
-
-func (x *DeleteHabitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[12]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use DeleteHabitRequest.ProtoReflect.Descriptor instead.
-func (*DeleteHabitRequest) Descriptor() ([]byte, []int) {
-	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{12}
-}
-
-func (x *DeleteHabitRequest) GetHabitId() string {
-	if x != nil {
-		return x.HabitId
-	}
-	return ""
-}
-
-// ActivateHabitRequest identifies a habit to activate.
-type ActivateHabitRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Habit identifier.
-	HabitId       string `protobuf:"bytes,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ActivateHabitRequest) Reset() {
-	*x = ActivateHabitRequest{}
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[13]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ActivateHabitRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ActivateHabitRequest) ProtoMessage() {}
-
-func (x *ActivateHabitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[13]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ActivateHabitRequest.ProtoReflect.Descriptor instead.
-func (*ActivateHabitRequest) Descriptor() ([]byte, []int) {
-	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *ActivateHabitRequest) GetHabitId() string {
-	if x != nil {
-		return x.HabitId
-	}
-	return ""
-}
-
-// DeactivateHabitRequest identifies a habit to deactivate.
-type DeactivateHabitRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Habit identifier.
-	HabitId       string `protobuf:"bytes,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *DeactivateHabitRequest) Reset() {
-	*x = DeactivateHabitRequest{}
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[14]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *DeactivateHabitRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*DeactivateHabitRequest) ProtoMessage() {}
-
-func (x *DeactivateHabitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[14]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use DeactivateHabitRequest.ProtoReflect.Descriptor instead.
-func (*DeactivateHabitRequest) Descriptor() ([]byte, []int) {
-	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *DeactivateHabitRequest) GetHabitId() string {
-	if x != nil {
-		return x.HabitId
-	}
-	return ""
-}
-
-// GetHabitStatsRequest identifies a habit for statistics.
-type GetHabitStatsRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Habit identifier.
-	HabitId       string `protobuf:"bytes,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetHabitStatsRequest) Reset() {
-	*x = GetHabitStatsRequest{}
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[15]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetHabitStatsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetHabitStatsRequest) ProtoMessage() {}
-
-func (x *GetHabitStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[15]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetHabitStatsRequest.ProtoReflect.Descriptor instead.
-func (*GetHabitStatsRequest) Descriptor() ([]byte, []int) {
-	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *GetHabitStatsRequest) GetHabitId() string {
-	if x != nil {
-		return x.HabitId
-	}
-	return ""
-}
-
-// HabitStatsResponse contains habit statistics.
-type HabitStatsResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Whether the request was successful.
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	// Human-readable message.
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	// Habit statistics.
-	Data          *HabitStats `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *HabitStatsResponse) Reset() {
-	*x = HabitStatsResponse{}
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[16]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *HabitStatsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*HabitStatsResponse) ProtoMessage() {}
-
-func (x *HabitStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[16]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use HabitStatsResponse.ProtoReflect.Descriptor instead.
-func (*HabitStatsResponse) Descriptor() ([]byte, []int) {
-	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *HabitStatsResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-func (x *HabitStatsResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-func (x *HabitStatsResponse) GetData() *HabitStats {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-// LogHabitRequest contains data for logging habit completion.
-type LogHabitRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Habit identifier.
-	HabitId string `protobuf:"bytes,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
-	// Log date in YYYY-MM-DD format.
-	LogDate string `protobuf:"bytes,2,opt,name=log_date,json=logDate,proto3" json:"log_date,omitempty"`
-	// Completion count.
-	Count int32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
-	// Optional note.
-	Note          *string `protobuf:"bytes,4,opt,name=note,proto3,oneof" json:"note,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *LogHabitRequest) Reset() {
-	*x = LogHabitRequest{}
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[17]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *LogHabitRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*LogHabitRequest) ProtoMessage() {}
-
-func (x *LogHabitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[17]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use LogHabitRequest.ProtoReflect.Descriptor instead.
-func (*LogHabitRequest) Descriptor() ([]byte, []int) {
-	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{17}
-}
-
-func (x *LogHabitRequest) GetHabitId() string {
-	if x != nil {
-		return x.HabitId
-	}
-	return ""
-}
-
-func (x *LogHabitRequest) GetLogDate() string {
-	if x != nil {
-		return x.LogDate
-	}
-	return ""
-}
-
-func (x *LogHabitRequest) GetCount() int32 {
-	if x != nil {
-		return x.Count
-	}
-	return 0
-}
-
-func (x *LogHabitRequest) GetNote() string {
-	if x != nil && x.Note != nil {
-		return *x.Note
-	}
-	return ""
-}
-
-// LogHabitResponse contains the created log ID.
-type LogHabitResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Whether the operation was successful.
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	// Human-readable message.
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	// Created log data.
-	Data          *LogHabitData `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *LogHabitResponse) Reset() {
-	*x = LogHabitResponse{}
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[18]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *LogHabitResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*LogHabitResponse) ProtoMessage() {}
-
-func (x *LogHabitResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[18]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use LogHabitResponse.ProtoReflect.Descriptor instead.
-func (*LogHabitResponse) Descriptor() ([]byte, []int) {
-	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *LogHabitResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-func (x *LogHabitResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-func (x *LogHabitResponse) GetData() *LogHabitData {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-// LogHabitData contains the created log ID.
-type LogHabitData struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The created log ID.
-	LogId         string `protobuf:"bytes,1,opt,name=log_id,json=logId,proto3" json:"log_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *LogHabitData) Reset() {
-	*x = LogHabitData{}
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[19]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *LogHabitData) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*LogHabitData) ProtoMessage() {}
-
-func (x *LogHabitData) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[19]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use LogHabitData.ProtoReflect.Descriptor instead.
-func (*LogHabitData) Descriptor() ([]byte, []int) {
-	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{19}
-}
-
-func (x *LogHabitData) GetLogId() string {
-	if x != nil {
-		return x.LogId
-	}
-	return ""
-}
-
-// GetHabitLogsRequest contains filters for habit logs.
-type GetHabitLogsRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Habit identifier.
-	HabitId string `protobuf:"bytes,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
-	// Page number.
-	Page int32 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	// Items per page.
-	PerPage int32 `protobuf:"varint,3,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
-	// Filter by start date.
-	StartDate *string `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"`
-	// Filter by end date.
-	EndDate *string `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`
-	// Search keyword.
-	Keyword *string `protobuf:"bytes,6,opt,name=keyword,proto3,oneof" json:"keyword,omitempty"`
-	// Sort field.
-	SortBy *string `protobuf:"bytes,7,opt,name=sort_by,json=sortBy,proto3,oneof" json:"sort_by,omitempty"`
-	// Sort direction (asc/desc).
-	SortDirection *string `protobuf:"bytes,8,opt,name=sort_direction,json=sortDirection,proto3,oneof" json:"sort_direction,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetHabitLogsRequest) Reset() {
-	*x = GetHabitLogsRequest{}
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[20]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetHabitLogsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetHabitLogsRequest) ProtoMessage() {}
-
-func (x *GetHabitLogsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[20]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetHabitLogsRequest.ProtoReflect.Descriptor instead.
-func (*GetHabitLogsRequest) Descriptor() ([]byte, []int) {
-	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{20}
-}
-
-func (x *GetHabitLogsRequest) GetHabitId() string {
-	if x != nil {
-		return x.HabitId
-	}
-	return ""
-}
-
-func (x *GetHabitLogsRequest) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *GetHabitLogsRequest) GetPerPage() int32 {
-	if x != nil {
-		return x.PerPage
-	}
-	return 0
-}
-
-func (x *GetHabitLogsRequest) GetStartDate() string {
-	if x != nil && x.StartDate != nil {
-		return *x.StartDate
-	}
-	return ""
-}
-
-func (x *GetHabitLogsRequest) GetEndDate() string {
-	if x != nil && x.EndDate != nil {
-		return *x.EndDate
-	}
-	return ""
-}
-
-func (x *GetHabitLogsRequest) GetKeyword() string {
-	if x != nil && x.Keyword != nil {
-		return *x.Keyword
-	}
-	return ""
-}
-
-func (x *GetHabitLogsRequest) GetSortBy() string {
-	if x != nil && x.SortBy != nil {
-		return *x.SortBy
-	}
-	return ""
-}
-
-func (x *GetHabitLogsRequest) GetSortDirection() string {
-	if x != nil && x.SortDirection != nil {
-		return *x.SortDirection
-	}
-	return ""
-}
-
-// GetHabitLogsResponse contains paginated habit logs.
-type GetHabitLogsResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Whether the request was successful.
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	// Human-readable message.
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	// List of habit logs.
-	Data []*HabitLog `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
-	// Pagination metadata.
-	Meta          *v1.Meta `protobuf:"bytes,4,opt,name=meta,proto3" json:"meta,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetHabitLogsResponse) Reset() {
-	*x = GetHabitLogsResponse{}
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[21]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetHabitLogsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetHabitLogsResponse) ProtoMessage() {}
-
-func (x *GetHabitLogsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[21]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetHabitLogsResponse.ProtoReflect.Descriptor instead.
-func (*GetHabitLogsResponse) Descriptor() ([]byte, []int) {
-	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{21}
-}
-
-func (x *GetHabitLogsResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-func (x *GetHabitLogsResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-func (x *GetHabitLogsResponse) GetData() []*HabitLog {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-func (x *GetHabitLogsResponse) GetMeta() *v1.Meta {
-	if x != nil {
-		return x.Meta
-	}
-	return nil
-}
-
-// UpdateHabitLogRequest contains data for updating a habit log.
-type UpdateHabitLogRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Log identifier.
-	LogId string `protobuf:"bytes,1,opt,name=log_id,json=logId,proto3" json:"log_id,omitempty"`
-	// New count.
-	Count *int32 `protobuf:"varint,2,opt,name=count,proto3,oneof" json:"count,omitempty"`
-	// New note.
-	Note *string `protobuf:"bytes,3,opt,name=note,proto3,oneof" json:"note,omitempty"`
-	// New log date.
-	LogDate       *string `protobuf:"bytes,4,opt,name=log_date,json=logDate,proto3,oneof" json:"log_date,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *UpdateHabitLogRequest) Reset() {
-	*x = UpdateHabitLogRequest{}
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[22]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *UpdateHabitLogRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*UpdateHabitLogRequest) ProtoMessage() {}
-
-func (x *UpdateHabitLogRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[22]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use UpdateHabitLogRequest.ProtoReflect.Descriptor instead.
-func (*UpdateHabitLogRequest) Descriptor() ([]byte, []int) {
-	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{22}
-}
-
-func (x *UpdateHabitLogRequest) GetLogId() string {
-	if x != nil {
-		return x.LogId
-	}
-	return ""
-}
-
-func (x *UpdateHabitLogRequest) GetCount() int32 {
-	if x != nil && x.Count != nil {
-		return *x.Count
-	}
-	return 0
-}
-
-func (x *UpdateHabitLogRequest) GetNote() string {
-	if x != nil && x.Note != nil {
-		return *x.Note
-	}
-	return ""
-}
-
-func (x *UpdateHabitLogRequest) GetLogDate() string {
-	if x != nil && x.LogDate != nil {
-		return *x.LogDate
-	}
-	return ""
-}
-
-// DeleteHabitLogRequest identifies a log to delete.
-type DeleteHabitLogRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Log identifier.
-	LogId         string `protobuf:"bytes,1,opt,name=log_id,json=logId,proto3" json:"log_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *DeleteHabitLogRequest) Reset() {
-	*x = DeleteHabitLogRequest{}
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[23]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *DeleteHabitLogRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*DeleteHabitLogRequest) ProtoMessage() {}
-
-func (x *DeleteHabitLogRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[23]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use DeleteHabitLogRequest.ProtoReflect.Descriptor instead.
-func (*DeleteHabitLogRequest) Descriptor() ([]byte, []int) {
-	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{23}
-}
-
-func (x *DeleteHabitLogRequest) GetLogId() string {
-	if x != nil {
-		return x.LogId
-	}
-	return ""
-}
-
-// GetDashboardRequest is empty - uses auth context.
-type GetDashboardRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetDashboardRequest) Reset() {
-	*x = GetDashboardRequest{}
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[24]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetDashboardRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetDashboardRequest) ProtoMessage() {}
-
-func (x *GetDashboardRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[24]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetDashboardRequest.ProtoReflect.Descriptor instead.
-func (*GetDashboardRequest) Descriptor() ([]byte, []int) {
-	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{24}
-}
-
-// DashboardResponse contains dashboard data.
-type DashboardResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Whether the request was successful.
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	// Human-readable message.
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	// Dashboard data.
-	Data          *Dashboard `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *DashboardResponse) Reset() {
-	*x = DashboardResponse{}
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[25]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *DashboardResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*DashboardResponse) ProtoMessage() {}
-
-func (x *DashboardResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[25]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use DashboardResponse.ProtoReflect.Descriptor instead.
-func (*DashboardResponse) Descriptor() ([]byte, []int) {
-	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{25}
-}
-
-func (x *DashboardResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-func (x *DashboardResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-func (x *DashboardResponse) GetData() *Dashboard {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-// GetWeeklyAnalyticsRequest is empty - uses auth context.
-type GetWeeklyAnalyticsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetWeeklyAnalyticsRequest) Reset() {
-	*x = GetWeeklyAnalyticsRequest{}
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[26]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetWeeklyAnalyticsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetWeeklyAnalyticsRequest) ProtoMessage() {}
-
-func (x *GetWeeklyAnalyticsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[26]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetWeeklyAnalyticsRequest.ProtoReflect.Descriptor instead.
-func (*GetWeeklyAnalyticsRequest) Descriptor() ([]byte, []int) {
-	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{26}
-}
-
-// WeeklyAnalyticsResponse contains weekly analytics.
-type WeeklyAnalyticsResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Whether the request was successful.
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	// Human-readable message.
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	// Weekly analytics data.
-	Data          *WeeklyAnalytics `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *WeeklyAnalyticsResponse) Reset() {
-	*x = WeeklyAnalyticsResponse{}
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[27]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *WeeklyAnalyticsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*WeeklyAnalyticsResponse) ProtoMessage() {}
-
-func (x *WeeklyAnalyticsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_habits_v1_messages_proto_msgTypes[27]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use WeeklyAnalyticsResponse.ProtoReflect.Descriptor instead.
-func (*WeeklyAnalyticsResponse) Descriptor() ([]byte, []int) {
-	return file_ethos_habits_v1_messages_proto_rawDescGZIP(), []int{27}
-}
-
-func (x *WeeklyAnalyticsResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-func (x *WeeklyAnalyticsResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-func (x *WeeklyAnalyticsResponse) GetData() *WeeklyAnalytics {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-var File_ethos_habits_v1_messages_proto protoreflect.FileDescriptor
-
-const file_ethos_habits_v1_messages_proto_rawDesc = "" +
-	"\n" +
-	"\x1eethos/habits/v1/messages.proto\x12\x0fethos.habits.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a ethos/common/v1/pagination.proto\"\xf2\x02\n" +
-	"\x05Habit\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12%\n" +
-	"\vdescription\x18\x03 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x1c\n" +
-	"\tfrequency\x18\x04 \x01(\tR\tfrequency\x12!\n" +
-	"\ftarget_count\x18\x05 \x01(\x05R\vtargetCount\x12(\n" +
-	"\rreminder_time\x18\x06 \x01(\tH\x01R\freminderTime\x88\x01\x01\x12\x1b\n" +
-	"\tis_active\x18\a \x01(\bR\bisActive\x129\n" +
-	"\n" +
-	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x0e\n" +
-	"\f_descriptionB\x10\n" +
-	"\x0e_reminder_time\"\xc3\x01\n" +
-	"\bHabitLog\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
-	"\bhabit_id\x18\x02 \x01(\tR\ahabitId\x12\x19\n" +
-	"\blog_date\x18\x03 \x01(\tR\alogDate\x12\x14\n" +
-	"\x05count\x18\x04 \x01(\x05R\x05count\x12\x17\n" +
-	"\x04note\x18\x05 \x01(\tH\x00R\x04note\x88\x01\x01\x129\n" +
-	"\n" +
-	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\a\n" +
-	"\x05_note\"y\n" +
-	"\n" +
-	"HabitStats\x12\x1d\n" +
-	"\n" +
-	"total_logs\x18\x01 \x01(\x05R\ttotalLogs\x12%\n" +
-	"\x0ecurrent_streak\x18\x02 \x01(\x05R\rcurrentStreak\x12%\n" +
-	"\x0elongest_streak\x18\x03 \x01(\x05R\rlongestStreak\"\xff\x01\n" +
-	"\tDashboard\x12.\n" +
-	"\x13active_habits_count\x18\x01 \x01(\x05R\x11activeHabitsCount\x12(\n" +
-	"\x10total_logs_today\x18\x02 \x01(\x05R\x0etotalLogsToday\x12%\n" +
-	"\x0ecurrent_streak\x18\x03 \x01(\x05R\rcurrentStreak\x12%\n" +
-	"\x0elongest_streak\x18\x04 \x01(\x05R\rlongestStreak\x12+\n" +
-	"\x11weekly_completion\x18\x05 \x01(\x05R\x10weeklyCompletion\x12\x1d\n" +
-	"\n" +
-	"total_logs\x18\x06 \x01(\x05R\ttotalLogs\"\x93\x01\n" +
-	"\x0eDailyAnalytics\x12\x19\n" +
-	"\bday_name\x18\x01 \x01(\tR\adayName\x12\x12\n" +
-	"\x04date\x18\x02 \x01(\tR\x04date\x12\x1d\n" +
-	"\n" +
-	"logs_count\x18\x03 \x01(\x05R\tlogsCount\x123\n" +
-	"\x15completion_percentage\x18\x04 \x01(\x05R\x14completionPercentage\"u\n" +
-	"\x0fWeeklyAnalytics\x123\n" +
-	"\x04days\x18\x01 \x03(\v2\x1f.ethos.habits.v1.DailyAnalyticsR\x04days\x12-\n" +
-	"\x12average_completion\x18\x02 \x01(\x05R\x11averageCompletion\"\x8c\x03\n" +
-	"\x11ListHabitsRequest\x12\x12\n" +
-	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x19\n" +
-	"\bper_page\x18\x02 \x01(\x05R\aperPage\x12\x1b\n" +
-	"\x06active\x18\x03 \x01(\bH\x00R\x06active\x88\x01\x01\x12\x1f\n" +
-	"\binactive\x18\x04 \x01(\bH\x01R\binactive\x88\x01\x01\x12\x1d\n" +
-	"\akeyword\x18\x05 \x01(\tH\x02R\akeyword\x88\x01\x01\x12\"\n" +
-	"\n" +
-	"start_date\x18\x06 \x01(\tH\x03R\tstartDate\x88\x01\x01\x12\x1e\n" +
-	"\bend_date\x18\a \x01(\tH\x04R\aendDate\x88\x01\x01\x12\x1c\n" +
-	"\asort_by\x18\b \x01(\tH\x05R\x06sortBy\x88\x01\x01\x12*\n" +
-	"\x0esort_direction\x18\t \x01(\tH\x06R\rsortDirection\x88\x01\x01B\t\n" +
-	"\a_activeB\v\n" +
-	"\t_inactiveB\n" +
-	"\n" +
-	"\b_keywordB\r\n" +
-	"\v_start_dateB\v\n" +
-	"\t_end_dateB\n" +
-	"\n" +
-	"\b_sort_byB\x11\n" +
-	"\x0f_sort_direction\"\x9f\x01\n" +
-	"\x12ListHabitsResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x12*\n" +
-	"\x04data\x18\x03 \x03(\v2\x16.ethos.habits.v1.HabitR\x04data\x12)\n" +
-	"\x04meta\x18\x04 \x01(\v2\x15.ethos.common.v1.MetaR\x04meta\"\x85\x02\n" +
-	"\x12CreateHabitRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12%\n" +
-	"\vdescription\x18\x02 \x01(\tH\x00R\vdescription\x88\x01\x01\x12!\n" +
-	"\tfrequency\x18\x03 \x01(\tH\x01R\tfrequency\x88\x01\x01\x12&\n" +
-	"\ftarget_count\x18\x04 \x01(\x05H\x02R\vtargetCount\x88\x01\x01\x12(\n" +
-	"\rreminder_time\x18\x05 \x01(\tH\x03R\freminderTime\x88\x01\x01B\x0e\n" +
-	"\f_descriptionB\f\n" +
-	"\n" +
-	"_frequencyB\x0f\n" +
-	"\r_target_countB\x10\n" +
-	"\x0e_reminder_time\"o\n" +
-	"\rHabitResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x12*\n" +
-	"\x04data\x18\x03 \x01(\v2\x16.ethos.habits.v1.HabitR\x04data\",\n" +
-	"\x0fGetHabitRequest\x12\x19\n" +
-	"\bhabit_id\x18\x01 \x01(\tR\ahabitId\"\xae\x02\n" +
-	"\x12UpdateHabitRequest\x12\x19\n" +
-	"\bhabit_id\x18\x01 \x01(\tR\ahabitId\x12\x17\n" +
-	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
-	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12!\n" +
-	"\tfrequency\x18\x04 \x01(\tH\x02R\tfrequency\x88\x01\x01\x12&\n" +
-	"\ftarget_count\x18\x05 \x01(\x05H\x03R\vtargetCount\x88\x01\x01\x12(\n" +
-	"\rreminder_time\x18\x06 \x01(\tH\x04R\freminderTime\x88\x01\x01B\a\n" +
-	"\x05_nameB\x0e\n" +
-	"\f_descriptionB\f\n" +
-	"\n" +
-	"_frequencyB\x0f\n" +
-	"\r_target_countB\x10\n" +
-	"\x0e_reminder_time\"/\n" +
-	"\x12DeleteHabitRequest\x12\x19\n" +
-	"\bhabit_id\x18\x01 \x01(\tR\ahabitId\"1\n" +
-	"\x14ActivateHabitRequest\x12\x19\n" +
-	"\bhabit_id\x18\x01 \x01(\tR\ahabitId\"3\n" +
-	"\x16DeactivateHabitRequest\x12\x19\n" +
-	"\bhabit_id\x18\x01 \x01(\tR\ahabitId\"1\n" +
-	"\x14GetHabitStatsRequest\x12\x19\n" +
-	"\bhabit_id\x18\x01 \x01(\tR\ahabitId\"y\n" +
-	"\x12HabitStatsResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x12/\n" +
-	"\x04data\x18\x03 \x01(\v2\x1b.ethos.habits.v1.HabitStatsR\x04data\"\x7f\n" +
-	"\x0fLogHabitRequest\x12\x19\n" +
-	"\bhabit_id\x18\x01 \x01(\tR\ahabitId\x12\x19\n" +
-	"\blog_date\x18\x02 \x01(\tR\alogDate\x12\x14\n" +
-	"\x05count\x18\x03 \x01(\x05R\x05count\x12\x17\n" +
-	"\x04note\x18\x04 \x01(\tH\x00R\x04note\x88\x01\x01B\a\n" +
-	"\x05_note\"y\n" +
-	"\x10LogHabitResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x121\n" +
-	"\x04data\x18\x03 \x01(\v2\x1d.ethos.habits.v1.LogHabitDataR\x04data\"%\n" +
-	"\fLogHabitData\x12\x15\n" +
-	"\x06log_id\x18\x01 \x01(\tR\x05logId\"\xd3\x02\n" +
-	"\x13GetHabitLogsRequest\x12\x19\n" +
-	"\bhabit_id\x18\x01 \x01(\tR\ahabitId\x12\x12\n" +
-	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x19\n" +
-	"\bper_page\x18\x03 \x01(\x05R\aperPage\x12\"\n" +
-	"\n" +
-	"start_date\x18\x04 \x01(\tH\x00R\tstartDate\x88\x01\x01\x12\x1e\n" +
-	"\bend_date\x18\x05 \x01(\tH\x01R\aendDate\x88\x01\x01\x12\x1d\n" +
-	"\akeyword\x18\x06 \x01(\tH\x02R\akeyword\x88\x01\x01\x12\x1c\n" +
-	"\asort_by\x18\a \x01(\tH\x03R\x06sortBy\x88\x01\x01\x12*\n" +
-	"\x0esort_direction\x18\b \x01(\tH\x04R\rsortDirection\x88\x01\x01B\r\n" +
-	"\v_start_dateB\v\n" +
-	"\t_end_dateB\n" +
-	"\n" +
-	"\b_keywordB\n" +
-	"\n" +
-	"\b_sort_byB\x11\n" +
-	"\x0f_sort_direction\"\xa4\x01\n" +
-	"\x14GetHabitLogsResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x12-\n" +
-	"\x04data\x18\x03 \x03(\v2\x19.ethos.habits.v1.HabitLogR\x04data\x12)\n" +
-	"\x04meta\x18\x04 \x01(\v2\x15.ethos.common.v1.MetaR\x04meta\"\xa2\x01\n" +
-	"\x15UpdateHabitLogRequest\x12\x15\n" +
-	"\x06log_id\x18\x01 \x01(\tR\x05logId\x12\x19\n" +
-	"\x05count\x18\x02 \x01(\x05H\x00R\x05count\x88\x01\x01\x12\x17\n" +
-	"\x04note\x18\x03 \x01(\tH\x01R\x04note\x88\x01\x01\x12\x1e\n" +
-	"\blog_date\x18\x04 \x01(\tH\x02R\alogDate\x88\x01\x01B\b\n" +
-	"\x06_countB\a\n" +
-	"\x05_noteB\v\n" +
-	"\t_log_date\".\n" +
-	"\x15DeleteHabitLogRequest\x12\x15\n" +
-	"\x06log_id\x18\x01 \x01(\tR\x05logId\"\x15\n" +
-	"\x13GetDashboardRequest\"w\n" +
-	"\x11DashboardResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x12.\n" +
-	"\x04data\x18\x03 \x01(\v2\x1a.ethos.habits.v1.DashboardR\x04data\"\x1b\n" +
-	"\x19GetWeeklyAnalyticsRequest\"\x83\x01\n" +
-	"\x17WeeklyAnalyticsResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x124\n" +
-	"\x04data\x18\x03 \x01(\v2 .ethos.habits.v1.WeeklyAnalyticsR\x04data*h\n" +
-	"\tFrequency\x12\x19\n" +
-	"\x15FREQUENCY_UNSPECIFIED\x10\x00\x12\x13\n" +
-	"\x0fFREQUENCY_DAILY\x10\x01\x12\x14\n" +
-	"\x10FREQUENCY_WEEKLY\x10\x02\x12\x15\n" +
-	"\x11FREQUENCY_MONTHLY\x10\x03B\xd1\x01\n" +
-	"\x13com.ethos.habits.v1B\rMessagesProtoP\x01ZMgithub.com/semmidev/ethos-go/internal/generated/grpc/ethos/habits/v1;habitsv1\xa2\x02\x03EHX\xaa\x02\x0fEthos.Habits.V1\xca\x02\x0fEthos\\Habits\\V1\xe2\x02\x1bEthos\\Habits\\V1\\GPBMetadata\xea\x02\x11Ethos::Habits::V1b\x06proto3"
-
-var (
-	file_ethos_habits_v1_messages_proto_rawDescOnce sync.Once
-	file_ethos_habits_v1_messages_proto_rawDescData []byte
-)
-
-func file_ethos_habits_v1_messages_proto_rawDescGZIP() []byte {
-	file_ethos_habits_v1_messages_proto_rawDescOnce.Do(func() {
-		file_ethos_habits_v1_messages_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ethos_habits_v1_messages_proto_rawDesc), len(file_ethos_habits_v1_messages_proto_rawDesc)))
-	})
-	return file_ethos_habits_v1_messages_proto_rawDescData
-}
-
-var file_ethos_habits_v1_messages_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_ethos_habits_v1_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
-var file_ethos_habits_v1_messages_proto_goTypes = []any{
-	(Frequency)(0),                    // 0: ethos.habits.v1.Frequency
-	(*Habit)(nil),                     // 1: ethos.habits.v1.Habit
-	(*HabitLog)(nil),                  // 2: ethos.habits.v1.HabitLog
-	(*HabitStats)(nil),                // 3: ethos.habits.v1.HabitStats
-	(*Dashboard)(nil),                 // 4: ethos.habits.v1.Dashboard
-	(*DailyAnalytics)(nil),            // 5: ethos.habits.v1.DailyAnalytics
-	(*WeeklyAnalytics)(nil),           // 6: ethos.habits.v1.WeeklyAnalytics
-	(*ListHabitsRequest)(nil),         // 7: ethos.habits.v1.ListHabitsRequest
-	(*ListHabitsResponse)(nil),        // 8: ethos.habits.v1.ListHabitsResponse
-	(*CreateHabitRequest)(nil),        // 9: ethos.habits.v1.CreateHabitRequest
-	(*HabitResponse)(nil),             // 10: ethos.habits.v1.HabitResponse
-	(*GetHabitRequest)(nil),           // 11: ethos.habits.v1.GetHabitRequest
-	(*UpdateHabitRequest)(nil),        // 12: ethos.habits.v1.UpdateHabitRequest
-	(*DeleteHabitRequest)(nil),        // 13: ethos.habits.v1.DeleteHabitRequest
-	(*ActivateHabitRequest)(nil),      // 14: ethos.habits.v1.ActivateHabitRequest
-	(*DeactivateHabitRequest)(nil),    // 15: ethos.habits.v1.DeactivateHabitRequest
-	(*GetHabitStatsRequest)(nil),      // 16: ethos.habits.v1.GetHabitStatsRequest
-	(*HabitStatsResponse)(nil),        // 17: ethos.habits.v1.HabitStatsResponse
-	(*LogHabitRequest)(nil),           // 18: ethos.habits.v1.LogHabitRequest
-	(*LogHabitResponse)(nil),          // 19: ethos.habits.v1.LogHabitResponse
-	(*LogHabitData)(nil),              // 20: ethos.habits.v1.LogHabitData
-	(*GetHabitLogsRequest)(nil),       // 21: ethos.habits.v1.GetHabitLogsRequest
-	(*GetHabitLogsResponse)(nil),      // 22: ethos.habits.v1.GetHabitLogsResponse
-	(*UpdateHabitLogRequest)(nil),     // 23: ethos.habits.v1.UpdateHabitLogRequest
-	(*DeleteHabitLogRequest)(nil),     // 24: ethos.habits.v1.DeleteHabitLogRequest
-	(*GetDashboardRequest)(nil),       // 25: ethos.habits.v1.GetDashboardRequest
-	(*DashboardResponse)(nil),         // 26: ethos.habits.v1.DashboardResponse
-	(*GetWeeklyAnalyticsRequest)(nil), // 27: ethos.habits.v1.GetWeeklyAnalyticsRequest
-	(*WeeklyAnalyticsResponse)(nil),   // 28: ethos.habits.v1.WeeklyAnalyticsResponse
-	(*timestamppb.Timestamp)(nil),     // 29: google.protobuf.Timestamp
-	(*v1.Meta)(nil),                   // 30: ethos.common.v1.Meta
-}
-var file_ethos_habits_v1_messages_proto_depIdxs = []int32{
-	29, // 0: ethos.habits.v1.Habit.created_at:type_name -> google.protobuf.Timestamp
-	29, // 1: ethos.habits.v1.Habit.updated_at:type_name -> google.protobuf.Timestamp
-	29, // 2: ethos.habits.v1.HabitLog.created_at:type_name -> google.protobuf.Timestamp
-	5,  // 3: ethos.habits.v1.WeeklyAnalytics.days:type_name -> ethos.habits.v1.DailyAnalytics
-	1,  // 4: ethos.habits.v1.ListHabitsResponse.data:type_name -> ethos.habits.v1.Habit
-	30, // 5: ethos.habits.v1.ListHabitsResponse.meta:type_name -> ethos.common.v1.Meta
-	1,  // 6: ethos.habits.v1.HabitResponse.data:type_name -> ethos.habits.v1.Habit
-	3,  // 7: ethos.habits.v1.HabitStatsResponse.data:type_name -> ethos.habits.v1.HabitStats
-	20, // 8: ethos.habits.v1.LogHabitResponse.data:type_name -> ethos.habits.v1.LogHabitData
-	2,  // 9: ethos.habits.v1.GetHabitLogsResponse.data:type_name -> ethos.habits.v1.HabitLog
-	30, // 10: ethos.habits.v1.GetHabitLogsResponse.meta:type_name -> ethos.common.v1.Meta
-	4,  // 11: ethos.habits.v1.DashboardResponse.data:type_name -> ethos.habits.v1.Dashboard
-	6,  // 12: ethos.habits.v1.WeeklyAnalyticsResponse.data:type_name -> ethos.habits.v1.WeeklyAnalytics
-	13, // [13:13] is the sub-list for method output_type
-	13, // [13:13] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
-}
-
-func init() { file_ethos_habits_v1_messages_proto_init() }
-func file_ethos_habits_v1_messages_proto_init() {
-	if File_ethos_habits_v1_messages_proto != nil {
-		return
-	}
-	file_ethos_habits_v1_messages_proto_msgTypes[0].OneofWrappers = []any{}
-	file_ethos_habits_v1_messages_proto_msgTypes[1].OneofWrappers = []any{}
-	file_ethos_habits_v1_messages_proto_msgTypes[6].OneofWrappers = []any{}
-	file_ethos_habits_v1_messages_proto_msgTypes[8].OneofWrappers = []any{}
-	file_ethos_habits_v1_messages_proto_msgTypes[11].OneofWrappers = []any{}
-	file_ethos_habits_v1_messages_proto_msgTypes[17].OneofWrappers = []any{}
-	file_ethos_habits_v1_messages_proto_msgTypes[20].OneofWrappers = []any{}
-	file_ethos_habits_v1_messages_proto_msgTypes[22].OneofWrappers = []any{}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ethos_habits_v1_messages_proto_rawDesc), len(file_ethos_habits_v1_messages_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   28,
-			NumExtensions: 0,
-			NumServices:   0,
-		},
-		GoTypes:           file_ethos_habits_v1_messages_proto_goTypes,
-		DependencyIndexes: file_ethos_habits_v1_messages_proto_depIdxs,
-		EnumInfos:         file_ethos_habits_v1_messages_proto_enumTypes,
-		MessageInfos:      file_ethos_habits_v1_messages_proto_msgTypes,
-	}.Build()
-	File_ethos_habits_v1_messages_proto = out.File
-	file_ethos_habits_v1_messages_proto_goTypes = nil
-	file_ethos_habits_v1_messages_proto_depIdxs = nil
-}
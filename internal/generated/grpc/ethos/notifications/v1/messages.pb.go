@@ -1,812 +0,0 @@
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.36.11
-// 	protoc        (unknown)
-// source: ethos/notifications/v1/messages.proto
-
-package notificationsv1
-
-import (
-	v1 "github.com/semmidev/ethos-go/internal/generated/grpc/ethos/common/v1"
-	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
-	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	structpb "google.golang.org/protobuf/types/known/structpb"
-	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
-	reflect "reflect"
-	sync "sync"
-	unsafe "unsafe"
-)
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-// NotificationType represents the type of notification.
-type NotificationType int32
-
-const (
-	// Unspecified notification type.
-	NotificationType_NOTIFICATION_TYPE_UNSPECIFIED NotificationType = 0
-	// Streak milestone notification.
-	NotificationType_NOTIFICATION_TYPE_STREAK_MILESTONE NotificationType = 1
-	// Habit reminder notification.
-	NotificationType_NOTIFICATION_TYPE_HABIT_REMINDER NotificationType = 2
-	// Achievement notification.
-	NotificationType_NOTIFICATION_TYPE_ACHIEVEMENT NotificationType = 3
-	// System notification.
-	NotificationType_NOTIFICATION_TYPE_SYSTEM NotificationType = 4
-	// Welcome notification.
-	NotificationType_NOTIFICATION_TYPE_WELCOME NotificationType = 5
-)
-
-// Enum value maps for NotificationType.
-var (
-	NotificationType_name = map[int32]string{
-		0: "NOTIFICATION_TYPE_UNSPECIFIED",
-		1: "NOTIFICATION_TYPE_STREAK_MILESTONE",
-		2: "NOTIFICATION_TYPE_HABIT_REMINDER",
-		3: "NOTIFICATION_TYPE_ACHIEVEMENT",
-		4: "NOTIFICATION_TYPE_SYSTEM",
-		5: "NOTIFICATION_TYPE_WELCOME",
-	}
-	NotificationType_value = map[string]int32{
-		"NOTIFICATION_TYPE_UNSPECIFIED":      0,
-		"NOTIFICATION_TYPE_STREAK_MILESTONE": 1,
-		"NOTIFICATION_TYPE_HABIT_REMINDER":   2,
-		"NOTIFICATION_TYPE_ACHIEVEMENT":      3,
-		"NOTIFICATION_TYPE_SYSTEM":           4,
-		"NOTIFICATION_TYPE_WELCOME":          5,
-	}
-)
-
-func (x NotificationType) Enum() *NotificationType {
-	p := new(NotificationType)
-	*p = x
-	return p
-}
-
-func (x NotificationType) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
-}
-
-func (NotificationType) Descriptor() protoreflect.EnumDescriptor {
-	return file_ethos_notifications_v1_messages_proto_enumTypes[0].Descriptor()
-}
-
-func (NotificationType) Type() protoreflect.EnumType {
-	return &file_ethos_notifications_v1_messages_proto_enumTypes[0]
-}
-
-func (x NotificationType) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
-}
-
-// Deprecated: Use NotificationType.Descriptor instead.
-func (NotificationType) EnumDescriptor() ([]byte, []int) {
-	return file_ethos_notifications_v1_messages_proto_rawDescGZIP(), []int{0}
-}
-
-// Notification represents a user notification.
-type Notification struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Unique notification identifier.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Notification type.
-	Type NotificationType `protobuf:"varint,2,opt,name=type,proto3,enum=ethos.notifications.v1.NotificationType" json:"type,omitempty"`
-	// Notification title.
-	Title string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	// Notification message.
-	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
-	// Additional notification data.
-	Data *structpb.Struct `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
-	// Whether the notification has been read.
-	IsRead bool `protobuf:"varint,6,opt,name=is_read,json=isRead,proto3" json:"is_read,omitempty"`
-	// Creation time.
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	// Time when notification was read.
-	ReadAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=read_at,json=readAt,proto3,oneof" json:"read_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *Notification) Reset() {
-	*x = Notification{}
-	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[0]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *Notification) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Notification) ProtoMessage() {}
-
-func (x *Notification) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[0]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
-func (*Notification) Descriptor() ([]byte, []int) {
-	return file_ethos_notifications_v1_messages_proto_rawDescGZIP(), []int{0}
-}
-
-func (x *Notification) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *Notification) GetType() NotificationType {
-	if x != nil {
-		return x.Type
-	}
-	return NotificationType_NOTIFICATION_TYPE_UNSPECIFIED
-}
-
-func (x *Notification) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
-}
-
-func (x *Notification) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-func (x *Notification) GetData() *structpb.Struct {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-func (x *Notification) GetIsRead() bool {
-	if x != nil {
-		return x.IsRead
-	}
-	return false
-}
-
-func (x *Notification) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
-}
-
-func (x *Notification) GetReadAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.ReadAt
-	}
-	return nil
-}
-
-// CreateNotificationRequest contains data for creating a notification.
-type CreateNotificationRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Notification title.
-	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	// Notification message.
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	// Notification type as string (streak_milestone, habit_reminder, etc.).
-	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	// Additional data.
-	Data          *structpb.Struct `protobuf:"bytes,4,opt,name=data,proto3,oneof" json:"data,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CreateNotificationRequest) Reset() {
-	*x = CreateNotificationRequest{}
-	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[1]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CreateNotificationRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CreateNotificationRequest) ProtoMessage() {}
-
-func (x *CreateNotificationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[1]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CreateNotificationRequest.ProtoReflect.Descriptor instead.
-func (*CreateNotificationRequest) Descriptor() ([]byte, []int) {
-	return file_ethos_notifications_v1_messages_proto_rawDescGZIP(), []int{1}
-}
-
-func (x *CreateNotificationRequest) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
-}
-
-func (x *CreateNotificationRequest) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-func (x *CreateNotificationRequest) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
-}
-
-func (x *CreateNotificationRequest) GetData() *structpb.Struct {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-// ListNotificationsRequest contains filters for listing notifications.
-type ListNotificationsRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Page number (1-indexed).
-	Page int32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	// Number of items per page.
-	PerPage int32 `protobuf:"varint,2,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
-	// Only return unread notifications.
-	UnreadOnly    bool `protobuf:"varint,3,opt,name=unread_only,json=unreadOnly,proto3" json:"unread_only,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListNotificationsRequest) Reset() {
-	*x = ListNotificationsRequest{}
-	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[2]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListNotificationsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListNotificationsRequest) ProtoMessage() {}
-
-func (x *ListNotificationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[2]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListNotificationsRequest.ProtoReflect.Descriptor instead.
-func (*ListNotificationsRequest) Descriptor() ([]byte, []int) {
-	return file_ethos_notifications_v1_messages_proto_rawDescGZIP(), []int{2}
-}
-
-func (x *ListNotificationsRequest) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *ListNotificationsRequest) GetPerPage() int32 {
-	if x != nil {
-		return x.PerPage
-	}
-	return 0
-}
-
-func (x *ListNotificationsRequest) GetUnreadOnly() bool {
-	if x != nil {
-		return x.UnreadOnly
-	}
-	return false
-}
-
-// ListNotificationsResponse contains paginated notifications.
-type ListNotificationsResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Whether the request was successful.
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	// Human-readable message.
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	// List of notifications.
-	Data []*Notification `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
-	// Pagination metadata.
-	Meta          *v1.Meta `protobuf:"bytes,4,opt,name=meta,proto3" json:"meta,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListNotificationsResponse) Reset() {
-	*x = ListNotificationsResponse{}
-	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[3]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListNotificationsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListNotificationsResponse) ProtoMessage() {}
-
-func (x *ListNotificationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[3]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListNotificationsResponse.ProtoReflect.Descriptor instead.
-func (*ListNotificationsResponse) Descriptor() ([]byte, []int) {
-	return file_ethos_notifications_v1_messages_proto_rawDescGZIP(), []int{3}
-}
-
-func (x *ListNotificationsResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-func (x *ListNotificationsResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-func (x *ListNotificationsResponse) GetData() []*Notification {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-func (x *ListNotificationsResponse) GetMeta() *v1.Meta {
-	if x != nil {
-		return x.Meta
-	}
-	return nil
-}
-
-// GetUnreadCountRequest is empty - uses auth context.
-type GetUnreadCountRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetUnreadCountRequest) Reset() {
-	*x = GetUnreadCountRequest{}
-	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[4]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetUnreadCountRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetUnreadCountRequest) ProtoMessage() {}
-
-func (x *GetUnreadCountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[4]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetUnreadCountRequest.ProtoReflect.Descriptor instead.
-func (*GetUnreadCountRequest) Descriptor() ([]byte, []int) {
-	return file_ethos_notifications_v1_messages_proto_rawDescGZIP(), []int{4}
-}
-
-// UnreadCountResponse contains the unread notification count.
-type UnreadCountResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Whether the request was successful.
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	// Human-readable message.
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	// Unread count data.
-	Data          *UnreadCountData `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *UnreadCountResponse) Reset() {
-	*x = UnreadCountResponse{}
-	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[5]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *UnreadCountResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*UnreadCountResponse) ProtoMessage() {}
-
-func (x *UnreadCountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[5]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use UnreadCountResponse.ProtoReflect.Descriptor instead.
-func (*UnreadCountResponse) Descriptor() ([]byte, []int) {
-	return file_ethos_notifications_v1_messages_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *UnreadCountResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-func (x *UnreadCountResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-func (x *UnreadCountResponse) GetData() *UnreadCountData {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-// UnreadCountData contains the unread count.
-type UnreadCountData struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Number of unread notifications.
-	Count         int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *UnreadCountData) Reset() {
-	*x = UnreadCountData{}
-	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[6]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *UnreadCountData) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*UnreadCountData) ProtoMessage() {}
-
-func (x *UnreadCountData) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[6]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use UnreadCountData.ProtoReflect.Descriptor instead.
-func (*UnreadCountData) Descriptor() ([]byte, []int) {
-	return file_ethos_notifications_v1_messages_proto_rawDescGZIP(), []int{6}
-}
-
-func (x *UnreadCountData) GetCount() int32 {
-	if x != nil {
-		return x.Count
-	}
-	return 0
-}
-
-// MarkAsReadRequest identifies a notification to mark as read.
-type MarkAsReadRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Notification identifier.
-	NotificationId string `protobuf:"bytes,1,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
-}
-
-func (x *MarkAsReadRequest) Reset() {
-	*x = MarkAsReadRequest{}
-	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[7]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *MarkAsReadRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*MarkAsReadRequest) ProtoMessage() {}
-
-func (x *MarkAsReadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[7]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use MarkAsReadRequest.ProtoReflect.Descriptor instead.
-func (*MarkAsReadRequest) Descriptor() ([]byte, []int) {
-	return file_ethos_notifications_v1_messages_proto_rawDescGZIP(), []int{7}
-}
-
-func (x *MarkAsReadRequest) GetNotificationId() string {
-	if x != nil {
-		return x.NotificationId
-	}
-	return ""
-}
-
-// MarkAllAsReadRequest is empty - uses auth context.
-type MarkAllAsReadRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *MarkAllAsReadRequest) Reset() {
-	*x = MarkAllAsReadRequest{}
-	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[8]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *MarkAllAsReadRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*MarkAllAsReadRequest) ProtoMessage() {}
-
-func (x *MarkAllAsReadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[8]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use MarkAllAsReadRequest.ProtoReflect.Descriptor instead.
-func (*MarkAllAsReadRequest) Descriptor() ([]byte, []int) {
-	return file_ethos_notifications_v1_messages_proto_rawDescGZIP(), []int{8}
-}
-
-// DeleteNotificationRequest identifies a notification to delete.
-type DeleteNotificationRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Notification identifier.
-	NotificationId string `protobuf:"bytes,1,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
-}
-
-func (x *DeleteNotificationRequest) Reset() {
-	*x = DeleteNotificationRequest{}
-	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[9]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *DeleteNotificationRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*DeleteNotificationRequest) ProtoMessage() {}
-
-func (x *DeleteNotificationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ethos_notifications_v1_messages_proto_msgTypes[9]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use DeleteNotificationRequest.ProtoReflect.Descriptor instead.
-func (*DeleteNotificationRequest) Descriptor() ([]byte, []int) {
-	return file_ethos_notifications_v1_messages_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *DeleteNotificationRequest) GetNotificationId() string {
-	if x != nil {
-		return x.NotificationId
-	}
-	return ""
-}
-
-var File_ethos_notifications_v1_messages_proto protoreflect.FileDescriptor
-
-const file_ethos_notifications_v1_messages_proto_rawDesc = "" +
-	"\n" +
-	"%ethos/notifications/v1/messages.proto\x12\x16ethos.notifications.v1\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a ethos/common/v1/pagination.proto\"\xd3\x02\n" +
-	"\fNotification\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12<\n" +
-	"\x04type\x18\x02 \x01(\x0e2(.ethos.notifications.v1.NotificationTypeR\x04type\x12\x14\n" +
-	"\x05title\x18\x03 \x01(\tR\x05title\x12\x18\n" +
-	"\amessage\x18\x04 \x01(\tR\amessage\x12+\n" +
-	"\x04data\x18\x05 \x01(\v2\x17.google.protobuf.StructR\x04data\x12\x17\n" +
-	"\ais_read\x18\x06 \x01(\bR\x06isRead\x129\n" +
-	"\n" +
-	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x128\n" +
-	"\aread_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x06readAt\x88\x01\x01B\n" +
-	"\n" +
-	"\b_read_at\"\x9a\x01\n" +
-	"\x19CreateNotificationRequest\x12\x14\n" +
-	"\x05title\x18\x01 \x01(\tR\x05title\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x12\x12\n" +
-	"\x04type\x18\x03 \x01(\tR\x04type\x120\n" +
-	"\x04data\x18\x04 \x01(\v2\x17.google.protobuf.StructH\x00R\x04data\x88\x01\x01B\a\n" +
-	"\x05_data\"j\n" +
-	"\x18ListNotificationsRequest\x12\x12\n" +
-	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x19\n" +
-	"\bper_page\x18\x02 \x01(\x05R\aperPage\x12\x1f\n" +
-	"\vunread_only\x18\x03 \x01(\bR\n" +
-	"unreadOnly\"\xb4\x01\n" +
-	"\x19ListNotificationsResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x128\n" +
-	"\x04data\x18\x03 \x03(\v2$.ethos.notifications.v1.NotificationR\x04data\x12)\n" +
-	"\x04meta\x18\x04 \x01(\v2\x15.ethos.common.v1.MetaR\x04meta\"\x17\n" +
-	"\x15GetUnreadCountRequest\"\x86\x01\n" +
-	"\x13UnreadCountResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x12;\n" +
-	"\x04data\x18\x03 \x01(\v2'.ethos.notifications.v1.UnreadCountDataR\x04data\"'\n" +
-	"\x0fUnreadCountData\x12\x14\n" +
-	"\x05count\x18\x01 \x01(\x05R\x05count\"<\n" +
-	"\x11MarkAsReadRequest\x12'\n" +
-	"\x0fnotification_id\x18\x01 \x01(\tR\x0enotificationId\"\x16\n" +
-	"\x14MarkAllAsReadRequest\"D\n" +
-	"\x19DeleteNotificationRequest\x12'\n" +
-	"\x0fnotification_id\x18\x01 \x01(\tR\x0enotificationId*\xe3\x01\n" +
-	"\x10NotificationType\x12!\n" +
-	"\x1dNOTIFICATION_TYPE_UNSPECIFIED\x10\x00\x12&\n" +
-	"\"NOTIFICATION_TYPE_STREAK_MILESTONE\x10\x01\x12$\n" +
-	" NOTIFICATION_TYPE_HABIT_REMINDER\x10\x02\x12!\n" +
-	"\x1dNOTIFICATION_TYPE_ACHIEVEMENT\x10\x03\x12\x1c\n" +
-	"\x18NOTIFICATION_TYPE_SYSTEM\x10\x04\x12\x1d\n" +
-	"\x19NOTIFICATION_TYPE_WELCOME\x10\x05B\x82\x02\n" +
-	"\x1acom.ethos.notifications.v1B\rMessagesProtoP\x01Z[github.com/semmidev/ethos-go/internal/generated/grpc/ethos/notifications/v1;notificationsv1\xa2\x02\x03ENX\xaa\x02\x16Ethos.Notifications.V1\xca\x02\x16Ethos\\Notifications\\V1\xe2\x02\"Ethos\\Notifications\\V1\\GPBMetadata\xea\x02\x18Ethos::Notifications::V1b\x06proto3"
-
-var (
-	file_ethos_notifications_v1_messages_proto_rawDescOnce sync.Once
-	file_ethos_notifications_v1_messages_proto_rawDescData []byte
-)
-
-func file_ethos_notifications_v1_messages_proto_rawDescGZIP() []byte {
-	file_ethos_notifications_v1_messages_proto_rawDescOnce.Do(func() {
-		file_ethos_notifications_v1_messages_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ethos_notifications_v1_messages_proto_rawDesc), len(file_ethos_notifications_v1_messages_proto_rawDesc)))
-	})
-	return file_ethos_notifications_v1_messages_proto_rawDescData
-}
-
-var file_ethos_notifications_v1_messages_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_ethos_notifications_v1_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
-var file_ethos_notifications_v1_messages_proto_goTypes = []any{
-	(NotificationType)(0),             // 0: ethos.notifications.v1.NotificationType
-	(*Notification)(nil),              // 1: ethos.notifications.v1.Notification
-	(*CreateNotificationRequest)(nil), // 2: ethos.notifications.v1.CreateNotificationRequest
-	(*ListNotificationsRequest)(nil),  // 3: ethos.notifications.v1.ListNotificationsRequest
-	(*ListNotificationsResponse)(nil), // 4: ethos.notifications.v1.ListNotificationsResponse
-	(*GetUnreadCountRequest)(nil),     // 5: ethos.notifications.v1.GetUnreadCountRequest
-	(*UnreadCountResponse)(nil),       // 6: ethos.notifications.v1.UnreadCountResponse
-	(*UnreadCountData)(nil),           // 7: ethos.notifications.v1.UnreadCountData
-	(*MarkAsReadRequest)(nil),         // 8: ethos.notifications.v1.MarkAsReadRequest
-	(*MarkAllAsReadRequest)(nil),      // 9: ethos.notifications.v1.MarkAllAsReadRequest
-	(*DeleteNotificationRequest)(nil), // 10: ethos.notifications.v1.DeleteNotificationRequest
-	(*structpb.Struct)(nil),           // 11: google.protobuf.Struct
-	(*timestamppb.Timestamp)(nil),     // 12: google.protobuf.Timestamp
-	(*v1.Meta)(nil),                   // 13: ethos.common.v1.Meta
-}
-var file_ethos_notifications_v1_messages_proto_depIdxs = []int32{
-	0,  // 0: ethos.notifications.v1.Notification.type:type_name -> ethos.notifications.v1.NotificationType
-	11, // 1: ethos.notifications.v1.Notification.data:type_name -> google.protobuf.Struct
-	12, // 2: ethos.notifications.v1.Notification.created_at:type_name -> google.protobuf.Timestamp
-	12, // 3: ethos.notifications.v1.Notification.read_at:type_name -> google.protobuf.Timestamp
-	11, // 4: ethos.notifications.v1.CreateNotificationRequest.data:type_name -> google.protobuf.Struct
-	1,  // 5: ethos.notifications.v1.ListNotificationsResponse.data:type_name -> ethos.notifications.v1.Notification
-	13, // 6: ethos.notifications.v1.ListNotificationsResponse.meta:type_name -> ethos.common.v1.Meta
-	7,  // 7: ethos.notifications.v1.UnreadCountResponse.data:type_name -> ethos.notifications.v1.UnreadCountData
-	8,  // [8:8] is the sub-list for method output_type
-	8,  // [8:8] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
-}
-
-func init() { file_ethos_notifications_v1_messages_proto_init() }
-func file_ethos_notifications_v1_messages_proto_init() {
-	if File_ethos_notifications_v1_messages_proto != nil {
-		return
-	}
-	file_ethos_notifications_v1_messages_proto_msgTypes[0].OneofWrappers = []any{}
-	file_ethos_notifications_v1_messages_proto_msgTypes[1].OneofWrappers = []any{}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ethos_notifications_v1_messages_proto_rawDesc), len(file_ethos_notifications_v1_messages_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   10,
-			NumExtensions: 0,
-			NumServices:   0,
-		},
-		GoTypes:           file_ethos_notifications_v1_messages_proto_goTypes,
-		DependencyIndexes: file_ethos_notifications_v1_messages_proto_depIdxs,
-		EnumInfos:         file_ethos_notifications_v1_messages_proto_enumTypes,
-		MessageInfos:      file_ethos_notifications_v1_messages_proto_msgTypes,
-	}.Build()
-	File_ethos_notifications_v1_messages_proto = out.File
-	file_ethos_notifications_v1_messages_proto_goTypes = nil
-	file_ethos_notifications_v1_messages_proto_depIdxs = nil
-}
@@ -0,0 +1,103 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/semmidev/ethos-go/internal/common/database"
+	"github.com/semmidev/ethos-go/internal/habits/app/query"
+)
+
+// DashboardProjection keeps each user's dashboard summary in
+// user_dashboards. Event handlers refresh a user's row after their habits
+// or logs change; reads of a missing row, or of one computed on an earlier
+// day, compute it from habit_logs and store it.
+type DashboardProjection struct {
+	db    database.DBTX
+	stats *StatsRepository
+}
+
+func NewDashboardProjection(db database.DBTX) *DashboardProjection {
+	return &DashboardProjection{
+		db:    db,
+		stats: NewStatsRepository(db),
+	}
+}
+
+type dashboardRow struct {
+	Summary    json.RawMessage `db:"summary"`
+	ComputedOn time.Time       `db:"computed_on"`
+}
+
+// GetDashboard returns the stored summary, computing it when it is
+// missing or from an earlier day
+func (p *DashboardProjection) GetDashboard(ctx context.Context, userID string) (*query.DashboardSummary, error) {
+	var row dashboardRow
+	err := p.db.GetContext(ctx, &row,
+		`SELECT summary, computed_on FROM user_dashboards WHERE user_id = $1`, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return p.refresh(ctx, userID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !row.ComputedOn.Equal(dashboardDay()) {
+		return p.refresh(ctx, userID)
+	}
+
+	var summary query.DashboardSummary
+	if err := json.Unmarshal(row.Summary, &summary); err != nil {
+		return p.refresh(ctx, userID)
+	}
+	return &summary, nil
+}
+
+// Refresh recomputes and stores a user's dashboard summary
+func (p *DashboardProjection) Refresh(ctx context.Context, userID string) error {
+	_, err := p.refresh(ctx, userID)
+	return err
+}
+
+// ListDashboardUsers returns every user with at least one habit
+func (p *DashboardProjection) ListDashboardUsers(ctx context.Context) ([]string, error) {
+	var userIDs []string
+	err := p.db.SelectContext(ctx, &userIDs,
+		`SELECT DISTINCT user_id FROM habits ORDER BY user_id`)
+	return userIDs, err
+}
+
+func (p *DashboardProjection) refresh(ctx context.Context, userID string) (*query.DashboardSummary, error) {
+	day := dashboardDay()
+
+	summary, err := p.stats.GetDashboard(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(summary)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = p.db.ExecContext(ctx, `
+		INSERT INTO user_dashboards (user_id, summary, computed_on, updated_at)
+		VALUES ($1, $2, $3, NOW())
+		ON CONFLICT (user_id) DO UPDATE
+		SET summary = EXCLUDED.summary, computed_on = EXCLUDED.computed_on, updated_at = NOW()
+	`, userID, data, day)
+	if err != nil {
+		return nil, err
+	}
+
+	return summary, nil
+}
+
+// dashboardDay is the UTC day summaries are computed for, matching the
+// "today" StatsRepository.GetDashboard counts completions against
+func dashboardDay() time.Time {
+	return time.Now().UTC().Truncate(24 * time.Hour)
+}
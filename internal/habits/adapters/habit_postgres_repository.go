@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/lib/pq"
 	"github.com/semmidev/ethos-go/internal/common/database"
 	"github.com/semmidev/ethos-go/internal/common/model"
 	"github.com/semmidev/ethos-go/internal/habits/app/query"
@@ -25,8 +26,14 @@ type habitModel struct {
 	TargetCount        int            `db:"target_count"`
 	ReminderTime       sql.NullString `db:"reminder_time"`
 	IsActive           bool           `db:"is_active"`
+	Position           int            `db:"position"`
+	PausedUntil        *time.Time     `db:"paused_until"`
 	CreatedAt          time.Time      `db:"created_at"`
 	UpdatedAt          time.Time      `db:"updated_at"`
+	Version            int            `db:"version"`
+	// Scheduled by the reminder sweep, not part of the aggregate
+	NextReminderAt   *time.Time     `db:"next_reminder_at"`
+	ReminderTimezone sql.NullString `db:"reminder_timezone"`
 }
 
 type statsModel struct {
@@ -58,8 +65,9 @@ func NewHabitPostgresRepository(db database.DBTX) *HabitPostgresRepository {
 
 func (r *HabitPostgresRepository) AddHabit(ctx context.Context, h *habit.Habit) error {
 	query := `
-        INSERT INTO habits (habit_id, user_id, name, description, frequency, target_count, reminder_time, is_active, created_at, updated_at)
-        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+        INSERT INTO habits (habit_id, user_id, name, description, frequency, recurrence_days, recurrence_interval, target_count, reminder_time, is_active, created_at, updated_at, position)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12,
+                (SELECT COALESCE(MAX(position) + 1, 0) FROM habits WHERE user_id = $2))
     `
 	// Convert *string to sql.NullString for database insert
 	var description sql.NullString
@@ -78,6 +86,8 @@ func (r *HabitPostgresRepository) AddHabit(ctx context.Context, h *habit.Habit)
 		h.Name(),
 		description,
 		h.Frequency().String(),
+		h.Recurrence().Days(),
+		h.Recurrence().Interval(),
 		h.TargetCount(),
 		reminderTime,
 		h.IsActive(),
@@ -87,27 +97,49 @@ func (r *HabitPostgresRepository) AddHabit(ctx context.Context, h *habit.Habit)
 	return err
 }
 
-func (r *HabitPostgresRepository) GetHabit(ctx context.Context, habitID, userID string) (*habit.Habit, error) {
-	var model habitModel
-	query := `SELECT * FROM habits WHERE habit_id = $1`
-	err := r.db.GetContext(ctx, &model, query, habitID)
-	if errors.Is(err, sql.ErrNoRows) {
-		return nil, habit.ErrNotFound
+// CountActiveHabitsForUpdate locks the user's row before counting. FOR NO
+// KEY UPDATE serializes callers without blocking writes that only
+// reference the user, such as logging a habit.
+func (r *HabitPostgresRepository) CountActiveHabitsForUpdate(ctx context.Context, userID string) (int, error) {
+	if _, err := r.db.ExecContext(ctx, `SELECT 1 FROM users WHERE user_id = $1 FOR NO KEY UPDATE`, userID); err != nil {
+		return 0, fmt.Errorf("lock user: %w", err)
 	}
-	if err != nil {
-		return nil, err
+
+	var count int
+	q := `SELECT COUNT(*) FROM habits WHERE user_id = $1 AND (is_active OR paused_until IS NOT NULL)`
+	if err := r.db.GetContext(ctx, &count, q, userID); err != nil {
+		return 0, fmt.Errorf("count active habits: %w", err)
 	}
+	return count, nil
+}
 
-	h, err := r.unmarshalHabit(model)
+func (r *HabitPostgresRepository) LockHabitForUpdate(ctx context.Context, habitID, userID string) error {
+	var locked string
+	q := `SELECT habit_id FROM habits WHERE habit_id = $1 AND user_id = $2 FOR NO KEY UPDATE`
+	err := r.db.GetContext(ctx, &locked, q, habitID, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return habit.ErrNotFound
+	}
 	if err != nil {
-		return nil, err
+		return fmt.Errorf("lock habit: %w", err)
 	}
+	return nil
+}
 
-	if err := h.CanBeViewedBy(userID); err != nil {
+// GetHabit returns ErrNotFound both for a missing habit and for another
+// user's, so callers cannot probe for habits they do not own
+func (r *HabitPostgresRepository) GetHabit(ctx context.Context, habitID, userID string) (*habit.Habit, error) {
+	var model habitModel
+	query := `SELECT * FROM habits WHERE habit_id = $1 AND user_id = $2`
+	err := r.db.GetContext(ctx, &model, query, habitID, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, habit.ErrNotFound
+	}
+	if err != nil {
 		return nil, err
 	}
 
-	return h, nil
+	return r.unmarshalHabit(model)
 }
 
 func (r *HabitPostgresRepository) UpdateHabit(
@@ -116,8 +148,8 @@ func (r *HabitPostgresRepository) UpdateHabit(
 	updateFn func(ctx context.Context, h *habit.Habit) (*habit.Habit, error),
 ) error {
 	var model habitModel
-	query := `SELECT * FROM habits WHERE habit_id = $1`
-	err := r.db.GetContext(ctx, &model, query, habitID)
+	query := `SELECT * FROM habits WHERE habit_id = $1 AND user_id = $2`
+	err := r.db.GetContext(ctx, &model, query, habitID, userID)
 	if errors.Is(err, sql.ErrNoRows) {
 		return habit.ErrNotFound
 	}
@@ -130,10 +162,6 @@ func (r *HabitPostgresRepository) UpdateHabit(
 		return err
 	}
 
-	if err := h.CanBeViewedBy(userID); err != nil {
-		return err
-	}
-
 	updatedHabit, err := updateFn(ctx, h)
 	if err != nil {
 		return err
@@ -150,12 +178,17 @@ func (r *HabitPostgresRepository) UpdateHabit(
 		reminderTime = sql.NullString{String: *updatedHabit.ReminderTime(), Valid: true}
 	}
 
+	// The version check turns a concurrent update between the read above
+	// and this write into a conflict instead of a lost update. A new
+	// reminder time clears the next reminder for the sweep to reschedule.
 	updateQuery := `
         UPDATE habits
-        SET name = $1, description = $2, frequency = $3, target_count = $4, reminder_time = $5, is_active = $6, updated_at = $7
-        WHERE habit_id = $8
+        SET name = $1, description = $2, frequency = $3, target_count = $4, reminder_time = $5, is_active = $6, updated_at = $7, paused_until = $8,
+            recurrence_days = $9, recurrence_interval = $10, version = version + 1,
+            next_reminder_at = CASE WHEN reminder_time IS DISTINCT FROM $5 THEN NULL ELSE next_reminder_at END
+        WHERE habit_id = $11 AND user_id = $12 AND version = $13
     `
-	_, err = r.db.ExecContext(ctx, updateQuery,
+	result, err := r.db.ExecContext(ctx, updateQuery,
 		updatedHabit.Name(),
 		description,
 		updatedHabit.Frequency().String(),
@@ -163,29 +196,85 @@ func (r *HabitPostgresRepository) UpdateHabit(
 		reminderTime,
 		updatedHabit.IsActive(),
 		updatedHabit.UpdatedAt(),
+		updatedHabit.PausedUntil(),
+		updatedHabit.Recurrence().Days(),
+		updatedHabit.Recurrence().Interval(),
 		habitID,
+		userID,
+		model.Version,
 	)
-	return err
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return habit.ErrVersionConflict
+	}
+	return nil
 }
 
 func (r *HabitPostgresRepository) DeleteHabit(ctx context.Context, habitID, userID string) error {
-	h, err := r.GetHabit(ctx, habitID, userID)
+	query := `DELETE FROM habits WHERE habit_id = $1 AND user_id = $2`
+	result, err := r.db.ExecContext(ctx, query, habitID, userID)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
 	if err != nil {
 		return err
 	}
+	if rows == 0 {
+		return habit.ErrNotFound
+	}
+	return nil
+}
 
-	if err := h.CanBeViewedBy(userID); err != nil {
+func (r *HabitPostgresRepository) ReorderHabits(ctx context.Context, userID string, habitIDs []string) error {
+	// Lock the user's habits so concurrent reorders apply one after another
+	var current []string
+	q := `SELECT habit_id FROM habits WHERE user_id = $1 ORDER BY position, created_at FOR UPDATE`
+	if err := r.db.SelectContext(ctx, &current, q, userID); err != nil {
 		return err
 	}
 
-	query := `DELETE FROM habits WHERE habit_id = $1`
-	_, err = r.db.ExecContext(ctx, query, habitID)
+	owned := make(map[string]bool, len(current))
+	for _, id := range current {
+		owned[id] = true
+	}
+
+	order := make([]string, 0, len(current))
+	listed := make(map[string]bool, len(habitIDs))
+	for _, id := range habitIDs {
+		if !owned[id] {
+			return habit.ErrNotFound
+		}
+		listed[id] = true
+		order = append(order, id)
+	}
+	for _, id := range current {
+		if !listed[id] {
+			order = append(order, id)
+		}
+	}
+
+	updateQuery := `
+		UPDATE habits AS h
+		SET position = o.ord - 1
+		FROM unnest($1::uuid[]) WITH ORDINALITY AS o(habit_id, ord)
+		WHERE h.habit_id = o.habit_id AND h.user_id = $2
+	`
+	_, err := r.db.ExecContext(ctx, updateQuery, pq.Array(order), userID)
 	return err
 }
 
 func (r *HabitPostgresRepository) ListHabitsByUser(ctx context.Context, userID string) ([]*habit.Habit, error) {
 	var models []habitModel
-	query := `SELECT * FROM habits WHERE user_id = $1`
+	query := `SELECT * FROM habits WHERE user_id = $1 ORDER BY position, created_at`
 	err := r.db.SelectContext(ctx, &models, query, userID)
 	if err != nil {
 		return nil, err
@@ -203,6 +292,30 @@ func (r *HabitPostgresRepository) ListHabitsByUser(ctx context.Context, userID s
 	return habits, nil
 }
 
+func (r *HabitPostgresRepository) ListPausedHabitsDue(ctx context.Context, now time.Time) ([]*habit.Habit, error) {
+	// A pause ends at the start of paused_until in the user's timezone
+	var models []habitModel
+	query := `
+		SELECT h.* FROM habits h
+		JOIN users u ON h.user_id = u.user_id
+		WHERE h.paused_until IS NOT NULL
+		  AND h.paused_until <= ($1::timestamptz AT TIME ZONE COALESCE(u.timezone, 'UTC'))::date
+	`
+	if err := r.db.SelectContext(ctx, &models, query, now); err != nil {
+		return nil, err
+	}
+
+	habits := make([]*habit.Habit, 0, len(models))
+	for _, m := range models {
+		h, err := r.unmarshalHabit(m)
+		if err != nil {
+			return nil, err
+		}
+		habits = append(habits, h)
+	}
+	return habits, nil
+}
+
 // Habit Stats
 
 func (r *HabitPostgresRepository) GetStats(ctx context.Context, habitID string) (*habit.HabitStats, error) {
@@ -326,8 +439,8 @@ func (r *HabitPostgresRepository) ListVacations(ctx context.Context, habitID str
 
 func (r *HabitPostgresRepository) GetHabitQuery(ctx context.Context, habitID, userID string) (*query.Habit, error) {
 	var model habitModel
-	q := `SELECT * FROM habits WHERE habit_id = $1`
-	err := r.db.GetContext(ctx, &model, q, habitID)
+	q := `SELECT * FROM habits WHERE habit_id = $1 AND user_id = $2`
+	err := r.db.GetContext(ctx, &model, q, habitID, userID)
 	if errors.Is(err, sql.ErrNoRows) {
 		return nil, habit.ErrNotFound
 	}
@@ -335,11 +448,6 @@ func (r *HabitPostgresRepository) GetHabitQuery(ctx context.Context, habitID, us
 		return nil, err
 	}
 
-	// Authorization check
-	if model.UserID != userID {
-		return nil, habit.ErrUnauthorized
-	}
-
 	return &query.Habit{
 		HabitID:      model.HabitID,
 		UserID:       model.UserID,
@@ -349,11 +457,46 @@ func (r *HabitPostgresRepository) GetHabitQuery(ctx context.Context, habitID, us
 		TargetCount:  model.TargetCount,
 		ReminderTime: nullStringToPtr(model.ReminderTime),
 		IsActive:     model.IsActive,
+		Position:     model.Position,
+		PausedUntil:  model.PausedUntil,
+		Recurrence:   toQueryRecurrence(model.RecurrenceDays, model.RecurrenceInterval),
 		CreatedAt:    model.CreatedAt,
 		UpdatedAt:    model.UpdatedAt,
+		Version:      model.Version,
 	}, nil
 }
 
+func (r *HabitPostgresRepository) ListVacationsQuery(ctx context.Context, habitID, userID string) ([]query.Vacation, error) {
+	var owned bool
+	err := r.db.GetContext(ctx, &owned,
+		`SELECT EXISTS (SELECT 1 FROM habits WHERE habit_id = $1 AND user_id = $2)`, habitID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !owned {
+		return nil, habit.ErrNotFound
+	}
+
+	var models []vacationModel
+	q := `SELECT * FROM habit_vacations WHERE habit_id = $1 ORDER BY start_date DESC`
+	if err := r.db.SelectContext(ctx, &models, q, habitID); err != nil {
+		return nil, err
+	}
+
+	vacations := make([]query.Vacation, len(models))
+	for i, m := range models {
+		vacations[i] = query.Vacation{
+			VacationID: m.ID,
+			HabitID:    m.HabitID,
+			StartDate:  m.StartDate,
+			EndDate:    m.EndDate,
+			Reason:     m.Reason,
+			CreatedAt:  m.CreatedAt,
+		}
+	}
+	return vacations, nil
+}
+
 func (r *HabitPostgresRepository) ListHabits(ctx context.Context, userID string, filter model.Filter) ([]query.Habit, int, error) {
 	// Build WHERE conditions
 	conditions := []string{"user_id = $1"}
@@ -395,32 +538,23 @@ func (r *HabitPostgresRepository) ListHabits(ctx context.Context, userID string,
 		return nil, 0, err
 	}
 
-	// Build ORDER BY clause
-	orderBy := "created_at"
-	if filter.HasSort() {
-		// Validate sort column to prevent SQL injection
-		allowedColumns := map[string]bool{
-			"name": true, "created_at": true, "updated_at": true, "is_active": true,
-		}
-		if allowedColumns[filter.SortBy] {
-			orderBy = filter.SortBy
-		}
-	}
+	// Build ORDER BY clause (user-defined order by default)
+	orderBy := filter.SortColumn(query.HabitSortColumns, "position")
 	orderDirection := "ASC"
 	if filter.IsDesc() {
 		orderDirection = "DESC"
 	}
 
-	// Build the main query with pagination
+	// Build the main query with pagination; habit_id keeps pages stable on ties
 	var q string
 	if filter.IsUnlimitedPage() {
 		q = fmt.Sprintf(
-			"SELECT * FROM habits WHERE %s ORDER BY %s %s",
+			"SELECT * FROM habits WHERE %s ORDER BY %s %s, created_at, habit_id",
 			whereClause, orderBy, orderDirection,
 		)
 	} else {
 		q = fmt.Sprintf(
-			"SELECT * FROM habits WHERE %s ORDER BY %s %s LIMIT $%d OFFSET $%d",
+			"SELECT * FROM habits WHERE %s ORDER BY %s %s, created_at, habit_id LIMIT $%d OFFSET $%d",
 			whereClause, orderBy, orderDirection, argIndex, argIndex+1,
 		)
 		args = append(args, filter.GetLimit(), filter.GetOffset())
@@ -442,13 +576,46 @@ func (r *HabitPostgresRepository) ListHabits(ctx context.Context, userID string,
 			TargetCount:  m.TargetCount,
 			ReminderTime: nullStringToPtr(m.ReminderTime),
 			IsActive:     m.IsActive,
+			Position:     m.Position,
+			PausedUntil:  m.PausedUntil,
+			Recurrence:   toQueryRecurrence(m.RecurrenceDays, m.RecurrenceInterval),
 			CreatedAt:    m.CreatedAt,
 			UpdatedAt:    m.UpdatedAt,
+			Version:      m.Version,
 		}
 	}
 	return habits, totalCount, nil
 }
 
+// CountMissedPeriods counts the scheduled days before today the habit was
+// missed in a row, looking back over recent logs only.
+func (r *HabitPostgresRepository) CountMissedPeriods(ctx context.Context, habitID, userID string, today time.Time) (int, error) {
+	h, err := r.GetHabit(ctx, habitID, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	vacations, err := r.ListVacations(ctx, habitID)
+	if err != nil {
+		return 0, err
+	}
+
+	var dates []time.Time
+	err = r.db.SelectContext(ctx, &dates,
+		`SELECT DISTINCT log_date FROM habit_logs WHERE habit_id = $1 AND log_date >= $2`,
+		habitID, today.AddDate(0, 0, -habit.MissedPeriodsHorizon))
+	if err != nil {
+		return 0, err
+	}
+
+	completionDates := make(map[string]bool, len(dates))
+	for _, d := range dates {
+		completionDates[d.Format("2006-01-02")] = true
+	}
+
+	return habit.NewStreakService().MissedPeriods(h, completionDates, vacations, today), nil
+}
+
 func (r *HabitPostgresRepository) unmarshalHabit(model habitModel) (*habit.Habit, error) {
 	return habit.UnmarshalHabitFromDatabase(
 		model.HabitID,
@@ -461,11 +628,26 @@ func (r *HabitPostgresRepository) unmarshalHabit(model habitModel) (*habit.Habit
 		model.TargetCount,
 		nullStringToPtr(model.ReminderTime),
 		model.IsActive,
+		model.PausedUntil,
 		model.CreatedAt,
 		model.UpdatedAt,
+		model.Version,
 	)
 }
 
+// toQueryRecurrence converts stored recurrence columns to the read model,
+// falling back to every day like UnmarshalHabitFromDatabase
+func toQueryRecurrence(days int16, interval int) query.Recurrence {
+	recurrence, err := habit.NewRecurrence(days, interval)
+	if err != nil {
+		recurrence = habit.DefaultRecurrence()
+	}
+	return query.Recurrence{
+		Days:     recurrence.DayCodes(),
+		Interval: recurrence.Interval(),
+	}
+}
+
 // nullStringToPtr converts sql.NullString to *string
 // Returns nil if NullString is not valid, otherwise returns pointer to the string value
 func nullStringToPtr(ns sql.NullString) *string {
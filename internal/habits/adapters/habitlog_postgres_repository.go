@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/lib/pq"
 	"github.com/semmidev/ethos-go/internal/common/database"
 	"github.com/semmidev/ethos-go/internal/common/model"
 	"github.com/semmidev/ethos-go/internal/habits/app/query"
@@ -35,10 +36,17 @@ func NewHabitLogPostgresRepository(db database.DBTX) *HabitLogPostgresRepository
 
 // Domain repository implementation
 
+// AddHabitLog inserts the log, or adds its count to the habit's existing
+// log for that day. The unique (habit_id, log_date) constraint makes the
+// increment atomic under concurrent logging.
 func (r *HabitLogPostgresRepository) AddHabitLog(ctx context.Context, log *habitlog.HabitLog) error {
 	q := `
 		INSERT INTO habit_logs (log_id, habit_id, user_id, log_date, count, note, created_at, updated_at)
 		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (habit_id, log_date) DO UPDATE
+		SET count = habit_logs.count + EXCLUDED.count,
+		    note = COALESCE(EXCLUDED.note, habit_logs.note),
+		    updated_at = EXCLUDED.updated_at
 	`
 	// Convert *string to sql.NullString for database insert
 	var note sql.NullString
@@ -59,10 +67,12 @@ func (r *HabitLogPostgresRepository) AddHabitLog(ctx context.Context, log *habit
 	return err
 }
 
+// GetHabitLog returns ErrNotFound both for a missing log and for another
+// user's, so callers cannot probe for logs they do not own
 func (r *HabitLogPostgresRepository) GetHabitLog(ctx context.Context, logID, userID string) (*habitlog.HabitLog, error) {
 	var model habitLogModel
-	q := `SELECT * FROM habit_logs WHERE log_id = $1`
-	err := r.db.GetContext(ctx, &model, q, logID)
+	q := `SELECT * FROM habit_logs WHERE log_id = $1 AND user_id = $2`
+	err := r.db.GetContext(ctx, &model, q, logID, userID)
 	if errors.Is(err, sql.ErrNoRows) {
 		return nil, habitlog.ErrNotFound
 	}
@@ -70,17 +80,7 @@ func (r *HabitLogPostgresRepository) GetHabitLog(ctx context.Context, logID, use
 		return nil, err
 	}
 
-	log, err := r.unmarshalHabitLog(model)
-	if err != nil {
-		return nil, err
-	}
-
-	// Authorization check
-	if err := log.CanBeViewedBy(userID); err != nil {
-		return nil, err
-	}
-
-	return log, nil
+	return r.unmarshalHabitLog(model)
 }
 
 func (r *HabitLogPostgresRepository) ListHabitLogs(ctx context.Context, habitID, userID string) ([]*habitlog.HabitLog, error) {
@@ -108,8 +108,8 @@ func (r *HabitLogPostgresRepository) UpdateHabitLog(
 	updateFn func(ctx context.Context, log *habitlog.HabitLog) (*habitlog.HabitLog, error),
 ) error {
 	var model habitLogModel
-	q := `SELECT * FROM habit_logs WHERE log_id = $1`
-	err := r.db.GetContext(ctx, &model, q, logID)
+	q := `SELECT * FROM habit_logs WHERE log_id = $1 AND user_id = $2`
+	err := r.db.GetContext(ctx, &model, q, logID, userID)
 	if errors.Is(err, sql.ErrNoRows) {
 		return habitlog.ErrNotFound
 	}
@@ -122,11 +122,6 @@ func (r *HabitLogPostgresRepository) UpdateHabitLog(
 		return err
 	}
 
-	// Authorization check
-	if err := log.CanBeModifiedBy(userID); err != nil {
-		return err
-	}
-
 	// Apply update function
 	updatedLog, err := updateFn(ctx, log)
 	if err != nil {
@@ -143,7 +138,7 @@ func (r *HabitLogPostgresRepository) UpdateHabitLog(
 	updateQuery := `
 	UPDATE habit_logs
 	SET count = $1, note = $2, log_date = $3, updated_at = $4
-	WHERE log_id = $5
+	WHERE log_id = $5 AND user_id = $6
 `
 	_, err = r.db.ExecContext(ctx, updateQuery,
 		updatedLog.Count(),
@@ -151,24 +146,30 @@ func (r *HabitLogPostgresRepository) UpdateHabitLog(
 		updatedLog.LogDate(),
 		updatedLog.UpdatedAt(),
 		logID,
+		userID,
 	)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23505" { // unique_violation
+		return habitlog.ErrDateTaken
+	}
 	return err
 }
 
 func (r *HabitLogPostgresRepository) DeleteHabitLog(ctx context.Context, logID, userID string) error {
-	// Get log first to check authorization
-	log, err := r.GetHabitLog(ctx, logID, userID)
+	q := `DELETE FROM habit_logs WHERE log_id = $1 AND user_id = $2`
+	result, err := r.db.ExecContext(ctx, q, logID, userID)
 	if err != nil {
 		return err
 	}
 
-	if err := log.CanBeModifiedBy(userID); err != nil {
+	rows, err := result.RowsAffected()
+	if err != nil {
 		return err
 	}
-
-	q := `DELETE FROM habit_logs WHERE log_id = $1`
-	_, err = r.db.ExecContext(ctx, q, logID)
-	return err
+	if rows == 0 {
+		return habitlog.ErrNotFound
+	}
+	return nil
 }
 
 func (r *HabitLogPostgresRepository) GetHabitLogByDate(
@@ -178,8 +179,8 @@ func (r *HabitLogPostgresRepository) GetHabitLogByDate(
 	userID string,
 ) (*habitlog.HabitLog, error) {
 	var model habitLogModel
-	q := `SELECT * FROM habit_logs WHERE habit_id = $1 AND log_date = $2`
-	err := r.db.GetContext(ctx, &model, q, habitID, date)
+	q := `SELECT * FROM habit_logs WHERE habit_id = $1 AND log_date = $2 AND user_id = $3`
+	err := r.db.GetContext(ctx, &model, q, habitID, date, userID)
 	if errors.Is(err, sql.ErrNoRows) {
 		return nil, habitlog.ErrNotFound
 	}
@@ -187,17 +188,122 @@ func (r *HabitLogPostgresRepository) GetHabitLogByDate(
 		return nil, err
 	}
 
-	log, err := r.unmarshalHabitLog(model)
+	return r.unmarshalHabitLog(model)
+}
+
+func (r *HabitLogPostgresRepository) GetLatestHabitLogForUpdate(
+	ctx context.Context,
+	habitID string,
+	date time.Time,
+	userID string,
+) (*habitlog.HabitLog, error) {
+	var model habitLogModel
+	q := `
+		SELECT * FROM habit_logs
+		WHERE habit_id = $1 AND log_date = $2::date AND user_id = $3
+		ORDER BY created_at DESC, log_id DESC
+		LIMIT 1
+		FOR UPDATE
+	`
+	err := r.db.GetContext(ctx, &model, q, habitID, date, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, habitlog.ErrNotFound
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	// Authorization check
-	if err := log.CanBeViewedBy(userID); err != nil {
+	return r.unmarshalHabitLog(model)
+}
+
+func (r *HabitLogPostgresRepository) ListRecentlyLoggedHabits(ctx context.Context, since time.Time) ([]habitlog.HabitRef, error) {
+	var rows []struct {
+		HabitID string `db:"habit_id"`
+		UserID  string `db:"user_id"`
+	}
+	q := `
+		SELECT DISTINCT habit_id, user_id FROM habit_logs
+		WHERE updated_at >= $1
+		ORDER BY habit_id
+	`
+	if err := r.db.SelectContext(ctx, &rows, q, since); err != nil {
+		return nil, err
+	}
+
+	refs := make([]habitlog.HabitRef, len(rows))
+	for i, row := range rows {
+		refs[i] = habitlog.HabitRef{HabitID: row.HabitID, UserID: row.UserID}
+	}
+	return refs, nil
+}
+
+func (r *HabitLogPostgresRepository) GetLogLockDays(ctx context.Context, userID string) (int, error) {
+	var days int
+	q := `SELECT COALESCE(log_lock_days, 0) FROM users WHERE user_id = $1`
+	err := r.db.GetContext(ctx, &days, q, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	return days, err
+}
+
+func (r *HabitLogPostgresRepository) ListCompactableHabits(ctx context.Context, plan string, before time.Time) ([]habitlog.HabitRef, error) {
+	var rows []struct {
+		HabitID string `db:"habit_id"`
+		UserID  string `db:"user_id"`
+	}
+	q := `
+		SELECT DISTINCT l.habit_id, l.user_id
+		FROM habit_logs l
+		JOIN users u ON u.user_id = l.user_id
+		WHERE u.plan = $1 AND l.log_date < $2::date
+		ORDER BY l.habit_id
+	`
+	if err := r.db.SelectContext(ctx, &rows, q, plan, before); err != nil {
 		return nil, err
 	}
 
-	return log, nil
+	refs := make([]habitlog.HabitRef, len(rows))
+	for i, row := range rows {
+		refs[i] = habitlog.HabitRef{HabitID: row.HabitID, UserID: row.UserID}
+	}
+	return refs, nil
+}
+
+// CompactHabitLogs deletes the logs and folds them into the summaries in
+// one statement. Summaries are added to, not replaced, so a month compacted
+// over several runs adds up.
+func (r *HabitLogPostgresRepository) CompactHabitLogs(ctx context.Context, habitID string, before time.Time) (int, error) {
+	q := `
+		WITH compacted AS (
+			DELETE FROM habit_logs
+			WHERE habit_id = $1 AND log_date < $2::date
+			RETURNING habit_id, user_id, log_date, count
+		), summarized AS (
+			INSERT INTO habit_log_monthly_summaries (habit_id, user_id, month, days_logged, total_count)
+			SELECT habit_id, user_id, date_trunc('month', log_date)::date,
+			       COUNT(DISTINCT log_date), COALESCE(SUM(count), 0)
+			FROM compacted
+			GROUP BY habit_id, user_id, date_trunc('month', log_date)
+			ON CONFLICT (habit_id, month) DO UPDATE
+			SET days_logged = habit_log_monthly_summaries.days_logged + EXCLUDED.days_logged,
+			    total_count = habit_log_monthly_summaries.total_count + EXCLUDED.total_count,
+			    updated_at = NOW()
+		)
+		SELECT COUNT(*) FROM compacted
+	`
+	var deleted int
+	if err := r.db.GetContext(ctx, &deleted, q, habitID, before); err != nil {
+		return 0, err
+	}
+	return deleted, nil
+}
+
+func (r *HabitLogPostgresRepository) GetCompactedDays(ctx context.Context, habitID string) (int, error) {
+	var days int
+	q := `SELECT COALESCE(SUM(days_logged), 0) FROM habit_log_monthly_summaries WHERE habit_id = $1`
+	err := r.db.GetContext(ctx, &days, q, habitID)
+	return days, err
 }
 
 // Query read model implementations
@@ -241,15 +347,7 @@ func (r *HabitLogPostgresRepository) GetHabitLogs(
 	}
 
 	// Build ORDER BY clause
-	orderBy := "log_date"
-	if filter.HasSort() {
-		allowedColumns := map[string]bool{
-			"log_date": true, "created_at": true, "count": true,
-		}
-		if allowedColumns[filter.SortBy] {
-			orderBy = filter.SortBy
-		}
-	}
+	orderBy := filter.SortColumn(query.HabitLogSortColumns, "log_date")
 	orderDirection := "DESC" // Default to descending for logs (most recent first)
 	if !filter.IsDesc() && filter.HasSort() {
 		orderDirection = "ASC"
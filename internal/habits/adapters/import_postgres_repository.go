@@ -0,0 +1,172 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/semmidev/ethos-go/internal/common/database"
+	"github.com/semmidev/ethos-go/internal/habits/app/query"
+	"github.com/semmidev/ethos-go/internal/habits/domain/habitimport"
+)
+
+type importModel struct {
+	ImportID       string          `db:"import_id"`
+	UserID         string          `db:"user_id"`
+	Source         string          `db:"source"`
+	Status         string          `db:"status"`
+	Habits         json.RawMessage `db:"habits"`
+	TotalHabits    int             `db:"total_habits"`
+	TotalLogs      int             `db:"total_logs"`
+	ImportedHabits int             `db:"imported_habits"`
+	ImportedLogs   int             `db:"imported_logs"`
+	Failure        *string         `db:"failure"`
+	CreatedAt      time.Time       `db:"created_at"`
+	UpdatedAt      time.Time       `db:"updated_at"`
+	CompletedAt    *time.Time      `db:"completed_at"`
+}
+
+type ImportPostgresRepository struct {
+	db database.DBTX
+}
+
+func NewImportPostgresRepository(db database.DBTX) *ImportPostgresRepository {
+	return &ImportPostgresRepository{db: db}
+}
+
+func (r *ImportPostgresRepository) AddImport(ctx context.Context, imp *habitimport.Import) error {
+	habits, err := json.Marshal(imp.Habits())
+	if err != nil {
+		return err
+	}
+
+	query := `
+        INSERT INTO habit_imports (import_id, user_id, source, status, habits, total_habits, total_logs, created_at, updated_at)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+    `
+	_, err = r.db.ExecContext(ctx, query,
+		imp.ImportID(),
+		imp.UserID(),
+		string(imp.Source()),
+		string(imp.Status()),
+		habits,
+		imp.TotalHabits(),
+		imp.TotalLogs(),
+		imp.CreatedAt(),
+		imp.UpdatedAt(),
+	)
+	return err
+}
+
+func (r *ImportPostgresRepository) GetImport(ctx context.Context, importID, userID string) (*habitimport.Import, error) {
+	return r.getImport(ctx, `SELECT * FROM habit_imports WHERE import_id = $1 AND user_id = $2`, importID, userID)
+}
+
+func (r *ImportPostgresRepository) UpdateImport(
+	ctx context.Context,
+	importID, userID string,
+	updateFn func(ctx context.Context, imp *habitimport.Import) (*habitimport.Import, error),
+) error {
+	imp, err := r.getImport(ctx, `SELECT * FROM habit_imports WHERE import_id = $1 AND user_id = $2 FOR UPDATE`, importID, userID)
+	if err != nil {
+		return err
+	}
+
+	updated, err := updateFn(ctx, imp)
+	if err != nil {
+		return err
+	}
+
+	query := `
+        UPDATE habit_imports
+        SET status = $1, imported_habits = $2, imported_logs = $3, failure = $4, updated_at = $5, completed_at = $6
+        WHERE import_id = $7 AND user_id = $8
+    `
+	_, err = r.db.ExecContext(ctx, query,
+		string(updated.Status()),
+		updated.ImportedHabits(),
+		updated.ImportedLogs(),
+		updated.Failure(),
+		updated.UpdatedAt(),
+		updated.CompletedAt(),
+		importID,
+		userID,
+	)
+	return err
+}
+
+func (r *ImportPostgresRepository) getImport(ctx context.Context, query, importID, userID string) (*habitimport.Import, error) {
+	var model importModel
+	err := r.db.GetContext(ctx, &model, query, importID, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, habitimport.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var habits []habitimport.Habit
+	if err := json.Unmarshal(model.Habits, &habits); err != nil {
+		return nil, err
+	}
+
+	return habitimport.UnmarshalImportFromDatabase(
+		model.ImportID,
+		model.UserID,
+		habitimport.Source(model.Source),
+		habitimport.Status(model.Status),
+		habits,
+		model.ImportedHabits,
+		model.ImportedLogs,
+		model.Failure,
+		model.CreatedAt,
+		model.UpdatedAt,
+		model.CompletedAt,
+	), nil
+}
+
+// GetImportQuery reads an import's progress without its parsed habits
+func (r *ImportPostgresRepository) GetImportQuery(ctx context.Context, importID, userID string) (query.HabitImport, error) {
+	var model struct {
+		ImportID       string     `db:"import_id"`
+		Source         string     `db:"source"`
+		Status         string     `db:"status"`
+		TotalHabits    int        `db:"total_habits"`
+		ImportedHabits int        `db:"imported_habits"`
+		TotalLogs      int        `db:"total_logs"`
+		ImportedLogs   int        `db:"imported_logs"`
+		Failure        *string    `db:"failure"`
+		CreatedAt      time.Time  `db:"created_at"`
+		UpdatedAt      time.Time  `db:"updated_at"`
+		CompletedAt    *time.Time `db:"completed_at"`
+	}
+	q := `
+        SELECT import_id, source, status, total_habits, imported_habits, total_logs, imported_logs,
+               failure, created_at, updated_at, completed_at
+        FROM habit_imports
+        WHERE import_id = $1 AND user_id = $2
+    `
+	err := r.db.GetContext(ctx, &model, q, importID, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return query.HabitImport{}, habitimport.ErrNotFound
+	}
+	if err != nil {
+		return query.HabitImport{}, err
+	}
+
+	return query.HabitImport{
+		ImportID:       model.ImportID,
+		Source:         model.Source,
+		Status:         model.Status,
+		TotalHabits:    model.TotalHabits,
+		ImportedHabits: model.ImportedHabits,
+		TotalLogs:      model.TotalLogs,
+		ImportedLogs:   model.ImportedLogs,
+		Failure:        model.Failure,
+		CreatedAt:      model.CreatedAt,
+		UpdatedAt:      model.UpdatedAt,
+		CompletedAt:    model.CompletedAt,
+	}, nil
+}
@@ -3,10 +3,13 @@ package adapters
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"time"
 
+	"github.com/semmidev/ethos-go/internal/common/apperror"
 	"github.com/semmidev/ethos-go/internal/common/database"
 	"github.com/semmidev/ethos-go/internal/habits/app/query"
+	"github.com/semmidev/ethos-go/internal/habits/domain/habit"
 )
 
 // StatsRepository handles statistics calculations
@@ -20,6 +23,15 @@ func NewStatsRepository(db database.DBTX) *StatsRepository {
 
 // GetHabitStats calculates statistics for a single habit
 func (r *StatsRepository) GetHabitStats(ctx context.Context, habitID, userID string) (*query.HabitStats, error) {
+	weekStart, err := r.userWeekStart(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return r.habitStats(ctx, habitID, userID, weekStart)
+}
+
+// habitStats calculates a habit's statistics with weeks beginning on weekStart
+func (r *StatsRepository) habitStats(ctx context.Context, habitID, userID string, weekStart time.Weekday) (*query.HabitStats, error) {
 	// Get habit info
 	var habitName string
 	err := r.db.GetContext(ctx, &habitName, `SELECT name FROM habits WHERE habit_id = $1 AND user_id = $2`, habitID, userID)
@@ -35,9 +47,11 @@ func (r *StatsRepository) GetHabitStats(ctx context.Context, habitID, userID str
 		HabitName: habitName,
 	}
 
-	// Total completions
-	err = r.db.GetContext(ctx, &stats.TotalCompletions,
-		`SELECT COALESCE(SUM(count), 0) FROM habit_logs WHERE habit_id = $1`, habitID)
+	// Total completions, including logs compacted into monthly summaries
+	err = r.db.GetContext(ctx, &stats.TotalCompletions, `
+		SELECT (SELECT COALESCE(SUM(count), 0) FROM habit_logs WHERE habit_id = $1)
+		     + (SELECT COALESCE(SUM(total_count), 0) FROM habit_log_monthly_summaries WHERE habit_id = $1)
+	`, habitID)
 	if err != nil {
 		return nil, err
 	}
@@ -53,15 +67,20 @@ func (r *StatsRepository) GetHabitStats(ctx context.Context, habitID, userID str
 		stats.LastLogDate = &lastDate.Time
 	}
 
+	// Vacation days neither count towards nor break streaks
+	vacations, err := r.listVacationPeriods(ctx, habitID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Current streak and longest streak
-	stats.CurrentStreak = r.calculateCurrentStreak(ctx, habitID)
-	stats.LongestStreak = r.calculateLongestStreak(ctx, habitID)
+	stats.CurrentStreak = r.calculateCurrentStreak(ctx, habitID, vacations)
+	stats.LongestStreak = r.calculateLongestStreak(ctx, habitID, vacations)
 
 	// This week count
-	weekStart := startOfWeek(time.Now())
 	err = r.db.GetContext(ctx, &stats.ThisWeekCount,
 		`SELECT COALESCE(SUM(count), 0) FROM habit_logs WHERE habit_id = $1 AND log_date >= $2`,
-		habitID, weekStart)
+		habitID, startOfWeek(time.Now(), weekStart))
 	if err != nil {
 		return nil, err
 	}
@@ -75,16 +94,27 @@ func (r *StatsRepository) GetHabitStats(ctx context.Context, habitID, userID str
 		return nil, err
 	}
 
-	// Completion rate (last 30 days)
+	// Completion rate (last 30 days, excluding vacation days)
 	thirtyDaysAgo := time.Now().AddDate(0, 0, -30)
-	var daysLogged int
-	err = r.db.GetContext(ctx, &daysLogged,
-		`SELECT COUNT(DISTINCT log_date) FROM habit_logs WHERE habit_id = $1 AND log_date >= $2`,
+	var loggedDates []time.Time
+	err = r.db.SelectContext(ctx, &loggedDates,
+		`SELECT DISTINCT log_date FROM habit_logs WHERE habit_id = $1 AND log_date >= $2`,
 		habitID, thirtyDaysAgo)
 	if err != nil {
 		return nil, err
 	}
-	stats.CompletionRate = float64(daysLogged) / 30.0 * 100.0
+	daysLogged := 0
+	for _, date := range loggedDates {
+		if !vacations.covers(date) {
+			daysLogged++
+		}
+	}
+	expectedDays := 30 - vacations.daysBetween(thirtyDaysAgo, time.Now())
+	if expectedDays > 0 {
+		stats.CompletionRate = float64(daysLogged) / float64(expectedDays) * 100.0
+	} else {
+		stats.CompletionRate = 100.0 // Whole window on vacation
+	}
 
 	return stats, nil
 }
@@ -95,8 +125,13 @@ func (r *StatsRepository) GetDashboard(ctx context.Context, userID string) (*que
 		HabitSummaries: []query.HabitStats{},
 	}
 
+	userWeekStart, err := r.userWeekStart(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Total active habits
-	err := r.db.GetContext(ctx, &summary.TotalActiveHabits,
+	err = r.db.GetContext(ctx, &summary.TotalActiveHabits,
 		`SELECT COUNT(*) FROM habits WHERE user_id = $1 AND is_active = true`, userID)
 	if err != nil {
 		return nil, err
@@ -112,7 +147,7 @@ func (r *StatsRepository) GetDashboard(ctx context.Context, userID string) (*que
 	}
 
 	// This week's completions
-	weekStart := startOfWeek(time.Now())
+	weekStart := startOfWeek(time.Now(), userWeekStart)
 	err = r.db.GetContext(ctx, &summary.TotalCompletionsWeek,
 		`SELECT COALESCE(SUM(count), 0) FROM habit_logs WHERE user_id = $1 AND log_date >= $2`,
 		userID, weekStart)
@@ -129,9 +164,11 @@ func (r *StatsRepository) GetDashboard(ctx context.Context, userID string) (*que
 		return nil, err
 	}
 
-	// Total logs all time
-	err = r.db.GetContext(ctx, &summary.TotalLogs,
-		`SELECT COALESCE(COUNT(*), 0) FROM habit_logs WHERE user_id = $1`, userID)
+	// Total logs all time; a compacted log is one logged day of a summary
+	err = r.db.GetContext(ctx, &summary.TotalLogs, `
+		SELECT (SELECT COUNT(*) FROM habit_logs WHERE user_id = $1)
+		     + (SELECT COALESCE(SUM(days_logged), 0) FROM habit_log_monthly_summaries WHERE user_id = $1)
+	`, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -148,7 +185,7 @@ func (r *StatsRepository) GetDashboard(ctx context.Context, userID string) (*que
 	maxCurrentStreak := 0
 	maxLongestStreak := 0
 	for _, habitID := range habitIDs {
-		habitStats, err := r.GetHabitStats(ctx, habitID, userID)
+		habitStats, err := r.habitStats(ctx, habitID, userID, userWeekStart)
 		if err != nil {
 			continue // Skip habits with errors
 		}
@@ -183,19 +220,89 @@ func (r *StatsRepository) GetDashboard(ctx context.Context, userID string) (*que
 
 // Helper methods for streak calculation
 
-func (r *StatsRepository) calculateCurrentStreak(ctx context.Context, habitID string) int {
+// vacationPeriod is a habit vacation; EndDate is nil while ongoing.
+type vacationPeriod struct {
+	StartDate time.Time  `db:"start_date"`
+	EndDate   *time.Time `db:"end_date"`
+}
+
+type vacationPeriods []vacationPeriod
+
+func (r *StatsRepository) listVacationPeriods(ctx context.Context, habitID string) (vacationPeriods, error) {
+	var periods vacationPeriods
+	err := r.db.SelectContext(ctx, &periods,
+		`SELECT start_date, end_date FROM habit_vacations WHERE habit_id = $1`, habitID)
+	return periods, err
+}
+
+// covers reports whether day falls within any vacation.
+func (p vacationPeriods) covers(day time.Time) bool {
+	day = day.Truncate(24 * time.Hour)
+	for _, v := range p {
+		if day.Before(v.StartDate) {
+			continue
+		}
+		if v.EndDate == nil || !day.After(*v.EndDate) {
+			return true
+		}
+	}
+	return false
+}
+
+// daysBetween counts vacation days in the range (from, to].
+func (p vacationPeriods) daysBetween(from, to time.Time) int {
+	if len(p) == 0 {
+		return 0
+	}
+	count := 0
+	for day := to.Truncate(24 * time.Hour); day.After(from); day = day.AddDate(0, 0, -1) {
+		if p.covers(day) {
+			count++
+		}
+	}
+	return count
+}
+
+// previousDay returns the last non-vacation day before day.
+func (p vacationPeriods) previousDay(day time.Time) time.Time {
+	day = day.AddDate(0, 0, -1)
+	for p.covers(day) {
+		day = day.AddDate(0, 0, -1)
+	}
+	return day
+}
+
+// withoutVacationDays drops log dates that fall on vacation days.
+func (p vacationPeriods) withoutVacationDays(dates []time.Time) []time.Time {
+	if len(p) == 0 {
+		return dates
+	}
+	kept := dates[:0]
+	for _, date := range dates {
+		if !p.covers(date) {
+			kept = append(kept, date)
+		}
+	}
+	return kept
+}
+
+func (r *StatsRepository) calculateCurrentStreak(ctx context.Context, habitID string, vacations vacationPeriods) int {
 	// Get all log dates in descending order
 	var dates []time.Time
 	err := r.db.SelectContext(ctx, &dates,
 		`SELECT DISTINCT log_date FROM habit_logs WHERE habit_id = $1 ORDER BY log_date DESC LIMIT 365`,
 		habitID)
+	dates = vacations.withoutVacationDays(dates)
 	if err != nil || len(dates) == 0 {
 		return 0
 	}
 
-	// Check if the most recent log is today or yesterday
+	// Check if the most recent log is on the latest non-vacation day or the one before it
 	today := time.Now().Truncate(24 * time.Hour)
-	yesterday := today.AddDate(0, 0, -1)
+	if vacations.covers(today) {
+		today = vacations.previousDay(today)
+	}
+	yesterday := vacations.previousDay(today)
 
 	if !dates[0].Equal(today) && !dates[0].Equal(yesterday) {
 		return 0 // Streak is broken
@@ -204,7 +311,7 @@ func (r *StatsRepository) calculateCurrentStreak(ctx context.Context, habitID st
 	// Count consecutive days
 	streak := 1
 	for i := 1; i < len(dates); i++ {
-		expectedDate := dates[i-1].AddDate(0, 0, -1)
+		expectedDate := vacations.previousDay(dates[i-1])
 		if dates[i].Equal(expectedDate) {
 			streak++
 		} else {
@@ -215,11 +322,12 @@ func (r *StatsRepository) calculateCurrentStreak(ctx context.Context, habitID st
 	return streak
 }
 
-func (r *StatsRepository) calculateLongestStreak(ctx context.Context, habitID string) int {
+func (r *StatsRepository) calculateLongestStreak(ctx context.Context, habitID string, vacations vacationPeriods) int {
 	var dates []time.Time
 	err := r.db.SelectContext(ctx, &dates,
 		`SELECT DISTINCT log_date FROM habit_logs WHERE habit_id = $1 ORDER BY log_date ASC`,
 		habitID)
+	dates = vacations.withoutVacationDays(dates)
 	if err != nil || len(dates) == 0 {
 		return 0
 	}
@@ -228,8 +336,7 @@ func (r *StatsRepository) calculateLongestStreak(ctx context.Context, habitID st
 	currentStreak := 1
 
 	for i := 1; i < len(dates); i++ {
-		expectedDate := dates[i-1].AddDate(0, 0, 1)
-		if dates[i].Equal(expectedDate) {
+		if vacations.previousDay(dates[i]).Equal(dates[i-1]) {
 			currentStreak++
 			if currentStreak > maxStreak {
 				maxStreak = currentStreak
@@ -242,15 +349,22 @@ func (r *StatsRepository) calculateLongestStreak(ctx context.Context, habitID st
 	return maxStreak
 }
 
-// GetWeeklyAnalytics returns completion data for the last 7 days
+// GetWeeklyAnalytics returns completion data for each day of the user's
+// current week. Days still ahead are reported empty and left out of the
+// average.
 func (r *StatsRepository) GetWeeklyAnalytics(ctx context.Context, userID string) (*query.WeeklyAnalytics, error) {
 	analytics := &query.WeeklyAnalytics{
 		Days: make([]query.DailyAnalytics, 0, 7),
 	}
 
+	weekStart, err := r.userWeekStart(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Get total active habits count for calculating percentages
 	var activeHabitsCount int
-	err := r.db.GetContext(ctx, &activeHabitsCount,
+	err = r.db.GetContext(ctx, &activeHabitsCount,
 		`SELECT COUNT(*) FROM habits WHERE user_id = $1 AND is_active = true`, userID)
 	if err != nil {
 		return nil, err
@@ -261,15 +375,23 @@ func (r *StatsRepository) GetWeeklyAnalytics(ctx context.Context, userID string)
 		activeHabitsCount = 1 // Avoid division by zero
 	}
 
-	// Get logs for each of the last 7 days
+	// Get logs for each day of the week so far
 	today := time.Now().Truncate(24 * time.Hour)
+	start := startOfWeek(today, weekStart)
 	totalCompletion := 0
+	elapsedDays := 0
 
-	for i := 6; i >= 0; i-- {
-		day := today.AddDate(0, 0, -i)
+	for i := 0; i < 7; i++ {
+		day := start.AddDate(0, 0, i)
 		dayName := day.Format("Mon")
 		dateStr := day.Format("2006-01-02")
 
+		if day.After(today) {
+			analytics.Days = append(analytics.Days, query.DailyAnalytics{DayName: dayName, Date: dateStr})
+			continue
+		}
+		elapsedDays++
+
 		var logsCount int
 		err := r.db.GetContext(ctx, &logsCount,
 			`SELECT COUNT(DISTINCT habit_id) FROM habit_logs WHERE user_id = $1 AND log_date = $2`,
@@ -293,49 +415,344 @@ func (r *StatsRepository) GetWeeklyAnalytics(ctx context.Context, userID string)
 		})
 	}
 
-	analytics.AverageCompletion = totalCompletion / 7
+	analytics.AverageCompletion = totalCompletion / elapsedDays
 
 	return analytics, nil
 }
 
-// GetHabitsDueForReminder returns habits that are active, daily, have no logs for today,
-// and either have reminder_time matching the current time in user's timezone, or have NULL reminder_time at 8 PM user's local time.
-func (r *StatsRepository) GetHabitsDueForReminder(ctx context.Context) ([]query.ReminderHabit, error) {
+// GetHabitLogCounts counts a habit's logs by weekday of the log date and by
+// the hour they were made in the user's timezone
+func (r *StatsRepository) GetHabitLogCounts(ctx context.Context, habitID, userID string) (*query.HabitLogCounts, error) {
+	counts := &query.HabitLogCounts{HabitID: habitID}
+
+	err := r.db.GetContext(ctx, &counts.HabitName,
+		`SELECT name FROM habits WHERE habit_id = $1 AND user_id = $2`, habitID, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apperror.NotFound("habit", habitID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []struct {
+		Weekday int `db:"weekday"`
+		Hour    int `db:"hour"`
+		Logs    int `db:"logs"`
+	}
+	err = r.db.SelectContext(ctx, &rows, `
+		SELECT
+			EXTRACT(DOW FROM l.log_date)::int AS weekday,
+			EXTRACT(HOUR FROM l.created_at AT TIME ZONE COALESCE(u.timezone, 'UTC'))::int AS hour,
+			COUNT(*) AS logs
+		FROM habit_logs l
+		JOIN users u ON u.user_id = l.user_id
+		WHERE l.habit_id = $1 AND l.user_id = $2
+		GROUP BY 1, 2
+	`, habitID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		counts.ByWeekday[row.Weekday] += row.Logs
+		counts.ByHour[row.Hour] += row.Logs
+	}
+	return counts, nil
+}
+
+// GetHabitLogDays returns the distinct days from start through end on
+// which each of the user's active habits was logged
+func (r *StatsRepository) GetHabitLogDays(ctx context.Context, userID string, start, end time.Time) ([]query.HabitLogDays, error) {
+	var rows []struct {
+		HabitID   string    `db:"habit_id"`
+		HabitName string    `db:"name"`
+		LogDate   time.Time `db:"log_date"`
+	}
+	err := r.db.SelectContext(ctx, &rows, `
+		SELECT h.habit_id, h.name, l.log_date
+		FROM habits h
+		JOIN habit_logs l ON l.habit_id = h.habit_id
+		WHERE h.user_id = $1 AND h.is_active = true
+		  AND l.log_date BETWEEN $2::date AND $3::date
+		GROUP BY h.habit_id, h.name, h.position, h.created_at, l.log_date
+		ORDER BY h.position, h.created_at, l.log_date
+	`, userID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	var habits []query.HabitLogDays
+	for _, row := range rows {
+		if len(habits) == 0 || habits[len(habits)-1].HabitID != row.HabitID {
+			habits = append(habits, query.HabitLogDays{HabitID: row.HabitID, HabitName: row.HabitName})
+		}
+		last := &habits[len(habits)-1]
+		last.Days = append(last.Days, row.LogDate)
+	}
+	return habits, nil
+}
+
+// GetPeriodTotals returns per-habit totals of the current week or month
+// so far and of the same number of days from the start of the previous
+// one, so partial periods are compared like for like
+func (r *StatsRepository) GetPeriodTotals(ctx context.Context, userID, period string) (*query.PeriodTotals, *query.PeriodTotals, error) {
+	today := time.Now().Truncate(24 * time.Hour)
+
+	var start, previousStart time.Time
+	switch period {
+	case query.PeriodWeek:
+		weekStart, err := r.userWeekStart(ctx, userID)
+		if err != nil {
+			return nil, nil, err
+		}
+		start = startOfWeek(today, weekStart)
+		previousStart = start.AddDate(0, 0, -7)
+	default:
+		start = startOfMonth(today)
+		previousStart = start.AddDate(0, -1, 0)
+	}
+
+	elapsed := int(today.Sub(start).Hours() / 24)
+	previousEnd := previousStart.AddDate(0, 0, elapsed)
+	if !previousEnd.Before(start) {
+		previousEnd = start.AddDate(0, 0, -1)
+	}
+
+	current, err := r.periodTotals(ctx, userID, start, today)
+	if err != nil {
+		return nil, nil, err
+	}
+	previous, err := r.periodTotals(ctx, userID, previousStart, previousEnd)
+	if err != nil {
+		return nil, nil, err
+	}
+	return current, previous, nil
+}
+
+// periodTotals sums completions, logged days and the longest run of
+// consecutive logged days per habit from start through end. Active habits
+// are listed even without logs; inactive ones only when logged.
+func (r *StatsRepository) periodTotals(ctx context.Context, userID string, start, end time.Time) (*query.PeriodTotals, error) {
+	totals := &query.PeriodTotals{StartDate: start, EndDate: end}
+
+	sqlQuery := `
+		WITH logs AS (
+			SELECT habit_id, log_date, SUM(count) AS count
+			FROM habit_logs
+			WHERE user_id = $1 AND log_date BETWEEN $2::date AND $3::date
+			GROUP BY habit_id, log_date
+		), runs AS (
+			SELECT habit_id, COUNT(*) AS length
+			FROM (
+				SELECT habit_id, log_date - (ROW_NUMBER() OVER (PARTITION BY habit_id ORDER BY log_date))::int AS run_start
+				FROM logs
+			) numbered
+			GROUP BY habit_id, run_start
+		)
+		SELECT
+			h.habit_id,
+			h.name,
+			COALESCE((SELECT SUM(l.count) FROM logs l WHERE l.habit_id = h.habit_id), 0) AS completions,
+			(SELECT COUNT(*) FROM logs l WHERE l.habit_id = h.habit_id) AS days_logged,
+			COALESCE((SELECT MAX(length) FROM runs r WHERE r.habit_id = h.habit_id), 0) AS longest_streak
+		FROM habits h
+		WHERE h.user_id = $1
+		  AND (h.is_active OR EXISTS (SELECT 1 FROM logs l WHERE l.habit_id = h.habit_id))
+		ORDER BY h.position, h.created_at
+	`
+
+	if err := r.db.SelectContext(ctx, &totals.Habits, sqlQuery, userID, start, end); err != nil {
+		return nil, err
+	}
+	return totals, nil
+}
+
+// GetHabitsDueForReminder returns habits of active accounts that are active, not paused and daily, whose next
+// reminder is due at now but not before since, and that have no logs for the local day the reminder is for.
+// Reminders scheduled in a timezone the user has since left are skipped until they are rescheduled.
+func (r *StatsRepository) GetHabitsDueForReminder(ctx context.Context, now, since time.Time) ([]query.ReminderHabit, error) {
 	var habits []query.ReminderHabit
-	today := time.Now().UTC().Truncate(24 * time.Hour)
 
-	// Use PostgreSQL timezone functions to compare reminder_time with current time in user's timezone
-	// The key is: TO_CHAR(NOW() AT TIME ZONE u.timezone, 'HH24:MI') gives current time in user's local timezone
 	sqlQuery := `
-		SELECT h.user_id, h.habit_id, h.name, h.reminder_time
+		SELECT h.user_id, h.habit_id, h.name, h.reminder_time, h.target_count, u.locale,
+		       (h.next_reminder_at AT TIME ZONE h.reminder_timezone)::date AS local_date
 		FROM habits h
 		JOIN users u ON h.user_id = u.user_id
-		LEFT JOIN habit_logs l ON h.habit_id = l.habit_id AND l.log_date = $1
-		WHERE h.is_active = true
+		WHERE u.is_active = true
+		  AND h.is_active = true
+		  AND h.paused_until IS NULL
 		  AND h.frequency = 'daily'
-		  AND l.habit_id IS NULL
-		  AND (
-		      -- Habit has custom reminder_time and it matches current time in user's timezone
-		      (h.reminder_time IS NOT NULL AND h.reminder_time = TO_CHAR(NOW() AT TIME ZONE COALESCE(u.timezone, 'UTC'), 'HH24:MI'))
-		      OR
-		      -- Habit has no custom reminder_time and it's 8 PM in user's timezone (default)
-		      (h.reminder_time IS NULL AND TO_CHAR(NOW() AT TIME ZONE COALESCE(u.timezone, 'UTC'), 'HH24:MI') = '20:00')
+		  AND h.next_reminder_at <= $1
+		  AND h.next_reminder_at > $2
+		  AND h.reminder_timezone = COALESCE(u.timezone, 'UTC')
+		  AND NOT EXISTS (
+		      SELECT 1 FROM habit_logs l
+		      WHERE l.habit_id = h.habit_id
+		        AND l.log_date = (h.next_reminder_at AT TIME ZONE h.reminder_timezone)::date
 		  )
 	`
 
-	err := r.db.SelectContext(ctx, &habits, sqlQuery, today)
+	err := r.db.SelectContext(ctx, &habits, sqlQuery, now, since)
 	return habits, err
 }
 
+// ListRemindersToSchedule returns the active daily habits of active accounts whose next reminder is not
+// scheduled, was scheduled in a timezone the user has since left, or is not after now
+func (r *StatsRepository) ListRemindersToSchedule(ctx context.Context, now time.Time) ([]habit.ReminderSchedule, error) {
+	var rows []struct {
+		HabitID      string         `db:"habit_id"`
+		ReminderTime sql.NullString `db:"reminder_time"`
+		Timezone     string         `db:"timezone"`
+		Rescheduled  bool           `db:"rescheduled"`
+	}
+
+	sqlQuery := `
+		SELECT h.habit_id, h.reminder_time, COALESCE(u.timezone, 'UTC') AS timezone,
+		       (h.next_reminder_at IS NULL OR h.reminder_timezone IS DISTINCT FROM COALESCE(u.timezone, 'UTC')) AS rescheduled
+		FROM habits h
+		JOIN users u ON h.user_id = u.user_id
+		WHERE u.is_active = true
+		  AND h.is_active = true
+		  AND h.frequency = 'daily'
+		  AND (h.next_reminder_at IS NULL
+		       OR h.next_reminder_at <= $1
+		       OR h.reminder_timezone IS DISTINCT FROM COALESCE(u.timezone, 'UTC'))
+	`
+
+	if err := r.db.SelectContext(ctx, &rows, sqlQuery, now); err != nil {
+		return nil, err
+	}
+
+	schedules := make([]habit.ReminderSchedule, 0, len(rows))
+	for _, row := range rows {
+		schedule := habit.ReminderSchedule{
+			HabitID:     row.HabitID,
+			Timezone:    row.Timezone,
+			Rescheduled: row.Rescheduled,
+		}
+		if row.ReminderTime.Valid {
+			schedule.ReminderTime = &row.ReminderTime.String
+		}
+		schedules = append(schedules, schedule)
+	}
+	return schedules, nil
+}
+
+// SetNextReminder stores when the habit is next reminded, and the timezone that was computed in
+func (r *StatsRepository) SetNextReminder(ctx context.Context, habitID string, at time.Time, timezone string) error {
+	_, err := r.db.ExecContext(ctx,
+		`UPDATE habits SET next_reminder_at = $1, reminder_timezone = $2 WHERE habit_id = $3`,
+		at, timezone, habitID,
+	)
+	return err
+}
+
+// GetDailySummaries counts, per active user whose local hour at now is
+// fromHour or later, the active daily habits scheduled for their local today and how
+// many of those reached their target count.
+func (r *StatsRepository) GetDailySummaries(ctx context.Context, now time.Time, fromHour int) ([]query.DailySummary, error) {
+	var summaries []query.DailySummary
+
+	sqlQuery := `
+		WITH local AS (
+			SELECT user_id, locale,
+			       COALESCE(timezone, 'UTC') AS timezone,
+			       ($1::timestamptz AT TIME ZONE COALESCE(timezone, 'UTC')) AS local_now
+			FROM users
+			WHERE is_active = true
+		)
+		SELECT l.user_id, l.timezone, l.locale,
+		       COUNT(*) AS total,
+		       COUNT(*) FILTER (WHERE COALESCE((
+		           SELECT SUM(hl.count) FROM habit_logs hl
+		           WHERE hl.habit_id = h.habit_id AND hl.log_date = l.local_now::date
+		       ), 0) >= h.target_count) AS completed
+		FROM local l
+		JOIN habits h ON h.user_id = l.user_id
+		WHERE EXTRACT(HOUR FROM l.local_now) >= $2
+		  AND h.is_active = true
+		  AND h.paused_until IS NULL
+		  AND h.frequency = 'daily'
+		  AND (COALESCE(h.recurrence_days, 127) & (1 << EXTRACT(DOW FROM l.local_now)::int)) <> 0
+		  AND (COALESCE(h.recurrence_interval, 1) <= 1
+		       OR (l.local_now::date - h.created_at::date) % h.recurrence_interval = 0)
+		GROUP BY l.user_id, l.timezone, l.locale
+	`
+
+	err := r.db.SelectContext(ctx, &summaries, sqlQuery, now, fromHour)
+	return summaries, err
+}
+
+// GetInactiveUsers returns active users whose latest log is before since,
+// together with their active habit that reached the longest streak. Users
+// with every habit paused or archived are left alone.
+func (r *StatsRepository) GetInactiveUsers(ctx context.Context, since time.Time) ([]query.InactiveUser, error) {
+	var users []query.InactiveUser
+
+	sqlQuery := `
+		SELECT a.user_id, a.last_log_date, top.habit_id, top.habit_name, top.streak
+		FROM (
+			SELECT l.user_id, MAX(l.log_date) AS last_log_date
+			FROM habit_logs l
+			JOIN users u ON u.user_id = l.user_id
+			WHERE u.is_active = true
+			GROUP BY l.user_id
+			HAVING MAX(l.log_date) < $1::date
+		) a
+		JOIN LATERAL (
+			SELECT h.habit_id, h.name AS habit_name, COALESCE(s.longest_streak, 0) AS streak
+			FROM habits h
+			LEFT JOIN habit_stats s ON s.habit_id = h.habit_id
+			WHERE h.user_id = a.user_id
+			  AND h.is_active = true
+			  AND h.paused_until IS NULL
+			ORDER BY COALESCE(s.longest_streak, 0) DESC, h.created_at
+			LIMIT 1
+		) top ON true
+		ORDER BY a.user_id
+	`
+
+	err := r.db.SelectContext(ctx, &users, sqlQuery, since)
+	return users, err
+}
+
+// GetPublicStats counts platform-wide totals for the public landing page.
+// Only aggregates are selected so no per-user data can leak.
+func (r *StatsRepository) GetPublicStats(ctx context.Context, weekStart time.Time) (*query.PublicStats, error) {
+	var stats query.PublicStats
+
+	sqlQuery := `
+		SELECT
+			(SELECT COUNT(*) FROM users WHERE is_active = true) AS total_users,
+			(SELECT COUNT(*) FROM habits) AS habits_tracked,
+			(SELECT COUNT(*) FROM habit_logs WHERE log_date >= $1::date) AS logs_this_week
+	`
+
+	if err := r.db.GetContext(ctx, &stats, sqlQuery, weekStart); err != nil {
+		return nil, err
+	}
+	return &stats, nil
+}
+
 // Time helper functions
 
-func startOfWeek(t time.Time) time.Time {
-	// Start of week (Monday)
-	weekday := int(t.Weekday())
-	if weekday == 0 {
-		weekday = 7 // Sunday
+// startOfWeek returns the first day of t's week for weeks beginning on weekStart
+func startOfWeek(t time.Time, weekStart time.Weekday) time.Time {
+	offset := (int(t.Weekday()) - int(weekStart) + 7) % 7
+	return t.AddDate(0, 0, -offset).Truncate(24 * time.Hour)
+}
+
+// userWeekStart returns the day the user's weeks begin on, Monday by default
+func (r *StatsRepository) userWeekStart(ctx context.Context, userID string) (time.Weekday, error) {
+	var day int
+	err := r.db.GetContext(ctx, &day, `SELECT week_start FROM users WHERE user_id = $1`, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return time.Monday, nil
+	}
+	if err != nil {
+		return 0, err
 	}
-	return t.AddDate(0, 0, -(weekday - 1)).Truncate(24 * time.Hour)
+	return time.Weekday(day), nil
 }
 
 func startOfMonth(t time.Time) time.Time {
@@ -0,0 +1,54 @@
+package task
+
+import (
+	"context"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/habits/app/command"
+)
+
+// TaskCompactLogs compacts habit logs past their plan's retention
+const TaskCompactLogs = "habits:compact_logs"
+
+// NewCompactLogsTask creates a new task for compacting old habit logs.
+func NewCompactLogsTask() *asynq.Task {
+	return asynq.NewTask(TaskCompactLogs, nil)
+}
+
+// CompactLogsProcessor handles the execution of habit log compaction.
+type CompactLogsProcessor struct {
+	handler command.CompactHabitLogsHandler
+	log     logger.Logger
+}
+
+// NewCompactLogsProcessor creates a new processor instance with required dependencies.
+func NewCompactLogsProcessor(
+	handler command.CompactHabitLogsHandler,
+	log logger.Logger,
+) *CompactLogsProcessor {
+	return &CompactLogsProcessor{
+		handler: handler,
+		log:     log,
+	}
+}
+
+// ProcessTask implements the asynq.Handler interface.
+func (p *CompactLogsProcessor) ProcessTask(ctx context.Context, t *asynq.Task) error {
+	p.log.Info(ctx, "starting habit log compaction",
+		logger.Field{Key: "task_id", Value: t.ResultWriter().TaskID()},
+	)
+
+	result, err := p.handler.Handle(ctx, command.CompactHabitLogs{Now: time.Now()})
+	if err != nil {
+		p.log.Error(ctx, err, "failed to compact habit logs")
+		return err
+	}
+
+	p.log.Info(ctx, "habit log compaction finished",
+		logger.Field{Key: "habits", Value: result.Habits},
+		logger.Field{Key: "logs", Value: result.Logs},
+	)
+	return nil
+}
@@ -2,49 +2,72 @@ package task
 
 import (
 	"context"
-	"encoding/json"
 
-	"github.com/hibiken/asynq"
+	"github.com/semmidev/ethos-go/internal/common/i18n"
 	"github.com/semmidev/ethos-go/internal/common/logger"
+	commontask "github.com/semmidev/ethos-go/internal/common/task"
 	domaintask "github.com/semmidev/ethos-go/internal/habits/domain/task"
 )
 
 // Task constants for habits module
-const TaskHabitCreated = "habits:created"
+const (
+	TaskHabitCreated = "habits:created"
+	TaskRunImport    = "habits:import"
+)
 
 // HabitCreatedPayload contains data for the habit created task
 type HabitCreatedPayload struct {
 	HabitID string `json:"habit_id"`
 	UserID  string `json:"user_id"`
 	Name    string `json:"name"`
+	Locale  string `json:"locale"` // locale of the request that created the habit
+}
+
+// ImportPayload contains data for the import task
+type ImportPayload struct {
+	ImportID string `json:"import_id"`
+	UserID   string `json:"user_id"`
 }
 
-// AsynqTaskDispatcher dispatches habit-related tasks to the queue
-type AsynqTaskDispatcher struct {
-	client *asynq.Client
+// TaskDispatcher dispatches habit-related tasks to the shared task queue
+type TaskDispatcher struct {
+	tasks  commontask.Dispatcher
 	logger logger.Logger
 }
 
-// Ensure AsynqTaskDispatcher implements domaintask.TaskDispatcher
-var _ domaintask.TaskDispatcher = (*AsynqTaskDispatcher)(nil)
+// Ensure TaskDispatcher implements domaintask.TaskDispatcher
+var _ domaintask.TaskDispatcher = (*TaskDispatcher)(nil)
 
-func NewAsynqTaskDispatcher(client *asynq.Client, logger logger.Logger) *AsynqTaskDispatcher {
-	return &AsynqTaskDispatcher{client: client, logger: logger}
+func NewTaskDispatcher(tasks commontask.Dispatcher, logger logger.Logger) *TaskDispatcher {
+	return &TaskDispatcher{tasks: tasks, logger: logger}
 }
 
-func (d *AsynqTaskDispatcher) DispatchHabitCreated(ctx context.Context, habitID, userID, name string) error {
-	payload, err := json.Marshal(HabitCreatedPayload{HabitID: habitID, UserID: userID, Name: name})
-	if err != nil {
-		return err
+func (d *TaskDispatcher) DispatchHabitCreated(ctx context.Context, habitID, userID, name string) error {
+	payload := HabitCreatedPayload{
+		HabitID: habitID,
+		UserID:  userID,
+		Name:    name,
+		Locale:  i18n.FromContext(ctx),
 	}
 
-	task := asynq.NewTask(TaskHabitCreated, payload)
-	info, err := d.client.EnqueueContext(ctx, task)
-	if err != nil {
+	if err := d.tasks.Enqueue(ctx, TaskHabitCreated, payload); err != nil {
 		d.logger.Error(ctx, err, "failed to enqueue habit created task")
 		return err
 	}
 
-	d.logger.Info(ctx, "dispatched habit created task", logger.Field{Key: "task_id", Value: info.ID})
+	d.logger.Info(ctx, "dispatched habit created task", logger.Field{Key: "habit_id", Value: habitID})
+	return nil
+}
+
+func (d *TaskDispatcher) DispatchImport(ctx context.Context, importID, userID string) error {
+	payload := ImportPayload{ImportID: importID, UserID: userID}
+
+	// Imports can be large; allow them longer than the default timeout
+	if err := d.tasks.Enqueue(ctx, TaskRunImport, payload, commontask.Timeout(importTimeout)); err != nil {
+		d.logger.Error(ctx, err, "failed to enqueue import task")
+		return err
+	}
+
+	d.logger.Info(ctx, "dispatched import task", logger.Field{Key: "import_id", Value: importID})
 	return nil
 }
@@ -0,0 +1,57 @@
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/habits/app/command"
+)
+
+// importTimeout bounds one attempt at writing an import. Retries resume
+// where the previous attempt stopped.
+const importTimeout = 30 * time.Minute
+
+// ImportProcessor writes queued imports as habits and logs.
+type ImportProcessor struct {
+	handler command.RunImportHandler
+	log     logger.Logger
+}
+
+// NewImportProcessor creates a new processor instance with required dependencies.
+func NewImportProcessor(
+	handler command.RunImportHandler,
+	log logger.Logger,
+) *ImportProcessor {
+	return &ImportProcessor{
+		handler: handler,
+		log:     log,
+	}
+}
+
+// ProcessTask implements the asynq.Handler interface.
+func (p *ImportProcessor) ProcessTask(ctx context.Context, t *asynq.Task) error {
+	var payload ImportPayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to parse task payload: %w", err)
+	}
+
+	p.log.Info(ctx, "starting import",
+		logger.Field{Key: "task_id", Value: t.ResultWriter().TaskID()},
+		logger.Field{Key: "import_id", Value: payload.ImportID},
+	)
+
+	err := p.handler.Handle(ctx, command.RunImport{
+		ImportID: payload.ImportID,
+		UserID:   payload.UserID,
+	})
+	if err != nil {
+		p.log.Error(ctx, err, "failed to run import", logger.Field{Key: "import_id", Value: payload.ImportID})
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package task
+
+import (
+	"context"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/habits/app/command"
+)
+
+// TaskResumePausedHabits reactivates habits whose pause has ended
+const TaskResumePausedHabits = "habits:resume_paused"
+
+// NewResumePausedHabitsTask creates a new task for resuming paused habits.
+func NewResumePausedHabitsTask() *asynq.Task {
+	return asynq.NewTask(TaskResumePausedHabits, nil)
+}
+
+// ResumePausedHabitsProcessor handles the execution of scheduled habit resumes.
+type ResumePausedHabitsProcessor struct {
+	handler command.ResumePausedHabitsHandler
+	log     logger.Logger
+}
+
+// NewResumePausedHabitsProcessor creates a new processor instance with required dependencies.
+func NewResumePausedHabitsProcessor(
+	handler command.ResumePausedHabitsHandler,
+	log logger.Logger,
+) *ResumePausedHabitsProcessor {
+	return &ResumePausedHabitsProcessor{
+		handler: handler,
+		log:     log,
+	}
+}
+
+// ProcessTask implements the asynq.Handler interface.
+func (p *ResumePausedHabitsProcessor) ProcessTask(ctx context.Context, t *asynq.Task) error {
+	p.log.Info(ctx, "starting paused habits resume processor",
+		logger.Field{Key: "task_id", Value: t.ResultWriter().TaskID()},
+	)
+
+	if err := p.handler.Handle(ctx, command.ResumePausedHabits{Now: time.Now()}); err != nil {
+		p.log.Error(ctx, err, "failed to resume paused habits")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package task
+
+import (
+	"context"
+
+	"github.com/hibiken/asynq"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/habits/app/command"
+)
+
+// TaskVerifyStats checks recently logged habits' stats against their logs
+const TaskVerifyStats = "habits:verify_stats"
+
+// NewVerifyStatsTask creates a new task for verifying habit stats.
+func NewVerifyStatsTask() *asynq.Task {
+	return asynq.NewTask(TaskVerifyStats, nil)
+}
+
+// VerifyStatsProcessor handles the execution of the stats consistency check.
+type VerifyStatsProcessor struct {
+	handler command.RecomputeStatsHandler
+	log     logger.Logger
+}
+
+// NewVerifyStatsProcessor creates a new processor instance with required dependencies.
+func NewVerifyStatsProcessor(
+	handler command.RecomputeStatsHandler,
+	log logger.Logger,
+) *VerifyStatsProcessor {
+	return &VerifyStatsProcessor{
+		handler: handler,
+		log:     log,
+	}
+}
+
+// ProcessTask implements the asynq.Handler interface.
+func (p *VerifyStatsProcessor) ProcessTask(ctx context.Context, t *asynq.Task) error {
+	p.log.Info(ctx, "starting habit stats verification",
+		logger.Field{Key: "task_id", Value: t.ResultWriter().TaskID()},
+	)
+
+	result, err := p.handler.Handle(ctx, command.RecomputeStats{})
+	if err != nil {
+		p.log.Error(ctx, err, "failed to verify habit stats")
+		return err
+	}
+
+	p.log.Info(ctx, "habit stats verification finished",
+		logger.Field{Key: "checked", Value: result.Checked},
+		logger.Field{Key: "repaired", Value: result.Repaired},
+	)
+	return nil
+}
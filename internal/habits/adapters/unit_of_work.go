@@ -7,6 +7,8 @@ import (
 
 	"github.com/jmoiron/sqlx"
 	"github.com/semmidev/ethos-go/internal/common/database"
+	"github.com/semmidev/ethos-go/internal/common/events"
+	"github.com/semmidev/ethos-go/internal/common/retry"
 	"github.com/semmidev/ethos-go/internal/habits/domain/habit"
 	"github.com/semmidev/ethos-go/internal/habits/domain/habitlog"
 )
@@ -34,6 +36,11 @@ type HabitsUnitOfWork interface {
 	// HabitLogs returns the habit log repository within this unit of work.
 	HabitLogs() habitlog.Repository
 
+	// Publisher binds publisher to this unit of work. Within a transaction,
+	// events published through it are written to the outbox in that
+	// transaction, so they are only delivered if it commits.
+	Publisher(publisher events.Publisher) events.Publisher
+
 	// WithTransaction executes a function within a transaction.
 	// It automatically commits on success or rolls back on error/panic.
 	// The callback receives a transactional UnitOfWork with repositories
@@ -70,10 +77,21 @@ func (uow *habitsUnitOfWork) HabitLogs() habitlog.Repository {
 	return uow.logRepo
 }
 
+// Publisher returns publisher unchanged outside a transaction, and one
+// that carries the transaction in its context inside one.
+func (uow *habitsUnitOfWork) Publisher(publisher events.Publisher) events.Publisher {
+	if !uow.inTransaction {
+		return publisher
+	}
+	return txPublisher{next: publisher, tx: uow.db}
+}
+
 // WithTransaction executes a function within a transaction.
 // This is the recommended way to use transactions as it handles
 // commit and rollback automatically, including panic recovery.
-func (uow *habitsUnitOfWork) WithTransaction(ctx context.Context, fn func(HabitsUnitOfWork) error) (err error) {
+// A transaction Postgres aborts over a deadlock or serialization failure
+// is retried, so fn must not have effects outside it.
+func (uow *habitsUnitOfWork) WithTransaction(ctx context.Context, fn func(HabitsUnitOfWork) error) error {
 	// If already in a transaction, just run the function (nested transaction support)
 	if uow.inTransaction {
 		return fn(uow)
@@ -104,6 +122,17 @@ func (uow *habitsUnitOfWork) WithTransaction(ctx context.Context, fn func(Habits
 		return errors.New("WithTransaction: db must be *sqlx.DB or *sqlx.Tx")
 	}
 
+	// Postgres may abort the transaction to resolve a deadlock or
+	// serialization conflict with a concurrent one; it was rolled back, so
+	// it is run again from the start
+	return retry.Do(ctx, database.TxRetryPolicy, func(ctx context.Context) error {
+		return runInTransaction(ctx, conn, fn)
+	})
+}
+
+// runInTransaction runs fn in a new transaction on conn, committing when
+// it succeeds and rolling back when it fails or panics
+func runInTransaction(ctx context.Context, conn *sqlx.DB, fn func(HabitsUnitOfWork) error) (err error) {
 	tx, err := conn.BeginTxx(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("begin transaction: %w", err)
@@ -139,3 +168,23 @@ func (uow *habitsUnitOfWork) WithTransaction(ctx context.Context, fn func(Habits
 
 	return nil
 }
+
+// txPublisher publishes through next with its transaction in the context,
+// which the outbox repository joins
+type txPublisher struct {
+	next events.Publisher
+	tx   database.DBTX
+}
+
+func (p txPublisher) Publish(ctx context.Context, event events.Event) error {
+	return p.next.Publish(database.WithTx(ctx, p.tx), event)
+}
+
+func (p txPublisher) PublishAll(ctx context.Context, evts []events.Event) error {
+	return p.next.PublishAll(database.WithTx(ctx, p.tx), evts)
+}
+
+// Close leaves the shared publisher open
+func (p txPublisher) Close() error {
+	return nil
+}
@@ -13,23 +13,45 @@ type Application struct {
 
 // Commands groups all command handlers (write operations)
 type Commands struct {
-	CreateHabit     command.CreateHabitHandler
-	UpdateHabit     command.UpdateHabitHandler
-	DeleteHabit     command.DeleteHabitHandler
-	ActivateHabit   command.ActivateHabitHandler
-	DeactivateHabit command.DeactivateHabitHandler
-	LogHabit        command.LogHabitHandler
-	UpdateHabitLog  command.UpdateHabitLogHandler
-	DeleteHabitLog  command.DeleteHabitLogHandler
+	CreateHabit        command.CreateHabitHandler
+	UpdateHabit        command.UpdateHabitHandler
+	DeleteHabit        command.DeleteHabitHandler
+	ActivateHabit      command.ActivateHabitHandler
+	DeactivateHabit    command.DeactivateHabitHandler
+	LogHabit           command.LogHabitHandler
+	UpdateHabitLog     command.UpdateHabitLogHandler
+	DeleteHabitLog     command.DeleteHabitLogHandler
+	UndoHabitLog       command.UndoHabitLogHandler
+	SetHabitLogCount   command.SetHabitLogCountHandler
+	ReorderHabits      command.ReorderHabitsHandler
+	PauseHabit         command.PauseHabitHandler
+	ResumePausedHabits command.ResumePausedHabitsHandler
+	StartVacation      command.StartVacationHandler
+	EndVacation        command.EndVacationHandler
+	RecomputeStats     command.RecomputeStatsHandler
+	CompactHabitLogs   command.CompactHabitLogsHandler
+	RefreshDashboards  command.RefreshDashboardsHandler
+	StartImport        command.StartImportHandler
+	RunImport          command.RunImportHandler
+	ScheduleReminders  command.ScheduleRemindersHandler
 }
 
 // Queries groups all query handlers (read operations)
 type Queries struct {
-	GetHabit           query.GetHabitHandler
-	ListHabits         query.ListHabitsHandler
-	GetHabitLogs       query.GetHabitLogsHandler
-	GetHabitStats      query.GetHabitStatsHandler
-	GetDashboard       query.GetDashboardHandler
-	GetWeeklyAnalytics query.GetWeeklyAnalyticsHandler
-	GetHabitsDue       query.GetHabitsDueHandler
+	GetHabit             query.GetHabitHandler
+	ListHabits           query.ListHabitsHandler
+	GetHabitLogs         query.GetHabitLogsHandler
+	GetHabitStats        query.GetHabitStatsHandler
+	GetHabitInsights     query.GetHabitInsightsHandler
+	GetDashboard         query.GetDashboardHandler
+	GetWeeklyAnalytics   query.GetWeeklyAnalyticsHandler
+	ComparePeriods       query.ComparePeriodsHandler
+	GetHabitCorrelations query.GetHabitCorrelationsHandler
+	GetHabitsDue         query.GetHabitsDueHandler
+	GetDailySummaries    query.GetDailySummariesHandler
+	GetInactiveUsers     query.GetInactiveUsersHandler
+	ListVacations        query.ListVacationsHandler
+	PreviewImport        query.PreviewImportHandler
+	GetImport            query.GetImportHandler
+	GetPublicStats       query.GetPublicStatsHandler
 }
@@ -2,13 +2,14 @@ package command
 
 import (
 	"context"
+	"time"
 
+	habitevents "github.com/semmidev/ethos-go/contracts/events/habits"
 	"github.com/semmidev/ethos-go/internal/common/apperror"
 	"github.com/semmidev/ethos-go/internal/common/decorator"
 	"github.com/semmidev/ethos-go/internal/common/events"
 	"github.com/semmidev/ethos-go/internal/common/logger"
 	"github.com/semmidev/ethos-go/internal/common/validator"
-	habitevents "github.com/semmidev/ethos-go/internal/habits/domain/events"
 	"github.com/semmidev/ethos-go/internal/habits/domain/habit"
 )
 
@@ -23,6 +24,7 @@ type ActivateHabitHandler decorator.CommandHandler[ActivateHabit]
 
 type activateHabitHandler struct {
 	repo      habit.Repository
+	plans     habit.PlanGate
 	validator *validator.Validator
 	publisher events.Publisher
 }
@@ -30,6 +32,7 @@ type activateHabitHandler struct {
 // NewActivateHabitHandler creates a new handler with decorators
 func NewActivateHabitHandler(
 	repo habit.Repository,
+	plans habit.PlanGate,
 	validator *validator.Validator,
 	publisher events.Publisher, // Injected publisher
 	log logger.Logger,
@@ -38,10 +41,14 @@ func NewActivateHabitHandler(
 	if repo == nil {
 		panic("nil habit repository")
 	}
+	if plans == nil {
+		panic("nil plan gate")
+	}
 
 	return decorator.ApplyCommandDecorators(
 		activateHabitHandler{
 			repo:      repo,
+			plans:     plans,
 			validator: validator,
 			publisher: publisher,
 		},
@@ -63,12 +70,18 @@ func (h activateHabitHandler) Handle(ctx context.Context, cmd ActivateHabit) err
 		return apperror.ValidationFailed(err.Error())
 	}
 
+	if err := h.checkPlan(ctx, cmd); err != nil {
+		return err
+	}
+
 	// Use repository UpdateFn pattern
+	var wasPaused bool
 	err := h.repo.UpdateHabit(
 		ctx,
 		cmd.HabitID,
 		cmd.UserID,
 		func(ctx context.Context, habit *habit.Habit) (*habit.Habit, error) {
+			wasPaused = habit.IsPaused()
 			// Apply domain behavior
 			if err := habit.Activate(); err != nil {
 				return nil, err
@@ -77,7 +90,14 @@ func (h activateHabitHandler) Handle(ctx context.Context, cmd ActivateHabit) err
 		},
 	)
 	if err != nil {
-		return err
+		return toNotFoundAppError(err, cmd.HabitID)
+	}
+
+	// Resuming early cuts the pause vacation short so later days count again
+	if wasPaused {
+		if err := h.endPauseVacation(ctx, cmd.HabitID); err != nil {
+			return err
+		}
 	}
 
 	// Publish HabitActivated event
@@ -86,3 +106,38 @@ func (h activateHabitHandler) Handle(ctx context.Context, cmd ActivateHabit) err
 
 	return nil
 }
+
+// checkPlan makes sure an inactive habit fits within the user's plan once
+// activated. Paused habits count as active already.
+func (h activateHabitHandler) checkPlan(ctx context.Context, cmd ActivateHabit) error {
+	habits, err := h.repo.ListHabitsByUser(ctx, cmd.UserID)
+	if err != nil {
+		return err
+	}
+
+	for _, hb := range habits {
+		if hb.HabitID() == cmd.HabitID && !hb.IsActive() && !hb.IsPaused() {
+			return h.plans.CheckActiveHabits(ctx, cmd.UserID, habit.CountActive(habits)+1)
+		}
+	}
+	return nil
+}
+
+func (h activateHabitHandler) endPauseVacation(ctx context.Context, habitID string) error {
+	vacation, err := h.repo.GetActiveVacation(ctx, habitID)
+	if err != nil || vacation == nil {
+		return err
+	}
+
+	today := time.Now()
+	if vacation.EndDate() == nil || !vacation.EndDate().After(today) {
+		return nil
+	}
+
+	// End yesterday, unless the pause only started today
+	end := today.AddDate(0, 0, -1)
+	if end.Before(vacation.StartDate()) {
+		end = vacation.StartDate()
+	}
+	return h.repo.EndVacation(ctx, vacation.ID(), end)
+}
@@ -0,0 +1,134 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/habits/adapters"
+	"github.com/semmidev/ethos-go/internal/habits/domain/habit"
+	"github.com/semmidev/ethos-go/internal/habits/domain/habitlog"
+)
+
+// CompactHabitLogs command folds logs older than their owner's plan keeps
+// into monthly summaries and deletes them. Logs of a streak still running
+// are kept whatever their age, so compaction never shortens a streak.
+type CompactHabitLogs struct {
+	Now time.Time
+}
+
+// CompactHabitLogsResult reports how many habits had logs compacted and how
+// many logs were deleted
+type CompactHabitLogsResult struct {
+	Habits int
+	Logs   int
+}
+
+// CompactHabitLogsHandler processes log compaction commands
+type CompactHabitLogsHandler decorator.CommandHandlerWithResult[CompactHabitLogs, CompactHabitLogsResult]
+
+type compactHabitLogsHandler struct {
+	uow       adapters.HabitsUnitOfWork
+	policy    habitlog.RetentionPolicy
+	streakSvc *habit.StreakService
+	metrics   decorator.MetricsClient
+}
+
+// NewCompactHabitLogsHandler creates a new handler with decorators
+func NewCompactHabitLogsHandler(
+	uow adapters.HabitsUnitOfWork,
+	policy habitlog.RetentionPolicy,
+	log logger.Logger,
+	metricsClient decorator.MetricsClient,
+) CompactHabitLogsHandler {
+	if uow == nil {
+		panic("nil unit of work")
+	}
+
+	return decorator.ApplyCommandResultDecorators(
+		compactHabitLogsHandler{
+			uow:       uow,
+			policy:    policy,
+			streakSvc: habit.NewStreakService(),
+			metrics:   metricsClient,
+		},
+		log,
+		metricsClient,
+	)
+}
+
+func (h compactHabitLogsHandler) Handle(ctx context.Context, cmd CompactHabitLogs) (CompactHabitLogsResult, error) {
+	var (
+		result CompactHabitLogsResult
+		errs   []error
+	)
+	for _, plan := range h.policy.Plans() {
+		cutoff, _ := h.policy.Cutoff(plan, cmd.Now)
+
+		refs, err := h.uow.HabitLogs().ListCompactableHabits(ctx, plan, cutoff)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		// One failing habit must not hold back the others
+		for _, ref := range refs {
+			deleted, err := h.compact(ctx, ref, cutoff, cmd.Now)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			if deleted > 0 {
+				result.Habits++
+				result.Logs += deleted
+			}
+		}
+	}
+
+	h.metrics.Inc("habits.logs.compacted", result.Logs)
+
+	return result, errors.Join(errs...)
+}
+
+// compact compacts one habit's logs dated before cutoff, stopping short of
+// its current streak
+func (h compactHabitLogsHandler) compact(ctx context.Context, ref habitlog.HabitRef, cutoff, now time.Time) (int, error) {
+	var deleted int
+
+	err := h.uow.WithTransaction(ctx, func(txUow adapters.HabitsUnitOfWork) error {
+		habitAgg, err := txUow.Habits().GetHabit(ctx, ref.HabitID, ref.UserID)
+		if err != nil {
+			return err
+		}
+
+		logs, err := txUow.HabitLogs().ListHabitLogs(ctx, ref.HabitID, ref.UserID)
+		if err != nil {
+			return err
+		}
+
+		vacations, err := txUow.Habits().ListVacations(ctx, ref.HabitID)
+		if err != nil {
+			return err
+		}
+
+		completionDates := make(map[string]bool, len(logs))
+		for _, l := range logs {
+			completionDates[l.LogDate().Format("2006-01-02")] = true
+		}
+
+		before := cutoff
+		if start := h.streakSvc.StreakStart(habitAgg, completionDates, vacations, now); start != nil {
+			day := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+			if day.Before(before) {
+				before = day
+			}
+		}
+
+		deleted, err = txUow.HabitLogs().CompactHabitLogs(ctx, ref.HabitID, before)
+		return err
+	})
+
+	return deleted, err
+}
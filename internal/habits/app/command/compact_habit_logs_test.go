@@ -0,0 +1,103 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/common/validator"
+	"github.com/semmidev/ethos-go/internal/habits/app/command"
+	"github.com/semmidev/ethos-go/internal/habits/domain/habitlog"
+	"github.com/semmidev/ethos-go/internal/testutil"
+)
+
+func TestCompactHabitLogsHandler(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given free and pro users with logs older than a year", t, func() {
+		ctx := context.Background()
+		now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+		day := func(year int, month time.Month, d int) time.Time {
+			return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+		}
+		created := day(2024, 1, 1)
+
+		lapsed := testutil.NewHabitBuilder().CreatedAt(created).Build()
+		streaking := testutil.NewHabitBuilder().WithUserID(lapsed.UserID()).CreatedAt(created).Build()
+		pro := testutil.NewHabitBuilder().CreatedAt(created).Build()
+
+		logs := []*habitlog.HabitLog{
+			testutil.NewHabitLogBuilder(lapsed).OnDate(day(2024, 3, 5)).Build(),
+			testutil.NewHabitLogBuilder(lapsed).OnDate(day(2024, 3, 6)).WithCount(2).Build(),
+			testutil.NewHabitLogBuilder(lapsed).OnDate(day(2024, 4, 1)).Build(),
+			testutil.NewHabitLogBuilder(lapsed).OnDate(day(2026, 10, 10)).Build(),
+			testutil.NewHabitLogBuilder(streaking).OnDate(day(2025, 9, 1)).Build(),
+			testutil.NewHabitLogBuilder(pro).OnDate(day(2024, 3, 5)).Build(),
+		}
+		// An unbroken daily streak since before the cutoff, still open today
+		for d := day(2025, 9, 20); d.Before(day(2026, 10, 17)); d = d.AddDate(0, 0, 1) {
+			logs = append(logs, testutil.NewHabitLogBuilder(streaking).OnDate(d).Build())
+		}
+
+		logRepo := testutil.NewHabitLogRepository(logs...)
+		logRepo.SetUserPlan(pro.UserID(), "pro")
+		uow := testutil.NewHabitsUnitOfWork(testutil.NewHabitRepository(lapsed, streaking, pro), logRepo)
+
+		handler := command.NewCompactHabitLogsHandler(
+			uow, habitlog.RetentionPolicy{"free": 12},
+			testutil.NopLogger{}, testutil.NewRecordingMetricsClient(),
+		)
+
+		Convey("When compaction runs", func() {
+			result, err := handler.Handle(ctx, command.CompactHabitLogs{Now: now})
+			So(err, ShouldBeNil)
+
+			Convey("Then logs before the cutoff month are summarized by month", func() {
+				So(result, ShouldResemble, command.CompactHabitLogsResult{Habits: 2, Logs: 4})
+				So(logRepo.Summaries(lapsed.HabitID()), ShouldResemble, []habitlog.MonthlySummary{
+					{HabitID: lapsed.HabitID(), UserID: lapsed.UserID(), Month: day(2024, 3, 1), DaysLogged: 2, TotalCount: 3},
+					{HabitID: lapsed.HabitID(), UserID: lapsed.UserID(), Month: day(2024, 4, 1), DaysLogged: 1, TotalCount: 1},
+				})
+
+				remaining, err := logRepo.ListHabitLogs(ctx, lapsed.HabitID(), lapsed.UserID())
+				So(err, ShouldBeNil)
+				So(remaining, ShouldHaveLength, 1)
+			})
+
+			Convey("Then a running streak keeps its logs past the cutoff", func() {
+				So(logRepo.Summaries(streaking.HabitID()), ShouldHaveLength, 1)
+
+				remaining, err := logRepo.ListHabitLogs(ctx, streaking.HabitID(), streaking.UserID())
+				So(err, ShouldBeNil)
+				So(remaining[len(remaining)-1].LogDate(), ShouldEqual, day(2025, 9, 20))
+			})
+
+			Convey("Then plans without a retention limit keep their logs", func() {
+				So(logRepo.Summaries(pro.HabitID()), ShouldBeEmpty)
+			})
+
+			Convey("And stats are recomputed", func() {
+				recompute := command.NewRecomputeStatsHandler(uow, validator.New("en"), testutil.NopLogger{}, testutil.NewRecordingMetricsClient())
+				_, err := recompute.Handle(ctx, command.RecomputeStats{HabitID: lapsed.HabitID(), UserID: lapsed.UserID()})
+				So(err, ShouldBeNil)
+
+				Convey("Then compacted days still count towards total completions", func() {
+					stats, err := uow.HabitRepo.GetStats(ctx, lapsed.HabitID())
+					So(err, ShouldBeNil)
+					So(stats.TotalCompletions(), ShouldEqual, 4)
+				})
+			})
+
+			Convey("And it runs again", func() {
+				result, err := handler.Handle(ctx, command.CompactHabitLogs{Now: now})
+
+				Convey("Then nothing is left to compact", func() {
+					So(err, ShouldBeNil)
+					So(result, ShouldResemble, command.CompactHabitLogsResult{})
+				})
+			})
+		})
+	})
+}
@@ -2,35 +2,38 @@ package command
 
 import (
 	"context"
+	"fmt"
 
+	habitevents "github.com/semmidev/ethos-go/contracts/events/habits"
 	"github.com/semmidev/ethos-go/internal/common/apperror"
 	"github.com/semmidev/ethos-go/internal/common/decorator"
 	"github.com/semmidev/ethos-go/internal/common/events"
 	"github.com/semmidev/ethos-go/internal/common/logger"
 	"github.com/semmidev/ethos-go/internal/common/validator"
-	habitevents "github.com/semmidev/ethos-go/internal/habits/domain/events"
+	"github.com/semmidev/ethos-go/internal/habits/adapters"
 	"github.com/semmidev/ethos-go/internal/habits/domain/habit"
 	domaintask "github.com/semmidev/ethos-go/internal/habits/domain/task"
 )
 
 // CreateHabit command encapsulates habit creation input
 type CreateHabit struct {
-	HabitID            string
-	UserID             string
-	Name               string  `json:"name" validate:"required,min=3,max=100"`
-	Description        *string `json:"description"`
-	Frequency          string  `json:"frequency" validate:"required,oneof=daily weekly monthly"`
-	RecurrenceDays     *int16  `json:"recurrence_days"`     // Bitmask: Sun=1, Mon=2, etc. nil = all days
-	RecurrenceInterval *int    `json:"recurrence_interval"` // Every N periods. nil = 1
-	TargetCount        int     `json:"target_count" validate:"required,min=1"`
-	ReminderTime       *string `json:"reminder_time"`
+	HabitID      string
+	UserID       string
+	Name         string           `json:"name" validate:"required,min=3,max=100"`
+	Description  *string          `json:"description"`
+	Frequency    string           `json:"frequency" validate:"required,oneof=daily weekly monthly"`
+	Recurrence   *RecurrenceInput `json:"recurrence"` // nil = every day
+	TargetCount  int              `json:"target_count" validate:"required,min=1"`
+	ReminderTime *string          `json:"reminder_time"`
 }
 
 // CreateHabitHandler processes habit creation commands
 type CreateHabitHandler decorator.CommandHandler[CreateHabit]
 
 type createHabitHandler struct {
-	repo       habit.Repository
+	uow        adapters.HabitsUnitOfWork
+	plans      habit.PlanGate
+	limit      habit.ActiveLimit
 	validator  *validator.Validator
 	dispatcher domaintask.TaskDispatcher
 	publisher  events.Publisher
@@ -38,20 +41,27 @@ type createHabitHandler struct {
 
 // NewCreateHabitHandler creates a new handler with decorators
 func NewCreateHabitHandler(
-	repo habit.Repository,
+	uow adapters.HabitsUnitOfWork,
+	plans habit.PlanGate,
+	limit habit.ActiveLimit,
 	validator *validator.Validator,
 	dispatcher domaintask.TaskDispatcher,
 	publisher events.Publisher, // Injected publisher
 	log logger.Logger,
 	metricsClient decorator.MetricsClient,
 ) CreateHabitHandler {
-	if repo == nil {
-		panic("nil habit repository")
+	if uow == nil {
+		panic("nil unit of work")
+	}
+	if plans == nil {
+		panic("nil plan gate")
 	}
 
 	return decorator.ApplyCommandDecorators(
 		createHabitHandler{
-			repo:       repo,
+			uow:        uow,
+			plans:      plans,
+			limit:      limit,
 			validator:  validator,
 			dispatcher: dispatcher,
 			publisher:  publisher,
@@ -81,17 +91,12 @@ func (h createHabitHandler) Handle(ctx context.Context, cmd CreateHabit) error {
 	}
 
 	// Create recurrence value object (use defaults if not provided)
-	recurrenceDays := habit.AllDays
-	if cmd.RecurrenceDays != nil {
-		recurrenceDays = *cmd.RecurrenceDays
-	}
-	recurrenceInterval := 1
-	if cmd.RecurrenceInterval != nil && *cmd.RecurrenceInterval > 0 {
-		recurrenceInterval = *cmd.RecurrenceInterval
-	}
-	recurrence, err := habit.NewRecurrence(recurrenceDays, recurrenceInterval)
-	if err != nil {
-		recurrence = habit.DefaultRecurrence()
+	recurrence := habit.DefaultRecurrence()
+	if cmd.Recurrence != nil {
+		recurrence, err = cmd.Recurrence.toRecurrence()
+		if err != nil {
+			return err
+		}
 	}
 
 	// Create new habit aggregate
@@ -109,8 +114,25 @@ func (h createHabitHandler) Handle(ctx context.Context, cmd CreateHabit) error {
 		return err
 	}
 
-	// Persist the habit
-	if err := h.repo.AddHabit(ctx, newHabit); err != nil {
+	// Count and insert in one transaction, so concurrent requests cannot
+	// each see room for one more habit
+	err = h.uow.WithTransaction(ctx, func(txUow adapters.HabitsUnitOfWork) error {
+		active, err := txUow.Habits().CountActiveHabitsForUpdate(ctx, cmd.UserID)
+		if err != nil {
+			return err
+		}
+		if err := h.limit.CheckAdd(active); err != nil {
+			return apperror.BusinessRuleViolation("max_active_habits",
+				fmt.Sprintf("a user can have at most %d active habits", h.limit)).
+				WithDetails("limit", int(h.limit))
+		}
+		if err := h.plans.CheckActiveHabits(ctx, cmd.UserID, active+1); err != nil {
+			return err
+		}
+
+		return txUow.Habits().AddHabit(ctx, newHabit)
+	})
+	if err != nil {
 		return err
 	}
 
@@ -131,3 +153,17 @@ func (h createHabitHandler) Handle(ctx context.Context, cmd CreateHabit) error {
 
 	return nil
 }
+
+// RecurrenceInput describes on which weekdays and how often a habit repeats
+type RecurrenceInput struct {
+	Days     []string `json:"days" validate:"max=7"`                       // Weekday names, e.g. "mon"; empty = every day
+	Interval int      `json:"interval" validate:"omitempty,min=1,max=365"` // Every N periods; 0 = 1
+}
+
+func (r RecurrenceInput) toRecurrence() (habit.Recurrence, error) {
+	recurrence, err := habit.NewRecurrenceFromDayNames(r.Days, r.Interval)
+	if err != nil {
+		return habit.Recurrence{}, apperror.InvalidInput("recurrence", err.Error())
+	}
+	return recurrence, nil
+}
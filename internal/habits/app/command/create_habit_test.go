@@ -0,0 +1,80 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	. "github.com/smartystreets/goconvey/convey"
+
+	billingapp "github.com/semmidev/ethos-go/internal/billing/app"
+	"github.com/semmidev/ethos-go/internal/billing/domain/plan"
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/validator"
+	"github.com/semmidev/ethos-go/internal/habits/app/command"
+	"github.com/semmidev/ethos-go/internal/habits/domain/habit"
+	"github.com/semmidev/ethos-go/internal/testutil"
+)
+
+func TestCreateHabitHandler(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given a pro user with an active habit limit of three", t, func() {
+		ctx := context.Background()
+		userID := uuid.NewString()
+
+		uow := testutil.NewHabitsUnitOfWork(testutil.NewHabitRepository(
+			testutil.NewHabitBuilder().WithUserID(userID).Build(),
+			testutil.NewHabitBuilder().WithUserID(userID).Inactive().Build(),
+		), nil)
+		plans := testutil.NewSubscriptionRepository()
+		plans.SetUserPlan(userID, plan.Pro)
+		publisher := testutil.NewRecordingPublisher()
+
+		handler := command.NewCreateHabitHandler(
+			uow,
+			billingapp.NewPlanGate(plans),
+			habit.ActiveLimit(3),
+			validator.New("en"),
+			&testutil.RecordingHabitTaskDispatcher{},
+			publisher,
+			testutil.NopLogger{},
+			&decorator.NoOpMetricsClient{},
+		)
+		create := func() error {
+			return handler.Handle(ctx, command.CreateHabit{
+				HabitID:     uuid.NewString(),
+				UserID:      userID,
+				Name:        "Read",
+				Frequency:   "daily",
+				TargetCount: 1,
+			})
+		}
+
+		Convey("When habits are created up to the limit", func() {
+			So(create(), ShouldBeNil)
+			So(create(), ShouldBeNil)
+
+			Convey("Then each is counted and stored in a transaction", func() {
+				So(uow.Transactions, ShouldEqual, 2)
+				So(uow.HabitRepo.Len(), ShouldEqual, 4)
+				So(publisher.Events(), ShouldHaveLength, 2)
+			})
+
+			Convey("And one more is created", func() {
+				err := create()
+
+				Convey("Then it breaks the limit rule and is not stored", func() {
+					appErr := apperror.GetAppError(err)
+					So(appErr, ShouldNotBeNil)
+					So(appErr.Code, ShouldEqual, apperror.ErrCodeBusinessRuleViolation)
+					So(appErr.Details["rule"], ShouldEqual, "max_active_habits")
+					So(appErr.Details["limit"], ShouldEqual, 3)
+					So(uow.HabitRepo.Len(), ShouldEqual, 4)
+					So(publisher.Events(), ShouldHaveLength, 2)
+				})
+			})
+		})
+	})
+}
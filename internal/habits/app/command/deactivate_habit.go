@@ -3,12 +3,12 @@ package command
 import (
 	"context"
 
+	habitevents "github.com/semmidev/ethos-go/contracts/events/habits"
 	"github.com/semmidev/ethos-go/internal/common/apperror"
 	"github.com/semmidev/ethos-go/internal/common/decorator"
 	"github.com/semmidev/ethos-go/internal/common/events"
 	"github.com/semmidev/ethos-go/internal/common/logger"
 	"github.com/semmidev/ethos-go/internal/common/validator"
-	habitevents "github.com/semmidev/ethos-go/internal/habits/domain/events"
 	"github.com/semmidev/ethos-go/internal/habits/domain/habit"
 )
 
@@ -77,7 +77,7 @@ func (h deactivateHabitHandler) Handle(ctx context.Context, cmd DeactivateHabit)
 		},
 	)
 	if err != nil {
-		return err
+		return toNotFoundAppError(err, cmd.HabitID)
 	}
 
 	// Publish HabitDeactivated event
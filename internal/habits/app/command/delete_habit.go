@@ -3,8 +3,10 @@ package command
 import (
 	"context"
 
+	habitevents "github.com/semmidev/ethos-go/contracts/events/habits"
 	"github.com/semmidev/ethos-go/internal/common/apperror"
 	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/events"
 	"github.com/semmidev/ethos-go/internal/common/logger"
 	"github.com/semmidev/ethos-go/internal/common/validator"
 	"github.com/semmidev/ethos-go/internal/habits/domain/habit"
@@ -22,12 +24,14 @@ type DeleteHabitHandler decorator.CommandHandler[DeleteHabit]
 type deleteHabitHandler struct {
 	repo      habit.Repository
 	validator *validator.Validator
+	publisher events.Publisher
 }
 
 // NewDeleteHabitHandler creates a new handler with decorators
 func NewDeleteHabitHandler(
 	repo habit.Repository,
 	validator *validator.Validator,
+	publisher events.Publisher,
 	log logger.Logger,
 	metricsClient decorator.MetricsClient,
 ) DeleteHabitHandler {
@@ -39,6 +43,7 @@ func NewDeleteHabitHandler(
 		deleteHabitHandler{
 			repo:      repo,
 			validator: validator,
+			publisher: publisher,
 		},
 		log,
 		metricsClient,
@@ -58,6 +63,12 @@ func (h deleteHabitHandler) Handle(ctx context.Context, cmd DeleteHabit) error {
 		return apperror.ValidationFailed(err.Error())
 	}
 
-	// Delete the habit (with authorization check in repository)
-	return h.repo.DeleteHabit(ctx, cmd.HabitID, cmd.UserID)
+	// The repository only deletes the user's own habit
+	if err := h.repo.DeleteHabit(ctx, cmd.HabitID, cmd.UserID); err != nil {
+		return toNotFoundAppError(err, cmd.HabitID)
+	}
+
+	_ = h.publisher.Publish(ctx, habitevents.NewHabitDeleted(cmd.HabitID, cmd.UserID))
+
+	return nil
 }
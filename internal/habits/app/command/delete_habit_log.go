@@ -2,9 +2,12 @@ package command
 
 import (
 	"context"
+	"time"
 
+	habitevents "github.com/semmidev/ethos-go/contracts/events/habits"
 	"github.com/semmidev/ethos-go/internal/common/apperror"
 	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/events"
 	"github.com/semmidev/ethos-go/internal/common/logger"
 	"github.com/semmidev/ethos-go/internal/common/validator"
 	"github.com/semmidev/ethos-go/internal/habits/domain/habitlog"
@@ -22,12 +25,14 @@ type DeleteHabitLogHandler decorator.CommandHandler[DeleteHabitLog]
 type deleteHabitLogHandler struct {
 	repo      habitlog.Repository
 	validator *validator.Validator
+	publisher events.Publisher
 }
 
 // NewDeleteHabitLogHandler creates a new handler with decorators
 func NewDeleteHabitLogHandler(
 	repo habitlog.Repository,
 	validator *validator.Validator,
+	publisher events.Publisher,
 	log logger.Logger,
 	metricsClient decorator.MetricsClient,
 ) DeleteHabitLogHandler {
@@ -39,6 +44,7 @@ func NewDeleteHabitLogHandler(
 		deleteHabitLogHandler{
 			repo:      repo,
 			validator: validator,
+			publisher: publisher,
 		},
 		log,
 		metricsClient,
@@ -58,5 +64,26 @@ func (h deleteHabitLogHandler) Handle(ctx context.Context, cmd DeleteHabitLog) e
 		return apperror.ValidationFailed(err.Error())
 	}
 
-	return h.repo.DeleteHabitLog(ctx, cmd.LogID, cmd.UserID)
+	log, err := h.repo.GetHabitLog(ctx, cmd.LogID, cmd.UserID)
+	if err != nil {
+		return toNotFoundAppError(err, cmd.LogID)
+	}
+
+	policy, err := logDatePolicy(ctx, h.repo, cmd.UserID, 0)
+	if err != nil {
+		return err
+	}
+	if err := policy.CanModify(log.LogDate(), time.Now()); err != nil {
+		return toLogPolicyAppError(err, policy)
+	}
+
+	if err := h.repo.DeleteHabitLog(ctx, cmd.LogID, cmd.UserID); err != nil {
+		return toNotFoundAppError(err, cmd.LogID)
+	}
+
+	_ = h.publisher.Publish(ctx, habitevents.NewHabitLogDeleted(
+		log.HabitID(), cmd.UserID, cmd.LogID, log.LogDate(), log.Count(),
+	))
+
+	return nil
 }
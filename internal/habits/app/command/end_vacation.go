@@ -0,0 +1,90 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/common/validator"
+	"github.com/semmidev/ethos-go/internal/habits/domain/habit"
+)
+
+// EndVacation command encapsulates ending a habit vacation
+type EndVacation struct {
+	VacationID string    `validate:"uuid"`
+	HabitID    string    `validate:"uuid"`
+	UserID     string    `validate:"uuid"`
+	EndDate    time.Time `json:"end_date" validate:"required"`
+}
+
+// EndVacationHandler processes vacation end commands
+type EndVacationHandler decorator.CommandHandler[EndVacation]
+
+type endVacationHandler struct {
+	repo      habit.Repository
+	validator *validator.Validator
+}
+
+// NewEndVacationHandler creates a new handler with decorators
+func NewEndVacationHandler(
+	repo habit.Repository,
+	validator *validator.Validator,
+	log logger.Logger,
+	metricsClient decorator.MetricsClient,
+) EndVacationHandler {
+	if repo == nil {
+		panic("nil habit repository")
+	}
+
+	return decorator.ApplyCommandDecorators(
+		endVacationHandler{
+			repo:      repo,
+			validator: validator,
+		},
+		log,
+		metricsClient,
+	)
+}
+
+func (h endVacationHandler) Handle(ctx context.Context, cmd EndVacation) error {
+	// Validate input
+	if err := h.validator.Validate(cmd); err != nil {
+		if validationErrors, ok := validator.GetValidationErrors(err); ok {
+			details := make(map[string]interface{})
+			for _, ve := range validationErrors {
+				details[ve.Field] = ve.Message
+			}
+			return apperror.ValidationFailedWithDetails("validation failed", details)
+		}
+		return apperror.ValidationFailed(err.Error())
+	}
+
+	err := h.endVacation(ctx, cmd)
+	return toVacationAppError(err, cmd.HabitID, cmd.VacationID)
+}
+
+func (h endVacationHandler) endVacation(ctx context.Context, cmd EndVacation) error {
+	// Verify habit exists and belongs to user
+	if _, err := h.repo.GetHabit(ctx, cmd.HabitID, cmd.UserID); err != nil {
+		return err
+	}
+
+	vacations, err := h.repo.ListVacations(ctx, cmd.HabitID)
+	if err != nil {
+		return err
+	}
+
+	for _, vacation := range vacations {
+		if vacation.ID() != cmd.VacationID {
+			continue
+		}
+		if err := vacation.End(cmd.EndDate); err != nil {
+			return err
+		}
+		return h.repo.EndVacation(ctx, vacation.ID(), cmd.EndDate)
+	}
+
+	return habit.ErrVacationNotFound
+}
@@ -0,0 +1,191 @@
+package command_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/smartystreets/goconvey/convey"
+
+	billingapp "github.com/semmidev/ethos-go/internal/billing/app"
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/validator"
+	"github.com/semmidev/ethos-go/internal/habits/app/command"
+	"github.com/semmidev/ethos-go/internal/habits/domain/habit"
+	"github.com/semmidev/ethos-go/internal/habits/domain/habitimport"
+	"github.com/semmidev/ethos-go/internal/testutil"
+)
+
+func TestImportHandlers(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given import handlers and a Loop export", t, func() {
+		ctx := context.Background()
+		userID := uuid.NewString()
+
+		date := func(daysAgo int) string { return time.Now().AddDate(0, 0, -daysAgo).Format("2006-01-02") }
+		data := []byte(fmt.Sprintf("Date,Meditate,Run,\n%s,2,0,\n%s,2,2,\n%s,2,0,\n", date(1), date(2), date(10)))
+
+		uow := testutil.NewHabitsUnitOfWork(nil, nil)
+		imports := testutil.NewImportRepository()
+		dispatcher := &testutil.RecordingHabitTaskDispatcher{}
+		metrics := testutil.NewRecordingMetricsClient()
+
+		startHandler := command.NewStartImportHandler(
+			imports,
+			uow.HabitRepo,
+			billingapp.NewPlanGate(testutil.NewSubscriptionRepository(userID)),
+			validator.New("en"),
+			dispatcher,
+			testutil.NopLogger{},
+			&decorator.NoOpMetricsClient{},
+		)
+		runHandler := command.NewRunImportHandler(
+			uow,
+			imports,
+			testutil.NopLogger{},
+			metrics,
+		)
+
+		importID := uuid.NewString()
+		start := func() error {
+			return startHandler.Handle(ctx, command.StartImport{
+				ImportID: importID,
+				UserID:   userID,
+				Source:   "loop",
+				Data:     data,
+			})
+		}
+		run := func() error {
+			return runHandler.Handle(ctx, command.RunImport{ImportID: importID, UserID: userID})
+		}
+
+		Convey("When the import is started", func() {
+			So(start(), ShouldBeNil)
+
+			imp, err := imports.GetImport(ctx, importID, userID)
+			So(err, ShouldBeNil)
+
+			Convey("Then it is stored pending and queued for the worker", func() {
+				So(imp.Status(), ShouldEqual, habitimport.StatusPending)
+				So(imp.TotalHabits(), ShouldEqual, 2)
+				So(imp.TotalLogs(), ShouldEqual, 4)
+				So(dispatcher.Imports, ShouldResemble, []testutil.ImportTask{{ImportID: importID, UserID: userID}})
+				So(uow.HabitRepo.Len(), ShouldEqual, 0)
+			})
+
+			Convey("And the worker runs it", func() {
+				So(run(), ShouldBeNil)
+
+				imp, err := imports.GetImport(ctx, importID, userID)
+				So(err, ShouldBeNil)
+				habits, err := uow.HabitRepo.ListHabitsByUser(ctx, userID)
+				So(err, ShouldBeNil)
+
+				Convey("Then every habit and log is written and the import completes", func() {
+					So(imp.Status(), ShouldEqual, habitimport.StatusCompleted)
+					So(imp.ImportedHabits(), ShouldEqual, 2)
+					So(imp.ImportedLogs(), ShouldEqual, 4)
+					So(imp.CompletedAt(), ShouldNotBeNil)
+					So(habits, ShouldHaveLength, 2)
+					So(uow.LogRepo.Len(), ShouldEqual, 4)
+					So(metrics.Count("habits.import.logs"), ShouldEqual, 4)
+				})
+
+				Convey("Then habits are dated back to their first completion", func() {
+					for _, h := range habits {
+						if h.Name() == "Meditate" {
+							So(h.CreatedAt().After(time.Now().AddDate(0, 0, -10)), ShouldBeFalse)
+						}
+					}
+				})
+
+				Convey("Then a redelivered task changes nothing", func() {
+					So(run(), ShouldBeNil)
+					So(uow.HabitRepo.Len(), ShouldEqual, 2)
+					So(uow.LogRepo.Len(), ShouldEqual, 4)
+				})
+			})
+
+			Convey("And a previous attempt stopped after writing a habit", func() {
+				first := imp.Habits()[0]
+				daily, err := habit.NewFrequency(habit.FrequencyDaily)
+				So(err, ShouldBeNil)
+				h, err := habit.NewHabit(first.HabitID, userID, first.Name, nil, daily, habit.DefaultRecurrence(), 1, nil)
+				So(err, ShouldBeNil)
+				So(uow.HabitRepo.AddHabit(ctx, h), ShouldBeNil)
+
+				So(run(), ShouldBeNil)
+
+				Convey("Then the written habit is not duplicated", func() {
+					imp, err := imports.GetImport(ctx, importID, userID)
+					So(err, ShouldBeNil)
+					So(imp.Status(), ShouldEqual, habitimport.StatusCompleted)
+					So(imp.ImportedHabits(), ShouldEqual, 2)
+					So(uow.HabitRepo.Len(), ShouldEqual, 2)
+					So(uow.LogRepo.Len(), ShouldEqual, 1)
+				})
+			})
+		})
+
+		Convey("When the file cannot be read", func() {
+			err := startHandler.Handle(ctx, command.StartImport{
+				ImportID: importID,
+				UserID:   userID,
+				Source:   "loop",
+				Data:     []byte("Name,Value\n"),
+			})
+
+			Convey("Then it is rejected and nothing is queued", func() {
+				appErr := apperror.GetAppError(err)
+				So(appErr, ShouldNotBeNil)
+				So(appErr.Code, ShouldEqual, apperror.ErrCodeInvalidInput)
+				So(imports.Len(), ShouldEqual, 0)
+				So(dispatcher.Imports, ShouldBeEmpty)
+			})
+		})
+
+		Convey("When the import cannot be queued", func() {
+			dispatcher.Err = errors.New("redis down")
+			err := start()
+
+			Convey("Then it fails and is not left pending", func() {
+				So(err, ShouldNotBeNil)
+				imp, err := imports.GetImport(ctx, importID, userID)
+				So(err, ShouldBeNil)
+				So(imp.Status(), ShouldEqual, habitimport.StatusFailed)
+			})
+		})
+
+		Convey("When the imported habits would exceed the free plan", func() {
+			for i := 0; i < 4; i++ {
+				So(uow.HabitRepo.AddHabit(ctx, testutil.NewHabitBuilder().WithUserID(userID).Build()), ShouldBeNil)
+			}
+			err := start()
+
+			Convey("Then it is refused before anything is queued", func() {
+				appErr := apperror.GetAppError(err)
+				So(appErr, ShouldNotBeNil)
+				So(appErr.Code, ShouldEqual, apperror.ErrCodePlanLimitExceeded)
+				So(appErr.Details["limit"], ShouldEqual, billingapp.LimitMaxActiveHabits)
+				So(imports.Len(), ShouldEqual, 0)
+				So(dispatcher.Imports, ShouldBeEmpty)
+			})
+		})
+
+		Convey("When another user runs the import", func() {
+			So(start(), ShouldBeNil)
+			err := runHandler.Handle(ctx, command.RunImport{ImportID: importID, UserID: uuid.NewString()})
+
+			Convey("Then it is not found", func() {
+				appErr := apperror.GetAppError(err)
+				So(appErr, ShouldNotBeNil)
+				So(appErr.Code, ShouldEqual, apperror.ErrCodeNotFound)
+			})
+		})
+	})
+}
@@ -2,20 +2,25 @@ package command
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
+	habitevents "github.com/semmidev/ethos-go/contracts/events/habits"
 	"github.com/semmidev/ethos-go/internal/common/apperror"
 	"github.com/semmidev/ethos-go/internal/common/decorator"
 	"github.com/semmidev/ethos-go/internal/common/events"
 	"github.com/semmidev/ethos-go/internal/common/logger"
 	"github.com/semmidev/ethos-go/internal/common/validator"
 	"github.com/semmidev/ethos-go/internal/habits/adapters"
-	habitevents "github.com/semmidev/ethos-go/internal/habits/domain/events"
 	"github.com/semmidev/ethos-go/internal/habits/domain/habit"
 	"github.com/semmidev/ethos-go/internal/habits/domain/habitlog"
 )
 
-// LogHabit command encapsulates logging a habit completion
+// LogHabit command encapsulates logging a habit completion. A habit has one
+// log per day: logging it again adds to that day's count, which is capped at
+// the habit's target plus the configured overshoot. SetHabitLogCount sets
+// the count outright.
 type LogHabit struct {
 	LogID   string
 	HabitID string
@@ -23,16 +28,30 @@ type LogHabit struct {
 	LogDate time.Time `json:"log_date" validate:"required"`
 	Count   int       `json:"count" validate:"required,min=1"`
 	Note    *string   `json:"note"`
+	// Override skips the backdating window and log lock. Only admin tooling
+	// sets it; user-facing ports never do.
+	Override bool
+}
+
+// LogHabitResult describes the day's log after a completion was logged
+type LogHabitResult struct {
+	// LogID is the day's log: the command's LogID, or the existing log's
+	// when the habit was already logged that day
+	LogID string
+	// TotalToday is the day's count including this completion
+	TotalToday int
 }
 
 // LogHabitHandler processes habit logging commands
-type LogHabitHandler decorator.CommandHandler[LogHabit]
+type LogHabitHandler decorator.CommandHandlerWithResult[LogHabit, *LogHabitResult]
 
 type logHabitHandler struct {
-	uow       adapters.HabitsUnitOfWork
-	validator *validator.Validator
-	streakSvc *habit.StreakService
-	publisher events.Publisher
+	uow          adapters.HabitsUnitOfWork
+	validator    *validator.Validator
+	streakSvc    *habit.StreakService
+	publisher    events.Publisher
+	backdateDays int
+	maxOvershoot int
 }
 
 // NewLogHabitHandler creates a new handler with decorators
@@ -40,6 +59,8 @@ func NewLogHabitHandler(
 	uow adapters.HabitsUnitOfWork,
 	validator *validator.Validator,
 	publisher events.Publisher,
+	backdateDays int,
+	maxOvershoot int,
 	log logger.Logger,
 	metricsClient decorator.MetricsClient,
 ) LogHabitHandler {
@@ -47,19 +68,21 @@ func NewLogHabitHandler(
 		panic("nil unit of work")
 	}
 
-	return decorator.ApplyCommandDecorators(
+	return decorator.ApplyCommandResultDecorators(
 		logHabitHandler{
-			uow:       uow,
-			validator: validator,
-			streakSvc: habit.NewStreakService(),
-			publisher: publisher,
+			uow:          uow,
+			validator:    validator,
+			streakSvc:    habit.NewStreakService(),
+			publisher:    publisher,
+			backdateDays: backdateDays,
+			maxOvershoot: maxOvershoot,
 		},
 		log,
 		metricsClient,
 	)
 }
 
-func (h logHabitHandler) Handle(ctx context.Context, cmd LogHabit) error {
+func (h logHabitHandler) Handle(ctx context.Context, cmd LogHabit) (*LogHabitResult, error) {
 	// Validate input
 	if err := h.validator.Validate(cmd); err != nil {
 		if validationErrors, ok := validator.GetValidationErrors(err); ok {
@@ -67,85 +90,182 @@ func (h logHabitHandler) Handle(ctx context.Context, cmd LogHabit) error {
 			for _, ve := range validationErrors {
 				details[ve.Field] = ve.Message
 			}
-			return apperror.ValidationFailedWithDetails("validation failed", details)
+			return nil, apperror.ValidationFailedWithDetails("validation failed", details)
 		}
-		return apperror.ValidationFailed(err.Error())
+		return nil, apperror.ValidationFailed(err.Error())
 	}
 
 	// Verify habit exists and belongs to user (read can be outside transaction)
-	if _, err := h.uow.Habits().GetHabit(ctx, cmd.HabitID, cmd.UserID); err != nil {
-		return err
+	habitAgg, err := h.uow.Habits().GetHabit(ctx, cmd.HabitID, cmd.UserID)
+	if err != nil {
+		return nil, toNotFoundAppError(err, cmd.HabitID)
 	}
+	dailyCap := habitAgg.TargetCount() + h.maxOvershoot
 
-	// Create new log entry
-	newLog, err := habitlog.NewHabitLog(
-		cmd.LogID,
-		cmd.HabitID,
-		cmd.UserID,
-		cmd.LogDate,
-		cmd.Count,
-		cmd.Note,
-	)
-	if err != nil {
-		return err
+	if !cmd.Override {
+		policy, err := logDatePolicy(ctx, h.uow.HabitLogs(), cmd.UserID, h.backdateDays)
+		if err != nil {
+			return nil, err
+		}
+		if err := policy.CanLog(cmd.LogDate, time.Now()); err != nil {
+			return nil, toLogPolicyAppError(err, policy)
+		}
 	}
 
-	// Use Unit of Work pattern for transactional consistency
-	var totalToday int
+	// The log, the stats and the completion event commit together, so
+	// concurrent logs of the same day cannot leave the stats behind
+	result := &LogHabitResult{}
 	err = h.uow.WithTransaction(ctx, func(txUow adapters.HabitsUnitOfWork) error {
-		// 1. Add the log entry
-		if err := txUow.HabitLogs().AddHabitLog(ctx, newLog); err != nil {
+		// 1. Add to the day's log, which stays locked until commit, or start it
+		added, err := addCompletions(ctx, txUow, cmd, dailyCap)
+		if err != nil {
 			return err
 		}
 
-		// 2. Get habit aggregate for streak calculation
-		habitAgg, err := txUow.Habits().GetHabit(ctx, cmd.HabitID, cmd.UserID)
+		// 2. Recalculate and persist streak stats
+		logs, err := recalculateHabitStats(ctx, txUow, h.streakSvc, cmd.HabitID, cmd.UserID)
 		if err != nil {
 			return err
 		}
 
-		// 3. Fetch all logs for this habit
-		logs, err := txUow.HabitLogs().ListHabitLogs(ctx, cmd.HabitID, cmd.UserID)
-		if err != nil {
-			return err
+		dayLog := logOnDay(logs, cmd.LogDate)
+		if dayLog == nil {
+			return habitlog.ErrNotFound
 		}
+		result.LogID = dayLog.LogID()
+		result.TotalToday = dayLog.Count()
+
+		// 3. Record the completion in the outbox
+		return txUow.Publisher(h.publisher).Publish(ctx, habitevents.NewHabitCompleted(
+			cmd.HabitID,
+			cmd.UserID,
+			result.LogID,
+			cmd.LogDate,
+			added,
+			result.TotalToday,
+		))
+	})
+	if errors.Is(err, habitlog.ErrDailyCap) {
+		return nil, apperror.BusinessRuleViolation("log_daily_cap",
+			fmt.Sprintf("this habit can be logged at most %d times a day", dailyCap))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// addCompletions adds cmd's completions to the day's log without taking it
+// past dailyCap, starting the log when there is none, and returns how many
+// were added
+func addCompletions(
+	ctx context.Context,
+	txUow adapters.HabitsUnitOfWork,
+	cmd LogHabit,
+	dailyCap int,
+) (int, error) {
+	// Two first logs of a day would both find no log to lock, and the
+	// insert would add their counts past the cap, so take the habit first
+	if err := txUow.Habits().LockHabitForUpdate(ctx, cmd.HabitID, cmd.UserID); err != nil {
+		return 0, err
+	}
 
-		// 4. Fetch active vacations
-		vacations, err := txUow.Habits().ListVacations(ctx, cmd.HabitID)
+	dayLog, err := txUow.HabitLogs().GetLatestHabitLogForUpdate(ctx, cmd.HabitID, cmd.LogDate, cmd.UserID)
+	if errors.Is(err, habitlog.ErrNotFound) {
+		newLog, err := habitlog.NewHabitLog(
+			cmd.LogID,
+			cmd.HabitID,
+			cmd.UserID,
+			cmd.LogDate,
+			min(cmd.Count, dailyCap),
+			cmd.Note,
+		)
 		if err != nil {
-			return err
+			return 0, err
 		}
-
-		// 5. Calculate and persist stats
-		stats := h.streakSvc.CalculateStreak(habitAgg, logs, vacations, time.Now())
-		if err := txUow.Habits().UpsertStats(ctx, stats); err != nil {
-			return err
+		if err := txUow.HabitLogs().AddHabitLog(ctx, newLog); err != nil {
+			return 0, err
 		}
+		return newLog.Count(), nil
+	}
+	if err != nil {
+		return 0, err
+	}
 
-		// Calculate total count for today (for event)
-		for _, l := range logs {
-			if l.LogDate().Year() == newLog.LogDate().Year() && l.LogDate().YearDay() == newLog.LogDate().YearDay() {
-				totalToday += l.Count()
+	var added int
+	err = txUow.HabitLogs().UpdateHabitLog(
+		ctx,
+		dayLog.LogID(),
+		cmd.UserID,
+		func(ctx context.Context, log *habitlog.HabitLog) (*habitlog.HabitLog, error) {
+			var err error
+			if added, err = log.AddCompletions(cmd.Count, dailyCap); err != nil {
+				return nil, err
 			}
-		}
+			if cmd.Note != nil {
+				log.UpdateNote(cmd.Note)
+			}
+			return log, nil
+		},
+	)
+	return added, err
+}
 
-		return nil
-	})
+// recalculateHabitStats recomputes and persists a habit's streak stats from
+// its logs and vacations, returning the logs it read
+func recalculateHabitStats(
+	ctx context.Context,
+	txUow adapters.HabitsUnitOfWork,
+	streakSvc *habit.StreakService,
+	habitID, userID string,
+) ([]*habitlog.HabitLog, error) {
+	habitAgg, err := txUow.Habits().GetHabit(ctx, habitID, userID)
+	if err != nil {
+		return nil, err
+	}
 
+	logs, err := txUow.HabitLogs().ListHabitLogs(ctx, habitID, userID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	// Publish event (outside transaction - fire-and-forget)
-	event := habitevents.NewHabitCompleted(
-		cmd.HabitID,
-		cmd.UserID,
-		cmd.LogID,
-		cmd.LogDate,
-		cmd.Count,
-		totalToday,
-	)
-	_ = h.publisher.Publish(ctx, event)
+	vacations, err := txUow.Habits().ListVacations(ctx, habitID)
+	if err != nil {
+		return nil, err
+	}
 
+	compacted, err := txUow.HabitLogs().GetCompactedDays(ctx, habitID)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := streakSvc.CalculateStreak(habitAgg, logs, vacations, time.Now())
+	stats.AddCompactedCompletions(compacted)
+	if err := txUow.Habits().UpsertStats(ctx, stats); err != nil {
+		return nil, err
+	}
+
+	return logs, nil
+}
+
+// logOnDay returns the log on date's day, if any
+func logOnDay(logs []*habitlog.HabitLog, date time.Time) *habitlog.HabitLog {
+	for _, l := range logs {
+		if l.LogDate().Year() == date.Year() && l.LogDate().YearDay() == date.YearDay() {
+			return l
+		}
+	}
 	return nil
 }
+
+// countOnDay sums the completions logged on date's day
+func countOnDay(logs []*habitlog.HabitLog, date time.Time) int {
+	total := 0
+	for _, l := range logs {
+		if l.LogDate().Year() == date.Year() && l.LogDate().YearDay() == date.YearDay() {
+			total += l.Count()
+		}
+	}
+	return total
+}
@@ -0,0 +1,189 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	habitevents "github.com/semmidev/ethos-go/contracts/events/habits"
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/validator"
+	"github.com/semmidev/ethos-go/internal/habits/app/command"
+	"github.com/semmidev/ethos-go/internal/testutil"
+)
+
+func TestLogHabitHandler(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given a LogHabit handler backed by in-memory repositories", t, func() {
+		ctx := context.Background()
+		owner := testutil.NewHabitBuilder().
+			WithName("Read a book").
+			WithTargetCount(3).
+			CreatedAt(time.Now().AddDate(0, 0, -7)).
+			Build()
+		yesterday := testutil.NewHabitLogBuilder(owner).DaysAgo(1).Build()
+
+		uow := testutil.NewHabitsUnitOfWork(
+			testutil.NewHabitRepository(owner),
+			testutil.NewHabitLogRepository(yesterday),
+		)
+		publisher := testutil.NewRecordingPublisher()
+		handler := command.NewLogHabitHandler(
+			uow,
+			validator.New("en"),
+			publisher,
+			7,
+			1,
+			testutil.NopLogger{},
+			&decorator.NoOpMetricsClient{},
+		)
+
+		today := time.Now()
+
+		Convey("When the owner logs the habit for today", func() {
+			result, err := handler.Handle(ctx, command.LogHabit{
+				LogID:   "log-today",
+				HabitID: owner.HabitID(),
+				UserID:  owner.UserID(),
+				LogDate: today,
+				Count:   1,
+			})
+
+			Convey("Then it should succeed inside a transaction", func() {
+				So(err, ShouldBeNil)
+				So(uow.Transactions, ShouldEqual, 1)
+				So(uow.LogRepo.Len(), ShouldEqual, 2)
+				So(result.LogID, ShouldEqual, "log-today")
+				So(result.TotalToday, ShouldEqual, 1)
+			})
+
+			Convey("Then logging it again the same day adds to the day's log", func() {
+				again, err := handler.Handle(ctx, command.LogHabit{
+					LogID:   "log-today-again",
+					HabitID: owner.HabitID(),
+					UserID:  owner.UserID(),
+					LogDate: today,
+					Count:   2,
+				})
+				So(err, ShouldBeNil)
+				So(again.LogID, ShouldEqual, "log-today")
+				So(again.TotalToday, ShouldEqual, 3)
+				So(uow.LogRepo.Len(), ShouldEqual, 2)
+
+				Convey("And the day's count stops at the target plus the overshoot", func() {
+					capped, err := handler.Handle(ctx, command.LogHabit{
+						LogID:   "log-today-capped",
+						HabitID: owner.HabitID(),
+						UserID:  owner.UserID(),
+						LogDate: today,
+						Count:   5,
+					})
+					So(err, ShouldBeNil)
+					So(capped.TotalToday, ShouldEqual, 4)
+
+					_, err = handler.Handle(ctx, command.LogHabit{
+						LogID:   "log-today-over",
+						HabitID: owner.HabitID(),
+						UserID:  owner.UserID(),
+						LogDate: today,
+						Count:   1,
+					})
+					appErr := apperror.GetAppError(err)
+					So(appErr, ShouldNotBeNil)
+					So(appErr.Details["rule"], ShouldEqual, "log_daily_cap")
+				})
+			})
+
+			Convey("Then the streak stats should be persisted", func() {
+				stats, err := uow.HabitRepo.GetStats(ctx, owner.HabitID())
+				So(err, ShouldBeNil)
+				So(stats.CurrentStreak(), ShouldBeGreaterThan, 0)
+				So(stats.TotalCompletions(), ShouldEqual, 2)
+			})
+
+			Convey("Then a completion event should be published", func() {
+				So(publisher.EventTypes(), ShouldResemble, []string{habitevents.HabitCompletedType})
+			})
+		})
+
+		Convey("When another user logs the habit", func() {
+			_, err := handler.Handle(ctx, command.LogHabit{
+				LogID:   "log-intruder",
+				HabitID: owner.HabitID(),
+				UserID:  "someone-else",
+				LogDate: today,
+				Count:   1,
+			})
+
+			Convey("Then the habit is not found and nothing changes", func() {
+				So(apperror.GetAppError(err).Code, ShouldEqual, apperror.ErrCodeNotFound)
+				So(uow.LogRepo.Len(), ShouldEqual, 1)
+				So(publisher.Events(), ShouldBeEmpty)
+			})
+		})
+
+		Convey("When the owner logs a date outside the backdating window", func() {
+			cmd := command.LogHabit{
+				LogID:   "log-old",
+				HabitID: owner.HabitID(),
+				UserID:  owner.UserID(),
+				LogDate: today.AddDate(0, 0, -8),
+				Count:   1,
+			}
+			_, err := handler.Handle(ctx, cmd)
+
+			Convey("Then it should be rejected as a business rule violation", func() {
+				appErr := apperror.GetAppError(err)
+				So(appErr, ShouldNotBeNil)
+				So(appErr.Code, ShouldEqual, apperror.ErrCodeBusinessRuleViolation)
+				So(appErr.Details["rule"], ShouldEqual, "log_backdate_window")
+				So(uow.LogRepo.Len(), ShouldEqual, 1)
+			})
+
+			Convey("Then an admin override should still log it", func() {
+				cmd.Override = true
+				_, err := handler.Handle(ctx, cmd)
+				So(err, ShouldBeNil)
+				So(uow.LogRepo.Len(), ShouldEqual, 2)
+			})
+		})
+
+		Convey("When the owner has locked logs older than three days", func() {
+			uow.LogRepo.SetLogLockDays(owner.UserID(), 3)
+
+			_, err := handler.Handle(ctx, command.LogHabit{
+				LogID:   "log-locked",
+				HabitID: owner.HabitID(),
+				UserID:  owner.UserID(),
+				LogDate: today.AddDate(0, 0, -5),
+				Count:   1,
+			})
+
+			Convey("Then a date inside the backdating window is still rejected", func() {
+				appErr := apperror.GetAppError(err)
+				So(appErr, ShouldNotBeNil)
+				So(appErr.Details["rule"], ShouldEqual, "log_locked")
+			})
+		})
+
+		Convey("When the count is missing", func() {
+			_, err := handler.Handle(ctx, command.LogHabit{
+				LogID:   "log-invalid",
+				HabitID: owner.HabitID(),
+				UserID:  owner.UserID(),
+				LogDate: today,
+			})
+
+			Convey("Then it should fail validation", func() {
+				So(err, ShouldNotBeNil)
+				appErr := apperror.GetAppError(err)
+				So(appErr, ShouldNotBeNil)
+				So(appErr.Code, ShouldEqual, apperror.ErrCodeValidationFailed)
+			})
+		})
+	})
+}
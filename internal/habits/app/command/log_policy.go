@@ -0,0 +1,39 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/habits/domain/habitlog"
+)
+
+// logDatePolicy combines the configured backdating window with the user's
+// own log lock setting
+func logDatePolicy(
+	ctx context.Context,
+	repo habitlog.Repository,
+	userID string,
+	backdateDays int,
+) (habitlog.DatePolicy, error) {
+	lockDays, err := repo.GetLogLockDays(ctx, userID)
+	if err != nil {
+		return habitlog.DatePolicy{}, err
+	}
+	return habitlog.DatePolicy{BackdateDays: backdateDays, LockDays: lockDays}, nil
+}
+
+// toLogPolicyAppError translates date policy violations to application errors
+func toLogPolicyAppError(err error, policy habitlog.DatePolicy) error {
+	switch {
+	case errors.Is(err, habitlog.ErrBackdateTooFar):
+		return apperror.BusinessRuleViolation("log_backdate_window",
+			fmt.Sprintf("habits can only be logged up to %d days back", policy.BackdateDays))
+	case errors.Is(err, habitlog.ErrLocked):
+		return apperror.BusinessRuleViolation("log_locked",
+			fmt.Sprintf("logs older than %d days are locked", policy.LockDays))
+	default:
+		return err
+	}
+}
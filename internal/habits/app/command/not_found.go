@@ -0,0 +1,21 @@
+package command
+
+import (
+	"errors"
+
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/habits/domain/habit"
+	"github.com/semmidev/ethos-go/internal/habits/domain/habitlog"
+)
+
+// toNotFoundAppError translates the repositories' not found errors, which
+// also stand for another user's habits and logs, to application errors
+func toNotFoundAppError(err error, id string) error {
+	switch {
+	case errors.Is(err, habit.ErrNotFound):
+		return apperror.NotFound("habit", id)
+	case errors.Is(err, habitlog.ErrNotFound):
+		return apperror.NotFound("habit log", id)
+	}
+	return err
+}
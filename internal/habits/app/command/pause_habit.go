@@ -0,0 +1,116 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	habitevents "github.com/semmidev/ethos-go/contracts/events/habits"
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/dateutil"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/events"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/common/validator"
+	"github.com/semmidev/ethos-go/internal/habits/adapters"
+	"github.com/semmidev/ethos-go/internal/habits/domain/habit"
+)
+
+// PauseHabit command encapsulates pausing a habit until a date
+type PauseHabit struct {
+	HabitID    string    `validate:"uuid"`
+	UserID     string    `validate:"uuid"`
+	VacationID string    `validate:"uuid"`
+	Until      time.Time `json:"until" validate:"required"`
+}
+
+// PauseHabitHandler processes habit pause commands
+type PauseHabitHandler decorator.CommandHandler[PauseHabit]
+
+type pauseHabitHandler struct {
+	uow       adapters.HabitsUnitOfWork
+	validator *validator.Validator
+	publisher events.Publisher
+}
+
+// NewPauseHabitHandler creates a new handler with decorators
+func NewPauseHabitHandler(
+	uow adapters.HabitsUnitOfWork,
+	validator *validator.Validator,
+	publisher events.Publisher,
+	log logger.Logger,
+	metricsClient decorator.MetricsClient,
+) PauseHabitHandler {
+	if uow == nil {
+		panic("nil unit of work")
+	}
+
+	return decorator.ApplyCommandDecorators(
+		pauseHabitHandler{
+			uow:       uow,
+			validator: validator,
+			publisher: publisher,
+		},
+		log,
+		metricsClient,
+	)
+}
+
+func (h pauseHabitHandler) Handle(ctx context.Context, cmd PauseHabit) error {
+	// Validate input
+	if err := h.validator.Validate(cmd); err != nil {
+		if validationErrors, ok := validator.GetValidationErrors(err); ok {
+			details := make(map[string]interface{})
+			for _, ve := range validationErrors {
+				details[ve.Field] = ve.Message
+			}
+			return apperror.ValidationFailedWithDetails("validation failed", details)
+		}
+		return apperror.ValidationFailed(err.Error())
+	}
+
+	now := time.Now()
+	var pausedUntil time.Time
+
+	// The pause is also recorded as a vacation so the paused days do not
+	// break the streak
+	err := h.uow.WithTransaction(ctx, func(txUow adapters.HabitsUnitOfWork) error {
+		err := txUow.Habits().UpdateHabit(
+			ctx,
+			cmd.HabitID,
+			cmd.UserID,
+			func(ctx context.Context, habit *habit.Habit) (*habit.Habit, error) {
+				if err := habit.Pause(cmd.Until, now); err != nil {
+					return nil, err
+				}
+				pausedUntil = *habit.PausedUntil()
+				return habit, nil
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		reason := fmt.Sprintf("Paused until %s", dateutil.FormatDate(pausedUntil))
+		vacation, err := habit.NewHabitVacation(cmd.VacationID, cmd.HabitID, now, &reason)
+		if err != nil {
+			return err
+		}
+		if err := vacation.End(pausedUntil.AddDate(0, 0, -1)); err != nil {
+			return err
+		}
+
+		return txUow.Habits().AddVacation(ctx, vacation)
+	})
+	if err != nil {
+		if errors.Is(err, habit.ErrInvalidPauseDate) {
+			return apperror.InvalidInput("until", err.Error())
+		}
+		return toNotFoundAppError(err, cmd.HabitID)
+	}
+
+	_ = h.publisher.Publish(ctx, habitevents.NewHabitPaused(cmd.HabitID, cmd.UserID, pausedUntil))
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/smartystreets/goconvey/convey"
+
+	habitevents "github.com/semmidev/ethos-go/contracts/events/habits"
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/validator"
+	"github.com/semmidev/ethos-go/internal/habits/app/command"
+	"github.com/semmidev/ethos-go/internal/testutil"
+)
+
+func TestPauseHabitHandler(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given a PauseHabit handler and an active habit", t, func() {
+		ctx := context.Background()
+		h := testutil.NewHabitBuilder().Build()
+
+		uow := testutil.NewHabitsUnitOfWork(testutil.NewHabitRepository(h), nil)
+		publisher := testutil.NewRecordingPublisher()
+		handler := command.NewPauseHabitHandler(
+			uow,
+			validator.New("en"),
+			publisher,
+			testutil.NopLogger{},
+			&decorator.NoOpMetricsClient{},
+		)
+
+		Convey("When the owner pauses it for a week", func() {
+			until := time.Now().AddDate(0, 0, 7)
+			err := handler.Handle(ctx, command.PauseHabit{
+				HabitID:    h.HabitID(),
+				UserID:     h.UserID(),
+				VacationID: uuid.NewString(),
+				Until:      until,
+			})
+
+			Convey("Then the habit is inactive until that date", func() {
+				So(err, ShouldBeNil)
+				paused, err := uow.HabitRepo.GetHabit(ctx, h.HabitID(), h.UserID())
+				So(err, ShouldBeNil)
+				So(paused.IsActive(), ShouldBeFalse)
+				So(paused.PausedUntil().Format("2006-01-02"), ShouldEqual, until.Format("2006-01-02"))
+			})
+
+			Convey("Then the paused days are covered by a vacation", func() {
+				vacations, err := uow.HabitRepo.ListVacations(ctx, h.HabitID())
+				So(err, ShouldBeNil)
+				So(vacations, ShouldHaveLength, 1)
+				So(vacations[0].IsActiveOn(time.Now()), ShouldBeTrue)
+				So(vacations[0].IsActiveOn(until.AddDate(0, 0, -1)), ShouldBeTrue)
+				So(vacations[0].IsActiveOn(until), ShouldBeFalse)
+			})
+
+			Convey("Then a paused event is published", func() {
+				So(publisher.EventTypes(), ShouldResemble, []string{habitevents.HabitPausedType})
+			})
+		})
+
+		Convey("When the pause ends today", func() {
+			err := handler.Handle(ctx, command.PauseHabit{
+				HabitID:    h.HabitID(),
+				UserID:     h.UserID(),
+				VacationID: uuid.NewString(),
+				Until:      time.Now(),
+			})
+
+			Convey("Then it is rejected as invalid input", func() {
+				appErr := apperror.GetAppError(err)
+				So(appErr, ShouldNotBeNil)
+				So(appErr.Code, ShouldEqual, apperror.ErrCodeInvalidInput)
+				So(publisher.Events(), ShouldBeEmpty)
+			})
+		})
+	})
+}
+
+func TestResumePausedHabitsHandler(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given one habit whose pause has ended and one still paused", t, func() {
+		ctx := context.Background()
+		now := time.Now()
+		expired := testutil.NewHabitBuilder().PausedUntil(now.AddDate(0, 0, -1)).Build()
+		pending := testutil.NewHabitBuilder().PausedUntil(now.AddDate(0, 0, 3)).Build()
+
+		repo := testutil.NewHabitRepository(expired, pending)
+		publisher := testutil.NewRecordingPublisher()
+		handler := command.NewResumePausedHabitsHandler(
+			repo,
+			publisher,
+			testutil.NopLogger{},
+			&decorator.NoOpMetricsClient{},
+		)
+
+		Convey("When the scheduled resume runs", func() {
+			err := handler.Handle(ctx, command.ResumePausedHabits{Now: now})
+			So(err, ShouldBeNil)
+
+			Convey("Then only the expired pause is resumed", func() {
+				resumed, _ := repo.GetHabit(ctx, expired.HabitID(), expired.UserID())
+				So(resumed.IsActive(), ShouldBeTrue)
+				So(resumed.IsPaused(), ShouldBeFalse)
+
+				stillPaused, _ := repo.GetHabit(ctx, pending.HabitID(), pending.UserID())
+				So(stillPaused.IsActive(), ShouldBeFalse)
+				So(stillPaused.IsPaused(), ShouldBeTrue)
+
+				So(publisher.EventTypes(), ShouldResemble, []string{habitevents.HabitActivatedType})
+			})
+		})
+	})
+}
@@ -0,0 +1,193 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/common/validator"
+	"github.com/semmidev/ethos-go/internal/habits/adapters"
+	"github.com/semmidev/ethos-go/internal/habits/domain/habit"
+	"github.com/semmidev/ethos-go/internal/habits/domain/habitlog"
+)
+
+// defaultRecomputeWindow is how far back a habit must have been logged to be
+// checked when no ActiveSince is given. It spans two nightly runs so a
+// missed run is caught up.
+const defaultRecomputeWindow = 48 * time.Hour
+
+// RecomputeStats command rebuilds habit_stats from habit_logs and repairs
+// rows that drifted. With UserID set only that user's habits are checked
+// (or just HabitID); otherwise every habit logged since ActiveSince is.
+type RecomputeStats struct {
+	HabitID     string `validate:"omitempty,uuid"`
+	UserID      string `validate:"required_with=HabitID,omitempty,uuid"`
+	ActiveSince time.Time
+}
+
+// RecomputeStatsResult reports how many habits were checked and repaired
+type RecomputeStatsResult struct {
+	Checked  int
+	Repaired int
+}
+
+// RecomputeStatsHandler processes stats recomputation commands
+type RecomputeStatsHandler decorator.CommandHandlerWithResult[RecomputeStats, RecomputeStatsResult]
+
+type recomputeStatsHandler struct {
+	uow       adapters.HabitsUnitOfWork
+	validator *validator.Validator
+	streakSvc *habit.StreakService
+	log       logger.Logger
+	metrics   decorator.MetricsClient
+}
+
+// NewRecomputeStatsHandler creates a new handler with decorators
+func NewRecomputeStatsHandler(
+	uow adapters.HabitsUnitOfWork,
+	validator *validator.Validator,
+	log logger.Logger,
+	metricsClient decorator.MetricsClient,
+) RecomputeStatsHandler {
+	if uow == nil {
+		panic("nil unit of work")
+	}
+
+	return decorator.ApplyCommandResultDecorators(
+		recomputeStatsHandler{
+			uow:       uow,
+			validator: validator,
+			streakSvc: habit.NewStreakService(),
+			log:       log,
+			metrics:   metricsClient,
+		},
+		log,
+		metricsClient,
+	)
+}
+
+func (h recomputeStatsHandler) Handle(ctx context.Context, cmd RecomputeStats) (RecomputeStatsResult, error) {
+	// Validate input
+	if err := h.validator.Validate(cmd); err != nil {
+		if validationErrors, ok := validator.GetValidationErrors(err); ok {
+			details := make(map[string]interface{})
+			for _, ve := range validationErrors {
+				details[ve.Field] = ve.Message
+			}
+			return RecomputeStatsResult{}, apperror.ValidationFailedWithDetails("validation failed", details)
+		}
+		return RecomputeStatsResult{}, apperror.ValidationFailed(err.Error())
+	}
+
+	refs, err := h.targets(ctx, cmd)
+	if err != nil {
+		return RecomputeStatsResult{}, err
+	}
+
+	var (
+		result RecomputeStatsResult
+		errs   []error
+	)
+	for _, ref := range refs {
+		repaired, err := h.recompute(ctx, ref)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		result.Checked++
+		if repaired {
+			result.Repaired++
+		}
+	}
+
+	h.metrics.Inc("habits.stats.checked", result.Checked)
+	h.metrics.Inc("habits.stats.drift", result.Repaired)
+
+	return result, errors.Join(errs...)
+}
+
+// targets resolves the habits the command covers
+func (h recomputeStatsHandler) targets(ctx context.Context, cmd RecomputeStats) ([]habitlog.HabitRef, error) {
+	switch {
+	case cmd.HabitID != "":
+		if _, err := h.uow.Habits().GetHabit(ctx, cmd.HabitID, cmd.UserID); err != nil {
+			if errors.Is(err, habit.ErrNotFound) {
+				return nil, apperror.NotFound("habit", cmd.HabitID)
+			}
+			return nil, err
+		}
+		return []habitlog.HabitRef{{HabitID: cmd.HabitID, UserID: cmd.UserID}}, nil
+
+	case cmd.UserID != "":
+		habits, err := h.uow.Habits().ListHabitsByUser(ctx, cmd.UserID)
+		if err != nil {
+			return nil, err
+		}
+		refs := make([]habitlog.HabitRef, len(habits))
+		for i, hb := range habits {
+			refs[i] = habitlog.HabitRef{HabitID: hb.HabitID(), UserID: hb.UserID()}
+		}
+		return refs, nil
+
+	default:
+		since := cmd.ActiveSince
+		if since.IsZero() {
+			since = time.Now().Add(-defaultRecomputeWindow)
+		}
+		return h.uow.HabitLogs().ListRecentlyLoggedHabits(ctx, since)
+	}
+}
+
+// recompute rebuilds one habit's stats and stores them if they drifted
+func (h recomputeStatsHandler) recompute(ctx context.Context, ref habitlog.HabitRef) (bool, error) {
+	var repaired bool
+
+	err := h.uow.WithTransaction(ctx, func(txUow adapters.HabitsUnitOfWork) error {
+		habitAgg, err := txUow.Habits().GetHabit(ctx, ref.HabitID, ref.UserID)
+		if err != nil {
+			return err
+		}
+
+		logs, err := txUow.HabitLogs().ListHabitLogs(ctx, ref.HabitID, ref.UserID)
+		if err != nil {
+			return err
+		}
+
+		vacations, err := txUow.Habits().ListVacations(ctx, ref.HabitID)
+		if err != nil {
+			return err
+		}
+
+		stored, err := txUow.Habits().GetStats(ctx, ref.HabitID)
+		if err != nil {
+			return err
+		}
+
+		compacted, err := txUow.HabitLogs().GetCompactedDays(ctx, ref.HabitID)
+		if err != nil {
+			return err
+		}
+
+		computed := h.streakSvc.CalculateStreak(habitAgg, logs, vacations, time.Now())
+		computed.AddCompactedCompletions(compacted)
+		if computed.Matches(stored) {
+			return nil
+		}
+
+		h.log.Warn(ctx, "habit stats drifted from logs",
+			logger.Field{Key: "habit_id", Value: ref.HabitID},
+			logger.Field{Key: "stored_current_streak", Value: stored.CurrentStreak()},
+			logger.Field{Key: "computed_current_streak", Value: computed.CurrentStreak()},
+			logger.Field{Key: "stored_total_completions", Value: stored.TotalCompletions()},
+			logger.Field{Key: "computed_total_completions", Value: computed.TotalCompletions()},
+		)
+
+		repaired = true
+		return txUow.Habits().UpsertStats(ctx, computed)
+	})
+
+	return repaired, err
+}
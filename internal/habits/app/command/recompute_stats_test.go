@@ -0,0 +1,83 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/validator"
+	"github.com/semmidev/ethos-go/internal/habits/app/command"
+	"github.com/semmidev/ethos-go/internal/habits/domain/habit"
+	"github.com/semmidev/ethos-go/internal/testutil"
+)
+
+func TestRecomputeStatsHandler(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given a habit whose stored stats drifted from its logs", t, func() {
+		ctx := context.Background()
+		owner := testutil.NewHabitBuilder().CreatedAt(time.Now().AddDate(0, 0, -7)).Build()
+		idle := testutil.NewHabitBuilder().WithUserID(owner.UserID()).Build()
+
+		habitRepo := testutil.NewHabitRepository(owner, idle)
+		So(habitRepo.UpsertStats(ctx, habit.UnmarshalStatsFromDatabase(owner.HabitID(), 9, 9, 9, nil, 0, time.Now())), ShouldBeNil)
+
+		uow := testutil.NewHabitsUnitOfWork(habitRepo, testutil.NewHabitLogRepository(
+			testutil.NewHabitLogBuilder(owner).Build(),
+			testutil.NewHabitLogBuilder(owner).DaysAgo(1).Build(),
+		))
+		metrics := testutil.NewRecordingMetricsClient()
+		handler := command.NewRecomputeStatsHandler(uow, validator.New("en"), testutil.NopLogger{}, metrics)
+
+		Convey("When the nightly check runs over recently logged habits", func() {
+			result, err := handler.Handle(ctx, command.RecomputeStats{})
+
+			Convey("Then only the logged habit is checked and its stats repaired", func() {
+				So(err, ShouldBeNil)
+				So(result, ShouldResemble, command.RecomputeStatsResult{Checked: 1, Repaired: 1})
+
+				stats, err := uow.HabitRepo.GetStats(ctx, owner.HabitID())
+				So(err, ShouldBeNil)
+				So(stats.CurrentStreak(), ShouldBeGreaterThan, 0)
+				So(stats.TotalCompletions(), ShouldEqual, 2)
+			})
+
+			Convey("Then the drift is counted in metrics", func() {
+				So(metrics.Count("habits.stats.checked"), ShouldEqual, 1)
+				So(metrics.Count("habits.stats.drift"), ShouldEqual, 1)
+			})
+
+			Convey("And it runs again", func() {
+				result, err := handler.Handle(ctx, command.RecomputeStats{})
+
+				Convey("Then nothing is left to repair", func() {
+					So(err, ShouldBeNil)
+					So(result, ShouldResemble, command.RecomputeStatsResult{Checked: 1, Repaired: 0})
+				})
+			})
+		})
+
+		Convey("When recomputing every habit of the user", func() {
+			result, err := handler.Handle(ctx, command.RecomputeStats{UserID: owner.UserID()})
+
+			Convey("Then habits without logs are checked too", func() {
+				So(err, ShouldBeNil)
+				So(result.Checked, ShouldEqual, 2)
+				So(result.Repaired, ShouldEqual, 1)
+			})
+		})
+
+		Convey("When a habit is named without its owner", func() {
+			_, err := handler.Handle(ctx, command.RecomputeStats{HabitID: owner.HabitID()})
+
+			Convey("Then it should fail validation", func() {
+				appErr := apperror.GetAppError(err)
+				So(appErr, ShouldNotBeNil)
+				So(appErr.Code, ShouldEqual, apperror.ErrCodeValidationFailed)
+			})
+		})
+	})
+}
@@ -0,0 +1,94 @@
+package command
+
+import (
+	"context"
+	"errors"
+
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/common/validator"
+)
+
+// RefreshDashboards command recomputes the stored dashboard summary of one
+// user, or of every user with habits when UserID is empty. Event handlers
+// refresh single users; the full rebuild recovers a drifted projection.
+type RefreshDashboards struct {
+	UserID string `validate:"omitempty,uuid"`
+}
+
+// RefreshDashboardsResult reports how many dashboards were refreshed
+type RefreshDashboardsResult struct {
+	Refreshed int
+}
+
+// RefreshDashboardsHandler processes dashboard refresh commands
+type RefreshDashboardsHandler decorator.CommandHandlerWithResult[RefreshDashboards, RefreshDashboardsResult]
+
+// DashboardProjection stores the per-user dashboard read model
+type DashboardProjection interface {
+	Refresh(ctx context.Context, userID string) error
+	ListDashboardUsers(ctx context.Context) ([]string, error)
+}
+
+type refreshDashboardsHandler struct {
+	projection DashboardProjection
+	validator  *validator.Validator
+}
+
+// NewRefreshDashboardsHandler creates a new handler with decorators
+func NewRefreshDashboardsHandler(
+	projection DashboardProjection,
+	validator *validator.Validator,
+	log logger.Logger,
+	metricsClient decorator.MetricsClient,
+) RefreshDashboardsHandler {
+	if projection == nil {
+		panic("nil dashboard projection")
+	}
+
+	return decorator.ApplyCommandResultDecorators(
+		refreshDashboardsHandler{
+			projection: projection,
+			validator:  validator,
+		},
+		log,
+		metricsClient,
+	)
+}
+
+func (h refreshDashboardsHandler) Handle(ctx context.Context, cmd RefreshDashboards) (RefreshDashboardsResult, error) {
+	// Validate input
+	if err := h.validator.Validate(cmd); err != nil {
+		if validationErrors, ok := validator.GetValidationErrors(err); ok {
+			details := make(map[string]interface{})
+			for _, ve := range validationErrors {
+				details[ve.Field] = ve.Message
+			}
+			return RefreshDashboardsResult{}, apperror.ValidationFailedWithDetails("validation failed", details)
+		}
+		return RefreshDashboardsResult{}, apperror.ValidationFailed(err.Error())
+	}
+
+	userIDs := []string{cmd.UserID}
+	if cmd.UserID == "" {
+		var err error
+		if userIDs, err = h.projection.ListDashboardUsers(ctx); err != nil {
+			return RefreshDashboardsResult{}, err
+		}
+	}
+
+	var (
+		result RefreshDashboardsResult
+		errs   []error
+	)
+	for _, userID := range userIDs {
+		if err := h.projection.Refresh(ctx, userID); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		result.Refreshed++
+	}
+
+	return result, errors.Join(errs...)
+}
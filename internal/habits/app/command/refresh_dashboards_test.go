@@ -0,0 +1,75 @@
+package command_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/common/random"
+	"github.com/semmidev/ethos-go/internal/common/validator"
+	"github.com/semmidev/ethos-go/internal/habits/app/command"
+	"github.com/semmidev/ethos-go/internal/testutil"
+)
+
+// fakeDashboards records refreshes and fails for the users in failing
+type fakeDashboards struct {
+	users     []string
+	failing   map[string]bool
+	refreshed []string
+}
+
+func (f *fakeDashboards) Refresh(_ context.Context, userID string) error {
+	if f.failing[userID] {
+		return errors.New("database unavailable")
+	}
+	f.refreshed = append(f.refreshed, userID)
+	return nil
+}
+
+func (f *fakeDashboards) ListDashboardUsers(context.Context) ([]string, error) {
+	return f.users, nil
+}
+
+func TestRefreshDashboardsHandler(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given three users with habits", t, func() {
+		ctx := context.Background()
+		userA, userB, userC := random.NewUUID().String(), random.NewUUID().String(), random.NewUUID().String()
+		dashboards := &fakeDashboards{users: []string{userA, userB, userC}}
+		handler := command.NewRefreshDashboardsHandler(dashboards, validator.New("en"), testutil.NopLogger{}, testutil.NewRecordingMetricsClient())
+
+		Convey("When one user's dashboard is refreshed", func() {
+			result, err := handler.Handle(ctx, command.RefreshDashboards{UserID: userB})
+
+			Convey("Then only that dashboard is recomputed", func() {
+				So(err, ShouldBeNil)
+				So(result.Refreshed, ShouldEqual, 1)
+				So(dashboards.refreshed, ShouldResemble, []string{userB})
+			})
+		})
+
+		Convey("When every dashboard is rebuilt and one fails", func() {
+			dashboards.failing = map[string]bool{userB: true}
+
+			result, err := handler.Handle(ctx, command.RefreshDashboards{})
+
+			Convey("Then the others are still rebuilt and the failure is reported", func() {
+				So(err, ShouldNotBeNil)
+				So(result.Refreshed, ShouldEqual, 2)
+				So(dashboards.refreshed, ShouldResemble, []string{userA, userC})
+			})
+		})
+
+		Convey("When the user ID is not a UUID", func() {
+			_, err := handler.Handle(ctx, command.RefreshDashboards{UserID: "nope"})
+
+			Convey("Then the command is rejected", func() {
+				So(err, ShouldNotBeNil)
+				So(dashboards.refreshed, ShouldBeEmpty)
+			})
+		})
+	})
+}
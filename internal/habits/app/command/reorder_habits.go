@@ -0,0 +1,80 @@
+package command
+
+import (
+	"context"
+	"errors"
+
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/common/validator"
+	"github.com/semmidev/ethos-go/internal/habits/adapters"
+	"github.com/semmidev/ethos-go/internal/habits/domain/habit"
+)
+
+// ReorderHabits command sets the user's custom habit order
+type ReorderHabits struct {
+	UserID   string   `validate:"uuid"`
+	HabitIDs []string `json:"habit_ids" validate:"required,min=1,dive,uuid"`
+}
+
+// ReorderHabitsHandler processes habit reorder commands
+type ReorderHabitsHandler decorator.CommandHandler[ReorderHabits]
+
+type reorderHabitsHandler struct {
+	uow       adapters.HabitsUnitOfWork
+	validator *validator.Validator
+}
+
+// NewReorderHabitsHandler creates a new handler with decorators
+func NewReorderHabitsHandler(
+	uow adapters.HabitsUnitOfWork,
+	validator *validator.Validator,
+	log logger.Logger,
+	metricsClient decorator.MetricsClient,
+) ReorderHabitsHandler {
+	if uow == nil {
+		panic("nil unit of work")
+	}
+
+	return decorator.ApplyCommandDecorators(
+		reorderHabitsHandler{
+			uow:       uow,
+			validator: validator,
+		},
+		log,
+		metricsClient,
+	)
+}
+
+func (h reorderHabitsHandler) Handle(ctx context.Context, cmd ReorderHabits) error {
+	// Validate input
+	if err := h.validator.Validate(cmd); err != nil {
+		if validationErrors, ok := validator.GetValidationErrors(err); ok {
+			details := make(map[string]interface{})
+			for _, ve := range validationErrors {
+				details[ve.Field] = ve.Message
+			}
+			return apperror.ValidationFailedWithDetails("validation failed", details)
+		}
+		return apperror.ValidationFailed(err.Error())
+	}
+
+	seen := make(map[string]bool, len(cmd.HabitIDs))
+	for _, id := range cmd.HabitIDs {
+		if seen[id] {
+			return apperror.InvalidInput("habit_ids", "habit "+id+" is listed more than once")
+		}
+		seen[id] = true
+	}
+
+	// Ownership is checked and positions written under one transaction so a
+	// concurrent reorder cannot interleave
+	err := h.uow.WithTransaction(ctx, func(txUow adapters.HabitsUnitOfWork) error {
+		return txUow.Habits().ReorderHabits(ctx, cmd.UserID, cmd.HabitIDs)
+	})
+	if errors.Is(err, habit.ErrNotFound) {
+		return apperror.NotFound("habit", "")
+	}
+	return err
+}
@@ -0,0 +1,94 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/validator"
+	"github.com/semmidev/ethos-go/internal/habits/app/command"
+	"github.com/semmidev/ethos-go/internal/testutil"
+)
+
+func TestReorderHabitsHandler(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given a user with three habits", t, func() {
+		ctx := context.Background()
+		userID := uuid.NewString()
+		now := time.Now()
+
+		first := testutil.NewHabitBuilder().WithUserID(userID).CreatedAt(now.Add(-3 * time.Hour)).Build()
+		second := testutil.NewHabitBuilder().WithUserID(userID).CreatedAt(now.Add(-2 * time.Hour)).Build()
+		third := testutil.NewHabitBuilder().WithUserID(userID).CreatedAt(now.Add(-time.Hour)).Build()
+		foreign := testutil.NewHabitBuilder().Build()
+
+		uow := testutil.NewHabitsUnitOfWork(testutil.NewHabitRepository(first, second, third, foreign), nil)
+		handler := command.NewReorderHabitsHandler(
+			uow,
+			validator.New("en"),
+			testutil.NopLogger{},
+			&decorator.NoOpMetricsClient{},
+		)
+
+		Convey("When the user moves the newest habit to the top", func() {
+			err := handler.Handle(ctx, command.ReorderHabits{
+				UserID:   userID,
+				HabitIDs: []string{third.HabitID()},
+			})
+
+			Convey("Then unlisted habits keep their order after it", func() {
+				So(err, ShouldBeNil)
+				So(uow.Transactions, ShouldEqual, 1)
+				So(uow.HabitRepo.Order(userID), ShouldResemble, []string{
+					third.HabitID(), first.HabitID(), second.HabitID(),
+				})
+			})
+		})
+
+		Convey("When the list includes another user's habit", func() {
+			err := handler.Handle(ctx, command.ReorderHabits{
+				UserID:   userID,
+				HabitIDs: []string{second.HabitID(), foreign.HabitID()},
+			})
+
+			Convey("Then it fails as not found and the order is unchanged", func() {
+				appErr := apperror.GetAppError(err)
+				So(appErr, ShouldNotBeNil)
+				So(appErr.Code, ShouldEqual, apperror.ErrCodeNotFound)
+				So(uow.HabitRepo.Order(userID), ShouldResemble, []string{
+					first.HabitID(), second.HabitID(), third.HabitID(),
+				})
+			})
+		})
+
+		Convey("When a habit is listed twice", func() {
+			err := handler.Handle(ctx, command.ReorderHabits{
+				UserID:   userID,
+				HabitIDs: []string{first.HabitID(), first.HabitID()},
+			})
+
+			Convey("Then it is rejected before touching the repository", func() {
+				appErr := apperror.GetAppError(err)
+				So(appErr, ShouldNotBeNil)
+				So(appErr.Code, ShouldEqual, apperror.ErrCodeInvalidInput)
+				So(uow.Transactions, ShouldEqual, 0)
+			})
+		})
+
+		Convey("When no habits are listed", func() {
+			err := handler.Handle(ctx, command.ReorderHabits{UserID: userID})
+
+			Convey("Then it should fail validation", func() {
+				appErr := apperror.GetAppError(err)
+				So(appErr, ShouldNotBeNil)
+				So(appErr.Code, ShouldEqual, apperror.ErrCodeValidationFailed)
+			})
+		})
+	})
+}
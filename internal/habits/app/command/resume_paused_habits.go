@@ -0,0 +1,83 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	habitevents "github.com/semmidev/ethos-go/contracts/events/habits"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/events"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/habits/domain/habit"
+)
+
+// ResumePausedHabits command reactivates every habit whose pause has ended
+type ResumePausedHabits struct {
+	Now time.Time
+}
+
+// ResumePausedHabitsHandler processes scheduled habit resumes
+type ResumePausedHabitsHandler decorator.CommandHandler[ResumePausedHabits]
+
+type resumePausedHabitsHandler struct {
+	repo      habit.Repository
+	publisher events.Publisher
+	log       logger.Logger
+}
+
+// NewResumePausedHabitsHandler creates a new handler with decorators
+func NewResumePausedHabitsHandler(
+	repo habit.Repository,
+	publisher events.Publisher,
+	log logger.Logger,
+	metricsClient decorator.MetricsClient,
+) ResumePausedHabitsHandler {
+	if repo == nil {
+		panic("nil habit repository")
+	}
+
+	return decorator.ApplyCommandDecorators(
+		resumePausedHabitsHandler{
+			repo:      repo,
+			publisher: publisher,
+			log:       log,
+		},
+		log,
+		metricsClient,
+	)
+}
+
+func (h resumePausedHabitsHandler) Handle(ctx context.Context, cmd ResumePausedHabits) error {
+	due, err := h.repo.ListPausedHabitsDue(ctx, cmd.Now)
+	if err != nil {
+		return err
+	}
+
+	// One failing habit must not keep the others paused
+	var errs []error
+	for _, paused := range due {
+		err := h.repo.UpdateHabit(
+			ctx,
+			paused.HabitID(),
+			paused.UserID(),
+			func(ctx context.Context, habit *habit.Habit) (*habit.Habit, error) {
+				if err := habit.Resume(); err != nil {
+					return nil, err
+				}
+				return habit, nil
+			},
+		)
+		if err != nil {
+			h.log.Error(ctx, err, "failed to resume paused habit",
+				logger.Field{Key: "habit_id", Value: paused.HabitID()},
+			)
+			errs = append(errs, err)
+			continue
+		}
+
+		_ = h.publisher.Publish(ctx, habitevents.NewHabitActivated(paused.HabitID(), paused.UserID()))
+	}
+
+	return errors.Join(errs...)
+}
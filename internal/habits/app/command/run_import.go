@@ -0,0 +1,194 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/common/random"
+	"github.com/semmidev/ethos-go/internal/habits/adapters"
+	"github.com/semmidev/ethos-go/internal/habits/domain/habit"
+	"github.com/semmidev/ethos-go/internal/habits/domain/habitimport"
+	"github.com/semmidev/ethos-go/internal/habits/domain/habitlog"
+)
+
+// RunImport command writes a queued import as habits and logs. It is run by
+// the worker and may be retried: habits written by an earlier attempt are
+// skipped.
+type RunImport struct {
+	ImportID string
+	UserID   string
+}
+
+// RunImportHandler processes import runs
+type RunImportHandler decorator.CommandHandler[RunImport]
+
+type runImportHandler struct {
+	uow       adapters.HabitsUnitOfWork
+	imports   habitimport.Repository
+	streakSvc *habit.StreakService
+	log       logger.Logger
+	metrics   decorator.MetricsClient
+}
+
+// NewRunImportHandler creates a new handler with decorators
+func NewRunImportHandler(
+	uow adapters.HabitsUnitOfWork,
+	imports habitimport.Repository,
+	log logger.Logger,
+	metricsClient decorator.MetricsClient,
+) RunImportHandler {
+	if uow == nil {
+		panic("nil unit of work")
+	}
+	if imports == nil {
+		panic("nil import repository")
+	}
+
+	return decorator.ApplyCommandDecorators(
+		runImportHandler{
+			uow:       uow,
+			imports:   imports,
+			streakSvc: habit.NewStreakService(),
+			log:       log,
+			metrics:   metricsClient,
+		},
+		log,
+		metricsClient,
+	)
+}
+
+func (h runImportHandler) Handle(ctx context.Context, cmd RunImport) error {
+	var imp *habitimport.Import
+	err := h.updateImport(ctx, cmd, func(record *habitimport.Import) error {
+		imp = record
+		return record.Start()
+	})
+	if errors.Is(err, habitimport.ErrAlreadyCompleted) {
+		// A redelivered task for a finished import
+		return nil
+	}
+	if errors.Is(err, habitimport.ErrNotFound) {
+		return apperror.NotFound("import", cmd.ImportID)
+	}
+	if err != nil {
+		return err
+	}
+
+	for i, imported := range imp.Habits() {
+		if i < imp.ImportedHabits() {
+			continue
+		}
+
+		logs, err := h.writeHabit(ctx, cmd.UserID, imported)
+		if err != nil {
+			h.log.Error(ctx, err, "failed to import habit",
+				logger.Field{Key: "import_id", Value: cmd.ImportID},
+				logger.Field{Key: "habit_id", Value: imported.HabitID},
+			)
+			reason := fmt.Sprintf("importing %q failed after %d of %d habits", imported.Name, i, imp.TotalHabits())
+			return errors.Join(err, h.updateImport(ctx, cmd, func(record *habitimport.Import) error {
+				record.Fail(reason)
+				return nil
+			}))
+		}
+
+		if err := h.updateImport(ctx, cmd, func(record *habitimport.Import) error {
+			record.HabitImported(logs)
+			return nil
+		}); err != nil {
+			return err
+		}
+		h.metrics.Inc("habits.import.habits", 1)
+		h.metrics.Inc("habits.import.logs", logs)
+	}
+
+	return h.updateImport(ctx, cmd, func(record *habitimport.Import) error {
+		record.Complete()
+		return nil
+	})
+}
+
+// writeHabit creates one imported habit with its logs and stats, returning
+// the number of logs written. A habit that already exists was written by an
+// earlier attempt and is left as it is.
+func (h runImportHandler) writeHabit(ctx context.Context, userID string, imported habitimport.Habit) (int, error) {
+	logs := 0
+
+	err := h.uow.WithTransaction(ctx, func(txUow adapters.HabitsUnitOfWork) error {
+		_, err := txUow.Habits().GetHabit(ctx, imported.HabitID, userID)
+		if err == nil {
+			logs = len(imported.Completions)
+			return nil
+		}
+		if !errors.Is(err, habit.ErrNotFound) {
+			return err
+		}
+
+		frequency, err := habit.NewFrequency(imported.Frequency)
+		if err != nil {
+			return err
+		}
+		recurrence, err := habit.NewRecurrenceFromDayNames(imported.Days, imported.Interval)
+		if err != nil {
+			return err
+		}
+
+		newHabit, err := habit.NewHabit(
+			imported.HabitID,
+			userID,
+			imported.Name,
+			imported.Description,
+			frequency,
+			recurrence,
+			imported.TargetCount,
+			nil,
+		)
+		if err != nil {
+			return err
+		}
+		if first := imported.FirstDate(); first != nil {
+			newHabit.BackdateCreation(*first)
+		}
+		if err := txUow.Habits().AddHabit(ctx, newHabit); err != nil {
+			return err
+		}
+
+		for _, c := range imported.Completions {
+			newLog, err := habitlog.NewHabitLog(
+				random.NewUUID().String(),
+				imported.HabitID,
+				userID,
+				c.Date,
+				c.Count,
+				nil,
+			)
+			if err != nil {
+				return err
+			}
+			if err := txUow.HabitLogs().AddHabitLog(ctx, newLog); err != nil {
+				return err
+			}
+		}
+		logs = len(imported.Completions)
+
+		_, err = recalculateHabitStats(ctx, txUow, h.streakSvc, imported.HabitID, userID)
+		return err
+	})
+
+	return logs, err
+}
+
+func (h runImportHandler) updateImport(ctx context.Context, cmd RunImport, fn func(*habitimport.Import) error) error {
+	return h.imports.UpdateImport(ctx, cmd.ImportID, cmd.UserID,
+		func(_ context.Context, imp *habitimport.Import) (*habitimport.Import, error) {
+			if err := fn(imp); err != nil {
+				return nil, err
+			}
+			return imp, nil
+		},
+	)
+}
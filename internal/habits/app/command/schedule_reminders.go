@@ -0,0 +1,102 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/habits/domain/habit"
+)
+
+// ScheduleReminders command computes when each habit is next reminded,
+// for habits never scheduled, whose user changed timezone, or whose
+// reminder at Now was just swept. It runs after every reminder sweep.
+type ScheduleReminders struct {
+	Now time.Time
+}
+
+// ReminderScheduleRepository stores when habits are next reminded
+type ReminderScheduleRepository interface {
+	// ListRemindersToSchedule returns the habits whose next reminder is
+	// not scheduled, was scheduled in another timezone, or is not after now
+	ListRemindersToSchedule(ctx context.Context, now time.Time) ([]habit.ReminderSchedule, error)
+	// SetNextReminder stores when the habit is next reminded and the
+	// timezone that was computed in
+	SetNextReminder(ctx context.Context, habitID string, at time.Time, timezone string) error
+}
+
+// ScheduleRemindersHandler processes reminder scheduling
+type ScheduleRemindersHandler decorator.CommandHandler[ScheduleReminders]
+
+type scheduleRemindersHandler struct {
+	repo ReminderScheduleRepository
+	log  logger.Logger
+}
+
+// NewScheduleRemindersHandler creates a new handler with decorators
+func NewScheduleRemindersHandler(
+	repo ReminderScheduleRepository,
+	log logger.Logger,
+	metricsClient decorator.MetricsClient,
+) ScheduleRemindersHandler {
+	if repo == nil {
+		panic("nil reminder schedule repository")
+	}
+
+	return decorator.ApplyCommandDecorators(
+		scheduleRemindersHandler{repo: repo, log: log},
+		log,
+		metricsClient,
+	)
+}
+
+func (h scheduleRemindersHandler) Handle(ctx context.Context, cmd ScheduleReminders) error {
+	now := cmd.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	schedules, err := h.repo.ListRemindersToSchedule(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	// The sweep runs every minute: a reminder newly scheduled for this
+	// minute is sent by the next sweep, one just swept waits for its next day
+	minute := now.Truncate(time.Minute)
+
+	// One failing habit must not leave the others unscheduled
+	var errs []error
+	for _, schedule := range schedules {
+		if err := h.schedule(ctx, schedule, minute); err != nil {
+			h.log.Error(ctx, err, "failed to schedule habit reminder",
+				logger.Field{Key: "habit_id", Value: schedule.HabitID},
+			)
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// schedule stores the habit's first reminder from minute when it is
+// rescheduled, and from the minute after otherwise
+func (h scheduleRemindersHandler) schedule(ctx context.Context, schedule habit.ReminderSchedule, minute time.Time) error {
+	loc, err := time.LoadLocation(schedule.Timezone)
+	if err != nil {
+		return err
+	}
+
+	from := minute.Add(time.Minute)
+	if schedule.Rescheduled {
+		from = minute
+	}
+
+	next, err := habit.NextReminder(schedule.ReminderTime, loc, from)
+	if err != nil {
+		return err
+	}
+	return h.repo.SetNextReminder(ctx, schedule.HabitID, next.UTC(), schedule.Timezone)
+}
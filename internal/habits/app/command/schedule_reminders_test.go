@@ -0,0 +1,83 @@
+package command_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/habits/app/command"
+	"github.com/semmidev/ethos-go/internal/habits/domain/habit"
+	"github.com/semmidev/ethos-go/internal/testutil"
+)
+
+// reminderSchedules lists fixed schedules and records what is stored
+type reminderSchedules struct {
+	due  []habit.ReminderSchedule
+	next map[string]time.Time
+	fail string
+}
+
+func (r *reminderSchedules) ListRemindersToSchedule(context.Context, time.Time) ([]habit.ReminderSchedule, error) {
+	return r.due, nil
+}
+
+func (r *reminderSchedules) SetNextReminder(_ context.Context, habitID string, at time.Time, _ string) error {
+	if habitID == r.fail {
+		return errors.New("connection reset")
+	}
+	r.next[habitID] = at
+	return nil
+}
+
+func TestScheduleRemindersHandler(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given habits reminded at 08:00 in New York, swept at 08:00:20 there", t, func() {
+		ctx := context.Background()
+		newYork, err := time.LoadLocation("America/New_York")
+		So(err, ShouldBeNil)
+		now := time.Date(2026, 6, 1, 8, 0, 20, 0, newYork)
+		eight := "08:00"
+
+		repo := &reminderSchedules{next: make(map[string]time.Time)}
+		handler := command.NewScheduleRemindersHandler(repo, testutil.NopLogger{}, &decorator.NoOpMetricsClient{})
+
+		Convey("When a reminder due now was just swept", func() {
+			repo.due = []habit.ReminderSchedule{{HabitID: "swept", ReminderTime: &eight, Timezone: newYork.String()}}
+			err := handler.Handle(ctx, command.ScheduleReminders{Now: now})
+
+			Convey("Then it is next due tomorrow", func() {
+				So(err, ShouldBeNil)
+				So(repo.next["swept"], ShouldEqual, time.Date(2026, 6, 2, 12, 0, 0, 0, time.UTC))
+			})
+		})
+
+		Convey("When a reminder for this minute is scheduled for the first time", func() {
+			repo.due = []habit.ReminderSchedule{{HabitID: "new", ReminderTime: &eight, Timezone: newYork.String(), Rescheduled: true}}
+			err := handler.Handle(ctx, command.ScheduleReminders{Now: now})
+
+			Convey("Then it is due at once, for the next sweep to send", func() {
+				So(err, ShouldBeNil)
+				So(repo.next["new"], ShouldEqual, time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC))
+			})
+		})
+
+		Convey("When storing one habit's reminder fails", func() {
+			repo.due = []habit.ReminderSchedule{
+				{HabitID: "failing", ReminderTime: &eight, Timezone: newYork.String()},
+				{HabitID: "other", Timezone: "Asia/Jakarta"},
+			}
+			repo.fail = "failing"
+			err := handler.Handle(ctx, command.ScheduleReminders{Now: now})
+
+			Convey("Then the others are still scheduled", func() {
+				So(err, ShouldNotBeNil)
+				So(repo.next["other"], ShouldEqual, time.Date(2026, 6, 1, 13, 0, 0, 0, time.UTC))
+			})
+		})
+	})
+}
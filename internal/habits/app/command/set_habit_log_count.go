@@ -0,0 +1,167 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	habitevents "github.com/semmidev/ethos-go/contracts/events/habits"
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/events"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/common/validator"
+	"github.com/semmidev/ethos-go/internal/habits/adapters"
+	"github.com/semmidev/ethos-go/internal/habits/domain/habit"
+	"github.com/semmidev/ethos-go/internal/habits/domain/habitlog"
+)
+
+// SetHabitLogCount command sets a day's completion count outright,
+// starting the day's log when there is none. Unlike LogHabit the count is
+// not capped at the habit's target.
+type SetHabitLogCount struct {
+	// LogID is used when the day has no log yet
+	LogID   string
+	HabitID string    `validate:"uuid"`
+	UserID  string    `validate:"uuid"`
+	LogDate time.Time `json:"log_date" validate:"required"`
+	Count   int       `json:"count" validate:"required,min=1"`
+}
+
+// SetHabitLogCountHandler processes set count commands
+type SetHabitLogCountHandler decorator.CommandHandlerWithResult[SetHabitLogCount, *LogHabitResult]
+
+type setHabitLogCountHandler struct {
+	uow          adapters.HabitsUnitOfWork
+	validator    *validator.Validator
+	streakSvc    *habit.StreakService
+	publisher    events.Publisher
+	backdateDays int
+}
+
+// NewSetHabitLogCountHandler creates a new handler with decorators
+func NewSetHabitLogCountHandler(
+	uow adapters.HabitsUnitOfWork,
+	validator *validator.Validator,
+	publisher events.Publisher,
+	backdateDays int,
+	log logger.Logger,
+	metricsClient decorator.MetricsClient,
+) SetHabitLogCountHandler {
+	if uow == nil {
+		panic("nil unit of work")
+	}
+
+	return decorator.ApplyCommandResultDecorators(
+		setHabitLogCountHandler{
+			uow:          uow,
+			validator:    validator,
+			streakSvc:    habit.NewStreakService(),
+			publisher:    publisher,
+			backdateDays: backdateDays,
+		},
+		log,
+		metricsClient,
+	)
+}
+
+func (h setHabitLogCountHandler) Handle(ctx context.Context, cmd SetHabitLogCount) (*LogHabitResult, error) {
+	// Validate input
+	if err := h.validator.Validate(cmd); err != nil {
+		if validationErrors, ok := validator.GetValidationErrors(err); ok {
+			details := make(map[string]interface{})
+			for _, ve := range validationErrors {
+				details[ve.Field] = ve.Message
+			}
+			return nil, apperror.ValidationFailedWithDetails("validation failed", details)
+		}
+		return nil, apperror.ValidationFailed(err.Error())
+	}
+
+	if _, err := h.uow.Habits().GetHabit(ctx, cmd.HabitID, cmd.UserID); err != nil {
+		return nil, toNotFoundAppError(err, cmd.HabitID)
+	}
+
+	policy, err := logDatePolicy(ctx, h.uow.HabitLogs(), cmd.UserID, h.backdateDays)
+	if err != nil {
+		return nil, err
+	}
+	if err := policy.CanLog(cmd.LogDate, time.Now()); err != nil {
+		return nil, toLogPolicyAppError(err, policy)
+	}
+
+	result := &LogHabitResult{TotalToday: cmd.Count}
+	err = h.uow.WithTransaction(ctx, func(txUow adapters.HabitsUnitOfWork) error {
+		logID, event, err := setDayCount(ctx, txUow, cmd)
+		if err != nil {
+			return err
+		}
+		result.LogID = logID
+
+		if _, err := recalculateHabitStats(ctx, txUow, h.streakSvc, cmd.HabitID, cmd.UserID); err != nil {
+			return err
+		}
+
+		if event == nil {
+			return nil
+		}
+		return txUow.Publisher(h.publisher).Publish(ctx, *event)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// setDayCount sets the count of the day's log, which stays locked until
+// commit, or starts it. It returns the day's log ID and the completion
+// event to publish, nil when the count did not change.
+func setDayCount(
+	ctx context.Context,
+	txUow adapters.HabitsUnitOfWork,
+	cmd SetHabitLogCount,
+) (string, *habitevents.HabitCompleted, error) {
+	// A concurrent first log of the day would make the insert add to, not
+	// set, the count, so take the habit first
+	if err := txUow.Habits().LockHabitForUpdate(ctx, cmd.HabitID, cmd.UserID); err != nil {
+		return "", nil, err
+	}
+
+	dayLog, err := txUow.HabitLogs().GetLatestHabitLogForUpdate(ctx, cmd.HabitID, cmd.LogDate, cmd.UserID)
+	if errors.Is(err, habitlog.ErrNotFound) {
+		newLog, err := habitlog.NewHabitLog(cmd.LogID, cmd.HabitID, cmd.UserID, cmd.LogDate, cmd.Count, nil)
+		if err != nil {
+			return "", nil, err
+		}
+		if err := txUow.HabitLogs().AddHabitLog(ctx, newLog); err != nil {
+			return "", nil, err
+		}
+		event := habitevents.NewHabitCompleted(cmd.HabitID, cmd.UserID, cmd.LogID, cmd.LogDate, cmd.Count, cmd.Count)
+		return cmd.LogID, &event, nil
+	}
+	if err != nil {
+		return "", nil, err
+	}
+
+	delta := cmd.Count - dayLog.Count()
+	if delta == 0 {
+		return dayLog.LogID(), nil, nil
+	}
+	err = txUow.HabitLogs().UpdateHabitLog(
+		ctx,
+		dayLog.LogID(),
+		cmd.UserID,
+		func(ctx context.Context, log *habitlog.HabitLog) (*habitlog.HabitLog, error) {
+			if err := log.UpdateCount(cmd.Count); err != nil {
+				return nil, err
+			}
+			return log, nil
+		},
+	)
+	if err != nil {
+		return "", nil, err
+	}
+	event := habitevents.NewHabitCompletionCorrected(cmd.HabitID, cmd.UserID, dayLog.LogID(), cmd.LogDate, delta, cmd.Count)
+	return dayLog.LogID(), &event, nil
+}
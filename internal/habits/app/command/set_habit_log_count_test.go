@@ -0,0 +1,102 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/smartystreets/goconvey/convey"
+
+	habitevents "github.com/semmidev/ethos-go/contracts/events/habits"
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/validator"
+	"github.com/semmidev/ethos-go/internal/habits/app/command"
+	"github.com/semmidev/ethos-go/internal/testutil"
+)
+
+func TestSetHabitLogCountHandler(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given a habit logged twice today", t, func() {
+		ctx := context.Background()
+		owner := testutil.NewHabitBuilder().CreatedAt(time.Now().AddDate(0, 0, -7)).Build()
+		todayLog := testutil.NewHabitLogBuilder(owner).WithCount(2).Build()
+		today := todayLog.LogDate()
+
+		uow := testutil.NewHabitsUnitOfWork(
+			testutil.NewHabitRepository(owner),
+			testutil.NewHabitLogRepository(todayLog),
+		)
+		publisher := testutil.NewRecordingPublisher()
+		handler := command.NewSetHabitLogCountHandler(
+			uow,
+			validator.New("en"),
+			publisher,
+			7,
+			testutil.NopLogger{},
+			&decorator.NoOpMetricsClient{},
+		)
+		set := command.SetHabitLogCount{
+			LogID:   uuid.NewString(),
+			HabitID: owner.HabitID(),
+			UserID:  owner.UserID(),
+			LogDate: today,
+		}
+
+		Convey("When the owner sets today's count past the target", func() {
+			set.Count = 5
+			result, err := handler.Handle(ctx, set)
+
+			Convey("Then today's log holds exactly that count", func() {
+				So(err, ShouldBeNil)
+				So(result.LogID, ShouldEqual, todayLog.LogID())
+				So(result.TotalToday, ShouldEqual, 5)
+				So(uow.LogRepo.Len(), ShouldEqual, 1)
+				So(uow.Transactions, ShouldEqual, 1)
+			})
+
+			Convey("Then a correction event carries the change", func() {
+				So(publisher.EventTypes(), ShouldResemble, []string{habitevents.HabitCompletedType})
+				event := publisher.Events()[0].(habitevents.HabitCompleted)
+				So(event.Correction, ShouldBeTrue)
+				So(event.Count, ShouldEqual, 3)
+				So(event.TotalToday, ShouldEqual, 5)
+			})
+		})
+
+		Convey("When the owner sets the count it already has", func() {
+			set.Count = 2
+			_, err := handler.Handle(ctx, set)
+
+			Convey("Then nothing is published", func() {
+				So(err, ShouldBeNil)
+				So(publisher.Events(), ShouldBeEmpty)
+			})
+		})
+
+		Convey("When the owner sets a count for a day without a log", func() {
+			set.Count = 1
+			set.LogDate = today.AddDate(0, 0, -1)
+			result, err := handler.Handle(ctx, set)
+
+			Convey("Then the day's log is started", func() {
+				So(err, ShouldBeNil)
+				So(result.LogID, ShouldEqual, set.LogID)
+				So(uow.LogRepo.Len(), ShouldEqual, 2)
+			})
+		})
+
+		Convey("When another user sets the count", func() {
+			set.Count = 1
+			set.UserID = uuid.NewString()
+			_, err := handler.Handle(ctx, set)
+
+			Convey("Then the habit is not found", func() {
+				So(apperror.GetAppError(err).Code, ShouldEqual, apperror.ErrCodeNotFound)
+				So(publisher.Events(), ShouldBeEmpty)
+			})
+		})
+	})
+}
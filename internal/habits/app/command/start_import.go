@@ -0,0 +1,134 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/common/random"
+	"github.com/semmidev/ethos-go/internal/common/validator"
+	"github.com/semmidev/ethos-go/internal/habits/domain/habit"
+	"github.com/semmidev/ethos-go/internal/habits/domain/habitimport"
+	domaintask "github.com/semmidev/ethos-go/internal/habits/domain/task"
+)
+
+// StartImport command parses an export file from another habit tracker and
+// queues it to be written in the background
+type StartImport struct {
+	ImportID string `validate:"uuid"`
+	UserID   string `validate:"uuid"`
+	Source   string `json:"source" validate:"required,oneof=habitica loop streaks"`
+	Data     []byte `json:"data" validate:"required"`
+}
+
+// StartImportHandler processes import start commands
+type StartImportHandler decorator.CommandHandler[StartImport]
+
+type startImportHandler struct {
+	repo       habitimport.Repository
+	habits     habit.HabitReader
+	plans      habit.PlanGate
+	validator  *validator.Validator
+	dispatcher domaintask.TaskDispatcher
+}
+
+// NewStartImportHandler creates a new handler with decorators
+func NewStartImportHandler(
+	repo habitimport.Repository,
+	habits habit.HabitReader,
+	plans habit.PlanGate,
+	validator *validator.Validator,
+	dispatcher domaintask.TaskDispatcher,
+	log logger.Logger,
+	metricsClient decorator.MetricsClient,
+) StartImportHandler {
+	if repo == nil {
+		panic("nil import repository")
+	}
+	if habits == nil {
+		panic("nil habit reader")
+	}
+	if plans == nil {
+		panic("nil plan gate")
+	}
+	if dispatcher == nil {
+		panic("nil task dispatcher")
+	}
+
+	return decorator.ApplyCommandDecorators(
+		startImportHandler{
+			repo:       repo,
+			habits:     habits,
+			plans:      plans,
+			validator:  validator,
+			dispatcher: dispatcher,
+		},
+		log,
+		metricsClient,
+	)
+}
+
+func (h startImportHandler) Handle(ctx context.Context, cmd StartImport) error {
+	// Validate input
+	if err := h.validator.Validate(cmd); err != nil {
+		if validationErrors, ok := validator.GetValidationErrors(err); ok {
+			details := make(map[string]interface{})
+			for _, ve := range validationErrors {
+				details[ve.Field] = ve.Message
+			}
+			return apperror.ValidationFailedWithDetails("validation failed", details)
+		}
+		return apperror.ValidationFailed(err.Error())
+	}
+
+	source, err := habitimport.NewSource(cmd.Source)
+	if err != nil {
+		return apperror.InvalidInput("source", err.Error())
+	}
+
+	parsed, err := habitimport.Parse(source, cmd.Data, time.Now())
+	if err != nil {
+		return apperror.InvalidInput("data", err.Error())
+	}
+
+	// Every imported habit is created active, so all of them must fit
+	// within the user's plan
+	existing, err := h.habits.ListHabitsByUser(ctx, cmd.UserID)
+	if err != nil {
+		return err
+	}
+	active := habit.CountActive(existing) + len(parsed.Habits)
+	if err := h.plans.CheckActiveHabits(ctx, cmd.UserID, active); err != nil {
+		return err
+	}
+
+	// IDs are fixed up front so a retried run recognises the habits an
+	// earlier attempt already wrote
+	for i := range parsed.Habits {
+		parsed.Habits[i].HabitID = random.NewUUID().String()
+	}
+
+	imp, err := habitimport.NewImport(cmd.ImportID, cmd.UserID, source, parsed.Habits)
+	if err != nil {
+		return err
+	}
+	if err := h.repo.AddImport(ctx, imp); err != nil {
+		return err
+	}
+
+	if err := h.dispatcher.DispatchImport(ctx, cmd.ImportID, cmd.UserID); err != nil {
+		// Without a task the import would stay pending forever
+		failErr := h.repo.UpdateImport(ctx, cmd.ImportID, cmd.UserID,
+			func(_ context.Context, imp *habitimport.Import) (*habitimport.Import, error) {
+				imp.Fail("the import could not be queued, please try again")
+				return imp, nil
+			},
+		)
+		return errors.Join(err, failErr)
+	}
+
+	return nil
+}
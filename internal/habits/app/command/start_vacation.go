@@ -0,0 +1,114 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/common/validator"
+	"github.com/semmidev/ethos-go/internal/habits/adapters"
+	"github.com/semmidev/ethos-go/internal/habits/domain/habit"
+)
+
+// StartVacation command encapsulates starting a vacation for a habit
+type StartVacation struct {
+	VacationID string     `validate:"uuid"`
+	HabitID    string     `validate:"uuid"`
+	UserID     string     `validate:"uuid"`
+	StartDate  time.Time  `json:"start_date" validate:"required"`
+	EndDate    *time.Time `json:"end_date"` // nil = until ended
+	Reason     *string    `json:"reason" validate:"omitempty,max=255"`
+}
+
+// StartVacationHandler processes vacation start commands
+type StartVacationHandler decorator.CommandHandler[StartVacation]
+
+type startVacationHandler struct {
+	uow       adapters.HabitsUnitOfWork
+	validator *validator.Validator
+}
+
+// NewStartVacationHandler creates a new handler with decorators
+func NewStartVacationHandler(
+	uow adapters.HabitsUnitOfWork,
+	validator *validator.Validator,
+	log logger.Logger,
+	metricsClient decorator.MetricsClient,
+) StartVacationHandler {
+	if uow == nil {
+		panic("nil unit of work")
+	}
+
+	return decorator.ApplyCommandDecorators(
+		startVacationHandler{
+			uow:       uow,
+			validator: validator,
+		},
+		log,
+		metricsClient,
+	)
+}
+
+func (h startVacationHandler) Handle(ctx context.Context, cmd StartVacation) error {
+	// Validate input
+	if err := h.validator.Validate(cmd); err != nil {
+		if validationErrors, ok := validator.GetValidationErrors(err); ok {
+			details := make(map[string]interface{})
+			for _, ve := range validationErrors {
+				details[ve.Field] = ve.Message
+			}
+			return apperror.ValidationFailedWithDetails("validation failed", details)
+		}
+		return apperror.ValidationFailed(err.Error())
+	}
+
+	vacation, err := habit.NewHabitVacation(cmd.VacationID, cmd.HabitID, cmd.StartDate, cmd.Reason)
+	if err != nil {
+		return err
+	}
+	if cmd.EndDate != nil {
+		if err := vacation.End(*cmd.EndDate); err != nil {
+			return toVacationAppError(err, cmd.HabitID, cmd.VacationID)
+		}
+	}
+
+	err = h.uow.WithTransaction(ctx, func(txUow adapters.HabitsUnitOfWork) error {
+		// Verify habit exists and belongs to user
+		if _, err := txUow.Habits().GetHabit(ctx, cmd.HabitID, cmd.UserID); err != nil {
+			return err
+		}
+
+		existing, err := txUow.Habits().ListVacations(ctx, cmd.HabitID)
+		if err != nil {
+			return err
+		}
+		for _, other := range existing {
+			if vacation.Overlaps(*other) {
+				return habit.ErrVacationOverlap
+			}
+		}
+
+		return txUow.Habits().AddVacation(ctx, vacation)
+	})
+	return toVacationAppError(err, cmd.HabitID, cmd.VacationID)
+}
+
+// toVacationAppError translates domain errors raised by vacation commands
+func toVacationAppError(err error, habitID, vacationID string) error {
+	switch {
+	case errors.Is(err, habit.ErrNotFound):
+		return apperror.NotFound("habit", habitID)
+	case errors.Is(err, habit.ErrVacationNotFound):
+		return apperror.NotFound("vacation", vacationID)
+	case errors.Is(err, habit.ErrVacationInvalidDates):
+		return apperror.InvalidInput("end_date", err.Error())
+	case errors.Is(err, habit.ErrVacationOverlap):
+		return apperror.BusinessRuleViolation("vacation_overlap", err.Error())
+	case errors.Is(err, habit.ErrVacationAlreadyEnded):
+		return apperror.BusinessRuleViolation("vacation_ended", err.Error())
+	}
+	return err
+}
@@ -0,0 +1,134 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	habitevents "github.com/semmidev/ethos-go/contracts/events/habits"
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/dateutil"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/events"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/common/validator"
+	"github.com/semmidev/ethos-go/internal/habits/adapters"
+	"github.com/semmidev/ethos-go/internal/habits/domain/habit"
+	"github.com/semmidev/ethos-go/internal/habits/domain/habitlog"
+)
+
+// UndoHabitLog command takes back the latest completion logged on a day
+type UndoHabitLog struct {
+	HabitID string    `validate:"uuid"`
+	UserID  string    `validate:"uuid"`
+	LogDate time.Time `json:"log_date" validate:"required"`
+}
+
+// UndoHabitLogHandler processes undo commands
+type UndoHabitLogHandler decorator.CommandHandler[UndoHabitLog]
+
+type undoHabitLogHandler struct {
+	uow          adapters.HabitsUnitOfWork
+	validator    *validator.Validator
+	streakSvc    *habit.StreakService
+	publisher    events.Publisher
+	backdateDays int
+}
+
+// NewUndoHabitLogHandler creates a new handler with decorators
+func NewUndoHabitLogHandler(
+	uow adapters.HabitsUnitOfWork,
+	validator *validator.Validator,
+	publisher events.Publisher,
+	backdateDays int,
+	log logger.Logger,
+	metricsClient decorator.MetricsClient,
+) UndoHabitLogHandler {
+	if uow == nil {
+		panic("nil unit of work")
+	}
+
+	return decorator.ApplyCommandDecorators(
+		undoHabitLogHandler{
+			uow:          uow,
+			validator:    validator,
+			streakSvc:    habit.NewStreakService(),
+			publisher:    publisher,
+			backdateDays: backdateDays,
+		},
+		log,
+		metricsClient,
+	)
+}
+
+func (h undoHabitLogHandler) Handle(ctx context.Context, cmd UndoHabitLog) error {
+	// Validate input
+	if err := h.validator.Validate(cmd); err != nil {
+		if validationErrors, ok := validator.GetValidationErrors(err); ok {
+			details := make(map[string]interface{})
+			for _, ve := range validationErrors {
+				details[ve.Field] = ve.Message
+			}
+			return apperror.ValidationFailedWithDetails("validation failed", details)
+		}
+		return apperror.ValidationFailed(err.Error())
+	}
+
+	// Taking back a completion changes the day as much as logging one, so
+	// the backdating window and the user's log lock apply too
+	policy, err := logDatePolicy(ctx, h.uow.HabitLogs(), cmd.UserID, h.backdateDays)
+	if err != nil {
+		return err
+	}
+	if err := policy.CanLog(cmd.LogDate, time.Now()); err != nil {
+		return toLogPolicyAppError(err, policy)
+	}
+
+	// The latest log stays row-locked until commit, so concurrent undos
+	// (e.g. a double-tap) each take back exactly one completion
+	err = h.uow.WithTransaction(ctx, func(txUow adapters.HabitsUnitOfWork) error {
+		latest, err := txUow.HabitLogs().GetLatestHabitLogForUpdate(ctx, cmd.HabitID, cmd.LogDate, cmd.UserID)
+		if err != nil {
+			return err
+		}
+		logID := latest.LogID()
+
+		if latest.Count() > 1 {
+			err = txUow.HabitLogs().UpdateHabitLog(
+				ctx,
+				logID,
+				cmd.UserID,
+				func(ctx context.Context, log *habitlog.HabitLog) (*habitlog.HabitLog, error) {
+					if err := log.UpdateCount(log.Count() - 1); err != nil {
+						return nil, err
+					}
+					return log, nil
+				},
+			)
+		} else {
+			err = txUow.HabitLogs().DeleteHabitLog(ctx, logID, cmd.UserID)
+		}
+		if err != nil {
+			return err
+		}
+
+		logs, err := recalculateHabitStats(ctx, txUow, h.streakSvc, cmd.HabitID, cmd.UserID)
+		if err != nil {
+			return err
+		}
+
+		return txUow.Publisher(h.publisher).Publish(ctx, habitevents.NewHabitCompletionCorrected(
+			cmd.HabitID,
+			cmd.UserID,
+			logID,
+			cmd.LogDate,
+			-1,
+			countOnDay(logs, cmd.LogDate),
+		))
+	})
+	if errors.Is(err, habitlog.ErrNotFound) {
+		return apperror.NotFound("habit log", dateutil.FormatDate(cmd.LogDate))
+	}
+
+	return err
+}
@@ -0,0 +1,127 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	habitevents "github.com/semmidev/ethos-go/contracts/events/habits"
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/validator"
+	"github.com/semmidev/ethos-go/internal/habits/app/command"
+	"github.com/semmidev/ethos-go/internal/testutil"
+)
+
+func TestUndoHabitLogHandler(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given a habit logged twice today", t, func() {
+		ctx := context.Background()
+		owner := testutil.NewHabitBuilder().CreatedAt(time.Now().AddDate(0, 0, -7)).Build()
+		first := testutil.NewHabitLogBuilder(owner).WithCount(2).Build()
+		second := testutil.NewHabitLogBuilder(owner).Build()
+		today := first.LogDate()
+
+		uow := testutil.NewHabitsUnitOfWork(
+			testutil.NewHabitRepository(owner),
+			testutil.NewHabitLogRepository(first, second),
+		)
+		publisher := testutil.NewRecordingPublisher()
+		handler := command.NewUndoHabitLogHandler(
+			uow,
+			validator.New("en"),
+			publisher,
+			7,
+			testutil.NopLogger{},
+			&decorator.NoOpMetricsClient{},
+		)
+		undo := command.UndoHabitLog{HabitID: owner.HabitID(), UserID: owner.UserID(), LogDate: today}
+
+		Convey("When the owner undoes once", func() {
+			err := handler.Handle(ctx, undo)
+
+			Convey("Then the latest single-count log is removed", func() {
+				So(err, ShouldBeNil)
+				So(uow.LogRepo.Len(), ShouldEqual, 1)
+				_, err := uow.LogRepo.GetHabitLog(ctx, second.LogID(), owner.UserID())
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("Then a correction event carries the new total", func() {
+				So(publisher.EventTypes(), ShouldResemble, []string{habitevents.HabitCompletedType})
+				event := publisher.Events()[0].(habitevents.HabitCompleted)
+				So(event.Correction, ShouldBeTrue)
+				So(event.Count, ShouldEqual, -1)
+				So(event.TotalToday, ShouldEqual, 2)
+				So(event.LogID, ShouldEqual, second.LogID())
+			})
+
+			Convey("And undoes again", func() {
+				So(handler.Handle(ctx, undo), ShouldBeNil)
+
+				Convey("Then the remaining log is decremented instead of removed", func() {
+					l, err := uow.LogRepo.GetHabitLog(ctx, first.LogID(), owner.UserID())
+					So(err, ShouldBeNil)
+					So(l.Count(), ShouldEqual, 1)
+
+					stats, err := uow.HabitRepo.GetStats(ctx, owner.HabitID())
+					So(err, ShouldBeNil)
+					So(stats.TotalCompletions(), ShouldEqual, 1)
+				})
+			})
+		})
+
+		Convey("When there is nothing logged on the day", func() {
+			undo.LogDate = today.AddDate(0, 0, -1)
+			err := handler.Handle(ctx, undo)
+
+			Convey("Then it fails as not found without an event", func() {
+				appErr := apperror.GetAppError(err)
+				So(appErr, ShouldNotBeNil)
+				So(appErr.Code, ShouldEqual, apperror.ErrCodeNotFound)
+				So(publisher.Events(), ShouldBeEmpty)
+			})
+		})
+
+		Convey("When the day is outside the backdating window", func() {
+			undo.LogDate = today.AddDate(0, 0, -8)
+			err := handler.Handle(ctx, undo)
+
+			Convey("Then it is rejected as a business rule violation", func() {
+				appErr := apperror.GetAppError(err)
+				So(appErr, ShouldNotBeNil)
+				So(appErr.Code, ShouldEqual, apperror.ErrCodeBusinessRuleViolation)
+				So(appErr.Details["rule"], ShouldEqual, "log_backdate_window")
+				So(publisher.Events(), ShouldBeEmpty)
+			})
+		})
+
+		Convey("When the owner has locked logs older than three days", func() {
+			uow.LogRepo.SetLogLockDays(owner.UserID(), 3)
+			undo.LogDate = today.AddDate(0, 0, -5)
+			err := handler.Handle(ctx, undo)
+
+			Convey("Then a day inside the backdating window is still rejected", func() {
+				appErr := apperror.GetAppError(err)
+				So(appErr, ShouldNotBeNil)
+				So(appErr.Details["rule"], ShouldEqual, "log_locked")
+				So(publisher.Events(), ShouldBeEmpty)
+			})
+		})
+
+		Convey("When another user tries to undo", func() {
+			undo.UserID = testutil.NewUserBuilder().Build().UserID().String()
+			err := handler.Handle(ctx, undo)
+
+			Convey("Then it fails as not found and nothing changes", func() {
+				appErr := apperror.GetAppError(err)
+				So(appErr, ShouldNotBeNil)
+				So(appErr.Code, ShouldEqual, apperror.ErrCodeNotFound)
+				So(uow.LogRepo.Len(), ShouldEqual, 2)
+			})
+		})
+	})
+}
@@ -2,6 +2,7 @@ package command
 
 import (
 	"context"
+	"errors"
 
 	"github.com/semmidev/ethos-go/internal/common/apperror"
 	"github.com/semmidev/ethos-go/internal/common/decorator"
@@ -12,15 +13,18 @@ import (
 
 // UpdateHabit command encapsulates habit update input
 type UpdateHabit struct {
-	HabitID            string
-	UserID             string
-	Name               *string `json:"name" validate:"omitempty,min=3,max=100"`
-	Description        *string `json:"description"` // Nullable
-	Frequency          *string `json:"frequency" validate:"omitempty,oneof=daily weekly monthly"`
-	RecurrenceDays     *int16  `json:"recurrence_days"`
-	RecurrenceInterval *int    `json:"recurrence_interval"`
-	TargetCount        *int    `json:"target_count" validate:"omitempty,min=1"`
-	ReminderTime       *string `json:"reminder_time"` // Nullable - e.g. "08:00"
+	HabitID      string
+	UserID       string
+	Name         *string          `json:"name" validate:"omitempty,min=3,max=100"`
+	Description  *string          `json:"description"` // Nullable
+	Frequency    *string          `json:"frequency" validate:"omitempty,oneof=daily weekly monthly"`
+	Recurrence   *RecurrenceInput `json:"recurrence"` // Replaces days and interval together
+	TargetCount  *int             `json:"target_count" validate:"omitempty,min=1"`
+	ReminderTime *string          `json:"reminder_time"` // Nullable - e.g. "08:00"
+
+	// ExpectedVersion, when set, rejects the update with a conflict unless
+	// the habit is still at the version the client last read
+	ExpectedVersion *int `json:"expected_version" validate:"omitempty,min=1"`
 }
 
 // UpdateHabitHandler processes habit update commands
@@ -66,13 +70,19 @@ func (h updateHabitHandler) Handle(ctx context.Context, cmd UpdateHabit) error {
 	}
 
 	// Use repository UpdateFn pattern for transactional update
-	return h.repo.UpdateHabit(
+	err := h.repo.UpdateHabit(
 		ctx,
 		cmd.HabitID,
 		cmd.UserID,
 		func(ctx context.Context, h *habit.Habit) (*habit.Habit, error) {
+			if cmd.ExpectedVersion != nil {
+				if err := h.CheckVersion(*cmd.ExpectedVersion); err != nil {
+					return nil, err
+				}
+			}
+
 			// Apply updates if provided
-			if cmd.Name != nil || cmd.Description != nil || cmd.Frequency != nil || cmd.RecurrenceDays != nil || cmd.RecurrenceInterval != nil || cmd.TargetCount != nil || cmd.ReminderTime != nil {
+			if cmd.Name != nil || cmd.Description != nil || cmd.Frequency != nil || cmd.Recurrence != nil || cmd.TargetCount != nil || cmd.ReminderTime != nil {
 				// Resolve Frequency
 				var freq habit.Frequency
 				var err error
@@ -86,20 +96,12 @@ func (h updateHabitHandler) Handle(ctx context.Context, cmd UpdateHabit) error {
 				}
 
 				// Resolve Recurrence
-				currentRecurrence := h.Recurrence()
-				days := currentRecurrence.Days()
-				interval := currentRecurrence.Interval()
-
-				if cmd.RecurrenceDays != nil {
-					days = *cmd.RecurrenceDays
-				}
-				if cmd.RecurrenceInterval != nil && *cmd.RecurrenceInterval > 0 {
-					interval = *cmd.RecurrenceInterval
-				}
-
-				recurrence, err := habit.NewRecurrence(days, interval)
-				if err != nil {
-					return nil, err
+				recurrence := h.Recurrence()
+				if cmd.Recurrence != nil {
+					recurrence, err = cmd.Recurrence.toRecurrence()
+					if err != nil {
+						return nil, err
+					}
 				}
 
 				name := h.Name()
@@ -130,4 +132,16 @@ func (h updateHabitHandler) Handle(ctx context.Context, cmd UpdateHabit) error {
 			return h, nil
 		},
 	)
+	return toUpdateHabitAppError(err, cmd.HabitID)
+}
+
+// toUpdateHabitAppError translates domain errors raised by habit updates
+func toUpdateHabitAppError(err error, habitID string) error {
+	switch {
+	case errors.Is(err, habit.ErrNotFound):
+		return apperror.NotFound("habit", habitID)
+	case errors.Is(err, habit.ErrVersionConflict):
+		return apperror.Conflict("habit", "habit was changed by another request; reload it and try again")
+	}
+	return err
 }
@@ -2,6 +2,7 @@ package command
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/semmidev/ethos-go/internal/common/apperror"
@@ -24,14 +25,16 @@ type UpdateHabitLog struct {
 type UpdateHabitLogHandler decorator.CommandHandler[UpdateHabitLog]
 
 type updateHabitLogHandler struct {
-	repo      habitlog.Repository
-	validator *validator.Validator
+	repo         habitlog.Repository
+	validator    *validator.Validator
+	backdateDays int
 }
 
 // NewUpdateHabitLogHandler creates a new handler with decorators
 func NewUpdateHabitLogHandler(
 	repo habitlog.Repository,
 	validator *validator.Validator,
+	backdateDays int,
 	log logger.Logger,
 	metricsClient decorator.MetricsClient,
 ) UpdateHabitLogHandler {
@@ -41,8 +44,9 @@ func NewUpdateHabitLogHandler(
 
 	return decorator.ApplyCommandDecorators(
 		updateHabitLogHandler{
-			repo:      repo,
-			validator: validator,
+			repo:         repo,
+			validator:    validator,
+			backdateDays: backdateDays,
 		},
 		log,
 		metricsClient,
@@ -62,11 +66,20 @@ func (h updateHabitLogHandler) Handle(ctx context.Context, cmd UpdateHabitLog) e
 		return apperror.ValidationFailed(err.Error())
 	}
 
-	return h.repo.UpdateHabitLog(
+	policy, err := logDatePolicy(ctx, h.repo, cmd.UserID, h.backdateDays)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+
+	err = h.repo.UpdateHabitLog(
 		ctx,
 		cmd.LogID,
 		cmd.UserID,
 		func(ctx context.Context, log *habitlog.HabitLog) (*habitlog.HabitLog, error) {
+			if err := policy.CanModify(log.LogDate(), now); err != nil {
+				return nil, err
+			}
 			if cmd.Count != nil {
 				if err := log.UpdateCount(*cmd.Count); err != nil {
 					return nil, err
@@ -76,6 +89,10 @@ func (h updateHabitLogHandler) Handle(ctx context.Context, cmd UpdateHabitLog) e
 				log.UpdateNote(cmd.Note)
 			}
 			if cmd.LogDate != nil {
+				// Moving a log is backdating it, so the window applies too
+				if err := policy.CanLog(*cmd.LogDate, now); err != nil {
+					return nil, err
+				}
 				if err := log.UpdateLogDate(*cmd.LogDate); err != nil {
 					return nil, err
 				}
@@ -83,4 +100,8 @@ func (h updateHabitLogHandler) Handle(ctx context.Context, cmd UpdateHabitLog) e
 			return log, nil
 		},
 	)
+	if errors.Is(err, habitlog.ErrDateTaken) {
+		return apperror.Conflict("habit log", "the habit already has a log on that date")
+	}
+	return toLogPolicyAppError(toNotFoundAppError(err, cmd.LogID), policy)
 }
@@ -0,0 +1,79 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/validator"
+	"github.com/semmidev/ethos-go/internal/habits/app/command"
+	"github.com/semmidev/ethos-go/internal/testutil"
+)
+
+func TestUpdateHabitHandler(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given a habit at version 2", t, func() {
+		ctx := context.Background()
+		h := testutil.NewHabitBuilder().WithVersion(2).Build()
+		repo := testutil.NewHabitRepository(h)
+		handler := command.NewUpdateHabitHandler(repo, validator.New("en"), testutil.NopLogger{}, &decorator.NoOpMetricsClient{})
+		name := "Read twenty pages"
+
+		Convey("When it is updated expecting version 2", func() {
+			version := 2
+			err := handler.Handle(ctx, command.UpdateHabit{
+				HabitID:         h.HabitID(),
+				UserID:          h.UserID(),
+				Name:            &name,
+				ExpectedVersion: &version,
+			})
+
+			Convey("Then the change is saved and the version advances", func() {
+				So(err, ShouldBeNil)
+				updated, err := repo.GetHabit(ctx, h.HabitID(), h.UserID())
+				So(err, ShouldBeNil)
+				So(updated.Name(), ShouldEqual, name)
+				So(updated.Version(), ShouldEqual, 3)
+			})
+		})
+
+		Convey("When it is updated expecting a stale version", func() {
+			version := 1
+			err := handler.Handle(ctx, command.UpdateHabit{
+				HabitID:         h.HabitID(),
+				UserID:          h.UserID(),
+				Name:            &name,
+				ExpectedVersion: &version,
+			})
+
+			Convey("Then it is rejected as a conflict and left unchanged", func() {
+				appErr := apperror.GetAppError(err)
+				So(appErr, ShouldNotBeNil)
+				So(appErr.Code, ShouldEqual, apperror.ErrCodeConflict)
+				unchanged, err := repo.GetHabit(ctx, h.HabitID(), h.UserID())
+				So(err, ShouldBeNil)
+				So(unchanged.Name(), ShouldEqual, h.Name())
+				So(unchanged.Version(), ShouldEqual, 2)
+			})
+		})
+
+		Convey("When it is updated without an expected version", func() {
+			err := handler.Handle(ctx, command.UpdateHabit{
+				HabitID: h.HabitID(),
+				UserID:  h.UserID(),
+				Name:    &name,
+			})
+
+			Convey("Then the update is applied unconditionally", func() {
+				So(err, ShouldBeNil)
+				updated, err := repo.GetHabit(ctx, h.HabitID(), h.UserID())
+				So(err, ShouldBeNil)
+				So(updated.Version(), ShouldEqual, 3)
+			})
+		})
+	})
+}
@@ -0,0 +1,116 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/validator"
+	"github.com/semmidev/ethos-go/internal/habits/app/command"
+	"github.com/semmidev/ethos-go/internal/testutil"
+)
+
+func TestVacationHandlers(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given vacation handlers and a habit", t, func() {
+		ctx := context.Background()
+		h := testutil.NewHabitBuilder().Build()
+
+		uow := testutil.NewHabitsUnitOfWork(testutil.NewHabitRepository(h), nil)
+		startHandler := command.NewStartVacationHandler(
+			uow,
+			validator.New("en"),
+			testutil.NopLogger{},
+			&decorator.NoOpMetricsClient{},
+		)
+		endHandler := command.NewEndVacationHandler(
+			uow.HabitRepo,
+			validator.New("en"),
+			testutil.NopLogger{},
+			&decorator.NoOpMetricsClient{},
+		)
+
+		today := time.Now()
+		inAWeek := today.AddDate(0, 0, 7)
+		start := func(userID string, from time.Time, to *time.Time) (string, error) {
+			id := uuid.NewString()
+			return id, startHandler.Handle(ctx, command.StartVacation{
+				VacationID: id,
+				HabitID:    h.HabitID(),
+				UserID:     userID,
+				StartDate:  from,
+				EndDate:    to,
+			})
+		}
+		appCode := func(err error) string {
+			appErr := apperror.GetAppError(err)
+			So(appErr, ShouldNotBeNil)
+			return appErr.Code
+		}
+
+		Convey("When the owner starts a week-long vacation", func() {
+			vacationID, err := start(h.UserID(), today, &inAWeek)
+			So(err, ShouldBeNil)
+
+			Convey("Then an overlapping vacation is rejected", func() {
+				_, err := start(h.UserID(), inAWeek, nil)
+				So(appCode(err), ShouldEqual, apperror.ErrCodeBusinessRuleViolation)
+			})
+
+			Convey("Then a vacation after it is accepted", func() {
+				_, err := start(h.UserID(), inAWeek.AddDate(0, 0, 1), nil)
+				So(err, ShouldBeNil)
+			})
+
+			Convey("Then ending it early shortens it", func() {
+				err := endHandler.Handle(ctx, command.EndVacation{
+					VacationID: vacationID,
+					HabitID:    h.HabitID(),
+					UserID:     h.UserID(),
+					EndDate:    today,
+				})
+				So(err, ShouldBeNil)
+
+				vacations, _ := uow.HabitRepo.ListVacations(ctx, h.HabitID())
+				So(vacations, ShouldHaveLength, 1)
+				So(vacations[0].IsActiveOn(today.AddDate(0, 0, 1)), ShouldBeFalse)
+			})
+		})
+
+		Convey("When another user starts a vacation on the habit", func() {
+			_, err := start(uuid.NewString(), today, nil)
+
+			Convey("Then the habit is not found", func() {
+				So(appCode(err), ShouldEqual, apperror.ErrCodeNotFound)
+			})
+		})
+
+		Convey("When the end date is before the start date", func() {
+			yesterday := today.AddDate(0, 0, -1)
+			_, err := start(h.UserID(), today, &yesterday)
+
+			Convey("Then it is rejected as invalid input", func() {
+				So(appCode(err), ShouldEqual, apperror.ErrCodeInvalidInput)
+			})
+		})
+
+		Convey("When ending a vacation that does not exist", func() {
+			err := endHandler.Handle(ctx, command.EndVacation{
+				VacationID: uuid.NewString(),
+				HabitID:    h.HabitID(),
+				UserID:     h.UserID(),
+				EndDate:    today,
+			})
+
+			Convey("Then the vacation is not found", func() {
+				So(appCode(err), ShouldEqual, apperror.ErrCodeNotFound)
+			})
+		})
+	})
+}
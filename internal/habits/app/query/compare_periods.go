@@ -0,0 +1,234 @@
+package query
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/dateutil"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/habits/domain/habit"
+)
+
+// Comparison periods
+const (
+	PeriodWeek  = "week"
+	PeriodMonth = "month"
+)
+
+// ComparePeriods query compares the user's current week or month so far
+// with the same number of days at the start of the previous one
+type ComparePeriods struct {
+	UserID string
+	Period string // week or month; month when empty
+}
+
+// PeriodComparison is the current period against the previous one
+type PeriodComparison struct {
+	Period   string        `json:"period"`
+	Current  PeriodSummary `json:"current"`
+	Previous PeriodSummary `json:"previous"`
+	// CompletionsChange is the percent change in completions, nil when the
+	// previous period had none
+	CompletionsChange *float64 `json:"completions_change"`
+	// CompletionRateChange is the difference in percentage points
+	CompletionRateChange float64            `json:"completion_rate_change"`
+	LongestStreakChange  int                `json:"longest_streak_change"`
+	Habits               []HabitPeriodDelta `json:"habits"`
+}
+
+// PeriodSummary totals one period across the user's habits
+type PeriodSummary struct {
+	StartDate      string  `json:"start_date"`
+	EndDate        string  `json:"end_date"` // Inclusive
+	Completions    int     `json:"completions"`
+	CompletionRate float64 `json:"completion_rate"`
+	LongestStreak  int     `json:"longest_streak"`
+}
+
+// HabitPeriodDelta compares one habit between the two periods
+type HabitPeriodDelta struct {
+	HabitID                string   `json:"habit_id"`
+	HabitName              string   `json:"habit_name"`
+	CurrentCompletions     int      `json:"current_completions"`
+	PreviousCompletions    int      `json:"previous_completions"`
+	CompletionsChange      *float64 `json:"completions_change"`
+	CurrentCompletionRate  float64  `json:"current_completion_rate"`
+	PreviousCompletionRate float64  `json:"previous_completion_rate"`
+	CompletionRateChange   float64  `json:"completion_rate_change"`
+	CurrentLongestStreak   int      `json:"current_longest_streak"`
+	PreviousLongestStreak  int      `json:"previous_longest_streak"`
+}
+
+// PeriodTotals are the raw per-habit totals of the days from StartDate
+// through EndDate
+type PeriodTotals struct {
+	StartDate time.Time
+	EndDate   time.Time
+	Habits    []PeriodHabitTotals
+}
+
+// PeriodHabitTotals are one habit's totals within a period
+type PeriodHabitTotals struct {
+	HabitID       string `db:"habit_id"`
+	HabitName     string `db:"name"`
+	Completions   int    `db:"completions"`
+	DaysLogged    int    `db:"days_logged"`
+	LongestStreak int    `db:"longest_streak"`
+}
+
+// days is the number of days the period covers
+func (t PeriodTotals) days() int {
+	return int(t.EndDate.Sub(t.StartDate).Hours()/24) + 1
+}
+
+// ComparePeriodsHandler processes period comparison queries
+type ComparePeriodsHandler decorator.QueryHandler[ComparePeriods, *PeriodComparison]
+
+// ComparePeriodsReadModel returns the totals of the current period so far
+// and of the matching days of the previous period
+type ComparePeriodsReadModel interface {
+	GetPeriodTotals(ctx context.Context, userID, period string) (current, previous *PeriodTotals, err error)
+}
+
+type comparePeriodsHandler struct {
+	readModel ComparePeriodsReadModel
+	plans     habit.PlanGate
+}
+
+// NewComparePeriodsHandler creates a new handler with decorators
+func NewComparePeriodsHandler(
+	readModel ComparePeriodsReadModel,
+	plans habit.PlanGate,
+	log logger.Logger,
+	metricsClient decorator.MetricsClient,
+) ComparePeriodsHandler {
+	if readModel == nil {
+		panic("nil read model")
+	}
+	if plans == nil {
+		panic("nil plan gate")
+	}
+
+	return decorator.ApplyQueryDecorators(
+		comparePeriodsHandler{readModel: readModel, plans: plans},
+		log,
+		metricsClient,
+	)
+}
+
+func (h comparePeriodsHandler) Handle(ctx context.Context, q ComparePeriods) (*PeriodComparison, error) {
+	period := q.Period
+	if period == "" {
+		period = PeriodMonth
+	}
+	if period != PeriodWeek && period != PeriodMonth {
+		return nil, apperror.InvalidInput("period", "must be week or month")
+	}
+
+	if err := h.plans.CheckAdvancedAnalytics(ctx, q.UserID); err != nil {
+		return nil, err
+	}
+
+	current, previous, err := h.readModel.GetPeriodTotals(ctx, q.UserID, period)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPeriodComparison(period, *current, *previous), nil
+}
+
+// NewPeriodComparison computes the changes between two periods. Habits are
+// listed in the order of the current period, followed by habits only
+// logged in the previous one.
+func NewPeriodComparison(period string, current, previous PeriodTotals) *PeriodComparison {
+	comparison := &PeriodComparison{
+		Period:   period,
+		Current:  summarizePeriod(current),
+		Previous: summarizePeriod(previous),
+		Habits:   []HabitPeriodDelta{},
+	}
+	comparison.CompletionsChange = percentChange(comparison.Previous.Completions, comparison.Current.Completions)
+	comparison.CompletionRateChange = round1(comparison.Current.CompletionRate - comparison.Previous.CompletionRate)
+	comparison.LongestStreakChange = comparison.Current.LongestStreak - comparison.Previous.LongestStreak
+
+	previousByID := make(map[string]PeriodHabitTotals, len(previous.Habits))
+	for _, h := range previous.Habits {
+		previousByID[h.HabitID] = h
+	}
+
+	seen := make(map[string]bool, len(current.Habits))
+	for _, cur := range current.Habits {
+		seen[cur.HabitID] = true
+		comparison.Habits = append(comparison.Habits, habitDelta(cur, previousByID[cur.HabitID], current.days(), previous.days()))
+	}
+	for _, prev := range previous.Habits {
+		if !seen[prev.HabitID] {
+			cur := PeriodHabitTotals{HabitID: prev.HabitID, HabitName: prev.HabitName}
+			comparison.Habits = append(comparison.Habits, habitDelta(cur, prev, current.days(), previous.days()))
+		}
+	}
+
+	return comparison
+}
+
+func summarizePeriod(t PeriodTotals) PeriodSummary {
+	summary := PeriodSummary{
+		StartDate: dateutil.FormatDate(t.StartDate),
+		EndDate:   dateutil.FormatDate(t.EndDate),
+	}
+
+	daysLogged := 0
+	for _, h := range t.Habits {
+		summary.Completions += h.Completions
+		daysLogged += h.DaysLogged
+		if h.LongestStreak > summary.LongestStreak {
+			summary.LongestStreak = h.LongestStreak
+		}
+	}
+	if len(t.Habits) > 0 {
+		summary.CompletionRate = completionRate(daysLogged, len(t.Habits)*t.days())
+	}
+
+	return summary
+}
+
+func habitDelta(cur, prev PeriodHabitTotals, currentDays, previousDays int) HabitPeriodDelta {
+	delta := HabitPeriodDelta{
+		HabitID:                cur.HabitID,
+		HabitName:              cur.HabitName,
+		CurrentCompletions:     cur.Completions,
+		PreviousCompletions:    prev.Completions,
+		CompletionsChange:      percentChange(prev.Completions, cur.Completions),
+		CurrentCompletionRate:  completionRate(cur.DaysLogged, currentDays),
+		PreviousCompletionRate: completionRate(prev.DaysLogged, previousDays),
+		CurrentLongestStreak:   cur.LongestStreak,
+		PreviousLongestStreak:  prev.LongestStreak,
+	}
+	delta.CompletionRateChange = round1(delta.CurrentCompletionRate - delta.PreviousCompletionRate)
+	return delta
+}
+
+// completionRate is the percentage of expected days that were logged
+func completionRate(daysLogged, expectedDays int) float64 {
+	if expectedDays <= 0 {
+		return 0
+	}
+	return round1(float64(daysLogged) / float64(expectedDays) * 100)
+}
+
+// percentChange is the change from previous to current in percent, nil
+// when there is nothing to compare against
+func percentChange(previous, current int) *float64 {
+	if previous == 0 {
+		return nil
+	}
+	change := round1(float64(current-previous) / float64(previous) * 100)
+	return &change
+}
+
+func round1(v float64) float64 {
+	return math.Round(v*10) / 10
+}
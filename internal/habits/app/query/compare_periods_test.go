@@ -0,0 +1,118 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	billingapp "github.com/semmidev/ethos-go/internal/billing/app"
+	"github.com/semmidev/ethos-go/internal/billing/domain/plan"
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/habits/app/query"
+	"github.com/semmidev/ethos-go/internal/testutil"
+)
+
+type fixedPeriodTotals struct {
+	current, previous query.PeriodTotals
+	period            string
+}
+
+func (m *fixedPeriodTotals) GetPeriodTotals(_ context.Context, _, period string) (*query.PeriodTotals, *query.PeriodTotals, error) {
+	m.period = period
+	return &m.current, &m.previous, nil
+}
+
+func TestComparePeriodsHandler(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given ten days of this month and the first ten of last month", t, func() {
+		ctx := context.Background()
+		readModel := &fixedPeriodTotals{
+			current: query.PeriodTotals{
+				StartDate: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+				EndDate:   time.Date(2026, 10, 10, 0, 0, 0, 0, time.UTC),
+				Habits: []query.PeriodHabitTotals{
+					{HabitID: "read", HabitName: "Read", Completions: 14, DaysLogged: 8, LongestStreak: 6},
+					{HabitID: "run", HabitName: "Run", Completions: 5, DaysLogged: 5, LongestStreak: 2},
+				},
+			},
+			previous: query.PeriodTotals{
+				StartDate: time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC),
+				EndDate:   time.Date(2026, 9, 10, 0, 0, 0, 0, time.UTC),
+				Habits: []query.PeriodHabitTotals{
+					{HabitID: "read", HabitName: "Read", Completions: 10, DaysLogged: 5, LongestStreak: 3},
+					{HabitID: "swim", HabitName: "Swim", Completions: 2, DaysLogged: 2, LongestStreak: 1},
+				},
+			},
+		}
+		plans := testutil.NewSubscriptionRepository()
+		plans.SetUserPlan("user", plan.Pro)
+		handler := query.NewComparePeriodsHandler(
+			readModel, billingapp.NewPlanGate(plans), testutil.NopLogger{}, &decorator.NoOpMetricsClient{},
+		)
+
+		Convey("When no period is given", func() {
+			comparison, err := handler.Handle(ctx, query.ComparePeriods{UserID: "user"})
+
+			Convey("Then months are compared", func() {
+				So(err, ShouldBeNil)
+				So(readModel.period, ShouldEqual, query.PeriodMonth)
+				So(comparison.Current.StartDate, ShouldEqual, "2026-10-01")
+				So(comparison.Previous.EndDate, ShouldEqual, "2026-09-10")
+			})
+
+			Convey("Then the totals and their changes are reported", func() {
+				So(comparison.Current.Completions, ShouldEqual, 19)
+				So(comparison.Previous.Completions, ShouldEqual, 12)
+				So(*comparison.CompletionsChange, ShouldEqual, 58.3)
+				So(comparison.Current.CompletionRate, ShouldEqual, 65.0)
+				So(comparison.Previous.CompletionRate, ShouldEqual, 35.0)
+				So(comparison.CompletionRateChange, ShouldEqual, 30.0)
+				So(comparison.LongestStreakChange, ShouldEqual, 3)
+			})
+
+			Convey("Then every habit of either period gets a delta", func() {
+				So(comparison.Habits, ShouldHaveLength, 3)
+
+				read := comparison.Habits[0]
+				So(read.HabitID, ShouldEqual, "read")
+				So(*read.CompletionsChange, ShouldEqual, 40.0)
+				So(read.CompletionRateChange, ShouldEqual, 30.0)
+
+				run := comparison.Habits[1]
+				So(run.CompletionsChange, ShouldBeNil)
+				So(run.CurrentCompletionRate, ShouldEqual, 50.0)
+
+				swim := comparison.Habits[2]
+				So(swim.HabitID, ShouldEqual, "swim")
+				So(swim.CurrentCompletions, ShouldEqual, 0)
+				So(*swim.CompletionsChange, ShouldEqual, -100.0)
+			})
+		})
+
+		Convey("When an unknown period is given", func() {
+			_, err := handler.Handle(ctx, query.ComparePeriods{UserID: "user", Period: "year"})
+
+			Convey("Then it is rejected as invalid input", func() {
+				appErr := apperror.GetAppError(err)
+				So(appErr, ShouldNotBeNil)
+				So(appErr.Code, ShouldEqual, apperror.ErrCodeInvalidInput)
+			})
+		})
+
+		Convey("When a user on the free plan asks", func() {
+			_, err := handler.Handle(ctx, query.ComparePeriods{UserID: "free-user"})
+
+			Convey("Then advanced analytics are refused", func() {
+				appErr := apperror.GetAppError(err)
+				So(appErr, ShouldNotBeNil)
+				So(appErr.Code, ShouldEqual, apperror.ErrCodePlanLimitExceeded)
+				So(appErr.Details["plan"], ShouldEqual, plan.Free)
+				So(appErr.Details["limit"], ShouldEqual, billingapp.LimitAdvancedAnalytics)
+			})
+		})
+	})
+}
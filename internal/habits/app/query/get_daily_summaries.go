@@ -0,0 +1,47 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+)
+
+// GetDailySummaries returns today's completion counts for every user whose
+// local clock at Now has reached FromHour, so each user is summarised at
+// the end of their own day.
+type GetDailySummaries struct {
+	Now      time.Time
+	FromHour int
+}
+
+type GetDailySummariesHandler decorator.QueryHandler[GetDailySummaries, []DailySummary]
+
+type DailySummaryReadModel interface {
+	GetDailySummaries(ctx context.Context, now time.Time, fromHour int) ([]DailySummary, error)
+}
+
+type getDailySummariesHandler struct {
+	readModel DailySummaryReadModel
+}
+
+func NewGetDailySummariesHandler(
+	readModel DailySummaryReadModel,
+	log logger.Logger,
+	metricsClient decorator.MetricsClient,
+) GetDailySummariesHandler {
+	if readModel == nil {
+		panic("nil read model")
+	}
+
+	return decorator.ApplyQueryDecorators(
+		getDailySummariesHandler{readModel: readModel},
+		log,
+		metricsClient,
+	)
+}
+
+func (h getDailySummariesHandler) Handle(ctx context.Context, q GetDailySummaries) ([]DailySummary, error) {
+	return h.readModel.GetDailySummaries(ctx, q.Now, q.FromHour)
+}
@@ -2,9 +2,12 @@ package query
 
 import (
 	"context"
+	"errors"
 
+	"github.com/semmidev/ethos-go/internal/common/apperror"
 	"github.com/semmidev/ethos-go/internal/common/decorator"
 	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/habits/domain/habit"
 )
 
 // GetHabit query retrieves a single habit by ID
@@ -43,5 +46,9 @@ func NewGetHabitHandler(
 }
 
 func (h getHabitHandler) Handle(ctx context.Context, q GetHabit) (*Habit, error) {
-	return h.readModel.GetHabitQuery(ctx, q.HabitID, q.UserID)
+	result, err := h.readModel.GetHabitQuery(ctx, q.HabitID, q.UserID)
+	if errors.Is(err, habit.ErrNotFound) {
+		return nil, apperror.NotFound("habit", q.HabitID)
+	}
+	return result, err
 }
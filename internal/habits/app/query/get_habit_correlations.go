@@ -0,0 +1,185 @@
+package query
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/semmidev/ethos-go/internal/common/dateutil"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/habits/domain/habit"
+)
+
+const (
+	// CorrelationWindowDays is how many days back correlations look
+	CorrelationWindowDays = 90
+	// MinCorrelationDays is how many days in the window each habit of a pair
+	// must be logged on before the pair is compared
+	MinCorrelationDays = 10
+	// MaxCorrelations caps how many pairs are returned, strongest first
+	MaxCorrelations = 20
+)
+
+// GetHabitCorrelations query finds habits that tend to be logged on the
+// same days over the last CorrelationWindowDays days
+type GetHabitCorrelations struct {
+	UserID string
+}
+
+// HabitCorrelations lists habit pairs, strongest first
+type HabitCorrelations struct {
+	StartDate    string             `json:"start_date"`
+	EndDate      string             `json:"end_date"`
+	Correlations []HabitCorrelation `json:"correlations"`
+}
+
+// HabitCorrelation reads as: on the HabitDays days HabitName was logged,
+// RelatedHabitName was also logged Rate percent of the time, against
+// BaselineRate percent of all days in the window
+type HabitCorrelation struct {
+	HabitID          string  `json:"habit_id"`
+	HabitName        string  `json:"habit_name"`
+	RelatedHabitID   string  `json:"related_habit_id"`
+	RelatedHabitName string  `json:"related_habit_name"`
+	HabitDays        int     `json:"habit_days"`
+	SharedDays       int     `json:"shared_days"`
+	Rate             float64 `json:"rate"`
+	BaselineRate     float64 `json:"baseline_rate"`
+	Lift             float64 `json:"lift"` // Rate minus BaselineRate, in percentage points
+}
+
+// HabitLogDays are the distinct days a habit was logged on
+type HabitLogDays struct {
+	HabitID   string
+	HabitName string
+	Days      []time.Time
+}
+
+// GetHabitCorrelationsHandler processes habit correlation queries
+type GetHabitCorrelationsHandler decorator.QueryHandler[GetHabitCorrelations, *HabitCorrelations]
+
+// GetHabitCorrelationsReadModel interface for data access
+type GetHabitCorrelationsReadModel interface {
+	// GetHabitLogDays returns the logged days from start through end of the
+	// user's active habits
+	GetHabitLogDays(ctx context.Context, userID string, start, end time.Time) ([]HabitLogDays, error)
+}
+
+type getHabitCorrelationsHandler struct {
+	readModel GetHabitCorrelationsReadModel
+	plans     habit.PlanGate
+}
+
+// NewGetHabitCorrelationsHandler creates a new handler with decorators
+func NewGetHabitCorrelationsHandler(
+	readModel GetHabitCorrelationsReadModel,
+	plans habit.PlanGate,
+	log logger.Logger,
+	metricsClient decorator.MetricsClient,
+) GetHabitCorrelationsHandler {
+	if readModel == nil {
+		panic("nil read model")
+	}
+	if plans == nil {
+		panic("nil plan gate")
+	}
+
+	return decorator.ApplyQueryDecorators(
+		getHabitCorrelationsHandler{readModel: readModel, plans: plans},
+		log,
+		metricsClient,
+	)
+}
+
+func (h getHabitCorrelationsHandler) Handle(ctx context.Context, q GetHabitCorrelations) (*HabitCorrelations, error) {
+	if err := h.plans.CheckAdvancedAnalytics(ctx, q.UserID); err != nil {
+		return nil, err
+	}
+
+	end := time.Now().UTC().Truncate(24 * time.Hour)
+	start := end.AddDate(0, 0, -(CorrelationWindowDays - 1))
+
+	habits, err := h.readModel.GetHabitLogDays(ctx, q.UserID, start, end)
+	if err != nil {
+		return nil, err
+	}
+	return NewHabitCorrelations(start, end, habits), nil
+}
+
+// NewHabitCorrelations compares every pair of habits logged on at least
+// MinCorrelationDays days from start through end, in both directions, and
+// keeps the MaxCorrelations pairs with the highest lift
+func NewHabitCorrelations(start, end time.Time, habits []HabitLogDays) *HabitCorrelations {
+	result := &HabitCorrelations{
+		StartDate:    dateutil.FormatDate(start),
+		EndDate:      dateutil.FormatDate(end),
+		Correlations: []HabitCorrelation{},
+	}
+	windowDays := int(end.Sub(start).Hours()/24) + 1
+
+	type daySet struct {
+		habit HabitLogDays
+		days  map[string]bool
+	}
+	var eligible []daySet
+	for _, h := range habits {
+		days := make(map[string]bool, len(h.Days))
+		for _, d := range h.Days {
+			days[dateutil.FormatDate(d)] = true
+		}
+		if len(days) >= MinCorrelationDays {
+			eligible = append(eligible, daySet{habit: h, days: days})
+		}
+	}
+
+	for _, a := range eligible {
+		for _, b := range eligible {
+			if a.habit.HabitID == b.habit.HabitID {
+				continue
+			}
+
+			shared := 0
+			for day := range a.days {
+				if b.days[day] {
+					shared++
+				}
+			}
+
+			rate := percent(shared, len(a.days))
+			baseline := percent(len(b.days), windowDays)
+			result.Correlations = append(result.Correlations, HabitCorrelation{
+				HabitID:          a.habit.HabitID,
+				HabitName:        a.habit.HabitName,
+				RelatedHabitID:   b.habit.HabitID,
+				RelatedHabitName: b.habit.HabitName,
+				HabitDays:        len(a.days),
+				SharedDays:       shared,
+				Rate:             rate,
+				BaselineRate:     baseline,
+				Lift:             round1(rate - baseline),
+			})
+		}
+	}
+
+	sort.SliceStable(result.Correlations, func(i, j int) bool {
+		ci, cj := result.Correlations[i], result.Correlations[j]
+		if ci.Lift != cj.Lift {
+			return ci.Lift > cj.Lift
+		}
+		return ci.Rate > cj.Rate
+	})
+	if len(result.Correlations) > MaxCorrelations {
+		result.Correlations = result.Correlations[:MaxCorrelations]
+	}
+
+	return result
+}
+
+func percent(part, whole int) float64 {
+	if whole == 0 {
+		return 0
+	}
+	return math.Round(float64(part)/float64(whole)*1000) / 10
+}
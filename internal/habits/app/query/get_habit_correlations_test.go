@@ -0,0 +1,70 @@
+package query_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/habits/app/query"
+)
+
+// loggedDays returns the days start+offset for each offset
+func loggedDays(start time.Time, offsets ...int) []time.Time {
+	days := make([]time.Time, len(offsets))
+	for i, offset := range offsets {
+		days[i] = start.AddDate(0, 0, offset)
+	}
+	return days
+}
+
+func TestNewHabitCorrelations(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given 90 days of logs for three habits", t, func() {
+		start := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+		end := start.AddDate(0, 0, query.CorrelationWindowDays-1)
+
+		var exerciseDays, readDays []int
+		for i := 0; i < 20; i++ {
+			exerciseDays = append(exerciseDays, i*4)
+			if i < 14 {
+				readDays = append(readDays, i*4) // 14 of the 20 exercise days
+			}
+		}
+		readDays = append(readDays, 81, 85, 89)
+
+		habits := []query.HabitLogDays{
+			{HabitID: "exercise", HabitName: "Exercise", Days: loggedDays(start, exerciseDays...)},
+			{HabitID: "read", HabitName: "Read", Days: loggedDays(start, readDays...)},
+			{HabitID: "meditate", HabitName: "Meditate", Days: loggedDays(start, 1, 2, 3)},
+		}
+
+		result := query.NewHabitCorrelations(start, end, habits)
+
+		Convey("Then habits below the minimum are left out", func() {
+			So(result.StartDate, ShouldEqual, "2026-07-01")
+			So(result.EndDate, ShouldEqual, "2026-09-28")
+			So(result.Correlations, ShouldHaveLength, 2)
+			for _, c := range result.Correlations {
+				So(c.HabitID, ShouldNotEqual, "meditate")
+				So(c.RelatedHabitID, ShouldNotEqual, "meditate")
+			}
+		})
+
+		Convey("Then pairs are ranked by how far they beat the baseline", func() {
+			readThenExercise, exerciseThenRead := result.Correlations[0], result.Correlations[1]
+			So(readThenExercise.HabitID, ShouldEqual, "read")
+			So(readThenExercise.Rate, ShouldEqual, 82.4)
+			So(readThenExercise.Lift, ShouldEqual, 60.2)
+
+			So(exerciseThenRead.HabitID, ShouldEqual, "exercise")
+			So(exerciseThenRead.RelatedHabitID, ShouldEqual, "read")
+			So(exerciseThenRead.HabitDays, ShouldEqual, 20)
+			So(exerciseThenRead.SharedDays, ShouldEqual, 14)
+			So(exerciseThenRead.Rate, ShouldEqual, 70.0)
+			So(exerciseThenRead.BaselineRate, ShouldEqual, 18.9)
+			So(exerciseThenRead.Lift, ShouldEqual, 51.1)
+		})
+	})
+}
@@ -0,0 +1,177 @@
+package query
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/habits/domain/habit"
+)
+
+// MinInsightLogs is how many logs a habit needs before its best and worst
+// days and times are named; fewer would make the suggestions noise
+const MinInsightLogs = 5
+
+// Times of day logs are grouped into, by the hour in the user's timezone
+const (
+	TimeOfDayMorning   = "morning"   // 05:00-11:59
+	TimeOfDayAfternoon = "afternoon" // 12:00-16:59
+	TimeOfDayEvening   = "evening"   // 17:00-20:59
+	TimeOfDayNight     = "night"     // 21:00-04:59
+)
+
+var timesOfDay = []string{TimeOfDayMorning, TimeOfDayAfternoon, TimeOfDayEvening, TimeOfDayNight}
+
+// GetHabitInsights query analyzes on which weekdays and at which times of
+// day a habit is usually completed
+type GetHabitInsights struct {
+	HabitID string
+	UserID  string
+}
+
+// HabitInsights shows when a habit is completed. The best and worst fields
+// are empty until the habit has MinInsightLogs logs.
+type HabitInsights struct {
+	HabitID       string          `json:"habit_id"`
+	HabitName     string          `json:"habit_name"`
+	TotalLogs     int             `json:"total_logs"`
+	Weekdays      []InsightBucket `json:"weekdays"`     // Monday first
+	TimesOfDay    []InsightBucket `json:"times_of_day"` // Morning first
+	BestWeekday   string          `json:"best_weekday"`
+	WorstWeekday  string          `json:"worst_weekday"`
+	BestTimeOfDay string          `json:"best_time_of_day"`
+}
+
+// InsightBucket counts the logs made on one weekday or time of day
+type InsightBucket struct {
+	Name  string  `json:"name"`
+	Logs  int     `json:"logs"`
+	Share float64 `json:"share"` // Percent of all logs
+}
+
+// HabitLogCounts are a habit's logs counted by weekday of the log date and
+// by hour of day they were made in the user's timezone
+type HabitLogCounts struct {
+	HabitID   string
+	HabitName string
+	ByWeekday [7]int  // Indexed by time.Weekday
+	ByHour    [24]int // Indexed by hour
+}
+
+// GetHabitInsightsHandler processes habit insights queries
+type GetHabitInsightsHandler decorator.QueryHandler[GetHabitInsights, *HabitInsights]
+
+// GetHabitInsightsReadModel interface for data access
+type GetHabitInsightsReadModel interface {
+	GetHabitLogCounts(ctx context.Context, habitID, userID string) (*HabitLogCounts, error)
+}
+
+type getHabitInsightsHandler struct {
+	readModel GetHabitInsightsReadModel
+	plans     habit.PlanGate
+}
+
+// NewGetHabitInsightsHandler creates a new handler with decorators
+func NewGetHabitInsightsHandler(
+	readModel GetHabitInsightsReadModel,
+	plans habit.PlanGate,
+	log logger.Logger,
+	metricsClient decorator.MetricsClient,
+) GetHabitInsightsHandler {
+	if readModel == nil {
+		panic("nil read model")
+	}
+	if plans == nil {
+		panic("nil plan gate")
+	}
+
+	return decorator.ApplyQueryDecorators(
+		getHabitInsightsHandler{readModel: readModel, plans: plans},
+		log,
+		metricsClient,
+	)
+}
+
+func (h getHabitInsightsHandler) Handle(ctx context.Context, q GetHabitInsights) (*HabitInsights, error) {
+	if err := h.plans.CheckAdvancedAnalytics(ctx, q.UserID); err != nil {
+		return nil, err
+	}
+
+	counts, err := h.readModel.GetHabitLogCounts(ctx, q.HabitID, q.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return NewHabitInsights(counts), nil
+}
+
+// NewHabitInsights groups log counts into weekday and time of day buckets
+// and picks the best and worst of them. Ties go to the earlier bucket.
+func NewHabitInsights(counts *HabitLogCounts) *HabitInsights {
+	insights := &HabitInsights{
+		HabitID:   counts.HabitID,
+		HabitName: counts.HabitName,
+	}
+	for _, n := range counts.ByWeekday {
+		insights.TotalLogs += n
+	}
+
+	for i := 0; i < 7; i++ {
+		day := time.Weekday((i + 1) % 7) // Monday first
+		insights.Weekdays = append(insights.Weekdays, insights.bucket(day.String(), counts.ByWeekday[day]))
+	}
+
+	byTime := make(map[string]int, len(timesOfDay))
+	for hour, n := range counts.ByHour {
+		byTime[timeOfDay(hour)] += n
+	}
+	for _, name := range timesOfDay {
+		insights.TimesOfDay = append(insights.TimesOfDay, insights.bucket(name, byTime[name]))
+	}
+
+	if insights.TotalLogs >= MinInsightLogs {
+		best, worst := extremes(insights.Weekdays)
+		insights.BestWeekday, insights.WorstWeekday = best.Name, worst.Name
+		best, _ = extremes(insights.TimesOfDay)
+		insights.BestTimeOfDay = best.Name
+	}
+
+	return insights
+}
+
+func (i *HabitInsights) bucket(name string, logs int) InsightBucket {
+	b := InsightBucket{Name: name, Logs: logs}
+	if i.TotalLogs > 0 {
+		b.Share = math.Round(float64(logs)/float64(i.TotalLogs)*1000) / 10
+	}
+	return b
+}
+
+// timeOfDay names the part of the day an hour falls in
+func timeOfDay(hour int) string {
+	switch {
+	case hour >= 5 && hour < 12:
+		return TimeOfDayMorning
+	case hour >= 12 && hour < 17:
+		return TimeOfDayAfternoon
+	case hour >= 17 && hour < 21:
+		return TimeOfDayEvening
+	default:
+		return TimeOfDayNight
+	}
+}
+
+// extremes returns the buckets with the most and the fewest logs
+func extremes(buckets []InsightBucket) (best, worst InsightBucket) {
+	best, worst = buckets[0], buckets[0]
+	for _, b := range buckets[1:] {
+		if b.Logs > best.Logs {
+			best = b
+		}
+		if b.Logs < worst.Logs {
+			worst = b
+		}
+	}
+	return best, worst
+}
@@ -0,0 +1,63 @@
+package query_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/habits/app/query"
+)
+
+func TestNewHabitInsights(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given a habit mostly logged on Tuesday mornings", t, func() {
+		counts := &query.HabitLogCounts{HabitID: "h1", HabitName: "Stretch"}
+		counts.ByWeekday[time.Tuesday] = 6
+		counts.ByWeekday[time.Friday] = 2
+		counts.ByWeekday[time.Sunday] = 2
+		counts.ByHour[7] = 5
+		counts.ByHour[11] = 1
+		counts.ByHour[19] = 2
+		counts.ByHour[23] = 1
+		counts.ByHour[2] = 1
+
+		insights := query.NewHabitInsights(counts)
+
+		Convey("Then the logs are bucketed Monday first and morning first", func() {
+			So(insights.TotalLogs, ShouldEqual, 10)
+			So(insights.Weekdays, ShouldHaveLength, 7)
+			So(insights.Weekdays[0].Name, ShouldEqual, "Monday")
+			So(insights.Weekdays[1], ShouldResemble, query.InsightBucket{Name: "Tuesday", Logs: 6, Share: 60})
+			So(insights.Weekdays[6].Name, ShouldEqual, "Sunday")
+			So(insights.TimesOfDay, ShouldResemble, []query.InsightBucket{
+				{Name: query.TimeOfDayMorning, Logs: 6, Share: 60},
+				{Name: query.TimeOfDayAfternoon, Logs: 0, Share: 0},
+				{Name: query.TimeOfDayEvening, Logs: 2, Share: 20},
+				{Name: query.TimeOfDayNight, Logs: 2, Share: 20},
+			})
+		})
+
+		Convey("Then the best and worst buckets are named", func() {
+			So(insights.BestWeekday, ShouldEqual, "Tuesday")
+			So(insights.WorstWeekday, ShouldEqual, "Monday")
+			So(insights.BestTimeOfDay, ShouldEqual, query.TimeOfDayMorning)
+		})
+	})
+
+	Convey("Given a habit with too few logs", t, func() {
+		counts := &query.HabitLogCounts{HabitID: "h2"}
+		counts.ByWeekday[time.Monday] = 2
+		counts.ByHour[8] = 2
+
+		insights := query.NewHabitInsights(counts)
+
+		Convey("Then no best or worst is named yet", func() {
+			So(insights.TotalLogs, ShouldEqual, 2)
+			So(insights.BestWeekday, ShouldBeEmpty)
+			So(insights.WorstWeekday, ShouldBeEmpty)
+			So(insights.BestTimeOfDay, ShouldBeEmpty)
+		})
+	})
+}
@@ -21,6 +21,9 @@ type GetHabitLogsResult struct {
 	Pagination *model.Paging
 }
 
+// HabitLogSortColumns are the columns habit logs can be sorted by
+var HabitLogSortColumns = []string{"log_date", "created_at", "count"}
+
 // GetHabitLogsHandler processes get habit logs queries
 type GetHabitLogsHandler decorator.QueryHandler[GetHabitLogs, GetHabitLogsResult]
 
@@ -55,8 +58,9 @@ func (h getHabitLogsHandler) Handle(ctx context.Context, q GetHabitLogs) (GetHab
 	q.Filter.Validate()
 
 	// Validate allowed sort columns
-	allowedSortColumns := []string{"log_date", "created_at", "count"}
-	q.Filter.ValidateSortBy(allowedSortColumns)
+	if err := q.Filter.ValidateSort(HabitLogSortColumns); err != nil {
+		return GetHabitLogsResult{}, err
+	}
 
 	logs, totalCount, err := h.readModel.GetHabitLogs(ctx, q.HabitID, q.UserID, q.Filter)
 	if err != nil {
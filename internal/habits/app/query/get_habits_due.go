@@ -2,39 +2,80 @@ package query
 
 import (
 	"context"
+	"time"
 
 	"github.com/semmidev/ethos-go/internal/common/decorator"
 	"github.com/semmidev/ethos-go/internal/common/logger"
 )
 
-type GetHabitsDue struct{}
+// reminderGrace is how late a reminder may still be sent, such as after
+// the worker was down. Older ones, like those of a habit just resumed from
+// a pause, are skipped and rescheduled.
+const reminderGrace = time.Hour
+
+// GetHabitsDue returns the habits whose next reminder is due at Now and
+// that were not logged on the day it is for
+type GetHabitsDue struct {
+	Now time.Time
+}
 
 type GetHabitsDueHandler decorator.QueryHandler[GetHabitsDue, []ReminderHabit]
 
 type HabitsDueReadModel interface {
-	GetHabitsDueForReminder(ctx context.Context) ([]ReminderHabit, error)
+	GetHabitsDueForReminder(ctx context.Context, now, since time.Time) ([]ReminderHabit, error)
+}
+
+// MissedPeriodsReadModel counts how many scheduled days in a row a habit was missed
+type MissedPeriodsReadModel interface {
+	CountMissedPeriods(ctx context.Context, habitID, userID string, today time.Time) (int, error)
 }
 
 type getHabitsDueHandler struct {
 	readModel HabitsDueReadModel
+	missed    MissedPeriodsReadModel
+	log       logger.Logger
 }
 
 func NewGetHabitsDueHandler(
 	readModel HabitsDueReadModel,
+	missed MissedPeriodsReadModel,
 	log logger.Logger,
 	metricsClient decorator.MetricsClient,
 ) GetHabitsDueHandler {
-	if readModel == nil {
+	if readModel == nil || missed == nil {
 		panic("nil read model")
 	}
 
 	return decorator.ApplyQueryDecorators(
-		getHabitsDueHandler{readModel: readModel},
+		getHabitsDueHandler{readModel: readModel, missed: missed, log: log},
 		log,
 		metricsClient,
 	)
 }
 
-func (h getHabitsDueHandler) Handle(ctx context.Context, _ GetHabitsDue) ([]ReminderHabit, error) {
-	return h.readModel.GetHabitsDueForReminder(ctx)
+func (h getHabitsDueHandler) Handle(ctx context.Context, q GetHabitsDue) ([]ReminderHabit, error) {
+	now := q.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	habits, err := h.readModel.GetHabitsDueForReminder(ctx, now, now.Add(-reminderGrace))
+	if err != nil {
+		return nil, err
+	}
+
+	// A failed count only loses the escalation, not the reminder itself
+	for i := range habits {
+		missed, err := h.missed.CountMissedPeriods(ctx, habits[i].HabitID, habits[i].UserID, habits[i].LocalDate)
+		if err != nil {
+			h.log.Warn(ctx, "failed to count missed periods",
+				logger.Field{Key: "habit_id", Value: habits[i].HabitID},
+				logger.Field{Key: "error", Value: err.Error()},
+			)
+			continue
+		}
+		habits[i].MissedPeriods = missed
+	}
+
+	return habits, nil
 }
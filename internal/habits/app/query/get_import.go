@@ -0,0 +1,54 @@
+package query
+
+import (
+	"context"
+	"errors"
+
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/habits/domain/habitimport"
+)
+
+// GetImport query retrieves the progress of an import
+type GetImport struct {
+	ImportID string
+	UserID   string
+}
+
+// GetImportHandler processes get import queries
+type GetImportHandler decorator.QueryHandler[GetImport, HabitImport]
+
+// GetImportReadModel interface for data access
+type GetImportReadModel interface {
+	GetImportQuery(ctx context.Context, importID, userID string) (HabitImport, error)
+}
+
+type getImportHandler struct {
+	readModel GetImportReadModel
+}
+
+// NewGetImportHandler creates a new handler with decorators
+func NewGetImportHandler(
+	readModel GetImportReadModel,
+	log logger.Logger,
+	metricsClient decorator.MetricsClient,
+) GetImportHandler {
+	if readModel == nil {
+		panic("nil read model")
+	}
+
+	return decorator.ApplyQueryDecorators(
+		getImportHandler{readModel: readModel},
+		log,
+		metricsClient,
+	)
+}
+
+func (h getImportHandler) Handle(ctx context.Context, q GetImport) (HabitImport, error) {
+	imp, err := h.readModel.GetImportQuery(ctx, q.ImportID, q.UserID)
+	if errors.Is(err, habitimport.ErrNotFound) {
+		return HabitImport{}, apperror.NotFound("import", q.ImportID)
+	}
+	return imp, err
+}
@@ -0,0 +1,44 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+)
+
+// GetInactiveUsers returns users who have logged before but not since Since
+type GetInactiveUsers struct {
+	Since time.Time
+}
+
+type GetInactiveUsersHandler decorator.QueryHandler[GetInactiveUsers, []InactiveUser]
+
+type InactiveUsersReadModel interface {
+	GetInactiveUsers(ctx context.Context, since time.Time) ([]InactiveUser, error)
+}
+
+type getInactiveUsersHandler struct {
+	readModel InactiveUsersReadModel
+}
+
+func NewGetInactiveUsersHandler(
+	readModel InactiveUsersReadModel,
+	log logger.Logger,
+	metricsClient decorator.MetricsClient,
+) GetInactiveUsersHandler {
+	if readModel == nil {
+		panic("nil read model")
+	}
+
+	return decorator.ApplyQueryDecorators(
+		getInactiveUsersHandler{readModel: readModel},
+		log,
+		metricsClient,
+	)
+}
+
+func (h getInactiveUsersHandler) Handle(ctx context.Context, q GetInactiveUsers) ([]InactiveUser, error) {
+	return h.readModel.GetInactiveUsers(ctx, q.Since)
+}
@@ -0,0 +1,89 @@
+package query
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+)
+
+// publicStatsTTL is how long public stats are served from memory before the
+// aggregates are counted again
+const publicStatsTTL = 10 * time.Minute
+
+// GetPublicStats returns anonymized totals across all users for the landing page
+type GetPublicStats struct{}
+
+// PublicStats holds platform-wide totals only. It must never carry anything
+// that identifies or describes a single user.
+type PublicStats struct {
+	TotalUsers    int       `json:"total_users" db:"total_users"`
+	HabitsTracked int       `json:"habits_tracked" db:"habits_tracked"`
+	LogsThisWeek  int       `json:"logs_this_week" db:"logs_this_week"`
+	GeneratedAt   time.Time `json:"generated_at" db:"-"`
+}
+
+type GetPublicStatsHandler decorator.QueryHandler[GetPublicStats, *PublicStats]
+
+type PublicStatsReadModel interface {
+	GetPublicStats(ctx context.Context, weekStart time.Time) (*PublicStats, error)
+}
+
+type getPublicStatsHandler struct {
+	readModel PublicStatsReadModel
+	cache     *publicStatsCache
+}
+
+// publicStatsCache keeps the last computed stats shared by all requests
+type publicStatsCache struct {
+	mu        sync.Mutex
+	stats     *PublicStats
+	expiresAt time.Time
+}
+
+func NewGetPublicStatsHandler(
+	readModel PublicStatsReadModel,
+	log logger.Logger,
+	metricsClient decorator.MetricsClient,
+) GetPublicStatsHandler {
+	if readModel == nil {
+		panic("nil read model")
+	}
+
+	return decorator.ApplyQueryDecorators(
+		getPublicStatsHandler{readModel: readModel, cache: &publicStatsCache{}},
+		log,
+		metricsClient,
+	)
+}
+
+func (h getPublicStatsHandler) Handle(ctx context.Context, _ GetPublicStats) (*PublicStats, error) {
+	h.cache.mu.Lock()
+	defer h.cache.mu.Unlock()
+
+	now := time.Now().UTC()
+	if h.cache.stats != nil && now.Before(h.cache.expiresAt) {
+		logger.SetCacheHit(ctx, true)
+		cached := *h.cache.stats
+		return &cached, nil
+	}
+	logger.SetCacheHit(ctx, false)
+
+	// Weeks start on Monday here since the totals span every user's timezone
+	// and week preference
+	offset := (int(now.Weekday()) + 6) % 7
+	weekStart := now.AddDate(0, 0, -offset).Truncate(24 * time.Hour)
+
+	stats, err := h.readModel.GetPublicStats(ctx, weekStart)
+	if err != nil {
+		return nil, err
+	}
+	stats.GeneratedAt = now
+
+	cached := *stats
+	h.cache.stats = &cached
+	h.cache.expiresAt = now.Add(publicStatsTTL)
+	return stats, nil
+}
@@ -0,0 +1,55 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/habits/app/query"
+	"github.com/semmidev/ethos-go/internal/testutil"
+)
+
+type countingPublicStatsReadModel struct {
+	calls     int
+	weekStart time.Time
+}
+
+func (m *countingPublicStatsReadModel) GetPublicStats(_ context.Context, weekStart time.Time) (*query.PublicStats, error) {
+	m.calls++
+	m.weekStart = weekStart
+	return &query.PublicStats{TotalUsers: 12, HabitsTracked: 40, LogsThisWeek: 95}, nil
+}
+
+func TestGetPublicStatsHandler(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given a GetPublicStats handler", t, func() {
+		ctx := context.Background()
+		readModel := &countingPublicStatsReadModel{}
+		handler := query.NewGetPublicStatsHandler(readModel, testutil.NopLogger{}, &decorator.NoOpMetricsClient{})
+
+		Convey("When the stats are requested", func() {
+			stats, err := handler.Handle(ctx, query.GetPublicStats{})
+
+			Convey("Then the aggregates are counted from the start of the week", func() {
+				So(err, ShouldBeNil)
+				So(stats.TotalUsers, ShouldEqual, 12)
+				So(stats.HabitsTracked, ShouldEqual, 40)
+				So(stats.LogsThisWeek, ShouldEqual, 95)
+				So(readModel.weekStart.Weekday(), ShouldEqual, time.Monday)
+				So(readModel.weekStart.After(time.Now()), ShouldBeFalse)
+			})
+
+			Convey("Then a second request within ten minutes is served from the cache", func() {
+				stats.TotalUsers = 0
+				again, err := handler.Handle(ctx, query.GetPublicStats{})
+				So(err, ShouldBeNil)
+				So(readModel.calls, ShouldEqual, 1)
+				So(again.TotalUsers, ShouldEqual, 12)
+			})
+		})
+	})
+}
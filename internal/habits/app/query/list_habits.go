@@ -20,6 +20,9 @@ type ListHabitsResult struct {
 	Pagination *model.Paging
 }
 
+// HabitSortColumns are the columns habits can be sorted by
+var HabitSortColumns = []string{"name", "created_at", "updated_at", "is_active", "position"}
+
 // ListHabitsHandler processes list habits queries
 type ListHabitsHandler decorator.QueryHandler[ListHabits, ListHabitsResult]
 
@@ -54,8 +57,9 @@ func (h listHabitsHandler) Handle(ctx context.Context, q ListHabits) (ListHabits
 	q.Filter.Validate()
 
 	// Validate allowed sort columns
-	allowedSortColumns := []string{"name", "created_at", "updated_at", "is_active"}
-	q.Filter.ValidateSortBy(allowedSortColumns)
+	if err := q.Filter.ValidateSort(HabitSortColumns); err != nil {
+		return ListHabitsResult{}, err
+	}
 
 	habits, totalCount, err := h.readModel.ListHabits(ctx, q.UserID, q.Filter)
 	if err != nil {
@@ -0,0 +1,59 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/model"
+	"github.com/semmidev/ethos-go/internal/habits/app/query"
+	"github.com/semmidev/ethos-go/internal/testutil"
+)
+
+// habitsReadModel records the filter it was asked to list habits with
+type habitsReadModel struct {
+	filter *model.Filter
+}
+
+func (r habitsReadModel) ListHabits(_ context.Context, _ string, filter model.Filter) ([]query.Habit, int, error) {
+	*r.filter = filter
+	return nil, 0, nil
+}
+
+func TestListHabitsHandler(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given a habits list", t, func() {
+		var listed model.Filter
+		handler := query.NewListHabitsHandler(habitsReadModel{filter: &listed}, testutil.NopLogger{}, &decorator.NoOpMetricsClient{})
+		list := func(sortBy string) error {
+			filter := model.NewFilter()
+			filter.SortBy = sortBy
+			_, err := handler.Handle(context.Background(), query.ListHabits{UserID: "user", Filter: filter})
+			return err
+		}
+
+		Convey("When it is sorted by a sortable column", func() {
+			err := list("Name")
+
+			Convey("Then the normalized column reaches the read model", func() {
+				So(err, ShouldBeNil)
+				So(listed.SortBy, ShouldEqual, "name")
+			})
+		})
+
+		Convey("When it is sorted by a column that can't be sorted by", func() {
+			err := list("user_id")
+
+			Convey("Then it is rejected as invalid input", func() {
+				appErr := apperror.GetAppError(err)
+				So(appErr, ShouldNotBeNil)
+				So(appErr.Code, ShouldEqual, apperror.ErrCodeInvalidInput)
+				So(appErr.StatusCode, ShouldEqual, 400)
+			})
+		})
+	})
+}
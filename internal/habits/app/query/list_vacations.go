@@ -0,0 +1,54 @@
+package query
+
+import (
+	"context"
+	"errors"
+
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/habits/domain/habit"
+)
+
+// ListVacations query retrieves all vacations of a habit, newest first
+type ListVacations struct {
+	HabitID string
+	UserID  string
+}
+
+// ListVacationsHandler processes list vacations queries
+type ListVacationsHandler decorator.QueryHandler[ListVacations, []Vacation]
+
+// ListVacationsReadModel interface for data access
+type ListVacationsReadModel interface {
+	ListVacationsQuery(ctx context.Context, habitID, userID string) ([]Vacation, error)
+}
+
+type listVacationsHandler struct {
+	readModel ListVacationsReadModel
+}
+
+// NewListVacationsHandler creates a new handler with decorators
+func NewListVacationsHandler(
+	readModel ListVacationsReadModel,
+	log logger.Logger,
+	metricsClient decorator.MetricsClient,
+) ListVacationsHandler {
+	if readModel == nil {
+		panic("nil read model")
+	}
+
+	return decorator.ApplyQueryDecorators(
+		listVacationsHandler{readModel: readModel},
+		log,
+		metricsClient,
+	)
+}
+
+func (h listVacationsHandler) Handle(ctx context.Context, q ListVacations) ([]Vacation, error) {
+	vacations, err := h.readModel.ListVacationsQuery(ctx, q.HabitID, q.UserID)
+	if errors.Is(err, habit.ErrNotFound) {
+		return nil, apperror.NotFound("habit", q.HabitID)
+	}
+	return vacations, err
+}
@@ -0,0 +1,70 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/habits/domain/habitimport"
+)
+
+// PreviewImport query parses an export file from another habit tracker and
+// describes what importing it would create, without writing anything
+type PreviewImport struct {
+	Source string
+	Data   []byte
+}
+
+// PreviewImportHandler processes import preview queries
+type PreviewImportHandler decorator.QueryHandler[PreviewImport, ImportPreview]
+
+type previewImportHandler struct{}
+
+// NewPreviewImportHandler creates a new handler with decorators
+func NewPreviewImportHandler(
+	log logger.Logger,
+	metricsClient decorator.MetricsClient,
+) PreviewImportHandler {
+	return decorator.ApplyQueryDecorators(
+		previewImportHandler{},
+		log,
+		metricsClient,
+	)
+}
+
+func (h previewImportHandler) Handle(ctx context.Context, q PreviewImport) (ImportPreview, error) {
+	source, err := habitimport.NewSource(q.Source)
+	if err != nil {
+		return ImportPreview{}, apperror.InvalidInput("source", err.Error())
+	}
+
+	parsed, err := habitimport.Parse(source, q.Data, time.Now())
+	if err != nil {
+		return ImportPreview{}, apperror.InvalidInput("data", err.Error())
+	}
+
+	preview := ImportPreview{
+		Source:      string(source),
+		Habits:      make([]ImportPreviewHabit, len(parsed.Habits)),
+		TotalHabits: len(parsed.Habits),
+		Warnings:    parsed.Warnings,
+	}
+	for i, h := range parsed.Habits {
+		preview.Habits[i] = ImportPreviewHabit{
+			Name:      h.Name,
+			Frequency: h.Frequency,
+			Recurrence: Recurrence{
+				Days:     h.Days,
+				Interval: h.Interval,
+			},
+			Logs:         len(h.Completions),
+			FirstLogDate: h.FirstDate(),
+			LastLogDate:  h.LastDate(),
+		}
+		preview.TotalLogs += len(h.Completions)
+	}
+
+	return preview, nil
+}
@@ -4,16 +4,36 @@ import "time"
 
 // Habit represents a read model for habit queries (optimized for UI)
 type Habit struct {
-	HabitID      string    `json:"habit_id"`
-	UserID       string    `json:"user_id"`
-	Name         string    `json:"name"`
-	Description  *string   `json:"description,omitempty"` // Nullable field
-	Frequency    string    `json:"frequency"`
-	TargetCount  int       `json:"target_count"`
-	ReminderTime *string   `json:"reminder_time,omitempty"` // Nullable field
-	IsActive     bool      `json:"is_active"`
-	CreatedAt    time.Time `json:"created_at"`
-	UpdatedAt    time.Time `json:"updated_at"`
+	HabitID      string     `json:"habit_id"`
+	UserID       string     `json:"user_id"`
+	Name         string     `json:"name"`
+	Description  *string    `json:"description,omitempty"` // Nullable field
+	Frequency    string     `json:"frequency"`
+	TargetCount  int        `json:"target_count"`
+	ReminderTime *string    `json:"reminder_time,omitempty"` // Nullable field
+	IsActive     bool       `json:"is_active"`
+	Position     int        `json:"position"`               // User-defined display order
+	PausedUntil  *time.Time `json:"paused_until,omitempty"` // Set while paused
+	Recurrence   Recurrence `json:"recurrence"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+	Version      int        `json:"version"` // Pass back to UpdateHabit to detect concurrent edits
+}
+
+// Recurrence describes on which weekdays and how often a habit repeats
+type Recurrence struct {
+	Days     []string `json:"days"`     // Short weekday names, e.g. "mon"
+	Interval int      `json:"interval"` // Every N periods
+}
+
+// Vacation represents a read model for a habit vacation
+type Vacation struct {
+	VacationID string     `json:"vacation_id"`
+	HabitID    string     `json:"habit_id"`
+	StartDate  time.Time  `json:"start_date"`
+	EndDate    *time.Time `json:"end_date,omitempty"` // nil = ongoing
+	Reason     *string    `json:"reason,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
 }
 
 // HabitLog represents a read model for habit log queries
@@ -71,8 +91,73 @@ type DailyAnalytics struct {
 
 // ReminderHabit represents a habit that needs a reminder (due today, not completed)
 type ReminderHabit struct {
-	UserID       string  `db:"user_id"`
-	HabitID      string  `db:"habit_id"`
-	HabitName    string  `db:"name"`
-	ReminderTime *string `db:"reminder_time"`
+	UserID        string    `db:"user_id"`
+	HabitID       string    `db:"habit_id"`
+	HabitName     string    `db:"name"`
+	ReminderTime  *string   `db:"reminder_time"`
+	TargetCount   int       `db:"target_count"`
+	Locale        string    `db:"locale"`
+	LocalDate     time.Time `db:"local_date"` // The user's today
+	MissedPeriods int       `db:"-"`          // Scheduled days missed in a row before today
+}
+
+// InactiveUser is a user who stopped logging, with the habit that had their
+// best streak to nudge them back to
+type InactiveUser struct {
+	UserID      string    `db:"user_id"`
+	LastLogDate time.Time `db:"last_log_date"`
+	HabitID     string    `db:"habit_id"`
+	HabitName   string    `db:"habit_name"`
+	Streak      int       `db:"streak"`
+}
+
+// DailySummary counts how many of a user's habits due today were completed
+type DailySummary struct {
+	UserID    string `db:"user_id"`
+	Timezone  string `db:"timezone"`
+	Locale    string `db:"locale"`
+	Completed int    `db:"completed"`
+	Total     int    `db:"total"`
+}
+
+// HabitImport represents the progress of an import from another habit tracker
+type HabitImport struct {
+	ImportID       string     `json:"import_id"`
+	Source         string     `json:"source"`
+	Status         string     `json:"status"` // pending, running, completed or failed
+	TotalHabits    int        `json:"total_habits"`
+	ImportedHabits int        `json:"imported_habits"`
+	TotalLogs      int        `json:"total_logs"`
+	ImportedLogs   int        `json:"imported_logs"`
+	Failure        *string    `json:"failure,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+	CompletedAt    *time.Time `json:"completed_at,omitempty"`
+}
+
+// Progress returns the share of habits written, from 0 to 100
+func (i HabitImport) Progress() int {
+	if i.TotalHabits == 0 {
+		return 0
+	}
+	return i.ImportedHabits * 100 / i.TotalHabits
+}
+
+// ImportPreview describes what an import would create
+type ImportPreview struct {
+	Source      string               `json:"source"`
+	Habits      []ImportPreviewHabit `json:"habits"`
+	TotalHabits int                  `json:"total_habits"`
+	TotalLogs   int                  `json:"total_logs"`
+	Warnings    []string             `json:"warnings"`
+}
+
+// ImportPreviewHabit is one habit an import would create
+type ImportPreviewHabit struct {
+	Name         string     `json:"name"`
+	Frequency    string     `json:"frequency"`
+	Recurrence   Recurrence `json:"recurrence"`
+	Logs         int        `json:"logs"`
+	FirstLogDate *time.Time `json:"first_log_date,omitempty"`
+	LastLogDate  *time.Time `json:"last_log_date,omitempty"`
 }
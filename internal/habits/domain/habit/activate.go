@@ -8,6 +8,7 @@ func (h *Habit) Activate() error {
 	}
 
 	h.isActive = true
+	h.pausedUntil = nil // Activating early ends a pause
 	h.updatedAt = time.Now()
 	return nil
 }
@@ -0,0 +1,13 @@
+package habit
+
+import "time"
+
+// BackdateCreation moves the habit's creation date back to at, so history
+// imported from another app counts towards streaks and schedules. Later
+// dates are ignored.
+func (h *Habit) BackdateCreation(at time.Time) {
+	at = startOfDay(at)
+	if at.Before(h.createdAt) {
+		h.createdAt = at
+	}
+}
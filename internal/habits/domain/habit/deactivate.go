@@ -3,11 +3,13 @@ package habit
 import "time"
 
 func (h *Habit) Deactivate() error {
-	if !h.isActive {
+	if !h.isActive && h.pausedUntil == nil {
 		return ErrAlreadyInactive
 	}
 
+	// Deactivating a paused habit cancels its scheduled resume
 	h.isActive = false
+	h.pausedUntil = nil
 	h.updatedAt = time.Now()
 	return nil
 }
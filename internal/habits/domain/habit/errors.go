@@ -8,6 +8,8 @@ var (
 	// Business logic errors
 	ErrAlreadyActive   = errors.New("habit is already active")
 	ErrAlreadyInactive = errors.New("habit is already inactive")
+	ErrNotPaused       = errors.New("habit is not paused")
+	ErrVersionConflict = errors.New("habit was changed by another request")
 
 	// Validation errors
 	ErrEmptyName          = errors.New("habit name cannot be empty")
@@ -15,8 +17,12 @@ var (
 	ErrInvalidReminder    = errors.New("invalid reminder time format (HH:MM)")
 	ErrEmptyHabitID       = errors.New("empty habit id")
 	ErrEmptyUserID        = errors.New("empty user id")
+	ErrInvalidPauseDate   = errors.New("pause end date must be after today")
 
-	// Access errors
-	ErrNotFound     = errors.New("habit not found")
-	ErrUnauthorized = errors.New("user cannot access this habit")
+	// Limit errors
+	ErrTooManyActive = errors.New("too many active habits")
+
+	// Access errors. Repositories report another user's habit as not
+	// found, so its existence is not revealed.
+	ErrNotFound = errors.New("habit not found")
 )
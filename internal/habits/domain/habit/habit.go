@@ -14,8 +14,10 @@ type Habit struct {
 	targetCount  int
 	reminderTime *string // Nullable field - e.g. "08:00"
 	isActive     bool
+	pausedUntil  *time.Time // Set while paused; the habit resumes on this date
 	createdAt    time.Time
 	updatedAt    time.Time
+	version      int // Incremented by the repository on every update
 }
 
 func NewHabit(
@@ -60,6 +62,7 @@ func NewHabit(
 		isActive:     true,
 		createdAt:    now,
 		updatedAt:    now,
+		version:      1,
 	}, nil
 }
 
@@ -72,7 +75,9 @@ func UnmarshalHabitFromDatabase(
 	targetCount int,
 	reminderTime *string,
 	isActive bool,
+	pausedUntil *time.Time,
 	createdAt, updatedAt time.Time,
+	version int,
 ) (*Habit, error) {
 	frequency, err := NewFrequency(frequencyStr)
 	if err != nil {
@@ -95,28 +100,34 @@ func UnmarshalHabitFromDatabase(
 		targetCount:  targetCount,
 		reminderTime: reminderTime,
 		isActive:     isActive,
+		pausedUntil:  pausedUntil,
 		createdAt:    createdAt,
 		updatedAt:    updatedAt,
+		version:      version,
 	}
 
 	return h, nil
 }
 
-func (h *Habit) HabitID() string        { return h.habitID }
-func (h *Habit) UserID() string         { return h.userID }
-func (h *Habit) Name() string           { return h.name }
-func (h *Habit) Description() *string   { return h.description }
-func (h *Habit) Frequency() Frequency   { return h.frequency }
-func (h *Habit) Recurrence() Recurrence { return h.recurrence }
-func (h *Habit) TargetCount() int       { return h.targetCount }
-func (h *Habit) ReminderTime() *string  { return h.reminderTime }
-func (h *Habit) IsActive() bool         { return h.isActive }
-func (h *Habit) CreatedAt() time.Time   { return h.createdAt }
-func (h *Habit) UpdatedAt() time.Time   { return h.updatedAt }
+func (h *Habit) HabitID() string         { return h.habitID }
+func (h *Habit) UserID() string          { return h.userID }
+func (h *Habit) Name() string            { return h.name }
+func (h *Habit) Description() *string    { return h.description }
+func (h *Habit) Frequency() Frequency    { return h.frequency }
+func (h *Habit) Recurrence() Recurrence  { return h.recurrence }
+func (h *Habit) TargetCount() int        { return h.targetCount }
+func (h *Habit) ReminderTime() *string   { return h.reminderTime }
+func (h *Habit) IsActive() bool          { return h.isActive }
+func (h *Habit) PausedUntil() *time.Time { return h.pausedUntil }
+func (h *Habit) CreatedAt() time.Time    { return h.createdAt }
+func (h *Habit) UpdatedAt() time.Time    { return h.updatedAt }
+func (h *Habit) Version() int            { return h.version }
 
-func (h *Habit) CanBeViewedBy(userID string) error {
-	if h.userID != userID {
-		return ErrUnauthorized
+// CheckVersion fails with ErrVersionConflict unless the habit is at
+// expected, i.e. nobody changed it since the caller read it
+func (h *Habit) CheckVersion(expected int) error {
+	if expected != h.version {
+		return ErrVersionConflict
 	}
 	return nil
 }
@@ -1,6 +1,7 @@
 package habit
 
 import (
+	"math"
 	"time"
 )
 
@@ -73,6 +74,13 @@ func (s *HabitStats) ResetStreak() {
 	s.updatedAt = time.Now()
 }
 
+// AddCompactedCompletions counts days whose logs were compacted into
+// monthly summaries, which the streak service no longer sees, towards the
+// total completions
+func (s *HabitStats) AddCompactedCompletions(days int) {
+	s.totalCompletions += days
+}
+
 // UpdateConsistency updates the consistency score
 func (s *HabitStats) UpdateConsistency(score float64) {
 	if score < 0 {
@@ -84,3 +92,24 @@ func (s *HabitStats) UpdateConsistency(score float64) {
 	s.consistencyScore = score
 	s.updatedAt = time.Now()
 }
+
+// Matches reports whether other holds the same statistics, ignoring when
+// they were last updated.
+func (s HabitStats) Matches(other *HabitStats) bool {
+	if other == nil {
+		return false
+	}
+	if s.currentStreak != other.currentStreak ||
+		s.longestStreak != other.longestStreak ||
+		s.totalCompletions != other.totalCompletions {
+		return false
+	}
+	if (s.lastCompletedAt == nil) != (other.lastCompletedAt == nil) {
+		return false
+	}
+	if s.lastCompletedAt != nil && s.lastCompletedAt.Format("2006-01-02") != other.lastCompletedAt.Format("2006-01-02") {
+		return false
+	}
+	// The score is stored as DECIMAL(5,2)
+	return math.Abs(s.consistencyScore-other.consistencyScore) < 0.01
+}
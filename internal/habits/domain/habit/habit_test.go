@@ -134,31 +134,6 @@ func TestReminderTimeValidation(t *testing.T) {
 	})
 }
 
-func TestHabitAuthorization(t *testing.T) {
-	t.Parallel()
-
-	Convey("Given a habit owned by a user", t, func() {
-		freq, _ := habit.NewFrequency("daily")
-		h, _ := habit.NewHabit("h-1", "user-owner", "Test", nil, freq, habit.DefaultRecurrence(), 1, nil)
-
-		Convey("When the owner tries to view", func() {
-			err := h.CanBeViewedBy("user-owner")
-
-			Convey("Then it should allow access", func() {
-				So(err, ShouldBeNil)
-			})
-		})
-
-		Convey("When a non-owner tries to view", func() {
-			err := h.CanBeViewedBy("user-other")
-
-			Convey("Then it should deny access", func() {
-				So(err, ShouldNotBeNil)
-			})
-		})
-	})
-}
-
 func TestUnmarshalHabitFromDatabase(t *testing.T) {
 	t.Parallel()
 
@@ -177,8 +152,10 @@ func TestUnmarshalHabitFromDatabase(t *testing.T) {
 			2,
 			nil,
 			true,
+			nil,
 			now,
 			now,
+			3,
 		)
 
 		Convey("Then it should unmarshal successfully", func() {
@@ -193,5 +170,61 @@ func TestUnmarshalHabitFromDatabase(t *testing.T) {
 		Convey("Then it should have correct Frequency", func() {
 			So(h.Frequency().String(), ShouldEqual, "weekly")
 		})
+
+		Convey("Then it should keep its version", func() {
+			So(h.Version(), ShouldEqual, 3)
+			So(h.CheckVersion(3), ShouldBeNil)
+			So(h.CheckVersion(2), ShouldEqual, habit.ErrVersionConflict)
+		})
+	})
+}
+
+func TestHabitPause(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given an active habit", t, func() {
+		freq, _ := habit.NewFrequency("daily")
+		h, _ := habit.NewHabit("h-1", "user-owner", "Test", nil, freq, habit.DefaultRecurrence(), 1, nil)
+		now := time.Date(2025, 7, 20, 15, 0, 0, 0, time.UTC)
+
+		Convey("When it is paused until a future date", func() {
+			err := h.Pause(time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC), now)
+
+			Convey("Then it should be inactive and paused", func() {
+				So(err, ShouldBeNil)
+				So(h.IsActive(), ShouldBeFalse)
+				So(h.IsPaused(), ShouldBeTrue)
+			})
+
+			Convey("Then the pause should expire on the until date", func() {
+				So(h.PauseExpired(now), ShouldBeFalse)
+				So(h.PauseExpired(time.Date(2025, 8, 1, 0, 30, 0, 0, time.UTC)), ShouldBeTrue)
+			})
+
+			Convey("Then resuming should reactivate it", func() {
+				So(h.Resume(), ShouldBeNil)
+				So(h.IsActive(), ShouldBeTrue)
+				So(h.IsPaused(), ShouldBeFalse)
+			})
+
+			Convey("Then deactivating should cancel the scheduled resume", func() {
+				So(h.Deactivate(), ShouldBeNil)
+				So(h.IsPaused(), ShouldBeFalse)
+				So(h.PauseExpired(time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)), ShouldBeFalse)
+			})
+		})
+
+		Convey("When it is paused until today", func() {
+			err := h.Pause(now, now)
+
+			Convey("Then it should be rejected", func() {
+				So(err, ShouldEqual, habit.ErrInvalidPauseDate)
+				So(h.IsActive(), ShouldBeTrue)
+			})
+		})
+
+		Convey("When a habit that is not paused is resumed", func() {
+			So(h.Resume(), ShouldEqual, habit.ErrNotPaused)
+		})
 	})
 }
@@ -93,16 +93,20 @@ func (v HabitVacation) IsActiveOn(date time.Time) bool {
 	return !dateOnly.After(endOnly)
 }
 
-// End ends the vacation on the given date
+// End ends the vacation on the given date. A vacation scheduled to end
+// later can be cut short; one already ending on or before endDate cannot.
 func (v *HabitVacation) End(endDate time.Time) error {
-	if v.endDate != nil {
-		return ErrVacationAlreadyEnded
-	}
-
 	// Normalize dates for comparison
 	endOnly := time.Date(endDate.Year(), endDate.Month(), endDate.Day(), 0, 0, 0, 0, endDate.Location())
 	startOnly := time.Date(v.startDate.Year(), v.startDate.Month(), v.startDate.Day(), 0, 0, 0, 0, v.startDate.Location())
 
+	if v.endDate != nil {
+		currentEnd := time.Date(v.endDate.Year(), v.endDate.Month(), v.endDate.Day(), 0, 0, 0, 0, v.endDate.Location())
+		if !currentEnd.After(endOnly) {
+			return ErrVacationAlreadyEnded
+		}
+	}
+
 	if endOnly.Before(startOnly) {
 		return ErrVacationInvalidDates
 	}
@@ -110,3 +114,18 @@ func (v *HabitVacation) End(endDate time.Time) error {
 	v.endDate = &endDate
 	return nil
 }
+
+// Overlaps returns true if the two vacations share at least one day
+func (v HabitVacation) Overlaps(other HabitVacation) bool {
+	return !v.endsBefore(other.startDate) && !other.endsBefore(v.startDate)
+}
+
+// endsBefore returns true if the vacation ends on a day before date
+func (v HabitVacation) endsBefore(date time.Time) bool {
+	if v.endDate == nil {
+		return false
+	}
+	endOnly := time.Date(v.endDate.Year(), v.endDate.Month(), v.endDate.Day(), 0, 0, 0, 0, v.endDate.Location())
+	dateOnly := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+	return endOnly.Before(dateOnly)
+}
@@ -0,0 +1,59 @@
+package habit_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/habits/domain/habit"
+)
+
+func TestHabitVacation(t *testing.T) {
+	t.Parallel()
+
+	day := func(d int) time.Time { return time.Date(2025, 7, d, 0, 0, 0, 0, time.UTC) }
+	vacation := func(start int, end *int) *habit.HabitVacation {
+		v, err := habit.NewHabitVacation("v", "h-1", day(start), nil)
+		So(err, ShouldBeNil)
+		if end != nil {
+			So(v.End(day(*end)), ShouldBeNil)
+		}
+		return v
+	}
+	ptr := func(d int) *int { return &d }
+
+	Convey("Given a vacation from the 10th to the 15th", t, func() {
+		v := vacation(10, ptr(15))
+
+		Convey("Then it overlaps vacations sharing a day", func() {
+			So(v.Overlaps(*vacation(15, ptr(20))), ShouldBeTrue)
+			So(v.Overlaps(*vacation(1, ptr(10))), ShouldBeTrue)
+			So(v.Overlaps(*vacation(12, nil)), ShouldBeTrue)
+			So(v.Overlaps(*vacation(1, nil)), ShouldBeTrue)
+		})
+
+		Convey("Then it does not overlap adjacent vacations", func() {
+			So(v.Overlaps(*vacation(16, nil)), ShouldBeFalse)
+			So(v.Overlaps(*vacation(1, ptr(9))), ShouldBeFalse)
+		})
+
+		Convey("When it is cut short", func() {
+			err := v.End(day(12))
+
+			Convey("Then it ends on the new date", func() {
+				So(err, ShouldBeNil)
+				So(v.IsActiveOn(day(12)), ShouldBeTrue)
+				So(v.IsActiveOn(day(13)), ShouldBeFalse)
+			})
+		})
+
+		Convey("When it is ended after its end date", func() {
+			So(v.End(day(20)), ShouldEqual, habit.ErrVacationAlreadyEnded)
+		})
+
+		Convey("When it is ended before it starts", func() {
+			So(v.End(day(5)), ShouldEqual, habit.ErrVacationInvalidDates)
+		})
+	})
+}
@@ -0,0 +1,26 @@
+package habit
+
+// ActiveLimit caps how many active and paused habits one user can have,
+// whatever their plan. It guards against clients creating habits in a loop.
+// Zero means unlimited.
+type ActiveLimit int
+
+// CheckAdd returns ErrTooManyActive when a user with active habits already
+// may not add another
+func (l ActiveLimit) CheckAdd(active int) error {
+	if l > 0 && active >= int(l) {
+		return ErrTooManyActive
+	}
+	return nil
+}
+
+// CountActive counts the habits that are active or paused
+func CountActive(habits []*Habit) int {
+	active := 0
+	for _, h := range habits {
+		if h.IsActive() || h.IsPaused() {
+			active++
+		}
+	}
+	return active
+}
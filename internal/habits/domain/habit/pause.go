@@ -0,0 +1,47 @@
+package habit
+
+import "time"
+
+// Pause deactivates the habit until the given date, when it should be
+// resumed. The date must be after today.
+func (h *Habit) Pause(until, now time.Time) error {
+	until = startOfDay(until)
+	if !until.After(startOfDay(now)) {
+		return ErrInvalidPauseDate
+	}
+	if !h.isActive && h.pausedUntil == nil {
+		return ErrAlreadyInactive
+	}
+
+	h.isActive = false
+	h.pausedUntil = &until
+	h.updatedAt = now
+	return nil
+}
+
+// IsPaused returns true if the habit is paused until a date
+func (h *Habit) IsPaused() bool {
+	return h.pausedUntil != nil
+}
+
+// PauseExpired returns true if the habit is paused and its pause has ended
+// on or before now
+func (h *Habit) PauseExpired(now time.Time) bool {
+	return h.pausedUntil != nil && !h.pausedUntil.After(startOfDay(now))
+}
+
+// Resume reactivates a paused habit
+func (h *Habit) Resume() error {
+	if h.pausedUntil == nil {
+		return ErrNotPaused
+	}
+
+	h.isActive = true
+	h.pausedUntil = nil
+	h.updatedAt = time.Now()
+	return nil
+}
+
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
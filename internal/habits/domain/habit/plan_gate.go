@@ -0,0 +1,16 @@
+package habit
+
+import "context"
+
+// PlanGate checks habit actions against the entitlements of the user's
+// billing plan. Its errors are already application errors and are returned
+// as they are.
+type PlanGate interface {
+	// CheckActiveHabits fails when the user's plan does not allow them to
+	// have active habits at once. Paused habits count as active.
+	CheckActiveHabits(ctx context.Context, userID string, active int) error
+
+	// CheckAdvancedAnalytics fails unless the user's plan includes
+	// insights, period comparisons and habit correlations
+	CheckAdvancedAnalytics(ctx context.Context, userID string) error
+}
@@ -2,6 +2,8 @@ package habit
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 )
 
@@ -45,6 +47,42 @@ func (r Recurrence) Validate() error {
 	return nil
 }
 
+// weekdayCodes are the short weekday names used by the API, indexed by bit position
+var weekdayCodes = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
+// NewRecurrenceFromDayNames creates a Recurrence from weekday names such as
+// "mon" or "Monday". No names means every day; an interval of 0 means 1.
+func NewRecurrenceFromDayNames(names []string, interval int) (Recurrence, error) {
+	days := AllDays
+	if len(names) > 0 {
+		days = 0
+		for _, name := range names {
+			day, err := parseWeekday(name)
+			if err != nil {
+				return Recurrence{}, err
+			}
+			days |= day
+		}
+	}
+	if interval == 0 {
+		interval = 1
+	}
+	return NewRecurrence(days, interval)
+}
+
+// parseWeekday accepts a full weekday name or any prefix of at least three letters
+func parseWeekday(name string) (int16, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if len(name) >= 3 {
+		for day := time.Sunday; day <= time.Saturday; day++ {
+			if strings.HasPrefix(strings.ToLower(day.String()), name) {
+				return 1 << day, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("invalid recurrence day %q", name)
+}
+
 // DefaultRecurrence returns a recurrence for every day
 func DefaultRecurrence() Recurrence {
 	return Recurrence{days: AllDays, interval: 1}
@@ -111,6 +149,18 @@ func (r Recurrence) DayNames() []string {
 	return days
 }
 
+// DayCodes returns the short names ("mon", "wed") of the days included in
+// this recurrence
+func (r Recurrence) DayCodes() []string {
+	days := []string{}
+	for i, code := range weekdayCodes {
+		if r.HasDay(1 << i) {
+			days = append(days, code)
+		}
+	}
+	return days
+}
+
 // IsEveryDay returns true if all days are selected
 func (r Recurrence) IsEveryDay() bool {
 	return r.days == AllDays
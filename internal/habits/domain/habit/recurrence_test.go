@@ -119,6 +119,45 @@ func TestRecurrenceHasDay(t *testing.T) {
 	})
 }
 
+func TestRecurrenceFromDayNames(t *testing.T) {
+	Convey("Given recurrence built from weekday names", t, func() {
+
+		Convey("When naming Mon, Wed and Fri", func() {
+			r, err := habit.NewRecurrenceFromDayNames([]string{"mon", "Wednesday", "FRI"}, 0)
+
+			Convey("Then days should be 42 with the default interval", func() {
+				So(err, ShouldBeNil)
+				So(r.Days(), ShouldEqual, 42)
+				So(r.Interval(), ShouldEqual, 1)
+			})
+
+			Convey("Then day codes should round-trip", func() {
+				So(r.DayCodes(), ShouldResemble, []string{"mon", "wed", "fri"})
+			})
+		})
+
+		Convey("When no days are named", func() {
+			r, err := habit.NewRecurrenceFromDayNames(nil, 3)
+
+			Convey("Then it should repeat every day at the interval", func() {
+				So(err, ShouldBeNil)
+				So(r.Days(), ShouldEqual, 127)
+				So(r.Interval(), ShouldEqual, 3)
+			})
+		})
+
+		Convey("When a name is ambiguous or unknown", func() {
+			for _, name := range []string{"mo", "xyz", ""} {
+				name := name
+				Convey("Then "+fmt.Sprintf("%q", name)+" should be rejected", func() {
+					_, err := habit.NewRecurrenceFromDayNames([]string{name}, 1)
+					So(err, ShouldNotBeNil)
+				})
+			}
+		})
+	})
+}
+
 func TestRecurrenceShouldCompleteOn(t *testing.T) {
 	Convey("Given habit completion scheduling", t, func() {
 
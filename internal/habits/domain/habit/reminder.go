@@ -0,0 +1,77 @@
+package habit
+
+import "time"
+
+// DefaultReminderTime is when a habit without its own reminder time is
+// reminded, in the user's timezone
+const DefaultReminderTime = "20:00"
+
+// ReminderSchedule is what scheduling a habit's next reminder needs
+type ReminderSchedule struct {
+	HabitID      string
+	ReminderTime *string
+	// Timezone is the user's current timezone
+	Timezone string
+	// Rescheduled is set when the habit has no next reminder yet, or it
+	// was computed for another reminder time or timezone, rather than
+	// having just been sent
+	Rescheduled bool
+}
+
+// NextReminder returns the first time at or after from when a habit
+// reminded at reminderTime (HH:MM, or DefaultReminderTime when nil) in loc
+// is due. It fires once every local day, including the days clocks change:
+// a time skipped when clocks spring forward fires the length of the gap
+// later, and a time repeated when they fall back fires the first time
+// round.
+func NextReminder(reminderTime *string, loc *time.Location, from time.Time) (time.Time, error) {
+	clock := DefaultReminderTime
+	if reminderTime != nil {
+		clock = *reminderTime
+	}
+	at, err := time.Parse("15:04", clock)
+	if err != nil {
+		return time.Time{}, ErrInvalidReminder
+	}
+
+	// Tomorrow's occurrence is always after from, so today's is the only
+	// one that may already have passed
+	year, month, day := from.In(loc).Date()
+	next := reminderOn(loc, year, month, day, at.Hour(), at.Minute())
+	if next.Before(from) {
+		next = reminderOn(loc, year, month, day+1, at.Hour(), at.Minute())
+	}
+	return next, nil
+}
+
+// reminderOn is when the wall clock in loc first reads hour:min on the
+// given day, or when it would have had clocks not sprung forward past it
+func reminderOn(loc *time.Location, year int, month time.Month, day, hour, minute int) time.Time {
+	wall := time.Date(year, month, day, hour, minute, 0, 0, time.UTC)
+
+	// A day has at most one transition, so the offsets a day either side
+	// are the ones in effect before and after it
+	_, before := wall.Add(-24 * time.Hour).In(loc).Zone()
+	_, after := wall.Add(24 * time.Hour).In(loc).Zone()
+
+	var first time.Time
+	for _, offset := range []int{before, after} {
+		at := wall.Add(-time.Duration(offset) * time.Second)
+		if readsAs(at.In(loc), wall) && (first.IsZero() || at.Before(first)) {
+			first = at
+		}
+	}
+	if first.IsZero() {
+		// Skipped: read the wall clock as if it had not moved yet
+		return wall.Add(-time.Duration(before) * time.Second)
+	}
+	return first
+}
+
+// readsAs reports whether local shows the same date and time as wall
+func readsAs(local, wall time.Time) bool {
+	y1, m1, d1 := local.Date()
+	y2, m2, d2 := wall.Date()
+	return y1 == y2 && m1 == m2 && d1 == d2 &&
+		local.Hour() == wall.Hour() && local.Minute() == wall.Minute()
+}
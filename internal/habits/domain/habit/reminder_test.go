@@ -0,0 +1,172 @@
+package habit_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/habits/domain/habit"
+)
+
+func TestNextReminder(t *testing.T) {
+	t.Parallel()
+
+	at := func(clock string) *string { return &clock }
+	load := func(name string) *time.Location {
+		loc, err := time.LoadLocation(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return loc
+	}
+	newYork := load("America/New_York")
+	berlin := load("Europe/Berlin")
+	lordHowe := load("Australia/Lord_Howe")
+
+	next := func(clock *string, loc *time.Location, from time.Time) time.Time {
+		t.Helper()
+		next, err := habit.NextReminder(clock, loc, from)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return next
+	}
+
+	Convey("Given a habit reminded at 08:00 in New York", t, func() {
+		Convey("When the reminder has not passed today, it is due today", func() {
+			from := time.Date(2026, 6, 1, 7, 0, 0, 0, newYork)
+			So(next(at("08:00"), newYork, from), ShouldEqual, time.Date(2026, 6, 1, 8, 0, 0, 0, newYork))
+		})
+
+		Convey("When it is exactly the reminder time, it is due now", func() {
+			from := time.Date(2026, 6, 1, 8, 0, 0, 0, newYork)
+			So(next(at("08:00"), newYork, from), ShouldEqual, from)
+		})
+
+		Convey("When the reminder has passed today, it is due tomorrow", func() {
+			from := time.Date(2026, 6, 1, 8, 1, 0, 0, newYork)
+			So(next(at("08:00"), newYork, from), ShouldEqual, time.Date(2026, 6, 2, 8, 0, 0, 0, newYork))
+		})
+	})
+
+	Convey("Given a habit without a reminder time", t, func() {
+		Convey("Then it is reminded at the default time", func() {
+			from := time.Date(2026, 6, 1, 12, 0, 0, 0, berlin)
+			So(next(nil, berlin, from).In(berlin).Format("15:04"), ShouldEqual, habit.DefaultReminderTime)
+		})
+	})
+
+	Convey("Given an invalid reminder time", t, func() {
+		_, err := habit.NextReminder(at("25:00"), time.UTC, time.Now())
+
+		Convey("Then it fails", func() {
+			So(err, ShouldEqual, habit.ErrInvalidReminder)
+		})
+	})
+
+	Convey("Given the day clocks spring forward in New York (02:00 becomes 03:00)", t, func() {
+		from := time.Date(2026, 3, 8, 0, 0, 0, 0, newYork)
+
+		Convey("When the reminder is at a skipped time, it fires the gap's length later", func() {
+			got := next(at("02:30"), newYork, from)
+			So(got.UTC(), ShouldEqual, time.Date(2026, 3, 8, 7, 30, 0, 0, time.UTC))
+			So(got.In(newYork).Format("15:04 MST"), ShouldEqual, "03:30 EDT")
+		})
+
+		Convey("When the reminder is after the gap, it fires at its local time", func() {
+			So(next(at("08:00"), newYork, from).UTC(), ShouldEqual, time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC))
+		})
+
+		Convey("When the reminder is before the gap, it fires at its local time", func() {
+			So(next(at("01:30"), newYork, from).UTC(), ShouldEqual, time.Date(2026, 3, 8, 6, 30, 0, 0, time.UTC))
+		})
+	})
+
+	Convey("Given the day clocks fall back in New York (02:00 becomes 01:00)", t, func() {
+		from := time.Date(2026, 11, 1, 0, 0, 0, 0, newYork)
+
+		Convey("When the reminder is at a repeated time, it fires the first time round", func() {
+			So(next(at("01:30"), newYork, from).UTC(), ShouldEqual, time.Date(2026, 11, 1, 5, 30, 0, 0, time.UTC))
+		})
+
+		Convey("When the first time round has passed, it is due tomorrow, not the second time round", func() {
+			first := time.Date(2026, 11, 1, 5, 30, 0, 0, time.UTC)
+			So(next(at("01:30"), newYork, first.Add(time.Minute)).UTC(), ShouldEqual, time.Date(2026, 11, 2, 6, 30, 0, 0, time.UTC))
+		})
+	})
+
+	Convey("Given the days clocks change in Berlin, east of UTC", t, func() {
+		Convey("When the reminder is skipped as clocks spring forward, it fires the gap's length later", func() {
+			from := time.Date(2026, 3, 29, 0, 0, 0, 0, berlin)
+			So(next(at("02:30"), berlin, from).UTC(), ShouldEqual, time.Date(2026, 3, 29, 1, 30, 0, 0, time.UTC))
+		})
+
+		Convey("When the reminder is repeated as clocks fall back, it fires the first time round", func() {
+			from := time.Date(2026, 10, 25, 0, 0, 0, 0, berlin)
+			So(next(at("02:30"), berlin, from).UTC(), ShouldEqual, time.Date(2026, 10, 25, 0, 30, 0, 0, time.UTC))
+		})
+	})
+
+	Convey("Given Lord Howe Island, whose clocks move by half an hour", t, func() {
+		Convey("When the reminder is skipped as clocks spring forward, it fires half an hour later", func() {
+			from := time.Date(2026, 10, 4, 0, 0, 0, 0, lordHowe)
+			So(next(at("02:15"), lordHowe, from).In(lordHowe).Format("15:04"), ShouldEqual, "02:45")
+		})
+
+		Convey("When the reminder is repeated as clocks fall back, it fires the first time round", func() {
+			from := time.Date(2026, 4, 5, 0, 0, 0, 0, lordHowe)
+			So(next(at("01:45"), lordHowe, from).UTC(), ShouldEqual, time.Date(2026, 4, 4, 14, 45, 0, 0, time.UTC))
+		})
+	})
+}
+
+// TestNextReminderSweep runs the minutely reminder sweep across the days
+// clocks change and checks every reminder fires exactly once a local day
+func TestNextReminderSweep(t *testing.T) {
+	t.Parallel()
+
+	zones := []string{"America/New_York", "Europe/Berlin", "Australia/Lord_Howe", "Asia/Jakarta"}
+	clocks := []string{"00:00", "00:30", "01:00", "01:30", "02:00", "02:15", "02:30", "02:45", "03:00", "08:00", "20:00", "23:59"}
+	days := []time.Time{
+		time.Date(2026, 3, 7, 0, 0, 0, 0, time.UTC),   // New York springs forward on the 8th
+		time.Date(2026, 3, 28, 0, 0, 0, 0, time.UTC),  // Berlin springs forward on the 29th
+		time.Date(2026, 4, 4, 0, 0, 0, 0, time.UTC),   // Lord Howe falls back on the 5th
+		time.Date(2026, 10, 3, 0, 0, 0, 0, time.UTC),  // Lord Howe springs forward on the 4th
+		time.Date(2026, 10, 24, 0, 0, 0, 0, time.UTC), // Berlin falls back on the 25th
+		time.Date(2026, 10, 31, 0, 0, 0, 0, time.UTC), // New York falls back on November 1st
+	}
+
+	Convey("Given reminders swept every minute over three days around each clock change", t, func() {
+		for _, zone := range zones {
+			loc, err := time.LoadLocation(zone)
+			So(err, ShouldBeNil)
+
+			for _, clock := range clocks {
+				for _, start := range days {
+					end := start.Add(72 * time.Hour)
+					fired := make(map[string]int)
+
+					next, err := habit.NextReminder(&clock, loc, start)
+					So(err, ShouldBeNil)
+					for now := start; now.Before(end); now = now.Add(time.Minute) {
+						if next.After(now) {
+							continue
+						}
+						fired[now.In(loc).Format(time.DateOnly)]++
+						next, err = habit.NextReminder(&clock, loc, now.Add(time.Minute))
+						So(err, ShouldBeNil)
+					}
+
+					// The local days fully inside the window
+					for day := start.In(loc).AddDate(0, 0, 1); day.Add(24 * time.Hour).Before(end); day = day.AddDate(0, 0, 1) {
+						So(fired[day.Format(time.DateOnly)], ShouldEqual, 1)
+					}
+					for _, n := range fired {
+						So(n, ShouldEqual, 1)
+					}
+				}
+			}
+		}
+	})
+}
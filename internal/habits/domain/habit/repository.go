@@ -8,11 +8,26 @@ import (
 // HabitReader provides read-only access to habit data.
 // Use this interface when you only need to query habits.
 type HabitReader interface {
-	// GetHabit retrieves a habit by ID for a specific user.
+	// GetHabit retrieves a habit by ID for a specific user. Another user's
+	// habit is ErrNotFound, like a missing one.
 	GetHabit(ctx context.Context, habitID, userID string) (*Habit, error)
 
 	// ListHabitsByUser returns all habits for a user.
 	ListHabitsByUser(ctx context.Context, userID string) ([]*Habit, error)
+
+	// CountActiveHabitsForUpdate counts the user's active and paused habits.
+	// Within a transaction it also locks the user until the transaction
+	// ends, so concurrent habit creations are counted one after another.
+	CountActiveHabitsForUpdate(ctx context.Context, userID string) (int, error)
+
+	// LockHabitForUpdate locks the user's habit until the transaction ends,
+	// so concurrent changes to its logs run one after another. Another
+	// user's habit is ErrNotFound, like a missing one.
+	LockHabitForUpdate(ctx context.Context, habitID, userID string) error
+
+	// ListPausedHabitsDue returns paused habits whose pause has ended by now
+	// in their user's timezone.
+	ListPausedHabitsDue(ctx context.Context, now time.Time) ([]*Habit, error)
 }
 
 // HabitWriter provides write operations for habit data.
@@ -30,6 +45,11 @@ type HabitWriter interface {
 
 	// DeleteHabit removes a habit.
 	DeleteHabit(ctx context.Context, habitID, userID string) error
+
+	// ReorderHabits moves the given habits, in order, to the top of the
+	// user's list; habits not listed keep their relative order after them.
+	// Returns ErrNotFound if any habit does not belong to the user.
+	ReorderHabits(ctx context.Context, userID string, habitIDs []string) error
 }
 
 // StatsRepository provides operations for habit statistics.
@@ -191,3 +191,89 @@ func (s *StreakService) CalculateConsistency(
 
 	return float64(completedDays) / float64(expectedDays) * 100.0
 }
+
+// StreakStart returns the first day of the unbroken run of completed
+// scheduled days leading up to today, or nil when there is none. Today may
+// still be open without breaking the run. Vacation days are skipped.
+func (s *StreakService) StreakStart(
+	habit *Habit,
+	completionDates map[string]bool,
+	vacations []*HabitVacation,
+	today time.Time,
+) *time.Time {
+	isVacationDate := func(date time.Time) bool {
+		for _, v := range vacations {
+			if v.IsActiveOn(date) {
+				return true
+			}
+		}
+		return false
+	}
+
+	created := habit.CreatedAt()
+	created = time.Date(created.Year(), created.Month(), created.Day(), 0, 0, 0, 0, today.Location())
+
+	var start *time.Time
+	for checkDate := today; !checkDate.Before(created); checkDate = checkDate.AddDate(0, 0, -1) {
+		if isVacationDate(checkDate) {
+			continue
+		}
+		if !habit.Recurrence().ShouldCompleteOn(checkDate, habit.Frequency(), habit.CreatedAt()) {
+			continue
+		}
+		if !completionDates[checkDate.Format("2006-01-02")] {
+			if checkDate.Equal(today) {
+				continue
+			}
+			break
+		}
+		day := checkDate
+		start = &day
+	}
+	return start
+}
+
+// MissedPeriodsHorizon bounds how many days back MissedPeriods looks
+const MissedPeriodsHorizon = 90
+
+// MissedPeriods counts the scheduled days before today that were missed in
+// a row, most recent first. Vacation days are skipped, and today does not
+// count as missed while it can still be completed.
+func (s *StreakService) MissedPeriods(
+	habit *Habit,
+	completionDates map[string]bool,
+	vacations []*HabitVacation,
+	today time.Time,
+) int {
+	isVacationDate := func(date time.Time) bool {
+		for _, v := range vacations {
+			if v.IsActiveOn(date) {
+				return true
+			}
+		}
+		return false
+	}
+
+	created := habit.CreatedAt()
+	created = time.Date(created.Year(), created.Month(), created.Day(), 0, 0, 0, 0, today.Location())
+
+	missed := 0
+	for i := 1; i <= MissedPeriodsHorizon; i++ {
+		checkDate := today.AddDate(0, 0, -i)
+		if checkDate.Before(created) {
+			break
+		}
+		if isVacationDate(checkDate) {
+			continue
+		}
+		if !habit.Recurrence().ShouldCompleteOn(checkDate, habit.Frequency(), habit.CreatedAt()) {
+			continue
+		}
+		if completionDates[checkDate.Format("2006-01-02")] {
+			break
+		}
+		missed++
+	}
+
+	return missed
+}
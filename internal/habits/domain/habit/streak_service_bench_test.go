@@ -0,0 +1,50 @@
+package habit_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/semmidev/ethos-go/internal/habits/domain/habit"
+	"github.com/semmidev/ethos-go/internal/habits/domain/habitlog"
+)
+
+// BenchmarkCalculateStreak measures the streak recalculation LogHabit runs on
+// every log, for habits with a growing history.
+func BenchmarkCalculateStreak(b *testing.B) {
+	today := time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)
+
+	for _, n := range []int{100, 1000, 10000} {
+		b.Run(fmt.Sprintf("logs=%d", n), func(b *testing.B) {
+			createdAt := today.AddDate(0, 0, -n)
+			h, err := habit.UnmarshalHabitFromDatabase(
+				"habit-bench", "user-bench", "Bench", nil,
+				habit.FrequencyDaily, habit.AllDays, 1, 1, nil, true, nil,
+				createdAt, createdAt, 1,
+			)
+			if err != nil {
+				b.Fatal(err)
+			}
+
+			logs := make([]*habitlog.HabitLog, 0, n)
+			for i := 0; i < n; i++ {
+				l, err := habitlog.NewHabitLog(
+					fmt.Sprintf("log-%d", i), h.HabitID(), h.UserID(),
+					today.AddDate(0, 0, -i), 1, nil,
+				)
+				if err != nil {
+					b.Fatal(err)
+				}
+				logs = append(logs, l)
+			}
+
+			svc := habit.NewStreakService()
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				svc.CalculateStreak(h, logs, nil, today)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package habit_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/habits/domain/habit"
+)
+
+func TestMissedPeriods(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given a daily habit created ten days ago", t, func() {
+		svc := habit.NewStreakService()
+		today := time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)
+		createdAt := today.AddDate(0, 0, -10)
+		h, err := habit.UnmarshalHabitFromDatabase(
+			"habit-1", "user-1", "Read", nil,
+			habit.FrequencyDaily, habit.AllDays, 1, 1, nil, true, nil,
+			createdAt, createdAt, 1,
+		)
+		So(err, ShouldBeNil)
+
+		done := func(daysAgo ...int) map[string]bool {
+			dates := make(map[string]bool)
+			for _, n := range daysAgo {
+				dates[today.AddDate(0, 0, -n).Format("2006-01-02")] = true
+			}
+			return dates
+		}
+
+		Convey("When the last completion was four days ago", func() {
+			missed := svc.MissedPeriods(h, done(4, 5), nil, today)
+
+			Convey("Then the three days since are missed, not counting today", func() {
+				So(missed, ShouldEqual, 3)
+			})
+		})
+
+		Convey("When it was completed yesterday", func() {
+			missed := svc.MissedPeriods(h, done(1), nil, today)
+
+			Convey("Then nothing is missed", func() {
+				So(missed, ShouldEqual, 0)
+			})
+		})
+
+		Convey("When it was never completed", func() {
+			missed := svc.MissedPeriods(h, done(), nil, today)
+
+			Convey("Then every day since creation is missed", func() {
+				So(missed, ShouldEqual, 10)
+			})
+		})
+
+		Convey("When two of the missed days were a vacation", func() {
+			vacation, err := habit.NewHabitVacation("vacation-1", h.HabitID(), today.AddDate(0, 0, -3), nil)
+			So(err, ShouldBeNil)
+			So(vacation.End(today.AddDate(0, 0, -2)), ShouldBeNil)
+
+			missed := svc.MissedPeriods(h, done(4), []*habit.HabitVacation{vacation}, today)
+
+			Convey("Then they do not count", func() {
+				So(missed, ShouldEqual, 1)
+			})
+		})
+	})
+}
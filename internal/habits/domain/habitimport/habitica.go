@@ -0,0 +1,151 @@
+package habitimport
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/semmidev/ethos-go/internal/habits/domain/habit"
+)
+
+// habiticaExport is the part of Habitica's user data export (JSON) that
+// holds habits and dailies. To-dos and rewards are not habits and are ignored.
+type habiticaExport struct {
+	Tasks struct {
+		Habits []habiticaTask `json:"habits"`
+		Dailys []habiticaTask `json:"dailys"`
+	} `json:"tasks"`
+}
+
+type habiticaTask struct {
+	Text      string            `json:"text"`
+	Notes     string            `json:"notes"`
+	Up        bool              `json:"up"`
+	Frequency string            `json:"frequency"` // Dailies: daily, weekly, monthly or yearly
+	EveryX    int               `json:"everyX"`
+	Repeat    map[string]bool   `json:"repeat"` // Dailies: weekday keys su, m, t, w, th, f, s
+	History   []habiticaHistory `json:"history"`
+}
+
+type habiticaHistory struct {
+	Date      habiticaTime `json:"date"`
+	Completed *bool        `json:"completed"` // Dailies; missing in old entries
+	ScoredUp  int          `json:"scoredUp"`  // Habits
+}
+
+// habiticaTime is a Unix time in milliseconds, or an RFC 3339 string in
+// older exports
+type habiticaTime struct{ time.Time }
+
+func (t *habiticaTime) UnmarshalJSON(data []byte) error {
+	raw := strings.Trim(string(data), `"`)
+	if ms, err := strconv.ParseFloat(raw, 64); err == nil {
+		t.Time = time.UnixMilli(int64(ms)).UTC()
+		return nil
+	}
+	parsed, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return fmt.Errorf("invalid history date %s", data)
+	}
+	t.Time = parsed
+	return nil
+}
+
+// habiticaWeekdays maps Habitica's repeat keys to Ethos weekday names
+var habiticaWeekdays = []struct{ key, day string }{
+	{"su", "sun"}, {"m", "mon"}, {"t", "tue"}, {"w", "wed"}, {"th", "thu"}, {"f", "fri"}, {"s", "sat"},
+}
+
+func parseHabitica(data []byte) (Parsed, error) {
+	var export habiticaExport
+	if err := json.Unmarshal(data, &export); err != nil {
+		return Parsed{}, err
+	}
+
+	var parsed Parsed
+	downOnly, undated := 0, 0
+
+	// Habits are counters: every "+" click is a completion. Negative-only
+	// habits track things to avoid, which Ethos has no notion of.
+	for _, task := range export.Tasks.Habits {
+		if !task.Up {
+			downOnly++
+			continue
+		}
+		h := Habit{Name: task.Text, Description: optional(task.Notes), Frequency: habit.FrequencyDaily}
+		for _, entry := range task.History {
+			if entry.ScoredUp > 0 {
+				h.Completions = append(h.Completions, Completion{Date: entry.Date.Time, Count: entry.ScoredUp})
+			}
+		}
+		parsed.Habits = append(parsed.Habits, h)
+	}
+
+	for _, task := range export.Tasks.Dailys {
+		h := habiticaDaily(task, &parsed)
+		for _, entry := range task.History {
+			if entry.Completed == nil {
+				undated++
+				continue
+			}
+			if *entry.Completed {
+				h.Completions = append(h.Completions, Completion{Date: entry.Date.Time, Count: 1})
+			}
+		}
+		parsed.Habits = append(parsed.Habits, h)
+	}
+
+	if downOnly > 0 {
+		parsed.Warnings = append(parsed.Warnings, fmt.Sprintf("%d negative-only habits were skipped", downOnly))
+	}
+	if undated > 0 {
+		parsed.Warnings = append(parsed.Warnings, fmt.Sprintf("%d daily history entries without a completion flag were skipped", undated))
+	}
+	return parsed, nil
+}
+
+// habiticaDaily maps a daily's schedule onto an Ethos frequency and recurrence
+func habiticaDaily(task habiticaTask, parsed *Parsed) Habit {
+	h := Habit{
+		Name:        task.Text,
+		Description: optional(task.Notes),
+		Frequency:   habit.FrequencyDaily,
+		Interval:    task.EveryX,
+	}
+
+	switch task.Frequency {
+	case "weekly":
+		for _, wd := range habiticaWeekdays {
+			if task.Repeat[wd.key] {
+				h.Days = append(h.Days, wd.day)
+			}
+		}
+		if len(h.Days) == len(habiticaWeekdays) {
+			h.Days = nil
+		}
+		// Every N weeks on the chosen days
+		if task.EveryX > 1 {
+			h.Frequency = habit.FrequencyWeekly
+		} else {
+			h.Interval = 1
+		}
+	case "monthly":
+		h.Frequency = habit.FrequencyMonthly
+	case "yearly":
+		h.Frequency = habit.FrequencyMonthly
+		h.Interval = 12 * max(task.EveryX, 1)
+		parsed.Warnings = append(parsed.Warnings, fmt.Sprintf("yearly daily %q was imported as every %d months", task.Text, h.Interval))
+	}
+
+	return h
+}
+
+// optional returns nil for an empty string
+func optional(s string) *string {
+	if strings.TrimSpace(s) == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,172 @@
+package habitimport
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrUnknownSource    = errors.New("unknown import source")
+	ErrFileTooLarge     = errors.New("import file is too large")
+	ErrNoHabits         = errors.New("import file contains no habits")
+	ErrTooManyHabits    = errors.New("import file contains too many habits")
+	ErrInvalidFile      = errors.New("import file could not be read")
+	ErrAlreadyCompleted = errors.New("import has already completed")
+	ErrEmptyImportID    = errors.New("empty import id")
+	ErrEmptyUserID      = errors.New("empty user id")
+	ErrNotFound         = errors.New("import not found")
+)
+
+// Source is the app an import file was exported from
+type Source string
+
+const (
+	SourceHabitica Source = "habitica"
+	SourceLoop     Source = "loop"
+	SourceStreaks  Source = "streaks"
+)
+
+// NewSource validates a source name
+func NewSource(value string) (Source, error) {
+	switch s := Source(value); s {
+	case SourceHabitica, SourceLoop, SourceStreaks:
+		return s, nil
+	}
+	return "", ErrUnknownSource
+}
+
+// Status is where an import is in its lifecycle
+type Status string
+
+const (
+	StatusPending   Status = "pending"
+	StatusRunning   Status = "running"
+	StatusCompleted Status = "completed"
+	StatusFailed    Status = "failed"
+)
+
+// Import is a parsed export file queued to be written as habits and logs.
+// Habits are written one at a time and each carries its own ID, so a
+// retried run skips the habits an earlier attempt already wrote.
+type Import struct {
+	importID       string
+	userID         string
+	source         Source
+	status         Status
+	habits         []Habit
+	importedHabits int
+	importedLogs   int
+	failure        *string
+	createdAt      time.Time
+	updatedAt      time.Time
+	completedAt    *time.Time
+}
+
+// NewImport creates a pending import of the parsed habits
+func NewImport(importID, userID string, source Source, habits []Habit) (*Import, error) {
+	if importID == "" {
+		return nil, ErrEmptyImportID
+	}
+	if userID == "" {
+		return nil, ErrEmptyUserID
+	}
+	if len(habits) == 0 {
+		return nil, ErrNoHabits
+	}
+
+	now := time.Now()
+	return &Import{
+		importID:  importID,
+		userID:    userID,
+		source:    source,
+		status:    StatusPending,
+		habits:    habits,
+		createdAt: now,
+		updatedAt: now,
+	}, nil
+}
+
+// UnmarshalImportFromDatabase reconstructs an Import from database
+func UnmarshalImportFromDatabase(
+	importID, userID string,
+	source Source,
+	status Status,
+	habits []Habit,
+	importedHabits, importedLogs int,
+	failure *string,
+	createdAt, updatedAt time.Time,
+	completedAt *time.Time,
+) *Import {
+	return &Import{
+		importID:       importID,
+		userID:         userID,
+		source:         source,
+		status:         status,
+		habits:         habits,
+		importedHabits: importedHabits,
+		importedLogs:   importedLogs,
+		failure:        failure,
+		createdAt:      createdAt,
+		updatedAt:      updatedAt,
+		completedAt:    completedAt,
+	}
+}
+
+func (i *Import) ImportID() string        { return i.importID }
+func (i *Import) UserID() string          { return i.userID }
+func (i *Import) Source() Source          { return i.source }
+func (i *Import) Status() Status          { return i.status }
+func (i *Import) Habits() []Habit         { return i.habits }
+func (i *Import) ImportedHabits() int     { return i.importedHabits }
+func (i *Import) ImportedLogs() int       { return i.importedLogs }
+func (i *Import) Failure() *string        { return i.failure }
+func (i *Import) CreatedAt() time.Time    { return i.createdAt }
+func (i *Import) UpdatedAt() time.Time    { return i.updatedAt }
+func (i *Import) CompletedAt() *time.Time { return i.completedAt }
+
+// TotalHabits is the number of habits the import writes
+func (i *Import) TotalHabits() int { return len(i.habits) }
+
+// TotalLogs is the number of logs the import writes
+func (i *Import) TotalLogs() int {
+	total := 0
+	for _, h := range i.habits {
+		total += len(h.Completions)
+	}
+	return total
+}
+
+// Start marks the import as running. A failed import may be started again
+// to resume where it stopped.
+func (i *Import) Start() error {
+	if i.status == StatusCompleted {
+		return ErrAlreadyCompleted
+	}
+
+	i.status = StatusRunning
+	i.failure = nil
+	i.updatedAt = time.Now()
+	return nil
+}
+
+// HabitImported records that one more habit and its logs were written
+func (i *Import) HabitImported(logs int) {
+	i.importedHabits++
+	i.importedLogs += logs
+	i.updatedAt = time.Now()
+}
+
+// Complete marks the import as finished
+func (i *Import) Complete() {
+	now := time.Now()
+	i.status = StatusCompleted
+	i.updatedAt = now
+	i.completedAt = &now
+}
+
+// Fail marks the import as stopped by an error
+func (i *Import) Fail(reason string) {
+	i.status = StatusFailed
+	i.failure = &reason
+	i.updatedAt = time.Now()
+}
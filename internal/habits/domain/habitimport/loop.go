@@ -0,0 +1,68 @@
+package habitimport
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// loopChecked marks a day the user checked off in Loop. Days implied by the
+// habit's schedule (1), unchecked (0), unknown (-1) and skipped (3) are not
+// completions.
+const loopChecked = "2"
+
+// parseLoop reads Checkmarks.csv from Loop Habit Tracker's CSV export: a
+// Date column followed by one column per habit.
+func parseLoop(data []byte) (Parsed, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	header, err := r.Read()
+	if err != nil {
+		return Parsed{}, fmt.Errorf("reading header: %w", err)
+	}
+	if len(header) < 2 || !strings.EqualFold(headerName(header[0]), "date") {
+		return Parsed{}, errors.New("expected Loop's Checkmarks.csv with a Date column first")
+	}
+
+	// Loop ends every line with a comma, leaving an unnamed last column
+	habits := make([]Habit, len(header)-1)
+	for i, name := range header[1:] {
+		habits[i] = Habit{Name: name}
+	}
+
+	for line := 2; ; line++ {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return Parsed{}, err
+		}
+		if len(record) == 0 || strings.TrimSpace(record[0]) == "" {
+			continue
+		}
+
+		date, err := parseDate(record[0])
+		if err != nil {
+			return Parsed{}, fmt.Errorf("line %d: %w", line, err)
+		}
+		for i, value := range record[1:] {
+			if i < len(habits) && strings.TrimSpace(value) == loopChecked {
+				habits[i].Completions = append(habits[i].Completions, Completion{Date: date, Count: 1})
+			}
+		}
+	}
+
+	var parsed Parsed
+	for _, h := range habits {
+		if strings.TrimSpace(h.Name) != "" {
+			parsed.Habits = append(parsed.Habits, h)
+		}
+	}
+	return parsed, nil
+}
@@ -0,0 +1,178 @@
+package habitimport
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/semmidev/ethos-go/internal/habits/domain/habit"
+)
+
+const (
+	// MaxFileSize bounds an export file so it fits in a single request
+	MaxFileSize = 2 << 20
+	// MaxHabits bounds how many habits one import may create
+	MaxHabits = 200
+
+	maxNameLength = 100
+)
+
+// Habit is a habit read from an export file, mapped to Ethos terms
+type Habit struct {
+	HabitID     string       `json:"habit_id"`
+	Name        string       `json:"name"`
+	Description *string      `json:"description,omitempty"`
+	Frequency   string       `json:"frequency"`
+	Days        []string     `json:"days,omitempty"` // Short weekday names; empty = every day
+	Interval    int          `json:"interval"`
+	TargetCount int          `json:"target_count"`
+	Completions []Completion `json:"completions"` // One per day, oldest first
+}
+
+// Completion is the number of times a habit was done on a day
+type Completion struct {
+	Date  time.Time `json:"date"`
+	Count int       `json:"count"`
+}
+
+// FirstDate returns the date of the earliest completion, if any
+func (h Habit) FirstDate() *time.Time {
+	if len(h.Completions) == 0 {
+		return nil
+	}
+	return &h.Completions[0].Date
+}
+
+// LastDate returns the date of the latest completion, if any
+func (h Habit) LastDate() *time.Time {
+	if len(h.Completions) == 0 {
+		return nil
+	}
+	return &h.Completions[len(h.Completions)-1].Date
+}
+
+// Parsed is the content of an export file
+type Parsed struct {
+	Habits   []Habit
+	Warnings []string // Data that was skipped or approximated
+}
+
+// Parse reads an export file from source. Completions after now's date are
+// dropped, and completions on the same day are merged.
+func Parse(source Source, data []byte, now time.Time) (Parsed, error) {
+	if len(data) > MaxFileSize {
+		return Parsed{}, ErrFileTooLarge
+	}
+
+	var (
+		parsed Parsed
+		err    error
+	)
+	switch source {
+	case SourceHabitica:
+		parsed, err = parseHabitica(data)
+	case SourceLoop:
+		parsed, err = parseLoop(data)
+	case SourceStreaks:
+		parsed, err = parseStreaks(data)
+	default:
+		return Parsed{}, ErrUnknownSource
+	}
+	if err != nil {
+		return Parsed{}, fmt.Errorf("%w: %v", ErrInvalidFile, err)
+	}
+
+	parsed = normalize(parsed, now)
+	if len(parsed.Habits) == 0 {
+		return Parsed{}, ErrNoHabits
+	}
+	if len(parsed.Habits) > MaxHabits {
+		return Parsed{}, ErrTooManyHabits
+	}
+	return parsed, nil
+}
+
+// normalize cleans up names, fills in defaults and sorts completions
+func normalize(parsed Parsed, now time.Time) Parsed {
+	today := dateOf(now)
+	habits := make([]Habit, 0, len(parsed.Habits))
+	unnamed, future := 0, 0
+
+	for _, h := range parsed.Habits {
+		h.Name = strings.TrimSpace(h.Name)
+		if h.Name == "" {
+			unnamed++
+			continue
+		}
+		if utf8.RuneCountInString(h.Name) > maxNameLength {
+			h.Name = string([]rune(h.Name)[:maxNameLength])
+		}
+		if h.Description != nil && strings.TrimSpace(*h.Description) == "" {
+			h.Description = nil
+		}
+		if h.Frequency == "" {
+			h.Frequency = habit.FrequencyDaily
+		}
+		if h.Interval < 1 {
+			h.Interval = 1
+		}
+		if h.TargetCount < 1 {
+			h.TargetCount = 1
+		}
+
+		counts := make(map[time.Time]int)
+		for _, c := range h.Completions {
+			date := dateOf(c.Date)
+			if date.After(today) {
+				future++
+				continue
+			}
+			if c.Count > 0 {
+				counts[date] += c.Count
+			}
+		}
+		h.Completions = make([]Completion, 0, len(counts))
+		for date, count := range counts {
+			h.Completions = append(h.Completions, Completion{Date: date, Count: count})
+		}
+		sort.Slice(h.Completions, func(i, j int) bool {
+			return h.Completions[i].Date.Before(h.Completions[j].Date)
+		})
+
+		habits = append(habits, h)
+	}
+
+	if unnamed > 0 {
+		parsed.Warnings = append(parsed.Warnings, fmt.Sprintf("%d habits without a name were skipped", unnamed))
+	}
+	if future > 0 {
+		parsed.Warnings = append(parsed.Warnings, fmt.Sprintf("%d completions dated in the future were skipped", future))
+	}
+
+	parsed.Habits = habits
+	return parsed
+}
+
+// dateOf truncates t to its calendar day in UTC, the way log dates are stored
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// parseDate accepts the date formats found in CSV exports
+func parseDate(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	for _, layout := range []string{"2006-01-02", "20060102", time.RFC3339, "2006-01-02 15:04:05"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date %q", value)
+}
+
+// headerName trims a CSV header cell, including the byte order mark some
+// apps write at the start of the file
+func headerName(cell string) string {
+	return strings.TrimSpace(strings.TrimPrefix(cell, "\ufeff"))
+}
@@ -0,0 +1,136 @@
+package habitimport_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/habits/domain/habitimport"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	day := func(d int) time.Time { return time.Date(2025, 3, d, 0, 0, 0, 0, time.UTC) }
+
+	Convey("Given a Habitica user data export", t, func() {
+		data := []byte(`{
+			"tasks": {
+				"habits": [
+					{"text": "Drink water", "up": true, "history": [
+						{"date": 1741334400000, "scoredUp": 2, "scoredDown": 0},
+						{"date": 1741420800000, "scoredUp": 0, "scoredDown": 1}
+					]},
+					{"text": "Smoke", "up": false, "down": true, "history": []}
+				],
+				"dailys": [
+					{"text": "Gym", "notes": "Leg day", "frequency": "weekly", "everyX": 1,
+					 "repeat": {"su": false, "m": true, "t": false, "w": true, "th": false, "f": true, "s": false},
+					 "history": [
+						{"date": 1741564800000, "completed": true},
+						{"date": 1741478400000, "completed": false},
+						{"date": 1741392000000, "value": 1.5}
+					 ]}
+				],
+				"todos": [{"text": "Buy milk"}]
+			}
+		}`)
+
+		parsed, err := habitimport.Parse(habitimport.SourceHabitica, data, now)
+
+		Convey("Then positive habits and dailies are imported with their completions", func() {
+			So(err, ShouldBeNil)
+			So(parsed.Habits, ShouldHaveLength, 2)
+
+			water := parsed.Habits[0]
+			So(water.Name, ShouldEqual, "Drink water")
+			So(water.Completions, ShouldResemble, []habitimport.Completion{{Date: day(7), Count: 2}})
+
+			gym := parsed.Habits[1]
+			So(gym.Name, ShouldEqual, "Gym")
+			So(*gym.Description, ShouldEqual, "Leg day")
+			So(gym.Frequency, ShouldEqual, "daily")
+			So(gym.Days, ShouldResemble, []string{"mon", "wed", "fri"})
+			So(gym.Completions, ShouldResemble, []habitimport.Completion{{Date: day(10), Count: 1}})
+		})
+
+		Convey("Then skipped data is reported", func() {
+			So(parsed.Warnings, ShouldContain, "1 negative-only habits were skipped")
+			So(parsed.Warnings, ShouldContain, "1 daily history entries without a completion flag were skipped")
+		})
+	})
+
+	Convey("Given Loop's Checkmarks.csv", t, func() {
+		data := []byte("Date,Meditate,Run,\n" +
+			"2025-03-11,2,2,\n" +
+			"2025-03-10,2,0,\n" +
+			"2025-03-09,1,2,\n" +
+			"2025-03-08,2,-1,\n")
+
+		parsed, err := habitimport.Parse(habitimport.SourceLoop, data, now)
+
+		Convey("Then only manual checks up to today become completions, oldest first", func() {
+			So(err, ShouldBeNil)
+			So(parsed.Habits, ShouldHaveLength, 2)
+			So(parsed.Habits[0].Name, ShouldEqual, "Meditate")
+			So(parsed.Habits[0].Completions, ShouldResemble, []habitimport.Completion{
+				{Date: day(8), Count: 1},
+				{Date: day(10), Count: 1},
+			})
+			So(parsed.Habits[1].Completions, ShouldResemble, []habitimport.Completion{
+				{Date: day(9), Count: 1},
+			})
+			So(parsed.Warnings, ShouldContain, "2 completions dated in the future were skipped")
+		})
+	})
+
+	Convey("Given a Streaks CSV export", t, func() {
+		data := []byte("task_id,title,entry_type,entry_date,quantity\n" +
+			"1,Read,completed_manually,20250308,\n" +
+			"1,Read,completed_manually,20250308,\n" +
+			"1,Read,missed_auto,20250309,\n" +
+			"2,Push-ups,completed_manually,20250309,30\n" +
+			"3,Floss,skipped_manually,20250309,\n")
+
+		parsed, err := habitimport.Parse(habitimport.SourceStreaks, data, now)
+
+		Convey("Then completed entries are merged per day and other entries skipped", func() {
+			So(err, ShouldBeNil)
+			So(parsed.Habits, ShouldHaveLength, 3)
+			So(parsed.Habits[0].Completions, ShouldResemble, []habitimport.Completion{{Date: day(8), Count: 2}})
+			So(parsed.Habits[1].Completions, ShouldResemble, []habitimport.Completion{{Date: day(9), Count: 30}})
+			So(parsed.Habits[2].Name, ShouldEqual, "Floss")
+			So(parsed.Habits[2].Completions, ShouldBeEmpty)
+		})
+	})
+
+	Convey("Given files that cannot be imported", t, func() {
+		Convey("Then an unknown source is rejected", func() {
+			_, err := habitimport.Parse("fitbit", []byte("{}"), now)
+			So(err, ShouldEqual, habitimport.ErrUnknownSource)
+		})
+
+		Convey("Then a malformed file is rejected", func() {
+			_, err := habitimport.Parse(habitimport.SourceHabitica, []byte("not json"), now)
+			So(err, ShouldWrap, habitimport.ErrInvalidFile)
+		})
+
+		Convey("Then a CSV that is not Loop's is rejected", func() {
+			_, err := habitimport.Parse(habitimport.SourceLoop, []byte("Name,Value\nA,1\n"), now)
+			So(err, ShouldWrap, habitimport.ErrInvalidFile)
+		})
+
+		Convey("Then a file without habits is rejected", func() {
+			_, err := habitimport.Parse(habitimport.SourceHabitica, []byte(`{"tasks": {}}`), now)
+			So(err, ShouldEqual, habitimport.ErrNoHabits)
+		})
+
+		Convey("Then an oversized file is rejected", func() {
+			data := make([]byte, habitimport.MaxFileSize+1)
+			_, err := habitimport.Parse(habitimport.SourceLoop, data, now)
+			So(err, ShouldEqual, habitimport.ErrFileTooLarge)
+		})
+	})
+}
@@ -0,0 +1,19 @@
+package habitimport
+
+import "context"
+
+// Repository defines the interface for import persistence
+type Repository interface {
+	// AddImport stores a new import
+	AddImport(ctx context.Context, imp *Import) error
+
+	// GetImport retrieves an import with authorization
+	GetImport(ctx context.Context, importID, userID string) (*Import, error)
+
+	// UpdateImport uses the updateFn pattern for transactional updates
+	UpdateImport(
+		ctx context.Context,
+		importID, userID string,
+		updateFn func(ctx context.Context, imp *Import) (*Import, error),
+	) error
+}
@@ -0,0 +1,101 @@
+package habitimport
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// parseStreaks reads the CSV export of the Streaks app: one row per entry,
+// with the task title, the entry date and, optionally, the entry type and
+// quantity. Only completed entries are imported.
+func parseStreaks(data []byte) (Parsed, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	header, err := r.Read()
+	if err != nil {
+		return Parsed{}, fmt.Errorf("reading header: %w", err)
+	}
+
+	columns := make(map[string]int)
+	for i, name := range header {
+		name = strings.ToLower(headerName(name))
+		if _, seen := columns[name]; !seen {
+			columns[name] = i
+		}
+	}
+	title, ok := streaksColumn(columns, "title", "task", "task_title")
+	if !ok {
+		return Parsed{}, errors.New("missing title column")
+	}
+	date, ok := streaksColumn(columns, "entry_date", "date")
+	if !ok {
+		return Parsed{}, errors.New("missing entry_date column")
+	}
+	entryType, hasType := streaksColumn(columns, "entry_type", "type")
+	quantity, hasQuantity := streaksColumn(columns, "quantity")
+
+	var (
+		parsed Parsed
+		byName = make(map[string]int)
+	)
+	for line := 2; ; line++ {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return Parsed{}, err
+		}
+		if title >= len(record) || date >= len(record) {
+			continue
+		}
+
+		name := strings.TrimSpace(record[title])
+		i, ok := byName[name]
+		if !ok {
+			i = len(parsed.Habits)
+			byName[name] = i
+			parsed.Habits = append(parsed.Habits, Habit{Name: name})
+		}
+
+		// Missed, skipped and reset entries keep the task listed but are
+		// not completions
+		if hasType && entryType < len(record) {
+			kind := strings.ToLower(record[entryType])
+			if kind != "" && !strings.Contains(kind, "complete") {
+				continue
+			}
+		}
+
+		day, err := parseDate(record[date])
+		if err != nil {
+			return Parsed{}, fmt.Errorf("line %d: %w", line, err)
+		}
+		count := 1
+		if hasQuantity && quantity < len(record) {
+			if q, err := strconv.ParseFloat(strings.TrimSpace(record[quantity]), 64); err == nil && q >= 1 {
+				count = int(q)
+			}
+		}
+		parsed.Habits[i].Completions = append(parsed.Habits[i].Completions, Completion{Date: day, Count: count})
+	}
+
+	return parsed, nil
+}
+
+// streaksColumn finds the first of names in the header
+func streaksColumn(columns map[string]int, names ...string) (int, bool) {
+	for _, name := range names {
+		if i, ok := columns[name]; ok {
+			return i, true
+		}
+	}
+	return 0, false
+}
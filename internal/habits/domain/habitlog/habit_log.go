@@ -25,7 +25,8 @@ var (
 	ErrInvalidCount = errors.New("count must be positive")
 	ErrInvalidDate  = errors.New("invalid log date")
 	ErrNotFound     = errors.New("habit log not found")
-	ErrUnauthorized = errors.New("user cannot access this log")
+	ErrDateTaken    = errors.New("habit already has a log on that date")
+	ErrDailyCap     = errors.New("habit already reached its daily cap")
 )
 
 // NewHabitLog creates a new habit log entry with validation
@@ -114,6 +115,22 @@ func (l *HabitLog) UpdateCount(newCount int) error {
 	return nil
 }
 
+// AddCompletions adds up to n completions without taking the count past
+// limit and returns how many were added. A log already at limit is
+// ErrDailyCap.
+func (l *HabitLog) AddCompletions(n, limit int) (int, error) {
+	if n < 1 {
+		return 0, ErrInvalidCount
+	}
+	added := min(n, limit-l.count)
+	if added < 1 {
+		return 0, ErrDailyCap
+	}
+	l.count += added
+	l.updatedAt = time.Now()
+	return added, nil
+}
+
 // UpdateLogDate modifies the date for this log entry
 func (l *HabitLog) UpdateLogDate(newDate time.Time) error {
 	if newDate.IsZero() {
@@ -129,19 +146,3 @@ func (l *HabitLog) UpdateNote(newNote *string) {
 	l.note = newNote
 	l.updatedAt = time.Now()
 }
-
-// CanBeViewedBy checks if the user has permission to view this log
-func (l *HabitLog) CanBeViewedBy(userID string) error {
-	if l.userID != userID {
-		return ErrUnauthorized
-	}
-	return nil
-}
-
-// CanBeModifiedBy checks if the user has permission to modify this log
-func (l *HabitLog) CanBeModifiedBy(userID string) error {
-	if l.userID != userID {
-		return ErrUnauthorized
-	}
-	return nil
-}
@@ -0,0 +1,46 @@
+package habitlog
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrBackdateTooFar = errors.New("log date is outside the backdating window")
+	ErrLocked         = errors.New("logs this old are locked")
+)
+
+// DatePolicy limits which dates logs may be written for, so past streaks
+// cannot be fabricated. A zero field disables its limit.
+type DatePolicy struct {
+	// BackdateDays is how many days back a new log may be dated
+	BackdateDays int
+	// LockDays freezes logs older than this many days against any change
+	LockDays int
+}
+
+// CanLog checks whether a log may be created for (or moved to) date.
+func (p DatePolicy) CanLog(date, now time.Time) error {
+	if err := p.CanModify(date, now); err != nil {
+		return err
+	}
+	if p.BackdateDays > 0 && daysAgo(date, now) > p.BackdateDays {
+		return ErrBackdateTooFar
+	}
+	return nil
+}
+
+// CanModify checks whether an existing log dated date may be changed.
+func (p DatePolicy) CanModify(date, now time.Time) error {
+	if p.LockDays > 0 && daysAgo(date, now) > p.LockDays {
+		return ErrLocked
+	}
+	return nil
+}
+
+// daysAgo counts calendar days from date to now, ignoring time of day.
+func daysAgo(date, now time.Time) int {
+	d := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	n := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	return int(n.Sub(d).Hours() / 24)
+}
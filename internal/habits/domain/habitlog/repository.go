@@ -5,27 +5,60 @@ import (
 	"time"
 )
 
+// HabitRef identifies a habit together with its owner
+type HabitRef struct {
+	HabitID string
+	UserID  string
+}
+
 // Repository defines the interface for habit log persistence
 type Repository interface {
-	// AddHabitLog creates a new habit log entry
+	// AddHabitLog creates a new habit log entry. A habit has one log per
+	// day: when it already has one on log's date, log's count is added to it.
 	AddHabitLog(ctx context.Context, log *HabitLog) error
 
-	// GetHabitLog retrieves a single log by ID with authorization
+	// GetHabitLog retrieves a single log by ID. Another user's log is
+	// ErrNotFound, like a missing one.
 	GetHabitLog(ctx context.Context, logID, userID string) (*HabitLog, error)
 
-	// UpdateHabitLog uses the updateFn pattern for transactional updates
+	// UpdateHabitLog uses the updateFn pattern for transactional updates.
+	// Moving a log onto a day the habit already has a log for fails with
+	// ErrDateTaken.
 	UpdateHabitLog(
 		ctx context.Context,
 		logID, userID string,
 		updateFn func(ctx context.Context, log *HabitLog) (*HabitLog, error),
 	) error
 
-	// DeleteHabitLog removes a log entry with authorization
+	// DeleteHabitLog removes one of the user's log entries
 	DeleteHabitLog(ctx context.Context, logID, userID string) error
 
 	// GetHabitLogByDate finds a log for a specific habit on a specific date
 	GetHabitLogByDate(ctx context.Context, habitID string, date time.Time, userID string) (*HabitLog, error)
 
+	// GetLatestHabitLogForUpdate returns the most recently created log for a
+	// habit on a date and locks it until the surrounding transaction ends
+	GetLatestHabitLogForUpdate(ctx context.Context, habitID string, date time.Time, userID string) (*HabitLog, error)
+
 	// ListHabitLogs retrieves all logs for a habit (used for streak calculation)
 	ListHabitLogs(ctx context.Context, habitID, userID string) ([]*HabitLog, error)
+
+	// ListRecentlyLoggedHabits returns the habits whose logs were created or
+	// changed since the given time
+	ListRecentlyLoggedHabits(ctx context.Context, since time.Time) ([]HabitRef, error)
+
+	// GetLogLockDays returns the user's log lock window in days, 0 when unset
+	GetLogLockDays(ctx context.Context, userID string) (int, error)
+
+	// ListCompactableHabits returns the habits of users on plan that have
+	// logs dated before the given day
+	ListCompactableHabits(ctx context.Context, plan string, before time.Time) ([]HabitRef, error)
+
+	// CompactHabitLogs adds the habit's logs dated before the given day to
+	// its monthly summaries and deletes them, returning how many it deleted
+	CompactHabitLogs(ctx context.Context, habitID string, before time.Time) (int, error)
+
+	// GetCompactedDays returns how many logged days of the habit were
+	// compacted into monthly summaries
+	GetCompactedDays(ctx context.Context, habitID string) (int, error)
 }
@@ -0,0 +1,68 @@
+package habitlog
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MonthlySummary is what remains of a habit's logs in one month after they
+// were compacted: how many days were logged and how many completions those
+// logs held in total.
+type MonthlySummary struct {
+	HabitID    string
+	UserID     string
+	Month      time.Time // first day of the month
+	DaysLogged int
+	TotalCount int
+}
+
+// RetentionPolicy maps a plan to how many months of logs its users keep.
+// Plans without an entry keep their logs indefinitely.
+type RetentionPolicy map[string]int
+
+// ParseRetentionPolicy parses "plan=months,..." as configured in
+// HABIT_LOG_RETENTION. An empty string keeps every plan's logs.
+func ParseRetentionPolicy(s string) (RetentionPolicy, error) {
+	policy := make(RetentionPolicy)
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		plan, months, ok := strings.Cut(pair, "=")
+		plan = strings.TrimSpace(plan)
+		if !ok || plan == "" {
+			return nil, fmt.Errorf("%q is not plan=months", pair)
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(months))
+		if err != nil || n < 1 {
+			return nil, fmt.Errorf("plan %q must keep at least one month of logs", plan)
+		}
+		policy[plan] = n
+	}
+	return policy, nil
+}
+
+// Plans returns the plans with a retention limit in a stable order
+func (p RetentionPolicy) Plans() []string {
+	plans := make([]string, 0, len(p))
+	for plan := range p {
+		plans = append(plans, plan)
+	}
+	sort.Strings(plans)
+	return plans
+}
+
+// Cutoff returns the first day of the oldest month plan still keeps in full
+// at now; logs dated before it are due for compaction. ok is false for
+// plans that keep their logs indefinitely.
+func (p RetentionPolicy) Cutoff(plan string, now time.Time) (cutoff time.Time, ok bool) {
+	months, ok := p[plan]
+	if !ok {
+		return time.Time{}, false
+	}
+	return time.Date(now.Year(), now.Month()-time.Month(months), 1, 0, 0, 0, 0, time.UTC), true
+}
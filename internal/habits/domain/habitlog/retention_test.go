@@ -0,0 +1,41 @@
+package habitlog_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/habits/domain/habitlog"
+)
+
+func TestParseRetentionPolicy(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given retention configured per plan", t, func() {
+		Convey("When it lists months for plans", func() {
+			policy, err := habitlog.ParseRetentionPolicy(" free = 12, team=36 ")
+			So(err, ShouldBeNil)
+			So(policy, ShouldResemble, habitlog.RetentionPolicy{"free": 12, "team": 36})
+
+			Convey("Then a plan's cutoff is the start of the month that many months back", func() {
+				cutoff, ok := policy.Cutoff("free", time.Date(2026, 1, 31, 8, 0, 0, 0, time.UTC))
+				So(ok, ShouldBeTrue)
+				So(cutoff, ShouldEqual, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+
+				_, ok = policy.Cutoff("pro", time.Now())
+				So(ok, ShouldBeFalse)
+			})
+		})
+
+		Convey("When a plan keeps no months", func() {
+			_, err := habitlog.ParseRetentionPolicy("free=0")
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("When an entry is not plan=months", func() {
+			_, err := habitlog.ParseRetentionPolicy("free")
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
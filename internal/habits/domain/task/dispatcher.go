@@ -5,4 +5,5 @@ import "context"
 // TaskDispatcher interface for dispatching habit-related background tasks
 type TaskDispatcher interface {
 	DispatchHabitCreated(ctx context.Context, habitID, userID, name string) error
+	DispatchImport(ctx context.Context, importID, userID string) error
 }
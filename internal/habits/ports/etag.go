@@ -0,0 +1,60 @@
+package ports
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+)
+
+// ifMatchKeys are the metadata keys an If-Match precondition arrives under:
+// the gateway prefixes forwarded HTTP headers, gRPC clients send it bare
+var ifMatchKeys = []string{"grpcgateway-if-match", "if-match"}
+
+// habitETag is the entity tag of a habit at version
+func habitETag(version int) string {
+	return fmt.Sprintf(`"v%d"`, version)
+}
+
+// setHabitETag sends the habit's ETag as response metadata; the gateway
+// returns it as the ETag header
+func setHabitETag(ctx context.Context, version int) {
+	_ = grpc.SetHeader(ctx, metadata.Pairs("etag", habitETag(version)))
+}
+
+// expectedVersion returns the version an update is conditional on: the
+// request's expected_version, or else an If-Match ETag. A nil result means
+// the update is unconditional.
+func expectedVersion(ctx context.Context, requested *int32) (*int, error) {
+	if requested != nil {
+		v := int(*requested)
+		return &v, nil
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, key := range ifMatchKeys {
+		values := md.Get(key)
+		if len(values) == 0 {
+			continue
+		}
+
+		tag := strings.TrimSpace(values[0])
+		if tag == "*" {
+			return nil, nil
+		}
+		tag = strings.TrimPrefix(tag, "W/")
+		tag = strings.TrimPrefix(strings.Trim(tag, `"`), "v")
+		v, err := strconv.Atoi(tag)
+		if err != nil || v < 1 {
+			return nil, apperror.InvalidInput("If-Match", "must be the ETag returned for the habit")
+		}
+		return &v, nil
+	}
+
+	return nil, nil
+}
@@ -8,12 +8,13 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	commonv1 "github.com/semmidev/ethos-go/contracts/grpc/ethos/common/v1"
+	habitsv1 "github.com/semmidev/ethos-go/contracts/grpc/ethos/habits/v1"
 	authctx "github.com/semmidev/ethos-go/internal/auth/infrastructure/context"
+	"github.com/semmidev/ethos-go/internal/common/dateutil"
 	"github.com/semmidev/ethos-go/internal/common/grpcutil"
 	"github.com/semmidev/ethos-go/internal/common/model"
 	"github.com/semmidev/ethos-go/internal/common/random"
-	commonv1 "github.com/semmidev/ethos-go/internal/generated/grpc/ethos/common/v1"
-	habitsv1 "github.com/semmidev/ethos-go/internal/generated/grpc/ethos/habits/v1"
 	"github.com/semmidev/ethos-go/internal/habits/app"
 	"github.com/semmidev/ethos-go/internal/habits/app/command"
 	"github.com/semmidev/ethos-go/internal/habits/app/query"
@@ -67,7 +68,7 @@ func (s *HabitsGRPCServer) ListHabits(ctx context.Context, req *habitsv1.ListHab
 		Filter: filter,
 	})
 	if err != nil {
-		return nil, toHabitsGRPCError(err)
+		return nil, toHabitsGRPCError(ctx, err)
 	}
 
 	habits := make([]*habitsv1.Habit, 0, len(result.Habits))
@@ -120,12 +121,13 @@ func (s *HabitsGRPCServer) CreateHabit(ctx context.Context, req *habitsv1.Create
 		Name:         req.Name,
 		Description:  req.Description,
 		Frequency:    frequency,
+		Recurrence:   toRecurrenceInput(req.Recurrence),
 		TargetCount:  targetCount,
 		ReminderTime: req.ReminderTime,
 	}
 
 	if err := s.app.Commands.CreateHabit.Handle(ctx, cmd); err != nil {
-		return nil, toHabitsGRPCError(err)
+		return nil, toHabitsGRPCError(ctx, err)
 	}
 
 	h, err := s.app.Queries.GetHabit.Handle(ctx, query.GetHabit{
@@ -133,7 +135,7 @@ func (s *HabitsGRPCServer) CreateHabit(ctx context.Context, req *habitsv1.Create
 		UserID:  user.UserID,
 	})
 	if err != nil {
-		return nil, toHabitsGRPCError(err)
+		return nil, toHabitsGRPCError(ctx, err)
 	}
 
 	return &habitsv1.HabitResponse{
@@ -155,8 +157,9 @@ func (s *HabitsGRPCServer) GetHabit(ctx context.Context, req *habitsv1.GetHabitR
 		UserID:  user.UserID,
 	})
 	if err != nil {
-		return nil, toHabitsGRPCError(err)
+		return nil, toHabitsGRPCError(ctx, err)
 	}
+	setHabitETag(ctx, h.Version)
 
 	return &habitsv1.HabitResponse{
 		Success: true,
@@ -178,18 +181,25 @@ func (s *HabitsGRPCServer) UpdateHabit(ctx context.Context, req *habitsv1.Update
 		targetCount = &tc
 	}
 
+	version, err := expectedVersion(ctx, req.ExpectedVersion)
+	if err != nil {
+		return nil, toHabitsGRPCError(ctx, err)
+	}
+
 	cmd := command.UpdateHabit{
-		HabitID:      req.HabitId,
-		UserID:       user.UserID,
-		Name:         req.Name,
-		Description:  req.Description,
-		Frequency:    req.Frequency,
-		TargetCount:  targetCount,
-		ReminderTime: req.ReminderTime,
+		HabitID:         req.HabitId,
+		UserID:          user.UserID,
+		Name:            req.Name,
+		Description:     req.Description,
+		Frequency:       req.Frequency,
+		Recurrence:      toRecurrenceInput(req.Recurrence),
+		TargetCount:     targetCount,
+		ReminderTime:    req.ReminderTime,
+		ExpectedVersion: version,
 	}
 
 	if err := s.app.Commands.UpdateHabit.Handle(ctx, cmd); err != nil {
-		return nil, toHabitsGRPCError(err)
+		return nil, toHabitsGRPCError(ctx, err)
 	}
 
 	h, err := s.app.Queries.GetHabit.Handle(ctx, query.GetHabit{
@@ -197,8 +207,9 @@ func (s *HabitsGRPCServer) UpdateHabit(ctx context.Context, req *habitsv1.Update
 		UserID:  user.UserID,
 	})
 	if err != nil {
-		return nil, toHabitsGRPCError(err)
+		return nil, toHabitsGRPCError(ctx, err)
 	}
+	setHabitETag(ctx, h.Version)
 
 	return &habitsv1.HabitResponse{
 		Success: true,
@@ -220,7 +231,7 @@ func (s *HabitsGRPCServer) DeleteHabit(ctx context.Context, req *habitsv1.Delete
 	}
 
 	if err := s.app.Commands.DeleteHabit.Handle(ctx, cmd); err != nil {
-		return nil, toHabitsGRPCError(err)
+		return nil, toHabitsGRPCError(ctx, err)
 	}
 
 	return &habitsv1.SuccessResponse{
@@ -242,7 +253,7 @@ func (s *HabitsGRPCServer) ActivateHabit(ctx context.Context, req *habitsv1.Acti
 	}
 
 	if err := s.app.Commands.ActivateHabit.Handle(ctx, cmd); err != nil {
-		return nil, toHabitsGRPCError(err)
+		return nil, toHabitsGRPCError(ctx, err)
 	}
 
 	return &habitsv1.SuccessResponse{
@@ -264,7 +275,7 @@ func (s *HabitsGRPCServer) DeactivateHabit(ctx context.Context, req *habitsv1.De
 	}
 
 	if err := s.app.Commands.DeactivateHabit.Handle(ctx, cmd); err != nil {
-		return nil, toHabitsGRPCError(err)
+		return nil, toHabitsGRPCError(ctx, err)
 	}
 
 	return &habitsv1.SuccessResponse{
@@ -285,7 +296,7 @@ func (s *HabitsGRPCServer) GetHabitStats(ctx context.Context, req *habitsv1.GetH
 		UserID:  user.UserID,
 	})
 	if err != nil {
-		return nil, toHabitsGRPCError(err)
+		return nil, toHabitsGRPCError(ctx, err)
 	}
 
 	return &habitsv1.HabitStatsResponse{
@@ -299,6 +310,49 @@ func (s *HabitsGRPCServer) GetHabitStats(ctx context.Context, req *habitsv1.GetH
 	}, nil
 }
 
+// GetHabitInsights shows on which weekdays and at which times a habit is usually completed.
+func (s *HabitsGRPCServer) GetHabitInsights(ctx context.Context, req *habitsv1.GetHabitInsightsRequest) (*habitsv1.HabitInsightsResponse, error) {
+	user, err := authctx.UserFromCtx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	insights, err := s.app.Queries.GetHabitInsights.Handle(ctx, query.GetHabitInsights{
+		HabitID: req.HabitId,
+		UserID:  user.UserID,
+	})
+	if err != nil {
+		return nil, toHabitsGRPCError(ctx, err)
+	}
+
+	return &habitsv1.HabitInsightsResponse{
+		Success: true,
+		Message: "Habit insights retrieved successfully",
+		Data: &habitsv1.HabitInsights{
+			HabitId:       insights.HabitID,
+			HabitName:     insights.HabitName,
+			TotalLogs:     int32(insights.TotalLogs),
+			Weekdays:      toProtoInsightBuckets(insights.Weekdays),
+			TimesOfDay:    toProtoInsightBuckets(insights.TimesOfDay),
+			BestWeekday:   insights.BestWeekday,
+			WorstWeekday:  insights.WorstWeekday,
+			BestTimeOfDay: insights.BestTimeOfDay,
+		},
+	}, nil
+}
+
+func toProtoInsightBuckets(buckets []query.InsightBucket) []*habitsv1.InsightBucket {
+	result := make([]*habitsv1.InsightBucket, len(buckets))
+	for i, b := range buckets {
+		result[i] = &habitsv1.InsightBucket{
+			Name:  b.Name,
+			Logs:  int32(b.Logs),
+			Share: b.Share,
+		}
+	}
+	return result
+}
+
 // LogHabit logs a habit completion.
 func (s *HabitsGRPCServer) LogHabit(ctx context.Context, req *habitsv1.LogHabitRequest) (*habitsv1.LogHabitResponse, error) {
 	user, err := authctx.UserFromCtx(ctx)
@@ -306,9 +360,9 @@ func (s *HabitsGRPCServer) LogHabit(ctx context.Context, req *habitsv1.LogHabitR
 		return nil, status.Error(codes.Unauthenticated, "unauthorized")
 	}
 
-	logDate, err := time.Parse("2006-01-02", req.LogDate)
+	logDate, err := dateutil.ParseDate("log_date", req.LogDate)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "invalid log_date format, expected YYYY-MM-DD")
+		return nil, toHabitsGRPCError(ctx, err)
 	}
 
 	logID := random.NewUUID().String()
@@ -322,15 +376,17 @@ func (s *HabitsGRPCServer) LogHabit(ctx context.Context, req *habitsv1.LogHabitR
 		Note:    req.Note,
 	}
 
-	if err := s.app.Commands.LogHabit.Handle(ctx, cmd); err != nil {
-		return nil, toHabitsGRPCError(err)
+	result, err := s.app.Commands.LogHabit.Handle(ctx, cmd)
+	if err != nil {
+		return nil, toHabitsGRPCError(ctx, err)
 	}
 
 	return &habitsv1.LogHabitResponse{
 		Success: true,
 		Message: "Habit logged successfully",
 		Data: &habitsv1.LogHabitData{
-			LogId: logID,
+			LogId:      result.LogID,
+			TotalToday: int32(result.TotalToday),
 		},
 	}, nil
 }
@@ -349,13 +405,11 @@ func (s *HabitsGRPCServer) GetHabitLogs(ctx context.Context, req *habitsv1.GetHa
 	if req.PerPage > 0 {
 		filter.PerPage = int(req.PerPage)
 	}
-	if req.StartDate != nil {
-		t, _ := time.Parse("2006-01-02", *req.StartDate)
-		filter.StartDate = &t
+	if filter.StartDate, err = dateutil.ParseOptionalDate("start_date", req.StartDate); err != nil {
+		return nil, toHabitsGRPCError(ctx, err)
 	}
-	if req.EndDate != nil {
-		t, _ := time.Parse("2006-01-02", *req.EndDate)
-		filter.EndDate = &t
+	if filter.EndDate, err = dateutil.ParseOptionalDate("end_date", req.EndDate); err != nil {
+		return nil, toHabitsGRPCError(ctx, err)
 	}
 	if req.Keyword != nil {
 		filter.Keyword = *req.Keyword
@@ -367,7 +421,7 @@ func (s *HabitsGRPCServer) GetHabitLogs(ctx context.Context, req *habitsv1.GetHa
 		Filter:  filter,
 	})
 	if err != nil {
-		return nil, toHabitsGRPCError(err)
+		return nil, toHabitsGRPCError(ctx, err)
 	}
 
 	logs := make([]*habitsv1.HabitLog, 0, len(result.Logs))
@@ -375,7 +429,7 @@ func (s *HabitsGRPCServer) GetHabitLogs(ctx context.Context, req *habitsv1.GetHa
 		logs = append(logs, &habitsv1.HabitLog{
 			Id:        l.LogID,
 			HabitId:   l.HabitID,
-			LogDate:   l.LogDate.Format("2006-01-02"),
+			LogDate:   dateutil.FormatDate(l.LogDate),
 			Count:     int32(l.Count),
 			Note:      l.Note,
 			CreatedAt: timestamppb.New(l.CreatedAt),
@@ -415,12 +469,9 @@ func (s *HabitsGRPCServer) UpdateHabitLog(ctx context.Context, req *habitsv1.Upd
 		count = &c
 	}
 
-	var logDate *time.Time
-	if req.LogDate != nil {
-		t, err := time.Parse("2006-01-02", *req.LogDate)
-		if err == nil {
-			logDate = &t
-		}
+	logDate, err := dateutil.ParseOptionalDate("log_date", req.LogDate)
+	if err != nil {
+		return nil, toHabitsGRPCError(ctx, err)
 	}
 
 	cmd := command.UpdateHabitLog{
@@ -432,7 +483,7 @@ func (s *HabitsGRPCServer) UpdateHabitLog(ctx context.Context, req *habitsv1.Upd
 	}
 
 	if err := s.app.Commands.UpdateHabitLog.Handle(ctx, cmd); err != nil {
-		return nil, toHabitsGRPCError(err)
+		return nil, toHabitsGRPCError(ctx, err)
 	}
 
 	return &habitsv1.SuccessResponse{
@@ -454,7 +505,7 @@ func (s *HabitsGRPCServer) DeleteHabitLog(ctx context.Context, req *habitsv1.Del
 	}
 
 	if err := s.app.Commands.DeleteHabitLog.Handle(ctx, cmd); err != nil {
-		return nil, toHabitsGRPCError(err)
+		return nil, toHabitsGRPCError(ctx, err)
 	}
 
 	return &habitsv1.SuccessResponse{
@@ -463,6 +514,77 @@ func (s *HabitsGRPCServer) DeleteHabitLog(ctx context.Context, req *habitsv1.Del
 	}, nil
 }
 
+// UndoHabitLog takes back the latest completion logged for a day.
+func (s *HabitsGRPCServer) UndoHabitLog(ctx context.Context, req *habitsv1.UndoHabitLogRequest) (*habitsv1.SuccessResponse, error) {
+	user, err := authctx.UserFromCtx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	now := time.Now()
+	logDate := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if req.LogDate != nil {
+		logDate, err = dateutil.ParseDate("log_date", *req.LogDate)
+		if err != nil {
+			return nil, toHabitsGRPCError(ctx, err)
+		}
+	}
+
+	cmd := command.UndoHabitLog{
+		HabitID: req.HabitId,
+		UserID:  user.UserID,
+		LogDate: logDate,
+	}
+
+	if err := s.app.Commands.UndoHabitLog.Handle(ctx, cmd); err != nil {
+		return nil, toHabitsGRPCError(ctx, err)
+	}
+
+	return &habitsv1.SuccessResponse{
+		Success: true,
+		Message: "Habit log undone successfully",
+	}, nil
+}
+
+// SetHabitLogCount sets a day's completion count outright.
+func (s *HabitsGRPCServer) SetHabitLogCount(ctx context.Context, req *habitsv1.SetHabitLogCountRequest) (*habitsv1.LogHabitResponse, error) {
+	user, err := authctx.UserFromCtx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	now := time.Now()
+	logDate := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if req.LogDate != nil {
+		logDate, err = dateutil.ParseDate("log_date", *req.LogDate)
+		if err != nil {
+			return nil, toHabitsGRPCError(ctx, err)
+		}
+	}
+
+	cmd := command.SetHabitLogCount{
+		LogID:   random.NewUUID().String(),
+		HabitID: req.HabitId,
+		UserID:  user.UserID,
+		LogDate: logDate,
+		Count:   int(req.Count),
+	}
+
+	result, err := s.app.Commands.SetHabitLogCount.Handle(ctx, cmd)
+	if err != nil {
+		return nil, toHabitsGRPCError(ctx, err)
+	}
+
+	return &habitsv1.LogHabitResponse{
+		Success: true,
+		Message: "Habit log count set successfully",
+		Data: &habitsv1.LogHabitData{
+			LogId:      result.LogID,
+			TotalToday: int32(result.TotalToday),
+		},
+	}, nil
+}
+
 // GetDashboard retrieves the user's dashboard data.
 func (s *HabitsGRPCServer) GetDashboard(ctx context.Context, req *habitsv1.GetDashboardRequest) (*habitsv1.DashboardResponse, error) {
 	user, err := authctx.UserFromCtx(ctx)
@@ -474,7 +596,7 @@ func (s *HabitsGRPCServer) GetDashboard(ctx context.Context, req *habitsv1.GetDa
 		UserID: user.UserID,
 	})
 	if err != nil {
-		return nil, toHabitsGRPCError(err)
+		return nil, toHabitsGRPCError(ctx, err)
 	}
 
 	return &habitsv1.DashboardResponse{
@@ -502,7 +624,7 @@ func (s *HabitsGRPCServer) GetWeeklyAnalytics(ctx context.Context, req *habitsv1
 		UserID: user.UserID,
 	})
 	if err != nil {
-		return nil, toHabitsGRPCError(err)
+		return nil, toHabitsGRPCError(ctx, err)
 	}
 
 	days := make([]*habitsv1.DailyAnalytics, len(analytics.Days))
@@ -525,6 +647,335 @@ func (s *HabitsGRPCServer) GetWeeklyAnalytics(ctx context.Context, req *habitsv1
 	}, nil
 }
 
+// ComparePeriods compares this week or month so far with the previous one.
+func (s *HabitsGRPCServer) ComparePeriods(ctx context.Context, req *habitsv1.ComparePeriodsRequest) (*habitsv1.PeriodComparisonResponse, error) {
+	user, err := authctx.UserFromCtx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	comparison, err := s.app.Queries.ComparePeriods.Handle(ctx, query.ComparePeriods{
+		UserID: user.UserID,
+		Period: req.Period,
+	})
+	if err != nil {
+		return nil, toHabitsGRPCError(ctx, err)
+	}
+
+	habits := make([]*habitsv1.HabitPeriodDelta, len(comparison.Habits))
+	for i, h := range comparison.Habits {
+		habits[i] = &habitsv1.HabitPeriodDelta{
+			HabitId:                h.HabitID,
+			HabitName:              h.HabitName,
+			CurrentCompletions:     int32(h.CurrentCompletions),
+			PreviousCompletions:    int32(h.PreviousCompletions),
+			CompletionsChange:      h.CompletionsChange,
+			CurrentCompletionRate:  h.CurrentCompletionRate,
+			PreviousCompletionRate: h.PreviousCompletionRate,
+			CompletionRateChange:   h.CompletionRateChange,
+			CurrentLongestStreak:   int32(h.CurrentLongestStreak),
+			PreviousLongestStreak:  int32(h.PreviousLongestStreak),
+		}
+	}
+
+	return &habitsv1.PeriodComparisonResponse{
+		Success: true,
+		Message: "Period comparison retrieved successfully",
+		Data: &habitsv1.PeriodComparison{
+			Period:               comparison.Period,
+			Current:              toProtoPeriodSummary(comparison.Current),
+			Previous:             toProtoPeriodSummary(comparison.Previous),
+			CompletionsChange:    comparison.CompletionsChange,
+			CompletionRateChange: comparison.CompletionRateChange,
+			LongestStreakChange:  int32(comparison.LongestStreakChange),
+			Habits:               habits,
+		},
+	}, nil
+}
+
+func toProtoPeriodSummary(p query.PeriodSummary) *habitsv1.PeriodSummary {
+	return &habitsv1.PeriodSummary{
+		StartDate:      p.StartDate,
+		EndDate:        p.EndDate,
+		Completions:    int32(p.Completions),
+		CompletionRate: p.CompletionRate,
+		LongestStreak:  int32(p.LongestStreak),
+	}
+}
+
+// GetHabitCorrelations finds habits that tend to be logged on the same days.
+func (s *HabitsGRPCServer) GetHabitCorrelations(ctx context.Context, req *habitsv1.GetHabitCorrelationsRequest) (*habitsv1.HabitCorrelationsResponse, error) {
+	user, err := authctx.UserFromCtx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	result, err := s.app.Queries.GetHabitCorrelations.Handle(ctx, query.GetHabitCorrelations{
+		UserID: user.UserID,
+	})
+	if err != nil {
+		return nil, toHabitsGRPCError(ctx, err)
+	}
+
+	correlations := make([]*habitsv1.HabitCorrelation, len(result.Correlations))
+	for i, c := range result.Correlations {
+		correlations[i] = &habitsv1.HabitCorrelation{
+			HabitId:          c.HabitID,
+			HabitName:        c.HabitName,
+			RelatedHabitId:   c.RelatedHabitID,
+			RelatedHabitName: c.RelatedHabitName,
+			HabitDays:        int32(c.HabitDays),
+			SharedDays:       int32(c.SharedDays),
+			Rate:             c.Rate,
+			BaselineRate:     c.BaselineRate,
+			Lift:             c.Lift,
+		}
+	}
+
+	return &habitsv1.HabitCorrelationsResponse{
+		Success: true,
+		Message: "Habit correlations retrieved successfully",
+		Data: &habitsv1.HabitCorrelations{
+			StartDate:    result.StartDate,
+			EndDate:      result.EndDate,
+			Correlations: correlations,
+		},
+	}, nil
+}
+
+// ReorderHabits sets the display order of the user's habits.
+func (s *HabitsGRPCServer) ReorderHabits(ctx context.Context, req *habitsv1.ReorderHabitsRequest) (*habitsv1.SuccessResponse, error) {
+	user, err := authctx.UserFromCtx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	cmd := command.ReorderHabits{
+		UserID:   user.UserID,
+		HabitIDs: req.HabitIds,
+	}
+
+	if err := s.app.Commands.ReorderHabits.Handle(ctx, cmd); err != nil {
+		return nil, toHabitsGRPCError(ctx, err)
+	}
+
+	return &habitsv1.SuccessResponse{
+		Success: true,
+		Message: "Habits reordered successfully",
+	}, nil
+}
+
+// PauseHabit pauses a habit until a date.
+func (s *HabitsGRPCServer) PauseHabit(ctx context.Context, req *habitsv1.PauseHabitRequest) (*habitsv1.SuccessResponse, error) {
+	user, err := authctx.UserFromCtx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	until, err := dateutil.ParseDate("until", req.Until)
+	if err != nil {
+		return nil, toHabitsGRPCError(ctx, err)
+	}
+
+	cmd := command.PauseHabit{
+		HabitID:    req.HabitId,
+		UserID:     user.UserID,
+		VacationID: random.NewUUID().String(),
+		Until:      until,
+	}
+
+	if err := s.app.Commands.PauseHabit.Handle(ctx, cmd); err != nil {
+		return nil, toHabitsGRPCError(ctx, err)
+	}
+
+	return &habitsv1.SuccessResponse{
+		Success: true,
+		Message: "Habit paused successfully",
+	}, nil
+}
+
+// StartVacation starts a vacation for a habit.
+func (s *HabitsGRPCServer) StartVacation(ctx context.Context, req *habitsv1.StartVacationRequest) (*habitsv1.VacationResponse, error) {
+	user, err := authctx.UserFromCtx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	startDate, err := dateutil.ParseDate("start_date", req.StartDate)
+	if err != nil {
+		return nil, toHabitsGRPCError(ctx, err)
+	}
+	endDate, err := dateutil.ParseOptionalDate("end_date", req.EndDate)
+	if err != nil {
+		return nil, toHabitsGRPCError(ctx, err)
+	}
+
+	vacationID := random.NewUUID().String()
+
+	cmd := command.StartVacation{
+		VacationID: vacationID,
+		HabitID:    req.HabitId,
+		UserID:     user.UserID,
+		StartDate:  startDate,
+		EndDate:    endDate,
+		Reason:     req.Reason,
+	}
+
+	if err := s.app.Commands.StartVacation.Handle(ctx, cmd); err != nil {
+		return nil, toHabitsGRPCError(ctx, err)
+	}
+
+	return &habitsv1.VacationResponse{
+		Success: true,
+		Message: "Vacation started successfully",
+		Data: toProtoVacation(query.Vacation{
+			VacationID: vacationID,
+			HabitID:    req.HabitId,
+			StartDate:  startDate,
+			EndDate:    endDate,
+			Reason:     req.Reason,
+			CreatedAt:  time.Now(),
+		}),
+	}, nil
+}
+
+// EndVacation ends a habit vacation.
+func (s *HabitsGRPCServer) EndVacation(ctx context.Context, req *habitsv1.EndVacationRequest) (*habitsv1.SuccessResponse, error) {
+	user, err := authctx.UserFromCtx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	endDate := time.Now()
+	if req.EndDate != nil {
+		endDate, err = dateutil.ParseDate("end_date", *req.EndDate)
+		if err != nil {
+			return nil, toHabitsGRPCError(ctx, err)
+		}
+	}
+
+	cmd := command.EndVacation{
+		VacationID: req.VacationId,
+		HabitID:    req.HabitId,
+		UserID:     user.UserID,
+		EndDate:    endDate,
+	}
+
+	if err := s.app.Commands.EndVacation.Handle(ctx, cmd); err != nil {
+		return nil, toHabitsGRPCError(ctx, err)
+	}
+
+	return &habitsv1.SuccessResponse{
+		Success: true,
+		Message: "Vacation ended successfully",
+	}, nil
+}
+
+// ListVacations retrieves a habit's vacations.
+func (s *HabitsGRPCServer) ListVacations(ctx context.Context, req *habitsv1.ListVacationsRequest) (*habitsv1.ListVacationsResponse, error) {
+	user, err := authctx.UserFromCtx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	vacations, err := s.app.Queries.ListVacations.Handle(ctx, query.ListVacations{
+		HabitID: req.HabitId,
+		UserID:  user.UserID,
+	})
+	if err != nil {
+		return nil, toHabitsGRPCError(ctx, err)
+	}
+
+	data := make([]*habitsv1.Vacation, len(vacations))
+	for i, v := range vacations {
+		data[i] = toProtoVacation(v)
+	}
+
+	return &habitsv1.ListVacationsResponse{
+		Success: true,
+		Message: "Vacations retrieved successfully",
+		Data:    data,
+	}, nil
+}
+
+// PreviewImport describes what importing an export file would create.
+func (s *HabitsGRPCServer) PreviewImport(ctx context.Context, req *habitsv1.PreviewImportRequest) (*habitsv1.ImportPreviewResponse, error) {
+	if _, err := authctx.UserFromCtx(ctx); err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	preview, err := s.app.Queries.PreviewImport.Handle(ctx, query.PreviewImport{
+		Source: req.Source,
+		Data:   []byte(req.Data),
+	})
+	if err != nil {
+		return nil, toHabitsGRPCError(ctx, err)
+	}
+
+	return &habitsv1.ImportPreviewResponse{
+		Success: true,
+		Message: "Import previewed successfully",
+		Data:    toProtoImportPreview(preview),
+	}, nil
+}
+
+// StartImport queues an export file to be imported in the background.
+func (s *HabitsGRPCServer) StartImport(ctx context.Context, req *habitsv1.StartImportRequest) (*habitsv1.ImportResponse, error) {
+	user, err := authctx.UserFromCtx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	importID := random.NewUUID().String()
+
+	cmd := command.StartImport{
+		ImportID: importID,
+		UserID:   user.UserID,
+		Source:   req.Source,
+		Data:     []byte(req.Data),
+	}
+
+	if err := s.app.Commands.StartImport.Handle(ctx, cmd); err != nil {
+		return nil, toHabitsGRPCError(ctx, err)
+	}
+
+	imp, err := s.app.Queries.GetImport.Handle(ctx, query.GetImport{
+		ImportID: importID,
+		UserID:   user.UserID,
+	})
+	if err != nil {
+		return nil, toHabitsGRPCError(ctx, err)
+	}
+
+	return &habitsv1.ImportResponse{
+		Success: true,
+		Message: "Import started successfully",
+		Data:    toProtoImport(imp),
+	}, nil
+}
+
+// GetImport returns the progress of an import.
+func (s *HabitsGRPCServer) GetImport(ctx context.Context, req *habitsv1.GetImportRequest) (*habitsv1.ImportResponse, error) {
+	user, err := authctx.UserFromCtx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	imp, err := s.app.Queries.GetImport.Handle(ctx, query.GetImport{
+		ImportID: req.ImportId,
+		UserID:   user.UserID,
+	})
+	if err != nil {
+		return nil, toHabitsGRPCError(ctx, err)
+	}
+
+	return &habitsv1.ImportResponse{
+		Success: true,
+		Message: "Import retrieved successfully",
+		Data:    toProtoImport(imp),
+	}, nil
+}
+
 // toProtoHabit converts a query.Habit to a protobuf Habit.
 func toProtoHabit(h query.Habit) *habitsv1.Habit {
 	habit := &habitsv1.Habit{
@@ -535,6 +986,12 @@ func toProtoHabit(h query.Habit) *habitsv1.Habit {
 		IsActive:    h.IsActive,
 		CreatedAt:   timestamppb.New(h.CreatedAt),
 		UpdatedAt:   timestamppb.New(h.UpdatedAt),
+		Position:    int32(h.Position),
+		Version:     int32(h.Version),
+		Recurrence: &habitsv1.Recurrence{
+			Days:     h.Recurrence.Days,
+			Interval: int32(h.Recurrence.Interval),
+		},
 	}
 
 	if h.Description != nil {
@@ -543,11 +1000,107 @@ func toProtoHabit(h query.Habit) *habitsv1.Habit {
 	if h.ReminderTime != nil {
 		habit.ReminderTime = h.ReminderTime
 	}
+	habit.PausedUntil = dateutil.FormatOptionalDate(h.PausedUntil)
 
 	return habit
 }
 
+// RecomputeHabitStats rebuilds stored habit stats from logs.
+func (s *HabitsGRPCServer) RecomputeHabitStats(ctx context.Context, req *habitsv1.RecomputeHabitStatsRequest) (*habitsv1.RecomputeHabitStatsResponse, error) {
+	cmd := command.RecomputeStats{
+		UserID:  req.GetUserId(),
+		HabitID: req.GetHabitId(),
+	}
+	if req.ActiveSince != nil {
+		cmd.ActiveSince = req.ActiveSince.AsTime()
+	}
+
+	result, err := s.app.Commands.RecomputeStats.Handle(ctx, cmd)
+	if err != nil {
+		return nil, toHabitsGRPCError(ctx, err)
+	}
+
+	return &habitsv1.RecomputeHabitStatsResponse{
+		Success:  true,
+		Message:  "Habit stats recomputed successfully",
+		Checked:  int32(result.Checked),
+		Repaired: int32(result.Repaired),
+	}, nil
+}
+
+// toRecurrenceInput converts an optional protobuf Recurrence to command input.
+func toRecurrenceInput(r *habitsv1.Recurrence) *command.RecurrenceInput {
+	if r == nil {
+		return nil
+	}
+	return &command.RecurrenceInput{
+		Days:     r.Days,
+		Interval: int(r.Interval),
+	}
+}
+
+// toProtoVacation converts a query.Vacation to a protobuf Vacation.
+func toProtoVacation(v query.Vacation) *habitsv1.Vacation {
+	return &habitsv1.Vacation{
+		Id:        v.VacationID,
+		HabitId:   v.HabitID,
+		StartDate: dateutil.FormatDate(v.StartDate),
+		EndDate:   dateutil.FormatOptionalDate(v.EndDate),
+		Reason:    v.Reason,
+		CreatedAt: timestamppb.New(v.CreatedAt),
+	}
+}
+
+// toProtoImport converts a query.HabitImport to a protobuf HabitImport.
+func toProtoImport(i query.HabitImport) *habitsv1.HabitImport {
+	imp := &habitsv1.HabitImport{
+		Id:             i.ImportID,
+		Source:         i.Source,
+		Status:         i.Status,
+		TotalHabits:    int32(i.TotalHabits),
+		ImportedHabits: int32(i.ImportedHabits),
+		TotalLogs:      int32(i.TotalLogs),
+		ImportedLogs:   int32(i.ImportedLogs),
+		Progress:       int32(i.Progress()),
+		Failure:        i.Failure,
+		CreatedAt:      timestamppb.New(i.CreatedAt),
+		UpdatedAt:      timestamppb.New(i.UpdatedAt),
+	}
+
+	if i.CompletedAt != nil {
+		imp.CompletedAt = timestamppb.New(*i.CompletedAt)
+	}
+
+	return imp
+}
+
+// toProtoImportPreview converts a query.ImportPreview to a protobuf ImportPreview.
+func toProtoImportPreview(p query.ImportPreview) *habitsv1.ImportPreview {
+	habits := make([]*habitsv1.ImportPreviewHabit, len(p.Habits))
+	for i, h := range p.Habits {
+		habits[i] = &habitsv1.ImportPreviewHabit{
+			Name:      h.Name,
+			Frequency: h.Frequency,
+			Recurrence: &habitsv1.Recurrence{
+				Days:     h.Recurrence.Days,
+				Interval: int32(h.Recurrence.Interval),
+			},
+			Logs:         int32(h.Logs),
+			FirstLogDate: dateutil.FormatOptionalDate(h.FirstLogDate),
+			LastLogDate:  dateutil.FormatOptionalDate(h.LastLogDate),
+		}
+	}
+
+	return &habitsv1.ImportPreview{
+		Source:      p.Source,
+		Habits:      habits,
+		TotalHabits: int32(p.TotalHabits),
+		TotalLogs:   int32(p.TotalLogs),
+		Warnings:    p.Warnings,
+	}
+}
+
 // toHabitsGRPCError converts application errors to gRPC status errors.
-func toHabitsGRPCError(err error) error {
-	return grpcutil.ToGRPCError(err)
+func toHabitsGRPCError(ctx context.Context, err error) error {
+	return grpcutil.ToGRPCError(ctx, err)
 }
@@ -0,0 +1,24 @@
+package ports
+
+import (
+	"net/http"
+
+	"github.com/semmidev/ethos-go/internal/common/httputil"
+	"github.com/semmidev/ethos-go/internal/habits/app/query"
+)
+
+// PublicStatsHandler serves anonymized platform totals without
+// authentication. Browsers and CDNs may cache the response as long as the
+// server does.
+func PublicStatsHandler(getPublicStats query.GetPublicStatsHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		stats, err := getPublicStats.Handle(r.Context(), query.GetPublicStats{})
+		if err != nil {
+			httputil.Error(w, r, err)
+			return
+		}
+
+		w.Header().Set("Cache-Control", "public, max-age=600")
+		httputil.Success(w, r, stats, "Public stats retrieved successfully")
+	})
+}
@@ -2,7 +2,10 @@ package service
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/semmidev/ethos-go/config"
+	billingsvc "github.com/semmidev/ethos-go/internal/billing/service"
 	"github.com/semmidev/ethos-go/internal/common/database"
 	"github.com/semmidev/ethos-go/internal/common/decorator"
 	"github.com/semmidev/ethos-go/internal/common/events"
@@ -12,12 +15,15 @@ import (
 	"github.com/semmidev/ethos-go/internal/habits/app"
 	"github.com/semmidev/ethos-go/internal/habits/app/command"
 	"github.com/semmidev/ethos-go/internal/habits/app/query"
+	"github.com/semmidev/ethos-go/internal/habits/domain/habit"
+	"github.com/semmidev/ethos-go/internal/habits/domain/habitlog"
 	domaintask "github.com/semmidev/ethos-go/internal/habits/domain/task"
 )
 
 // NewApplication creates and wires all dependencies for the habits module
 func NewApplication(
 	ctx context.Context,
+	cfg *config.Config,
 	db database.DBTX,
 	dispatcher domaintask.TaskDispatcher,
 	eventPublisher events.Publisher, // Added eventPublisher
@@ -28,16 +34,26 @@ func NewApplication(
 	habitRepo := adapters.NewHabitPostgresRepository(db)
 	habitLogRepo := adapters.NewHabitLogPostgresRepository(db)
 	statsRepo := adapters.NewStatsRepository(db)
+	importRepo := adapters.NewImportPostgresRepository(db)
+	dashboards := adapters.NewDashboardProjection(db)
 	validate := validator.New("en")
+	plans := billingsvc.NewPlanGate(db)
 
 	// Create Unit of Work for commands that need transactional consistency
 	habitsUow := adapters.NewHabitsUnitOfWork(db)
 
+	retention, err := habitlog.ParseRetentionPolicy(cfg.HabitLogRetention)
+	if err != nil {
+		panic(fmt.Sprintf("invalid habit log retention: %v", err))
+	}
+
 	// Create command handlers with decorators
 	return app.Application{
 		Commands: app.Commands{
 			CreateHabit: command.NewCreateHabitHandler(
-				habitRepo,
+				habitsUow,
+				plans,
+				habit.ActiveLimit(cfg.HabitMaxActive),
 				validate,
 				dispatcher,
 				eventPublisher,
@@ -53,11 +69,13 @@ func NewApplication(
 			DeleteHabit: command.NewDeleteHabitHandler(
 				habitRepo,
 				validate,
+				eventPublisher,
 				log,
 				metricsClient,
 			),
 			ActivateHabit: command.NewActivateHabitHandler(
 				habitRepo,
+				plans,
 				validate,
 				eventPublisher,
 				log,
@@ -74,18 +92,107 @@ func NewApplication(
 				habitsUow, // Use Unit of Work for transactional consistency
 				validate,
 				eventPublisher,
+				cfg.HabitLogBackdateDays,
+				cfg.HabitLogMaxOvershoot,
 				log,
 				metricsClient,
 			),
 			UpdateHabitLog: command.NewUpdateHabitLogHandler(
 				habitLogRepo,
 				validate,
+				cfg.HabitLogBackdateDays,
 				log,
 				metricsClient,
 			),
 			DeleteHabitLog: command.NewDeleteHabitLogHandler(
 				habitLogRepo,
 				validate,
+				eventPublisher,
+				log,
+				metricsClient,
+			),
+			UndoHabitLog: command.NewUndoHabitLogHandler(
+				habitsUow,
+				validate,
+				eventPublisher,
+				cfg.HabitLogBackdateDays,
+				log,
+				metricsClient,
+			),
+			SetHabitLogCount: command.NewSetHabitLogCountHandler(
+				habitsUow,
+				validate,
+				eventPublisher,
+				cfg.HabitLogBackdateDays,
+				log,
+				metricsClient,
+			),
+			ReorderHabits: command.NewReorderHabitsHandler(
+				habitsUow,
+				validate,
+				log,
+				metricsClient,
+			),
+			PauseHabit: command.NewPauseHabitHandler(
+				habitsUow,
+				validate,
+				eventPublisher,
+				log,
+				metricsClient,
+			),
+			ResumePausedHabits: command.NewResumePausedHabitsHandler(
+				habitRepo,
+				eventPublisher,
+				log,
+				metricsClient,
+			),
+			StartVacation: command.NewStartVacationHandler(
+				habitsUow,
+				validate,
+				log,
+				metricsClient,
+			),
+			EndVacation: command.NewEndVacationHandler(
+				habitRepo,
+				validate,
+				log,
+				metricsClient,
+			),
+			RecomputeStats: command.NewRecomputeStatsHandler(
+				habitsUow,
+				validate,
+				log,
+				metricsClient,
+			),
+			CompactHabitLogs: command.NewCompactHabitLogsHandler(
+				habitsUow,
+				retention,
+				log,
+				metricsClient,
+			),
+			RefreshDashboards: command.NewRefreshDashboardsHandler(
+				dashboards,
+				validate,
+				log,
+				metricsClient,
+			),
+			StartImport: command.NewStartImportHandler(
+				importRepo,
+				habitRepo,
+				plans,
+				validate,
+				dispatcher,
+				log,
+				metricsClient,
+			),
+			RunImport: command.NewRunImportHandler(
+				habitsUow,
+				importRepo,
+				log,
+				metricsClient,
+			),
+			ScheduleReminders: command.NewScheduleRemindersHandler(
+				statsRepo,
 				log,
 				metricsClient,
 			),
@@ -111,8 +218,14 @@ func NewApplication(
 				log,
 				metricsClient,
 			),
-			GetDashboard: query.NewGetDashboardHandler(
+			GetHabitInsights: query.NewGetHabitInsightsHandler(
 				statsRepo,
+				plans,
+				log,
+				metricsClient,
+			),
+			GetDashboard: query.NewGetDashboardHandler(
+				dashboardReadModel(cfg, statsRepo, dashboards),
 				log,
 				metricsClient,
 			),
@@ -121,7 +234,49 @@ func NewApplication(
 				log,
 				metricsClient,
 			),
+			ComparePeriods: query.NewComparePeriodsHandler(
+				statsRepo,
+				plans,
+				log,
+				metricsClient,
+			),
+			GetHabitCorrelations: query.NewGetHabitCorrelationsHandler(
+				statsRepo,
+				plans,
+				log,
+				metricsClient,
+			),
 			GetHabitsDue: query.NewGetHabitsDueHandler(
+				statsRepo,
+				habitRepo,
+				log,
+				metricsClient,
+			),
+			GetDailySummaries: query.NewGetDailySummariesHandler(
+				statsRepo,
+				log,
+				metricsClient,
+			),
+			GetInactiveUsers: query.NewGetInactiveUsersHandler(
+				statsRepo,
+				log,
+				metricsClient,
+			),
+			ListVacations: query.NewListVacationsHandler(
+				habitRepo,
+				log,
+				metricsClient,
+			),
+			PreviewImport: query.NewPreviewImportHandler(
+				log,
+				metricsClient,
+			),
+			GetImport: query.NewGetImportHandler(
+				importRepo,
+				log,
+				metricsClient,
+			),
+			GetPublicStats: query.NewGetPublicStatsHandler(
 				statsRepo,
 				log,
 				metricsClient,
@@ -129,3 +284,13 @@ func NewApplication(
 		},
 	}
 }
+
+// dashboardReadModel serves dashboards from the projection when events
+// reach the worker that refreshes it, and computes them per request
+// otherwise
+func dashboardReadModel(cfg *config.Config, stats *adapters.StatsRepository, projection *adapters.DashboardProjection) query.GetDashboardReadModel {
+	if cfg.NATSUrl == "" {
+		return stats
+	}
+	return projection
+}
@@ -0,0 +1,83 @@
+package service_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/model"
+	"github.com/semmidev/ethos-go/internal/habits/app/command"
+	"github.com/semmidev/ethos-go/internal/habits/app/query"
+)
+
+// TestLogHabitConcurrentFirstLogs races the first logs of a day against
+// Postgres. Without the habit lock they all missed the day's log and the
+// insert added their counts past the daily cap. Like the benchmarks, it
+// is skipped unless ETHOS_BENCH_DSN is set.
+func TestLogHabitConcurrentFirstLogs(t *testing.T) {
+	f := setupBench(t, 0)
+
+	Convey("Given a habit with a daily target of 1 and no overshoot, not logged today", t, func() {
+		ctx := context.Background()
+		today := time.Now().UTC().Truncate(24 * time.Hour)
+		const callers = 20
+
+		Convey("When many first logs of the day arrive at once", func() {
+			var (
+				wg        sync.WaitGroup
+				mu        sync.Mutex
+				succeeded int
+				capped    int
+			)
+			for i := 0; i < callers; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					_, err := f.app.Commands.LogHabit.Handle(ctx, command.LogHabit{
+						LogID:   uuid.NewString(),
+						HabitID: f.habitID,
+						UserID:  f.userID,
+						LogDate: today,
+						Count:   2,
+					})
+
+					mu.Lock()
+					defer mu.Unlock()
+					switch {
+					case err == nil:
+						succeeded++
+					case apperror.GetAppError(err) != nil && apperror.GetAppError(err).Code == apperror.ErrCodeBusinessRuleViolation:
+						capped++
+					default:
+						t.Errorf("log habit: %v", err)
+					}
+				}()
+			}
+			wg.Wait()
+
+			Convey("Then exactly one is logged and the day stays at the cap", func() {
+				So(succeeded, ShouldEqual, 1)
+				So(capped, ShouldEqual, callers-1)
+
+				logs, err := f.app.Queries.GetHabitLogs.Handle(ctx, query.GetHabitLogs{
+					HabitID: f.habitID,
+					UserID:  f.userID,
+					Filter:  model.Filter{CurrentPage: 1, PerPage: 20},
+				})
+				So(err, ShouldBeNil)
+				total := 0
+				for _, l := range logs.Logs {
+					if l.LogDate.Format(time.DateOnly) == today.Format(time.DateOnly) {
+						total += l.Count
+					}
+				}
+				So(total, ShouldEqual, 1)
+			})
+		})
+	})
+}
@@ -5,9 +5,12 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/lib/pq"
+
 	"github.com/semmidev/ethos-go/internal/common/apperror"
 	"github.com/semmidev/ethos-go/internal/common/database"
 	"github.com/semmidev/ethos-go/internal/common/model"
@@ -24,17 +27,17 @@ func NewNotificationPostgresRepository(db database.DBTX) *NotificationPostgresRe
 
 func (r *NotificationPostgresRepository) Create(ctx context.Context, n *domain.Notification) error {
 	query := `
-		INSERT INTO notifications (notification_id, user_id, type, title, message, data, is_read, created_at, read_at)
-		VALUES (:notification_id, :user_id, :type, :title, :message, :data, :is_read, :created_at, :read_at)
+		INSERT INTO notifications (notification_id, user_id, type, title, message, data, is_read, created_at, read_at, group_key, deliver_at)
+		VALUES (:notification_id, :user_id, :type, :title, :message, :data, :is_read, :created_at, :read_at, :group_key, :deliver_at)
 	`
 	_, err := r.db.NamedExecContext(ctx, query, n)
 	return err
 }
 
-func (r *NotificationPostgresRepository) FindByID(ctx context.Context, id string) (*domain.Notification, error) {
+func (r *NotificationPostgresRepository) FindByID(ctx context.Context, id, userID string) (*domain.Notification, error) {
 	var n domain.Notification
-	query := `SELECT * FROM notifications WHERE notification_id = $1`
-	err := r.db.GetContext(ctx, &n, query, id)
+	query := `SELECT * FROM notifications WHERE notification_id = $1 AND user_id = $2`
+	err := r.db.GetContext(ctx, &n, query, id, userID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, apperror.NotFound("notification", id)
@@ -44,96 +47,228 @@ func (r *NotificationPostgresRepository) FindByID(ctx context.Context, id string
 	return &n, nil
 }
 
-func (r *NotificationPostgresRepository) List(ctx context.Context, userID string, filter model.Filter) ([]domain.Notification, *model.Paging, error) {
-	var n []domain.Notification
+func (r *NotificationPostgresRepository) List(ctx context.Context, userID string, filter domain.ListFilter) ([]domain.Notification, *model.Paging, error) {
+	whereClause, args := listConditions(userID, filter)
+
 	var count int
+	countQuery := `SELECT COUNT(*) FROM notifications WHERE ` + whereClause
+	if err := r.db.GetContext(ctx, &count, countQuery, args...); err != nil {
+		return nil, nil, err
+	}
 
-	countQuery := `SELECT COUNT(*) FROM notifications WHERE user_id = $1`
-	args := []interface{}{userID}
+	pagination, err := model.NewPaging(filter.CurrentPage, filter.PerPage, count)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	// Filter by unread status if requested
-	if filter.IsActive != nil && *filter.IsActive {
-		// Assuming IsActive=true means "Unread Only" for this context
-		// This is a temporary mapping until we have specific filter fields
+	query := fmt.Sprintf("SELECT * FROM notifications WHERE %s ORDER BY created_at DESC LIMIT %s OFFSET %d",
+		whereClause, limitClause(filter.Filter), filter.GetOffset())
+
+	var n []domain.Notification
+	if err := r.db.SelectContext(ctx, &n, query, args...); err != nil {
+		return nil, nil, err
 	}
 
-	// Let's build query dynamically
-	var conditions []string
-	conditions = append(conditions, "user_id = $1")
+	return n, pagination, nil
+}
+
+// likeEscaper escapes the LIKE wildcards, so a keyword matches literally
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// listConditions is the WHERE clause, and its arguments, selecting the
+// user's delivered notifications that match filter
+func listConditions(userID string, filter domain.ListFilter) (string, []interface{}) {
+	conditions := []string{"user_id = $1", "deliver_at IS NULL"}
+	args := []interface{}{userID}
+	add := func(condition string, arg interface{}) {
+		args = append(args, arg)
+		conditions = append(conditions, strings.ReplaceAll(condition, "?", "$"+strconv.Itoa(len(args))))
+	}
 
-	// If keyword search
 	if filter.Keyword != "" {
-		conditions = append(conditions, "(title ILIKE $2 OR message ILIKE $2)")
-		args = append(args, "%"+filter.Keyword+"%")
+		// Served by the trigram indexes on title and message
+		add("(title ILIKE ? OR message ILIKE ?)", "%"+likeEscaper.Replace(filter.Keyword)+"%")
+	}
+	if len(filter.Types) > 0 {
+		types := make([]string, len(filter.Types))
+		for i, t := range filter.Types {
+			types[i] = string(t)
+		}
+		add("type = ANY(?)", pq.Array(types))
+	}
+	if filter.Read != nil {
+		add("is_read = ?", *filter.Read)
+	}
+	if filter.StartDate != nil {
+		add("created_at >= ?", *filter.StartDate)
+	}
+	if filter.EndDate != nil {
+		// The end date is inclusive
+		add("created_at < ?", filter.EndDate.AddDate(0, 0, 1))
 	}
+	if filter.HabitID != "" {
+		add("data->>'habit_id' = ?", filter.HabitID)
+	}
+
+	return strings.Join(conditions, " AND "), args
+}
 
-	// Construct WHERE clause
-	whereClause := strings.Join(conditions, " AND ")
+// notificationThread identifies a notification's thread; ungrouped
+// notifications are threads of their own
+const notificationThread = `COALESCE(group_key, notification_id::text)`
 
-	// Final Count Query
-	finalCountQuery := strings.Replace(countQuery, "WHERE user_id = $1", "WHERE "+whereClause, 1)
+func (r *NotificationPostgresRepository) ListGrouped(ctx context.Context, userID string, filter domain.ListFilter) ([]domain.NotificationGroup, *model.Paging, error) {
+	whereClause, args := listConditions(userID, filter)
 
-	// Get total count
-	err := r.db.GetContext(ctx, &count, finalCountQuery, args...)
-	if err != nil {
+	var count int
+	countQuery := `SELECT COUNT(DISTINCT ` + notificationThread + `) FROM notifications WHERE ` + whereClause
+	if err := r.db.GetContext(ctx, &count, countQuery, args...); err != nil {
 		return nil, nil, err
 	}
 
-	// Calculate pagination
 	pagination, err := model.NewPaging(filter.CurrentPage, filter.PerPage, count)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	// Final Select Query
-	offset := filter.GetOffset()
-	query := fmt.Sprintf("SELECT * FROM notifications WHERE %s ORDER BY created_at DESC LIMIT %d OFFSET %d",
-		whereClause, pagination.PerPage, offset)
+	query := fmt.Sprintf(`
+		WITH threads AS (
+			SELECT notifications.*,
+				COUNT(*) OVER thread AS group_count,
+				COUNT(*) FILTER (WHERE NOT is_read) OVER thread AS group_unread_count,
+				ROW_NUMBER() OVER (thread ORDER BY created_at DESC, notification_id DESC) AS position
+			FROM notifications
+			WHERE %s
+			WINDOW thread AS (PARTITION BY %s)
+		)
+		SELECT notification_id, user_id, type, title, message, data, is_read, created_at, read_at, group_key,
+			deliver_at, group_count, group_unread_count
+		FROM threads
+		WHERE position = 1
+		ORDER BY created_at DESC, notification_id DESC
+		LIMIT %s OFFSET %d`,
+		whereClause, notificationThread, limitClause(filter.Filter), filter.GetOffset())
 
-	err = r.db.SelectContext(ctx, &n, query, args...)
-	if err != nil {
+	var groups []domain.NotificationGroup
+	if err := r.db.SelectContext(ctx, &groups, query, args...); err != nil {
 		return nil, nil, err
 	}
 
-	return n, pagination, nil
+	return groups, pagination, nil
 }
 
-// ListUnread is a specific method if needed, or we adapt List above.
-// For explicit control, let's modify List to support custom "unread" logic if needed,
-// but actually, we can just extend Filter struct later.
-// For this MVP, let's add `ListUnread` if strictly needed, but `List` with args is fine.
-// Wait, the interface uses `model.Filter`. Let's stick to that.
+// limitClause is the LIMIT of a page of filter
+func limitClause(filter model.Filter) string {
+	if filter.IsUnlimitedPage() {
+		return "ALL"
+	}
+	return strconv.Itoa(filter.GetLimit())
+}
 
 func (r *NotificationPostgresRepository) Update(ctx context.Context, n *domain.Notification) error {
 	query := `
 		UPDATE notifications SET
 			is_read = :is_read,
-			read_at = :read_at
-		WHERE notification_id = :notification_id
+			read_at = :read_at,
+			deliver_at = :deliver_at
+		WHERE notification_id = :notification_id AND user_id = :user_id
 	`
 	_, err := r.db.NamedExecContext(ctx, query, n)
 	return err
 }
 
-func (r *NotificationPostgresRepository) Delete(ctx context.Context, id string) error {
-	query := `DELETE FROM notifications WHERE notification_id = $1`
-	_, err := r.db.ExecContext(ctx, query, id)
-	return err
+func (r *NotificationPostgresRepository) Delete(ctx context.Context, id, userID string) error {
+	query := `DELETE FROM notifications WHERE notification_id = $1 AND user_id = $2`
+	result, err := r.db.ExecContext(ctx, query, id, userID)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return apperror.NotFound("notification", id)
+	}
+	return nil
 }
 
 func (r *NotificationPostgresRepository) MarkAllAsRead(ctx context.Context, userID string) error {
 	query := `
 		UPDATE notifications
 		SET is_read = true, read_at = $1
-		WHERE user_id = $2 AND is_read = false
+		WHERE user_id = $2 AND is_read = false AND deliver_at IS NULL
 	`
 	_, err := r.db.ExecContext(ctx, query, time.Now(), userID)
 	return err
 }
 
+// ownedNotifications limits a batch statement to the requested IDs the
+// user owns; the statement changes nothing unless they own all of them
+const ownedNotifications = `
+	WITH requested AS (
+		SELECT DISTINCT unnest($2::uuid[]) AS notification_id
+	), owned AS (
+		SELECT n.notification_id FROM notifications n
+		JOIN requested USING (notification_id)
+		WHERE n.user_id = $1
+	)`
+
+const allRequestedOwned = `(SELECT COUNT(*) FROM owned) = (SELECT COUNT(*) FROM requested)`
+
+func (r *NotificationPostgresRepository) MarkAsReadByIDs(ctx context.Context, userID string, ids []string) error {
+	query := ownedNotifications + `
+		UPDATE notifications
+		SET is_read = true, read_at = COALESCE(read_at, $3)
+		WHERE notification_id IN (SELECT notification_id FROM owned) AND ` + allRequestedOwned
+	result, err := r.db.ExecContext(ctx, query, userID, pq.Array(ids), time.Now())
+	if err != nil {
+		return err
+	}
+	return requireBatchApplied(result)
+}
+
+func (r *NotificationPostgresRepository) DeleteByIDs(ctx context.Context, userID string, ids []string) error {
+	query := ownedNotifications + `
+		DELETE FROM notifications
+		WHERE notification_id IN (SELECT notification_id FROM owned) AND ` + allRequestedOwned
+	result, err := r.db.ExecContext(ctx, query, userID, pq.Array(ids))
+	if err != nil {
+		return err
+	}
+	return requireBatchApplied(result)
+}
+
+// requireBatchApplied reports a batch that changed no rows, which happens
+// only when some requested notification is missing or another user's
+func requireBatchApplied(result sql.Result) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return apperror.NotFound("notification", "one or more of the requested notifications")
+	}
+	return nil
+}
+
 func (r *NotificationPostgresRepository) GetUnreadCount(ctx context.Context, userID string) (int, error) {
 	var count int
-	query := `SELECT COUNT(*) FROM notifications WHERE user_id = $1 AND is_read = false`
+	query := `SELECT COUNT(*) FROM notifications WHERE user_id = $1 AND is_read = false AND deliver_at IS NULL`
 	err := r.db.GetContext(ctx, &count, query, userID)
 	return count, err
 }
+
+func (r *NotificationPostgresRepository) DeliverDue(ctx context.Context, now time.Time) (int, error) {
+	query := `
+		UPDATE notifications
+		SET created_at = deliver_at, deliver_at = NULL
+		WHERE deliver_at <= $1
+	`
+	result, err := r.db.ExecContext(ctx, query, now)
+	if err != nil {
+		return 0, err
+	}
+	delivered, err := result.RowsAffected()
+	return int(delivered), err
+}
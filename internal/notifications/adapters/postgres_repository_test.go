@@ -0,0 +1,66 @@
+package adapters_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/notifications/adapters"
+)
+
+func TestBatchNotificationStatements(t *testing.T) {
+	Convey("Given a batch of notification IDs", t, func() {
+		db, mock, err := sqlmock.New()
+		So(err, ShouldBeNil)
+		defer db.Close()
+
+		ctx := context.Background()
+		repo := adapters.NewNotificationPostgresRepository(sqlx.NewDb(db, "sqlmock"))
+		ids := []string{"6f9c1f7e-2d4b-4c5e-9a1b-0c2d3e4f5a6b", "7a0d2e8f-3e5c-4d6f-8b2c-1d3e4f5a6b7c"}
+
+		// Both statements only see the user's own requested notifications,
+		// and change nothing unless that is every requested one
+		owned := `WITH requested AS \(\s+SELECT DISTINCT unnest\(\$2::uuid\[\]\) AS notification_id\s+\), ` +
+			`owned AS \(\s+SELECT n.notification_id FROM notifications n\s+JOIN requested USING \(notification_id\)\s+WHERE n.user_id = \$1\s+\)\s+`
+		allOwned := `WHERE notification_id IN \(SELECT notification_id FROM owned\) AND ` +
+			`\(SELECT COUNT\(\*\) FROM owned\) = \(SELECT COUNT\(\*\) FROM requested\)`
+		markRead := owned + `UPDATE notifications\s+SET is_read = true, read_at = COALESCE\(read_at, \$3\)\s+` + allOwned
+		deleteAll := owned + `DELETE FROM notifications\s+` + allOwned
+
+		Convey("When the user owns all of them", func() {
+			mock.ExpectExec(markRead).
+				WithArgs("user-1", `{"`+ids[0]+`","`+ids[1]+`"}`, sqlmock.AnyArg()).
+				WillReturnResult(sqlmock.NewResult(0, 2))
+			mock.ExpectExec(deleteAll).
+				WithArgs("user-1", `{"`+ids[0]+`","`+ids[1]+`"}`).
+				WillReturnResult(sqlmock.NewResult(0, 2))
+
+			readErr := repo.MarkAsReadByIDs(ctx, "user-1", ids)
+			deleteErr := repo.DeleteByIDs(ctx, "user-1", ids)
+
+			Convey("Then both statements succeed", func() {
+				So(readErr, ShouldBeNil)
+				So(deleteErr, ShouldBeNil)
+				So(mock.ExpectationsWereMet(), ShouldBeNil)
+			})
+		})
+
+		Convey("When any of them is missing or someone else's, so no row changes", func() {
+			mock.ExpectExec(markRead).WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectExec(deleteAll).WillReturnResult(sqlmock.NewResult(0, 0))
+
+			readErr := repo.MarkAsReadByIDs(ctx, "user-1", ids)
+			deleteErr := repo.DeleteByIDs(ctx, "user-1", ids)
+
+			Convey("Then both fail as not found", func() {
+				So(apperror.GetAppError(readErr).Code, ShouldEqual, apperror.ErrCodeNotFound)
+				So(apperror.GetAppError(deleteErr).Code, ShouldEqual, apperror.ErrCodeNotFound)
+				So(mock.ExpectationsWereMet(), ShouldBeNil)
+			})
+		})
+	})
+}
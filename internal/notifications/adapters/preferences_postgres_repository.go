@@ -0,0 +1,115 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/database"
+	"github.com/semmidev/ethos-go/internal/notifications/domain"
+)
+
+type PreferencesPostgresRepository struct {
+	db database.DBTX
+}
+
+func NewPreferencesPostgresRepository(db database.DBTX) *PreferencesPostgresRepository {
+	return &PreferencesPostgresRepository{db: db}
+}
+
+func (r *PreferencesPostgresRepository) GetPreferences(ctx context.Context, userID string) (*domain.Preferences, error) {
+	var p domain.Preferences
+	query := `SELECT * FROM notification_preferences WHERE user_id = $1`
+	err := r.db.GetContext(ctx, &p, query, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.DefaultPreferences(userID), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+func (r *PreferencesPostgresRepository) SavePreferences(ctx context.Context, p *domain.Preferences) error {
+	query := `
+		INSERT INTO notification_preferences (user_id, daily_summary, weekly_report, reengagement, quiet_hours_start, quiet_hours_end,
+			do_not_disturb, do_not_disturb_until, unsubscribe_token, updated_at)
+		VALUES (:user_id, :daily_summary, :weekly_report, :reengagement, :quiet_hours_start, :quiet_hours_end,
+			:do_not_disturb, :do_not_disturb_until, :unsubscribe_token, :updated_at)
+		ON CONFLICT (user_id) DO UPDATE SET
+			daily_summary = EXCLUDED.daily_summary,
+			weekly_report = EXCLUDED.weekly_report,
+			reengagement = EXCLUDED.reengagement,
+			quiet_hours_start = EXCLUDED.quiet_hours_start,
+			quiet_hours_end = EXCLUDED.quiet_hours_end,
+			do_not_disturb = EXCLUDED.do_not_disturb,
+			do_not_disturb_until = EXCLUDED.do_not_disturb_until,
+			unsubscribe_token = EXCLUDED.unsubscribe_token,
+			updated_at = EXCLUDED.updated_at
+	`
+	_, err := r.db.NamedExecContext(ctx, query, p)
+	return err
+}
+
+func (r *PreferencesPostgresRepository) FindByUnsubscribeToken(ctx context.Context, token string) (*domain.Preferences, error) {
+	var p domain.Preferences
+	query := `SELECT * FROM notification_preferences WHERE unsubscribe_token = $1`
+	err := r.db.GetContext(ctx, &p, query, token)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperror.NotFound("unsubscribe token", token)
+		}
+		return nil, err
+	}
+	return &p, nil
+}
+
+func (r *PreferencesPostgresRepository) ListWeeklyReportRecipients(ctx context.Context, weekStart time.Weekday) ([]string, error) {
+	var userIDs []string
+	query := `
+		SELECT u.user_id FROM users u
+		LEFT JOIN notification_preferences p ON p.user_id = u.user_id
+		WHERE u.is_active AND u.is_verified
+		  AND u.week_start = $1
+		  AND COALESCE(p.weekly_report, true)
+		ORDER BY u.user_id
+	`
+	err := r.db.SelectContext(ctx, &userIDs, query, int(weekStart))
+	return userIDs, err
+}
+
+func (r *PreferencesPostgresRepository) ClaimDelivery(ctx context.Context, userID, kind string, date time.Time) (bool, error) {
+	query := `
+		INSERT INTO notification_deliveries (user_id, kind, period_date)
+		VALUES ($1, $2, $3::date)
+		ON CONFLICT DO NOTHING
+	`
+	res, err := r.db.ExecContext(ctx, query, userID, kind, date.Format("2006-01-02"))
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n == 1, nil
+}
+
+func (r *PreferencesPostgresRepository) ClaimReminder(ctx context.Context, habitID string, date time.Time) (bool, error) {
+	query := `
+		INSERT INTO reminder_sends (habit_id, reminder_date)
+		VALUES ($1, $2::date)
+		ON CONFLICT DO NOTHING
+	`
+	res, err := r.db.ExecContext(ctx, query, habitID, date.Format("2006-01-02"))
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n == 1, nil
+}
@@ -0,0 +1,54 @@
+package adapters_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/notifications/adapters"
+)
+
+func TestClaimReminder(t *testing.T) {
+	Convey("Given a habit's reminder for its owner's local date", t, func() {
+		db, mock, err := sqlmock.New()
+		So(err, ShouldBeNil)
+		defer db.Close()
+
+		repo := adapters.NewPreferencesPostgresRepository(sqlx.NewDb(db, "sqlmock"))
+		// Late evening in UTC is already the next day for the owner
+		localDate := time.Date(2026, 10, 18, 0, 0, 0, 0, time.FixedZone("WIB", 7*60*60))
+		claim := `INSERT INTO reminder_sends \(habit_id, reminder_date\)\s+VALUES \(\$1, \$2::date\)\s+ON CONFLICT DO NOTHING`
+
+		Convey("When the first sweep claims it", func() {
+			mock.ExpectExec(claim).
+				WithArgs("habit-1", "2026-10-18").
+				WillReturnResult(sqlmock.NewResult(0, 1))
+
+			claimed, err := repo.ClaimReminder(context.Background(), "habit-1", localDate)
+
+			Convey("Then it is claimed for the owner's date", func() {
+				So(err, ShouldBeNil)
+				So(claimed, ShouldBeTrue)
+				So(mock.ExpectationsWereMet(), ShouldBeNil)
+			})
+		})
+
+		Convey("When a second sweep finds it already sent", func() {
+			mock.ExpectExec(claim).
+				WithArgs("habit-1", "2026-10-18").
+				WillReturnResult(sqlmock.NewResult(0, 0))
+
+			claimed, err := repo.ClaimReminder(context.Background(), "habit-1", localDate)
+
+			Convey("Then it is not claimed again", func() {
+				So(err, ShouldBeNil)
+				So(claimed, ShouldBeFalse)
+				So(mock.ExpectationsWereMet(), ShouldBeNil)
+			})
+		})
+	})
+}
@@ -0,0 +1,47 @@
+package task
+
+import (
+	"context"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/notifications/app/command"
+)
+
+// TaskDeliverScheduled delivers scheduled and snoozed notifications that
+// are due
+const TaskDeliverScheduled = "notifications:deliver_scheduled"
+
+// NewDeliverScheduledTask creates a task to deliver due notifications. It
+// runs every minute, so a failed run is left to the next one.
+func NewDeliverScheduledTask() *asynq.Task {
+	return asynq.NewTask(TaskDeliverScheduled, nil, asynq.MaxRetry(0), asynq.Timeout(time.Minute))
+}
+
+// DeliverScheduledProcessor runs the scheduled notification delivery sweep
+type DeliverScheduledProcessor struct {
+	handler command.DeliverScheduledNotificationsHandler
+	log     logger.Logger
+}
+
+// NewDeliverScheduledProcessor creates a new processor instance with required dependencies.
+func NewDeliverScheduledProcessor(
+	handler command.DeliverScheduledNotificationsHandler,
+	log logger.Logger,
+) *DeliverScheduledProcessor {
+	return &DeliverScheduledProcessor{
+		handler: handler,
+		log:     log,
+	}
+}
+
+// ProcessTask implements the asynq.Handler interface.
+func (p *DeliverScheduledProcessor) ProcessTask(ctx context.Context, t *asynq.Task) error {
+	if err := p.handler.Handle(ctx, command.DeliverScheduledNotifications{Now: time.Now()}); err != nil {
+		p.log.Error(ctx, err, "failed to deliver scheduled notifications")
+		return err
+	}
+
+	return nil
+}
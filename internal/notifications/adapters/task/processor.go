@@ -7,83 +7,208 @@ import (
 	"time"
 
 	"github.com/hibiken/asynq"
+	"github.com/semmidev/ethos-go/internal/common/i18n"
 	"github.com/semmidev/ethos-go/internal/common/logger"
 	habittask "github.com/semmidev/ethos-go/internal/habits/adapters/task"
 	habitsapp "github.com/semmidev/ethos-go/internal/habits/app"
+	habitscommand "github.com/semmidev/ethos-go/internal/habits/app/command"
 	habitsquery "github.com/semmidev/ethos-go/internal/habits/app/query"
 	notifapp "github.com/semmidev/ethos-go/internal/notifications/app"
 	"github.com/semmidev/ethos-go/internal/notifications/app/command"
+	"github.com/semmidev/ethos-go/internal/notifications/app/query"
 	"github.com/semmidev/ethos-go/internal/notifications/domain"
 )
 
 const (
-	TaskProcessReminders = "notifications:process_reminders"
+	TaskProcessReminders   = "notifications:process_reminders"
+	TaskSendDailySummaries = "notifications:send_daily_summaries"
 )
 
+// escalateAfterMissed is how many scheduled days in a row a habit must be
+// missed before its reminder escalates
+const escalateAfterMissed = 3
+
+// dailySummaryHour is the local hour from which a user's day is summarised.
+// Hourly runs until midnight retry users skipped for quiet hours.
+const dailySummaryHour = 21
+
 // TaskProcessor handles processing of notification-related background tasks
 type TaskProcessor struct {
-	notifApp  notifapp.Application
-	habitsApp habitsapp.Application
-	logger    logger.Logger
+	notifApp   notifapp.Application
+	habitsApp  habitsapp.Application
+	deliveries domain.DeliveryRepository
+	logger     logger.Logger
 }
 
 func NewTaskProcessor(
 	notifApp notifapp.Application,
 	habitsApp habitsapp.Application,
+	deliveries domain.DeliveryRepository,
 	logger logger.Logger,
 ) *TaskProcessor {
 	return &TaskProcessor{
-		notifApp:  notifApp,
-		habitsApp: habitsApp,
-		logger:    logger,
+		notifApp:   notifApp,
+		habitsApp:  habitsApp,
+		deliveries: deliveries,
+		logger:     logger,
 	}
 }
 
-// NewProcessRemindersTask creates a task to process reminders
+// NewProcessRemindersTask creates a task to process reminders. It runs
+// every minute, so it is retried once and must finish within the minute;
+// later retries would only send stale reminders.
 func NewProcessRemindersTask() *asynq.Task {
-	return asynq.NewTask(TaskProcessReminders, nil)
+	return asynq.NewTask(TaskProcessReminders, nil, asynq.MaxRetry(1), asynq.Timeout(time.Minute))
+}
+
+// NewSendDailySummariesTask creates a task to send end-of-day summaries
+func NewSendDailySummariesTask() *asynq.Task {
+	return asynq.NewTask(TaskSendDailySummaries, nil)
 }
 
-// ProcessTask implements asynq.Handler for reminders
+// ProcessTask implements asynq.Handler for reminders. Each habit's next
+// reminder is stored in UTC, so it fires once a day even when clocks
+// change; after sending the reminders due now, every one that was due, or
+// never scheduled, is scheduled for its next occurrence.
 func (p *TaskProcessor) ProcessTask(ctx context.Context, t *asynq.Task) error {
-	currentTime := time.Now().Format("15:04")
+	now := time.Now()
 
 	p.logger.Info(ctx, "processing habit reminders task",
-		logger.Field{Key: "current_time", Value: currentTime},
+		logger.Field{Key: "current_time", Value: now.UTC().Format("15:04")},
 	)
 
-	// Get Habits Due - the query already filters correctly:
-	// - At 8 PM: returns habits with matching time OR NULL reminder_time
-	// - At other times: returns only habits with matching reminder_time
-	habits, err := p.habitsApp.Queries.GetHabitsDue.Handle(ctx, habitsquery.GetHabitsDue{})
+	habits, err := p.habitsApp.Queries.GetHabitsDue.Handle(ctx, habitsquery.GetHabitsDue{Now: now})
 	if err != nil {
 		p.logger.Error(ctx, err, "failed to get habits due")
 		return err
 	}
 
+	dnd := make(map[string]bool) // by user, looked up once per run
+
 	count := 0
 	for _, habit := range habits {
-		title := "Habit Reminder"
-		message := fmt.Sprintf("Don't forget to complete '%s' today!", habit.HabitName)
+		silenced, ok := dnd[habit.UserID]
+		if !ok {
+			silenced = p.inDoNotDisturb(ctx, habit.UserID, now)
+			dnd[habit.UserID] = silenced
+		}
+		if silenced {
+			continue
+		}
+
+		// Claimed before sending so an overlapping or restarted sweep
+		// skips the habit; a failed send is not retried the same day
+		claimed, err := p.deliveries.ClaimReminder(ctx, habit.HabitID, habit.LocalDate)
+		if err != nil {
+			p.logger.Error(ctx, err, "failed to claim reminder", logger.Field{Key: "habit_id", Value: habit.HabitID})
+			continue
+		}
+		if !claimed {
+			continue
+		}
+
+		err = p.notifApp.Commands.CreateNotification.Handle(ctx, ReminderNotification(habit))
+		if err != nil {
+			p.logger.Error(ctx, err, "failed to create notification", logger.Field{Key: "user_id", Value: habit.UserID})
+			continue
+		}
+		count++
+	}
+
+	p.logger.Info(ctx, "processed reminders", logger.Field{Key: "count", Value: count})
+
+	if err := p.habitsApp.Commands.ScheduleReminders.Handle(ctx, habitscommand.ScheduleReminders{Now: now}); err != nil {
+		p.logger.Error(ctx, err, "failed to schedule reminders")
+		return err
+	}
+	return nil
+}
 
-		err := p.notifApp.Commands.CreateNotification.Handle(ctx, command.CreateNotification{
+// inDoNotDisturb reports whether the user switched reminders off for now.
+// When preferences cannot be read the reminder is sent.
+func (p *TaskProcessor) inDoNotDisturb(ctx context.Context, userID string, now time.Time) bool {
+	prefs, err := p.notifApp.Queries.GetPreferences.Handle(ctx, query.GetPreferences{UserID: userID})
+	if err != nil {
+		p.logger.Error(ctx, err, "failed to get notification preferences", logger.Field{Key: "user_id", Value: userID})
+		return false
+	}
+	return prefs.InDoNotDisturb(now)
+}
+
+// ReminderNotification builds the reminder for a habit due today. Habits
+// missed escalateAfterMissed scheduled days in a row get an escalated
+// reminder suggesting a lower target instead.
+func ReminderNotification(habit habitsquery.ReminderHabit) command.CreateNotification {
+	if habit.MissedPeriods < escalateAfterMissed {
+		return command.CreateNotification{
 			UserID:  habit.UserID,
 			Type:    domain.TypeHabitReminder,
-			Title:   title,
-			Message: message,
+			Title:   i18n.T(habit.Locale, "Habit Reminder"),
+			Message: i18n.T(habit.Locale, "Don't forget to complete '%s' today!", habit.HabitName),
 			Data: map[string]interface{}{
 				"habit_id": habit.HabitID,
 			},
-		})
+			GroupKey: domain.HabitGroupKey(habit.HabitID),
+		}
+	}
+
+	data := map[string]interface{}{
+		"habit_id":       habit.HabitID,
+		"missed_periods": habit.MissedPeriods,
+	}
+	message := i18n.T(habit.Locale, "You've missed '%s' %d days in a row. Even a small step today counts!",
+		habit.HabitName, habit.MissedPeriods)
+	if habit.TargetCount > 1 {
+		suggested := (habit.TargetCount + 1) / 2
+		data["suggested_target"] = suggested
+		message = i18n.T(habit.Locale, "You've missed '%s' %d days in a row. Try lowering the target to %d for now.",
+			habit.HabitName, habit.MissedPeriods, suggested)
+	}
+
+	return command.CreateNotification{
+		UserID:   habit.UserID,
+		Type:     domain.TypeReminderEscalation,
+		Title:    i18n.T(habit.Locale, "Let's Get Back on Track"),
+		Message:  message,
+		Data:     data,
+		GroupKey: domain.HabitGroupKey(habit.HabitID),
+	}
+}
+
+// ProcessDailySummaryTask sends each user whose evening has started an
+// in-app summary of today's completions. Push delivery is not available
+// since push subscriptions were removed.
+func (p *TaskProcessor) ProcessDailySummaryTask(ctx context.Context, t *asynq.Task) error {
+	now := time.Now()
 
+	summaries, err := p.habitsApp.Queries.GetDailySummaries.Handle(ctx, habitsquery.GetDailySummaries{
+		Now:      now,
+		FromHour: dailySummaryHour,
+	})
+	if err != nil {
+		p.logger.Error(ctx, err, "failed to get daily summaries")
+		return err
+	}
+
+	for _, summary := range summaries {
+		loc, err := time.LoadLocation(summary.Timezone)
 		if err != nil {
-			p.logger.Error(ctx, err, "failed to create notification", logger.Field{Key: "user_id", Value: habit.UserID})
-			continue
+			loc = time.UTC
+		}
+
+		err = p.notifApp.Commands.SendDailySummary.Handle(ctx, command.SendDailySummary{
+			UserID:    summary.UserID,
+			LocalTime: now.In(loc),
+			Completed: summary.Completed,
+			Total:     summary.Total,
+			Locale:    summary.Locale,
+		})
+		if err != nil {
+			p.logger.Error(ctx, err, "failed to send daily summary", logger.Field{Key: "user_id", Value: summary.UserID})
 		}
-		count++
 	}
 
-	p.logger.Info(ctx, "processed reminders", logger.Field{Key: "count", Value: count})
+	p.logger.Info(ctx, "processed daily summaries", logger.Field{Key: "users", Value: len(summaries)})
 	return nil
 }
 
@@ -96,8 +221,8 @@ func (p *TaskProcessor) ProcessHabitCreatedTask(ctx context.Context, t *asynq.Ta
 		return fmt.Errorf("failed to parse task payload: %w", err)
 	}
 
-	title := "New Habit Started!"
-	message := fmt.Sprintf("You've started tracking '%s'. We believe in you!", payload.Name)
+	title := i18n.T(payload.Locale, "New Habit Started!")
+	message := i18n.T(payload.Locale, "You've started tracking '%s'. We believe in you!", payload.Name)
 
 	err := p.notifApp.Commands.CreateNotification.Handle(ctx, command.CreateNotification{
 		UserID:  payload.UserID,
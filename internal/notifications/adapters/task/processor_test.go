@@ -0,0 +1,154 @@
+package task_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/common/dateutil"
+	habitsapp "github.com/semmidev/ethos-go/internal/habits/app"
+	habitscommand "github.com/semmidev/ethos-go/internal/habits/app/command"
+	habitsquery "github.com/semmidev/ethos-go/internal/habits/app/query"
+	"github.com/semmidev/ethos-go/internal/notifications/adapters/task"
+	notifapp "github.com/semmidev/ethos-go/internal/notifications/app"
+	"github.com/semmidev/ethos-go/internal/notifications/app/command"
+	"github.com/semmidev/ethos-go/internal/notifications/app/query"
+	"github.com/semmidev/ethos-go/internal/notifications/domain"
+	"github.com/semmidev/ethos-go/internal/testutil"
+)
+
+func TestReminderNotification(t *testing.T) {
+	Convey("Given a habit due today", t, func() {
+		habit := habitsquery.ReminderHabit{
+			UserID:      "user-1",
+			HabitID:     "habit-1",
+			HabitName:   "Push-ups",
+			TargetCount: 5,
+		}
+
+		Convey("When it was missed only twice in a row", func() {
+			habit.MissedPeriods = 2
+			notif := task.ReminderNotification(habit)
+
+			Convey("Then a regular reminder is sent", func() {
+				So(notif.Type, ShouldEqual, domain.TypeHabitReminder)
+				So(notif.Message, ShouldEqual, "Don't forget to complete 'Push-ups' today!")
+				So(notif.GroupKey, ShouldEqual, "habit:habit-1")
+			})
+		})
+
+		Convey("When it was missed three times in a row", func() {
+			habit.MissedPeriods = 3
+			notif := task.ReminderNotification(habit)
+
+			Convey("Then the reminder escalates and suggests a lower target", func() {
+				So(notif.Type, ShouldEqual, domain.TypeReminderEscalation)
+				So(notif.Message, ShouldContainSubstring, "lowering the target to 3")
+				So(notif.Data["suggested_target"], ShouldEqual, 3)
+				So(notif.Data["missed_periods"], ShouldEqual, 3)
+				So(notif.GroupKey, ShouldEqual, "habit:habit-1")
+			})
+		})
+
+		Convey("When a single-count habit keeps being missed", func() {
+			habit.MissedPeriods = 4
+			habit.TargetCount = 1
+			notif := task.ReminderNotification(habit)
+
+			Convey("Then it escalates without a target suggestion", func() {
+				So(notif.Type, ShouldEqual, domain.TypeReminderEscalation)
+				So(notif.Data, ShouldNotContainKey, "suggested_target")
+			})
+		})
+	})
+}
+
+// dueHabits answers GetHabitsDue with the same habits on every sweep
+type dueHabits struct {
+	habits []habitsquery.ReminderHabit
+}
+
+func (d *dueHabits) Handle(context.Context, habitsquery.GetHabitsDue) ([]habitsquery.ReminderHabit, error) {
+	return d.habits, nil
+}
+
+type noopScheduleReminders struct{}
+
+func (noopScheduleReminders) Handle(context.Context, habitscommand.ScheduleReminders) error {
+	return nil
+}
+
+type defaultPreferences struct{}
+
+func (defaultPreferences) Handle(_ context.Context, q query.GetPreferences) (*domain.Preferences, error) {
+	return domain.DefaultPreferences(q.UserID), nil
+}
+
+// sentNotifications records the notifications created
+type sentNotifications struct {
+	sent []command.CreateNotification
+}
+
+func (s *sentNotifications) Handle(_ context.Context, cmd command.CreateNotification) error {
+	s.sent = append(s.sent, cmd)
+	return nil
+}
+
+// reminderClaims claims each habit's reminder once per date, as the
+// reminder_sends primary key does
+type reminderClaims map[string]bool
+
+func (c reminderClaims) ClaimDelivery(context.Context, string, string, time.Time) (bool, error) {
+	return true, nil
+}
+
+func (c reminderClaims) ClaimReminder(_ context.Context, habitID string, date time.Time) (bool, error) {
+	key := habitID + "/" + dateutil.FormatDate(date)
+	if c[key] {
+		return false, nil
+	}
+	c[key] = true
+	return true, nil
+}
+
+func TestProcessRemindersOncePerDay(t *testing.T) {
+	Convey("Given a habit whose reminder is due on its owner's local date", t, func() {
+		ctx := context.Background()
+		today := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+		due := &dueHabits{habits: []habitsquery.ReminderHabit{
+			{UserID: "user-1", HabitID: "habit-1", HabitName: "Read", TargetCount: 1, Locale: "en", LocalDate: today},
+		}}
+		sent := &sentNotifications{}
+
+		var notifications notifapp.Application
+		notifications.Commands.CreateNotification = sent
+		notifications.Queries.GetPreferences = defaultPreferences{}
+		var habits habitsapp.Application
+		habits.Queries.GetHabitsDue = due
+		habits.Commands.ScheduleReminders = noopScheduleReminders{}
+
+		processor := task.NewTaskProcessor(notifications, habits, reminderClaims{}, testutil.NopLogger{})
+
+		Convey("When the sweep runs twice for the same local date", func() {
+			So(processor.ProcessTask(ctx, task.NewProcessRemindersTask()), ShouldBeNil)
+			So(processor.ProcessTask(ctx, task.NewProcessRemindersTask()), ShouldBeNil)
+
+			Convey("Then the reminder is sent once", func() {
+				So(sent.sent, ShouldHaveLength, 1)
+				So(sent.sent[0].Data["habit_id"], ShouldEqual, "habit-1")
+			})
+		})
+
+		Convey("When the next sweep falls on the owner's next local date", func() {
+			So(processor.ProcessTask(ctx, task.NewProcessRemindersTask()), ShouldBeNil)
+			due.habits[0].LocalDate = today.AddDate(0, 0, 1)
+			So(processor.ProcessTask(ctx, task.NewProcessRemindersTask()), ShouldBeNil)
+
+			Convey("Then that day's reminder is sent too", func() {
+				So(sent.sent, ShouldHaveLength, 2)
+			})
+		})
+	})
+}
@@ -0,0 +1,173 @@
+package task
+
+import (
+	"context"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/semmidev/ethos-go/config"
+	"github.com/semmidev/ethos-go/internal/common/email"
+	"github.com/semmidev/ethos-go/internal/common/i18n"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/common/ports"
+	habitsapp "github.com/semmidev/ethos-go/internal/habits/app"
+	habitsquery "github.com/semmidev/ethos-go/internal/habits/app/query"
+	notifapp "github.com/semmidev/ethos-go/internal/notifications/app"
+	"github.com/semmidev/ethos-go/internal/notifications/app/command"
+	"github.com/semmidev/ethos-go/internal/notifications/domain"
+)
+
+const (
+	TaskSendReengagement = "notifications:send_reengagement"
+)
+
+// ReengagementEmail is the data rendered into the re-engagement email
+type ReengagementEmail struct {
+	Locale         string
+	Name           string
+	From           string
+	AppURL         string
+	UnsubscribeURL string
+	DaysInactive   int
+	HabitName      string
+	Streak         int
+}
+
+// NewSendReengagementTask creates a task to nudge inactive users
+func NewSendReengagementTask() *asynq.Task {
+	return asynq.NewTask(TaskSendReengagement, nil)
+}
+
+// ReengagementProcessor nudges users who stopped logging, at most once a
+// week each, with an in-app notification and an email.
+type ReengagementProcessor struct {
+	notifApp  notifapp.Application
+	habitsApp habitsapp.Application
+	prefs     EmailPreferences
+	users     ports.UserProvider
+	email     email.Email
+	templates *email.Renderer
+	cfg       *config.Config
+	logger    logger.Logger
+}
+
+func NewReengagementProcessor(
+	notifApp notifapp.Application,
+	habitsApp habitsapp.Application,
+	prefs EmailPreferences,
+	users ports.UserProvider,
+	sender email.Email,
+	templates *email.Renderer,
+	cfg *config.Config,
+	logger logger.Logger,
+) *ReengagementProcessor {
+	return &ReengagementProcessor{
+		notifApp:  notifApp,
+		habitsApp: habitsApp,
+		prefs:     prefs,
+		users:     users,
+		email:     sender,
+		templates: templates,
+		cfg:       cfg,
+		logger:    logger,
+	}
+}
+
+// ProcessTask implements asynq.Handler for re-engagement
+func (p *ReengagementProcessor) ProcessTask(ctx context.Context, t *asynq.Task) error {
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	inactive, err := p.habitsApp.Queries.GetInactiveUsers.Handle(ctx, habitsquery.GetInactiveUsers{
+		Since: today.AddDate(0, 0, -p.cfg.ReengagementInactiveDays),
+	})
+	if err != nil {
+		p.logger.Error(ctx, err, "failed to get inactive users")
+		return err
+	}
+
+	count := 0
+	for _, user := range inactive {
+		sent, err := p.nudge(ctx, user, today)
+		if err != nil {
+			p.logger.Error(ctx, err, "failed to send re-engagement", logger.Field{Key: "user_id", Value: user.UserID})
+			continue
+		}
+		if sent {
+			count++
+		}
+	}
+
+	p.logger.Info(ctx, "processed re-engagement", logger.Field{Key: "count", Value: count})
+	return nil
+}
+
+// nudge sends one inactive user their reminder unless they opted out or
+// were already nudged this week.
+func (p *ReengagementProcessor) nudge(ctx context.Context, inactive habitsquery.InactiveUser, today time.Time) (bool, error) {
+	prefs, err := p.prefs.GetPreferences(ctx, inactive.UserID)
+	if err != nil {
+		return false, err
+	}
+	if !prefs.Reengagement {
+		return false, nil
+	}
+
+	user, err := p.users.GetUserByID(ctx, inactive.UserID)
+	if err != nil {
+		return false, err
+	}
+
+	token, err := unsubscribeToken(ctx, p.prefs, inactive.UserID)
+	if err != nil {
+		return false, err
+	}
+
+	data := ReengagementEmail{
+		Locale:         localeOrDefault(user.Locale),
+		Name:           user.Name,
+		From:           p.cfg.AppName,
+		AppURL:         p.cfg.AppClientURL,
+		UnsubscribeURL: unsubscribeURL(p.cfg.AppClientURL, token, domain.DeliveryReengagement),
+		DaysInactive:   int(today.Sub(inactive.LastLogDate).Hours() / 24),
+		HabitName:      inactive.HabitName,
+		Streak:         inactive.Streak,
+	}
+
+	msg, err := p.templates.Render(email.TemplateReengagement, user.Locale, data)
+	if err != nil {
+		return false, err
+	}
+
+	claimed, err := p.prefs.ClaimDelivery(ctx, inactive.UserID, domain.DeliveryReengagement, startOfWeek(today, time.Monday))
+	if err != nil || !claimed {
+		return false, err
+	}
+
+	err = p.notifApp.Commands.CreateNotification.Handle(ctx, command.CreateNotification{
+		UserID:  inactive.UserID,
+		Type:    domain.TypeReengagement,
+		Title:   i18n.T(user.Locale, "We Miss You"),
+		Message: ReengagementMessage(user.Locale, inactive.HabitName, inactive.Streak),
+		Data: map[string]interface{}{
+			"habit_id": inactive.HabitID,
+		},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if err := p.email.Send(ctx, email.TemplateReengagement, user.Email, msg.Subject, msg.HTML, data); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// ReengagementMessage is the in-app nudge, naming the streak when there is one
+func ReengagementMessage(locale, habitName string, streak int) string {
+	if streak > 1 {
+		return i18n.T(locale, "Your %d-day streak on %s is waiting. Log it today to get back on track!", streak, habitName)
+	}
+	return i18n.T(locale, "%s is waiting for you. Log it today to get back on track!", habitName)
+}
@@ -0,0 +1,37 @@
+package task_test
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/notifications/adapters/task"
+)
+
+func TestReengagementMessage(t *testing.T) {
+	Convey("Given an inactive user's best habit", t, func() {
+		Convey("When it had a streak", func() {
+			msg := task.ReengagementMessage("en", "Reading", 12)
+
+			Convey("Then the streak is named", func() {
+				So(msg, ShouldStartWith, "Your 12-day streak on Reading is waiting")
+			})
+		})
+
+		Convey("When it never got past a single day", func() {
+			msg := task.ReengagementMessage("en", "Reading", 1)
+
+			Convey("Then the habit alone is named", func() {
+				So(msg, ShouldStartWith, "Reading is waiting for you")
+			})
+		})
+
+		Convey("When the user reads Indonesian", func() {
+			msg := task.ReengagementMessage("id", "Reading", 12)
+
+			Convey("Then the message is translated", func() {
+				So(msg, ShouldStartWith, "Streak 12 hari Anda pada Reading sedang menunggu")
+			})
+		})
+	})
+}
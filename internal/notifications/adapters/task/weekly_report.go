@@ -0,0 +1,239 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/semmidev/ethos-go/config"
+	"github.com/semmidev/ethos-go/internal/common/email"
+	"github.com/semmidev/ethos-go/internal/common/i18n"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/common/ports"
+	habitsapp "github.com/semmidev/ethos-go/internal/habits/app"
+	habitsquery "github.com/semmidev/ethos-go/internal/habits/app/query"
+	"github.com/semmidev/ethos-go/internal/notifications/domain"
+)
+
+const (
+	TaskSendWeeklyReports = "notifications:send_weekly_reports"
+
+	// weeklyReportListSize caps the best-streak and most-missed lists
+	weeklyReportListSize = 3
+)
+
+// EmailPreferences is the preference and delivery storage scheduled emails need
+type EmailPreferences interface {
+	domain.PreferencesRepository
+	domain.DeliveryRepository
+}
+
+// WeeklyReport is the data rendered into the weekly progress email
+type WeeklyReport struct {
+	Locale            string
+	Name              string
+	From              string
+	UnsubscribeURL    string
+	AverageCompletion int
+	Days              []habitsquery.DailyAnalytics
+	BestStreaks       []ReportEntry
+	MostMissed        []ReportEntry
+}
+
+// ReportEntry is one habit line in a report list
+type ReportEntry struct {
+	Name  string
+	Value int
+}
+
+// NewWeeklyReport builds the report body from the weekly analytics and the
+// dashboard's per-habit stats.
+func NewWeeklyReport(analytics *habitsquery.WeeklyAnalytics, dashboard *habitsquery.DashboardSummary) WeeklyReport {
+	report := WeeklyReport{
+		AverageCompletion: analytics.AverageCompletion,
+		Days:              analytics.Days,
+	}
+
+	habits := make([]habitsquery.HabitStats, len(dashboard.HabitSummaries))
+	copy(habits, dashboard.HabitSummaries)
+
+	sort.SliceStable(habits, func(i, j int) bool {
+		return habits[i].CurrentStreak > habits[j].CurrentStreak
+	})
+	for _, h := range habits {
+		if h.CurrentStreak == 0 || len(report.BestStreaks) == weeklyReportListSize {
+			break
+		}
+		report.BestStreaks = append(report.BestStreaks, ReportEntry{Name: h.HabitName, Value: h.CurrentStreak})
+	}
+
+	// Habits logged on fewer than all seven days, least logged first
+	sort.SliceStable(habits, func(i, j int) bool {
+		return habits[i].ThisWeekCount < habits[j].ThisWeekCount
+	})
+	for _, h := range habits {
+		if h.ThisWeekCount >= 7 || len(report.MostMissed) == weeklyReportListSize {
+			break
+		}
+		report.MostMissed = append(report.MostMissed, ReportEntry{Name: h.HabitName, Value: h.ThisWeekCount})
+	}
+
+	return report
+}
+
+// NewSendWeeklyReportsTask creates a task to email weekly progress reports
+func NewSendWeeklyReportsTask() *asynq.Task {
+	return asynq.NewTask(TaskSendWeeklyReports, nil)
+}
+
+// WeeklyReportProcessor emails each opted-in user a summary of their week
+type WeeklyReportProcessor struct {
+	habitsApp habitsapp.Application
+	prefs     EmailPreferences
+	users     ports.UserProvider
+	email     email.Email
+	templates *email.Renderer
+	cfg       *config.Config
+	logger    logger.Logger
+}
+
+func NewWeeklyReportProcessor(
+	habitsApp habitsapp.Application,
+	prefs EmailPreferences,
+	users ports.UserProvider,
+	sender email.Email,
+	templates *email.Renderer,
+	cfg *config.Config,
+	logger logger.Logger,
+) *WeeklyReportProcessor {
+	return &WeeklyReportProcessor{
+		habitsApp: habitsApp,
+		prefs:     prefs,
+		users:     users,
+		email:     sender,
+		templates: templates,
+		cfg:       cfg,
+		logger:    logger,
+	}
+}
+
+// ProcessTask implements asynq.Handler for weekly reports. It runs daily
+// and reports to the users whose week ends today, i.e. whose next week
+// begins tomorrow.
+func (p *WeeklyReportProcessor) ProcessTask(ctx context.Context, t *asynq.Task) error {
+	now := time.Now()
+	nextWeekStart := now.AddDate(0, 0, 1).Weekday()
+	weekStart := startOfWeek(now, nextWeekStart)
+
+	recipients, err := p.prefs.ListWeeklyReportRecipients(ctx, nextWeekStart)
+	if err != nil {
+		p.logger.Error(ctx, err, "failed to list weekly report recipients")
+		return err
+	}
+
+	count := 0
+	for _, userID := range recipients {
+		sent, err := p.sendReport(ctx, userID, weekStart)
+		if err != nil {
+			p.logger.Error(ctx, err, "failed to send weekly report", logger.Field{Key: "user_id", Value: userID})
+			continue
+		}
+		if sent {
+			count++
+		}
+	}
+
+	p.logger.Info(ctx, "processed weekly reports", logger.Field{Key: "count", Value: count})
+	return nil
+}
+
+// sendReport emails one user's report; users without habits and users
+// already sent this week's report are skipped.
+func (p *WeeklyReportProcessor) sendReport(ctx context.Context, userID string, weekStart time.Time) (bool, error) {
+	dashboard, err := p.habitsApp.Queries.GetDashboard.Handle(ctx, habitsquery.GetDashboard{UserID: userID})
+	if err != nil {
+		return false, err
+	}
+	if len(dashboard.HabitSummaries) == 0 {
+		return false, nil
+	}
+
+	analytics, err := p.habitsApp.Queries.GetWeeklyAnalytics.Handle(ctx, habitsquery.GetWeeklyAnalytics{UserID: userID})
+	if err != nil {
+		return false, err
+	}
+
+	user, err := p.users.GetUserByID(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+
+	token, err := unsubscribeToken(ctx, p.prefs, userID)
+	if err != nil {
+		return false, err
+	}
+
+	report := NewWeeklyReport(analytics, dashboard)
+	report.Locale = localeOrDefault(user.Locale)
+	report.Name = user.Name
+	report.From = p.cfg.AppName
+	report.UnsubscribeURL = unsubscribeURL(p.cfg.AppClientURL, token, domain.DeliveryWeeklyReport)
+
+	msg, err := p.templates.Render(email.TemplateWeeklyReport, user.Locale, report)
+	if err != nil {
+		return false, err
+	}
+
+	claimed, err := p.prefs.ClaimDelivery(ctx, userID, domain.DeliveryWeeklyReport, weekStart)
+	if err != nil || !claimed {
+		return false, err
+	}
+
+	if err := p.email.Send(ctx, email.TemplateWeeklyReport, user.Email, msg.Subject, msg.HTML, report); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// localeOrDefault fills in the default locale for users without one
+func localeOrDefault(locale string) string {
+	if !i18n.IsSupported(locale) {
+		return i18n.DefaultLocale
+	}
+	return locale
+}
+
+// unsubscribeToken returns the user's unsubscribe token, storing a new one
+// the first time.
+func unsubscribeToken(ctx context.Context, prefsRepo domain.PreferencesRepository, userID string) (string, error) {
+	prefs, err := prefsRepo.GetPreferences(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	if prefs.UnsubscribeToken != nil {
+		return *prefs.UnsubscribeToken, nil
+	}
+
+	token := prefs.EnsureUnsubscribeToken()
+	prefs.UpdatedAt = time.Now()
+	if err := prefsRepo.SavePreferences(ctx, prefs); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// unsubscribeURL links to the client page that unsubscribes from kind
+func unsubscribeURL(clientURL, token, kind string) string {
+	return fmt.Sprintf("%s/unsubscribe?token=%s&kind=%s", clientURL, url.QueryEscape(token), kind)
+}
+
+// startOfWeek returns the first day of t's week for weeks beginning on
+// weekStart, which keys weekly deliveries
+func startOfWeek(t time.Time, weekStart time.Weekday) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	return day.AddDate(0, 0, -((int(t.Weekday()) - int(weekStart) + 7) % 7))
+}
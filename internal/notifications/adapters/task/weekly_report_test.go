@@ -0,0 +1,57 @@
+package task_test
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	habitsquery "github.com/semmidev/ethos-go/internal/habits/app/query"
+	"github.com/semmidev/ethos-go/internal/notifications/adapters/task"
+)
+
+func TestNewWeeklyReport(t *testing.T) {
+	Convey("Given a week of analytics and per-habit stats", t, func() {
+		analytics := &habitsquery.WeeklyAnalytics{
+			Days:              []habitsquery.DailyAnalytics{{DayName: "Mon", CompletionPercentage: 50}},
+			AverageCompletion: 64,
+		}
+		dashboard := &habitsquery.DashboardSummary{
+			HabitSummaries: []habitsquery.HabitStats{
+				{HabitName: "Read", CurrentStreak: 4, ThisWeekCount: 7},
+				{HabitName: "Run", CurrentStreak: 0, ThisWeekCount: 1},
+				{HabitName: "Meditate", CurrentStreak: 12, ThisWeekCount: 7},
+				{HabitName: "Journal", CurrentStreak: 2, ThisWeekCount: 3},
+				{HabitName: "Stretch", CurrentStreak: 1, ThisWeekCount: 0},
+			},
+		}
+
+		Convey("When the report is built", func() {
+			report := task.NewWeeklyReport(analytics, dashboard)
+
+			Convey("Then it carries the daily chart data", func() {
+				So(report.AverageCompletion, ShouldEqual, 64)
+				So(report.Days, ShouldResemble, analytics.Days)
+			})
+
+			Convey("Then the three longest running streaks are listed", func() {
+				So(report.BestStreaks, ShouldResemble, []task.ReportEntry{
+					{Name: "Meditate", Value: 12},
+					{Name: "Read", Value: 4},
+					{Name: "Journal", Value: 2},
+				})
+			})
+
+			Convey("Then the least logged habits are listed, skipping perfect ones", func() {
+				So(report.MostMissed, ShouldResemble, []task.ReportEntry{
+					{Name: "Stretch", Value: 0},
+					{Name: "Run", Value: 1},
+					{Name: "Journal", Value: 3},
+				})
+			})
+
+			Convey("Then the dashboard order is left untouched", func() {
+				So(dashboard.HabitSummaries[0].HabitName, ShouldEqual, "Read")
+			})
+		})
+	})
+}
@@ -11,13 +11,21 @@ type Application struct {
 }
 
 type Commands struct {
-	CreateNotification command.CreateNotificationHandler
-	MarkAsRead         command.MarkAsReadHandler
-	MarkAllRead        command.MarkAllReadHandler
-	DeleteNotification command.DeleteNotificationHandler
+	CreateNotification            command.CreateNotificationHandler
+	MarkAsRead                    command.MarkAsReadHandler
+	MarkAllRead                   command.MarkAllReadHandler
+	DeleteNotification            command.DeleteNotificationHandler
+	SnoozeNotification            command.SnoozeNotificationHandler
+	BatchMarkAsRead               command.BatchMarkAsReadHandler
+	BatchDeleteNotifications      command.BatchDeleteNotificationsHandler
+	SendDailySummary              command.SendDailySummaryHandler
+	DeliverScheduledNotifications command.DeliverScheduledNotificationsHandler
+	UpdatePreferences             command.UpdatePreferencesHandler
+	Unsubscribe                   command.UnsubscribeHandler
 }
 
 type Queries struct {
 	ListNotifications query.ListNotificationsHandler
 	GetUnreadCount    query.GetUnreadCountHandler
+	GetPreferences    query.GetPreferencesHandler
 }
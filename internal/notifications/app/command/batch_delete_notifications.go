@@ -0,0 +1,43 @@
+package command
+
+import (
+	"context"
+
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/notifications/domain"
+)
+
+// BatchDeleteNotifications deletes the listed notifications. It fails
+// without deleting anything when any of them is not the user's.
+type BatchDeleteNotifications struct {
+	UserID          string
+	NotificationIDs []string
+}
+
+type BatchDeleteNotificationsHandler decorator.CommandHandler[BatchDeleteNotifications]
+
+type batchDeleteNotificationsHandler struct {
+	repo domain.NotificationRepository
+}
+
+func NewBatchDeleteNotificationsHandler(
+	repo domain.NotificationRepository,
+	log logger.Logger,
+	metricsClient decorator.MetricsClient,
+) BatchDeleteNotificationsHandler {
+	return decorator.ApplyCommandDecorators(
+		batchDeleteNotificationsHandler{repo: repo},
+		log,
+		metricsClient,
+	)
+}
+
+func (h batchDeleteNotificationsHandler) Handle(ctx context.Context, cmd BatchDeleteNotifications) error {
+	ids, err := batchNotificationIDs(cmd.NotificationIDs)
+	if err != nil {
+		return err
+	}
+
+	return h.repo.DeleteByIDs(ctx, cmd.UserID, ids)
+}
@@ -0,0 +1,73 @@
+package command
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/notifications/domain"
+)
+
+// MaxBatchNotifications caps how many notifications one batch request may list
+const MaxBatchNotifications = 100
+
+// BatchMarkAsRead marks the listed notifications as read. It fails without
+// changing anything when any of them is not the user's.
+type BatchMarkAsRead struct {
+	UserID          string
+	NotificationIDs []string
+}
+
+type BatchMarkAsReadHandler decorator.CommandHandler[BatchMarkAsRead]
+
+type batchMarkAsReadHandler struct {
+	repo domain.NotificationRepository
+}
+
+func NewBatchMarkAsReadHandler(
+	repo domain.NotificationRepository,
+	log logger.Logger,
+	metricsClient decorator.MetricsClient,
+) BatchMarkAsReadHandler {
+	return decorator.ApplyCommandDecorators(
+		batchMarkAsReadHandler{repo: repo},
+		log,
+		metricsClient,
+	)
+}
+
+func (h batchMarkAsReadHandler) Handle(ctx context.Context, cmd BatchMarkAsRead) error {
+	ids, err := batchNotificationIDs(cmd.NotificationIDs)
+	if err != nil {
+		return err
+	}
+
+	return h.repo.MarkAsReadByIDs(ctx, cmd.UserID, ids)
+}
+
+// batchNotificationIDs validates the IDs of a batch request and drops
+// duplicates
+func batchNotificationIDs(ids []string) ([]string, error) {
+	if len(ids) == 0 {
+		return nil, apperror.InvalidInput("notification_ids", "at least one notification is required")
+	}
+	if len(ids) > MaxBatchNotifications {
+		return nil, apperror.InvalidInput("notification_ids", "at most 100 notifications can be changed at once")
+	}
+
+	seen := make(map[string]bool, len(ids))
+	unique := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if _, err := uuid.Parse(id); err != nil {
+			return nil, apperror.InvalidInput("notification_ids", "must be notification IDs")
+		}
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique, nil
+}
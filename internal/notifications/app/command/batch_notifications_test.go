@@ -0,0 +1,172 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/notifications/app/command"
+	"github.com/semmidev/ethos-go/internal/notifications/domain"
+	"github.com/semmidev/ethos-go/internal/testutil"
+)
+
+// batchNotificationRepository keeps notifications in memory and changes a
+// batch only when the user owns every listed notification, as the
+// Postgres repository does
+type batchNotificationRepository struct {
+	domain.NotificationRepository
+	notifications map[string]*domain.Notification
+	batches       int
+}
+
+func newBatchNotificationRepository(notifications ...*domain.Notification) *batchNotificationRepository {
+	r := &batchNotificationRepository{notifications: make(map[string]*domain.Notification)}
+	for _, n := range notifications {
+		r.notifications[n.ID] = n
+	}
+	return r
+}
+
+func (r *batchNotificationRepository) owned(userID string, ids []string) bool {
+	r.batches++
+	for _, id := range ids {
+		n, ok := r.notifications[id]
+		if !ok || n.UserID != userID {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *batchNotificationRepository) MarkAsReadByIDs(_ context.Context, userID string, ids []string) error {
+	if !r.owned(userID, ids) {
+		return apperror.NotFound("notification", "one or more of the requested notifications")
+	}
+	for _, id := range ids {
+		r.notifications[id].IsRead = true
+	}
+	return nil
+}
+
+func (r *batchNotificationRepository) DeleteByIDs(_ context.Context, userID string, ids []string) error {
+	if !r.owned(userID, ids) {
+		return apperror.NotFound("notification", "one or more of the requested notifications")
+	}
+	for _, id := range ids {
+		delete(r.notifications, id)
+	}
+	return nil
+}
+
+func newTestNotification(userID string) *domain.Notification {
+	n, err := domain.NewNotification(userID, domain.TypeHabitReminder, "Habit Reminder", "Don't forget!", nil)
+	So(err, ShouldBeNil)
+	return n
+}
+
+// manyIDs returns n notification IDs
+func manyIDs(n int) []string {
+	ids := make([]string, n)
+	for i := range ids {
+		ids[i] = uuid.NewString()
+	}
+	return ids
+}
+
+func TestBatchNotificationHandlers(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given a user's notifications and someone else's", t, func() {
+		ctx := context.Background()
+		owner, other := uuid.NewString(), uuid.NewString()
+		first, second := newTestNotification(owner), newTestNotification(owner)
+		foreign := newTestNotification(other)
+		repo := newBatchNotificationRepository(first, second, foreign)
+
+		markRead := command.NewBatchMarkAsReadHandler(repo, testutil.NopLogger{}, &decorator.NoOpMetricsClient{})
+		deleteAll := command.NewBatchDeleteNotificationsHandler(repo, testutil.NopLogger{}, &decorator.NoOpMetricsClient{})
+
+		Convey("When the owner marks their notifications read, listing one twice", func() {
+			err := markRead.Handle(ctx, command.BatchMarkAsRead{
+				UserID:          owner,
+				NotificationIDs: []string{first.ID, second.ID, first.ID},
+			})
+
+			Convey("Then both are read", func() {
+				So(err, ShouldBeNil)
+				So(first.IsRead, ShouldBeTrue)
+				So(second.IsRead, ShouldBeTrue)
+			})
+		})
+
+		Convey("When the owner lists someone else's notification among their own", func() {
+			ids := []string{first.ID, foreign.ID}
+			readErr := markRead.Handle(ctx, command.BatchMarkAsRead{UserID: owner, NotificationIDs: ids})
+			deleteErr := deleteAll.Handle(ctx, command.BatchDeleteNotifications{UserID: owner, NotificationIDs: ids})
+
+			Convey("Then both batches fail as not found and nothing changes", func() {
+				So(apperror.GetAppError(readErr).Code, ShouldEqual, apperror.ErrCodeNotFound)
+				So(apperror.GetAppError(deleteErr).Code, ShouldEqual, apperror.ErrCodeNotFound)
+				So(first.IsRead, ShouldBeFalse)
+				So(foreign.IsRead, ShouldBeFalse)
+				So(repo.notifications, ShouldContainKey, first.ID)
+				So(repo.notifications, ShouldContainKey, foreign.ID)
+			})
+		})
+
+		Convey("When the owner deletes their notifications", func() {
+			err := deleteAll.Handle(ctx, command.BatchDeleteNotifications{
+				UserID:          owner,
+				NotificationIDs: []string{first.ID, second.ID},
+			})
+
+			Convey("Then only theirs are gone", func() {
+				So(err, ShouldBeNil)
+				So(repo.notifications, ShouldHaveLength, 1)
+				So(repo.notifications, ShouldContainKey, foreign.ID)
+			})
+		})
+
+		Convey("When a batch lists more than 100 notifications", func() {
+			ids := manyIDs(command.MaxBatchNotifications + 1)
+			readErr := markRead.Handle(ctx, command.BatchMarkAsRead{UserID: owner, NotificationIDs: ids})
+			deleteErr := deleteAll.Handle(ctx, command.BatchDeleteNotifications{UserID: owner, NotificationIDs: ids})
+
+			Convey("Then it is rejected before reaching the repository", func() {
+				So(apperror.GetAppError(readErr).Code, ShouldEqual, apperror.ErrCodeInvalidInput)
+				So(apperror.GetAppError(deleteErr).Code, ShouldEqual, apperror.ErrCodeInvalidInput)
+				So(repo.batches, ShouldEqual, 0)
+			})
+		})
+
+		Convey("When a batch lists exactly 100 notifications", func() {
+			err := markRead.Handle(ctx, command.BatchMarkAsRead{
+				UserID:          owner,
+				NotificationIDs: manyIDs(command.MaxBatchNotifications),
+			})
+
+			Convey("Then it reaches the repository", func() {
+				So(apperror.GetAppError(err).Code, ShouldEqual, apperror.ErrCodeNotFound)
+				So(repo.batches, ShouldEqual, 1)
+			})
+		})
+
+		Convey("When a batch is empty or lists something other than IDs", func() {
+			emptyErr := markRead.Handle(ctx, command.BatchMarkAsRead{UserID: owner})
+			invalidErr := deleteAll.Handle(ctx, command.BatchDeleteNotifications{
+				UserID:          owner,
+				NotificationIDs: []string{first.ID, "not-an-id"},
+			})
+
+			Convey("Then it is rejected as invalid input", func() {
+				So(apperror.GetAppError(emptyErr).Code, ShouldEqual, apperror.ErrCodeInvalidInput)
+				So(apperror.GetAppError(invalidErr).Code, ShouldEqual, apperror.ErrCodeInvalidInput)
+				So(repo.batches, ShouldEqual, 0)
+			})
+		})
+	})
+}
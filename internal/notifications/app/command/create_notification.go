@@ -2,7 +2,9 @@ package command
 
 import (
 	"context"
+	"time"
 
+	"github.com/semmidev/ethos-go/internal/common/apperror"
 	"github.com/semmidev/ethos-go/internal/common/decorator"
 	"github.com/semmidev/ethos-go/internal/common/logger"
 	"github.com/semmidev/ethos-go/internal/notifications/domain"
@@ -14,6 +16,10 @@ type CreateNotification struct {
 	Title   string
 	Message string
 	Data    map[string]interface{}
+	// GroupKey threads the notification with others sharing the key
+	GroupKey string
+	// DeliverAt schedules the notification for later instead of now
+	DeliverAt *time.Time
 }
 
 type CreateNotificationHandler decorator.CommandHandler[CreateNotification]
@@ -43,6 +49,14 @@ func (h createNotificationHandler) Handle(ctx context.Context, cmd CreateNotific
 	if err != nil {
 		return err
 	}
+	if cmd.GroupKey != "" {
+		notif.GroupKey = &cmd.GroupKey
+	}
+	if cmd.DeliverAt != nil {
+		if err := notif.Schedule(*cmd.DeliverAt, time.Now()); err != nil {
+			return apperror.InvalidInput("deliver_at", err.Error())
+		}
+	}
 	if err := h.repo.Create(ctx, notif); err != nil {
 		return err
 	}
@@ -3,7 +3,6 @@ package command
 import (
 	"context"
 
-	"github.com/semmidev/ethos-go/internal/common/apperror"
 	"github.com/semmidev/ethos-go/internal/common/decorator"
 	"github.com/semmidev/ethos-go/internal/common/logger"
 	"github.com/semmidev/ethos-go/internal/notifications/domain"
@@ -33,14 +32,5 @@ func NewDeleteNotificationHandler(
 }
 
 func (h deleteNotificationHandler) Handle(ctx context.Context, cmd DeleteNotification) error {
-	notif, err := h.repo.FindByID(ctx, cmd.NotificationID)
-	if err != nil {
-		return err
-	}
-
-	if notif.UserID != cmd.UserID {
-		return apperror.Unauthorized("notification does not belong to user")
-	}
-
-	return h.repo.Delete(ctx, cmd.NotificationID)
+	return h.repo.Delete(ctx, cmd.NotificationID, cmd.UserID)
 }
@@ -0,0 +1,50 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/notifications/domain"
+)
+
+// DeliverScheduledNotifications delivers the scheduled and snoozed
+// notifications that are due by Now
+type DeliverScheduledNotifications struct {
+	Now time.Time
+}
+
+type DeliverScheduledNotificationsHandler decorator.CommandHandler[DeliverScheduledNotifications]
+
+type deliverScheduledNotificationsHandler struct {
+	repo domain.NotificationRepository
+	log  logger.Logger
+}
+
+func NewDeliverScheduledNotificationsHandler(
+	repo domain.NotificationRepository,
+	log logger.Logger,
+	metricsClient decorator.MetricsClient,
+) DeliverScheduledNotificationsHandler {
+	return decorator.ApplyCommandDecorators(
+		deliverScheduledNotificationsHandler{
+			repo: repo,
+			log:  log,
+		},
+		log,
+		metricsClient,
+	)
+}
+
+func (h deliverScheduledNotificationsHandler) Handle(ctx context.Context, cmd DeliverScheduledNotifications) error {
+	delivered, err := h.repo.DeliverDue(ctx, cmd.Now)
+	if err != nil {
+		return err
+	}
+
+	if delivered > 0 {
+		h.log.Info(ctx, "delivered scheduled notifications", logger.Field{Key: "count", Value: delivered})
+	}
+	return nil
+}
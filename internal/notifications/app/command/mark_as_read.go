@@ -3,7 +3,6 @@ package command
 import (
 	"context"
 
-	"github.com/semmidev/ethos-go/internal/common/apperror"
 	"github.com/semmidev/ethos-go/internal/common/decorator"
 	"github.com/semmidev/ethos-go/internal/common/logger"
 	"github.com/semmidev/ethos-go/internal/notifications/domain"
@@ -33,15 +32,11 @@ func NewMarkAsReadHandler(
 }
 
 func (h markAsReadHandler) Handle(ctx context.Context, cmd MarkAsRead) error {
-	notif, err := h.repo.FindByID(ctx, cmd.NotificationID)
+	notif, err := h.repo.FindByID(ctx, cmd.NotificationID, cmd.UserID)
 	if err != nil {
 		return err
 	}
 
-	if notif.UserID != cmd.UserID {
-		return apperror.Unauthorized("notification does not belong to user")
-	}
-
 	notif.MarkAsRead()
 
 	return h.repo.Update(ctx, notif)
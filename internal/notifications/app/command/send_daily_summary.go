@@ -0,0 +1,103 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/semmidev/ethos-go/internal/common/dateutil"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/i18n"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/notifications/domain"
+)
+
+// SendDailySummary notifies a user how many of today's habits they completed.
+// LocalTime is the current time in the user's timezone; its date is the day
+// summarised.
+type SendDailySummary struct {
+	UserID    string
+	LocalTime time.Time
+	Completed int
+	Total     int
+	Locale    string
+}
+
+type SendDailySummaryHandler decorator.CommandHandler[SendDailySummary]
+
+type sendDailySummaryHandler struct {
+	repo       domain.NotificationRepository
+	prefs      domain.PreferencesRepository
+	deliveries domain.DeliveryRepository
+	log        logger.Logger
+}
+
+func NewSendDailySummaryHandler(
+	repo domain.NotificationRepository,
+	prefs domain.PreferencesRepository,
+	deliveries domain.DeliveryRepository,
+	log logger.Logger,
+	metricsClient decorator.MetricsClient,
+) SendDailySummaryHandler {
+	return decorator.ApplyCommandDecorators(
+		sendDailySummaryHandler{
+			repo:       repo,
+			prefs:      prefs,
+			deliveries: deliveries,
+			log:        log,
+		},
+		log,
+		metricsClient,
+	)
+}
+
+func (h sendDailySummaryHandler) Handle(ctx context.Context, cmd SendDailySummary) error {
+	if cmd.Total == 0 {
+		return nil
+	}
+
+	prefs, err := h.prefs.GetPreferences(ctx, cmd.UserID)
+	if err != nil {
+		return err
+	}
+
+	// Skipped during quiet hours; a later run the same evening picks it up
+	if !prefs.DailySummary || prefs.InQuietHours(cmd.LocalTime) {
+		return nil
+	}
+
+	claimed, err := h.deliveries.ClaimDelivery(ctx, cmd.UserID, domain.DeliveryDailySummary, cmd.LocalTime)
+	if err != nil {
+		return err
+	}
+	if !claimed {
+		return nil
+	}
+
+	notif, err := domain.NewNotification(
+		cmd.UserID,
+		domain.TypeDailySummary,
+		i18n.T(cmd.Locale, "Daily Summary"),
+		dailySummaryMessage(cmd.Locale, cmd.Completed, cmd.Total),
+		map[string]interface{}{
+			"date":      dateutil.FormatDate(cmd.LocalTime),
+			"completed": cmd.Completed,
+			"total":     cmd.Total,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return h.repo.Create(ctx, notif)
+}
+
+func dailySummaryMessage(locale string, completed, total int) string {
+	switch {
+	case completed >= total:
+		return i18n.T(locale, "You completed all %d habits today. Great work!", total)
+	case completed == 0:
+		return i18n.T(locale, "You completed 0/%d habits today. Tomorrow is a fresh start!", total)
+	default:
+		return i18n.T(locale, "You completed %d/%d habits today.", completed, total)
+	}
+}
@@ -0,0 +1,50 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/notifications/domain"
+)
+
+// SnoozeNotification hides a notification until Until, when it is
+// delivered again as unread
+type SnoozeNotification struct {
+	NotificationID string
+	UserID         string
+	Until          time.Time
+}
+
+type SnoozeNotificationHandler decorator.CommandHandler[SnoozeNotification]
+
+type snoozeNotificationHandler struct {
+	repo domain.NotificationRepository
+}
+
+func NewSnoozeNotificationHandler(
+	repo domain.NotificationRepository,
+	log logger.Logger,
+	metricsClient decorator.MetricsClient,
+) SnoozeNotificationHandler {
+	return decorator.ApplyCommandDecorators(
+		snoozeNotificationHandler{repo: repo},
+		log,
+		metricsClient,
+	)
+}
+
+func (h snoozeNotificationHandler) Handle(ctx context.Context, cmd SnoozeNotification) error {
+	notif, err := h.repo.FindByID(ctx, cmd.NotificationID, cmd.UserID)
+	if err != nil {
+		return err
+	}
+
+	if err := notif.Schedule(cmd.Until, time.Now()); err != nil {
+		return apperror.InvalidInput("until", err.Error())
+	}
+
+	return h.repo.Update(ctx, notif)
+}
@@ -0,0 +1,55 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/notifications/domain"
+)
+
+// Unsubscribe turns off one kind of email for the owner of an email
+// unsubscribe token, without requiring them to sign in. Kind defaults to
+// the weekly report, which older links do not name.
+type Unsubscribe struct {
+	Token string
+	Kind  string
+}
+
+type UnsubscribeHandler decorator.CommandHandler[Unsubscribe]
+
+type unsubscribeHandler struct {
+	prefs domain.PreferencesRepository
+}
+
+func NewUnsubscribeHandler(
+	prefs domain.PreferencesRepository,
+	log logger.Logger,
+	metricsClient decorator.MetricsClient,
+) UnsubscribeHandler {
+	return decorator.ApplyCommandDecorators(
+		unsubscribeHandler{prefs: prefs},
+		log,
+		metricsClient,
+	)
+}
+
+func (h unsubscribeHandler) Handle(ctx context.Context, cmd Unsubscribe) error {
+	prefs, err := h.prefs.FindByUnsubscribeToken(ctx, cmd.Token)
+	if err != nil {
+		return err
+	}
+
+	kind := cmd.Kind
+	if kind == "" {
+		kind = domain.DeliveryWeeklyReport
+	}
+	if err := prefs.Unsubscribe(kind); err != nil {
+		return apperror.InvalidInput("kind", err.Error())
+	}
+	prefs.UpdatedAt = time.Now()
+
+	return h.prefs.SavePreferences(ctx, prefs)
+}
@@ -0,0 +1,89 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/notifications/domain"
+)
+
+// UpdatePreferences changes the given preferences; nil fields are kept.
+// Empty quiet hours clear them. DoNotDisturbUntil only applies when
+// DoNotDisturb is switched on.
+type UpdatePreferences struct {
+	UserID            string
+	DailySummary      *bool
+	WeeklyReport      *bool
+	Reengagement      *bool
+	QuietHoursStart   *string
+	QuietHoursEnd     *string
+	DoNotDisturb      *bool
+	DoNotDisturbUntil *time.Time
+}
+
+type UpdatePreferencesHandler decorator.CommandHandler[UpdatePreferences]
+
+type updatePreferencesHandler struct {
+	prefs domain.PreferencesRepository
+}
+
+func NewUpdatePreferencesHandler(
+	prefs domain.PreferencesRepository,
+	log logger.Logger,
+	metricsClient decorator.MetricsClient,
+) UpdatePreferencesHandler {
+	return decorator.ApplyCommandDecorators(
+		updatePreferencesHandler{prefs: prefs},
+		log,
+		metricsClient,
+	)
+}
+
+func (h updatePreferencesHandler) Handle(ctx context.Context, cmd UpdatePreferences) error {
+	prefs, err := h.prefs.GetPreferences(ctx, cmd.UserID)
+	if err != nil {
+		return err
+	}
+
+	if cmd.DailySummary != nil {
+		prefs.DailySummary = *cmd.DailySummary
+	}
+	if cmd.WeeklyReport != nil {
+		prefs.WeeklyReport = *cmd.WeeklyReport
+	}
+	if cmd.Reengagement != nil {
+		prefs.Reengagement = *cmd.Reengagement
+	}
+
+	if cmd.QuietHoursStart != nil || cmd.QuietHoursEnd != nil {
+		start, end := valueOr(cmd.QuietHoursStart, prefs.QuietHoursStart), valueOr(cmd.QuietHoursEnd, prefs.QuietHoursEnd)
+		if err := prefs.SetQuietHours(start, end); err != nil {
+			return apperror.InvalidInput("quiet_hours", err.Error())
+		}
+	}
+
+	now := time.Now()
+	if cmd.DoNotDisturb != nil {
+		if err := prefs.SetDoNotDisturb(*cmd.DoNotDisturb, cmd.DoNotDisturbUntil, now); err != nil {
+			return apperror.InvalidInput("do_not_disturb_until", err.Error())
+		}
+	}
+
+	prefs.UpdatedAt = now
+
+	return h.prefs.SavePreferences(ctx, prefs)
+}
+
+// valueOr returns the requested value, falling back to the current one
+func valueOr(requested, current *string) string {
+	if requested != nil {
+		return *requested
+	}
+	if current != nil {
+		return *current
+	}
+	return ""
+}
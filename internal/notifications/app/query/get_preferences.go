@@ -0,0 +1,35 @@
+package query
+
+import (
+	"context"
+
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/notifications/domain"
+)
+
+type GetPreferences struct {
+	UserID string
+}
+
+type GetPreferencesHandler decorator.QueryHandler[GetPreferences, *domain.Preferences]
+
+type getPreferencesHandler struct {
+	prefs domain.PreferencesRepository
+}
+
+func NewGetPreferencesHandler(
+	prefs domain.PreferencesRepository,
+	log logger.Logger,
+	metricsClient decorator.MetricsClient,
+) GetPreferencesHandler {
+	return decorator.ApplyQueryDecorators(
+		getPreferencesHandler{prefs: prefs},
+		log,
+		metricsClient,
+	)
+}
+
+func (h getPreferencesHandler) Handle(ctx context.Context, q GetPreferences) (*domain.Preferences, error) {
+	return h.prefs.GetPreferences(ctx, q.UserID)
+}
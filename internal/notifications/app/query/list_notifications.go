@@ -2,21 +2,35 @@ package query
 
 import (
 	"context"
+	"fmt"
+	"unicode/utf8"
 
+	"github.com/google/uuid"
+
+	"github.com/semmidev/ethos-go/internal/common/apperror"
 	"github.com/semmidev/ethos-go/internal/common/decorator"
 	"github.com/semmidev/ethos-go/internal/common/logger"
 	"github.com/semmidev/ethos-go/internal/common/model"
 	"github.com/semmidev/ethos-go/internal/notifications/domain"
 )
 
+// maxKeywordLength is the longest text notifications can be searched for
+const maxKeywordLength = 100
+
 type ListNotifications struct {
 	UserID string
-	Filter model.Filter
+	Filter domain.ListFilter
+	// Grouped lists threads instead of single notifications. The filter
+	// applies to the notifications, so a thread only counts the ones that
+	// match it.
+	Grouped bool
 }
 
 type ListNotificationsResult struct {
-	Notifications []domain.Notification `json:"notifications"`
-	Pagination    *model.Paging         `json:"pagination"`
+	Notifications []domain.Notification `json:"notifications,omitempty"`
+	// Groups is set instead of Notifications for grouped listings
+	Groups     []domain.NotificationGroup `json:"groups,omitempty"`
+	Pagination *model.Paging              `json:"pagination"`
 }
 
 type ListNotificationsHandler decorator.QueryHandler[ListNotifications, *ListNotificationsResult]
@@ -38,6 +52,20 @@ func NewListNotificationsHandler(
 }
 
 func (h listNotificationsHandler) Handle(ctx context.Context, q ListNotifications) (*ListNotificationsResult, error) {
+	// Validate filter
+	q.Filter.Validate()
+	if err := validateListFilter(q.Filter); err != nil {
+		return nil, err
+	}
+
+	if q.Grouped {
+		groups, paging, err := h.repo.ListGrouped(ctx, q.UserID, q.Filter)
+		if err != nil {
+			return nil, err
+		}
+		return &ListNotificationsResult{Groups: groups, Pagination: paging}, nil
+	}
+
 	notifs, paging, err := h.repo.List(ctx, q.UserID, q.Filter)
 	if err != nil {
 		return nil, err
@@ -48,3 +76,23 @@ func (h listNotificationsHandler) Handle(ctx context.Context, q ListNotification
 		Pagination:    paging,
 	}, nil
 }
+
+func validateListFilter(filter domain.ListFilter) error {
+	if utf8.RuneCountInString(filter.Keyword) > maxKeywordLength {
+		return apperror.InvalidInput("keyword", fmt.Sprintf("must be at most %d characters", maxKeywordLength))
+	}
+	for _, t := range filter.Types {
+		if !t.IsValid() {
+			return apperror.InvalidInput("type", "unknown notification type "+string(t))
+		}
+	}
+	if filter.HabitID != "" {
+		if _, err := uuid.Parse(filter.HabitID); err != nil {
+			return apperror.InvalidInput("habit_id", "invalid UUID format").WithError(err)
+		}
+	}
+	if filter.StartDate != nil && filter.EndDate != nil && filter.EndDate.Before(*filter.StartDate) {
+		return apperror.InvalidInput("end_date", "must not be before start_date")
+	}
+	return nil
+}
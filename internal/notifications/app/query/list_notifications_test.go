@@ -0,0 +1,113 @@
+package query_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/common/apperror"
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/model"
+	"github.com/semmidev/ethos-go/internal/notifications/app/query"
+	"github.com/semmidev/ethos-go/internal/notifications/domain"
+	"github.com/semmidev/ethos-go/internal/testutil"
+)
+
+// notificationRepository records the filter it was asked to list
+// notifications with
+type notificationRepository struct {
+	domain.NotificationRepository
+	filter *domain.ListFilter
+}
+
+func (r notificationRepository) List(_ context.Context, _ string, filter domain.ListFilter) ([]domain.Notification, *model.Paging, error) {
+	*r.filter = filter
+	return nil, &model.Paging{}, nil
+}
+
+func TestListNotificationsHandler(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given a notifications list", t, func() {
+		var listed domain.ListFilter
+		handler := query.NewListNotificationsHandler(notificationRepository{filter: &listed}, testutil.NopLogger{}, &decorator.NoOpMetricsClient{})
+		list := func(filter domain.ListFilter) error {
+			_, err := handler.Handle(context.Background(), query.ListNotifications{UserID: "user", Filter: filter})
+			return err
+		}
+		invalidField := func(err error) string {
+			appErr := apperror.GetAppError(err)
+			So(appErr, ShouldNotBeNil)
+			So(appErr.Code, ShouldEqual, apperror.ErrCodeInvalidInput)
+			return appErr.Details["field"].(string)
+		}
+
+		Convey("When it is filtered by known types, read state and a habit", func() {
+			unread := false
+			err := list(domain.ListFilter{
+				Types:   []domain.NotificationType{domain.TypeHabitReminder, domain.TypeReminderEscalation},
+				Read:    &unread,
+				HabitID: "0b6e3f4c-3c1e-4f2a-9a55-6f1f3f6c2d10",
+			})
+
+			Convey("Then the filter reaches the repository", func() {
+				So(err, ShouldBeNil)
+				So(listed.Types, ShouldHaveLength, 2)
+				So(*listed.Read, ShouldBeFalse)
+				So(listed.HabitID, ShouldEqual, "0b6e3f4c-3c1e-4f2a-9a55-6f1f3f6c2d10")
+			})
+		})
+
+		Convey("When it is filtered by an unknown type", func() {
+			err := list(domain.ListFilter{Types: []domain.NotificationType{"newsletter"}})
+
+			Convey("Then it is rejected as invalid input", func() {
+				So(invalidField(err), ShouldEqual, "type")
+			})
+		})
+
+		Convey("When it is filtered by a habit ID that is not a UUID", func() {
+			err := list(domain.ListFilter{HabitID: "1 OR 1=1"})
+
+			Convey("Then it is rejected as invalid input", func() {
+				So(invalidField(err), ShouldEqual, "habit_id")
+			})
+		})
+
+		Convey("When it is searched for a keyword", func() {
+			filter := domain.ListFilter{}
+			filter.Keyword = "streak"
+			err := list(filter)
+
+			Convey("Then the keyword reaches the repository", func() {
+				So(err, ShouldBeNil)
+				So(listed.Keyword, ShouldEqual, "streak")
+			})
+		})
+
+		Convey("When it is searched for an overly long keyword", func() {
+			filter := domain.ListFilter{}
+			filter.Keyword = strings.Repeat("a", 101)
+			err := list(filter)
+
+			Convey("Then it is rejected as invalid input", func() {
+				So(invalidField(err), ShouldEqual, "keyword")
+			})
+		})
+
+		Convey("When the date range ends before it starts", func() {
+			start := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+			end := start.AddDate(0, 0, -1)
+			filter := domain.ListFilter{}
+			filter.StartDate, filter.EndDate = &start, &end
+			err := list(filter)
+
+			Convey("Then it is rejected as invalid input", func() {
+				So(invalidField(err), ShouldEqual, "end_date")
+			})
+		})
+	})
+}
@@ -2,6 +2,8 @@ package domain
 
 import (
 	"encoding/json"
+	"errors"
+	"slices"
 	"time"
 
 	"github.com/semmidev/ethos-go/internal/common/random"
@@ -15,8 +17,35 @@ const (
 	TypeAchievement     NotificationType = "achievement"
 	TypeSystem          NotificationType = "system"
 	TypeWelcome         NotificationType = "welcome"
+	TypeDailySummary    NotificationType = "daily_summary"
+	TypeReengagement    NotificationType = "reengagement"
+	// TypeReminderEscalation replaces the reminder of a repeatedly missed habit
+	TypeReminderEscalation NotificationType = "reminder_escalation"
 )
 
+// NotificationTypes are all the types a notification can have
+var NotificationTypes = []NotificationType{
+	TypeStreakMilestone,
+	TypeHabitReminder,
+	TypeAchievement,
+	TypeSystem,
+	TypeWelcome,
+	TypeDailySummary,
+	TypeReengagement,
+	TypeReminderEscalation,
+}
+
+// IsValid reports whether t is one of NotificationTypes
+func (t NotificationType) IsValid() bool {
+	return slices.Contains(NotificationTypes, t)
+}
+
+// MaxDeliveryDelay is how far ahead a notification can be scheduled or
+// snoozed
+const MaxDeliveryDelay = 30 * 24 * time.Hour
+
+var ErrInvalidDeliverAt = errors.New("delivery time must be in the future and at most 30 days ahead")
+
 type Notification struct {
 	ID        string           `db:"notification_id" json:"id"`
 	UserID    string           `db:"user_id" json:"user_id"`
@@ -27,6 +56,25 @@ type Notification struct {
 	IsRead    bool             `db:"is_read" json:"is_read"`
 	CreatedAt time.Time        `db:"created_at" json:"created_at"`
 	ReadAt    *time.Time       `db:"read_at" json:"read_at"`
+	// GroupKey threads related notifications, such as the reminders of one
+	// habit; nil leaves the notification on its own
+	GroupKey *string `db:"group_key" json:"group_key,omitempty"`
+	// DeliverAt is set while the notification is pending: it is left out of
+	// the inbox until delivered at that time
+	DeliverAt *time.Time `db:"deliver_at" json:"deliver_at,omitempty"`
+}
+
+// NotificationGroup is a thread of notifications sharing a group key,
+// represented by its latest notification
+type NotificationGroup struct {
+	Notification
+	Count       int `db:"group_count" json:"group_count"`
+	UnreadCount int `db:"group_unread_count" json:"group_unread_count"`
+}
+
+// HabitGroupKey is the group key of the notifications about one habit
+func HabitGroupKey(habitID string) string {
+	return "habit:" + habitID
 }
 
 func NewNotification(userID string, notifType NotificationType, title, message string, data map[string]interface{}) (*Notification, error) {
@@ -47,6 +95,23 @@ func NewNotification(userID string, notifType NotificationType, title, message s
 	}, nil
 }
 
+// Schedule holds the notification back until deliverAt, when it is
+// delivered again as unread
+func (n *Notification) Schedule(deliverAt, now time.Time) error {
+	if !deliverAt.After(now) || deliverAt.Sub(now) > MaxDeliveryDelay {
+		return ErrInvalidDeliverAt
+	}
+	n.DeliverAt = &deliverAt
+	n.IsRead = false
+	n.ReadAt = nil
+	return nil
+}
+
+// IsPending reports whether the notification awaits delivery
+func (n *Notification) IsPending() bool {
+	return n.DeliverAt != nil
+}
+
 func (n *Notification) MarkAsRead() {
 	now := time.Now()
 	n.IsRead = true
@@ -0,0 +1,41 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/notifications/domain"
+)
+
+func TestNotificationSchedule(t *testing.T) {
+	now := time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)
+
+	Convey("Given a notification that was read", t, func() {
+		notif, err := domain.NewNotification("user-1", domain.TypeHabitReminder, "Habit Reminder", "Time to read", nil)
+		So(err, ShouldBeNil)
+		notif.MarkAsRead()
+		So(notif.IsPending(), ShouldBeFalse)
+
+		Convey("When it is snoozed for an hour", func() {
+			until := now.Add(time.Hour)
+			So(notif.Schedule(until, now), ShouldBeNil)
+
+			Convey("Then it is pending and will come back unread", func() {
+				So(notif.IsPending(), ShouldBeTrue)
+				So(*notif.DeliverAt, ShouldEqual, until)
+				So(notif.IsRead, ShouldBeFalse)
+				So(notif.ReadAt, ShouldBeNil)
+			})
+		})
+
+		Convey("Then it cannot be scheduled in the past or too far ahead", func() {
+			So(notif.Schedule(now, now), ShouldEqual, domain.ErrInvalidDeliverAt)
+			So(notif.Schedule(now.Add(-time.Minute), now), ShouldEqual, domain.ErrInvalidDeliverAt)
+			So(notif.Schedule(now.Add(domain.MaxDeliveryDelay+time.Second), now), ShouldEqual, domain.ErrInvalidDeliverAt)
+			So(notif.IsPending(), ShouldBeFalse)
+			So(notif.IsRead, ShouldBeTrue)
+		})
+	})
+}
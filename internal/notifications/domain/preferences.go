@@ -0,0 +1,131 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/semmidev/ethos-go/internal/common/random"
+)
+
+// Kinds of scheduled notifications in the delivery log
+const (
+	DeliveryDailySummary = "daily_summary"
+	DeliveryWeeklyReport = "weekly_report"
+	DeliveryReengagement = "reengagement"
+)
+
+var (
+	ErrInvalidQuietHours = errors.New("quiet hours must be HH:MM and set together")
+	ErrUnknownEmailKind  = errors.New("unknown email kind")
+	ErrInvalidDNDExpiry  = errors.New("do not disturb must end in the future")
+)
+
+// Preferences holds a user's choices for scheduled notifications.
+// Quiet hours are wall-clock times in the user's timezone and may wrap
+// past midnight (e.g. 22:00 to 07:00).
+type Preferences struct {
+	UserID          string  `db:"user_id" json:"user_id"`
+	DailySummary    bool    `db:"daily_summary" json:"daily_summary"`
+	WeeklyReport    bool    `db:"weekly_report" json:"weekly_report"`
+	Reengagement    bool    `db:"reengagement" json:"reengagement"`
+	QuietHoursStart *string `db:"quiet_hours_start" json:"quiet_hours_start"`
+	QuietHoursEnd   *string `db:"quiet_hours_end" json:"quiet_hours_end"`
+	// DoNotDisturb suppresses habit reminders until it is switched off or
+	// DoNotDisturbUntil passes, when set
+	DoNotDisturb      bool       `db:"do_not_disturb" json:"do_not_disturb"`
+	DoNotDisturbUntil *time.Time `db:"do_not_disturb_until" json:"do_not_disturb_until"`
+	// UnsubscribeToken authorises the unsubscribe link in report emails
+	UnsubscribeToken *string   `db:"unsubscribe_token" json:"-"`
+	UpdatedAt        time.Time `db:"updated_at" json:"updated_at"`
+}
+
+// DefaultPreferences returns the preferences of a user who never changed them
+func DefaultPreferences(userID string) *Preferences {
+	return &Preferences{
+		UserID:       userID,
+		DailySummary: true,
+		WeeklyReport: true,
+		Reengagement: true,
+		UpdatedAt:    time.Now(),
+	}
+}
+
+// EnsureUnsubscribeToken returns the unsubscribe token, creating it first
+func (p *Preferences) EnsureUnsubscribeToken() string {
+	if p.UnsubscribeToken == nil {
+		token := random.NewUUID().String()
+		p.UnsubscribeToken = &token
+	}
+	return *p.UnsubscribeToken
+}
+
+// Unsubscribe turns off the emails of the given kind, as linked from them
+func (p *Preferences) Unsubscribe(kind string) error {
+	switch kind {
+	case DeliveryWeeklyReport:
+		p.WeeklyReport = false
+	case DeliveryReengagement:
+		p.Reengagement = false
+	default:
+		return ErrUnknownEmailKind
+	}
+	return nil
+}
+
+// SetQuietHours sets the quiet period; two empty strings clear it
+func (p *Preferences) SetQuietHours(start, end string) error {
+	if start == "" && end == "" {
+		p.QuietHoursStart = nil
+		p.QuietHoursEnd = nil
+		return nil
+	}
+	if !validClock(start) || !validClock(end) || start == end {
+		return ErrInvalidQuietHours
+	}
+	p.QuietHoursStart = &start
+	p.QuietHoursEnd = &end
+	return nil
+}
+
+// InQuietHours reports whether local falls inside the quiet period.
+// local must already be in the user's timezone.
+func (p *Preferences) InQuietHours(local time.Time) bool {
+	if p.QuietHoursStart == nil || p.QuietHoursEnd == nil {
+		return false
+	}
+	now := local.Format("15:04")
+	start, end := *p.QuietHoursStart, *p.QuietHoursEnd
+	if start < end {
+		return now >= start && now < end
+	}
+	return now >= start || now < end
+}
+
+// SetDoNotDisturb switches do not disturb on, until the optional expiry,
+// or off
+func (p *Preferences) SetDoNotDisturb(enabled bool, until *time.Time, now time.Time) error {
+	if !enabled {
+		p.DoNotDisturb = false
+		p.DoNotDisturbUntil = nil
+		return nil
+	}
+	if until != nil && !until.After(now) {
+		return ErrInvalidDNDExpiry
+	}
+	p.DoNotDisturb = true
+	p.DoNotDisturbUntil = until
+	return nil
+}
+
+// InDoNotDisturb reports whether do not disturb is on at now
+func (p *Preferences) InDoNotDisturb(now time.Time) bool {
+	if !p.DoNotDisturb {
+		return false
+	}
+	return p.DoNotDisturbUntil == nil || now.Before(*p.DoNotDisturbUntil)
+}
+
+func validClock(s string) bool {
+	_, err := time.Parse("15:04", s)
+	return err == nil && len(s) == 5
+}
@@ -0,0 +1,120 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/semmidev/ethos-go/internal/notifications/domain"
+)
+
+func TestPreferencesQuietHours(t *testing.T) {
+	at := func(clock string) time.Time {
+		t, _ := time.Parse("15:04", clock)
+		return t
+	}
+
+	Convey("Given default preferences", t, func() {
+		prefs := domain.DefaultPreferences("user-1")
+
+		Convey("Then the daily summary is on and no time is quiet", func() {
+			So(prefs.DailySummary, ShouldBeTrue)
+			So(prefs.InQuietHours(at("03:00")), ShouldBeFalse)
+		})
+
+		Convey("When quiet hours wrap past midnight", func() {
+			So(prefs.SetQuietHours("22:00", "07:00"), ShouldBeNil)
+
+			Convey("Then late evening and early morning are quiet", func() {
+				So(prefs.InQuietHours(at("22:00")), ShouldBeTrue)
+				So(prefs.InQuietHours(at("23:30")), ShouldBeTrue)
+				So(prefs.InQuietHours(at("06:59")), ShouldBeTrue)
+				So(prefs.InQuietHours(at("07:00")), ShouldBeFalse)
+				So(prefs.InQuietHours(at("21:59")), ShouldBeFalse)
+			})
+		})
+
+		Convey("When quiet hours sit within one day", func() {
+			So(prefs.SetQuietHours("13:00", "15:00"), ShouldBeNil)
+
+			Convey("Then only that span is quiet", func() {
+				So(prefs.InQuietHours(at("14:00")), ShouldBeTrue)
+				So(prefs.InQuietHours(at("21:00")), ShouldBeFalse)
+			})
+		})
+
+		Convey("When quiet hours are cleared", func() {
+			So(prefs.SetQuietHours("22:00", "07:00"), ShouldBeNil)
+			So(prefs.SetQuietHours("", ""), ShouldBeNil)
+
+			Convey("Then nothing is quiet", func() {
+				So(prefs.QuietHoursStart, ShouldBeNil)
+				So(prefs.InQuietHours(at("23:00")), ShouldBeFalse)
+			})
+		})
+
+		Convey("When quiet hours are malformed", func() {
+			So(prefs.SetQuietHours("22:00", ""), ShouldEqual, domain.ErrInvalidQuietHours)
+			So(prefs.SetQuietHours("9:00", "17:00"), ShouldEqual, domain.ErrInvalidQuietHours)
+			So(prefs.SetQuietHours("25:00", "07:00"), ShouldEqual, domain.ErrInvalidQuietHours)
+			So(prefs.SetQuietHours("08:00", "08:00"), ShouldEqual, domain.ErrInvalidQuietHours)
+		})
+
+		Convey("When unsubscribing from an email kind", func() {
+			So(prefs.Unsubscribe(domain.DeliveryReengagement), ShouldBeNil)
+
+			Convey("Then only that kind is turned off", func() {
+				So(prefs.Reengagement, ShouldBeFalse)
+				So(prefs.WeeklyReport, ShouldBeTrue)
+			})
+		})
+
+		Convey("When unsubscribing from an unknown kind", func() {
+			err := prefs.Unsubscribe("daily_summary")
+
+			Convey("Then it is rejected", func() {
+				So(err, ShouldEqual, domain.ErrUnknownEmailKind)
+			})
+		})
+	})
+}
+
+func TestPreferencesDoNotDisturb(t *testing.T) {
+	now := time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)
+
+	Convey("Given default preferences", t, func() {
+		prefs := domain.DefaultPreferences("user-1")
+		So(prefs.InDoNotDisturb(now), ShouldBeFalse)
+
+		Convey("When do not disturb is switched on without an expiry", func() {
+			So(prefs.SetDoNotDisturb(true, nil, now), ShouldBeNil)
+
+			Convey("Then it stays on until switched off", func() {
+				So(prefs.InDoNotDisturb(now.AddDate(1, 0, 0)), ShouldBeTrue)
+
+				So(prefs.SetDoNotDisturb(false, nil, now), ShouldBeNil)
+				So(prefs.InDoNotDisturb(now), ShouldBeFalse)
+			})
+		})
+
+		Convey("When do not disturb is switched on for two hours", func() {
+			until := now.Add(2 * time.Hour)
+			So(prefs.SetDoNotDisturb(true, &until, now), ShouldBeNil)
+
+			Convey("Then it ends by itself", func() {
+				So(prefs.InDoNotDisturb(now.Add(time.Hour)), ShouldBeTrue)
+				So(prefs.InDoNotDisturb(until), ShouldBeFalse)
+			})
+		})
+
+		Convey("When the expiry has already passed", func() {
+			past := now.Add(-time.Minute)
+
+			Convey("Then it is rejected", func() {
+				So(prefs.SetDoNotDisturb(true, &past, now), ShouldEqual, domain.ErrInvalidDNDExpiry)
+				So(prefs.DoNotDisturb, ShouldBeFalse)
+			})
+		})
+	})
+}
@@ -2,16 +2,63 @@ package domain
 
 import (
 	"context"
+	"time"
 
 	"github.com/semmidev/ethos-go/internal/common/model"
 )
 
+// ListFilter selects the notifications to list. Besides the page and
+// keyword, StartDate and EndDate of the embedded filter bound the days,
+// in UTC, the notifications were created on. Unset fields don't filter.
+type ListFilter struct {
+	model.Filter
+	// Types lists only notifications of any of these types
+	Types []NotificationType
+	// Read lists only read notifications when true and unread ones when
+	// false
+	Read *bool
+	// HabitID lists only the notifications about this habit
+	HabitID string
+}
+
 type NotificationRepository interface {
 	Create(ctx context.Context, notification *Notification) error
-	FindByID(ctx context.Context, id string) (*Notification, error)
-	List(ctx context.Context, userID string, filter model.Filter) ([]Notification, *model.Paging, error)
+	// FindByID and Delete only see the user's own notifications; someone
+	// else's is reported as not found. Update only writes the notification
+	// if it belongs to its UserID.
+	FindByID(ctx context.Context, id, userID string) (*Notification, error)
+	List(ctx context.Context, userID string, filter ListFilter) ([]Notification, *model.Paging, error)
+	// ListGrouped pages through the user's threads, newest first. A
+	// notification without a group key is a thread of its own.
+	ListGrouped(ctx context.Context, userID string, filter ListFilter) ([]NotificationGroup, *model.Paging, error)
 	Update(ctx context.Context, notification *Notification) error
-	Delete(ctx context.Context, id string) error
+	Delete(ctx context.Context, id, userID string) error
 	MarkAllAsRead(ctx context.Context, userID string) error
+	// MarkAsReadByIDs and DeleteByIDs change all the listed notifications
+	// in one statement, or none of them when any is not the user's
+	MarkAsReadByIDs(ctx context.Context, userID string, ids []string) error
+	DeleteByIDs(ctx context.Context, userID string, ids []string) error
 	GetUnreadCount(ctx context.Context, userID string) (int, error)
+	// DeliverDue delivers the pending notifications due by now, moving them
+	// to the top of their owners' inboxes, and returns how many it delivered
+	DeliverDue(ctx context.Context, now time.Time) (int, error)
+}
+
+type PreferencesRepository interface {
+	// GetPreferences returns DefaultPreferences when the user has none stored
+	GetPreferences(ctx context.Context, userID string) (*Preferences, error)
+	SavePreferences(ctx context.Context, prefs *Preferences) error
+	FindByUnsubscribeToken(ctx context.Context, token string) (*Preferences, error)
+	// ListWeeklyReportRecipients returns the active, verified users whose
+	// weeks begin on weekStart and who did not opt out of the weekly report
+	ListWeeklyReportRecipients(ctx context.Context, weekStart time.Weekday) ([]string, error)
+}
+
+type DeliveryRepository interface {
+	// ClaimDelivery records that kind was sent to the user for date. It
+	// returns false when it was already claimed.
+	ClaimDelivery(ctx context.Context, userID, kind string, date time.Time) (bool, error)
+	// ClaimReminder records that the habit's reminder was sent for its
+	// owner's local date. It returns false when it was already claimed.
+	ClaimReminder(ctx context.Context, habitID string, date time.Time) (bool, error)
 }
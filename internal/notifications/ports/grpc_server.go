@@ -3,17 +3,20 @@ package ports
 import (
 	"context"
 	"encoding/json"
+	"strings"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	commonv1 "github.com/semmidev/ethos-go/contracts/grpc/ethos/common/v1"
+	notificationsv1 "github.com/semmidev/ethos-go/contracts/grpc/ethos/notifications/v1"
 	authctx "github.com/semmidev/ethos-go/internal/auth/infrastructure/context"
+	"github.com/semmidev/ethos-go/internal/common/dateutil"
 	"github.com/semmidev/ethos-go/internal/common/grpcutil"
 	"github.com/semmidev/ethos-go/internal/common/model"
-	commonv1 "github.com/semmidev/ethos-go/internal/generated/grpc/ethos/common/v1"
-	notificationsv1 "github.com/semmidev/ethos-go/internal/generated/grpc/ethos/notifications/v1"
 	"github.com/semmidev/ethos-go/internal/notifications/app"
 	"github.com/semmidev/ethos-go/internal/notifications/app/command"
 	"github.com/semmidev/ethos-go/internal/notifications/app/query"
@@ -53,9 +56,13 @@ func (s *NotificationsGRPCServer) CreateNotification(ctx context.Context, req *n
 		Message: req.Message,
 		Data:    data,
 	}
+	if req.DeliverAt != nil {
+		deliverAt := req.DeliverAt.AsTime()
+		cmd.DeliverAt = &deliverAt
+	}
 
 	if err := s.app.Commands.CreateNotification.Handle(ctx, cmd); err != nil {
-		return nil, toNotificationsGRPCError(err)
+		return nil, toNotificationsGRPCError(ctx, err)
 	}
 
 	return &notificationsv1.SuccessResponse{
@@ -71,32 +78,54 @@ func (s *NotificationsGRPCServer) ListNotifications(ctx context.Context, req *no
 		return nil, status.Error(codes.Unauthenticated, "unauthorized")
 	}
 
-	filter := model.NewFilter()
+	filter := domain.ListFilter{Filter: model.NewFilter()}
 	if req.Page > 0 {
 		filter.CurrentPage = int(req.Page)
 	}
 	if req.PerPage > 0 {
 		filter.PerPage = int(req.PerPage)
 	}
-
-	// Set unread filter if requested
-	if req.UnreadOnly {
-		isActive := true
-		filter.IsActive = &isActive // Using IsActive to represent unread-only filter
+	if filter.StartDate, err = dateutil.ParseOptionalDate("start_date", req.StartDate); err != nil {
+		return nil, toNotificationsGRPCError(ctx, err)
+	}
+	if filter.EndDate, err = dateutil.ParseOptionalDate("end_date", req.EndDate); err != nil {
+		return nil, toNotificationsGRPCError(ctx, err)
+	}
+	for _, t := range req.Type {
+		filter.Types = append(filter.Types, domain.NotificationType(t))
+	}
+	filter.Read = req.Read
+	// unread_only is the older spelling of read=false
+	if filter.Read == nil && req.UnreadOnly {
+		unread := false
+		filter.Read = &unread
+	}
+	if req.HabitId != nil {
+		filter.HabitID = *req.HabitId
+	}
+	if req.Keyword != nil {
+		filter.Keyword = strings.TrimSpace(*req.Keyword)
 	}
 
 	result, err := s.app.Queries.ListNotifications.Handle(ctx, query.ListNotifications{
-		UserID: user.UserID,
-		Filter: filter,
+		UserID:  user.UserID,
+		Filter:  filter,
+		Grouped: req.Grouped,
 	})
 	if err != nil {
-		return nil, toNotificationsGRPCError(err)
+		return nil, toNotificationsGRPCError(ctx, err)
 	}
 
-	notifications := make([]*notificationsv1.Notification, 0, len(result.Notifications))
+	notifications := make([]*notificationsv1.Notification, 0, len(result.Notifications)+len(result.Groups))
 	for _, n := range result.Notifications {
 		notifications = append(notifications, toProtoNotification(n))
 	}
+	for _, g := range result.Groups {
+		notif := toProtoNotification(g.Notification)
+		notif.GroupCount = int32(g.Count)
+		notif.GroupUnreadCount = int32(g.UnreadCount)
+		notifications = append(notifications, notif)
+	}
 
 	return &notificationsv1.ListNotificationsResponse{
 		Success: true,
@@ -129,7 +158,7 @@ func (s *NotificationsGRPCServer) GetUnreadCount(ctx context.Context, req *notif
 		UserID: user.UserID,
 	})
 	if err != nil {
-		return nil, toNotificationsGRPCError(err)
+		return nil, toNotificationsGRPCError(ctx, err)
 	}
 
 	return &notificationsv1.UnreadCountResponse{
@@ -154,7 +183,7 @@ func (s *NotificationsGRPCServer) MarkAsRead(ctx context.Context, req *notificat
 	}
 
 	if err := s.app.Commands.MarkAsRead.Handle(ctx, cmd); err != nil {
-		return nil, toNotificationsGRPCError(err)
+		return nil, toNotificationsGRPCError(ctx, err)
 	}
 
 	return &notificationsv1.SuccessResponse{
@@ -175,7 +204,7 @@ func (s *NotificationsGRPCServer) MarkAllAsRead(ctx context.Context, req *notifi
 	}
 
 	if err := s.app.Commands.MarkAllRead.Handle(ctx, cmd); err != nil {
-		return nil, toNotificationsGRPCError(err)
+		return nil, toNotificationsGRPCError(ctx, err)
 	}
 
 	return &notificationsv1.SuccessResponse{
@@ -184,6 +213,32 @@ func (s *NotificationsGRPCServer) MarkAllAsRead(ctx context.Context, req *notifi
 	}, nil
 }
 
+// SnoozeNotification hides a notification until the requested time.
+func (s *NotificationsGRPCServer) SnoozeNotification(ctx context.Context, req *notificationsv1.SnoozeNotificationRequest) (*notificationsv1.SuccessResponse, error) {
+	user, err := authctx.UserFromCtx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+	if req.Until == nil {
+		return nil, status.Error(codes.InvalidArgument, "until is required")
+	}
+
+	cmd := command.SnoozeNotification{
+		NotificationID: req.NotificationId,
+		UserID:         user.UserID,
+		Until:          req.Until.AsTime(),
+	}
+
+	if err := s.app.Commands.SnoozeNotification.Handle(ctx, cmd); err != nil {
+		return nil, toNotificationsGRPCError(ctx, err)
+	}
+
+	return &notificationsv1.SuccessResponse{
+		Success: true,
+		Message: "Notification snoozed",
+	}, nil
+}
+
 // DeleteNotification deletes a notification.
 func (s *NotificationsGRPCServer) DeleteNotification(ctx context.Context, req *notificationsv1.DeleteNotificationRequest) (*notificationsv1.SuccessResponse, error) {
 	user, err := authctx.UserFromCtx(ctx)
@@ -197,7 +252,7 @@ func (s *NotificationsGRPCServer) DeleteNotification(ctx context.Context, req *n
 	}
 
 	if err := s.app.Commands.DeleteNotification.Handle(ctx, cmd); err != nil {
-		return nil, toNotificationsGRPCError(err)
+		return nil, toNotificationsGRPCError(ctx, err)
 	}
 
 	return &notificationsv1.SuccessResponse{
@@ -206,6 +261,138 @@ func (s *NotificationsGRPCServer) DeleteNotification(ctx context.Context, req *n
 	}, nil
 }
 
+// BatchMarkAsRead marks the listed notifications as read.
+func (s *NotificationsGRPCServer) BatchMarkAsRead(ctx context.Context, req *notificationsv1.BatchMarkAsReadRequest) (*notificationsv1.SuccessResponse, error) {
+	user, err := authctx.UserFromCtx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	cmd := command.BatchMarkAsRead{
+		UserID:          user.UserID,
+		NotificationIDs: req.NotificationIds,
+	}
+
+	if err := s.app.Commands.BatchMarkAsRead.Handle(ctx, cmd); err != nil {
+		return nil, toNotificationsGRPCError(ctx, err)
+	}
+
+	return &notificationsv1.SuccessResponse{
+		Success: true,
+		Message: "Notifications marked as read",
+	}, nil
+}
+
+// BatchDeleteNotifications deletes the listed notifications.
+func (s *NotificationsGRPCServer) BatchDeleteNotifications(ctx context.Context, req *notificationsv1.BatchDeleteNotificationsRequest) (*notificationsv1.SuccessResponse, error) {
+	user, err := authctx.UserFromCtx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	cmd := command.BatchDeleteNotifications{
+		UserID:          user.UserID,
+		NotificationIDs: req.NotificationIds,
+	}
+
+	if err := s.app.Commands.BatchDeleteNotifications.Handle(ctx, cmd); err != nil {
+		return nil, toNotificationsGRPCError(ctx, err)
+	}
+
+	return &notificationsv1.SuccessResponse{
+		Success: true,
+		Message: "Notifications deleted successfully",
+	}, nil
+}
+
+// GetNotificationPreferences returns the user's notification preferences.
+func (s *NotificationsGRPCServer) GetNotificationPreferences(ctx context.Context, req *notificationsv1.GetNotificationPreferencesRequest) (*notificationsv1.NotificationPreferencesResponse, error) {
+	user, err := authctx.UserFromCtx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	prefs, err := s.app.Queries.GetPreferences.Handle(ctx, query.GetPreferences{UserID: user.UserID})
+	if err != nil {
+		return nil, toNotificationsGRPCError(ctx, err)
+	}
+
+	return &notificationsv1.NotificationPreferencesResponse{
+		Success: true,
+		Message: "Notification preferences retrieved successfully",
+		Data:    toProtoPreferences(prefs),
+	}, nil
+}
+
+// UpdateNotificationPreferences changes the user's notification preferences.
+func (s *NotificationsGRPCServer) UpdateNotificationPreferences(ctx context.Context, req *notificationsv1.UpdateNotificationPreferencesRequest) (*notificationsv1.NotificationPreferencesResponse, error) {
+	user, err := authctx.UserFromCtx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	cmd := command.UpdatePreferences{
+		UserID:          user.UserID,
+		DailySummary:    req.DailySummary,
+		WeeklyReport:    req.WeeklyReport,
+		Reengagement:    req.Reengagement,
+		QuietHoursStart: req.QuietHoursStart,
+		QuietHoursEnd:   req.QuietHoursEnd,
+		DoNotDisturb:    req.DoNotDisturb,
+	}
+	if req.DoNotDisturbUntil != nil {
+		until := req.DoNotDisturbUntil.AsTime()
+		cmd.DoNotDisturbUntil = &until
+	}
+
+	if err := s.app.Commands.UpdatePreferences.Handle(ctx, cmd); err != nil {
+		return nil, toNotificationsGRPCError(ctx, err)
+	}
+
+	prefs, err := s.app.Queries.GetPreferences.Handle(ctx, query.GetPreferences{UserID: user.UserID})
+	if err != nil {
+		return nil, toNotificationsGRPCError(ctx, err)
+	}
+
+	return &notificationsv1.NotificationPreferencesResponse{
+		Success: true,
+		Message: "Notification preferences updated successfully",
+		Data:    toProtoPreferences(prefs),
+	}, nil
+}
+
+// Unsubscribe turns off one kind of email for the owner of an email link token.
+func (s *NotificationsGRPCServer) Unsubscribe(ctx context.Context, req *notificationsv1.UnsubscribeRequest) (*notificationsv1.SuccessResponse, error) {
+	if req.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+
+	if err := s.app.Commands.Unsubscribe.Handle(ctx, command.Unsubscribe{Token: req.Token, Kind: req.Kind}); err != nil {
+		return nil, toNotificationsGRPCError(ctx, err)
+	}
+
+	return &notificationsv1.SuccessResponse{
+		Success: true,
+		Message: "Unsubscribed successfully",
+	}, nil
+}
+
+// toProtoPreferences converts domain.Preferences to protobuf NotificationPreferences.
+func toProtoPreferences(p *domain.Preferences) *notificationsv1.NotificationPreferences {
+	prefs := &notificationsv1.NotificationPreferences{
+		DailySummary:    p.DailySummary,
+		WeeklyReport:    p.WeeklyReport,
+		Reengagement:    p.Reengagement,
+		QuietHoursStart: p.QuietHoursStart,
+		QuietHoursEnd:   p.QuietHoursEnd,
+		DoNotDisturb:    p.InDoNotDisturb(time.Now()),
+	}
+	if prefs.DoNotDisturb && p.DoNotDisturbUntil != nil {
+		prefs.DoNotDisturbUntil = timestamppb.New(*p.DoNotDisturbUntil)
+	}
+	return prefs
+}
+
 // toProtoNotification converts a domain.Notification to a protobuf Notification.
 func toProtoNotification(n domain.Notification) *notificationsv1.Notification {
 	notifType := notificationsv1.NotificationType_NOTIFICATION_TYPE_SYSTEM
@@ -218,6 +405,12 @@ func toProtoNotification(n domain.Notification) *notificationsv1.Notification {
 		notifType = notificationsv1.NotificationType_NOTIFICATION_TYPE_ACHIEVEMENT
 	case domain.TypeWelcome:
 		notifType = notificationsv1.NotificationType_NOTIFICATION_TYPE_WELCOME
+	case domain.TypeDailySummary:
+		notifType = notificationsv1.NotificationType_NOTIFICATION_TYPE_DAILY_SUMMARY
+	case domain.TypeReengagement:
+		notifType = notificationsv1.NotificationType_NOTIFICATION_TYPE_REENGAGEMENT
+	case domain.TypeReminderEscalation:
+		notifType = notificationsv1.NotificationType_NOTIFICATION_TYPE_REMINDER_ESCALATION
 	}
 
 	notif := &notificationsv1.Notification{
@@ -242,11 +435,12 @@ func toProtoNotification(n domain.Notification) *notificationsv1.Notification {
 	if n.ReadAt != nil {
 		notif.ReadAt = timestamppb.New(*n.ReadAt)
 	}
+	notif.GroupKey = n.GroupKey
 
 	return notif
 }
 
 // toNotificationsGRPCError converts application errors to gRPC status errors.
-func toNotificationsGRPCError(err error) error {
-	return grpcutil.ToGRPCError(err)
+func toNotificationsGRPCError(ctx context.Context, err error) error {
+	return grpcutil.ToGRPCError(ctx, err)
 }
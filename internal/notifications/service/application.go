@@ -18,6 +18,7 @@ func NewApplication(
 	_ *config.Config, // config parameter kept for API compatibility but no longer used for VAPID
 ) app.Application {
 	repo := adapters.NewNotificationPostgresRepository(db)
+	prefsRepo := adapters.NewPreferencesPostgresRepository(db)
 
 	return app.Application{
 		Commands: app.Commands{
@@ -41,6 +42,43 @@ func NewApplication(
 				log,
 				metricsClient,
 			),
+			SnoozeNotification: command.NewSnoozeNotificationHandler(
+				repo,
+				log,
+				metricsClient,
+			),
+			DeliverScheduledNotifications: command.NewDeliverScheduledNotificationsHandler(
+				repo,
+				log,
+				metricsClient,
+			),
+			BatchMarkAsRead: command.NewBatchMarkAsReadHandler(
+				repo,
+				log,
+				metricsClient,
+			),
+			BatchDeleteNotifications: command.NewBatchDeleteNotificationsHandler(
+				repo,
+				log,
+				metricsClient,
+			),
+			SendDailySummary: command.NewSendDailySummaryHandler(
+				repo,
+				prefsRepo,
+				prefsRepo,
+				log,
+				metricsClient,
+			),
+			UpdatePreferences: command.NewUpdatePreferencesHandler(
+				prefsRepo,
+				log,
+				metricsClient,
+			),
+			Unsubscribe: command.NewUnsubscribeHandler(
+				prefsRepo,
+				log,
+				metricsClient,
+			),
 		},
 		Queries: app.Queries{
 			ListNotifications: query.NewListNotificationsHandler(
@@ -53,6 +91,11 @@ func NewApplication(
 				log,
 				metricsClient,
 			),
+			GetPreferences: query.NewGetPreferencesHandler(
+				prefsRepo,
+				log,
+				metricsClient,
+			),
 		},
 	}
 }
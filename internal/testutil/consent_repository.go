@@ -0,0 +1,47 @@
+package testutil
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/semmidev/ethos-go/internal/auth/domain/consent"
+)
+
+// ConsentRepository is an in-memory implementation of consent.Repository.
+type ConsentRepository struct {
+	mu       sync.RWMutex
+	consents []*consent.Consent
+}
+
+var _ consent.Repository = (*ConsentRepository)(nil)
+
+func NewConsentRepository(consents ...*consent.Consent) *ConsentRepository {
+	return &ConsentRepository{consents: consents}
+}
+
+func (r *ConsentRepository) Save(_ context.Context, c *consent.Consent) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, existing := range r.consents {
+		if existing.UserID() == c.UserID() && existing.Document() == c.Document() && existing.Version() == c.Version() {
+			return nil
+		}
+	}
+	r.consents = append(r.consents, c)
+	return nil
+}
+
+func (r *ConsentRepository) ListByUserID(_ context.Context, userID uuid.UUID) ([]*consent.Consent, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var result []*consent.Consent
+	for i := len(r.consents) - 1; i >= 0; i-- {
+		if r.consents[i].UserID() == userID {
+			result = append(result, r.consents[i])
+		}
+	}
+	return result, nil
+}
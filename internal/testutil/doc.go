@@ -0,0 +1,9 @@
+// Package testutil provides in-memory repositories, recording stubs and
+// aggregate builders for unit-testing command and query handlers without a
+// real database.
+//
+// The in-memory repositories mirror the behaviour of the PostgreSQL adapters
+// closely enough for handler tests: they return the same domain errors
+// (ErrNotFound, ErrVersionConflict, ...) and, like the SQL queries, treat
+// another user's aggregates as not found.
+package testutil
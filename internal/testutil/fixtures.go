@@ -0,0 +1,304 @@
+package testutil
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/semmidev/ethos-go/internal/auth/domain/session"
+	"github.com/semmidev/ethos-go/internal/auth/domain/user"
+	"github.com/semmidev/ethos-go/internal/common/i18n"
+	"github.com/semmidev/ethos-go/internal/habits/domain/habit"
+	"github.com/semmidev/ethos-go/internal/habits/domain/habitlog"
+)
+
+// Builders construct valid aggregates with sensible defaults. Each With*
+// method overrides one field; Build panics if the result is invalid, since a
+// broken fixture is a bug in the test itself.
+
+// HabitBuilder builds *habit.Habit fixtures.
+type HabitBuilder struct {
+	habitID      string
+	userID       string
+	name         string
+	description  *string
+	frequency    string
+	recurrence   habit.Recurrence
+	targetCount  int
+	reminderTime *string
+	isActive     bool
+	pausedUntil  *time.Time
+	createdAt    time.Time
+	updatedAt    time.Time
+	version      int
+}
+
+// NewHabitBuilder returns a builder for an active daily habit with a
+// target count of 1.
+func NewHabitBuilder() *HabitBuilder {
+	now := time.Now()
+	return &HabitBuilder{
+		habitID:     uuid.NewString(),
+		userID:      uuid.NewString(),
+		name:        "Drink water",
+		frequency:   habit.FrequencyDaily,
+		recurrence:  habit.DefaultRecurrence(),
+		targetCount: 1,
+		isActive:    true,
+		createdAt:   now,
+		updatedAt:   now,
+		version:     1,
+	}
+}
+
+func (b *HabitBuilder) WithID(habitID string) *HabitBuilder     { b.habitID = habitID; return b }
+func (b *HabitBuilder) WithUserID(userID string) *HabitBuilder  { b.userID = userID; return b }
+func (b *HabitBuilder) WithName(name string) *HabitBuilder      { b.name = name; return b }
+func (b *HabitBuilder) WithFrequency(freq string) *HabitBuilder { b.frequency = freq; return b }
+func (b *HabitBuilder) WithTargetCount(n int) *HabitBuilder     { b.targetCount = n; return b }
+func (b *HabitBuilder) Inactive() *HabitBuilder                 { b.isActive = false; return b }
+func (b *HabitBuilder) WithVersion(v int) *HabitBuilder         { b.version = v; return b }
+
+// PausedUntil makes the habit inactive and paused until the given date.
+func (b *HabitBuilder) PausedUntil(until time.Time) *HabitBuilder {
+	b.isActive = false
+	b.pausedUntil = &until
+	return b
+}
+
+func (b *HabitBuilder) WithDescription(description string) *HabitBuilder {
+	b.description = &description
+	return b
+}
+
+func (b *HabitBuilder) WithRecurrence(recurrence habit.Recurrence) *HabitBuilder {
+	b.recurrence = recurrence
+	return b
+}
+
+func (b *HabitBuilder) WithReminderTime(reminderTime string) *HabitBuilder {
+	b.reminderTime = &reminderTime
+	return b
+}
+
+func (b *HabitBuilder) CreatedAt(t time.Time) *HabitBuilder {
+	b.createdAt = t
+	b.updatedAt = t
+	return b
+}
+
+func (b *HabitBuilder) Build() *habit.Habit {
+	h, err := habit.UnmarshalHabitFromDatabase(
+		b.habitID,
+		b.userID,
+		b.name,
+		b.description,
+		b.frequency,
+		b.recurrence.Days(),
+		b.recurrence.Interval(),
+		b.targetCount,
+		b.reminderTime,
+		b.isActive,
+		b.pausedUntil,
+		b.createdAt,
+		b.updatedAt,
+		b.version,
+	)
+	if err != nil {
+		panic(fmt.Sprintf("testutil: invalid habit fixture: %v", err))
+	}
+	return h
+}
+
+// HabitLogBuilder builds *habitlog.HabitLog fixtures.
+type HabitLogBuilder struct {
+	logID   string
+	habitID string
+	userID  string
+	logDate time.Time
+	count   int
+	note    *string
+}
+
+// NewHabitLogBuilder returns a builder for a single completion of the given
+// habit, logged today.
+func NewHabitLogBuilder(h *habit.Habit) *HabitLogBuilder {
+	return &HabitLogBuilder{
+		logID:   uuid.NewString(),
+		habitID: h.HabitID(),
+		userID:  h.UserID(),
+		logDate: truncateToDay(time.Now()),
+		count:   1,
+	}
+}
+
+func (b *HabitLogBuilder) WithID(logID string) *HabitLogBuilder      { b.logID = logID; return b }
+func (b *HabitLogBuilder) WithCount(count int) *HabitLogBuilder      { b.count = count; return b }
+func (b *HabitLogBuilder) OnDate(date time.Time) *HabitLogBuilder    { b.logDate = date; return b }
+func (b *HabitLogBuilder) WithUserID(userID string) *HabitLogBuilder { b.userID = userID; return b }
+
+// DaysAgo sets the log date to n days before today.
+func (b *HabitLogBuilder) DaysAgo(n int) *HabitLogBuilder {
+	b.logDate = truncateToDay(time.Now()).AddDate(0, 0, -n)
+	return b
+}
+
+func (b *HabitLogBuilder) WithNote(note string) *HabitLogBuilder {
+	b.note = &note
+	return b
+}
+
+func (b *HabitLogBuilder) Build() *habitlog.HabitLog {
+	l, err := habitlog.NewHabitLog(b.logID, b.habitID, b.userID, b.logDate, b.count, b.note)
+	if err != nil {
+		panic(fmt.Sprintf("testutil: invalid habit log fixture: %v", err))
+	}
+	return l
+}
+
+// UserBuilder builds *user.User fixtures.
+type UserBuilder struct {
+	userID         uuid.UUID
+	email          string
+	name           string
+	hashedPassword *string
+	authProvider   string
+	authProviderID *string
+	role           string
+	timezone       string
+	locale         string
+	isActive       bool
+	deactivatedBy  string
+	isVerified     bool
+}
+
+// NewUserBuilder returns a builder for an active, verified email user.
+func NewUserBuilder() *UserBuilder {
+	userID := uuid.New()
+	hashed := "$2a$10$testutilhashedpasswordplaceholder"
+	return &UserBuilder{
+		userID:         userID,
+		email:          fmt.Sprintf("user-%s@example.com", userID.String()[:8]),
+		name:           "Test User",
+		hashedPassword: &hashed,
+		authProvider:   "email",
+		role:           user.RoleMember,
+		timezone:       "Asia/Jakarta",
+		locale:         i18n.DefaultLocale,
+		isActive:       true,
+		isVerified:     true,
+	}
+}
+
+func (b *UserBuilder) WithID(userID uuid.UUID) *UserBuilder  { b.userID = userID; return b }
+func (b *UserBuilder) WithEmail(email string) *UserBuilder   { b.email = email; return b }
+func (b *UserBuilder) WithName(name string) *UserBuilder     { b.name = name; return b }
+func (b *UserBuilder) WithTimezone(tz string) *UserBuilder   { b.timezone = tz; return b }
+func (b *UserBuilder) WithLocale(locale string) *UserBuilder { b.locale = locale; return b }
+func (b *UserBuilder) WithRole(role string) *UserBuilder     { b.role = role; return b }
+func (b *UserBuilder) Unverified() *UserBuilder              { b.isVerified = false; return b }
+func (b *UserBuilder) Inactive() *UserBuilder                { return b.DeactivatedBy(user.DeactivatedByUser) }
+
+// DeactivatedBy makes the fixture an account deactivated by the given party.
+func (b *UserBuilder) DeactivatedBy(by string) *UserBuilder {
+	b.isActive = false
+	b.deactivatedBy = by
+	return b
+}
+
+func (b *UserBuilder) WithHashedPassword(hashed string) *UserBuilder {
+	b.hashedPassword = &hashed
+	return b
+}
+
+// WithGoogle turns the fixture into a Google account without a password.
+func (b *UserBuilder) WithGoogle(googleID string) *UserBuilder {
+	b.authProvider = "google"
+	b.authProviderID = &googleID
+	b.hashedPassword = nil
+	return b
+}
+
+func (b *UserBuilder) Build() *user.User {
+	now := time.Now()
+	return user.UnmarshalUserFromDatabase(
+		b.userID,
+		b.email,
+		b.name,
+		b.hashedPassword,
+		b.authProvider,
+		b.authProviderID,
+		b.role,
+		b.timezone,
+		b.locale,
+		user.DefaultWeekStart,
+		nil, nil, user.Preferences{},
+		b.isActive,
+		b.deactivatedBy,
+		b.isVerified,
+		nil, nil,
+		now,
+		now,
+	)
+}
+
+// SessionBuilder builds *session.Session fixtures.
+type SessionBuilder struct {
+	sessionID    uuid.UUID
+	userID       uuid.UUID
+	refreshToken string
+	userAgent    string
+	clientIP     string
+	location     session.Location
+	isBlocked    bool
+	expiresAt    time.Time
+}
+
+// NewSessionBuilder returns a builder for a valid session of the given user
+// that expires in 24 hours.
+func NewSessionBuilder(u *user.User) *SessionBuilder {
+	return &SessionBuilder{
+		sessionID:    uuid.New(),
+		userID:       u.UserID(),
+		refreshToken: uuid.NewString(),
+		userAgent:    "testutil",
+		clientIP:     "127.0.0.1",
+		expiresAt:    time.Now().Add(24 * time.Hour),
+	}
+}
+
+func (b *SessionBuilder) WithID(sessionID uuid.UUID) *SessionBuilder {
+	b.sessionID = sessionID
+	return b
+}
+func (b *SessionBuilder) WithRefreshToken(token string) *SessionBuilder {
+	b.refreshToken = token
+	return b
+}
+func (b *SessionBuilder) WithLocation(city, country string) *SessionBuilder {
+	b.location = session.Location{City: city, Country: country}
+	return b
+}
+func (b *SessionBuilder) Blocked() *SessionBuilder { b.isBlocked = true; return b }
+
+// Expired makes the session expire an hour ago.
+func (b *SessionBuilder) Expired() *SessionBuilder {
+	b.expiresAt = time.Now().Add(-time.Hour)
+	return b
+}
+
+func (b *SessionBuilder) Build() *session.Session {
+	now := time.Now()
+	return session.UnmarshalSessionFromDatabase(
+		b.sessionID,
+		b.userID,
+		b.refreshToken,
+		b.userAgent,
+		b.clientIP,
+		b.location,
+		b.isBlocked,
+		b.expiresAt,
+		now,
+		now,
+	)
+}
@@ -0,0 +1,341 @@
+package testutil
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/semmidev/ethos-go/internal/habits/domain/habit"
+)
+
+// HabitRepository is an in-memory implementation of habit.Repository.
+type HabitRepository struct {
+	mu        sync.RWMutex
+	habits    map[string]*habit.Habit
+	stats     map[string]*habit.HabitStats
+	vacations map[string]*habit.HabitVacation
+	positions map[string]int // set by ReorderHabits
+}
+
+var _ habit.Repository = (*HabitRepository)(nil)
+
+// NewHabitRepository creates an empty in-memory habit repository,
+// optionally seeded with the given habits.
+func NewHabitRepository(habits ...*habit.Habit) *HabitRepository {
+	r := &HabitRepository{
+		habits:    make(map[string]*habit.Habit),
+		stats:     make(map[string]*habit.HabitStats),
+		vacations: make(map[string]*habit.HabitVacation),
+		positions: make(map[string]int),
+	}
+	for _, h := range habits {
+		r.habits[h.HabitID()] = copyHabit(h)
+	}
+	return r
+}
+
+func (r *HabitRepository) AddHabit(_ context.Context, h *habit.Habit) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.habits[h.HabitID()] = copyHabit(h)
+	return nil
+}
+
+func (r *HabitRepository) GetHabit(_ context.Context, habitID, userID string) (*habit.Habit, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	h, ok := r.habits[habitID]
+	if !ok || h.UserID() != userID {
+		return nil, habit.ErrNotFound
+	}
+	return copyHabit(h), nil
+}
+
+func (r *HabitRepository) ListHabitsByUser(_ context.Context, userID string) ([]*habit.Habit, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	habits := make([]*habit.Habit, 0)
+	for _, h := range r.habits {
+		if h.UserID() == userID {
+			habits = append(habits, copyHabit(h))
+		}
+	}
+	sort.Slice(habits, func(i, j int) bool {
+		return habits[i].CreatedAt().After(habits[j].CreatedAt())
+	})
+	return habits, nil
+}
+
+// LockHabitForUpdate only checks the habit exists; there is no transaction
+// to hold a lock for.
+func (r *HabitRepository) LockHabitForUpdate(_ context.Context, habitID, userID string) error {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	h, ok := r.habits[habitID]
+	if !ok || h.UserID() != userID {
+		return habit.ErrNotFound
+	}
+	return nil
+}
+
+func (r *HabitRepository) CountActiveHabitsForUpdate(_ context.Context, userID string) (int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var habits []*habit.Habit
+	for _, h := range r.habits {
+		if h.UserID() == userID {
+			habits = append(habits, h)
+		}
+	}
+	return habit.CountActive(habits), nil
+}
+
+func (r *HabitRepository) UpdateHabit(
+	ctx context.Context,
+	habitID, userID string,
+	updateFn func(ctx context.Context, h *habit.Habit) (*habit.Habit, error),
+) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	h, ok := r.habits[habitID]
+	if !ok || h.UserID() != userID {
+		return habit.ErrNotFound
+	}
+
+	updated, err := updateFn(ctx, copyHabit(h))
+	if err != nil {
+		return err
+	}
+
+	r.habits[habitID] = nextVersion(updated)
+	return nil
+}
+
+func (r *HabitRepository) DeleteHabit(_ context.Context, habitID, userID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	h, ok := r.habits[habitID]
+	if !ok || h.UserID() != userID {
+		return habit.ErrNotFound
+	}
+
+	delete(r.habits, habitID)
+	delete(r.stats, habitID)
+	delete(r.positions, habitID)
+	for id, v := range r.vacations {
+		if v.HabitID() == habitID {
+			delete(r.vacations, id)
+		}
+	}
+	return nil
+}
+
+func (r *HabitRepository) ListPausedHabitsDue(_ context.Context, now time.Time) ([]*habit.Habit, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var due []*habit.Habit
+	for _, h := range r.habits {
+		if h.PauseExpired(now) {
+			due = append(due, h)
+		}
+	}
+	return due, nil
+}
+
+func (r *HabitRepository) ReorderHabits(_ context.Context, userID string, habitIDs []string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	listed := make(map[string]bool, len(habitIDs))
+	for _, id := range habitIDs {
+		h, ok := r.habits[id]
+		if !ok || h.UserID() != userID {
+			return habit.ErrNotFound
+		}
+		listed[id] = true
+	}
+
+	order := append([]string(nil), habitIDs...)
+	for _, id := range r.orderLocked(userID) {
+		if !listed[id] {
+			order = append(order, id)
+		}
+	}
+	for i, id := range order {
+		r.positions[id] = i
+	}
+	return nil
+}
+
+// Order returns the user's habit IDs in display order: habits placed by
+// ReorderHabits first, then the rest oldest first.
+func (r *HabitRepository) Order(userID string) []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.orderLocked(userID)
+}
+
+func (r *HabitRepository) orderLocked(userID string) []string {
+	var habits []*habit.Habit
+	for _, h := range r.habits {
+		if h.UserID() == userID {
+			habits = append(habits, h)
+		}
+	}
+	sort.Slice(habits, func(i, j int) bool {
+		pi, iok := r.positions[habits[i].HabitID()]
+		pj, jok := r.positions[habits[j].HabitID()]
+		if iok != jok {
+			return iok
+		}
+		if iok && pi != pj {
+			return pi < pj
+		}
+		return habits[i].CreatedAt().Before(habits[j].CreatedAt())
+	})
+
+	ids := make([]string, len(habits))
+	for i, h := range habits {
+		ids[i] = h.HabitID()
+	}
+	return ids
+}
+
+// GetStats returns fresh zeroed stats when none were stored, like the
+// PostgreSQL adapter does.
+func (r *HabitRepository) GetStats(_ context.Context, habitID string) (*habit.HabitStats, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	s, ok := r.stats[habitID]
+	if !ok {
+		return habit.NewHabitStats(habitID), nil
+	}
+	cp := *s
+	return &cp, nil
+}
+
+func (r *HabitRepository) UpsertStats(_ context.Context, stats *habit.HabitStats) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cp := *stats
+	r.stats[stats.HabitID()] = &cp
+	return nil
+}
+
+func (r *HabitRepository) AddVacation(_ context.Context, vacation *habit.HabitVacation) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cp := *vacation
+	r.vacations[vacation.ID()] = &cp
+	return nil
+}
+
+// GetActiveVacation returns nil without error when the habit has no active
+// vacation, matching the PostgreSQL adapter.
+func (r *HabitRepository) GetActiveVacation(_ context.Context, habitID string) (*habit.HabitVacation, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	today := truncateToDay(time.Now())
+	var active *habit.HabitVacation
+	for _, v := range r.vacations {
+		if v.HabitID() != habitID {
+			continue
+		}
+		if v.EndDate() != nil && v.EndDate().Before(today) {
+			continue
+		}
+		if active == nil || v.StartDate().After(active.StartDate()) {
+			active = v
+		}
+	}
+	if active == nil {
+		return nil, nil
+	}
+	cp := *active
+	return &cp, nil
+}
+
+func (r *HabitRepository) EndVacation(_ context.Context, vacationID string, endDate time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	v, ok := r.vacations[vacationID]
+	if !ok {
+		return habit.ErrVacationNotFound
+	}
+	r.vacations[vacationID] = habit.UnmarshalVacationFromDatabase(
+		v.ID(),
+		v.HabitID(),
+		v.StartDate(),
+		&endDate,
+		v.Reason(),
+		v.CreatedAt(),
+	)
+	return nil
+}
+
+func (r *HabitRepository) ListVacations(_ context.Context, habitID string) ([]*habit.HabitVacation, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	vacations := make([]*habit.HabitVacation, 0)
+	for _, v := range r.vacations {
+		if v.HabitID() == habitID {
+			cp := *v
+			vacations = append(vacations, &cp)
+		}
+	}
+	sort.Slice(vacations, func(i, j int) bool {
+		return vacations[i].StartDate().After(vacations[j].StartDate())
+	})
+	return vacations, nil
+}
+
+// Len returns the number of stored habits across all users.
+func (r *HabitRepository) Len() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return len(r.habits)
+}
+
+// copyHabit returns a shallow copy so callers can't mutate stored state
+// without going through UpdateHabit.
+func copyHabit(h *habit.Habit) *habit.Habit {
+	cp := *h
+	return &cp
+}
+
+// nextVersion copies h with its version incremented, as the database does
+// on every update
+func nextVersion(h *habit.Habit) *habit.Habit {
+	next, err := habit.UnmarshalHabitFromDatabase(
+		h.HabitID(), h.UserID(), h.Name(), h.Description(),
+		h.Frequency().String(), h.Recurrence().Days(), h.Recurrence().Interval(),
+		h.TargetCount(), h.ReminderTime(), h.IsActive(), h.PausedUntil(),
+		h.CreatedAt(), h.UpdatedAt(), h.Version()+1,
+	)
+	if err != nil {
+		panic(fmt.Sprintf("testutil: invalid habit: %v", err))
+	}
+	return next
+}
+
+func truncateToDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
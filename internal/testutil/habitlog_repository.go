@@ -0,0 +1,316 @@
+package testutil
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/semmidev/ethos-go/internal/habits/domain/habitlog"
+)
+
+// HabitLogRepository is an in-memory implementation of habitlog.Repository.
+type HabitLogRepository struct {
+	mu        sync.RWMutex
+	logs      map[string]*habitlog.HabitLog
+	lockDays  map[string]int
+	plans     map[string]string
+	summaries map[string]map[time.Time]*habitlog.MonthlySummary
+}
+
+var _ habitlog.Repository = (*HabitLogRepository)(nil)
+
+// NewHabitLogRepository creates an empty in-memory habit log repository,
+// optionally seeded with the given logs.
+func NewHabitLogRepository(logs ...*habitlog.HabitLog) *HabitLogRepository {
+	r := &HabitLogRepository{
+		logs:      make(map[string]*habitlog.HabitLog),
+		lockDays:  make(map[string]int),
+		plans:     make(map[string]string),
+		summaries: make(map[string]map[time.Time]*habitlog.MonthlySummary),
+	}
+	for _, l := range logs {
+		r.logs[l.LogID()] = copyHabitLog(l)
+	}
+	return r
+}
+
+func (r *HabitLogRepository) AddHabitLog(_ context.Context, log *habitlog.HabitLog) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if existing := r.logOnDay(log.HabitID(), log.LogDate(), ""); existing != nil {
+		merged := copyHabitLog(existing)
+		if err := merged.UpdateCount(existing.Count() + log.Count()); err != nil {
+			return err
+		}
+		if log.Note() != nil {
+			merged.UpdateNote(log.Note())
+		}
+		r.logs[merged.LogID()] = merged
+		return nil
+	}
+
+	r.logs[log.LogID()] = copyHabitLog(log)
+	return nil
+}
+
+func (r *HabitLogRepository) GetHabitLog(_ context.Context, logID, userID string) (*habitlog.HabitLog, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	l, ok := r.logs[logID]
+	if !ok || l.UserID() != userID {
+		return nil, habitlog.ErrNotFound
+	}
+	return copyHabitLog(l), nil
+}
+
+func (r *HabitLogRepository) UpdateHabitLog(
+	ctx context.Context,
+	logID, userID string,
+	updateFn func(ctx context.Context, log *habitlog.HabitLog) (*habitlog.HabitLog, error),
+) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	l, ok := r.logs[logID]
+	if !ok || l.UserID() != userID {
+		return habitlog.ErrNotFound
+	}
+
+	updated, err := updateFn(ctx, copyHabitLog(l))
+	if err != nil {
+		return err
+	}
+	if r.logOnDay(updated.HabitID(), updated.LogDate(), logID) != nil {
+		return habitlog.ErrDateTaken
+	}
+
+	r.logs[logID] = copyHabitLog(updated)
+	return nil
+}
+
+func (r *HabitLogRepository) DeleteHabitLog(_ context.Context, logID, userID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	l, ok := r.logs[logID]
+	if !ok || l.UserID() != userID {
+		return habitlog.ErrNotFound
+	}
+
+	delete(r.logs, logID)
+	return nil
+}
+
+func (r *HabitLogRepository) GetHabitLogByDate(
+	_ context.Context,
+	habitID string,
+	date time.Time,
+	userID string,
+) (*habitlog.HabitLog, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, l := range r.logs {
+		if l.HabitID() == habitID && l.UserID() == userID && sameDay(l.LogDate(), date) {
+			return copyHabitLog(l), nil
+		}
+	}
+	return nil, habitlog.ErrNotFound
+}
+
+func (r *HabitLogRepository) GetLatestHabitLogForUpdate(
+	_ context.Context,
+	habitID string,
+	date time.Time,
+	userID string,
+) (*habitlog.HabitLog, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var latest *habitlog.HabitLog
+	for _, l := range r.logs {
+		if l.HabitID() != habitID || l.UserID() != userID || !sameDay(l.LogDate(), date) {
+			continue
+		}
+		if latest == nil || l.CreatedAt().After(latest.CreatedAt()) ||
+			(l.CreatedAt().Equal(latest.CreatedAt()) && l.LogID() > latest.LogID()) {
+			latest = l
+		}
+	}
+	if latest == nil {
+		return nil, habitlog.ErrNotFound
+	}
+	return copyHabitLog(latest), nil
+}
+
+func (r *HabitLogRepository) ListHabitLogs(_ context.Context, habitID, userID string) ([]*habitlog.HabitLog, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	logs := make([]*habitlog.HabitLog, 0)
+	for _, l := range r.logs {
+		if l.HabitID() == habitID && l.UserID() == userID {
+			logs = append(logs, copyHabitLog(l))
+		}
+	}
+	sort.Slice(logs, func(i, j int) bool {
+		return logs[i].LogDate().After(logs[j].LogDate())
+	})
+	return logs, nil
+}
+
+func (r *HabitLogRepository) ListRecentlyLoggedHabits(_ context.Context, since time.Time) ([]habitlog.HabitRef, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	seen := make(map[string]bool)
+	refs := make([]habitlog.HabitRef, 0)
+	for _, l := range r.logs {
+		if l.UpdatedAt().Before(since) || seen[l.HabitID()] {
+			continue
+		}
+		seen[l.HabitID()] = true
+		refs = append(refs, habitlog.HabitRef{HabitID: l.HabitID(), UserID: l.UserID()})
+	}
+	sort.Slice(refs, func(i, j int) bool {
+		return refs[i].HabitID < refs[j].HabitID
+	})
+	return refs, nil
+}
+
+func (r *HabitLogRepository) GetLogLockDays(_ context.Context, userID string) (int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.lockDays[userID], nil
+}
+
+// SetLogLockDays sets the user's log lock window returned by GetLogLockDays.
+func (r *HabitLogRepository) SetLogLockDays(userID string, days int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.lockDays[userID] = days
+}
+
+// ListCompactableHabits treats users without a plan set by SetUserPlan as
+// on the free plan, like the users table's default.
+func (r *HabitLogRepository) ListCompactableHabits(_ context.Context, plan string, before time.Time) ([]habitlog.HabitRef, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	seen := make(map[string]bool)
+	refs := make([]habitlog.HabitRef, 0)
+	for _, l := range r.logs {
+		if r.planOf(l.UserID()) != plan || !l.LogDate().Before(before) || seen[l.HabitID()] {
+			continue
+		}
+		seen[l.HabitID()] = true
+		refs = append(refs, habitlog.HabitRef{HabitID: l.HabitID(), UserID: l.UserID()})
+	}
+	sort.Slice(refs, func(i, j int) bool {
+		return refs[i].HabitID < refs[j].HabitID
+	})
+	return refs, nil
+}
+
+func (r *HabitLogRepository) CompactHabitLogs(_ context.Context, habitID string, before time.Time) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	deleted := 0
+	for id, l := range r.logs {
+		if l.HabitID() != habitID || !l.LogDate().Before(before) {
+			continue
+		}
+		d := l.LogDate()
+		month := time.Date(d.Year(), d.Month(), 1, 0, 0, 0, 0, time.UTC)
+		if r.summaries[habitID] == nil {
+			r.summaries[habitID] = make(map[time.Time]*habitlog.MonthlySummary)
+		}
+		summary := r.summaries[habitID][month]
+		if summary == nil {
+			summary = &habitlog.MonthlySummary{HabitID: habitID, UserID: l.UserID(), Month: month}
+			r.summaries[habitID][month] = summary
+		}
+		summary.DaysLogged++
+		summary.TotalCount += l.Count()
+
+		delete(r.logs, id)
+		deleted++
+	}
+	return deleted, nil
+}
+
+func (r *HabitLogRepository) GetCompactedDays(_ context.Context, habitID string) (int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	days := 0
+	for _, summary := range r.summaries[habitID] {
+		days += summary.DaysLogged
+	}
+	return days, nil
+}
+
+// SetUserPlan puts the user on plan for ListCompactableHabits.
+func (r *HabitLogRepository) SetUserPlan(userID, plan string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.plans[userID] = plan
+}
+
+// Summaries returns the habit's monthly summaries, oldest month first.
+func (r *HabitLogRepository) Summaries(habitID string) []habitlog.MonthlySummary {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	summaries := make([]habitlog.MonthlySummary, 0, len(r.summaries[habitID]))
+	for _, summary := range r.summaries[habitID] {
+		summaries = append(summaries, *summary)
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].Month.Before(summaries[j].Month)
+	})
+	return summaries
+}
+
+// Len returns the number of stored logs across all habits.
+func (r *HabitLogRepository) Len() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return len(r.logs)
+}
+
+// planOf returns the user's plan. Callers hold the lock.
+func (r *HabitLogRepository) planOf(userID string) string {
+	if plan, ok := r.plans[userID]; ok {
+		return plan
+	}
+	return "free"
+}
+
+// logOnDay returns the habit's log on date's day other than exceptID.
+// Callers hold the lock.
+func (r *HabitLogRepository) logOnDay(habitID string, date time.Time, exceptID string) *habitlog.HabitLog {
+	for _, l := range r.logs {
+		if l.HabitID() == habitID && l.LogID() != exceptID && sameDay(l.LogDate(), date) {
+			return l
+		}
+	}
+	return nil
+}
+
+func copyHabitLog(l *habitlog.HabitLog) *habitlog.HabitLog {
+	cp := *l
+	return &cp
+}
+
+func sameDay(a, b time.Time) bool {
+	return a.Year() == b.Year() && a.YearDay() == b.YearDay()
+}
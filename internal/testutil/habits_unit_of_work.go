@@ -0,0 +1,56 @@
+package testutil
+
+import (
+	"context"
+
+	"github.com/semmidev/ethos-go/internal/common/events"
+	"github.com/semmidev/ethos-go/internal/habits/adapters"
+	"github.com/semmidev/ethos-go/internal/habits/domain/habit"
+	"github.com/semmidev/ethos-go/internal/habits/domain/habitlog"
+)
+
+// HabitsUnitOfWork is an in-memory implementation of adapters.HabitsUnitOfWork.
+// Transactions are not isolated: WithTransaction runs the callback against
+// the same repositories, so writes made before a failing step are kept.
+type HabitsUnitOfWork struct {
+	HabitRepo *HabitRepository
+	LogRepo   *HabitLogRepository
+
+	// Transactions counts how many times WithTransaction was called.
+	Transactions int
+}
+
+var _ adapters.HabitsUnitOfWork = (*HabitsUnitOfWork)(nil)
+
+// NewHabitsUnitOfWork creates a unit of work backed by the given in-memory
+// repositories. Nil repositories are replaced with empty ones.
+func NewHabitsUnitOfWork(habitRepo *HabitRepository, logRepo *HabitLogRepository) *HabitsUnitOfWork {
+	if habitRepo == nil {
+		habitRepo = NewHabitRepository()
+	}
+	if logRepo == nil {
+		logRepo = NewHabitLogRepository()
+	}
+	return &HabitsUnitOfWork{
+		HabitRepo: habitRepo,
+		LogRepo:   logRepo,
+	}
+}
+
+func (uow *HabitsUnitOfWork) Habits() habit.Repository {
+	return uow.HabitRepo
+}
+
+func (uow *HabitsUnitOfWork) HabitLogs() habitlog.Repository {
+	return uow.LogRepo
+}
+
+// Publisher returns publisher unchanged; there is no transaction to join.
+func (uow *HabitsUnitOfWork) Publisher(publisher events.Publisher) events.Publisher {
+	return publisher
+}
+
+func (uow *HabitsUnitOfWork) WithTransaction(_ context.Context, fn func(adapters.HabitsUnitOfWork) error) error {
+	uow.Transactions++
+	return fn(uow)
+}
@@ -0,0 +1,79 @@
+package testutil
+
+import (
+	"context"
+	"sync"
+
+	"github.com/semmidev/ethos-go/internal/habits/domain/habitimport"
+)
+
+// ImportRepository is an in-memory implementation of habitimport.Repository.
+type ImportRepository struct {
+	mu      sync.RWMutex
+	imports map[string]*habitimport.Import
+}
+
+var _ habitimport.Repository = (*ImportRepository)(nil)
+
+// NewImportRepository creates an in-memory import repository, optionally
+// seeded with the given imports.
+func NewImportRepository(imports ...*habitimport.Import) *ImportRepository {
+	r := &ImportRepository{imports: make(map[string]*habitimport.Import)}
+	for _, imp := range imports {
+		r.imports[imp.ImportID()] = copyImport(imp)
+	}
+	return r
+}
+
+func (r *ImportRepository) AddImport(_ context.Context, imp *habitimport.Import) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.imports[imp.ImportID()] = copyImport(imp)
+	return nil
+}
+
+func (r *ImportRepository) GetImport(_ context.Context, importID, userID string) (*habitimport.Import, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	imp, ok := r.imports[importID]
+	if !ok || imp.UserID() != userID {
+		return nil, habitimport.ErrNotFound
+	}
+	return copyImport(imp), nil
+}
+
+func (r *ImportRepository) UpdateImport(
+	ctx context.Context,
+	importID, userID string,
+	updateFn func(ctx context.Context, imp *habitimport.Import) (*habitimport.Import, error),
+) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	imp, ok := r.imports[importID]
+	if !ok || imp.UserID() != userID {
+		return habitimport.ErrNotFound
+	}
+
+	updated, err := updateFn(ctx, copyImport(imp))
+	if err != nil {
+		return err
+	}
+	r.imports[importID] = copyImport(updated)
+	return nil
+}
+
+// Len returns the number of stored imports.
+func (r *ImportRepository) Len() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return len(r.imports)
+}
+
+func copyImport(imp *habitimport.Import) *habitimport.Import {
+	cp := *imp
+	return &cp
+}
@@ -0,0 +1,103 @@
+package testutil
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/semmidev/ethos-go/internal/auth/domain/magiclink"
+)
+
+// MagicLinkRepository is an in-memory implementation of magiclink.Repository.
+type MagicLinkRepository struct {
+	mu    sync.RWMutex
+	links map[uuid.UUID]*magiclink.MagicLink
+}
+
+var (
+	_ magiclink.Repository = (*MagicLinkRepository)(nil)
+	_ magiclink.Maintainer = (*MagicLinkRepository)(nil)
+)
+
+// NewMagicLinkRepository creates an empty in-memory magic link repository.
+func NewMagicLinkRepository() *MagicLinkRepository {
+	return &MagicLinkRepository{links: make(map[uuid.UUID]*magiclink.MagicLink)}
+}
+
+func (r *MagicLinkRepository) Create(_ context.Context, link *magiclink.MagicLink) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.links[link.LinkID()] = link
+	return nil
+}
+
+func (r *MagicLinkRepository) FindByTokenHash(_ context.Context, tokenHash string) (*magiclink.MagicLink, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, l := range r.links {
+		if l.TokenHash() == tokenHash {
+			return l, nil
+		}
+	}
+	return nil, magiclink.ErrInvalidLink
+}
+
+func (r *MagicLinkRepository) MarkUsed(_ context.Context, linkID uuid.UUID, usedAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	l, ok := r.links[linkID]
+	if !ok || l.UsedAt() != nil {
+		return magiclink.ErrInvalidLink
+	}
+	r.links[linkID] = magiclink.UnmarshalFromDatabase(
+		l.LinkID(), l.UserID(), l.TokenHash(), l.ExpiresAt(), &usedAt, l.CreatedAt(),
+	)
+	return nil
+}
+
+// CreateWithinLimit counts and stores under one lock, like the PostgreSQL
+// adapter does with the user's row.
+func (r *MagicLinkRepository) CreateWithinLimit(_ context.Context, link *magiclink.MagicLink, since time.Time, limit int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	count := 0
+	for _, l := range r.links {
+		if l.UserID() == link.UserID() && !l.CreatedAt().Before(since) {
+			count++
+		}
+	}
+	if count >= limit {
+		return magiclink.ErrLimitReached
+	}
+	r.links[link.LinkID()] = link
+	return nil
+}
+
+// DeleteExpired removes expired links like the PostgreSQL adapter does.
+func (r *MagicLinkRepository) DeleteExpired(_ context.Context) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var deleted int64
+	now := time.Now()
+	for id, l := range r.links {
+		if !now.Before(l.ExpiresAt()) && l.CreatedAt().Before(now.Add(-time.Hour)) {
+			delete(r.links, id)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+// Len returns the number of stored links.
+func (r *MagicLinkRepository) Len() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return len(r.links)
+}
@@ -0,0 +1,51 @@
+package testutil
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/semmidev/ethos-go/internal/auth/domain/service"
+)
+
+// SessionDenylist is an in-memory implementation of service.SessionDenylist
+// that records the TTL of every denied session instead of expiring it.
+type SessionDenylist struct {
+	mu     sync.RWMutex
+	denied map[uuid.UUID]time.Duration
+}
+
+var _ service.SessionDenylist = (*SessionDenylist)(nil)
+
+func NewSessionDenylist() *SessionDenylist {
+	return &SessionDenylist{denied: make(map[uuid.UUID]time.Duration)}
+}
+
+func (d *SessionDenylist) Deny(_ context.Context, sessionID uuid.UUID, ttl time.Duration) error {
+	if ttl <= 0 {
+		return nil
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.denied[sessionID] = ttl
+	return nil
+}
+
+func (d *SessionDenylist) IsDenied(_ context.Context, sessionID uuid.UUID) (bool, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	_, ok := d.denied[sessionID]
+	return ok, nil
+}
+
+// TTL returns the TTL the session was denied for, or zero if it wasn't
+func (d *SessionDenylist) TTL(sessionID uuid.UUID) time.Duration {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	return d.denied[sessionID]
+}
@@ -0,0 +1,134 @@
+package testutil
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/semmidev/ethos-go/internal/auth/domain/session"
+)
+
+// SessionRepository is an in-memory implementation of session.Repository.
+type SessionRepository struct {
+	mu       sync.RWMutex
+	sessions map[uuid.UUID]*session.Session
+}
+
+var _ session.Repository = (*SessionRepository)(nil)
+
+// NewSessionRepository creates an empty in-memory session repository,
+// optionally seeded with the given sessions.
+func NewSessionRepository(sessions ...*session.Session) *SessionRepository {
+	r := &SessionRepository{sessions: make(map[uuid.UUID]*session.Session)}
+	for _, s := range sessions {
+		r.sessions[s.SessionID()] = copySession(s)
+	}
+	return r
+}
+
+func (r *SessionRepository) Create(_ context.Context, s *session.Session) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.sessions[s.SessionID()] = copySession(s)
+	return nil
+}
+
+func (r *SessionRepository) FindByID(_ context.Context, sessionID uuid.UUID) (*session.Session, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	s, ok := r.sessions[sessionID]
+	if !ok {
+		return nil, session.ErrNotFound
+	}
+	return copySession(s), nil
+}
+
+func (r *SessionRepository) FindByRefreshToken(_ context.Context, refreshToken string) (*session.Session, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, s := range r.sessions {
+		if s.MatchesToken(refreshToken) {
+			return copySession(s), nil
+		}
+	}
+	return nil, session.ErrNotFound
+}
+
+func (r *SessionRepository) FindAllByUserID(_ context.Context, userID uuid.UUID) ([]*session.Session, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	sessions := make([]*session.Session, 0)
+	for _, s := range r.sessions {
+		if s.UserID() == userID {
+			sessions = append(sessions, copySession(s))
+		}
+	}
+	return sessions, nil
+}
+
+func (r *SessionRepository) Update(_ context.Context, s *session.Session) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.sessions[s.SessionID()]; !ok {
+		return session.ErrNotFound
+	}
+	r.sessions[s.SessionID()] = copySession(s)
+	return nil
+}
+
+func (r *SessionRepository) Delete(_ context.Context, sessionID uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.sessions[sessionID]; !ok {
+		return session.ErrNotFound
+	}
+	delete(r.sessions, sessionID)
+	return nil
+}
+
+func (r *SessionRepository) DeleteAllByUserID(_ context.Context, userID uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for id, s := range r.sessions {
+		if s.UserID() == userID {
+			delete(r.sessions, id)
+		}
+	}
+	return nil
+}
+
+// DeleteExpired removes expired, non-blocked sessions like the PostgreSQL
+// adapter does; blocked sessions are kept for auditing.
+func (r *SessionRepository) DeleteExpired(_ context.Context) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var deleted int64
+	for id, s := range r.sessions {
+		if s.IsExpired() && !s.IsBlocked() {
+			delete(r.sessions, id)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+// Len returns the number of stored sessions across all users.
+func (r *SessionRepository) Len() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return len(r.sessions)
+}
+
+func copySession(s *session.Session) *session.Session {
+	cp := *s
+	return &cp
+}
@@ -0,0 +1,47 @@
+package testutil
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/semmidev/ethos-go/internal/auth/domain/service"
+)
+
+// SSOLoginStore is an in-memory implementation of service.SSOLoginStore
+// whose sign-ins never expire.
+type SSOLoginStore struct {
+	mu     sync.Mutex
+	logins map[string]service.SSOLogin
+}
+
+var _ service.SSOLoginStore = (*SSOLoginStore)(nil)
+
+func NewSSOLoginStore() *SSOLoginStore {
+	return &SSOLoginStore{logins: make(map[string]service.SSOLogin)}
+}
+
+func (s *SSOLoginStore) Save(_ context.Context, login service.SSOLogin, _ time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.logins[login.State] = login
+	return nil
+}
+
+func (s *SSOLoginStore) Take(_ context.Context, state string) (service.SSOLogin, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	login, ok := s.logins[state]
+	delete(s.logins, state)
+	return login, ok, nil
+}
+
+// Len returns the number of sign-ins waiting for their callback
+func (s *SSOLoginStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.logins)
+}
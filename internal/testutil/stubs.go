@@ -0,0 +1,208 @@
+package testutil
+
+import (
+	"context"
+	"sync"
+
+	"github.com/semmidev/ethos-go/internal/common/decorator"
+	"github.com/semmidev/ethos-go/internal/common/events"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+	domaintask "github.com/semmidev/ethos-go/internal/habits/domain/task"
+)
+
+// NopLogger is a logger.Logger that discards everything.
+type NopLogger struct{}
+
+var _ logger.Logger = NopLogger{}
+
+func (NopLogger) Debug(context.Context, string, ...logger.Field)        {}
+func (NopLogger) Info(context.Context, string, ...logger.Field)         {}
+func (NopLogger) Warn(context.Context, string, ...logger.Field)         {}
+func (NopLogger) Error(context.Context, error, string, ...logger.Field) {}
+func (l NopLogger) With(...logger.Field) logger.Logger                  { return l }
+
+// LogEntry is a message logged through a RecordingLogger.
+type LogEntry struct {
+	Level   string
+	Message string
+	Fields  map[string]any
+}
+
+// RecordingLogger is a logger.Logger that keeps every entry so tests can
+// assert on them.
+type RecordingLogger struct {
+	mu      sync.Mutex
+	entries []LogEntry
+}
+
+var _ logger.Logger = (*RecordingLogger)(nil)
+
+func NewRecordingLogger() *RecordingLogger {
+	return &RecordingLogger{}
+}
+
+func (l *RecordingLogger) Debug(_ context.Context, msg string, fields ...logger.Field) {
+	l.record("debug", msg, fields)
+}
+
+func (l *RecordingLogger) Info(_ context.Context, msg string, fields ...logger.Field) {
+	l.record("info", msg, fields)
+}
+
+func (l *RecordingLogger) Warn(_ context.Context, msg string, fields ...logger.Field) {
+	l.record("warn", msg, fields)
+}
+
+func (l *RecordingLogger) Error(_ context.Context, _ error, msg string, fields ...logger.Field) {
+	l.record("error", msg, fields)
+}
+
+func (l *RecordingLogger) With(...logger.Field) logger.Logger { return l }
+
+// Entries returns a copy of the logged entries in order.
+func (l *RecordingLogger) Entries() []LogEntry {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return append([]LogEntry(nil), l.entries...)
+}
+
+func (l *RecordingLogger) record(level, msg string, fields []logger.Field) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	entry := LogEntry{Level: level, Message: msg, Fields: make(map[string]any, len(fields))}
+	for _, f := range fields {
+		entry.Fields[f.Key] = f.Value
+	}
+	l.entries = append(l.entries, entry)
+}
+
+// RecordingPublisher is an events.Publisher that keeps every published event
+// so tests can assert on them.
+type RecordingPublisher struct {
+	mu     sync.Mutex
+	events []events.Event
+}
+
+var _ events.Publisher = (*RecordingPublisher)(nil)
+
+func NewRecordingPublisher() *RecordingPublisher {
+	return &RecordingPublisher{}
+}
+
+func (p *RecordingPublisher) Publish(_ context.Context, event events.Event) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.events = append(p.events, event)
+	return nil
+}
+
+func (p *RecordingPublisher) PublishAll(ctx context.Context, evts []events.Event) error {
+	for _, e := range evts {
+		if err := p.Publish(ctx, e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *RecordingPublisher) Close() error {
+	return nil
+}
+
+// Events returns a copy of the published events in publish order.
+func (p *RecordingPublisher) Events() []events.Event {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return append([]events.Event(nil), p.events...)
+}
+
+// EventTypes returns the type of every published event in publish order.
+func (p *RecordingPublisher) EventTypes() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	types := make([]string, len(p.events))
+	for i, e := range p.events {
+		types[i] = e.EventType()
+	}
+	return types
+}
+
+// RecordingMetricsClient is a decorator.MetricsClient that sums every
+// increment per key so tests can assert on them.
+type RecordingMetricsClient struct {
+	mu     sync.Mutex
+	counts map[string]int
+}
+
+var _ decorator.MetricsClient = (*RecordingMetricsClient)(nil)
+
+func NewRecordingMetricsClient() *RecordingMetricsClient {
+	return &RecordingMetricsClient{counts: make(map[string]int)}
+}
+
+func (c *RecordingMetricsClient) Inc(key string, value int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.counts[key] += value
+}
+
+// Count returns the summed increments for key.
+func (c *RecordingMetricsClient) Count(key string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.counts[key]
+}
+
+// HabitCreatedTask records a DispatchHabitCreated call.
+type HabitCreatedTask struct {
+	HabitID string
+	UserID  string
+	Name    string
+}
+
+// ImportTask records a DispatchImport call.
+type ImportTask struct {
+	ImportID string
+	UserID   string
+}
+
+// RecordingHabitTaskDispatcher is a habits task.TaskDispatcher that records
+// dispatched tasks instead of enqueueing them. Set Err to make every
+// dispatch fail.
+type RecordingHabitTaskDispatcher struct {
+	mu           sync.Mutex
+	HabitCreated []HabitCreatedTask
+	Imports      []ImportTask
+	Err          error
+}
+
+var _ domaintask.TaskDispatcher = (*RecordingHabitTaskDispatcher)(nil)
+
+func (d *RecordingHabitTaskDispatcher) DispatchHabitCreated(_ context.Context, habitID, userID, name string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.Err != nil {
+		return d.Err
+	}
+	d.HabitCreated = append(d.HabitCreated, HabitCreatedTask{HabitID: habitID, UserID: userID, Name: name})
+	return nil
+}
+
+func (d *RecordingHabitTaskDispatcher) DispatchImport(_ context.Context, importID, userID string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.Err != nil {
+		return d.Err
+	}
+	d.Imports = append(d.Imports, ImportTask{ImportID: importID, UserID: userID})
+	return nil
+}
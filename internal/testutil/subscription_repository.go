@@ -0,0 +1,122 @@
+package testutil
+
+import (
+	"context"
+	"sync"
+
+	"github.com/semmidev/ethos-go/internal/billing/domain/plan"
+	"github.com/semmidev/ethos-go/internal/billing/domain/subscription"
+)
+
+// SubscriptionRepository is an in-memory implementation of
+// subscription.Repository. It also keeps users' plans, serving them as a
+// plan.Reader.
+type SubscriptionRepository struct {
+	mu    sync.RWMutex
+	subs  []*subscription.Subscription
+	plans map[string]string // userID -> plan
+}
+
+var (
+	_ subscription.Repository = (*SubscriptionRepository)(nil)
+	_ plan.Reader             = (*SubscriptionRepository)(nil)
+)
+
+// NewSubscriptionRepository creates an in-memory subscription repository
+// for the given users, all on the free plan.
+func NewSubscriptionRepository(userIDs ...string) *SubscriptionRepository {
+	r := &SubscriptionRepository{plans: make(map[string]string)}
+	for _, id := range userIDs {
+		r.plans[id] = plan.Free
+	}
+	return r
+}
+
+// SetUserPlan moves a user to a plan, adding the user if needed.
+func (r *SubscriptionRepository) SetUserPlan(userID, planName string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.plans[userID] = planName
+}
+
+func (r *SubscriptionRepository) FindByStripeID(_ context.Context, stripeSubscriptionID string) (*subscription.Subscription, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, s := range r.subs {
+		if s.StripeSubscriptionID() == stripeSubscriptionID {
+			return copySubscription(s), nil
+		}
+	}
+	return nil, subscription.ErrNotFound
+}
+
+func (r *SubscriptionRepository) FindUserByCustomerID(_ context.Context, stripeCustomerID string) (string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, s := range r.subs {
+		if s.StripeCustomerID() == stripeCustomerID {
+			return s.UserID(), nil
+		}
+	}
+	return "", subscription.ErrUnknownUser
+}
+
+func (r *SubscriptionRepository) ListByUserID(_ context.Context, userID string) ([]*subscription.Subscription, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var subs []*subscription.Subscription
+	for _, s := range r.subs {
+		if s.UserID() == userID {
+			subs = append(subs, copySubscription(s))
+		}
+	}
+	return subs, nil
+}
+
+// Save stores the subscription and the user's plan unless a newer event
+// was saved already, like the PostgreSQL adapter does.
+func (r *SubscriptionRepository) Save(_ context.Context, sub *subscription.Subscription, userPlan string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.plans[sub.UserID()]; !ok {
+		return subscription.ErrUnknownUser
+	}
+
+	for i, s := range r.subs {
+		if s.StripeSubscriptionID() != sub.StripeSubscriptionID() {
+			continue
+		}
+		if s.EventAt().After(sub.EventAt()) {
+			return nil
+		}
+		r.subs[i] = copySubscription(sub)
+		r.plans[sub.UserID()] = userPlan
+		return nil
+	}
+
+	r.subs = append(r.subs, copySubscription(sub))
+	r.plans[sub.UserID()] = userPlan
+	return nil
+}
+
+func (r *SubscriptionRepository) GetUserPlan(_ context.Context, userID string) (string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if p, ok := r.plans[userID]; ok {
+		return p, nil
+	}
+	return plan.Free, nil
+}
+
+func copySubscription(s *subscription.Subscription) *subscription.Subscription {
+	return subscription.UnmarshalFromDatabase(
+		s.StripeSubscriptionID(), s.StripeCustomerID(), s.UserID(), s.PriceID(), s.Plan(),
+		s.Status(), s.CurrentPeriodEnd(), s.CancelAtPeriodEnd(), s.EventAt(),
+	)
+}
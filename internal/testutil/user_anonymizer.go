@@ -0,0 +1,49 @@
+package testutil
+
+import (
+	"context"
+	"sync"
+
+	"github.com/semmidev/ethos-go/internal/auth/domain/user"
+)
+
+// UserAnonymizer is an in-memory implementation of user.Anonymizer that
+// scrubs users in a UserRepository and records every anonymization in
+// place of an audit log.
+type UserAnonymizer struct {
+	users *UserRepository
+
+	mu             sync.Mutex
+	anonymizations []user.Anonymization
+}
+
+var _ user.Anonymizer = (*UserAnonymizer)(nil)
+
+func NewUserAnonymizer(users *UserRepository) *UserAnonymizer {
+	return &UserAnonymizer{users: users}
+}
+
+func (a *UserAnonymizer) Anonymize(ctx context.Context, an user.Anonymization) error {
+	u, err := a.users.FindByID(ctx, an.UserID)
+	if err != nil {
+		return err
+	}
+	u.Anonymize()
+	if err := a.users.Update(ctx, u); err != nil {
+		return err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.anonymizations = append(a.anonymizations, an)
+	return nil
+}
+
+// Anonymizations returns the recorded anonymizations in order.
+func (a *UserAnonymizer) Anonymizations() []user.Anonymization {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return append([]user.Anonymization(nil), a.anonymizations...)
+}
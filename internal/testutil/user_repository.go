@@ -0,0 +1,147 @@
+package testutil
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/semmidev/ethos-go/internal/auth/domain/user"
+)
+
+// UserRepository is an in-memory implementation of user.Repository.
+type UserRepository struct {
+	mu    sync.RWMutex
+	users map[uuid.UUID]*user.User
+}
+
+var _ user.Repository = (*UserRepository)(nil)
+
+// NewUserRepository creates an empty in-memory user repository,
+// optionally seeded with the given users.
+func NewUserRepository(users ...*user.User) *UserRepository {
+	r := &UserRepository{users: make(map[uuid.UUID]*user.User)}
+	for _, u := range users {
+		r.users[u.UserID()] = copyUser(u)
+	}
+	return r
+}
+
+// Create returns user.ErrAlreadyExists when the email is taken,
+// matching the unique constraint on users.email.
+func (r *UserRepository) Create(_ context.Context, u *user.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, existing := range r.users {
+		if strings.EqualFold(existing.Email(), u.Email()) {
+			return user.ErrAlreadyExists
+		}
+	}
+	if _, ok := r.users[u.UserID()]; ok {
+		return user.ErrAlreadyExists
+	}
+
+	r.users[u.UserID()] = copyUser(u)
+	return nil
+}
+
+func (r *UserRepository) FindByEmail(_ context.Context, email string) (*user.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, u := range r.users {
+		if strings.EqualFold(u.Email(), email) {
+			return copyUser(u), nil
+		}
+	}
+	return nil, user.ErrNotFound
+}
+
+func (r *UserRepository) FindByID(_ context.Context, userID uuid.UUID) (*user.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	u, ok := r.users[userID]
+	if !ok {
+		return nil, user.ErrNotFound
+	}
+	return copyUser(u), nil
+}
+
+func (r *UserRepository) FindByAuthProvider(_ context.Context, provider, providerID string) (*user.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, u := range r.users {
+		if u.AuthProvider() == provider && u.AuthProviderID() != nil && *u.AuthProviderID() == providerID {
+			return copyUser(u), nil
+		}
+	}
+	return nil, user.ErrNotFound
+}
+
+func (r *UserRepository) Update(_ context.Context, u *user.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.users[u.UserID()]; !ok {
+		return user.ErrNotFound
+	}
+	r.users[u.UserID()] = copyUser(u)
+	return nil
+}
+
+func (r *UserRepository) Delete(_ context.Context, userID uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.users[userID]; !ok {
+		return user.ErrNotFound
+	}
+	delete(r.users, userID)
+	return nil
+}
+
+// RecordCodeAttempt counts a guess at the user's pending code under the
+// repository lock, like the single UPDATE statement in Postgres.
+func (r *UserRepository) RecordCodeAttempt(_ context.Context, userID uuid.UUID, kind user.CodeKind) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	u, ok := r.users[userID]
+	if !ok {
+		return false, user.ErrNotFound
+	}
+
+	verifyCode, resetCode := u.VerifyCode(), u.PasswordResetCode()
+	code := &verifyCode
+	if kind == user.CodePasswordReset {
+		code = &resetCode
+	}
+	if *code == nil || (*code).Attempts() >= user.MaxCodeAttempts {
+		return false, nil
+	}
+	hash, expiresAt := (*code).Hash(), (*code).ExpiresAt()
+	*code = user.UnmarshalOneTimeCode(&hash, &expiresAt, (*code).Attempts()+1)
+
+	r.users[userID] = user.UnmarshalUserFromDatabase(
+		u.UserID(), u.Email(), u.Name(), u.HashedPassword(), u.AuthProvider(), u.AuthProviderID(),
+		u.Role(), u.Timezone(), u.Locale(), u.WeekStart(), u.LogLockDays(), u.Avatar(), u.Preferences(),
+		u.IsActive(), u.DeactivatedBy(), u.IsVerified(), verifyCode, resetCode, u.CreatedAt(), u.UpdatedAt(),
+	)
+	return true, nil
+}
+
+// Len returns the number of stored users.
+func (r *UserRepository) Len() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return len(r.users)
+}
+
+func copyUser(u *user.User) *user.User {
+	cp := *u
+	return &cp
+}
@@ -0,0 +1,72 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"sync"
+	"time"
+
+	"github.com/semmidev/ethos-go/config"
+	"github.com/semmidev/ethos-go/internal/common/email"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/common/observability"
+)
+
+// deadTaskAlertInterval limits ops emails to one per task type, so an
+// outage that kills every task does not flood the inbox
+const deadTaskAlertInterval = 15 * time.Minute
+
+const deadTaskAlertBody = `<p>A background task exhausted its retries and was moved to the dead queue.</p>
+<ul>
+<li>Type: %s</li>
+<li>Queue: %s</li>
+<li>ID: %s</li>
+<li>Retries: %d of %d</li>
+<li>Last error: %s</li>
+</ul>
+<p>Requeue or delete it under /admin/queues/%s/dead/%s.</p>`
+
+// deadTaskAlert logs every dead task and emails OPS_ALERT_EMAIL when set
+func deadTaskAlert(cfg *config.Config, sender email.Email, appLogger logger.Logger) func(context.Context, observability.DeadTask) {
+	var mu sync.Mutex
+	lastSent := make(map[string]time.Time)
+
+	return func(ctx context.Context, task observability.DeadTask) {
+		appLogger.Error(ctx, task.Err, "task moved to dead queue",
+			logger.Field{Key: "task_id", Value: task.ID},
+			logger.Field{Key: "task_type", Value: task.Type},
+			logger.Field{Key: "queue", Value: task.Queue},
+			logger.Field{Key: "retried", Value: task.Retried},
+		)
+
+		if cfg.OpsAlertEmail == "" {
+			return
+		}
+
+		mu.Lock()
+		now := time.Now()
+		if now.Sub(lastSent[task.Type]) < deadTaskAlertInterval {
+			mu.Unlock()
+			return
+		}
+		lastSent[task.Type] = now
+		mu.Unlock()
+
+		subject := fmt.Sprintf("[%s] %s task moved to the dead queue", cfg.AppName, task.Type)
+		body := fmt.Sprintf(deadTaskAlertBody,
+			html.EscapeString(task.Type),
+			html.EscapeString(task.Queue),
+			html.EscapeString(task.ID),
+			task.Retried, task.MaxRetry,
+			html.EscapeString(task.Err.Error()),
+			html.EscapeString(task.Queue), html.EscapeString(task.ID),
+		)
+
+		if err := sender.Send(ctx, email.TypeOpsAlert, cfg.OpsAlertEmail, subject, body, task); err != nil {
+			appLogger.Error(ctx, err, "failed to email dead task alert",
+				logger.Field{Key: "task_id", Value: task.ID},
+			)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/semmidev/ethos-go/config"
+	"github.com/semmidev/ethos-go/internal/common/breaker"
+	"github.com/semmidev/ethos-go/internal/common/events"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/common/observability"
+	"github.com/semmidev/ethos-go/internal/common/outbox"
+)
+
+// registerWorkerMetrics exports the backlog gauges the worker is
+// responsible for draining. They are sampled on every scrape.
+func registerWorkerMetrics(
+	db *sqlx.DB,
+	redisOpt asynq.RedisClientOpt,
+	outboxRepo *outbox.Repository,
+	consumer *events.Consumer,
+	consumerName string,
+	breakers ...*breaker.Breaker,
+) error {
+	if err := observability.RegisterDBPoolMetrics(db.DB); err != nil {
+		return err
+	}
+
+	if err := observability.RegisterQueueMetrics(asynq.NewInspector(redisOpt)); err != nil {
+		return err
+	}
+
+	if err := outbox.RegisterMetrics(outboxRepo); err != nil {
+		return err
+	}
+
+	if err := observability.RegisterBreakerMetrics(breakers...); err != nil {
+		return err
+	}
+
+	if consumer != nil {
+		return observability.RegisterConsumerLagMetrics(consumerName, consumer.Lag)
+	}
+
+	return nil
+}
+
+// startMetricsServer serves /metrics on WORKER_METRICS_PORT until ctx is
+// cancelled.
+func startMetricsServer(ctx context.Context, cfg *config.Config, appLogger logger.Logger) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", observability.MetricsHandler(cfg.MetricsUsername, cfg.MetricsPassword))
+
+	server := &http.Server{
+		Addr:              ":" + cfg.WorkerMetricsPort,
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	go func() {
+		appLogger.Info(ctx, "starting worker metrics server", logger.Field{Key: "addr", Value: server.Addr})
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			appLogger.Error(ctx, err, "worker metrics server error")
+		}
+	}()
+}
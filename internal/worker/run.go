@@ -0,0 +1,517 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/jmoiron/sqlx"
+	"github.com/redis/go-redis/v9"
+	"github.com/semmidev/ethos-go/config"
+	habitevents "github.com/semmidev/ethos-go/contracts/events/habits"
+	authadapter "github.com/semmidev/ethos-go/internal/auth/adapters"
+	authtask "github.com/semmidev/ethos-go/internal/auth/adapters/task"
+	"github.com/semmidev/ethos-go/internal/common/breaker"
+	"github.com/semmidev/ethos-go/internal/common/database"
+	"github.com/semmidev/ethos-go/internal/common/email"
+	"github.com/semmidev/ethos-go/internal/common/events"
+	"github.com/semmidev/ethos-go/internal/common/events/handlers"
+	"github.com/semmidev/ethos-go/internal/common/leader"
+	"github.com/semmidev/ethos-go/internal/common/logger"
+	"github.com/semmidev/ethos-go/internal/common/metrics"
+	"github.com/semmidev/ethos-go/internal/common/observability"
+	"github.com/semmidev/ethos-go/internal/common/outbox"
+	"github.com/semmidev/ethos-go/internal/common/startup"
+	commontask "github.com/semmidev/ethos-go/internal/common/task"
+	habittask "github.com/semmidev/ethos-go/internal/habits/adapters/task"
+	habitcommand "github.com/semmidev/ethos-go/internal/habits/app/command"
+	habitsvc "github.com/semmidev/ethos-go/internal/habits/service"
+	notifadapter "github.com/semmidev/ethos-go/internal/notifications/adapters"
+	notiftask "github.com/semmidev/ethos-go/internal/notifications/adapters/task"
+	notificationsvc "github.com/semmidev/ethos-go/internal/notifications/service"
+)
+
+// outboxDrainTimeout bounds how long shutdown waits for the outbox entry
+// being published
+const outboxDrainTimeout = 10 * time.Second
+
+// Run processes background tasks, runs the scheduler while this replica is
+// the leader and relays the outbox until ctx is cancelled, then drains and
+// stops. version is reported to OpenTelemetry.
+func Run(ctx context.Context, cfg *config.Config, version string) error {
+	// Setup Logger
+	appLogger, err := logger.New(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to initialize logger: %w", err)
+	}
+
+	appLogger.Info(ctx, "starting worker",
+		logger.Field{Key: "env", Value: cfg.AppEnv},
+	)
+
+	// Log level can be changed without a restart
+	go config.Watch(ctx, func(newCfg *config.Config) {
+		logger.SetLevel(newCfg.LoggerLevel)
+	})
+
+	// Dependencies that are still starting, as under docker-compose, are
+	// retried until the startup timeout
+	waiter := startup.NewWaiter(cfg.StartupTimeout, appLogger).
+		WithBackoff(cfg.StartupRetryInitialInterval, cfg.StartupRetryMaxInterval)
+
+	// Initialize OpenTelemetry
+	var otelProvider *observability.Provider
+	err = waiter.Retry(ctx, "otlp", func(ctx context.Context) error {
+		var err error
+		otelProvider, err = observability.New(ctx, observability.Config{
+			ServiceName:    cfg.AppName + "-worker",
+			ServiceVersion: version,
+			Environment:    cfg.AppEnv,
+			OTLPEndpoint:   cfg.OTLPEndpoint,
+			EnableTracing:  cfg.OTLPEnableTracing,
+			EnableMetrics:  cfg.OTLPEnableMetrics,
+			SampleRate:     cfg.OTLPSampleRate,
+		})
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to initialize OpenTelemetry: %w", err)
+	}
+	defer otelProvider.Shutdown(context.Background())
+
+	if _, err := observability.InitMetrics(ctx); err != nil {
+		return fmt.Errorf("failed to initialize metrics: %w", err)
+	}
+
+	// Initialize Database Connection
+	var db *sqlx.DB
+	err = waiter.Retry(ctx, "postgres", func(context.Context) error {
+		var err error
+		db, err = database.NewSQLXConnection(cfg)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	defer db.Close()
+
+	if err := db.Ping(); err != nil {
+		return fmt.Errorf("failed to ping database: %w", err)
+	}
+	appLogger.Info(ctx, "database connection established")
+
+	// Initialize Dependencies
+	metricsClient := metrics.NewPrometheusMetricsClient()
+	sessionRepo := authadapter.NewSessionPostgresRepository(db)
+	userRepo := authadapter.NewUserPostgresRepository(db)
+
+	// Create UserProvider adapter - this allows other modules to access user data via interface
+	userProvider := authadapter.NewUserProviderAdapter(userRepo)
+
+	// Create notification repository for cross-module communication
+	notifRepo := notifadapter.NewNotificationPostgresRepository(db)
+
+	// Transactional emails, rendered from the embedded templates. Every
+	// attempt is recorded in the email log for support. While the mail
+	// server keeps failing, sends fail at once and their tasks are retried
+	// later instead of holding the worker's slots.
+	smtp, err := email.NewSMTPClient(cfg, appLogger)
+	if err != nil {
+		return fmt.Errorf("failed to initialize smtp client: %w", err)
+	}
+	smtpBreaker := breaker.New(breaker.Settings{
+		Name:             "smtp",
+		FailureThreshold: cfg.CircuitBreakerFailureThreshold,
+		OpenTimeout:      cfg.CircuitBreakerOpenTimeout,
+	}, appLogger)
+	smtpClient := email.Audited(email.Guarded(smtp, smtpBreaker), email.NewLogRepository(db), smtp.Provider(), appLogger)
+	emailTemplates := email.NewRenderer(email.Templates)
+
+	// Event schemas validate what the worker publishes and consumes
+	eventRegistry := events.NewModuleRegistry()
+
+	// Initialize NATS
+	var eventPublisher events.Publisher
+	var eventConsumer *events.Consumer
+
+	if cfg.NATSUrl != "" {
+		// NATS Publisher
+		var natsPublisher *events.NATSPublisher
+		err := waiter.Retry(ctx, "nats", func(ctx context.Context) error {
+			var err error
+			natsPublisher, err = events.NewNATSPublisher(ctx, events.NATSConfig{
+				URL:           cfg.NATSUrl,
+				StreamName:    cfg.NATSStreamName,
+				MaxReconnects: cfg.NATSMaxReconnects,
+				ReconnectWait: 2 * time.Second,
+			}, appLogger)
+			return err
+		})
+		if err != nil {
+			appLogger.Error(ctx, err, "failed to initialize NATS publisher")
+			// We continue, but outbox processor won't be able to publish
+			eventPublisher = events.NewNoOpPublisher()
+		} else {
+			eventPublisher = natsPublisher
+			defer natsPublisher.Close()
+			appLogger.Info(ctx, "NATS publisher initialized")
+		}
+
+		// NATS Consumer
+		var natsConsumer *events.Consumer
+		err = waiter.Retry(ctx, "nats", func(ctx context.Context) error {
+			var err error
+			natsConsumer, err = events.NewConsumer(ctx, events.ConsumerConfig{
+				NATSConfig: events.NATSConfig{
+					URL:           cfg.NATSUrl,
+					StreamName:    cfg.NATSStreamName,
+					MaxReconnects: cfg.NATSMaxReconnects,
+					ReconnectWait: 2 * time.Second,
+				},
+				ConsumerName: cfg.NATSConsumerName,
+				QueueGroup:   cfg.NATSConsumerName + "-group", // Load balance among workers
+				Registry:     eventRegistry,
+			}, appLogger)
+			return err
+		})
+		if err != nil {
+			appLogger.Error(ctx, err, "failed to initialize NATS consumer")
+		} else {
+			eventConsumer = natsConsumer
+			defer eventConsumer.Close()
+			appLogger.Info(ctx, "NATS consumer initialized")
+
+			// Register Event Handlers with cross-module dependencies
+			// UserRegisteredHandler: uses UserProvider (Auth) + NotificationRepository (Notifications)
+			eventConsumer.RegisterHandler(handlers.NewUserRegisteredHandler(
+				appLogger, userProvider, notifRepo,
+				smtpClient, emailTemplates, cfg.AppName, cfg.AppClientURL,
+			))
+			eventConsumer.RegisterHandler(handlers.NewHabitCreatedHandler(appLogger))
+			eventConsumer.RegisterHandler(handlers.NewHabitCompletedHandler(appLogger))
+		}
+	} else {
+		eventPublisher = events.NewNoOpPublisher()
+		appLogger.Warn(ctx, "NATS not configured, skipping event integration")
+	}
+
+	// Initialize Outbox Processor
+	outboxRepo := outbox.NewRepository(db)
+	outboxProcessor := outbox.NewProcessor(
+		outboxRepo,
+		eventPublisher,
+		appLogger,
+		cfg.OutboxPollInterval,
+		50, // Batch size
+	)
+	if !cfg.OutboxDisableListen {
+		outboxListener, err := outbox.NewListener(cfg.DSN(), cfg.OutboxPollInterval, appLogger)
+		if err != nil {
+			appLogger.Warn(ctx, "failed to listen for outbox inserts, polling instead",
+				logger.Field{Key: "error", Value: err.Error()})
+		} else {
+			defer outboxListener.Close()
+			go outboxListener.Run(ctx)
+			outboxProcessor.WithWakeups(outboxListener.Wakeups(), cfg.OutboxFallbackInterval)
+		}
+	}
+	go outboxProcessor.Start(ctx)
+
+	// Asynq connects to Redis lazily, so check it is up before starting
+	// the task server and scheduler
+	if err := waitForRedis(ctx, waiter, cfg); err != nil {
+		return fmt.Errorf("failed to connect to redis: %w", err)
+	}
+	appLogger.Info(ctx, "redis connection established")
+
+	// Initialize Asynq Client
+	redisOpt := asynq.RedisClientOpt{
+		Addr:     cfg.RedisDSN(),
+		Password: cfg.RedisPassword,
+		DB:       cfg.RedisDB,
+	}
+	asynqClient := asynq.NewClient(redisOpt)
+	defer asynqClient.Close()
+
+	if cfg.OTLPEnableMetrics {
+		if err := registerWorkerMetrics(db, redisOpt, outboxRepo, eventConsumer, cfg.NATSConsumerName, smtpBreaker); err != nil {
+			return fmt.Errorf("failed to register worker metrics: %w", err)
+		}
+		startMetricsServer(ctx, cfg, appLogger)
+	}
+
+	// Initialize task dispatcher for habits
+	habitDispatcher := habittask.NewTaskDispatcher(commontask.NewAsynqDispatcher(asynqClient), appLogger)
+	habitsApp := habitsvc.NewApplication(ctx, cfg, db, habitDispatcher,
+		events.NewEnvelopePublisher(eventPublisher, eventRegistry, "ethos-worker", appLogger),
+		appLogger, metricsClient)
+
+	if eventConsumer != nil {
+		// Keep the dashboard projection in step with habit changes
+		refreshDashboard := func(ctx context.Context, userID string) error {
+			_, err := habitsApp.Commands.RefreshDashboards.Handle(ctx, habitcommand.RefreshDashboards{UserID: userID})
+			return err
+		}
+		for _, eventType := range dashboardEventTypes {
+			eventConsumer.RegisterHandler(handlers.NewDashboardProjectionHandler(appLogger, eventType, refreshDashboard))
+		}
+
+		// Start Consumer
+		if err := eventConsumer.Start(ctx, cfg.NATSConsumerName, cfg.NATSConsumerName+"-group"); err != nil {
+			appLogger.Error(ctx, err, "failed to start NATS consumer")
+		}
+	}
+
+	// Notifications App
+	notificationsApp := notificationsvc.NewApplication(db, appLogger, metricsClient, cfg)
+
+	// Setup Asynq Server (The Worker)
+	srv := asynq.NewServer(
+		redisOpt,
+		asynq.Config{
+			Concurrency: 10,
+			Queues: map[string]int{
+				"default": 1,
+			},
+			Logger: NewAsynqLogger(appLogger),
+
+			// Count tasks that exhaust their retries and alert ops
+			ErrorHandler: observability.DeadTaskErrorHandler(deadTaskAlert(cfg, smtpClient, appLogger)),
+		},
+	)
+
+	// Register Task Processors
+	mux := asynq.NewServeMux()
+	mux.Use(observability.AsynqTracingMiddleware())
+
+	// Session Cleanup Processor
+	sessionCleanupProcessor := authtask.NewSessionCleanupProcessor(sessionRepo, appLogger)
+	mux.Handle(authtask.TaskSessionCleanup, sessionCleanupProcessor)
+	mux.Handle(authtask.TaskCodeCleanup, authtask.NewCodeCleanupProcessor(userRepo, authadapter.NewMagicLinkPostgresRepository(db), appLogger))
+
+	// Notification Task Processor
+	prefsRepo := notifadapter.NewPreferencesPostgresRepository(db)
+	notifProcessor := notiftask.NewTaskProcessor(notificationsApp, habitsApp, prefsRepo, appLogger)
+	mux.HandleFunc(notiftask.TaskProcessReminders, notifProcessor.ProcessTask)
+	mux.HandleFunc(habittask.TaskHabitCreated, notifProcessor.ProcessHabitCreatedTask)
+	mux.HandleFunc(notiftask.TaskSendDailySummaries, notifProcessor.ProcessDailySummaryTask)
+
+	// Scheduled Notifications Processor
+	deliverScheduledProcessor := notiftask.NewDeliverScheduledProcessor(notificationsApp.Commands.DeliverScheduledNotifications, appLogger)
+	mux.Handle(notiftask.TaskDeliverScheduled, deliverScheduledProcessor)
+
+	// Paused Habits Processor
+	resumePausedProcessor := habittask.NewResumePausedHabitsProcessor(habitsApp.Commands.ResumePausedHabits, appLogger)
+	mux.Handle(habittask.TaskResumePausedHabits, resumePausedProcessor)
+
+	// Stats Consistency Processor
+	verifyStatsProcessor := habittask.NewVerifyStatsProcessor(habitsApp.Commands.RecomputeStats, appLogger)
+	mux.Handle(habittask.TaskVerifyStats, verifyStatsProcessor)
+
+	// Log Retention Processor
+	compactLogsProcessor := habittask.NewCompactLogsProcessor(habitsApp.Commands.CompactHabitLogs, appLogger)
+	mux.Handle(habittask.TaskCompactLogs, compactLogsProcessor)
+
+	// Habit Import Processor
+	importProcessor := habittask.NewImportProcessor(habitsApp.Commands.RunImport, appLogger)
+	mux.Handle(habittask.TaskRunImport, importProcessor)
+
+	// Email Task Processor
+	weeklyReportProcessor := notiftask.NewWeeklyReportProcessor(
+		habitsApp,
+		prefsRepo,
+		userProvider,
+		smtpClient,
+		emailTemplates,
+		cfg,
+		appLogger,
+	)
+	mux.Handle(notiftask.TaskSendWeeklyReports, weeklyReportProcessor)
+
+	reengagementProcessor := notiftask.NewReengagementProcessor(
+		notificationsApp,
+		habitsApp,
+		prefsRepo,
+		userProvider,
+		smtpClient,
+		emailTemplates,
+		cfg,
+		appLogger,
+	)
+	mux.Handle(notiftask.TaskSendReengagement, reengagementProcessor)
+
+	authTaskProcessor := authtask.NewTaskProcessor(appLogger, smtpClient, emailTemplates)
+	mux.HandleFunc(authtask.TaskSendVerifyEmail, authTaskProcessor.ProcessTaskSendVerifyEmail)
+	mux.HandleFunc(authtask.TaskSendForgotPasswordEmail, authTaskProcessor.ProcessTaskSendForgotPasswordEmail)
+	mux.HandleFunc(authtask.TaskSendMagicLinkEmail, authTaskProcessor.ProcessTaskSendMagicLinkEmail)
+
+	appLogger.Info(ctx, "starting worker and scheduler")
+
+	// Every replica processes the queues, but only the elected leader runs
+	// the scheduler so each cron entry is enqueued once
+	schedulerErrors := make(chan error, 1)
+	schedulerDone := make(chan struct{})
+	go func() {
+		defer close(schedulerDone)
+		leader.NewElector(db.DB, "worker-scheduler", appLogger).Run(ctx, func(leadCtx context.Context) {
+			if err := runScheduler(leadCtx, redisOpt, appLogger); err != nil {
+				select {
+				case schedulerErrors <- err:
+				default:
+				}
+			}
+		})
+	}()
+
+	// Start rather than Run: Run waits for OS signals itself, while the
+	// caller decides when to stop through ctx
+	if err := srv.Start(mux); err != nil {
+		return fmt.Errorf("worker server failed: %w", err)
+	}
+
+	// Wait for shutdown signal or error
+	select {
+	case err := <-schedulerErrors:
+		return fmt.Errorf("scheduler failed: %w", err)
+	case <-ctx.Done():
+		appLogger.Info(ctx, "shutdown signal received")
+	}
+
+	// Graceful shutdown
+	srv.Shutdown()
+	<-schedulerDone
+
+	drainCtx, cancelDrain := context.WithTimeout(context.Background(), outboxDrainTimeout)
+	defer cancelDrain()
+	if err := outboxProcessor.Stop(drainCtx); err != nil {
+		appLogger.Warn(ctx, "outbox processor did not drain before the deadline",
+			logger.Field{Key: "error", Value: err.Error()})
+	}
+
+	appLogger.Info(ctx, "worker stopped gracefully")
+	return nil
+}
+
+// runScheduler enqueues the periodic tasks until ctx is canceled, which
+// happens when this replica stops being the scheduler leader
+// waitForRedis pings Redis until it answers
+func waitForRedis(ctx context.Context, waiter *startup.Waiter, cfg *config.Config) error {
+	client := redis.NewClient(&redis.Options{
+		Addr:     cfg.RedisDSN(),
+		Password: cfg.RedisPassword,
+		DB:       cfg.RedisDB,
+	})
+	defer client.Close()
+
+	return waiter.Retry(ctx, "redis", func(ctx context.Context) error {
+		return client.Ping(ctx).Err()
+	})
+}
+
+func runScheduler(ctx context.Context, redisOpt asynq.RedisClientOpt, appLogger logger.Logger) error {
+	scheduler := asynq.NewScheduler(
+		redisOpt,
+		&asynq.SchedulerOpts{
+			Logger: NewAsynqLogger(appLogger),
+		},
+	)
+
+	// Register scheduled tasks
+	if _, err := scheduler.Register("@every 15m", authtask.NewSessionCleanupTask()); err != nil {
+		return fmt.Errorf("failed to register cleanup schedule: %w", err)
+	}
+	if _, err := scheduler.Register("@every 15m", authtask.NewCodeCleanupTask()); err != nil {
+		return fmt.Errorf("failed to register code cleanup schedule: %w", err)
+	}
+
+	if _, err := scheduler.Register("* * * * *", notiftask.NewProcessRemindersTask()); err != nil {
+		return fmt.Errorf("failed to register notification schedule: %w", err)
+	}
+
+	// Evenings start at different hours across timezones, so check hourly
+	if _, err := scheduler.Register("0 * * * *", notiftask.NewSendDailySummariesTask()); err != nil {
+		return fmt.Errorf("failed to register daily summary schedule: %w", err)
+	}
+
+	// Weekly progress emails every evening to the users whose week (by
+	// their week start day) is complete
+	if _, err := scheduler.Register("0 19 * * *", notiftask.NewSendWeeklyReportsTask()); err != nil {
+		return fmt.Errorf("failed to register weekly report schedule: %w", err)
+	}
+
+	// Deliver scheduled and snoozed notifications within a minute of due
+	if _, err := scheduler.Register("* * * * *", notiftask.NewDeliverScheduledTask()); err != nil {
+		return fmt.Errorf("failed to register scheduled notifications schedule: %w", err)
+	}
+
+	// Nudge inactive users daily; each is capped at one nudge a week
+	if _, err := scheduler.Register("0 10 * * *", notiftask.NewSendReengagementTask()); err != nil {
+		return fmt.Errorf("failed to register re-engagement schedule: %w", err)
+	}
+
+	// Pauses end at local midnight, so check hourly across timezones
+	if _, err := scheduler.Register("@every 1h", habittask.NewResumePausedHabitsTask()); err != nil {
+		return fmt.Errorf("failed to register paused habits schedule: %w", err)
+	}
+
+	// Repair habit stats that drifted from their logs, nightly at off-peak
+	if _, err := scheduler.Register("0 3 * * *", habittask.NewVerifyStatsTask()); err != nil {
+		return fmt.Errorf("failed to register stats verification schedule: %w", err)
+	}
+
+	// Compact logs past their plan's retention, nightly after the stats check
+	if _, err := scheduler.Register("0 4 * * *", habittask.NewCompactLogsTask()); err != nil {
+		return fmt.Errorf("failed to register log compaction schedule: %w", err)
+	}
+
+	if err := scheduler.Start(); err != nil {
+		return fmt.Errorf("failed to start scheduler: %w", err)
+	}
+
+	<-ctx.Done()
+	scheduler.Shutdown()
+	return nil
+}
+
+// NewAsynqLogger adapts our structured logger to asynq logger interface
+func NewAsynqLogger(l logger.Logger) asynq.Logger {
+	return &asynqLoggerAdapter{l}
+}
+
+type asynqLoggerAdapter struct {
+	logger logger.Logger
+}
+
+func (l *asynqLoggerAdapter) Debug(args ...interface{}) {
+	l.logger.Debug(context.Background(), "asynq", logger.Field{Key: "msg", Value: args})
+}
+
+func (l *asynqLoggerAdapter) Info(args ...interface{}) {
+	l.logger.Info(context.Background(), "asynq", logger.Field{Key: "msg", Value: args})
+}
+
+func (l *asynqLoggerAdapter) Warn(args ...interface{}) {
+	l.logger.Warn(context.Background(), "asynq", logger.Field{Key: "msg", Value: args})
+}
+
+func (l *asynqLoggerAdapter) Error(args ...interface{}) {
+	l.logger.Error(context.Background(), nil, "asynq", logger.Field{Key: "msg", Value: args})
+}
+
+func (l *asynqLoggerAdapter) Fatal(args ...interface{}) {
+	l.logger.Error(context.Background(), nil, "asynq fatal", logger.Field{Key: "msg", Value: args})
+	os.Exit(1)
+}
+
+// dashboardEventTypes are the habit events that change a user's dashboard
+var dashboardEventTypes = []string{
+	habitevents.HabitCreatedType,
+	habitevents.HabitCompletedType,
+	habitevents.HabitDeactivatedType,
+	habitevents.HabitActivatedType,
+	habitevents.HabitPausedType,
+	habitevents.HabitDeletedType,
+	habitevents.HabitLogDeletedType,
+}
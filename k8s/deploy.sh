@@ -76,6 +76,7 @@ create_secrets() {
     echo "  --from-literal=DB_PASSWORD=YOUR_DB_PASSWORD \\"
     echo "  --from-literal=REDIS_PASSWORD=YOUR_REDIS_PASSWORD \\"
     echo "  --from-literal=AUTH_JWT_SECRET=\$(openssl rand -base64 32) \\"
+    echo "  --from-literal=AUTH_CODE_SECRET=\$(openssl rand -base64 32) \\"
     echo "  --from-literal=SMTP_PASSWORD=YOUR_SMTP_PASSWORD \\"
     echo "  --namespace=$NAMESPACE"
     echo ""
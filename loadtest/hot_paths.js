@@ -0,0 +1,139 @@
+// k6 scenario for the hot read/write paths: GetDashboard, ListHabits and
+// LogHabit.
+//
+// The script logs in as an existing, verified user. For numbers that are
+// comparable with the Go benchmarks, the user should own ~10 habits with
+// ~10k logs in total.
+//
+// Usage:
+//
+//   BASE_URL=http://localhost:8080 EMAIL=bench@example.com PASSWORD=secret \
+//     make load-test
+//
+// Tunables (env): VUS (default 20), DURATION (default 1m).
+// The end-of-test summary is written to loadtest/results/summary.json so CI
+// can diff it against a previous run.
+
+import http from 'k6/http';
+import { check, fail } from 'k6';
+
+const BASE_URL = __ENV.BASE_URL || 'http://localhost:8080';
+const VUS = parseInt(__ENV.VUS || '20', 10);
+const DURATION = __ENV.DURATION || '1m';
+
+export const options = {
+  scenarios: {
+    dashboard: {
+      executor: 'constant-vus',
+      exec: 'getDashboard',
+      vus: VUS,
+      duration: DURATION,
+    },
+    list_habits: {
+      executor: 'constant-vus',
+      exec: 'listHabits',
+      vus: VUS,
+      duration: DURATION,
+    },
+    log_habit: {
+      executor: 'constant-vus',
+      exec: 'logHabit',
+      vus: Math.max(1, Math.floor(VUS / 4)),
+      duration: DURATION,
+    },
+  },
+  thresholds: {
+    'http_req_failed': ['rate<0.01'],
+    'http_req_duration{scenario:dashboard}': ['p(95)<300'],
+    'http_req_duration{scenario:list_habits}': ['p(95)<150'],
+    'http_req_duration{scenario:log_habit}': ['p(95)<400'],
+  },
+};
+
+export function setup() {
+  if (!__ENV.EMAIL || !__ENV.PASSWORD) {
+    fail('EMAIL and PASSWORD must be set to an existing, verified user');
+  }
+
+  const login = http.post(
+    `${BASE_URL}/api/v1/auth/login`,
+    JSON.stringify({ email: __ENV.EMAIL, password: __ENV.PASSWORD }),
+    { headers: { 'Content-Type': 'application/json' } },
+  );
+  if (login.status !== 200) {
+    fail(`login failed: ${login.status} ${login.body}`);
+  }
+  const token = login.json('data.access_token');
+
+  const habits = http.get(`${BASE_URL}/api/v1/habits?per_page=100`, authHeaders(token));
+  if (habits.status !== 200) {
+    fail(`list habits failed: ${habits.status} ${habits.body}`);
+  }
+  const habitIDs = (habits.json('data') || []).map((h) => h.id);
+  if (habitIDs.length === 0) {
+    fail('the load test user has no habits');
+  }
+
+  return { token, habitIDs };
+}
+
+export function getDashboard(data) {
+  const res = http.get(`${BASE_URL}/api/v1/dashboard`, authHeaders(data.token));
+  check(res, { 'dashboard 200': (r) => r.status === 200 });
+}
+
+export function listHabits(data) {
+  const res = http.get(`${BASE_URL}/api/v1/habits?page=1&per_page=20`, authHeaders(data.token));
+  check(res, { 'list habits 200': (r) => r.status === 200 });
+}
+
+export function logHabit(data) {
+  const habitID = data.habitIDs[Math.floor(Math.random() * data.habitIDs.length)];
+  const today = new Date().toISOString().slice(0, 10);
+  const res = http.post(
+    `${BASE_URL}/api/v1/habits/${habitID}/logs`,
+    JSON.stringify({ log_date: today, count: 1 }),
+    authHeaders(data.token),
+  );
+  check(res, { 'log habit 200': (r) => r.status === 200 });
+}
+
+export function handleSummary(data) {
+  return {
+    'loadtest/results/summary.json': JSON.stringify(data, null, 2),
+    stdout: textSummary(data),
+  };
+}
+
+function authHeaders(token) {
+  return {
+    headers: {
+      'Content-Type': 'application/json',
+      Authorization: `Bearer ${token}`,
+    },
+  };
+}
+
+// textSummary prints p50/p95/p99 per scenario in a fixed, diff-friendly format.
+function textSummary(data) {
+  const lines = [];
+  for (const scenario of ['dashboard', 'list_habits', 'log_habit']) {
+    const m = data.metrics[`http_req_duration{scenario:${scenario}}`];
+    if (!m) {
+      continue;
+    }
+    const v = m.values;
+    lines.push(
+      `${scenario.padEnd(12)} p50=${fmt(v.med)}ms p95=${fmt(v['p(95)'])}ms max=${fmt(v.max)}ms`,
+    );
+  }
+  const failed = data.metrics.http_req_failed;
+  if (failed) {
+    lines.push(`failed       rate=${(failed.values.rate * 100).toFixed(2)}%`);
+  }
+  return lines.join('\n') + '\n';
+}
+
+function fmt(n) {
+  return (n || 0).toFixed(2);
+}
@@ -0,0 +1,6 @@
+-- ============================================================================
+-- DROP HABIT POSITION
+-- ============================================================================
+
+DROP INDEX IF EXISTS idx_habits_user_position;
+ALTER TABLE habits DROP COLUMN IF EXISTS position;
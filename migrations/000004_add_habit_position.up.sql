@@ -0,0 +1,19 @@
+-- ============================================================================
+-- HABIT POSITION
+-- User-defined display order for habits (lower comes first)
+-- ============================================================================
+
+ALTER TABLE habits ADD COLUMN IF NOT EXISTS position INTEGER NOT NULL DEFAULT 0;
+
+-- Keep the current order (oldest first) for existing habits
+UPDATE habits h
+SET position = ordered.rn
+FROM (
+    SELECT habit_id, ROW_NUMBER() OVER (PARTITION BY user_id ORDER BY created_at, habit_id) - 1 AS rn
+    FROM habits
+) ordered
+WHERE h.habit_id = ordered.habit_id;
+
+CREATE INDEX IF NOT EXISTS idx_habits_user_position ON habits(user_id, position);
+
+COMMENT ON COLUMN habits.position IS 'Urutan tampilan kebiasaan yang diatur oleh user';
@@ -0,0 +1,6 @@
+-- ============================================================================
+-- DROP HABIT PAUSE
+-- ============================================================================
+
+DROP INDEX IF EXISTS idx_habits_paused_until;
+ALTER TABLE habits DROP COLUMN IF EXISTS paused_until;
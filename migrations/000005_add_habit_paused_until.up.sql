@@ -0,0 +1,10 @@
+-- ============================================================================
+-- HABIT PAUSE
+-- Inactive habits with paused_until set are resumed by the worker on that date
+-- ============================================================================
+
+ALTER TABLE habits ADD COLUMN IF NOT EXISTS paused_until DATE;
+
+CREATE INDEX IF NOT EXISTS idx_habits_paused_until ON habits(paused_until) WHERE paused_until IS NOT NULL;
+
+COMMENT ON COLUMN habits.paused_until IS 'Tanggal kebiasaan yang dijeda akan aktif kembali secara otomatis';
@@ -0,0 +1,5 @@
+-- ============================================================================
+-- DROP HABIT LOG LOCK
+-- ============================================================================
+
+ALTER TABLE users DROP COLUMN IF EXISTS log_lock_days;
@@ -0,0 +1,8 @@
+-- ============================================================================
+-- HABIT LOG LOCK
+-- Users may lock habit logs older than N days against changes
+-- ============================================================================
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS log_lock_days INT CHECK (log_lock_days > 0);
+
+COMMENT ON COLUMN users.log_lock_days IS 'Log kebiasaan yang lebih lama dari jumlah hari ini tidak dapat diubah; NULL berarti tidak dikunci';
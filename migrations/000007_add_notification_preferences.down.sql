@@ -0,0 +1,12 @@
+-- ============================================================================
+-- DROP NOTIFICATION PREFERENCES
+-- ============================================================================
+
+DELETE FROM notifications WHERE type = 'daily_summary';
+
+ALTER TABLE notifications DROP CONSTRAINT IF EXISTS valid_notification_type;
+ALTER TABLE notifications ADD CONSTRAINT valid_notification_type
+    CHECK (type IN ('streak_milestone', 'habit_reminder', 'achievement', 'system', 'welcome'));
+
+DROP TABLE IF EXISTS notification_deliveries;
+DROP TABLE IF EXISTS notification_preferences;
@@ -0,0 +1,37 @@
+-- ============================================================================
+-- NOTIFICATION PREFERENCES
+-- Per-user opt-outs and quiet hours for scheduled notifications
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS notification_preferences (
+    user_id UUID PRIMARY KEY REFERENCES users(user_id) ON DELETE CASCADE,
+    daily_summary BOOLEAN NOT NULL DEFAULT true,
+    quiet_hours_start VARCHAR(5),
+    quiet_hours_end VARCHAR(5),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT valid_quiet_hours CHECK ((quiet_hours_start IS NULL) = (quiet_hours_end IS NULL))
+);
+
+COMMENT ON COLUMN notification_preferences.daily_summary IS 'Kirim ringkasan harian di akhir hari';
+COMMENT ON COLUMN notification_preferences.quiet_hours_start IS 'Awal jam tenang (HH:MM, zona waktu pengguna)';
+COMMENT ON COLUMN notification_preferences.quiet_hours_end IS 'Akhir jam tenang (HH:MM, zona waktu pengguna)';
+
+-- ============================================================================
+-- NOTIFICATION DELIVERIES
+-- One row per scheduled notification kind per user per day, so retried or
+-- overlapping runs never send the same notification twice
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS notification_deliveries (
+    user_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    kind VARCHAR(50) NOT NULL,
+    period_date DATE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, kind, period_date)
+);
+
+COMMENT ON COLUMN notification_deliveries.period_date IS 'Tanggal lokal pengguna yang dicakup notifikasi';
+
+ALTER TABLE notifications DROP CONSTRAINT IF EXISTS valid_notification_type;
+ALTER TABLE notifications ADD CONSTRAINT valid_notification_type
+    CHECK (type IN ('streak_milestone', 'habit_reminder', 'achievement', 'system', 'welcome', 'daily_summary'));
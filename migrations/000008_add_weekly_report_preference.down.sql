@@ -0,0 +1,6 @@
+-- ============================================================================
+-- DROP WEEKLY REPORT PREFERENCE
+-- ============================================================================
+
+ALTER TABLE notification_preferences DROP COLUMN IF EXISTS unsubscribe_token;
+ALTER TABLE notification_preferences DROP COLUMN IF EXISTS weekly_report;
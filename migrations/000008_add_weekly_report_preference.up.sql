@@ -0,0 +1,10 @@
+-- ============================================================================
+-- WEEKLY REPORT PREFERENCE
+-- Weekly progress emails, with a per-user token for one-click unsubscribe
+-- ============================================================================
+
+ALTER TABLE notification_preferences ADD COLUMN IF NOT EXISTS weekly_report BOOLEAN NOT NULL DEFAULT true;
+ALTER TABLE notification_preferences ADD COLUMN IF NOT EXISTS unsubscribe_token UUID UNIQUE;
+
+COMMENT ON COLUMN notification_preferences.weekly_report IS 'Kirim laporan kemajuan mingguan melalui email';
+COMMENT ON COLUMN notification_preferences.unsubscribe_token IS 'Token tautan berhenti berlangganan pada email laporan';
@@ -0,0 +1,12 @@
+-- ============================================================================
+-- DROP RE-ENGAGEMENT CAMPAIGN
+-- ============================================================================
+
+DELETE FROM notifications WHERE type = 'reengagement';
+DELETE FROM notification_deliveries WHERE kind = 'reengagement';
+
+ALTER TABLE notifications DROP CONSTRAINT IF EXISTS valid_notification_type;
+ALTER TABLE notifications ADD CONSTRAINT valid_notification_type
+    CHECK (type IN ('streak_milestone', 'habit_reminder', 'achievement', 'system', 'welcome', 'daily_summary'));
+
+ALTER TABLE notification_preferences DROP COLUMN IF EXISTS reengagement;
@@ -0,0 +1,13 @@
+-- ============================================================================
+-- RE-ENGAGEMENT CAMPAIGN
+-- Nudges for users who stopped logging; sends are capped through the
+-- notification_deliveries ledger
+-- ============================================================================
+
+ALTER TABLE notification_preferences ADD COLUMN IF NOT EXISTS reengagement BOOLEAN NOT NULL DEFAULT true;
+
+COMMENT ON COLUMN notification_preferences.reengagement IS 'Kirim pengingat ketika pengguna lama tidak mencatat kebiasaan';
+
+ALTER TABLE notifications DROP CONSTRAINT IF EXISTS valid_notification_type;
+ALTER TABLE notifications ADD CONSTRAINT valid_notification_type
+    CHECK (type IN ('streak_milestone', 'habit_reminder', 'achievement', 'system', 'welcome', 'daily_summary', 'reengagement'));
@@ -0,0 +1,9 @@
+-- ============================================================================
+-- DROP REMINDER ESCALATION
+-- ============================================================================
+
+UPDATE notifications SET type = 'habit_reminder' WHERE type = 'reminder_escalation';
+
+ALTER TABLE notifications DROP CONSTRAINT IF EXISTS valid_notification_type;
+ALTER TABLE notifications ADD CONSTRAINT valid_notification_type
+    CHECK (type IN ('streak_milestone', 'habit_reminder', 'achievement', 'system', 'welcome', 'daily_summary', 'reengagement'));
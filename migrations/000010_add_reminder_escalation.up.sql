@@ -0,0 +1,8 @@
+-- ============================================================================
+-- REMINDER ESCALATION
+-- Habits missed several scheduled days in a row get a different reminder
+-- ============================================================================
+
+ALTER TABLE notifications DROP CONSTRAINT IF EXISTS valid_notification_type;
+ALTER TABLE notifications ADD CONSTRAINT valid_notification_type
+    CHECK (type IN ('streak_milestone', 'habit_reminder', 'achievement', 'system', 'welcome', 'daily_summary', 'reengagement', 'reminder_escalation'));
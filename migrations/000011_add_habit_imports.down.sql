@@ -0,0 +1,5 @@
+-- ============================================================================
+-- DROP HABIT IMPORTS
+-- ============================================================================
+
+DROP TABLE IF EXISTS habit_imports;
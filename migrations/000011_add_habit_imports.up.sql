@@ -0,0 +1,29 @@
+-- ============================================================================
+-- HABIT IMPORTS
+-- Export files from other habit trackers, parsed and queued to be written as
+-- habits and logs by the worker
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS habit_imports (
+    import_id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    source VARCHAR(20) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    habits JSONB NOT NULL,
+    total_habits INT NOT NULL,
+    total_logs INT NOT NULL,
+    imported_habits INT NOT NULL DEFAULT 0,
+    imported_logs INT NOT NULL DEFAULT 0,
+    failure TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    completed_at TIMESTAMP WITH TIME ZONE,
+    CONSTRAINT valid_import_source CHECK (source IN ('habitica', 'loop', 'streaks')),
+    CONSTRAINT valid_import_status CHECK (status IN ('pending', 'running', 'completed', 'failed'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_habit_imports_user ON habit_imports(user_id, created_at DESC);
+
+COMMENT ON TABLE habit_imports IS 'Impor data dari aplikasi pelacak kebiasaan lain';
+COMMENT ON COLUMN habit_imports.habits IS 'Kebiasaan dan catatan hasil parsing file ekspor, siap ditulis';
+COMMENT ON COLUMN habit_imports.imported_habits IS 'Jumlah kebiasaan yang sudah ditulis, untuk progres impor';
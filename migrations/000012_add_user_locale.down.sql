@@ -0,0 +1,5 @@
+-- ============================================================================
+-- DROP USER LOCALE
+-- ============================================================================
+
+ALTER TABLE users DROP COLUMN IF EXISTS locale;
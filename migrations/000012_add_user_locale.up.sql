@@ -0,0 +1,8 @@
+-- ============================================================================
+-- USER LOCALE
+-- Language for server-generated messages, notifications and emails
+-- ============================================================================
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS locale VARCHAR(10) NOT NULL DEFAULT 'en';
+
+COMMENT ON COLUMN users.locale IS 'Bahasa untuk pesan, notifikasi, dan email dari server (mis. en, id)';
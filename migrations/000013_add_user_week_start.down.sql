@@ -0,0 +1,5 @@
+-- ============================================================================
+-- DROP USER WEEK START
+-- ============================================================================
+
+ALTER TABLE users DROP COLUMN IF EXISTS week_start;
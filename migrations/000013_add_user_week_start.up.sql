@@ -0,0 +1,9 @@
+-- ============================================================================
+-- USER WEEK START
+-- Day weekly stats and the weekly report begin on (0 = Sunday ... 6 = Saturday)
+-- ============================================================================
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS week_start SMALLINT NOT NULL DEFAULT 1
+    CHECK (week_start IN (0, 1, 6));
+
+COMMENT ON COLUMN users.week_start IS 'Hari awal minggu untuk statistik dan laporan mingguan: 0 = Minggu, 1 = Senin, 6 = Sabtu';
@@ -0,0 +1,6 @@
+-- ============================================================================
+-- DROP SESSION LOCATION
+-- ============================================================================
+
+ALTER TABLE sessions DROP COLUMN IF EXISTS country;
+ALTER TABLE sessions DROP COLUMN IF EXISTS city;
@@ -0,0 +1,10 @@
+-- ============================================================================
+-- SESSION LOCATION
+-- Approximate city/country of the client IP, looked up via GeoIP at login
+-- ============================================================================
+
+ALTER TABLE sessions ADD COLUMN IF NOT EXISTS city VARCHAR(100) NOT NULL DEFAULT '';
+ALTER TABLE sessions ADD COLUMN IF NOT EXISTS country VARCHAR(100) NOT NULL DEFAULT '';
+
+COMMENT ON COLUMN sessions.city IS 'Perkiraan kota asal IP klien saat login, kosong jika tidak diketahui';
+COMMENT ON COLUMN sessions.country IS 'Perkiraan negara asal IP klien saat login, kosong jika tidak diketahui';
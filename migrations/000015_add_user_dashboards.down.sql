@@ -0,0 +1,5 @@
+-- ============================================================================
+-- DROP USER DASHBOARDS
+-- ============================================================================
+
+DROP TABLE IF EXISTS user_dashboards;
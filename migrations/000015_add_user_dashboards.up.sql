@@ -0,0 +1,16 @@
+-- ============================================================================
+-- USER DASHBOARDS
+-- Read model of GET /dashboard, refreshed by habit event handlers so the
+-- query is a single-row read. computed_on is the UTC day the summary is valid
+-- for; older rows are recomputed on read.
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS user_dashboards (
+    user_id UUID PRIMARY KEY REFERENCES users(user_id) ON DELETE CASCADE,
+    summary JSONB NOT NULL,
+    computed_on DATE NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+COMMENT ON TABLE user_dashboards IS 'Proyeksi ringkasan dasbor per pengguna, diperbarui oleh event handler kebiasaan';
+COMMENT ON COLUMN user_dashboards.computed_on IS 'Tanggal (UTC) ringkasan dihitung; baris dari hari sebelumnya dihitung ulang saat dibaca';
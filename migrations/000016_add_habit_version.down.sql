@@ -0,0 +1,5 @@
+-- ============================================================================
+-- DROP HABIT VERSION
+-- ============================================================================
+
+ALTER TABLE habits DROP COLUMN IF EXISTS version;
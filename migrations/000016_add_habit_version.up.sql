@@ -0,0 +1,9 @@
+-- ============================================================================
+-- HABIT VERSION
+-- Incremented on every update so concurrent edits are detected instead of
+-- silently overwriting each other
+-- ============================================================================
+
+ALTER TABLE habits ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
+
+COMMENT ON COLUMN habits.version IS 'Versi kebiasaan, bertambah setiap kali diubah; dipakai untuk mendeteksi perubahan bersamaan';
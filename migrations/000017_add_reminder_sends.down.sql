@@ -0,0 +1,5 @@
+-- ============================================================================
+-- DROP REMINDER SENDS
+-- ============================================================================
+
+DROP TABLE IF EXISTS reminder_sends;
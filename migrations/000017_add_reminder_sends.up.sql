@@ -0,0 +1,14 @@
+-- ============================================================================
+-- REMINDER SENDS
+-- One row per habit per local day a reminder was sent, so overlapping or
+-- restarted reminder sweeps never remind about the same habit twice
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS reminder_sends (
+    habit_id UUID NOT NULL REFERENCES habits(habit_id) ON DELETE CASCADE,
+    reminder_date DATE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (habit_id, reminder_date)
+);
+
+COMMENT ON COLUMN reminder_sends.reminder_date IS 'Tanggal lokal pengguna saat pengingat dikirim';
@@ -0,0 +1,6 @@
+-- ============================================================================
+-- DROP OUTBOX NOTIFY
+-- ============================================================================
+
+DROP TRIGGER IF EXISTS outbox_inserted ON outbox;
+DROP FUNCTION IF EXISTS notify_outbox_inserted();
@@ -0,0 +1,21 @@
+-- ============================================================================
+-- OUTBOX NOTIFY
+-- Wakes the outbox processor as soon as events are committed instead of
+-- waiting for its next poll. Notifications are delivered on commit and
+-- coalesced per transaction, so one is sent per inserting statement batch.
+-- ============================================================================
+
+CREATE OR REPLACE FUNCTION notify_outbox_inserted() RETURNS trigger AS $$
+BEGIN
+    PERFORM pg_notify('outbox_inserted', '');
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS outbox_inserted ON outbox;
+CREATE TRIGGER outbox_inserted
+    AFTER INSERT ON outbox
+    FOR EACH STATEMENT
+    EXECUTE FUNCTION notify_outbox_inserted();
+
+COMMENT ON FUNCTION notify_outbox_inserted() IS 'Memberi tahu pemroses outbox bahwa ada event baru';
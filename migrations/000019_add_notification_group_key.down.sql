@@ -0,0 +1,6 @@
+-- ============================================================================
+-- DROP NOTIFICATION GROUPS
+-- ============================================================================
+
+DROP INDEX IF EXISTS idx_notifications_user_group;
+ALTER TABLE notifications DROP COLUMN IF EXISTS group_key;
@@ -0,0 +1,19 @@
+-- ============================================================================
+-- NOTIFICATION GROUPS
+-- Notifications sharing a group_key form one thread, so a grouped listing
+-- can collapse e.g. all the reminders of one habit into a single entry
+-- ============================================================================
+
+ALTER TABLE notifications ADD COLUMN IF NOT EXISTS group_key VARCHAR(100);
+
+UPDATE notifications
+SET group_key = 'habit:' || (data->>'habit_id')
+WHERE group_key IS NULL
+  AND type IN ('habit_reminder', 'reminder_escalation')
+  AND data ? 'habit_id';
+
+CREATE INDEX IF NOT EXISTS idx_notifications_user_group
+    ON notifications(user_id, group_key, created_at DESC)
+    WHERE group_key IS NOT NULL;
+
+COMMENT ON COLUMN notifications.group_key IS 'Kunci utas notifikasi, misalnya habit:<habit_id>; NULL berarti tidak dikelompokkan';
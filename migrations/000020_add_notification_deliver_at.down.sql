@@ -0,0 +1,6 @@
+-- ============================================================================
+-- DROP SCHEDULED NOTIFICATIONS
+-- ============================================================================
+
+DROP INDEX IF EXISTS idx_notifications_deliver_at;
+ALTER TABLE notifications DROP COLUMN IF EXISTS deliver_at;
@@ -0,0 +1,13 @@
+-- ============================================================================
+-- SCHEDULED NOTIFICATIONS
+-- A notification with deliver_at set is pending: it stays out of the inbox
+-- until the worker's delivery sweep clears deliver_at at that time
+-- ============================================================================
+
+ALTER TABLE notifications ADD COLUMN IF NOT EXISTS deliver_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX IF NOT EXISTS idx_notifications_deliver_at
+    ON notifications(deliver_at)
+    WHERE deliver_at IS NOT NULL;
+
+COMMENT ON COLUMN notifications.deliver_at IS 'Waktu notifikasi tertunda dikirim; NULL berarti sudah terkirim';
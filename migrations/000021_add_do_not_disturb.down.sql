@@ -0,0 +1,6 @@
+-- ============================================================================
+-- DROP DO NOT DISTURB
+-- ============================================================================
+
+ALTER TABLE notification_preferences DROP COLUMN IF EXISTS do_not_disturb_until;
+ALTER TABLE notification_preferences DROP COLUMN IF EXISTS do_not_disturb;
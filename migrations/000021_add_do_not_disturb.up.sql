@@ -0,0 +1,11 @@
+-- ============================================================================
+-- DO NOT DISTURB
+-- Suppresses habit reminders until switched off or until the optional
+-- expiry passes; other in-app notifications are still recorded
+-- ============================================================================
+
+ALTER TABLE notification_preferences ADD COLUMN IF NOT EXISTS do_not_disturb BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE notification_preferences ADD COLUMN IF NOT EXISTS do_not_disturb_until TIMESTAMP WITH TIME ZONE;
+
+COMMENT ON COLUMN notification_preferences.do_not_disturb IS 'Mode jangan ganggu: pengingat kebiasaan tidak dikirim';
+COMMENT ON COLUMN notification_preferences.do_not_disturb_until IS 'Waktu mode jangan ganggu berakhir otomatis; NULL berarti sampai dimatikan';
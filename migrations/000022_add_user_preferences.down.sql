@@ -0,0 +1,5 @@
+-- ============================================================================
+-- DROP USER PREFERENCES
+-- ============================================================================
+
+ALTER TABLE users DROP COLUMN IF EXISTS preferences;
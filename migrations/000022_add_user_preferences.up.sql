@@ -0,0 +1,10 @@
+-- ============================================================================
+-- USER PREFERENCES
+-- Free-form per-user settings (theme, default reminder hour, ...) kept as a
+-- JSON object so new settings do not each need a migration. Settings that
+-- other queries filter on, such as locale and week_start, stay columns.
+-- ============================================================================
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS preferences JSONB NOT NULL DEFAULT '{}'::jsonb;
+
+COMMENT ON COLUMN users.preferences IS 'Preferensi pengguna dalam bentuk objek JSON (tema, jam pengingat bawaan, dll.)';
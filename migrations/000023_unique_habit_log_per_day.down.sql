@@ -0,0 +1,7 @@
+-- ============================================================================
+-- DROP ONE HABIT LOG PER DAY
+-- Merged duplicates are not split again
+-- ============================================================================
+
+ALTER TABLE habit_logs DROP CONSTRAINT IF EXISTS uq_habit_logs_habit_date;
+CREATE INDEX IF NOT EXISTS idx_habit_logs_habit_date ON habit_logs (habit_id, log_date DESC);
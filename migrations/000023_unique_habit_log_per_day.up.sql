@@ -0,0 +1,34 @@
+-- ============================================================================
+-- ONE HABIT LOG PER DAY
+-- A habit has at most one log per day; logging it again adds to that log's
+-- count. Existing duplicates are merged into the day's earliest log.
+-- ============================================================================
+
+WITH days AS (
+    SELECT habit_id,
+           log_date,
+           (array_agg(log_id ORDER BY created_at, log_id))[1] AS keep_id,
+           sum(count) AS total,
+           string_agg(note, E'\n' ORDER BY created_at) AS notes,
+           max(updated_at) AS last_update
+    FROM habit_logs
+    GROUP BY habit_id, log_date
+    HAVING count(*) > 1
+),
+merged AS (
+    UPDATE habit_logs l
+    SET count = d.total, note = d.notes, updated_at = d.last_update
+    FROM days d
+    WHERE l.log_id = d.keep_id
+)
+DELETE FROM habit_logs l
+USING days d
+WHERE l.habit_id = d.habit_id AND l.log_date = d.log_date AND l.log_id <> d.keep_id;
+
+ALTER TABLE habit_logs
+    ADD CONSTRAINT uq_habit_logs_habit_date UNIQUE (habit_id, log_date);
+
+COMMENT ON CONSTRAINT uq_habit_logs_habit_date ON habit_logs IS 'Satu catatan per kebiasaan per hari; pencatatan ulang menambah jumlahnya';
+
+-- The constraint's index serves the same lookups
+DROP INDEX IF EXISTS idx_habit_logs_habit_date;
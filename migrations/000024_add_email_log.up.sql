@@ -0,0 +1,27 @@
+-- ============================================================================
+-- EMAIL LOG
+-- One row per attempted email, so support can check whether an email was
+-- sent without searching the logs. Recipients are stored as a SHA-256 hash
+-- of the normalized address: a known address can be looked up, but the log
+-- does not hold the addresses themselves.
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS email_log (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    email_type VARCHAR(64) NOT NULL,
+    recipient_hash CHAR(64) NOT NULL,
+    provider VARCHAR(255) NOT NULL,
+    status VARCHAR(16) NOT NULL CHECK (status IN ('sent', 'failed')),
+    error TEXT,
+    latency_ms INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_email_log_recipient ON email_log(recipient_hash, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_email_log_created_at ON email_log(created_at DESC);
+
+COMMENT ON TABLE email_log IS 'Catatan setiap percobaan pengiriman email';
+COMMENT ON COLUMN email_log.email_type IS 'Jenis email, misalnya nama template';
+COMMENT ON COLUMN email_log.recipient_hash IS 'Hash SHA-256 dari alamat penerima yang dinormalisasi';
+COMMENT ON COLUMN email_log.status IS 'Hasil pengiriman: sent atau failed';
+COMMENT ON COLUMN email_log.error IS 'Pesan kesalahan dari penyedia email saat gagal';
@@ -0,0 +1,18 @@
+-- ============================================================================
+-- UNHASH VERIFICATION CODES
+-- Hashed codes cannot be restored, so pending codes are cleared; users
+-- request a new one.
+-- ============================================================================
+
+UPDATE users
+SET verify_code_hash = NULL, verify_expires_at = NULL,
+    password_reset_code_hash = NULL, password_reset_expires_at = NULL;
+
+ALTER TABLE users DROP COLUMN IF EXISTS verify_attempts;
+ALTER TABLE users DROP COLUMN IF EXISTS password_reset_attempts;
+
+ALTER TABLE users RENAME COLUMN verify_code_hash TO verify_token;
+ALTER TABLE users RENAME COLUMN password_reset_code_hash TO password_reset_token;
+
+CREATE INDEX IF NOT EXISTS idx_users_verify_token ON users(verify_token);
+CREATE INDEX IF NOT EXISTS idx_users_reset_token ON users(password_reset_token);
@@ -0,0 +1,29 @@
+-- ============================================================================
+-- HASH VERIFICATION CODES
+-- Email verification and password reset codes are stored as a SHA-256 hash
+-- salted with the user ID, and count wrong guesses so a code locks after
+-- five attempts. Pending plaintext codes are hashed in place.
+-- ============================================================================
+
+ALTER TABLE users RENAME COLUMN verify_token TO verify_code_hash;
+ALTER TABLE users RENAME COLUMN password_reset_token TO password_reset_code_hash;
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS verify_attempts INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS password_reset_attempts INTEGER NOT NULL DEFAULT 0;
+
+UPDATE users
+SET verify_code_hash = encode(sha256((user_id::text || ':' || verify_code_hash)::bytea), 'hex')
+WHERE verify_code_hash IS NOT NULL;
+
+UPDATE users
+SET password_reset_code_hash = encode(sha256((user_id::text || ':' || password_reset_code_hash)::bytea), 'hex')
+WHERE password_reset_code_hash IS NOT NULL;
+
+-- Codes are looked up by email, never by value
+DROP INDEX IF EXISTS idx_users_verify_token;
+DROP INDEX IF EXISTS idx_users_reset_token;
+
+COMMENT ON COLUMN users.verify_code_hash IS 'Hash SHA-256 dari kode verifikasi email, digarami dengan user_id';
+COMMENT ON COLUMN users.verify_attempts IS 'Jumlah tebakan salah untuk kode verifikasi saat ini';
+COMMENT ON COLUMN users.password_reset_code_hash IS 'Hash SHA-256 dari kode reset kata sandi, digarami dengan user_id';
+COMMENT ON COLUMN users.password_reset_attempts IS 'Jumlah tebakan salah untuk kode reset kata sandi saat ini';
@@ -0,0 +1,5 @@
+-- ============================================================================
+-- DROP MAGIC LINKS
+-- ============================================================================
+
+DROP TABLE IF EXISTS magic_links;
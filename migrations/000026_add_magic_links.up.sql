@@ -0,0 +1,22 @@
+-- ============================================================================
+-- MAGIC LINKS
+-- Single-use links emailed for passwordless login. Only a SHA-256 hash of
+-- the link's token is stored. Expired links are removed by the worker.
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS magic_links (
+    link_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    token_hash CHAR(64) NOT NULL UNIQUE,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    used_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Rate limiting counts a user's recent links
+CREATE INDEX IF NOT EXISTS idx_magic_links_user_created ON magic_links(user_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_magic_links_expires_at ON magic_links(expires_at);
+
+COMMENT ON TABLE magic_links IS 'Tautan login sekali pakai yang dikirim lewat email';
+COMMENT ON COLUMN magic_links.token_hash IS 'Hash SHA-256 dari token di dalam tautan';
+COMMENT ON COLUMN magic_links.used_at IS 'Waktu tautan dipakai untuk login; NULL jika belum dipakai';
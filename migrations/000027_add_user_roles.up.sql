@@ -0,0 +1,11 @@
+-- ============================================================================
+-- ADD USER ROLES
+-- Users signing in through enterprise SSO get their role from the groups
+-- their identity provider puts them in. Everyone else is a member.
+-- ============================================================================
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS role VARCHAR(20) NOT NULL DEFAULT 'member';
+
+ALTER TABLE users ADD CONSTRAINT chk_users_role CHECK (role IN ('member', 'admin'));
+
+COMMENT ON COLUMN users.role IS 'Peran pengguna: member atau admin; untuk pengguna SSO diambil dari grup di penyedia identitas';
@@ -0,0 +1,8 @@
+-- ============================================================================
+-- DROP HABIT LOG RETENTION
+-- ============================================================================
+
+DROP TABLE IF EXISTS habit_log_monthly_summaries;
+
+ALTER TABLE users DROP CONSTRAINT IF EXISTS chk_users_plan;
+ALTER TABLE users DROP COLUMN IF EXISTS plan;
@@ -0,0 +1,32 @@
+-- ============================================================================
+-- HABIT LOG RETENTION
+-- Users are on a plan that decides how long their habit logs are kept. Logs
+-- past their plan's retention are folded into one summary row per habit and
+-- month before they are deleted, so totals and long-term trends survive
+-- while habit_logs stays bounded.
+-- ============================================================================
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS plan VARCHAR(20) NOT NULL DEFAULT 'free';
+
+ALTER TABLE users ADD CONSTRAINT chk_users_plan CHECK (plan IN ('free', 'pro'));
+
+COMMENT ON COLUMN users.plan IS 'Paket langganan pengguna: free atau pro; menentukan berapa lama log kebiasaan disimpan';
+
+CREATE TABLE IF NOT EXISTS habit_log_monthly_summaries (
+    habit_id UUID NOT NULL REFERENCES habits(habit_id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    month DATE NOT NULL,
+    days_logged INT NOT NULL DEFAULT 0,
+    total_count INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (habit_id, month),
+    CONSTRAINT chk_habit_log_monthly_summaries_month CHECK (month = date_trunc('month', month)::date)
+);
+
+CREATE INDEX IF NOT EXISTS idx_habit_log_monthly_summaries_user ON habit_log_monthly_summaries(user_id, month DESC);
+
+COMMENT ON TABLE habit_log_monthly_summaries IS 'Ringkasan bulanan log kebiasaan yang sudah melewati masa simpan paket pengguna';
+COMMENT ON COLUMN habit_log_monthly_summaries.month IS 'Tanggal pertama bulan yang diringkas';
+COMMENT ON COLUMN habit_log_monthly_summaries.days_logged IS 'Jumlah hari dalam bulan itu yang memiliki log';
+COMMENT ON COLUMN habit_log_monthly_summaries.total_count IS 'Jumlah total penyelesaian dari log yang diringkas';
@@ -0,0 +1,5 @@
+-- ============================================================================
+-- DROP SUBSCRIPTIONS
+-- ============================================================================
+
+DROP TABLE IF EXISTS subscriptions;
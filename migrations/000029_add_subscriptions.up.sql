@@ -0,0 +1,32 @@
+-- ============================================================================
+-- SUBSCRIPTIONS
+-- Paid plans are billed through Stripe. Each Stripe subscription is mirrored
+-- here from its webhook events, and users.plan follows the subscriptions
+-- that currently grant a plan. stripe_event_at is when the last applied
+-- event was created, so events delivered out of order are ignored.
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS subscriptions (
+    subscription_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    stripe_subscription_id VARCHAR(255) NOT NULL,
+    stripe_customer_id VARCHAR(255) NOT NULL,
+    stripe_price_id VARCHAR(255) NOT NULL,
+    plan VARCHAR(20) NOT NULL,
+    status VARCHAR(32) NOT NULL,
+    current_period_end TIMESTAMPTZ,
+    cancel_at_period_end BOOLEAN NOT NULL DEFAULT false,
+    stripe_event_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_subscriptions_stripe_subscription UNIQUE (stripe_subscription_id),
+    CONSTRAINT chk_subscriptions_plan CHECK (plan IN ('free', 'pro'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_subscriptions_user_id ON subscriptions(user_id);
+CREATE INDEX IF NOT EXISTS idx_subscriptions_customer_id ON subscriptions(stripe_customer_id);
+
+COMMENT ON TABLE subscriptions IS 'Langganan Stripe pengguna, diperbarui dari event webhook Stripe';
+COMMENT ON COLUMN subscriptions.plan IS 'Paket yang diberikan langganan selama statusnya aktif';
+COMMENT ON COLUMN subscriptions.status IS 'Status langganan di Stripe, misalnya active, trialing, past_due atau canceled';
+COMMENT ON COLUMN subscriptions.stripe_event_at IS 'Waktu event Stripe terakhir yang diterapkan; event yang lebih lama diabaikan';
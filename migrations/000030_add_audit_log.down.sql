@@ -0,0 +1,5 @@
+-- ============================================================================
+-- DROP AUDIT LOG
+-- ============================================================================
+
+DROP TABLE IF EXISTS audit_log;
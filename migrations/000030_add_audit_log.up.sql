@@ -0,0 +1,23 @@
+-- ============================================================================
+-- AUDIT LOG
+-- Operator actions on user accounts, such as anonymizing a user on request.
+-- target_user_id has no foreign key so entries outlive the users they are
+-- about.
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS audit_log (
+    audit_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    actor VARCHAR(255) NOT NULL,
+    action VARCHAR(64) NOT NULL,
+    target_user_id UUID,
+    details JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_log_target_user ON audit_log(target_user_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_log_created_at ON audit_log(created_at DESC);
+
+COMMENT ON TABLE audit_log IS 'Catatan tindakan operator terhadap akun pengguna';
+COMMENT ON COLUMN audit_log.actor IS 'Operator yang melakukan tindakan';
+COMMENT ON COLUMN audit_log.action IS 'Jenis tindakan, misalnya user.anonymized';
+COMMENT ON COLUMN audit_log.target_user_id IS 'Pengguna yang dikenai tindakan; tanpa foreign key agar catatan tetap ada setelah pengguna dihapus';
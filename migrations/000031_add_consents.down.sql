@@ -0,0 +1,5 @@
+-- ============================================================================
+-- DROP CONSENTS
+-- ============================================================================
+
+DROP TABLE IF EXISTS consents;
@@ -0,0 +1,22 @@
+-- ============================================================================
+-- CONSENTS
+-- Acceptance of each version of the terms of service and privacy policy,
+-- with when and from which IP address. Once a new version is published,
+-- signed in users must accept it before they can use the API again.
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS consents (
+    consent_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    document VARCHAR(20) NOT NULL,
+    version VARCHAR(64) NOT NULL,
+    client_ip VARCHAR(50) NOT NULL DEFAULT '',
+    accepted_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_consents_user_document_version UNIQUE (user_id, document, version),
+    CONSTRAINT chk_consents_document CHECK (document IN ('terms', 'privacy'))
+);
+
+COMMENT ON TABLE consents IS 'Persetujuan pengguna atas setiap versi syarat layanan dan kebijakan privasi';
+COMMENT ON COLUMN consents.document IS 'Dokumen yang disetujui: terms atau privacy';
+COMMENT ON COLUMN consents.version IS 'Versi dokumen yang disetujui';
+COMMENT ON COLUMN consents.client_ip IS 'Alamat IP klien saat menyetujui; dikosongkan saat pengguna dianonimkan';
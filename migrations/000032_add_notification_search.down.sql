@@ -0,0 +1,6 @@
+-- ============================================================================
+-- DROP NOTIFICATION SEARCH
+-- ============================================================================
+
+DROP INDEX IF EXISTS idx_notifications_message_trgm;
+DROP INDEX IF EXISTS idx_notifications_title_trgm;
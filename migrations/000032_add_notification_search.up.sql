@@ -0,0 +1,13 @@
+-- ============================================================================
+-- NOTIFICATION SEARCH
+-- Trigram indexes so searching notifications by a keyword anywhere in the
+-- title or message (ILIKE '%keyword%') doesn't scan the whole inbox
+-- ============================================================================
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS idx_notifications_title_trgm
+    ON notifications USING GIN (title gin_trgm_ops);
+
+CREATE INDEX IF NOT EXISTS idx_notifications_message_trgm
+    ON notifications USING GIN (message gin_trgm_ops);
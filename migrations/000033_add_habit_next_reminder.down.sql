@@ -0,0 +1,7 @@
+-- ============================================================================
+-- DROP HABIT NEXT REMINDER
+-- ============================================================================
+
+DROP INDEX IF EXISTS idx_habits_next_reminder_at;
+ALTER TABLE habits DROP COLUMN IF EXISTS reminder_timezone;
+ALTER TABLE habits DROP COLUMN IF EXISTS next_reminder_at;
@@ -0,0 +1,16 @@
+-- ============================================================================
+-- HABIT NEXT REMINDER
+-- When each habit is next reminded, computed from its reminder time in the
+-- user's timezone and stored in UTC, so reminders fire once a day even on
+-- the days clocks change. Left NULL until the reminder sweep schedules it.
+-- ============================================================================
+
+ALTER TABLE habits ADD COLUMN IF NOT EXISTS next_reminder_at TIMESTAMPTZ;
+ALTER TABLE habits ADD COLUMN IF NOT EXISTS reminder_timezone TEXT;
+
+CREATE INDEX IF NOT EXISTS idx_habits_next_reminder_at
+    ON habits (next_reminder_at)
+    WHERE is_active = true;
+
+COMMENT ON COLUMN habits.next_reminder_at IS 'Waktu pengingat berikutnya (UTC); NULL jika belum dijadwalkan';
+COMMENT ON COLUMN habits.reminder_timezone IS 'Zona waktu pengguna saat pengingat dijadwalkan; dijadwalkan ulang jika zona waktu pengguna berubah';
@@ -0,0 +1,5 @@
+-- ============================================================================
+-- DROP USER DEACTIVATED BY
+-- ============================================================================
+
+ALTER TABLE users DROP COLUMN IF EXISTS deactivated_by;
@@ -0,0 +1,17 @@
+-- ============================================================================
+-- USER DEACTIVATED BY
+-- Who switched an account off: the user, who may switch it back on by
+-- signing in, or the identity provider through provisioning, in which case
+-- it stays off until it is provisioned again. NULL while the account is
+-- active.
+-- ============================================================================
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS deactivated_by TEXT;
+
+-- Accounts deactivated before this column existed: single sign-on accounts
+-- may have been deprovisioned, so they are not reactivated by signing in
+UPDATE users
+SET deactivated_by = CASE WHEN auth_provider = 'oidc' THEN 'provisioning' ELSE 'user' END
+WHERE is_active = false AND deactivated_by IS NULL;
+
+COMMENT ON COLUMN users.deactivated_by IS 'Siapa yang menonaktifkan akun: user atau provisioning; NULL jika akun aktif';
@@ -13,9 +13,9 @@ export const authAPI = {
     return response.data;
   },
 
-  // Logout current session
-  logout: async (sessionId) => {
-    const response = await apiClient.post('/auth/logout', { session_id: sessionId });
+  // Logout current session (taken from the access token)
+  logout: async () => {
+    const response = await apiClient.post('/auth/logout', {});
     return response.data;
   },
 
@@ -73,8 +73,7 @@ export const authAPI = {
   },
 
   revokeSession: async (sessionId) => {
-    // Using logout endpoint to revoke a specific session
-    const response = await apiClient.post('/auth/logout', { session_id: sessionId });
+    const response = await apiClient.delete(`/auth/sessions/${sessionId}`);
     return response.data;
   },
 
@@ -71,7 +71,7 @@ export default function SessionsScreen() {
             <Text style={[styles.deviceName, { color: theme.colors.text }]} numberOfLines={1}>
               {item.user_agent || 'Unknown Device'}
             </Text>
-            <Text style={[styles.deviceMeta, { color: theme.colors.textMuted }]}>{item.client_ip}</Text>
+            <Text style={[styles.deviceMeta, { color: theme.colors.textMuted }]}>{[item.client_ip, [item.city, item.country].filter(Boolean).join(', ')].filter(Boolean).join(' • ')}</Text>
           </View>
         </View>
 
@@ -99,7 +99,7 @@ export const useAuthStore = create((set, get) => ({
     try {
       const sessionId = get().sessionId;
       if (sessionId) {
-        await authAPI.logout(sessionId);
+        await authAPI.logout();
       }
     } catch {
       // Ignore errors on logout